@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
 	"github.com/go-redis/redis/v8"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -23,6 +27,7 @@ import (
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
@@ -39,21 +44,26 @@ const (
 
 func NewCommand() *cobra.Command {
 	var (
-		clientConfig             clientcmd.ClientConfig
-		appResyncPeriod          int64
-		repoServerAddress        string
-		repoServerTimeoutSeconds int
-		selfHealTimeoutSeconds   int
-		statusProcessors         int
-		operationProcessors      int
-		glogLevel                int
-		metricsPort              int
-		metricsCacheExpiration   time.Duration
-		kubectlParallelismLimit  int64
-		cacheSrc                 func() (*appstatecache.Cache, error)
-		redisClient              *redis.Client
-		repoServerPlaintext      bool
-		repoServerStrictTLS      bool
+		clientConfig               clientcmd.ClientConfig
+		appResyncPeriod            int64
+		repoServerAddress          string
+		repoServerTimeoutSeconds   int
+		selfHealTimeoutSeconds     int
+		statusProcessors           int
+		operationProcessors        int
+		glogLevel                  int
+		metricsPort                int
+		metricsCacheExpiration     time.Duration
+		kubectlParallelismLimit    int64
+		cacheSrc                   func() (*appstatecache.Cache, error)
+		redisClient                redis.UniversalClient
+		repoServerPlaintext        bool
+		repoServerStrictTLS        bool
+		repoServerSharding         bool
+		dynamicClusterDistribution bool
+		shardingHeartbeatInterval  time.Duration
+		clusterSelector            string
+		refreshRateLimiterConfig   controller.AppRefreshRateLimiterConfig
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -64,6 +74,7 @@ func NewCommand() *cobra.Command {
 			cli.SetLogFormat(cmdutil.LogFormat)
 			cli.SetLogLevel(cmdutil.LogLevel)
 			cli.SetGLogLevel(glogLevel)
+			errors.CheckError(db.RegisterVaultCredentialsProviderFromEnv())
 
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
@@ -101,7 +112,7 @@ func NewCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 
-			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			repoClientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig, apiclient.WithSharding(repoServerSharding))
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -112,7 +123,21 @@ func NewCommand() *cobra.Command {
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 			kubectl := kubeutil.NewKubectl()
-			clusterFilter := getClusterFilter()
+			selector, err := labels.Parse(clusterSelector)
+			errors.CheckError(err)
+			var clusterSharding *sharding.ClusterSharding
+			var clusterFilter func(cluster *v1alpha1.Cluster) bool
+			if dynamicClusterDistribution {
+				clusterSharding, err = newClusterSharding(ctx, kubeClient, settingsMgr, namespace, cache, shardingHeartbeatInterval, selector)
+				errors.CheckError(err)
+				clusterFilter = clusterSharding.IsClusterHandled
+			} else {
+				clusterFilter = getClusterFilter()
+			}
+			if !selector.Empty() {
+				log.Infof("Processing clusters matching the selector %s", selector)
+				clusterFilter = sharding.WithClusterSelector(selector, clusterFilter)
+			}
 			appController, err := controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -126,7 +151,8 @@ func NewCommand() *cobra.Command {
 				metricsPort,
 				metricsCacheExpiration,
 				kubectlParallelismLimit,
-				clusterFilter)
+				clusterFilter,
+				refreshRateLimiterConfig)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
 
@@ -137,6 +163,24 @@ func NewCommand() *cobra.Command {
 			stats.RegisterHeapDumper("memprofile")
 
 			go appController.Run(ctx, statusProcessors, operationProcessors)
+			if clusterSharding != nil {
+				// the clusters of the replica are released on shutdown so that the other replicas take them over
+				// without waiting for the replica to be considered gone
+				signals := make(chan os.Signal, 1)
+				signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+				shardingDone := make(chan struct{})
+				go func() {
+					defer close(shardingDone)
+					clusterSharding.Run(ctx, func() {
+						appController.ReconcileClusterShards(context.Background())
+					})
+				}()
+				sig := <-signals
+				log.Infof("Received %s, releasing the clusters of the replica", sig)
+				cancel()
+				<-shardingDone
+				return nil
+			}
 
 			// Wait forever
 			select {}
@@ -158,12 +202,49 @@ func NewCommand() *cobra.Command {
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", 20, "Number of allowed concurrent kubectl fork/execs. Any value less the 1 means no limit.")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_PLAINTEXT", false), "Disable TLS on connections to repo server")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_STRICT_TLS", false), "Whether to use strict validation of the TLS cert presented by the repo server")
-	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
+	command.Flags().BoolVar(&repoServerSharding, "repo-server-sharding", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_REPO_SERVER_SHARDING", false), "Send all the requests for a repository to the same repo server replica. The repo server address must resolve to the addresses of all the replicas (e.g. a headless service)")
+	command.Flags().BoolVar(&dynamicClusterDistribution, "dynamic-cluster-distribution", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_DYNAMIC_CLUSTER_DISTRIBUTION", false), "Dynamically distribute the clusters across the live controller replicas, balanced by their number of resources, instead of using the replica index")
+	command.Flags().StringVar(&clusterSelector, "cluster-selector", env.StringFromEnv("ARGOCD_APPLICATION_CONTROLLER_CLUSTER_SELECTOR", ""), "Only process the clusters whose secret labels match the given label selector, e.g. to dedicate controllers to the largest clusters")
+	command.Flags().DurationVar(&shardingHeartbeatInterval, "sharding-heartbeat-interval", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_SHARDING_HEARTBEAT_INTERVAL", 10*time.Second, time.Second, math.MaxInt64), "Interval of the replica heartbeats used by the dynamic cluster distribution. A replica is considered gone after three missed heartbeats")
+	command.Flags().Float64Var(&refreshRateLimiterConfig.GlobalQPS, "refresh-qps", env.ParseFloatFromEnv("ARGOCD_APPLICATION_CONTROLLER_REFRESH_QPS", 0, 0, math.MaxFloat64), "Maximum number of application refreshes per second across all the applications. Zero means no limit")
+	command.Flags().IntVar(&refreshRateLimiterConfig.GlobalBurst, "refresh-burst", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_REFRESH_BURST", 100, 1, math.MaxInt32), "Number of application refreshes allowed at once across all the applications before --refresh-qps applies")
+	command.Flags().Float64Var(&refreshRateLimiterConfig.AppQPS, "app-refresh-qps", env.ParseFloatFromEnv("ARGOCD_APPLICATION_CONTROLLER_APP_REFRESH_QPS", 0, 0, math.MaxFloat64), "Maximum number of refreshes per second of a single application. Zero means no limit")
+	command.Flags().IntVar(&refreshRateLimiterConfig.AppBurst, "app-refresh-burst", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_APP_REFRESH_BURST", 5, 1, math.MaxInt32), "Number of refreshes of a single application allowed at once before --app-refresh-qps applies")
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client redis.UniversalClient) {
 		redisClient = client
 	})
 	return &command
 }
 
+func newClusterSharding(ctx context.Context, kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, namespace string, cache *appstatecache.Cache, heartbeatInterval time.Duration, selector labels.Selector) (*sharding.ClusterSharding, error) {
+	replica, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+	clusterSharding := sharding.NewClusterSharding(kubeClient, namespace, replica, heartbeatInterval, func() ([]v1alpha1.Cluster, error) {
+		clusters, err := argoDB.ListClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var selected []v1alpha1.Cluster
+		for _, c := range clusters.Items {
+			if selector.Matches(labels.Set(c.Labels)) {
+				selected = append(selected, c)
+			}
+		}
+		return selected, nil
+	}, func(server string) int64 {
+		var info v1alpha1.ClusterInfo
+		if err := cache.GetClusterInfo(server, &info); err != nil {
+			return 0
+		}
+		return info.CacheInfo.ResourcesCount
+	})
+	log.Infof("Processing clusters dynamically assigned to replica %s", replica)
+	return clusterSharding, clusterSharding.Init(ctx)
+}
+
 func getClusterFilter() func(cluster *v1alpha1.Cluster) bool {
 	replicas := env.ParseNumFromEnv(common.EnvControllerReplicas, 0, 0, math.MaxInt32)
 	shard := env.ParseNumFromEnv(common.EnvControllerShard, -1, -math.MaxInt32, math.MaxInt32)
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+const (
+	cliName = "argocd-git-ssh-proxy"
+)
+
+// NewCommand returns a new instance of an argocd-git-ssh-proxy command, which is used as SSH ProxyCommand to
+// connect to SSH git servers through a SOCKS5 or HTTP CONNECT proxy
+func NewCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:               cliName + " HOST PORT",
+		Short:             "Connects stdin and stdout to an SSH git server through a proxy",
+		Long:              fmt.Sprintf("argocd-git-ssh-proxy is an SSH ProxyCommand used by Argo CD. The SOCKS5 or HTTP CONNECT proxy is read from the %s environment variable.", common.EnvGitSSHProxy),
+		DisableAutoGenTag: true,
+		Args:              cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			proxyURL := os.Getenv(common.EnvGitSSHProxy)
+			if proxyURL == "" {
+				errors.CheckError(fmt.Errorf("%s is not set", common.EnvGitSSHProxy))
+			}
+			conn, err := git.DialProxy(proxyURL, net.JoinHostPort(args[0], args[1]))
+			errors.CheckError(err)
+			defer func() { _ = conn.Close() }()
+
+			go func() {
+				_, _ = io.Copy(conn, os.Stdin)
+			}()
+			// the session ends when the server closes the connection
+			_, _ = io.Copy(os.Stdout, conn)
+		},
+	}
+	return command
+}
@@ -13,6 +13,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
@@ -21,6 +22,7 @@ import (
 	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
 	"github.com/argoproj/argo-cd/v2/reposerver/repository"
+	"github.com/argoproj/argo-cd/v2/util/app/discovery"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/env"
@@ -28,6 +30,8 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/healthz"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
+	argojsonnet "github.com/argoproj/argo-cd/v2/util/jsonnet"
+	"github.com/argoproj/argo-cd/v2/util/sandbox"
 	"github.com/argoproj/argo-cd/v2/util/tls"
 )
 
@@ -63,14 +67,34 @@ func getPauseGenerationOnFailureForRequests() int {
 
 func NewCommand() *cobra.Command {
 	var (
-		parallelismLimit       int64
-		listenPort             int
-		metricsPort            int
-		cacheSrc               func() (*reposervercache.Cache, error)
-		tlsConfigCustomizer    tls.ConfigCustomizer
-		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
-		redisClient            *redis.Client
-		disableTLS             bool
+		parallelismLimit             int64
+		listenPort                   int
+		metricsPort                  int
+		cacheSrc                     func() (*reposervercache.Cache, error)
+		tlsConfigCustomizer          tls.ConfigCustomizer
+		tlsConfigCustomizerSrc       func() (tls.ConfigCustomizer, error)
+		redisClient                  redis.UniversalClient
+		disableTLS                   bool
+		helmDependencyCacheDir       string
+		helmDependencyCacheMax       string
+		manifestGenTimeout           time.Duration
+		maxManifestGenTimeout        time.Duration
+		jsonnetVendorCacheDir        string
+		cacheConfigDir               string
+		jsonnetNativeFuncs           []string
+		jsonnetImportPaths           []string
+		failOnDuplicates             bool
+		pluginMaxOutput              string
+		cloneCacheDir                string
+		cloneCacheMax                string
+		cloneCacheGCInterval         time.Duration
+		kustomizeAllowedBuildOptions []string
+		appDiscoveryMaxDepth         int
+		appDiscoveryExclusions       []string
+		sandboxEnabled               bool
+		sandboxHiddenDirs            []string
+		sandboxNetworkTools          []string
+		sopsKeysDir                  string
 	)
 	var command = cobra.Command{
 		Use:               cliName,
@@ -90,13 +114,47 @@ func NewCommand() *cobra.Command {
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
+			helmDependencyCacheMaxSize, err := resource.ParseQuantity(helmDependencyCacheMax)
+			errors.CheckError(err)
+			pluginMaxOutputSize, err := resource.ParseQuantity(pluginMaxOutput)
+			errors.CheckError(err)
+			cloneCacheMaxSize, err := resource.ParseQuantity(cloneCacheMax)
+			errors.CheckError(err)
+			errors.CheckError(argojsonnet.ValidateNativeFunctions(jsonnetNativeFuncs))
+			errors.CheckError(discovery.ValidateExclusions(appDiscoveryExclusions))
+			if sopsKeysDir != "" {
+				// the key sets are only exposed to the sops commands decrypting the files of their projects
+				sandboxHiddenDirs = append(sandboxHiddenDirs, sopsKeysDir)
+			}
+			commandSandbox, err := sandbox.NewSandbox(sandboxEnabled, sandboxHiddenDirs, sandboxNetworkTools)
+			errors.CheckError(err)
+
 			metricsServer := metrics.NewMetricsServer()
-			cacheutil.CollectMetrics(redisClient, metricsServer)
+			if redisClient != nil {
+				cacheutil.CollectMetrics(redisClient, metricsServer)
+			}
 			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
 				ParallelismLimit: parallelismLimit,
 				PauseGenerationAfterFailedGenerationAttempts: getPauseGenerationAfterFailedGenerationAttempts(),
 				PauseGenerationOnFailureForMinutes:           getPauseGenerationOnFailureForMinutes(),
 				PauseGenerationOnFailureForRequests:          getPauseGenerationOnFailureForRequests(),
+				HelmDependencyCacheDir:                       helmDependencyCacheDir,
+				HelmDependencyCacheMaxSize:                   helmDependencyCacheMaxSize.Value(),
+				ManifestGenerationTimeout:                    manifestGenTimeout,
+				MaxManifestGenerationTimeout:                 maxManifestGenTimeout,
+				JsonnetVendorCacheDir:                        jsonnetVendorCacheDir,
+				JsonnetNativeFunctions:                       jsonnetNativeFuncs,
+				JsonnetImportPaths:                           jsonnetImportPaths,
+				FailOnDuplicateResources:                     failOnDuplicates,
+				PluginMaxOutputSize:                          pluginMaxOutputSize.Value(),
+				CloneCacheDir:                                cloneCacheDir,
+				CloneCacheMaxSize:                            cloneCacheMaxSize.Value(),
+				CloneCacheGCInterval:                         cloneCacheGCInterval,
+				KustomizeAllowedBuildOptions:                 kustomizeAllowedBuildOptions,
+				AppDiscoveryMaxDepth:                         appDiscoveryMaxDepth,
+				AppDiscoveryExclusions:                       appDiscoveryExclusions,
+				Sandbox:                                      commandSandbox,
+				SopsKeysDir:                                  sopsKeysDir,
 			})
 			errors.CheckError(err)
 
@@ -142,6 +200,12 @@ func NewCommand() *cobra.Command {
 				go func() { errors.CheckError(reposerver.StartGPGWatcher(getGnuPGSourcePath())) }()
 			}
 
+			if cacheConfigDir != "" {
+				go func() {
+					errors.CheckError(reposerver.StartCacheExpirationWatcher(cache, cacheConfigDir, cache.Expirations()))
+				}()
+			}
+
 			log.Infof("argocd-repo-server %s serving on %s", common.GetVersion(), listener.Addr())
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
@@ -159,10 +223,30 @@ func NewCommand() *cobra.Command {
 	command.Flags().Int64Var(&parallelismLimit, "parallelismlimit", int64(env.ParseNumFromEnv("ARGOCD_REPO_SERVER_PARALLELISM_LIMIT", 0, 0, math.MaxInt32)), "Limit on number of concurrent manifests generate requests. Any value less the 1 means no limit.")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortRepoServer, "Listen on given port for incoming connections")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortRepoServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&helmDependencyCacheDir, "helm-dependency-cache-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_DEPENDENCY_CACHE_DIR", ""), "Directory of the Helm chart archive cache shared by all applications. The cache is disabled if empty.")
+	command.Flags().StringVar(&helmDependencyCacheMax, "helm-dependency-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_HELM_DEPENDENCY_CACHE_MAX_SIZE", "1Gi"), "Maximum size of the Helm chart archive cache. Any value less than 1 means no limit.")
+	command.Flags().StringVar(&jsonnetVendorCacheDir, "jsonnet-vendor-cache-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_JSONNET_VENDOR_CACHE_DIR", ""), "Directory of the cache of jsonnet-bundler dependencies shared by all applications. The cache is disabled if empty.")
+	command.Flags().StringSliceVar(&jsonnetNativeFuncs, "jsonnet-native-functions", env.StringsFromEnv("ARGOCD_REPO_SERVER_JSONNET_NATIVE_FUNCTIONS", []string{}, ","), "Native functions available to Jsonnet files with std.native(). One or more of: parseJson|parseYaml|manifestYamlFromJson|sha256|regexMatch|regexSubst")
+	command.Flags().StringSliceVar(&jsonnetImportPaths, "jsonnet-import-paths", env.StringsFromEnv("ARGOCD_REPO_SERVER_JSONNET_IMPORT_PATHS", []string{}, ","), "Directories outside of the repository Jsonnet files are allowed to import files from. They are also added to the Jsonnet library paths.")
+	command.Flags().DurationVar(&manifestGenTimeout, "manifest-generation-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_MANIFEST_GENERATION_TIMEOUT", 0, 0, math.MaxInt64), "Maximum duration of the manifest generation of an application, which can be overridden with the argocd.argoproj.io/manifest-generation-timeout annotation. 0 means no limit.")
+	command.Flags().DurationVar(&maxManifestGenTimeout, "max-manifest-generation-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_MAX_MANIFEST_GENERATION_TIMEOUT", 0, 0, math.MaxInt64), "Maximum manifest generation timeout applications can set with the argocd.argoproj.io/manifest-generation-timeout annotation, greater timeouts are lowered to it. 0 means the value of --manifest-generation-timeout.")
+	command.Flags().BoolVar(&failOnDuplicates, "fail-on-duplicate-resources", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_FAIL_ON_DUPLICATE_RESOURCES", false), "Fail the manifest generation if a resource with the same group, kind, namespace and name is generated more than once, instead of reporting a warning")
+	command.Flags().StringVar(&pluginMaxOutput, "plugin-max-output-size", env.StringFromEnv("ARGOCD_REPO_SERVER_PLUGIN_MAX_OUTPUT_SIZE", "100Mi"), "Maximum size of the output of the config management plugin commands. Any value less than 1 means no limit.")
+	command.Flags().StringVar(&cloneCacheDir, "clone-cache-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_CLONE_CACHE_DIR", ""), "Persistent directory of the clones of the repositories, which are reused after a restart. The clones are stored in the temp directory if empty.")
+	command.Flags().StringVar(&cloneCacheMax, "clone-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_CLONE_CACHE_MAX_SIZE", "10Gi"), "Size of the clone cache above which the least recently used clones are evicted. Any value less than 1 means no limit.")
+	command.Flags().DurationVar(&cloneCacheGCInterval, "clone-cache-gc-interval", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_CLONE_CACHE_GC_INTERVAL", 10*time.Minute, 0, math.MaxInt64), "Interval at which the size of the clone cache is checked. 0 disables the eviction of clones.")
+	command.Flags().StringSliceVar(&kustomizeAllowedBuildOptions, "kustomize-allowed-build-options", env.StringsFromEnv("ARGOCD_REPO_SERVER_KUSTOMIZE_ALLOWED_BUILD_OPTIONS", []string{}, ","), "Flags of kustomize build projects are allowed to enable with their kustomizeBuildOptions, e.g. --load-restrictor,--enable-alpha-plugins. The build options of projects are rejected if empty.")
+	command.Flags().IntVar(&appDiscoveryMaxDepth, "app-discovery-max-depth", env.ParseNumFromEnv("ARGOCD_REPO_SERVER_APP_DISCOVERY_MAX_DEPTH", 0, 0, math.MaxInt32), "Maximum depth below the repository root of the applications discovered when creating an application from a repository. 0 means no limit.")
+	command.Flags().StringSliceVar(&appDiscoveryExclusions, "app-discovery-exclusions", env.StringsFromEnv("ARGOCD_REPO_SERVER_APP_DISCOVERY_EXCLUSIONS", []string{}, ","), "Glob patterns of the directories which are not searched for applications, matching the path relative to the repository root or the directory name, e.g. docs/*,node_modules")
+	command.Flags().BoolVar(&sandboxEnabled, "sandbox-enabled", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_SANDBOX_ENABLED", false), "Run the commands of the templating tools and config management plugins in a sandbox, in their own user, mount and network namespaces, without capabilities and with a seccomp filter. Requires user namespaces to be allowed in the repo server container.")
+	command.Flags().StringSliceVar(&sandboxHiddenDirs, "sandbox-hidden-dirs", env.StringsFromEnv("ARGOCD_REPO_SERVER_SANDBOX_HIDDEN_DIRS", []string{os.TempDir(), "/dev/shm", "/app/config/gpg", "/app/config/reposerver", "/var/run/secrets"}, ","), "Directories hidden from the sandboxed commands, e.g. because they hold the checkouts and credentials of the repositories. The commands only have access to the files of the application they generate the manifests of.")
+	command.Flags().StringSliceVar(&sandboxNetworkTools, "sandbox-network-tools", env.StringsFromEnv("ARGOCD_REPO_SERVER_SANDBOX_NETWORK_TOOLS", []string{}, ","), "Tools whose sandboxed commands keep network access, among helm, kustomize, helmfile, ytt, jb, sops, ksonnet and the names of config management plugins. The commands of the other tools run without network.")
+	command.Flags().StringVar(&sopsKeysDir, "sops-keys-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_SOPS_KEYS_DIR", ""), "Directory holding the SOPS key sets, one subdirectory per key set, the SOPS encrypted files of the applications are decrypted with the key set of their project. The files are not decrypted if empty.")
+	command.Flags().StringVar(&cacheConfigDir, "cache-config-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_CACHE_CONFIG_DIR", ""), "Directory of the mounted argocd-cmd-params-cm ConfigMap. The cache expirations are reloaded when the ConfigMap changes. Disabled if empty.")
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS on the gRPC endpoint")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
-	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
+	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, func(client redis.UniversalClient) {
 		redisClient = client
 	})
 	return &command
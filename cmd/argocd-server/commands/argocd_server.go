@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
@@ -18,9 +21,13 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	repocache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	"github.com/argoproj/argo-cd/v2/server"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/kube"
@@ -45,7 +52,7 @@ func init() {
 // NewCommand returns a new instance of an argocd command
 func NewCommand() *cobra.Command {
 	var (
-		redisClient              *redis.Client
+		redisClient              redis.UniversalClient
 		insecure                 bool
 		listenPort               int
 		metricsPort              int
@@ -60,10 +67,17 @@ func NewCommand() *cobra.Command {
 		enableGZip               bool
 		tlsConfigCustomizerSrc   func() (tls.ConfigCustomizer, error)
 		cacheSrc                 func() (*servercache.Cache, error)
+		repoCacheSrc             func(redisCache *cacheutil.Cache) (*repocache.Cache, error)
 		frameOptions             string
 		repoServerPlaintext      bool
 		repoServerStrictTLS      bool
+		repoServerSharding       bool
 		staticAssetsDir          string
+		webhookWarmManifestCache bool
+		auditLogSink             string
+		auditLogWebhookURL       string
+		auditLogKafkaURL         string
+		auditLogKafkaTopic       string
 	)
 	var command = &cobra.Command{
 		Use:               cliName,
@@ -74,6 +88,7 @@ func NewCommand() *cobra.Command {
 			cli.SetLogFormat(cmdutil.LogFormat)
 			cli.SetLogLevel(cmdutil.LogLevel)
 			cli.SetGLogLevel(glogLevel)
+			errors.CheckError(db.RegisterVaultCredentialsProviderFromEnv())
 
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
@@ -86,6 +101,8 @@ func NewCommand() *cobra.Command {
 			errors.CheckError(err)
 			cache, err := cacheSrc()
 			errors.CheckError(err)
+			repoCache, err := repoCacheSrc(cache.GetCache())
+			errors.CheckError(err)
 
 			kubeclientset := kubernetes.NewForConfigOrDie(config)
 
@@ -115,7 +132,8 @@ func NewCommand() *cobra.Command {
 				tlsConfig.Certificates = pool
 			}
 
-			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			// requests of the API server are made on behalf of users, so they are served before the controller ones
+			repoclientset := apiclient.NewRepoServerClientsetWithPriority(repoServerAddress, repoServerTimeoutSeconds, tlsConfig, apiclient.RequestPriorityInteractive, apiclient.WithSharding(repoServerSharding))
 			if rootPath != "" {
 				if baseHRef != "" && baseHRef != rootPath {
 					log.Warnf("--basehref and --rootpath had conflict: basehref: %s rootpath: %s", baseHRef, rootPath)
@@ -123,24 +141,48 @@ func NewCommand() *cobra.Command {
 				baseHRef = rootPath
 			}
 
+			auditSink, err := audit.NewSink(auditLogSink, audit.SinkOptions{
+				WebhookURL: auditLogWebhookURL,
+				KafkaURL:   auditLogKafkaURL,
+				KafkaTopic: auditLogKafkaTopic,
+			})
+			errors.CheckError(err)
+			var auditLogger *audit.Logger
+			if auditSink != nil {
+				// the audit logger outlives the restarts of the server, and the queued events are flushed on shutdown
+				auditLogger = audit.NewLogger(auditSink)
+				go auditLogger.Run(context.Background())
+				signals := make(chan os.Signal, 1)
+				signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+				go func() {
+					sig := <-signals
+					log.Infof("Received %s, flushing the audit log", sig)
+					auditLogger.Close()
+					os.Exit(0)
+				}()
+			}
+
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:            insecure,
-				ListenPort:          listenPort,
-				MetricsPort:         metricsPort,
-				Namespace:           namespace,
-				BaseHRef:            baseHRef,
-				RootPath:            rootPath,
-				KubeClientset:       kubeclientset,
-				AppClientset:        appclientset,
-				RepoClientset:       repoclientset,
-				DexServerAddr:       dexServerAddress,
-				DisableAuth:         disableAuth,
-				EnableGZip:          enableGZip,
-				TLSConfigCustomizer: tlsConfigCustomizer,
-				Cache:               cache,
-				XFrameOptions:       frameOptions,
-				RedisClient:         redisClient,
-				StaticAssetsDir:     staticAssetsDir,
+				Insecure:                 insecure,
+				ListenPort:               listenPort,
+				MetricsPort:              metricsPort,
+				Namespace:                namespace,
+				BaseHRef:                 baseHRef,
+				RootPath:                 rootPath,
+				KubeClientset:            kubeclientset,
+				AppClientset:             appclientset,
+				RepoClientset:            repoclientset,
+				DexServerAddr:            dexServerAddress,
+				DisableAuth:              disableAuth,
+				EnableGZip:               enableGZip,
+				TLSConfigCustomizer:      tlsConfigCustomizer,
+				Cache:                    cache,
+				RepoCache:                repoCache,
+				XFrameOptions:            frameOptions,
+				RedisClient:              redisClient,
+				StaticAssetsDir:          staticAssetsDir,
+				WebhookWarmManifestCache: webhookWarmManifestCache,
+				AuditLogger:              auditLogger,
 			}
 
 			stats.RegisterStackDumper()
@@ -175,10 +217,17 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", env.ParseNumFromEnv("ARGOCD_SERVER_REPO_SERVER_TIMEOUT_SECONDS", 60, 0, math.MaxInt64), "Repo server RPC call timeout seconds.")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", env.StringFromEnv("ARGOCD_SERVER_X_FRAME_OPTIONS", "sameorigin"), "Set X-Frame-Options header in HTTP responses to `value`. To disable, set to \"\".")
 	command.Flags().BoolVar(&repoServerPlaintext, "repo-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to repository server")
+	command.Flags().BoolVar(&webhookWarmManifestCache, "webhook-warm-manifest-cache", env.ParseBoolFromEnv("ARGOCD_SERVER_WEBHOOK_WARM_MANIFEST_CACHE", false), "Generate the manifests of the applications affected by a webhook push event before refreshing them")
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to repo server")
+	command.Flags().BoolVar(&repoServerSharding, "repo-server-sharding", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_SHARDING", false), "Send all the requests for a repository to the same repo server replica. The repo server address must resolve to the addresses of all the replicas (e.g. a headless service)")
+	command.Flags().StringVar(&auditLogSink, "audit-log-sink", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_SINK", ""), "Record the actions performed through the API as JSON audit events to the given sink. One of: stdout|webhook|kafka. Disabled if empty")
+	command.Flags().StringVar(&auditLogWebhookURL, "audit-log-webhook-url", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_WEBHOOK_URL", ""), "URL the audit events are posted to by the webhook audit log sink")
+	command.Flags().StringVar(&auditLogKafkaURL, "audit-log-kafka-url", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_KAFKA_URL", ""), "URL of the Kafka REST proxy the audit events are produced through by the kafka audit log sink")
+	command.Flags().StringVar(&auditLogKafkaTopic, "audit-log-kafka-topic", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_KAFKA_TOPIC", ""), "Kafka topic the audit events are produced to by the kafka audit log sink")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
-	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client *redis.Client) {
+	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client redis.UniversalClient) {
 		redisClient = client
 	})
+	repoCacheSrc = repocache.AddSharedCacheFlagsToCmd(command)
 	return command
 }
@@ -43,6 +43,10 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountDeleteTokensCommand(clientOpts))
+	command.AddCommand(NewAccountCreateCommand(clientOpts))
+	command.AddCommand(NewAccountEnableCommand(clientOpts))
+	command.AddCommand(NewAccountDisableCommand(clientOpts))
 	return command
 }
 
@@ -310,7 +314,7 @@ func printAccountDetails(acc *accountpkg.Account) {
 		fmt.Println("NONE")
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
+		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tLAST USED\n")
 		for _, t := range acc.Tokens {
 			expiresAtFormatted := "never"
 			if t.ExpiresAt > 0 {
@@ -321,7 +325,12 @@ func printAccountDetails(acc *accountpkg.Account) {
 				}
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+			lastUsedAtFormatted := "never"
+			if t.LastUsedAt > 0 {
+				lastUsedAtFormatted = time.Unix(t.LastUsedAt, 0).Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, lastUsedAtFormatted)
 		}
 		_ = w.Flush()
 	}
@@ -361,7 +370,7 @@ argocd account generate-token --account <account-name>`,
 		},
 	}
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
-	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
+	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. Required if the server enforces a maximum token duration. (Default: No expiration)")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fall back to uuid if not value specified.")
 	return cmd
 }
@@ -398,3 +407,99 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func NewAccountDeleteTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "delete-tokens",
+		Short: "Deletes all the tokens of an account",
+		Example: `# Delete all the tokens of the currently logged in account
+argocd account delete-tokens
+
+# Delete all the tokens of the account with the specified name
+argocd account delete-tokens --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			clientset := argocdclient.NewClientOrDie(clientOpts)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(clientset).Username
+			}
+			deleted, err := client.DeleteTokens(context.Background(), &accountpkg.DeleteTokensRequest{Name: account})
+			errors.CheckError(err)
+			for _, t := range deleted.Items {
+				fmt.Printf("token '%s' deleted\n", t.Id)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
+
+func NewAccountCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		capabilities []string
+		disabled     bool
+	)
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a local account",
+		Example: `# Create an account which can log in to the UI and CLI
+argocd account create alice
+
+# Create an account which can only generate API tokens
+argocd account create ci --capabilities apiKey`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer io.Close(conn)
+			_, err := client.CreateAccount(context.Background(), &accountpkg.CreateAccountRequest{
+				Name:         args[0],
+				Capabilities: capabilities,
+				Disabled:     disabled,
+			})
+			errors.CheckError(err)
+			fmt.Printf("account '%s' created\n", args[0])
+		},
+	}
+	cmd.Flags().StringSliceVar(&capabilities, "capabilities", []string{"login"}, "Account capabilities. One or more of: login|apiKey")
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Create the account disabled")
+	return cmd
+}
+
+func NewAccountEnableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newAccountSetEnabledCommand(clientOpts, true)
+}
+
+func NewAccountDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newAccountSetEnabledCommand(clientOpts, false)
+}
+
+func newAccountSetEnabledCommand(clientOpts *argocdclient.ClientOptions, enabled bool) *cobra.Command {
+	verb := "disable"
+	if enabled {
+		verb = "enable"
+	}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s NAME", verb),
+		Short:   fmt.Sprintf("%s a local account", strings.Title(verb)),
+		Example: fmt.Sprintf("argocd account %s alice", verb),
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := argocdclient.NewClientOrDie(clientOpts).NewAccountClientOrDie()
+			defer io.Close(conn)
+			_, err := client.UpdateAccount(context.Background(), &accountpkg.UpdateAccountRequest{Name: args[0], Enabled: &accountpkg.BoolValue{Value: enabled}})
+			errors.CheckError(err)
+			fmt.Printf("account '%s' %sd\n", args[0], verb)
+		},
+	}
+	return cmd
+}
@@ -212,9 +212,25 @@ var validatorsByGroup = map[string]settingValidator{
 			}
 			ssoProvider = "OIDC"
 		}
+		var additionalProviders []string
+		if general.OIDCProvidersRAW != "" {
+			providers, err := settings.UnmarshalOIDCProviders(general.OIDCProvidersRAW)
+			if err != nil {
+				return "", fmt.Errorf("invalid oidc.providers: %v", err)
+			}
+			for _, provider := range providers {
+				additionalProviders = append(additionalProviders, provider.Name)
+			}
+			if ssoProvider == "" && len(additionalProviders) > 0 {
+				ssoProvider = "OIDC"
+			}
+		}
 		var summary string
 		if ssoProvider != "" {
 			summary = fmt.Sprintf("%s is configured", ssoProvider)
+			if len(additionalProviders) > 0 {
+				summary = summary + fmt.Sprintf(" with the additional providers %s", strings.Join(additionalProviders, ", "))
+			}
 			if general.URL == "" {
 				summary = summary + " ('url' field is missing)"
 			}
@@ -504,13 +520,17 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 }
 
 func NewResourceActionRunCommand(cmdCtx commandContext) *cobra.Command {
+	var params []string
 	var command = &cobra.Command{
 		Use:     "run-action RESOURCE_YAML_PATH ACTION",
 		Aliases: []string{"action"},
 		Short:   "Executes resource action",
 		Long:    "Executes resource action using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
 		Example: `
-argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml`,
+argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml
+
+# Run an action which declares parameters
+argocd admin settings resource-overrides action run /tmp/deploy.yaml scale --param replicas=3 --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) < 2 {
 				c.HelpFunc()(c, args)
@@ -529,7 +549,20 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua)
+				var declaredParams []v1alpha1.ResourceActionParam
+				discoveryScript, err := luaVM.GetResourceActionDiscovery(&res)
+				errors.CheckError(err)
+				if discoveryScript != "" {
+					availableActions, err := luaVM.ExecuteResourceActionDiscovery(&res, discoveryScript)
+					errors.CheckError(err)
+					declaredParams = lua.FindResourceActionParams(availableActions, action.Name)
+				}
+				paramValues, err := lua.SplitResourceActionParams(params)
+				errors.CheckError(err)
+				actionParams, err := lua.ParseResourceActionParams(declaredParams, paramValues)
+				errors.CheckError(err)
+
+				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, actionParams)
 				errors.CheckError(err)
 
 				if reflect.DeepEqual(&res, modifiedRes) {
@@ -542,5 +575,6 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 			})
 		},
 	}
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Set a parameter of the action (e.g. --param replicas=3)")
 	return command
 }
@@ -47,6 +47,7 @@ var validRBACResources map[string]bool = map[string]bool{
 	rbacpolicy.ResourceApplications: true,
 	rbacpolicy.ResourceCertificates: true,
 	rbacpolicy.ResourceClusters:     true,
+	rbacpolicy.ResourceExtensions:   true,
 	rbacpolicy.ResourceGPGKeys:      true,
 	rbacpolicy.ResourceProjects:     true,
 	rbacpolicy.ResourceRepositories: true,
@@ -57,7 +58,10 @@ var validRBACActions map[string]bool = map[string]bool{
 	rbacpolicy.ActionAction:   true,
 	rbacpolicy.ActionCreate:   true,
 	rbacpolicy.ActionDelete:   true,
+	rbacpolicy.ActionExec:     true,
 	rbacpolicy.ActionGet:      true,
+	rbacpolicy.ActionInvoke:   true,
+	rbacpolicy.ActionLogs:     true,
 	rbacpolicy.ActionOverride: true,
 	rbacpolicy.ActionSync:     true,
 	rbacpolicy.ActionUpdate:   true,
@@ -364,7 +368,7 @@ func resolveRBACResourceName(name string) string {
 // isValidRBACAction checks whether a given action is a valid RBAC action
 func isValidRBACAction(action string) bool {
 	_, ok := validRBACActions[action]
-	return ok
+	return ok || rbacpolicy.IsValidAction(action)
 }
 
 // isValidRBACResource checks whether a given resource is a valid RBAC resource
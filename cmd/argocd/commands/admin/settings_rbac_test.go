@@ -20,6 +20,10 @@ func Test_isValidRBACAction(t *testing.T) {
 			assert.True(t, ok)
 		})
 	}
+	t.Run("resource action", func(t *testing.T) {
+		ok := isValidRBACAction("delete//Pod/*/*")
+		assert.True(t, ok)
+	})
 	t.Run("invalid", func(t *testing.T) {
 		ok := isValidRBACAction("invalid")
 		assert.False(t, ok)
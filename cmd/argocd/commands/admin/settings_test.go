@@ -136,6 +136,31 @@ clientSecret: aaaabbbbccccddddeee`,
 			},
 			containsSummary: "OIDC is configured",
 		},
+		"General_OIDCProvidersConfigured": {
+			validator: "general",
+			data: map[string]string{
+				"url": "https://myargocd.com",
+				"oidc.providers": `
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+  clientID: aaaabbbbccccddddeee
+- name: azure
+  issuer: https://login.microsoftonline.com/tenant/v2.0
+  clientID: aaaabbbbccccddddeee`,
+			},
+			containsSummary: "OIDC is configured with the additional providers okta, azure",
+		},
+		"General_OIDCProvidersInvalidConfig": {
+			validator: "general",
+			data: map[string]string{
+				"oidc.providers": `
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+- name: okta
+  issuer: https://login.microsoftonline.com/tenant/v2.0`,
+			},
+			containsError: "invalid oidc.providers",
+		},
 		"General_DexConfiguredMissingURL": {
 			validator: "general",
 			data: map[string]string{
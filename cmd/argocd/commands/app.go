@@ -16,6 +16,7 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
@@ -25,6 +26,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,7 +39,6 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
 	clusterpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
-	projectpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
 	settingspkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/settings"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -48,7 +49,6 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	argoio "github.com/argoproj/argo-cd/v2/util/io"
-	argokube "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/templates"
 	"github.com/argoproj/argo-cd/v2/util/text/label"
 )
@@ -82,6 +82,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationSetCommand(clientOpts))
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncPreviewCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
@@ -96,6 +97,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationLinksCommand(clientOpts))
 	return command
 }
 
@@ -218,13 +220,11 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName, Refresh: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
 
-			pConn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
-			defer argoio.Close(pConn)
-			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: app.Spec.Project})
+			// the sync windows are matched by the API server, which knows the labels of the destination cluster and
+			// namespace selected by the windows
+			windows, err := appIf.GetApplicationSyncWindows(context.Background(), &applicationpkg.ApplicationSyncWindowsQuery{Name: &app.Name})
 			errors.CheckError(err)
 
-			windows := proj.Spec.SyncWindows.Matches(app)
-
 			switch output {
 			case "yaml", "json":
 				err := PrintResource(app, output)
@@ -269,25 +269,46 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 // NewApplicationLogsCommand returns logs of application pods
 func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		group        string
-		kind         string
-		namespace    string
-		resourceName string
-		follow       bool
-		tail         int64
-		sinceSeconds int64
-		untilTime    string
-		filter       string
-		container    string
+		group         string
+		kind          string
+		namespace     string
+		resourceName  string
+		follow        bool
+		tail          int64
+		sinceSeconds  int64
+		untilTime     string
+		filter        string
+		filterRegex   bool
+		container     string
+		allContainers bool
+		previous      bool
+		sinceTime     string
 	)
 	var command = &cobra.Command{
 		Use:   "logs APPNAME",
 		Short: "Get logs of application pods",
+		Example: `  # Get the logs of all the containers of the pods of a deployment, prefixed with their pod and container names
+  argocd app logs my-app --kind Deployment --name my-deployment --all-containers
+
+  # Get the logs of the previous instance of a crashed container
+  argocd app logs my-app --name my-pod --container main --previous
+
+  # Get the error logs of a time range
+  argocd app logs my-app --since-time 2021-09-01T10:00:00Z --until-time 2021-09-01T11:00:00Z --filter 'level=(error|fatal)' --filter-regex`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			var since *metav1.Time
+			if sinceTime != "" {
+				if sinceSeconds > 0 {
+					log.Fatal("--since-seconds and --since-time cannot be used together")
+				}
+				t, err := time.Parse(time.RFC3339, sinceTime)
+				errors.CheckError(err)
+				since = &metav1.Time{Time: t}
+			}
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer argoio.Close(conn)
@@ -297,17 +318,21 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			for retry {
 				retry = false
 				stream, err := appIf.PodLogs(context.Background(), &applicationpkg.ApplicationPodLogsQuery{
-					Name:         &appName,
-					Group:        &group,
-					Namespace:    namespace,
-					Kind:         &kind,
-					ResourceName: &resourceName,
-					Follow:       follow,
-					TailLines:    tail,
-					SinceSeconds: sinceSeconds,
-					UntilTime:    &untilTime,
-					Filter:       &filter,
-					Container:    container,
+					Name:          &appName,
+					Group:         &group,
+					Namespace:     namespace,
+					Kind:          &kind,
+					ResourceName:  &resourceName,
+					Follow:        follow,
+					TailLines:     tail,
+					SinceSeconds:  sinceSeconds,
+					SinceTime:     since,
+					UntilTime:     &untilTime,
+					Filter:        &filter,
+					FilterRegex:   filterRegex,
+					Container:     container,
+					AllContainers: allContainers,
+					Previous:      previous,
 				})
 				if err != nil {
 					log.Fatalf("failed to get pod logs: %v", err)
@@ -325,12 +350,17 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 						if st.Code() == codes.Unavailable && follow {
 							retry = true
 							sinceSeconds = 1
+							since = nil
 							break
 						}
 						log.Fatalf("stream read failed: %v", err)
 					}
 					if !msg.Last {
-						fmt.Println(msg.Content)
+						if allContainers {
+							fmt.Printf("[%s/%s] %s\n", msg.PodName, msg.ContainerName, msg.Content)
+						} else {
+							fmt.Println(msg.Content)
+						}
 					} else {
 						return
 					}
@@ -346,14 +376,18 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().BoolVar(&follow, "follow", false, "Specify if the logs should be streamed")
 	command.Flags().Int64Var(&tail, "tail", 0, "The number of lines from the end of the logs to show")
 	command.Flags().Int64Var(&sinceSeconds, "since-seconds", 0, "A relative time in seconds before the current time from which to show logs")
+	command.Flags().StringVar(&sinceTime, "since-time", "", "Show logs since this time (RFC3339)")
 	command.Flags().StringVar(&untilTime, "until-time", "", "Show logs until this time")
-	command.Flags().StringVar(&filter, "filter", "", "Show logs contain this string")
+	command.Flags().StringVar(&filter, "filter", "", "Show logs contain this string, or not containing it if prefixed with '!'")
+	command.Flags().BoolVar(&filterRegex, "filter-regex", false, "Interpret the filter as a regular expression")
 	command.Flags().StringVar(&container, "container", "", "Optional container name")
+	command.Flags().BoolVar(&allContainers, "all-containers", false, "Show the logs of all the containers of the pods, prefixed with the pod and container names")
+	command.Flags().BoolVarP(&previous, "previous", "p", false, "Show the logs of the previous terminated instance of the containers")
 
 	return command
 }
 
-func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *argoappv1.SyncWindows) {
+func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *applicationpkg.ApplicationSyncWindowsResponse) {
 	fmt.Printf(printOpFmtStr, "Name:", app.Name)
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
 	fmt.Printf(printOpFmtStr, "Server:", app.Spec.Destination.Server)
@@ -366,24 +400,24 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	var wds []string
 	var status string
 	var allow, deny, inactiveAllows bool
-	if windows.HasWindows() {
-		active := windows.Active()
-		if active.HasWindows() {
-			for _, w := range *active {
-				if w.Kind == "deny" {
-					deny = true
-				} else {
-					allow = true
-				}
+	if windows != nil && len(windows.AssignedWindows) > 0 {
+		active := map[string]bool{}
+		for _, w := range windows.ActiveWindows {
+			active[syncWindowString(w)] = true
+			if w.GetKind() == "deny" {
+				deny = true
+			} else {
+				allow = true
 			}
 		}
-		if windows.InactiveAllows().HasWindows() {
-			inactiveAllows = true
+		for _, w := range windows.AssignedWindows {
+			if w.GetKind() == "allow" && !active[syncWindowString(w)] {
+				inactiveAllows = true
+			}
 		}
 
-		s := windows.CanSync(true)
 		if deny || !deny && !allow && inactiveAllows {
-			if s {
+			if windows.GetCanSync() {
 				status = "Manual Allowed"
 			} else {
 				status = "Sync Denied"
@@ -392,9 +426,8 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		} else {
 			status = "Sync Allowed"
 		}
-		for _, w := range *windows {
-			s := w.Kind + ":" + w.Schedule + ":" + w.Duration
-			wds = append(wds, s)
+		for _, w := range windows.AssignedWindows {
+			wds = append(wds, syncWindowString(w))
 		}
 	} else {
 		status = "Sync Allowed"
@@ -432,6 +465,10 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	fmt.Printf(printOpFmtStr, "Health Status:", healthStr)
 }
 
+func syncWindowString(w *applicationpkg.ApplicationSyncWindow) string {
+	return w.GetKind() + ":" + w.GetSchedule() + ":" + w.GetDuration()
+}
+
 func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
 	if appSrc.Ksonnet != nil && appSrc.Ksonnet.Environment != "" {
 		fmt.Printf(printOpFmtStr, "Environment:", appSrc.Ksonnet.Environment)
@@ -732,9 +769,9 @@ func liveObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructu
 	return objs, nil
 }
 
-func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
+func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
 	configManagementPlugins []*argoappv1.ConfigManagementPlugin) []*unstructured.Unstructured {
-	manifestStrings := getLocalObjectsString(app, local, localRepoRoot, appLabelKey, kubeVersion, kustomizeOptions, configManagementPlugins)
+	manifestStrings := getLocalObjectsString(app, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion, kustomizeOptions, configManagementPlugins)
 	objs := make([]*unstructured.Unstructured, len(manifestStrings))
 	for i := range manifestStrings {
 		obj := unstructured.Unstructured{}
@@ -745,7 +782,7 @@ func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelK
 	return objs
 }
 
-func getLocalObjectsString(app *argoappv1.Application, local, localRepoRoot, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
+func getLocalObjectsString(app *argoappv1.Application, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
 	configManagementPlugins []*argoappv1.ConfigManagementPlugin) []string {
 
 	res, err := repository.GenerateManifests(local, localRepoRoot, app.Spec.Source.TargetRevision, &repoapiclient.ManifestRequest{
@@ -757,6 +794,7 @@ func getLocalObjectsString(app *argoappv1.Application, local, localRepoRoot, app
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       kubeVersion,
 		Plugins:           configManagementPlugins,
+		TrackingMethod:    trackingMethod,
 	}, true)
 	errors.CheckError(err)
 
@@ -842,8 +880,8 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				defer argoio.Close(conn)
 				cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
 				errors.CheckError(err)
-				localObjs := groupObjsByKey(getLocalObjects(app, local, localRepoRoot, argoSettings.AppLabelKey, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins), liveObjs, app.Spec.Destination.Namespace)
-				items = groupObjsForDiff(resources, localObjs, items, argoSettings, appName)
+				localObjs := groupObjsByKey(getLocalObjects(app, local, localRepoRoot, argoSettings.AppLabelKey, argoSettings.TrackingMethod, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins), liveObjs, app.Spec.Destination.Namespace)
+				items = groupObjsForDiff(resources, localObjs, items, argoSettings, app)
 			} else if revision != "" {
 				var unstructureds []*unstructured.Unstructured
 				q := applicationpkg.ApplicationManifestQuery{
@@ -858,7 +896,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					unstructureds = append(unstructureds, obj)
 				}
 				groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
-				items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, appName)
+				items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, app)
 			} else {
 				for i := range resources.Items {
 					res := resources.Items[i]
@@ -923,7 +961,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
-func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName string) []objKeyLiveTarget {
+func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, app *argoappv1.Application) []objKeyLiveTarget {
 	for _, res := range resources.Items {
 		var live = &unstructured.Unstructured{}
 		err := json.Unmarshal([]byte(res.NormalizedLiveState), &live)
@@ -937,7 +975,7 @@ func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[
 		}
 		if local, ok := objs[key]; ok || live != nil {
 			if local != nil && !kube.IsCRD(local) {
-				err = argokube.SetAppInstanceLabel(local, argoSettings.AppLabelKey, appName)
+				err = argo.SetAppInstance(local, argoSettings.AppLabelKey, app.Name, app.Spec.Destination.Namespace, argo.ParseTrackingMethod(argoSettings.TrackingMethod))
 				errors.CheckError(err)
 			}
 
@@ -1070,10 +1108,13 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output   string
-		selector string
-		projects []string
-		repo     string
+		output        string
+		selector      string
+		fieldSelector string
+		sortBy        string
+		chunkSize     int64
+		projects      []string
+		repo          string
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -1082,13 +1123,32 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app list
 
   # List apps by label, in this example we listing apps that are children of another app (aka app-of-apps)
-  argocd app list -l app.kubernetes.io/instance=my-app`,
+  argocd app list -l app.kubernetes.io/instance=my-app
+
+  # List the out of sync apps sorted by health status
+  argocd app list --field-selector status.sync.status=OutOfSync --sort-by health`,
 		Run: func(c *cobra.Command, args []string) {
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer argoio.Close(conn)
-			apps, err := appIf.List(context.Background(), &applicationpkg.ApplicationQuery{Selector: selector})
-			errors.CheckError(err)
-			appList := apps.Items
+			query := applicationpkg.ApplicationQuery{
+				Selector:      selector,
+				FieldSelector: fieldSelector,
+				SortBy:        sortBy,
+				Projects:      projects,
+				Repo:          repo,
+				Limit:         chunkSize,
+			}
+			var appList []argoappv1.Application
+			for {
+				apps, err := appIf.List(context.Background(), &query)
+				errors.CheckError(err)
+				appList = append(appList, apps.Items...)
+				if apps.Continue == "" {
+					break
+				}
+				query.Continue = apps.Continue
+			}
+			// filter the apps client side as well in case the server does not support the filters
 			if len(projects) != 0 {
 				appList = argo.FilterByProjects(appList, projects)
 			}
@@ -1110,6 +1170,9 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|name|json|yaml")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "List apps by label")
+	command.Flags().StringVar(&fieldSelector, "field-selector", "", "List apps by field, e.g. status.sync.status=OutOfSync,spec.destination.namespace=default")
+	command.Flags().StringVar(&sortBy, "sort-by", "", "Sort apps by name, sync or health. Prefix with '-' for descending order")
+	command.Flags().Int64Var(&chunkSize, "chunk-size", 500, "Return the apps in chunks rather than all at once. Pass 0 to disable")
 	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Filter by project name")
 	command.Flags().StringVarP(&repo, "repo", "r", "", "List apps by source repo URL")
 	return command
@@ -1377,7 +1440,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
 					errors.CheckError(err)
 					argoio.Close(conn)
-					localObjsStrings = getLocalObjectsString(app, local, localRepoRoot, argoSettings.AppLabelKey, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins)
+					localObjsStrings = getLocalObjectsString(app, local, localRepoRoot, argoSettings.AppLabelKey, argoSettings.TrackingMethod, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins)
 				}
 
 				syncReq := applicationpkg.ApplicationSyncRequest{
@@ -2071,6 +2134,174 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewApplicationLinksCommand returns a new instance of an `argocd app links` command
+func NewApplicationLinksCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		group        string
+		kind         string
+		namespace    string
+		resourceName string
+	)
+	var command = &cobra.Command{
+		Use:   "links APPNAME",
+		Short: "List the deep links of an application or of one of its resources",
+		Example: `  # List the links of an application
+  argocd app links my-app
+
+  # List the links of a resource of an application
+  argocd app links my-app --kind Deployment --resource-name my-deployment`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			ctx := context.Background()
+			var links *applicationpkg.LinksResponse
+			var err error
+			if kind == "" && resourceName == "" {
+				links, err = appIf.ListLinks(ctx, &applicationpkg.ListAppLinksRequest{Name: &appName})
+				errors.CheckError(err)
+			} else {
+				if kind == "" || resourceName == "" {
+					log.Fatal("Both --kind and --resource-name must be set to list the links of a resource")
+				}
+				tree, err := appIf.ResourceTree(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+				errors.CheckError(err)
+				var found []argoappv1.ResourceNode
+				for _, node := range tree.Nodes {
+					if node.Kind == kind && node.Name == resourceName &&
+						(!c.Flag("group").Changed || node.Group == group) &&
+						(!c.Flag("namespace").Changed || node.Namespace == namespace) {
+						found = append(found, node)
+					}
+				}
+				switch len(found) {
+				case 0:
+					log.Fatalf("%s %s not found as part of application %s", kind, resourceName, appName)
+				case 1:
+				default:
+					log.Fatalf("%d resources match %s %s, use --group and --namespace to select one", len(found), kind, resourceName)
+				}
+				res := found[0]
+				links, err = appIf.ListResourceLinks(ctx, &applicationpkg.ApplicationResourceRequest{
+					Name:         &appName,
+					Namespace:    res.Namespace,
+					ResourceName: res.Name,
+					Version:      res.Version,
+					Group:        res.Group,
+					Kind:         res.Kind,
+				})
+				errors.CheckError(err)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "TITLE\tURL\tDESCRIPTION\n")
+			for _, link := range links.Items {
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", link.GetTitle(), link.GetUrl(), link.GetDescription())
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().StringVar(&group, "group", "", "Group of the resource")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace of the resource")
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of the resource")
+	return command
+}
+
+// NewApplicationSyncPreviewCommand returns a new instance of an `argocd app sync-preview` command
+func NewApplicationSyncPreviewCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		revision    string
+		resources   []string
+		prune       bool
+		strategy    string
+		force       bool
+		syncOptions []string
+		output      string
+	)
+	var command = &cobra.Command{
+		Use:   "sync-preview APPNAME",
+		Short: "Preview the actions a sync of an application would perform",
+		Long:  "Performs a server-side dry-run of the sync of an application, including its hooks and sync waves, and lists the actions it would perform on each resource in the order of the sync. Exits with a non-zero status if the sync would fail or is currently blocked by a sync window.",
+		Example: `  # Preview the sync of an app
+  argocd app sync-preview my-app
+
+  # Preview the sync of an app to a specific revision, with pruning
+  argocd app sync-preview my-app --revision v1.2.0 --prune`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+
+			syncReq := applicationpkg.ApplicationSyncRequest{
+				Name:      &appName,
+				DryRun:    true,
+				Revision:  revision,
+				Resources: parseSelectedResources(resources),
+				Prune:     prune,
+			}
+			switch strategy {
+			case "apply":
+				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{Force: force}}
+			case "", "hook":
+				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{SyncStrategyApply: argoappv1.SyncStrategyApply{Force: force}}}
+			default:
+				log.Fatalf("Unknown sync strategy: '%s'", strategy)
+			}
+			if c.Flags().Changed("sync-option") {
+				syncReq.SyncOptions = &applicationpkg.SyncOptions{Items: syncOptions}
+			}
+			preview, err := appIf.SyncPreview(context.Background(), &syncReq)
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(preview, output)
+				errors.CheckError(err)
+			case "wide", "":
+				fmt.Printf(printOpFmtStr, "Revision:", preview.Revision)
+				fmt.Printf(printOpFmtStr, "Phase:", preview.Phase)
+				fmt.Printf(printOpFmtStr, "Message:", preview.Message)
+				if preview.BlockedBySyncWindows {
+					var wds []string
+					for _, w := range preview.ActiveSyncWindows {
+						wds = append(wds, syncWindowString(w))
+					}
+					fmt.Printf(printOpFmtStr, "SyncWindow:", "Sync Denied")
+					fmt.Printf(printOpFmtStr, "Active Windows:", strings.Join(wds, ","))
+				}
+				fmt.Println()
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "PHASE\tWAVE\tGROUP\tKIND\tNAMESPACE\tNAME\tACTION\tHOOK\tSTATUS\tMESSAGE\n")
+				for _, res := range preview.Resources {
+					_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.SyncPhase, res.SyncWave, res.Group, res.Kind, res.Namespace, res.Name, res.Action, res.HookType, res.Status, res.Message)
+				}
+				_ = w.Flush()
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+			if !synccommon.OperationPhase(preview.Phase).Successful() || preview.BlockedBySyncWindows {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(&revision, "revision", "", "Preview the sync to a specific revision")
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Preview the sync of specific resources only as GROUP%sKIND%sNAME. Fields may be blank. This option may be specified repeatedly", resourceFieldDelimiter, resourceFieldDelimiter))
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
+	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().StringArrayVar(&syncOptions, "sync-option", []string{}, "Sync options overriding the ones of the application, e.g. PruneLast=true. This option may be specified repeatedly")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
 func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var patch string
 	var patchType string
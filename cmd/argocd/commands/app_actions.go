@@ -122,9 +122,15 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var kind string
 	var group string
 	var all bool
+	var params []string
 	var command = &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s)",
+		Example: `  # Restart a deployment
+  argocd app actions run my-app restart --kind Deployment --resource-name my-deployment
+
+  # Scale a deployment to 3 replicas
+  argocd app actions run my-app scale --kind Deployment --resource-name my-deployment --param replicas=3`,
 	}
 
 	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
@@ -133,6 +139,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group")
 	errors.CheckError(command.MarkFlagRequired("kind"))
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Set a parameter of the action (e.g. --param replicas=3)")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		if len(args) != 2 {
@@ -166,6 +173,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 				Group:        gvk.Group,
 				Kind:         gvk.Kind,
 				Action:       actionName,
+				Parameters:   params,
 			})
 			errors.CheckError(err)
 		}
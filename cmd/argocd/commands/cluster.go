@@ -9,6 +9,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/mattn/go-isatty"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v2/util/cli"
@@ -146,6 +147,9 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			if clusterOpts.Shard >= 0 {
 				clst.Shard = &clusterOpts.Shard
 			}
+			if clusterOpts.ResyncInterval > 0 {
+				clst.ResyncInterval = &metav1.Duration{Duration: clusterOpts.ResyncInterval}
+			}
 			if clusterOpts.Project != "" {
 				clst.Project = clusterOpts.Project
 			}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -38,15 +39,35 @@ func NewGPGCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 // NewGPGListCommand lists all configured public keys from the server
 func NewGPGListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output        string
+		keyring       bool
+		expiresWithin time.Duration
 	)
 	var command = &cobra.Command{
 		Use:   "list",
 		Short: "List configured GPG public keys",
+		Example: `# List the configured GPG public keys
+argocd gpg list
+
+# List the keys of the keyring of the repository server which expire within 30 days, or which are expired
+argocd gpg list --keyring --expires-within 720h`,
 		Run: func(c *cobra.Command, args []string) {
+			if expiresWithin != 0 && !keyring {
+				errors.CheckError(fmt.Errorf("--expires-within requires --keyring"))
+			}
 			conn, gpgIf := argocdclient.NewClientOrDie(clientOpts).NewGPGKeyClientOrDie()
 			defer argoio.Close(conn)
-			keys, err := gpgIf.List(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{})
+			var keys *appsv1.GnuPGPublicKeyList
+			var err error
+			if keyring {
+				query := &gpgkeypkg.GnuPGKeyringQuery{}
+				if expiresWithin != 0 {
+					query.ExpiresWithin = expiresWithin.String()
+				}
+				keys, err = gpgIf.ListKeyring(context.Background(), query)
+			} else {
+				keys, err = gpgIf.List(context.Background(), &gpgkeypkg.GnuPGPublicKeyQuery{})
+			}
 			errors.CheckError(err)
 			switch output {
 			case "yaml", "json":
@@ -60,6 +81,8 @@ func NewGPGListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&keyring, "keyring", false, "List the keys of the keyring the repository server verifies the signatures with, instead of the configured keys")
+	command.Flags().DurationVar(&expiresWithin, "expires-within", 0, "Only list the keys of the keyring which expire within this duration, or which are expired")
 	return command
 }
 
@@ -88,6 +111,9 @@ func NewGPGGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				fmt.Printf("Key fingerprint: %s\n", key.Fingerprint)
 				fmt.Printf("Key subtype:     %s\n", strings.ToUpper(key.SubType))
 				fmt.Printf("Key owner:       %s\n", key.Owner)
+				if key.ExpiresAt != nil {
+					fmt.Printf("Key expires:     %s\n", key.ExpiresAt.UTC().Format("2006-01-02"))
+				}
 				fmt.Printf("Key data follows until EOF:\n%s\n", key.KeyData)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
@@ -153,10 +179,14 @@ func NewGPGDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 // Print table of certificate info
 func printKeyTable(keys []appsv1.GnuPGPublicKey) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(w, "KEYID\tTYPE\tIDENTITY\n")
+	fmt.Fprintf(w, "KEYID\tTYPE\tIDENTITY\tEXPIRES\n")
 
 	for _, k := range keys {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", k.KeyID, strings.ToUpper(k.SubType), k.Owner)
+		expires := "never"
+		if k.ExpiresAt != nil {
+			expires = k.ExpiresAt.UTC().Format("2006-01-02")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", k.KeyID, strings.ToUpper(k.SubType), k.Owner, expires)
 	}
 	_ = w.Flush()
 }
@@ -87,8 +87,8 @@ func (c *forwardRepoClientset) NewRepoServerClient() (io.Closer, repoapiclient.R
 			c.err = err
 			return
 		}
-		c.repoClientset = apiclient.NewRepoServerClientset(fmt.Sprintf("localhost:%d", repoServerPort), 60, apiclient.TLSConfiguration{
-			DisableTLS: false, StrictValidation: false})
+		c.repoClientset = apiclient.NewRepoServerClientsetWithPriority(fmt.Sprintf("localhost:%d", repoServerPort), 60, apiclient.TLSConfiguration{
+			DisableTLS: false, StrictValidation: false}, apiclient.RequestPriorityInteractive)
 	})
 	if c.err != nil {
 		return nil, nil, c.err
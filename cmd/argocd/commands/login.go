@@ -2,8 +2,6 @@ package commands
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/base64"
 	"fmt"
 	"html"
 	"net/http"
@@ -35,11 +33,12 @@ import (
 // NewLoginCommand returns a new instance of `argocd login` command
 func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		ctxName  string
-		username string
-		password string
-		sso      bool
-		ssoPort  int
+		ctxName     string
+		username    string
+		password    string
+		sso         bool
+		ssoPort     int
+		ssoProvider string
 	)
 	var command = &cobra.Command{
 		Use:   "login SERVER",
@@ -51,6 +50,9 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD using one of the additional OIDC providers
+argocd login cd.argoproj.io --sso --sso-provider okta
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -121,6 +123,13 @@ argocd login cd.argoproj.io --core`,
 					ctx = oidc.ClientContext(ctx, httpClient)
 					acdSet, err := setIf.Get(ctx, &settingspkg.SettingsQuery{})
 					errors.CheckError(err)
+					if ssoProvider != "" {
+						providerSet := argocdclient.SettingsWithOIDCProvider(acdSet, func(provider *settingspkg.OIDCConfig) bool { return provider.Name == ssoProvider })
+						if providerSet == nil {
+							log.Fatalf("OIDC provider '%s' is not configured", ssoProvider)
+						}
+						acdSet = providerSet
+					}
 					oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 					errors.CheckError(err)
 					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
@@ -172,6 +181,7 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
 	command.Flags().BoolVar(&sso, "sso", false, "perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
+	command.Flags().StringVar(&ssoProvider, "sso-provider", "", "name of the additional OIDC provider to perform the SSO login with, instead of the one of oidc.config or Dex")
 	return command
 }
 
@@ -210,9 +220,7 @@ func oauth2Login(ctx context.Context, port int, oidcSettings *settingspkg.OIDCCo
 	}
 
 	// PKCE implementation of https://tools.ietf.org/html/rfc7636
-	codeVerifier := rand.RandStringCharset(43, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~")
-	codeChallengeHash := sha256.Sum256([]byte(codeVerifier))
-	codeChallenge := base64.RawURLEncoding.EncodeToString(codeChallengeHash[:])
+	codeVerifier := oidcutil.PKCECodeVerifier()
 
 	// Authorization redirect callback from OAuth2 auth flow.
 	// Handles both implicit and authorization code flow
@@ -290,8 +298,7 @@ func oauth2Login(ctx context.Context, port int, oidcSettings *settingspkg.OIDCCo
 
 	switch grantType {
 	case oidcutil.GrantTypeAuthorizationCode:
-		opts = append(opts, oauth2.SetAuthURLParam("code_challenge", codeChallenge))
-		opts = append(opts, oauth2.SetAuthURLParam("code_challenge_method", "S256"))
+		opts = append(opts, oidcutil.PKCEAuthCodeOptions(codeVerifier)...)
 		url = oauth2conf.AuthCodeURL(stateNonce, opts...)
 	case oidcutil.GrantTypeImplicit:
 		url = oidcutil.ImplicitFlowURL(oauth2conf, stateNonce, opts...)
@@ -39,6 +39,7 @@ func NewProjectRoleCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	roleCommand.AddCommand(NewProjectRoleCreateCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleCreateTokenCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectRoleRotateTokenCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleListTokensCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleDeleteTokenCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectRoleAddPolicyCommand(clientOpts))
@@ -239,40 +240,96 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 				Id:        tokenID,
 			})
 			errors.CheckError(err)
+			printProjectToken("Create token", tokenResponse.Token, outputTokenOnly)
+		},
+	}
+	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
+		"Duration before the token will expire, e.g. \"12h\", \"7d\". (Default: No expiration)",
+	)
+	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
+	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
 
-			token, err := jwtgo.Parse(tokenResponse.Token, nil)
-			if token == nil {
-				err = fmt.Errorf("received malformed token %v", err)
-				errors.CheckError(err)
-				return
-			}
+	return command
+}
+
+// NewProjectRoleRotateTokenCommand returns a new instance of an `argocd proj role rotate-token` command
+func NewProjectRoleRotateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		expiresIn       string
+		gracePeriod     string
+		outputTokenOnly bool
+	)
+	var command = &cobra.Command{
+		Use:   "rotate-token PROJECT ROLE-NAME ID",
+		Short: "Replace a project token with a new one, keeping the previous token valid during a grace period",
+		Example: `  # Create a new token expiring in 30 days, the previous token remains valid for 1 hour
+  argocd proj role rotate-token my-project ci-role 0b5a3a4e-7f1b-4b3b-9bd4-2a9df8c0e4b1 --expires-in 30d --grace-period 1h
 
-			claims := token.Claims.(jwtgo.MapClaims)
-			issuedAt, _ := jwt.IssuedAt(claims)
-			expiresAt := int64(jwt.Float64Field(claims, "exp"))
-			id := jwt.StringField(claims, "jti")
-			subject := jwt.StringField(claims, "sub")
-
-			if !outputTokenOnly {
-				fmt.Printf("Create token succeeded for %s.\n", subject)
-				fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
-					id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
-				)
-				fmt.Println("  Token: " + tokenResponse.Token)
-			} else {
-				fmt.Println(tokenResponse.Token)
+  # Create a new token and revoke the previous token immediately
+  argocd proj role rotate-token my-project ci-role 0b5a3a4e-7f1b-4b3b-9bd4-2a9df8c0e4b1 --grace-period 0s`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 3 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			roleName := args[1]
+			tokenID := args[2]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer io.Close(conn)
+			if expiresIn == "" {
+				expiresIn = "0s"
 			}
+			expiresInDuration, err := timeutil.ParseDuration(expiresIn)
+			errors.CheckError(err)
+			gracePeriodDuration, err := timeutil.ParseDuration(gracePeriod)
+			errors.CheckError(err)
+			tokenResponse, err := projIf.RotateToken(context.Background(), &projectpkg.ProjectTokenRotateRequest{
+				Project:     projName,
+				Role:        roleName,
+				Id:          tokenID,
+				ExpiresIn:   int64(expiresInDuration.Seconds()),
+				GracePeriod: int64(gracePeriodDuration.Seconds()),
+			})
+			errors.CheckError(err)
+			printProjectToken("Rotate token", tokenResponse.Token, outputTokenOnly)
 		},
 	}
 	command.Flags().StringVarP(&expiresIn, "expires-in", "e", "",
-		"Duration before the token will expire, e.g. \"12h\", \"7d\". (Default: No expiration)",
+		"Duration before the new token will expire, e.g. \"12h\", \"7d\". (Default: The duration of the rotated token, which is required if the rotated token does not expire)",
 	)
-	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
+	command.Flags().StringVarP(&gracePeriod, "grace-period", "g", "1h", "Duration during which the previous token remains valid. The previous token is revoked immediately if zero.")
 	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
 
 	return command
 }
 
+// printProjectToken prints the given project token, with its claims unless only the token is requested
+func printProjectToken(operation string, tokenString string, outputTokenOnly bool) {
+	token, err := jwtgo.Parse(tokenString, nil)
+	if token == nil {
+		err = fmt.Errorf("received malformed token %v", err)
+		errors.CheckError(err)
+		return
+	}
+
+	claims := token.Claims.(jwtgo.MapClaims)
+	issuedAt, _ := jwt.IssuedAt(claims)
+	expiresAt := int64(jwt.Float64Field(claims, "exp"))
+	id := jwt.StringField(claims, "jti")
+	subject := jwt.StringField(claims, "sub")
+
+	if !outputTokenOnly {
+		fmt.Printf("%s succeeded for %s.\n", operation, subject)
+		fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
+			id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
+		)
+		fmt.Println("  Token: " + tokenString)
+	} else {
+		fmt.Println(tokenString)
+	}
+}
+
 func NewProjectRoleListTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		useUnixTime bool
@@ -9,6 +9,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	projectpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
@@ -109,13 +110,16 @@ func NewProjectWindowsEnableManualSyncCommand(clientOpts *argocdclient.ClientOpt
 // NewProjectWindowsAddWindowCommand returns a new instance of an `argocd proj windows add` command
 func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		kind         string
-		schedule     string
-		duration     string
-		applications []string
-		namespaces   []string
-		clusters     []string
-		manualSync   bool
+		kind              string
+		schedule          string
+		duration          string
+		applications      []string
+		namespaces        []string
+		clusters          []string
+		manualSync        bool
+		timeZone          string
+		clusterSelector   string
+		namespaceSelector string
 	)
 	var command = &cobra.Command{
 		Use:   "add PROJECT",
@@ -132,7 +136,12 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync)
+			clSelector, err := parseWindowSelector(clusterSelector)
+			errors.CheckError(err)
+			nsSelector, err := parseWindowSelector(namespaceSelector)
+			errors.CheckError(err)
+
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, clSelector, nsSelector)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -146,6 +155,9 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "IANA time zone of the sync window schedule, UTC by default. (e.g. --time-zone Europe/Paris)")
+	command.Flags().StringVar(&clusterSelector, "cluster-selector", "", "Label selector of the cluster secrets of the clusters that the schedule will be applied to. (e.g. --cluster-selector env=prod)")
+	command.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Label selector of the namespaces that the schedule will be applied to. (e.g. --namespace-selector team=payments)")
 
 	return command
 }
@@ -189,6 +201,7 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 		applications []string
 		namespaces   []string
 		clusters     []string
+		timeZone     string
 	)
 	var command = &cobra.Command{
 		Use:   "update PROJECT ID",
@@ -212,7 +225,7 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 
 			for i, window := range proj.Spec.SyncWindows {
 				if id == i {
-					err := window.Update(schedule, duration, applications, namespaces, clusters)
+					err := window.Update(schedule, duration, applications, namespaces, clusters, timeZone)
 					if err != nil {
 						errors.CheckError(err)
 					}
@@ -228,9 +241,18 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "IANA time zone of the sync window schedule. (e.g. --time-zone Europe/Paris)")
 	return command
 }
 
+// parseWindowSelector parses the label selector of a sync window, returning nil if none is given
+func parseWindowSelector(selector string) (*metav1.LabelSelector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	return metav1.ParseToLabelSelector(selector)
+}
+
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -269,8 +291,8 @@ func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []interface{}{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC"}
-	fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+	headers := []interface{}{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "TIMEZONE", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC"}
+	fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
@@ -280,9 +302,10 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 				window.Kind,
 				window.Schedule,
 				window.Duration,
+				formatTimeZoneOutput(window.TimeZone),
 				formatListOutput(window.Applications),
-				formatListOutput(window.Namespaces),
-				formatListOutput(window.Clusters),
+				formatSelectorOutput(window.Namespaces, window.NamespaceSelector),
+				formatSelectorOutput(window.Clusters, window.ClusterSelector),
 				formatManualOutput(window.ManualSync),
 			}
 			fmt.Fprintf(w, fmtStr, vals...)
@@ -300,6 +323,22 @@ func formatListOutput(list []string) string {
 	}
 	return o
 }
+func formatSelectorOutput(list []string, selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return formatListOutput(list)
+	}
+	o := metav1.FormatLabelSelector(selector)
+	if len(list) > 0 {
+		o = strings.Join(list, ",") + "," + o
+	}
+	return o
+}
+func formatTimeZoneOutput(timeZone string) string {
+	if timeZone == "" {
+		return "UTC"
+	}
+	return timeZone
+}
 func formatBoolOutput(active bool) string {
 	var o string
 	if active {
@@ -67,6 +67,9 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 				ctx = oidc.ClientContext(ctx, httpClient)
 				acdSet, err := setIf.Get(ctx, &settingspkg.SettingsQuery{})
 				errors.CheckError(err)
+				if set := argocdclient.SettingsWithOIDCProvider(acdSet, func(provider *settingspkg.OIDCConfig) bool { return provider.Issuer == claims.Issuer }); set != nil {
+					acdSet = set
+				}
 				oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 				errors.CheckError(err)
 				tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
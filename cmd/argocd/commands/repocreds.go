@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
 
@@ -63,6 +64,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 
   # Add credentials with helm oci registry so that these oci registry urls do not need to be added as repos individually.
   argocd repocreds add localhost:5000/myrepo --enable-oci --type helm 
+
+  # Add credentials for all helm oci charts of an Amazon ECR registry, using the IAM role of the repo server
+  argocd repocreds add 123456789012.dkr.ecr.eu-west-1.amazonaws.com --enable-oci --type helm --auth-provider aws
 `
 
 	var command = &cobra.Command{
@@ -126,6 +130,10 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 				}
 			}
 
+			if !helm.IsValidAuthProvider(repo.AuthProvider) {
+				errors.CheckError(fmt.Errorf("--auth-provider must be either 'aws' or 'gcp'"))
+			}
+
 			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoCredsClientOrDie()
 			defer io.Close(conn)
 
@@ -157,6 +165,7 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
 	command.Flags().BoolVar(&repo.EnableOCI, "enable-oci", false, "Specifies whether helm-oci support should be enabled for this repo")
 	command.Flags().StringVar(&repo.Type, "type", common.DefaultRepoType, "type of the repository, \"git\" or \"helm\"")
+	command.Flags().StringVar(&repo.AuthProvider, "auth-provider", "", "obtain registry tokens of helm-oci repositories for the identity of the repo server, \"aws\" or \"gcp\"")
 	return command
 }
 
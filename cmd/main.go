@@ -9,9 +9,11 @@ import (
 
 	appcontroller "github.com/argoproj/argo-cd/v2/cmd/argocd-application-controller/commands"
 	dex "github.com/argoproj/argo-cd/v2/cmd/argocd-dex/commands"
+	gitsshproxy "github.com/argoproj/argo-cd/v2/cmd/argocd-git-ssh-proxy/commands"
 	reposerver "github.com/argoproj/argo-cd/v2/cmd/argocd-repo-server/commands"
 	apiserver "github.com/argoproj/argo-cd/v2/cmd/argocd-server/commands"
 	cli "github.com/argoproj/argo-cd/v2/cmd/argocd/commands"
+	"github.com/argoproj/argo-cd/v2/util/sandbox"
 )
 
 const (
@@ -36,6 +38,11 @@ func main() {
 		command = reposerver.NewCommand()
 	case "argocd-dex":
 		command = dex.NewCommand()
+	case "argocd-git-ssh-proxy":
+		command = gitsshproxy.NewCommand()
+	case sandbox.HelperName:
+		// sets up the sandbox of a command run by the repo server and runs it, does not return
+		sandbox.Main()
 	default:
 		command = cli.NewCommand()
 	}
@@ -49,6 +49,7 @@ type AppOptions struct {
 	namePrefix                      string
 	nameSuffix                      string
 	directoryRecurse                bool
+	directoryEnvsubst               bool
 	configManagementPlugin          string
 	jsonnetTlaStr                   []string
 	jsonnetTlaCode                  []string
@@ -69,6 +70,16 @@ type AppOptions struct {
 	retryBackoffDuration            time.Duration
 	retryBackoffMaxDuration         time.Duration
 	retryBackoffFactor              int64
+	ytt                             bool
+	yttFiles                        []string
+	yttDataValuesFiles              []string
+	yttDataValues                   []string
+	yttDataValuesYAML               []string
+	yttKbld                         bool
+	helmfileEnvironment             string
+	helmfileSelectors               []string
+	kubeVersion                     string
+	apiVersions                     []string
 }
 
 func AddAppFlags(command *cobra.Command, opts *AppOptions) {
@@ -114,10 +125,21 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().BoolVar(&opts.kustomizeForceCommonAnnotations, "kustomize-force-common-annotation", false, "Force common annotations in Kustomize")
 	command.Flags().StringVar(&opts.directoryExclude, "directory-exclude", "", "Set glob expression used to exclude files from application source path")
 	command.Flags().StringVar(&opts.directoryInclude, "directory-include", "", "Set glob expression used to include files from application source path")
+	command.Flags().BoolVar(&opts.directoryEnvsubst, "directory-envsubst", false, "Substitute build environment variables in YAML manifests of the directory")
 	command.Flags().Int64Var(&opts.retryLimit, "sync-retry-limit", 0, "Max number of allowed sync retries")
 	command.Flags().DurationVar(&opts.retryBackoffDuration, "sync-retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Sync retry backoff base duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().DurationVar(&opts.retryBackoffMaxDuration, "sync-retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max sync retry backoff duration. Input needs to be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&opts.retryBackoffFactor, "sync-retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed sync retry")
+	command.Flags().BoolVar(&opts.ytt, "ytt", false, "Render the application with ytt")
+	command.Flags().StringArrayVar(&opts.yttFiles, "ytt-file", []string{}, "Additional ytt template or overlay file or directory, relative to the application path")
+	command.Flags().StringArrayVar(&opts.yttDataValuesFiles, "ytt-data-values-file", []string{}, "ytt data values file, relative to the application path")
+	command.Flags().StringArrayVar(&opts.yttDataValues, "ytt-data-value", []string{}, "ytt string data value (can be repeated to set several values: --ytt-data-value key1=val1 --ytt-data-value key2=val2)")
+	command.Flags().StringArrayVar(&opts.yttDataValuesYAML, "ytt-data-value-yaml", []string{}, "ytt data value parsed as YAML (can be repeated to set several values: --ytt-data-value-yaml key1=val1 --ytt-data-value-yaml key2=val2)")
+	command.Flags().BoolVar(&opts.yttKbld, "ytt-kbld", false, "Resolve images of the rendered manifests to digests with kbld")
+	command.Flags().StringVar(&opts.helmfileEnvironment, "helmfile-environment", "", "helmfile environment used to render the releases")
+	command.Flags().StringArrayVar(&opts.helmfileSelectors, "helmfile-selector", []string{}, "helmfile label selector restricting the rendered releases (can be repeated to render releases matching any selector: --helmfile-selector tier=frontend --helmfile-selector name=redis)")
+	command.Flags().StringVar(&opts.kubeVersion, "kube-version", "", "Kubernetes version passed to Helm and config management plugins instead of the version of the destination cluster (e.g. 1.22.0)")
+	command.Flags().StringArrayVar(&opts.apiVersions, "api-versions", []string{}, "Kubernetes API versions passed to Helm and config management plugins instead of the API versions of the destination cluster (can be repeated to set several versions: --api-versions apps/v1 --api-versions networking.k8s.io/v1/Ingress)")
 }
 
 func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, appOpts *AppOptions) int {
@@ -180,6 +202,12 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			} else {
 				spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Include: appOpts.directoryInclude}
 			}
+		case "directory-envsubst":
+			if spec.Source.Directory != nil {
+				spec.Source.Directory.Envsubst = appOpts.directoryEnvsubst
+			} else {
+				spec.Source.Directory = &argoappv1.ApplicationSourceDirectory{Envsubst: appOpts.directoryEnvsubst}
+			}
 		case "config-management-plugin":
 			spec.Source.Plugin = &argoappv1.ApplicationSourcePlugin{Name: appOpts.configManagementPlugin}
 		case "dest-name":
@@ -222,6 +250,30 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setJsonnetOptLibs(&spec.Source, appOpts.jsonnetLibs)
 		case "plugin-env":
 			setPluginOptEnvs(&spec.Source, appOpts.pluginEnvs)
+		case "ytt":
+			if appOpts.ytt {
+				setYttOpt(&spec.Source, yttOpts{})
+			} else {
+				spec.Source.Ytt = nil
+			}
+		case "ytt-file":
+			setYttOpt(&spec.Source, yttOpts{files: appOpts.yttFiles})
+		case "ytt-data-values-file":
+			setYttOpt(&spec.Source, yttOpts{dataValuesFiles: appOpts.yttDataValuesFiles})
+		case "ytt-data-value":
+			setYttOpt(&spec.Source, yttOpts{dataValues: appOpts.yttDataValues})
+		case "ytt-data-value-yaml":
+			setYttOpt(&spec.Source, yttOpts{dataValuesYAML: appOpts.yttDataValuesYAML})
+		case "ytt-kbld":
+			setYttOpt(&spec.Source, yttOpts{kbld: &appOpts.yttKbld})
+		case "helmfile-environment":
+			setHelmfileOpt(&spec.Source, helmfileOpts{environment: &appOpts.helmfileEnvironment})
+		case "helmfile-selector":
+			setHelmfileOpt(&spec.Source, helmfileOpts{selectors: appOpts.helmfileSelectors})
+		case "kube-version":
+			spec.Source.KubeVersion = appOpts.kubeVersion
+		case "api-versions":
+			spec.Source.APIVersions = appOpts.apiVersions
 		case "sync-policy":
 			switch appOpts.syncPolicy {
 			case "none":
@@ -371,6 +423,63 @@ func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 	}
 }
 
+type yttOpts struct {
+	files           []string
+	dataValuesFiles []string
+	dataValues      []string
+	dataValuesYAML  []string
+	kbld            *bool
+}
+
+func setYttOpt(src *argoappv1.ApplicationSource, opts yttOpts) {
+	if src.Ytt == nil {
+		src.Ytt = &argoappv1.ApplicationSourceYtt{}
+	}
+	if len(opts.files) > 0 {
+		src.Ytt.Files = opts.files
+	}
+	if len(opts.dataValuesFiles) > 0 {
+		src.Ytt.DataValuesFiles = opts.dataValuesFiles
+	}
+	for _, text := range opts.dataValues {
+		src.Ytt.AddDataValue(newYttDataValue(text, false))
+	}
+	for _, text := range opts.dataValuesYAML {
+		src.Ytt.AddDataValue(newYttDataValue(text, true))
+	}
+	if opts.kbld != nil {
+		src.Ytt.Kbld = *opts.kbld
+	}
+}
+
+func newYttDataValue(text string, yaml bool) argoappv1.YttDataValue {
+	parts := strings.SplitN(text, "=", 2)
+	if len(parts) != 2 {
+		log.Fatalf("Expected ytt data value of the form: key=value. Received: %s", text)
+	}
+	return argoappv1.YttDataValue{Name: parts[0], Value: parts[1], YAML: yaml}
+}
+
+type helmfileOpts struct {
+	environment *string
+	selectors   []string
+}
+
+func setHelmfileOpt(src *argoappv1.ApplicationSource, opts helmfileOpts) {
+	if src.Helmfile == nil {
+		src.Helmfile = &argoappv1.ApplicationSourceHelmfile{}
+	}
+	if opts.environment != nil {
+		src.Helmfile.Environment = *opts.environment
+	}
+	if len(opts.selectors) > 0 {
+		src.Helmfile.Selectors = opts.selectors
+	}
+	if src.Helmfile.IsZero() {
+		src.Helmfile = nil
+	}
+}
+
 type helmOpts struct {
 	valueFiles     []string
 	values         string
@@ -114,6 +114,35 @@ func Test_setPluginOptEnvs(t *testing.T) {
 	})
 }
 
+func Test_setYttOpt(t *testing.T) {
+	src := v1alpha1.ApplicationSource{}
+	setYttOpt(&src, yttOpts{files: []string{"overlays"}, dataValues: []string{"app.name=guestbook", "app.version=1.0"}})
+	setYttOpt(&src, yttOpts{dataValuesYAML: []string{"app.version=2"}})
+	kbld := true
+	setYttOpt(&src, yttOpts{kbld: &kbld})
+	assert.Equal(t, &v1alpha1.ApplicationSourceYtt{
+		Files: []string{"overlays"},
+		DataValues: []v1alpha1.YttDataValue{
+			{Name: "app.name", Value: "guestbook"},
+			{Name: "app.version", Value: "2", YAML: true},
+		},
+		Kbld: true,
+	}, src.Ytt)
+}
+
+func Test_setHelmfileOpt(t *testing.T) {
+	src := v1alpha1.ApplicationSource{}
+	environment := "production"
+	setHelmfileOpt(&src, helmfileOpts{environment: &environment})
+	setHelmfileOpt(&src, helmfileOpts{selectors: []string{"tier=frontend"}})
+	assert.Equal(t, &v1alpha1.ApplicationSourceHelmfile{Environment: "production", Selectors: []string{"tier=frontend"}}, src.Helmfile)
+
+	environment = ""
+	src = v1alpha1.ApplicationSource{}
+	setHelmfileOpt(&src, helmfileOpts{environment: &environment})
+	assert.Nil(t, src.Helmfile)
+}
+
 type appOptionsFixture struct {
 	spec    *v1alpha1.ApplicationSpec
 	command *cobra.Command
@@ -171,6 +200,15 @@ func Test_setAppSpecOptions(t *testing.T) {
 		assert.NoError(t, f.SetFlag("sync-retry-limit", "0"))
 		assert.Nil(t, f.spec.SyncPolicy.Retry)
 	})
+	t.Run("KubeVersion", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("kube-version", "1.22.0"))
+		assert.Equal(t, "1.22.0", f.spec.Source.KubeVersion)
+	})
+	t.Run("APIVersions", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("api-versions", "apps/v1"))
+		assert.NoError(t, f.SetFlag("api-versions", "networking.k8s.io/v1/Ingress"))
+		assert.Equal(t, []string{"apps/v1", "networking.k8s.io/v1/Ingress"}, f.spec.Source.APIVersions)
+	})
 }
 
 func Test_setAnnotations(t *testing.T) {
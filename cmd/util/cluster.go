@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/rest"
@@ -115,6 +116,7 @@ type ClusterOptions struct {
 	Name                    string
 	Project                 string
 	Shard                   int64
+	ResyncInterval          time.Duration
 	ExecProviderCommand     string
 	ExecProviderArgs        []string
 	ExecProviderEnv         map[string]string
@@ -131,6 +133,7 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringVar(&opts.Project, "project", "", "project of the cluster")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
+	command.Flags().DurationVar(&opts.ResyncInterval, "resync-interval", 0, "Interval of the full resync of the cluster cache; the application controller default if not set")
 	command.Flags().StringVar(&opts.ExecProviderCommand, "exec-command", "", "Command to run to provide client credentials to the cluster. You may need to build a custom ArgoCD image to ensure the command is available at runtime.")
 	command.Flags().StringArrayVar(&opts.ExecProviderArgs, "exec-command-args", nil, "Arguments to supply to the --exec-command executable")
 	command.Flags().StringToStringVar(&opts.ExecProviderEnv, "exec-command-env", nil, "Environment vars to set when running the --exec-command executable")
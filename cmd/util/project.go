@@ -24,6 +24,7 @@ type ProjectOpts struct {
 	destinations  []string
 	Sources       []string
 	SignatureKeys []string
+	HelmVersion   string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -31,6 +32,7 @@ type ProjectOpts struct {
 	deniedClusterResources     []string
 	allowedNamespacedResources []string
 	deniedNamespacedResources  []string
+	sopsKeySet                 string
 }
 
 func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
@@ -45,6 +47,8 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringArrayVar(&opts.deniedClusterResources, "deny-cluster-resource", []string{}, "List of denied cluster level resources")
 	command.Flags().StringArrayVar(&opts.allowedNamespacedResources, "allow-namespaced-resource", []string{}, "List of allowed namespaced resources")
 	command.Flags().StringArrayVar(&opts.deniedNamespacedResources, "deny-namespaced-resource", []string{}, "List of denied namespaced resources")
+	command.Flags().StringVar(&opts.HelmVersion, "helm-version", "", "Default Helm version of the applications of the project, either v2, v3 or a version registered in argocd-cm")
+	command.Flags().StringVar(&opts.sopsKeySet, "sops-key-set", "", "Name of the SOPS key set of the repo server the SOPS encrypted Helm values files and manifests of the applications of the project are decrypted with")
 
 }
 
@@ -156,6 +160,10 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.NamespaceResourceWhitelist = projOpts.GetAllowedNamespacedResources()
 		case "deny-namespaced-resource":
 			spec.NamespaceResourceBlacklist = projOpts.GetDeniedNamespacedResources()
+		case "helm-version":
+			spec.HelmVersion = projOpts.HelmVersion
+		case "sops-key-set":
+			spec.SopsKeySet = projOpts.sopsKeySet
 		}
 	})
 	if flags.Changed("orphaned-resources") || flags.Changed("orphaned-resources-warn") {
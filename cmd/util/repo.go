@@ -17,6 +17,8 @@ type RepoOptions struct {
 	TlsClientCertKeyPath           string
 	EnableLfs                      bool
 	EnableOci                      bool
+	EnableSubmodules               bool
+	SubmodulesUseRepoCreds         bool
 	GithubAppId                    int64
 	GithubAppInstallationId        int64
 	GithubAppPrivateKeyPath        string
@@ -24,8 +26,18 @@ type RepoOptions struct {
 	Proxy                          string
 }
 
+// ApplySubmoduleFlags sets the submodule settings of the repository. The default of the repo server is used if
+// --enable-submodules is not specified.
+func ApplySubmoduleFlags(command *cobra.Command, opts *RepoOptions) {
+	if command.Flags().Changed("enable-submodules") {
+		enableSubmodules := opts.EnableSubmodules
+		opts.Repo.EnableSubmodules = &enableSubmodules
+	}
+	opts.Repo.SubmodulesUseRepoCreds = opts.SubmodulesUseRepoCreds
+}
+
 func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
-	command.Flags().StringVar(&opts.Repo.Type, "type", common.DefaultRepoType, "type of the repository, \"git\" or \"helm\"")
+	command.Flags().StringVar(&opts.Repo.Type, "type", common.DefaultRepoType, "type of the repository, \"git\", \"helm\" or \"oci\"")
 	command.Flags().StringVar(&opts.Repo.Name, "name", "", "name of the repository, mandatory for repositories of type helm")
 	command.Flags().StringVar(&opts.Repo.Project, "project", "", "project of the repository")
 	command.Flags().StringVar(&opts.Repo.Username, "username", "", "username to the repository")
@@ -37,9 +49,12 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().BoolVar(&opts.InsecureSkipServerVerification, "insecure-skip-server-verification", false, "disables server certificate and host key checks")
 	command.Flags().BoolVar(&opts.EnableLfs, "enable-lfs", false, "enable git-lfs (Large File Support) on this repository")
 	command.Flags().BoolVar(&opts.EnableOci, "enable-oci", false, "enable helm-oci (Helm OCI-Based Repository)")
+	command.Flags().BoolVar(&opts.EnableSubmodules, "enable-submodules", true, "enable (--enable-submodules=true) or disable (--enable-submodules=false) the checkout of git submodules on this repository, the default of the repo server is used if not specified")
+	command.Flags().BoolVar(&opts.SubmodulesUseRepoCreds, "submodules-use-repo-creds", false, "rewrite the URLs of the git submodules hosted on the same server as this repository to its URL scheme, so that they are fetched using its credentials")
 	command.Flags().Int64Var(&opts.GithubAppId, "github-app-id", 0, "id of the GitHub Application")
 	command.Flags().Int64Var(&opts.GithubAppInstallationId, "github-app-installation-id", 0, "installation id of the GitHub Application")
 	command.Flags().StringVar(&opts.GithubAppPrivateKeyPath, "github-app-private-key-path", "", "private key of the GitHub Application")
 	command.Flags().StringVar(&opts.GitHubAppEnterpriseBaseURL, "github-app-enterprise-base-url", "", "base url to use when using GitHub Enterprise (e.g. https://ghe.example.com/api/v3")
 	command.Flags().StringVar(&opts.Proxy, "proxy", "", "use proxy to access repository")
+	command.Flags().StringVar(&opts.Repo.AuthProvider, "auth-provider", "", "obtain registry tokens of helm-oci repositories for the identity of the repo server, \"aws\" or \"gcp\"")
 }
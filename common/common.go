@@ -25,6 +25,11 @@ const (
 	// Contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// Contains the GPG public keys stored in secrets, maintained by the API server so that they are mounted along with
+	// the ones of argocd-gpg-keys-cm by the repo server
+	ArgoCDGPGKeysSecretsConfigMapName = "argocd-gpg-keys-secrets-cm"
+	// Contains the heartbeats of the application controller replicas and the assignment of clusters to them
+	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 )
 
 // Some default configurables
@@ -104,7 +109,10 @@ const (
 	LabelKeyAppInstance = "app.kubernetes.io/instance"
 	// LabelKeyLegacyApplicationName is the legacy label (v0.10 and below) and is superseded by 'app.kubernetes.io/instance'
 	LabelKeyLegacyApplicationName = "applications.argoproj.io/app-name"
-	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster', 'repository', 'repo-config' or 'repo-creds')
+	// AnnotationKeyAppInstance is the annotation which tracks the application of a resource with the annotation
+	// resource tracking methods. Its value is <application name>:<group>/<kind>:<namespace>/<name>
+	AnnotationKeyAppInstance = "argocd.argoproj.io/tracking-id"
+	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster', 'repository', 'repo-config', 'repo-creds' or 'gpg-key')
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
@@ -112,9 +120,30 @@ const (
 	LabelValueSecretTypeRepository = "repository"
 	// LabelValueSecretTypeRepoCreds indicates a secret type of repository credentials
 	LabelValueSecretTypeRepoCreds = "repo-creds"
+	// LabelValueSecretTypeGPGKey indicates a secret type of GPG public key
+	LabelValueSecretTypeGPGKey = "gpg-key"
+	// LabelKeySCIMResourceType contains the type of the SCIM resource held by a config map (currently: 'user' or 'group')
+	LabelKeySCIMResourceType = "argocd.argoproj.io/scim-resource-type"
+	// LabelValueSCIMResourceTypeUser indicates a config map holding a user provisioned through SCIM
+	LabelValueSCIMResourceTypeUser = "user"
+	// LabelValueSCIMResourceTypeGroup indicates a config map holding a group provisioned through SCIM
+	LabelValueSCIMResourceTypeGroup = "group"
+	// AnnotationKeyCredentialsProvider opts a repository, repository credentials or cluster secret in to referencing
+	// its credentials in the external secret store named by its value, e.g. 'vault'
+	AnnotationKeyCredentialsProvider = "argocd.argoproj.io/credentials-provider"
 
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.argoproj.io/compare-options"
+	// AnnotationSyncWaveDelay is the delay to wait for after applying the sync wave of the annotated resource
+	AnnotationSyncWaveDelay = "argocd.argoproj.io/sync-wave-delay"
+	// AnnotationHookTimeout is the maximum duration of the annotated hook, after which the hook is failed
+	AnnotationHookTimeout = "argocd.argoproj.io/hook-timeout"
+	// AnnotationHookFailurePolicy is the policy applied when the annotated hook fails, either Fail or Ignore
+	AnnotationHookFailurePolicy = "argocd.argoproj.io/hook-failure-policy"
+	// HookFailurePolicyFail fails the sync operation when the hook fails
+	HookFailurePolicyFail = "Fail"
+	// HookFailurePolicyIgnore ignores the failure of the hook, which is considered successful
+	HookFailurePolicyIgnore = "Ignore"
 
 	// AnnotationKeyManagedBy is annotation name which indicates that k8s resource is managed by an application.
 	AnnotationKeyManagedBy = "managed-by"
@@ -172,6 +201,8 @@ const (
 	EnvLogFormat = "ARGOCD_LOG_FORMAT"
 	// EnvLogLevel log level that is defined by `--loglevel` option
 	EnvLogLevel = "ARGOCD_LOG_LEVEL"
+	// EnvGitSSHProxy is the SOCKS5 or HTTP CONNECT proxy used by argocd-git-ssh-proxy to reach SSH git servers
+	EnvGitSSHProxy = "ARGOCD_GIT_SSH_PROXY"
 )
 
 const (
@@ -18,6 +18,7 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -48,11 +49,13 @@ import (
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/argo/managedfields"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
+	"github.com/argoproj/argo-cd/v2/util/lua"
 	settings_util "github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -60,6 +63,10 @@ const (
 	updateOperationStateTimeout = 1 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by the names of the applications they depend on
+	dependsOnIndex = "dependsOn"
+	// maxOrphanedResourcesInMessage is the maximum number of orphaned resources listed in the orphaned resources warning
+	maxOrphanedResourcesInMessage = 10
 )
 
 type CompareWith int
@@ -109,6 +116,8 @@ type ApplicationController struct {
 	settingsMgr                   *settings_util.SettingsManager
 	refreshRequestedApps          map[string]CompareWith
 	refreshRequestedAppsMutex     *sync.Mutex
+	refreshEnqueuedAt             map[string]time.Time
+	refreshEnqueuedAtMutex        *sync.Mutex
 	metricsServer                 *metrics.MetricsServer
 	kubectlSemaphore              *semaphore.Weighted
 	clusterFilter                 func(cluster *appv1.Cluster) bool
@@ -129,6 +138,7 @@ func NewApplicationController(
 	metricsCacheExpiration time.Duration,
 	kubectlParallelismLimit int64,
 	clusterFilter func(cluster *appv1.Cluster) bool,
+	refreshRateLimiterConfig AppRefreshRateLimiterConfig,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v", appResyncPeriod)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -139,7 +149,7 @@ func NewApplicationController(
 		kubectl:                       kubectl,
 		applicationClientset:          applicationClientset,
 		repoClientset:                 repoClientset,
-		appRefreshQueue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "app_reconciliation_queue"),
+		appRefreshQueue:               workqueue.NewNamedRateLimitingQueue(newAppRefreshRateLimiter(refreshRateLimiterConfig), "app_reconciliation_queue"),
 		appOperationQueue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "app_operation_processing_queue"),
 		projectRefreshQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "project_reconciliation_queue"),
 		appComparisonTypeRefreshQueue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
@@ -147,6 +157,8 @@ func NewApplicationController(
 		statusRefreshTimeout:          appResyncPeriod,
 		refreshRequestedApps:          make(map[string]CompareWith),
 		refreshRequestedAppsMutex:     &sync.Mutex{},
+		refreshEnqueuedAt:             make(map[string]time.Time),
+		refreshEnqueuedAtMutex:        &sync.Mutex{},
 		auditLogger:                   argo.NewAuditLogger(namespace, kubeClientset, "argocd-application-controller"),
 		settingsMgr:                   settingsMgr,
 		selfHealTimeout:               selfHealTimeout,
@@ -313,6 +325,23 @@ func isKnownOrphanedResourceExclusion(key kube.ResourceKey, proj *appv1.AppProje
 	return false
 }
 
+// orphanedResourcesMessage returns the message of the orphaned resources warning, which lists the first orphaned
+// top-level resources
+func orphanedResourcesMessage(count int, keys []kube.ResourceKey) string {
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	var names []string
+	for i, key := range keys {
+		if i == maxOrphanedResourcesInMessage {
+			names = append(names, fmt.Sprintf("and %d more", len(keys)-maxOrphanedResourcesInMessage))
+			break
+		}
+		names = append(names, fmt.Sprintf("%s/%s", key.Kind, key.Name))
+	}
+	return fmt.Sprintf("Application has %d orphaned resources: %s", count, strings.Join(names, ", "))
+}
+
 func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managedResources []*appv1.ResourceDiff) (*appv1.ApplicationTree, error) {
 	nodes := make([]appv1.ResourceNode, 0)
 
@@ -329,6 +358,10 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 		}
 		warnOrphaned = proj.Spec.OrphanedResources.IsWarn()
 	}
+	healthOverrides, err := ctrl.getProjectHealthOverrides(proj)
+	if err != nil {
+		return nil, err
+	}
 
 	for i := range managedResources {
 		managedResource := managedResources[i]
@@ -355,7 +388,14 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 				},
 			})
 		} else {
-			err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, kube.GetResourceKey(live), func(child appv1.ResourceNode, appName string) {
+			liveKey := kube.GetResourceKey(live)
+			err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, liveKey, func(child appv1.ResourceNode, appName string) {
+				if healthOverrides != nil && kube.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name) == liveKey {
+					// the health of the resources in the cache is assessed with the health checks of argocd-cm only
+					if healthStatus, err := health.GetResourceHealth(live, healthOverrides); err == nil && healthStatus != nil {
+						child.Health = &appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
+					}
+				}
 				nodes = append(nodes, child)
 			})
 			if err != nil {
@@ -364,8 +404,10 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 		}
 	}
 	orphanedNodes := make([]appv1.ResourceNode, 0)
+	var orphanedKeys []kube.ResourceKey
 	for k := range orphanedNodesMap {
 		if k.Namespace != "" && proj.IsGroupKindPermitted(k.GroupKind(), true) && !isKnownOrphanedResourceExclusion(k, proj) {
+			orphaned := false
 			err := ctrl.stateCache.IterateHierarchy(a.Spec.Destination.Server, k, func(child appv1.ResourceNode, appName string) {
 				belongToAnotherApp := false
 				if appName != "" {
@@ -375,21 +417,30 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 				}
 				if !belongToAnotherApp {
 					orphanedNodes = append(orphanedNodes, child)
+					orphaned = true
 				}
 			})
 			if err != nil {
 				return nil, err
 			}
+			if orphaned {
+				orphanedKeys = append(orphanedKeys, k)
+			}
 		}
 	}
 	var conditions []appv1.ApplicationCondition
 	if len(orphanedNodes) > 0 && warnOrphaned {
 		conditions = []appv1.ApplicationCondition{{
 			Type:    appv1.ApplicationConditionOrphanedResourceWarning,
-			Message: fmt.Sprintf("Application has %d orphaned resources", len(orphanedNodes)),
+			Message: orphanedResourcesMessage(len(orphanedNodes), orphanedKeys),
 		}}
 	}
 	a.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionOrphanedResourceWarning: true})
+	if proj.Spec.OrphanedResources != nil {
+		ctrl.metricsServer.SetOrphanedResources(a, len(orphanedNodes))
+	} else {
+		ctrl.metricsServer.DeleteOrphanedResources(a)
+	}
 	sort.Slice(orphanedNodes, func(i, j int) bool {
 		return orphanedNodes[i].ResourceRef.String() < orphanedNodes[j].ResourceRef.String()
 	})
@@ -402,6 +453,22 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes, Hosts: hosts}, nil
 }
 
+// getProjectHealthOverrides returns the health checks of argocd-cm completed with the ones customized in the given
+// project, or nil if the project does not customize any health check
+func (ctrl *ApplicationController) getProjectHealthOverrides(proj *appv1.AppProject) (lua.ResourceHealthOverrides, error) {
+	for _, customization := range proj.Spec.ResourceCustomizations {
+		if customization.HealthLua == "" {
+			continue
+		}
+		resourceOverrides, err := ctrl.settingsMgr.GetResourceOverrides()
+		if err != nil {
+			return nil, err
+		}
+		return lua.ResourceHealthOverrides(proj.ResourceOverrides(resourceOverrides)), nil
+	}
+	return nil, nil
+}
+
 func (ctrl *ApplicationController) getAppHosts(a *appv1.Application, appNodes []appv1.ResourceNode) ([]appv1.HostInfo, error) {
 	supportedResourceNames := map[v1.ResourceName]bool{
 		v1.ResourceCPU:     true,
@@ -507,12 +574,17 @@ func (ctrl *ApplicationController) managedResources(comparisonResult *comparison
 		live := res.Live
 		resDiff := res.Diff
 		if res.Kind == kube.SecretKind && res.Group == "" {
-			var err error
-			target, live, err = diff.HideSecretData(res.Target, res.Live)
+			compareOptions, err := ctrl.settingsMgr.GetResourceCompareOptions()
 			if err != nil {
 				return nil, err
 			}
-			compareOptions, err := ctrl.settingsMgr.GetResourceCompareOptions()
+			if compareOptions.IgnoreFieldsOwnedByOtherManagers {
+				target, live, err = managedfields.Normalize(target, live, compareOptions.ArgoCDFieldManagers)
+				if err != nil {
+					return nil, err
+				}
+			}
+			target, live, err = diff.HideSecretData(target, live)
 			if err != nil {
 				return nil, err
 			}
@@ -604,6 +676,25 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	<-ctx.Done()
 }
 
+// getNamespaceLabels gets the labels of the namespace from the live state cache rather than from the API server of the
+// cluster, since they are needed on every refresh of the applications with sync windows selecting namespaces
+func (ctrl *ApplicationController) getNamespaceLabels(_ context.Context, cluster *appv1.Cluster, namespace string) (map[string]string, error) {
+	return ctrl.stateCache.GetNamespaceLabels(cluster.Server, namespace)
+}
+
+// ReconcileClusterShards updates the watched clusters and refreshes the handled applications after the assignment
+// of clusters to the controller replicas changed.
+func (ctrl *ApplicationController) ReconcileClusterShards(ctx context.Context) {
+	if err := ctrl.stateCache.ReconcileClusters(ctx); err != nil {
+		log.Warnf("Failed to reconcile watched clusters: %v", err)
+	}
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		if app, ok := obj.(*appv1.Application); ok && ctrl.canProcessApp(app) {
+			ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+		}
+	}
+}
+
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
 	key := fmt.Sprintf("%s/%s", ctrl.namespace, appName)
 
@@ -615,16 +706,51 @@ func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith
 			ctrl.refreshRequestedApps[appName] = compareWith.Max(ctrl.refreshRequestedApps[appName])
 			ctrl.refreshRequestedAppsMutex.Unlock()
 		}
+		ctrl.enqueueAppRefresh(key, after)
 		if after != nil {
-			ctrl.appRefreshQueue.AddAfter(key, *after)
 			ctrl.appOperationQueue.AddAfter(key, *after)
 		} else {
-			ctrl.appRefreshQueue.Add(key)
 			ctrl.appOperationQueue.Add(key)
 		}
 	}
 }
 
+// enqueueAppRefresh adds the given application key to the refresh queue. Refreshes requested without an explicit
+// delay are subject to the refresh rate limits.
+func (ctrl *ApplicationController) enqueueAppRefresh(key string, after *time.Duration) {
+	enqueuedAt := time.Now()
+	if after != nil {
+		enqueuedAt = enqueuedAt.Add(*after)
+	}
+	ctrl.refreshEnqueuedAtMutex.Lock()
+	if at, ok := ctrl.refreshEnqueuedAt[key]; !ok || enqueuedAt.Before(at) {
+		ctrl.refreshEnqueuedAt[key] = enqueuedAt
+	}
+	ctrl.refreshEnqueuedAtMutex.Unlock()
+
+	if after != nil {
+		ctrl.appRefreshQueue.AddAfter(key, *after)
+	} else {
+		ctrl.appRefreshQueue.AddRateLimited(key)
+	}
+}
+
+// refreshQueueWait returns how long the given application key waited in the refresh queue
+func (ctrl *ApplicationController) refreshQueueWait(key string) (time.Duration, bool) {
+	ctrl.refreshEnqueuedAtMutex.Lock()
+	defer ctrl.refreshEnqueuedAtMutex.Unlock()
+	enqueuedAt, ok := ctrl.refreshEnqueuedAt[key]
+	if !ok {
+		return 0, false
+	}
+	delete(ctrl.refreshEnqueuedAt, key)
+	wait := time.Since(enqueuedAt)
+	if wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
 func (ctrl *ApplicationController) isRefreshRequested(appName string) (bool, CompareWith) {
 	ctrl.refreshRequestedAppsMutex.Lock()
 	defer ctrl.refreshRequestedAppsMutex.Unlock()
@@ -663,6 +789,10 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if origApp.IsReconcileSkipped() {
+		log.WithField("application", origApp.Name).Debugf("Skipping operation: reconciliation is paused by the %s annotation", appv1.AnnotationKeySkipReconcile)
+		return
+	}
 	app := origApp.DeepCopy()
 
 	if app.Operation != nil {
@@ -846,6 +976,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 			// Wait for objects pending deletion to complete before proceeding with next sync wave
 			if objsMap[k].GetDeletionTimestamp() != nil {
 				logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+				ctrl.setDeletionProgress(app, objsMap)
 				return objs, nil
 			}
 
@@ -855,6 +986,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		}
 
 		config := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, app, cluster.RESTConfig())
+		// delete the resources with the permissions of the service account which synced them
+		if err := argo.ImpersonateServiceAccount(ctrl.settingsMgr, proj, app.Spec.Destination, config); err != nil {
+			return objs, err
+		}
 
 		filteredObjs := FilterObjectsForDeletion(objs)
 
@@ -884,6 +1019,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 		}
 		if len(objsMap) > 0 {
 			logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+			ctrl.setDeletionProgress(app, objsMap)
 			return objs, nil
 		}
 	}
@@ -910,6 +1046,60 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	return objs, nil
 }
 
+// setDeletionProgress reports the resources remaining for deletion in the DeletionProgress condition of the application
+func (ctrl *ApplicationController) setDeletionProgress(app *appv1.Application, objsMap map[kube.ResourceKey]*unstructured.Unstructured) {
+	objs := make([]*unstructured.Unstructured, 0)
+	for _, obj := range objsMap {
+		if ctrl.shouldBeDeleted(app, obj) {
+			objs = append(objs, obj)
+		}
+	}
+	ctrl.setAppCondition(app, appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionDeletionProgress,
+		Message: deletionProgressMessage(objs),
+	})
+}
+
+// maxDeletionBlockers is the max number of resources blocked by finalizers listed in the DeletionProgress condition
+const maxDeletionBlockers = 5
+
+// deletionProgressMessage describes the sync wave being deleted, the number of resources remaining for deletion and
+// the finalizers of the resources pending deletion
+func deletionProgressMessage(objs []*unstructured.Unstructured) string {
+	if len(objs) == 0 {
+		return "No resources remaining for deletion"
+	}
+	sort.Slice(objs, func(i, j int) bool {
+		keyI, keyJ := kube.GetResourceKey(objs[i]), kube.GetResourceKey(objs[j])
+		return keyI.String() < keyJ.String()
+	})
+	wave := syncwaves.Wave(objs[0])
+	for _, obj := range objs {
+		if w := syncwaves.Wave(obj); w > wave {
+			wave = w
+		}
+	}
+	inWave := 0
+	var blockers []string
+	for _, obj := range objs {
+		if syncwaves.Wave(obj) == wave {
+			inWave++
+		}
+		if obj.GetDeletionTimestamp() != nil && len(obj.GetFinalizers()) > 0 {
+			blockers = append(blockers, fmt.Sprintf("%s/%s %s/%s (%s)",
+				obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName(), strings.Join(obj.GetFinalizers(), ", ")))
+		}
+	}
+	message := fmt.Sprintf("Deleting sync wave %d: %d resources remaining in the wave, %d in total", wave, inWave, len(objs))
+	if len(blockers) > maxDeletionBlockers {
+		blockers = append(blockers[:maxDeletionBlockers], fmt.Sprintf("%d more", len(blockers)-maxDeletionBlockers))
+	}
+	if len(blockers) > 0 {
+		message += fmt.Sprintf(". Waiting for the finalizers of %s", strings.Join(blockers, "; "))
+	}
+	return message
+}
+
 func (ctrl *ApplicationController) removeCascadeFinalizer(app *appv1.Application) error {
 	app.UnSetCascadedDeletion()
 	var patch []byte
@@ -969,7 +1159,8 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		terminating = state.Phase == synccommon.OperationTerminating
 		// Failed  operation with retry strategy might have be in-progress and has completion time
 		if state.FinishedAt != nil && !terminating {
-			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
+			retry := app.Status.OperationState.Operation.Retry.ForSyncResult(state.SyncResult)
+			retryAt, err := retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
 			if err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = err.Error()
@@ -1006,6 +1197,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 
 	if state.Phase == synccommon.OperationRunning {
+		if delay := ctrl.appStateManager.SyncWaveDelay(app); delay > 0 {
+			// resume the operation once the delay before the next sync wave elapsed
+			ctrl.appOperationQueue.AddAfter(fmt.Sprintf("%s/%s", ctrl.namespace, app.Name), delay)
+		}
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
 		freshApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(ctrl.namespace).Get(context.Background(), app.ObjectMeta.Name, metav1.GetOptions{})
@@ -1019,10 +1214,12 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		}
 	} else if state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError {
-		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
+		// the retry strategy may be overridden for the phase or the hook which failed
+		retry := state.Operation.Retry.ForSyncResult(state.SyncResult)
+		if !terminating && (state.RetryCount < retry.Limit || retry.Limit < 0) {
 			now := metav1.Now()
 			state.FinishedAt = &now
-			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
+			if retryAt, err := retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
 				state.Phase = synccommon.OperationFailed
 				state.Message = fmt.Sprintf("%s (failed to retry: %v)", state.Message, err)
 			} else {
@@ -1136,6 +1333,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		}
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
+	queueWait, waited := ctrl.refreshQueueWait(appKey.(string))
 
 	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey.(string))
 	if err != nil {
@@ -1144,6 +1342,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	if !exists {
 		// This happens after app was deleted, but the work queue still had an entry for it.
+		ctrl.appRefreshQueue.Forget(appKey)
 		return
 	}
 	origApp, ok := obj.(*appv1.Application)
@@ -1151,6 +1350,17 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if waited {
+		ctrl.metricsServer.ObserveAppRefreshQueueWait(origApp, queueWait)
+	}
+	if !ctrl.canProcessApp(origApp) {
+		// The application cluster was assigned to another controller replica after the app had been queued
+		return
+	}
+	if origApp.IsReconcileSkipped() {
+		log.WithField("application", origApp.Name).Debugf("Skipping refresh: reconciliation is paused by the %s annotation", appv1.AnnotationKeySkipReconcile)
+		return
+	}
 	origApp = origApp.DeepCopy()
 	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout)
 
@@ -1227,8 +1437,14 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary()
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+	clusterLabels, namespaceLabels, err := argo.GetSyncWindowsLabels(context.Background(), &project.Spec.SyncWindows, app, ctrl.db, ctrl.getNamespaceLabels)
+	if err != nil {
+		logCtx.Warnf("Sync prevented: failed to get the labels selected by sync windows: %v", err)
+	} else if project.Spec.SyncWindows.MatchesWithLabels(app, clusterLabels, namespaceLabels).CanSync(false) {
+		syncErrCond, rolledBack := ctrl.autoRollback(app, compareResult.healthStatus)
+		if syncErrCond == nil && !rolledBack {
+			syncErrCond = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		}
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1254,6 +1470,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
 	app.Status.SourceType = compareResult.appSourceType
+	ctrl.pruneAppHistory(origApp, now.Time)
 	ctrl.persistAppStatus(origApp, &app.Status)
 	return
 }
@@ -1395,6 +1612,42 @@ func (ctrl *ApplicationController) persistAppStatus(orig *appv1.Application, new
 	}
 }
 
+// pruneAppHistory prunes the revision history and the operation state of the application according to the retention
+// settings. The patch is rejected if the application changed meanwhile, e.g. because a sync added a history entry or a
+// new operation started, in which case the next refresh prunes the latest state.
+func (ctrl *ApplicationController) pruneAppHistory(orig *appv1.Application, now time.Time) {
+	logCtx := log.WithField("application", orig.Name)
+	retention, err := ctrl.settingsMgr.GetAppHistoryRetention()
+	if err != nil {
+		logCtx.Warnf("Failed to get the history retention settings: %v", err)
+		return
+	}
+	app := orig.DeepCopy()
+	pruneRevisionHistory(app, retention, now)
+	pruneOperationState(app, retention, now)
+	status := make(map[string]interface{})
+	if len(app.Status.History) != len(orig.Status.History) {
+		status["history"] = app.Status.History
+	}
+	if opState := app.Status.OperationState; opState != nil && opState.SyncResult != nil && len(opState.SyncResult.Resources) != len(orig.Status.OperationState.SyncResult.Resources) {
+		status["operationState"] = map[string]interface{}{"syncResult": map[string]interface{}{"resources": opState.SyncResult.Resources}}
+	}
+	if len(status) == 0 {
+		return
+	}
+	patch, err := resourceVersionGuardedPatch(orig.ResourceVersion, status)
+	if err != nil {
+		logCtx.Errorf("Error constructing app history patch: %v", err)
+		return
+	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(orig.Namespace).Patch(context.Background(), orig.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if apierr.IsConflict(err) {
+		logCtx.Debug("Application changed while pruning its history, pruning it at the next refresh")
+	} else if err != nil {
+		logCtx.Warnf("Error pruning application history: %v", err)
+	}
+}
+
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus) *appv1.ApplicationCondition {
 	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
@@ -1417,6 +1670,11 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		return nil
 	}
 
+	if unready := ctrl.unreadyDependencies(app); len(unready) > 0 {
+		logCtx.Infof("Skipping auto-sync: waiting for dependencies %s to be Synced and Healthy", strings.Join(unready, ", "))
+		return nil
+	}
+
 	if !app.Spec.SyncPolicy.Automated.Prune {
 		requirePruneOnly := true
 		for _, r := range resources {
@@ -1432,6 +1690,13 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	}
 
 	desiredCommitSHA := syncStatus.Revision
+	// Do not sync again a revision which was automatically rolled back, until a newer revision is available or another
+	// sync is performed
+	if rolledBackRevision := lastRolledBackRevision(app); rolledBackRevision != "" && rolledBackRevision == desiredCommitSHA {
+		message := fmt.Sprintf("Skipping auto-sync: revision %s was automatically rolled back", desiredCommitSHA)
+		logCtx.Warn(message)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}
+	}
 	alreadyAttempted, attemptPhase := alreadyAttemptedSync(app, desiredCommitSHA)
 	selfHeal := app.Spec.SyncPolicy.Automated.SelfHeal
 	op := appv1.Operation{
@@ -1503,6 +1768,99 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 	return nil
 }
 
+// unreadyDependencies returns the names of the applications which the application depends on and which are not
+// Synced and Healthy yet
+func (ctrl *ApplicationController) unreadyDependencies(app *appv1.Application) []string {
+	var unready []string
+	for _, name := range app.Spec.DependsOn {
+		if name == app.Name {
+			continue
+		}
+		dependency, err := ctrl.appLister.Applications(ctrl.namespace).Get(name)
+		if err != nil || !isDependencyReady(dependency) {
+			unready = append(unready, name)
+		}
+	}
+	return unready
+}
+
+// isDependencyReady returns whether the applications depending on the given application can be automatically synced
+func isDependencyReady(app *appv1.Application) bool {
+	return app.Status.Sync.Status == appv1.SyncStatusCodeSynced && app.Status.Health.Status == health.HealthStatusHealthy
+}
+
+// autoRollback rolls the application back to the previous revision of its history if it became Degraded after a
+// successful automated sync, within the duration of its auto rollback policy. It returns true if a rollback was
+// initiated.
+func (ctrl *ApplicationController) autoRollback(app *appv1.Application, healthStatus *appv1.HealthStatus) (*appv1.ApplicationCondition, bool) {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil || app.Spec.SyncPolicy.AutoRollback == nil {
+		return nil, false
+	}
+	if app.Operation != nil || (app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero()) {
+		return nil, false
+	}
+	if healthStatus == nil || healthStatus.Status != health.HealthStatusDegraded {
+		return nil, false
+	}
+	state := app.Status.OperationState
+	if state == nil || state.Phase != synccommon.OperationSucceeded || state.FinishedAt == nil || state.Operation.Sync == nil {
+		return nil, false
+	}
+	// Only automated syncs are rolled back, and never the automatic rollbacks themselves
+	if !state.Operation.InitiatedBy.Automated || state.Operation.Sync.RolledBackRevision != "" || state.Operation.Sync.DryRun {
+		return nil, false
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	duration, err := app.Spec.SyncPolicy.AutoRollback.GetDuration()
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid auto rollback duration: %v", err)}, false
+	}
+	if time.Since(state.FinishedAt.Time) > duration {
+		return nil, false
+	}
+
+	history := app.Status.History
+	if len(history) < 2 || state.SyncResult == nil || history[len(history)-1].Revision != state.SyncResult.Revision {
+		logCtx.Warnf("Skipping auto rollback: no previous revision to roll back to")
+		return nil, false
+	}
+	current := history[len(history)-1]
+	previous := history[len(history)-2]
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision:           previous.Revision,
+			Source:             previous.Source.DeepCopy(),
+			Prune:              app.Spec.SyncPolicy.Automated.Prune,
+			SyncOptions:        app.Spec.SyncPolicy.SyncOptions,
+			RolledBackRevision: current.Revision,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+	}
+	if app.Spec.SyncPolicy.Retry != nil {
+		op.Retry = *app.Spec.SyncPolicy.Retry
+	}
+
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	_, err = argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate auto rollback to %s: %v", previous.Revision, err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}, false
+	}
+	message := fmt.Sprintf("Initiated automatic rollback to '%s': application became %s after sync to '%s'", previous.Revision, healthStatus.Status, current.Revision)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeWarning}, message)
+	logCtx.Info(message)
+	return nil, true
+}
+
+// lastRolledBackRevision returns the revision rolled back by the most recent sync, if it was an automatic rollback
+func lastRolledBackRevision(app *appv1.Application) string {
+	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil {
+		return ""
+	}
+	return app.Status.OperationState.Operation.Sync.RolledBackRevision
+}
+
 // alreadyAttemptedSync returns whether or not the most recent sync was performed against the
 // commitSHA and with the same app source config which are currently set in the app
 func alreadyAttemptedSync(app *appv1.Application, commitSHA string) (bool, synccommon.OperationPhase) {
@@ -1551,6 +1909,21 @@ func (ctrl *ApplicationController) canProcessApp(obj interface{}) bool {
 	return true
 }
 
+// refreshDependentApps requests the refresh of the applications of this shard which depend on the given application,
+// since they might be automatically synced now
+func (ctrl *ApplicationController) refreshDependentApps(indexer cache.Indexer, app *appv1.Application) {
+	objs, err := indexer.ByIndex(dependsOnIndex, app.Name)
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Failed to list the dependent applications: %v", err)
+		return
+	}
+	for _, obj := range objs {
+		if dependent, ok := obj.(*appv1.Application); ok && ctrl.canProcessApp(dependent) {
+			ctrl.requestAppRefresh(dependent.Name, CompareWithRecent.Pointer(), nil)
+		}
+	}
+}
+
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
@@ -1580,6 +1953,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 
 				return cache.MetaNamespaceIndexFunc(obj)
 			},
+			dependsOnIndex: func(obj interface{}) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok {
+					return nil, nil
+				}
+				return app.Spec.DependsOn, nil
+			},
 			orphanedIndex: func(obj interface{}) (i []string, e error) {
 				app, ok := obj.(*appv1.Application)
 				if !ok {
@@ -1606,11 +1986,18 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				key, err := cache.MetaNamespaceKeyFunc(obj)
 				if err == nil {
-					ctrl.appRefreshQueue.Add(key)
+					ctrl.enqueueAppRefresh(key, nil)
 					ctrl.appOperationQueue.Add(key)
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
+				oldApp, oldOK := old.(*appv1.Application)
+				newApp, newOK := new.(*appv1.Application)
+				if oldOK && newOK && !isDependencyReady(oldApp) && isDependencyReady(newApp) {
+					// every shard watches all the applications, so that the dependent applications are refreshed even
+					// if the dependency is handled by another shard
+					ctrl.refreshDependentApps(informer.GetIndexer(), newApp)
+				}
 				if !ctrl.canProcessApp(new) {
 					return
 				}
@@ -1620,16 +2007,25 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 					return
 				}
 				var compareWith *CompareWith
-				oldApp, oldOK := old.(*appv1.Application)
-				newApp, newOK := new.(*appv1.Application)
 				if oldOK && newOK && automatedSyncEnabled(oldApp, newApp) {
 					log.WithField("application", newApp.Name).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
 				}
+				if oldOK && newOK && oldApp.IsReconcileSkipped() && !newApp.IsReconcileSkipped() {
+					log.WithField("application", newApp.Name).Info("Resumed reconciliation")
+					compareWith = CompareWithLatest.Pointer()
+				}
 				ctrl.requestAppRefresh(newApp.Name, compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
 			},
 			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if app, ok := obj.(*appv1.Application); ok {
+					// the series of deleted applications are removed whichever shard reported them
+					ctrl.metricsServer.DeleteOrphanedResources(app)
+				}
 				if !ctrl.canProcessApp(obj) {
 					return
 				}
@@ -1637,7 +2033,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				// key function.
 				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 				if err == nil {
-					ctrl.appRefreshQueue.Add(key)
+					ctrl.enqueueAppRefresh(key, nil)
 				}
 			},
 		},
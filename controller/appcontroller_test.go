@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
@@ -108,6 +110,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 		data.metricsCacheExpiration,
 		0,
 		nil,
+		AppRefreshRateLimiterConfig{},
 	)
 	if err != nil {
 		panic(err)
@@ -511,6 +514,173 @@ func TestAutoSyncParameterOverrides(t *testing.T) {
 	assert.NotNil(t, app.Operation)
 }
 
+func newFakeAutoRollbackApp() *argoappv1.Application {
+	app := newFakeApp()
+	app.Spec.SyncPolicy.AutoRollback = &argoappv1.SyncPolicyAutoRollback{Duration: "10m"}
+	finishedAt := metav1.Now()
+	app.Status.History = argoappv1.RevisionHistories{
+		{ID: 0, Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Source: *app.Spec.Source.DeepCopy()},
+		{ID: 1, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Source: *app.Spec.Source.DeepCopy()},
+	}
+	app.Status.OperationState = &argoappv1.OperationState{
+		Operation: argoappv1.Operation{
+			Sync:        &argoappv1.SyncOperation{Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+			InitiatedBy: argoappv1.OperationInitiator{Automated: true},
+		},
+		Phase:      synccommon.OperationSucceeded,
+		FinishedAt: &finishedAt,
+		SyncResult: &argoappv1.SyncOperationResult{
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			Source:   *app.Spec.Source.DeepCopy(),
+		},
+	}
+	return app
+}
+
+// TestAutoRollback verifies we roll back to the previous revision when the app is degraded after an automated sync
+func TestAutoRollback(t *testing.T) {
+	app := newFakeAutoRollbackApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	cond, rolledBack := ctrl.autoRollback(app, &argoappv1.HealthStatus{Status: health.HealthStatusDegraded})
+	assert.Nil(t, cond)
+	assert.True(t, rolledBack)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", app.Operation.Sync.Revision)
+	assert.Equal(t, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", app.Operation.Sync.RolledBackRevision)
+	assert.NotNil(t, app.Operation.Sync.Source)
+	assert.True(t, app.Operation.InitiatedBy.Automated)
+}
+
+// TestSkipAutoRollback verifies we do not roll back when the rollback conditions are not met
+func TestSkipAutoRollback(t *testing.T) {
+	degraded := &argoappv1.HealthStatus{Status: health.HealthStatusDegraded}
+	tests := []struct {
+		name   string
+		modify func(app *argoappv1.Application)
+		health *argoappv1.HealthStatus
+	}{
+		{"Healthy", func(app *argoappv1.Application) {}, &argoappv1.HealthStatus{Status: health.HealthStatusHealthy}},
+		{"NoPolicy", func(app *argoappv1.Application) { app.Spec.SyncPolicy.AutoRollback = nil }, degraded},
+		{"ManualSync", func(app *argoappv1.Application) { app.Status.OperationState.Operation.InitiatedBy.Automated = false }, degraded},
+		{"FailedSync", func(app *argoappv1.Application) { app.Status.OperationState.Phase = synccommon.OperationFailed }, degraded},
+		{"DurationElapsed", func(app *argoappv1.Application) {
+			finishedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+			app.Status.OperationState.FinishedAt = &finishedAt
+		}, degraded},
+		{"AlreadyRolledBack", func(app *argoappv1.Application) {
+			app.Status.OperationState.Operation.Sync.RolledBackRevision = "cccccccccccccccccccccccccccccccccccccccc"
+		}, degraded},
+		{"NoPreviousRevision", func(app *argoappv1.Application) { app.Status.History = app.Status.History[1:] }, degraded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newFakeAutoRollbackApp()
+			tt.modify(app)
+			ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+			cond, rolledBack := ctrl.autoRollback(app, tt.health)
+			assert.Nil(t, cond)
+			assert.False(t, rolledBack)
+			app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Nil(t, app.Operation)
+		})
+	}
+}
+
+// TestAutoSyncSkipsRolledBackRevision verifies we do not auto-sync again a revision which was rolled back
+func TestAutoSyncSkipsRolledBackRevision(t *testing.T) {
+	app := newFakeAutoRollbackApp()
+	app.Status.OperationState.Operation.Sync = &argoappv1.SyncOperation{
+		Revision:           "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		RolledBackRevision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	app.Status.OperationState.SyncResult.Revision = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}}
+
+	cond := ctrl.autoSync(app, &syncStatus, resources)
+	assert.NotNil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	// a newer revision is synced
+	syncStatus.Revision = "cccccccccccccccccccccccccccccccccccccccc"
+	cond = ctrl.autoSync(app, &syncStatus, resources)
+	assert.Nil(t, cond)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+}
+
+func TestAutoSyncWaitsForDependencies(t *testing.T) {
+	dependency := newFakeApp()
+	dependency.Name = "infra"
+	dependency.Status.Sync.Status = argoappv1.SyncStatusCodeSynced
+	dependency.Status.Health.Status = health.HealthStatusProgressing
+	app := newFakeApp()
+	app.Spec.DependsOn = []string{"infra"}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency}})
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []argoappv1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: argoappv1.SyncStatusCodeOutOfSync}}
+
+	assert.Equal(t, []string{"infra"}, ctrl.unreadyDependencies(app))
+	cond := ctrl.autoSync(app, &syncStatus, resources)
+	assert.Nil(t, cond)
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, app.Operation)
+
+	// the dependency becomes healthy
+	dependency.Status.Health.Status = health.HealthStatusHealthy
+	assert.NoError(t, ctrl.appInformer.GetIndexer().Update(dependency))
+	assert.Empty(t, ctrl.unreadyDependencies(app))
+	cond = ctrl.autoSync(app, &syncStatus, resources)
+	assert.Nil(t, cond)
+	app, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, app.Operation)
+
+	// missing dependencies are never ready
+	app.Spec.DependsOn = []string{"infra", "missing"}
+	assert.Equal(t, []string{"missing"}, ctrl.unreadyDependencies(app))
+}
+
+func TestRefreshDependentApps(t *testing.T) {
+	dependency := newFakeApp()
+	dependency.Name = "infra"
+	// the dependency is handled by another shard
+	dependency.Spec.Destination.Server = "https://other-shard"
+	app := newFakeApp()
+	app.Spec.DependsOn = []string{"infra"}
+	other := newFakeApp()
+	other.Name = "other-app"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency, other}})
+	ctrl.clusterFilter = func(cluster *argoappv1.Cluster) bool {
+		return cluster != nil
+	}
+	assert.False(t, ctrl.canProcessApp(dependency))
+
+	ctrl.refreshDependentApps(ctrl.appInformer.GetIndexer(), dependency)
+
+	ok, level := ctrl.isRefreshRequested(app.Name)
+	assert.True(t, ok)
+	assert.Equal(t, CompareWithRecent, level)
+	ok, _ = ctrl.isRefreshRequested(other.Name)
+	assert.False(t, ok)
+}
+
 // TestFinalizeAppDeletion verifies application deletion
 func TestFinalizeAppDeletion(t *testing.T) {
 	defaultProj := argoappv1.AppProject{
@@ -671,6 +841,65 @@ func TestFinalizeAppDeletion(t *testing.T) {
 
 	})
 
+	// Ensure the resources pending deletion and their finalizers are reported
+	t.Run("DeletionProgress", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.Destination.Namespace = test.FakeArgoCDNamespace
+		cm := newFakeCM()
+		pendingObj := kube.MustToUnstructured(&cm)
+		pendingObj.SetNamespace(test.FakeArgoCDNamespace)
+		now := metav1.Now()
+		pendingObj.SetDeletionTimestamp(&now)
+		pendingObj.SetFinalizers([]string{"example.com/cleanup"})
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, &defaultProj}, managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+			kube.GetResourceKey(pendingObj): pendingObj,
+		}})
+
+		var patch string
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		defaultReactor := fakeAppCs.ReactionChain[0]
+		fakeAppCs.ReactionChain = nil
+		fakeAppCs.AddReactor("get", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			return defaultReactor.React(action)
+		})
+		fakeAppCs.AddReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patch = string(action.(kubetesting.PatchAction).GetPatch())
+			return true, nil, nil
+		})
+		_, err := ctrl.finalizeApplicationDeletion(app)
+		assert.NoError(t, err)
+		assert.Contains(t, patch, `"type":"DeletionProgress"`)
+		assert.Contains(t, patch, "Deleting sync wave 0: 1 resources remaining in the wave, 1 in total. Waiting for the finalizers of /ConfigMap "+test.FakeArgoCDNamespace+"/test-cm (example.com/cleanup)")
+	})
+}
+
+func TestDeletionProgressMessage(t *testing.T) {
+	newObj := func(name, wave string, finalizers ...string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		obj.SetNamespace("default")
+		if wave != "" {
+			obj.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: wave})
+		}
+		if len(finalizers) > 0 {
+			now := metav1.Now()
+			obj.SetDeletionTimestamp(&now)
+			obj.SetFinalizers(finalizers)
+		}
+		return obj
+	}
+
+	assert.Equal(t, "No resources remaining for deletion", deletionProgressMessage(nil))
+	assert.Equal(t, "Deleting sync wave 2: 2 resources remaining in the wave, 3 in total",
+		deletionProgressMessage([]*unstructured.Unstructured{newObj("a", "2"), newObj("b", ""), newObj("c", "2")}))
+	assert.Equal(t, "Deleting sync wave 0: 1 resources remaining in the wave, 2 in total. Waiting for the finalizers of apps/Deployment default/b (foregroundDeletion, example.com/cleanup)",
+		deletionProgressMessage([]*unstructured.Unstructured{newObj("a", "-1"), newObj("b", "", "foregroundDeletion", "example.com/cleanup")}))
+
+	var objs []*unstructured.Unstructured
+	for i := 0; i < maxDeletionBlockers+2; i++ {
+		objs = append(objs, newObj(fmt.Sprintf("obj-%d", i), "", "foregroundDeletion"))
+	}
+	assert.Contains(t, deletionProgressMessage(objs), "apps/Deployment default/obj-4 (foregroundDeletion); 2 more")
 }
 
 // TestNormalizeApplication verifies we normalize an application during reconciliation
@@ -828,6 +1057,104 @@ func TestGetResourceTree_HasOrphanedResources(t *testing.T) {
 	assert.Equal(t, tree.OrphanedNodes, []argoappv1.ResourceNode{orphanedDeploy1, orphanedDeploy2})
 }
 
+func TestGetResourceTree_ProjectHealthChecks(t *testing.T) {
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ResourceCustomizations = []argoappv1.ProjectResourceCustomization{{
+		Group:     "apps",
+		Kind:      kube.DeploymentKind,
+		HealthLua: `return {status = "Degraded", message = "customized by the project"}`,
+	}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, proj}})
+
+	tree, err := ctrl.getResourceTree(app, []*argoappv1.ResourceDiff{{
+		Name:        "nginx-deployment",
+		Kind:        kube.DeploymentKind,
+		Group:       "apps",
+		LiveState:   test.DeploymentManifest,
+		TargetState: test.DeploymentManifest,
+	}})
+
+	assert.NoError(t, err)
+	if assert.Len(t, tree.Nodes, 1) {
+		assert.Equal(t, &argoappv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "customized by the project"}, tree.Nodes[0].Health)
+	}
+}
+
+func TestPruneAppHistory(t *testing.T) {
+	now := time.Now()
+	finishedAt := metav1.NewTime(now.Add(-2 * time.Hour))
+	app := newFakeApp()
+	app.ResourceVersion = "1"
+	app.Status.History = argoappv1.RevisionHistories{
+		{ID: 0, DeployedAt: metav1.NewTime(now.Add(-3 * time.Hour))},
+		{ID: 1, DeployedAt: finishedAt},
+	}
+	app.Status.OperationState = &argoappv1.OperationState{
+		Phase:      synccommon.OperationSucceeded,
+		FinishedAt: &finishedAt,
+		SyncResult: &argoappv1.SyncOperationResult{Revision: "abc123", Resources: argoappv1.ResourceResults{{Kind: "Pod", Name: "my-pod"}}},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps:          []runtime.Object{app, &defaultProj},
+		configMapData: map[string]string{"application.history.maxEntries": "1", "application.operationState.maxAge": "1h"},
+	})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patch string
+	fakeAppCs.PrependReactor("patch", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		patch = string(action.(kubetesting.PatchAction).GetPatch())
+		return false, nil, nil
+	})
+
+	ctrl.pruneAppHistory(app, now)
+
+	// the patch is rejected if the application changed since
+	assert.Contains(t, patch, `"resourceVersion":"1"`)
+	assert.NotContains(t, patch, "null")
+	updated, err := fakeAppCs.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, updated.Status.History, 1) {
+		assert.Equal(t, int64(1), updated.Status.History[0].ID)
+	}
+	assert.Empty(t, updated.Status.OperationState.SyncResult.Resources)
+	assert.Equal(t, "abc123", updated.Status.OperationState.SyncResult.Revision)
+}
+
+func TestGetResourceTree_OrphanedResourcesWarning(t *testing.T) {
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	warn := true
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{Warn: &warn}
+
+	orphanedDeploy := argoappv1.ResourceNode{
+		ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "deploy1"},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, proj},
+		namespacedResources: map[kube.ResourceKey]namespacedResource{
+			kube.NewResourceKey("apps", "Deployment", "default", "deploy1"): {ResourceNode: orphanedDeploy},
+		},
+	})
+	_, err := ctrl.getResourceTree(app, nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionOrphanedResourceWarning, app.Status.Conditions[0].Type)
+		assert.Equal(t, "Application has 1 orphaned resources: Deployment/deploy1", app.Status.Conditions[0].Message)
+	}
+}
+
+func TestOrphanedResourcesMessage(t *testing.T) {
+	var keys []kube.ResourceKey
+	for i := 0; i < 12; i++ {
+		keys = append(keys, kube.NewResourceKey("", "ConfigMap", "default", fmt.Sprintf("cm-%02d", 11-i)))
+	}
+
+	assert.Equal(t, "Application has 2 orphaned resources: ConfigMap/cm-10, ConfigMap/cm-11", orphanedResourcesMessage(2, keys[:2]))
+	assert.Equal(t, "Application has 14 orphaned resources: ConfigMap/cm-00, ConfigMap/cm-01, ConfigMap/cm-02, ConfigMap/cm-03, "+
+		"ConfigMap/cm-04, ConfigMap/cm-05, ConfigMap/cm-06, ConfigMap/cm-07, ConfigMap/cm-08, ConfigMap/cm-09, and 2 more", orphanedResourcesMessage(14, keys))
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
@@ -1034,6 +1361,37 @@ func TestUpdateReconciledAt(t *testing.T) {
 
 }
 
+func TestProcessAppQueueItems_SkipReconcile(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{argoappv1.AnnotationKeySkipReconcile: "true"}
+	app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	})
+	key, _ := cache.MetaNamespaceKeyFunc(app)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	patched := false
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patched = true
+		return true, nil, nil
+	})
+
+	ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+	ctrl.appRefreshQueue.Add(key)
+	assert.True(t, ctrl.processAppRefreshQueueItem())
+	ctrl.appOperationQueue.Add(key)
+	assert.True(t, ctrl.processAppOperationQueueItem())
+
+	assert.False(t, patched)
+}
+
 func TestFinalizeProjectDeletion_HasApplications(t *testing.T) {
 	app := newFakeApp()
 	proj := &argoappv1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace}}
@@ -1156,6 +1514,44 @@ func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	assert.Equal(t, float64(1), retryCount)
 }
 
+func TestProcessRequestedAppOperation_FailedPhaseHasNoRetries(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "invalid-project"
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{},
+		Retry: argoappv1.RetryStrategy{
+			Limit:  5,
+			Phases: []argoappv1.PhaseRetryStrategy{{Phase: synccommon.SyncPhaseSync, Limit: 0}},
+		},
+	}
+	app.Status.OperationState.Phase = synccommon.OperationRunning
+	app.Status.OperationState.SyncResult.Resources = []*argoappv1.ResourceResult{{
+		Name:      "guestbook",
+		Kind:      "Deployment",
+		Group:     "apps",
+		Status:    synccommon.ResultCodeSyncFailed,
+		SyncPhase: synccommon.SyncPhaseSync,
+	}}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		patchedApp := &v1alpha1.Application{}
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &patchedApp))
+		}
+		return true, patchedApp, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationError), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.NotContains(t, message, "Retrying attempt")
+}
+
 func TestProcessRequestedAppOperation_RunningPreviouslyFailed(t *testing.T) {
 	app := newFakeApp()
 	app.Operation = &argoappv1.Operation{
@@ -2,13 +2,17 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"os"
 	"reflect"
+	"strconv"
 	"sync"
 	"time"
 
 	clustercache "github.com/argoproj/gitops-engine/pkg/cache"
+	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
@@ -23,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	logutils "github.com/argoproj/argo-cd/v2/util/log"
 	"github.com/argoproj/argo-cd/v2/util/lua"
@@ -63,10 +68,14 @@ type LiveStateCache interface {
 	IterateResources(server string, callback func(res *clustercache.Resource, info *ResourceInfo)) error
 	// Returns all top level resources (resources without owner references) of a specified namespace
 	GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]appv1.ResourceNode, error)
+	// Returns the labels of the specified namespace, which are empty if the namespace does not exist
+	GetNamespaceLabels(server string, namespace string) (map[string]string, error)
 	// Starts watching resources of each controlled cluster.
 	Run(ctx context.Context) error
 	// Returns information about monitored clusters
 	GetClustersInfo() []clustercache.ClusterInfo
+	// Stops watching clusters which are no longer handled by the controller and warms up the newly handled ones
+	ReconcileClusters(ctx context.Context) error
 	// Init must be executed before cache can be used
 	Init() error
 }
@@ -96,6 +105,12 @@ type ResourceInfo struct {
 	PodInfo *PodInfo
 	// NodeInfo is available for nodes only
 	NodeInfo *NodeInfo
+	// NamespaceLabels are available for namespaces only
+	NamespaceLabels map[string]string
+
+	// manifestHash is the hash of the resource without the fields whose updates are ignored. It is available only if
+	// the resource updates are ignored
+	manifestHash string
 }
 
 func NewLiveStateCache(
@@ -123,7 +138,13 @@ func NewLiveStateCache(
 
 type cacheSettings struct {
 	clusterSettings     clustercache.Settings
+	resourcesFilter     *settings.ResourcesFilter
 	appInstanceLabelKey string
+	trackingMethod      appv1.TrackingMethod
+
+	// ignoreResourceUpdatesOverrides are the overrides listing the fields whose updates do not trigger the refresh of
+	// the applications, nil if the resource updates are not ignored
+	ignoreResourceUpdatesOverrides map[string]appv1.ResourceOverride
 }
 
 type liveStateCache struct {
@@ -144,11 +165,25 @@ type liveStateCache struct {
 	lock          sync.RWMutex
 }
 
+// getClusterSettings returns the settings of the cache of the given cluster, which filters the resources using both
+// the global and the cluster resource exclusions and inclusions
+func (cs *cacheSettings) getClusterSettings(cluster *appv1.Cluster) clustercache.Settings {
+	clusterSettings := cs.clusterSettings
+	if cs.resourcesFilter != nil {
+		clusterSettings.ResourcesFilter = cs.resourcesFilter.ForCluster(cluster)
+	}
+	return clusterSettings
+}
+
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	appInstanceLabelKey, err := c.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := c.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
 	resourcesFilter, err := c.settingsMgr.GetResourcesFilter()
 	if err != nil {
 		return nil, err
@@ -161,7 +196,24 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
 	}
-	return &cacheSettings{clusterSettings, appInstanceLabelKey}, nil
+	ignoreResourceUpdatesEnabled, err := c.settingsMgr.GetIsIgnoreResourceUpdatesEnabled()
+	if err != nil {
+		return nil, err
+	}
+	var ignoreResourceUpdatesOverrides map[string]appv1.ResourceOverride
+	if ignoreResourceUpdatesEnabled {
+		ignoreResourceUpdatesOverrides = make(map[string]appv1.ResourceOverride)
+		for key, override := range resourceOverrides {
+			ignoreResourceUpdatesOverrides[key] = appv1.ResourceOverride{IgnoreDifferences: override.IgnoreResourceUpdates}
+		}
+	}
+	return &cacheSettings{
+		clusterSettings:                clusterSettings,
+		resourcesFilter:                resourcesFilter,
+		appInstanceLabelKey:            appInstanceLabelKey,
+		trackingMethod:                 argo.ParseTrackingMethod(trackingMethod),
+		ignoreResourceUpdatesOverrides: ignoreResourceUpdatesOverrides,
+	}, nil
 }
 
 func asResourceNode(r *clustercache.Resource) appv1.ResourceNode {
@@ -258,16 +310,55 @@ func skipAppRequeuing(key kube.ResourceKey) bool {
 	return ignoredRefreshResources[key.Group+"/"+key.Kind]
 }
 
+// skipResourceUpdate returns true if only fields whose updates are ignored changed between the old and new versions
+// of a resource and its health did not change
+func skipResourceUpdate(oldInfo, newInfo *ResourceInfo) bool {
+	if oldInfo.manifestHash == "" || oldInfo.manifestHash != newInfo.manifestHash {
+		return false
+	}
+	if oldInfo.Health == nil || newInfo.Health == nil {
+		return oldInfo.Health == nil && newInfo.Health == nil
+	}
+	return oldInfo.Health.Status == newInfo.Health.Status && oldInfo.Health.Message == newInfo.Health.Message
+}
+
+// manifestHash returns the hash of the resource without its resource version, managed fields and the fields removed
+// by the given normalizer
+func manifestHash(un *unstructured.Unstructured, normalizer diff.Normalizer) (string, error) {
+	un = un.DeepCopy()
+	unstructured.RemoveNestedField(un.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(un.Object, "metadata", "managedFields")
+	if err := normalizer.Normalize(un); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(un)
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return strconv.FormatUint(h.Sum64(), 16), nil
+}
+
 func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, error) {
 	c.lock.RLock()
 	clusterCache, ok := c.clusters[server]
-	cacheSettings := c.cacheSettings
 	c.lock.RUnlock()
 
 	if ok {
 		return clusterCache, nil
 	}
 
+	// the cluster is fetched before taking the lock, so that a slow call does not block the other users of the cache
+	cluster, err := c.db.GetCluster(context.Background(), server)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.canHandleCluster(cluster) {
+		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
@@ -275,27 +366,33 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 	if ok {
 		return clusterCache, nil
 	}
+	cacheSettings := c.cacheSettings
 
-	cluster, err := c.db.GetCluster(context.Background(), server)
-	if err != nil {
-		return nil, err
-	}
-
-	if !c.canHandleCluster(cluster) {
-		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
+	var ignoreResourceUpdatesNormalizer diff.Normalizer
+	if cacheSettings.ignoreResourceUpdatesOverrides != nil {
+		ignoreResourceUpdatesNormalizer, err = normalizers.NewIgnoreNormalizer(nil, cacheSettings.ignoreResourceUpdatesOverrides)
+		if err != nil {
+			log.Warnf("Failed to load the ignored resource updates, all the updates are processed: %v", err)
+		}
 	}
 
 	clusterCache = clustercache.NewClusterCache(cluster.RESTConfig(),
 		clustercache.SetListSemaphore(c.listSemaphore),
-		clustercache.SetResyncTimeout(K8SClusterResyncDuration),
-		clustercache.SetSettings(cacheSettings.clusterSettings),
+		clustercache.SetResyncTimeout(clusterResyncDuration(cluster)),
+		clustercache.SetSettings(cacheSettings.getClusterSettings(cluster)),
 		clustercache.SetNamespaces(cluster.Namespaces),
 		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res)
 			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
-			appName := kube.GetAppInstanceLabel(un, cacheSettings.appInstanceLabelKey)
+			if ignoreResourceUpdatesNormalizer != nil {
+				var err error
+				if res.manifestHash, err = manifestHash(un, ignoreResourceUpdatesNormalizer); err != nil {
+					log.Warnf("Failed to compute hash of %s/%s: %v", un.GetKind(), un.GetName(), err)
+				}
+			}
+			appName := argo.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod)
 			if isRoot && appName != "" {
 				res.AppName = appName
 			}
@@ -309,6 +406,10 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 	)
 
 	_ = clusterCache.OnResourceUpdated(func(newRes *clustercache.Resource, oldRes *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) {
+		if newRes != nil && oldRes != nil && skipResourceUpdate(resInfo(oldRes), resInfo(newRes)) {
+			log.Debugf("Ignoring update of %s/%s", newRes.Ref.Kind, newRes.Ref.Name)
+			return
+		}
 		toNotify := make(map[string]bool)
 		var ref v1.ObjectReference
 		if newRes != nil {
@@ -353,12 +454,28 @@ func (c *liveStateCache) getSyncedCluster(server string) (clustercache.ClusterCa
 
 func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	log.Info("invalidating live state cache")
+	// the clusters are listed before taking the lock, so that a slow call does not block the other users of the cache
+	clustersByServer := make(map[string]*appv1.Cluster)
+	if clusters, err := c.db.ListClusters(context.Background()); err != nil {
+		log.Warnf("Failed to list the clusters, their resource exclusions and inclusions are ignored: %v", err)
+	} else {
+		for i := range clusters.Items {
+			clustersByServer[clusters.Items[i].Server] = &clusters.Items[i]
+		}
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
 	c.cacheSettings = cacheSettings
-	for _, clust := range c.clusters {
-		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+	for server, clust := range c.clusters {
+		clusterSettings := cacheSettings.clusterSettings
+		if cluster, ok := clustersByServer[server]; ok {
+			clusterSettings = cacheSettings.getClusterSettings(cluster)
+		} else {
+			log.Warnf("Cluster %s not found, its resource exclusions and inclusions are ignored", server)
+		}
+		clust.Invalidate(clustercache.SetSettings(clusterSettings))
 	}
 	log.Info("live state cache invalidated")
 }
@@ -409,6 +526,22 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server string, namespace
 	return res, nil
 }
 
+func (c *liveStateCache) GetNamespaceLabels(server string, namespace string) (map[string]string, error) {
+	clusterInfo, err := c.getSyncedCluster(server)
+	if err != nil {
+		return nil, err
+	}
+	resources := clusterInfo.FindResources("", func(r *clustercache.Resource) bool {
+		return r.Ref.Kind == kube.NamespaceKind && r.Ref.GroupVersionKind().Group == "" && r.Ref.Name == namespace
+	})
+	for _, r := range resources {
+		if labels := resInfo(r).NamespaceLabels; labels != nil {
+			return labels, nil
+		}
+	}
+	return map[string]string{}, nil
+}
+
 func (c *liveStateCache) GetManagedLiveObjs(a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	clusterInfo, err := c.getSyncedCluster(a.Spec.Destination.Server)
 	if err != nil {
@@ -499,6 +632,14 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 	return nil
 }
 
+// clusterResyncDuration returns the interval of the full resync of the cache of the given cluster
+func clusterResyncDuration(cluster *appv1.Cluster) time.Duration {
+	if cluster.ResyncInterval != nil {
+		return cluster.ResyncInterval.Duration
+	}
+	return K8SClusterResyncDuration
+}
+
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
 	if c.clusterFilter == nil {
 		return true
@@ -548,6 +689,15 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
 			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
 		}
+		if !reflect.DeepEqual(oldCluster.ResourceExclusions, newCluster.ResourceExclusions) || !reflect.DeepEqual(oldCluster.ResourceInclusions, newCluster.ResourceInclusions) {
+			c.lock.RLock()
+			cacheSettings := c.cacheSettings
+			c.lock.RUnlock()
+			updateSettings = append(updateSettings, clustercache.SetSettings(cacheSettings.getClusterSettings(newCluster)))
+		}
+		if !reflect.DeepEqual(oldCluster.ResyncInterval, newCluster.ResyncInterval) {
+			updateSettings = append(updateSettings, clustercache.SetResyncTimeout(clusterResyncDuration(newCluster)))
+		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
 			cluster.GetClusterInfo().LastCacheSyncTime != nil &&
@@ -576,6 +726,22 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 	}
 }
 
+func (c *liveStateCache) ReconcileClusters(ctx context.Context) error {
+	clusters, err := c.db.ListClusters(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if c.canHandleCluster(cluster) {
+			c.handleAddEvent(cluster)
+		} else {
+			c.handleDeleteEvent(cluster.Server)
+		}
+	}
+	return nil
+}
+
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	clusters := make(map[string]clustercache.ClusterCache)
 	c.lock.RLock()
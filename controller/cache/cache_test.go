@@ -2,14 +2,25 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/cache/mocks"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/mock"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
+	dbmocks "github.com/argoproj/argo-cd/v2/util/db/mocks"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 func TestHandleModEvent_HasChanges(t *testing.T) {
@@ -79,6 +90,107 @@ func TestHandleModEvent_NoChanges(t *testing.T) {
 	})
 }
 
+func TestHandleModEvent_ResourceFiltersChanged(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Once()
+
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+		cacheSettings: cacheSettings{resourcesFilter: &settings.ResourcesFilter{}},
+	}
+
+	clustersCache.handleModEvent(&appv1.Cluster{
+		Server: "https://mycluster",
+	}, &appv1.Cluster{
+		Server:             "https://mycluster",
+		ResourceExclusions: []appv1.ClusterResourceFilter{{APIGroups: []string{"noisy.io"}}},
+	})
+
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything)
+}
+
+func TestHandleModEvent_ResyncIntervalChanged(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil).Once()
+	clusterCache.On("EnsureSynced").Return(nil).Once()
+
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+	}
+
+	clustersCache.handleModEvent(&appv1.Cluster{
+		Server: "https://mycluster",
+	}, &appv1.Cluster{
+		Server:         "https://mycluster",
+		ResyncInterval: &metav1.Duration{Duration: 24 * time.Hour},
+	})
+
+	clusterCache.AssertCalled(t, "Invalidate", mock.Anything)
+}
+
+func TestClusterResyncDuration(t *testing.T) {
+	assert.Equal(t, K8SClusterResyncDuration, clusterResyncDuration(&appv1.Cluster{}))
+	assert.Equal(t, 24*time.Hour, clusterResyncDuration(&appv1.Cluster{ResyncInterval: &metav1.Duration{Duration: 24 * time.Hour}}))
+}
+
+func TestCacheSettings_GetClusterSettings(t *testing.T) {
+	globalFilter := &settings.ResourcesFilter{ResourceExclusions: []settings.FilteredResource{{APIGroups: []string{"excluded.io"}}}}
+	cacheSettings := cacheSettings{
+		clusterSettings: cache.Settings{ResourcesFilter: globalFilter},
+		resourcesFilter: globalFilter,
+	}
+
+	clusterSettings := cacheSettings.getClusterSettings(&appv1.Cluster{Server: "https://mycluster"})
+	assert.Same(t, globalFilter, clusterSettings.ResourcesFilter)
+
+	clusterSettings = cacheSettings.getClusterSettings(&appv1.Cluster{
+		Server:             "https://mycluster",
+		ResourceExclusions: []appv1.ClusterResourceFilter{{APIGroups: []string{"noisy.io"}}},
+	})
+	assert.True(t, clusterSettings.ResourcesFilter.IsExcludedResource("excluded.io", "Foo", "https://mycluster"))
+	assert.True(t, clusterSettings.ResourcesFilter.IsExcludedResource("noisy.io", "Foo", "https://mycluster"))
+	assert.False(t, clusterSettings.ResourcesFilter.IsExcludedResource("apps", "Deployment", "https://mycluster"))
+}
+
+func TestInvalidate_DoesNotBlockWhileListingClusters(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil).Once()
+	argoDB := &dbmocks.ArgoDB{}
+	listing := make(chan struct{})
+	release := make(chan struct{})
+	argoDB.On("ListClusters", mock.Anything).Run(func(args mock.Arguments) {
+		close(listing)
+		<-release
+	}).Return(&appv1.ClusterList{Items: []appv1.Cluster{{
+		Server:             "https://mycluster",
+		ResourceExclusions: []appv1.ClusterResourceFilter{{APIGroups: []string{"noisy.io"}}},
+	}}}, nil)
+	clustersCache := liveStateCache{
+		db:       argoDB,
+		clusters: map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		clustersCache.invalidate(cacheSettings{resourcesFilter: &settings.ResourcesFilter{}})
+		close(done)
+	}()
+	<-listing
+	// the cache stays usable while the clusters are listed
+	cluster, err := clustersCache.getCluster("https://mycluster")
+	assert.NoError(t, err)
+	assert.Same(t, clusterCache, cluster)
+	close(release)
+	<-done
+
+	clusterCache.AssertExpectations(t)
+}
+
 func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	clustersCache := liveStateCache{
 		clusters: map[string]cache.ClusterCache{},
@@ -93,3 +205,70 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 
 	assert.Len(t, clustersCache.clusters, 0)
 }
+
+func TestSkipResourceUpdate(t *testing.T) {
+	healthy := &health.HealthStatus{Status: health.HealthStatusHealthy}
+	progressing := &health.HealthStatus{Status: health.HealthStatusProgressing}
+
+	assert.True(t, skipResourceUpdate(&ResourceInfo{manifestHash: "a", Health: healthy}, &ResourceInfo{manifestHash: "a", Health: healthy}))
+	assert.True(t, skipResourceUpdate(&ResourceInfo{manifestHash: "a"}, &ResourceInfo{manifestHash: "a"}))
+	assert.False(t, skipResourceUpdate(&ResourceInfo{manifestHash: "a"}, &ResourceInfo{manifestHash: "b"}))
+	assert.False(t, skipResourceUpdate(&ResourceInfo{}, &ResourceInfo{}))
+	assert.False(t, skipResourceUpdate(&ResourceInfo{manifestHash: "a", Health: healthy}, &ResourceInfo{manifestHash: "a", Health: progressing}))
+	assert.False(t, skipResourceUpdate(&ResourceInfo{manifestHash: "a", Health: healthy}, &ResourceInfo{manifestHash: "a"}))
+}
+
+func TestManifestHash(t *testing.T) {
+	normalizer, err := normalizers.NewIgnoreNormalizer(nil, map[string]appv1.ResourceOverride{
+		"apps/Deployment": {IgnoreDifferences: appv1.OverrideIgnoreDiff{JSONPointers: []string{"/status"}}},
+	})
+	require.NoError(t, err)
+
+	deployment := test.NewDeployment()
+	hash, err := manifestHash(deployment, normalizer)
+	require.NoError(t, err)
+
+	updated := deployment.DeepCopy()
+	updated.SetResourceVersion("2")
+	require.NoError(t, unstructured.SetNestedField(updated.Object, int64(2), "status", "observedGeneration"))
+	updatedHash, err := manifestHash(updated, normalizer)
+	require.NoError(t, err)
+	assert.Equal(t, hash, updatedHash)
+
+	require.NoError(t, unstructured.SetNestedField(updated.Object, int64(5), "spec", "replicas"))
+	updatedHash, err = manifestHash(updated, normalizer)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, updatedHash)
+}
+
+func TestGetNamespaceLabels(t *testing.T) {
+	nsResource := &cache.Resource{
+		Ref:  v1.ObjectReference{APIVersion: "v1", Kind: "Namespace", Name: "payments"},
+		Info: &ResourceInfo{NamespaceLabels: map[string]string{"team": "payments"}},
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("EnsureSynced").Return(nil)
+	clusterCache.On("FindResources", "", mock.Anything).Return(func(namespace string, predicates ...func(r *cache.Resource) bool) map[kube.ResourceKey]*cache.Resource {
+		res := map[kube.ResourceKey]*cache.Resource{}
+		for _, predicate := range predicates {
+			if !predicate(nsResource) {
+				return res
+			}
+		}
+		res[nsResource.ResourceKey()] = nsResource
+		return res
+	})
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+	}
+
+	labels, err := clustersCache.GetNamespaceLabels("https://mycluster", "payments")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments"}, labels)
+
+	labels, err = clustersCache.GetNamespaceLabels("https://mycluster", "other")
+	require.NoError(t, err)
+	assert.Empty(t, labels)
+}
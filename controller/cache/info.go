@@ -37,6 +37,8 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 		case "Node":
 			populateHostNodeInfo(un, res)
 			return
+		case kube.NamespaceKind:
+			res.NamespaceLabels = un.GetLabels()
 		}
 	case "extensions", "networking.k8s.io":
 		switch gvk.Kind {
@@ -258,6 +258,21 @@ status:
 	}, info.NodeInfo)
 }
 
+func TestGetNamespaceInfo(t *testing.T) {
+	namespace := strToUnstructured(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: payments
+  labels:
+    team: payments
+`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(namespace, info)
+	assert.Equal(t, map[string]string{"team": "payments"}, info.NamespaceLabels)
+}
+
 func TestGetServiceInfo(t *testing.T) {
 	info := &ResourceInfo{}
 	populateNodeInfo(testService, info)
@@ -89,6 +89,29 @@ func (_m *LiveStateCache) GetManagedLiveObjs(a *v1alpha1.Application, targetObjs
 	return r0, r1
 }
 
+// GetNamespaceLabels provides a mock function with given fields: server, namespace
+func (_m *LiveStateCache) GetNamespaceLabels(server string, namespace string) (map[string]string, error) {
+	ret := _m.Called(server, namespace)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string, string) map[string]string); ok {
+		r0 = rf(server, namespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(server, namespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNamespaceTopLevelResources provides a mock function with given fields: server, namespace
 func (_m *LiveStateCache) GetNamespaceTopLevelResources(server string, namespace string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
 	ret := _m.Called(server, namespace)
@@ -205,6 +228,20 @@ func (_m *LiveStateCache) IterateResources(server string, callback func(*cache.R
 	return r0
 }
 
+// ReconcileClusters provides a mock function with given fields: ctx
+func (_m *LiveStateCache) ReconcileClusters(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *LiveStateCache) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
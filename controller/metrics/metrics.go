@@ -31,7 +31,9 @@ type MetricsServer struct {
 	clusterEventsCounter    *prometheus.CounterVec
 	redisRequestCounter     *prometheus.CounterVec
 	reconcileHistogram      *prometheus.HistogramVec
+	refreshQueueHistogram   *prometheus.HistogramVec
 	redisRequestHistogram   *prometheus.HistogramVec
+	orphanedResourcesGauge  *prometheus.GaugeVec
 	registry                *prometheus.Registry
 	hostname                string
 	cron                    *cron.Cron
@@ -113,6 +115,15 @@ var (
 		[]string{"namespace", "dest_server"},
 	)
 
+	refreshQueueHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_app_refresh_queue_wait",
+			Help:    "Time applications waited in the refresh queue before being reconciled.",
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120},
+		},
+		[]string{"namespace", "dest_server"},
+	)
+
 	clusterEventsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "argocd_cluster_events_total",
 		Help: "Number of processes k8s resource events.",
@@ -134,6 +145,11 @@ var (
 		},
 		[]string{"hostname", "initiator"},
 	)
+
+	orphanedResourcesGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_orphaned_resources_count",
+		Help: "Number of orphaned resources per application.",
+	}, []string{"namespace", "name", "project"})
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -157,9 +173,11 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(kubectlExecCounter)
 	registry.MustRegister(kubectlExecPendingGauge)
 	registry.MustRegister(reconcileHistogram)
+	registry.MustRegister(refreshQueueHistogram)
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(orphanedResourcesGauge)
 
 	return &MetricsServer{
 		registry: registry,
@@ -172,9 +190,11 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		kubectlExecCounter:      kubectlExecCounter,
 		kubectlExecPendingGauge: kubectlExecPendingGauge,
 		reconcileHistogram:      reconcileHistogram,
+		refreshQueueHistogram:   refreshQueueHistogram,
 		clusterEventsCounter:    clusterEventsCounter,
 		redisRequestCounter:     redisRequestCounter,
 		redisRequestHistogram:   redisRequestHistogram,
+		orphanedResourcesGauge:  orphanedResourcesGauge,
 		hostname:                hostname,
 		cron:                    cron.New(),
 	}, nil
@@ -239,6 +259,22 @@ func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.D
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
 }
 
+// ObserveAppRefreshQueueWait observes the time an application waited in the refresh queue
+func (m *MetricsServer) ObserveAppRefreshQueueWait(app *argoappv1.Application, duration time.Duration) {
+	m.refreshQueueHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
+}
+
+// SetOrphanedResources sets the number of orphaned resources of an application
+func (m *MetricsServer) SetOrphanedResources(app *argoappv1.Application, count int) {
+	m.orphanedResourcesGauge.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject()).Set(float64(count))
+}
+
+// DeleteOrphanedResources removes the number of orphaned resources of an application, e.g. once the monitoring of
+// orphaned resources is disabled
+func (m *MetricsServer) DeleteOrphanedResources(app *argoappv1.Application) {
+	m.orphanedResourcesGauge.DeleteLabelValues(app.Namespace, app.Name, app.Spec.GetProject())
+}
+
 // HasExpiration return true if expiration is set
 func (m *MetricsServer) HasExpiration() bool {
 	return len(m.cron.Entries()) > 0
@@ -259,7 +295,9 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.clusterEventsCounter.Reset()
 		m.redisRequestCounter.Reset()
 		m.reconcileHistogram.Reset()
+		m.refreshQueueHistogram.Reset()
 		m.redisRequestHistogram.Reset()
+		m.orphanedResourcesGauge.Reset()
 	})
 	if err != nil {
 		return err
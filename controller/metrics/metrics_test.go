@@ -303,6 +303,67 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestAppRefreshQueueWaitMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck)
+	assert.NoError(t, err)
+
+	queueWaitMetrics := `
+# HELP argocd_app_refresh_queue_wait Time applications waited in the refresh queue before being reconciled.
+# TYPE argocd_app_refresh_queue_wait histogram
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="0.1"} 0
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="0.5"} 0
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="1"} 0
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="5"} 0
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="10"} 1
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="30"} 1
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="60"} 1
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="120"} 1
+argocd_app_refresh_queue_wait_bucket{dest_server="https://localhost:6443",namespace="argocd",le="+Inf"} 1
+argocd_app_refresh_queue_wait_sum{dest_server="https://localhost:6443",namespace="argocd"} 7
+argocd_app_refresh_queue_wait_count{dest_server="https://localhost:6443",namespace="argocd"} 1
+`
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.ObserveAppRefreshQueueWait(fakeApp, 7*time.Second)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, queueWaitMetrics, body)
+}
+
+func TestOrphanedResourcesMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck)
+	assert.NoError(t, err)
+
+	orphanedResources := `
+# HELP argocd_app_orphaned_resources_count Number of orphaned resources per application.
+# TYPE argocd_app_orphaned_resources_count gauge
+argocd_app_orphaned_resources_count{name="my-app",namespace="argocd",project="important-project"} 3
+`
+	fakeApp := newFakeApp(fakeApp)
+	metricsServ.SetOrphanedResources(fakeApp, 3)
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	body := rr.Body.String()
+	assertMetricsPrinted(t, orphanedResources, body)
+
+	metricsServ.DeleteOrphanedResources(fakeApp)
+	rr = httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.NotContains(t, rr.Body.String(), "argocd_app_orphaned_resources_count{")
+}
+
 func TestMetricsReset(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
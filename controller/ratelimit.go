@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// AppRefreshRateLimiterConfig configures the token buckets limiting how often applications are refreshed. A bucket
+// with a zero QPS is disabled.
+type AppRefreshRateLimiterConfig struct {
+	// GlobalQPS is the number of refreshes per second allowed across all the applications
+	GlobalQPS float64
+	// GlobalBurst is the number of refreshes allowed across all the applications before GlobalQPS kicks in
+	GlobalBurst int
+	// AppQPS is the number of refreshes per second allowed for a single application
+	AppQPS float64
+	// AppBurst is the number of refreshes allowed for a single application before AppQPS kicks in
+	AppBurst int
+}
+
+func newLimiter(qps float64, burst int) *rate.Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(qps), burst)
+}
+
+// appRefreshRateLimiter is a workqueue.RateLimiter delaying the refreshes of the applications using a global and a
+// per application token bucket. Refreshes requested while an application is already waiting for its turn are merged
+// into the pending one and don't consume any token.
+type appRefreshRateLimiter struct {
+	config AppRefreshRateLimiterConfig
+	global *rate.Limiter
+	now    func() time.Time
+
+	lock sync.Mutex
+	apps map[interface{}]*appRefreshRateLimit
+}
+
+type appRefreshRateLimit struct {
+	limiter *rate.Limiter
+	// pendingUntil is the time at which the last scheduled refresh of the application is due
+	pendingUntil time.Time
+}
+
+func newAppRefreshRateLimiter(config AppRefreshRateLimiterConfig) *appRefreshRateLimiter {
+	limiter := &appRefreshRateLimiter{
+		config: config,
+		now:    time.Now,
+		apps:   make(map[interface{}]*appRefreshRateLimit),
+	}
+	if config.GlobalQPS > 0 {
+		limiter.global = newLimiter(config.GlobalQPS, config.GlobalBurst)
+	}
+	return limiter
+}
+
+var _ workqueue.RateLimiter = &appRefreshRateLimiter{}
+
+// When returns how long the given application must wait before being refreshed
+func (r *appRefreshRateLimiter) When(item interface{}) time.Duration {
+	if r.global == nil && r.config.AppQPS <= 0 {
+		return 0
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+	limit, ok := r.apps[item]
+	if !ok {
+		limit = &appRefreshRateLimit{}
+		if r.config.AppQPS > 0 {
+			limit.limiter = newLimiter(r.config.AppQPS, r.config.AppBurst)
+		}
+		r.apps[item] = limit
+	}
+	if limit.pendingUntil.After(now) {
+		return limit.pendingUntil.Sub(now)
+	}
+
+	var delay time.Duration
+	if limit.limiter != nil {
+		delay = limit.limiter.ReserveN(now, 1).DelayFrom(now)
+	}
+	if r.global != nil {
+		if globalDelay := r.global.ReserveN(now, 1).DelayFrom(now); globalDelay > delay {
+			delay = globalDelay
+		}
+	}
+	limit.pendingUntil = now.Add(delay)
+	return delay
+}
+
+// Forget drops the state kept for the given application, e.g. once it has been deleted
+func (r *appRefreshRateLimiter) Forget(item interface{}) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.apps, item)
+}
+
+// NumRequeues always returns zero since refreshes are not retried
+func (r *appRefreshRateLimiter) NumRequeues(_ interface{}) int {
+	return 0
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestAppRefreshRateLimiter(config AppRefreshRateLimiterConfig) (*appRefreshRateLimiter, *time.Time) {
+	now := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newAppRefreshRateLimiter(config)
+	limiter.now = func() time.Time {
+		return now
+	}
+	return limiter, &now
+}
+
+func TestAppRefreshRateLimiter_Disabled(t *testing.T) {
+	limiter, _ := newTestAppRefreshRateLimiter(AppRefreshRateLimiterConfig{})
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Duration(0), limiter.When("argocd/my-app"))
+	}
+	assert.Empty(t, limiter.apps)
+}
+
+func TestAppRefreshRateLimiter_PerApp(t *testing.T) {
+	limiter, now := newTestAppRefreshRateLimiter(AppRefreshRateLimiterConfig{AppQPS: 0.1, AppBurst: 2})
+
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/my-app"))
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/my-app"))
+	// burst is exhausted
+	assert.Equal(t, 10*time.Second, limiter.When("argocd/my-app"))
+	// the pending refresh absorbs the following requests
+	*now = now.Add(4 * time.Second)
+	assert.Equal(t, 6*time.Second, limiter.When("argocd/my-app"))
+
+	// other applications are not affected
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/other-app"))
+
+	*now = now.Add(6 * time.Second)
+	assert.Equal(t, 10*time.Second, limiter.When("argocd/my-app"))
+
+	limiter.Forget("argocd/my-app")
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/my-app"))
+}
+
+func TestAppRefreshRateLimiter_Global(t *testing.T) {
+	limiter, _ := newTestAppRefreshRateLimiter(AppRefreshRateLimiterConfig{GlobalQPS: 1, GlobalBurst: 1})
+
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/app-1"))
+	assert.Equal(t, 1*time.Second, limiter.When("argocd/app-2"))
+	assert.Equal(t, 2*time.Second, limiter.When("argocd/app-3"))
+	// already pending
+	assert.Equal(t, 1*time.Second, limiter.When("argocd/app-2"))
+}
+
+func TestAppRefreshRateLimiter_PerAppAndGlobal(t *testing.T) {
+	limiter, _ := newTestAppRefreshRateLimiter(AppRefreshRateLimiterConfig{GlobalQPS: 1, GlobalBurst: 1, AppQPS: 0.5, AppBurst: 1})
+
+	assert.Equal(t, time.Duration(0), limiter.When("argocd/app-1"))
+	// the per app token is available but the global one isn't
+	assert.Equal(t, 1*time.Second, limiter.When("argocd/app-2"))
+	// app-1 waits for the later of its own token and the next global one
+	assert.Equal(t, 2*time.Second, limiter.When("argocd/app-1"))
+	assert.Equal(t, 3*time.Second, limiter.When("argocd/app-3"))
+}
@@ -0,0 +1,465 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// heartbeatsKey is the config map key holding the last heartbeat time of every replica
+	heartbeatsKey = "heartbeats"
+	// assignmentKey is the config map key holding the replica assigned to every cluster
+	assignmentKey = "assignment"
+	// ownersKey is the config map key holding the replica currently handling every cluster
+	ownersKey = "owners"
+	// heartbeatMisses is the number of missed heartbeats after which a replica is considered gone
+	heartbeatMisses = 3
+	// rebalanceThreshold is the relative load difference between the most and the least loaded replicas
+	// above which already assigned clusters are moved
+	rebalanceThreshold = 0.2
+)
+
+// ClusterSharding dynamically distributes clusters across the live application controller replicas.
+//
+// Every replica periodically records a heartbeat in the argocd-app-controller-shard-cm config map. The first live
+// replica (in lexical order) assigns the clusters to the live replicas, balancing them by their number of resources,
+// and stores the assignment in the same config map so that all the replicas share it. A cluster is only handled by the
+// replica recorded as its owner: the previous owner of a reassigned cluster stops handling it and releases it before
+// the replica it is assigned to claims it, so that a cluster never has two owners.
+type ClusterSharding struct {
+	kubeClient        kubernetes.Interface
+	namespace         string
+	replica           string
+	heartbeatInterval time.Duration
+	listClusters      func() ([]v1alpha1.Cluster, error)
+	clusterLoad       func(server string) int64
+	now               func() time.Time
+
+	lock          sync.RWMutex
+	replicas      []string
+	owned         map[string]bool
+	lastHeartbeat time.Time
+}
+
+// NewClusterSharding returns the dynamic sharding of the given replica. listClusters returns all the clusters to
+// distribute and clusterLoad the number of resources of a cluster.
+func NewClusterSharding(
+	kubeClient kubernetes.Interface,
+	namespace string,
+	replica string,
+	heartbeatInterval time.Duration,
+	listClusters func() ([]v1alpha1.Cluster, error),
+	clusterLoad func(server string) int64,
+) *ClusterSharding {
+	return &ClusterSharding{
+		kubeClient:        kubeClient,
+		namespace:         namespace,
+		replica:           replica,
+		heartbeatInterval: heartbeatInterval,
+		listClusters:      listClusters,
+		clusterLoad:       clusterLoad,
+		now:               time.Now,
+		owned:             map[string]bool{},
+	}
+}
+
+// Init records the first heartbeat of the replica and claims the clusters assigned to it.
+func (s *ClusterSharding) Init(ctx context.Context) error {
+	_, err := s.heartbeat(ctx, func() {})
+	return err
+}
+
+// Run records a heartbeat every heartbeat interval and calls onChange whenever the set of live replicas or the
+// clusters handled by the replica changed. onChange is called before the released clusters are recorded as such, so
+// it must stop handling them before returning. The replica releases its clusters and removes its heartbeat when the
+// context is done, and stops handling them if it could not record its heartbeats for almost as long as the other
+// replicas wait before considering it gone.
+func (s *ClusterSharding) Run(ctx context.Context, onChange func()) {
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			leaveCtx, cancel := context.WithTimeout(context.Background(), s.heartbeatInterval)
+			if err := s.leave(leaveCtx, onChange); err != nil {
+				log.Warnf("Failed to remove heartbeat of replica %s: %v", s.replica, err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+			changed, err := s.heartbeat(ctx, onChange)
+			if err != nil {
+				log.Warnf("Failed to update cluster sharding: %v", err)
+				if s.fence() {
+					log.Warnf("Replica %s failed to record its heartbeats, releasing all its clusters", s.replica)
+					onChange()
+				}
+				continue
+			}
+			if changed {
+				log.Infof("Cluster sharding changed, live replicas: %v", s.Replicas())
+				onChange()
+			}
+		}
+	}
+}
+
+// Replicas returns the live replicas in lexical order.
+func (s *ClusterSharding) Replicas() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return append([]string{}, s.replicas...)
+}
+
+// IsClusterHandled returns true if the replica owns the given cluster.
+func (s *ClusterSharding) IsClusterHandled(c *v1alpha1.Cluster) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	// cluster might be nil if app is using invalid cluster URL, assume the first replica handles it
+	if c == nil {
+		return len(s.replicas) > 0 && s.replicas[0] == s.replica
+	}
+	return s.owned[c.Server]
+}
+
+// fence stops handling all the clusters if the replica has not recorded a heartbeat for one heartbeat interval less
+// than the other replicas wait before considering it gone and claiming its clusters. Returns true if clusters were
+// released.
+func (s *ClusterSharding) fence() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.owned == nil || s.now().Sub(s.lastHeartbeat) < (heartbeatMisses-1)*s.heartbeatInterval {
+		return false
+	}
+	s.owned = nil
+	s.replicas = nil
+	return true
+}
+
+// pinnedReplica returns the replica handling the clusters pinned to the given shard. The replica is picked using
+// rendezvous hashing, so that the pinned clusters only move when the replica they are assigned to is gone.
+func pinnedReplica(shard int64, replicas []string) string {
+	key := strconv.FormatInt(shard, 10)
+	var res string
+	var resHash uint64
+	for _, replica := range replicas {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(replica + "/" + key))
+		if hash := h.Sum64(); res == "" || hash > resHash {
+			res, resHash = replica, hash
+		}
+	}
+	return res
+}
+
+func containsReplica(replicas []string, replica string) bool {
+	for _, r := range replicas {
+		if r == replica {
+			return true
+		}
+	}
+	return false
+}
+
+// release stops handling the given clusters before their release is recorded in the config map.
+func (s *ClusterSharding) release(servers []string, onChange func()) {
+	if len(servers) == 0 {
+		return
+	}
+	s.lock.Lock()
+	released := false
+	for _, server := range servers {
+		if s.owned[server] {
+			delete(s.owned, server)
+			released = true
+		}
+	}
+	s.lock.Unlock()
+	if released {
+		log.Infof("Releasing clusters %v", servers)
+		onChange()
+	}
+}
+
+// updateShardConfigMap applies the given update to the shard config map, retrying on conflicts with the other
+// replicas.
+func (s *ClusterSharding) updateShardConfigMap(ctx context.Context, update func(state *shardState) error) error {
+	configMaps := s.kubeClient.CoreV1().ConfigMaps(s.namespace)
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierr.IsConflict(err) || apierr.IsAlreadyExists(err)
+	}, func() error {
+		cm, err := configMaps.Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		create := false
+		if apierr.IsNotFound(err) {
+			create = true
+			cm = &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDAppControllerShardConfigMapName,
+				Namespace: s.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			}}
+		} else if err != nil {
+			return err
+		}
+		state, err := parseShardConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		if err := update(state); err != nil {
+			return err
+		}
+		if err := writeShardConfigMap(cm, state); err != nil {
+			return err
+		}
+		if create {
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+		} else {
+			_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		}
+		return err
+	})
+}
+
+func (s *ClusterSharding) heartbeat(ctx context.Context, onChange func()) (bool, error) {
+	var replicas []string
+	var owned map[string]bool
+	var now time.Time
+	err := s.updateShardConfigMap(ctx, func(state *shardState) error {
+		now = s.now()
+		state.heartbeats[s.replica] = now
+		replicas = liveReplicas(state.heartbeats, now.Add(-heartbeatMisses*s.heartbeatInterval))
+		if replicas[0] == s.replica {
+			clusters, err := s.listClusters()
+			if err != nil {
+				return fmt.Errorf("failed to list clusters: %w", err)
+			}
+			state.assignment = assignClusters(clusters, replicas, state.assignment, s.clusterLoad)
+		}
+		assignment, owners := state.assignment, state.owners
+
+		var released []string
+		owned = map[string]bool{}
+		for server, owner := range owners {
+			switch {
+			case !containsReplica(replicas, owner):
+				// the owner is gone and can't release its clusters anymore
+				delete(owners, server)
+			case owner == s.replica && assignment[server] != s.replica:
+				released = append(released, server)
+				delete(owners, server)
+			}
+		}
+		s.release(released, onChange)
+		for server, replica := range assignment {
+			if replica != s.replica {
+				continue
+			}
+			// the cluster is claimed once its previous owner released it
+			if _, ok := owners[server]; !ok {
+				owners[server] = s.replica
+			}
+			if owners[server] == s.replica {
+				owned[server] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	changed := !reflect.DeepEqual(s.replicas, replicas) || !reflect.DeepEqual(s.owned, owned)
+	s.replicas = replicas
+	s.owned = owned
+	s.lastHeartbeat = now
+	return changed, nil
+}
+
+// leave stops handling the clusters of the replica, then releases them and removes the heartbeat of the replica so
+// that the other replicas take them over without waiting for the replica to be considered gone.
+func (s *ClusterSharding) leave(ctx context.Context, onChange func()) error {
+	s.lock.Lock()
+	handled := s.owned != nil
+	s.owned = nil
+	s.replicas = nil
+	s.lock.Unlock()
+	if handled {
+		onChange()
+	}
+	return s.updateShardConfigMap(ctx, func(state *shardState) error {
+		delete(state.heartbeats, s.replica)
+		for server, owner := range state.owners {
+			if owner == s.replica {
+				delete(state.owners, server)
+			}
+		}
+		return nil
+	})
+}
+
+// shardState is the content of the shard config map
+type shardState struct {
+	// heartbeats holds the last heartbeat time of every replica
+	heartbeats map[string]time.Time
+	// assignment holds the replica every cluster is assigned to
+	assignment map[string]string
+	// owners holds the replica currently handling every cluster
+	owners map[string]string
+}
+
+func parseShardConfigMap(cm *v1.ConfigMap) (*shardState, error) {
+	state := &shardState{heartbeats: map[string]time.Time{}, assignment: map[string]string{}, owners: map[string]string{}}
+	for key, value := range map[string]interface{}{heartbeatsKey: &state.heartbeats, assignmentKey: &state.assignment, ownersKey: &state.owners} {
+		if data, ok := cm.Data[key]; ok {
+			if err := json.Unmarshal([]byte(data), value); err != nil {
+				return nil, fmt.Errorf("failed to parse key '%s' of config map %s: %w", key, cm.Name, err)
+			}
+		}
+	}
+	return state, nil
+}
+
+func writeShardConfigMap(cm *v1.ConfigMap, state *shardState) error {
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	for key, value := range map[string]interface{}{heartbeatsKey: state.heartbeats, assignmentKey: state.assignment, ownersKey: state.owners} {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		cm.Data[key] = string(data)
+	}
+	return nil
+}
+
+// liveReplicas removes the replicas which did not send a heartbeat since the given deadline and returns the others
+// in lexical order.
+func liveReplicas(heartbeats map[string]time.Time, deadline time.Time) []string {
+	var replicas []string
+	for replica, heartbeat := range heartbeats {
+		if heartbeat.Before(deadline) {
+			delete(heartbeats, replica)
+			continue
+		}
+		replicas = append(replicas, replica)
+	}
+	sort.Strings(replicas)
+	return replicas
+}
+
+// assignClusters assigns the given clusters to the replicas. Clusters keep their previous replica if it is still
+// live, the others are assigned to the least loaded replicas. Clusters are then moved from the most loaded replica
+// to the least loaded one as long as the load difference exceeds the rebalance threshold. The load of a cluster is
+// its number of resources plus one so that empty clusters are distributed as well.
+func assignClusters(clusters []v1alpha1.Cluster, replicas []string, previous map[string]string, clusterLoad func(server string) int64) map[string]string {
+	sorted := make([]v1alpha1.Cluster, len(clusters))
+	copy(sorted, clusters)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Server < sorted[j].Server
+	})
+
+	loads := map[string]int64{}
+	for _, replica := range replicas {
+		loads[replica] = 0
+	}
+	clusterLoads := map[string]int64{}
+	assignment := map[string]string{}
+	var unassigned []v1alpha1.Cluster
+	for _, c := range sorted {
+		load := clusterLoad(c.Server) + 1
+		clusterLoads[c.Server] = load
+		var replica string
+		if c.Shard != nil {
+			replica = pinnedReplica(*c.Shard, replicas)
+		} else if r, ok := previous[c.Server]; ok && containsReplica(replicas, r) {
+			replica = r
+		} else {
+			unassigned = append(unassigned, c)
+			continue
+		}
+		assignment[c.Server] = replica
+		loads[replica] += load
+	}
+
+	sort.SliceStable(unassigned, func(i, j int) bool {
+		return clusterLoads[unassigned[i].Server] > clusterLoads[unassigned[j].Server]
+	})
+	for _, c := range unassigned {
+		replica := leastLoadedReplica(replicas, loads)
+		assignment[c.Server] = replica
+		loads[replica] += clusterLoads[c.Server]
+	}
+
+	for range sorted {
+		most, least := mostLoadedReplica(replicas, loads), leastLoadedReplica(replicas, loads)
+		diff := loads[most] - loads[least]
+		if float64(diff) <= rebalanceThreshold*float64(loads[most]) {
+			break
+		}
+		// move the cluster which brings the two replicas the closest to each other
+		var candidate string
+		var candidateLoad int64
+		for _, c := range sorted {
+			load := clusterLoads[c.Server]
+			if c.Shard != nil || assignment[c.Server] != most || load >= diff {
+				continue
+			}
+			if candidate == "" || abs(diff-2*load) < abs(diff-2*candidateLoad) {
+				candidate, candidateLoad = c.Server, load
+			}
+		}
+		if candidate == "" {
+			break
+		}
+		assignment[candidate] = least
+		loads[most] -= candidateLoad
+		loads[least] += candidateLoad
+	}
+	return assignment
+}
+
+func leastLoadedReplica(replicas []string, loads map[string]int64) string {
+	res := replicas[0]
+	for _, replica := range replicas[1:] {
+		if loads[replica] < loads[res] {
+			res = replica
+		}
+	}
+	return res
+}
+
+func mostLoadedReplica(replicas []string, loads map[string]int64) string {
+	res := replicas[0]
+	for _, replica := range replicas[1:] {
+		if loads[replica] > loads[res] {
+			res = replica
+		}
+	}
+	return res
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,267 @@
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func loadsOf(loads map[string]int64) func(server string) int64 {
+	return func(server string) int64 {
+		return loads[server]
+	}
+}
+
+func clustersOf(servers ...string) []v1alpha1.Cluster {
+	var clusters []v1alpha1.Cluster
+	for _, server := range servers {
+		clusters = append(clusters, v1alpha1.Cluster{ID: server, Server: server})
+	}
+	return clusters
+}
+
+func TestAssignClusters_BalancedByResources(t *testing.T) {
+	clusters := clustersOf("a", "b", "c", "d")
+	assignment := assignClusters(clusters, []string{"r-0", "r-1"}, nil, loadsOf(map[string]int64{"a": 100, "b": 60, "c": 40, "d": 0}))
+
+	assert.Equal(t, map[string]string{"a": "r-0", "b": "r-1", "c": "r-1", "d": "r-0"}, assignment)
+}
+
+func TestAssignClusters_KeepsPreviousAssignment(t *testing.T) {
+	clusters := clustersOf("a", "b", "c")
+	previous := map[string]string{"a": "r-1", "b": "r-0", "c": "r-1"}
+	assignment := assignClusters(clusters, []string{"r-0", "r-1"}, previous, loadsOf(map[string]int64{"a": 10, "b": 12, "c": 11}))
+
+	assert.Equal(t, previous, assignment)
+}
+
+func TestAssignClusters_RebalancesNewReplica(t *testing.T) {
+	clusters := clustersOf("a", "b", "c", "d")
+	previous := map[string]string{"a": "r-0", "b": "r-0", "c": "r-0", "d": "r-0"}
+	assignment := assignClusters(clusters, []string{"r-0", "r-1"}, previous, loadsOf(map[string]int64{"a": 10, "b": 10, "c": 10, "d": 10}))
+
+	counts := map[string]int{}
+	for _, replica := range assignment {
+		counts[replica]++
+	}
+	assert.Equal(t, map[string]int{"r-0": 2, "r-1": 2}, counts)
+}
+
+func TestAssignClusters_RebalancesResourceCountChange(t *testing.T) {
+	clusters := clustersOf("a", "b", "c")
+	previous := map[string]string{"a": "r-0", "b": "r-0", "c": "r-1"}
+	assignment := assignClusters(clusters, []string{"r-0", "r-1"}, previous, loadsOf(map[string]int64{"a": 50, "b": 40, "c": 1}))
+
+	assert.Equal(t, map[string]string{"a": "r-0", "b": "r-1", "c": "r-1"}, assignment)
+}
+
+func TestAssignClusters_ReassignsGoneReplica(t *testing.T) {
+	clusters := clustersOf("a", "b")
+	previous := map[string]string{"a": "r-0", "b": "r-2"}
+	assignment := assignClusters(clusters, []string{"r-0", "r-1"}, previous, loadsOf(map[string]int64{}))
+
+	assert.Equal(t, map[string]string{"a": "r-0", "b": "r-1"}, assignment)
+}
+
+func TestAssignClusters_PinnedShard(t *testing.T) {
+	shard := int64(3)
+	clusters := clustersOf("a", "b")
+	clusters[0].Shard = &shard
+	replicas := []string{"r-0", "r-1"}
+	previous := map[string]string{"a": "r-0"}
+	assignment := assignClusters(clusters, replicas, previous, loadsOf(map[string]int64{"a": 100}))
+
+	pinned := pinnedReplica(shard, replicas)
+	assert.Equal(t, pinned, assignment["a"])
+	assert.NotEqual(t, pinned, assignment["b"])
+}
+
+func TestPinnedReplica_OnlyMovesToNewReplica(t *testing.T) {
+	replicas := []string{"r-0", "r-1", "r-2"}
+	withNewReplica := append(append([]string{}, replicas...), "r-3")
+	moved := 0
+	for shard := int64(0); shard < 100; shard++ {
+		replica := pinnedReplica(shard, replicas)
+		assert.Contains(t, replicas, replica)
+		if newReplica := pinnedReplica(shard, withNewReplica); newReplica != replica {
+			assert.Equal(t, "r-3", newReplica)
+			moved++
+		}
+	}
+	assert.Greater(t, moved, 0)
+	assert.Less(t, moved, 50)
+}
+
+func TestLiveReplicas(t *testing.T) {
+	now := time.Now()
+	heartbeats := map[string]time.Time{"r-1": now, "r-0": now.Add(-time.Second), "r-2": now.Add(-time.Minute)}
+
+	assert.Equal(t, []string{"r-0", "r-1"}, liveReplicas(heartbeats, now.Add(-30*time.Second)))
+	assert.NotContains(t, heartbeats, "r-2")
+}
+
+func getShardConfigMap(t *testing.T, kubeClient kubernetes.Interface) (map[string]time.Time, map[string]string, map[string]string) {
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	state, err := parseShardConfigMap(cm)
+	require.NoError(t, err)
+	return state.heartbeats, state.assignment, state.owners
+}
+
+func TestClusterSharding(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	clusters := clustersOf("a", "b", "c", "d")
+	loads := loadsOf(map[string]int64{"a": 10, "b": 10, "c": 10, "d": 10})
+	listClusters := func() ([]v1alpha1.Cluster, error) {
+		return clusters, nil
+	}
+	now := time.Now()
+	newSharding := func(replica string) *ClusterSharding {
+		s := NewClusterSharding(kubeClient, "argocd", replica, 10*time.Second, listClusters, loads)
+		s.now = func() time.Time {
+			return now
+		}
+		return s
+	}
+	handled := func(s *ClusterSharding) []string {
+		var res []string
+		for i := range clusters {
+			if s.IsClusterHandled(&clusters[i]) {
+				res = append(res, clusters[i].Server)
+			}
+		}
+		return res
+	}
+	first := newSharding("r-0")
+	second := newSharding("r-1")
+
+	require.NoError(t, first.Init(context.Background()))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, handled(first))
+	assert.True(t, first.IsClusterHandled(nil))
+
+	require.NoError(t, second.Init(context.Background()))
+	assert.Equal(t, []string{"r-0", "r-1"}, second.Replicas())
+	assert.Empty(t, handled(second))
+
+	// the first replica assigns half of the clusters to the second one, and stops handling them before releasing them
+	var releasedBeforeWrite []string
+	changed, err := first.heartbeat(context.Background(), func() {
+		_, _, owners := getShardConfigMap(t, kubeClient)
+		for _, c := range clusters {
+			if !first.IsClusterHandled(&c) && owners[c.Server] == "r-0" {
+				releasedBeforeWrite = append(releasedBeforeWrite, c.Server)
+			}
+		}
+	})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Len(t, releasedBeforeWrite, 2)
+	assert.Len(t, handled(first), 2)
+	assert.Empty(t, handled(second))
+
+	// the second replica claims the released clusters
+	changed, err = second.heartbeat(context.Background(), func() {})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.ElementsMatch(t, releasedBeforeWrite, handled(second))
+	assert.Empty(t, intersect(handled(first), handled(second)))
+	assert.False(t, second.IsClusterHandled(nil))
+	_, _, owners := getShardConfigMap(t, kubeClient)
+	assert.Len(t, owners, 4)
+
+	changed, err = second.heartbeat(context.Background(), func() {})
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// the second replica takes over all the clusters once the first one is gone
+	now = now.Add(time.Minute)
+	changed, err = second.heartbeat(context.Background(), func() {})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"r-1"}, second.Replicas())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, handled(second))
+
+	heartbeats, _, owners := getShardConfigMap(t, kubeClient)
+	assert.NotContains(t, heartbeats, "r-0")
+	assert.Equal(t, map[string]string{"a": "r-1", "b": "r-1", "c": "r-1", "d": "r-1"}, owners)
+
+	// the first replica stops handling its clusters since it could not record its heartbeats
+	assert.True(t, first.fence())
+	assert.Empty(t, handled(first))
+	assert.False(t, first.fence())
+}
+
+func TestClusterSharding_RetriesOnConflict(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	conflicts := 0
+	kubeClient.PrependReactor("update", "configmaps", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		if conflicts < 2 {
+			conflicts++
+			return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "configmaps"}, common.ArgoCDAppControllerShardConfigMapName, nil)
+		}
+		return false, nil, nil
+	})
+	listClusters := func() ([]v1alpha1.Cluster, error) {
+		return clustersOf("a"), nil
+	}
+	s := NewClusterSharding(kubeClient, "argocd", "r-0", 10*time.Second, listClusters, loadsOf(nil))
+	require.NoError(t, s.Init(context.Background()))
+
+	_, err := s.heartbeat(context.Background(), func() {})
+	require.NoError(t, err)
+	assert.Equal(t, 2, conflicts)
+	assert.True(t, s.IsClusterHandled(&v1alpha1.Cluster{Server: "a"}))
+}
+
+func TestClusterSharding_Leave(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	listClusters := func() ([]v1alpha1.Cluster, error) {
+		return clustersOf("a", "b"), nil
+	}
+	s := NewClusterSharding(kubeClient, "argocd", "r-0", 10*time.Second, listClusters, loadsOf(nil))
+	require.NoError(t, s.Init(context.Background()))
+	assert.True(t, s.IsClusterHandled(&v1alpha1.Cluster{Server: "a"}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := 0
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx, func() {
+			changes++
+		})
+		close(done)
+	}()
+	cancel()
+	<-done
+
+	assert.Equal(t, 1, changes)
+	assert.False(t, s.IsClusterHandled(&v1alpha1.Cluster{Server: "a"}))
+	heartbeats, _, owners := getShardConfigMap(t, kubeClient)
+	assert.Empty(t, heartbeats)
+	assert.Empty(t, owners)
+}
+
+func intersect(a []string, b []string) []string {
+	var res []string
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				res = append(res, x)
+			}
+		}
+	}
+	return res
+}
@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
@@ -51,3 +53,15 @@ func GetClusterFilter(replicas int, shard int) func(c *v1alpha1.Cluster) bool {
 		return clusterShard == shard
 	}
 }
+
+// WithClusterSelector restricts the given cluster filter, which may be nil, to the clusters whose labels match the given
+// selector. This allows dedicating controllers to some clusters, e.g. to the largest ones.
+func WithClusterSelector(selector labels.Selector, filter func(c *v1alpha1.Cluster) bool) func(c *v1alpha1.Cluster) bool {
+	return func(c *v1alpha1.Cluster) bool {
+		// cluster might be nil if app is using invalid cluster URL, leave it to the sharding
+		if c != nil && !selector.Matches(labels.Set(c.Labels)) {
+			return false
+		}
+		return filter == nil || filter(c)
+	}
+}
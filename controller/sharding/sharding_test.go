@@ -6,6 +6,8 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func TestGetShardByID_NotEmptyID(t *testing.T) {
@@ -27,3 +29,20 @@ func TestGetClusterFilter(t *testing.T) {
 	assert.False(t, filter(&v1alpha1.Cluster{ID: "3"}))
 	assert.True(t, filter(&v1alpha1.Cluster{ID: "4"}))
 }
+
+func TestWithClusterSelector(t *testing.T) {
+	selector, err := labels.Parse("tier=large")
+	require.NoError(t, err)
+
+	filter := WithClusterSelector(selector, nil)
+	assert.True(t, filter(&v1alpha1.Cluster{ID: "1", Labels: map[string]string{"tier": "large"}}))
+	assert.False(t, filter(&v1alpha1.Cluster{ID: "2", Labels: map[string]string{"tier": "small"}}))
+	assert.False(t, filter(&v1alpha1.Cluster{ID: "3"}))
+	assert.True(t, filter(nil))
+
+	filter = WithClusterSelector(selector, GetClusterFilter(2, 1))
+	assert.False(t, filter(&v1alpha1.Cluster{ID: "1", Labels: map[string]string{"tier": "large"}}))
+	assert.True(t, filter(&v1alpha1.Cluster{ID: "2", Labels: map[string]string{"tier": "large"}}))
+	assert.False(t, filter(&v1alpha1.Cluster{ID: "4", Labels: map[string]string{"tier": "small"}}))
+	assert.False(t, filter(nil))
+}
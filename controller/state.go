@@ -2,9 +2,12 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	gosync "sync"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/diff"
@@ -16,11 +19,13 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
@@ -30,10 +35,12 @@ import (
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/argo/managedfields"
 	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	"github.com/argoproj/argo-cd/v2/util/stats"
 )
@@ -62,6 +69,8 @@ type managedResource struct {
 type AppStateManager interface {
 	CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localObjects []string) *comparisonResult
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
+	// SyncWaveDelay returns the time left before the next sync wave of the running sync of the application
+	SyncWaveDelay(app *v1alpha1.Application) time.Duration
 }
 
 type comparisonResult struct {
@@ -98,9 +107,12 @@ type appStateManager struct {
 	cache                *appstatecache.Cache
 	namespace            string
 	statusRefreshTimeout time.Duration
+
+	syncWavesLock  gosync.Mutex
+	nextSyncWaveAt map[string]time.Time
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey string, trackingMethod v1alpha1.TrackingMethod, revision string, noCache, noRevisionCache, verifySignature bool, proj *v1alpha1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
 	ts := stats.NewTimingStats()
 	helmRepos, err := m.db.ListHelmRepositories(context.Background())
 	if err != nil {
@@ -124,6 +136,14 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	if err != nil {
 		return nil, nil, err
 	}
+	jsonnetLibRepos, err := argo.GetJsonnetLibRepos(context.Background(), m.db, proj, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	helmGitDependencyRepos, err := argo.GetHelmGitDependencyRepos(context.Background(), m.db, proj)
+	if err != nil {
+		return nil, nil, err
+	}
 	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, nil, err
@@ -134,7 +154,7 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		revision = source.TargetRevision
 	}
 
-	plugins, err := m.settingsMgr.GetConfigManagementPlugins()
+	plugins, err := m.settingsMgr.GetConfigManagementPluginsWithSecrets()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -148,7 +168,15 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 	if err != nil {
 		return nil, nil, err
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source, proj)
+	if err != nil {
+		return nil, nil, err
+	}
+	helmSettings, err := m.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return nil, nil, err
+	}
+	helmOptions, err := helmSettings.GetOptions(app.Spec.Source, proj)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -158,22 +186,33 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("version_ms")
+	manifestGenerationTimeout, err := app.GetManifestGenerationTimeout()
+	if err != nil {
+		return nil, nil, err
+	}
 	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-		Repo:              repo,
-		Repos:             permittedHelmRepos,
-		Revision:          revision,
-		NoCache:           noCache,
-		NoRevisionCache:   noRevisionCache,
-		AppLabelKey:       appLabelKey,
-		AppName:           app.Name,
-		Namespace:         app.Spec.Destination.Namespace,
-		ApplicationSource: &source,
-		Plugins:           tools,
-		KustomizeOptions:  kustomizeOptions,
-		KubeVersion:       serverVersion,
-		ApiVersions:       argo.APIGroupsToVersions(apiGroups),
-		VerifySignature:   verifySignature,
-		HelmRepoCreds:     permittedHelmCredentials,
+		Repo:                             repo,
+		Repos:                            permittedHelmRepos,
+		Revision:                         revision,
+		NoCache:                          noCache,
+		NoRevisionCache:                  noRevisionCache,
+		AppLabelKey:                      appLabelKey,
+		TrackingMethod:                   string(trackingMethod),
+		AppName:                          app.Name,
+		Namespace:                        app.Spec.Destination.Namespace,
+		ApplicationSource:                &source,
+		Plugins:                          tools,
+		KustomizeOptions:                 kustomizeOptions,
+		KubeVersion:                      serverVersion,
+		ApiVersions:                      argo.APIGroupsToVersions(apiGroups),
+		VerifySignature:                  verifySignature,
+		HelmRepoCreds:                    permittedHelmCredentials,
+		ChartSignatureVerification:       proj.Spec.ChartSignatureVerification,
+		ManifestGenerationTimeoutSeconds: int64(manifestGenerationTimeout.Seconds()),
+		JsonnetLibRepos:                  jsonnetLibRepos,
+		HelmGitDependencyRepos:           helmGitDependencyRepos,
+		HelmOptions:                      helmOptions,
+		SopsKeySet:                       proj.Spec.SopsKeySet,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -264,6 +303,10 @@ func (m *appStateManager) getComparisonSettings(app *appv1.Application) (string,
 	if err != nil {
 		return "", nil, nil, nil, err
 	}
+	// the destination cluster is validated later on, the global filter is good enough if it cannot be found
+	if cluster, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server); err == nil {
+		resFilter = resFilter.ForCluster(cluster)
+	}
 	return appLabelKey, resourceOverrides, diffNormalizer, resFilter, nil
 }
 
@@ -358,12 +401,90 @@ func (m *appStateManager) diffArrayCached(configArray []*unstructured.Unstructur
 	return &diffResultList, nil
 }
 
+// diffArrayPersisted diffs the target and live objects, reusing the diffs persisted in the cache for the same revision,
+// target objects and comparison settings whose live objects did not change since, e.g. before a restart of the
+// controller
+func (m *appStateManager) diffArrayPersisted(appName, revision string, configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, comparisonSettings []interface{}, opts ...diff.Option) (*diff.DiffResultList, error) {
+	hash, err := diffsHash(configArray, comparisonSettings)
+	if err != nil {
+		log.Warnf("Failed to hash the state of application %s, not using the persisted diffs: %v", appName, err)
+		return diff.DiffArray(configArray, liveArray, opts...)
+	}
+	var cachedDiffs []*appv1.ResourceDiff
+	if err := m.cache.GetAppDiffs(appName, revision, hash, &cachedDiffs); err != nil {
+		cachedDiffs = nil
+	}
+	diffResultList, err := m.diffArrayCached(configArray, liveArray, cachedDiffs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	diffs := make([]*appv1.ResourceDiff, len(diffResultList.Diffs))
+	for i, dr := range diffResultList.Diffs {
+		obj, resourceVersion := liveArray[i], ""
+		if obj != nil {
+			resourceVersion = obj.GetResourceVersion()
+		} else {
+			obj = configArray[i]
+		}
+		key := kube.GetResourceKey(obj)
+		diffs[i] = &appv1.ResourceDiff{
+			Group:           key.Group,
+			Kind:            key.Kind,
+			Namespace:       key.Namespace,
+			Name:            key.Name,
+			ResourceVersion: resourceVersion,
+			Modified:        dr.Modified,
+		}
+		// the states of secrets contain their data in plain text, and are never used as is since the diffs of the
+		// managed secrets are computed again once their data is hidden
+		if key.Kind != kube.SecretKind || key.Group != "" {
+			diffs[i].NormalizedLiveState = string(dr.NormalizedLive)
+			diffs[i].PredictedLiveState = string(dr.PredictedLive)
+		}
+	}
+	if err := m.cache.SetAppDiffs(appName, revision, hash, diffs); err != nil {
+		log.Warnf("Failed to persist the diffs of application %s: %v", appName, err)
+	}
+	return diffResultList, nil
+}
+
+// diffsHash returns a hash of the target objects and the comparison settings
+func diffsHash(configArray []*unstructured.Unstructured, comparisonSettings []interface{}) (string, error) {
+	h := sha256.New()
+	for _, setting := range comparisonSettings {
+		data, err := json.Marshal(setting)
+		if err != nil {
+			return "", err
+		}
+		_, _ = h.Write(data)
+		_, _ = h.Write([]byte{0})
+	}
+	for i := range configArray {
+		data, err := json.Marshal(configArray[i])
+		if err != nil {
+			return "", err
+		}
+		_, _ = h.Write(data)
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// syncRevision returns the revision of the generated manifests, empty for local manifests
+func syncRevision(manifestInfo *apiclient.ManifestResponse) string {
+	if manifestInfo == nil {
+		return ""
+	}
+	return manifestInfo.Revision
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
 func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, noRevisionCache bool, localManifests []string) *comparisonResult {
 	ts := stats.NewTimingStats()
 	appLabelKey, resourceOverrides, diffNormalizer, resFilter, err := m.getComparisonSettings(app)
+	trackingMethod := argo.GetTrackingMethod(m.settingsMgr)
 	ts.AddCheckpoint("settings_ms")
 
 	// return unknown comparison result if basic comparison settings cannot be loaded
@@ -395,7 +516,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	now := metav1.Now()
 
 	if len(localManifests) == 0 {
-		targetObjs, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache, noRevisionCache, verifySignature, project)
+		targetObjs, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, trackingMethod, revision, noCache, noRevisionCache, verifySignature, project)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
@@ -443,6 +564,24 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 			})
 		}
 	}
+	manifestPolicy, err := manifestpolicy.Compile(project.Spec.ManifestPolicy)
+	var violations []string
+	if err == nil {
+		violations, err = manifestPolicy.Violations(targetObjs)
+	}
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionManifestPolicyError,
+			Message:            fmt.Sprintf("Failed to evaluate the manifest policy of project %s: %v", project.Name, err),
+			LastTransitionTime: &now,
+		})
+	} else if len(violations) > 0 {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{
+			Type:               v1alpha1.ApplicationConditionManifestPolicyError,
+			Message:            fmt.Sprintf("Manifests violate the manifest policy of project %s: %s", project.Name, manifestpolicy.FormatViolations(violations)),
+			LastTransitionTime: &now,
+		})
+	}
 	ts.AddCheckpoint("dedup_ms")
 
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(app, targetObjs)
@@ -462,7 +601,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
-			appInstanceName := kubeutil.GetAppInstanceLabel(liveObj, appLabelKey)
+			appInstanceName := argo.GetAppName(liveObj, appLabelKey, trackingMethod)
 			if appInstanceName != "" && appInstanceName != app.Name {
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
@@ -497,11 +636,22 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	_, refreshRequested := app.IsRefreshRequested()
 	noCache = noCache || refreshRequested || app.Status.Expired(m.statusRefreshTimeout)
 
+	diffTargets, diffLives := reconciliation.Target, reconciliation.Live
+	if compareOptions.IgnoreFieldsOwnedByOtherManagers {
+		normalizedTargets, normalizedLives, err := managedfields.NormalizeAll(reconciliation.Target, reconciliation.Live, compareOptions.ArgoCDFieldManagers)
+		if err != nil {
+			logCtx.Warnf("Failed to ignore the fields owned by other managers: %v", err)
+		} else {
+			diffTargets, diffLives = normalizedTargets, normalizedLives
+		}
+	}
+
 	if noCache || specChanged || revisionChanged || m.cache.GetAppManagedResources(app.Name, &cachedDiff) != nil {
 		// (rare) cache miss
-		diffResults, err = diff.DiffArray(reconciliation.Target, reconciliation.Live, diffOpts...)
+		diffResults, err = m.diffArrayPersisted(app.Name, syncRevision(manifestInfo), diffTargets, diffLives,
+			[]interface{}{app.Spec.IgnoreDifferences, resourceOverrides, compareOptions}, diffOpts...)
 	} else {
-		diffResults, err = m.diffArrayCached(reconciliation.Target, reconciliation.Live, cachedDiff, diffOpts...)
+		diffResults, err = m.diffArrayCached(diffTargets, diffLives, cachedDiff, diffOpts...)
 	}
 
 	if err != nil {
@@ -606,7 +756,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	}
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app)
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, project.ResourceOverrides(resourceOverrides), app)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 	}
@@ -618,6 +768,12 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		conditions = append(conditions, verifyGnuPGSignature(revision, project, manifestInfo)...)
 	}
 
+	if manifestInfo != nil {
+		for _, warning := range manifestInfo.Warnings {
+			conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionManifestGenerationWarning, Message: warning, LastTransitionTime: &now})
+		}
+	}
+
 	compRes := comparisonResult{
 		syncStatus:           &syncStatus,
 		healthStatus:         healthStatus,
@@ -631,41 +787,93 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
 	}
 	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionComparisonError:         true,
-		appv1.ApplicationConditionSharedResourceWarning:   true,
-		appv1.ApplicationConditionRepeatedResourceWarning: true,
-		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionComparisonError:           true,
+		appv1.ApplicationConditionSharedResourceWarning:     true,
+		appv1.ApplicationConditionRepeatedResourceWarning:   true,
+		appv1.ApplicationConditionExcludedResourceWarning:   true,
+		appv1.ApplicationConditionManifestGenerationWarning: true,
+		appv1.ApplicationConditionManifestPolicyError:       true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
 	return &compRes
 }
 
-func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, startedAt metav1.Time) error {
-	var nextID int64
-	if len(app.Status.History) > 0 {
-		nextID = app.Status.History.LastRevisionHistory().ID + 1
-	}
-	app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-		Revision:        revision,
-		DeployedAt:      metav1.NewTime(time.Now().UTC()),
-		DeployStartedAt: &startedAt,
-		ID:              nextID,
-		Source:          source,
+func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, startedAt metav1.Time, rolledBackRevision string) error {
+	retention, err := m.settingsMgr.GetAppHistoryRetention()
+	if err != nil {
+		log.WithField("application", app.Name).Warnf("Failed to get the history retention settings, using the defaults: %v", err)
+		retention = &settings.DefaultAppHistoryRetention
+	}
+	appClient := m.appclientset.ArgoprojV1alpha1().Applications(m.namespace)
+	history := app.Status.History
+	// the whole history is replaced, so the patch is rejected if the history changed meanwhile, in which case the entry
+	// is added to the latest history
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var nextID int64
+		if len(history) > 0 {
+			nextID = history.LastRevisionHistory().ID + 1
+		}
+		app.Status.History = append(history[:len(history):len(history)], v1alpha1.RevisionHistory{
+			Revision:           revision,
+			DeployedAt:         metav1.NewTime(time.Now().UTC()),
+			DeployStartedAt:    &startedAt,
+			ID:                 nextID,
+			Source:             source,
+			RolledBackRevision: rolledBackRevision,
+		})
+		pruneRevisionHistory(app, retention, time.Now())
+
+		patch, err := resourceVersionGuardedPatch(app.ResourceVersion, map[string]interface{}{"history": app.Status.History})
+		if err != nil {
+			return err
+		}
+		updated, err := appClient.Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if apierr.IsConflict(err) {
+			if latest, getErr := appClient.Get(context.Background(), app.Name, metav1.GetOptions{}); getErr == nil {
+				app.ResourceVersion = latest.ResourceVersion
+				history = latest.Status.History
+			}
+		} else if err == nil {
+			app.ResourceVersion = updated.ResourceVersion
+		}
+		return err
 	})
+}
+
+// resourceVersionGuardedPatch returns a merge patch of the given status fields which is rejected with a conflict if the
+// application changed since the given resource version
+func resourceVersionGuardedPatch(resourceVersion string, status map[string]interface{}) ([]byte, error) {
+	patch := map[string]interface{}{"status": status}
+	if resourceVersion != "" {
+		patch["metadata"] = map[string]interface{}{"resourceVersion": resourceVersion}
+	}
+	return json.Marshal(patch)
+}
 
-	app.Status.History = app.Status.History.Trunc(app.Spec.GetRevisionHistoryLimit())
+// pruneRevisionHistory removes the revision history entries exceeding the history limit of the application, or the
+// configured default limit, as well as the entries older than the configured max age
+func pruneRevisionHistory(app *v1alpha1.Application, retention *settings.AppHistoryRetention, now time.Time) {
+	maxEntries := retention.MaxEntries
+	if app.Spec.RevisionHistoryLimit != nil {
+		maxEntries = app.Spec.GetRevisionHistoryLimit()
+	}
+	app.Status.History = app.Status.History.Trunc(maxEntries)
+	if retention.MaxAge > 0 {
+		app.Status.History = app.Status.History.TruncBefore(now.Add(-retention.MaxAge))
+	}
+}
 
-	patch, err := json.Marshal(map[string]map[string][]v1alpha1.RevisionHistory{
-		"status": {
-			"history": app.Status.History,
-		},
-	})
-	if err != nil {
-		return err
+// pruneOperationState removes the resource results of the completed operation once it is older than the configured
+// max age. The phase, message and revision of the operation are kept since automated sync relies on them.
+func pruneOperationState(app *v1alpha1.Application, retention *settings.AppHistoryRetention, now time.Time) {
+	opState := app.Status.OperationState
+	if retention.OperationStateMaxAge <= 0 || opState == nil || !opState.Phase.Completed() || opState.FinishedAt == nil || opState.SyncResult == nil {
+		return
+	}
+	if opState.FinishedAt.Add(retention.OperationStateMaxAge).Before(now) && len(opState.SyncResult.Resources) > 0 {
+		opState.SyncResult.Resources = v1alpha1.ResourceResults{}
 	}
-	_, err = m.appclientset.ArgoprojV1alpha1().Applications(m.namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
-	return err
 }
 
 // NewAppStateManager creates new instance of AppStateManager
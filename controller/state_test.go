@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -13,14 +15,19 @@ import (
 	. "github.com/argoproj/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubetesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 // TestCompareAppStateEmpty tests comparison when both git and live have no objects
@@ -246,6 +253,57 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Equal(t, 4, len(compRes.resources))
 }
 
+func TestCompareAppStateManifestGenerationWarnings(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+			Warnings:  []string{"Ignored \"values.yaml\" since it could not be parsed as a Kubernetes manifest"},
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+
+	assert.NotNil(t, compRes)
+	assert.Equal(t, 1, len(app.Status.Conditions))
+	assert.Equal(t, argoappv1.ApplicationConditionManifestGenerationWarning, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Ignored \"values.yaml\" since it could not be parsed as a Kubernetes manifest", app.Status.Conditions[0].Message)
+}
+
+func TestCompareAppStateManifestPolicy(t *testing.T) {
+	pod := NewPod()
+	pod.SetAnnotations(map[string]string{"example.com/owner": "team-a"})
+	assert.NoError(t, unstructured.SetNestedField(pod.Object, true, "spec", "hostNetwork"))
+	podBytes, _ := json.Marshal(pod)
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(podBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ManifestPolicy = &argoappv1.ManifestPolicy{
+		RequiredAnnotations: []string{"example.com/owner", "example.com/cost-center"},
+		ForbiddenFields:     []argoappv1.ForbiddenManifestField{{Kind: "Pod", JQPathExpression: ".spec.hostNetwork", Value: "true"}},
+	}
+	compRes := ctrl.appStateManager.CompareAppState(app, proj, "", app.Spec.Source, false, false, nil)
+
+	assert.NotNil(t, compRes)
+	conditions := app.Status.GetConditions(map[argoappv1.ApplicationConditionType]bool{argoappv1.ApplicationConditionManifestPolicyError: true})
+	if assert.Len(t, conditions, 1) {
+		assert.Equal(t, "Manifests violate the manifest policy of project default: Pod/my-pod is missing the required annotation example.com/cost-center; Pod/my-pod sets the forbidden field .spec.hostNetwork", conditions[0].Message)
+	}
+}
+
 var defaultProj = argoappv1.AppProject{
 	ObjectMeta: metav1.ObjectMeta{
 		Name:      "default",
@@ -424,6 +482,63 @@ func TestSetManagedResourcesKnownOrphanedResourceExceptions(t *testing.T) {
 	assert.Equal(t, "guestbook", tree.OrphanedNodes[0].Name)
 }
 
+func Test_appStateManager_diffArrayPersisted(t *testing.T) {
+	ctrl := newFakeController(&fakeData{})
+	manager := ctrl.appStateManager.(*appStateManager)
+	target := test.NewDeployment()
+	live := test.NewDeployment()
+	live.SetResourceVersion("1")
+	_ = unstructured.SetNestedField(target.Object, int64(5), "spec", "replicas")
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kube.SecretKind,
+		"metadata":   map[string]interface{}{"name": "my-secret", "namespace": "default"},
+		"data":       map[string]interface{}{"password": "czNjcjN0"},
+	}}
+	targets, lives := []*unstructured.Unstructured{target, nil, secret}, []*unstructured.Unstructured{live, NewPod(), secret}
+	settings := []interface{}{"settings"}
+
+	diffs, err := manager.diffArrayPersisted("my-app", "abc123", targets, lives, settings)
+	assert.NoError(t, err)
+	assert.True(t, diffs.Modified)
+	assert.Len(t, diffs.Diffs, 3)
+
+	hash, err := diffsHash(targets, settings)
+	assert.NoError(t, err)
+	assert.Len(t, hash, 64)
+	var cachedDiffs []*argoappv1.ResourceDiff
+	assert.NoError(t, manager.cache.GetAppDiffs("my-app", "abc123", hash, &cachedDiffs))
+	if assert.Len(t, cachedDiffs, 3) {
+		assert.True(t, cachedDiffs[0].Modified)
+		assert.Equal(t, "nginx-deployment", cachedDiffs[0].Name)
+		assert.Equal(t, "1", cachedDiffs[0].ResourceVersion)
+		assert.NotEmpty(t, cachedDiffs[0].NormalizedLiveState)
+		// the states of secrets are not persisted
+		assert.Equal(t, "my-secret", cachedDiffs[2].Name)
+		assert.False(t, cachedDiffs[2].Modified)
+		assert.Empty(t, cachedDiffs[2].NormalizedLiveState)
+		assert.Empty(t, cachedDiffs[2].PredictedLiveState)
+	}
+
+	// the persisted diffs are reused for the same state
+	cachedDiffs[0].Modified = false
+	assert.NoError(t, manager.cache.SetAppDiffs("my-app", "abc123", hash, cachedDiffs))
+	diffs, err = manager.diffArrayPersisted("my-app", "abc123", targets, lives, settings)
+	assert.NoError(t, err)
+	assert.False(t, diffs.Diffs[0].Modified)
+
+	// but not once a live object changed
+	live.SetResourceVersion("2")
+	diffs, err = manager.diffArrayPersisted("my-app", "abc123", targets, lives, settings)
+	assert.NoError(t, err)
+	assert.True(t, diffs.Diffs[0].Modified)
+
+	// nor once the comparison settings changed
+	newHash, err := diffsHash(targets, []interface{}{"other settings"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, newHash)
+}
+
 func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	app := newFakeApp()
 	ctrl := newFakeController(&fakeData{
@@ -435,7 +550,7 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 		app.Spec.RevisionHistoryLimit = &i
 	}
 	addHistory := func() {
-		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1.Time{})
+		err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1.Time{}, "")
 		assert.NoError(t, err)
 	}
 	addHistory()
@@ -471,11 +586,119 @@ func Test_appStateManager_persistRevisionHistory(t *testing.T) {
 	assert.Len(t, app.Status.History, 9)
 
 	metav1NowTime := metav1.NewTime(time.Now())
-	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1NowTime)
+	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1NowTime, "")
 	assert.NoError(t, err)
 	assert.Equal(t, app.Status.History.LastRevisionHistory().DeployStartedAt, &metav1NowTime)
 }
 
+func Test_appStateManager_persistRevisionHistory_Conflict(t *testing.T) {
+	app := newFakeApp()
+	app.ResourceVersion = "1"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	manager := ctrl.appStateManager.(*appStateManager)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patches []string
+	fakeAppCs.PrependReactor("patch", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		patches = append(patches, string(action.(kubetesting.PatchAction).GetPatch()))
+		if len(patches) > 1 {
+			return false, nil, nil
+		}
+		// another sync added a history entry meanwhile
+		latest := app.DeepCopy()
+		latest.ResourceVersion = "2"
+		latest.Status.History = argoappv1.RevisionHistories{{ID: 0, Revision: "concurrent"}}
+		assert.NoError(t, fakeAppCs.Tracker().Update(argoappv1.SchemeGroupVersion.WithResource("applications"), latest, app.Namespace))
+		return true, nil, apierr.NewConflict(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, app.Name, errors.New("the object has been modified"))
+	})
+
+	err := manager.persistRevisionHistory(app, "my-revision", argoappv1.ApplicationSource{}, metav1.Time{}, "")
+
+	assert.NoError(t, err)
+	if assert.Len(t, patches, 2) {
+		assert.Contains(t, patches[0], `"resourceVersion":"1"`)
+		assert.Contains(t, patches[1], `"resourceVersion":"2"`)
+	}
+	// the entry is added to the latest history rather than replacing it
+	updated, err := fakeAppCs.ArgoprojV1alpha1().Applications(app.Namespace).Get(context.Background(), app.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, updated.Status.History, 2) {
+		assert.Equal(t, "concurrent", updated.Status.History[0].Revision)
+		assert.Equal(t, "my-revision", updated.Status.History[1].Revision)
+		assert.Equal(t, int64(1), updated.Status.History[1].ID)
+	}
+}
+
+func Test_pruneRevisionHistory(t *testing.T) {
+	now := time.Now()
+	newApp := func() *argoappv1.Application {
+		app := newFakeApp()
+		for i := 0; i < 5; i++ {
+			app.Status.History = append(app.Status.History, argoappv1.RevisionHistory{
+				ID:         int64(i),
+				DeployedAt: metav1.NewTime(now.Add(time.Duration(i-5) * time.Hour)),
+			})
+		}
+		return app
+	}
+
+	app := newApp()
+	pruneRevisionHistory(app, &settings.AppHistoryRetention{MaxEntries: 3}, now)
+	assert.Len(t, app.Status.History, 3)
+	assert.Equal(t, int64(4), app.Status.History.LastRevisionHistory().ID)
+
+	app = newApp()
+	limit := int64(4)
+	app.Spec.RevisionHistoryLimit = &limit
+	pruneRevisionHistory(app, &settings.AppHistoryRetention{MaxEntries: 3}, now)
+	assert.Len(t, app.Status.History, 4)
+
+	app = newApp()
+	pruneRevisionHistory(app, &settings.AppHistoryRetention{MaxEntries: 10, MaxAge: 150 * time.Minute}, now)
+	assert.Len(t, app.Status.History, 2)
+
+	app = newApp()
+	pruneRevisionHistory(app, &settings.AppHistoryRetention{MaxEntries: 10, MaxAge: time.Minute}, now)
+	assert.Len(t, app.Status.History, 1)
+	assert.Equal(t, int64(4), app.Status.History.LastRevisionHistory().ID)
+}
+
+func Test_pruneOperationState(t *testing.T) {
+	now := time.Now()
+	newApp := func(phase synccommon.OperationPhase, finishedAt time.Time) *argoappv1.Application {
+		app := newFakeApp()
+		finished := metav1.NewTime(finishedAt)
+		app.Status.OperationState = &argoappv1.OperationState{
+			Phase:      phase,
+			Message:    "successfully synced",
+			FinishedAt: &finished,
+			SyncResult: &argoappv1.SyncOperationResult{
+				Revision:  "abc123",
+				Resources: argoappv1.ResourceResults{{Kind: "Pod", Name: "my-pod"}},
+			},
+		}
+		return app
+	}
+	retention := &settings.AppHistoryRetention{OperationStateMaxAge: time.Hour}
+
+	app := newApp(synccommon.OperationSucceeded, now.Add(-2*time.Hour))
+	pruneOperationState(app, retention, now)
+	assert.Empty(t, app.Status.OperationState.SyncResult.Resources)
+	assert.Equal(t, "abc123", app.Status.OperationState.SyncResult.Revision)
+	assert.Equal(t, synccommon.OperationSucceeded, app.Status.OperationState.Phase)
+
+	app = newApp(synccommon.OperationSucceeded, now.Add(-time.Minute))
+	pruneOperationState(app, retention, now)
+	assert.Len(t, app.Status.OperationState.SyncResult.Resources, 1)
+
+	app = newApp(synccommon.OperationRunning, now.Add(-2*time.Hour))
+	pruneOperationState(app, retention, now)
+	assert.Len(t, app.Status.OperationState.SyncResult.Resources, 1)
+
+	app = newApp(synccommon.OperationSucceeded, now.Add(-2*time.Hour))
+	pruneOperationState(app, &settings.AppHistoryRetention{}, now)
+	assert.Len(t, app.Status.OperationState.SyncResult.Resources, 1)
+}
+
 // helper function to read contents of a file to string
 // panics on error
 func mustReadFile(path string) string {
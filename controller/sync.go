@@ -1,21 +1,36 @@
 package controller
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/argoproj/gitops-engine/pkg/sync/resource"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/openapi"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
 
 	cdcommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/controller/metrics"
@@ -33,6 +48,18 @@ const (
 	// EnvVarSyncWaveDelay is an environment variable which controls the delay in seconds between
 	// each sync-wave
 	EnvVarSyncWaveDelay = "ARGOCD_SYNC_WAVE_DELAY"
+	// SyncOptionServerSideApply is the sync option which applies resources with server-side apply
+	SyncOptionServerSideApply = "ServerSideApply=true"
+	// ServerSideApplyFieldManager is the field manager of the fields applied with server-side apply
+	ServerSideApplyFieldManager = "argocd-controller"
+	// SyncOptionSyncWaveDelay is the prefix of the sync option which overrides the delay between each sync-wave, e.g.
+	// SyncWaveDelay=30s
+	SyncOptionSyncWaveDelay = "SyncWaveDelay="
+	// maxSyncWaveDelay is the maximum delay between each sync-wave
+	maxSyncWaveDelay = time.Hour
+	// SyncOptionPruneReverseWaveOrder is the sync option which prunes resources after the other resources are synced, in
+	// the reverse order of their sync waves
+	SyncOptionPruneReverseWaveOrder = "PruneReverseWaveOrder=true"
 )
 
 func (m *appStateManager) getOpenAPISchema(server string) (openapi.Resources, error) {
@@ -60,6 +87,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 	syncOp = *state.Operation.Sync
+	if state.Phase != common.OperationTerminating {
+		if delay := m.SyncWaveDelay(app); delay > 0 {
+			// the controller requeues the operation once the delay elapsed
+			state.Message = fmt.Sprintf("waiting %s before the next sync wave", delay.Round(time.Second))
+			return
+		}
+	}
 	if syncOp.Source == nil {
 		// normal sync case (where source is taken from app.spec.source)
 		source = app.Spec.Source
@@ -93,6 +127,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
 		return
 	}
+	syncOp.SyncOptions = syncOp.SyncOptions.WithDefaults(proj.Spec.SyncOptions)
 
 	compareResult := m.CompareAppState(app, proj, revision, source, false, true, syncOp.Manifests)
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
@@ -101,14 +136,24 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:     true,
+		v1alpha1.ApplicationConditionInvalidSpecError:    true,
+		v1alpha1.ApplicationConditionManifestPolicyError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
 	}
 
+	if len(syncOp.Resources) > 0 && len(syncRes.Resources) == 0 {
+		// a partial sync selecting resources which are not part of the application would silently sync nothing
+		if unknown := unknownSyncResources(syncOp.Resources, compareResult.managedResources); len(unknown) > 0 {
+			state.Phase = common.OperationFailed
+			state.Message = fmt.Sprintf("The selected resources are not managed by the application: %s", strings.Join(unknown, ", "))
+			return
+		}
+	}
+
 	clst, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
 	if err != nil {
 		state.Phase = common.OperationError
@@ -119,12 +164,19 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	rawConfig := clst.RawRestConfig()
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clst.RESTConfig())
 
+	if err := argo.ImpersonateServiceAccount(m.settingsMgr, proj, app.Spec.Destination, rawConfig, restConfig); err != nil {
+		state.Phase = common.OperationError
+		state.Message = err.Error()
+		return
+	}
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = common.OperationError
 		state.Message = fmt.Sprintf("Failed to load resource overrides: %v", err)
 		return
 	}
+	resourceOverrides = proj.ResourceOverrides(resourceOverrides)
 
 	atomic.AddUint64(&syncIdPrefix, 1)
 	syncId := fmt.Sprintf("%05d-%s", syncIdPrefix, rand.RandString(5))
@@ -162,16 +214,32 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
+	waveDelays, err := newSyncWaveDelays(syncOp.SyncOptions, compareResult.reconciliationResult)
+	if err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Invalid sync wave delay: %v", err)
+		return
+	}
+
+	managedNamespace := newManagedNamespace(app, syncOp.SyncOptions, compareResult.reconciliationResult.Target)
+
+	reconciliationResult := compareResult.reconciliationResult
+	pruneReverseWaveOrder := syncOp.SyncOptions.HasOption(SyncOptionPruneReverseWaveOrder)
+	if pruneReverseWaveOrder {
+		reconciliationResult = reversePruneWaves(reconciliationResult)
+	}
+	kubectl := &prunePropagationKubectl{Kubectl: m.kubectl, policies: resourcePrunePropagationPolicies(reconciliationResult)}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
-		compareResult.reconciliationResult,
+		reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		&serverSideApplyKubectl{Kubectl: kubectl, all: syncOp.SyncOptions.HasOption(SyncOptionServerSideApply), managedNamespace: managedNamespace},
 		app.Spec.Destination.Namespace,
 		openAPISchema,
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(&hookHealthOverride{HealthOverride: lua.ResourceHealthOverrides(resourceOverrides), now: time.Now}),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
 			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
 				return fmt.Errorf("Resource %s:%s is not permitted in project %s.", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
@@ -188,14 +256,22 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
 		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), func(un *unstructured.Unstructured) bool {
+			if un != nil && managedNamespace != nil {
+				return managedNamespaceOutOfSync(un, managedNamespace) || hasLegacyNamespaceLabel(un, managedNamespace)
+			}
 			if un != nil && kube.GetAppInstanceLabel(un, cdcommon.LabelKeyAppInstance) != "" {
 				kube.UnsetLabel(un, cdcommon.LabelKeyAppInstance)
 				return true
 			}
 			return false
 		}),
-		sync.WithSyncWaveHook(delayBetweenSyncWaves),
-		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
+		sync.WithSyncWaveHook(func(phase common.SyncPhase, wave int, finalWave bool) error {
+			if !finalWave {
+				m.delayNextSyncWave(app, waveDelays.delay(phase, wave))
+			}
+			return nil
+		}),
+		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast) && !pruneReverseWaveOrder),
 		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), compareResult.diffResultList),
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
@@ -218,6 +294,9 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	if state.Phase.Completed() {
+		m.clearSyncWaveDelay(app)
+	}
 	state.SyncResult.Resources = nil
 	for _, res := range resState {
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
@@ -237,7 +316,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
 	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
-		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state.StartedAt)
+		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, source, state.StartedAt, syncOp.RolledBackRevision)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -245,24 +324,455 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 }
 
-// delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
-// between each sync wave. We introduce an artificial delay in order give other controllers a
-// _chance_ to react to the spec change that we just applied. This is important because without
-// this, Argo CD will likely assess resource health too quickly (against the stale object), causing
-// hooks to fire prematurely. See: https://github.com/argoproj/argo-cd/issues/4669.
+// unknownSyncResources returns the resources selected by a partial sync which don't match any resource managed by the
+// application, formatted as GROUP:KIND:NAME or GROUP:KIND:NAMESPACE/NAME
+func unknownSyncResources(selected []v1alpha1.SyncOperationResource, managedResources []managedResource) []string {
+	var unknown []string
+	for _, r := range selected {
+		found := false
+		for _, res := range managedResources {
+			if r.HasIdentity(res.Name, res.Namespace, schema.GroupVersionKind{Group: res.Group, Kind: res.Kind}) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			name := r.Name
+			if r.Namespace != "" {
+				name = r.Namespace + "/" + r.Name
+			}
+			unknown = append(unknown, fmt.Sprintf("%s:%s:%s", r.Group, r.Kind, name))
+		}
+	}
+	return unknown
+}
+
+// syncWaveKey identifies a sync wave of a sync phase
+type syncWaveKey struct {
+	phase common.SyncPhase
+	wave  int
+}
+
+// syncWaveDelays holds the delays to wait for after each sync wave. The default delay is set by the SyncWaveDelay
+// sync option, or else by the ARGOCD_SYNC_WAVE_DELAY environment variable, and is overridden for a given wave by the
+// largest sync-wave-delay annotation of its resources.
+type syncWaveDelays struct {
+	defaultDelay time.Duration
+	waves        map[syncWaveKey]time.Duration
+}
+
+func newSyncWaveDelays(syncOptions v1alpha1.SyncOptions, reconciliationResult sync.ReconciliationResult) (*syncWaveDelays, error) {
+	delays := &syncWaveDelays{defaultDelay: 2 * time.Second, waves: map[syncWaveKey]time.Duration{}}
+	if delaySecStr := os.Getenv(EnvVarSyncWaveDelay); delaySecStr != "" {
+		if val, err := strconv.Atoi(delaySecStr); err == nil && val >= 0 {
+			delays.defaultDelay = time.Duration(val) * time.Second
+			if delays.defaultDelay > maxSyncWaveDelay {
+				delays.defaultDelay = maxSyncWaveDelay
+			}
+		}
+	}
+	for _, option := range syncOptions {
+		if strings.HasPrefix(option, SyncOptionSyncWaveDelay) {
+			delay, err := parseMaxSyncWaveDelay(strings.TrimPrefix(option, SyncOptionSyncWaveDelay))
+			if err != nil {
+				return nil, fmt.Errorf("sync option %s: %w", option, err)
+			}
+			delays.defaultDelay = delay
+		}
+	}
+
+	objs := append(append([]*unstructured.Unstructured{}, reconciliationResult.Target...), reconciliationResult.Hooks...)
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		delayStr, ok := obj.GetAnnotations()[cdcommon.AnnotationSyncWaveDelay]
+		if !ok {
+			continue
+		}
+		delay, err := parseMaxSyncWaveDelay(delayStr)
+		if err != nil {
+			return nil, fmt.Errorf("annotation %s of %s/%s: %w", cdcommon.AnnotationSyncWaveDelay, obj.GetKind(), obj.GetName(), err)
+		}
+		for _, phase := range syncPhases(obj) {
+			key := syncWaveKey{phase: phase, wave: syncwaves.Wave(obj)}
+			if current, ok := delays.waves[key]; !ok || delay > current {
+				delays.waves[key] = delay
+			}
+		}
+	}
+	return delays, nil
+}
+
+// delay returns the delay to wait for after the given sync wave
+func (d *syncWaveDelays) delay(phase common.SyncPhase, wave int) time.Duration {
+	if delay, ok := d.waves[syncWaveKey{phase: phase, wave: wave}]; ok {
+		return delay
+	}
+	return d.defaultDelay
+}
+
+// delayNextSyncWave delays the sync of the next wave of the application by the given delay. The sync waves are
+// delayed in order give other controllers a _chance_ to react to the spec change that we just applied. This is
+// important because without this, Argo CD will likely assess resource health too quickly (against the stale object),
+// causing hooks to fire prematurely. See: https://github.com/argoproj/argo-cd/issues/4669.
 // Note, this is not foolproof, since a proper fix would require the CRD record
 // status.observedGeneration coupled with a health.lua that verifies
 // status.observedGeneration == metadata.generation
-func delayBetweenSyncWaves(phase common.SyncPhase, wave int, finalWave bool) error {
-	if !finalWave {
-		delaySec := 2
-		if delaySecStr := os.Getenv(EnvVarSyncWaveDelay); delaySecStr != "" {
-			if val, err := strconv.Atoi(delaySecStr); err == nil {
-				delaySec = val
+// The operation is requeued rather than blocking a worker for the whole delay. The delays are kept in memory, so the
+// next wave is not delayed if the controller restarts meanwhile.
+func (m *appStateManager) delayNextSyncWave(app *v1alpha1.Application, delay time.Duration) {
+	m.syncWavesLock.Lock()
+	defer m.syncWavesLock.Unlock()
+	if m.nextSyncWaveAt == nil {
+		m.nextSyncWaveAt = map[string]time.Time{}
+	}
+	m.nextSyncWaveAt[app.Name] = time.Now().Add(delay)
+}
+
+// SyncWaveDelay returns the time left before the next sync wave of the application
+func (m *appStateManager) SyncWaveDelay(app *v1alpha1.Application) time.Duration {
+	m.syncWavesLock.Lock()
+	defer m.syncWavesLock.Unlock()
+	at, ok := m.nextSyncWaveAt[app.Name]
+	if !ok {
+		return 0
+	}
+	delay := time.Until(at)
+	if delay <= 0 {
+		delete(m.nextSyncWaveAt, app.Name)
+		return 0
+	}
+	return delay
+}
+
+func (m *appStateManager) clearSyncWaveDelay(app *v1alpha1.Application) {
+	m.syncWavesLock.Lock()
+	defer m.syncWavesLock.Unlock()
+	delete(m.nextSyncWaveAt, app.Name)
+}
+
+// parseMaxSyncWaveDelay parses a sync wave delay which must not exceed the maximum delay between sync waves
+func parseMaxSyncWaveDelay(delayStr string) (time.Duration, error) {
+	delay, err := parseSyncWaveDelay(delayStr)
+	if err != nil {
+		return 0, err
+	}
+	if delay > maxSyncWaveDelay {
+		return 0, fmt.Errorf("delay '%s' exceeds the maximum of %s", delayStr, maxSyncWaveDelay)
+	}
+	return delay, nil
+}
+
+// parseSyncWaveDelay parses a sync wave delay, either a number of seconds or a duration (e.g. "30s", "2m")
+func parseSyncWaveDelay(delayStr string) (time.Duration, error) {
+	var delay time.Duration
+	if val, err := strconv.Atoi(delayStr); err == nil {
+		delay = time.Duration(val) * time.Second
+	} else if delay, err = time.ParseDuration(delayStr); err != nil {
+		return 0, fmt.Errorf("invalid delay '%s'", delayStr)
+	}
+	if delay < 0 {
+		return 0, fmt.Errorf("negative delay '%s'", delayStr)
+	}
+	return delay, nil
+}
+
+// hookHealthOverride overrides the health of the hooks, which determines their result, according to their timeout and
+// failure policy annotations
+type hookHealthOverride struct {
+	health.HealthOverride
+	now func() time.Time
+}
+
+func (o *hookHealthOverride) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	if !hook.IsHook(obj) {
+		return o.HealthOverride.GetResourceHealth(obj)
+	}
+	healthStatus, err := health.GetResourceHealth(obj, o.HealthOverride)
+	if err != nil || healthStatus == nil {
+		return healthStatus, err
+	}
+	if timeoutStr, ok := obj.GetAnnotations()[cdcommon.AnnotationHookTimeout]; ok && obj.GetDeletionTimestamp() == nil &&
+		(healthStatus.Status == health.HealthStatusProgressing || healthStatus.Status == health.HealthStatusSuspended) {
+		timeout, err := parseSyncWaveDelay(timeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook timeout: %v", err)
+		}
+		if o.now().Sub(obj.GetCreationTimestamp().Time) >= timeout {
+			healthStatus = &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("Hook timed out after %v", timeout)}
+		}
+	}
+	switch policy := obj.GetAnnotations()[cdcommon.AnnotationHookFailurePolicy]; policy {
+	case "", cdcommon.HookFailurePolicyFail:
+	case cdcommon.HookFailurePolicyIgnore:
+		if healthStatus.Status == health.HealthStatusDegraded || healthStatus.Status == health.HealthStatusUnknown {
+			healthStatus = &health.HealthStatus{Status: health.HealthStatusHealthy, Message: fmt.Sprintf("Ignored hook failure: %s", healthStatus.Message)}
+		}
+	default:
+		return nil, fmt.Errorf("invalid hook failure policy '%s'", policy)
+	}
+	return healthStatus, nil
+}
+
+// syncPhases returns the sync phases the object is synced in, the same way gitops-engine does
+func syncPhases(obj *unstructured.Unstructured) []common.SyncPhase {
+	if hook.Skip(obj) {
+		return nil
+	}
+	if !hook.IsHook(obj) {
+		return []common.SyncPhase{common.SyncPhaseSync}
+	}
+	var phases []common.SyncPhase
+	for _, hookType := range hook.Types(obj) {
+		switch hookType {
+		case common.HookTypePreSync, common.HookTypeSync, common.HookTypePostSync, common.HookTypeSyncFail:
+			phases = append(phases, common.SyncPhase(hookType))
+		}
+	}
+	return phases
+}
+
+// reversePruneWaves moves the resources to prune after the last sync wave of the other resources, in the reverse order of
+// their sync waves, so that resources are deleted before the ones they depend on, e.g. custom resources before their
+// operator. The pruned live objects are copied since they are shared with the cluster cache.
+func reversePruneWaves(res sync.ReconciliationResult) sync.ReconciliationResult {
+	lastWave := 0
+	for _, target := range res.Target {
+		if target != nil && syncwaves.Wave(target) > lastWave {
+			lastWave = syncwaves.Wave(target)
+		}
+	}
+	var pruneWaves []int
+	for i, live := range res.Live {
+		if live != nil && res.Target[i] == nil {
+			pruneWaves = append(pruneWaves, syncwaves.Wave(live))
+		}
+	}
+	if len(pruneWaves) == 0 {
+		return res
+	}
+	lastPruneWave := pruneWaves[0]
+	for _, wave := range pruneWaves {
+		if wave > lastPruneWave {
+			lastPruneWave = wave
+		}
+	}
+
+	live := make([]*unstructured.Unstructured, len(res.Live))
+	for i := range res.Live {
+		live[i] = res.Live[i]
+		if live[i] == nil || res.Target[i] != nil {
+			continue
+		}
+		live[i] = live[i].DeepCopy()
+		annotations := live[i].GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[common.AnnotationSyncWave] = strconv.Itoa(lastWave + 1 + lastPruneWave - syncwaves.Wave(res.Live[i]))
+		live[i].SetAnnotations(annotations)
+	}
+	res.Live = live
+	return res
+}
+
+// resourcePrunePropagationPolicies returns the deletion propagation policies set by the PrunePropagationPolicy sync
+// option annotation of the resources to prune
+func resourcePrunePropagationPolicies(res sync.ReconciliationResult) map[kube.ResourceKey]v1.DeletionPropagation {
+	policies := map[kube.ResourceKey]v1.DeletionPropagation{}
+	for i, live := range res.Live {
+		if live == nil || res.Target[i] != nil {
+			continue
+		}
+		for _, policy := range []v1.DeletionPropagation{v1.DeletePropagationBackground, v1.DeletePropagationForeground, v1.DeletePropagationOrphan} {
+			if resourceutil.HasAnnotationOption(live, common.AnnotationSyncOptions, "PrunePropagationPolicy="+strings.ToLower(string(policy))) {
+				policies[kube.GetResourceKey(live)] = policy
+			}
+		}
+	}
+	return policies
+}
+
+// prunePropagationKubectl deletes resources with the propagation policy of their PrunePropagationPolicy sync option
+// annotation, which overrides the one of the application.
+type prunePropagationKubectl struct {
+	kube.Kubectl
+	policies map[kube.ResourceKey]v1.DeletionPropagation
+}
+
+func (k *prunePropagationKubectl) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions v1.DeleteOptions) error {
+	if policy, ok := k.policies[kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)]; ok {
+		deleteOptions.PropagationPolicy = &policy
+	}
+	return k.Kubectl.DeleteResource(ctx, config, gvk, name, namespace, deleteOptions)
+}
+
+// newManagedNamespace returns the destination namespace of the application with its managed labels and annotations,
+// or nil if the namespace is not created by the CreateNamespace=true sync option, has no managed metadata or is one of
+// the application resources.
+func newManagedNamespace(app *v1alpha1.Application, syncOptions v1alpha1.SyncOptions, targets []*unstructured.Unstructured) *unstructured.Unstructured {
+	if !syncOptions.HasOption("CreateNamespace=true") || app.Spec.Destination.Namespace == "" || app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.ManagedNamespaceMetadata == nil {
+		return nil
+	}
+	for _, obj := range targets {
+		if isNamespaceWithName(obj, app.Spec.Destination.Namespace) {
+			return nil
+		}
+	}
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind(kube.NamespaceKind)
+	ns.SetName(app.Spec.Destination.Namespace)
+	ns.SetLabels(app.Spec.SyncPolicy.ManagedNamespaceMetadata.Labels)
+	ns.SetAnnotations(app.Spec.SyncPolicy.ManagedNamespaceMetadata.Annotations)
+	return ns
+}
+
+// managedNamespaceOutOfSync returns whether the live namespace misses some of the managed labels and annotations, or
+// still has some which were previously applied by Argo CD and are not managed anymore.
+func managedNamespaceOutOfSync(live *unstructured.Unstructured, managed *unstructured.Unstructured) bool {
+	if !isSubset(managed.GetLabels(), live.GetLabels()) || !isSubset(managed.GetAnnotations(), live.GetAnnotations()) {
+		return true
+	}
+	for _, entry := range live.GetManagedFields() {
+		if entry.Manager != ServerSideApplyFieldManager || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return true
+		}
+		metadata := fields.WithPrefix(fieldpath.PathElement{FieldName: pointer.StringPtr("metadata")})
+		for field, values := range map[string]map[string]string{"labels": managed.GetLabels(), "annotations": managed.GetAnnotations()} {
+			outOfSync := false
+			metadata.WithPrefix(fieldpath.PathElement{FieldName: pointer.StringPtr(field)}).Iterate(func(p fieldpath.Path) {
+				if len(p) == 1 && p[0].FieldName != nil {
+					if _, ok := values[*p[0].FieldName]; !ok {
+						outOfSync = true
+					}
+				}
+			})
+			if outOfSync {
+				return true
 			}
 		}
-		duration := time.Duration(delaySec) * time.Second
-		time.Sleep(duration)
 	}
-	return nil
+	return false
+}
+
+// hasLegacyNamespaceLabel returns whether the live namespace still has the instance label set by previous versions on
+// the namespaces they created, while it is not one of the managed labels
+func hasLegacyNamespaceLabel(live *unstructured.Unstructured, managed *unstructured.Unstructured) bool {
+	if _, ok := managed.GetLabels()[cdcommon.LabelKeyAppInstance]; ok {
+		return false
+	}
+	_, ok := live.GetLabels()[cdcommon.LabelKeyAppInstance]
+	return ok
+}
+
+func isSubset(subset map[string]string, set map[string]string) bool {
+	for k, v := range subset {
+		if val, ok := set[k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func isNamespaceWithName(obj *unstructured.Unstructured, name string) bool {
+	return obj != nil && obj.GroupVersionKind().Group == "" && obj.GetKind() == kube.NamespaceKind && obj.GetName() == name
+}
+
+// serverSideApplyKubectl applies resources with server-side apply, either all of them or the ones annotated with the
+// ServerSideApply=true sync option. Server-side apply does not store the last applied configuration in an annotation,
+// which is limited in size and fails for big resources such as some CRDs. The managed namespace, if any, is always
+// applied with server-side apply.
+type serverSideApplyKubectl struct {
+	kube.Kubectl
+	all              bool
+	managedNamespace *unstructured.Unstructured
+}
+
+func (k *serverSideApplyKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	ops, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return &serverSideApplyResourceOperations{
+		ResourceOperations: ops,
+		dynamicIf:          dynamicIf,
+		disco:              memory.NewMemCacheClient(disco),
+		all:                k.all,
+		managedNamespace:   k.managedNamespace,
+	}, cleanup, nil
+}
+
+type serverSideApplyResourceOperations struct {
+	kube.ResourceOperations
+	dynamicIf        dynamic.Interface
+	disco            discovery.DiscoveryInterface
+	all              bool
+	managedNamespace *unstructured.Unstructured
+}
+
+func (o *serverSideApplyResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate bool) (string, error) {
+	serverSide := o.all || resourceutil.HasAnnotationOption(obj, common.AnnotationSyncOptions, SyncOptionServerSideApply)
+	managedNamespace := o.managedNamespace != nil && isNamespaceWithName(obj, o.managedNamespace.GetName())
+	if managedNamespace {
+		// only the managed labels and annotations are applied, so that server-side apply removes the ones which were
+		// previously applied and are not managed anymore
+		obj = o.managedNamespace.DeepCopy()
+		serverSide = true
+	}
+	// server-side apply cannot be dry run on the client side
+	if !serverSide || dryRunStrategy == cmdutil.DryRunClient {
+		return o.ResourceOperations.ApplyResource(ctx, obj, dryRunStrategy, force, validate)
+	}
+	gvk := obj.GroupVersionKind()
+	apiResource, err := kube.ServerResourceForGroupVersionKind(o.disco, gvk)
+	if err != nil {
+		return "", err
+	}
+	resourceIf := kube.ToResourceInterface(o.dynamicIf, apiResource, gvk.GroupVersion().WithResource(apiResource.Name), obj.GetNamespace())
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	patchOptions := v1.PatchOptions{FieldManager: ServerSideApplyFieldManager}
+	// conflicts with other field managers, e.g. previous client-side applies, fail the apply unless the Force=true
+	// sync option resolves them in favor of the application
+	if force {
+		patchOptions.Force = &force
+	}
+	if dryRunStrategy == cmdutil.DryRunServer {
+		patchOptions.DryRun = []string{v1.DryRunAll}
+	}
+	applied, err := resourceIf.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, patchOptions)
+	if err != nil {
+		return "", err
+	}
+	if managedNamespace && hasLegacyNamespaceLabel(applied, o.managedNamespace) {
+		// the instance label which tracked namespaces created by previous versions is removed once the managed
+		// metadata is applied, since server-side apply only removes the labels it applied itself
+		patch := []byte(fmt.Sprintf(`[{"op":"remove","path":"/metadata/labels/%s"}]`, strings.ReplaceAll(cdcommon.LabelKeyAppInstance, "/", "~1")))
+		if _, err := resourceIf.Patch(ctx, obj.GetName(), types.JSONPatchType, patch, v1.PatchOptions{DryRun: patchOptions.DryRun}); err != nil {
+			return "", err
+		}
+	}
+	message := fmt.Sprintf("%s/%s serverside-applied", strings.ToLower(gvk.Kind), obj.GetName())
+	if dryRunStrategy == cmdutil.DryRunServer {
+		message += " (server dry run)"
+	}
+	return message, nil
 }
@@ -2,18 +2,36 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
+	cdcommon "github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
 func TestPersistRevisionHistory(t *testing.T) {
@@ -142,3 +160,491 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.NotEmpty(t, conditions)
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
+
+func TestSyncUnknownSelectedResources(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Resources: []v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui"}},
+		},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	assert.Equal(t, common.OperationFailed, opState.Phase)
+	assert.Equal(t, "The selected resources are not managed by the application: apps:Deployment:default/guestbook-ui", opState.Message)
+}
+
+func TestSyncImpersonationWithoutServiceAccount(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	defaultProject := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{{Server: test.FakeClusterURL, Namespace: "other", DefaultServiceAccount: "deployer"}},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, defaultProject},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData:   map[string]string{"application.sync.impersonation.enabled": "true"},
+	}
+	ctrl := newFakeController(&data)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	assert.Equal(t, common.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "failed to find the service account to impersonate")
+}
+
+func TestUnknownSyncResources(t *testing.T) {
+	managedResources := []managedResource{
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui"},
+		{Kind: "Namespace", Name: "default"},
+	}
+	assert.Empty(t, unknownSyncResources([]v1alpha1.SyncOperationResource{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"},
+		{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui"},
+		{Kind: "Namespace", Name: "default"},
+	}, managedResources))
+	assert.Equal(t, []string{"apps:Deployment:other/guestbook-ui", ":Service:guestbook-ui"}, unknownSyncResources([]v1alpha1.SyncOperationResource{
+		{Group: "apps", Kind: "Deployment", Namespace: "other", Name: "guestbook-ui"},
+		{Kind: "Service", Name: "guestbook-ui"},
+	}, managedResources))
+}
+
+func TestServerSideApplyResourceOperations_Delegation(t *testing.T) {
+	kubectl := &kubetest.MockKubectlCmd{}
+	ssaKubectl := &serverSideApplyKubectl{Kubectl: kubectl}
+	ops, cleanup, err := ssaKubectl.ManageResources(&rest.Config{}, nil)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	obj := test.NewDeployment()
+	// resources without the sync option are applied with client-side apply
+	_, err = ops.ApplyResource(context.Background(), obj, cmdutil.DryRunNone, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "apply", kubectl.GetLastResourceCommand(kube.GetResourceKey(obj)))
+
+	// server-side apply cannot be dry run on the client side
+	annotated := obj.DeepCopy()
+	annotated.SetName("annotated")
+	annotated.SetAnnotations(map[string]string{common.AnnotationSyncOptions: SyncOptionServerSideApply})
+	_, err = ops.ApplyResource(context.Background(), annotated, cmdutil.DryRunClient, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "apply", kubectl.GetLastResourceCommand(kube.GetResourceKey(annotated)))
+}
+
+func TestServerSideApplyResourceOperations_ApplyResource(t *testing.T) {
+	type patchRequest struct {
+		path        string
+		contentType string
+		query       url.Values
+	}
+	var patches []patchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			_ = json.NewEncoder(w).Encode(v1.APIVersions{Versions: []string{"v1"}})
+		case r.URL.Path == "/apis":
+			_ = json.NewEncoder(w).Encode(v1.APIGroupList{Groups: []v1.APIGroup{{
+				Name:             "apps",
+				Versions:         []v1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}},
+				PreferredVersion: v1.GroupVersionForDiscovery{GroupVersion: "apps/v1", Version: "v1"},
+			}}})
+		case r.URL.Path == "/api/v1":
+			_ = json.NewEncoder(w).Encode(v1.APIResourceList{GroupVersion: "v1"})
+		case r.URL.Path == "/apis/apps/v1":
+			_ = json.NewEncoder(w).Encode(v1.APIResourceList{GroupVersion: "apps/v1", APIResources: []v1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true},
+			}})
+		case r.Method == http.MethodPatch:
+			patches = append(patches, patchRequest{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), query: r.URL.Query()})
+			_, _ = io.Copy(w, r.Body)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	kubectl := &kubetest.MockKubectlCmd{}
+	ssaKubectl := &serverSideApplyKubectl{Kubectl: kubectl, all: true}
+	ops, cleanup, err := ssaKubectl.ManageResources(&rest.Config{Host: server.URL}, nil)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	obj := test.NewDeployment()
+	obj.SetNamespace(test.FakeDestNamespace)
+
+	message, err := ops.ApplyResource(context.Background(), obj, cmdutil.DryRunNone, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "deployment/nginx-deployment serverside-applied", message)
+	message, err = ops.ApplyResource(context.Background(), obj, cmdutil.DryRunServer, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "deployment/nginx-deployment serverside-applied (server dry run)", message)
+
+	// the resources are applied with the API server rather than kubectl
+	assert.Empty(t, kubectl.GetLastResourceCommand(kube.GetResourceKey(obj)))
+	if assert.Len(t, patches, 2) {
+		path := fmt.Sprintf("/apis/apps/v1/namespaces/%s/deployments/nginx-deployment", test.FakeDestNamespace)
+		assert.Equal(t, path, patches[0].path)
+		assert.Equal(t, string(types.ApplyPatchType), patches[0].contentType)
+		assert.Equal(t, ServerSideApplyFieldManager, patches[0].query.Get("fieldManager"))
+		// conflicts are only forced if the sync is
+		assert.Empty(t, patches[0].query.Get("force"))
+		assert.Empty(t, patches[0].query.Get("dryRun"))
+
+		assert.Equal(t, path, patches[1].path)
+		assert.Equal(t, "true", patches[1].query.Get("force"))
+		assert.Equal(t, v1.DryRunAll, patches[1].query.Get("dryRun"))
+	}
+}
+
+func TestServerSideApplyResourceOperations_ApplyManagedNamespace(t *testing.T) {
+	type patchRequest struct {
+		contentType string
+		body        string
+	}
+	var patches []patchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api":
+			_ = json.NewEncoder(w).Encode(v1.APIVersions{Versions: []string{"v1"}})
+		case r.URL.Path == "/apis":
+			_ = json.NewEncoder(w).Encode(v1.APIGroupList{})
+		case r.URL.Path == "/api/v1":
+			_ = json.NewEncoder(w).Encode(v1.APIResourceList{GroupVersion: "v1", APIResources: []v1.APIResource{
+				{Name: "namespaces", Kind: "Namespace"},
+			}})
+		case r.URL.Path == "/api/v1/namespaces/my-namespace" && r.Method == http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			patches = append(patches, patchRequest{contentType: r.Header.Get("Content-Type"), body: string(body)})
+			// the live namespace was created by a previous version and still has the legacy instance label
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]interface{}{
+					"name":   "my-namespace",
+					"labels": map[string]string{"team": "a", cdcommon.LabelKeyAppInstance: "my-app"},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	managed := kube.MustToUnstructured(&corev1.Namespace{
+		TypeMeta:   v1.TypeMeta{APIVersion: "v1", Kind: kube.NamespaceKind},
+		ObjectMeta: v1.ObjectMeta{Name: "my-namespace", Labels: map[string]string{"team": "a"}},
+	})
+	ssaKubectl := &serverSideApplyKubectl{Kubectl: &kubetest.MockKubectlCmd{}, managedNamespace: managed}
+	ops, cleanup, err := ssaKubectl.ManageResources(&rest.Config{Host: server.URL}, nil)
+	assert.NoError(t, err)
+	defer cleanup()
+
+	live := managed.DeepCopy()
+	live.SetLabels(map[string]string{cdcommon.LabelKeyAppInstance: "my-app"})
+	assert.True(t, hasLegacyNamespaceLabel(live, managed))
+
+	message, err := ops.ApplyResource(context.Background(), live, cmdutil.DryRunNone, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "namespace/my-namespace serverside-applied", message)
+	if assert.Len(t, patches, 2) {
+		// only the managed metadata is applied, then the legacy label is removed
+		assert.Equal(t, string(types.ApplyPatchType), patches[0].contentType)
+		assert.NotContains(t, patches[0].body, cdcommon.LabelKeyAppInstance)
+		assert.Equal(t, string(types.JSONPatchType), patches[1].contentType)
+		assert.JSONEq(t, `[{"op":"remove","path":"/metadata/labels/app.kubernetes.io~1instance"}]`, patches[1].body)
+	}
+}
+
+func TestSyncWaveDelays(t *testing.T) {
+	annotated := func(name string, annotations map[string]string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{
+			annotated("first", map[string]string{common.AnnotationSyncWave: "1", cdcommon.AnnotationSyncWaveDelay: "10s"}),
+			annotated("second", map[string]string{common.AnnotationSyncWave: "1", cdcommon.AnnotationSyncWaveDelay: "30"}),
+			annotated("third", map[string]string{common.AnnotationSyncWave: "2"}),
+			nil,
+		},
+		Hooks: []*unstructured.Unstructured{
+			annotated("hook", map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationSyncWaveDelay: "1m"}),
+		},
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		delays, err := newSyncWaveDelays(nil, reconciliationResult)
+		assert.NoError(t, err)
+		assert.Equal(t, 30*time.Second, delays.delay(common.SyncPhaseSync, 1))
+		assert.Equal(t, 2*time.Second, delays.delay(common.SyncPhaseSync, 2))
+		assert.Equal(t, time.Minute, delays.delay(common.SyncPhasePreSync, 0))
+		assert.Equal(t, 2*time.Second, delays.delay(common.SyncPhaseSync, 0))
+	})
+
+	t.Run("SyncOption", func(t *testing.T) {
+		delays, err := newSyncWaveDelays(v1alpha1.SyncOptions{"SyncWaveDelay=5m"}, reconciliationResult)
+		assert.NoError(t, err)
+		assert.Equal(t, 30*time.Second, delays.delay(common.SyncPhaseSync, 1))
+		assert.Equal(t, 5*time.Minute, delays.delay(common.SyncPhaseSync, 2))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := newSyncWaveDelays(v1alpha1.SyncOptions{"SyncWaveDelay=-1"}, reconciliationResult)
+		assert.Error(t, err)
+		_, err = newSyncWaveDelays(v1alpha1.SyncOptions{"SyncWaveDelay=2h"}, reconciliationResult)
+		assert.EqualError(t, err, "sync option SyncWaveDelay=2h: delay '2h' exceeds the maximum of 1h0m0s")
+		_, err = newSyncWaveDelays(nil, sync.ReconciliationResult{
+			Target: []*unstructured.Unstructured{annotated("invalid", map[string]string{cdcommon.AnnotationSyncWaveDelay: "soon"})},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestSyncAppState_WaitsForSyncWaveDelay(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	stateManager := ctrl.appStateManager.(*appStateManager)
+	assert.Zero(t, stateManager.SyncWaveDelay(app))
+
+	stateManager.delayNextSyncWave(app, time.Minute)
+	opState := &v1alpha1.OperationState{Phase: common.OperationRunning, Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}}
+	stateManager.SyncAppState(app, opState)
+	// the sync is resumed by the controller once the delay elapsed rather than blocking the worker
+	assert.Equal(t, common.OperationRunning, opState.Phase)
+	assert.Equal(t, "waiting 1m0s before the next sync wave", opState.Message)
+	assert.Nil(t, opState.SyncResult)
+	assert.Greater(t, int64(stateManager.SyncWaveDelay(app)), int64(50*time.Second))
+
+	stateManager.clearSyncWaveDelay(app)
+	assert.Zero(t, stateManager.SyncWaveDelay(app))
+	stateManager.SyncAppState(app, opState)
+	assert.Equal(t, common.OperationSucceeded, opState.Phase)
+}
+
+func TestManagedNamespace(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = "guestbook"
+	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{
+		Labels:      map[string]string{"team": "a"},
+		Annotations: map[string]string{"owner": "me"},
+	}}
+	createNamespace := v1alpha1.SyncOptions{"CreateNamespace=true"}
+
+	managed := newManagedNamespace(app, createNamespace, nil)
+	if assert.NotNil(t, managed) {
+		assert.Equal(t, "guestbook", managed.GetName())
+		assert.Equal(t, map[string]string{"team": "a"}, managed.GetLabels())
+		assert.Equal(t, map[string]string{"owner": "me"}, managed.GetAnnotations())
+	}
+
+	assert.Nil(t, newManagedNamespace(app, nil, nil))
+	ns := managed.DeepCopy()
+	ns.SetLabels(nil)
+	assert.Nil(t, newManagedNamespace(app, createNamespace, []*unstructured.Unstructured{ns}))
+
+	t.Run("OutOfSync", func(t *testing.T) {
+		live := managed.DeepCopy()
+		live.SetLabels(map[string]string{"team": "b"})
+		assert.True(t, managedNamespaceOutOfSync(live, managed))
+	})
+
+	t.Run("InSync", func(t *testing.T) {
+		live := managed.DeepCopy()
+		live.SetLabels(map[string]string{"team": "a", "other": "label"})
+		live.SetManagedFields([]v1.ManagedFieldsEntry{{
+			Manager:  ServerSideApplyFieldManager,
+			FieldsV1: &v1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{}},"f:annotations":{"f:owner":{}}}}`)},
+		}})
+		assert.False(t, managedNamespaceOutOfSync(live, managed))
+	})
+
+	t.Run("LegacyLabel", func(t *testing.T) {
+		live := managed.DeepCopy()
+		live.SetLabels(map[string]string{"team": "a", cdcommon.LabelKeyAppInstance: "my-app"})
+		assert.True(t, hasLegacyNamespaceLabel(live, managed))
+		managedWithLabel := managed.DeepCopy()
+		managedWithLabel.SetLabels(map[string]string{"team": "a", cdcommon.LabelKeyAppInstance: "my-app"})
+		assert.False(t, hasLegacyNamespaceLabel(live, managedWithLabel))
+	})
+
+	t.Run("NotManagedAnymore", func(t *testing.T) {
+		live := managed.DeepCopy()
+		live.SetLabels(map[string]string{"team": "a", "removed": "label"})
+		live.SetManagedFields([]v1.ManagedFieldsEntry{{
+			Manager:  ServerSideApplyFieldManager,
+			FieldsV1: &v1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:team":{},"f:removed":{}}}}`)},
+		}})
+		assert.True(t, managedNamespaceOutOfSync(live, managed))
+	})
+}
+
+func TestReversePruneWaves(t *testing.T) {
+	withWave := func(name string, wave string) *unstructured.Unstructured {
+		obj := test.NewDeployment()
+		obj.SetName(name)
+		if wave != "" {
+			obj.SetAnnotations(map[string]string{common.AnnotationSyncWave: wave})
+		}
+		return obj
+	}
+	operator := withWave("operator", "-1")
+	custom := withWave("custom", "2")
+	res := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{withWave("applied", "3"), nil, nil, nil},
+		Live:   []*unstructured.Unstructured{withWave("applied", "3"), operator, custom, withWave("plain", "")},
+	}
+
+	reversed := reversePruneWaves(res)
+
+	waves := map[string]string{}
+	for _, live := range reversed.Live {
+		waves[live.GetName()] = live.GetAnnotations()[common.AnnotationSyncWave]
+	}
+	assert.Equal(t, map[string]string{"applied": "3", "custom": "4", "plain": "6", "operator": "7"}, waves)
+	// the live objects of the cluster cache are not modified
+	assert.Equal(t, "-1", operator.GetAnnotations()[common.AnnotationSyncWave])
+	assert.Equal(t, "2", res.Live[2].GetAnnotations()[common.AnnotationSyncWave])
+}
+
+type deleteOptionsRecorder struct {
+	kubetest.MockKubectlCmd
+	deleteOptions map[string]v1.DeleteOptions
+}
+
+func (k *deleteOptionsRecorder) DeleteResource(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, deleteOptions v1.DeleteOptions) error {
+	k.deleteOptions[name] = deleteOptions
+	return nil
+}
+
+func TestPrunePropagationKubectl(t *testing.T) {
+	background := test.NewDeployment()
+	background.SetName("background")
+	background.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Prune=true,PrunePropagationPolicy=background"})
+	plain := test.NewDeployment()
+	plain.SetName("plain")
+	policies := resourcePrunePropagationPolicies(sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{nil, nil},
+		Live:   []*unstructured.Unstructured{background, plain},
+	})
+	assert.Equal(t, map[kube.ResourceKey]v1.DeletionPropagation{kube.GetResourceKey(background): v1.DeletePropagationBackground}, policies)
+
+	recorder := &deleteOptionsRecorder{deleteOptions: map[string]v1.DeleteOptions{}}
+	kubectl := &prunePropagationKubectl{Kubectl: recorder, policies: policies}
+	foreground := v1.DeletePropagationForeground
+	for _, obj := range []*unstructured.Unstructured{background, plain} {
+		err := kubectl.DeleteResource(context.Background(), &rest.Config{}, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), v1.DeleteOptions{PropagationPolicy: &foreground})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, v1.DeletePropagationBackground, *recorder.deleteOptions["background"].PropagationPolicy)
+	assert.Equal(t, v1.DeletePropagationForeground, *recorder.deleteOptions["plain"].PropagationPolicy)
+}
+
+func TestHookHealthOverride(t *testing.T) {
+	now := time.Now()
+	override := &hookHealthOverride{HealthOverride: lua.ResourceHealthOverrides(nil), now: func() time.Time { return now }}
+	newJob := func(annotations map[string]string, failed bool) *unstructured.Unstructured {
+		job := &unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "batch/v1", "kind": "Job"}}
+		job.SetName("migration")
+		job.SetCreationTimestamp(v1.NewTime(now.Add(-time.Minute)))
+		job.SetAnnotations(annotations)
+		if failed {
+			job.Object["status"] = map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Failed", "status": "True", "message": "BackoffLimitExceeded"},
+			}}
+		}
+		return job
+	}
+
+	t.Run("NotHook", func(t *testing.T) {
+		healthStatus, err := override.GetResourceHealth(newJob(map[string]string{cdcommon.AnnotationHookTimeout: "1s"}, false))
+		assert.NoError(t, err)
+		assert.Nil(t, healthStatus)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		healthStatus, err := override.GetResourceHealth(newJob(map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationHookTimeout: "5m"}, false))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("TimedOut", func(t *testing.T) {
+		healthStatus, err := override.GetResourceHealth(newJob(map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationHookTimeout: "30"}, false))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, "Hook timed out after 30s", healthStatus.Message)
+	})
+
+	t.Run("TimedOutIgnored", func(t *testing.T) {
+		healthStatus, err := override.GetResourceHealth(newJob(map[string]string{
+			common.AnnotationKeyHook:             "PreSync",
+			cdcommon.AnnotationHookTimeout:       "30s",
+			cdcommon.AnnotationHookFailurePolicy: cdcommon.HookFailurePolicyIgnore,
+		}, false))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
+		assert.Equal(t, "Ignored hook failure: Hook timed out after 30s", healthStatus.Message)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		healthStatus, err := override.GetResourceHealth(newJob(map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationHookFailurePolicy: cdcommon.HookFailurePolicyFail}, true))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := override.GetResourceHealth(newJob(map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationHookTimeout: "soon"}, false))
+		assert.Error(t, err)
+		_, err = override.GetResourceHealth(newJob(map[string]string{common.AnnotationKeyHook: "PreSync", cdcommon.AnnotationHookFailurePolicy: "Retry"}, false))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,11 @@
+#!/bin/bash
+set -eux -o pipefail
+
+. $(dirname $0)/../tool-versions.sh
+
+# sops is built from its module, which is verified against the Go checksum database, in a temporary module so that
+# go.mod of Argo CD is not modified
+cd $(mktemp -d)
+GO111MODULE=on GOBIN=$(pwd) go install go.mozilla.org/sops/v3/cmd/sops@v${sops_version}
+sudo install -m 0755 ./sops $BIN/sops
+sops --version
@@ -16,4 +16,5 @@ kubectl_version=1.17.8
 kubectx_version=0.6.3
 kustomize4_version=4.2.0
 protoc_version=3.7.1
+sops_version=3.7.1
 swagger_version=0.19.0
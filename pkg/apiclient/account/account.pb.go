@@ -406,9 +406,11 @@ func (m *AccountsList) GetItems() []*Account {
 }
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IssuedAt             int64    `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
-	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// lastUsedAt is the approximate time at which the token was last used
+	LastUsedAt           int64    `protobuf:"varint,4,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -468,6 +470,13 @@ func (m *Token) GetExpiresAt() int64 {
 	return 0
 }
 
+func (m *Token) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
 type TokensList struct {
 	Items                []*Token `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -720,6 +729,230 @@ func (m *ListAccountRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListAccountRequest proto.InternalMessageInfo
 
+type CreateAccountRequest struct {
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capabilities []string `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// disabled creates the account disabled
+	Disabled             bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{13}
+}
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAccountRequest) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *CreateAccountRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+// BoolValue wraps a bool so that it can be left unset
+type BoolValue struct {
+	Value                bool     `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BoolValue) Reset()         { *m = BoolValue{} }
+func (m *BoolValue) String() string { return proto.CompactTextString(m) }
+func (*BoolValue) ProtoMessage()    {}
+func (*BoolValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{14}
+}
+func (m *BoolValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BoolValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BoolValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BoolValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolValue.Merge(m, src)
+}
+func (m *BoolValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *BoolValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoolValue proto.InternalMessageInfo
+
+func (m *BoolValue) GetValue() bool {
+	if m != nil {
+		return m.Value
+	}
+	return false
+}
+
+type UpdateAccountRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled updates whether the account is enabled, unless unset
+	Enabled *BoolValue `protobuf:"bytes,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// capabilities replaces the capabilities of the account, unless empty
+	Capabilities         []string `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
+func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAccountRequest) ProtoMessage()    {}
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{15}
+}
+func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAccountRequest.Merge(m, src)
+}
+func (m *UpdateAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAccountRequest proto.InternalMessageInfo
+
+func (m *UpdateAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateAccountRequest) GetEnabled() *BoolValue {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+func (m *UpdateAccountRequest) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type DeleteTokensRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTokensRequest) Reset()         { *m = DeleteTokensRequest{} }
+func (m *DeleteTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTokensRequest) ProtoMessage()    {}
+func (*DeleteTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{16}
+}
+func (m *DeleteTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTokensRequest.Merge(m, src)
+}
+func (m *DeleteTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTokensRequest proto.InternalMessageInfo
+
+func (m *DeleteTokensRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -730,7 +963,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{13}
+	return fileDescriptor_56d089a9b5e998c0, []int{17}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,60 +1006,74 @@ func init() {
 	proto.RegisterType((*CreateTokenResponse)(nil), "account.CreateTokenResponse")
 	proto.RegisterType((*DeleteTokenRequest)(nil), "account.DeleteTokenRequest")
 	proto.RegisterType((*ListAccountRequest)(nil), "account.ListAccountRequest")
+	proto.RegisterType((*CreateAccountRequest)(nil), "account.CreateAccountRequest")
+	proto.RegisterType((*BoolValue)(nil), "account.BoolValue")
+	proto.RegisterType((*UpdateAccountRequest)(nil), "account.UpdateAccountRequest")
+	proto.RegisterType((*DeleteTokensRequest)(nil), "account.DeleteTokensRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "account.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 743 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0x96, 0x93, 0xa6, 0x1f, 0x93, 0xbc, 0xe9, 0xdb, 0x25, 0x0d, 0x96, 0x09, 0x21, 0xdd, 0x56,
-	0x6d, 0x28, 0x6a, 0x2d, 0x02, 0xe2, 0xeb, 0x82, 0xda, 0x82, 0x50, 0x25, 0x0e, 0x10, 0x3e, 0x0e,
-	0xe5, 0xb4, 0x71, 0x56, 0x61, 0x69, 0x62, 0xbb, 0xde, 0x75, 0x0a, 0x8a, 0x72, 0x81, 0x9f, 0xc0,
-	0x89, 0x7f, 0xc4, 0x11, 0x89, 0x3f, 0x80, 0x2a, 0x7e, 0x08, 0xf2, 0x7a, 0xd7, 0x71, 0x9c, 0x14,
-	0x71, 0xf2, 0xce, 0xcc, 0xee, 0x3c, 0xcf, 0xcc, 0x3e, 0xe3, 0x85, 0x1a, 0xa7, 0xc1, 0x90, 0x06,
-	0x36, 0x71, 0x1c, 0x2f, 0x74, 0x85, 0xfe, 0xee, 0xfb, 0x81, 0x27, 0x3c, 0xb4, 0xa4, 0x4c, 0xab,
-	0xd2, 0xf3, 0x7a, 0x9e, 0xf4, 0xd9, 0xd1, 0x2a, 0x0e, 0x5b, 0xb5, 0x9e, 0xe7, 0xf5, 0xfa, 0xd4,
-	0x26, 0x3e, 0xb3, 0x89, 0xeb, 0x7a, 0x82, 0x08, 0xe6, 0xb9, 0x3c, 0x8e, 0xe2, 0x73, 0x58, 0x7f,
-	0xe3, 0x77, 0x89, 0xa0, 0x2f, 0x08, 0xe7, 0xe7, 0x5e, 0xd0, 0x6d, 0xd3, 0xb3, 0x90, 0x72, 0x81,
-	0x1a, 0x50, 0x74, 0xe9, 0xb9, 0xf6, 0x9a, 0x46, 0xc3, 0x68, 0xae, 0xb4, 0xd3, 0x2e, 0xd4, 0x84,
-	0x55, 0x27, 0x0c, 0x02, 0xea, 0x8a, 0x64, 0x57, 0x4e, 0xee, 0xca, 0xba, 0x11, 0x82, 0x05, 0x97,
-	0x0c, 0xa8, 0x99, 0x97, 0x61, 0xb9, 0xc6, 0x26, 0x54, 0xb3, 0xc0, 0xdc, 0xf7, 0x5c, 0x4e, 0xb1,
-	0x03, 0xc5, 0x23, 0xe2, 0x1e, 0x6b, 0x22, 0x16, 0x2c, 0x07, 0x94, 0x7b, 0x61, 0xe0, 0x50, 0xc5,
-	0x22, 0xb1, 0x51, 0x15, 0x16, 0x89, 0x13, 0x95, 0xa3, 0x90, 0x95, 0x15, 0x91, 0xe7, 0x61, 0x27,
-	0x39, 0x16, 0xe3, 0xa6, 0x5d, 0x78, 0x0b, 0x4a, 0x31, 0x48, 0x0c, 0x8a, 0x2a, 0x50, 0x18, 0x92,
-	0x7e, 0xa8, 0x21, 0x62, 0x03, 0xef, 0xc0, 0xda, 0x33, 0x2a, 0x0e, 0xe2, 0xfe, 0x6a, 0x42, 0xba,
-	0x1a, 0x23, 0x55, 0xcd, 0x17, 0x03, 0x96, 0xd4, 0xb6, 0x79, 0x71, 0x64, 0xc2, 0x12, 0x75, 0x49,
-	0xa7, 0x4f, 0xe3, 0x1e, 0x2d, 0xb7, 0xb5, 0x89, 0x30, 0x94, 0x1c, 0xe2, 0x93, 0x0e, 0xeb, 0x33,
-	0xc1, 0x28, 0x37, 0xf3, 0x8d, 0x7c, 0x73, 0xa5, 0x3d, 0xe5, 0x43, 0xdb, 0xb0, 0x28, 0xbc, 0x53,
-	0xea, 0x72, 0x73, 0xa1, 0x91, 0x6f, 0x16, 0x5b, 0xe5, 0x7d, 0xad, 0x80, 0xd7, 0x91, 0xbb, 0xad,
-	0xa2, 0xf8, 0x1e, 0x94, 0x14, 0x09, 0xfe, 0x9c, 0x71, 0x81, 0xb6, 0xa1, 0xc0, 0x04, 0x1d, 0x70,
-	0xd3, 0x90, 0xc7, 0xfe, 0x4f, 0x8e, 0xe9, 0x8a, 0xe2, 0x30, 0x7e, 0x09, 0x05, 0x99, 0x08, 0x95,
-	0x21, 0xc7, 0xf4, 0x5d, 0xe7, 0x58, 0x37, 0xea, 0x3d, 0xe3, 0x3c, 0xa4, 0xdd, 0x03, 0x21, 0x79,
-	0xe7, 0xdb, 0x89, 0x8d, 0x6a, 0xb0, 0x42, 0x3f, 0xfa, 0x2c, 0xa0, 0xfc, 0x40, 0xc8, 0x0e, 0xe7,
-	0xdb, 0x13, 0x07, 0x6e, 0x01, 0xc8, 0x94, 0x31, 0x91, 0xad, 0x69, 0x22, 0x59, 0xfe, 0x8a, 0xc6,
-	0x5b, 0x40, 0x47, 0x01, 0x25, 0x82, 0xc6, 0xde, 0xcb, 0xdb, 0x9d, 0xc2, 0x3e, 0x76, 0x15, 0xb1,
-	0x89, 0x43, 0x55, 0x91, 0xd7, 0x55, 0xe0, 0x5b, 0x70, 0x65, 0x2a, 0xef, 0xe4, 0xca, 0x65, 0xdf,
-	0xf4, 0x95, 0x4b, 0x03, 0x3f, 0x00, 0xf4, 0x84, 0xf6, 0xe9, 0x3f, 0x90, 0x88, 0x61, 0x72, 0x09,
-	0x4c, 0x05, 0x50, 0x54, 0xec, 0xb4, 0x5a, 0xf0, 0x2a, 0xfc, 0xf7, 0x74, 0xe0, 0x8b, 0x4f, 0x1a,
-	0xb6, 0xf5, 0xad, 0x00, 0x65, 0xb5, 0xe7, 0x15, 0x0d, 0x86, 0xcc, 0xa1, 0x48, 0xc0, 0x42, 0x24,
-	0x46, 0x54, 0x49, 0xfa, 0x92, 0x1a, 0x00, 0x6b, 0x3d, 0xe3, 0x55, 0x63, 0xf2, 0xf8, 0xf3, 0xcf,
-	0xdf, 0x5f, 0x73, 0x0f, 0xd1, 0x7d, 0x39, 0xd9, 0xc3, 0xdb, 0xc9, 0xdf, 0xc1, 0x21, 0xee, 0x1e,
-	0xb3, 0x47, 0x5a, 0xea, 0x63, 0x7b, 0x14, 0x4f, 0xc5, 0xd8, 0x1e, 0xa5, 0x26, 0x60, 0x8c, 0x86,
-	0x50, 0x9e, 0x9e, 0x40, 0x54, 0x4f, 0x90, 0xe6, 0xfe, 0x13, 0xac, 0x1b, 0x97, 0xc6, 0x15, 0xa7,
-	0x4d, 0xc9, 0xe9, 0xba, 0x65, 0x66, 0x39, 0xf9, 0x6a, 0xe7, 0x23, 0x63, 0x17, 0xbd, 0x83, 0x52,
-	0xaa, 0x4f, 0x1c, 0x5d, 0x4b, 0xb2, 0xce, 0xb6, 0x2f, 0x55, 0x7c, 0x5a, 0xd9, 0xf8, 0xaa, 0x04,
-	0x5a, 0x43, 0xab, 0x19, 0x20, 0x74, 0x02, 0x30, 0x99, 0x58, 0x64, 0x25, 0xa7, 0x67, 0xc6, 0xd8,
-	0x9a, 0x99, 0x06, 0x5c, 0x97, 0x49, 0x4d, 0x54, 0xcd, 0xb2, 0x1f, 0x45, 0xf7, 0x3d, 0x46, 0x67,
-	0x50, 0x4c, 0xe9, 0x28, 0xc5, 0x7b, 0x56, 0xb5, 0x56, 0x6d, 0x7e, 0x50, 0xf5, 0x69, 0x47, 0x22,
-	0x6d, 0xe0, 0xda, 0x7c, 0x24, 0x5b, 0x4a, 0x31, 0xea, 0xd5, 0x00, 0x8a, 0x29, 0x35, 0xa6, 0x20,
-	0x67, 0x35, 0x6a, 0x55, 0x93, 0xe0, 0x94, 0xe0, 0xf0, 0x4d, 0x09, 0xb6, 0xb9, 0xbb, 0xf1, 0x37,
-	0x30, 0x7b, 0xc4, 0xba, 0xe3, 0xc3, 0xc3, 0xef, 0x17, 0x75, 0xe3, 0xc7, 0x45, 0xdd, 0xf8, 0x75,
-	0x51, 0x37, 0x4e, 0xee, 0xf6, 0x98, 0x78, 0x1f, 0x76, 0xf6, 0x1d, 0x6f, 0x60, 0x93, 0x40, 0x3e,
-	0x2d, 0x1f, 0xe4, 0x62, 0xcf, 0xe9, 0xda, 0xc3, 0x96, 0xed, 0x9f, 0xf6, 0xa2, 0x94, 0x4e, 0x9f,
-	0xd1, 0xc9, 0xa3, 0xd4, 0x59, 0x94, 0x0f, 0xcb, 0x9d, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa7,
-	0xbc, 0x40, 0x6e, 0xb5, 0x06, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xda, 0xf9, 0x3d, 0x76, 0x12, 0x7a, 0xe2, 0x86, 0xd5, 0xd6, 0x35, 0xc9, 0x34, 0x6a,
+	0xdd, 0x40, 0xb3, 0xc2, 0x20, 0xfe, 0x6e, 0x50, 0x52, 0x10, 0xaa, 0xc4, 0x05, 0x32, 0xb4, 0x17,
+	0x85, 0x9b, 0xf1, 0x7a, 0x64, 0xa6, 0xb5, 0x77, 0x37, 0x3b, 0x63, 0x07, 0x64, 0x7c, 0x03, 0x8f,
+	0xc0, 0x33, 0x21, 0x71, 0x89, 0xc4, 0x0b, 0xa0, 0x88, 0x07, 0x41, 0x3b, 0xb3, 0xb3, 0x9e, 0x5d,
+	0x6f, 0x42, 0x7a, 0x65, 0xcf, 0x39, 0x33, 0xf3, 0x7d, 0x67, 0xce, 0x77, 0x3e, 0x2d, 0xb4, 0x05,
+	0x4b, 0x66, 0x2c, 0xf1, 0x69, 0x10, 0x44, 0xd3, 0x50, 0x9a, 0xdf, 0xd3, 0x38, 0x89, 0x64, 0x84,
+	0x9b, 0xd9, 0xd2, 0x6b, 0x8d, 0xa2, 0x51, 0xa4, 0x62, 0x7e, 0xfa, 0x4f, 0xa7, 0xbd, 0xf6, 0x28,
+	0x8a, 0x46, 0x63, 0xe6, 0xd3, 0x98, 0xfb, 0x34, 0x0c, 0x23, 0x49, 0x25, 0x8f, 0x42, 0xa1, 0xb3,
+	0xe4, 0x12, 0xee, 0x3e, 0x8f, 0x87, 0x54, 0xb2, 0x6f, 0xa8, 0x10, 0x97, 0x51, 0x32, 0xec, 0xb3,
+	0x8b, 0x29, 0x13, 0x12, 0x0f, 0xa1, 0x11, 0xb2, 0x4b, 0x13, 0x75, 0x9d, 0x43, 0xa7, 0xbb, 0xdd,
+	0xb7, 0x43, 0xd8, 0x85, 0xbd, 0x60, 0x9a, 0x24, 0x2c, 0x94, 0xf9, 0xae, 0x9a, 0xda, 0x55, 0x0e,
+	0x23, 0xc2, 0x5a, 0x48, 0x27, 0xcc, 0xad, 0xab, 0xb4, 0xfa, 0x4f, 0x5c, 0x38, 0x28, 0x03, 0x8b,
+	0x38, 0x0a, 0x05, 0x23, 0x01, 0x34, 0x9e, 0xd2, 0xf0, 0x99, 0x21, 0xe2, 0xc1, 0x56, 0xc2, 0x44,
+	0x34, 0x4d, 0x02, 0x96, 0xb1, 0xc8, 0xd7, 0x78, 0x00, 0x1b, 0x34, 0x48, 0xcb, 0xc9, 0x90, 0xb3,
+	0x55, 0x4a, 0x5e, 0x4c, 0x07, 0xf9, 0x31, 0x8d, 0x6b, 0x87, 0xc8, 0x31, 0x34, 0x35, 0x88, 0x06,
+	0xc5, 0x16, 0xac, 0xcf, 0xe8, 0x78, 0x6a, 0x20, 0xf4, 0x82, 0x3c, 0x82, 0x3b, 0x5f, 0x31, 0x79,
+	0xa6, 0xdf, 0xd7, 0x10, 0x32, 0xd5, 0x38, 0x56, 0x35, 0xbf, 0x39, 0xb0, 0x99, 0x6d, 0xab, 0xca,
+	0xa3, 0x0b, 0x9b, 0x2c, 0xa4, 0x83, 0x31, 0xd3, 0x6f, 0xb4, 0xd5, 0x37, 0x4b, 0x24, 0xd0, 0x0c,
+	0x68, 0x4c, 0x07, 0x7c, 0xcc, 0x25, 0x67, 0xc2, 0xad, 0x1f, 0xd6, 0xbb, 0xdb, 0xfd, 0x42, 0x0c,
+	0x1f, 0xc2, 0x86, 0x8c, 0x5e, 0xb3, 0x50, 0xb8, 0x6b, 0x87, 0xf5, 0x6e, 0xa3, 0xb7, 0x7b, 0x6a,
+	0x14, 0xf0, 0x5d, 0x1a, 0xee, 0x67, 0x59, 0xf2, 0x11, 0x34, 0x33, 0x12, 0xe2, 0x6b, 0x2e, 0x24,
+	0x3e, 0x84, 0x75, 0x2e, 0xd9, 0x44, 0xb8, 0x8e, 0x3a, 0xf6, 0x56, 0x7e, 0xcc, 0x54, 0xa4, 0xd3,
+	0xe4, 0x02, 0xd6, 0xd5, 0x45, 0xb8, 0x0b, 0x35, 0x6e, 0x7a, 0x5d, 0xe3, 0xc3, 0xf4, 0xed, 0xb9,
+	0x10, 0x53, 0x36, 0x3c, 0x93, 0x8a, 0x77, 0xbd, 0x9f, 0xaf, 0xb1, 0x0d, 0xdb, 0xec, 0xa7, 0x98,
+	0x27, 0x4c, 0x9c, 0x49, 0xf5, 0xc2, 0xf5, 0xfe, 0x32, 0x80, 0x1d, 0x80, 0x31, 0x15, 0xf2, 0xb9,
+	0x50, 0x67, 0xd7, 0x54, 0xda, 0x8a, 0x90, 0x1e, 0x80, 0x82, 0xd4, 0x44, 0x8f, 0x8b, 0x44, 0xcb,
+	0xf5, 0x65, 0x34, 0x5f, 0x00, 0x3e, 0x4d, 0x18, 0x95, 0x4c, 0x47, 0xaf, 0x6f, 0x87, 0xc5, 0xed,
+	0x59, 0x98, 0x11, 0x5f, 0x06, 0xb2, 0x2a, 0xeb, 0xa6, 0x4a, 0xf2, 0x2e, 0xec, 0x17, 0xee, 0x5d,
+	0x4a, 0x42, 0xbd, 0xab, 0x91, 0x84, 0x5a, 0x90, 0x4f, 0x00, 0xbf, 0x60, 0x63, 0x76, 0x0b, 0x12,
+	0x1a, 0xa6, 0x96, 0xc3, 0xb4, 0x00, 0xd3, 0x62, 0x8b, 0x6a, 0x22, 0xaf, 0xa0, 0xa5, 0xc1, 0xff,
+	0x5f, 0x65, 0x2b, 0x5a, 0xa9, 0x55, 0x68, 0xc5, 0x83, 0xad, 0x21, 0x17, 0x5a, 0x6a, 0x75, 0x25,
+	0xb5, 0x7c, 0x4d, 0x8e, 0x60, 0xfb, 0x3c, 0x8a, 0xc6, 0x2f, 0x52, 0x6d, 0x17, 0x15, 0xbf, 0x65,
+	0x14, 0xff, 0x0b, 0xb4, 0xf4, 0x58, 0xde, 0x82, 0xce, 0x7b, 0x4b, 0x51, 0xa7, 0x0d, 0x6e, 0xf4,
+	0x30, 0xef, 0x5b, 0x0e, 0xf3, 0x46, 0x42, 0x27, 0x8f, 0x61, 0xdf, 0x7a, 0x5c, 0x71, 0xd3, 0xc4,
+	0xed, 0xc1, 0xce, 0x97, 0x93, 0x58, 0xfe, 0x6c, 0xda, 0xd5, 0xfb, 0x63, 0x13, 0x76, 0x33, 0xd2,
+	0xdf, 0xb2, 0x64, 0xc6, 0x03, 0x86, 0x12, 0xd6, 0xd2, 0x21, 0xc7, 0x56, 0xce, 0xcb, 0x32, 0x16,
+	0xef, 0x6e, 0x29, 0x9a, 0xd9, 0xcf, 0xe7, 0xbf, 0xfe, 0xfd, 0xef, 0xef, 0xb5, 0x4f, 0xf1, 0x63,
+	0xe5, 0x98, 0xb3, 0xf7, 0x73, 0xd7, 0x0d, 0x68, 0xf8, 0x84, 0xfb, 0x73, 0x63, 0x21, 0x0b, 0x7f,
+	0xae, 0xdd, 0x66, 0xe1, 0xcf, 0x2d, 0x67, 0x59, 0xe0, 0x0c, 0x76, 0x8b, 0xce, 0x86, 0x9d, 0x1c,
+	0xa9, 0xd2, 0x6b, 0xbd, 0x77, 0xae, 0xcd, 0x67, 0x9c, 0x1e, 0x28, 0x4e, 0xf7, 0x3d, 0xb7, 0xcc,
+	0x29, 0xce, 0x76, 0x7e, 0xe6, 0x9c, 0xe0, 0xf7, 0xd0, 0xb4, 0xf4, 0x25, 0xf0, 0x5e, 0x7e, 0xeb,
+	0xaa, 0xec, 0xac, 0xe2, 0x6d, 0xc7, 0x20, 0x6f, 0x2b, 0xa0, 0x3b, 0xb8, 0x57, 0x02, 0xc2, 0x97,
+	0x00, 0x4b, 0x27, 0x44, 0x2f, 0x3f, 0xbd, 0x62, 0x8f, 0xde, 0x8a, 0xcb, 0x90, 0x8e, 0xba, 0xd4,
+	0xc5, 0x83, 0x32, 0xfb, 0x79, 0xda, 0xc9, 0x05, 0xfe, 0x00, 0x3b, 0x85, 0x11, 0xc0, 0xfb, 0xcb,
+	0xce, 0x54, 0x8c, 0x46, 0x05, 0x82, 0xa7, 0x10, 0x5a, 0xa4, 0x4c, 0x3b, 0x7d, 0x96, 0x00, 0x76,
+	0x0a, 0x8a, 0xb6, 0x6e, 0xaf, 0x52, 0x7a, 0xc5, 0xed, 0x47, 0xea, 0xf6, 0x7b, 0xde, 0x35, 0xfc,
+	0x53, 0x90, 0x0b, 0x68, 0x58, 0x16, 0x62, 0x3d, 0xfd, 0xaa, 0x61, 0x79, 0xed, 0xea, 0x64, 0xd6,
+	0xea, 0x47, 0x0a, 0xec, 0x88, 0xb4, 0xab, 0xc1, 0x7c, 0xe5, 0x42, 0x29, 0xe4, 0x04, 0x1a, 0xd6,
+	0xac, 0x58, 0x90, 0xab, 0xf6, 0xe4, 0x1d, 0xe4, 0xc9, 0xc2, 0xcc, 0x90, 0xc7, 0x0a, 0xec, 0xc1,
+	0xc9, 0xd1, 0x4d, 0x60, 0xfe, 0x9c, 0x0f, 0x17, 0x38, 0x82, 0xa6, 0x3d, 0x9a, 0xd8, 0xae, 0xc2,
+	0x33, 0x13, 0xeb, 0xed, 0x17, 0x1d, 0x5c, 0x8b, 0xeb, 0x58, 0xa1, 0x75, 0x4e, 0x6e, 0x2c, 0xed,
+	0xfc, 0xfc, 0xcf, 0xab, 0x8e, 0xf3, 0xd7, 0x55, 0xc7, 0xf9, 0xe7, 0xaa, 0xe3, 0xbc, 0xfc, 0x70,
+	0xc4, 0xe5, 0x8f, 0xd3, 0xc1, 0x69, 0x10, 0x4d, 0x7c, 0x9a, 0xa8, 0xcf, 0x9b, 0x57, 0xea, 0xcf,
+	0x93, 0x60, 0xe8, 0xcf, 0x7a, 0x7e, 0xfc, 0x7a, 0x94, 0xde, 0x16, 0x8c, 0x39, 0x5b, 0x7e, 0x18,
+	0x0d, 0x36, 0xd4, 0xc7, 0xcd, 0x07, 0xff, 0x0d, 0x00, 0x9c, 0xc9, 0x68, 0x60, 0x39, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -849,10 +1096,16 @@ type AccountServiceClient interface {
 	ListAccounts(ctx context.Context, in *ListAccountRequest, opts ...grpc.CallOption) (*AccountsList, error)
 	// GetAccount returns an account
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// CreateAccount creates a local account
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// UpdateAccount updates whether a local account is enabled and its capabilities
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// DeleteTokens deletes all the tokens of an account and returns them
+	DeleteTokens(ctx context.Context, in *DeleteTokensRequest, opts ...grpc.CallOption) (*TokensList, error)
 }
 
 type accountServiceClient struct {
@@ -899,6 +1152,24 @@ func (c *accountServiceClient) GetAccount(ctx context.Context, in *GetAccountReq
 	return out, nil
 }
 
+func (c *accountServiceClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/account.AccountService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/account.AccountService/UpdateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error) {
 	out := new(CreateTokenResponse)
 	err := c.cc.Invoke(ctx, "/account.AccountService/CreateToken", in, out, opts...)
@@ -917,6 +1188,15 @@ func (c *accountServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenR
 	return out, nil
 }
 
+func (c *accountServiceClient) DeleteTokens(ctx context.Context, in *DeleteTokensRequest, opts ...grpc.CallOption) (*TokensList, error) {
+	out := new(TokensList)
+	err := c.cc.Invoke(ctx, "/account.AccountService/DeleteTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 type AccountServiceServer interface {
 	// CanI checks if the current account has permission to perform an action
@@ -927,10 +1207,16 @@ type AccountServiceServer interface {
 	ListAccounts(context.Context, *ListAccountRequest) (*AccountsList, error)
 	// GetAccount returns an account
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// CreateAccount creates a local account
+	CreateAccount(context.Context, *CreateAccountRequest) (*Account, error)
+	// UpdateAccount updates whether a local account is enabled and its capabilities
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*Account, error)
 	// CreateToken creates a token
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(context.Context, *DeleteTokenRequest) (*EmptyResponse, error)
+	// DeleteTokens deletes all the tokens of an account and returns them
+	DeleteTokens(context.Context, *DeleteTokensRequest) (*TokensList, error)
 }
 
 // UnimplementedAccountServiceServer can be embedded to have forward compatible implementations.
@@ -949,12 +1235,21 @@ func (*UnimplementedAccountServiceServer) ListAccounts(ctx context.Context, req
 func (*UnimplementedAccountServiceServer) GetAccount(ctx context.Context, req *GetAccountRequest) (*Account, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
+func (*UnimplementedAccountServiceServer) CreateAccount(ctx context.Context, req *CreateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (*UnimplementedAccountServiceServer) UpdateAccount(ctx context.Context, req *UpdateAccountRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
 func (*UnimplementedAccountServiceServer) CreateToken(ctx context.Context, req *CreateTokenRequest) (*CreateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateToken not implemented")
 }
 func (*UnimplementedAccountServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedAccountServiceServer) DeleteTokens(ctx context.Context, req *DeleteTokensRequest) (*TokensList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTokens not implemented")
+}
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
 	s.RegisterService(&_AccountService_serviceDesc, srv)
@@ -1032,6 +1327,42 @@ func _AccountService_GetAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/UpdateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_CreateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTokenRequest)
 	if err := dec(in); err != nil {
@@ -1068,6 +1399,24 @@ func _AccountService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_DeleteTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).DeleteTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/DeleteTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).DeleteTokens(ctx, req.(*DeleteTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -1088,6 +1437,14 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccount",
 			Handler:    _AccountService_GetAccount_Handler,
 		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _AccountService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _AccountService_UpdateAccount_Handler,
+		},
 		{
 			MethodName: "CreateToken",
 			Handler:    _AccountService_CreateToken_Handler,
@@ -1096,6 +1453,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _AccountService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "DeleteTokens",
+			Handler:    _AccountService_DeleteTokens_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -1424,6 +1785,11 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ExpiresAt != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
 		i--
@@ -1633,7 +1999,7 @@ func (m *ListAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *CreateAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1643,12 +2009,12 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1657,16 +2023,195 @@ func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAccount(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BoolValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BoolValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BoolValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value {
+		i--
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Enabled != nil {
+		{
+			size, err := m.Enabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Capabilities) > 0 {
+		for iNdEx := len(m.Capabilities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Capabilities[iNdEx])
+			copy(dAtA[i:], m.Capabilities[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Capabilities[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -1828,6 +2373,9 @@ func (m *Token) Size() (n int) {
 	if m.ExpiresAt != 0 {
 		n += 1 + sovAccount(uint64(m.ExpiresAt))
 	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovAccount(uint64(m.LastUsedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1923,6 +2471,88 @@ func (m *ListAccountRequest) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BoolValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Capabilities) > 0 {
+		for _, s := range m.Capabilities {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.Enabled != nil {
+		l = m.Enabled.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EmptyResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2805,6 +3435,25 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3295,6 +3944,446 @@ func (m *ListAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capabilities = append(m.Capabilities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enabled == nil {
+				m.Enabled = &BoolValue{}
+			}
+			if err := m.Enabled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
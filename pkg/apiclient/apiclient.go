@@ -349,6 +349,20 @@ func (c *client) OIDCConfig(ctx context.Context, set *settingspkg.Settings) (*oa
 	return &oauth2conf, provider, nil
 }
 
+// SettingsWithOIDCProvider returns a copy of the given settings performing SSO with the additional OIDC provider
+// matching the given predicate, or nil if none matches
+func SettingsWithOIDCProvider(set *settingspkg.Settings, matches func(provider *settingspkg.OIDCConfig) bool) *settingspkg.Settings {
+	for _, provider := range set.OIDCProviders {
+		if matches(provider) {
+			res := *set
+			res.OIDCConfig = provider
+			res.DexConfig = nil
+			return &res
+		}
+	}
+	return nil
+}
+
 // HTTPClient returns a HTTPClient appropriate for performing OAuth, based on TLS settings
 func (c *client) HTTPClient() (*http.Client, error) {
 	tlsConfig, err := c.tlsConfig()
@@ -406,7 +420,7 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 	}
 
 	log.Debug("Auth token no longer valid. Refreshing")
-	rawIDToken, refreshToken, err := c.redeemRefreshToken()
+	rawIDToken, refreshToken, err := c.redeemRefreshToken(claims.Issuer)
 	if err != nil {
 		return err
 	}
@@ -425,7 +439,7 @@ func (c *client) refreshAuthToken(localCfg *localconfig.LocalConfig, ctxName, co
 }
 
 // redeemRefreshToken performs the exchange of a refresh_token for a new id_token and refresh_token
-func (c *client) redeemRefreshToken() (string, string, error) {
+func (c *client) redeemRefreshToken(issuer string) (string, string, error) {
 	setConn, setIf, err := c.NewSettingsClient()
 	if err != nil {
 		return "", "", err
@@ -440,6 +454,10 @@ func (c *client) redeemRefreshToken() (string, string, error) {
 	if err != nil {
 		return "", "", err
 	}
+	// the token must be refreshed by the provider which issued it
+	if set := SettingsWithOIDCProvider(acdSet, func(provider *settingspkg.OIDCConfig) bool { return provider.Issuer == issuer }); set != nil {
+		acdSet = set
+	}
 	oauth2conf, _, err := c.OIDCConfig(ctx, acdSet)
 	if err != nil {
 		return "", "", err
@@ -45,12 +45,24 @@ type ApplicationQuery struct {
 	Refresh *string `protobuf:"bytes,2,opt,name=refresh" json:"refresh,omitempty"`
 	// the project names to restrict returned list applications
 	Projects []string `protobuf:"bytes,3,rep,name=project" json:"project,omitempty"`
-	// when specified with a watch call, shows changes that occur after that particular version of a resource.
+	// when specified with a watch call, shows changes that occur after that particular version of a resource. The watch fails with the OutOfRange code if the version is too old.
 	ResourceVersion string `protobuf:"bytes,4,opt,name=resourceVersion" json:"resourceVersion"`
 	// the selector to to restrict returned list to applications only with matched labels
 	Selector string `protobuf:"bytes,5,opt,name=selector" json:"selector"`
 	// the repoURL to restrict returned list applications
-	Repo                 string   `protobuf:"bytes,6,opt,name=repo" json:"repo"`
+	Repo string `protobuf:"bytes,6,opt,name=repo" json:"repo"`
+	// the selector to restrict returned list to applications only with matched fields, e.g. status.sync.status=OutOfSync
+	FieldSelector string `protobuf:"bytes,7,opt,name=fieldSelector" json:"fieldSelector"`
+	// the key returned list applications are sorted by: name, sync or health, prefixed with '-' for descending order. Defaults to name.
+	SortBy string `protobuf:"bytes,8,opt,name=sortBy" json:"sortBy"`
+	// the maximum number of returned list applications, all if zero
+	Limit int64 `protobuf:"varint,9,opt,name=limit" json:"limit"`
+	// the continue token of the previous page of a limited list
+	Continue string `protobuf:"bytes,10,opt,name=continue" json:"continue"`
+	// when specified with a watch call, sends the changes of the applications already sent as JSON merge patches
+	Incremental bool `protobuf:"varint,11,opt,name=incremental" json:"incremental"`
+	// when specified with a watch call, periodically sends BOOKMARK events holding the latest resource version
+	AllowWatchBookmarks  bool     `protobuf:"varint,12,opt,name=allowWatchBookmarks" json:"allowWatchBookmarks"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -131,6 +143,48 @@ func (m *ApplicationQuery) GetRepo() string {
 	return ""
 }
 
+func (m *ApplicationQuery) GetFieldSelector() string {
+	if m != nil {
+		return m.FieldSelector
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ApplicationQuery) GetContinue() string {
+	if m != nil {
+		return m.Continue
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetIncremental() bool {
+	if m != nil {
+		return m.Incremental
+	}
+	return false
+}
+
+func (m *ApplicationQuery) GetAllowWatchBookmarks() bool {
+	if m != nil {
+		return m.AllowWatchBookmarks
+	}
+	return false
+}
+
 type NodeQuery struct {
 	// the application's name
 	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -751,6 +805,227 @@ func (m *ApplicationSyncRequest) GetSyncOptions() *SyncOptions {
 	return nil
 }
 
+// SyncPreviewResource is the action a sync would perform on a resource
+type SyncPreviewResource struct {
+	Group     string `protobuf:"bytes,1,opt,name=group" json:"group"`
+	Version   string `protobuf:"bytes,2,opt,name=version" json:"version"`
+	Kind      string `protobuf:"bytes,3,opt,name=kind" json:"kind"`
+	Namespace string `protobuf:"bytes,4,opt,name=namespace" json:"namespace"`
+	Name      string `protobuf:"bytes,5,opt,name=name" json:"name"`
+	// Action is the planned action: Create, Update, Unchanged or Prune
+	Action    string `protobuf:"bytes,6,opt,name=action" json:"action"`
+	SyncPhase string `protobuf:"bytes,7,opt,name=syncPhase" json:"syncPhase"`
+	SyncWave  int64  `protobuf:"varint,8,opt,name=syncWave" json:"syncWave"`
+	// HookType is the type of the hook, empty for the resources which are not hooks
+	HookType string `protobuf:"bytes,9,opt,name=hookType" json:"hookType"`
+	// Status is the result of the dry-run of the resource
+	Status               string   `protobuf:"bytes,10,opt,name=status" json:"status"`
+	Message              string   `protobuf:"bytes,11,opt,name=message" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncPreviewResource) Reset()         { *m = SyncPreviewResource{} }
+func (m *SyncPreviewResource) String() string { return proto.CompactTextString(m) }
+func (*SyncPreviewResource) ProtoMessage()    {}
+func (*SyncPreviewResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{11}
+}
+func (m *SyncPreviewResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPreviewResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncPreviewResource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncPreviewResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPreviewResource.Merge(m, src)
+}
+func (m *SyncPreviewResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPreviewResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPreviewResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPreviewResource proto.InternalMessageInfo
+
+func (m *SyncPreviewResource) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetSyncPhase() string {
+	if m != nil {
+		return m.SyncPhase
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetSyncWave() int64 {
+	if m != nil {
+		return m.SyncWave
+	}
+	return 0
+}
+
+func (m *SyncPreviewResource) GetHookType() string {
+	if m != nil {
+		return m.HookType
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SyncPreviewResource) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// SyncPreviewResponse is the outcome of the dry-run of a sync, listing the resources in the order they would be synced
+type SyncPreviewResponse struct {
+	Revision  string                 `protobuf:"bytes,1,opt,name=revision" json:"revision"`
+	Phase     string                 `protobuf:"bytes,2,opt,name=phase" json:"phase"`
+	Message   string                 `protobuf:"bytes,3,opt,name=message" json:"message"`
+	Resources []*SyncPreviewResource `protobuf:"bytes,4,rep,name=resources" json:"resources,omitempty"`
+	// BlockedBySyncWindows tells whether the sync windows of the project currently block the sync
+	BlockedBySyncWindows bool `protobuf:"varint,5,opt,name=blockedBySyncWindows" json:"blockedBySyncWindows"`
+	// ActiveSyncWindows are the sync windows of the application which are currently active
+	ActiveSyncWindows    []*ApplicationSyncWindow `protobuf:"bytes,6,rep,name=activeSyncWindows" json:"activeSyncWindows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SyncPreviewResponse) Reset()         { *m = SyncPreviewResponse{} }
+func (m *SyncPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncPreviewResponse) ProtoMessage()    {}
+func (*SyncPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{12}
+}
+func (m *SyncPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPreviewResponse.Merge(m, src)
+}
+func (m *SyncPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPreviewResponse proto.InternalMessageInfo
+
+func (m *SyncPreviewResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *SyncPreviewResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *SyncPreviewResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SyncPreviewResponse) GetResources() []*SyncPreviewResource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *SyncPreviewResponse) GetBlockedBySyncWindows() bool {
+	if m != nil {
+		return m.BlockedBySyncWindows
+	}
+	return false
+}
+
+func (m *SyncPreviewResponse) GetActiveSyncWindows() []*ApplicationSyncWindow {
+	if m != nil {
+		return m.ActiveSyncWindows
+	}
+	return nil
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -765,7 +1040,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{11}
+	return fileDescriptor_df6e82b174b5eaec, []int{13}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -829,7 +1104,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{12}
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1168,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{13}
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1241,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1330,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1158,7 +1433,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,13 +1519,15 @@ func (m *ApplicationResourceDeleteRequest) GetOrphan() bool {
 }
 
 type ResourceActionRunRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	ResourceName         string   `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
-	Version              string   `protobuf:"bytes,4,req,name=version" json:"version"`
-	Group                string   `protobuf:"bytes,5,req,name=group" json:"group"`
-	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
-	Action               string   `protobuf:"bytes,7,req,name=action" json:"action"`
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace    string  `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	ResourceName string  `protobuf:"bytes,3,req,name=resourceName" json:"resourceName"`
+	Version      string  `protobuf:"bytes,4,req,name=version" json:"version"`
+	Group        string  `protobuf:"bytes,5,req,name=group" json:"group"`
+	Kind         string  `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Action       string  `protobuf:"bytes,7,req,name=action" json:"action"`
+	// the values of the parameters of the action, formatted as name=value
+	Parameters           []string `protobuf:"bytes,8,rep,name=parameters" json:"parameters,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1260,7 +1537,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,6 +1615,13 @@ func (m *ResourceActionRunRequest) GetAction() string {
 	return ""
 }
 
+func (m *ResourceActionRunRequest) GetParameters() []string {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
 type ResourceActionsListResponse struct {
 	Actions              []v1alpha1.ResourceAction `protobuf:"bytes,1,rep,name=actions" json:"actions"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -1349,7 +1633,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1680,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,19 +1717,25 @@ func (m *ApplicationResourceResponse) GetManifest() string {
 }
 
 type ApplicationPodLogsQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	PodName              *string  `protobuf:"bytes,3,opt,name=podName" json:"podName,omitempty"`
-	Container            string   `protobuf:"bytes,4,req,name=container" json:"container"`
-	SinceSeconds         int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
-	SinceTime            *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
-	TailLines            int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
-	Follow               bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
-	UntilTime            *string  `protobuf:"bytes,9,opt,name=untilTime" json:"untilTime,omitempty"`
-	Filter               *string  `protobuf:"bytes,10,opt,name=filter" json:"filter,omitempty"`
-	Kind                 *string  `protobuf:"bytes,11,opt,name=kind" json:"kind,omitempty"`
-	Group                *string  `protobuf:"bytes,12,opt,name=group" json:"group,omitempty"`
-	ResourceName         *string  `protobuf:"bytes,13,opt,name=resourceName" json:"resourceName,omitempty"`
+	Name         *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace    string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	PodName      *string  `protobuf:"bytes,3,opt,name=podName" json:"podName,omitempty"`
+	Container    string   `protobuf:"bytes,4,req,name=container" json:"container"`
+	SinceSeconds int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
+	SinceTime    *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
+	TailLines    int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
+	Follow       bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
+	UntilTime    *string  `protobuf:"bytes,9,opt,name=untilTime" json:"untilTime,omitempty"`
+	Filter       *string  `protobuf:"bytes,10,opt,name=filter" json:"filter,omitempty"`
+	Kind         *string  `protobuf:"bytes,11,opt,name=kind" json:"kind,omitempty"`
+	Group        *string  `protobuf:"bytes,12,opt,name=group" json:"group,omitempty"`
+	ResourceName *string  `protobuf:"bytes,13,opt,name=resourceName" json:"resourceName,omitempty"`
+	// shows the logs of the previous terminated instance of the containers
+	Previous bool `protobuf:"varint,14,opt,name=previous" json:"previous"`
+	// shows the logs of all the containers of the pods, including the init containers, instead of a single container
+	AllContainers bool `protobuf:"varint,15,opt,name=allContainers" json:"allContainers"`
+	// interprets the filter as a regular expression, which can still be inverted with a '!' prefix
+	FilterRegex          bool     `protobuf:"varint,16,opt,name=filterRegex" json:"filterRegex"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1455,7 +1745,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,13 +1865,36 @@ func (m *ApplicationPodLogsQuery) GetResourceName() string {
 	return ""
 }
 
+func (m *ApplicationPodLogsQuery) GetPrevious() bool {
+	if m != nil {
+		return m.Previous
+	}
+	return false
+}
+
+func (m *ApplicationPodLogsQuery) GetAllContainers() bool {
+	if m != nil {
+		return m.AllContainers
+	}
+	return false
+}
+
+func (m *ApplicationPodLogsQuery) GetFilterRegex() bool {
+	if m != nil {
+		return m.FilterRegex
+	}
+	return false
+}
+
 type LogEntry struct {
 	Content string `protobuf:"bytes,1,req,name=content" json:"content"`
 	// deprecated in favor of timeStampStr since meta.v1.Time don't support nano time
-	TimeStamp            v1.Time  `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"` // Deprecated: Do not use.
-	Last                 bool     `protobuf:"varint,3,req,name=last" json:"last"`
-	TimeStampStr         string   `protobuf:"bytes,4,req,name=timeStampStr" json:"timeStampStr"`
-	PodName              string   `protobuf:"bytes,5,req,name=podName" json:"podName"`
+	TimeStamp    v1.Time `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"` // Deprecated: Do not use.
+	Last         bool    `protobuf:"varint,3,req,name=last" json:"last"`
+	TimeStampStr string  `protobuf:"bytes,4,req,name=timeStampStr" json:"timeStampStr"`
+	PodName      string  `protobuf:"bytes,5,req,name=podName" json:"podName"`
+	// the name of the container the line was logged by, empty if the logs of the default container of the pod were requested
+	ContainerName        string   `protobuf:"bytes,6,opt,name=containerName" json:"containerName"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1591,7 +1904,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1656,6 +1969,13 @@ func (m *LogEntry) GetPodName() string {
 	return ""
 }
 
+func (m *LogEntry) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1667,7 +1987,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1714,7 +2034,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1763,7 +2083,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +2147,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2214,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1939,7 +2259,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2021,7 +2341,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2057,22 +2377,191 @@ func (m *ManagedResourcesResponse) GetItems() []*v1alpha1.ResourceDiff {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
-	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
-	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
-	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
-	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
-	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
-	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
-	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
-	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
-	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
-	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
-	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
-	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
-	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
-	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
+// ListAppLinksRequest is the request to list the deep links of an application
+type ListAppLinksRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
+func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAppLinksRequest) ProtoMessage()    {}
+func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
+}
+func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAppLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAppLinksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAppLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAppLinksRequest.Merge(m, src)
+}
+func (m *ListAppLinksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAppLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAppLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAppLinksRequest proto.InternalMessageInfo
+
+func (m *ListAppLinksRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+// LinkInfo is a deep link rendered for an application or one of its resources
+type LinkInfo struct {
+	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
+	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
+	Description          *string  `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	IconClass            *string  `protobuf:"bytes,4,opt,name=iconClass" json:"iconClass,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
+func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
+func (*LinkInfo) ProtoMessage()    {}
+func (*LinkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
+}
+func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkInfo.Merge(m, src)
+}
+func (m *LinkInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkInfo proto.InternalMessageInfo
+
+func (m *LinkInfo) GetTitle() string {
+	if m != nil && m.Title != nil {
+		return *m.Title
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetUrl() string {
+	if m != nil && m.Url != nil {
+		return *m.Url
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetDescription() string {
+	if m != nil && m.Description != nil {
+		return *m.Description
+	}
+	return ""
+}
+
+func (m *LinkInfo) GetIconClass() string {
+	if m != nil && m.IconClass != nil {
+		return *m.IconClass
+	}
+	return ""
+}
+
+type LinksResponse struct {
+	Items                []*LinkInfo `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
+func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
+func (*LinksResponse) ProtoMessage()    {}
+func (*LinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
+}
+func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinksResponse.Merge(m, src)
+}
+func (m *LinksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinksResponse proto.InternalMessageInfo
+
+func (m *LinksResponse) GetItems() []*LinkInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
+	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
+	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
+	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
+	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
+	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
+	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
+	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
+	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
+	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
+	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*SyncPreviewResource)(nil), "application.SyncPreviewResource")
+	proto.RegisterType((*SyncPreviewResponse)(nil), "application.SyncPreviewResponse")
+	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
+	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
+	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
+	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
 	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
 	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
 	proto.RegisterType((*ResourceActionRunRequest)(nil), "application.ResourceActionRunRequest")
@@ -2087,6 +2576,9 @@ func init() {
 	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
 	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
+	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
+	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 }
 
 func init() {
@@ -2094,153 +2586,186 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x8f, 0xdc, 0x48,
-	0xf5, 0xff, 0x56, 0xcf, 0xaf, 0xee, 0xd7, 0xc9, 0x26, 0xa9, 0x4d, 0xf2, 0xf5, 0x3a, 0x93, 0x49,
-	0xab, 0xf2, 0x6b, 0x32, 0xc9, 0xb8, 0x93, 0x26, 0x42, 0x61, 0x16, 0xb4, 0x64, 0x36, 0x21, 0x1b,
-	0x98, 0x64, 0x07, 0x4f, 0x42, 0xd0, 0x72, 0x00, 0xaf, 0x5d, 0xd3, 0x63, 0xc6, 0x6d, 0x3b, 0xb6,
-	0xbb, 0xa3, 0x56, 0xc8, 0x65, 0x91, 0xb8, 0x80, 0x00, 0x41, 0x0e, 0x80, 0x10, 0x42, 0xac, 0xf6,
-	0xcc, 0x0d, 0x10, 0xb7, 0xbd, 0xa0, 0xdd, 0x1b, 0x82, 0x3d, 0x47, 0x4b, 0xc4, 0x1f, 0xc0, 0x9f,
-	0x80, 0xaa, 0x5c, 0x65, 0x97, 0x7b, 0xba, 0xdd, 0x9d, 0x9d, 0x5e, 0xa1, 0xdc, 0xba, 0x5e, 0x95,
-	0xdf, 0xfb, 0xbc, 0xdf, 0x55, 0x6f, 0x06, 0xce, 0xc4, 0x34, 0xea, 0xd1, 0xa8, 0x69, 0x85, 0xa1,
-	0xe7, 0xda, 0x56, 0xe2, 0x06, 0xbe, 0xfa, 0xdb, 0x08, 0xa3, 0x20, 0x09, 0x70, 0x5d, 0x21, 0xe9,
-	0x47, 0xdb, 0x41, 0x3b, 0xe0, 0xf4, 0x26, 0xfb, 0x95, 0x1e, 0xd1, 0x17, 0xdb, 0x41, 0xd0, 0xf6,
-	0x68, 0xd3, 0x0a, 0xdd, 0xa6, 0xe5, 0xfb, 0x41, 0xc2, 0x0f, 0xc7, 0x62, 0x97, 0xec, 0x5e, 0x8b,
-	0x0d, 0x37, 0xe0, 0xbb, 0x76, 0x10, 0xd1, 0x66, 0xef, 0x4a, 0xb3, 0x4d, 0x7d, 0x1a, 0x59, 0x09,
-	0x75, 0xc4, 0x99, 0xab, 0xf9, 0x99, 0x8e, 0x65, 0xef, 0xb8, 0x3e, 0x8d, 0xfa, 0xcd, 0x70, 0xb7,
-	0xcd, 0x08, 0x71, 0xb3, 0x43, 0x13, 0x6b, 0xd8, 0x57, 0x1b, 0x6d, 0x37, 0xd9, 0xe9, 0xbe, 0x6b,
-	0xd8, 0x41, 0xa7, 0x69, 0x45, 0x1c, 0xd8, 0xf7, 0xf9, 0x8f, 0x55, 0xdb, 0x69, 0xf6, 0x5a, 0x39,
-	0x03, 0x55, 0xc3, 0xde, 0x15, 0xcb, 0x0b, 0x77, 0xac, 0xbd, 0xdc, 0x6e, 0x8e, 0xe1, 0x16, 0xd1,
-	0x30, 0x10, 0x16, 0xe3, 0x3f, 0xdd, 0x24, 0x88, 0xfa, 0xca, 0xcf, 0x94, 0x0d, 0xf9, 0x04, 0xc1,
-	0xe1, 0xeb, 0xb9, 0xbc, 0x6f, 0x76, 0x69, 0xd4, 0xc7, 0x18, 0x66, 0x7d, 0xab, 0x43, 0x35, 0xd4,
-	0x40, 0xcb, 0x35, 0x93, 0xff, 0xc6, 0x1a, 0x2c, 0x44, 0x74, 0x3b, 0xa2, 0xf1, 0x8e, 0x56, 0xe1,
-	0x64, 0xb9, 0xc4, 0xe7, 0x60, 0x81, 0x09, 0xa7, 0x76, 0xa2, 0xcd, 0x34, 0x66, 0x96, 0x6b, 0xeb,
-	0x07, 0x9e, 0x3f, 0x3b, 0x55, 0xdd, 0x4c, 0x49, 0xb1, 0x29, 0x37, 0xb1, 0x01, 0x87, 0x22, 0x1a,
-	0x07, 0xdd, 0xc8, 0xa6, 0xdf, 0xa2, 0x51, 0xec, 0x06, 0xbe, 0x36, 0xcb, 0x38, 0xad, 0xcf, 0x7e,
-	0xf4, 0xec, 0xd4, 0xff, 0x99, 0x83, 0x9b, 0xb8, 0x01, 0xd5, 0x98, 0x7a, 0xd4, 0x4e, 0x82, 0x48,
-	0x9b, 0x53, 0x0e, 0x66, 0x54, 0xac, 0xc1, 0x2c, 0x53, 0x48, 0x9b, 0x57, 0x76, 0x39, 0x85, 0x9c,
-	0x82, 0xda, 0xdd, 0xc0, 0xa1, 0x23, 0xd5, 0x21, 0xb7, 0xe0, 0x98, 0x49, 0x7b, 0x2e, 0x13, 0x74,
-	0x87, 0x26, 0x96, 0x63, 0x25, 0xd6, 0xe0, 0xe1, 0x4a, 0xa6, 0xbb, 0x0e, 0xd5, 0x48, 0x1c, 0xd6,
-	0x2a, 0x9c, 0x9e, 0xad, 0xc9, 0x5f, 0x11, 0x2c, 0x29, 0x06, 0x34, 0x85, 0x12, 0x37, 0x7b, 0xd4,
-	0x4f, 0xe2, 0xd1, 0x2c, 0x5b, 0x70, 0x44, 0xea, 0x7b, 0xd7, 0xea, 0xd0, 0x38, 0xb4, 0x6c, 0x9a,
-	0xf2, 0x16, 0x7a, 0xec, 0xdd, 0xc6, 0xcb, 0x70, 0x40, 0x25, 0x6a, 0x33, 0xca, 0xf1, 0xc2, 0x0e,
-	0x3e, 0x07, 0x75, 0xb9, 0xbe, 0x7f, 0xfb, 0x86, 0x36, 0xab, 0x1c, 0x54, 0x37, 0xc8, 0x26, 0x68,
-	0x0a, 0xf6, 0x3b, 0x96, 0xef, 0x6e, 0xd3, 0x38, 0x19, 0x8d, 0xba, 0x51, 0x30, 0x84, 0xe2, 0x92,
-	0xcc, 0x1c, 0xc7, 0xe0, 0xd5, 0xa2, 0x35, 0xc2, 0xc0, 0x8f, 0x29, 0xf9, 0x10, 0x15, 0x24, 0xbd,
-	0x19, 0x51, 0x2b, 0xa1, 0x26, 0x7d, 0xd8, 0xa5, 0x71, 0x82, 0x1f, 0x82, 0x9a, 0xb5, 0x5c, 0x60,
-	0xbd, 0x75, 0xdb, 0xc8, 0x03, 0xdc, 0x90, 0x01, 0xce, 0x7f, 0x7c, 0xd7, 0x76, 0x8c, 0x5e, 0xcb,
-	0x08, 0x77, 0xdb, 0x06, 0x4b, 0x17, 0x43, 0x2d, 0x02, 0x32, 0x5d, 0x0c, 0x45, 0x98, 0x54, 0x5c,
-	0x39, 0x87, 0x8f, 0xc3, 0x7c, 0x37, 0x8c, 0x69, 0x94, 0x70, 0x35, 0xaa, 0xa6, 0x58, 0x31, 0x4f,
-	0xf7, 0x2c, 0xcf, 0x75, 0xac, 0x84, 0x99, 0x97, 0xed, 0x64, 0x6b, 0xf2, 0x7e, 0x51, 0x87, 0xfb,
-	0xa1, 0xa3, 0xe8, 0xb0, 0xfb, 0xf9, 0xea, 0x50, 0x44, 0xaf, 0xa2, 0xac, 0x0c, 0xa0, 0xec, 0x15,
-	0x40, 0xde, 0xa0, 0x1e, 0xcd, 0x41, 0x0e, 0x73, 0xa9, 0x06, 0x0b, 0xb6, 0x15, 0xdb, 0x96, 0x23,
-	0x59, 0xc9, 0x25, 0xbe, 0x04, 0x47, 0xc2, 0x28, 0x08, 0xad, 0x36, 0xe7, 0xb4, 0x19, 0x78, 0xae,
-	0xdd, 0xe7, 0x46, 0xa9, 0x99, 0x7b, 0x37, 0xc8, 0x69, 0xa8, 0x6f, 0xf5, 0x7d, 0xfb, 0xed, 0x90,
-	0x17, 0x53, 0x7c, 0x14, 0xe6, 0xdc, 0x84, 0x76, 0x62, 0x0d, 0xb1, 0x92, 0x60, 0xa6, 0x0b, 0xf2,
-	0xf3, 0x39, 0x38, 0xae, 0xa0, 0x63, 0x1f, 0x94, 0x61, 0x1b, 0x1b, 0x6e, 0x78, 0x11, 0xe6, 0x9d,
-	0xa8, 0x6f, 0x76, 0xfd, 0xd4, 0x5b, 0x62, 0x5f, 0xd0, 0xb0, 0x0e, 0x73, 0x61, 0xd4, 0xf5, 0x29,
-	0xaf, 0x33, 0x72, 0x33, 0x25, 0xe1, 0x6d, 0xa8, 0xc6, 0x09, 0x2b, 0xa8, 0xed, 0x3e, 0xaf, 0x2e,
-	0xf5, 0xd6, 0xd7, 0xf7, 0xe7, 0x2d, 0xa6, 0xcc, 0x96, 0xe0, 0x68, 0x66, 0xbc, 0xf1, 0x23, 0xa8,
-	0xc9, 0x8c, 0x8b, 0xb5, 0x85, 0xc6, 0xcc, 0x72, 0xbd, 0xb5, 0xb5, 0x7f, 0x41, 0x6f, 0x87, 0xac,
-	0x19, 0x28, 0xf5, 0x46, 0x28, 0x97, 0xcb, 0xc2, 0x8b, 0x50, 0xeb, 0x88, 0x84, 0x8e, 0xb5, 0x2a,
-	0xf7, 0x42, 0x4e, 0xc0, 0xdf, 0x86, 0x39, 0xd7, 0xdf, 0x0e, 0x62, 0xad, 0xc6, 0x21, 0xad, 0xef,
-	0x0f, 0xd2, 0x6d, 0x7f, 0x3b, 0x30, 0x53, 0x86, 0xf8, 0x21, 0x1c, 0x8c, 0x68, 0x12, 0xf5, 0xa5,
-	0x2d, 0x34, 0xe0, 0xd6, 0xfd, 0xc6, 0xfe, 0x24, 0x98, 0x2a, 0x4b, 0xb3, 0x28, 0x01, 0xaf, 0x41,
-	0x3d, 0xce, 0x63, 0x4f, 0xab, 0x73, 0x81, 0x5a, 0x81, 0x91, 0x12, 0x9b, 0xa6, 0x7a, 0x98, 0xfc,
-	0x19, 0xc1, 0xe2, 0x9e, 0xac, 0xde, 0x0a, 0x69, 0x69, 0x60, 0xb6, 0x61, 0x36, 0x0e, 0xa9, 0xcd,
-	0x0b, 0x76, 0xbd, 0x75, 0x67, 0x6a, 0x69, 0xce, 0xe4, 0xca, 0x3e, 0xc6, 0x04, 0x94, 0xd6, 0xa3,
-	0x0e, 0xfc, 0xbf, 0xf2, 0xe9, 0xa6, 0x95, 0xd8, 0x3b, 0x65, 0x98, 0x59, 0x32, 0xb0, 0x33, 0x85,
-	0x2e, 0x93, 0x92, 0x30, 0x81, 0x1a, 0xff, 0x71, 0xaf, 0x1f, 0x16, 0xdb, 0x4a, 0x4e, 0x26, 0x3f,
-	0x42, 0xa0, 0xab, 0x15, 0x29, 0xf0, 0xbc, 0x77, 0x2d, 0x7b, 0xb7, 0x5c, 0x64, 0xc5, 0x75, 0xb8,
-	0xbc, 0x99, 0x75, 0x60, 0xfc, 0x9e, 0x3f, 0x3b, 0x55, 0xb9, 0x7d, 0xc3, 0xac, 0xb8, 0xce, 0x67,
-	0xcf, 0x5c, 0x76, 0x65, 0xd1, 0x87, 0x74, 0xdc, 0x32, 0x20, 0x04, 0x6a, 0xfe, 0xd0, 0x2e, 0x9b,
-	0x93, 0x5f, 0xa0, 0xbb, 0x2e, 0xc1, 0x42, 0x2f, 0xbb, 0xc0, 0xe4, 0x87, 0x24, 0x91, 0x81, 0x6f,
-	0x47, 0x41, 0x37, 0xd4, 0xe6, 0x54, 0x4b, 0x73, 0x12, 0xbb, 0xb2, 0xec, 0xba, 0xbe, 0xa3, 0xcd,
-	0x2b, 0x5b, 0x9c, 0x42, 0x7e, 0x5d, 0x81, 0x53, 0x43, 0xd4, 0x1a, 0xeb, 0xd7, 0x97, 0x40, 0xb7,
-	0x3c, 0xf6, 0x16, 0xc6, 0xc4, 0x5e, 0x75, 0x78, 0xec, 0x3d, 0xad, 0x40, 0x63, 0x88, 0x6d, 0xc6,
-	0x77, 0xb7, 0x97, 0xc4, 0x38, 0xdb, 0x41, 0x64, 0x53, 0x6d, 0x21, 0x8b, 0x75, 0x64, 0xa6, 0x24,
-	0x96, 0x25, 0x41, 0x14, 0xee, 0x58, 0xbe, 0x56, 0x55, 0x36, 0x05, 0x8d, 0xfc, 0x07, 0x81, 0x26,
-	0x6d, 0x71, 0xdd, 0xe6, 0x96, 0xe9, 0xfa, 0x2f, 0xbb, 0x39, 0x16, 0x61, 0xde, 0xe2, 0xba, 0x14,
-	0x82, 0x45, 0xd0, 0xc8, 0x8f, 0x11, 0x9c, 0x28, 0xaa, 0x1c, 0x6f, 0xb8, 0x71, 0x22, 0x2f, 0x9a,
-	0xd8, 0x83, 0x85, 0xf4, 0x64, 0x7a, 0xf3, 0xa8, 0xb7, 0x36, 0xf6, 0xdb, 0x77, 0x54, 0x59, 0x52,
-	0x43, 0x21, 0x82, 0xbc, 0x01, 0x27, 0x86, 0x56, 0x22, 0x01, 0xa6, 0x01, 0x55, 0xd9, 0x71, 0x53,
-	0x37, 0xc8, 0xfb, 0x8b, 0xa4, 0x92, 0x8f, 0x67, 0x8a, 0x45, 0x3c, 0x70, 0x36, 0x82, 0x76, 0xc9,
-	0xb3, 0x61, 0x12, 0x07, 0x6a, 0xb0, 0x10, 0x06, 0x8e, 0xf0, 0x1d, 0x7f, 0xa9, 0x89, 0x25, 0xfb,
-	0xda, 0x0e, 0xfc, 0xc4, 0x62, 0x0f, 0xd6, 0x82, 0xcb, 0x72, 0x32, 0x73, 0x7f, 0xec, 0xfa, 0x36,
-	0xdd, 0xa2, 0x76, 0xe0, 0x3b, 0x31, 0xf7, 0xdd, 0x8c, 0x74, 0xbf, 0xba, 0x83, 0xdf, 0x82, 0x1a,
-	0x5f, 0xdf, 0x73, 0x3b, 0x94, 0x3f, 0xc1, 0xea, 0xad, 0x15, 0x23, 0x7d, 0x19, 0x1b, 0xea, 0xcb,
-	0x38, 0xb7, 0x30, 0x7b, 0x19, 0x1b, 0xbd, 0x2b, 0x06, 0xfb, 0xc2, 0xcc, 0x3f, 0x66, 0xb8, 0x12,
-	0xcb, 0xf5, 0x36, 0x5c, 0x9f, 0xdf, 0x91, 0x72, 0x81, 0x39, 0x99, 0x85, 0xc5, 0x76, 0xe0, 0x79,
-	0xc1, 0x23, 0x5e, 0x23, 0xb2, 0x7e, 0x91, 0xd2, 0xd8, 0x65, 0xa7, 0xeb, 0x27, 0xae, 0xc7, 0xb1,
-	0xd4, 0xb8, 0xd6, 0x39, 0x81, 0xdd, 0xf6, 0xb7, 0x5d, 0x2f, 0xa1, 0x11, 0xbf, 0x8b, 0xd4, 0x4c,
-	0xb1, 0x62, 0x16, 0xe6, 0x41, 0x58, 0x4f, 0x1f, 0x86, 0x3c, 0xfc, 0x8e, 0xca, 0xa0, 0x3d, 0xc0,
-	0x89, 0x22, 0x5c, 0xc9, 0x40, 0x52, 0x1c, 0xe4, 0x9b, 0x05, 0x1a, 0xf9, 0x14, 0x41, 0x75, 0x23,
-	0x68, 0xdf, 0xf4, 0x93, 0xa8, 0xcf, 0x72, 0x83, 0xd9, 0x94, 0xfa, 0x45, 0xcf, 0x4b, 0x22, 0xde,
-	0x84, 0x5a, 0xe2, 0x76, 0xe8, 0x56, 0x62, 0x75, 0x42, 0x71, 0x8d, 0x78, 0x01, 0xe3, 0xad, 0xcf,
-	0x33, 0x6e, 0x1a, 0x32, 0x73, 0x26, 0x2c, 0xa3, 0x3c, 0x2b, 0x4e, 0x78, 0xbe, 0x4a, 0xf3, 0x70,
-	0x0a, 0x73, 0x69, 0x76, 0x6c, 0x2b, 0x29, 0x7a, 0xbe, 0xb0, 0xc3, 0x50, 0xcb, 0xd0, 0x51, 0x73,
-	0x56, 0x12, 0x49, 0x13, 0x5e, 0xcb, 0x6e, 0x9e, 0xf7, 0x68, 0xd4, 0x71, 0x7d, 0xab, 0xb4, 0xfe,
-	0x92, 0x2b, 0x85, 0x04, 0x61, 0x97, 0xb0, 0x07, 0xae, 0xef, 0x04, 0x8f, 0x46, 0x87, 0x38, 0xf9,
-	0x47, 0xf1, 0x41, 0xad, 0x7c, 0x93, 0xe5, 0xd5, 0x5b, 0x70, 0x90, 0x65, 0x60, 0x8f, 0x8a, 0x0d,
-	0x91, 0xea, 0xa4, 0x90, 0xc2, 0x43, 0x79, 0x98, 0xc5, 0x0f, 0xf1, 0x06, 0x1c, 0xb2, 0xe2, 0xd8,
-	0x6d, 0xfb, 0xd4, 0x91, 0xbc, 0x2a, 0x13, 0xf3, 0x1a, 0xfc, 0x34, 0x7d, 0x4b, 0xf1, 0x13, 0xa9,
-	0x17, 0x4c, 0xb9, 0x24, 0x3f, 0x44, 0x70, 0x6c, 0x28, 0x93, 0x2c, 0x06, 0x85, 0x09, 0x44, 0x47,
-	0xa8, 0xc6, 0xf6, 0x0e, 0x75, 0xba, 0x1e, 0x95, 0xf3, 0x06, 0xb9, 0x66, 0x7b, 0x4e, 0x37, 0xf5,
-	0x40, 0x5a, 0x9a, 0xcd, 0x6c, 0x8d, 0x97, 0x00, 0x3a, 0x96, 0xdf, 0xb5, 0x3c, 0x0e, 0x61, 0x96,
-	0x43, 0x50, 0x28, 0x64, 0x11, 0xf4, 0x61, 0xee, 0x13, 0x6f, 0xf4, 0x4f, 0x10, 0xbc, 0x22, 0x4b,
-	0x98, 0xf0, 0x8f, 0x01, 0x87, 0x14, 0x33, 0xdc, 0xcd, 0x5c, 0x25, 0xfa, 0xd0, 0xe0, 0xe6, 0x60,
-	0x79, 0x42, 0xc3, 0xcb, 0x53, 0xea, 0xf3, 0x19, 0x75, 0x68, 0xe3, 0xef, 0xe9, 0x27, 0xa8, 0xb4,
-	0x9f, 0xa0, 0xd1, 0xfd, 0x04, 0x0d, 0xdc, 0xab, 0x7e, 0x00, 0xda, 0x1d, 0xcb, 0xb7, 0xda, 0xd4,
-	0xc9, 0x94, 0xcb, 0x02, 0xe9, 0x7b, 0xea, 0x2b, 0x75, 0xdf, 0x2f, 0xc0, 0xec, 0x5a, 0xe2, 0x6e,
-	0x6f, 0x8b, 0x17, 0x6f, 0xeb, 0x5f, 0x4b, 0x80, 0x55, 0xc7, 0xd3, 0xa8, 0xe7, 0xda, 0x14, 0xff,
-	0x02, 0xc1, 0x2c, 0xeb, 0x5b, 0xf8, 0xe4, 0xa8, 0x38, 0xe3, 0x0e, 0xd0, 0xa7, 0xf7, 0xb4, 0x60,
-	0xd2, 0xc8, 0xe2, 0x7b, 0xff, 0xfc, 0xf7, 0x2f, 0x2b, 0xc7, 0xf1, 0x51, 0x3e, 0xde, 0xec, 0x5d,
-	0x51, 0x47, 0x8d, 0x31, 0xfe, 0x09, 0x02, 0x2c, 0x9a, 0xa9, 0x32, 0xc3, 0xc2, 0x17, 0x47, 0x41,
-	0x1c, 0x32, 0xeb, 0xd2, 0x4f, 0x2a, 0x45, 0xcc, 0xb0, 0x83, 0x88, 0xb2, 0x92, 0xc5, 0x0f, 0x70,
-	0x00, 0x2b, 0x1c, 0xc0, 0x19, 0x4c, 0x86, 0x01, 0x68, 0x3e, 0x66, 0x61, 0xf0, 0xa4, 0x49, 0x53,
-	0xb9, 0x7f, 0x40, 0x30, 0xf7, 0x80, 0x5f, 0x11, 0xc7, 0x18, 0x69, 0x6b, 0x6a, 0x46, 0xe2, 0xe2,
-	0x38, 0x5a, 0x72, 0x9a, 0x23, 0x3d, 0x89, 0x4f, 0x48, 0xa4, 0x71, 0x12, 0x51, 0xab, 0x53, 0x00,
-	0x7c, 0x19, 0xe1, 0x0f, 0x10, 0xcc, 0xa7, 0xd3, 0x2c, 0x7c, 0x76, 0x14, 0xca, 0xc2, 0xb4, 0x4b,
-	0x9f, 0xde, 0x50, 0x88, 0x5c, 0xe0, 0x18, 0x4f, 0x93, 0xa1, 0xee, 0x5c, 0x2b, 0x8c, 0x8c, 0x9e,
-	0x22, 0x98, 0xb9, 0x45, 0xc7, 0xc6, 0xdb, 0x14, 0xc1, 0xed, 0x31, 0xe0, 0x10, 0x57, 0xe3, 0xf7,
-	0x11, 0xbc, 0x76, 0x8b, 0x26, 0xc3, 0xeb, 0x3d, 0x5e, 0x1e, 0x5f, 0x84, 0x45, 0xd8, 0x5d, 0x9c,
-	0xe0, 0x64, 0x56, 0xe8, 0x9a, 0x1c, 0xd9, 0x05, 0x7c, 0xbe, 0x2c, 0x08, 0xe3, 0xbe, 0x6f, 0x3f,
-	0x12, 0x38, 0x3e, 0x46, 0x70, 0x78, 0x70, 0x5a, 0x8c, 0x8b, 0x1d, 0x62, 0xe8, 0x30, 0x59, 0xbf,
-	0xbb, 0xdf, 0x82, 0x52, 0x64, 0x4a, 0xae, 0x73, 0xe4, 0xaf, 0xe3, 0x2f, 0x95, 0x21, 0x97, 0xc3,
-	0xb1, 0xb8, 0xf9, 0x58, 0xfe, 0x7c, 0xc2, 0xff, 0x28, 0xc1, 0x61, 0xbf, 0x87, 0xe0, 0xc0, 0x2d,
-	0x9a, 0xdc, 0xc9, 0x26, 0x41, 0x23, 0xc3, 0xb6, 0x30, 0x0e, 0xd6, 0x17, 0x0d, 0xe5, 0x6f, 0x07,
-	0x72, 0x2b, 0x33, 0xe9, 0x2a, 0x07, 0x76, 0x1e, 0x9f, 0x2d, 0x03, 0x96, 0x4f, 0x9f, 0x3e, 0x44,
-	0x30, 0x9f, 0x4e, 0x5a, 0x46, 0x8b, 0x2f, 0xcc, 0x57, 0xa7, 0x19, 0x98, 0x37, 0x39, 0xd6, 0x37,
-	0xf4, 0xcb, 0xc3, 0xb1, 0xaa, 0xdf, 0x4b, 0xab, 0x19, 0x5c, 0x81, 0x62, 0x46, 0xfd, 0x09, 0x01,
-	0xe4, 0xd3, 0x22, 0x7c, 0xa1, 0x5c, 0x0f, 0x65, 0xa2, 0xa4, 0x4f, 0x77, 0x5e, 0x44, 0x0c, 0xae,
-	0xcf, 0xb2, 0xde, 0x28, 0x0d, 0xe7, 0x90, 0xda, 0x6b, 0xe9, 0x4c, 0xe9, 0xf7, 0x08, 0xe6, 0xf8,
-	0x54, 0x01, 0x9f, 0x19, 0x85, 0x59, 0x1d, 0x3a, 0x4c, 0xd3, 0xf4, 0xe7, 0x38, 0xd4, 0x46, 0xab,
-	0xac, 0x26, 0xac, 0xa1, 0x15, 0xdc, 0x83, 0xf9, 0xf4, 0x6d, 0x3f, 0x3a, 0x3c, 0x0a, 0x6f, 0x7f,
-	0xbd, 0x51, 0xd2, 0xa3, 0xd2, 0x08, 0x15, 0xe5, 0x68, 0x65, 0x5c, 0x39, 0x9a, 0x65, 0x15, 0x03,
-	0x9f, 0x2e, 0xab, 0x27, 0x9f, 0x83, 0x61, 0x2e, 0x72, 0x74, 0x67, 0x49, 0x63, 0x5c, 0x49, 0x62,
-	0xd6, 0xf9, 0x15, 0x82, 0xc3, 0x83, 0x57, 0x1a, 0x7c, 0x62, 0xa0, 0x1c, 0xa9, 0xf7, 0x38, 0xbd,
-	0x68, 0xc5, 0x51, 0xd7, 0x21, 0xf2, 0x55, 0x8e, 0x62, 0x0d, 0x5f, 0x1b, 0x9b, 0x19, 0x77, 0x65,
-	0x42, 0x33, 0x46, 0xab, 0xf9, 0xd0, 0xf9, 0x2f, 0x08, 0x0e, 0x48, 0xbe, 0xf7, 0x22, 0x4a, 0xcb,
-	0x61, 0x4d, 0x2f, 0x11, 0x98, 0x2c, 0xf2, 0x65, 0x0e, 0xff, 0x8b, 0xf8, 0xea, 0x84, 0xf0, 0x25,
-	0xec, 0xd5, 0x84, 0x21, 0xfd, 0x1b, 0x82, 0x23, 0x0f, 0xd2, 0xb8, 0xff, 0x1f, 0xe1, 0x7f, 0x93,
-	0xe3, 0xff, 0x0a, 0x7e, 0xbd, 0xe4, 0xca, 0x31, 0x4e, 0x8d, 0xcb, 0x08, 0xff, 0x11, 0x41, 0x55,
-	0x4e, 0x67, 0xf1, 0xf9, 0x91, 0x89, 0x51, 0x9c, 0xdf, 0x4e, 0x33, 0x98, 0x45, 0x7f, 0x25, 0x67,
-	0x4a, 0xbb, 0x94, 0x90, 0xcf, 0x02, 0xfa, 0x29, 0x02, 0x9c, 0xbd, 0x47, 0xb2, 0x17, 0x0a, 0x3e,
-	0x57, 0x10, 0x35, 0xf2, 0xe1, 0xa9, 0x9f, 0x1f, 0x7b, 0xae, 0xd8, 0xa5, 0x56, 0x4a, 0xbb, 0x54,
-	0x90, 0xc9, 0xff, 0x29, 0x82, 0xfa, 0x2d, 0x9a, 0x5d, 0x87, 0x4b, 0x6c, 0x59, 0x1c, 0x41, 0xeb,
-	0xcb, 0xe3, 0x0f, 0x0a, 0x44, 0x97, 0x38, 0xa2, 0x73, 0xb8, 0xdc, 0x54, 0x12, 0xc0, 0x6f, 0x11,
-	0x1c, 0xdc, 0x54, 0x43, 0x14, 0x5f, 0x1a, 0x27, 0xa9, 0x50, 0xc9, 0x27, 0xc7, 0xf5, 0x05, 0x8e,
-	0x6b, 0x95, 0x4c, 0x84, 0x6b, 0x4d, 0x4c, 0x72, 0x7f, 0x87, 0xe0, 0x55, 0xf5, 0xfd, 0x20, 0xe6,
-	0x73, 0x9f, 0xd5, 0x6e, 0x25, 0x63, 0x3e, 0x72, 0x95, 0xe3, 0x33, 0xf0, 0xa5, 0x49, 0xf0, 0x35,
-	0xc5, 0xb8, 0x0e, 0xff, 0x06, 0xc1, 0x11, 0x3e, 0x21, 0x55, 0x19, 0x0f, 0xb4, 0x98, 0x51, 0xf3,
-	0xd4, 0x09, 0x5a, 0x8c, 0xa8, 0x3f, 0xe4, 0x85, 0x40, 0xad, 0x89, 0xc9, 0x26, 0xfe, 0x19, 0x82,
-	0x57, 0x64, 0x53, 0x13, 0xde, 0x5d, 0x1d, 0x67, 0xb8, 0x17, 0x6d, 0x82, 0x22, 0xdc, 0x56, 0x26,
-	0x0b, 0xb7, 0x0f, 0x10, 0x2c, 0x88, 0x89, 0x64, 0xc9, 0x55, 0x41, 0x19, 0x59, 0xea, 0xc7, 0x0a,
-	0xa7, 0xe4, 0x30, 0x8c, 0x7c, 0x87, 0x8b, 0xbd, 0x8f, 0x9b, 0x65, 0x62, 0xc3, 0xc0, 0x89, 0x9b,
-	0x8f, 0xc5, 0xa4, 0xe9, 0x49, 0xd3, 0x0b, 0xda, 0xf1, 0x3b, 0x04, 0x97, 0x36, 0x44, 0x76, 0xe6,
-	0x32, 0x5a, 0xff, 0xda, 0x47, 0xcf, 0x97, 0xd0, 0xdf, 0x9f, 0x2f, 0xa1, 0x4f, 0x9f, 0x2f, 0xa1,
-	0x77, 0xae, 0x4d, 0xf6, 0x6f, 0x36, 0xb6, 0xe7, 0x52, 0x3f, 0x51, 0xd9, 0xfe, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x06, 0x56, 0xdd, 0x2d, 0x62, 0x24, 0x00, 0x00,
+	// 2857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x8f, 0x1c, 0x47,
+	0xf1, 0xff, 0xf6, 0xde, 0xaf, 0xdd, 0x5a, 0x5f, 0x6c, 0xb7, 0xed, 0x7c, 0x27, 0xeb, 0x8b, 0xbd,
+	0x19, 0x3b, 0xf6, 0xf9, 0x6c, 0xef, 0xda, 0x4b, 0x14, 0x99, 0x4b, 0x20, 0xf8, 0x1c, 0xe3, 0x18,
+	0xce, 0xce, 0x31, 0xe7, 0x60, 0x14, 0x1e, 0xa0, 0x33, 0xdb, 0xb7, 0x37, 0xdc, 0xec, 0xcc, 0x64,
+	0x7a, 0x76, 0xcd, 0x2a, 0xe4, 0x25, 0x48, 0x48, 0x88, 0x08, 0x22, 0xc8, 0x03, 0x20, 0x84, 0x10,
+	0x51, 0x9e, 0x79, 0x03, 0x84, 0x90, 0xa2, 0xbc, 0xa0, 0xf0, 0x86, 0x20, 0xcf, 0x11, 0xb2, 0x78,
+	0xe5, 0x85, 0xbf, 0x00, 0x75, 0x4f, 0xf7, 0x4c, 0xf7, 0xfe, 0x98, 0xdd, 0xe4, 0x2e, 0x42, 0x79,
+	0xdb, 0xae, 0xaa, 0xa9, 0xfa, 0x74, 0x75, 0x75, 0x55, 0x4d, 0xcd, 0xc2, 0x59, 0x46, 0xe3, 0x3e,
+	0x8d, 0x9b, 0x24, 0x8a, 0x7c, 0xcf, 0x25, 0x89, 0x17, 0x06, 0xfa, 0xef, 0x46, 0x14, 0x87, 0x49,
+	0x88, 0xab, 0x1a, 0xa9, 0x76, 0xbc, 0x13, 0x76, 0x42, 0x41, 0x6f, 0xf2, 0x5f, 0xa9, 0x48, 0x6d,
+	0xa5, 0x13, 0x86, 0x1d, 0x9f, 0x36, 0x49, 0xe4, 0x35, 0x49, 0x10, 0x84, 0x89, 0x10, 0x66, 0x92,
+	0x6b, 0xef, 0x5d, 0x63, 0x0d, 0x2f, 0x14, 0x5c, 0x37, 0x8c, 0x69, 0xb3, 0x7f, 0xb5, 0xd9, 0xa1,
+	0x01, 0x8d, 0x49, 0x42, 0xdb, 0x52, 0xe6, 0xa9, 0x5c, 0xa6, 0x4b, 0xdc, 0x5d, 0x2f, 0xa0, 0xf1,
+	0xa0, 0x19, 0xed, 0x75, 0x38, 0x81, 0x35, 0xbb, 0x34, 0x21, 0xe3, 0x9e, 0xda, 0xec, 0x78, 0xc9,
+	0x6e, 0xef, 0x95, 0x86, 0x1b, 0x76, 0x9b, 0x24, 0x16, 0xc0, 0xbe, 0x23, 0x7e, 0x5c, 0x76, 0xdb,
+	0xcd, 0x7e, 0x2b, 0x57, 0xa0, 0xef, 0xb0, 0x7f, 0x95, 0xf8, 0xd1, 0x2e, 0x19, 0xd5, 0x76, 0x73,
+	0x8a, 0xb6, 0x98, 0x46, 0xa1, 0xf4, 0x98, 0xf8, 0xe9, 0x25, 0x61, 0x3c, 0xd0, 0x7e, 0xa6, 0x6a,
+	0xec, 0x3f, 0xcf, 0xc1, 0x91, 0xeb, 0xb9, 0xbd, 0xaf, 0xf5, 0x68, 0x3c, 0xc0, 0x18, 0xe6, 0x03,
+	0xd2, 0xa5, 0x16, 0xaa, 0xa3, 0xd5, 0x8a, 0x23, 0x7e, 0x63, 0x0b, 0x96, 0x62, 0xba, 0x13, 0x53,
+	0xb6, 0x6b, 0x95, 0x04, 0x59, 0x2d, 0xf1, 0x39, 0x58, 0xe2, 0xc6, 0xa9, 0x9b, 0x58, 0x73, 0xf5,
+	0xb9, 0xd5, 0xca, 0xc6, 0xa1, 0x87, 0x1f, 0x9d, 0x2e, 0x6f, 0xa5, 0x24, 0xe6, 0x28, 0x26, 0x6e,
+	0xc0, 0xe1, 0x98, 0xb2, 0xb0, 0x17, 0xbb, 0xf4, 0xeb, 0x34, 0x66, 0x5e, 0x18, 0x58, 0xf3, 0x5c,
+	0xd3, 0xc6, 0xfc, 0x07, 0x1f, 0x9d, 0xfe, 0x3f, 0x67, 0x98, 0x89, 0xeb, 0x50, 0x66, 0xd4, 0xa7,
+	0x6e, 0x12, 0xc6, 0xd6, 0x82, 0x26, 0x98, 0x51, 0xb1, 0x05, 0xf3, 0x7c, 0x43, 0xd6, 0xa2, 0xc6,
+	0x15, 0x14, 0xbc, 0x06, 0xcb, 0x3b, 0x1e, 0xf5, 0xdb, 0xdb, 0x4a, 0xc1, 0x92, 0x26, 0x62, 0xb2,
+	0xf0, 0x0a, 0x2c, 0xb2, 0x30, 0x4e, 0x36, 0x06, 0x56, 0x59, 0x13, 0x92, 0x34, 0x5c, 0x83, 0x05,
+	0xdf, 0xeb, 0x7a, 0x89, 0x55, 0xa9, 0xa3, 0xd5, 0x39, 0xc9, 0x4c, 0x49, 0x1c, 0xa1, 0x1b, 0x06,
+	0x89, 0x17, 0xf4, 0xa8, 0x05, 0x3a, 0x42, 0x45, 0xc5, 0xe7, 0xa0, 0xea, 0x05, 0x6e, 0x4c, 0xbb,
+	0x34, 0x48, 0x88, 0x6f, 0x55, 0xeb, 0x68, 0xb5, 0x2c, 0x85, 0x74, 0x06, 0x7e, 0x1a, 0x8e, 0x11,
+	0xdf, 0x0f, 0x1f, 0xdc, 0x27, 0x89, 0xbb, 0xbb, 0x11, 0x86, 0x7b, 0x5d, 0x12, 0xef, 0x31, 0xeb,
+	0x90, 0x26, 0x3f, 0x4e, 0xc0, 0x3e, 0x0d, 0x95, 0xbb, 0x61, 0x9b, 0x4e, 0x3c, 0x36, 0xfb, 0x16,
+	0x9c, 0x70, 0x68, 0xdf, 0xe3, 0x0e, 0xbd, 0x43, 0x13, 0xd2, 0x26, 0x09, 0x19, 0x16, 0x2e, 0x65,
+	0x67, 0x5c, 0x83, 0x72, 0x2c, 0x85, 0xad, 0x92, 0xa0, 0x67, 0x6b, 0xfb, 0x4f, 0x08, 0x4e, 0x69,
+	0x81, 0xe2, 0xc8, 0xc3, 0xba, 0xd9, 0xa7, 0x41, 0xc2, 0x26, 0xab, 0x6c, 0xc1, 0x51, 0x75, 0xae,
+	0x77, 0x49, 0x97, 0xb2, 0x88, 0xb8, 0x34, 0xd5, 0x2d, 0xb7, 0x35, 0xca, 0xc6, 0xab, 0x70, 0x48,
+	0x27, 0x5a, 0x73, 0x9a, 0xb8, 0xc1, 0xe1, 0xee, 0x55, 0xeb, 0x97, 0x6e, 0x3f, 0x6f, 0xcd, 0x6b,
+	0x82, 0x3a, 0xc3, 0xde, 0x02, 0x4b, 0xc3, 0x7e, 0x87, 0x04, 0xde, 0x0e, 0x65, 0xc9, 0x64, 0xd4,
+	0x75, 0xc3, 0x11, 0xda, 0xc1, 0x66, 0xee, 0x38, 0x01, 0xc7, 0x4c, 0x6f, 0x44, 0x61, 0xc0, 0xa8,
+	0xfd, 0x3e, 0x32, 0x2c, 0xdd, 0x88, 0x29, 0x49, 0xa8, 0x43, 0x5f, 0xed, 0x51, 0x96, 0xe0, 0x57,
+	0x41, 0xcf, 0x4e, 0xc2, 0x60, 0xb5, 0x75, 0xbb, 0x91, 0x5f, 0xe4, 0x86, 0xba, 0xc8, 0xe2, 0xc7,
+	0xb7, 0xdc, 0x76, 0xa3, 0xdf, 0x6a, 0x44, 0x7b, 0x9d, 0x06, 0x4f, 0x0b, 0x0d, 0xed, 0xd9, 0x86,
+	0x4a, 0x0b, 0x0d, 0xcd, 0x98, 0xda, 0xb8, 0x26, 0x87, 0x1f, 0x85, 0xc5, 0x5e, 0xc4, 0x68, 0x9c,
+	0x88, 0x6d, 0x94, 0x1d, 0xb9, 0xe2, 0x27, 0xdd, 0x27, 0xbe, 0xd7, 0x26, 0x09, 0x77, 0x2f, 0xe7,
+	0x64, 0x6b, 0xfb, 0x1d, 0x73, 0x0f, 0x2f, 0x45, 0x6d, 0x6d, 0x0f, 0x7b, 0x9f, 0xee, 0x1e, 0x4c,
+	0xf4, 0x3a, 0xca, 0xd2, 0x10, 0xca, 0xbe, 0x01, 0xf2, 0x79, 0xea, 0xd3, 0x1c, 0xe4, 0xb8, 0x23,
+	0xb5, 0x60, 0xc9, 0x25, 0xcc, 0x25, 0x6d, 0xa5, 0x4a, 0x2d, 0xf1, 0x25, 0x38, 0x1a, 0xc5, 0x61,
+	0x44, 0x3a, 0x42, 0xd3, 0x56, 0xe8, 0x7b, 0xee, 0x40, 0x38, 0xa5, 0xe2, 0x8c, 0x32, 0xec, 0x33,
+	0x50, 0xdd, 0x1e, 0x04, 0xee, 0x8b, 0x11, 0xa7, 0x31, 0x7c, 0x1c, 0x16, 0xbc, 0x84, 0x76, 0x99,
+	0x85, 0x78, 0xea, 0x73, 0xd2, 0x85, 0xfd, 0xd6, 0x02, 0x3c, 0xaa, 0xa1, 0xe3, 0x0f, 0x14, 0x61,
+	0x9b, 0x1a, 0x6e, 0x3c, 0x47, 0xb5, 0xe3, 0x81, 0xd3, 0x0b, 0xd2, 0xd3, 0x52, 0x39, 0x2a, 0xa5,
+	0xf1, 0x1c, 0x15, 0xc5, 0xbd, 0x80, 0x5a, 0xf3, 0x1a, 0x33, 0x25, 0xe1, 0x1d, 0x28, 0xb3, 0x84,
+	0x17, 0x8e, 0xce, 0x40, 0x64, 0xd1, 0x6a, 0xeb, 0x2b, 0xfb, 0x3b, 0x2d, 0xbe, 0x99, 0x6d, 0xa9,
+	0xd1, 0xc9, 0x74, 0xe3, 0x07, 0x50, 0x51, 0x37, 0x8e, 0x59, 0x4b, 0xf5, 0xb9, 0xd5, 0x6a, 0x6b,
+	0x7b, 0xff, 0x86, 0x5e, 0x8c, 0x68, 0x9c, 0x06, 0x86, 0xd4, 0x2d, 0x37, 0x97, 0xdb, 0xc2, 0x2b,
+	0x50, 0xe9, 0xca, 0x0b, 0xcd, 0xac, 0xb2, 0x38, 0x85, 0x9c, 0x80, 0xbf, 0x01, 0x0b, 0x5e, 0xb0,
+	0x13, 0x32, 0xab, 0x22, 0x20, 0x6d, 0xec, 0x0f, 0xd2, 0xed, 0x60, 0x27, 0x74, 0x52, 0x85, 0xf8,
+	0x55, 0x58, 0x8e, 0x69, 0x12, 0x0f, 0x94, 0x2f, 0x44, 0x05, 0xa8, 0xb6, 0xbe, 0xba, 0x3f, 0x0b,
+	0x8e, 0xae, 0xd2, 0x31, 0x2d, 0xe0, 0x75, 0xa8, 0xb2, 0x3c, 0xf6, 0x44, 0x35, 0xa9, 0xb6, 0x2c,
+	0x43, 0x91, 0x16, 0x9b, 0x8e, 0x2e, 0x6c, 0xff, 0xbb, 0x04, 0xc7, 0x38, 0x73, 0x8b, 0xc7, 0x14,
+	0x7d, 0xa0, 0xfc, 0xc9, 0x63, 0xa7, 0x13, 0x87, 0xbd, 0x28, 0xad, 0x1a, 0x2a, 0x76, 0x04, 0x09,
+	0x9f, 0x82, 0xa5, 0x3e, 0x8d, 0x47, 0xc2, 0x52, 0x11, 0x79, 0xfd, 0xdd, 0xf3, 0x82, 0xb6, 0x35,
+	0xa7, 0x31, 0x05, 0x05, 0xdb, 0x50, 0x09, 0xb2, 0x74, 0xaf, 0x57, 0xf9, 0x9c, 0xcc, 0x9f, 0xe6,
+	0x0b, 0xa3, 0xb6, 0xa7, 0xf7, 0x61, 0x05, 0x16, 0x89, 0x2b, 0xf2, 0x8b, 0x5e, 0xd9, 0x25, 0x8d,
+	0xeb, 0xe6, 0x1b, 0xdb, 0xda, 0x25, 0x8c, 0x1a, 0x75, 0x3d, 0x27, 0x8b, 0xde, 0x61, 0x10, 0xb8,
+	0xf7, 0x49, 0x9f, 0x5a, 0x65, 0xad, 0x70, 0x67, 0x54, 0x2e, 0xb1, 0x1b, 0x86, 0x7b, 0xf7, 0x06,
+	0x11, 0xb5, 0x2a, 0x9a, 0x92, 0x8c, 0x2a, 0xfa, 0x82, 0x84, 0x24, 0x3d, 0x66, 0xd4, 0x76, 0x49,
+	0xe3, 0xbe, 0xe9, 0x52, 0xc6, 0x48, 0x87, 0x5a, 0x55, 0x8d, 0xad, 0x88, 0xf6, 0x7b, 0x23, 0xfe,
+	0x16, 0x15, 0xc2, 0xb8, 0xeb, 0x68, 0xec, 0x5d, 0xe7, 0xb7, 0x59, 0xec, 0x4d, 0xf7, 0x79, 0x4a,
+	0xd2, 0xad, 0xce, 0x8d, 0xb1, 0x8a, 0xbf, 0xa8, 0xdf, 0xc2, 0x79, 0x11, 0xf2, 0xf5, 0x91, 0xf8,
+	0x18, 0x0a, 0x01, 0xfd, 0x32, 0x5d, 0x83, 0xe3, 0xaf, 0xf8, 0xa1, 0xbb, 0x47, 0xdb, 0x1b, 0x03,
+	0x2e, 0x7a, 0xdf, 0x0b, 0xda, 0xe1, 0x03, 0x66, 0x2d, 0x68, 0x89, 0x65, 0xac, 0x04, 0xde, 0x82,
+	0xa3, 0xfc, 0x7c, 0xfa, 0x54, 0x7f, 0x6c, 0x51, 0x20, 0xb0, 0x0d, 0x04, 0x43, 0x79, 0x31, 0x15,
+	0x75, 0x46, 0x1f, 0xb6, 0xff, 0x80, 0x60, 0x65, 0xa4, 0x0e, 0x6d, 0x47, 0xb4, 0x30, 0x95, 0x76,
+	0x60, 0x9e, 0x45, 0xd4, 0x15, 0x2d, 0x46, 0xb5, 0x75, 0xe7, 0xc0, 0x0a, 0x13, 0xb7, 0xab, 0x62,
+	0x94, 0x1b, 0x28, 0xac, 0xa0, 0x5d, 0xf8, 0x7f, 0xed, 0xd1, 0x2d, 0xde, 0xb2, 0x15, 0x61, 0xe6,
+	0x07, 0xce, 0x65, 0x8c, 0xbe, 0x28, 0x25, 0xf1, 0x60, 0x17, 0x3f, 0x44, 0x9c, 0xea, 0x8d, 0x50,
+	0x4e, 0xb6, 0x7f, 0x80, 0xa0, 0xa6, 0xd7, 0xd0, 0xd0, 0xf7, 0x5f, 0x21, 0xee, 0x5e, 0xb1, 0xc9,
+	0x92, 0xd7, 0x16, 0xf6, 0xe6, 0x36, 0x80, 0xeb, 0x7b, 0xf8, 0xd1, 0xe9, 0xd2, 0xed, 0xe7, 0x9d,
+	0x92, 0xd7, 0xfe, 0xe4, 0xb5, 0xc6, 0xfe, 0x70, 0x08, 0x88, 0x0a, 0xb0, 0x02, 0x20, 0x46, 0xa2,
+	0xd0, 0xf7, 0x5f, 0x09, 0x3e, 0x41, 0x3f, 0xa8, 0x25, 0x2c, 0xbd, 0x17, 0x54, 0xc4, 0x3c, 0xd9,
+	0x2d, 0xe8, 0x9e, 0x16, 0xa4, 0x2c, 0x99, 0x2d, 0x6a, 0x2c, 0x41, 0xb1, 0x7f, 0x51, 0x82, 0xd3,
+	0x63, 0xb6, 0x35, 0xf5, 0x5c, 0x3f, 0x03, 0x7b, 0xcb, 0x63, 0x6f, 0x69, 0x4a, 0xec, 0x95, 0xc7,
+	0xc7, 0xde, 0xdb, 0x25, 0xa8, 0x8f, 0xf1, 0xcd, 0xf4, 0x7e, 0xec, 0x33, 0xe2, 0x9c, 0x9d, 0x30,
+	0x76, 0xd3, 0x2a, 0x93, 0xc6, 0x3a, 0x72, 0x52, 0x12, 0xbf, 0x25, 0x61, 0x1c, 0xed, 0x92, 0xc0,
+	0x2a, 0x6b, 0x4c, 0x49, 0xb3, 0xdf, 0x2a, 0x81, 0xa5, 0x7c, 0x71, 0x5d, 0x94, 0x2d, 0xa7, 0x17,
+	0x7c, 0xd6, 0xdd, 0x91, 0x97, 0x65, 0x3d, 0x58, 0x24, 0x0d, 0x9f, 0x02, 0x88, 0x48, 0x4c, 0xba,
+	0x34, 0xa1, 0xb1, 0x6a, 0xc4, 0x34, 0x8a, 0xfd, 0x23, 0x04, 0x27, 0x4d, 0x97, 0xb0, 0x4d, 0x8f,
+	0x25, 0x59, 0x61, 0xf4, 0x61, 0x29, 0xd5, 0x94, 0xf6, 0xd2, 0xd5, 0xd6, 0xe6, 0x7e, 0x3b, 0x29,
+	0xdd, 0x96, 0xf2, 0x80, 0x34, 0x61, 0x3f, 0x07, 0x27, 0xc7, 0x66, 0xaa, 0xbc, 0x4a, 0xab, 0x1e,
+	0x32, 0x3d, 0x26, 0x55, 0xa5, 0x15, 0xd5, 0x7e, 0x6f, 0xde, 0x4c, 0xf2, 0x61, 0x7b, 0x33, 0xec,
+	0x14, 0xbc, 0x08, 0xcf, 0x72, 0xc0, 0x16, 0x2c, 0x45, 0x61, 0x5b, 0x9e, 0xad, 0x98, 0xb1, 0xc8,
+	0x25, 0x7f, 0xda, 0x0d, 0x83, 0x84, 0x78, 0x01, 0x8d, 0x8d, 0x23, 0xcd, 0xc9, 0x3c, 0x3c, 0x98,
+	0x17, 0xb8, 0x74, 0x9b, 0xba, 0x61, 0xd0, 0x66, 0xe2, 0x6c, 0x55, 0xdf, 0x63, 0x70, 0xf0, 0x0b,
+	0x50, 0x11, 0xeb, 0x7b, 0x5e, 0x97, 0x8a, 0x16, 0xab, 0xda, 0x5a, 0x6b, 0xa4, 0x33, 0xad, 0x86,
+	0x3e, 0xd3, 0xca, 0x3d, 0xcc, 0x67, 0x5a, 0x8d, 0xfe, 0xd5, 0x06, 0x7f, 0xc2, 0xc9, 0x1f, 0xe6,
+	0xb8, 0x12, 0xe2, 0xf9, 0x9b, 0x5e, 0x20, 0xba, 0xfe, 0xdc, 0x60, 0x4e, 0xe6, 0x61, 0xb3, 0x13,
+	0xf2, 0xd9, 0x85, 0xc8, 0x21, 0x59, 0x3d, 0x49, 0x69, 0xbc, 0x7d, 0xef, 0x05, 0x89, 0xe7, 0x0b,
+	0x2c, 0xa2, 0x11, 0x73, 0x72, 0x02, 0x7f, 0x7f, 0xdd, 0xf1, 0xfc, 0x84, 0xc6, 0x69, 0x0f, 0xe6,
+	0xc8, 0x15, 0xf7, 0xb0, 0x08, 0x52, 0xd1, 0x7a, 0xc9, 0xf0, 0x3c, 0xae, 0x82, 0xfa, 0x90, 0x20,
+	0xa6, 0x0b, 0x6c, 0x0f, 0x5d, 0x9a, 0x65, 0xc1, 0x34, 0xaf, 0x4b, 0x1d, 0xca, 0x11, 0xef, 0x89,
+	0xc2, 0x1e, 0xb3, 0x1e, 0xd1, 0xca, 0x5a, 0x46, 0xe5, 0xf3, 0x24, 0xe2, 0xfb, 0x37, 0x94, 0xaf,
+	0x99, 0x75, 0x58, 0x13, 0x33, 0x59, 0x7c, 0x28, 0x91, 0xa2, 0x74, 0x68, 0x87, 0x7e, 0xd7, 0x3a,
+	0xa2, 0xcf, 0x7c, 0x34, 0x86, 0xfd, 0x66, 0x09, 0xca, 0x9b, 0x61, 0xe7, 0x66, 0x90, 0xc4, 0x03,
+	0x7e, 0x63, 0xf9, 0x49, 0xd2, 0xc0, 0x8c, 0x37, 0x45, 0xc4, 0x5b, 0x50, 0x49, 0xbc, 0x2e, 0xdd,
+	0x4e, 0x48, 0x37, 0x92, 0xcd, 0xcd, 0xc7, 0x38, 0xb2, 0x8d, 0x45, 0xae, 0xcd, 0x42, 0x4e, 0xae,
+	0x84, 0xdf, 0x73, 0x9f, 0xb0, 0x44, 0x64, 0x11, 0x85, 0x4f, 0x50, 0x78, 0x20, 0x65, 0x62, 0xdb,
+	0x89, 0x19, 0x6f, 0x06, 0x87, 0xa3, 0x56, 0x01, 0xab, 0x67, 0x92, 0x2c, 0x6c, 0xd7, 0x60, 0x39,
+	0x8b, 0xcf, 0xbb, 0x44, 0x06, 0x5b, 0x36, 0x86, 0x33, 0x58, 0x76, 0x13, 0x1e, 0xcb, 0xde, 0xf6,
+	0xee, 0xd1, 0xb8, 0xeb, 0x05, 0xa4, 0xb0, 0x82, 0xd8, 0x57, 0x8d, 0x2b, 0xac, 0x75, 0x8e, 0x13,
+	0x2f, 0xa1, 0xfd, 0x77, 0x73, 0x88, 0xa5, 0x3d, 0x93, 0xdd, 0xfc, 0x17, 0x60, 0x39, 0x6d, 0x45,
+	0x25, 0xc3, 0x42, 0x33, 0xf7, 0xb0, 0xe6, 0x83, 0x78, 0x13, 0x0e, 0x13, 0xc6, 0xbc, 0x4e, 0x40,
+	0xdb, 0x4a, 0x57, 0x69, 0x66, 0x5d, 0xc3, 0x8f, 0xa6, 0xf3, 0x0b, 0x21, 0x91, 0x9e, 0x98, 0xa3,
+	0x96, 0xf6, 0xf7, 0x11, 0x9c, 0x18, 0xab, 0x24, 0xbb, 0x25, 0xd2, 0x05, 0xb2, 0xa6, 0x95, 0x99,
+	0xbb, 0x4b, 0xdb, 0x3d, 0x9f, 0xaa, 0x19, 0x9f, 0x5a, 0x73, 0x5e, 0xbb, 0x97, 0x9e, 0x40, 0x5a,
+	0x5c, 0x9c, 0x6c, 0xcd, 0xd3, 0x7b, 0x97, 0x04, 0x3d, 0xe2, 0x0b, 0x08, 0xf3, 0x02, 0x82, 0x46,
+	0xb1, 0x57, 0xa0, 0x36, 0xee, 0xf8, 0xe4, 0x5c, 0xec, 0x43, 0x04, 0x8f, 0xa8, 0x24, 0x2b, 0xcf,
+	0xa7, 0x01, 0x87, 0x35, 0x37, 0xdc, 0xcd, 0x8e, 0x4a, 0x56, 0xd2, 0x61, 0xe6, 0x70, 0x02, 0x2d,
+	0x7c, 0xa5, 0x9c, 0x1b, 0x79, 0xa5, 0x34, 0x2a, 0x22, 0x2a, 0xac, 0x88, 0x68, 0x72, 0x45, 0x1c,
+	0x7a, 0xcd, 0xb5, 0xbf, 0x07, 0xd6, 0x1d, 0x12, 0x90, 0x0e, 0x6d, 0x67, 0x9b, 0xcb, 0x02, 0xe9,
+	0xdb, 0xfa, 0x64, 0x68, 0xdf, 0x53, 0x97, 0xac, 0xb1, 0xf2, 0x76, 0x76, 0xd4, 0x94, 0xe9, 0x02,
+	0x1c, 0xe3, 0x15, 0xf4, 0x7a, 0x14, 0x6d, 0x7a, 0xc1, 0x1e, 0x2b, 0xba, 0x2b, 0x31, 0x94, 0xb9,
+	0x0c, 0x9f, 0x5f, 0xf0, 0x3c, 0x99, 0x78, 0x89, 0xaf, 0x04, 0xd2, 0x05, 0x3e, 0x02, 0x73, 0xbd,
+	0xd8, 0x97, 0x21, 0xc1, 0x7f, 0xe2, 0x3a, 0x54, 0xdb, 0x94, 0xb9, 0xb1, 0x17, 0xc9, 0x80, 0xe0,
+	0x89, 0x53, 0x27, 0xf1, 0xdc, 0xed, 0xb9, 0x61, 0x70, 0xc3, 0x27, 0x8c, 0xa5, 0x6e, 0x75, 0x72,
+	0x82, 0xfd, 0x2c, 0x2c, 0x4b, 0x5c, 0xd2, 0x23, 0x17, 0x4d, 0x8f, 0x9c, 0x30, 0x76, 0xaa, 0xe0,
+	0xc9, 0xcd, 0xb5, 0xfe, 0xf3, 0x04, 0x60, 0x3d, 0xaa, 0x69, 0xdc, 0xf7, 0x5c, 0x8a, 0x7f, 0x8a,
+	0x60, 0x9e, 0x6f, 0x1a, 0x3f, 0x3e, 0xe9, 0x12, 0x89, 0xe8, 0xaa, 0x1d, 0xdc, 0x9b, 0x1f, 0xb7,
+	0x66, 0xaf, 0xbc, 0xf1, 0x8f, 0x7f, 0xfd, 0xac, 0xf4, 0x28, 0x3e, 0x2e, 0xbe, 0x0b, 0xf5, 0xaf,
+	0xea, 0xdf, 0x68, 0x18, 0x7e, 0x13, 0x01, 0x96, 0xbd, 0x8c, 0x36, 0x14, 0xc7, 0x17, 0x27, 0x41,
+	0x1c, 0x33, 0x3c, 0xaf, 0x3d, 0xae, 0x65, 0xf3, 0x86, 0x1b, 0xc6, 0x94, 0xe7, 0x6e, 0x21, 0x20,
+	0x00, 0xac, 0x09, 0x00, 0x67, 0xb1, 0x3d, 0x0e, 0x40, 0xf3, 0x35, 0x7e, 0xc8, 0xaf, 0x37, 0x69,
+	0x6a, 0xf7, 0xb7, 0x08, 0x16, 0xc4, 0x77, 0x82, 0x69, 0x4e, 0xda, 0x3e, 0x30, 0x27, 0x09, 0x73,
+	0x02, 0xad, 0x7d, 0x46, 0x20, 0x7d, 0x1c, 0x9f, 0x54, 0x48, 0x59, 0x12, 0x53, 0xd2, 0x35, 0x00,
+	0x5f, 0x41, 0xf8, 0x5d, 0x04, 0x8b, 0xe9, 0x78, 0x1c, 0x3f, 0x39, 0x09, 0xa5, 0x31, 0x3e, 0xaf,
+	0x1d, 0xdc, 0x94, 0xd9, 0xbe, 0x20, 0x30, 0x9e, 0xb1, 0xc7, 0x1e, 0xe7, 0xba, 0x31, 0x83, 0x7e,
+	0x1b, 0xc1, 0xdc, 0x2d, 0x3a, 0x35, 0xde, 0x0e, 0x10, 0xdc, 0x88, 0x03, 0xc7, 0x1c, 0x35, 0x7e,
+	0x07, 0xc1, 0x63, 0xb7, 0x68, 0x32, 0xbe, 0x98, 0xe1, 0xd5, 0xe9, 0x15, 0x46, 0x86, 0xdd, 0xc5,
+	0x19, 0x24, 0xb3, 0x2c, 0xde, 0x14, 0xc8, 0x2e, 0xe0, 0xf3, 0x45, 0x41, 0xc8, 0x27, 0x6c, 0x0f,
+	0x24, 0x8e, 0xbf, 0x22, 0x38, 0x32, 0xfc, 0xf9, 0x09, 0x9b, 0xe5, 0x6f, 0xec, 0xd7, 0xa9, 0xda,
+	0xdd, 0xfd, 0x66, 0x4b, 0x53, 0xa9, 0x7d, 0x5d, 0x20, 0x7f, 0x06, 0x7f, 0xbe, 0x08, 0xb9, 0x9a,
+	0xc0, 0xb1, 0xe6, 0x6b, 0xea, 0xe7, 0xeb, 0xcd, 0xae, 0x54, 0x81, 0xdf, 0x40, 0x70, 0xe8, 0x16,
+	0x4d, 0xee, 0x64, 0xa3, 0xe5, 0x89, 0x61, 0x6b, 0x7c, 0x5f, 0xaa, 0xad, 0x34, 0xb4, 0x8f, 0xae,
+	0x8a, 0x95, 0xb9, 0xf4, 0xb2, 0x00, 0x76, 0x1e, 0x3f, 0x59, 0x04, 0x2c, 0x1f, 0x67, 0xbf, 0x8f,
+	0x60, 0x31, 0x1d, 0x84, 0x4d, 0x36, 0x6f, 0x7c, 0xb0, 0x39, 0xc8, 0xc0, 0xbc, 0x29, 0xb0, 0x3e,
+	0x57, 0xbb, 0x32, 0x1e, 0xab, 0xfe, 0xbc, 0xf2, 0x5a, 0x43, 0x6c, 0xc0, 0xbc, 0x51, 0xbf, 0x47,
+	0x00, 0xf9, 0x30, 0x0f, 0x5f, 0x28, 0xde, 0x87, 0x36, 0xf0, 0xab, 0x1d, 0xec, 0x38, 0xcf, 0x6e,
+	0x88, 0xfd, 0xac, 0xd6, 0xea, 0x85, 0xe1, 0x1c, 0x51, 0x77, 0x3d, 0x1d, 0xf9, 0xfd, 0x06, 0xc1,
+	0x82, 0x18, 0xfa, 0xe0, 0xb3, 0x93, 0x30, 0xeb, 0x33, 0xa1, 0x83, 0x74, 0xfd, 0x39, 0x01, 0xb5,
+	0xde, 0x2a, 0xca, 0x09, 0xeb, 0x68, 0x0d, 0xf7, 0x61, 0x31, 0x1d, 0xbd, 0x4c, 0x0e, 0x0f, 0x63,
+	0x34, 0x53, 0xab, 0x17, 0xd4, 0xa8, 0x34, 0x42, 0x65, 0x3a, 0x5a, 0x9b, 0x96, 0x8e, 0xe6, 0x79,
+	0xc6, 0xc0, 0x67, 0x8a, 0xf2, 0xc9, 0xa7, 0xe0, 0x98, 0x8b, 0x02, 0xdd, 0x93, 0x76, 0x7d, 0x5a,
+	0x4a, 0xe2, 0xde, 0xf9, 0x21, 0x82, 0xaa, 0x36, 0x00, 0x9f, 0x0d, 0x6c, 0xd1, 0xfc, 0x3c, 0xf5,
+	0xd0, 0xe7, 0x04, 0x86, 0xcb, 0xf6, 0xea, 0x34, 0x0c, 0xcd, 0x28, 0x7d, 0x92, 0x63, 0x49, 0xa0,
+	0xc2, 0x0b, 0xbb, 0xe8, 0x90, 0x70, 0x7d, 0xa8, 0x15, 0x1a, 0x69, 0xea, 0x6a, 0xb5, 0x91, 0x66,
+	0x29, 0x4f, 0xcb, 0xb2, 0x9a, 0xe1, 0x27, 0x8a, 0xec, 0xfb, 0xc2, 0xd0, 0xcf, 0x11, 0x1c, 0x19,
+	0xee, 0x58, 0xf1, 0xc9, 0xa1, 0x84, 0xac, 0xb7, 0xe9, 0x35, 0x33, 0x8e, 0x26, 0x75, 0xbb, 0xf6,
+	0x97, 0x04, 0x86, 0x75, 0x7c, 0x6d, 0x6a, 0x6e, 0xb8, 0xab, 0x52, 0x1a, 0x57, 0x74, 0x39, 0xff,
+	0xf4, 0xf0, 0x47, 0x04, 0x87, 0x94, 0xde, 0x7b, 0x31, 0xa5, 0xc5, 0xb0, 0x0e, 0x2e, 0x15, 0x70,
+	0x5b, 0xf6, 0xb3, 0x02, 0xfe, 0xd3, 0xf8, 0xa9, 0x19, 0xe1, 0x2b, 0xd8, 0x97, 0x13, 0x8e, 0xf4,
+	0x2f, 0x08, 0x8e, 0xde, 0x4f, 0x6f, 0xfe, 0xff, 0x08, 0xff, 0x0d, 0x81, 0xff, 0x0b, 0xf8, 0x99,
+	0x82, 0xa6, 0x6b, 0xda, 0x36, 0xae, 0x20, 0xfc, 0x3b, 0x04, 0x65, 0xf5, 0xf9, 0x00, 0x9f, 0x9f,
+	0x98, 0x1a, 0xcc, 0x0f, 0x0c, 0x07, 0x79, 0x9d, 0x65, 0x87, 0x61, 0x9f, 0x2d, 0xac, 0xd3, 0xd2,
+	0x3e, 0xbf, 0x46, 0x6f, 0x23, 0xc0, 0xd9, 0xeb, 0x66, 0xf6, 0x02, 0x8a, 0xcf, 0x19, 0xa6, 0x26,
+	0xce, 0x15, 0x6a, 0xe7, 0xa7, 0xca, 0x99, 0x75, 0x7a, 0xad, 0xb0, 0x4e, 0x87, 0x99, 0xfd, 0x1f,
+	0x23, 0xa8, 0xde, 0xa2, 0xd9, 0x0b, 0x41, 0x81, 0x2f, 0xcd, 0x6f, 0x24, 0xb5, 0xd5, 0xe9, 0x82,
+	0x12, 0xd1, 0x25, 0x81, 0xe8, 0x1c, 0x2e, 0x76, 0x95, 0x02, 0xf0, 0x2b, 0x04, 0xcb, 0x5b, 0x7a,
+	0x88, 0xe2, 0x4b, 0xd3, 0x2c, 0x19, 0xb5, 0x6c, 0x76, 0x5c, 0x2a, 0x1b, 0xce, 0x84, 0x6b, 0x5d,
+	0x7e, 0x6a, 0xf8, 0x35, 0x4a, 0xdf, 0x65, 0x87, 0x06, 0xc4, 0x9f, 0xd4, 0x6f, 0x05, 0x73, 0x66,
+	0xfb, 0x29, 0x81, 0xaf, 0x81, 0x2f, 0xcd, 0x82, 0xaf, 0x29, 0xe7, 0xc5, 0xf8, 0x97, 0x08, 0x8e,
+	0x8a, 0x11, 0xbe, 0xae, 0x78, 0xa8, 0xc8, 0x4e, 0x1a, 0xf8, 0xcf, 0x50, 0x64, 0x65, 0xfe, 0xb1,
+	0x3f, 0x16, 0xa8, 0x75, 0x35, 0x7a, 0x7f, 0x13, 0xc1, 0x51, 0xdd, 0x7b, 0x69, 0x51, 0x99, 0xd9,
+	0x77, 0x45, 0xb5, 0xa5, 0x25, 0x80, 0x5d, 0xc2, 0x6b, 0x33, 0x01, 0x4b, 0x8b, 0xcc, 0x4f, 0x10,
+	0x3c, 0xa2, 0xba, 0x0c, 0x19, 0x6c, 0x97, 0xa7, 0x61, 0xf9, 0xb8, 0x5d, 0x89, 0x8c, 0xfe, 0xb5,
+	0xd9, 0xa2, 0xff, 0x5d, 0x04, 0x4b, 0x72, 0x42, 0x5f, 0xd0, 0xbb, 0x69, 0x23, 0xfc, 0xda, 0xd0,
+	0x70, 0x42, 0x8e, 0x69, 0xed, 0x6f, 0x0a, 0xb3, 0x2f, 0xe1, 0x66, 0x91, 0xd9, 0x28, 0x6c, 0xb3,
+	0xe6, 0x6b, 0x72, 0x06, 0xfa, 0x7a, 0xd3, 0x0f, 0x3b, 0xec, 0x65, 0x1b, 0x17, 0x76, 0x28, 0x5c,
+	0xe6, 0x0a, 0xda, 0xf8, 0xf2, 0x07, 0x0f, 0x4f, 0xa1, 0xbf, 0x3d, 0x3c, 0x85, 0xfe, 0xf9, 0xf0,
+	0x14, 0x7a, 0xf9, 0xda, 0x6c, 0x7f, 0x18, 0x75, 0x7d, 0x8f, 0x06, 0x89, 0xae, 0xf6, 0xbf, 0x03,
+	0x00, 0x2a, 0x1a, 0x2d, 0xcb, 0x2c, 0x2b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2281,6 +2806,10 @@ type ApplicationServiceClient interface {
 	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// SyncPreview performs a server-side dry-run of a sync, including hooks and sync waves, and returns the actions it would perform
+	SyncPreview(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*SyncPreviewResponse, error)
+	// ListLinks returns the deep links of an application
+	ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -2299,6 +2828,8 @@ type ApplicationServiceClient interface {
 	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
 	// RunResourceAction run resource action
 	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// ListResourceLinks returns the deep links of an application resource
+	ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error)
 	// DeleteResource deletes a single application resource
 	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// PodLogs returns stream of log entries for the specified pod. Pod
@@ -2453,6 +2984,24 @@ func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSync
 	return out, nil
 }
 
+func (c *applicationServiceClient) SyncPreview(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*SyncPreviewResponse, error) {
+	out := new(SyncPreviewResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/SyncPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
+	out := new(LinksResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
 	out := new(ManagedResourcesResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
@@ -2557,6 +3106,15 @@ func (c *applicationServiceClient) RunResourceAction(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *applicationServiceClient) ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
+	out := new(LinksResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
 	out := new(ApplicationResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteResource", in, out, opts...)
@@ -2626,6 +3184,10 @@ type ApplicationServiceServer interface {
 	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	// SyncPreview performs a server-side dry-run of a sync, including hooks and sync waves, and returns the actions it would perform
+	SyncPreview(context.Context, *ApplicationSyncRequest) (*SyncPreviewResponse, error)
+	// ListLinks returns the deep links of an application
+	ListLinks(context.Context, *ListAppLinksRequest) (*LinksResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -2644,6 +3206,8 @@ type ApplicationServiceServer interface {
 	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
 	// RunResourceAction run resource action
 	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
+	// ListResourceLinks returns the deep links of an application resource
+	ListResourceLinks(context.Context, *ApplicationResourceRequest) (*LinksResponse, error)
 	// DeleteResource deletes a single application resource
 	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
 	// PodLogs returns stream of log entries for the specified pod. Pod
@@ -2693,6 +3257,12 @@ func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *A
 func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
+func (*UnimplementedApplicationServiceServer) SyncPreview(ctx context.Context, req *ApplicationSyncRequest) (*SyncPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPreview not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListLinks(ctx context.Context, req *ListAppLinksRequest) (*LinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
@@ -2720,6 +3290,9 @@ func (*UnimplementedApplicationServiceServer) ListResourceActions(ctx context.Co
 func (*UnimplementedApplicationServiceServer) RunResourceAction(ctx context.Context, req *ResourceActionRunRequest) (*ApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunResourceAction not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ListResourceLinks(ctx context.Context, req *ApplicationResourceRequest) (*LinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceLinks not implemented")
+}
 func (*UnimplementedApplicationServiceServer) DeleteResource(ctx context.Context, req *ApplicationResourceDeleteRequest) (*ApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
 }
@@ -2968,6 +3541,42 @@ func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_SyncPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SyncPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/SyncPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SyncPreview(ctx, req.(*ApplicationSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListLinks(ctx, req.(*ListAppLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -3133,6 +3742,24 @@ func _ApplicationService_RunResourceAction_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ListResourceLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationResourceDeleteRequest)
 	if err := dec(in); err != nil {
@@ -3224,6 +3851,14 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sync",
 			Handler:    _ApplicationService_Sync_Handler,
 		},
+		{
+			MethodName: "SyncPreview",
+			Handler:    _ApplicationService_SyncPreview_Handler,
+		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _ApplicationService_ListLinks_Handler,
+		},
 		{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
@@ -3256,6 +3891,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RunResourceAction",
 			Handler:    _ApplicationService_RunResourceAction_Handler,
 		},
+		{
+			MethodName: "ListResourceLinks",
+			Handler:    _ApplicationService_ListResourceLinks_Handler,
+		},
 		{
 			MethodName: "DeleteResource",
 			Handler:    _ApplicationService_DeleteResource_Handler,
@@ -3305,6 +3944,40 @@ func (m *ApplicationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i--
+	if m.AllowWatchBookmarks {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	i--
+	if m.Incremental {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i -= len(m.Continue)
+	copy(dAtA[i:], m.Continue)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Continue)))
+	i--
+	dAtA[i] = 0x52
+	i = encodeVarintApplication(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.SortBy)
+	copy(dAtA[i:], m.SortBy)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.SortBy)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.FieldSelector)
+	copy(dAtA[i:], m.FieldSelector)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.FieldSelector)))
+	i--
+	dAtA[i] = 0x3a
 	i -= len(m.Repo)
 	copy(dAtA[i:], m.Repo)
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.Repo)))
@@ -3871,7 +4544,7 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncPreviewResource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3881,12 +4554,12 @@ func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncPreviewResource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncPreviewResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3895,31 +4568,189 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Validate != nil {
-		i--
-		if *m.Validate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplication(dAtA, i, uint64(size))
-	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.HookType)
+	copy(dAtA[i:], m.HookType)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.HookType)))
+	i--
+	dAtA[i] = 0x4a
+	i = encodeVarintApplication(dAtA, i, uint64(m.SyncWave))
+	i--
+	dAtA[i] = 0x40
+	i -= len(m.SyncPhase)
+	copy(dAtA[i:], m.SyncPhase)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.SyncPhase)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Version)))
 	i--
 	dAtA[i] = 0x12
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActiveSyncWindows) > 0 {
+		for iNdEx := len(m.ActiveSyncWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveSyncWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i--
+	if m.BlockedBySyncWindows {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Validate != nil {
+		i--
+		if *m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
 		dAtA[i] = 0xa
@@ -4265,6 +5096,15 @@ func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	i -= len(m.Action)
 	copy(dAtA[i:], m.Action)
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
@@ -4404,6 +5244,32 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i--
+	if m.FilterRegex {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i--
+	if m.AllContainers {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
+	i--
+	if m.Previous {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
 	if m.ResourceName != nil {
 		i -= len(*m.ResourceName)
 		copy(dAtA[i:], *m.ResourceName)
@@ -4518,6 +5384,11 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i -= len(m.ContainerName)
+	copy(dAtA[i:], m.ContainerName)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ContainerName)))
+	i--
+	dAtA[i] = 0x32
 	i -= len(m.PodName)
 	copy(dAtA[i:], m.PodName)
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.PodName)))
@@ -4888,6 +5759,142 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IconClass != nil {
+		i -= len(*m.IconClass)
+		copy(dAtA[i:], *m.IconClass)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Url == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	} else {
+		i -= len(*m.Url)
+		copy(dAtA[i:], *m.Url)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Title == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	} else {
+		i -= len(*m.Title)
+		copy(dAtA[i:], *m.Title)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplication(v)
 	base := offset
@@ -4925,9 +5932,18 @@ func (m *ApplicationQuery) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = len(m.Repo)
 	n += 1 + l + sovApplication(uint64(l))
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
+	l = len(m.FieldSelector)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.SortBy)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.Limit))
+	l = len(m.Continue)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
+	n += 2
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
 	return n
 }
 
@@ -5149,6 +6165,70 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 	return n
 }
 
+func (m *SyncPreviewResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.SyncPhase)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.SyncWave))
+	l = len(m.HookType)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	n += 2
+	if len(m.ActiveSyncWindows) > 0 {
+		for _, e := range m.ActiveSyncWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationUpdateSpecRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5319,6 +6399,12 @@ func (m *ResourceActionRunRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = len(m.Action)
 	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5402,6 +6488,9 @@ func (m *ApplicationPodLogsQuery) Size() (n int) {
 		l = len(*m.ResourceName)
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	n += 2
+	n += 2
+	n += 3
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5423,6 +6512,8 @@ func (m *LogEntry) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = len(m.PodName)
 	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ContainerName)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5571,6 +6662,68 @@ func (m *ManagedResourcesResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListAppLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LinkInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Title != nil {
+		l = len(*m.Title)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Url != nil {
+		l = len(*m.Url)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Description != nil {
+		l = len(*m.Description)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.IconClass != nil {
+		l = len(*m.IconClass)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplication(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5800,60 +6953,9 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5881,64 +6983,11 @@ func (m *NodeQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.FieldSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5966,13 +7015,30 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.SortBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6000,11 +7066,253 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Revision = &s
+			m.Continue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		default:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowWatchBookmarks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowWatchBookmarks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
 			if err != nil {
@@ -7240,8 +8548,7 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *SyncPreviewResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7264,15 +8571,15 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPreviewResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPreviewResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7300,15 +8607,13 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7318,31 +8623,1118 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWave", wireType)
+			}
+			m.SyncWave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SyncWave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &SyncPreviewResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedBySyncWindows", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockedBySyncWindows = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveSyncWindows = append(m.ActiveSyncWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveSyncWindows[len(m.ActiveSyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Validate = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Prune = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7352,13 +9744,124 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Validate = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7378,8 +9881,20 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	}
 
 	if iNdEx > l {
@@ -7387,7 +9902,7 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -7411,10 +9926,10 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7453,7 +9968,7 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7481,12 +9996,12 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7514,73 +10029,45 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatchType = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000004)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7608,15 +10095,14 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7626,17 +10112,30 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7646,17 +10145,30 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000040)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -7666,12 +10178,25 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PatchType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000080)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7692,7 +10217,25 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000040) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000080) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
 	}
 
 	if iNdEx > l {
@@ -7700,7 +10243,7 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -7724,10 +10267,10 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7929,6 +10472,48 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000020)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Force = &b
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Orphan = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7969,7 +10554,7 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -7993,10 +10578,10 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8200,7 +10785,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000020)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8228,12 +10813,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000040)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8261,9 +10846,8 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatchType = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000080)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8299,10 +10883,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	}
 	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000080) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
 	}
 
 	if iNdEx > l {
@@ -8310,8 +10891,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8334,17 +10914,17 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8354,161 +10934,81 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Actions = append(m.Actions, v1alpha1.ResourceAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8536,51 +11036,9 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Force = &b
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orphan", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Orphan = &b
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8598,22 +11056,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
 	}
 
 	if iNdEx > l {
@@ -8621,7 +11064,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -8645,10 +11088,10 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8720,7 +11163,7 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8748,12 +11191,12 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.PodName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8781,14 +11224,14 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
+			hasFields[0] |= uint64(0x00000004)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
 			}
-			var stringLen uint64
+			m.SinceSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8798,30 +11241,17 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
+			hasFields[0] |= uint64(0x00000008)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8831,30 +11261,33 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if m.SinceTime == nil {
+				m.SinceTime = &v1.Time{}
+			}
+			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
 			}
-			var stringLen uint64
+			m.TailLines = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8864,102 +11297,17 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TailLines |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000040)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("group")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000040) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			hasFields[0] |= uint64(0x00000010)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -8969,81 +11317,16 @@ func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Actions = append(m.Actions, v1alpha1.ResourceAction{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Follow = bool(v != 0)
+			hasFields[0] |= uint64(0x00000020)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9071,67 +11354,12 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.UntilTime = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9160,12 +11388,11 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Filter = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9193,12 +11420,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9227,11 +11454,11 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.PodName = &s
+			m.Group = &s
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9259,14 +11486,14 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Container = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 5:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
 			}
-			m.SinceSeconds = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -9276,17 +11503,127 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SinceSeconds |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000008)
-		case 6:
+			m.Previous = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllContainers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllContainers = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterRegex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterRegex = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("sinceSeconds")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("tailLines")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("follow")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -9296,33 +11633,30 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SinceTime == nil {
-				m.SinceTime = &v1.Time{}
-			}
-			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
 			}
-			m.TailLines = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -9332,15 +11666,29 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TailLines |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000010)
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9357,11 +11705,11 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Follow = bool(v != 0)
-			hasFields[0] |= uint64(0x00000020)
-		case 9:
+			m.Last = bool(v != 0)
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStampStr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9389,12 +11737,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UntilTime = &s
+			m.TimeStampStr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+			hasFields[0] |= uint64(0x00000008)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9422,12 +11770,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Filter = &s
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+			hasFields[0] |= uint64(0x00000010)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9455,12 +11803,78 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.ContainerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9489,11 +11903,67 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Name = &s
 			iNdEx = postIndex
-		case 13:
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9522,8 +11992,9 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9543,28 +12014,13 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("sinceSeconds")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("tailLines")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("follow")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -9588,17 +12044,17 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -9608,28 +12064,29 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9656,14 +12113,14 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
+			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9680,11 +12137,67 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Last = bool(v != 0)
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
+			b := bool(v != 0)
+			m.CanSync = &b
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeStampStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9712,12 +12225,13 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeStampStr = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9745,79 +12259,13 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Schedule = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9846,9 +12294,31 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Duration = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ManualSync = &b
+			hasFields[0] |= uint64(0x00000008)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9866,7 +12336,16 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
 	}
 
 	if iNdEx > l {
@@ -9874,8 +12353,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9898,46 +12376,12 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9954,16 +12398,13 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -9987,17 +12428,17 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10007,65 +12448,31 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
-			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ApplicationName = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
-			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10075,72 +12482,27 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.CanSync = &b
-			hasFields[0] |= uint64(0x00000001)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10168,13 +12530,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10202,13 +12562,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Schedule = &s
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10236,15 +12594,13 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Duration = &s
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10254,14 +12610,24 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ManualSync = &b
-			hasFields[0] |= uint64(0x00000008)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10279,16 +12645,7 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
 	}
 
 	if iNdEx > l {
@@ -10296,7 +12653,7 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10319,12 +12676,46 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10347,7 +12738,7 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
+func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -10371,15 +12762,15 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAppLinksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAppLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10408,44 +12799,67 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkInfo) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10473,11 +12887,13 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Title = &s
 			iNdEx = postIndex
-		case 4:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10505,11 +12921,13 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Url = &s
 			iNdEx = postIndex
-		case 5:
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10537,11 +12955,12 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Description = &s
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10569,7 +12988,8 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.IconClass = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10588,7 +13008,10 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
 	}
 
 	if iNdEx > l {
@@ -10596,7 +13019,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *LinksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10619,10 +13042,10 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10654,7 +13077,7 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			m.Items = append(m.Items, &LinkInfo{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -48,13 +48,21 @@ func init() {
 	forward_ApplicationService_PodLogs_0 = logsForwarder
 	forward_ApplicationService_PodLogs_1 = logsForwarder
 	forward_ApplicationService_WatchResourceTree_0 = http.StreamForwarder
-	forward_ApplicationService_Watch_0 = http.NewStreamForwarder(func(message proto.Message) (string, error) {
+	watchForwarder := http.NewStreamForwarder(func(message proto.Message) (string, error) {
 		event, ok := message.(*v1alpha1.ApplicationWatchEvent)
 		if !ok {
 			return "", errors.New("unexpected message type")
 		}
 		return event.Application.Name, nil
 	})
+	forward_ApplicationService_Watch_0 = func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w gohttp.ResponseWriter, req *gohttp.Request, recv func() (proto.Message, error), opts ...func(context.Context, gohttp.ResponseWriter, proto.Message) error) {
+		if req.URL.Query().Get("incremental") == "true" {
+			// the incremental watches only send the changes, which must not be deduplicated by application
+			http.StreamForwarder(ctx, mux, marshaler, w, req, recv, opts...)
+		} else {
+			watchForwarder(ctx, mux, marshaler, w, req, recv, opts...)
+		}
+	}
 	forward_ApplicationService_List_0 = http.UnaryForwarder
 	forward_ApplicationService_ManagedResources_0 = http.UnaryForwarder
 }
@@ -0,0 +1,117 @@
+package application
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+type fakeWatchClient struct {
+	ApplicationServiceClient
+	events []*v1alpha1.ApplicationWatchEvent
+}
+
+func (c *fakeWatchClient) Watch(ctx context.Context, _ *ApplicationQuery, _ ...grpc.CallOption) (ApplicationService_WatchClient, error) {
+	return &fakeWatchStream{ctx: ctx, events: c.events}, nil
+}
+
+type fakeWatchStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	events []*v1alpha1.ApplicationWatchEvent
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+func (s *fakeWatchStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func (s *fakeWatchStream) Recv() (*v1alpha1.ApplicationWatchEvent, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return event, nil
+}
+
+func TestWatchForwarder_Incremental(t *testing.T) {
+	compact := func(version string) v1alpha1.Application {
+		return v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", ResourceVersion: version}}
+	}
+	app := compact("1")
+	app.Spec.Source.RepoURL = "https://github.com/argoproj/argocd-example-apps.git"
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	client := &fakeWatchClient{events: []*v1alpha1.ApplicationWatchEvent{
+		{Type: watch.Added, Application: app},
+		{Type: watch.Modified, Application: compact("2"), Patch: `{"metadata":{"resourceVersion":"2"},"status":{"sync":{"status":"Synced"}}}`},
+		{Type: watch.Modified, Application: compact("3"), Patch: `{"metadata":{"resourceVersion":"3"},"status":{"sync":{"status":"OutOfSync"}}}`},
+		{Type: watch.Modified, Application: compact("4"), Patch: `{"metadata":{"resourceVersion":"4"},"status":{"sync":{"status":"Synced"}}}`},
+	}}
+	mux := runtime.NewServeMux()
+	require.NoError(t, RegisterApplicationServiceHandlerClient(context.Background(), mux, client))
+
+	// the fields the UI applications list watches with
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stream/applications?incremental=true&fields="+
+		"result.type,result.patch,result.application.metadata.name,result.application.metadata.resourceVersion,result.application.spec,result.application.status.sync.status", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var received []byte
+	var versions []string
+	scanner := bufio.NewScanner(w.Body)
+	for scanner.Scan() {
+		var chunk struct {
+			Result struct {
+				Type        watch.EventType `json:"type"`
+				Application json.RawMessage `json:"application"`
+				Patch       string          `json:"patch"`
+			} `json:"result"`
+		}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &chunk))
+		event := chunk.Result
+		if event.Type == watch.Modified {
+			require.NotEmpty(t, event.Patch)
+			patched, err := jsonpatch.MergePatch(received, []byte(event.Patch))
+			require.NoError(t, err)
+			received = patched
+		} else {
+			received = event.Application
+		}
+		var compactApp v1alpha1.Application
+		require.NoError(t, json.Unmarshal(event.Application, &compactApp))
+		versions = append(versions, compactApp.ResourceVersion)
+	}
+	// every event is forwarded, with the resource version the watch resumes from
+	assert.Equal(t, []string{"1", "2", "3", "4"}, versions)
+
+	var result v1alpha1.Application
+	require.NoError(t, json.Unmarshal(received, &result))
+	assert.Equal(t, "guestbook", result.Name)
+	assert.Equal(t, "4", result.ResourceVersion)
+	assert.Equal(t, app.Spec.Source.RepoURL, result.Spec.Source.RepoURL)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, result.Status.Sync.Status)
+}
@@ -238,48 +238,101 @@ func (m *GnuPGPublicKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GnuPGPublicKeyResponse proto.InternalMessageInfo
 
+// Message to query the server for the keys of the keyring of the repository server
+type GnuPGKeyringQuery struct {
+	// Only return the keys which expire within this duration (e.g. 720h), or which are already expired
+	ExpiresWithin        string   `protobuf:"bytes,1,opt,name=expiresWithin,proto3" json:"expiresWithin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GnuPGKeyringQuery) Reset()         { *m = GnuPGKeyringQuery{} }
+func (m *GnuPGKeyringQuery) String() string { return proto.CompactTextString(m) }
+func (*GnuPGKeyringQuery) ProtoMessage()    {}
+func (*GnuPGKeyringQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba55a5eb76dc6fd, []int{4}
+}
+func (m *GnuPGKeyringQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GnuPGKeyringQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GnuPGKeyringQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GnuPGKeyringQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GnuPGKeyringQuery.Merge(m, src)
+}
+func (m *GnuPGKeyringQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GnuPGKeyringQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GnuPGKeyringQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GnuPGKeyringQuery proto.InternalMessageInfo
+
+func (m *GnuPGKeyringQuery) GetExpiresWithin() string {
+	if m != nil {
+		return m.ExpiresWithin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GnuPGPublicKeyQuery)(nil), "gpgkey.GnuPGPublicKeyQuery")
 	proto.RegisterType((*GnuPGPublicKeyCreateRequest)(nil), "gpgkey.GnuPGPublicKeyCreateRequest")
 	proto.RegisterType((*GnuPGPublicKeyCreateResponse)(nil), "gpgkey.GnuPGPublicKeyCreateResponse")
 	proto.RegisterType((*GnuPGPublicKeyResponse)(nil), "gpgkey.GnuPGPublicKeyResponse")
+	proto.RegisterType((*GnuPGKeyringQuery)(nil), "gpgkey.GnuPGKeyringQuery")
 }
 
 func init() { proto.RegisterFile("server/gpgkey/gpgkey.proto", fileDescriptor_8ba55a5eb76dc6fd) }
 
 var fileDescriptor_8ba55a5eb76dc6fd = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x99, 0xee, 0x9a, 0xb5, 0x23, 0x22, 0x8e, 0xcb, 0x6e, 0xcc, 0x96, 0x5a, 0xa2, 0x87,
-	0xa2, 0x38, 0x43, 0xbb, 0x37, 0x6f, 0xea, 0x42, 0x90, 0xed, 0xa1, 0xc6, 0x9b, 0x07, 0x25, 0x4d,
-	0x1e, 0xb3, 0x69, 0x62, 0x66, 0xcc, 0x4c, 0x22, 0x41, 0xbc, 0x78, 0x57, 0x10, 0x3f, 0x81, 0xe0,
-	0x87, 0xf1, 0x28, 0xf8, 0x05, 0xa4, 0xf8, 0x41, 0xa4, 0x93, 0xd4, 0xdd, 0x96, 0x52, 0xf7, 0xd0,
-	0x53, 0xde, 0xcb, 0x9b, 0xf7, 0xde, 0x6f, 0xde, 0xfb, 0x0f, 0x76, 0x14, 0xe4, 0x25, 0xe4, 0x8c,
-	0x4b, 0x9e, 0x40, 0xd5, 0x7c, 0xa8, 0xcc, 0x85, 0x16, 0xc4, 0xaa, 0x3d, 0x67, 0x9f, 0x0b, 0x2e,
-	0xcc, 0x2f, 0x36, 0xb7, 0xea, 0xa8, 0xd3, 0xe1, 0x42, 0xf0, 0x14, 0x58, 0x20, 0x63, 0x16, 0x64,
-	0x99, 0xd0, 0x81, 0x8e, 0x45, 0xa6, 0x9a, 0xe8, 0x88, 0xc7, 0xfa, 0xac, 0x98, 0xd0, 0x50, 0xbc,
-	0x61, 0x41, 0x6e, 0xd2, 0xa7, 0xc6, 0x78, 0x18, 0x46, 0xac, 0x1c, 0x32, 0x99, 0xf0, 0x79, 0xa6,
-	0x62, 0x81, 0x94, 0x69, 0x1c, 0x9a, 0x5c, 0x56, 0x0e, 0x82, 0x54, 0x9e, 0x05, 0x03, 0xc6, 0x21,
-	0x83, 0x3c, 0xd0, 0x10, 0xd5, 0xd5, 0xdc, 0x07, 0xf8, 0x96, 0x97, 0x15, 0x63, 0x6f, 0x5c, 0x4c,
-	0xd2, 0x38, 0x3c, 0x85, 0xea, 0x79, 0x01, 0x79, 0x45, 0xf6, 0xf1, 0x95, 0x04, 0xaa, 0x67, 0x27,
-	0x36, 0xea, 0xa1, 0x7e, 0xdb, 0xaf, 0x1d, 0xf7, 0x1b, 0xc2, 0x47, 0xcb, 0xa7, 0x9f, 0xe6, 0x10,
-	0x68, 0xf0, 0xe1, 0x6d, 0x01, 0x4a, 0x93, 0x29, 0x6e, 0x4b, 0x13, 0x49, 0xa0, 0x32, 0x99, 0xd7,
-	0x86, 0x23, 0x7a, 0x8e, 0x4b, 0x17, 0xb8, 0xc6, 0x78, 0x1d, 0x46, 0xb4, 0x1c, 0x52, 0x99, 0x70,
-	0x3a, 0xc7, 0xa5, 0x17, 0x70, 0xe9, 0x02, 0x97, 0x2e, 0x77, 0xf3, 0xcf, 0xcb, 0x93, 0x03, 0x6c,
-	0x15, 0x52, 0x41, 0xae, 0xed, 0x56, 0x0f, 0xf5, 0xaf, 0xfa, 0x8d, 0xe7, 0x7e, 0x47, 0xb8, 0xb3,
-	0x9e, 0x51, 0x49, 0x91, 0x29, 0x20, 0x53, 0xbc, 0x17, 0x9a, 0x3f, 0x51, 0x83, 0x38, 0xde, 0x26,
-	0xe2, 0x28, 0x56, 0xda, 0x5f, 0x34, 0x20, 0x36, 0xde, 0x53, 0x49, 0x2c, 0x25, 0x44, 0x76, 0xab,
-	0xb7, 0xd3, 0x6f, 0xfb, 0x0b, 0xd7, 0xb5, 0xf1, 0xc1, 0xca, 0xdd, 0x1a, 0xbe, 0xe1, 0xa7, 0x5d,
-	0x7c, 0xdd, 0x1b, 0x7b, 0xa7, 0x50, 0xbd, 0x80, 0xbc, 0x8c, 0x43, 0x20, 0x9f, 0x11, 0xde, 0x9d,
-	0xd7, 0x25, 0x47, 0xb4, 0x51, 0xd1, 0x9a, 0x95, 0x39, 0x5b, 0xbf, 0x86, 0x7b, 0xf8, 0xf1, 0xd7,
-	0x9f, 0xaf, 0xad, 0x9b, 0xe4, 0x86, 0x51, 0x62, 0x39, 0x68, 0x34, 0xac, 0xc8, 0x17, 0x84, 0x77,
-	0x3c, 0xf8, 0x0f, 0xcf, 0x56, 0x37, 0xef, 0xde, 0x31, 0x2c, 0xb7, 0xc9, 0xe1, 0x0a, 0x0b, 0x7b,
-	0x6f, 0xa4, 0xf9, 0x81, 0xbc, 0xc3, 0x56, 0xbd, 0x68, 0x72, 0x77, 0x3d, 0xd5, 0x92, 0x54, 0x9d,
-	0x7b, 0x9b, 0x0f, 0xd5, 0xbb, 0x70, 0x5d, 0xd3, 0xb5, 0xe3, 0xae, 0x4e, 0xe0, 0xd1, 0x05, 0x21,
-	0xbe, 0xc2, 0xd6, 0x09, 0xa4, 0xa0, 0x61, 0xf3, 0x38, 0xba, 0xeb, 0x83, 0xff, 0x5a, 0x35, 0xc3,
-	0xbe, 0xbf, 0xda, 0xea, 0xc9, 0xe3, 0x1f, 0xb3, 0x2e, 0xfa, 0x39, 0xeb, 0xa2, 0xdf, 0xb3, 0x2e,
-	0x7a, 0x79, 0x7c, 0xb9, 0xd7, 0x1f, 0xa6, 0x31, 0x64, 0xba, 0xa9, 0x31, 0xb1, 0xcc, 0x5b, 0x3f,
-	0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x22, 0x5b, 0x6e, 0xbf, 0x93, 0x04, 0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x95, 0x8e, 0x75, 0xd4, 0xd3, 0x84, 0x66, 0xa6, 0xad, 0xeb, 0xaa, 0x52, 0x85, 0x1d,
+	0x2a, 0x10, 0xb6, 0xda, 0x9d, 0xe0, 0x06, 0x4c, 0xaa, 0xd0, 0x7a, 0x28, 0xe1, 0x80, 0xc4, 0x01,
+	0x94, 0xa6, 0x4f, 0xae, 0x9b, 0x90, 0x18, 0xdb, 0x09, 0x44, 0x88, 0x0b, 0x1f, 0x00, 0x09, 0x71,
+	0xe4, 0x84, 0xc4, 0x8d, 0x2f, 0xc2, 0x11, 0x89, 0x2f, 0x80, 0x2a, 0x3e, 0x08, 0xaa, 0xe3, 0xb2,
+	0xb6, 0x2a, 0x83, 0x43, 0x39, 0xc5, 0xcf, 0xcf, 0xcf, 0xef, 0xe7, 0xf7, 0xfe, 0x2f, 0xa8, 0xa6,
+	0x40, 0x66, 0x20, 0x29, 0x13, 0x2c, 0x84, 0xdc, 0x7e, 0x88, 0x90, 0x89, 0x4e, 0x70, 0xb9, 0xb0,
+	0x6a, 0x7b, 0x2c, 0x61, 0x89, 0xd9, 0xa2, 0xd3, 0x55, 0xe1, 0xad, 0xd5, 0x59, 0x92, 0xb0, 0x08,
+	0xa8, 0x2f, 0x38, 0xf5, 0xe3, 0x38, 0xd1, 0xbe, 0xe6, 0x49, 0xac, 0xac, 0xb7, 0xc7, 0xb8, 0x1e,
+	0xa5, 0x03, 0x12, 0x24, 0xcf, 0xa9, 0x2f, 0x4d, 0xf8, 0xd8, 0x2c, 0x6e, 0x05, 0x43, 0x9a, 0x75,
+	0xa8, 0x08, 0xd9, 0x34, 0x52, 0x51, 0x5f, 0x88, 0x88, 0x07, 0x26, 0x96, 0x66, 0x6d, 0x3f, 0x12,
+	0x23, 0xbf, 0x4d, 0x19, 0xc4, 0x20, 0x7d, 0x0d, 0xc3, 0xe2, 0x36, 0xf7, 0x26, 0xba, 0xda, 0x8d,
+	0xd3, 0x7e, 0xb7, 0x9f, 0x0e, 0x22, 0x1e, 0x9c, 0x41, 0xfe, 0x30, 0x05, 0x99, 0xe3, 0x3d, 0xb4,
+	0x19, 0x42, 0xfe, 0xe0, 0xb4, 0xea, 0x34, 0x9d, 0x56, 0xc5, 0x2b, 0x0c, 0xf7, 0x93, 0x83, 0x8e,
+	0x16, 0x4f, 0xdf, 0x97, 0xe0, 0x6b, 0xf0, 0xe0, 0x45, 0x0a, 0x4a, 0xe3, 0x31, 0xaa, 0x08, 0xe3,
+	0x09, 0x21, 0x37, 0x91, 0xdb, 0x9d, 0x1e, 0x39, 0xc7, 0x25, 0x33, 0x5c, 0xb3, 0x78, 0x16, 0x0c,
+	0x49, 0xd6, 0x21, 0x22, 0x64, 0x64, 0x8a, 0x4b, 0xe6, 0x70, 0xc9, 0x0c, 0x97, 0x2c, 0x66, 0xf3,
+	0xce, 0xaf, 0xc7, 0xfb, 0xa8, 0x9c, 0x0a, 0x05, 0x52, 0x57, 0x4b, 0x4d, 0xa7, 0x75, 0xd9, 0xb3,
+	0x96, 0xfb, 0xd9, 0x41, 0xf5, 0xd5, 0x8c, 0x4a, 0x24, 0xb1, 0x02, 0x3c, 0x46, 0x5b, 0x81, 0xd9,
+	0x19, 0x5a, 0xc4, 0xfe, 0x3a, 0x11, 0x7b, 0x5c, 0x69, 0x6f, 0x96, 0x00, 0x57, 0xd1, 0x96, 0x0a,
+	0xb9, 0x10, 0x30, 0xac, 0x96, 0x9a, 0x1b, 0xad, 0x8a, 0x37, 0x33, 0xdd, 0x2a, 0xda, 0x5f, 0x7a,
+	0x9b, 0xe5, 0x73, 0x6f, 0xa3, 0x5d, 0xe3, 0x39, 0x83, 0x5c, 0xf2, 0x98, 0x15, 0xfd, 0x38, 0x46,
+	0x3b, 0xf0, 0x4a, 0x70, 0x09, 0xea, 0x31, 0xd7, 0x23, 0x1e, 0xdb, 0xbe, 0x2c, 0x6e, 0x76, 0xbe,
+	0x6c, 0xa2, 0x9d, 0xae, 0x89, 0x7c, 0x04, 0x32, 0xe3, 0x01, 0xe0, 0x77, 0x0e, 0xba, 0x34, 0x45,
+	0xc2, 0x47, 0xc4, 0x0a, 0x70, 0x45, 0xb7, 0x6b, 0x6b, 0xaf, 0x80, 0x7b, 0xf0, 0xf6, 0xfb, 0xcf,
+	0x0f, 0xa5, 0x5d, 0x7c, 0xc5, 0x88, 0x38, 0x6b, 0x5b, 0xf9, 0x2b, 0xfc, 0xd1, 0x41, 0xdb, 0xd3,
+	0x13, 0xf6, 0x75, 0xf8, 0x70, 0x81, 0x6b, 0xfe, 0xcd, 0xff, 0x81, 0xea, 0x9a, 0xa1, 0x3a, 0xc4,
+	0x07, 0x4b, 0x54, 0x34, 0xb4, 0x34, 0xef, 0x1d, 0xb4, 0xd1, 0x85, 0xbf, 0x54, 0x6b, 0xad, 0x92,
+	0xfe, 0x33, 0xd3, 0x6b, 0x33, 0x73, 0x6f, 0xf0, 0x4b, 0x54, 0x2e, 0x14, 0x8c, 0xaf, 0xaf, 0xa6,
+	0x5a, 0x98, 0xc1, 0xda, 0xf1, 0xc5, 0x87, 0xac, 0xc8, 0x5c, 0x93, 0xb5, 0xee, 0x2e, 0xf7, 0xe7,
+	0xce, 0xdc, 0x84, 0x3d, 0x45, 0xe5, 0x53, 0x88, 0x40, 0xc3, 0xc5, 0xe5, 0x68, 0xac, 0x76, 0xfe,
+	0x4e, 0x65, 0xa5, 0x70, 0x63, 0x39, 0xd5, 0xbd, 0xbb, 0x5f, 0x27, 0x0d, 0xe7, 0xdb, 0xa4, 0xe1,
+	0xfc, 0x98, 0x34, 0x9c, 0x27, 0x27, 0xff, 0xf6, 0x5b, 0x0b, 0x22, 0x0e, 0xb1, 0xb6, 0x77, 0x0c,
+	0xca, 0xe6, 0x27, 0x76, 0xf2, 0x6b, 0x00, 0x5c, 0xa2, 0xda, 0x6e, 0x6c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +349,8 @@ const _ = grpc.SupportPackageIsVersion4
 type GPGKeyServiceClient interface {
 	// List all available repository certificates
 	List(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error)
+	// List the GPG public keys of the keyring the repository server verifies the signatures of the commits with
+	ListKeyring(ctx context.Context, in *GnuPGKeyringQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error)
 	// Get information about specified GPG public key from the server
 	Get(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKey, error)
 	// Create one or more GPG public keys in the server's configuration
@@ -321,6 +376,15 @@ func (c *gPGKeyServiceClient) List(ctx context.Context, in *GnuPGPublicKeyQuery,
 	return out, nil
 }
 
+func (c *gPGKeyServiceClient) ListKeyring(ctx context.Context, in *GnuPGKeyringQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKeyList, error) {
+	out := new(v1alpha1.GnuPGPublicKeyList)
+	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/ListKeyring", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gPGKeyServiceClient) Get(ctx context.Context, in *GnuPGPublicKeyQuery, opts ...grpc.CallOption) (*v1alpha1.GnuPGPublicKey, error) {
 	out := new(v1alpha1.GnuPGPublicKey)
 	err := c.cc.Invoke(ctx, "/gpgkey.GPGKeyService/Get", in, out, opts...)
@@ -352,6 +416,8 @@ func (c *gPGKeyServiceClient) Delete(ctx context.Context, in *GnuPGPublicKeyQuer
 type GPGKeyServiceServer interface {
 	// List all available repository certificates
 	List(context.Context, *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKeyList, error)
+	// List the GPG public keys of the keyring the repository server verifies the signatures of the commits with
+	ListKeyring(context.Context, *GnuPGKeyringQuery) (*v1alpha1.GnuPGPublicKeyList, error)
 	// Get information about specified GPG public key from the server
 	Get(context.Context, *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKey, error)
 	// Create one or more GPG public keys in the server's configuration
@@ -367,6 +433,9 @@ type UnimplementedGPGKeyServiceServer struct {
 func (*UnimplementedGPGKeyServiceServer) List(ctx context.Context, req *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKeyList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (*UnimplementedGPGKeyServiceServer) ListKeyring(ctx context.Context, req *GnuPGKeyringQuery) (*v1alpha1.GnuPGPublicKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeyring not implemented")
+}
 func (*UnimplementedGPGKeyServiceServer) Get(ctx context.Context, req *GnuPGPublicKeyQuery) (*v1alpha1.GnuPGPublicKey, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
@@ -399,6 +468,24 @@ func _GPGKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GPGKeyService_ListKeyring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GnuPGKeyringQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GPGKeyServiceServer).ListKeyring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gpgkey.GPGKeyService/ListKeyring",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GPGKeyServiceServer).ListKeyring(ctx, req.(*GnuPGKeyringQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GPGKeyService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GnuPGPublicKeyQuery)
 	if err := dec(in); err != nil {
@@ -461,6 +548,10 @@ var _GPGKeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _GPGKeyService_List_Handler,
 		},
+		{
+			MethodName: "ListKeyring",
+			Handler:    _GPGKeyService_ListKeyring_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _GPGKeyService_Get_Handler,
@@ -636,6 +727,40 @@ func (m *GnuPGPublicKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GnuPGKeyringQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GnuPGKeyringQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GnuPGKeyringQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExpiresWithin) > 0 {
+		i -= len(m.ExpiresWithin)
+		copy(dAtA[i:], m.ExpiresWithin)
+		i = encodeVarintGpgkey(dAtA, i, uint64(len(m.ExpiresWithin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGpgkey(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGpgkey(v)
 	base := offset
@@ -716,6 +841,22 @@ func (m *GnuPGPublicKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *GnuPGKeyringQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExpiresWithin)
+	if l > 0 {
+		n += 1 + l + sovGpgkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovGpgkey(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1082,6 +1223,89 @@ func (m *GnuPGPublicKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GnuPGKeyringQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGpgkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GnuPGKeyringQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GnuPGKeyringQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresWithin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGpgkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresWithin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGpgkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGpgkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGpgkey(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
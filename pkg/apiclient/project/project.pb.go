@@ -244,6 +244,266 @@ func (m *ProjectTokenCreateRequest) GetId() string {
 	return ""
 }
 
+// ProjectTokenRotateRequest defines project token rotation parameters.
+type ProjectTokenRotateRequest struct {
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// id is the id of the token to rotate
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// expiresIn represents the duration in seconds of the new token, which defaults to the duration of the rotated token
+	ExpiresIn int64 `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	// gracePeriod represents the duration in seconds during which the rotated token remains valid
+	GracePeriod          int64    `protobuf:"varint,5,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenRotateRequest) Reset()         { *m = ProjectTokenRotateRequest{} }
+func (m *ProjectTokenRotateRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenRotateRequest) ProtoMessage()    {}
+func (*ProjectTokenRotateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{3}
+}
+func (m *ProjectTokenRotateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenRotateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenRotateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTokenRotateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenRotateRequest.Merge(m, src)
+}
+func (m *ProjectTokenRotateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenRotateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenRotateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenRotateRequest proto.InternalMessageInfo
+
+func (m *ProjectTokenRotateRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectTokenRotateRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ProjectTokenRotateRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectTokenRotateRequest) GetExpiresIn() int64 {
+	if m != nil {
+		return m.ExpiresIn
+	}
+	return 0
+}
+
+func (m *ProjectTokenRotateRequest) GetGracePeriod() int64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+// ProjectTokenListRequest defines project token listing parameters.
+type ProjectTokenListRequest struct {
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// role optionally restricts the tokens to the ones of the given role
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectTokenListRequest) Reset()         { *m = ProjectTokenListRequest{} }
+func (m *ProjectTokenListRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenListRequest) ProtoMessage()    {}
+func (*ProjectTokenListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{4}
+}
+func (m *ProjectTokenListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTokenListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenListRequest.Merge(m, src)
+}
+func (m *ProjectTokenListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenListRequest proto.InternalMessageInfo
+
+func (m *ProjectTokenListRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *ProjectTokenListRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+// ProjectToken holds the metadata of a token of a project role
+type ProjectToken struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt             int64    `protobuf:"varint,3,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProjectToken) Reset()         { *m = ProjectToken{} }
+func (m *ProjectToken) String() string { return proto.CompactTextString(m) }
+func (*ProjectToken) ProtoMessage()    {}
+func (*ProjectToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{5}
+}
+func (m *ProjectToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectToken.Merge(m, src)
+}
+func (m *ProjectToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectToken proto.InternalMessageInfo
+
+func (m *ProjectToken) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ProjectToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectToken) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *ProjectToken) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// ProjectTokenListResponse holds the tokens of a project
+type ProjectTokenListResponse struct {
+	Items                []*ProjectToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ProjectTokenListResponse) Reset()         { *m = ProjectTokenListResponse{} }
+func (m *ProjectTokenListResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectTokenListResponse) ProtoMessage()    {}
+func (*ProjectTokenListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{6}
+}
+func (m *ProjectTokenListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTokenListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTokenListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTokenListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTokenListResponse.Merge(m, src)
+}
+func (m *ProjectTokenListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTokenListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTokenListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTokenListResponse proto.InternalMessageInfo
+
+func (m *ProjectTokenListResponse) GetItems() []*ProjectToken {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 // ProjectTokenResponse wraps the created token or returns an empty string if deleted.
 type ProjectTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -256,7 +516,7 @@ func (m *ProjectTokenResponse) Reset()         { *m = ProjectTokenResponse{} }
 func (m *ProjectTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*ProjectTokenResponse) ProtoMessage()    {}
 func (*ProjectTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{3}
+	return fileDescriptor_5f0a51496972c9e2, []int{7}
 }
 func (m *ProjectTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -304,7 +564,7 @@ func (m *ProjectQuery) Reset()         { *m = ProjectQuery{} }
 func (m *ProjectQuery) String() string { return proto.CompactTextString(m) }
 func (*ProjectQuery) ProtoMessage()    {}
 func (*ProjectQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{4}
+	return fileDescriptor_5f0a51496972c9e2, []int{8}
 }
 func (m *ProjectQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,7 +611,7 @@ func (m *ProjectUpdateRequest) Reset()         { *m = ProjectUpdateRequest{} }
 func (m *ProjectUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateRequest) ProtoMessage()    {}
 func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{5}
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
 }
 func (m *ProjectUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +657,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{6}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +697,7 @@ func (m *SyncWindowsQuery) Reset()         { *m = SyncWindowsQuery{} }
 func (m *SyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsQuery) ProtoMessage()    {}
 func (*SyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{7}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *SyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,7 +744,7 @@ func (m *SyncWindowsResponse) Reset()         { *m = SyncWindowsResponse{} }
 func (m *SyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncWindowsResponse) ProtoMessage()    {}
 func (*SyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{8}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *SyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,7 +791,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +841,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{14}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,6 +902,10 @@ func init() {
 	proto.RegisterType((*ProjectCreateRequest)(nil), "project.ProjectCreateRequest")
 	proto.RegisterType((*ProjectTokenDeleteRequest)(nil), "project.ProjectTokenDeleteRequest")
 	proto.RegisterType((*ProjectTokenCreateRequest)(nil), "project.ProjectTokenCreateRequest")
+	proto.RegisterType((*ProjectTokenRotateRequest)(nil), "project.ProjectTokenRotateRequest")
+	proto.RegisterType((*ProjectTokenListRequest)(nil), "project.ProjectTokenListRequest")
+	proto.RegisterType((*ProjectToken)(nil), "project.ProjectToken")
+	proto.RegisterType((*ProjectTokenListResponse)(nil), "project.ProjectTokenListResponse")
 	proto.RegisterType((*ProjectTokenResponse)(nil), "project.ProjectTokenResponse")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
@@ -655,69 +919,78 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 977 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0x23, 0x35,
-	0x14, 0xc7, 0x35, 0x49, 0x9b, 0xdd, 0x3a, 0x4b, 0x29, 0xde, 0x6e, 0x99, 0x86, 0x6e, 0x37, 0x18,
-	0x51, 0x45, 0x85, 0x7a, 0xd4, 0x74, 0x91, 0x56, 0x70, 0x62, 0x77, 0xab, 0x80, 0xd4, 0x03, 0x4c,
-	0x41, 0x20, 0x0e, 0x20, 0x67, 0xe6, 0x29, 0x75, 0x33, 0x19, 0x0f, 0x63, 0x27, 0xdb, 0x10, 0xf5,
-	0x82, 0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x7f, 0x80, 0x13, 0xff, 0x04, 0x37, 0x8e, 0x48, 0xfc,
-	0x03, 0xa8, 0xe2, 0x0f, 0x41, 0xf6, 0xfc, 0x48, 0x26, 0xe9, 0x20, 0x50, 0x03, 0xa7, 0x78, 0xde,
-	0xbc, 0x79, 0xdf, 0xcf, 0x7b, 0xb6, 0x9f, 0x1d, 0xb4, 0x23, 0x21, 0x1e, 0x41, 0xec, 0x44, 0xb1,
-	0x38, 0x07, 0x4f, 0x65, 0xbf, 0x34, 0x8a, 0x85, 0x12, 0xf8, 0x56, 0xfa, 0xd8, 0xd8, 0xec, 0x89,
-	0x9e, 0x30, 0x36, 0x47, 0x8f, 0x92, 0xd7, 0x8d, 0x9d, 0x9e, 0x10, 0xbd, 0x00, 0x1c, 0x16, 0x71,
-	0x87, 0x85, 0xa1, 0x50, 0x4c, 0x71, 0x11, 0xca, 0xf4, 0x2d, 0xe9, 0x3f, 0x92, 0x94, 0x0b, 0xf3,
-	0xd6, 0x13, 0x31, 0x38, 0xa3, 0x43, 0xa7, 0x07, 0x21, 0xc4, 0x4c, 0x81, 0x9f, 0xfa, 0x3c, 0x9c,
-	0xfa, 0x0c, 0x98, 0x77, 0xc6, 0x43, 0x88, 0xc7, 0x4e, 0xd4, 0xef, 0x69, 0x83, 0x74, 0x06, 0xa0,
-	0xd8, 0x75, 0x5f, 0x9d, 0xf4, 0xb8, 0x3a, 0x1b, 0x76, 0xa9, 0x27, 0x06, 0x0e, 0x8b, 0x0d, 0xd8,
-	0xb9, 0x19, 0x1c, 0x78, 0xbe, 0x33, 0x6a, 0x4f, 0x03, 0xb0, 0x28, 0x0a, 0xb8, 0x67, 0xa8, 0x9c,
-	0xd1, 0x21, 0x0b, 0xa2, 0x33, 0xb6, 0x10, 0x8d, 0x7c, 0x6f, 0xa1, 0xcd, 0xf7, 0x92, 0x3c, 0x9f,
-	0xc4, 0xc0, 0x14, 0xb8, 0xf0, 0xf9, 0x10, 0xa4, 0xc2, 0x5d, 0x94, 0xe5, 0x6f, 0x5b, 0x4d, 0xab,
-	0x55, 0x6f, 0xbf, 0x43, 0xa7, 0xc2, 0x34, 0x13, 0x36, 0x83, 0xcf, 0x3c, 0x9f, 0x8e, 0xda, 0x34,
-	0xea, 0xf7, 0xa8, 0x16, 0xa6, 0x33, 0xc2, 0x34, 0x13, 0xa6, 0x6f, 0x47, 0x51, 0xaa, 0xe3, 0x66,
-	0x81, 0xf1, 0x16, 0xaa, 0x0d, 0x23, 0x09, 0xb1, 0xb2, 0x2b, 0x4d, 0xab, 0x75, 0xdb, 0x4d, 0x9f,
-	0x48, 0x1f, 0x6d, 0xa7, 0xbe, 0x1f, 0x88, 0x3e, 0x84, 0x4f, 0x21, 0x80, 0x29, 0x98, 0x5d, 0x04,
-	0x5b, 0x9b, 0x86, 0xc3, 0x68, 0x25, 0x16, 0x01, 0x98, 0x60, 0x6b, 0xae, 0x19, 0xe3, 0x0d, 0x54,
-	0xe5, 0x4c, 0xd9, 0xd5, 0xa6, 0xd5, 0xaa, 0xba, 0x7a, 0x88, 0xd7, 0x51, 0x85, 0xfb, 0xf6, 0x8a,
-	0xf1, 0xa9, 0x70, 0x9f, 0xfc, 0x68, 0x15, 0xd5, 0x8a, 0x65, 0x28, 0x57, 0x6b, 0xa2, 0xba, 0x0f,
-	0xd2, 0x8b, 0x79, 0xa4, 0x13, 0x4d, 0x45, 0x67, 0x4d, 0x39, 0x4f, 0x75, 0x86, 0x67, 0x07, 0xad,
-	0xc1, 0x45, 0xc4, 0x63, 0x90, 0xef, 0x86, 0x06, 0xa2, 0xea, 0x4e, 0x0d, 0x29, 0xdb, 0x6a, 0xce,
-	0xf6, 0x7a, 0x3e, 0x39, 0x06, 0xcd, 0x05, 0x19, 0x89, 0x50, 0x02, 0xde, 0x44, 0xab, 0x4a, 0x1b,
-	0x52, 0xa6, 0xe4, 0x81, 0x10, 0x74, 0x27, 0xf5, 0x7e, 0x7f, 0x08, 0xf1, 0x58, 0xeb, 0x87, 0x6c,
-	0x00, 0xa9, 0x93, 0x19, 0x93, 0x2f, 0xf2, 0x88, 0x1f, 0x46, 0xfe, 0xff, 0x3b, 0xdd, 0xe4, 0x79,
-	0xf4, 0xdc, 0xf1, 0x20, 0x52, 0xe3, 0x2c, 0x0d, 0xb2, 0x87, 0x36, 0x4e, 0xc7, 0xa1, 0xf7, 0x11,
-	0x0f, 0x7d, 0xf1, 0x4c, 0x96, 0x43, 0x8f, 0xd1, 0xdd, 0x19, 0xbf, 0xbc, 0x0a, 0x5d, 0x74, 0xeb,
-	0x59, 0x62, 0xb2, 0xad, 0x66, 0xf5, 0xe6, 0xcc, 0x53, 0x0d, 0x37, 0x0b, 0x4c, 0x2e, 0xd0, 0x56,
-	0x27, 0x10, 0x5d, 0x16, 0xa4, 0xd9, 0x4c, 0xd5, 0x3f, 0x45, 0xab, 0x5c, 0xc1, 0x60, 0x49, 0xda,
-	0x33, 0xf5, 0x4a, 0xc2, 0x92, 0x5f, 0xaa, 0xc8, 0x7e, 0x0a, 0x8a, 0xf1, 0x00, 0xfc, 0x05, 0xf1,
-	0x08, 0xad, 0xf7, 0x0a, 0x58, 0x4b, 0xa7, 0x98, 0x8b, 0x3f, 0xbb, 0x40, 0x2a, 0xff, 0x55, 0x3f,
-	0x08, 0xd0, 0x9d, 0x18, 0x22, 0x21, 0xb9, 0x12, 0x31, 0x07, 0x69, 0x57, 0x97, 0x91, 0x93, 0x9b,
-	0x45, 0x1c, 0xbb, 0x85, 0xe8, 0x98, 0xa1, 0xdb, 0x5e, 0x30, 0x94, 0x0a, 0x62, 0x69, 0xaf, 0x18,
-	0xa5, 0xe3, 0x9b, 0x29, 0x3d, 0x49, 0xa2, 0xb9, 0x79, 0xd8, 0xf6, 0xcf, 0x75, 0xb4, 0x9e, 0x66,
-	0x79, 0x0a, 0xf1, 0x88, 0x7b, 0x80, 0xbf, 0xb5, 0x50, 0x3d, 0x69, 0x31, 0x66, 0x4b, 0x63, 0x42,
-	0xb3, 0x53, 0xa7, 0xb4, 0x09, 0x35, 0xee, 0x5f, 0xeb, 0x93, 0x6f, 0xa3, 0x47, 0x5f, 0xfe, 0xfe,
-	0xe7, 0x0f, 0x95, 0x36, 0x39, 0x30, 0xa7, 0xcd, 0xe8, 0x30, 0x3b, 0xc7, 0xa4, 0x33, 0x49, 0x47,
-	0x97, 0x8e, 0x6e, 0x3e, 0xd2, 0x99, 0xe8, 0x9f, 0x4b, 0xc7, 0xb4, 0x8b, 0x37, 0xad, 0x7d, 0xfc,
-	0xb5, 0x85, 0xea, 0x49, 0x77, 0xfd, 0x3b, 0x98, 0x42, 0xff, 0x6d, 0x6c, 0xe5, 0x3e, 0xc5, 0xcd,
-	0xfc, 0x96, 0xa1, 0x78, 0x63, 0xff, 0xe8, 0x5f, 0x51, 0x38, 0x13, 0xce, 0xd4, 0x25, 0xfe, 0xce,
-	0x42, 0xb5, 0x24, 0x67, 0xbc, 0x90, 0x6c, 0xb1, 0x16, 0x4b, 0x5b, 0x76, 0xe4, 0x25, 0x03, 0x7c,
-	0x8f, 0x6c, 0xcc, 0x03, 0xeb, 0xca, 0x7c, 0x65, 0xa1, 0x95, 0x13, 0x2e, 0x15, 0xbe, 0x37, 0x8f,
-	0x63, 0xda, 0x54, 0xe3, 0x64, 0x59, 0x18, 0x5a, 0x84, 0xd8, 0x06, 0x05, 0xe3, 0x05, 0x14, 0x7c,
-	0x81, 0x70, 0x07, 0xd4, 0x5c, 0x1f, 0x28, 0x83, 0x7a, 0x39, 0x37, 0x97, 0x35, 0x0e, 0xd2, 0x32,
-	0x4a, 0x04, 0x37, 0x17, 0x67, 0x49, 0xb7, 0xda, 0x4b, 0xc7, 0x4f, 0xbf, 0xc4, 0xdf, 0x58, 0xa8,
-	0xda, 0x81, 0x52, 0xad, 0xe5, 0xcd, 0xc3, 0x03, 0x83, 0xb4, 0x8d, 0x5f, 0x2c, 0x41, 0xc2, 0x13,
-	0xf4, 0x42, 0x07, 0x54, 0xb1, 0x0d, 0x97, 0x61, 0x3d, 0xc8, 0xcd, 0xd7, 0xb7, 0x6d, 0x42, 0x8d,
-	0x5a, 0x0b, 0xef, 0x95, 0x15, 0x20, 0xe9, 0x7b, 0xf9, 0x04, 0xfc, 0x64, 0xa1, 0x5a, 0x72, 0x54,
-	0x2e, 0xae, 0xcc, 0xc2, 0x11, 0xba, 0xc4, 0x8a, 0x1c, 0x19, 0xc6, 0x83, 0x46, 0xab, 0x74, 0x2b,
-	0x51, 0x7d, 0x3b, 0xf4, 0x99, 0x62, 0xd4, 0x40, 0xeb, 0x15, 0xfb, 0x31, 0xaa, 0x25, 0x1b, 0xb5,
-	0xac, 0x34, 0x65, 0x1b, 0x37, 0xad, 0xff, 0x7e, 0x69, 0xfd, 0xcf, 0x11, 0xd2, 0xab, 0xf4, 0x78,
-	0x04, 0x61, 0x79, 0xe1, 0xef, 0xd3, 0xe4, 0x36, 0xab, 0x33, 0xa4, 0xfa, 0xc6, 0x4b, 0x47, 0x87,
-	0xd4, 0x7c, 0x62, 0x56, 0xf8, 0x9e, 0x11, 0x69, 0xe2, 0xdd, 0xb2, 0xb2, 0x43, 0x12, 0x7d, 0x82,
-	0xee, 0x76, 0x40, 0xcd, 0x9c, 0xf6, 0xa7, 0x4a, 0x97, 0x7e, 0x3b, 0x17, 0x9d, 0xbf, 0x30, 0x34,
-	0x76, 0xae, 0x7b, 0x95, 0x27, 0xf7, 0x9a, 0xd1, 0x7d, 0x15, 0xbf, 0x52, 0xa6, 0x2b, 0xc7, 0xa1,
-	0x97, 0x1e, 0xf6, 0x8f, 0x1f, 0xff, 0x7a, 0xb5, 0x6b, 0xfd, 0x76, 0xb5, 0x6b, 0xfd, 0x71, 0xb5,
-	0x6b, 0x7d, 0xf2, 0xf0, 0x9f, 0x5d, 0xb4, 0xbd, 0x80, 0x43, 0x98, 0xff, 0x77, 0xe8, 0xd6, 0xcc,
-	0xbd, 0xfa, 0xe8, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x43, 0xdb, 0x65, 0x5c, 0x0c, 0x00,
-	0x00,
+	// 1126 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc7, 0xb5, 0x76, 0x92, 0x26, 0xe3, 0x36, 0x84, 0x69, 0x9a, 0x6e, 0x4c, 0x9a, 0xba, 0x53,
+	0x11, 0x99, 0x94, 0xec, 0x2a, 0x4e, 0x91, 0x0a, 0x48, 0x48, 0x69, 0x13, 0x19, 0xa4, 0x5c, 0x94,
+	0x0d, 0x08, 0xc4, 0x05, 0x68, 0xb3, 0x7b, 0xe4, 0x4c, 0xb2, 0xde, 0x59, 0x66, 0xc6, 0x6e, 0x4c,
+	0xc8, 0x0d, 0x12, 0x20, 0x71, 0xc1, 0x05, 0x48, 0x48, 0xbc, 0x00, 0xef, 0x81, 0xb8, 0xe1, 0x12,
+	0x89, 0x17, 0x40, 0x11, 0x0f, 0x82, 0x66, 0xf6, 0xc3, 0xbb, 0xb1, 0x37, 0x6d, 0xa9, 0xe1, 0xca,
+	0xb3, 0x67, 0xc7, 0xe7, 0xff, 0x3b, 0x67, 0x66, 0xcf, 0x99, 0x41, 0x2b, 0x02, 0x78, 0x1f, 0xb8,
+	0x1d, 0x71, 0x76, 0x04, 0x9e, 0x4c, 0x7f, 0xad, 0x88, 0x33, 0xc9, 0xf0, 0x95, 0xe4, 0xb1, 0xbe,
+	0xd8, 0x61, 0x1d, 0xa6, 0x6d, 0xb6, 0x1a, 0xc5, 0xaf, 0xeb, 0x2b, 0x1d, 0xc6, 0x3a, 0x01, 0xd8,
+	0x6e, 0x44, 0x6d, 0x37, 0x0c, 0x99, 0x74, 0x25, 0x65, 0xa1, 0x48, 0xde, 0x92, 0xe3, 0x07, 0xc2,
+	0xa2, 0x4c, 0xbf, 0xf5, 0x18, 0x07, 0xbb, 0xbf, 0x69, 0x77, 0x20, 0x04, 0xee, 0x4a, 0xf0, 0x93,
+	0x39, 0xf7, 0x87, 0x73, 0xba, 0xae, 0x77, 0x48, 0x43, 0xe0, 0x03, 0x3b, 0x3a, 0xee, 0x28, 0x83,
+	0xb0, 0xbb, 0x20, 0xdd, 0x71, 0xff, 0xda, 0xeb, 0x50, 0x79, 0xd8, 0x3b, 0xb0, 0x3c, 0xd6, 0xb5,
+	0x5d, 0xae, 0xc1, 0x8e, 0xf4, 0x60, 0xc3, 0xf3, 0xed, 0x7e, 0x6b, 0xe8, 0xc0, 0x8d, 0xa2, 0x80,
+	0x7a, 0x9a, 0xca, 0xee, 0x6f, 0xba, 0x41, 0x74, 0xe8, 0x8e, 0x78, 0x23, 0x3f, 0x18, 0x68, 0xf1,
+	0x71, 0x1c, 0xe7, 0x23, 0x0e, 0xae, 0x04, 0x07, 0x3e, 0xef, 0x81, 0x90, 0xf8, 0x00, 0xa5, 0xf1,
+	0x9b, 0x46, 0xc3, 0x68, 0xd6, 0x5a, 0xef, 0x5a, 0x43, 0x61, 0x2b, 0x15, 0xd6, 0x83, 0xcf, 0x3c,
+	0xdf, 0xea, 0xb7, 0xac, 0xe8, 0xb8, 0x63, 0x29, 0x61, 0x2b, 0x27, 0x6c, 0xa5, 0xc2, 0xd6, 0x76,
+	0x14, 0x25, 0x3a, 0x4e, 0xea, 0x18, 0x2f, 0xa1, 0x99, 0x5e, 0x24, 0x80, 0x4b, 0xb3, 0xd2, 0x30,
+	0x9a, 0xb3, 0x4e, 0xf2, 0x44, 0x8e, 0xd1, 0x72, 0x32, 0xf7, 0x03, 0x76, 0x0c, 0xe1, 0x0e, 0x04,
+	0x30, 0x04, 0x33, 0x8b, 0x60, 0x73, 0x43, 0x77, 0x18, 0x4d, 0x71, 0x16, 0x80, 0x76, 0x36, 0xe7,
+	0xe8, 0x31, 0x5e, 0x40, 0x55, 0xea, 0x4a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x47, 0x0d, 0xf1, 0x3c,
+	0xaa, 0x50, 0xdf, 0x9c, 0xd2, 0x73, 0x2a, 0xd4, 0x27, 0x3f, 0x1b, 0x45, 0xb5, 0x62, 0x1a, 0xca,
+	0xd5, 0x1a, 0xa8, 0xe6, 0x83, 0xf0, 0x38, 0x8d, 0x54, 0xa0, 0x89, 0x68, 0xde, 0x94, 0xf1, 0x54,
+	0x73, 0x3c, 0x2b, 0x68, 0x0e, 0x4e, 0x22, 0xca, 0x41, 0xbc, 0x17, 0x6a, 0x88, 0xaa, 0x33, 0x34,
+	0x24, 0x6c, 0xd3, 0xa5, 0x6c, 0x0e, 0x93, 0xcf, 0xc4, 0x36, 0x2e, 0x13, 0xb1, 0xef, 0x6a, 0xea,
+	0xfb, 0x29, 0x24, 0x0d, 0x54, 0xeb, 0x70, 0xd7, 0x83, 0xc7, 0xc0, 0x29, 0x8b, 0x91, 0xaa, 0x4e,
+	0xde, 0x44, 0xda, 0xe8, 0x66, 0x1e, 0x6d, 0x8f, 0x0a, 0xf9, 0xaf, 0xc0, 0x48, 0x80, 0xae, 0xe6,
+	0x1d, 0x65, 0x73, 0x8c, 0x11, 0xf8, 0x4a, 0x06, 0x5f, 0x47, 0xb3, 0x54, 0x88, 0x1e, 0xf8, 0xdb,
+	0xe9, 0xda, 0x66, 0xcf, 0xb9, 0xc0, 0xb6, 0xe5, 0x85, 0xc0, 0xb6, 0x25, 0x69, 0x23, 0x73, 0x14,
+	0x5b, 0x44, 0x2c, 0x14, 0x80, 0xef, 0xa1, 0x69, 0x2a, 0xa1, 0x2b, 0x4c, 0xa3, 0x51, 0x6d, 0xd6,
+	0x5a, 0x37, 0xac, 0xb4, 0x20, 0x14, 0xd6, 0x20, 0x9e, 0x43, 0x5e, 0x47, 0x8b, 0x05, 0x73, 0xea,
+	0x64, 0x11, 0x4d, 0x4b, 0x65, 0x48, 0xf8, 0xe3, 0x07, 0x42, 0xb2, 0x20, 0xdf, 0xef, 0x01, 0x1f,
+	0xa8, 0x20, 0x43, 0xb7, 0x9b, 0x05, 0xa9, 0xc6, 0xe4, 0x8b, 0xcc, 0xe3, 0x87, 0x91, 0xff, 0xff,
+	0x7e, 0x8a, 0xe4, 0x25, 0x74, 0x6d, 0xb7, 0x1b, 0xc9, 0x41, 0x1a, 0x06, 0x59, 0x43, 0x0b, 0xfb,
+	0x83, 0xd0, 0xfb, 0x88, 0x86, 0x3e, 0x7b, 0x22, 0xca, 0xa1, 0x07, 0xe8, 0x7a, 0x6e, 0x5e, 0x96,
+	0x85, 0x03, 0x74, 0xe5, 0x49, 0x6c, 0x4a, 0x92, 0xf9, 0x82, 0xcc, 0x43, 0x0d, 0x27, 0x75, 0x4c,
+	0x4e, 0xd0, 0x52, 0x3b, 0x60, 0x07, 0x6e, 0x90, 0x44, 0x33, 0x54, 0xff, 0xb4, 0xb8, 0x90, 0x93,
+	0xcb, 0x57, 0xb2, 0xf6, 0xbf, 0x56, 0x91, 0xb9, 0x03, 0xd2, 0xa5, 0x01, 0xf8, 0x23, 0xe2, 0x11,
+	0x9a, 0xef, 0x14, 0xb0, 0x26, 0x4e, 0x71, 0xc1, 0x7f, 0x7e, 0x83, 0x54, 0xfe, 0xab, 0x5a, 0x1d,
+	0xa0, 0xab, 0x1c, 0x22, 0x26, 0xa8, 0x64, 0x9c, 0x82, 0x30, 0xab, 0x93, 0x88, 0xc9, 0x49, 0x3d,
+	0x0e, 0x9c, 0x82, 0x77, 0xec, 0xa2, 0x59, 0x2f, 0xe8, 0x09, 0x09, 0x5c, 0x98, 0x53, 0x5a, 0x69,
+	0xf7, 0xc5, 0x94, 0x1e, 0xc5, 0xde, 0x9c, 0xcc, 0x6d, 0xeb, 0xb7, 0x6b, 0x68, 0x3e, 0x89, 0x72,
+	0x1f, 0x78, 0x9f, 0x7a, 0x80, 0xbf, 0x33, 0x50, 0x2d, 0x2e, 0xff, 0x71, 0x25, 0x22, 0x63, 0x0b,
+	0x40, 0xa1, 0x41, 0xd4, 0x6f, 0x8d, 0x2f, 0x12, 0xe9, 0x67, 0xf4, 0xe0, 0xab, 0x3f, 0xff, 0xfe,
+	0xb1, 0xd2, 0x22, 0x1b, 0xfa, 0x24, 0xd0, 0xdf, 0x4c, 0xcf, 0x18, 0xc2, 0x3e, 0x4d, 0x46, 0x67,
+	0xb6, 0xaa, 0x70, 0xc2, 0x3e, 0x55, 0x3f, 0x67, 0xb6, 0x2e, 0x17, 0x6f, 0x19, 0xeb, 0xf8, 0x1b,
+	0x03, 0xd5, 0xe2, 0xce, 0x77, 0x19, 0x4c, 0xa1, 0x37, 0xd6, 0x97, 0xb2, 0x39, 0xc5, 0x8f, 0xf9,
+	0x6d, 0x4d, 0xf1, 0xc6, 0xfa, 0xd6, 0x73, 0x51, 0xd8, 0xa7, 0xd4, 0x95, 0x67, 0xf8, 0x27, 0x03,
+	0xd5, 0xe2, 0xc6, 0x73, 0x19, 0x48, 0xa1, 0x35, 0x3d, 0x2d, 0x2b, 0x3b, 0x9a, 0xe7, 0x1d, 0xf2,
+	0xe6, 0xf3, 0xf2, 0xf8, 0xca, 0xae, 0x84, 0x54, 0x86, 0xbe, 0x44, 0x48, 0x95, 0x6f, 0xed, 0x5a,
+	0xe0, 0xc6, 0x58, 0xc9, 0x5c, 0x5b, 0xaa, 0xdf, 0xb9, 0x64, 0x46, 0x02, 0xf6, 0x9a, 0x06, 0xbb,
+	0x8b, 0xef, 0x5c, 0x02, 0x26, 0x63, 0xbd, 0xef, 0x0d, 0x34, 0x13, 0x6f, 0x05, 0x3c, 0x12, 0x6d,
+	0x71, 0x8b, 0x4c, 0xec, 0x6b, 0x24, 0xaf, 0x68, 0xbc, 0x1b, 0x64, 0xe1, 0x22, 0x9e, 0x4a, 0xc7,
+	0xd7, 0x06, 0x9a, 0x52, 0xc1, 0xe0, 0x91, 0xbe, 0xa5, 0xab, 0x77, 0x7d, 0x6f, 0x52, 0x18, 0x4a,
+	0x84, 0x98, 0x1a, 0x05, 0xe3, 0x11, 0x14, 0x7c, 0x82, 0x70, 0x1b, 0xe4, 0x85, 0xf2, 0x58, 0x06,
+	0x35, 0x5c, 0x93, 0xb2, 0x7a, 0x4a, 0x9a, 0x5a, 0x89, 0xe0, 0xc6, 0xe8, 0x9a, 0xa8, 0x0e, 0x74,
+	0x66, 0xfb, 0xc9, 0x3f, 0xf1, 0xb7, 0x06, 0xaa, 0xb6, 0xa1, 0x54, 0x6b, 0x72, 0xeb, 0x70, 0x5b,
+	0x23, 0x2d, 0xe3, 0x9b, 0x25, 0x48, 0xf8, 0x14, 0xbd, 0xdc, 0x06, 0x59, 0xec, 0x4e, 0x65, 0x58,
+	0xb7, 0x33, 0xf3, 0xf8, 0x6e, 0x46, 0x2c, 0xad, 0xd6, 0xc4, 0x6b, 0x65, 0x09, 0x88, 0xdb, 0x41,
+	0xb6, 0x00, 0xbf, 0x18, 0x68, 0x26, 0x3e, 0x41, 0x8c, 0xee, 0xcc, 0xc2, 0xc9, 0x62, 0x82, 0x19,
+	0xd9, 0xd2, 0x8c, 0x1b, 0xf5, 0x66, 0xe9, 0x87, 0x63, 0xa9, 0x0b, 0x8d, 0xef, 0x4a, 0xd7, 0xd2,
+	0xd0, 0x6a, 0xc7, 0x7e, 0x8c, 0x66, 0xe2, 0xfa, 0x55, 0x96, 0x9a, 0xb2, 0x7a, 0x96, 0xe4, 0x7f,
+	0xbd, 0x34, 0xff, 0x47, 0x71, 0x69, 0xd8, 0xed, 0x43, 0x58, 0x9e, 0xf8, 0x5b, 0x56, 0x7c, 0x01,
+	0x53, 0x11, 0x5a, 0x1e, 0xe3, 0x60, 0xf5, 0x37, 0x2d, 0xfd, 0x17, 0xbd, 0xc3, 0xd7, 0xb4, 0x48,
+	0x03, 0xaf, 0x96, 0xa5, 0x1d, 0x62, 0xef, 0xa7, 0xe8, 0x7a, 0x1b, 0x64, 0xee, 0x10, 0xb4, 0xaf,
+	0x0a, 0x14, 0x5e, 0xce, 0x44, 0x2f, 0x9e, 0xa3, 0xea, 0x2b, 0xe3, 0x5e, 0x65, 0xc1, 0xdd, 0xd3,
+	0xba, 0xaf, 0xe2, 0xbb, 0x65, 0xba, 0x62, 0x10, 0x7a, 0xc9, 0x19, 0xe8, 0xe1, 0xc3, 0xdf, 0xcf,
+	0x57, 0x8d, 0x3f, 0xce, 0x57, 0x8d, 0xbf, 0xce, 0x57, 0x8d, 0x4f, 0xee, 0x3f, 0xdb, 0xdd, 0xd0,
+	0x0b, 0x28, 0x84, 0xd9, 0x75, 0xf7, 0x60, 0x46, 0x5f, 0x05, 0xb7, 0xfe, 0x19, 0x00, 0x70, 0x4d,
+	0x40, 0x66, 0x0f, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -736,6 +1009,10 @@ type ProjectServiceClient interface {
 	CreateToken(ctx context.Context, in *ProjectTokenCreateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(ctx context.Context, in *ProjectTokenDeleteRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// Rotate a project token, keeping the previous token valid during a grace period
+	RotateToken(ctx context.Context, in *ProjectTokenRotateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error)
+	// List the tokens of a project
+	ListTokens(ctx context.Context, in *ProjectTokenListRequest, opts ...grpc.CallOption) (*ProjectTokenListResponse, error)
 	// Create a new project
 	Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -782,6 +1059,24 @@ func (c *projectServiceClient) DeleteToken(ctx context.Context, in *ProjectToken
 	return out, nil
 }
 
+func (c *projectServiceClient) RotateToken(ctx context.Context, in *ProjectTokenRotateRequest, opts ...grpc.CallOption) (*ProjectTokenResponse, error) {
+	out := new(ProjectTokenResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/RotateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListTokens(ctx context.Context, in *ProjectTokenListRequest, opts ...grpc.CallOption) (*ProjectTokenListResponse, error) {
+	out := new(ProjectTokenListResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ListTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) Create(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	out := new(v1alpha1.AppProject)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/Create", in, out, opts...)
@@ -869,6 +1164,10 @@ type ProjectServiceServer interface {
 	CreateToken(context.Context, *ProjectTokenCreateRequest) (*ProjectTokenResponse, error)
 	// Delete a new project token
 	DeleteToken(context.Context, *ProjectTokenDeleteRequest) (*EmptyResponse, error)
+	// Rotate a project token, keeping the previous token valid during a grace period
+	RotateToken(context.Context, *ProjectTokenRotateRequest) (*ProjectTokenResponse, error)
+	// List the tokens of a project
+	ListTokens(context.Context, *ProjectTokenListRequest) (*ProjectTokenListResponse, error)
 	// Create a new project
 	Create(context.Context, *ProjectCreateRequest) (*v1alpha1.AppProject, error)
 	// List returns list of projects
@@ -899,6 +1198,12 @@ func (*UnimplementedProjectServiceServer) CreateToken(ctx context.Context, req *
 func (*UnimplementedProjectServiceServer) DeleteToken(ctx context.Context, req *ProjectTokenDeleteRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedProjectServiceServer) RotateToken(ctx context.Context, req *ProjectTokenRotateRequest) (*ProjectTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateToken not implemented")
+}
+func (*UnimplementedProjectServiceServer) ListTokens(ctx context.Context, req *ProjectTokenListRequest) (*ProjectTokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
 func (*UnimplementedProjectServiceServer) Create(ctx context.Context, req *ProjectCreateRequest) (*v1alpha1.AppProject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
@@ -967,6 +1272,42 @@ func _ProjectService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_RotateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectTokenRotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RotateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/RotateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RotateToken(ctx, req.(*ProjectTokenRotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectTokenListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListTokens(ctx, req.(*ProjectTokenListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectCreateRequest)
 	if err := dec(in); err != nil {
@@ -1141,6 +1482,14 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _ProjectService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "RotateToken",
+			Handler:    _ProjectService_RotateToken_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _ProjectService_ListTokens_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ProjectService_Create_Handler,
@@ -1344,7 +1693,7 @@ func (m *ProjectTokenCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectTokenResponse) Marshal() (dAtA []byte, err error) {
+func (m *ProjectTokenRotateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1354,12 +1703,12 @@ func (m *ProjectTokenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectTokenRotateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectTokenRotateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1368,10 +1717,201 @@ func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintProject(dAtA, i, uint64(len(m.Token)))
+	if m.GracePeriod != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresIn != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpiresIn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectTokenListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectTokenListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IssuedAt != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.IssuedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectTokenListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectTokenListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Token)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1763,6 +2303,100 @@ func (m *ProjectTokenCreateRequest) Size() (n int) {
 	return n
 }
 
+func (m *ProjectTokenRotateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.ExpiresIn != 0 {
+		n += 1 + sovProject(uint64(m.ExpiresIn))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovProject(uint64(m.GracePeriod))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.IssuedAt != 0 {
+		n += 1 + sovProject(uint64(m.IssuedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovProject(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProjectTokenListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ProjectTokenResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2386,6 +3020,544 @@ func (m *ProjectTokenCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectTokenRotateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTokenRotateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTokenRotateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresIn", wireType)
+			}
+			m.ExpiresIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTokenListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTokenListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTokenListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			}
+			m.IssuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTokenListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTokenListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTokenListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ProjectToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
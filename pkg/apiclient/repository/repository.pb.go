@@ -309,6 +309,176 @@ func (m *RepoQuery) GetForceRefresh() bool {
 	return false
 }
 
+// RepoRefsQuery is a query for the branches and tags of a repository
+type RepoRefsQuery struct {
+	// Repo URL for query
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Only return the branches and tags starting with this prefix
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Only return the branches and tags matching this regular expression
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	// The number of matching branches and tags to skip
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of branches and tags to return, no limit if zero
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoRefsQuery) Reset()         { *m = RepoRefsQuery{} }
+func (m *RepoRefsQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoRefsQuery) ProtoMessage()    {}
+func (*RepoRefsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{5}
+}
+func (m *RepoRefsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoRefsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoRefsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoRefsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoRefsQuery.Merge(m, src)
+}
+func (m *RepoRefsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoRefsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoRefsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoRefsQuery proto.InternalMessageInfo
+
+func (m *RepoRefsQuery) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *RepoRefsQuery) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *RepoRefsQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// RepoHelmChartsQuery is a query for the charts of a Helm repository
+type RepoHelmChartsQuery struct {
+	// Repo URL for query
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Whether to force a cache refresh on repo's connection state
+	ForceRefresh bool `protobuf:"varint,2,opt,name=forceRefresh,proto3" json:"forceRefresh,omitempty"`
+	// Only return the charts whose name contains this string
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of matching charts to skip
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The maximum number of charts to return, no limit if zero
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoHelmChartsQuery) Reset()         { *m = RepoHelmChartsQuery{} }
+func (m *RepoHelmChartsQuery) String() string { return proto.CompactTextString(m) }
+func (*RepoHelmChartsQuery) ProtoMessage()    {}
+func (*RepoHelmChartsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d38260443475705, []int{6}
+}
+func (m *RepoHelmChartsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoHelmChartsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoHelmChartsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoHelmChartsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoHelmChartsQuery.Merge(m, src)
+}
+func (m *RepoHelmChartsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoHelmChartsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoHelmChartsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoHelmChartsQuery proto.InternalMessageInfo
+
+func (m *RepoHelmChartsQuery) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoHelmChartsQuery) GetForceRefresh() bool {
+	if m != nil {
+		return m.ForceRefresh
+	}
+	return false
+}
+
+func (m *RepoHelmChartsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepoHelmChartsQuery) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *RepoHelmChartsQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // RepoAccessQuery is a query for checking access to a repo
 type RepoAccessQuery struct {
 	// The URL to the repo
@@ -342,7 +512,9 @@ type RepoAccessQuery struct {
 	// HTTP/HTTPS proxy to access the repository
 	Proxy string `protobuf:"bytes,16,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	// Reference between project and repository that allow you automatically to be added as item inside SourceRepos project entity
-	Project              string   `protobuf:"bytes,17,opt,name=project,proto3" json:"project,omitempty"`
+	Project string `protobuf:"bytes,17,opt,name=project,proto3" json:"project,omitempty"`
+	// Cloud provider issuing OCI registry tokens for the identity of the repo server
+	AuthProvider         string   `protobuf:"bytes,18,opt,name=authProvider,proto3" json:"authProvider,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -352,7 +524,7 @@ func (m *RepoAccessQuery) Reset()         { *m = RepoAccessQuery{} }
 func (m *RepoAccessQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoAccessQuery) ProtoMessage()    {}
 func (*RepoAccessQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{5}
+	return fileDescriptor_8d38260443475705, []int{7}
 }
 func (m *RepoAccessQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +665,13 @@ func (m *RepoAccessQuery) GetProject() string {
 	return ""
 }
 
+func (m *RepoAccessQuery) GetAuthProvider() string {
+	if m != nil {
+		return m.AuthProvider
+	}
+	return ""
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -503,7 +682,7 @@ func (m *RepoResponse) Reset()         { *m = RepoResponse{} }
 func (m *RepoResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()    {}
 func (*RepoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{6}
+	return fileDescriptor_8d38260443475705, []int{8}
 }
 func (m *RepoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +728,7 @@ func (m *RepoCreateRequest) Reset()         { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()    {}
 func (*RepoCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{7}
+	return fileDescriptor_8d38260443475705, []int{9}
 }
 func (m *RepoCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +789,7 @@ func (m *RepoUpdateRequest) Reset()         { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()    {}
 func (*RepoUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8d38260443475705, []int{8}
+	return fileDescriptor_8d38260443475705, []int{10}
 }
 func (m *RepoUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,6 +831,8 @@ func init() {
 	proto.RegisterType((*RepoAppDetailsQuery)(nil), "repository.RepoAppDetailsQuery")
 	proto.RegisterType((*RepoAppsResponse)(nil), "repository.RepoAppsResponse")
 	proto.RegisterType((*RepoQuery)(nil), "repository.RepoQuery")
+	proto.RegisterType((*RepoRefsQuery)(nil), "repository.RepoRefsQuery")
+	proto.RegisterType((*RepoHelmChartsQuery)(nil), "repository.RepoHelmChartsQuery")
 	proto.RegisterType((*RepoAccessQuery)(nil), "repository.RepoAccessQuery")
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
@@ -663,77 +844,82 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xd7, 0xe5, 0x8f, 0x93, 0x6c, 0xfe, 0xd4, 0xd9, 0x84, 0x72, 0xb8, 0x69, 0x1a, 0x6d, 0x4b,
-	0x15, 0xa2, 0x72, 0xd7, 0x18, 0x21, 0xaa, 0x22, 0x40, 0x69, 0x12, 0xb5, 0x11, 0x11, 0x81, 0xab,
-	0xc2, 0x03, 0x02, 0xa1, 0xcd, 0x79, 0x62, 0x1f, 0x39, 0xdf, 0x6e, 0x77, 0xd7, 0x06, 0xab, 0xea,
-	0x0b, 0x4f, 0x48, 0xf0, 0x82, 0x10, 0x52, 0xdf, 0x78, 0x41, 0xe2, 0x81, 0xcf, 0xc0, 0x3b, 0x8f,
-	0x48, 0x7c, 0x01, 0x14, 0xf1, 0x39, 0x10, 0xda, 0xdd, 0xf3, 0xdd, 0x39, 0xb1, 0x9d, 0x54, 0x84,
-	0xbc, 0xed, 0xfc, 0x66, 0x76, 0xe6, 0x37, 0xe3, 0x99, 0x59, 0x1f, 0x22, 0x12, 0x44, 0x1b, 0x84,
-	0x2f, 0x80, 0x33, 0x19, 0x29, 0x26, 0x3a, 0x85, 0xa3, 0xc7, 0x05, 0x53, 0x0c, 0xa3, 0x1c, 0xa9,
-	0x2c, 0xd6, 0x59, 0x9d, 0x19, 0xd8, 0xd7, 0x27, 0x6b, 0x51, 0x59, 0xaa, 0x33, 0x56, 0x8f, 0xc1,
-	0xa7, 0x3c, 0xf2, 0x69, 0x92, 0x30, 0x45, 0x55, 0xc4, 0x12, 0x99, 0x6a, 0xc9, 0xd1, 0x3d, 0xe9,
-	0x45, 0xcc, 0x68, 0x43, 0x26, 0xc0, 0x6f, 0xaf, 0xfb, 0x75, 0x48, 0x40, 0x50, 0x05, 0xb5, 0xd4,
-	0x66, 0xb7, 0x1e, 0xa9, 0x46, 0xeb, 0xc0, 0x0b, 0x59, 0xd3, 0xa7, 0xc2, 0x84, 0xf8, 0xc2, 0x1c,
-	0x5e, 0x0f, 0x6b, 0x7e, 0xbb, 0xea, 0xf3, 0xa3, 0xba, 0xbe, 0x2f, 0x7d, 0xca, 0x79, 0x1c, 0x85,
-	0xc6, 0xbf, 0xdf, 0x5e, 0xa7, 0x31, 0x6f, 0xd0, 0xd3, 0xde, 0xb6, 0xcf, 0xf0, 0x66, 0x12, 0x3a,
-	0x33, 0x71, 0xf2, 0x1e, 0x9a, 0x0d, 0x80, 0xb3, 0x0d, 0xce, 0xe5, 0x47, 0x2d, 0x10, 0x1d, 0x8c,
-	0xd1, 0x98, 0x36, 0x72, 0x9d, 0x15, 0x67, 0x75, 0x2a, 0x30, 0x67, 0x5c, 0x41, 0x93, 0x02, 0xda,
-	0x91, 0x8c, 0x58, 0xe2, 0x8e, 0x18, 0x3c, 0x93, 0xc9, 0x3a, 0x9a, 0xd8, 0xe0, 0x7c, 0x27, 0x39,
-	0x64, 0xfa, 0xaa, 0xea, 0x70, 0xe8, 0x5e, 0xd5, 0x67, 0x8d, 0x71, 0xaa, 0x1a, 0xe9, 0x35, 0x73,
-	0x26, 0xcf, 0x1d, 0xb4, 0x90, 0x06, 0xdd, 0x02, 0x45, 0xa3, 0x38, 0x0d, 0x5d, 0x47, 0x25, 0xc9,
-	0x5a, 0x22, 0xb4, 0x1e, 0xa6, 0xab, 0x7b, 0x5e, 0x9e, 0xa3, 0xd7, 0xcd, 0xd1, 0x1c, 0x3e, 0x0f,
-	0x6b, 0x5e, 0xbb, 0xea, 0xf1, 0xa3, 0xba, 0xa7, 0x2b, 0xe6, 0x15, 0x2a, 0xe6, 0x75, 0x2b, 0xe6,
-	0x6d, 0xe4, 0xe0, 0x63, 0xe3, 0x36, 0x48, 0xdd, 0x63, 0x17, 0x4d, 0x50, 0xce, 0x3f, 0xa0, 0x4d,
-	0x48, 0x79, 0x75, 0x45, 0xf2, 0x0e, 0x2a, 0x77, 0xcb, 0x11, 0x80, 0xe4, 0x2c, 0x91, 0x80, 0x5f,
-	0x43, 0xe3, 0x91, 0x82, 0xa6, 0x74, 0x9d, 0x95, 0xd1, 0xd5, 0xe9, 0xea, 0x82, 0x57, 0x28, 0x62,
-	0x9a, 0x7a, 0x60, 0x2d, 0xc8, 0x26, 0x9a, 0xd2, 0xd7, 0x07, 0x57, 0x92, 0xa0, 0x99, 0x43, 0xa6,
-	0xa9, 0xc0, 0xa1, 0x00, 0x69, 0xcb, 0x32, 0x19, 0xf4, 0x60, 0xe4, 0xb7, 0x31, 0x74, 0xc5, 0x90,
-	0x08, 0x43, 0x90, 0xc3, 0x7f, 0x95, 0x96, 0x04, 0x91, 0xe4, 0x69, 0x64, 0xb2, 0xd6, 0x71, 0x2a,
-	0xe5, 0x97, 0x4c, 0xd4, 0xdc, 0x51, 0xab, 0xeb, 0xca, 0xf8, 0x16, 0x9a, 0x95, 0xb2, 0xf1, 0xa1,
-	0x88, 0xda, 0x54, 0xc1, 0xfb, 0xd0, 0x71, 0xc7, 0x8c, 0x41, 0x2f, 0xa8, 0x3d, 0x44, 0x89, 0x84,
-	0xb0, 0x25, 0xc0, 0x1d, 0x37, 0x2c, 0x33, 0x19, 0xdf, 0x41, 0xf3, 0x2a, 0x96, 0x9b, 0x71, 0x04,
-	0x89, 0xda, 0x04, 0xa1, 0xb6, 0xa8, 0xa2, 0x6e, 0xc9, 0x78, 0x39, 0xad, 0xc0, 0x6b, 0xa8, 0xdc,
-	0x03, 0xea, 0x90, 0x13, 0xc6, 0xf8, 0x14, 0x9e, 0xb5, 0xd0, 0x54, 0x6f, 0x0b, 0x99, 0x1c, 0x91,
-	0xc5, 0x4c, 0x7e, 0x4b, 0x68, 0x0a, 0x12, 0x7a, 0x10, 0xc3, 0x5e, 0x18, 0xb9, 0xd3, 0x86, 0x5e,
-	0x0e, 0xe0, 0xbb, 0x68, 0xc1, 0x76, 0xce, 0x06, 0xe7, 0x85, 0x3c, 0x67, 0x8c, 0x83, 0x7e, 0x2a,
-	0xbc, 0x82, 0xa6, 0x33, 0x78, 0x67, 0xcb, 0x9d, 0x5d, 0x71, 0x56, 0x47, 0x83, 0x22, 0x84, 0xef,
-	0xa1, 0x97, 0x73, 0x31, 0x91, 0x8a, 0xc6, 0xb1, 0x69, 0xad, 0x9d, 0x2d, 0x77, 0xce, 0x58, 0x0f,
-	0x52, 0xe3, 0x77, 0x51, 0x25, 0x53, 0x6d, 0x27, 0x0a, 0x04, 0x17, 0x91, 0x84, 0x07, 0x54, 0xc2,
-	0xbe, 0x88, 0xdd, 0x2b, 0x86, 0xd4, 0x10, 0x0b, 0xbc, 0x88, 0xc6, 0xb9, 0x60, 0x5f, 0x75, 0xdc,
-	0xb2, 0x31, 0xb5, 0x82, 0xee, 0x61, 0x3d, 0x0e, 0x10, 0x2a, 0x77, 0xde, 0xf6, 0x70, 0x2a, 0x92,
-	0x39, 0x34, 0xa3, 0xdb, 0xa7, 0xdb, 0xbf, 0xe4, 0x17, 0x07, 0xcd, 0x6b, 0x60, 0x53, 0x00, 0x55,
-	0x10, 0xc0, 0x93, 0x16, 0x48, 0x85, 0x3f, 0x2d, 0x74, 0xd4, 0x74, 0xf5, 0xd1, 0x7f, 0x1b, 0xb5,
-	0x20, 0x9b, 0x88, 0xb4, 0x37, 0xaf, 0xa2, 0x52, 0x8b, 0x4b, 0x10, 0x2a, 0xed, 0xf0, 0x54, 0xd2,
-	0xbf, 0x5b, 0x28, 0xa0, 0x26, 0xf7, 0x92, 0xb8, 0x63, 0x1a, 0x73, 0x32, 0xc8, 0x01, 0xf2, 0xc4,
-	0x12, 0xdd, 0xe7, 0xb5, 0xcb, 0x22, 0x5a, 0xfd, 0x67, 0xce, 0xc6, 0xb4, 0xe0, 0x63, 0x10, 0xed,
-	0x28, 0x04, 0xfc, 0x9d, 0x83, 0xc6, 0x76, 0x23, 0xa9, 0xf0, 0x4b, 0xc5, 0x61, 0xcf, 0x46, 0xbb,
-	0xb2, 0x7b, 0x51, 0x2c, 0x74, 0x10, 0x72, 0xe3, 0xeb, 0x3f, 0xff, 0xfe, 0x61, 0xe4, 0x2a, 0x5e,
-	0x34, 0xcf, 0x47, 0x7b, 0x3d, 0xdf, 0xd2, 0x11, 0xc8, 0x6f, 0x46, 0x1c, 0xfc, 0xad, 0x83, 0x46,
-	0x1f, 0xc2, 0x40, 0x36, 0x17, 0x56, 0x13, 0x72, 0xd3, 0x30, 0xb9, 0x8e, 0xaf, 0xf5, 0x63, 0xe2,
-	0x3f, 0xd5, 0xd2, 0x33, 0xfc, 0xa3, 0x83, 0xca, 0x9a, 0x77, 0x50, 0xd0, 0x5d, 0x4e, 0xa1, 0x96,
-	0x86, 0x15, 0x0a, 0x7f, 0x86, 0x26, 0x2d, 0xad, 0xc3, 0x81, 0x74, 0xca, 0xbd, 0xf0, 0xa1, 0x24,
-	0xab, 0xc6, 0x25, 0xc1, 0x2b, 0x43, 0x32, 0xf6, 0x85, 0x76, 0xd9, 0xb4, 0xee, 0xf5, 0xd3, 0x80,
-	0x5f, 0x39, 0xe9, 0x3e, 0x7b, 0x3f, 0x2b, 0x4b, 0xfd, 0x54, 0xd9, 0x2c, 0x9e, 0x2b, 0x1c, 0xd5,
-	0x21, 0xbe, 0x77, 0xd0, 0xec, 0x43, 0x50, 0xf9, 0x1b, 0x89, 0x6f, 0xf4, 0xf1, 0x5c, 0x7c, 0x3f,
-	0x2b, 0x64, 0xb0, 0x41, 0x46, 0xe0, 0x6d, 0x43, 0xe0, 0x4d, 0x72, 0xb7, 0x3f, 0x01, 0xfb, 0x40,
-	0x1a, 0x3f, 0xfb, 0xc1, 0xae, 0xa1, 0x52, 0xb3, 0x1e, 0xee, 0x3b, 0x6b, 0xb8, 0x6d, 0x28, 0x3d,
-	0x82, 0xb8, 0xb9, 0xd9, 0xa0, 0x42, 0x0d, 0x2c, 0xf3, 0x72, 0x11, 0xce, 0xcd, 0x33, 0x12, 0x9e,
-	0x21, 0xb1, 0x8a, 0x6f, 0x0f, 0xab, 0x42, 0x03, 0xe2, 0x66, 0x68, 0xc3, 0x3c, 0x77, 0x50, 0xc9,
-	0x6e, 0x2f, 0x7c, 0xfd, 0x64, 0xc4, 0x9e, 0xad, 0x76, 0x81, 0xa3, 0xf0, 0xaa, 0xe1, 0xb8, 0x44,
-	0xfa, 0xf6, 0xda, 0x7d, 0xb3, 0x3c, 0xf4, 0x68, 0xfe, 0xe4, 0xa0, 0x72, 0x97, 0x42, 0xf7, 0xee,
-	0xe5, 0x91, 0x24, 0x67, 0x93, 0xc4, 0x3f, 0x3b, 0xa8, 0x64, 0x37, 0xea, 0x69, 0x5e, 0x3d, 0x9b,
-	0xf6, 0x02, 0x79, 0xad, 0xdb, 0x1f, 0xb8, 0x32, 0xa4, 0xcd, 0x0d, 0x95, 0x67, 0x79, 0x21, 0x7f,
-	0x75, 0x50, 0xb9, 0x4b, 0x67, 0x70, 0x21, 0xff, 0x2f, 0xc2, 0xde, 0x8b, 0x11, 0xc6, 0x14, 0x95,
-	0xb6, 0x20, 0x06, 0x05, 0x83, 0x46, 0xc0, 0x3d, 0x09, 0x67, 0xcd, 0x7f, 0xdb, 0xee, 0xd8, 0xb5,
-	0x61, 0x3b, 0x56, 0x17, 0xa4, 0x81, 0xca, 0x36, 0x44, 0xa1, 0x1e, 0x2f, 0x1c, 0xec, 0xe6, 0x39,
-	0x82, 0xe1, 0xa7, 0x68, 0xee, 0x63, 0x1a, 0x47, 0xba, 0xb2, 0xf6, 0x3f, 0x27, 0xbe, 0x76, 0x6a,
-	0x93, 0xe4, 0xff, 0x45, 0x87, 0x44, 0xab, 0x9a, 0x68, 0x77, 0xc8, 0xad, 0x61, 0x73, 0xdd, 0x4e,
-	0x43, 0xd9, 0x4a, 0x3e, 0xd8, 0xfe, 0xfd, 0x78, 0xd9, 0xf9, 0xe3, 0x78, 0xd9, 0xf9, 0xeb, 0x78,
-	0xd9, 0xf9, 0xe4, 0xad, 0xf3, 0x7d, 0x23, 0x85, 0xe6, 0x4f, 0x63, 0xe1, 0x6b, 0xe6, 0xa0, 0x64,
-	0x3e, 0x67, 0xde, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x38, 0x35, 0xe9, 0x0a, 0xed, 0x0d, 0x00,
-	0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
+	0x17, 0x96, 0x9b, 0x64, 0x93, 0x4c, 0xbe, 0x36, 0x93, 0xbc, 0x7d, 0xcd, 0x36, 0x4d, 0x23, 0xb7,
+	0x54, 0x21, 0x2a, 0x76, 0xb3, 0x08, 0x51, 0x15, 0x01, 0x4a, 0x93, 0xa8, 0x8d, 0x88, 0x48, 0x71,
+	0x15, 0x2e, 0x10, 0x12, 0x9a, 0x78, 0xcf, 0xee, 0x9a, 0x78, 0x3d, 0xd3, 0x99, 0xd9, 0x25, 0xab,
+	0xaa, 0x02, 0x71, 0x85, 0x04, 0x08, 0x21, 0x84, 0xd4, 0x3b, 0x6e, 0x90, 0xb8, 0xe0, 0x8f, 0x70,
+	0x89, 0xc4, 0x1f, 0x80, 0x88, 0x1f, 0x82, 0x66, 0xc6, 0x6b, 0x7b, 0xb3, 0x1f, 0x49, 0xd4, 0x90,
+	0xbb, 0x39, 0x1f, 0x73, 0xce, 0x73, 0x9e, 0x99, 0x73, 0x3c, 0x46, 0x8e, 0x00, 0xde, 0x02, 0xee,
+	0x71, 0x60, 0x54, 0x84, 0x92, 0xf2, 0x76, 0x6e, 0xe9, 0x32, 0x4e, 0x25, 0xc5, 0x28, 0xd3, 0x94,
+	0x16, 0x6b, 0xb4, 0x46, 0xb5, 0xda, 0x53, 0x2b, 0xe3, 0x51, 0x5a, 0xaa, 0x51, 0x5a, 0x8b, 0xc0,
+	0x23, 0x2c, 0xf4, 0x48, 0x1c, 0x53, 0x49, 0x64, 0x48, 0x63, 0x91, 0x58, 0x9d, 0xc3, 0x7b, 0xc2,
+	0x0d, 0xa9, 0xb6, 0x06, 0x94, 0x83, 0xd7, 0x5a, 0xf7, 0x6a, 0x10, 0x03, 0x27, 0x12, 0x2a, 0x89,
+	0xcf, 0x6e, 0x2d, 0x94, 0xf5, 0xe6, 0x81, 0x1b, 0xd0, 0x86, 0x47, 0xb8, 0x4e, 0xf1, 0x99, 0x5e,
+	0xbc, 0x1e, 0x54, 0xbc, 0x56, 0xd9, 0x63, 0x87, 0x35, 0xb5, 0x5f, 0x78, 0x84, 0xb1, 0x28, 0x0c,
+	0x74, 0x7c, 0xaf, 0xb5, 0x4e, 0x22, 0x56, 0x27, 0xbd, 0xd1, 0xb6, 0x4f, 0x89, 0xa6, 0x0b, 0x3a,
+	0xb5, 0x70, 0xe7, 0x3d, 0x34, 0xe3, 0x03, 0xa3, 0x1b, 0x8c, 0x89, 0x0f, 0x9b, 0xc0, 0xdb, 0x18,
+	0xa3, 0x51, 0xe5, 0x64, 0x5b, 0x2b, 0xd6, 0xea, 0xa4, 0xaf, 0xd7, 0xb8, 0x84, 0x26, 0x38, 0xb4,
+	0x42, 0x11, 0xd2, 0xd8, 0xbe, 0xa2, 0xf5, 0xa9, 0xec, 0xac, 0xa3, 0xf1, 0x0d, 0xc6, 0x76, 0xe2,
+	0x2a, 0x55, 0x5b, 0x65, 0x9b, 0x41, 0x67, 0xab, 0x5a, 0x2b, 0x1d, 0x23, 0xb2, 0x9e, 0x6c, 0xd3,
+	0x6b, 0xe7, 0x85, 0x85, 0x16, 0x92, 0xa4, 0x5b, 0x20, 0x49, 0x18, 0x25, 0xa9, 0x6b, 0xa8, 0x20,
+	0x68, 0x93, 0x07, 0x26, 0xc2, 0x54, 0x79, 0xcf, 0xcd, 0x6a, 0x74, 0x3b, 0x35, 0xea, 0xc5, 0xa7,
+	0x41, 0xc5, 0x6d, 0x95, 0x5d, 0x76, 0x58, 0x73, 0x15, 0x63, 0x6e, 0x8e, 0x31, 0xb7, 0xc3, 0x98,
+	0xbb, 0x91, 0x29, 0x9f, 0xe8, 0xb0, 0x7e, 0x12, 0x1e, 0xdb, 0x68, 0x9c, 0x30, 0xf6, 0x01, 0x69,
+	0x40, 0x82, 0xab, 0x23, 0x3a, 0xef, 0xa0, 0x62, 0x87, 0x0e, 0x1f, 0x04, 0xa3, 0xb1, 0x00, 0xfc,
+	0x1a, 0x1a, 0x0b, 0x25, 0x34, 0x84, 0x6d, 0xad, 0x8c, 0xac, 0x4e, 0x95, 0x17, 0xdc, 0x1c, 0x89,
+	0x49, 0xe9, 0xbe, 0xf1, 0x70, 0x36, 0xd1, 0xa4, 0xda, 0x3e, 0x98, 0x49, 0x07, 0x4d, 0x57, 0xa9,
+	0x82, 0x02, 0x55, 0x0e, 0xc2, 0xd0, 0x32, 0xe1, 0x77, 0xe9, 0x9c, 0x2f, 0xcc, 0x91, 0xf8, 0x50,
+	0x1d, 0x72, 0x24, 0x57, 0x51, 0x81, 0x71, 0xa8, 0x86, 0x47, 0x49, 0x05, 0x89, 0x84, 0x17, 0xd1,
+	0x18, 0x87, 0x1a, 0x1c, 0xd9, 0x23, 0x5a, 0x6d, 0x04, 0xe5, 0x4d, 0xab, 0x55, 0x01, 0xd2, 0x1e,
+	0x5d, 0xb1, 0x56, 0x47, 0xfc, 0x44, 0x52, 0xde, 0x51, 0xd8, 0x08, 0xa5, 0x3d, 0xa6, 0xd5, 0x46,
+	0x70, 0xbe, 0x4f, 0xce, 0xe7, 0x11, 0x44, 0x8d, 0xcd, 0x3a, 0xe1, 0x52, 0xbc, 0x54, 0x41, 0x6a,
+	0x5f, 0xac, 0xb8, 0x36, 0x90, 0xf4, 0xfa, 0x9c, 0x88, 0xfe, 0x1e, 0x45, 0x73, 0xfa, 0x5c, 0x82,
+	0x00, 0xc4, 0xf0, 0x8b, 0xda, 0x14, 0xc0, 0xe3, 0xec, 0x64, 0x53, 0x59, 0xd9, 0x18, 0x11, 0xe2,
+	0x73, 0xca, 0x2b, 0x09, 0x92, 0x54, 0xc6, 0xb7, 0xd0, 0x8c, 0x10, 0xf5, 0xc7, 0x3c, 0x6c, 0x11,
+	0x09, 0xef, 0x43, 0x5b, 0x83, 0x9a, 0xf4, 0xbb, 0x95, 0x2a, 0x42, 0x18, 0x0b, 0x08, 0x9a, 0x1c,
+	0x34, 0xbc, 0x09, 0x3f, 0x95, 0xf1, 0x1d, 0x34, 0x2f, 0x23, 0xb1, 0x19, 0x85, 0x10, 0xcb, 0x4d,
+	0xe0, 0x72, 0x8b, 0x48, 0x62, 0x17, 0x74, 0x94, 0x5e, 0x03, 0x5e, 0x43, 0xc5, 0x2e, 0xa5, 0x4a,
+	0x39, 0xae, 0x9d, 0x7b, 0xf4, 0x69, 0x57, 0x4d, 0x76, 0x77, 0x95, 0xae, 0x11, 0xe5, 0x18, 0x5d,
+	0x42, 0x93, 0x10, 0x93, 0x83, 0x08, 0xf6, 0x82, 0xd0, 0x9e, 0xd2, 0xf0, 0x32, 0x05, 0xbe, 0x8b,
+	0x16, 0x4c, 0x33, 0x6d, 0x30, 0x96, 0xab, 0x73, 0x5a, 0x07, 0xe8, 0x67, 0xc2, 0x2b, 0x68, 0x2a,
+	0x55, 0xef, 0x6c, 0xd9, 0x33, 0xfa, 0x3c, 0xf2, 0x2a, 0x7c, 0x0f, 0xfd, 0x3f, 0x13, 0x63, 0x21,
+	0x49, 0x14, 0xe9, 0x6e, 0xdb, 0xd9, 0xb2, 0x67, 0xb5, 0xf7, 0x20, 0x33, 0x7e, 0x17, 0x95, 0x52,
+	0xd3, 0x76, 0x2c, 0x81, 0x33, 0x1e, 0x0a, 0x78, 0x40, 0x04, 0xec, 0xf3, 0xc8, 0x9e, 0xd3, 0xa0,
+	0x86, 0x78, 0xa8, 0x5b, 0xc2, 0x38, 0x3d, 0x6a, 0xdb, 0x45, 0x73, 0xcb, 0xb5, 0xa0, 0xda, 0x5a,
+	0x4d, 0x08, 0x08, 0xa4, 0x3d, 0x6f, 0xda, 0x3a, 0x11, 0xd5, 0x2d, 0x25, 0x4d, 0x59, 0x7f, 0xcc,
+	0x69, 0x2b, 0xac, 0x00, 0xb7, 0xb1, 0x36, 0x77, 0xe9, 0x9c, 0x59, 0x34, 0x6d, 0xda, 0xce, 0xb4,
+	0xbd, 0xf3, 0xab, 0x85, 0xe6, 0x95, 0x62, 0x93, 0x03, 0x91, 0xe0, 0xc3, 0xd3, 0x26, 0x08, 0x89,
+	0x3f, 0xc9, 0xdd, 0xba, 0xa9, 0xf2, 0xa3, 0x97, 0x9b, 0x50, 0x7e, 0x3a, 0x48, 0xb2, 0xae, 0x6e,
+	0x32, 0x01, 0x5c, 0x26, 0x7d, 0x94, 0x48, 0xea, 0x6c, 0x03, 0x0e, 0x15, 0xb1, 0x17, 0x47, 0x6d,
+	0x7d, 0x79, 0x27, 0xfc, 0x4c, 0xe1, 0x3c, 0x35, 0x40, 0xf7, 0x59, 0xe5, 0xb2, 0x80, 0x96, 0xbf,
+	0x9b, 0x43, 0xf3, 0x99, 0xf2, 0x09, 0xf0, 0x56, 0x18, 0x00, 0xfe, 0xd6, 0x42, 0xa3, 0xbb, 0xa1,
+	0x90, 0xf8, 0x7f, 0xf9, 0x19, 0x99, 0x4e, 0xc4, 0xd2, 0xee, 0x45, 0xa1, 0x50, 0x49, 0x9c, 0x1b,
+	0x5f, 0xfd, 0xf9, 0xcf, 0x8f, 0x57, 0xae, 0xe2, 0x45, 0xfd, 0xd5, 0x6d, 0xad, 0x67, 0x1f, 0xb7,
+	0x10, 0xc4, 0xd7, 0x57, 0x2c, 0xfc, 0x8d, 0x85, 0x46, 0x1e, 0xc2, 0x40, 0x34, 0x17, 0xc6, 0x89,
+	0x73, 0x53, 0x23, 0xb9, 0x8e, 0xaf, 0xf5, 0x43, 0xe2, 0x3d, 0x53, 0xd2, 0x73, 0xfc, 0x93, 0x85,
+	0x8a, 0x0a, 0xb7, 0x9f, 0xb3, 0x5d, 0x0e, 0x51, 0x4b, 0xc3, 0x88, 0xc2, 0x04, 0x4d, 0x18, 0x58,
+	0x55, 0x81, 0x5f, 0x39, 0x09, 0x27, 0xfd, 0x08, 0x95, 0x8a, 0xdd, 0xa6, 0xaa, 0x70, 0x56, 0x75,
+	0x58, 0x07, 0xaf, 0x0c, 0xa9, 0xda, 0xe3, 0x2a, 0x6c, 0xc3, 0xa4, 0x50, 0x5f, 0xd5, 0xde, 0x14,
+	0xe9, 0xd3, 0xa3, 0xb4, 0xd4, 0xcf, 0x94, 0xf6, 0xe3, 0x99, 0xd2, 0x11, 0x95, 0xe2, 0x07, 0x0b,
+	0xcd, 0x3c, 0x04, 0x99, 0x3d, 0x2f, 0xf0, 0x8d, 0x3e, 0x91, 0xf3, 0x4f, 0x8f, 0x92, 0x33, 0xd8,
+	0x21, 0x05, 0xf0, 0xb6, 0x06, 0xf0, 0xa6, 0x73, 0xb7, 0x3f, 0x00, 0xf3, 0xb6, 0xd0, 0x71, 0xf6,
+	0xfd, 0x5d, 0x0d, 0xa5, 0x62, 0x22, 0xdc, 0xb7, 0xd6, 0xf0, 0x97, 0x06, 0x53, 0xf6, 0x49, 0xed,
+	0xc5, 0x74, 0xe2, 0x73, 0x5b, 0x5a, 0xce, 0x3b, 0x64, 0xc6, 0x14, 0x8f, 0xab, 0xf1, 0xac, 0xe2,
+	0xdb, 0xc3, 0x08, 0xa9, 0x43, 0xd4, 0x08, 0x4c, 0xc2, 0x17, 0x16, 0x2a, 0x98, 0x61, 0x86, 0xaf,
+	0x9f, 0xcc, 0xdd, 0x35, 0xe4, 0x2e, 0xb0, 0x33, 0x5e, 0xd5, 0x18, 0x97, 0x9c, 0xbe, 0x57, 0xef,
+	0xbe, 0x9e, 0x25, 0xaa, 0x53, 0x7f, 0xb6, 0x50, 0xb1, 0x03, 0xa1, 0xb3, 0xf7, 0xf2, 0x40, 0x3a,
+	0xa7, 0x83, 0xc4, 0xbf, 0x58, 0xa8, 0x60, 0x06, 0x6c, 0x2f, 0xae, 0xae, 0xc1, 0x7b, 0x81, 0xb8,
+	0xd6, 0xcd, 0x01, 0x97, 0x86, 0xdc, 0x78, 0x0d, 0xe5, 0x79, 0x46, 0xe4, 0x6f, 0x16, 0x2a, 0x76,
+	0xe0, 0x0c, 0x26, 0xf2, 0xbf, 0x02, 0xec, 0x9e, 0x0f, 0x30, 0x26, 0xa8, 0xb0, 0x05, 0x11, 0x48,
+	0x18, 0x34, 0x07, 0xed, 0xde, 0x79, 0x94, 0x5c, 0xfe, 0xdb, 0x66, 0xe4, 0xae, 0x0d, 0x1b, 0xb9,
+	0x8a, 0x90, 0x3a, 0x2a, 0x9a, 0x14, 0x39, 0x3e, 0xce, 0x9d, 0xec, 0xe6, 0x19, 0x92, 0xe1, 0x67,
+	0x68, 0xf6, 0x23, 0x12, 0x85, 0x8a, 0x59, 0xf3, 0x4c, 0xc5, 0xd7, 0x7a, 0x86, 0x4a, 0xf6, 0x7c,
+	0x1d, 0x92, 0xad, 0xac, 0xb3, 0xdd, 0x71, 0x6e, 0x0d, 0xeb, 0xeb, 0x56, 0x92, 0xca, 0x30, 0xf9,
+	0x60, 0xfb, 0xf7, 0xe3, 0x65, 0xeb, 0x8f, 0xe3, 0x65, 0xeb, 0xaf, 0xe3, 0x65, 0xeb, 0xe3, 0xb7,
+	0xce, 0xf6, 0xa7, 0x19, 0xe8, 0x77, 0x66, 0x16, 0xbe, 0x7d, 0x50, 0xd0, 0x3f, 0x85, 0x6f, 0xfc,
+	0x3b, 0x00, 0xbd, 0x58, 0x4e, 0x75, 0x33, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -754,13 +940,13 @@ type RepositoryServiceClient interface {
 	Get(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*v1alpha1.RepositoryList, error)
-	ListRefs(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.Refs, error)
+	ListRefs(ctx context.Context, in *RepoRefsQuery, opts ...grpc.CallOption) (*apiclient.Refs, error)
 	// ListApps returns list of apps in the repo
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(ctx context.Context, in *RepoAppDetailsQuery, opts ...grpc.CallOption) (*apiclient.RepoAppDetailsResponse, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
-	GetHelmCharts(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error)
+	GetHelmCharts(ctx context.Context, in *RepoHelmChartsQuery, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error)
 	// Create creates a repo or a repo credential set
 	Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Repository, error)
 	// CreateRepository creates a new repository configuration
@@ -813,7 +999,7 @@ func (c *repositoryServiceClient) ListRepositories(ctx context.Context, in *Repo
 	return out, nil
 }
 
-func (c *repositoryServiceClient) ListRefs(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.Refs, error) {
+func (c *repositoryServiceClient) ListRefs(ctx context.Context, in *RepoRefsQuery, opts ...grpc.CallOption) (*apiclient.Refs, error) {
 	out := new(apiclient.Refs)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ListRefs", in, out, opts...)
 	if err != nil {
@@ -840,7 +1026,7 @@ func (c *repositoryServiceClient) GetAppDetails(ctx context.Context, in *RepoApp
 	return out, nil
 }
 
-func (c *repositoryServiceClient) GetHelmCharts(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error) {
+func (c *repositoryServiceClient) GetHelmCharts(ctx context.Context, in *RepoHelmChartsQuery, opts ...grpc.CallOption) (*apiclient.HelmChartsResponse, error) {
 	out := new(apiclient.HelmChartsResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/GetHelmCharts", in, out, opts...)
 	if err != nil {
@@ -923,13 +1109,13 @@ type RepositoryServiceServer interface {
 	Get(context.Context, *RepoQuery) (*v1alpha1.Repository, error)
 	// ListRepositories gets a list of all configured repositories
 	ListRepositories(context.Context, *RepoQuery) (*v1alpha1.RepositoryList, error)
-	ListRefs(context.Context, *RepoQuery) (*apiclient.Refs, error)
+	ListRefs(context.Context, *RepoRefsQuery) (*apiclient.Refs, error)
 	// ListApps returns list of apps in the repo
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// GetAppDetails returns application details by given path
 	GetAppDetails(context.Context, *RepoAppDetailsQuery) (*apiclient.RepoAppDetailsResponse, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
-	GetHelmCharts(context.Context, *RepoQuery) (*apiclient.HelmChartsResponse, error)
+	GetHelmCharts(context.Context, *RepoHelmChartsQuery) (*apiclient.HelmChartsResponse, error)
 	// Create creates a repo or a repo credential set
 	Create(context.Context, *RepoCreateRequest) (*v1alpha1.Repository, error)
 	// CreateRepository creates a new repository configuration
@@ -959,7 +1145,7 @@ func (*UnimplementedRepositoryServiceServer) Get(ctx context.Context, req *RepoQ
 func (*UnimplementedRepositoryServiceServer) ListRepositories(ctx context.Context, req *RepoQuery) (*v1alpha1.RepositoryList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepositories not implemented")
 }
-func (*UnimplementedRepositoryServiceServer) ListRefs(ctx context.Context, req *RepoQuery) (*apiclient.Refs, error) {
+func (*UnimplementedRepositoryServiceServer) ListRefs(ctx context.Context, req *RepoRefsQuery) (*apiclient.Refs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRefs not implemented")
 }
 func (*UnimplementedRepositoryServiceServer) ListApps(ctx context.Context, req *RepoAppsQuery) (*RepoAppsResponse, error) {
@@ -968,7 +1154,7 @@ func (*UnimplementedRepositoryServiceServer) ListApps(ctx context.Context, req *
 func (*UnimplementedRepositoryServiceServer) GetAppDetails(ctx context.Context, req *RepoAppDetailsQuery) (*apiclient.RepoAppDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppDetails not implemented")
 }
-func (*UnimplementedRepositoryServiceServer) GetHelmCharts(ctx context.Context, req *RepoQuery) (*apiclient.HelmChartsResponse, error) {
+func (*UnimplementedRepositoryServiceServer) GetHelmCharts(ctx context.Context, req *RepoHelmChartsQuery) (*apiclient.HelmChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHelmCharts not implemented")
 }
 func (*UnimplementedRepositoryServiceServer) Create(ctx context.Context, req *RepoCreateRequest) (*v1alpha1.Repository, error) {
@@ -1052,7 +1238,7 @@ func _RepositoryService_ListRepositories_Handler(srv interface{}, ctx context.Co
 }
 
 func _RepositoryService_ListRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoQuery)
+	in := new(RepoRefsQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1064,7 +1250,7 @@ func _RepositoryService_ListRefs_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: "/repository.RepositoryService/ListRefs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServiceServer).ListRefs(ctx, req.(*RepoQuery))
+		return srv.(RepositoryServiceServer).ListRefs(ctx, req.(*RepoRefsQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1106,7 +1292,7 @@ func _RepositoryService_GetAppDetails_Handler(srv interface{}, ctx context.Conte
 }
 
 func _RepositoryService_GetHelmCharts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoQuery)
+	in := new(RepoHelmChartsQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1118,7 +1304,7 @@ func _RepositoryService_GetHelmCharts_Handler(srv interface{}, ctx context.Conte
 		FullMethod: "/repository.RepositoryService/GetHelmCharts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServiceServer).GetHelmCharts(ctx, req.(*RepoQuery))
+		return srv.(RepositoryServiceServer).GetHelmCharts(ctx, req.(*RepoHelmChartsQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1527,6 +1713,125 @@ func (m *RepoQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RepoRefsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoRefsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoRefsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Regex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoHelmChartsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoHelmChartsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoHelmChartsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Limit != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ForceRefresh {
+		i--
+		if m.ForceRefresh {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RepoAccessQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1551,6 +1856,15 @@ func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.AuthProvider) > 0 {
+		i -= len(m.AuthProvider)
+		copy(dAtA[i:], m.AuthProvider)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.AuthProvider)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.Project) > 0 {
 		i -= len(m.Project)
 		copy(dAtA[i:], m.Project)
@@ -1905,7 +2219,7 @@ func (m *RepoQuery) Size() (n int) {
 	return n
 }
 
-func (m *RepoAccessQuery) Size() (n int) {
+func (m *RepoRefsQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1915,46 +2229,105 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Password)
+	l = len(m.Prefix)
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	l = len(m.SshPrivateKey)
+	l = len(m.Regex)
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.Insecure {
-		n += 2
+	if m.Offset != 0 {
+		n += 1 + sovRepository(uint64(m.Offset))
 	}
-	l = len(m.TlsClientCertData)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovRepository(uint64(m.Limit))
 	}
-	l = len(m.TlsClientCertKey)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	return n
+}
+
+func (m *RepoHelmChartsQuery) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Name)
+	var l int
+	_ = l
+	l = len(m.Repo)
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.EnableOci {
+	if m.ForceRefresh {
 		n += 2
 	}
-	l = len(m.GithubAppPrivateKey)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.GithubAppID != 0 {
-		n += 1 + sovRepository(uint64(m.GithubAppID))
+	if m.Offset != 0 {
+		n += 1 + sovRepository(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovRepository(uint64(m.Limit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepoAccessQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.SshPrivateKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Insecure {
+		n += 2
+	}
+	l = len(m.TlsClientCertData)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TlsClientCertKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.EnableOci {
+		n += 2
+	}
+	l = len(m.GithubAppPrivateKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.GithubAppID != 0 {
+		n += 1 + sovRepository(uint64(m.GithubAppID))
 	}
 	if m.GithubAppInstallationID != 0 {
 		n += 1 + sovRepository(uint64(m.GithubAppInstallationID))
@@ -1971,6 +2344,10 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	l = len(m.AuthProvider)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2570,6 +2947,364 @@ func (m *RepoQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoRefsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoRefsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoRefsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoHelmChartsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoHelmChartsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoHelmChartsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceRefresh", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceRefresh = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3061,6 +3796,38 @@ func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -182,7 +182,7 @@ var (
 )
 
 func request_RepositoryService_ListRefs_0(ctx context.Context, marshaler runtime.Marshaler, client RepositoryServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RepoQuery
+	var protoReq RepoRefsQuery
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -216,7 +216,7 @@ func request_RepositoryService_ListRefs_0(ctx context.Context, marshaler runtime
 }
 
 func local_request_RepositoryService_ListRefs_0(ctx context.Context, marshaler runtime.Marshaler, server RepositoryServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RepoQuery
+	var protoReq RepoRefsQuery
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -396,7 +396,7 @@ var (
 )
 
 func request_RepositoryService_GetHelmCharts_0(ctx context.Context, marshaler runtime.Marshaler, client RepositoryServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RepoQuery
+	var protoReq RepoHelmChartsQuery
 	var metadata runtime.ServerMetadata
 
 	var (
@@ -430,7 +430,7 @@ func request_RepositoryService_GetHelmCharts_0(ctx context.Context, marshaler ru
 }
 
 func local_request_RepositoryService_GetHelmCharts_0(ctx context.Context, marshaler runtime.Marshaler, server RepositoryServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq RepoQuery
+	var protoReq RepoHelmChartsQuery
 	var metadata runtime.ServerMetadata
 
 	var (
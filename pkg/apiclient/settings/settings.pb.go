@@ -93,9 +93,14 @@ type Settings struct {
 	UiBannerContent         string                             `protobuf:"bytes,15,opt,name=uiBannerContent,proto3" json:"uiBannerContent,omitempty"`
 	UiBannerURL             string                             `protobuf:"bytes,16,opt,name=uiBannerURL,proto3" json:"uiBannerURL,omitempty"`
 	PasswordPattern         string                             `protobuf:"bytes,17,opt,name=passwordPattern,proto3" json:"passwordPattern,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                           `json:"-"`
-	XXX_unrecognized        []byte                             `json:"-"`
-	XXX_sizecache           int32                              `json:"-"`
+	TrackingMethod          string                             `protobuf:"bytes,18,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	// OIDC providers available in addition to the one of oidcConfig
+	OIDCProviders []*OIDCConfig `protobuf:"bytes,19,rep,name=oidcProviders,proto3" json:"oidcProviders,omitempty"`
+	// whether the web terminal is enabled
+	ExecEnabled          bool     `protobuf:"varint,20,opt,name=execEnabled,proto3" json:"execEnabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -250,6 +255,27 @@ func (m *Settings) GetPasswordPattern() string {
 	return ""
 }
 
+func (m *Settings) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *Settings) GetOIDCProviders() []*OIDCConfig {
+	if m != nil {
+		return m.OIDCProviders
+	}
+	return nil
+}
+
+func (m *Settings) GetExecEnabled() bool {
+	if m != nil {
+		return m.ExecEnabled
+	}
+	return false
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -617,70 +643,73 @@ func init() {
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x97, 0x9b, 0x6e, 0x9b, 0xbc, 0x6c, 0x9b, 0x76, 0x80, 0x62, 0xa2, 0x55, 0x1a, 0x72, 0x58,
-	0x05, 0x09, 0x6c, 0x9a, 0x15, 0x02, 0x21, 0x24, 0x20, 0xce, 0x6a, 0x37, 0x6c, 0x96, 0x96, 0xd9,
-	0x76, 0x0f, 0x48, 0xa8, 0x9a, 0xda, 0x83, 0x3b, 0xc4, 0x9d, 0xb1, 0x66, 0xc6, 0x61, 0xb3, 0x47,
-	0x6e, 0x5c, 0xb8, 0xc0, 0x87, 0xe2, 0x88, 0xc4, 0xbd, 0x42, 0x11, 0x9f, 0x80, 0x4f, 0x80, 0x3c,
-	0xfe, 0x53, 0x37, 0x09, 0x7f, 0xa4, 0xbd, 0xbd, 0xf9, 0xbd, 0xf7, 0x7e, 0x6f, 0xfc, 0xfc, 0xf3,
-	0x7b, 0x86, 0x8e, 0xa2, 0x72, 0x46, 0xa5, 0xab, 0xa8, 0xd6, 0x8c, 0x87, 0xaa, 0x34, 0x9c, 0x58,
-	0x0a, 0x2d, 0xd0, 0xb6, 0x1f, 0x25, 0x4a, 0x53, 0xd9, 0x7e, 0x3d, 0x14, 0xa1, 0x30, 0x98, 0x9b,
-	0x5a, 0x99, 0xbb, 0x7d, 0x2f, 0x14, 0x22, 0x8c, 0xa8, 0x4b, 0x62, 0xe6, 0x12, 0xce, 0x85, 0x26,
-	0x9a, 0x09, 0x9e, 0x27, 0xb7, 0x27, 0x21, 0xd3, 0x97, 0xc9, 0x85, 0xe3, 0x8b, 0x2b, 0x97, 0x48,
-	0x93, 0xfe, 0x9d, 0x31, 0xde, 0xf3, 0x03, 0x77, 0x36, 0x70, 0xe3, 0x69, 0x98, 0x66, 0x2a, 0x97,
-	0xc4, 0x71, 0xc4, 0x7c, 0x93, 0xeb, 0xce, 0x8e, 0x48, 0x14, 0x5f, 0x92, 0x23, 0x37, 0xa4, 0x9c,
-	0x4a, 0xa2, 0x69, 0x90, 0xb3, 0x7d, 0xf6, 0x1f, 0x6c, 0xcb, 0x4f, 0x22, 0x58, 0xe0, 0xbb, 0x7e,
-	0x44, 0xd8, 0x55, 0x7e, 0x9f, 0x5e, 0x0b, 0x76, 0x9e, 0xe5, 0xde, 0xaf, 0x12, 0x2a, 0xe7, 0xbd,
-	0xbf, 0xea, 0x50, 0x2f, 0x10, 0xf4, 0x16, 0xd4, 0x12, 0x19, 0xd9, 0x56, 0xd7, 0xea, 0x37, 0x86,
-	0xdb, 0x8b, 0xeb, 0xc3, 0xda, 0x19, 0x9e, 0xe0, 0x14, 0x43, 0xef, 0x43, 0x23, 0xa0, 0x2f, 0x3c,
-	0xc1, 0xbf, 0x65, 0xa1, 0xbd, 0xd1, 0xb5, 0xfa, 0xcd, 0x01, 0x72, 0xf2, 0xce, 0x38, 0xa3, 0xc2,
-	0x83, 0x6f, 0x82, 0x90, 0x07, 0x90, 0xd6, 0xcf, 0x53, 0x6a, 0x26, 0xe5, 0xb5, 0x32, 0xe5, 0x78,
-	0x3c, 0xf2, 0x32, 0xd7, 0x70, 0x77, 0x71, 0x7d, 0x08, 0x37, 0x67, 0x5c, 0x49, 0x43, 0x5d, 0x68,
-	0x92, 0x38, 0x9e, 0x90, 0x0b, 0x1a, 0x3d, 0xa1, 0x73, 0x7b, 0x33, 0xbd, 0x19, 0xae, 0x42, 0xe8,
-	0x39, 0xec, 0x4b, 0xaa, 0x44, 0x22, 0x7d, 0x7a, 0x3c, 0xa3, 0x52, 0xb2, 0x80, 0x2a, 0xfb, 0x4e,
-	0xb7, 0xd6, 0x6f, 0x0e, 0xfa, 0x65, 0xb5, 0xe2, 0x09, 0x1d, 0xbc, 0x1c, 0xfa, 0x90, 0x6b, 0x39,
-	0xc7, 0xab, 0x14, 0xc8, 0x01, 0xa4, 0x34, 0xd1, 0x89, 0x1a, 0x92, 0x20, 0xa4, 0x0f, 0x39, 0xb9,
-	0x88, 0x68, 0x60, 0x6f, 0x75, 0xad, 0x7e, 0x1d, 0xaf, 0xf1, 0xa0, 0xc7, 0xd0, 0xca, 0x94, 0xf0,
-	0x39, 0x27, 0xd1, 0x5c, 0x33, 0x5f, 0xd9, 0xdb, 0xe6, 0x99, 0x3b, 0xe5, 0x2d, 0x1e, 0xdd, 0xf6,
-	0xe7, 0x8f, 0xbb, 0x9c, 0x86, 0x5e, 0xc2, 0xde, 0x34, 0x51, 0x5a, 0x5c, 0xb1, 0x97, 0xf4, 0x38,
-	0x36, 0x6a, 0xb2, 0xeb, 0x86, 0xea, 0x4b, 0xe7, 0x46, 0x00, 0x4e, 0x21, 0x00, 0x63, 0x9c, 0xfb,
-	0x81, 0x33, 0x1b, 0x38, 0xf1, 0x34, 0x74, 0x52, 0x39, 0x39, 0x15, 0x39, 0x39, 0x85, 0x9c, 0x9c,
-	0x27, 0x4b, 0xac, 0x78, 0xa5, 0x0e, 0x7a, 0x1b, 0x36, 0x2f, 0x69, 0x14, 0xdb, 0x0d, 0x53, 0x6f,
-	0xa7, 0xbc, 0xfa, 0x63, 0x1a, 0xc5, 0xd8, 0xb8, 0xd0, 0x3b, 0xb0, 0x1d, 0x47, 0x49, 0xc8, 0xb8,
-	0xb2, 0xc1, 0xb4, 0xb9, 0x55, 0x46, 0x9d, 0x18, 0x1c, 0x17, 0xfe, 0xb4, 0x87, 0x89, 0xa2, 0x72,
-	0x22, 0xd2, 0xd3, 0x88, 0xa9, 0xac, 0x87, 0xcd, 0xac, 0x87, 0xab, 0x1e, 0xf4, 0x93, 0x05, 0x6f,
-	0xfa, 0xa6, 0x2b, 0x4f, 0x09, 0x27, 0x21, 0xbd, 0xa2, 0x5c, 0x9f, 0xe4, 0xb5, 0xee, 0x9a, 0x5a,
-	0xa7, 0xaf, 0xd6, 0x01, 0x6f, 0x2d, 0x39, 0xfe, 0xa7, 0xa2, 0xe8, 0x5d, 0xd8, 0x2f, 0x5b, 0xf4,
-	0x9c, 0x4a, 0x65, 0xde, 0xc5, 0x4e, 0xb7, 0xd6, 0x6f, 0xe0, 0x55, 0x07, 0x6a, 0x43, 0x3d, 0x61,
-	0x9e, 0x52, 0x67, 0x78, 0x62, 0xef, 0x1a, 0xa5, 0x96, 0x67, 0xd4, 0x87, 0x56, 0xc2, 0x86, 0x84,
-	0x73, 0x2a, 0x3d, 0xc1, 0x35, 0xe5, 0xda, 0x6e, 0x99, 0x90, 0x65, 0x38, 0x95, 0x7c, 0x01, 0xa5,
-	0x44, 0x7b, 0x99, 0xe4, 0x2b, 0x50, 0xca, 0x15, 0x13, 0xa5, 0xbe, 0x17, 0x32, 0x38, 0x21, 0x5a,
-	0x53, 0xc9, 0xed, 0xfd, 0x8c, 0x6b, 0x09, 0x6e, 0xff, 0x62, 0xc1, 0xc1, 0x7a, 0xc9, 0xa3, 0x3d,
-	0xa8, 0x4d, 0xe9, 0x3c, 0xfb, 0xd6, 0x71, 0x6a, 0xa2, 0x00, 0xee, 0xcc, 0x48, 0x94, 0xd0, 0xfc,
-	0xf3, 0x7e, 0x45, 0xb1, 0x2d, 0x97, 0xc5, 0x19, 0xf9, 0xc7, 0x1b, 0x1f, 0x59, 0xbd, 0x73, 0x78,
-	0x63, 0xed, 0xb7, 0x80, 0x3a, 0x00, 0x5a, 0x12, 0x7f, 0xca, 0x78, 0x38, 0x1e, 0xe5, 0x77, 0xab,
-	0x20, 0xe8, 0x3e, 0xec, 0x12, 0x2e, 0xf8, 0x3c, 0x6d, 0xfb, 0x99, 0xa2, 0x52, 0x99, 0xbb, 0xd6,
-	0xf1, 0x12, 0xda, 0xfb, 0x04, 0x36, 0x53, 0xc5, 0x22, 0x1b, 0xb6, 0xfd, 0x4b, 0xa2, 0xcf, 0x8a,
-	0xa1, 0x86, 0x8b, 0x63, 0xfa, 0xae, 0x52, 0xf3, 0x94, 0xbe, 0xd0, 0x86, 0xa3, 0x81, 0xcb, 0x73,
-	0xef, 0x1e, 0x6c, 0x65, 0x02, 0x40, 0x08, 0x36, 0x39, 0xb9, 0xa2, 0x79, 0xb2, 0xb1, 0x7b, 0x9f,
-	0x42, 0xa3, 0x9c, 0x77, 0x68, 0x00, 0xe0, 0x0b, 0xce, 0xa9, 0xaf, 0x85, 0x54, 0xb6, 0x65, 0x34,
-	0x7a, 0x33, 0x17, 0xbd, 0xc2, 0x85, 0x2b, 0x51, 0xbd, 0x07, 0xd0, 0x28, 0x1d, 0xeb, 0x2a, 0xa4,
-	0x98, 0x9e, 0xc7, 0x34, 0xbf, 0x97, 0xb1, 0x7b, 0x3f, 0xd6, 0xa0, 0x32, 0x23, 0xd7, 0xa6, 0x1d,
-	0xc0, 0x16, 0x53, 0x2a, 0xa1, 0x32, 0x4f, 0xcc, 0x4f, 0xa8, 0x0f, 0x75, 0x3f, 0x62, 0x94, 0xeb,
-	0xf1, 0xc8, 0x8c, 0xe1, 0xc6, 0xf0, 0xee, 0xe2, 0xfa, 0xb0, 0xee, 0xe5, 0x18, 0x2e, 0xbd, 0xe8,
-	0x08, 0x9a, 0x7e, 0xc4, 0x0a, 0x47, 0x36, 0x6d, 0x87, 0xad, 0xc5, 0xf5, 0x61, 0xd3, 0x9b, 0x8c,
-	0xcb, 0xf8, 0x6a, 0x4c, 0x5a, 0x54, 0xf9, 0x22, 0xce, 0x67, 0x6e, 0x03, 0xe7, 0x27, 0x74, 0x0e,
-	0x3b, 0x2c, 0x38, 0x15, 0x53, 0xca, 0x3d, 0xb3, 0x7f, 0xec, 0x2d, 0xd3, 0x9b, 0xfb, 0x6b, 0x16,
-	0x80, 0x33, 0xae, 0x06, 0x1a, 0x75, 0x0e, 0xf7, 0x17, 0xd7, 0x87, 0x3b, 0xe3, 0x51, 0x05, 0xc7,
-	0xb7, 0xf9, 0xda, 0x73, 0x40, 0xab, 0x79, 0x6b, 0x54, 0xfd, 0xf4, 0xb6, 0xaa, 0x3f, 0xfc, 0x57,
-	0x55, 0x67, 0x0b, 0xd4, 0x29, 0xff, 0x00, 0xd2, 0x4d, 0xe4, 0x18, 0xfe, 0x8a, 0x7c, 0x07, 0xdf,
-	0x40, 0xab, 0x58, 0x28, 0xcf, 0xa8, 0x9c, 0x31, 0x9f, 0xa2, 0x2f, 0xa0, 0xf6, 0x88, 0x6a, 0x74,
-	0xb0, 0xb2, 0x71, 0xcc, 0x96, 0x6d, 0xef, 0xaf, 0xe0, 0x3d, 0xfb, 0x87, 0xdf, 0xff, 0xfc, 0x79,
-	0x03, 0xa1, 0x3d, 0xf3, 0xe7, 0x30, 0x3b, 0x2a, 0xb7, 0xf6, 0xd0, 0xfb, 0x75, 0xd1, 0xb1, 0x7e,
-	0x5b, 0x74, 0xac, 0x3f, 0x16, 0x1d, 0xeb, 0xeb, 0x0f, 0xfe, 0xdf, 0x1f, 0x44, 0xf6, 0x0e, 0x4b,
-	0x92, 0x8b, 0x2d, 0xb3, 0xef, 0x1f, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x31, 0x2c, 0x49,
-	0xde, 0x08, 0x00, 0x00,
+	// 1043 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0x97, 0xe3, 0x34, 0xb1, 0x9f, 0xeb, 0x3a, 0x99, 0xf6, 0x9b, 0xef, 0x62, 0x55, 0x8e, 0xf1,
+	0xa1, 0x32, 0x12, 0xac, 0x89, 0x2b, 0x04, 0x42, 0x48, 0x80, 0xed, 0xaa, 0x35, 0x75, 0x48, 0x98,
+	0x26, 0x3d, 0x20, 0xa1, 0x68, 0xb2, 0x3b, 0x6c, 0x06, 0x6f, 0x66, 0x56, 0x33, 0xb3, 0x26, 0xee,
+	0x91, 0x1b, 0x1c, 0xb8, 0xc0, 0x1f, 0xc5, 0x11, 0x89, 0x7b, 0x84, 0x2c, 0xfe, 0x10, 0x34, 0xb3,
+	0x3f, 0xb2, 0xb1, 0x5d, 0x40, 0x82, 0xdb, 0x7b, 0x9f, 0xf7, 0x6b, 0xe6, 0xed, 0xe7, 0xed, 0x1b,
+	0x68, 0x29, 0x2a, 0x67, 0x54, 0xf6, 0x14, 0xd5, 0x9a, 0xf1, 0x40, 0xe5, 0x82, 0x1b, 0x49, 0xa1,
+	0x05, 0xda, 0xf6, 0xc2, 0x58, 0x69, 0x2a, 0x9b, 0x0f, 0x02, 0x11, 0x08, 0x8b, 0xf5, 0x8c, 0x94,
+	0x98, 0x9b, 0x0f, 0x03, 0x21, 0x82, 0x90, 0xf6, 0x48, 0xc4, 0x7a, 0x84, 0x73, 0xa1, 0x89, 0x66,
+	0x82, 0xa7, 0xc1, 0xcd, 0x49, 0xc0, 0xf4, 0x45, 0x7c, 0xee, 0x7a, 0xe2, 0xb2, 0x47, 0xa4, 0x0d,
+	0xff, 0xc6, 0x0a, 0xef, 0x78, 0x7e, 0x6f, 0xd6, 0xef, 0x45, 0xd3, 0xc0, 0x44, 0xaa, 0x1e, 0x89,
+	0xa2, 0x90, 0x79, 0x36, 0xb6, 0x37, 0x3b, 0x20, 0x61, 0x74, 0x41, 0x0e, 0x7a, 0x01, 0xe5, 0x54,
+	0x12, 0x4d, 0xfd, 0x34, 0xdb, 0x27, 0x7f, 0x93, 0x6d, 0xf9, 0x26, 0x82, 0xf9, 0x5e, 0xcf, 0x0b,
+	0x09, 0xbb, 0x4c, 0xcf, 0xd3, 0x69, 0x40, 0xfd, 0x45, 0x6a, 0xfd, 0x22, 0xa6, 0x72, 0xde, 0xf9,
+	0x01, 0xa0, 0x92, 0x21, 0xe8, 0x0d, 0x28, 0xc7, 0x32, 0x74, 0x4a, 0xed, 0x52, 0xb7, 0x3a, 0xd8,
+	0x5e, 0x5c, 0xef, 0x97, 0x4f, 0xf1, 0x04, 0x1b, 0x0c, 0xbd, 0x0b, 0x55, 0x9f, 0x5e, 0x0d, 0x05,
+	0xff, 0x9a, 0x05, 0xce, 0x46, 0xbb, 0xd4, 0xad, 0xf5, 0x91, 0x9b, 0x76, 0xc6, 0x1d, 0x65, 0x16,
+	0x7c, 0xe3, 0x84, 0x86, 0x00, 0xa6, 0x7e, 0x1a, 0x52, 0xb6, 0x21, 0xf7, 0xf3, 0x90, 0xa3, 0xf1,
+	0x68, 0x98, 0x98, 0x06, 0xf7, 0x16, 0xd7, 0xfb, 0x70, 0xa3, 0xe3, 0x42, 0x18, 0x6a, 0x43, 0x8d,
+	0x44, 0xd1, 0x84, 0x9c, 0xd3, 0xf0, 0x39, 0x9d, 0x3b, 0x9b, 0xe6, 0x64, 0xb8, 0x08, 0xa1, 0x97,
+	0xb0, 0x2b, 0xa9, 0x12, 0xb1, 0xf4, 0xe8, 0xd1, 0x8c, 0x4a, 0xc9, 0x7c, 0xaa, 0x9c, 0x3b, 0xed,
+	0x72, 0xb7, 0xd6, 0xef, 0xe6, 0xd5, 0xb2, 0x1b, 0xba, 0x78, 0xd9, 0xf5, 0x09, 0xd7, 0x72, 0x8e,
+	0x57, 0x53, 0x20, 0x17, 0x90, 0xd2, 0x44, 0xc7, 0x6a, 0x40, 0xfc, 0x80, 0x3e, 0xe1, 0xe4, 0x3c,
+	0xa4, 0xbe, 0xb3, 0xd5, 0x2e, 0x75, 0x2b, 0x78, 0x8d, 0x05, 0x3d, 0x83, 0x46, 0xc2, 0x84, 0x4f,
+	0x39, 0x09, 0xe7, 0x9a, 0x79, 0xca, 0xd9, 0xb6, 0x77, 0x6e, 0xe5, 0xa7, 0x78, 0x7a, 0xdb, 0x9e,
+	0x5e, 0x77, 0x39, 0x0c, 0xbd, 0x82, 0x9d, 0x69, 0xac, 0xb4, 0xb8, 0x64, 0xaf, 0xe8, 0x51, 0x64,
+	0xd9, 0xe4, 0x54, 0x6c, 0xaa, 0xcf, 0xdd, 0x1b, 0x02, 0xb8, 0x19, 0x01, 0xac, 0x70, 0xe6, 0xf9,
+	0xee, 0xac, 0xef, 0x46, 0xd3, 0xc0, 0x35, 0x74, 0x72, 0x0b, 0x74, 0x72, 0x33, 0x3a, 0xb9, 0xcf,
+	0x97, 0xb2, 0xe2, 0x95, 0x3a, 0xe8, 0x4d, 0xd8, 0xbc, 0xa0, 0x61, 0xe4, 0x54, 0x6d, 0xbd, 0x7a,
+	0x7e, 0xf4, 0x67, 0x34, 0x8c, 0xb0, 0x35, 0xa1, 0xb7, 0x60, 0x3b, 0x0a, 0xe3, 0x80, 0x71, 0xe5,
+	0x80, 0x6d, 0x73, 0x23, 0xf7, 0x3a, 0xb6, 0x38, 0xce, 0xec, 0xa6, 0x87, 0xb1, 0xa2, 0x72, 0x22,
+	0x8c, 0x36, 0x62, 0x2a, 0xe9, 0x61, 0x2d, 0xe9, 0xe1, 0xaa, 0x05, 0xfd, 0x58, 0x82, 0xff, 0x7b,
+	0xb6, 0x2b, 0x87, 0x84, 0x93, 0x80, 0x5e, 0x52, 0xae, 0x8f, 0xd3, 0x5a, 0x77, 0x6d, 0xad, 0x93,
+	0x7f, 0xd7, 0x81, 0xe1, 0xda, 0xe4, 0xf8, 0x75, 0x45, 0xd1, 0xdb, 0xb0, 0x9b, 0xb7, 0xe8, 0x25,
+	0x95, 0xca, 0x7e, 0x8b, 0x7a, 0xbb, 0xdc, 0xad, 0xe2, 0x55, 0x03, 0x6a, 0x42, 0x25, 0x66, 0x43,
+	0xa5, 0x4e, 0xf1, 0xc4, 0xb9, 0x67, 0x99, 0x9a, 0xeb, 0xa8, 0x0b, 0x8d, 0x98, 0x0d, 0x08, 0xe7,
+	0x54, 0x0e, 0x05, 0xd7, 0x94, 0x6b, 0xa7, 0x61, 0x5d, 0x96, 0x61, 0x43, 0xf9, 0x0c, 0x32, 0x89,
+	0x76, 0x12, 0xca, 0x17, 0x20, 0x93, 0x2b, 0x22, 0x4a, 0x7d, 0x2b, 0xa4, 0x7f, 0x4c, 0xb4, 0xa6,
+	0x92, 0x3b, 0xbb, 0x49, 0xae, 0x25, 0x18, 0x3d, 0x82, 0x7b, 0x5a, 0x12, 0x6f, 0xca, 0x78, 0x70,
+	0x48, 0xf5, 0x85, 0xf0, 0x1d, 0x64, 0x1d, 0x97, 0x50, 0x34, 0x81, 0xba, 0x19, 0xba, 0x63, 0x29,
+	0x66, 0xcc, 0xa7, 0x52, 0x39, 0xf7, 0xdb, 0xe5, 0xd7, 0x8d, 0xeb, 0xee, 0xe2, 0x7a, 0xbf, 0x6e,
+	0xf4, 0xdc, 0x1b, 0xdf, 0x0e, 0x36, 0x37, 0xa0, 0x57, 0xd4, 0xcb, 0x66, 0xe6, 0x81, 0xfd, 0xde,
+	0x45, 0xa8, 0xf9, 0x73, 0x09, 0xf6, 0xd6, 0x8f, 0x22, 0xda, 0x81, 0xf2, 0x94, 0xce, 0x93, 0x7f,
+	0x10, 0x36, 0x22, 0xf2, 0xe1, 0xce, 0x8c, 0x84, 0x31, 0x75, 0x36, 0xfe, 0x8b, 0x21, 0x58, 0x2e,
+	0x8b, 0x93, 0xe4, 0x1f, 0x6e, 0x7c, 0x50, 0xea, 0x9c, 0xc1, 0xff, 0xd6, 0xce, 0x28, 0x6a, 0x01,
+	0x64, 0x1d, 0x1b, 0x8f, 0xd2, 0xb3, 0x15, 0x10, 0xd3, 0x67, 0xc2, 0x05, 0x9f, 0x1b, 0x3a, 0x9c,
+	0x2a, 0xd3, 0xc0, 0x0d, 0x7b, 0xe9, 0x25, 0xb4, 0xf3, 0x11, 0x6c, 0x9a, 0x49, 0x42, 0x0e, 0x6c,
+	0x7b, 0x17, 0x44, 0x9f, 0x66, 0x3f, 0x5b, 0x9c, 0xa9, 0x86, 0x43, 0x46, 0x3c, 0xa1, 0x57, 0xda,
+	0xe6, 0xa8, 0xe2, 0x5c, 0xef, 0x3c, 0x84, 0xad, 0x84, 0x98, 0x08, 0xc1, 0x26, 0x27, 0x97, 0x34,
+	0x0d, 0xb6, 0x72, 0xe7, 0x63, 0xa8, 0xe6, 0xff, 0x61, 0xd4, 0x07, 0xf0, 0x04, 0xe7, 0xd4, 0xd3,
+	0x42, 0x2a, 0xa7, 0xd4, 0x2e, 0xdf, 0xfa, 0x5f, 0x0f, 0x33, 0x13, 0x2e, 0x78, 0x75, 0x1e, 0x43,
+	0x35, 0x37, 0xac, 0xab, 0x60, 0x30, 0x3d, 0x8f, 0x68, 0x7a, 0x2e, 0x2b, 0x77, 0xbe, 0x2f, 0x43,
+	0xe1, 0xdf, 0xbd, 0x36, 0x6c, 0x0f, 0xb6, 0x98, 0x52, 0x31, 0x95, 0x69, 0x60, 0xaa, 0xa1, 0x2e,
+	0x54, 0xbc, 0x90, 0x51, 0xae, 0xc7, 0x23, 0xbb, 0x1e, 0xaa, 0x83, 0xbb, 0x8b, 0xeb, 0xfd, 0xca,
+	0x30, 0xc5, 0x70, 0x6e, 0x45, 0x07, 0x50, 0xf3, 0x42, 0x96, 0x19, 0x92, 0x2d, 0x30, 0x68, 0x2c,
+	0xae, 0xf7, 0x6b, 0xc3, 0xc9, 0x38, 0xf7, 0x2f, 0xfa, 0x98, 0xa2, 0xca, 0x13, 0x51, 0xba, 0x0b,
+	0xaa, 0x38, 0xd5, 0xd0, 0x19, 0xd4, 0x99, 0x7f, 0x22, 0xa6, 0x94, 0x0f, 0xed, 0x5e, 0x74, 0xb6,
+	0x6c, 0x6f, 0x1e, 0xad, 0x61, 0xba, 0x3b, 0x2e, 0x3a, 0x5a, 0x76, 0x26, 0xe4, 0x1f, 0x8f, 0x0a,
+	0x38, 0xbe, 0x9d, 0xaf, 0x39, 0x07, 0xb4, 0x1a, 0xb7, 0x86, 0xd5, 0x87, 0xb7, 0x59, 0xfd, 0xfe,
+	0x5f, 0xb2, 0x3a, 0x59, 0xec, 0x6e, 0xfe, 0x32, 0x31, 0xf3, 0xe6, 0xda, 0xfc, 0x05, 0xfa, 0xf6,
+	0xbf, 0x82, 0x46, 0xb6, 0xe8, 0x5e, 0x50, 0x39, 0x63, 0x1e, 0x45, 0x9f, 0x41, 0xf9, 0x29, 0xd5,
+	0x68, 0x6f, 0x65, 0x13, 0xda, 0xed, 0xdf, 0xdc, 0x5d, 0xc1, 0x3b, 0xce, 0x77, 0xbf, 0xfd, 0xf1,
+	0xd3, 0x06, 0x42, 0x3b, 0xf6, 0x45, 0x33, 0x3b, 0xc8, 0x5f, 0x13, 0x83, 0xe1, 0x2f, 0x8b, 0x56,
+	0xe9, 0xd7, 0x45, 0xab, 0xf4, 0xfb, 0xa2, 0x55, 0xfa, 0xf2, 0xbd, 0x7f, 0xf6, 0xb2, 0x49, 0xbe,
+	0x61, 0x9e, 0xe4, 0x7c, 0xcb, 0xbe, 0x43, 0x1e, 0xff, 0x39, 0x00, 0x40, 0xc5, 0x34, 0xb6, 0x76,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -816,6 +845,43 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ExecEnabled {
+		i--
+		if m.ExecEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.OIDCProviders) > 0 {
+		for iNdEx := len(m.OIDCProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OIDCProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.TrackingMethod) > 0 {
+		i -= len(m.TrackingMethod)
+		copy(dAtA[i:], m.TrackingMethod)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingMethod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if len(m.PasswordPattern) > 0 {
 		i -= len(m.PasswordPattern)
 		copy(dAtA[i:], m.PasswordPattern)
@@ -1409,6 +1475,19 @@ func (m *Settings) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovSettings(uint64(l))
 	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 2 + l + sovSettings(uint64(l))
+	}
+	if len(m.OIDCProviders) > 0 {
+		for _, e := range m.OIDCProviders {
+			l = e.Size()
+			n += 2 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.ExecEnabled {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2282,6 +2361,92 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			}
 			m.PasswordPattern = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OIDCProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OIDCProviders = append(m.OIDCProviders, &OIDCConfig{})
+			if err := m.OIDCProviders[len(m.OIDCProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
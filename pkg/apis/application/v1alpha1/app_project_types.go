@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	fmt "fmt"
 	"sort"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/glob"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/itchyny/gojq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -134,6 +136,29 @@ func (p AppProject) RemoveJWTToken(roleIndex int, issuedAt int64, id string) err
 	}
 }
 
+// SetJWTTokenExpiration sets the expiration of the JWT token of a role, in the spec and the status of the project. The
+// tokens are rejected once their expiration recorded in the project elapses, even if the JWT expires later.
+func (p *AppProject) SetJWTTokenExpiration(roleIndex int, id string, expiresAt int64) error {
+	role := &p.Spec.Roles[roleIndex]
+	found := false
+	for i := range role.JWTTokens {
+		if role.JWTTokens[i].ID == id {
+			role.JWTTokens[i].ExpiresAt = expiresAt
+			found = true
+		}
+	}
+	for i := range p.Status.JWTTokensByRole[role.Name].Items {
+		if p.Status.JWTTokensByRole[role.Name].Items[i].ID == id {
+			p.Status.JWTTokensByRole[role.Name].Items[i].ExpiresAt = expiresAt
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("JWT token '%s' of role '%s' does not exist in project '%s'", id, role.Name, p.Name)
+	}
+	return nil
+}
+
 // TODO: document this method
 func (p *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 	role, _, err := p.GetRoleByName(roleName)
@@ -216,9 +241,118 @@ func (p *AppProject) ValidateProject() error {
 		}
 	}
 
+	customizationKeys := make(map[string]bool)
+	for _, customization := range p.Spec.ResourceCustomizations {
+		if customization.Kind == "" {
+			return status.Errorf(codes.InvalidArgument, "resource customization of group '%s' requires a kind", customization.Group)
+		}
+		key := customization.key()
+		if _, ok := customizationKeys[key]; ok {
+			return status.Errorf(codes.AlreadyExists, "resource customization '%s' already exists", key)
+		}
+		customizationKeys[key] = true
+	}
+
+	serviceAccountDestKeys := make(map[string]bool)
+	for _, dest := range p.Spec.DestinationServiceAccounts {
+		if dest.Server == "" {
+			return status.Errorf(codes.InvalidArgument, "destination service account '%s' requires a server", dest.DefaultServiceAccount)
+		}
+		if dest.DefaultServiceAccount == "" {
+			return status.Errorf(codes.InvalidArgument, "destination service account of '%s/%s' requires a service account", dest.Server, dest.Namespace)
+		}
+		key := fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)
+		if _, ok := serviceAccountDestKeys[key]; ok {
+			return status.Errorf(codes.AlreadyExists, "destination service account of '%s' already added", key)
+		}
+		serviceAccountDestKeys[key] = true
+	}
+
+	if p.Spec.SopsKeySet != "" && !roleNameRegexp.MatchString(p.Spec.SopsKeySet) {
+		return status.Errorf(codes.InvalidArgument, "invalid SOPS key set name '%s'. Must consist of alphanumeric characters, '-' or '_', and must start and end with an alphanumeric character", p.Spec.SopsKeySet)
+	}
+
+	if p.Spec.ManifestPolicy != nil {
+		for _, field := range p.Spec.ManifestPolicy.ForbiddenFields {
+			if field.JQPathExpression == "" {
+				return status.Errorf(codes.InvalidArgument, "forbidden field of kind '%s' requires a JQ path expression", field.Kind)
+			}
+			query, err := gojq.Parse(field.JQPathExpression)
+			if err == nil {
+				_, err = gojq.Compile(query)
+			}
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid JQ path expression '%s' of forbidden field: %v", field.JQPathExpression, err)
+			}
+			if field.Value != "" && !json.Valid([]byte(field.Value)) {
+				return status.Errorf(codes.InvalidArgument, "value '%s' of forbidden field '%s' is not valid JSON", field.Value, field.JQPathExpression)
+			}
+		}
+	}
+
 	return nil
 }
 
+// ServiceAccountToImpersonate returns the username impersonating the service account used to sync the applications to
+// the given destination, i.e. the service account of the first destination service account matching the destination
+func (proj AppProject) ServiceAccountToImpersonate(dest ApplicationDestination) (string, error) {
+	for _, sa := range proj.Spec.DestinationServiceAccounts {
+		if !glob.Match(sa.Server, dest.Server) || !glob.Match(sa.Namespace, dest.Namespace) {
+			continue
+		}
+		namespace, name := dest.Namespace, sa.DefaultServiceAccount
+		if parts := strings.SplitN(sa.DefaultServiceAccount, ":", 2); len(parts) == 2 {
+			namespace, name = parts[0], parts[1]
+		}
+		if namespace == "" {
+			return "", fmt.Errorf("the namespace of service account '%s' is required since the destination has no namespace", sa.DefaultServiceAccount)
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name), nil
+	}
+	return "", fmt.Errorf("no destination service account of project '%s' matches the destination '%s/%s'", proj.Name, dest.Server, dest.Namespace)
+}
+
+// Matches returns whether the field is forbidden in the resources of the given group and kind
+func (f ForbiddenManifestField) Matches(group string, kind string) bool {
+	return (f.Group == "" || f.Group == group) && (f.Kind == "" || f.Kind == kind)
+}
+
+// key returns the key of the resource customization in the resource overrides, the same as in argocd-cm
+func (c ProjectResourceCustomization) key() string {
+	if c.Group == "" {
+		return c.Kind
+	}
+	return fmt.Sprintf("%s/%s", c.Group, c.Kind)
+}
+
+// ResourceOverrides returns the given resource overrides of argocd-cm completed with the health checks and actions
+// customized in the project. The customizations of argocd-cm always take precedence over the ones of the project, and
+// the project cannot customize the resources whose scripts are allowed to use the Lua standard libraries.
+func (proj AppProject) ResourceOverrides(overrides map[string]ResourceOverride) map[string]ResourceOverride {
+	if len(proj.Spec.ResourceCustomizations) == 0 {
+		return overrides
+	}
+	res := make(map[string]ResourceOverride, len(overrides)+len(proj.Spec.ResourceCustomizations))
+	for key, override := range overrides {
+		res[key] = override
+	}
+	for _, customization := range proj.Spec.ResourceCustomizations {
+		key := customization.key()
+		override := res[key]
+		if override.UseOpenLibs {
+			continue
+		}
+		if override.HealthLua == "" {
+			override.HealthLua = customization.HealthLua
+		}
+		if override.Actions == "" {
+			override.Actions = customization.Actions
+		}
+		res[key] = override
+	}
+	return res
+}
+
 // AddGroupToRole adds an OIDC group to a role
 func (p *AppProject) AddGroupToRole(roleName, group string) (bool, error) {
 	role, roleIndex, err := p.GetRoleByName(roleName)
@@ -10,4 +10,12 @@ const (
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
 	// source path within the repository.
 	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
+
+	// AnnotationKeyManifestGenerationTimeout is an annotation that contains the maximum duration (e.g. 3m) of the manifest
+	// generation of the application, overriding the default timeout of the repo server.
+	AnnotationKeyManifestGenerationTimeout = "argocd.argoproj.io/manifest-generation-timeout"
+
+	// AnnotationKeySkipReconcile is an annotation which pauses the reconciliation of the application when set to
+	// "true": the application is neither compared nor synced, and keeps its last known status.
+	AnnotationKeySkipReconcile = "argocd.argoproj.io/skip-reconcile"
 )
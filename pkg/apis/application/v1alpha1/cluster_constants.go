@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"os"
+	"path/filepath"
 	"strconv"
 )
 
@@ -19,6 +20,10 @@ const (
 
 	// EnvK8sClientMaxIdleConnections is the number of max idle connections in K8s REST client HTTP transport (default: 500)
 	EnvK8sClientMaxIdleConnections = "ARGOCD_K8S_CLIENT_MAX_IDLE_CONNECTIONS"
+
+	// EnvExecProviderAllowedPaths is the list of directories, separated like the PATH, from which the commands of the
+	// cluster exec providers are allowed to run (default: any command is allowed)
+	EnvExecProviderAllowedPaths = "ARGOCD_EXEC_PROVIDER_ALLOWED_PATHS"
 )
 
 // Constants associated with the Cluster API
@@ -32,6 +37,10 @@ var (
 
 	// K8sMaxIdleConnections controls the number of max idle connections in K8s REST client HTTP transport
 	K8sMaxIdleConnections = 500
+
+	// ExecProviderAllowedPaths controls the directories from which the commands of the cluster exec providers are
+	// allowed to run. Any command is allowed if empty.
+	ExecProviderAllowedPaths []string
 )
 
 func init() {
@@ -54,4 +63,10 @@ func init() {
 		}
 	}
 
+	for _, path := range filepath.SplitList(os.Getenv(EnvExecProviderAllowedPaths)) {
+		if path != "" {
+			ExecProviderAllowedPaths = append(ExecProviderAllowedPaths, path)
+		}
+	}
+
 }
@@ -260,10 +260,38 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
+func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
+func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{8}
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.Merge(m, src)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{9}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +319,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{10}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +347,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +375,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{12}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,10 +400,38 @@ func (m *ApplicationSourceHelm) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 
+func (m *ApplicationSourceHelmfile) Reset()      { *m = ApplicationSourceHelmfile{} }
+func (*ApplicationSourceHelmfile) ProtoMessage() {}
+func (*ApplicationSourceHelmfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{13}
+}
+func (m *ApplicationSourceHelmfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourceHelmfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSourceHelmfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourceHelmfile.Merge(m, src)
+}
+func (m *ApplicationSourceHelmfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourceHelmfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourceHelmfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourceHelmfile proto.InternalMessageInfo
+
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +459,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKsonnet) Reset()      { *m = ApplicationSourceKsonnet{} }
 func (*ApplicationSourceKsonnet) ProtoMessage() {}
 func (*ApplicationSourceKsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSourceKsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +487,7 @@ var xxx_messageInfo_ApplicationSourceKsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +515,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{17}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,10 +540,38 @@ func (m *ApplicationSourcePlugin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 
+func (m *ApplicationSourceYtt) Reset()      { *m = ApplicationSourceYtt{} }
+func (*ApplicationSourceYtt) ProtoMessage() {}
+func (*ApplicationSourceYtt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{18}
+}
+func (m *ApplicationSourceYtt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSourceYtt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSourceYtt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSourceYtt.Merge(m, src)
+}
+func (m *ApplicationSourceYtt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSourceYtt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSourceYtt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSourceYtt proto.InternalMessageInfo
+
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{19}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +599,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +627,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +655,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{22}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +683,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +711,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,10 +736,38 @@ func (m *Backoff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Backoff proto.InternalMessageInfo
 
+func (m *ChartSignatureVerification) Reset()      { *m = ChartSignatureVerification{} }
+func (*ChartSignatureVerification) ProtoMessage() {}
+func (*ChartSignatureVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{25}
+}
+func (m *ChartSignatureVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChartSignatureVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChartSignatureVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChartSignatureVerification.Merge(m, src)
+}
+func (m *ChartSignatureVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChartSignatureVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChartSignatureVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChartSignatureVerification proto.InternalMessageInfo
+
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +795,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{27}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +823,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +851,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +879,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,10 +904,38 @@ func (m *ClusterList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 
+func (m *ClusterResourceFilter) Reset()      { *m = ClusterResourceFilter{} }
+func (*ClusterResourceFilter) ProtoMessage() {}
+func (*ClusterResourceFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{31}
+}
+func (m *ClusterResourceFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterResourceFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterResourceFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterResourceFilter.Merge(m, src)
+}
+func (m *ClusterResourceFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterResourceFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterResourceFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterResourceFilter proto.InternalMessageInfo
+
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +963,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +991,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{34}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1019,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,10 +1044,38 @@ func (m *ConfigManagementPlugin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 
+func (m *ConfigManagementPluginDiscovery) Reset()      { *m = ConfigManagementPluginDiscovery{} }
+func (*ConfigManagementPluginDiscovery) ProtoMessage() {}
+func (*ConfigManagementPluginDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{36}
+}
+func (m *ConfigManagementPluginDiscovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigManagementPluginDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigManagementPluginDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigManagementPluginDiscovery.Merge(m, src)
+}
+func (m *ConfigManagementPluginDiscovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigManagementPluginDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigManagementPluginDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigManagementPluginDiscovery proto.InternalMessageInfo
+
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1103,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1131,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -988,10 +1156,38 @@ func (m *ExecProviderConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 
+func (m *ForbiddenManifestField) Reset()      { *m = ForbiddenManifestField{} }
+func (*ForbiddenManifestField) ProtoMessage() {}
+func (*ForbiddenManifestField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{40}
+}
+func (m *ForbiddenManifestField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForbiddenManifestField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ForbiddenManifestField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForbiddenManifestField.Merge(m, src)
+}
+func (m *ForbiddenManifestField) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForbiddenManifestField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForbiddenManifestField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForbiddenManifestField proto.InternalMessageInfo
+
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1215,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{42}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1243,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1271,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,10 +1296,38 @@ func (m *HelmFileParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 
+func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
+func (*HelmOptions) ProtoMessage() {}
+func (*HelmOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{45}
+}
+func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmOptions.Merge(m, src)
+}
+func (m *HelmOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
+
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{46}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,10 +1352,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HookRetryStrategy) Reset()      { *m = HookRetryStrategy{} }
+func (*HookRetryStrategy) ProtoMessage() {}
+func (*HookRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{47}
+}
+func (m *HookRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HookRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookRetryStrategy.Merge(m, src)
+}
+func (m *HookRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookRetryStrategy proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{48}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1411,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1439,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1467,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{51}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1495,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1523,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1551,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{54}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1324,10 +1576,38 @@ func (m *JsonnetVar) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 
+func (m *KeylessIdentity) Reset()      { *m = KeylessIdentity{} }
+func (*KeylessIdentity) ProtoMessage() {}
+func (*KeylessIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{55}
+}
+func (m *KeylessIdentity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeylessIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeylessIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeylessIdentity.Merge(m, src)
+}
+func (m *KeylessIdentity) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeylessIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeylessIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeylessIdentity proto.InternalMessageInfo
+
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1635,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KsonnetParameter) Reset()      { *m = KsonnetParameter{} }
 func (*KsonnetParameter) ProtoMessage() {}
 func (*KsonnetParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *KsonnetParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,12 +1660,40 @@ func (m *KsonnetParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KsonnetParameter proto.InternalMessageInfo
 
-func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
-func (*KustomizeOptions) ProtoMessage() {}
-func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+func (m *KustomizeBuildOptions) Reset()      { *m = KustomizeBuildOptions{} }
+func (*KustomizeBuildOptions) ProtoMessage() {}
+func (*KustomizeBuildOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{58}
 }
-func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
+func (m *KustomizeBuildOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KustomizeBuildOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KustomizeBuildOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KustomizeBuildOptions.Merge(m, src)
+}
+func (m *KustomizeBuildOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *KustomizeBuildOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_KustomizeBuildOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KustomizeBuildOptions proto.InternalMessageInfo
+
+func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
+func (*KustomizeOptions) ProtoMessage() {}
+func (*KustomizeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{59}
+}
+func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 func (m *KustomizeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -1408,10 +1716,66 @@ func (m *KustomizeOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 
+func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
+func (*ManagedNamespaceMetadata) ProtoMessage() {}
+func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{60}
+}
+func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedNamespaceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedNamespaceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedNamespaceMetadata.Merge(m, src)
+}
+func (m *ManagedNamespaceMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedNamespaceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedNamespaceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
+
+func (m *ManifestPolicy) Reset()      { *m = ManifestPolicy{} }
+func (*ManifestPolicy) ProtoMessage() {}
+func (*ManifestPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{61}
+}
+func (m *ManifestPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManifestPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestPolicy.Merge(m, src)
+}
+func (m *ManifestPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestPolicy proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1803,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1831,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1859,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1887,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1915,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1576,10 +1940,66 @@ func (m *OverrideIgnoreDiff) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 
+func (m *PhaseRetryStrategy) Reset()      { *m = PhaseRetryStrategy{} }
+func (*PhaseRetryStrategy) ProtoMessage() {}
+func (*PhaseRetryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{68}
+}
+func (m *PhaseRetryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PhaseRetryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PhaseRetryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PhaseRetryStrategy.Merge(m, src)
+}
+func (m *PhaseRetryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PhaseRetryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PhaseRetryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PhaseRetryStrategy proto.InternalMessageInfo
+
+func (m *ProjectResourceCustomization) Reset()      { *m = ProjectResourceCustomization{} }
+func (*ProjectResourceCustomization) ProtoMessage() {}
+func (*ProjectResourceCustomization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{69}
+}
+func (m *ProjectResourceCustomization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectResourceCustomization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectResourceCustomization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectResourceCustomization.Merge(m, src)
+}
+func (m *ProjectResourceCustomization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectResourceCustomization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectResourceCustomization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectResourceCustomization proto.InternalMessageInfo
+
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2027,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2055,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2083,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2111,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2139,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{75}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2167,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{76}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2195,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2223,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2251,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2279,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2307,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2335,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2363,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2391,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2419,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2447,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2475,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2503,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2531,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2559,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{90}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2587,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{91}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2615,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{92}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2643,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{93}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2671,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{94}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2699,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{95}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2727,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{96}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2332,10 +2752,38 @@ func (m *SyncPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 
+func (m *SyncPolicyAutoRollback) Reset()      { *m = SyncPolicyAutoRollback{} }
+func (*SyncPolicyAutoRollback) ProtoMessage() {}
+func (*SyncPolicyAutoRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{97}
+}
+func (m *SyncPolicyAutoRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPolicyAutoRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncPolicyAutoRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPolicyAutoRollback.Merge(m, src)
+}
+func (m *SyncPolicyAutoRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPolicyAutoRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPolicyAutoRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPolicyAutoRollback proto.InternalMessageInfo
+
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{98}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2811,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{99}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2839,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{100}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2867,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{101}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2895,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{102}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2923,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{103}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2951,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{88}
+	return fileDescriptor_030104ce3b95bcac, []int{104}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,6 +2976,34 @@ func (m *TLSClientConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 
+func (m *YttDataValue) Reset()      { *m = YttDataValue{} }
+func (*YttDataValue) ProtoMessage() {}
+func (*YttDataValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{105}
+}
+func (m *YttDataValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YttDataValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *YttDataValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YttDataValue.Merge(m, src)
+}
+func (m *YttDataValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *YttDataValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_YttDataValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YttDataValue proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AWSAuthConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AWSAuthConfig")
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.AppProject")
@@ -2538,22 +3014,26 @@ func init() {
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSource")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
 	proto.RegisterType((*ApplicationSourceHelm)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceHelm")
+	proto.RegisterType((*ApplicationSourceHelmfile)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceHelmfile")
 	proto.RegisterType((*ApplicationSourceJsonnet)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceJsonnet")
 	proto.RegisterType((*ApplicationSourceKsonnet)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKsonnet")
 	proto.RegisterType((*ApplicationSourceKustomize)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKustomize")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKustomize.CommonAnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceKustomize.CommonLabelsEntry")
 	proto.RegisterType((*ApplicationSourcePlugin)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourcePlugin")
+	proto.RegisterType((*ApplicationSourceYtt)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceYtt")
 	proto.RegisterType((*ApplicationSpec)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSpec")
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationStatus")
 	proto.RegisterType((*ApplicationSummary)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSummary")
 	proto.RegisterType((*ApplicationTree)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationTree")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Backoff")
+	proto.RegisterType((*ChartSignatureVerification)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ChartSignatureVerification")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Cluster.LabelsEntry")
@@ -2561,19 +3041,24 @@ func init() {
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterInfo")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterList")
+	proto.RegisterType((*ClusterResourceFilter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ClusterResourceFilter")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*ComparedTo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ComparedTo")
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConfigManagementPlugin)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConfigManagementPlugin")
+	proto.RegisterType((*ConfigManagementPluginDiscovery)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConfigManagementPluginDiscovery")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ConnectionState")
 	proto.RegisterType((*EnvEntry)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.EnvEntry")
 	proto.RegisterType((*ExecProviderConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ExecProviderConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ExecProviderConfig.EnvEntry")
+	proto.RegisterType((*ForbiddenManifestField)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ForbiddenManifestField")
 	proto.RegisterType((*GnuPGPublicKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GnuPGPublicKey")
 	proto.RegisterType((*GnuPGPublicKeyList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.GnuPGPublicKeyList")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmFileParameter")
+	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HookRetryStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HookRetryStrategy")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Info")
@@ -2581,15 +3066,23 @@ func init() {
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.JWTToken")
 	proto.RegisterType((*JWTTokens)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.JWTTokens")
 	proto.RegisterType((*JsonnetVar)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.JsonnetVar")
+	proto.RegisterType((*KeylessIdentity)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KeylessIdentity")
 	proto.RegisterType((*KnownTypeField)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KnownTypeField")
 	proto.RegisterType((*KsonnetParameter)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KsonnetParameter")
+	proto.RegisterType((*KustomizeBuildOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeBuildOptions")
 	proto.RegisterType((*KustomizeOptions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.KustomizeOptions")
+	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata.LabelsEntry")
+	proto.RegisterType((*ManifestPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ManifestPolicy")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
+	proto.RegisterType((*PhaseRetryStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.PhaseRetryStrategy")
+	proto.RegisterType((*ProjectResourceCustomization)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectResourceCustomization")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCreds")
 	proto.RegisterType((*RepoCredsList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCredsList")
@@ -2619,6 +3112,7 @@ func init() {
 	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPolicy")
+	proto.RegisterType((*SyncPolicyAutoRollback)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPolicyAutoRollback")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategy")
@@ -2626,6 +3120,7 @@ func init() {
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncStrategyHook")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.TLSClientConfig")
+	proto.RegisterType((*YttDataValue)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.YttDataValue")
 }
 
 func init() {
@@ -2633,427 +3128,513 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 6715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xd9,
-	0x55, 0xf0, 0x56, 0xb7, 0x7f, 0xba, 0x8f, 0x7f, 0x66, 0x7c, 0x67, 0x76, 0xd6, 0xf1, 0xb7, 0x19,
-	0x8f, 0x6a, 0x95, 0x64, 0xbf, 0x2f, 0x89, 0xfd, 0xed, 0xb0, 0x84, 0x25, 0x1b, 0x36, 0xb8, 0x6d,
-	0xcf, 0x8c, 0x67, 0x3c, 0xb6, 0xe7, 0xd8, 0x33, 0x43, 0x7e, 0x08, 0x5b, 0xae, 0xbe, 0xdd, 0x5d,
-	0xe3, 0xee, 0xaa, 0xde, 0xaa, 0x6a, 0x8f, 0x3b, 0x21, 0x7f, 0x28, 0x90, 0x15, 0xf9, 0xd9, 0x28,
-	0xc9, 0x43, 0x22, 0x21, 0x08, 0x3f, 0x42, 0xe2, 0x21, 0x02, 0x9e, 0x00, 0x21, 0x1e, 0xc8, 0x53,
-	0x10, 0x12, 0x44, 0x02, 0x25, 0x81, 0x08, 0x93, 0x0c, 0x41, 0x01, 0x24, 0x40, 0x40, 0x5e, 0x98,
-	0x27, 0x74, 0x7f, 0xea, 0xde, 0x5b, 0xd5, 0xdd, 0x63, 0x7b, 0xba, 0x66, 0x12, 0x45, 0xbc, 0x75,
-	0x9d, 0x73, 0xee, 0x39, 0xe7, 0xfe, 0x9d, 0x7b, 0xee, 0xb9, 0xe7, 0xde, 0x86, 0xf5, 0xba, 0x17,
-	0x37, 0x3a, 0xbb, 0x0b, 0x6e, 0xd0, 0x5a, 0x74, 0xc2, 0x7a, 0xd0, 0x0e, 0x83, 0x3b, 0xfc, 0xc7,
-	0x5b, 0xdd, 0xea, 0xe2, 0xfe, 0xc5, 0xc5, 0xf6, 0x5e, 0x7d, 0xd1, 0x69, 0x7b, 0xd1, 0xa2, 0xd3,
-	0x6e, 0x37, 0x3d, 0xd7, 0x89, 0xbd, 0xc0, 0x5f, 0xdc, 0x7f, 0xce, 0x69, 0xb6, 0x1b, 0xce, 0x73,
-	0x8b, 0x75, 0xea, 0xd3, 0xd0, 0x89, 0x69, 0x75, 0xa1, 0x1d, 0x06, 0x71, 0x40, 0xde, 0xa1, 0xb9,
-	0x2d, 0x24, 0xdc, 0xf8, 0x8f, 0x9f, 0x73, 0xab, 0x0b, 0xfb, 0x17, 0x17, 0xda, 0x7b, 0xf5, 0x05,
-	0xc6, 0x6d, 0xc1, 0xe0, 0xb6, 0x90, 0x70, 0x9b, 0x7b, 0xab, 0xa1, 0x4b, 0x3d, 0xa8, 0x07, 0x8b,
-	0x9c, 0xe9, 0x6e, 0xa7, 0xc6, 0xbf, 0xf8, 0x07, 0xff, 0x25, 0x84, 0xcd, 0xd9, 0x7b, 0x2f, 0x44,
-	0x0b, 0x5e, 0xc0, 0xd4, 0x5b, 0x74, 0x83, 0x90, 0x2e, 0xee, 0xf7, 0x28, 0x34, 0xf7, 0xbc, 0xa6,
-	0x69, 0x39, 0x6e, 0xc3, 0xf3, 0x69, 0xd8, 0xd5, 0x75, 0x6a, 0xd1, 0xd8, 0xe9, 0x57, 0x6a, 0x71,
-	0x50, 0xa9, 0xb0, 0xe3, 0xc7, 0x5e, 0x8b, 0xf6, 0x14, 0x78, 0xdb, 0x51, 0x05, 0x22, 0xb7, 0x41,
-	0x5b, 0x4e, 0xb6, 0x9c, 0xfd, 0x0a, 0x4c, 0x2d, 0xdd, 0xde, 0x5e, 0xea, 0xc4, 0x8d, 0xe5, 0xc0,
-	0xaf, 0x79, 0x75, 0xf2, 0xe3, 0x30, 0xe1, 0x36, 0x3b, 0x51, 0x4c, 0xc3, 0x0d, 0xa7, 0x45, 0x67,
-	0xad, 0x0b, 0xd6, 0xb3, 0xe5, 0xca, 0x99, 0xaf, 0x1e, 0xce, 0x3f, 0x71, 0xef, 0x70, 0x7e, 0x62,
-	0x59, 0xa3, 0xd0, 0xa4, 0x23, 0xff, 0x17, 0xc6, 0xc3, 0xa0, 0x49, 0x97, 0x70, 0x63, 0xb6, 0xc0,
-	0x8b, 0x9c, 0x92, 0x45, 0xc6, 0x51, 0x80, 0x31, 0xc1, 0xdb, 0x5f, 0x2f, 0x00, 0x2c, 0xb5, 0xdb,
-	0x5b, 0x61, 0x70, 0x87, 0xba, 0x31, 0x79, 0x19, 0x4a, 0xac, 0x15, 0xaa, 0x4e, 0xec, 0x70, 0x69,
-	0x13, 0x17, 0xff, 0xff, 0x82, 0xa8, 0xcc, 0x82, 0x59, 0x19, 0xdd, 0x73, 0x8c, 0x7a, 0x61, 0xff,
-	0xb9, 0x85, 0xcd, 0x5d, 0x56, 0xfe, 0x3a, 0x8d, 0x9d, 0x0a, 0x91, 0xc2, 0x40, 0xc3, 0x50, 0x71,
-	0x25, 0x3e, 0x8c, 0x44, 0x6d, 0xea, 0x72, 0xc5, 0x26, 0x2e, 0xae, 0x2f, 0x0c, 0x33, 0x44, 0x16,
-	0xb4, 0xe6, 0xdb, 0x6d, 0xea, 0x56, 0x26, 0xa5, 0xe4, 0x11, 0xf6, 0x85, 0x5c, 0x0e, 0xd9, 0x87,
-	0xb1, 0x28, 0x76, 0xe2, 0x4e, 0x34, 0x5b, 0xe4, 0x12, 0x37, 0x72, 0x93, 0xc8, 0xb9, 0x56, 0xa6,
-	0xa5, 0xcc, 0x31, 0xf1, 0x8d, 0x52, 0x9a, 0xfd, 0x77, 0x16, 0x4c, 0x6b, 0xe2, 0x75, 0x2f, 0x8a,
-	0xc9, 0x7b, 0x7b, 0x1a, 0x77, 0xe1, 0x78, 0x8d, 0xcb, 0x4a, 0xf3, 0xa6, 0x3d, 0x2d, 0x85, 0x95,
-	0x12, 0x88, 0xd1, 0xb0, 0x2d, 0x18, 0xf5, 0x62, 0xda, 0x8a, 0x66, 0x0b, 0x17, 0x8a, 0xcf, 0x4e,
-	0x5c, 0xbc, 0x92, 0x57, 0x3d, 0x2b, 0x53, 0x52, 0xe8, 0xe8, 0x1a, 0x63, 0x8f, 0x42, 0x8a, 0xfd,
-	0x7d, 0x30, 0xeb, 0xc7, 0x1a, 0x9c, 0x3c, 0x07, 0x13, 0x51, 0xd0, 0x09, 0x5d, 0x8a, 0xb4, 0x1d,
-	0x44, 0xb3, 0xd6, 0x85, 0x22, 0x1b, 0x7a, 0x6c, 0xa4, 0x6e, 0x6b, 0x30, 0x9a, 0x34, 0xe4, 0xd3,
-	0x16, 0x4c, 0x56, 0x69, 0x14, 0x7b, 0x3e, 0x97, 0x9f, 0x28, 0xbf, 0x33, 0xb4, 0xf2, 0x09, 0x70,
-	0x45, 0x33, 0xaf, 0x9c, 0x95, 0x15, 0x99, 0x34, 0x80, 0x11, 0xa6, 0xe4, 0xb3, 0x19, 0x57, 0xa5,
-	0x91, 0x1b, 0x7a, 0x6d, 0xf6, 0xcd, 0xc7, 0x8c, 0x31, 0xe3, 0x56, 0x34, 0x0a, 0x4d, 0x3a, 0xe2,
-	0xc3, 0x28, 0x9b, 0x51, 0xd1, 0xec, 0x08, 0xd7, 0x7f, 0x6d, 0x38, 0xfd, 0x65, 0xa3, 0xb2, 0xc9,
-	0xaa, 0x5b, 0x9f, 0x7d, 0x45, 0x28, 0xc4, 0x90, 0x4f, 0x59, 0x30, 0x2b, 0x67, 0x3c, 0x52, 0xd1,
-	0xa0, 0xb7, 0x1b, 0x5e, 0x4c, 0x9b, 0x5e, 0x14, 0xcf, 0x8e, 0x72, 0x1d, 0x16, 0x8f, 0x37, 0xb6,
-	0x2e, 0x87, 0x41, 0xa7, 0x7d, 0xcd, 0xf3, 0xab, 0x95, 0x0b, 0x52, 0xd2, 0xec, 0xf2, 0x00, 0xc6,
-	0x38, 0x50, 0x24, 0xf9, 0x9c, 0x05, 0x73, 0xbe, 0xd3, 0xa2, 0x51, 0xdb, 0x61, 0x5d, 0x2b, 0xd0,
-	0x95, 0xa6, 0xe3, 0xee, 0x71, 0x8d, 0xc6, 0x1e, 0x4e, 0x23, 0x5b, 0x6a, 0x34, 0xb7, 0x31, 0x90,
-	0x35, 0x3e, 0x40, 0x2c, 0xf9, 0x4d, 0x0b, 0x66, 0x82, 0xb0, 0xdd, 0x70, 0x7c, 0x5a, 0x4d, 0xb0,
-	0xd1, 0xec, 0x38, 0x9f, 0x7a, 0xef, 0x1b, 0xae, 0x8b, 0x36, 0xb3, 0x6c, 0xaf, 0x07, 0xbe, 0x17,
-	0x07, 0xe1, 0x36, 0x8d, 0x63, 0xcf, 0xaf, 0x47, 0x95, 0x27, 0xef, 0x1d, 0xce, 0xcf, 0xf4, 0x50,
-	0x61, 0xaf, 0x3e, 0xe4, 0x03, 0x30, 0x11, 0x75, 0x7d, 0xf7, 0xb6, 0xe7, 0x57, 0x83, 0xbb, 0xd1,
-	0x6c, 0x29, 0x8f, 0xe9, 0xbb, 0xad, 0x18, 0xca, 0x09, 0xa8, 0x05, 0xa0, 0x29, 0xad, 0x7f, 0xc7,
-	0xe9, 0xa1, 0x54, 0xce, 0xbb, 0xe3, 0xf4, 0x60, 0x7a, 0x80, 0x58, 0xf2, 0x71, 0x0b, 0xa6, 0x22,
-	0xaf, 0xee, 0x3b, 0x71, 0x27, 0xa4, 0xd7, 0x68, 0x37, 0x9a, 0x05, 0xae, 0xc8, 0xd5, 0x21, 0x5b,
-	0xc5, 0x60, 0x59, 0x79, 0x52, 0xea, 0x38, 0x65, 0x42, 0x23, 0x4c, 0xcb, 0xed, 0x37, 0xd1, 0xf4,
-	0xb0, 0x9e, 0xc8, 0x77, 0xa2, 0xe9, 0x41, 0x3d, 0x50, 0xa4, 0xfd, 0x67, 0x05, 0x38, 0x9d, 0x5d,
-	0x83, 0xc8, 0x6f, 0x5b, 0x70, 0xea, 0xce, 0xdd, 0x78, 0x27, 0xd8, 0xa3, 0x7e, 0x54, 0xe9, 0x32,
-	0x4b, 0xc1, 0xad, 0xef, 0xc4, 0x45, 0x37, 0xdf, 0xd5, 0x6e, 0xe1, 0x6a, 0x5a, 0xca, 0xaa, 0x1f,
-	0x87, 0xdd, 0xca, 0x53, 0xb2, 0x3e, 0xa7, 0xae, 0xde, 0xde, 0x31, 0xb1, 0x98, 0x55, 0x6a, 0xee,
-	0x13, 0x16, 0x9c, 0xed, 0xc7, 0x82, 0x9c, 0x86, 0xe2, 0x1e, 0xed, 0x0a, 0x07, 0x07, 0xd9, 0x4f,
-	0xf2, 0xb3, 0x30, 0xba, 0xef, 0x34, 0x3b, 0x54, 0x3a, 0x0a, 0x97, 0x87, 0xab, 0x88, 0xd2, 0x0c,
-	0x05, 0xd7, 0xb7, 0x17, 0x5e, 0xb0, 0xec, 0xbf, 0x2c, 0xc2, 0x84, 0xb1, 0x54, 0x3c, 0x06, 0xe7,
-	0x27, 0x48, 0x39, 0x3f, 0xd7, 0x73, 0x5b, 0xe5, 0x06, 0x7a, 0x3f, 0x77, 0x33, 0xde, 0xcf, 0x66,
-	0x7e, 0x22, 0x1f, 0xe8, 0xfe, 0x90, 0x18, 0xca, 0x41, 0x9b, 0x39, 0xb7, 0x6c, 0x15, 0x1d, 0xc9,
-	0xa3, 0x0b, 0x37, 0x13, 0x76, 0x95, 0xa9, 0x7b, 0x87, 0xf3, 0x65, 0xf5, 0x89, 0x5a, 0x90, 0xfd,
-	0x0d, 0x0b, 0xce, 0x1a, 0x3a, 0x2e, 0x07, 0x7e, 0xd5, 0xe3, 0x5d, 0x7b, 0x01, 0x46, 0xe2, 0x6e,
-	0x3b, 0xf1, 0xa0, 0x55, 0x4b, 0xed, 0x74, 0xdb, 0x14, 0x39, 0x86, 0xf9, 0xcc, 0x2d, 0x1a, 0x45,
-	0x4e, 0x9d, 0x66, 0x7d, 0xe6, 0xeb, 0x02, 0x8c, 0x09, 0x9e, 0x84, 0x40, 0x9a, 0x4e, 0x14, 0xef,
-	0x84, 0x8e, 0x1f, 0x71, 0xf6, 0x3b, 0x5e, 0x8b, 0xca, 0x06, 0xfe, 0x7f, 0xc7, 0x1b, 0x31, 0xac,
-	0x44, 0xe5, 0xdc, 0xbd, 0xc3, 0x79, 0xb2, 0xde, 0xc3, 0x09, 0xfb, 0x70, 0xb7, 0x3f, 0x67, 0xc1,
-	0xb9, 0xfe, 0x6e, 0x0d, 0x79, 0x23, 0x8c, 0x45, 0x34, 0xdc, 0xa7, 0xa1, 0xac, 0x9d, 0xee, 0x12,
-	0x0e, 0x45, 0x89, 0x25, 0x8b, 0x50, 0x56, 0x26, 0x57, 0xd6, 0x71, 0x46, 0x92, 0x96, 0xb5, 0x9d,
-	0xd6, 0x34, 0xac, 0xd1, 0xd8, 0x87, 0x74, 0x82, 0x54, 0xa3, 0xf1, 0xfd, 0x06, 0xc7, 0xd8, 0x7f,
-	0x6f, 0xc1, 0x29, 0x43, 0xab, 0xc7, 0xe0, 0xe5, 0xfa, 0x69, 0x2f, 0x77, 0x2d, 0xb7, 0xf1, 0x3c,
-	0xc0, 0xcd, 0xfd, 0xca, 0x18, 0xcc, 0x98, 0xa3, 0x9e, 0x9b, 0x63, 0xbe, 0xc1, 0xa2, 0xed, 0xe0,
-	0x26, 0xae, 0xcb, 0x36, 0xd7, 0x1b, 0x2c, 0x01, 0xc6, 0x04, 0xcf, 0x1a, 0xb1, 0xed, 0xc4, 0x0d,
-	0xd9, 0xe0, 0xaa, 0x11, 0xb7, 0x9c, 0xb8, 0x81, 0x1c, 0x43, 0x5e, 0x82, 0xe9, 0xd8, 0x09, 0xeb,
-	0x34, 0x46, 0xba, 0xef, 0x45, 0xc9, 0x7c, 0x29, 0x57, 0xce, 0x49, 0xda, 0xe9, 0x9d, 0x14, 0x16,
-	0x33, 0xd4, 0xe4, 0x15, 0x18, 0x69, 0xd0, 0x66, 0x4b, 0xfa, 0x35, 0xdb, 0xf9, 0xcd, 0x70, 0x5e,
-	0xd7, 0x2b, 0xb4, 0xd9, 0xaa, 0x94, 0x98, 0xca, 0xec, 0x17, 0x72, 0x51, 0xe4, 0x17, 0x2d, 0x28,
-	0xef, 0x75, 0xa2, 0x38, 0x68, 0x79, 0xef, 0xa7, 0xb3, 0x25, 0x2e, 0xf8, 0x67, 0x72, 0x16, 0x7c,
-	0x2d, 0xe1, 0x2f, 0xe6, 0xbb, 0xfa, 0x44, 0x2d, 0x99, 0x7c, 0x10, 0xc6, 0xf7, 0xa2, 0xc0, 0xf7,
-	0x29, 0xf3, 0x54, 0x98, 0x12, 0xb7, 0xf2, 0x56, 0x42, 0x70, 0xaf, 0x4c, 0xb0, 0xbe, 0x95, 0x1f,
-	0x98, 0xc8, 0xe4, 0xcd, 0x50, 0xf5, 0x42, 0xea, 0xc6, 0x41, 0xd8, 0x9d, 0x85, 0x47, 0xd2, 0x0c,
-	0x2b, 0x09, 0x7f, 0xd1, 0x0c, 0xea, 0x13, 0xb5, 0x64, 0xd2, 0x85, 0xb1, 0x76, 0xb3, 0x53, 0xf7,
-	0xfc, 0xd9, 0x09, 0xae, 0xc3, 0xcd, 0x9c, 0x75, 0xd8, 0xe2, 0xcc, 0x2b, 0xc0, 0x8c, 0x8a, 0xf8,
-	0x8d, 0x52, 0x20, 0x79, 0x06, 0x46, 0xdd, 0x86, 0x13, 0xc6, 0xb3, 0x93, 0x7c, 0xcc, 0xaa, 0x49,
-	0xb4, 0xcc, 0x80, 0x28, 0x70, 0xf6, 0xaf, 0x17, 0x60, 0x6e, 0x70, 0xc5, 0xc4, 0x6c, 0x72, 0x3b,
-	0x61, 0x24, 0xec, 0x73, 0xc9, 0x9c, 0x4d, 0x1c, 0x8c, 0x09, 0x9e, 0x7c, 0xd4, 0x82, 0xf1, 0x3b,
-	0xb2, 0xc7, 0x0b, 0x8f, 0xa4, 0xc7, 0xaf, 0xca, 0x1e, 0x57, 0x3a, 0x5c, 0x4d, 0x7a, 0x5d, 0xca,
-	0x65, 0xea, 0xd2, 0x03, 0xb7, 0xd9, 0xa9, 0x26, 0x96, 0x51, 0x91, 0xae, 0x0a, 0x30, 0x26, 0x78,
-	0x46, 0xea, 0xf9, 0x82, 0x74, 0x24, 0x4d, 0xba, 0xe6, 0x4b, 0x52, 0x89, 0xb7, 0xbf, 0x5b, 0x84,
-	0x27, 0xfb, 0x4e, 0x3e, 0xb2, 0x00, 0xc0, 0x7d, 0x96, 0x4b, 0x1e, 0xdb, 0x60, 0x8a, 0x5d, 0xf5,
-	0x34, 0x73, 0x31, 0x6e, 0x29, 0x28, 0x1a, 0x14, 0xe4, 0xc3, 0x00, 0x6d, 0x27, 0x74, 0x5a, 0x34,
-	0xa6, 0x61, 0x62, 0x27, 0xaf, 0x0d, 0xd7, 0x4a, 0x4c, 0x8f, 0xad, 0x84, 0xa7, 0xf6, 0x71, 0x14,
-	0x28, 0x42, 0x43, 0x24, 0xdb, 0x43, 0x87, 0xb4, 0x49, 0x9d, 0x88, 0x6e, 0xe8, 0xe5, 0x43, 0xed,
-	0xa1, 0x51, 0xa3, 0xd0, 0xa4, 0x63, 0xeb, 0x18, 0xaf, 0x45, 0x24, 0xdb, 0x4a, 0xad, 0x63, 0xbc,
-	0x9e, 0x11, 0x4a, 0x2c, 0x79, 0xcd, 0x82, 0xe9, 0x9a, 0xd7, 0xa4, 0x5a, 0xba, 0xdc, 0xf1, 0x6e,
-	0x0e, 0x5f, 0xc9, 0x4b, 0x26, 0x5f, 0x6d, 0x81, 0x53, 0xe0, 0x08, 0x33, 0xe2, 0x59, 0x37, 0xef,
-	0xd3, 0x90, 0x9b, 0xee, 0xb1, 0x74, 0x37, 0xdf, 0x12, 0x60, 0x4c, 0xf0, 0xf6, 0x17, 0x0b, 0x30,
-	0x3b, 0x68, 0xcc, 0x91, 0x88, 0x8d, 0xac, 0xf8, 0x96, 0x13, 0x46, 0xd2, 0x7d, 0x1f, 0x72, 0x17,
-	0x28, 0xf9, 0xde, 0x72, 0x42, 0x73, 0x8c, 0x72, 0x01, 0x98, 0x48, 0x22, 0x77, 0x60, 0x24, 0x6e,
-	0x3a, 0x39, 0x85, 0x8d, 0x0c, 0x89, 0xda, 0xc9, 0x5a, 0x5f, 0x8a, 0x90, 0xcb, 0x20, 0x4f, 0xc3,
-	0x48, 0xd3, 0xdb, 0x65, 0xce, 0x28, 0x1b, 0xc4, 0x7c, 0x55, 0x59, 0xf7, 0x76, 0x23, 0xe4, 0x50,
-	0xfb, 0xeb, 0x56, 0x9f, 0xb6, 0x91, 0x46, 0x97, 0x0d, 0x2a, 0xea, 0xef, 0x7b, 0x61, 0xe0, 0xb7,
-	0xa8, 0x1f, 0x67, 0x43, 0xa1, 0xab, 0x1a, 0x85, 0x26, 0x1d, 0xf9, 0x05, 0xab, 0xcf, 0x6c, 0x18,
-	0x32, 0x06, 0x28, 0x55, 0x3a, 0xf6, 0x84, 0xb0, 0xff, 0x7d, 0xac, 0x8f, 0xfd, 0x53, 0x0b, 0x1a,
-	0xb9, 0x08, 0xc0, 0xbc, 0xa9, 0xad, 0x90, 0xd6, 0xbc, 0x03, 0x59, 0x33, 0xc5, 0x72, 0x43, 0x61,
-	0xd0, 0xa0, 0x4a, 0xca, 0x6c, 0x77, 0x6a, 0xac, 0x4c, 0xa1, 0xb7, 0x8c, 0xc0, 0xa0, 0x41, 0x45,
-	0x9e, 0x87, 0x31, 0xaf, 0xe5, 0xd4, 0x69, 0xd2, 0xfe, 0x4f, 0xb3, 0xc9, 0xb5, 0xc6, 0x21, 0xf7,
-	0x0f, 0xe7, 0xa7, 0x95, 0x42, 0x1c, 0x84, 0x92, 0x96, 0xfc, 0x96, 0x05, 0x93, 0x6e, 0xd0, 0x6a,
-	0x05, 0xfe, 0xba, 0xb3, 0x4b, 0x9b, 0x49, 0x88, 0xeb, 0xce, 0xa3, 0x5a, 0xee, 0x17, 0x96, 0x0d,
-	0x61, 0x62, 0x83, 0xa9, 0x02, 0x77, 0x26, 0x0a, 0x53, 0x5a, 0x99, 0x73, 0x70, 0xf4, 0xc1, 0x73,
-	0x90, 0xfc, 0xa1, 0x05, 0x33, 0xa2, 0xec, 0x92, 0xef, 0x07, 0xb1, 0x8c, 0x3c, 0x8a, 0x18, 0x55,
-	0xf0, 0x88, 0xab, 0x65, 0x48, 0x14, 0x75, 0x7b, 0x9d, 0x54, 0x73, 0xa6, 0x07, 0x8f, 0xbd, 0x4a,
-	0x92, 0xcb, 0x30, 0x53, 0x0b, 0x42, 0x97, 0x9a, 0x0d, 0xc1, 0x1d, 0xbf, 0x92, 0x66, 0x74, 0x29,
-	0x4b, 0x80, 0xbd, 0x65, 0xc8, 0x2d, 0x38, 0x67, 0x00, 0xcd, 0x76, 0x28, 0x71, 0x6e, 0xe7, 0x25,
-	0xb7, 0x73, 0x97, 0xfa, 0x52, 0xe1, 0x80, 0xd2, 0x73, 0xef, 0x84, 0x99, 0x9e, 0xfe, 0xeb, 0xb3,
-	0xbb, 0x3f, 0x6b, 0xee, 0xee, 0xcb, 0xc6, 0xa6, 0x7c, 0x6e, 0x05, 0xce, 0xf5, 0x6f, 0xa9, 0x93,
-	0x70, 0xb1, 0x7f, 0xd5, 0x82, 0xa7, 0x06, 0xb8, 0x31, 0x6a, 0x5b, 0x63, 0x0d, 0xda, 0xd6, 0x10,
-	0x07, 0x8a, 0xd4, 0xdf, 0x97, 0xc6, 0xe2, 0xd2, 0x70, 0x23, 0x62, 0xd5, 0xdf, 0x17, 0x1d, 0x3d,
-	0x7e, 0xef, 0x70, 0xbe, 0xb8, 0xea, 0xef, 0x23, 0xe3, 0x6d, 0x7f, 0x7e, 0x2c, 0xb5, 0x73, 0xda,
-	0x4e, 0x36, 0xeb, 0x5c, 0x51, 0xb9, 0x6f, 0xda, 0xcc, 0x79, 0x2c, 0x1a, 0x3b, 0x43, 0x11, 0x82,
-	0x97, 0xe2, 0xc8, 0x27, 0x2c, 0x1e, 0xf5, 0x4e, 0x76, 0x94, 0xd2, 0xb3, 0x7a, 0x34, 0x41, 0x78,
-	0x33, 0x96, 0x9e, 0x00, 0xd1, 0x94, 0xce, 0x66, 0x72, 0x5b, 0x04, 0x9d, 0xb2, 0xfe, 0x55, 0x12,
-	0x17, 0x4f, 0xf0, 0xe4, 0x00, 0x20, 0xea, 0xfa, 0xee, 0x56, 0xd0, 0xf4, 0xdc, 0xae, 0x0c, 0x33,
-	0xe4, 0x10, 0x39, 0x15, 0xfc, 0x84, 0x93, 0xa5, 0xbf, 0xd1, 0x90, 0x45, 0xbe, 0x64, 0xc1, 0x8c,
-	0x57, 0xf7, 0x83, 0x90, 0xae, 0x78, 0xb5, 0x1a, 0x0d, 0xa9, 0xef, 0xd2, 0xc4, 0x0f, 0xb9, 0x3d,
-	0x9c, 0x06, 0x49, 0xd0, 0x6f, 0x2d, 0xcb, 0x5e, 0x4f, 0xf1, 0x1e, 0x14, 0xf6, 0x2a, 0x43, 0xaa,
-	0x30, 0xe2, 0xf9, 0xb5, 0x40, 0x1a, 0xb6, 0xca, 0x70, 0x4a, 0xad, 0xf9, 0xb5, 0x40, 0xcf, 0x15,
-	0xf6, 0x85, 0x9c, 0x3b, 0x59, 0x87, 0xb3, 0xa1, 0xdc, 0x89, 0x5e, 0xf1, 0x22, 0xe6, 0xcf, 0xaf,
-	0x7b, 0x2d, 0x2f, 0xe6, 0x46, 0xa9, 0x58, 0x99, 0xbd, 0x77, 0x38, 0x7f, 0x16, 0xfb, 0xe0, 0xb1,
-	0x6f, 0x29, 0xfb, 0xd5, 0x72, 0x7a, 0xbb, 0x2d, 0x82, 0x49, 0x1f, 0x84, 0x72, 0xa8, 0xc2, 0xf7,
-	0xc2, 0x33, 0x5a, 0xcf, 0xa7, 0x8d, 0x65, 0x14, 0x4b, 0xc5, 0x41, 0x74, 0xa0, 0x5e, 0x4b, 0x64,
-	0x1e, 0x12, 0xeb, 0x79, 0x39, 0x2d, 0x72, 0x18, 0x5f, 0x52, 0xaa, 0x0e, 0xd8, 0x75, 0x7d, 0x17,
-	0xb9, 0x0c, 0x12, 0xc2, 0x58, 0x83, 0x3a, 0xcd, 0xb8, 0x21, 0xe3, 0x49, 0x57, 0x87, 0xf5, 0x69,
-	0x19, 0xaf, 0x6c, 0xac, 0x4e, 0x40, 0x51, 0x4a, 0x22, 0x07, 0x30, 0xde, 0x10, 0x9d, 0x20, 0xd7,
-	0xf6, 0xeb, 0xc3, 0x36, 0x6e, 0xaa, 0x67, 0xf5, 0xfc, 0x95, 0x00, 0x4c, 0xc4, 0x91, 0x5f, 0xb2,
-	0x00, 0xdc, 0x24, 0x48, 0x97, 0x4c, 0x1f, 0xcc, 0xcd, 0xee, 0xa8, 0xf8, 0x9f, 0x76, 0x8d, 0x14,
-	0x28, 0x42, 0x43, 0x32, 0x79, 0x19, 0x26, 0x43, 0xea, 0x06, 0xbe, 0xeb, 0x35, 0x69, 0x75, 0x29,
-	0xe6, 0x6e, 0xfc, 0xc9, 0x82, 0x79, 0xa7, 0x99, 0x7f, 0x82, 0x06, 0x0f, 0x4c, 0x71, 0x24, 0xaf,
-	0x5a, 0x30, 0xad, 0x02, 0x95, 0xac, 0x43, 0xa8, 0x0c, 0xd8, 0xac, 0xe7, 0x14, 0x16, 0xe5, 0x3c,
-	0x2b, 0x84, 0x6d, 0x57, 0xd2, 0x30, 0xcc, 0xc8, 0x25, 0xef, 0x06, 0x08, 0x76, 0x79, 0x50, 0x90,
-	0x55, 0xb5, 0x74, 0xe2, 0xaa, 0x4e, 0x8b, 0xf8, 0x76, 0xc2, 0x01, 0x0d, 0x6e, 0xe4, 0x1a, 0x80,
-	0x98, 0x36, 0x3b, 0xdd, 0x36, 0xe5, 0x41, 0x99, 0x72, 0xe5, 0xcd, 0x49, 0xe3, 0x6f, 0x2b, 0xcc,
-	0xfd, 0xc3, 0xf9, 0xde, 0xdd, 0x2e, 0x8f, 0xc6, 0x1a, 0xc5, 0xc9, 0x07, 0x60, 0x3c, 0xea, 0xb4,
-	0x5a, 0x8e, 0x0a, 0xae, 0x6c, 0xe5, 0xb7, 0x22, 0x0a, 0xbe, 0x7a, 0x6c, 0x4a, 0x00, 0x26, 0x12,
-	0x6d, 0x1f, 0x48, 0x2f, 0x3d, 0x79, 0x1e, 0x26, 0xe9, 0x41, 0x4c, 0x43, 0xdf, 0x69, 0xde, 0xc4,
-	0xf5, 0x64, 0x3b, 0xce, 0x3b, 0x7f, 0xd5, 0x80, 0x63, 0x8a, 0x8a, 0xd8, 0xca, 0xf3, 0x2e, 0x70,
-	0x7a, 0xd0, 0x9e, 0x77, 0xe2, 0x67, 0xdb, 0xff, 0x5d, 0x48, 0x79, 0x04, 0x3b, 0x21, 0xa5, 0x24,
-	0x80, 0x51, 0x3f, 0xa8, 0x2a, 0xa3, 0x77, 0x35, 0x1f, 0xa3, 0xb7, 0x11, 0x54, 0x8d, 0x73, 0x65,
-	0xf6, 0x15, 0xa1, 0x90, 0xc3, 0x0f, 0xde, 0x92, 0x13, 0x4a, 0x8e, 0x90, 0x4e, 0x50, 0x9e, 0x92,
-	0xd5, 0xc1, 0xdb, 0xa6, 0x29, 0x08, 0xd3, 0x72, 0xc9, 0x1e, 0x8c, 0x36, 0x82, 0x28, 0x16, 0x7b,
-	0x95, 0xa1, 0xbd, 0xb0, 0x2b, 0x41, 0x14, 0xf3, 0x25, 0x4c, 0x55, 0x9b, 0x41, 0x22, 0x14, 0x32,
-	0xec, 0xef, 0x59, 0xa9, 0xe0, 0xcb, 0x6d, 0x27, 0x76, 0x1b, 0xab, 0xfb, 0x6c, 0xff, 0x78, 0x2d,
-	0x75, 0x70, 0xf0, 0x13, 0xe6, 0xc1, 0xc1, 0xfd, 0xc3, 0xf9, 0x37, 0x0d, 0x4a, 0xf4, 0xb9, 0xcb,
-	0x38, 0x2c, 0x70, 0x16, 0xc6, 0x19, 0xc3, 0x47, 0x2c, 0x98, 0x30, 0xd4, 0x93, 0x0b, 0x4a, 0x8e,
-	0x31, 0x6c, 0xe5, 0x5c, 0x19, 0x40, 0x34, 0x45, 0xda, 0x9f, 0xb5, 0x60, 0xbc, 0xe2, 0xb8, 0x7b,
-	0x41, 0xad, 0x46, 0xde, 0x02, 0xa5, 0x6a, 0x47, 0x1e, 0xd1, 0x88, 0xfa, 0xa9, 0xc8, 0xfb, 0x8a,
-	0x84, 0xa3, 0xa2, 0x60, 0x63, 0xb8, 0xe6, 0xb8, 0x71, 0x10, 0x72, 0xb5, 0x8b, 0x62, 0x0c, 0x5f,
-	0xe2, 0x10, 0x94, 0x18, 0xb6, 0x49, 0x6f, 0x39, 0x07, 0x49, 0xe1, 0x6c, 0xe4, 0xe7, 0xba, 0x46,
-	0xa1, 0x49, 0x67, 0xff, 0x69, 0x19, 0xc6, 0xe5, 0x59, 0xe8, 0xb1, 0x4f, 0x33, 0x12, 0x2f, 0xbe,
-	0x30, 0xd0, 0x8b, 0x8f, 0x60, 0xcc, 0xe5, 0x69, 0x54, 0x72, 0x29, 0x1d, 0x32, 0x06, 0x26, 0x15,
-	0x14, 0x99, 0x59, 0x5a, 0x2d, 0xf1, 0x8d, 0x52, 0x14, 0xf9, 0x8c, 0x05, 0xa7, 0xdc, 0xc0, 0xf7,
-	0xa9, 0xab, 0xed, 0xfc, 0x48, 0x1e, 0xa7, 0x7d, 0xcb, 0x69, 0xa6, 0xfa, 0xd0, 0x35, 0x83, 0xc0,
-	0xac, 0x78, 0xf2, 0x22, 0x4c, 0x89, 0x36, 0xbb, 0x95, 0xda, 0x1f, 0xeb, 0xf3, 0x6f, 0x13, 0x89,
-	0x69, 0x5a, 0xb2, 0x20, 0xe2, 0x0c, 0xfc, 0x40, 0x48, 0xec, 0x91, 0x65, 0xf0, 0x51, 0x9d, 0x18,
-	0x45, 0x68, 0x50, 0x90, 0x10, 0x48, 0x48, 0x6b, 0x21, 0x8d, 0x1a, 0x48, 0x5f, 0xe9, 0xd0, 0x28,
-	0xe6, 0x6b, 0xcc, 0xf8, 0xc3, 0x9d, 0x8d, 0x61, 0x0f, 0x27, 0xec, 0xc3, 0x9d, 0xec, 0x49, 0x47,
-	0xb7, 0x94, 0xc7, 0x74, 0x92, 0xdd, 0x3c, 0xd0, 0xdf, 0x9d, 0x87, 0xd1, 0xa8, 0xe1, 0x84, 0x55,
-	0xbe, 0xb6, 0x15, 0x2b, 0x65, 0x66, 0x4b, 0xb6, 0x19, 0x00, 0x05, 0x9c, 0xac, 0xc0, 0xe9, 0xcc,
-	0xe9, 0x7d, 0xc4, 0x57, 0xaf, 0x52, 0x65, 0x56, 0xb2, 0x3b, 0x9d, 0x39, 0xf7, 0x8f, 0xb0, 0xa7,
-	0x84, 0xb9, 0x09, 0x9a, 0x38, 0x62, 0x13, 0xd4, 0x85, 0xb1, 0xa6, 0x08, 0x04, 0x4c, 0x72, 0x53,
-	0x79, 0x23, 0x97, 0x06, 0x58, 0x30, 0x03, 0x30, 0x6a, 0xb4, 0xcb, 0x80, 0x82, 0x14, 0x48, 0x3e,
-	0xc5, 0x0c, 0x9a, 0x11, 0x3b, 0x98, 0xe2, 0x0a, 0xdc, 0xca, 0x47, 0x81, 0x9e, 0x50, 0x89, 0xb6,
-	0x6e, 0x46, 0x20, 0xc2, 0x94, 0x3f, 0xf7, 0x93, 0x30, 0xf1, 0xb0, 0x71, 0x87, 0x97, 0xe0, 0xf4,
-	0x50, 0x11, 0x87, 0xef, 0x5b, 0x90, 0xf4, 0xeb, 0xb2, 0xe3, 0x36, 0x28, 0x1b, 0x32, 0xe4, 0x25,
-	0x98, 0x56, 0xdb, 0x88, 0xe5, 0xa0, 0x23, 0xe3, 0x96, 0x45, 0x1d, 0x58, 0xc6, 0x14, 0x16, 0x33,
-	0xd4, 0x64, 0x11, 0xca, 0xac, 0x9d, 0x44, 0x51, 0x61, 0x76, 0xd5, 0x56, 0x65, 0x69, 0x6b, 0x4d,
-	0x96, 0xd2, 0x34, 0x24, 0x80, 0x99, 0xa6, 0x13, 0xc5, 0x5c, 0x03, 0xb6, 0xab, 0x78, 0xc8, 0x93,
-	0x69, 0x9e, 0xbc, 0xb4, 0x9e, 0x65, 0x84, 0xbd, 0xbc, 0xed, 0x6f, 0x8c, 0xc0, 0x54, 0xca, 0x32,
-	0xb2, 0x55, 0xa5, 0x13, 0x31, 0xd7, 0x47, 0x85, 0x58, 0xd4, 0xaa, 0x72, 0x53, 0xc2, 0x51, 0x51,
-	0x30, 0xea, 0xb6, 0x13, 0x45, 0x77, 0x83, 0xb0, 0x2a, 0x4d, 0xb9, 0xa2, 0xde, 0x92, 0x70, 0x54,
-	0x14, 0x6c, 0x7d, 0xd9, 0xa5, 0x4e, 0x48, 0x43, 0x9e, 0xcc, 0x91, 0x5d, 0x5f, 0x2a, 0x1a, 0x85,
-	0x26, 0x1d, 0x37, 0xca, 0x71, 0x33, 0x5a, 0x6e, 0x7a, 0xd4, 0x8f, 0x85, 0x9a, 0xf9, 0x18, 0xe5,
-	0x9d, 0xf5, 0x6d, 0x93, 0xa9, 0x36, 0xca, 0x19, 0x04, 0x66, 0xc5, 0x93, 0x8f, 0x59, 0x30, 0xe5,
-	0xdc, 0x8d, 0x74, 0xae, 0x2f, 0xb7, 0xca, 0x43, 0x2f, 0x52, 0xa9, 0xf4, 0xe1, 0xca, 0x0c, 0x33,
-	0xef, 0x29, 0x10, 0xa6, 0x85, 0x92, 0x2f, 0x58, 0x40, 0xe8, 0x01, 0x75, 0xb7, 0xc2, 0x60, 0xdf,
-	0xab, 0x26, 0x7d, 0x28, 0xb7, 0x3f, 0x43, 0x7a, 0xdb, 0xab, 0x3d, 0x7c, 0x85, 0x55, 0xef, 0x85,
-	0x63, 0x1f, 0x1d, 0xec, 0xbf, 0x2d, 0xc2, 0x84, 0x61, 0x8c, 0xfb, 0xae, 0xac, 0xd6, 0x0f, 0xd9,
-	0xca, 0x5a, 0x38, 0xc1, 0xca, 0xfa, 0x61, 0x28, 0xbb, 0x89, 0xa1, 0xc8, 0x27, 0x37, 0x39, 0x6b,
-	0x7e, 0xb4, 0xad, 0x50, 0x20, 0xd4, 0x32, 0xc9, 0x65, 0x98, 0x31, 0xd8, 0x48, 0x23, 0x33, 0xc2,
-	0x8d, 0x8c, 0x0a, 0x34, 0x2d, 0x65, 0x09, 0xb0, 0xb7, 0x0c, 0x79, 0x8e, 0x79, 0xb5, 0x9e, 0xac,
-	0x97, 0xd8, 0xc5, 0xcb, 0xbc, 0xdf, 0xa5, 0xad, 0xb5, 0x04, 0x8c, 0x26, 0x8d, 0xfd, 0x0d, 0x4b,
-	0x75, 0xee, 0x63, 0x48, 0x1a, 0xb9, 0x93, 0x4e, 0x1a, 0x59, 0xcd, 0xa5, 0x99, 0x07, 0x24, 0x8c,
-	0x6c, 0xc0, 0xf8, 0x72, 0xd0, 0x6a, 0x39, 0x7e, 0x95, 0xbc, 0x01, 0xc6, 0x5d, 0xf1, 0x53, 0x6e,
-	0x13, 0x79, 0x16, 0x81, 0xc4, 0x62, 0x82, 0x23, 0x4f, 0xc3, 0x88, 0x13, 0xd6, 0x93, 0xad, 0x21,
-	0x3f, 0x14, 0x5b, 0x0a, 0xeb, 0x11, 0x72, 0xa8, 0xfd, 0xb9, 0x02, 0xc0, 0x72, 0xd0, 0x6a, 0x3b,
-	0x21, 0xad, 0xee, 0x04, 0xff, 0x1b, 0x23, 0x16, 0x3b, 0x86, 0x4f, 0x5a, 0x40, 0x58, 0xab, 0x04,
-	0x3e, 0xf5, 0xf5, 0x41, 0x1c, 0x5b, 0x2f, 0xdd, 0x04, 0x2a, 0x17, 0x1f, 0x3d, 0x07, 0x12, 0x04,
-	0x6a, 0x9a, 0x63, 0xec, 0x22, 0x9e, 0x49, 0x56, 0xfc, 0x62, 0x3a, 0xc1, 0x81, 0x1f, 0x4a, 0x4b,
-	0x07, 0xc0, 0xfe, 0x7c, 0x01, 0xce, 0x09, 0xb3, 0x75, 0xdd, 0xf1, 0x9d, 0x3a, 0x6d, 0x31, 0xad,
-	0x8e, 0x7b, 0xda, 0xe0, 0x32, 0xf7, 0xd5, 0x4b, 0xf2, 0x19, 0x86, 0x1d, 0x9c, 0x62, 0x50, 0x89,
-	0x61, 0xb4, 0xe6, 0x7b, 0x31, 0x72, 0xe6, 0x24, 0x82, 0x52, 0x72, 0xdb, 0x44, 0x1a, 0x9b, 0x9c,
-	0x04, 0xa9, 0x79, 0x77, 0x59, 0xb2, 0x47, 0x25, 0xc8, 0xfe, 0x8a, 0x05, 0x59, 0x23, 0xca, 0xf7,
-	0x77, 0x22, 0x23, 0x31, 0xbb, 0xbf, 0x4b, 0x27, 0x10, 0x9e, 0x20, 0x1f, 0xef, 0xbd, 0x30, 0xe1,
-	0xc4, 0x31, 0x6d, 0xb5, 0xc5, 0x66, 0xa3, 0xf8, 0x70, 0x01, 0xad, 0xeb, 0x41, 0xd5, 0xab, 0x79,
-	0x7c, 0x93, 0x61, 0xb2, 0xb3, 0x6f, 0x40, 0x29, 0x39, 0xc3, 0x39, 0x46, 0x67, 0x3e, 0x93, 0x72,
-	0x10, 0x07, 0x0c, 0x97, 0xfb, 0x05, 0xe8, 0xb3, 0x0a, 0xb2, 0x2a, 0x6b, 0x7b, 0x91, 0xaa, 0xf2,
-	0xc9, 0x6c, 0x06, 0x39, 0x10, 0xe7, 0x57, 0x22, 0x72, 0xf2, 0xae, 0xbc, 0x57, 0x71, 0x7d, 0xa4,
-	0x35, 0x21, 0xf5, 0x53, 0xc7, 0x5a, 0xe4, 0x22, 0x80, 0x36, 0xf3, 0x32, 0x8f, 0x43, 0xc5, 0x5e,
-	0xf5, 0x6a, 0x80, 0x06, 0x15, 0x73, 0xea, 0x3c, 0x3f, 0x8a, 0x9d, 0x66, 0xf3, 0x8a, 0xe7, 0xc7,
-	0x72, 0x77, 0xaa, 0x4c, 0xc0, 0x9a, 0x46, 0xa1, 0x49, 0x37, 0xf7, 0x36, 0xa3, 0x5f, 0x4e, 0xe2,
-	0xa8, 0x7f, 0xb2, 0x00, 0xd3, 0x97, 0xfd, 0xce, 0xd6, 0xe5, 0xad, 0xce, 0x6e, 0xd3, 0x73, 0xaf,
-	0xd1, 0x2e, 0xeb, 0xb4, 0x3d, 0xda, 0x5d, 0x5b, 0x91, 0xcd, 0xae, 0x3a, 0xed, 0x1a, 0x03, 0xa2,
-	0xc0, 0x31, 0x35, 0x6b, 0x9e, 0x5f, 0xa7, 0x61, 0x3b, 0xf4, 0xa4, 0x37, 0x6e, 0xa8, 0x79, 0x49,
-	0xa3, 0xd0, 0xa4, 0x63, 0xbc, 0x83, 0xbb, 0x3e, 0x0d, 0xb3, 0xf6, 0x63, 0x93, 0x01, 0x51, 0xe0,
-	0x18, 0x51, 0x1c, 0x76, 0xa2, 0x58, 0xb6, 0x98, 0x22, 0xda, 0x61, 0x40, 0x14, 0x38, 0x36, 0x3c,
-	0xa2, 0xce, 0x2e, 0x8f, 0xab, 0x66, 0x4e, 0xb8, 0xb7, 0x05, 0x18, 0x13, 0x3c, 0x23, 0xdd, 0xa3,
-	0xdd, 0x15, 0xb6, 0x9a, 0x66, 0x12, 0x52, 0xae, 0x09, 0x30, 0x26, 0x78, 0xfb, 0x1f, 0x2d, 0x20,
-	0xe9, 0xe6, 0x78, 0x0c, 0x0b, 0xf2, 0x2b, 0xe9, 0x05, 0x79, 0xc8, 0x10, 0x78, 0x5a, 0xfd, 0x01,
-	0xeb, 0xf2, 0x6f, 0x58, 0x30, 0x69, 0x9e, 0x86, 0x90, 0x7a, 0xc6, 0x10, 0x6d, 0xa6, 0x0d, 0xd1,
-	0xfd, 0xc3, 0xf9, 0x9f, 0xea, 0x77, 0x19, 0xb2, 0xee, 0xc5, 0x41, 0x3b, 0x7a, 0x2b, 0xf5, 0xeb,
-	0x9e, 0x4f, 0x79, 0xac, 0x4f, 0x9c, 0xa2, 0xa4, 0x8e, 0x5a, 0x96, 0x83, 0x2a, 0x7d, 0x08, 0x4b,
-	0x66, 0xdf, 0x86, 0x99, 0x9e, 0x2c, 0xa4, 0x63, 0x18, 0x9d, 0x23, 0x73, 0x4c, 0xed, 0x4f, 0x59,
-	0x30, 0x95, 0x4a, 0xe2, 0xca, 0xc9, 0x94, 0xf1, 0x59, 0x11, 0xf0, 0x83, 0xb4, 0xd0, 0xf3, 0x45,
-	0xa4, 0xad, 0x64, 0xcc, 0x0a, 0x8d, 0x42, 0x93, 0xce, 0xfe, 0x6c, 0x01, 0x4a, 0x49, 0x4c, 0xf6,
-	0x18, 0xaa, 0x7c, 0xc2, 0x82, 0x29, 0xb5, 0x35, 0xe6, 0x0e, 0x73, 0x2e, 0x89, 0x3c, 0x4c, 0x03,
-	0x75, 0xda, 0xca, 0x1c, 0x66, 0xe5, 0xb9, 0xa3, 0x29, 0x0c, 0xd3, 0xb2, 0xc9, 0x2d, 0x80, 0xa8,
-	0x1b, 0xc5, 0xb4, 0x65, 0xb8, 0xee, 0xb6, 0x31, 0x3b, 0x16, 0xdc, 0x20, 0xa4, 0x6c, 0x2e, 0x6c,
-	0x04, 0x55, 0xba, 0xad, 0x28, 0xb5, 0x21, 0xd4, 0x30, 0x34, 0x38, 0xd9, 0xbf, 0x5b, 0x80, 0xd3,
-	0x59, 0x95, 0xc8, 0x7b, 0x60, 0x32, 0x91, 0x6e, 0xdc, 0x01, 0x4d, 0x02, 0xd1, 0x93, 0x68, 0xe0,
-	0xee, 0x1f, 0xce, 0xcf, 0xf7, 0x5e, 0x82, 0x5d, 0x30, 0x49, 0x30, 0xc5, 0x4c, 0xc4, 0x27, 0x64,
-	0x20, 0xad, 0xd2, 0x5d, 0x6a, 0xb7, 0x65, 0x90, 0xc1, 0x88, 0x4f, 0x98, 0x58, 0xcc, 0x50, 0x93,
-	0x2d, 0x38, 0x6b, 0x40, 0x36, 0xa8, 0x57, 0x6f, 0xec, 0x06, 0xa1, 0xb8, 0x6c, 0x50, 0xac, 0x3c,
-	0x2d, 0xb9, 0x9c, 0xc5, 0x3e, 0x34, 0xd8, 0xb7, 0x24, 0x79, 0x0b, 0x94, 0x5c, 0xa7, 0xed, 0xb8,
-	0x5e, 0xdc, 0x95, 0x7b, 0x11, 0x65, 0x47, 0x96, 0x25, 0x1c, 0x15, 0x85, 0x7d, 0x1d, 0x46, 0x8e,
-	0x39, 0x82, 0x8e, 0xb5, 0x2e, 0xdf, 0x80, 0x12, 0x63, 0xc7, 0xec, 0x46, 0x5e, 0x2c, 0x03, 0x28,
-	0x25, 0x77, 0x4f, 0x88, 0x0d, 0x45, 0xcf, 0x49, 0x42, 0x40, 0xaa, 0x5a, 0x6b, 0x51, 0xd4, 0xe1,
-	0x5e, 0x07, 0x43, 0x92, 0x67, 0xa0, 0x48, 0x0f, 0xda, 0xd9, 0x58, 0xcf, 0xea, 0x41, 0xdb, 0x0b,
-	0x69, 0xc4, 0x88, 0xe8, 0x41, 0x9b, 0xcc, 0x41, 0xc1, 0xab, 0xca, 0x05, 0x05, 0x24, 0x4d, 0x61,
-	0x6d, 0x05, 0x0b, 0x5e, 0xd5, 0x3e, 0x80, 0xb2, 0xba, 0xec, 0x42, 0xf6, 0x12, 0x3b, 0x6b, 0xe5,
-	0x71, 0x88, 0x92, 0xf0, 0x1d, 0x60, 0x61, 0x3b, 0x00, 0x3a, 0xfd, 0x2f, 0x2f, 0xfb, 0x72, 0x01,
-	0x46, 0xdc, 0x40, 0x66, 0xda, 0x96, 0x34, 0x1b, 0x6e, 0x60, 0x39, 0xc6, 0xbe, 0x0d, 0xd3, 0xd7,
-	0xfc, 0xe0, 0xae, 0xcf, 0x16, 0xbe, 0x4b, 0x1e, 0x6d, 0x56, 0x19, 0xe3, 0x1a, 0xfb, 0x91, 0x5d,
-	0xce, 0x39, 0x16, 0x05, 0x4e, 0xdd, 0x08, 0x29, 0x0c, 0xba, 0x11, 0x62, 0xff, 0xb2, 0x05, 0xa7,
-	0xb3, 0xa9, 0x7e, 0x3f, 0xb0, 0x1d, 0xc6, 0x47, 0x98, 0x32, 0x49, 0x2e, 0xd9, 0x66, 0x5b, 0x9c,
-	0x5a, 0xbf, 0x00, 0x93, 0xbb, 0x1d, 0xaf, 0x59, 0x95, 0xdf, 0x52, 0x1f, 0x95, 0x2d, 0x57, 0x31,
-	0x70, 0x98, 0xa2, 0x64, 0x7e, 0xda, 0xae, 0xe7, 0x3b, 0x61, 0x77, 0x4b, 0xaf, 0x1b, 0xca, 0x3c,
-	0x55, 0x14, 0x06, 0x0d, 0x2a, 0xfb, 0xaf, 0x8b, 0xa0, 0x6f, 0xdd, 0x10, 0x4f, 0x26, 0x45, 0x58,
-	0x79, 0x84, 0xad, 0xb6, 0xbb, 0xbe, 0xab, 0xef, 0xf7, 0x94, 0x32, 0x39, 0x11, 0x1f, 0xb7, 0x98,
-	0x87, 0xe8, 0xc5, 0x9e, 0xc3, 0x8d, 0x85, 0xdc, 0x28, 0x6d, 0xe5, 0x74, 0x6e, 0xbe, 0x26, 0x38,
-	0x07, 0xa1, 0xe9, 0x73, 0x2a, 0x61, 0x68, 0x4a, 0x26, 0x2f, 0xcb, 0x93, 0x86, 0x62, 0x6e, 0x29,
-	0x35, 0xa5, 0xcc, 0xf1, 0x42, 0x1b, 0x46, 0x43, 0x1a, 0x87, 0x49, 0x32, 0xd3, 0xb5, 0x61, 0xcf,
-	0x5d, 0xe3, 0xb0, 0xbb, 0x1d, 0xb3, 0xcd, 0x58, 0xdd, 0x70, 0x8c, 0x38, 0x18, 0x85, 0x20, 0x3b,
-	0x02, 0xd2, 0xdb, 0x16, 0x27, 0x8c, 0xe2, 0x2e, 0x42, 0xd9, 0xe9, 0xc4, 0x41, 0x8b, 0x35, 0x13,
-	0xef, 0x9e, 0x92, 0x11, 0xa7, 0x4e, 0x10, 0xa8, 0x69, 0xec, 0xd7, 0x46, 0x21, 0x93, 0xa5, 0x40,
-	0x0e, 0xcc, 0x1b, 0x63, 0x56, 0xbe, 0x37, 0xc6, 0x94, 0x32, 0xfd, 0x6e, 0x8d, 0x91, 0x3a, 0x8c,
-	0xb6, 0x1b, 0x4e, 0x94, 0xcc, 0xd1, 0x1b, 0x49, 0x33, 0x6d, 0x31, 0xe0, 0xfd, 0xc3, 0xf9, 0x9f,
-	0x3e, 0x9e, 0x1f, 0xc8, 0xc6, 0xea, 0xa2, 0x48, 0xd9, 0xd4, 0xa2, 0x39, 0x0f, 0x14, 0xfc, 0x4d,
-	0x4f, 0xb0, 0x78, 0xc4, 0x9e, 0xf6, 0xa3, 0x96, 0x48, 0x6d, 0x43, 0x1a, 0x75, 0x9a, 0xb1, 0x1c,
-	0x0d, 0x37, 0x72, 0x9c, 0x65, 0x82, 0xb1, 0xce, 0x71, 0x13, 0xdf, 0x68, 0x08, 0x25, 0xef, 0x81,
-	0x72, 0x14, 0x3b, 0x61, 0xfc, 0x90, 0x19, 0x31, 0xaa, 0xd1, 0xb7, 0x13, 0x26, 0xa8, 0xf9, 0x91,
-	0x77, 0x03, 0xd4, 0x3c, 0xdf, 0x8b, 0x1a, 0x0f, 0x79, 0x40, 0xc8, 0x15, 0xbf, 0xa4, 0x38, 0xa0,
-	0xc1, 0x8d, 0x59, 0x37, 0x3e, 0xb6, 0x45, 0x48, 0xb3, 0xc4, 0xd7, 0x52, 0x65, 0xdd, 0x50, 0x61,
-	0xd0, 0xa0, 0xb2, 0x3f, 0x04, 0x67, 0xb2, 0xb7, 0xb5, 0xe5, 0xd6, 0xb0, 0x1e, 0x06, 0x9d, 0x76,
-	0x76, 0x2d, 0xe1, 0xb7, 0x79, 0x51, 0xe0, 0x98, 0x8d, 0xdf, 0xf3, 0xfc, 0x6a, 0xd6, 0xc6, 0x5f,
-	0xf3, 0xfc, 0x2a, 0x72, 0xcc, 0x31, 0xae, 0xd2, 0xfd, 0xb1, 0x05, 0x17, 0x8e, 0xba, 0x54, 0xce,
-	0xb6, 0xfd, 0x77, 0x9d, 0xd0, 0x97, 0xd7, 0x64, 0xb8, 0xed, 0xb8, 0xed, 0x84, 0x3e, 0x72, 0x28,
-	0xe9, 0xc2, 0x98, 0xc8, 0x02, 0x94, 0xde, 0xf1, 0x8d, 0x7c, 0xaf, 0xb8, 0xb3, 0xbd, 0x95, 0x8a,
-	0xd6, 0x88, 0x0c, 0x44, 0x94, 0x02, 0xed, 0xd7, 0x2c, 0x20, 0x9b, 0xfb, 0x34, 0x0c, 0xbd, 0xaa,
-	0x91, 0xb7, 0x48, 0x9e, 0x87, 0xc9, 0x3b, 0xdb, 0x9b, 0x1b, 0x5b, 0x81, 0xe7, 0xf3, 0xf4, 0x7b,
-	0x23, 0x5b, 0xe6, 0xaa, 0x01, 0xc7, 0x14, 0x15, 0x59, 0x86, 0x99, 0x3b, 0xaf, 0xb0, 0x25, 0x67,
-	0xf5, 0xa0, 0x1d, 0xd2, 0x28, 0x52, 0x0f, 0x43, 0x94, 0xc5, 0xc1, 0xd4, 0xd5, 0x1b, 0x19, 0x24,
-	0xf6, 0xd2, 0xdb, 0x5f, 0x2e, 0xc0, 0x84, 0xf1, 0x8e, 0xc2, 0x31, 0xfc, 0x91, 0xcc, 0xd3, 0x0f,
-	0x85, 0x63, 0x3e, 0xfd, 0xf0, 0x2c, 0x94, 0xda, 0x41, 0xd3, 0x73, 0x3d, 0x95, 0x57, 0x3f, 0xc9,
-	0x4f, 0xaf, 0x24, 0x0c, 0x15, 0x96, 0xdc, 0x85, 0xb2, 0xba, 0x10, 0x2d, 0x33, 0xed, 0xf2, 0xf2,
-	0xc8, 0xd4, 0x5c, 0xd3, 0x17, 0x9d, 0xb5, 0x2c, 0x62, 0xc3, 0x18, 0x1f, 0xa8, 0x49, 0x6c, 0x9e,
-	0xa7, 0x6e, 0xf0, 0x11, 0x1c, 0xa1, 0xc4, 0xd8, 0xff, 0x32, 0x0a, 0x65, 0xa4, 0xed, 0x60, 0x39,
-	0xa4, 0xd5, 0x88, 0xbc, 0x1e, 0x8a, 0x9d, 0xb0, 0x29, 0x1b, 0x4b, 0x85, 0x79, 0x6e, 0xe2, 0x3a,
-	0x32, 0x78, 0x6a, 0x75, 0x28, 0x9c, 0xe8, 0x8c, 0xaf, 0x78, 0xe4, 0x19, 0xdf, 0x8b, 0x30, 0x15,
-	0x45, 0x8d, 0xad, 0xd0, 0xdb, 0x77, 0x62, 0x36, 0xe6, 0x64, 0x4c, 0x44, 0x1f, 0xaa, 0x6c, 0x5f,
-	0xd1, 0x48, 0x4c, 0xd3, 0x92, 0xcb, 0x30, 0xa3, 0x4f, 0xda, 0x68, 0x18, 0xf3, 0x10, 0x88, 0x88,
-	0x96, 0xa8, 0x33, 0x0d, 0x7d, 0x36, 0x27, 0x09, 0xb0, 0xb7, 0x0c, 0x59, 0x81, 0xd3, 0x29, 0x20,
-	0x53, 0x44, 0x84, 0x52, 0xd4, 0x29, 0x7e, 0x8a, 0x0f, 0xd3, 0xa5, 0xa7, 0x04, 0xb9, 0x0e, 0x67,
-	0x44, 0xff, 0xf2, 0x8b, 0xf4, 0xaa, 0x46, 0xe3, 0x9c, 0xd1, 0xff, 0x91, 0x8c, 0xce, 0x5c, 0xee,
-	0x25, 0xc1, 0x7e, 0xe5, 0xd8, 0x08, 0x55, 0xe0, 0xb5, 0x15, 0x69, 0xd8, 0xd4, 0x08, 0x55, 0x6c,
-	0xd6, 0xaa, 0x68, 0xd2, 0x91, 0x77, 0xc1, 0x53, 0xfa, 0x53, 0x44, 0xd0, 0xc4, 0x6a, 0xbf, 0x22,
-	0x93, 0x18, 0xe6, 0x25, 0x8b, 0xa7, 0x2e, 0xf7, 0x25, 0xab, 0xe2, 0xa0, 0xf2, 0x64, 0x17, 0xe6,
-	0x14, 0x6a, 0x95, 0xcd, 0xde, 0x76, 0xe8, 0x45, 0xb4, 0xe2, 0x44, 0xf4, 0x66, 0xd8, 0xe4, 0x69,
-	0x0f, 0x65, 0xfd, 0x18, 0xc4, 0x65, 0x2f, 0xbe, 0xd2, 0x8f, 0x12, 0xd7, 0xf1, 0x01, 0x5c, 0x98,
-	0x73, 0x41, 0x7d, 0x67, 0xb7, 0x49, 0x37, 0x97, 0xd7, 0x78, 0x32, 0x84, 0xe1, 0x5c, 0xac, 0x26,
-	0x08, 0xd4, 0x34, 0xca, 0xb5, 0x9f, 0x1c, 0xe8, 0xda, 0x7f, 0xcb, 0x82, 0x29, 0x35, 0xd8, 0x1f,
-	0x43, 0xbc, 0xab, 0x99, 0x8e, 0x77, 0x5d, 0x1e, 0xd6, 0xab, 0x93, 0x9a, 0x0f, 0xd8, 0x88, 0x7d,
-	0xaf, 0x0c, 0xc0, 0x9f, 0xd7, 0xf1, 0x78, 0x92, 0xed, 0x05, 0x18, 0x09, 0x69, 0x3b, 0xc8, 0x5a,
-	0x3e, 0x46, 0x81, 0x1c, 0xf3, 0xc3, 0x3b, 0x9d, 0xfb, 0x9d, 0xf9, 0x8e, 0xfe, 0x60, 0xcf, 0x7c,
-	0xb7, 0xe1, 0x49, 0xcf, 0x8f, 0xa8, 0xdb, 0x09, 0xe5, 0x42, 0x77, 0x25, 0x88, 0x94, 0x75, 0x28,
-	0x55, 0x5e, 0x2f, 0x19, 0x3d, 0xb9, 0xd6, 0x8f, 0x08, 0xfb, 0x97, 0x65, 0x4d, 0x9a, 0x20, 0xe4,
-	0x6d, 0x1e, 0x1d, 0x1e, 0x90, 0x70, 0x54, 0x14, 0x7a, 0x42, 0xac, 0xd7, 0x92, 0xeb, 0x3a, 0x99,
-	0x09, 0xb1, 0x7e, 0x69, 0x1b, 0x35, 0x4d, 0x7f, 0xab, 0x58, 0xce, 0xc9, 0x2a, 0xc2, 0x89, 0xad,
-	0x62, 0x32, 0x3f, 0x27, 0x06, 0x3e, 0xc6, 0x90, 0x2c, 0xd6, 0x93, 0x03, 0x17, 0xeb, 0x97, 0x60,
-	0xda, 0xf3, 0x1b, 0x34, 0xf4, 0x62, 0x5a, 0xe5, 0x73, 0x61, 0x76, 0x8a, 0x37, 0x84, 0x8a, 0x5c,
-	0xad, 0xa5, 0xb0, 0x98, 0xa1, 0x4e, 0x1b, 0x95, 0xe9, 0x63, 0x18, 0x95, 0x01, 0xa6, 0xfc, 0x54,
-	0x3e, 0xa6, 0xfc, 0xf4, 0xf0, 0xa6, 0x7c, 0xe6, 0x91, 0x9a, 0x72, 0x92, 0x8b, 0x29, 0x7f, 0x06,
-	0x46, 0xdb, 0x61, 0x70, 0xd0, 0x9d, 0x3d, 0x93, 0xf6, 0xa6, 0xb7, 0x18, 0x10, 0x05, 0xce, 0x4c,
-	0x7d, 0x3b, 0xfb, 0xe0, 0xd4, 0x37, 0xfb, 0xd5, 0x02, 0x3c, 0xa9, 0x2d, 0x1d, 0x1b, 0x5f, 0x5e,
-	0x8d, 0xcd, 0x75, 0x7e, 0xa7, 0x52, 0xa4, 0x5b, 0x18, 0x41, 0x53, 0x1d, 0x7f, 0x55, 0x18, 0x34,
-	0xa8, 0x78, 0xec, 0x91, 0x86, 0x3c, 0x61, 0x37, 0x6b, 0x06, 0x97, 0x25, 0x1c, 0x15, 0x05, 0x7f,
-	0x9b, 0x8f, 0x86, 0xb1, 0x3c, 0x7b, 0xc9, 0xe6, 0x22, 0x2d, 0x6b, 0x14, 0x9a, 0x74, 0xcc, 0x5d,
-	0x74, 0x93, 0x29, 0xc8, 0x4c, 0xe1, 0xa4, 0x70, 0x17, 0xd5, 0xac, 0x53, 0xd8, 0x44, 0x1d, 0x1e,
-	0x64, 0x1e, 0xed, 0x55, 0x87, 0x07, 0x0d, 0x14, 0x85, 0xfd, 0x5f, 0x16, 0xbc, 0xae, 0x6f, 0x53,
-	0x3c, 0x86, 0xe5, 0xed, 0x20, 0xbd, 0xbc, 0x6d, 0x0f, 0xbf, 0xbc, 0xf5, 0xd4, 0x62, 0xc0, 0x52,
-	0xf7, 0x37, 0x16, 0x4c, 0x6b, 0xfa, 0xc7, 0x50, 0x55, 0x2f, 0xd7, 0x57, 0xf6, 0xb4, 0xea, 0x22,
-	0x91, 0x34, 0x55, 0xb7, 0x6f, 0xf1, 0xba, 0x89, 0xbd, 0xd7, 0x92, 0x9b, 0x3c, 0x63, 0x73, 0xc4,
-	0x26, 0xa6, 0x0b, 0x63, 0xfc, 0xe2, 0x71, 0x94, 0xcf, 0x1e, 0x30, 0x2d, 0x9f, 0x87, 0x41, 0xf5,
-	0x1e, 0x90, 0x7f, 0x46, 0x28, 0x05, 0xf2, 0x74, 0x72, 0x2f, 0x62, 0xf6, 0xb2, 0x2a, 0xc3, 0xb5,
-	0x3a, 0x9d, 0x5c, 0xc2, 0x51, 0x51, 0xd8, 0x2d, 0x98, 0x4d, 0x33, 0x5f, 0xa1, 0x35, 0x1e, 0x6a,
-	0x3b, 0x56, 0x35, 0x17, 0xa1, 0xec, 0xf0, 0x52, 0xeb, 0x1d, 0x27, 0xfb, 0x96, 0xcd, 0x52, 0x82,
-	0x40, 0x4d, 0x63, 0xff, 0x8e, 0x05, 0x67, 0xfa, 0x54, 0x26, 0xc7, 0x30, 0x75, 0xac, 0xad, 0xc0,
-	0x80, 0xf7, 0x85, 0xaa, 0xb4, 0xe6, 0x24, 0xc1, 0x1c, 0xc3, 0xaa, 0xad, 0x08, 0x30, 0x26, 0x78,
-	0xfb, 0x5f, 0x2d, 0x38, 0x95, 0xd6, 0x35, 0x22, 0x57, 0x81, 0x88, 0xca, 0xac, 0x78, 0x91, 0x1b,
-	0xec, 0xd3, 0xb0, 0xcb, 0x6a, 0x2e, 0xb4, 0x9e, 0x93, 0x9c, 0xc8, 0x52, 0x0f, 0x05, 0xf6, 0x29,
-	0xc5, 0xb3, 0x76, 0xab, 0xaa, 0xb5, 0x93, 0x91, 0x72, 0x2b, 0xcf, 0x91, 0xa2, 0x3b, 0xd3, 0xdc,
-	0x41, 0x2b, 0x91, 0x68, 0xca, 0xb7, 0xbf, 0x3d, 0x02, 0xea, 0x1c, 0x8b, 0x87, 0x0d, 0x72, 0x0a,
-	0xba, 0xa4, 0x1e, 0x3c, 0x2a, 0x9e, 0xe0, 0xc1, 0xa3, 0x91, 0x07, 0xc5, 0x08, 0xc4, 0xeb, 0x3b,
-	0xda, 0x17, 0x35, 0x8c, 0xfe, 0x8e, 0x46, 0xa1, 0x49, 0xc7, 0x34, 0x69, 0x7a, 0xfb, 0x54, 0x14,
-	0x1a, 0x4b, 0x6b, 0xb2, 0x9e, 0x20, 0x50, 0xd3, 0x30, 0x4d, 0xaa, 0x5e, 0xad, 0x26, 0x77, 0x8a,
-	0x4a, 0x13, 0xd6, 0x3a, 0xc8, 0x31, 0x8c, 0xa2, 0x11, 0x04, 0x7b, 0xd2, 0xff, 0x53, 0x14, 0x57,
-	0x82, 0x60, 0x0f, 0x39, 0x86, 0x79, 0x2c, 0x7e, 0x10, 0xb6, 0x9c, 0xa6, 0xf7, 0x7e, 0x5a, 0x55,
-	0x52, 0xa4, 0xdf, 0xa7, 0x3c, 0x96, 0x8d, 0x5e, 0x12, 0xec, 0x57, 0x8e, 0x8d, 0xc0, 0x76, 0x48,
-	0xab, 0x9e, 0x1b, 0x9b, 0xdc, 0x20, 0x3d, 0x02, 0xb7, 0x7a, 0x28, 0xb0, 0x4f, 0x29, 0xb2, 0x04,
-	0xa7, 0x92, 0x73, 0xc8, 0x24, 0x57, 0x44, 0x38, 0x83, 0xca, 0x0f, 0xc7, 0x34, 0x1a, 0xb3, 0xf4,
-	0xcc, 0xda, 0xb4, 0x64, 0xc6, 0x0e, 0x77, 0x13, 0x0d, 0x6b, 0x93, 0x64, 0xf2, 0xa0, 0xa2, 0xb0,
-	0x7f, 0xaf, 0xc0, 0x56, 0xc7, 0x01, 0xf7, 0x6a, 0x1f, 0x5b, 0x90, 0x2f, 0x3d, 0x22, 0x47, 0x8e,
-	0x31, 0x22, 0x9f, 0x87, 0xc9, 0x3b, 0x51, 0xe0, 0xab, 0x00, 0xda, 0xe8, 0xc0, 0x00, 0x9a, 0x41,
-	0xd5, 0x3f, 0x80, 0x36, 0x76, 0xc2, 0x00, 0xda, 0x9f, 0x8f, 0xc2, 0x39, 0x75, 0x74, 0x4c, 0xe3,
-	0xbb, 0x41, 0xb8, 0xe7, 0xf9, 0x75, 0x7e, 0xdc, 0xfa, 0x25, 0x0b, 0x26, 0xc5, 0xf0, 0x96, 0x2f,
-	0x10, 0x88, 0xe3, 0xc5, 0x5a, 0x4e, 0x97, 0xc4, 0x52, 0xc2, 0x16, 0x76, 0x0c, 0x41, 0x99, 0xe7,
-	0x20, 0x4c, 0x14, 0xa6, 0x34, 0x22, 0x1f, 0x04, 0x48, 0x9e, 0xc9, 0xaa, 0xe5, 0xf4, 0x58, 0x58,
-	0xa2, 0x1f, 0xd2, 0x9a, 0x76, 0x25, 0x77, 0x94, 0x10, 0x34, 0x04, 0x92, 0x57, 0x2d, 0x75, 0x29,
-	0x43, 0x9c, 0x15, 0xbd, 0xfc, 0x48, 0xda, 0xe6, 0x38, 0x77, 0x34, 0x10, 0xc6, 0x3d, 0xbf, 0xce,
-	0xba, 0x55, 0xc6, 0x1c, 0xdf, 0xd4, 0x2f, 0x55, 0x61, 0x3d, 0x70, 0xaa, 0x15, 0xa7, 0xe9, 0xf8,
-	0x2e, 0x0d, 0xd7, 0x04, 0xb9, 0xf9, 0x58, 0x11, 0x07, 0x60, 0xc2, 0xa8, 0xe7, 0x16, 0xe4, 0xe8,
-	0x71, 0x6e, 0x41, 0xce, 0xbd, 0x13, 0x66, 0x7a, 0x3a, 0xf3, 0x44, 0x77, 0x34, 0x1e, 0xfe, 0x7a,
-	0x87, 0xfd, 0x27, 0x63, 0x7a, 0x8d, 0xd9, 0x08, 0xaa, 0xe2, 0x2e, 0x5e, 0xa8, 0x7b, 0x54, 0xba,
-	0x8a, 0x39, 0x0e, 0x11, 0xe3, 0xc1, 0x23, 0x05, 0x44, 0x53, 0x24, 0x1b, 0xa3, 0x6d, 0x27, 0xa4,
-	0xfe, 0xa3, 0x1e, 0xa3, 0x5b, 0x4a, 0x08, 0x1a, 0x02, 0x49, 0x23, 0x75, 0x98, 0x79, 0x69, 0xf8,
-	0xc3, 0x4c, 0xe6, 0xbd, 0xf6, 0xbd, 0x33, 0xf5, 0x19, 0x0b, 0xa6, 0xfd, 0xd4, 0xc8, 0x95, 0x07,
-	0x5a, 0x3b, 0x8f, 0x62, 0x56, 0x88, 0x3b, 0xd0, 0x69, 0x18, 0x66, 0xe4, 0xf7, 0x5b, 0x81, 0x46,
-	0x4f, 0xb8, 0x02, 0xe9, 0x4b, 0xbd, 0x63, 0x83, 0x2e, 0xf5, 0x12, 0x5f, 0x5d, 0xe7, 0x1f, 0xcf,
-	0xfd, 0x3a, 0x3f, 0xf4, 0xb9, 0xca, 0x7f, 0x1b, 0xca, 0x6e, 0x48, 0x9d, 0xf8, 0x21, 0x6f, 0x76,
-	0xf3, 0x27, 0xe6, 0x96, 0x13, 0x06, 0xa8, 0x79, 0xd9, 0x7f, 0x55, 0x84, 0xd3, 0x49, 0x8b, 0x24,
-	0x07, 0x3d, 0x6c, 0x39, 0x13, 0x72, 0xb5, 0x2f, 0xaa, 0x96, 0xb3, 0x2b, 0x09, 0x02, 0x35, 0x0d,
-	0x73, 0x9f, 0x3a, 0x11, 0xdd, 0x6c, 0x53, 0x7f, 0xdd, 0xdb, 0x8d, 0x78, 0x8b, 0x1b, 0xd9, 0x62,
-	0x37, 0x35, 0x0a, 0x4d, 0x3a, 0xe6, 0x3b, 0x0b, 0x37, 0x36, 0xca, 0x9e, 0x9b, 0x4a, 0xf7, 0x18,
-	0x13, 0x3c, 0xf9, 0x62, 0xdf, 0x77, 0x39, 0xf2, 0xc9, 0x18, 0xe8, 0x39, 0xdf, 0x3a, 0xe1, 0x83,
-	0x1c, 0xaf, 0x59, 0x70, 0x6a, 0x2f, 0x95, 0xaa, 0x92, 0x98, 0xe4, 0x21, 0x13, 0x20, 0xd3, 0xf9,
-	0x2f, 0x7a, 0x08, 0xa7, 0xe1, 0x11, 0x66, 0xa5, 0xdb, 0xff, 0x61, 0x81, 0x69, 0x9e, 0x8e, 0xe7,
-	0x08, 0x19, 0x2f, 0x2d, 0x15, 0x8e, 0x78, 0x69, 0x29, 0xf1, 0x99, 0x8a, 0xc7, 0xf3, 0xd1, 0x47,
-	0x4e, 0xe0, 0xa3, 0x8f, 0x0e, 0x74, 0xb2, 0x5e, 0x0f, 0xc5, 0x8e, 0x57, 0x95, 0x6e, 0xb6, 0x3e,
-	0xbb, 0x5a, 0x5b, 0x41, 0x06, 0xb7, 0xff, 0x68, 0x54, 0x6f, 0xab, 0xe5, 0x41, 0xf7, 0x8f, 0x44,
-	0xb5, 0x6b, 0x2a, 0x9f, 0x55, 0xd4, 0x7c, 0xa3, 0x27, 0x9f, 0xf5, 0x1d, 0x27, 0xcf, 0x63, 0x10,
-	0x0d, 0x34, 0x28, 0x9d, 0x75, 0xfc, 0x88, 0x24, 0x86, 0x3b, 0x50, 0x62, 0x3b, 0x11, 0x1e, 0x1f,
-	0x2b, 0xa5, 0x94, 0x2a, 0x5d, 0x91, 0xf0, 0xfb, 0x87, 0xf3, 0x6f, 0x3f, 0xb9, 0x5a, 0x49, 0x69,
-	0x54, 0xfc, 0x49, 0x04, 0x65, 0xf6, 0x9b, 0xe7, 0x5b, 0xc8, 0x3d, 0xce, 0x4d, 0x65, 0x8b, 0x12,
-	0x44, 0x2e, 0xc9, 0x1c, 0x5a, 0x0e, 0xf1, 0xa1, 0xcc, 0xdf, 0x04, 0xe2, 0x42, 0xc5, 0x56, 0x68,
-	0x4b, 0x65, 0x3d, 0x24, 0x88, 0xfb, 0x87, 0xf3, 0x2f, 0x9e, 0x5c, 0xa8, 0x2a, 0x8e, 0x5a, 0x84,
-	0xfd, 0xdd, 0xa2, 0x1e, 0xbb, 0x32, 0x8d, 0xf9, 0x47, 0x62, 0xec, 0xbe, 0x90, 0x19, 0xbb, 0x17,
-	0x7a, 0xc6, 0xee, 0xb4, 0x7e, 0x37, 0x27, 0x35, 0x1a, 0x1f, 0xf7, 0x02, 0x7b, 0xf4, 0xb6, 0x9b,
-	0x7b, 0x16, 0xaf, 0x74, 0xbc, 0x90, 0x46, 0x5b, 0x61, 0xc7, 0xf7, 0xfc, 0x3a, 0x1f, 0x8e, 0x25,
-	0xd3, 0xb3, 0x48, 0xa1, 0x31, 0x4b, 0x6f, 0x7f, 0x99, 0x1f, 0x4f, 0x1a, 0xa9, 0x5b, 0xac, 0x97,
-	0x9b, 0xfc, 0x59, 0x25, 0x91, 0x3c, 0xaa, 0x7a, 0x59, 0xbc, 0xa5, 0x24, 0x70, 0xe4, 0x2e, 0x8c,
-	0xef, 0x8a, 0xa7, 0x1d, 0xf2, 0xb9, 0x4b, 0x24, 0xdf, 0x89, 0xe0, 0xb7, 0x36, 0x93, 0x47, 0x23,
-	0xee, 0xeb, 0x9f, 0x98, 0x48, 0xb3, 0x7f, 0xad, 0x08, 0xa7, 0x32, 0x8f, 0xfe, 0xb0, 0xfd, 0x79,
-	0xf2, 0xc2, 0x53, 0x36, 0x98, 0xae, 0x5e, 0x32, 0x56, 0x14, 0xe4, 0x7d, 0x00, 0x55, 0xda, 0x6e,
-	0x06, 0x5d, 0xee, 0xb8, 0x8c, 0x9c, 0xd8, 0x71, 0x51, 0xbe, 0xee, 0x8a, 0xe2, 0x82, 0x06, 0x47,
-	0x99, 0x31, 0x3b, 0x2a, 0x1e, 0xae, 0x48, 0x67, 0xcc, 0x1a, 0x57, 0xea, 0xc6, 0x1e, 0xef, 0x95,
-	0x3a, 0x0f, 0x4e, 0x09, 0x15, 0x55, 0x82, 0xd4, 0x43, 0xe4, 0x41, 0x9d, 0x61, 0x23, 0x6a, 0x25,
-	0xcd, 0x06, 0xb3, 0x7c, 0xed, 0x4f, 0x17, 0x98, 0xfb, 0x26, 0x1a, 0xfb, 0x7a, 0x12, 0xcb, 0x7e,
-	0x23, 0x8c, 0x39, 0x9d, 0xb8, 0x11, 0xf4, 0x3c, 0xb5, 0xb1, 0xc4, 0xa1, 0x28, 0xb1, 0x64, 0x1d,
-	0x46, 0xaa, 0x4e, 0x9c, 0xbc, 0xc4, 0x7f, 0x12, 0xe5, 0x74, 0xe0, 0xca, 0x89, 0x29, 0x72, 0x2e,
-	0xe4, 0x69, 0x18, 0x89, 0x9d, 0x7a, 0xea, 0x0d, 0xd0, 0x1d, 0xa7, 0x1e, 0x21, 0x87, 0x9a, 0xab,
-	0xcb, 0xc8, 0x11, 0xab, 0xcb, 0x8b, 0xc6, 0x7f, 0x44, 0x18, 0x87, 0x24, 0xbd, 0xff, 0xeb, 0x20,
-	0x72, 0xf8, 0x53, 0xb4, 0xf6, 0x8f, 0xc1, 0xa4, 0xf9, 0xbf, 0x0f, 0xc7, 0xba, 0x02, 0x64, 0xff,
-	0xf3, 0x08, 0x4c, 0xa5, 0x92, 0xe8, 0x52, 0xa3, 0xdc, 0x3a, 0x72, 0x94, 0xf3, 0xe3, 0xaf, 0x8e,
-	0x4f, 0x65, 0x8a, 0xa4, 0x71, 0xfc, 0xd5, 0xf1, 0x29, 0x0a, 0x1c, 0xeb, 0x95, 0x6a, 0xd8, 0xc5,
-	0x8e, 0x2f, 0x83, 0xe8, 0xaa, 0x57, 0x56, 0x38, 0x14, 0x25, 0x96, 0x6d, 0x60, 0x27, 0x23, 0x6e,
-	0x14, 0x85, 0x8d, 0x90, 0xb3, 0xe6, 0x6a, 0x1e, 0xcf, 0x93, 0xc9, 0x84, 0x51, 0xbe, 0xa1, 0x37,
-	0x21, 0x98, 0x92, 0x48, 0x3e, 0x66, 0x99, 0x0f, 0xb3, 0x8d, 0xe5, 0x71, 0xf8, 0x93, 0xcd, 0x51,
-	0x14, 0x33, 0xe8, 0xc1, 0xef, 0xb3, 0x45, 0x6a, 0x02, 0x8f, 0x3f, 0x9a, 0x09, 0x0c, 0x7d, 0x26,
-	0xef, 0x9b, 0xa1, 0xdc, 0x72, 0x7c, 0xaf, 0x46, 0xa3, 0x58, 0xfc, 0x67, 0x4b, 0x59, 0xec, 0x9e,
-	0xae, 0x27, 0x40, 0xd4, 0x78, 0xfe, 0xcf, 0x48, 0xbc, 0x62, 0x62, 0x13, 0x53, 0x36, 0xfe, 0x19,
-	0x49, 0x83, 0xd1, 0xa4, 0xb1, 0x7f, 0xdf, 0x82, 0x27, 0xfb, 0x36, 0xc6, 0x0f, 0x6f, 0xb4, 0xd2,
-	0xfe, 0x83, 0x02, 0x9c, 0xe9, 0x93, 0x64, 0x4a, 0xba, 0x8f, 0xec, 0xfd, 0x3e, 0x99, 0xc5, 0x3a,
-	0x35, 0x70, 0x6c, 0x9c, 0x6c, 0x19, 0xd2, 0x4b, 0x41, 0xf1, 0xb1, 0x2e, 0x05, 0xf6, 0x97, 0x0b,
-	0x60, 0xbc, 0x34, 0x49, 0x3e, 0x64, 0xe6, 0x53, 0x5b, 0x79, 0xe5, 0xfe, 0x0a, 0xe6, 0x2a, 0x1f,
-	0x5b, 0xb4, 0x5a, 0xbf, 0xf4, 0xec, 0xec, 0x78, 0x2d, 0x1c, 0x3d, 0x5e, 0x49, 0x33, 0x49, 0x5c,
-	0x2f, 0xe6, 0x9f, 0xb8, 0x5e, 0xee, 0x49, 0x5a, 0xff, 0x15, 0x4b, 0x8c, 0xb4, 0x4c, 0x95, 0xb4,
-	0x85, 0xb5, 0x1e, 0x60, 0x61, 0xdf, 0x02, 0xa5, 0x88, 0x36, 0x6b, 0xcc, 0xb3, 0x93, 0x96, 0x58,
-	0x8d, 0x89, 0x6d, 0x09, 0x47, 0x45, 0xc1, 0xaf, 0xb4, 0x36, 0x9b, 0xc1, 0xdd, 0xd5, 0x56, 0x3b,
-	0xee, 0x4a, 0x9b, 0xac, 0xaf, 0xb4, 0x2a, 0x0c, 0x1a, 0x54, 0xf6, 0x7f, 0x5a, 0xa2, 0x3b, 0xa5,
-	0x8f, 0xfe, 0x42, 0xe6, 0xaa, 0xe1, 0xf1, 0xdd, 0xdb, 0x9f, 0x07, 0x70, 0xd5, 0xe5, 0xff, 0x7c,
-	0x1e, 0xa0, 0xd4, 0x8f, 0x09, 0x98, 0xaf, 0x22, 0x26, 0x30, 0x34, 0xe4, 0xa5, 0x26, 0x4f, 0xf1,
-	0xa8, 0xc9, 0x63, 0xff, 0x9b, 0x05, 0xa9, 0xc5, 0x82, 0xb4, 0x61, 0x94, 0x69, 0xd0, 0xcd, 0xe7,
-	0xa9, 0x02, 0x93, 0x35, 0x9b, 0x58, 0x72, 0x58, 0xf0, 0x9f, 0x28, 0x04, 0x91, 0xa6, 0xf4, 0xce,
-	0x0b, 0x79, 0x3c, 0xa7, 0x61, 0x0a, 0x64, 0xfe, 0xbd, 0xfc, 0x17, 0x0c, 0xe5, 0xe9, 0xdb, 0x2f,
-	0xc0, 0x4c, 0x8f, 0x52, 0xfc, 0xf2, 0x51, 0x90, 0xbc, 0xcf, 0x60, 0x8c, 0x40, 0x7e, 0x15, 0x12,
-	0x05, 0x8e, 0x39, 0xf8, 0xa7, 0xb3, 0xec, 0xc9, 0x17, 0x2c, 0x98, 0x89, 0xb2, 0xfc, 0x1e, 0x55,
-	0xdb, 0xa9, 0xc8, 0x55, 0x0f, 0x0a, 0x7b, 0x95, 0xb0, 0xff, 0x42, 0x9a, 0x27, 0xf1, 0xaf, 0x61,
-	0x6a, 0x71, 0xb1, 0x06, 0x2e, 0x2e, 0x6c, 0x8a, 0xb9, 0x0d, 0x5a, 0xed, 0x34, 0x7b, 0x52, 0x69,
-	0xb6, 0x25, 0x1c, 0x15, 0x45, 0xea, 0x21, 0xba, 0xe2, 0x91, 0x0f, 0xd1, 0x3d, 0x0f, 0x93, 0xe6,
-	0x1b, 0x24, 0x3c, 0x84, 0x26, 0x0f, 0x1f, 0xcc, 0xe7, 0x4a, 0x30, 0x45, 0x95, 0x79, 0xc8, 0x6c,
-	0xf4, 0xc8, 0x87, 0xcc, 0x9e, 0x85, 0x92, 0x7c, 0x94, 0x2b, 0x89, 0xef, 0x8a, 0x3c, 0x1d, 0x09,
-	0x43, 0x85, 0x65, 0x06, 0xa2, 0xe5, 0xf8, 0x1d, 0xa7, 0xc9, 0x5a, 0x48, 0xa6, 0xef, 0xa9, 0x99,
-	0x75, 0x5d, 0x61, 0xd0, 0xa0, 0xb2, 0xff, 0xc9, 0x82, 0xec, 0x1b, 0x41, 0xa9, 0x24, 0x40, 0xeb,
-	0xc8, 0x24, 0xc0, 0x74, 0x82, 0x53, 0xe1, 0x58, 0x09, 0x4e, 0x66, 0xee, 0x51, 0xf1, 0x81, 0xb9,
-	0x47, 0x6f, 0xd0, 0x17, 0xc8, 0x45, 0x92, 0xd2, 0x44, 0xbf, 0xcb, 0xe3, 0xc4, 0x86, 0x31, 0xd7,
-	0x51, 0x39, 0xd6, 0x93, 0xc2, 0x51, 0x5a, 0x5e, 0xe2, 0x44, 0x12, 0x53, 0x59, 0xf8, 0xea, 0x77,
-	0xce, 0x3f, 0xf1, 0xb5, 0xef, 0x9c, 0x7f, 0xe2, 0x9b, 0xdf, 0x39, 0xff, 0xc4, 0x47, 0xee, 0x9d,
-	0xb7, 0xbe, 0x7a, 0xef, 0xbc, 0xf5, 0xb5, 0x7b, 0xe7, 0xad, 0x6f, 0xde, 0x3b, 0x6f, 0x7d, 0xfb,
-	0xde, 0x79, 0xeb, 0x33, 0xff, 0x70, 0xfe, 0x89, 0x77, 0x97, 0x92, 0xb1, 0xfa, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0x7f, 0x2b, 0x5c, 0x83, 0x76, 0x00, 0x00,
+	// 8095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x8c, 0x24, 0xdd,
+	0x75, 0xd0, 0x57, 0xdd, 0xf3, 0xe8, 0xbe, 0xf3, 0xbe, 0xfb, 0xf8, 0xda, 0x8b, 0xb3, 0xb3, 0x2a,
+	0xe3, 0xc4, 0xe0, 0x78, 0x16, 0x6f, 0x9c, 0xe4, 0x23, 0x4e, 0x4c, 0xa6, 0x67, 0x66, 0x77, 0x67,
+	0x67, 0x76, 0x77, 0xbe, 0x33, 0xb3, 0xbb, 0x7c, 0xce, 0x03, 0xd7, 0x74, 0xdf, 0x9e, 0xa9, 0x9d,
+	0xee, 0xaa, 0xfe, 0xaa, 0xaa, 0x67, 0xa7, 0x13, 0xfc, 0x08, 0x02, 0x62, 0xc5, 0x09, 0xb6, 0x62,
+	0x29, 0x24, 0x12, 0x38, 0x01, 0x02, 0xff, 0x22, 0x02, 0x02, 0xf1, 0x88, 0xf8, 0x11, 0x40, 0xc8,
+	0xc0, 0x8f, 0x58, 0x22, 0x8a, 0x03, 0x11, 0x43, 0xbc, 0x10, 0x09, 0x81, 0x02, 0x0a, 0x8f, 0x1f,
+	0x2c, 0x42, 0x42, 0xe7, 0xbe, 0xab, 0xba, 0x7a, 0xa7, 0x67, 0xbb, 0x66, 0xfd, 0xc9, 0xca, 0xaf,
+	0x99, 0x3a, 0xe7, 0xd4, 0x39, 0xe7, 0xde, 0xba, 0x8f, 0x73, 0xcf, 0x39, 0xf7, 0x34, 0xd9, 0x3e,
+	0xf0, 0x93, 0xc3, 0xde, 0xfe, 0x4a, 0x23, 0xec, 0xdc, 0xf4, 0xa2, 0x83, 0xb0, 0x1b, 0x85, 0x4f,
+	0xf9, 0x3f, 0x1f, 0x69, 0x34, 0x6f, 0x1e, 0xdf, 0xba, 0xd9, 0x3d, 0x3a, 0xb8, 0xe9, 0x75, 0xfd,
+	0xf8, 0xa6, 0xd7, 0xed, 0xb6, 0xfd, 0x86, 0x97, 0xf8, 0x61, 0x70, 0xf3, 0xf8, 0xa3, 0x5e, 0xbb,
+	0x7b, 0xe8, 0x7d, 0xf4, 0xe6, 0x01, 0x0b, 0x58, 0xe4, 0x25, 0xac, 0xb9, 0xd2, 0x8d, 0xc2, 0x24,
+	0xa4, 0xdf, 0x6f, 0xb8, 0xad, 0x28, 0x6e, 0xfc, 0x9f, 0x3f, 0xd3, 0x68, 0xae, 0x1c, 0xdf, 0x5a,
+	0xe9, 0x1e, 0x1d, 0xac, 0x20, 0xb7, 0x15, 0x8b, 0xdb, 0x8a, 0xe2, 0x76, 0xed, 0x23, 0x96, 0x2e,
+	0x07, 0xe1, 0x41, 0x78, 0x93, 0x33, 0xdd, 0xef, 0xb5, 0xf8, 0x13, 0x7f, 0xe0, 0xff, 0x09, 0x61,
+	0xd7, 0xdc, 0xa3, 0xb7, 0xe2, 0x15, 0x3f, 0x44, 0xf5, 0x6e, 0x36, 0xc2, 0x88, 0xdd, 0x3c, 0x1e,
+	0x50, 0xe8, 0xda, 0xc7, 0x0c, 0x4d, 0xc7, 0x6b, 0x1c, 0xfa, 0x01, 0x8b, 0xfa, 0xa6, 0x4d, 0x1d,
+	0x96, 0x78, 0x79, 0x6f, 0xdd, 0x1c, 0xf6, 0x56, 0xd4, 0x0b, 0x12, 0xbf, 0xc3, 0x06, 0x5e, 0xf8,
+	0x9e, 0xb3, 0x5e, 0x88, 0x1b, 0x87, 0xac, 0xe3, 0x65, 0xdf, 0x73, 0xdf, 0x25, 0x73, 0xab, 0x4f,
+	0x76, 0x57, 0x7b, 0xc9, 0xe1, 0x5a, 0x18, 0xb4, 0xfc, 0x03, 0xfa, 0xdd, 0x64, 0xa6, 0xd1, 0xee,
+	0xc5, 0x09, 0x8b, 0x1e, 0x78, 0x1d, 0x56, 0x73, 0x6e, 0x38, 0x1f, 0xaa, 0xd6, 0x2f, 0x7d, 0xf5,
+	0x74, 0xf9, 0x8d, 0xe7, 0xa7, 0xcb, 0x33, 0x6b, 0x06, 0x05, 0x36, 0x1d, 0xfd, 0x63, 0x64, 0x3a,
+	0x0a, 0xdb, 0x6c, 0x15, 0x1e, 0xd4, 0x4a, 0xfc, 0x95, 0x05, 0xf9, 0xca, 0x34, 0x08, 0x30, 0x28,
+	0xbc, 0xfb, 0x5b, 0x25, 0x42, 0x56, 0xbb, 0xdd, 0x9d, 0x28, 0x7c, 0xca, 0x1a, 0x09, 0xfd, 0x14,
+	0xa9, 0x60, 0x2f, 0x34, 0xbd, 0xc4, 0xe3, 0xd2, 0x66, 0x6e, 0xfd, 0x89, 0x15, 0xd1, 0x98, 0x15,
+	0xbb, 0x31, 0xe6, 0xcb, 0x21, 0xf5, 0xca, 0xf1, 0x47, 0x57, 0x1e, 0xee, 0xe3, 0xfb, 0xf7, 0x59,
+	0xe2, 0xd5, 0xa9, 0x14, 0x46, 0x0c, 0x0c, 0x34, 0x57, 0x1a, 0x90, 0x89, 0xb8, 0xcb, 0x1a, 0x5c,
+	0xb1, 0x99, 0x5b, 0xdb, 0x2b, 0xe3, 0x0c, 0x91, 0x15, 0xa3, 0xf9, 0x6e, 0x97, 0x35, 0xea, 0xb3,
+	0x52, 0xf2, 0x04, 0x3e, 0x01, 0x97, 0x43, 0x8f, 0xc9, 0x54, 0x9c, 0x78, 0x49, 0x2f, 0xae, 0x95,
+	0xb9, 0xc4, 0x07, 0x85, 0x49, 0xe4, 0x5c, 0xeb, 0xf3, 0x52, 0xe6, 0x94, 0x78, 0x06, 0x29, 0xcd,
+	0xfd, 0xf7, 0x0e, 0x99, 0x37, 0xc4, 0xdb, 0x7e, 0x9c, 0xd0, 0x1f, 0x1e, 0xe8, 0xdc, 0x95, 0xd1,
+	0x3a, 0x17, 0xdf, 0xe6, 0x5d, 0xbb, 0x28, 0x85, 0x55, 0x14, 0xc4, 0xea, 0xd8, 0x0e, 0x99, 0xf4,
+	0x13, 0xd6, 0x89, 0x6b, 0xa5, 0x1b, 0xe5, 0x0f, 0xcd, 0xdc, 0xba, 0x5b, 0x54, 0x3b, 0xeb, 0x73,
+	0x52, 0xe8, 0xe4, 0x26, 0xb2, 0x07, 0x21, 0xc5, 0xfd, 0x7f, 0xd4, 0x6e, 0x1f, 0x76, 0x38, 0xfd,
+	0x28, 0x99, 0x89, 0xc3, 0x5e, 0xd4, 0x60, 0xc0, 0xba, 0x61, 0x5c, 0x73, 0x6e, 0x94, 0x71, 0xe8,
+	0xe1, 0x48, 0xdd, 0x35, 0x60, 0xb0, 0x69, 0xe8, 0x5f, 0x72, 0xc8, 0x6c, 0x93, 0xc5, 0x89, 0x1f,
+	0x70, 0xf9, 0x4a, 0xf9, 0xbd, 0xb1, 0x95, 0x57, 0xc0, 0x75, 0xc3, 0xbc, 0x7e, 0x59, 0x36, 0x64,
+	0xd6, 0x02, 0xc6, 0x90, 0x92, 0x8f, 0x33, 0xae, 0xc9, 0xe2, 0x46, 0xe4, 0x77, 0xf1, 0xb9, 0x56,
+	0x4e, 0xcf, 0xb8, 0x75, 0x83, 0x02, 0x9b, 0x8e, 0x06, 0x64, 0x12, 0x67, 0x54, 0x5c, 0x9b, 0xe0,
+	0xfa, 0x6f, 0x8e, 0xa7, 0xbf, 0xec, 0x54, 0x9c, 0xac, 0xa6, 0xf7, 0xf1, 0x29, 0x06, 0x21, 0x86,
+	0xfe, 0x8c, 0x43, 0x6a, 0x72, 0xc6, 0x03, 0x13, 0x1d, 0xfa, 0xe4, 0xd0, 0x4f, 0x58, 0xdb, 0x8f,
+	0x93, 0xda, 0x24, 0xd7, 0xe1, 0xe6, 0x68, 0x63, 0xeb, 0x4e, 0x14, 0xf6, 0xba, 0x5b, 0x7e, 0xd0,
+	0xac, 0xdf, 0x90, 0x92, 0x6a, 0x6b, 0x43, 0x18, 0xc3, 0x50, 0x91, 0xf4, 0xcb, 0x0e, 0xb9, 0x16,
+	0x78, 0x1d, 0x16, 0x77, 0xbd, 0x06, 0x53, 0xe8, 0x7a, 0xdb, 0x6b, 0x1c, 0x71, 0x8d, 0xa6, 0x5e,
+	0x4d, 0x23, 0x57, 0x6a, 0x74, 0xed, 0xc1, 0x50, 0xd6, 0xf0, 0x12, 0xb1, 0xf4, 0x6f, 0x38, 0x64,
+	0x29, 0x8c, 0xba, 0x87, 0x5e, 0xc0, 0x9a, 0x0a, 0x1b, 0xd7, 0xa6, 0xf9, 0xd4, 0xfb, 0xd1, 0xf1,
+	0x3e, 0xd1, 0xc3, 0x2c, 0xdb, 0xfb, 0x61, 0xe0, 0x27, 0x61, 0xb4, 0xcb, 0x92, 0xc4, 0x0f, 0x0e,
+	0xe2, 0xfa, 0x95, 0xe7, 0xa7, 0xcb, 0x4b, 0x03, 0x54, 0x30, 0xa8, 0x0f, 0xfd, 0x71, 0x32, 0x13,
+	0xf7, 0x83, 0xc6, 0x13, 0x3f, 0x68, 0x86, 0xcf, 0xe2, 0x5a, 0xa5, 0x88, 0xe9, 0xbb, 0xab, 0x19,
+	0xca, 0x09, 0x68, 0x04, 0x80, 0x2d, 0x2d, 0xff, 0xc3, 0x99, 0xa1, 0x54, 0x2d, 0xfa, 0xc3, 0x99,
+	0xc1, 0xf4, 0x12, 0xb1, 0xf4, 0x27, 0x1d, 0x32, 0x17, 0xfb, 0x07, 0x81, 0x97, 0xf4, 0x22, 0xb6,
+	0xc5, 0xfa, 0x71, 0x8d, 0x70, 0x45, 0xee, 0x8d, 0xd9, 0x2b, 0x16, 0xcb, 0xfa, 0x15, 0xa9, 0xe3,
+	0x9c, 0x0d, 0x8d, 0x21, 0x2d, 0x37, 0x6f, 0xa2, 0x99, 0x61, 0x3d, 0x53, 0xec, 0x44, 0x33, 0x83,
+	0x7a, 0xa8, 0x48, 0xfa, 0x0f, 0x1d, 0x72, 0xad, 0x71, 0xe8, 0x45, 0x89, 0xd6, 0xfa, 0x31, 0x8b,
+	0xfc, 0x96, 0x6c, 0x6a, 0x6d, 0x96, 0x8f, 0xed, 0x3f, 0x3d, 0x5e, 0x37, 0xad, 0x0d, 0xe5, 0x5f,
+	0xbf, 0x8e, 0x1f, 0x75, 0x38, 0x1e, 0x5e, 0xa2, 0x1b, 0x2e, 0xad, 0x87, 0xac, 0xdd, 0x79, 0xcc,
+	0xa2, 0x18, 0x55, 0x9d, 0x4b, 0x2f, 0xad, 0x77, 0x0d, 0x0a, 0x6c, 0x3a, 0xfa, 0xcb, 0x0e, 0xb9,
+	0x72, 0xd4, 0x8b, 0x93, 0xb0, 0xe3, 0xff, 0x18, 0xab, 0xf7, 0xfc, 0x76, 0xf3, 0x61, 0x57, 0xec,
+	0x15, 0xf3, 0xbc, 0xb1, 0xbb, 0xe3, 0x35, 0x76, 0x2b, 0x8f, 0x75, 0xfd, 0x7d, 0xcf, 0x4f, 0x97,
+	0xaf, 0xe4, 0xa2, 0x20, 0x5f, 0x19, 0x7a, 0x8b, 0x90, 0x38, 0xec, 0xc6, 0x5b, 0xac, 0xbf, 0xcb,
+	0x92, 0xda, 0x65, 0xde, 0x38, 0x6d, 0x09, 0xed, 0x6a, 0x0c, 0x58, 0x54, 0xf4, 0xef, 0x3a, 0xe4,
+	0x6a, 0x24, 0x3f, 0xf1, 0x9a, 0xe4, 0x2a, 0xf7, 0xc1, 0x45, 0x3e, 0xb4, 0x3e, 0x59, 0xcc, 0x3e,
+	0x92, 0x27, 0xa2, 0x7e, 0x5d, 0x2a, 0x77, 0x35, 0x17, 0x1d, 0xc3, 0x10, 0xcd, 0xf8, 0x2e, 0xdf,
+	0x0f, 0x1a, 0xea, 0x23, 0x2c, 0x59, 0xbb, 0xbc, 0x01, 0x83, 0x4d, 0x43, 0x3f, 0xef, 0x90, 0xf9,
+	0x8e, 0x17, 0xf8, 0x2d, 0x16, 0x27, 0x3b, 0x61, 0xdb, 0x6f, 0xf4, 0x6b, 0xb4, 0x08, 0xf3, 0xef,
+	0x7e, 0x8a, 0x67, 0x9d, 0x3e, 0x3f, 0x5d, 0x9e, 0x4f, 0xc3, 0x20, 0x23, 0x97, 0xfe, 0x0b, 0x87,
+	0x5c, 0xb3, 0x36, 0xfc, 0x5d, 0x16, 0x1d, 0xfb, 0x0d, 0xb6, 0xda, 0x68, 0x84, 0xbd, 0x20, 0x89,
+	0x6b, 0x97, 0x78, 0xb7, 0xef, 0x5f, 0x84, 0xf9, 0x91, 0x16, 0x65, 0x96, 0xc8, 0xa1, 0x24, 0x31,
+	0xbc, 0x44, 0x53, 0xf7, 0x5f, 0x96, 0xc8, 0x62, 0xd6, 0x18, 0xa5, 0x7f, 0xcb, 0x21, 0x0b, 0x4f,
+	0x9f, 0x25, 0x7b, 0xe1, 0x11, 0x0b, 0xe2, 0x7a, 0x1f, 0x4d, 0x06, 0x6e, 0x86, 0xcd, 0xdc, 0x6a,
+	0x14, 0x6b, 0xf6, 0xae, 0xdc, 0x4b, 0x4b, 0xd9, 0x08, 0x92, 0xa8, 0x5f, 0x7f, 0x53, 0xb6, 0x69,
+	0xe1, 0xde, 0x93, 0x3d, 0x1b, 0x0b, 0x59, 0xa5, 0xae, 0x7d, 0xc1, 0x21, 0x97, 0xf3, 0x58, 0xd0,
+	0x45, 0x52, 0x3e, 0x62, 0x7d, 0x71, 0xd2, 0x01, 0xfc, 0x97, 0xfe, 0x08, 0x99, 0x3c, 0xf6, 0xda,
+	0x3d, 0x26, 0x4f, 0x0c, 0x77, 0xc6, 0x6b, 0x88, 0xd6, 0x0c, 0x04, 0xd7, 0xef, 0x2b, 0xbd, 0xe5,
+	0xb8, 0xbf, 0x51, 0x26, 0x33, 0xd6, 0x47, 0x7b, 0x0d, 0xa7, 0xa0, 0x30, 0x75, 0x0a, 0xba, 0x5f,
+	0xd8, 0x78, 0x1b, 0x7a, 0x0c, 0x7a, 0x96, 0x39, 0x06, 0x3d, 0x2c, 0x4e, 0xe4, 0x4b, 0xcf, 0x41,
+	0x34, 0x21, 0xd5, 0xb0, 0xcb, 0x22, 0xb1, 0x3d, 0x4d, 0x14, 0xf1, 0x09, 0x1f, 0x2a, 0x76, 0xf5,
+	0xb9, 0xe7, 0xa7, 0xcb, 0x55, 0xfd, 0x08, 0x46, 0x90, 0xfb, 0x75, 0x87, 0x5c, 0xb6, 0x74, 0x5c,
+	0x0b, 0x83, 0xa6, 0xcf, 0x3f, 0xed, 0x0d, 0x32, 0x91, 0xf4, 0xbb, 0xea, 0x28, 0xad, 0x7b, 0x6a,
+	0xaf, 0xdf, 0x65, 0xc0, 0x31, 0x78, 0x78, 0xee, 0xb0, 0x38, 0xf6, 0x0e, 0x58, 0xf6, 0xf0, 0x7c,
+	0x5f, 0x80, 0x41, 0xe1, 0x69, 0x44, 0x68, 0xdb, 0x8b, 0x93, 0xbd, 0xc8, 0x0b, 0x62, 0xce, 0x7e,
+	0xcf, 0xef, 0x30, 0xd9, 0xc1, 0x7f, 0x7c, 0xb4, 0x11, 0x83, 0x6f, 0xd4, 0xaf, 0x3e, 0x3f, 0x5d,
+	0xa6, 0xdb, 0x03, 0x9c, 0x20, 0x87, 0xbb, 0xfb, 0x65, 0x87, 0x5c, 0xcd, 0x5f, 0x60, 0xe8, 0xb7,
+	0x93, 0xa9, 0x98, 0x45, 0xc7, 0x2c, 0x92, 0xad, 0x33, 0x9f, 0x84, 0x43, 0x41, 0x62, 0xe9, 0x4d,
+	0x52, 0xd5, 0xb6, 0x97, 0x6c, 0xe3, 0x92, 0x24, 0xad, 0x1a, 0x83, 0xcd, 0xd0, 0x60, 0xa7, 0x05,
+	0x9e, 0x6c, 0x99, 0xd5, 0x69, 0x48, 0x0b, 0x1c, 0xe3, 0xfe, 0xa6, 0x43, 0xfe, 0xe8, 0x28, 0xcb,
+	0xde, 0xc5, 0xe9, 0xb8, 0x4b, 0xae, 0x34, 0x59, 0xcb, 0xeb, 0xb5, 0x93, 0xb4, 0x44, 0xa9, 0xf4,
+	0xb7, 0xc9, 0x97, 0xaf, 0xac, 0xe7, 0x11, 0x41, 0xfe, 0xbb, 0xee, 0x7f, 0x70, 0xc8, 0x82, 0xd5,
+	0xac, 0xd7, 0x70, 0x8a, 0x0f, 0xd2, 0xa7, 0xf8, 0xcd, 0xc2, 0xa6, 0xe9, 0x90, 0x63, 0xfc, 0x2f,
+	0x56, 0xc9, 0x92, 0x3d, 0x99, 0xf9, 0x8e, 0xcf, 0x1d, 0x48, 0xac, 0x1b, 0x3e, 0x82, 0xed, 0x9a,
+	0x93, 0x9e, 0x03, 0x20, 0xc0, 0xa0, 0xf0, 0x38, 0x36, 0xba, 0x5e, 0x72, 0x58, 0x2b, 0xa5, 0xc7,
+	0xc6, 0x8e, 0x97, 0x1c, 0x02, 0xc7, 0xd0, 0x4f, 0x90, 0xf9, 0xc4, 0x8b, 0x0e, 0x58, 0x02, 0xec,
+	0xd8, 0x8f, 0xd5, 0x32, 0x50, 0xad, 0x5f, 0x95, 0xb4, 0xf3, 0x7b, 0x29, 0x2c, 0x64, 0xa8, 0xe9,
+	0xbb, 0x64, 0x02, 0xed, 0xc1, 0xda, 0x74, 0x11, 0xe6, 0xde, 0x40, 0x5b, 0xd1, 0xee, 0xac, 0x57,
+	0x50, 0x65, 0xfc, 0x0f, 0xb8, 0x28, 0xfa, 0x17, 0x1c, 0x52, 0xd5, 0x66, 0x5e, 0xad, 0x52, 0x84,
+	0x51, 0x3d, 0x20, 0xd8, 0x58, 0x97, 0x7c, 0x19, 0xd3, 0x8f, 0x60, 0x24, 0xd3, 0x4f, 0x93, 0xe9,
+	0xa3, 0x38, 0x0c, 0x02, 0x86, 0x27, 0x31, 0x54, 0xe2, 0x71, 0xd1, 0x4a, 0x08, 0xee, 0xf5, 0x19,
+	0xfc, 0xb6, 0xf2, 0x01, 0x94, 0x4c, 0xde, 0x0d, 0x4d, 0x3f, 0x62, 0x8d, 0x24, 0x8c, 0xfa, 0x35,
+	0x72, 0x21, 0xdd, 0xb0, 0xae, 0xf8, 0x8b, 0x6e, 0xd0, 0x8f, 0x60, 0x24, 0xd3, 0x3e, 0x99, 0xea,
+	0xb6, 0x7b, 0x07, 0x7e, 0x50, 0x9b, 0xe1, 0x3a, 0x3c, 0x2a, 0x58, 0x87, 0x1d, 0xce, 0xbc, 0x4e,
+	0x70, 0x1d, 0x12, 0xff, 0x83, 0x14, 0x48, 0x3f, 0x40, 0x26, 0xf9, 0x91, 0x86, 0x9f, 0xac, 0xaa,
+	0x66, 0x12, 0xf1, 0x33, 0x10, 0x08, 0x1c, 0xed, 0x90, 0x72, 0x3f, 0x49, 0xf8, 0x89, 0x66, 0xe6,
+	0x16, 0x14, 0xac, 0xdc, 0x3b, 0x49, 0x52, 0x9f, 0x7e, 0x7e, 0xba, 0x5c, 0x7e, 0x27, 0x49, 0x00,
+	0xe5, 0xd0, 0x9f, 0x70, 0x48, 0x05, 0x87, 0x69, 0xcb, 0x6f, 0x33, 0x79, 0x08, 0x7a, 0x72, 0x01,
+	0xb3, 0x02, 0xd9, 0xd7, 0x67, 0x71, 0x9d, 0x52, 0x4f, 0xa0, 0xc5, 0xe2, 0x61, 0xee, 0xa8, 0xb7,
+	0xcf, 0xd4, 0x61, 0x6e, 0x21, 0x7d, 0x98, 0xdb, 0x32, 0x28, 0xb0, 0xe9, 0xf0, 0xf0, 0xe0, 0x75,
+	0x7d, 0xf9, 0x24, 0x4e, 0x39, 0xf2, 0xf0, 0xb0, 0xba, 0xb3, 0xa9, 0xc0, 0x60, 0xd3, 0xb8, 0xbf,
+	0x51, 0x22, 0xd7, 0x86, 0x8f, 0x1a, 0xb1, 0x54, 0x35, 0x7a, 0x51, 0x2c, 0xf6, 0xf4, 0x8a, 0xbd,
+	0x54, 0x71, 0x30, 0x28, 0x3c, 0xf6, 0xdb, 0xf4, 0x53, 0x39, 0x9d, 0x4a, 0x17, 0x32, 0x9d, 0xee,
+	0xc9, 0xe9, 0xa4, 0x75, 0xb8, 0xa7, 0xa6, 0x94, 0x94, 0x8b, 0xea, 0xb2, 0x93, 0x46, 0xbb, 0xd7,
+	0x54, 0xbb, 0xa9, 0x26, 0xdd, 0x10, 0x60, 0x50, 0x78, 0x24, 0xf5, 0x03, 0x41, 0x3a, 0x91, 0x26,
+	0xdd, 0x0c, 0x24, 0xa9, 0xc4, 0xd3, 0xef, 0x24, 0x15, 0x16, 0x1c, 0xc7, 0xbd, 0x7d, 0xee, 0xfd,
+	0xc3, 0x5e, 0xd0, 0x7b, 0xcc, 0x86, 0x84, 0x83, 0xa6, 0x70, 0xff, 0x53, 0x99, 0x5c, 0xc9, 0xfd,
+	0xe2, 0x74, 0x85, 0x10, 0x6e, 0x15, 0xdf, 0xf6, 0xd1, 0x97, 0x29, 0x1c, 0xb8, 0xf3, 0x68, 0xc4,
+	0x3e, 0xd6, 0x50, 0xb0, 0x28, 0xe8, 0x67, 0x09, 0xe9, 0x7a, 0x91, 0xd7, 0x61, 0x09, 0x8b, 0xd4,
+	0x96, 0xb5, 0x35, 0x5e, 0x9f, 0xa2, 0x1e, 0x3b, 0x8a, 0xa7, 0xb1, 0xa2, 0x35, 0x28, 0x06, 0x4b,
+	0x24, 0x0e, 0xc3, 0x88, 0xb5, 0x99, 0x17, 0xb3, 0x07, 0xc6, 0x40, 0xd1, 0xc3, 0x10, 0x0c, 0x0a,
+	0x6c, 0x3a, 0xb4, 0x42, 0x78, 0x2b, 0xe2, 0xda, 0x44, 0xda, 0x0a, 0xe1, 0xed, 0x8c, 0x41, 0x62,
+	0xe9, 0x17, 0x1d, 0x32, 0x8f, 0xc3, 0xdd, 0x48, 0x97, 0xce, 0xd5, 0x87, 0xe3, 0x37, 0xf2, 0xb6,
+	0xcd, 0xd7, 0x6c, 0x86, 0x29, 0x70, 0x0c, 0x19, 0xf1, 0x38, 0x28, 0x8e, 0xe5, 0x9c, 0x9b, 0x4a,
+	0x0f, 0x0a, 0x35, 0xdf, 0x14, 0xde, 0xfd, 0x2c, 0x79, 0xdf, 0xd0, 0x79, 0x8d, 0x1d, 0xc7, 0x82,
+	0x63, 0x3f, 0x0a, 0x83, 0x0e, 0x0b, 0x92, 0x6c, 0x64, 0x69, 0xc3, 0xa0, 0xc0, 0xa6, 0xa3, 0x1f,
+	0x26, 0xd5, 0x98, 0xb5, 0xf9, 0xd4, 0x13, 0xdf, 0xbb, 0x2a, 0x96, 0xed, 0x5d, 0x05, 0x04, 0x83,
+	0x77, 0x7f, 0xa1, 0x44, 0x6a, 0xc3, 0xa6, 0x08, 0x8d, 0x71, 0x22, 0x24, 0x8f, 0xbd, 0x28, 0xae,
+	0x39, 0x45, 0x78, 0x3c, 0x25, 0xdf, 0xc7, 0x5e, 0x64, 0x4f, 0x29, 0x2e, 0x00, 0x94, 0x24, 0xfa,
+	0x94, 0x4c, 0x24, 0x6d, 0xaf, 0xa0, 0x10, 0x89, 0x25, 0xd1, 0x9c, 0x23, 0xb6, 0x57, 0x63, 0xe0,
+	0x32, 0xe8, 0xfb, 0xc9, 0x44, 0xdb, 0xdf, 0xc7, 0xf3, 0x16, 0xf6, 0x12, 0xb7, 0x30, 0xb6, 0xfd,
+	0xfd, 0x18, 0x38, 0xd4, 0xfd, 0x2d, 0x27, 0xa7, 0x6f, 0xe4, 0x06, 0xfc, 0xaa, 0x1f, 0xe7, 0xcf,
+	0x39, 0x39, 0xd3, 0x71, 0xcc, 0x78, 0x97, 0x54, 0x69, 0xe4, 0x19, 0xe9, 0xfe, 0xf7, 0xa9, 0x9c,
+	0xe5, 0x5a, 0x1b, 0x37, 0xe8, 0x26, 0x43, 0x9b, 0x7d, 0x27, 0x62, 0x2d, 0xff, 0x44, 0xb6, 0x4c,
+	0xb3, 0x7c, 0xa0, 0x31, 0x60, 0x51, 0xa9, 0x77, 0x76, 0x7b, 0x2d, 0x7c, 0xa7, 0x34, 0xf8, 0x8e,
+	0xc0, 0x80, 0x45, 0x45, 0x3f, 0x46, 0xa6, 0xfc, 0x8e, 0x77, 0xc0, 0x54, 0xff, 0xbf, 0x1f, 0x67,
+	0xf7, 0x26, 0x87, 0xbc, 0x38, 0x5d, 0x9e, 0xd7, 0x0a, 0x71, 0x10, 0x48, 0x5a, 0xf4, 0x35, 0xce,
+	0x36, 0xc2, 0x4e, 0x27, 0x0c, 0xb6, 0xbd, 0x7d, 0xd6, 0x56, 0xe1, 0x9c, 0xa7, 0x17, 0x65, 0xfa,
+	0xad, 0xac, 0x59, 0xc2, 0x84, 0x0f, 0x45, 0x07, 0xa9, 0x6c, 0x14, 0xa4, 0xb4, 0xb2, 0x17, 0x81,
+	0xc9, 0x97, 0x2f, 0x02, 0xe8, 0x2f, 0x5e, 0x12, 0xef, 0xae, 0x06, 0x41, 0x98, 0x48, 0xef, 0xa2,
+	0x88, 0xc7, 0x84, 0x17, 0xdc, 0x2c, 0x4b, 0xa2, 0x68, 0xdb, 0xfb, 0xa4, 0x9a, 0x4b, 0x03, 0x78,
+	0x18, 0x54, 0x92, 0xde, 0x21, 0x4b, 0xad, 0x10, 0xfd, 0x8f, 0xf6, 0x07, 0x99, 0xe6, 0xbb, 0x9b,
+	0x66, 0x74, 0x3b, 0x4b, 0x00, 0x83, 0xef, 0xd0, 0xc7, 0xe4, 0xaa, 0x05, 0xb4, 0xfb, 0xa1, 0xc2,
+	0xb9, 0x69, 0x4f, 0xe8, 0xed, 0x5c, 0x2a, 0x18, 0xf2, 0xf6, 0xb5, 0x3f, 0x45, 0x96, 0x06, 0xbe,
+	0x5f, 0x8e, 0x03, 0xeb, 0xb2, 0xed, 0xc0, 0xaa, 0x5a, 0x7e, 0xa7, 0x6b, 0xeb, 0xe4, 0x6a, 0x7e,
+	0x4f, 0x9d, 0x87, 0x8b, 0xfb, 0x15, 0x87, 0xbc, 0x39, 0xc4, 0xa4, 0xd5, 0x27, 0x77, 0x67, 0xd8,
+	0xc9, 0x9d, 0x7a, 0xa4, 0xcc, 0x82, 0x63, 0xb9, 0x58, 0xdc, 0x1e, 0x6f, 0x44, 0x6c, 0x04, 0xc7,
+	0xe2, 0x43, 0x73, 0x7b, 0x75, 0x23, 0x38, 0x06, 0xe4, 0xed, 0xfe, 0x7c, 0x89, 0x5c, 0x1e, 0x50,
+	0xf0, 0x9d, 0x24, 0xa1, 0xcb, 0x64, 0xb2, 0x65, 0x59, 0x1a, 0x55, 0x34, 0xac, 0x85, 0x91, 0x21,
+	0xe0, 0xf4, 0x07, 0xc8, 0x02, 0x9e, 0x8a, 0xc5, 0xae, 0xcc, 0x31, 0x72, 0xd3, 0xb9, 0x84, 0x5e,
+	0xc6, 0xf5, 0x34, 0x0a, 0xb2, 0xb4, 0xf4, 0x33, 0x84, 0x18, 0x50, 0xad, 0x5c, 0x44, 0x08, 0xe9,
+	0x9d, 0x24, 0xd1, 0x62, 0xcd, 0x22, 0x64, 0x34, 0x01, 0x4b, 0x22, 0xf6, 0xfe, 0xd1, 0x7e, 0xbb,
+	0xc9, 0x8d, 0x8c, 0x8a, 0xe9, 0xfd, 0xad, 0xfd, 0x76, 0x13, 0x38, 0xc6, 0xfd, 0xf5, 0xa9, 0x94,
+	0x83, 0x61, 0x57, 0xb9, 0xea, 0x78, 0x17, 0x49, 0xf7, 0xc2, 0xc3, 0x82, 0xa7, 0xa9, 0xe5, 0x73,
+	0xe1, 0xcf, 0x20, 0xc5, 0xd1, 0x2f, 0x38, 0x3c, 0xf8, 0xad, 0x3c, 0x37, 0xd2, 0x46, 0xbe, 0x98,
+	0x58, 0xbc, 0x1d, 0x52, 0x57, 0x40, 0xb0, 0xa5, 0xe3, 0x22, 0xd7, 0x15, 0x2e, 0xe7, 0xac, 0xa5,
+	0xac, 0xc2, 0x1a, 0x0a, 0x4f, 0x4f, 0x08, 0xc1, 0x70, 0x83, 0x0c, 0x2d, 0x08, 0x27, 0x63, 0x01,
+	0x01, 0x54, 0xc1, 0x4f, 0x18, 0xc0, 0xe6, 0x19, 0x2c, 0x59, 0xf4, 0x97, 0x1c, 0xb2, 0xe4, 0x1f,
+	0x04, 0x61, 0xc4, 0xd6, 0xfd, 0x56, 0x8b, 0x45, 0x2c, 0x68, 0x30, 0x65, 0x23, 0x8e, 0x79, 0x26,
+	0x53, 0x61, 0x99, 0xcd, 0x2c, 0x7b, 0xb3, 0xfa, 0x0d, 0xa0, 0x60, 0x50, 0x19, 0xda, 0x24, 0x13,
+	0x7e, 0xd0, 0x0a, 0xe5, 0x9a, 0x5f, 0x1f, 0x4f, 0xa9, 0xcd, 0xa0, 0x15, 0x9a, 0x81, 0x8c, 0x4f,
+	0xc0, 0xb9, 0xd3, 0x6d, 0x72, 0x39, 0x92, 0x0e, 0x9b, 0xbb, 0x7e, 0x8c, 0x27, 0xb3, 0x6d, 0xbf,
+	0xe3, 0x27, 0x7c, 0xbd, 0x2e, 0xd7, 0x6b, 0xcf, 0x4f, 0x97, 0x2f, 0x43, 0x0e, 0x1e, 0x72, 0xdf,
+	0x42, 0x33, 0xb3, 0xc9, 0xba, 0x2c, 0x68, 0xc6, 0x0f, 0x83, 0x5a, 0xc5, 0x98, 0x99, 0xeb, 0x0a,
+	0x08, 0x06, 0xef, 0x7e, 0x3e, 0xe3, 0xc2, 0x12, 0x7e, 0xe7, 0x4f, 0x93, 0x6a, 0xa4, 0x43, 0xfe,
+	0xc2, 0xc2, 0xdc, 0x2e, 0xe6, 0x83, 0x08, 0x01, 0xc6, 0x1d, 0x69, 0x82, 0xfb, 0x46, 0x22, 0x5a,
+	0x9a, 0x38, 0x4c, 0x6a, 0xa5, 0xa2, 0x06, 0xa3, 0x94, 0x6a, 0x7c, 0xfb, 0xfd, 0x00, 0x7d, 0xfb,
+	0xfd, 0xa0, 0x41, 0x23, 0x32, 0x75, 0xc8, 0xbc, 0x76, 0x72, 0x28, 0x5d, 0xcf, 0xf7, 0xc6, 0x3d,
+	0x9c, 0x20, 0xaf, 0xac, 0x5b, 0x5f, 0x40, 0x41, 0x4a, 0xa2, 0x27, 0x64, 0xfa, 0x50, 0x7c, 0x31,
+	0x69, 0x23, 0xdd, 0x1f, 0xb7, 0x73, 0x53, 0xc3, 0xc0, 0x4c, 0x76, 0x09, 0x00, 0x25, 0x8e, 0xfe,
+	0x45, 0x87, 0x90, 0x86, 0xf2, 0xe7, 0xab, 0xb9, 0x56, 0x9c, 0xd3, 0x45, 0x87, 0x0a, 0xcc, 0xea,
+	0xae, 0x41, 0x31, 0x58, 0x92, 0xe9, 0xa7, 0xc8, 0x6c, 0xc4, 0x1a, 0x61, 0xd0, 0xf0, 0xdb, 0xac,
+	0xb9, 0x9a, 0xd4, 0xa6, 0xce, 0xed, 0xf7, 0x5f, 0x44, 0x3b, 0x0f, 0x2c, 0x1e, 0x90, 0xe2, 0xc8,
+	0xe3, 0xa6, 0x3a, 0xa6, 0x81, 0x1f, 0x84, 0x49, 0x27, 0xe8, 0x76, 0x41, 0x11, 0x14, 0xce, 0x53,
+	0xc4, 0x4d, 0xd3, 0x30, 0xc8, 0xc8, 0xa5, 0x9f, 0x24, 0x24, 0xdc, 0xe7, 0xbe, 0x79, 0x6c, 0x6a,
+	0xe5, 0xdc, 0x4d, 0x9d, 0x17, 0xa1, 0x30, 0xc5, 0x01, 0x2c, 0x6e, 0x74, 0x8b, 0x10, 0x31, 0x6d,
+	0x30, 0x0a, 0xc3, 0x1d, 0x9d, 0xd5, 0xfa, 0x87, 0x4d, 0xe8, 0x5c, 0x61, 0x5e, 0x9c, 0x2e, 0x0f,
+	0xba, 0x2d, 0x10, 0x01, 0xd6, 0xeb, 0xf4, 0xc7, 0xc9, 0x74, 0xdc, 0xeb, 0x74, 0x3c, 0xed, 0xb0,
+	0xdc, 0x29, 0x6e, 0xfb, 0x14, 0x7c, 0xcd, 0xd8, 0x94, 0x00, 0x50, 0x12, 0xdd, 0x80, 0xd0, 0x41,
+	0x7a, 0xfa, 0x31, 0x32, 0xcb, 0x4e, 0x12, 0x16, 0x05, 0x5e, 0xfb, 0x11, 0x6c, 0x2b, 0x6b, 0x87,
+	0x7f, 0xfc, 0x0d, 0x0b, 0x0e, 0x29, 0x2a, 0xea, 0xea, 0x13, 0x8c, 0x30, 0x79, 0x88, 0x39, 0xc1,
+	0xa8, 0xf3, 0x8a, 0xfb, 0x7f, 0x4a, 0x29, 0xf3, 0x61, 0x2f, 0x62, 0x8c, 0x86, 0x64, 0x32, 0x08,
+	0x9b, 0x7a, 0xd1, 0xbb, 0x57, 0xcc, 0xa2, 0xf7, 0x20, 0x6c, 0x5a, 0xb9, 0x68, 0xf8, 0x14, 0x83,
+	0x90, 0xc3, 0x93, 0x75, 0x54, 0x56, 0x13, 0x47, 0xd4, 0x4a, 0x85, 0x4b, 0xd6, 0xc9, 0x3a, 0x0f,
+	0x6d, 0x41, 0x90, 0x96, 0x4b, 0x8f, 0xc8, 0xe4, 0x61, 0x18, 0x27, 0xca, 0xd4, 0x1b, 0xd3, 0x9a,
+	0xbd, 0x1b, 0xc6, 0x09, 0xdf, 0xef, 0x74, 0xb3, 0x11, 0x12, 0x83, 0x90, 0xe1, 0xfe, 0x74, 0x29,
+	0xe5, 0x45, 0x7b, 0xe2, 0x25, 0x8d, 0xc3, 0x8d, 0x63, 0x3c, 0x87, 0x6f, 0xa5, 0x62, 0x8c, 0xdf,
+	0x6b, 0xc7, 0x18, 0x5f, 0x9c, 0x2e, 0x7f, 0xc7, 0xb0, 0xe4, 0xe0, 0x67, 0xc8, 0x61, 0x85, 0xb3,
+	0xb0, 0xc2, 0x91, 0x9f, 0x73, 0xd0, 0x65, 0xaa, 0xc5, 0xc8, 0x0d, 0xa5, 0xc0, 0xb8, 0x90, 0xb6,
+	0xc4, 0x2c, 0x20, 0xd8, 0x22, 0xd1, 0x07, 0xde, 0x45, 0xdd, 0xa4, 0x1d, 0xa6, 0xbb, 0x63, 0x07,
+	0x81, 0x20, 0x70, 0xee, 0xcf, 0x3a, 0x64, 0xba, 0xee, 0x35, 0x8e, 0xc2, 0x56, 0x0b, 0xdd, 0x91,
+	0xcd, 0x9e, 0x0c, 0xf9, 0x8a, 0x4e, 0xd0, 0xee, 0xc8, 0x75, 0x09, 0x07, 0x4d, 0x81, 0x03, 0xbd,
+	0xe5, 0x35, 0x92, 0x30, 0xe2, 0x6d, 0x2b, 0x8b, 0x81, 0x7e, 0x9b, 0x43, 0x40, 0x62, 0xd0, 0x23,
+	0xd2, 0xf1, 0x4e, 0xd4, 0xcb, 0x59, 0x3f, 0xdf, 0x7d, 0x83, 0x02, 0x9b, 0xce, 0xfd, 0x3d, 0x87,
+	0xbc, 0x24, 0x5b, 0x09, 0xdd, 0x9d, 0xdd, 0xde, 0x7e, 0xdb, 0x6f, 0xf0, 0x14, 0x33, 0xcb, 0xdd,
+	0xb9, 0xa3, 0xa1, 0x60, 0x51, 0xd0, 0x9f, 0x73, 0xc8, 0xd2, 0x11, 0xeb, 0xb7, 0x59, 0x1c, 0x6f,
+	0x36, 0x59, 0x90, 0xf8, 0x89, 0xaf, 0x47, 0xfb, 0x98, 0xfb, 0xdf, 0x56, 0x8a, 0xad, 0x75, 0x54,
+	0xde, 0xca, 0xca, 0x83, 0x41, 0x15, 0xdc, 0x3f, 0x98, 0x25, 0xd3, 0x32, 0x99, 0x6c, 0xe4, 0x08,
+	0xab, 0x3a, 0x1a, 0x96, 0x86, 0x1e, 0x0d, 0x63, 0x32, 0xd5, 0xe0, 0x79, 0xe8, 0xd2, 0xae, 0x18,
+	0xd3, 0xb3, 0x2b, 0x15, 0x14, 0xa9, 0xed, 0x46, 0x2d, 0xf1, 0x0c, 0x52, 0x14, 0xfd, 0x92, 0x43,
+	0x16, 0x1a, 0x61, 0x10, 0xb0, 0x86, 0xd9, 0xf4, 0x26, 0x8a, 0xc8, 0x92, 0x58, 0x4b, 0x33, 0x35,
+	0xc9, 0x2a, 0x19, 0x04, 0x64, 0xc5, 0xd3, 0x8f, 0x93, 0x39, 0xd1, 0x67, 0x8f, 0x53, 0x4e, 0x17,
+	0x93, 0x40, 0x68, 0x23, 0x21, 0x4d, 0x8b, 0x63, 0x4c, 0x07, 0xa9, 0x85, 0xe3, 0x45, 0x8e, 0x31,
+	0x1d, 0xc5, 0x8e, 0xc1, 0xa2, 0xc0, 0x9c, 0x82, 0x88, 0xb5, 0x22, 0x16, 0x1f, 0x02, 0x7b, 0xb7,
+	0xc7, 0xe2, 0x84, 0x6f, 0xb8, 0xd3, 0xaf, 0x96, 0x53, 0x00, 0x03, 0x9c, 0x20, 0x87, 0x3b, 0x3d,
+	0x92, 0x47, 0x84, 0x4a, 0x11, 0x6b, 0x8b, 0xfc, 0xcc, 0x43, 0x4f, 0x0a, 0xcb, 0x64, 0x32, 0x3e,
+	0xf4, 0xa2, 0x26, 0xdf, 0xe8, 0xcb, 0xe2, 0xd0, 0xbf, 0x8b, 0x00, 0x10, 0x70, 0xba, 0x4e, 0x16,
+	0x33, 0xe9, 0x8f, 0x31, 0xdf, 0xca, 0x2b, 0xf5, 0x9a, 0x64, 0xb7, 0x98, 0x49, 0x9c, 0x8c, 0x61,
+	0xe0, 0x0d, 0xfb, 0xf8, 0x38, 0x73, 0xc6, 0xf1, 0xb1, 0x4f, 0xa6, 0xda, 0xc2, 0xbb, 0x34, 0xcb,
+	0xa7, 0xf2, 0xdb, 0x85, 0x74, 0xc0, 0x8a, 0xed, 0xd5, 0xd3, 0xa3, 0x5d, 0x00, 0x41, 0x0a, 0xc4,
+	0xf4, 0xd2, 0x19, 0xcf, 0x72, 0x48, 0xcd, 0xdd, 0x28, 0x8f, 0x1f, 0x96, 0x52, 0x0a, 0x0c, 0xf8,
+	0xdf, 0xcc, 0x52, 0x6f, 0x30, 0x60, 0xcb, 0xa7, 0xbf, 0xe8, 0xe0, 0xf0, 0x13, 0x7d, 0xc8, 0x03,
+	0x52, 0xb1, 0xcc, 0xb4, 0x2c, 0x8f, 0x1f, 0x7a, 0xcf, 0x7c, 0xb4, 0xdb, 0x7e, 0x1b, 0xfd, 0xc9,
+	0xd7, 0xa4, 0x4e, 0x14, 0x06, 0xc4, 0x42, 0x8e, 0x2a, 0x29, 0x0d, 0x37, 0x03, 0x05, 0xae, 0x2d,
+	0xbc, 0x46, 0x0d, 0x37, 0x83, 0x41, 0x0d, 0x0d, 0x8c, 0x3e, 0x25, 0xf3, 0x11, 0xc3, 0x83, 0xd9,
+	0x66, 0x90, 0xb0, 0xe8, 0xd8, 0x6b, 0xd7, 0x16, 0xcf, 0x93, 0x26, 0xa2, 0x36, 0x2f, 0x61, 0x96,
+	0x43, 0x8a, 0x13, 0x64, 0x38, 0x5f, 0xfb, 0x93, 0x64, 0xe6, 0x55, 0x9d, 0x8f, 0x9f, 0x20, 0x8b,
+	0x63, 0xb9, 0x1d, 0xff, 0x97, 0x43, 0xd4, 0x3c, 0x5c, 0xf3, 0x1a, 0x87, 0x0c, 0xa7, 0x38, 0xe6,
+	0x7a, 0xe8, 0x33, 0xf0, 0x1a, 0x4f, 0xbf, 0x71, 0xf8, 0x2c, 0xd7, 0xe1, 0x2d, 0x48, 0x61, 0x21,
+	0x43, 0x8d, 0x69, 0x3f, 0xd8, 0x19, 0xe2, 0x55, 0x61, 0x0e, 0xe8, 0x73, 0xf6, 0xea, 0xce, 0xa6,
+	0x7c, 0xcb, 0xd0, 0xd0, 0x90, 0x2c, 0xb5, 0xbd, 0x38, 0xe1, 0x1a, 0xe0, 0x91, 0xf8, 0x15, 0x33,
+	0xb0, 0x78, 0xb6, 0xfe, 0x76, 0x96, 0x11, 0x0c, 0xf2, 0x76, 0xbf, 0x3e, 0x41, 0xe6, 0x52, 0x3b,
+	0x19, 0x5a, 0x3b, 0xbd, 0x98, 0x45, 0x96, 0x9f, 0x55, 0x5b, 0x3b, 0x8f, 0x24, 0x1c, 0x34, 0x05,
+	0x52, 0x77, 0xbd, 0x38, 0x7e, 0x16, 0x46, 0xcd, 0x5a, 0x29, 0x4d, 0xbd, 0x23, 0xe1, 0xa0, 0x29,
+	0xd0, 0xee, 0xd9, 0x67, 0x5e, 0xc4, 0x22, 0x9e, 0xb4, 0x98, 0xb5, 0x7b, 0xea, 0x06, 0x05, 0x36,
+	0x1d, 0xdf, 0x44, 0x93, 0x76, 0xbc, 0xd6, 0xf6, 0x59, 0x90, 0x08, 0x35, 0x8b, 0xd9, 0x44, 0xf7,
+	0xb6, 0x77, 0x6d, 0xa6, 0x66, 0x13, 0xcd, 0x20, 0x20, 0x2b, 0x9e, 0xfe, 0x79, 0x87, 0xcc, 0x79,
+	0xcf, 0x62, 0x73, 0xb9, 0xad, 0x36, 0x59, 0x84, 0x51, 0x91, 0xba, 0x2f, 0x57, 0x5f, 0xc2, 0xed,
+	0x38, 0x05, 0x82, 0xb4, 0x50, 0xfa, 0xf3, 0x0e, 0xa1, 0xec, 0x84, 0x35, 0x76, 0xa2, 0xf0, 0xd8,
+	0x6f, 0xaa, 0x6f, 0x58, 0x9b, 0x2a, 0xe2, 0xa8, 0xb8, 0x31, 0xc0, 0x57, 0xec, 0xc2, 0x83, 0x70,
+	0xc8, 0xd1, 0xc1, 0xfd, 0x77, 0x65, 0x32, 0x63, 0x6d, 0x9e, 0xb9, 0x96, 0x90, 0xf3, 0x1e, 0xb3,
+	0x84, 0x4a, 0xe7, 0xb0, 0x84, 0x3e, 0x4b, 0xaa, 0x0d, 0xb5, 0x50, 0x14, 0x73, 0x19, 0x2f, 0xbb,
+	0xfc, 0x98, 0xb5, 0x42, 0x83, 0xc0, 0xc8, 0xc4, 0x80, 0x92, 0xc5, 0x46, 0x2e, 0x32, 0x13, 0x7c,
+	0x91, 0xd1, 0xe6, 0xf6, 0x6a, 0x96, 0x00, 0x06, 0xdf, 0xc9, 0x66, 0xb1, 0x4c, 0x8e, 0x90, 0xc5,
+	0xf2, 0x75, 0x47, 0x7f, 0xdc, 0xd7, 0x90, 0x45, 0xf8, 0x34, 0x9d, 0x45, 0xb8, 0x51, 0x48, 0x37,
+	0x0f, 0xc9, 0x20, 0x64, 0xe4, 0x4a, 0xee, 0xbe, 0x89, 0x4e, 0x5c, 0xaf, 0xeb, 0xf3, 0x6b, 0x2f,
+	0xea, 0x70, 0x35, 0x27, 0xd7, 0x71, 0x01, 0x04, 0x83, 0x47, 0xab, 0xf0, 0xc8, 0x0f, 0x9a, 0xca,
+	0xd9, 0xc1, 0xad, 0x42, 0xbc, 0x2c, 0x13, 0x83, 0x80, 0xbb, 0x0f, 0xc8, 0x34, 0xc6, 0xca, 0xbc,
+	0xa0, 0x49, 0x3f, 0x48, 0xa6, 0x1b, 0xe2, 0x5f, 0xc9, 0x96, 0x67, 0xaf, 0x49, 0x2c, 0x28, 0x1c,
+	0x06, 0xe0, 0xbd, 0xe8, 0x40, 0x71, 0xe4, 0x01, 0xf8, 0xd5, 0xe8, 0x20, 0x06, 0x0e, 0x75, 0xbf,
+	0x5c, 0x22, 0x64, 0x2d, 0xec, 0x74, 0xbd, 0x88, 0x35, 0xf7, 0xc2, 0x3f, 0x0c, 0xba, 0xf0, 0x07,
+	0xf7, 0xa7, 0x1d, 0x42, 0xb1, 0x57, 0xc2, 0x80, 0x05, 0x26, 0xe8, 0x8f, 0xdb, 0x72, 0x43, 0x41,
+	0xe5, 0x1e, 0x67, 0xa6, 0x9a, 0x42, 0x80, 0xa1, 0x19, 0xe1, 0x70, 0xf9, 0x01, 0x65, 0x58, 0x64,
+	0x9c, 0x0a, 0x3c, 0x74, 0x26, 0xed, 0x0c, 0xf7, 0x1f, 0x4f, 0x90, 0xab, 0x62, 0x75, 0xbc, 0xef,
+	0x05, 0xde, 0x01, 0xeb, 0xa0, 0x56, 0xa3, 0x46, 0x36, 0x1b, 0x78, 0xaa, 0xf1, 0x55, 0xaa, 0xd7,
+	0xb8, 0x73, 0x40, 0x0c, 0x2a, 0x31, 0x8c, 0x36, 0x03, 0x3f, 0x01, 0xce, 0x9c, 0xc6, 0xa4, 0xa2,
+	0x6e, 0x71, 0xd7, 0xca, 0x45, 0x0a, 0xd2, 0xd3, 0xfb, 0x8e, 0x64, 0x0f, 0x5a, 0x10, 0x7a, 0xdc,
+	0x2a, 0x4d, 0x3f, 0x6e, 0x84, 0x78, 0xca, 0x17, 0xfb, 0xfa, 0x8f, 0x8c, 0xbd, 0x25, 0xe4, 0x74,
+	0xf2, 0xba, 0x94, 0xd1, 0x17, 0x69, 0x80, 0xea, 0x11, 0xb4, 0x70, 0x15, 0x3d, 0x9e, 0xbc, 0xb8,
+	0xe8, 0x31, 0xfd, 0x5e, 0x32, 0xe7, 0xb5, 0xdb, 0xe1, 0x33, 0xd6, 0x5c, 0xed, 0x76, 0x37, 0x82,
+	0x63, 0x79, 0x86, 0x16, 0x5b, 0xbd, 0x8d, 0x80, 0x34, 0x9d, 0xfb, 0xf7, 0x1c, 0xb2, 0x7c, 0x46,
+	0xbb, 0xd0, 0x1a, 0xc3, 0x48, 0xf3, 0x83, 0x1c, 0xdb, 0xed, 0xb6, 0x84, 0x83, 0xa6, 0xc0, 0x11,
+	0xd5, 0xf2, 0x83, 0xe6, 0x05, 0x8c, 0xa8, 0xdb, 0x7e, 0xd0, 0x04, 0xce, 0xdc, 0xfd, 0xa7, 0x0e,
+	0xc9, 0x6e, 0xc4, 0xdc, 0xa7, 0x23, 0x6e, 0x6f, 0x64, 0x7d, 0x3a, 0xe9, 0xcb, 0x16, 0xe7, 0xb8,
+	0xbb, 0xf0, 0xc3, 0x64, 0xc6, 0x4b, 0x12, 0xd6, 0xe9, 0x0a, 0x07, 0x43, 0xf9, 0xd5, 0x3c, 0xfa,
+	0xf7, 0xc3, 0xa6, 0xdf, 0xf2, 0x91, 0x03, 0xd8, 0xec, 0xdc, 0xb7, 0x49, 0x45, 0x7d, 0xce, 0x11,
+	0x66, 0xea, 0x07, 0x52, 0x87, 0x8c, 0x21, 0x6b, 0xc1, 0x8b, 0x12, 0xc9, 0xb1, 0xa4, 0xb0, 0xc9,
+	0x66, 0x33, 0x48, 0x35, 0xf9, 0x7c, 0x1b, 0x02, 0x3d, 0x11, 0x43, 0x59, 0xb8, 0x8e, 0xdf, 0x29,
+	0xda, 0x12, 0x34, 0xa3, 0x7b, 0x46, 0xea, 0x67, 0x46, 0xf8, 0x2d, 0x42, 0x8c, 0xa9, 0x20, 0x33,
+	0x12, 0x75, 0xf0, 0xc9, 0x58, 0x14, 0x60, 0x51, 0xe1, 0xc1, 0xc0, 0x0f, 0xe2, 0xc4, 0x6b, 0xb7,
+	0xef, 0xfa, 0x41, 0x22, 0x3d, 0x52, 0x7a, 0x7d, 0xdf, 0x34, 0x28, 0xb0, 0xe9, 0xae, 0x7d, 0x8f,
+	0xf5, 0x5d, 0xce, 0x73, 0xd8, 0xfb, 0x57, 0x0e, 0xc1, 0xec, 0x98, 0x7d, 0xbf, 0xd9, 0x64, 0x81,
+	0xba, 0x62, 0x77, 0xdb, 0x67, 0xed, 0x26, 0x7e, 0xbc, 0x03, 0xdc, 0xc3, 0x6b, 0x4e, 0xfa, 0xe3,
+	0xf1, 0x8d, 0x1d, 0x04, 0x8e, 0x67, 0x42, 0xa8, 0x99, 0x63, 0x8d, 0x81, 0x2d, 0x3e, 0xec, 0x11,
+	0x83, 0x5e, 0x9f, 0xa7, 0xef, 0xe2, 0xad, 0x81, 0x8d, 0x93, 0x6e, 0xc4, 0xe2, 0xd8, 0xb8, 0x79,
+	0xb5, 0xd7, 0xe7, 0xde, 0xdb, 0x69, 0x3c, 0x0c, 0xbc, 0x61, 0x46, 0xd2, 0xc4, 0x4b, 0x46, 0xd2,
+	0xef, 0x95, 0xc8, 0xfc, 0x9d, 0xa0, 0xb7, 0x73, 0x47, 0xbb, 0x79, 0xf1, 0xbd, 0x23, 0xd6, 0xdf,
+	0x5c, 0xcf, 0x36, 0x62, 0x0b, 0x81, 0x20, 0x70, 0xd8, 0xe7, 0x2d, 0x3f, 0x38, 0x60, 0x51, 0x37,
+	0xf2, 0xe5, 0xf1, 0xd4, 0xea, 0xf3, 0xdb, 0x06, 0x05, 0x36, 0x1d, 0xf2, 0x0e, 0x9f, 0x05, 0x2c,
+	0xca, 0xee, 0x74, 0x0f, 0x11, 0x08, 0x02, 0x87, 0x44, 0x49, 0xd4, 0x8b, 0x93, 0xac, 0xe2, 0x7b,
+	0x08, 0x04, 0x81, 0xc3, 0xb1, 0x1e, 0xf7, 0xf6, 0x79, 0x94, 0x2c, 0x93, 0xf7, 0xb5, 0x2b, 0xc0,
+	0xa0, 0xf0, 0x48, 0x7a, 0xc4, 0xfa, 0x98, 0x97, 0x92, 0xcd, 0x13, 0xdd, 0x12, 0x60, 0x50, 0x78,
+	0xfa, 0x84, 0x54, 0xd9, 0x49, 0xd7, 0x8f, 0x58, 0xfc, 0x4a, 0x8e, 0x46, 0x6e, 0xd3, 0x6d, 0x28,
+	0x06, 0x60, 0x78, 0xa1, 0xf7, 0x9d, 0xa6, 0xfb, 0xf9, 0x35, 0x98, 0xbe, 0xef, 0xa6, 0x4d, 0xdf,
+	0x31, 0x23, 0xa5, 0x69, 0xf5, 0x87, 0x58, 0xc0, 0x7f, 0xdd, 0x21, 0xb3, 0x76, 0xd0, 0x9c, 0x1e,
+	0x64, 0x96, 0xeb, 0x87, 0xe9, 0xe5, 0xfa, 0xc5, 0xe9, 0xf2, 0x0f, 0xe4, 0xd5, 0xd9, 0x39, 0xf0,
+	0x93, 0xb0, 0x1b, 0x7f, 0x84, 0x05, 0x07, 0x7e, 0xc0, 0x78, 0x48, 0x48, 0x04, 0xdb, 0x53, 0x11,
+	0xf9, 0xb5, 0xb0, 0xc9, 0x5e, 0x61, 0xbd, 0x77, 0x9f, 0x90, 0xa5, 0x81, 0xac, 0xe3, 0x11, 0x96,
+	0xe6, 0x33, 0xaf, 0xf7, 0xb8, 0x6d, 0xc2, 0xef, 0x6e, 0x5b, 0xf7, 0xa0, 0xf7, 0xfd, 0xc0, 0x8b,
+	0xfa, 0x48, 0x92, 0x4d, 0xf0, 0xac, 0x6b, 0x0c, 0x58, 0x54, 0x76, 0x3e, 0x63, 0xe9, 0x8c, 0xa4,
+	0xe6, 0x9f, 0x71, 0xc8, 0x5c, 0x2a, 0x45, 0xbc, 0xa0, 0xed, 0x85, 0x4f, 0xee, 0x90, 0x67, 0x77,
+	0x44, 0x7e, 0x20, 0x22, 0x1e, 0x15, 0x6b, 0x72, 0x1b, 0x14, 0xd8, 0x74, 0xee, 0xbf, 0x71, 0xc8,
+	0xd2, 0xdd, 0x30, 0x3c, 0x02, 0x96, 0x44, 0xfd, 0xdd, 0x24, 0xf2, 0x12, 0x76, 0x30, 0xe2, 0x96,
+	0xd7, 0xe6, 0x09, 0x32, 0xc2, 0xc9, 0xa5, 0x75, 0x12, 0x59, 0x31, 0x02, 0x47, 0x9f, 0x91, 0xe9,
+	0x7d, 0x11, 0x52, 0x2b, 0xc6, 0xb6, 0x94, 0xf1, 0x39, 0xee, 0x95, 0x50, 0xc1, 0xba, 0x17, 0xe6,
+	0x5f, 0x50, 0xd2, 0xdc, 0x9f, 0x2d, 0x91, 0x8a, 0x0a, 0x7f, 0x8e, 0xd0, 0x98, 0x2f, 0x38, 0x64,
+	0x4e, 0x3b, 0xf2, 0xf0, 0x9d, 0x62, 0x72, 0x8f, 0x51, 0x03, 0xe3, 0x73, 0x6d, 0x85, 0xc6, 0xcf,
+	0x00, 0xb6, 0x30, 0x48, 0xcb, 0xa6, 0x8f, 0x31, 0x1b, 0x2c, 0x4e, 0x58, 0xc7, 0x72, 0x34, 0xb8,
+	0xd6, 0x0a, 0xb3, 0xd2, 0x08, 0x23, 0x86, 0xeb, 0x09, 0x06, 0x8d, 0x77, 0x35, 0xa5, 0x75, 0x5b,
+	0x5f, 0xc3, 0xc0, 0xe2, 0xe4, 0xfe, 0xed, 0x12, 0x59, 0xcc, 0xaa, 0x44, 0x7f, 0x08, 0x93, 0x40,
+	0x64, 0xa0, 0xda, 0xeb, 0x64, 0x63, 0xbe, 0xb3, 0x60, 0xe1, 0x5e, 0x9c, 0x2e, 0x2f, 0x0f, 0xd6,
+	0xa8, 0x5a, 0xb1, 0x49, 0x20, 0xc5, 0x4c, 0x78, 0x53, 0x65, 0x98, 0xa6, 0xde, 0x5f, 0xed, 0x76,
+	0x6b, 0xa5, 0xac, 0x37, 0xd5, 0xc6, 0x42, 0x86, 0x9a, 0xee, 0x90, 0xcb, 0x16, 0xe4, 0x01, 0xf3,
+	0x0f, 0x0e, 0xf7, 0x31, 0x71, 0xbf, 0xcc, 0xb9, 0xbc, 0x5f, 0x72, 0xb9, 0x0c, 0x39, 0x34, 0x90,
+	0xfb, 0x26, 0xda, 0xcb, 0x0d, 0xaf, 0xeb, 0x35, 0xfc, 0xa4, 0x2f, 0x3d, 0x27, 0x7a, 0x2d, 0x5e,
+	0x93, 0x70, 0xd0, 0x14, 0xee, 0x7d, 0x32, 0x31, 0xe2, 0x08, 0x1a, 0xc9, 0x02, 0x7c, 0x9b, 0x54,
+	0x90, 0x1d, 0xae, 0xbd, 0x45, 0xb1, 0x0c, 0x49, 0x45, 0xdd, 0x08, 0xa7, 0x2e, 0x29, 0xfb, 0x9e,
+	0x72, 0x58, 0xeb, 0x66, 0x6d, 0xc6, 0x71, 0x8f, 0xdb, 0xb7, 0x88, 0xa4, 0x1f, 0x20, 0x65, 0x76,
+	0xd2, 0xcd, 0x7a, 0xa6, 0xcd, 0xee, 0x87, 0x58, 0x7a, 0x8d, 0x94, 0xfc, 0xa6, 0xdc, 0xed, 0x89,
+	0xa4, 0x29, 0x6d, 0xae, 0x43, 0xc9, 0x6f, 0xba, 0x27, 0xa4, 0xaa, 0x04, 0xf2, 0x7c, 0x05, 0xb1,
+	0x57, 0x39, 0x45, 0x9c, 0x9f, 0x14, 0xdf, 0x21, 0xbb, 0x54, 0x8f, 0x10, 0x73, 0x63, 0xa1, 0xa8,
+	0x55, 0xf3, 0x06, 0x99, 0x68, 0x84, 0xf2, 0x2e, 0x93, 0x95, 0xe1, 0xca, 0x37, 0x29, 0x8e, 0x71,
+	0x9b, 0x64, 0x21, 0x13, 0xdb, 0xc6, 0xd3, 0x8c, 0x8f, 0xbd, 0x3a, 0x10, 0xa1, 0xe6, 0x7d, 0x1d,
+	0x81, 0xc4, 0x4a, 0x73, 0x87, 0x87, 0xf0, 0x4a, 0x03, 0xe6, 0x8e, 0x08, 0xe1, 0x49, 0xbc, 0xfb,
+	0x84, 0xcc, 0x6f, 0x05, 0xe1, 0xb3, 0x00, 0x6d, 0x1f, 0x6d, 0x96, 0xb6, 0xf0, 0x9f, 0xac, 0x45,
+	0xc7, 0xb1, 0x20, 0x70, 0xfa, 0x36, 0x78, 0x69, 0xd8, 0x6d, 0x70, 0xf7, 0xa7, 0x1c, 0xb2, 0x98,
+	0xbd, 0x03, 0xf1, 0x4d, 0x73, 0x87, 0x7c, 0xcd, 0x21, 0xf9, 0x45, 0x49, 0x70, 0xa5, 0x68, 0x87,
+	0x1e, 0x16, 0x15, 0x4a, 0x22, 0x9f, 0xe7, 0x52, 0x38, 0xe9, 0x3b, 0xb6, 0xdb, 0x29, 0x2c, 0x64,
+	0xa8, 0xe9, 0x3d, 0x42, 0x59, 0xe0, 0xed, 0xb7, 0xd9, 0x2a, 0x8e, 0x25, 0x71, 0x4a, 0x8e, 0xb9,
+	0xba, 0x15, 0x13, 0xff, 0xda, 0x18, 0xa0, 0x80, 0x9c, 0xb7, 0xd0, 0xef, 0xc7, 0x4e, 0x92, 0xc8,
+	0xc3, 0xb3, 0x95, 0xbc, 0x7d, 0x21, 0x6d, 0x44, 0x09, 0x04, 0x83, 0x77, 0xff, 0x5a, 0x89, 0x2c,
+	0xea, 0x26, 0xa9, 0xd6, 0xbc, 0x45, 0x66, 0xf7, 0xad, 0xd6, 0xc9, 0xb6, 0xe8, 0x9b, 0x11, 0x76,
+	0xcb, 0x21, 0x45, 0x99, 0xb1, 0x3e, 0x4a, 0x23, 0x59, 0x1f, 0x5f, 0x71, 0xc8, 0x25, 0x19, 0x0a,
+	0xb6, 0x39, 0xcb, 0x9d, 0xe3, 0x42, 0xca, 0xcb, 0xbc, 0xf9, 0xfc, 0x74, 0xf9, 0xd2, 0xce, 0xa0,
+	0x4c, 0xc8, 0x53, 0xc4, 0xfd, 0x67, 0x65, 0x52, 0x13, 0x2e, 0x8c, 0xa6, 0x4e, 0x1a, 0xb8, 0xaf,
+	0xec, 0xdd, 0x9f, 0x72, 0x74, 0xf4, 0xda, 0x29, 0xa2, 0x78, 0xc9, 0x30, 0x41, 0x23, 0x85, 0xb3,
+	0xbf, 0x92, 0x09, 0x67, 0x0b, 0x33, 0xe0, 0xe0, 0x82, 0x34, 0x3a, 0x7f, 0x7c, 0xfb, 0x9b, 0x19,
+	0x2f, 0xfd, 0xdf, 0x0e, 0xc9, 0x14, 0xa7, 0xa1, 0x9b, 0xe4, 0x12, 0xee, 0xb2, 0x7e, 0xc4, 0x9a,
+	0x16, 0x6b, 0xe9, 0xd4, 0xe6, 0x63, 0x04, 0x06, 0xd1, 0x90, 0xf7, 0x0e, 0xfd, 0xcb, 0x0e, 0x59,
+	0x68, 0xa9, 0x03, 0x3a, 0x5f, 0xe3, 0x0a, 0xaa, 0xa5, 0x97, 0x7f, 0xea, 0x37, 0x31, 0xa3, 0xdb,
+	0x69, 0xa1, 0x90, 0xd5, 0xc2, 0xfd, 0xcd, 0x32, 0x31, 0x35, 0x3a, 0xa8, 0x2f, 0xf3, 0xa2, 0x9d,
+	0x22, 0x82, 0x7f, 0xa2, 0xe0, 0x90, 0x64, 0x2d, 0x1c, 0x3a, 0x56, 0x5a, 0xf4, 0x4f, 0x3a, 0xe8,
+	0x23, 0xf1, 0x13, 0xdf, 0xe3, 0x46, 0x4c, 0xad, 0x54, 0x44, 0x8c, 0x4f, 0x8b, 0xdb, 0x14, 0x9c,
+	0xc3, 0xc8, 0xf6, 0xba, 0x68, 0x61, 0x60, 0x4b, 0xa6, 0x9f, 0x92, 0xf9, 0x35, 0xe5, 0xc2, 0x52,
+	0xf0, 0x2b, 0x99, 0xa4, 0x9a, 0x2e, 0x99, 0x8c, 0xf0, 0x04, 0x52, 0x9b, 0x28, 0xa2, 0x5f, 0x53,
+	0x87, 0x19, 0xab, 0x02, 0x21, 0x82, 0x41, 0x08, 0x72, 0x63, 0x42, 0x07, 0xfb, 0xe2, 0x9c, 0xb1,
+	0x70, 0x8c, 0xf6, 0xf7, 0x92, 0xb0, 0x83, 0xdd, 0x24, 0x37, 0x1b, 0x13, 0xed, 0x57, 0x08, 0x30,
+	0x34, 0xee, 0x17, 0x27, 0x49, 0x26, 0x51, 0x99, 0x9e, 0xd8, 0xf5, 0x65, 0x9c, 0x62, 0xeb, 0xcb,
+	0x68, 0x65, 0xf2, 0x6a, 0xcc, 0xd0, 0x03, 0x32, 0xd9, 0x3d, 0xf4, 0x62, 0xb5, 0xab, 0xbf, 0xad,
+	0xd3, 0x22, 0x11, 0xf8, 0xe2, 0x74, 0xf9, 0x07, 0x47, 0x3b, 0xe3, 0xe3, 0x58, 0xbd, 0x29, 0x6e,
+	0xbf, 0x19, 0xd1, 0x9c, 0x07, 0x08, 0xfe, 0xf6, 0x29, 0xbf, 0x7c, 0x86, 0x57, 0xf7, 0x27, 0x1c,
+	0x71, 0x15, 0x06, 0x58, 0xdc, 0x6b, 0x27, 0x72, 0x34, 0xbc, 0x5d, 0xe0, 0x2c, 0x13, 0x8c, 0xcd,
+	0x9d, 0x18, 0xf1, 0x0c, 0x96, 0x50, 0xfa, 0x43, 0xa4, 0x1a, 0x27, 0x5e, 0x94, 0xbc, 0x62, 0x52,
+	0xbc, 0xee, 0xf4, 0x5d, 0xc5, 0x04, 0x0c, 0x3f, 0xcc, 0x43, 0x6f, 0xf9, 0x81, 0x1f, 0x1f, 0xbe,
+	0x62, 0x5a, 0x1c, 0x57, 0xfc, 0xb6, 0xe6, 0x00, 0x16, 0x37, 0x34, 0x1e, 0xf8, 0xd8, 0x16, 0x81,
+	0xe1, 0x0a, 0xb7, 0xf1, 0xb5, 0xf1, 0x00, 0x1a, 0x03, 0x16, 0x95, 0xfb, 0x19, 0x72, 0x29, 0x5b,
+	0xe4, 0x51, 0xfa, 0x13, 0x8b, 0x70, 0x8a, 0x9e, 0x5d, 0x78, 0xe7, 0xd7, 0x1c, 0x72, 0xe3, 0xac,
+	0x5a, 0x94, 0xe8, 0xf8, 0x7e, 0xe6, 0x45, 0x81, 0x2c, 0x90, 0xc0, 0xd7, 0x8e, 0x27, 0x5e, 0x14,
+	0x00, 0x87, 0x62, 0xfa, 0x9b, 0xb8, 0x35, 0x24, 0x37, 0x8c, 0xb7, 0x8b, 0xad, 0x8c, 0xb9, 0xc5,
+	0x2c, 0x7b, 0x41, 0xdc, 0x58, 0x02, 0x29, 0xd0, 0xfd, 0xa2, 0x43, 0xe8, 0xc3, 0x63, 0x16, 0x45,
+	0x7e, 0xd3, 0xba, 0xe7, 0x84, 0x09, 0xf3, 0x4f, 0x77, 0x1f, 0x3e, 0xd8, 0x09, 0xfd, 0x80, 0xdf,
+	0x64, 0xb6, 0x12, 0xe6, 0xef, 0x59, 0x70, 0x48, 0x51, 0xd1, 0x35, 0xb2, 0x94, 0xf5, 0x07, 0x2b,
+	0x5f, 0x3f, 0x4f, 0xef, 0xc9, 0xba, 0x8f, 0x63, 0x18, 0xa4, 0x77, 0x7f, 0xae, 0x44, 0xa8, 0x98,
+	0x7c, 0x29, 0x87, 0xce, 0xbe, 0x9a, 0xeb, 0xe2, 0x7b, 0x6e, 0x67, 0xe7, 0xfa, 0xc7, 0xcf, 0x3f,
+	0xd7, 0xf9, 0x8d, 0x32, 0x7b, 0x9a, 0xbf, 0xb7, 0x5d, 0x42, 0xbf, 0xee, 0x90, 0xf7, 0xbf, 0xac,
+	0x9e, 0x60, 0x51, 0x43, 0xfe, 0x26, 0xa9, 0x0a, 0xaf, 0xe7, 0x76, 0xcf, 0x93, 0xe3, 0x5e, 0xaf,
+	0x08, 0x77, 0x15, 0x02, 0x0c, 0x0d, 0xae, 0x8e, 0x5e, 0x43, 0x18, 0x4e, 0x99, 0x32, 0x19, 0xab,
+	0x02, 0x0c, 0x0a, 0xef, 0xfe, 0x4a, 0x89, 0xcc, 0x58, 0xb5, 0x75, 0x47, 0x38, 0x04, 0x67, 0xca,
+	0x01, 0x97, 0x46, 0x2c, 0x07, 0xfc, 0x21, 0x52, 0xe9, 0xa2, 0x89, 0xe7, 0xeb, 0xfb, 0xe7, 0x3c,
+	0x80, 0xba, 0x23, 0x61, 0xa0, 0xb1, 0xf4, 0x19, 0xa9, 0xea, 0xda, 0x78, 0xb5, 0x89, 0x42, 0xdd,
+	0x00, 0xba, 0xdb, 0x4c, 0xcd, 0x3b, 0x23, 0x0b, 0xb3, 0xee, 0x0f, 0x44, 0x6a, 0xc6, 0xa4, 0xb9,
+	0x5e, 0x22, 0xf3, 0x32, 0x24, 0xc6, 0xfd, 0xe5, 0x29, 0x52, 0x05, 0xd6, 0x0d, 0xd7, 0x22, 0xd6,
+	0x8c, 0xe9, 0xb7, 0x91, 0x72, 0x2f, 0x6a, 0xcb, 0xce, 0xd2, 0x51, 0x2c, 0x2c, 0x06, 0x85, 0xf0,
+	0xd4, 0xd6, 0x5f, 0x3a, 0x57, 0x1a, 0x5c, 0xf9, 0xcc, 0x34, 0x38, 0xcc, 0x3b, 0x8a, 0x0f, 0x77,
+	0x22, 0xff, 0xd8, 0x4b, 0x70, 0x41, 0x91, 0x5f, 0xda, 0xe4, 0x1d, 0xed, 0xde, 0x35, 0x48, 0x48,
+	0xd3, 0x62, 0xda, 0x8f, 0x49, 0x46, 0x63, 0x11, 0xbf, 0xbf, 0x2b, 0xe3, 0x27, 0x3a, 0xed, 0xc7,
+	0xa4, 0xaf, 0x49, 0x02, 0x18, 0x7c, 0x07, 0x43, 0x54, 0x29, 0x20, 0x2a, 0x32, 0x95, 0x0e, 0x51,
+	0xa5, 0xf8, 0xa0, 0x2e, 0x03, 0x6f, 0xd0, 0xfb, 0xe4, 0x92, 0xf8, 0xbe, 0xbc, 0xa6, 0xa2, 0x6e,
+	0xd1, 0x34, 0x67, 0xf4, 0x47, 0x24, 0xa3, 0x4b, 0x77, 0x06, 0x49, 0x20, 0xef, 0x3d, 0x1c, 0xa1,
+	0x1a, 0xbc, 0xb9, 0x2e, 0x77, 0x2d, 0x3d, 0x42, 0x35, 0x9b, 0xcd, 0x26, 0xd8, 0x74, 0xf4, 0x1d,
+	0xf2, 0xa6, 0x79, 0x14, 0x01, 0x42, 0x61, 0xca, 0xad, 0xcb, 0xbc, 0xec, 0x65, 0xc9, 0xe2, 0xcd,
+	0x3b, 0xb9, 0x64, 0x4d, 0x18, 0xf6, 0x3e, 0xdd, 0x27, 0xd7, 0x34, 0x6a, 0x03, 0x97, 0xe6, 0x6e,
+	0xe4, 0xc7, 0xac, 0xee, 0xc5, 0xec, 0x51, 0xd4, 0xe6, 0x99, 0xdc, 0x55, 0x53, 0xfd, 0xf2, 0x8e,
+	0x9f, 0xdc, 0xcd, 0xa3, 0x84, 0x6d, 0x78, 0x09, 0x17, 0x5c, 0x25, 0x84, 0xe7, 0xe1, 0xe1, 0xda,
+	0x66, 0x6d, 0x26, 0x6d, 0x39, 0x6e, 0x28, 0x04, 0x18, 0x1a, 0xed, 0xe9, 0x99, 0x1d, 0x5a, 0xf7,
+	0xef, 0x2d, 0x32, 0xeb, 0xf5, 0x92, 0x43, 0x15, 0xb6, 0xad, 0xcd, 0xa5, 0x9d, 0x0e, 0xab, 0x16,
+	0x0e, 0x52, 0x94, 0xee, 0xef, 0x38, 0x64, 0x4e, 0x4f, 0x93, 0xd7, 0x10, 0xe2, 0x6a, 0xa7, 0x43,
+	0x5c, 0x77, 0xc6, 0x35, 0xf6, 0xa5, 0xe6, 0x43, 0xfc, 0x86, 0xbf, 0x36, 0x43, 0x08, 0xd2, 0xc4,
+	0x3e, 0xbf, 0x7e, 0x79, 0x83, 0x4c, 0x44, 0xac, 0x1b, 0x66, 0xd7, 0x4c, 0xa4, 0x00, 0x8e, 0x79,
+	0xef, 0x2e, 0x04, 0x79, 0x09, 0x95, 0x93, 0xdf, 0xdc, 0x84, 0xca, 0x5d, 0x72, 0xc5, 0x0f, 0x62,
+	0xd6, 0xe8, 0x45, 0xd2, 0xfe, 0xc1, 0x00, 0x83, 0x5a, 0x57, 0x2a, 0xa6, 0x6a, 0xe1, 0x66, 0x1e,
+	0x11, 0xe4, 0xbf, 0x8b, 0x5d, 0xaa, 0x10, 0xb2, 0x5e, 0x86, 0xf1, 0x66, 0x4b, 0x38, 0x68, 0x0a,
+	0x33, 0x95, 0xb6, 0x5b, 0xaa, 0x20, 0x46, 0x66, 0x2a, 0x6d, 0xdf, 0xde, 0x05, 0x43, 0x93, 0xbf,
+	0x9e, 0x56, 0x0b, 0x5a, 0x4f, 0xc9, 0xb9, 0xd7, 0x53, 0x35, 0xb3, 0x67, 0x86, 0xce, 0x6c, 0xb5,
+	0xcd, 0xcf, 0x0e, 0xdd, 0xe6, 0x3f, 0x41, 0xe6, 0xfd, 0xe0, 0x90, 0x45, 0x7e, 0xc2, 0x9a, 0x7c,
+	0x2e, 0xf0, 0xd9, 0x5f, 0x31, 0xee, 0xd3, 0xcd, 0x14, 0x16, 0x32, 0xd4, 0xe9, 0xe5, 0x68, 0x7e,
+	0x84, 0xe5, 0x68, 0xc8, 0x26, 0xb0, 0x50, 0xcc, 0x26, 0xb0, 0x38, 0xfe, 0x26, 0xb0, 0x74, 0xa1,
+	0x9b, 0x00, 0x2d, 0x64, 0x13, 0xc0, 0x7b, 0x89, 0x51, 0x78, 0xd2, 0xaf, 0x5d, 0xca, 0xdc, 0x4b,
+	0x44, 0x20, 0x08, 0x9c, 0x7d, 0x0f, 0xe8, 0xf2, 0x19, 0xf7, 0x80, 0xb2, 0x3b, 0xc0, 0x95, 0x51,
+	0x77, 0x00, 0xfa, 0x83, 0x64, 0x51, 0x7c, 0xdb, 0xdd, 0xde, 0x7e, 0x27, 0x6c, 0xf6, 0xb0, 0x50,
+	0xc9, 0x55, 0x3e, 0x0c, 0x2e, 0xe3, 0x28, 0xde, 0xc8, 0xe0, 0x60, 0x80, 0x1a, 0x6b, 0xd4, 0xc4,
+	0xfa, 0xe9, 0x51, 0xcc, 0xf4, 0xaa, 0x5c, 0x7b, 0x33, 0x5d, 0xa3, 0x66, 0x37, 0x97, 0x0a, 0x86,
+	0xbc, 0xed, 0x7e, 0xbe, 0x44, 0xae, 0x98, 0xd5, 0x1b, 0xe7, 0x8c, 0xb8, 0xfe, 0xc8, 0x2b, 0x31,
+	0x89, 0xfc, 0x6c, 0x2b, 0x6e, 0x69, 0x42, 0xa0, 0x1a, 0x03, 0x16, 0x15, 0x0f, 0xff, 0xb1, 0x88,
+	0x5f, 0x4f, 0xcd, 0x2e, 0xed, 0x6b, 0x12, 0x0e, 0x9a, 0x02, 0x47, 0x25, 0xfe, 0x2f, 0x73, 0x53,
+	0xb2, 0x97, 0x17, 0xd6, 0x0c, 0x0a, 0x6c, 0x3a, 0x34, 0x9e, 0x1b, 0x6a, 0x59, 0xc1, 0xe5, 0x7d,
+	0x56, 0x18, 0xcf, 0x7a, 0x25, 0xd1, 0x58, 0xa5, 0x0e, 0x8f, 0xf3, 0x4e, 0x0e, 0xaa, 0x83, 0x70,
+	0xd0, 0x14, 0xee, 0xff, 0x74, 0xc8, 0xfb, 0x72, 0xbb, 0xe2, 0x35, 0x6c, 0xd9, 0x27, 0xe9, 0x2d,
+	0x7b, 0x77, 0xfc, 0x2d, 0x7b, 0xa0, 0x15, 0x43, 0xb6, 0xef, 0x7f, 0xeb, 0x90, 0x79, 0x43, 0xff,
+	0x1a, 0x9a, 0xea, 0x17, 0xfa, 0x3b, 0x34, 0x46, 0xf5, 0x7a, 0x75, 0xa0, 0x6d, 0xbf, 0xc3, 0xdb,
+	0x26, 0x0e, 0xa3, 0xe2, 0xb0, 0x37, 0xc2, 0x91, 0x0e, 0x8b, 0x89, 0x62, 0x24, 0x2f, 0x2e, 0xc6,
+	0xdd, 0x91, 0x96, 0xcf, 0x63, 0x84, 0xc6, 0xdd, 0xc1, 0x1f, 0x63, 0x90, 0x02, 0xf9, 0xbd, 0x68,
+	0x3f, 0xc6, 0x99, 0xdf, 0x94, 0x11, 0x53, 0x73, 0x2f, 0x5a, 0xc2, 0x41, 0x53, 0xb8, 0x1d, 0x52,
+	0x4b, 0x33, 0x5f, 0x67, 0x2d, 0xee, 0x55, 0x1e, 0xa9, 0x99, 0xe8, 0x5b, 0xe5, 0x6f, 0xe1, 0x39,
+	0x3a, 0x53, 0x40, 0x79, 0x55, 0x21, 0xc0, 0xd0, 0xb8, 0x9f, 0x73, 0xc8, 0xa5, 0x9c, 0xc6, 0x8c,
+	0x96, 0x23, 0x94, 0x98, 0x09, 0x9e, 0xb7, 0x03, 0x7f, 0x90, 0x4c, 0xcb, 0x02, 0xcb, 0xd2, 0x60,
+	0xe3, 0x19, 0xfb, 0xb2, 0x14, 0x33, 0x28, 0x9c, 0xfb, 0xfb, 0x0e, 0x59, 0x48, 0xab, 0x10, 0x63,
+	0x64, 0x52, 0xe8, 0xa8, 0x93, 0x76, 0xb1, 0x41, 0x42, 0x19, 0x1d, 0x99, 0x5c, 0x1d, 0xa0, 0x80,
+	0x9c, 0xb7, 0xf8, 0x6d, 0xcb, 0xa6, 0xee, 0x44, 0x35, 0x00, 0x1e, 0x17, 0x39, 0x00, 0xcc, 0x37,
+	0xb2, 0xdd, 0x04, 0x5a, 0x24, 0xd8, 0xf2, 0xdd, 0xdf, 0x9d, 0x20, 0x3a, 0x43, 0x84, 0x3b, 0xbe,
+	0x8a, 0xf3, 0xa1, 0x98, 0xe2, 0xd9, 0xe5, 0x73, 0x14, 0xf8, 0x9e, 0x78, 0x99, 0x23, 0x44, 0x94,
+	0x65, 0x36, 0x66, 0xb3, 0xb5, 0x96, 0xef, 0x19, 0x14, 0xd8, 0x74, 0xa8, 0x49, 0xdb, 0x3f, 0x66,
+	0xe2, 0xa5, 0xa9, 0xb4, 0x26, 0xdb, 0x0a, 0x01, 0x86, 0x06, 0x35, 0x69, 0xfa, 0xad, 0x56, 0x6d,
+	0x3a, 0xad, 0x09, 0xf6, 0x0e, 0x70, 0x0c, 0x52, 0x1c, 0x86, 0xe1, 0x91, 0x34, 0x55, 0x35, 0x05,
+	0x4f, 0xc2, 0xe2, 0x18, 0x34, 0xae, 0x82, 0x30, 0xea, 0x78, 0x6d, 0xff, 0xc7, 0x58, 0x53, 0x4b,
+	0xa9, 0x55, 0xd3, 0xc6, 0xd5, 0x83, 0x41, 0x12, 0xc8, 0x7b, 0x0f, 0x47, 0x60, 0x37, 0x62, 0x4d,
+	0xbf, 0x91, 0xd8, 0xdc, 0x48, 0x7a, 0x04, 0xee, 0x0c, 0x50, 0x40, 0xce, 0x5b, 0x74, 0x95, 0x2c,
+	0xa8, 0x0c, 0x1f, 0x95, 0xef, 0x2b, 0xec, 0x56, 0x7d, 0x64, 0x80, 0x34, 0x1a, 0xb2, 0xf4, 0xb8,
+	0x88, 0x74, 0x64, 0xd6, 0x75, 0x6d, 0x36, 0xbd, 0x88, 0xa8, 0x6c, 0x6c, 0xd0, 0x14, 0xee, 0xaf,
+	0x96, 0x70, 0xd3, 0x1b, 0x52, 0x49, 0xea, 0xb5, 0xb9, 0xa9, 0xd3, 0x23, 0x72, 0x62, 0x84, 0x11,
+	0x89, 0x2e, 0xe0, 0x38, 0x0c, 0xb4, 0x0b, 0x78, 0x72, 0xa8, 0x0b, 0xd8, 0xa2, 0xca, 0x77, 0x01,
+	0x4f, 0x9d, 0xd3, 0x05, 0xfc, 0xaf, 0x27, 0x89, 0xfe, 0x4d, 0x94, 0x07, 0x2c, 0x79, 0x16, 0x46,
+	0x47, 0x7e, 0x70, 0xc0, 0x13, 0x99, 0x7e, 0xc9, 0x21, 0xb3, 0x62, 0x78, 0x6f, 0xdb, 0x21, 0xf7,
+	0x56, 0x41, 0x95, 0x4e, 0x52, 0xc2, 0x56, 0xf6, 0x2c, 0x41, 0x99, 0xda, 0x90, 0x36, 0x0a, 0x52,
+	0x1a, 0xd1, 0x4f, 0x13, 0x22, 0x9e, 0x81, 0xb5, 0x0a, 0xaa, 0x22, 0xaf, 0xf4, 0x03, 0xd6, 0x32,
+	0x16, 0xe2, 0x9e, 0x16, 0x02, 0x96, 0x40, 0x2c, 0x59, 0xa4, 0xd2, 0x11, 0x44, 0xb4, 0xf3, 0x53,
+	0x17, 0xd2, 0x37, 0xa3, 0x24, 0x23, 0x00, 0xd6, 0x4f, 0x3e, 0xc0, 0xcf, 0x2a, 0x1d, 0xab, 0xdf,
+	0x91, 0x97, 0x04, 0x88, 0x89, 0x31, 0x75, 0xaf, 0xed, 0x05, 0x0d, 0xbc, 0x76, 0xc9, 0xc9, 0xed,
+	0x42, 0xcb, 0x1c, 0x00, 0x8a, 0xd1, 0x40, 0x29, 0x9f, 0xc9, 0x51, 0x4a, 0xf9, 0x60, 0xa1, 0xc8,
+	0x81, 0x8f, 0x79, 0xae, 0xdc, 0x83, 0x57, 0x4f, 0x5b, 0x70, 0xff, 0xc9, 0x94, 0xd9, 0x63, 0x30,
+	0xe1, 0x91, 0x17, 0x94, 0x89, 0xcc, 0x17, 0x95, 0x16, 0x60, 0x81, 0x43, 0xc4, 0x2a, 0xbf, 0xac,
+	0x81, 0x60, 0x8b, 0xc4, 0x31, 0xda, 0xf5, 0x22, 0x16, 0x5c, 0xf4, 0x18, 0xdd, 0xd1, 0x42, 0xc0,
+	0x12, 0x48, 0x0f, 0x53, 0xe1, 0xf8, 0xdb, 0xe3, 0x87, 0xe3, 0xd1, 0x28, 0xcd, 0xad, 0x75, 0xf1,
+	0x25, 0x87, 0xcc, 0x07, 0xa9, 0x91, 0x2b, 0x43, 0xb2, 0x7b, 0x17, 0x31, 0x2b, 0x44, 0xc5, 0x80,
+	0x34, 0x0c, 0x32, 0xf2, 0xf3, 0x76, 0xa0, 0xc9, 0x73, 0xee, 0x40, 0xa6, 0x32, 0xd5, 0xd4, 0xb0,
+	0xca, 0x54, 0x34, 0xd0, 0x35, 0xe9, 0xa6, 0x0b, 0xaf, 0x49, 0x47, 0x72, 0xea, 0xd1, 0x3d, 0x21,
+	0xd5, 0x46, 0xc4, 0xbc, 0xe4, 0x15, 0xcb, 0x93, 0xf1, 0x04, 0xb5, 0x35, 0xc5, 0x00, 0x0c, 0x2f,
+	0xf7, 0xff, 0x4e, 0x90, 0x45, 0xd5, 0x23, 0x2a, 0x54, 0x99, 0x0e, 0x52, 0x39, 0x23, 0x04, 0xa9,
+	0xbe, 0x9b, 0xcc, 0xf4, 0x62, 0xf6, 0xb0, 0xcb, 0x02, 0xac, 0x01, 0x2d, 0x6b, 0xb4, 0xeb, 0x89,
+	0xf2, 0xc8, 0xa0, 0xc0, 0xa6, 0xb3, 0x63, 0x5b, 0xe5, 0x97, 0xc7, 0xb6, 0xe8, 0x2f, 0xe4, 0x56,
+	0xa2, 0x2c, 0x26, 0xe7, 0x65, 0x20, 0x42, 0x7b, 0xce, 0x12, 0x94, 0x7f, 0xd3, 0x21, 0x57, 0x04,
+	0x54, 0xf5, 0xe4, 0xa3, 0x6e, 0xd3, 0x4b, 0xf8, 0x00, 0xba, 0x18, 0xfd, 0x8c, 0xe3, 0x34, 0x4f,
+	0x2c, 0xe4, 0x6b, 0x83, 0xe5, 0xde, 0x17, 0x8e, 0x52, 0x69, 0xa4, 0x6a, 0xeb, 0x18, 0xf3, 0x1a,
+	0x49, 0x3a, 0x37, 0xd5, 0x4c, 0xb5, 0x34, 0x3c, 0x86, 0xac, 0x74, 0xf7, 0x0f, 0x1c, 0x62, 0x2f,
+	0xa3, 0xa3, 0x19, 0x6c, 0xa3, 0x5f, 0xa7, 0xd0, 0xb6, 0x5d, 0x79, 0xb4, 0xb3, 0xc4, 0xc4, 0x39,
+	0xce, 0x12, 0x93, 0x43, 0x8d, 0x41, 0x0c, 0x24, 0xfa, 0xcd, 0xda, 0x54, 0x26, 0x90, 0xb8, 0xb9,
+	0x0e, 0x08, 0x77, 0xff, 0xd1, 0xa4, 0x39, 0xd5, 0xcb, 0x94, 0x92, 0x6f, 0x89, 0x66, 0xb7, 0xf4,
+	0xad, 0x20, 0xd1, 0xf2, 0x07, 0x03, 0xb7, 0x82, 0xbe, 0xff, 0xfc, 0x59, 0x04, 0xa2, 0x83, 0x86,
+	0x5d, 0x0a, 0x9a, 0x3e, 0x23, 0x5d, 0xe8, 0x29, 0xa9, 0xe0, 0x89, 0x89, 0xbb, 0xe7, 0x2a, 0x29,
+	0xa5, 0x2a, 0x77, 0x25, 0xfc, 0xc5, 0xe9, 0xf2, 0xf7, 0x9d, 0x5f, 0x2d, 0xf5, 0x36, 0x68, 0xfe,
+	0x34, 0x26, 0x55, 0xfc, 0x9f, 0xa7, 0x3c, 0xc8, 0xb3, 0xd8, 0x23, 0xbd, 0x66, 0x2a, 0x44, 0x21,
+	0x69, 0x53, 0x46, 0x0e, 0x0d, 0x48, 0x35, 0x56, 0x79, 0x16, 0xf2, 0xc8, 0xb6, 0xa3, 0x84, 0xea,
+	0x04, 0x8c, 0x71, 0xf3, 0x37, 0x8c, 0x08, 0xfc, 0x69, 0x8d, 0xf9, 0x74, 0x9d, 0xd8, 0x6f, 0x8d,
+	0xb1, 0xfb, 0x56, 0x66, 0xec, 0xde, 0x18, 0x18, 0xbb, 0xf3, 0xa6, 0x48, 0x6d, 0x6a, 0x34, 0xbe,
+	0x6e, 0x43, 0xe0, 0x6c, 0xf7, 0x00, 0xb7, 0x80, 0x78, 0x06, 0x6d, 0xbc, 0x13, 0xf5, 0x02, 0xbc,
+	0xed, 0x55, 0xe5, 0xc4, 0x96, 0x05, 0x94, 0x42, 0x43, 0x96, 0xde, 0xfd, 0xab, 0x65, 0x32, 0x97,
+	0x4e, 0x10, 0xd2, 0xc9, 0x3b, 0xce, 0x68, 0xc9, 0x3b, 0xa5, 0xd7, 0x99, 0xbc, 0x43, 0x4f, 0xc8,
+	0x14, 0xcf, 0x31, 0x52, 0x87, 0xb2, 0x31, 0x37, 0xdc, 0xc1, 0x04, 0x29, 0xcb, 0xe5, 0xc9, 0xe5,
+	0x80, 0x94, 0x47, 0x13, 0x2c, 0xc9, 0x19, 0x1e, 0xa9, 0x7d, 0x74, 0xdc, 0xdf, 0x4d, 0xc9, 0xde,
+	0xb4, 0xb3, 0x6b, 0x73, 0x86, 0x47, 0xbc, 0x36, 0x67, 0x78, 0x14, 0xbb, 0xff, 0xb5, 0x4c, 0x16,
+	0x32, 0x15, 0x85, 0xd1, 0x6f, 0xa2, 0x6a, 0x4d, 0x67, 0x63, 0x17, 0x8a, 0x14, 0x34, 0x05, 0xfd,
+	0x51, 0x42, 0x9a, 0xac, 0xdb, 0x0e, 0xfb, 0xdc, 0xa0, 0x9c, 0x38, 0xb7, 0x41, 0x69, 0x4a, 0xc3,
+	0x6b, 0x2e, 0x60, 0x71, 0x94, 0x77, 0x84, 0x26, 0x45, 0xc1, 0xcb, 0xf4, 0x1d, 0x21, 0xab, 0x16,
+	0xc9, 0xd4, 0xeb, 0xad, 0x45, 0xe2, 0x93, 0x05, 0xa1, 0xa2, 0x4e, 0xbd, 0x7c, 0x85, 0x0c, 0x4b,
+	0x51, 0x9a, 0x3f, 0xcd, 0x06, 0xb2, 0x7c, 0xd1, 0xa9, 0x16, 0x85, 0xed, 0x36, 0x6b, 0xe2, 0x58,
+	0x55, 0xfd, 0x5f, 0xab, 0xa4, 0x9d, 0x6a, 0x30, 0x40, 0x01, 0x39, 0x6f, 0xb9, 0xff, 0xbc, 0x44,
+	0x16, 0xd5, 0x83, 0xbe, 0x17, 0xf1, 0xed, 0x64, 0x0a, 0x43, 0x74, 0xe1, 0xc0, 0x25, 0xa3, 0x55,
+	0x0e, 0x05, 0x89, 0xa5, 0xdb, 0x64, 0x02, 0x8d, 0xbf, 0x5a, 0xe9, 0xdc, 0x0d, 0x35, 0xce, 0x49,
+	0x2f, 0x61, 0xc0, 0xb9, 0x60, 0xa6, 0x65, 0xe2, 0x1d, 0xa4, 0x7e, 0xf4, 0x65, 0xcf, 0xc3, 0x12,
+	0x03, 0x08, 0xb5, 0x77, 0xe6, 0x89, 0x33, 0x76, 0xe6, 0x8f, 0x5b, 0x3f, 0x80, 0x6d, 0xc5, 0xb7,
+	0x06, 0x7f, 0xb4, 0x5a, 0xdc, 0x80, 0x4c, 0xd1, 0xa2, 0x97, 0xa2, 0x71, 0xe8, 0x05, 0x07, 0xac,
+	0x29, 0x7e, 0x33, 0x61, 0xca, 0x78, 0x29, 0xd6, 0x2c, 0x38, 0xa4, 0xa8, 0xdc, 0xef, 0x22, 0xb3,
+	0xf6, 0x4f, 0x61, 0x8f, 0x74, 0x27, 0xde, 0xfd, 0xfb, 0x93, 0x64, 0x2e, 0x95, 0x20, 0x9c, 0x9a,
+	0x67, 0xce, 0x99, 0xf3, 0x8c, 0xc7, 0x70, 0x7b, 0x01, 0x93, 0xe9, 0xdf, 0x56, 0x0c, 0xb7, 0x17,
+	0x60, 0x66, 0x24, 0xfe, 0xc1, 0x6f, 0xd9, 0x8c, 0xfa, 0xd0, 0x0b, 0x64, 0xd4, 0x44, 0x7f, 0xcb,
+	0x75, 0x0e, 0x05, 0x89, 0x45, 0xd7, 0xc6, 0x6c, 0xcc, 0xb7, 0x21, 0xb1, 0x3a, 0xd4, 0x26, 0x8a,
+	0xd8, 0x72, 0x76, 0x2d, 0x8e, 0xa2, 0x13, 0x6d, 0x08, 0xa4, 0x24, 0x62, 0x99, 0x33, 0xab, 0xee,
+	0xfc, 0x54, 0x11, 0xd1, 0xbe, 0x6c, 0xfe, 0xb5, 0x98, 0xc3, 0x2f, 0x2f, 0x3f, 0x1f, 0xeb, 0x25,
+	0x64, 0xfa, 0x62, 0x96, 0x10, 0x92, 0xb3, 0x7c, 0x7c, 0x98, 0x54, 0xd5, 0xaf, 0x2d, 0xc7, 0x76,
+	0xd5, 0x7e, 0x75, 0x73, 0x24, 0x06, 0x83, 0xcf, 0xfe, 0x8c, 0x74, 0x75, 0x84, 0x9f, 0x91, 0xce,
+	0x5f, 0x33, 0xc8, 0x2b, 0xad, 0x19, 0x7f, 0xc7, 0x21, 0x57, 0x72, 0x3b, 0xf6, 0xbd, 0xeb, 0x13,
+	0x77, 0xff, 0x41, 0x89, 0x5c, 0xca, 0x49, 0xc6, 0xa7, 0xfd, 0x0b, 0xfb, 0xa9, 0x03, 0x21, 0x40,
+	0x7c, 0xc5, 0xdc, 0x71, 0x76, 0xbe, 0x4d, 0xd5, 0x6c, 0x6c, 0xe5, 0xd7, 0xba, 0xb1, 0xb9, 0xbf,
+	0x3f, 0x41, 0xac, 0x5f, 0xf0, 0xa0, 0x9f, 0xb1, 0xef, 0x9d, 0x38, 0x45, 0xdd, 0x91, 0x10, 0xcc,
+	0xf5, 0xbd, 0x15, 0x59, 0xec, 0x2c, 0xe7, 0x1a, 0x4b, 0x76, 0xec, 0x97, 0x46, 0x18, 0xfb, 0x6d,
+	0x75, 0xc1, 0xa7, 0x5c, 0xfc, 0x05, 0x9f, 0x6a, 0xf6, 0x72, 0x0f, 0x5e, 0x2a, 0xc4, 0x04, 0x97,
+	0x10, 0x27, 0x13, 0xda, 0x90, 0xc5, 0x78, 0x2d, 0xd3, 0x9d, 0xa4, 0x78, 0x8b, 0x25, 0xd5, 0x86,
+	0x40, 0x4a, 0x36, 0xfd, 0x55, 0x87, 0xd4, 0x3a, 0x43, 0xee, 0x00, 0xca, 0xfc, 0xbd, 0xc7, 0x17,
+	0x73, 0xc3, 0x90, 0xff, 0x6a, 0xd8, 0xd0, 0xab, 0x97, 0x30, 0x54, 0x2b, 0xf7, 0x36, 0xb9, 0x9a,
+	0xdf, 0xd8, 0xf3, 0x95, 0x46, 0x77, 0xff, 0x8a, 0x43, 0x2e, 0xa5, 0x19, 0x89, 0x01, 0xa4, 0x77,
+	0x4d, 0xe7, 0x25, 0xbb, 0xe6, 0x77, 0x92, 0x4a, 0xcc, 0xda, 0x2d, 0x3c, 0x1f, 0xc9, 0xdd, 0x55,
+	0x8b, 0xda, 0x95, 0x70, 0xd0, 0x14, 0xbc, 0x08, 0x11, 0x96, 0xcf, 0xda, 0xe8, 0x74, 0x93, 0xbe,
+	0xdc, 0x67, 0x4d, 0x11, 0x22, 0x8d, 0x01, 0x8b, 0xca, 0xfd, 0x1f, 0x8e, 0x98, 0x56, 0xf2, 0xa4,
+	0xfb, 0x56, 0xa6, 0xec, 0xc9, 0xe8, 0x87, 0xc4, 0x3f, 0x8b, 0x3f, 0xe9, 0xa1, 0x6a, 0xf1, 0x15,
+	0xf3, 0x9b, 0x29, 0xa6, 0xb6, 0x9f, 0xfd, 0x43, 0x1e, 0x0a, 0x06, 0x96, 0xbc, 0xd4, 0x22, 0x56,
+	0x3e, 0x6b, 0x11, 0x73, 0xff, 0x9b, 0x43, 0x52, 0x06, 0x00, 0xde, 0xbd, 0x43, 0x0d, 0xfa, 0xc5,
+	0x54, 0x0e, 0xb4, 0x59, 0xe3, 0x02, 0x27, 0xa7, 0x27, 0xff, 0x17, 0x84, 0x20, 0xda, 0x96, 0x67,
+	0xdc, 0x52, 0x11, 0x45, 0x34, 0x6d, 0x81, 0x78, 0x88, 0x92, 0x3f, 0x86, 0xac, 0xcf, 0xcb, 0xee,
+	0x5b, 0x64, 0x69, 0x40, 0x29, 0x7e, 0xbd, 0x3e, 0x8c, 0x1a, 0x03, 0x23, 0x90, 0x17, 0x4a, 0x01,
+	0x81, 0x73, 0x7f, 0xc5, 0x21, 0x8b, 0x59, 0xf6, 0x58, 0x81, 0x75, 0x29, 0xce, 0xf2, 0xbb, 0xa8,
+	0xbe, 0xd3, 0x7e, 0xea, 0x01, 0x14, 0x0c, 0x2a, 0xe1, 0xfe, 0x17, 0xb9, 0x4d, 0x3c, 0xf1, 0x83,
+	0x66, 0xf8, 0x4c, 0x6f, 0xf2, 0xce, 0xd0, 0x4d, 0x1e, 0xa7, 0x58, 0xe3, 0x90, 0x61, 0xda, 0x5d,
+	0x76, 0xfb, 0xdb, 0x95, 0x70, 0xd0, 0x14, 0xa9, 0xb9, 0x5f, 0x3e, 0xf3, 0x67, 0x11, 0x3e, 0x46,
+	0x66, 0xad, 0x46, 0x8a, 0x03, 0xb4, 0x34, 0xe2, 0xed, 0x22, 0xa5, 0x90, 0xa2, 0xca, 0x94, 0x9b,
+	0x9f, 0x3c, 0xb3, 0xdc, 0x3c, 0x26, 0xdb, 0x89, 0xea, 0x9d, 0xea, 0x98, 0x20, 0x92, 0xed, 0x24,
+	0x0c, 0x34, 0x16, 0x17, 0x88, 0x8e, 0x17, 0xf4, 0xbc, 0x36, 0xf6, 0x90, 0xcc, 0x2b, 0xd6, 0x33,
+	0xeb, 0xbe, 0xc6, 0x80, 0x45, 0x85, 0x2d, 0x4e, 0xfc, 0x0e, 0xfb, 0x64, 0x18, 0x28, 0xff, 0xa2,
+	0x6e, 0xf1, 0x9e, 0x84, 0x83, 0xa6, 0xa0, 0x11, 0x59, 0x90, 0xd2, 0xd4, 0xcf, 0x89, 0xca, 0x5f,
+	0xbd, 0xfe, 0xae, 0x11, 0xd3, 0xd3, 0x30, 0x44, 0xaa, 0x5e, 0x15, 0xe7, 0xd0, 0xb5, 0x34, 0x3f,
+	0xc8, 0x0a, 0xa0, 0x27, 0x64, 0x49, 0xf7, 0x86, 0x96, 0x4a, 0x5e, 0x5d, 0x2a, 0x4f, 0x33, 0x78,
+	0x90, 0xe5, 0x08, 0x83, 0x42, 0xdc, 0xff, 0xec, 0x90, 0x6c, 0xd5, 0xe4, 0x54, 0xe6, 0xb6, 0x73,
+	0x66, 0xe6, 0x76, 0x3a, 0x83, 0xb3, 0x34, 0x52, 0x06, 0xa7, 0x9d, 0x5c, 0x59, 0x7e, 0x69, 0x72,
+	0xe5, 0x07, 0x4d, 0x05, 0x31, 0x91, 0x85, 0x39, 0x93, 0x5b, 0x3d, 0xcc, 0x25, 0x53, 0x0d, 0x4f,
+	0x5f, 0xa9, 0x99, 0x15, 0x07, 0x83, 0xb5, 0x55, 0x4e, 0x24, 0x31, 0xee, 0x33, 0x32, 0x6b, 0xff,
+	0x6e, 0x5e, 0x81, 0xc5, 0x47, 0xfa, 0x5e, 0xa7, 0x9d, 0x2d, 0x3e, 0xf2, 0xce, 0xea, 0xfd, 0x6d,
+	0xe0, 0x98, 0xfa, 0xca, 0x57, 0xbf, 0x71, 0xfd, 0x8d, 0xaf, 0x7d, 0xe3, 0xfa, 0x1b, 0xbf, 0xfd,
+	0x8d, 0xeb, 0x6f, 0x7c, 0xee, 0xf9, 0x75, 0xe7, 0xab, 0xcf, 0xaf, 0x3b, 0x5f, 0x7b, 0x7e, 0xdd,
+	0xf9, 0xed, 0xe7, 0xd7, 0x9d, 0xdf, 0x7d, 0x7e, 0xdd, 0xf9, 0xd2, 0x7f, 0xbc, 0xfe, 0xc6, 0x27,
+	0x2b, 0x6a, 0x01, 0xf9, 0xff, 0x03, 0x00, 0x26, 0x89, 0x9e, 0xe6, 0xff, 0x92, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -3209,6 +3790,99 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SopsKeySet)
+	copy(dAtA[i:], m.SopsKeySet)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SopsKeySet)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.DestinationServiceAccounts) > 0 {
+		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationServiceAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ManifestPolicy != nil {
+		{
+			size, err := m.ManifestPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.SyncOptions) > 0 {
+		for iNdEx := len(m.SyncOptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SyncOptions[iNdEx])
+			copy(dAtA[i:], m.SyncOptions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncOptions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ResourceCustomizations) > 0 {
+		for iNdEx := len(m.ResourceCustomizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceCustomizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.KustomizeBuildOptions != nil {
+		{
+			size, err := m.KustomizeBuildOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	i -= len(m.HelmVersion)
+	copy(dAtA[i:], m.HelmVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HelmVersion)))
+	i--
+	dAtA[i] = 0x6a
+	if m.ChartSignatureVerification != nil {
+		{
+			size, err := m.ChartSignatureVerification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.ClusterResourceBlacklist) > 0 {
 		for iNdEx := len(m.ClusterResourceBlacklist) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3550,7 +4224,7 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationDestinationServiceAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3560,19 +4234,57 @@ func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationDestinationServiceAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	i -= len(m.DefaultServiceAccount)
+	copy(dAtA[i:], m.DefaultServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultServiceAccount)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -3617,6 +4329,46 @@ func (m *ApplicationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.APIVersions) > 0 {
+		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.APIVersions[iNdEx])
+			copy(dAtA[i:], m.APIVersions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	i -= len(m.KubeVersion)
+	copy(dAtA[i:], m.KubeVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubeVersion)))
+	i--
+	dAtA[i] = 0x7a
+	if m.Helmfile != nil {
+		{
+			size, err := m.Helmfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Ytt != nil {
+		{
+			size, err := m.Ytt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i -= len(m.Chart)
 	copy(dAtA[i:], m.Chart)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
@@ -3720,6 +4472,14 @@ func (m *ApplicationSourceDirectory) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Envsubst {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
 	i -= len(m.Include)
 	copy(dAtA[i:], m.Include)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Include)))
@@ -3826,6 +4586,43 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSourceHelmfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourceHelmfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSourceHelmfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Environment)
+	copy(dAtA[i:], m.Environment)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Environment)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSourceJsonnet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4081,6 +4878,69 @@ func (m *ApplicationSourcePlugin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSourceYtt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSourceYtt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSourceYtt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Kbld {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.DataValues) > 0 {
+		for iNdEx := len(m.DataValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DataValuesFiles) > 0 {
+		for iNdEx := len(m.DataValuesFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataValuesFiles[iNdEx])
+			copy(dAtA[i:], m.DataValuesFiles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DataValuesFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Files[iNdEx])
+			copy(dAtA[i:], m.Files[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Files[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4101,6 +4961,15 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -4436,6 +5305,11 @@ func (m *ApplicationWatchEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Patch)
+	copy(dAtA[i:], m.Patch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4492,6 +5366,52 @@ func (m *Backoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChartSignatureVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartSignatureVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartSignatureVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeylessIdentities) > 0 {
+		for iNdEx := len(m.KeylessIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeylessIdentities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PublicKeys[iNdEx])
+			copy(dAtA[i:], m.PublicKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.PublicKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Cluster) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4512,6 +5432,48 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResyncInterval != nil {
+		{
+			size, err := m.ResyncInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ResourceInclusions) > 0 {
+		for iNdEx := len(m.ResourceInclusions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInclusions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ResourceExclusions) > 0 {
+		for iNdEx := len(m.ResourceExclusions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceExclusions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.Annotations) > 0 {
 		keysForAnnotations := make([]string, 0, len(m.Annotations))
 		for k := range m.Annotations {
@@ -4867,6 +5829,47 @@ func (m *ClusterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterResourceFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterResourceFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterResourceFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kinds) > 0 {
+		for iNdEx := len(m.Kinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Kinds[iNdEx])
+			copy(dAtA[i:], m.Kinds[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kinds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.APIGroups) > 0 {
+		for iNdEx := len(m.APIGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.APIGroups[iNdEx])
+			copy(dAtA[i:], m.APIGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIGroups[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Command) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5009,13 +6012,48 @@ func (m *ConfigManagementPlugin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Generate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.AllowedAppEnv) > 0 {
+		for iNdEx := len(m.AllowedAppEnv) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAppEnv[iNdEx])
+			copy(dAtA[i:], m.AllowedAppEnv[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowedAppEnv[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	if len(m.Env) > 0 {
+		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Env[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Discover != nil {
+		{
+			size, err := m.Discover.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Generate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
@@ -5039,6 +6077,46 @@ func (m *ConfigManagementPlugin) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfigManagementPluginDiscovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigManagementPluginDiscovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigManagementPluginDiscovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Find != nil {
+		{
+			size, err := m.Find.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.FileName)
+	copy(dAtA[i:], m.FileName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FileName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5188,6 +6266,49 @@ func (m *ExecProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForbiddenManifestField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForbiddenManifestField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForbiddenManifestField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.JQPathExpression)
+	copy(dAtA[i:], m.JQPathExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQPathExpression)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GnuPGPublicKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5208,6 +6329,18 @@ func (m *GnuPGPublicKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		{
+			size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	i -= len(m.KeyData)
 	copy(dAtA[i:], m.KeyData)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyData)))
@@ -5354,6 +6487,39 @@ func (m *HelmFileParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.BinaryPath)
+	copy(dAtA[i:], m.BinaryPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BinaryPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5395,6 +6561,49 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5662,6 +6871,39 @@ func (m *JsonnetVar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeylessIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeylessIdentity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeylessIdentity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Issuer)
+	copy(dAtA[i:], m.Issuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Issuer)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KnownTypeField) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5733,6 +6975,51 @@ func (m *KsonnetParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KustomizeBuildOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KustomizeBuildOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KustomizeBuildOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtraArgs) > 0 {
+		for iNdEx := len(m.ExtraArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExtraArgs[iNdEx])
+			copy(dAtA[i:], m.ExtraArgs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExtraArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.EnableAlphaPlugins {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.LoadRestrictor)
+	copy(dAtA[i:], m.LoadRestrictor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LoadRestrictor)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KustomizeOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5753,6 +7040,18 @@ func (m *KustomizeOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProjectBuildOptions != nil {
+		{
+			size, err := m.ProjectBuildOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.BinaryPath)
 	copy(dAtA[i:], m.BinaryPath)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BinaryPath)))
@@ -5766,7 +7065,7 @@ func (m *KustomizeOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
+func (m *ManagedNamespaceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5776,21 +7075,138 @@ func (m *Operation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForbiddenFields) > 0 {
+		for iNdEx := len(m.ForbiddenFields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForbiddenFields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RequiredAnnotations) > 0 {
+		for iNdEx := len(m.RequiredAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAnnotations[iNdEx])
+			copy(dAtA[i:], m.RequiredAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequiredAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
@@ -6077,6 +7493,92 @@ func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PhaseRetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PhaseRetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PhaseRetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectResourceCustomization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectResourceCustomization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectResourceCustomization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Actions)
+	copy(dAtA[i:], m.Actions)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actions)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.HealthLua)
+	copy(dAtA[i:], m.HealthLua)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HealthLua)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6162,6 +7664,11 @@ func (m *RepoCreds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.AuthProvider)
+	copy(dAtA[i:], m.AuthProvider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthProvider)))
+	i--
+	dAtA[i] = 0x6a
 	i -= len(m.Type)
 	copy(dAtA[i:], m.Type)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
@@ -6291,6 +7798,35 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.SubmodulesUseRepoCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb8
+	if m.EnableSubmodules != nil {
+		i--
+		if *m.EnableSubmodules {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	i -= len(m.AuthProvider)
+	copy(dAtA[i:], m.AuthProvider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthProvider)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
 	i -= len(m.Project)
 	copy(dAtA[i:], m.Project)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
@@ -6667,21 +8203,18 @@ func (m *ResourceActionParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Default)
-	copy(dAtA[i:], m.Default)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Default)))
-	i--
-	dAtA[i] = 0x22
+	if m.Default != nil {
+		i -= len(*m.Default)
+		copy(dAtA[i:], *m.Default)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Default)))
+		i--
+		dAtA[i] = 0x22
+	}
 	i -= len(m.Type)
 	copy(dAtA[i:], m.Type)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0x12
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -7121,6 +8654,16 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.IgnoreResourceUpdates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	i--
 	if m.UseOpenLibs {
 		dAtA[i] = 1
@@ -7393,6 +8936,34 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Phases) > 0 {
+		for iNdEx := len(m.Phases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Phases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Backoff != nil {
 		{
 			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
@@ -7431,6 +9002,11 @@ func (m *RevisionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RolledBackRevision)
+	copy(dAtA[i:], m.RolledBackRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RolledBackRevision)))
+	i--
+	dAtA[i] = 0x42
 	if m.DeployStartedAt != nil {
 		{
 			size, err := m.DeployStartedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -7494,8 +9070,17 @@ func (m *RevisionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.SignatureInfo)
-	copy(dAtA[i:], m.SignatureInfo)
+	if len(m.ChangedFiles) > 0 {
+		for iNdEx := len(m.ChangedFiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFiles[iNdEx])
+			copy(dAtA[i:], m.ChangedFiles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChangedFiles[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.SignatureInfo)
+	copy(dAtA[i:], m.SignatureInfo)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SignatureInfo)))
 	i--
 	dAtA[i] = 0x2a
@@ -7579,6 +9164,11 @@ func (m *SyncOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RolledBackRevision)
+	copy(dAtA[i:], m.RolledBackRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RolledBackRevision)))
+	i--
+	dAtA[i] = 0x52
 	if len(m.SyncOptions) > 0 {
 		for iNdEx := len(m.SyncOptions) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SyncOptions[iNdEx])
@@ -7774,6 +9364,30 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ManagedNamespaceMetadata != nil {
+		{
+			size, err := m.ManagedNamespaceMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AutoRollback != nil {
+		{
+			size, err := m.AutoRollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Retry != nil {
 		{
 			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
@@ -7810,6 +9424,34 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncPolicyAutoRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyAutoRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPolicyAutoRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8031,6 +9673,35 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NamespaceSelector != nil {
+		{
+			size, err := m.NamespaceSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ClusterSelector != nil {
+		{
+			size, err := m.ClusterSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x42
 	i--
 	if m.ManualSync {
 		dAtA[i] = 1
@@ -8141,6 +9812,47 @@ func (m *TLSClientConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *YttDataValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YttDataValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YttDataValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.YAML {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -8263,6 +9975,40 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.ChartSignatureVerification != nil {
+		l = m.ChartSignatureVerification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.HelmVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.KustomizeBuildOptions != nil {
+		l = m.KustomizeBuildOptions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ResourceCustomizations) > 0 {
+		for _, e := range m.ResourceCustomizations {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ManifestPolicy != nil {
+		l = m.ManifestPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, e := range m.DestinationServiceAccounts {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.SopsKeySet)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -8335,6 +10081,21 @@ func (m *ApplicationDestination) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDestinationServiceAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DefaultServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8386,6 +10147,22 @@ func (m *ApplicationSource) Size() (n int) {
 	}
 	l = len(m.Chart)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Ytt != nil {
+		l = m.Ytt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Helmfile != nil {
+		l = m.Helmfile.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.KubeVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.APIVersions) > 0 {
+		for _, s := range m.APIVersions {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8402,6 +10179,7 @@ func (m *ApplicationSourceDirectory) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Include)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -8438,6 +10216,23 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSourceHelmfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Environment)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ApplicationSourceJsonnet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8538,6 +10333,34 @@ func (m *ApplicationSourcePlugin) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSourceYtt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DataValuesFiles) > 0 {
+		for _, s := range m.DataValuesFiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DataValues) > 0 {
+		for _, e := range m.DataValues {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
 func (m *ApplicationSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8569,6 +10392,12 @@ func (m *ApplicationSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8677,6 +10506,8 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Application.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Patch)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -8696,6 +10527,27 @@ func (m *Backoff) Size() (n int) {
 	return n
 }
 
+func (m *ChartSignatureVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, s := range m.PublicKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.KeylessIdentities) > 0 {
+		for _, e := range m.KeylessIdentities {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8746,6 +10598,22 @@ func (m *Cluster) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ResourceExclusions) > 0 {
+		for _, e := range m.ResourceExclusions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ResourceInclusions) > 0 {
+		for _, e := range m.ResourceInclusions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ResyncInterval != nil {
+		l = m.ResyncInterval.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8828,6 +10696,27 @@ func (m *ClusterList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterResourceFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.APIGroups) > 0 {
+		for _, s := range m.APIGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Kinds) > 0 {
+		for _, s := range m.Kinds {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Command) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8891,33 +10780,64 @@ func (m *ConfigManagementPlugin) Size() (n int) {
 	}
 	l = m.Generate.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Discover != nil {
+		l = m.Discover.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AllowedAppEnv) > 0 {
+		for _, s := range m.AllowedAppEnv {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ConnectionState) Size() (n int) {
+func (m *ConfigManagementPluginDiscovery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.FileName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.ModifiedAt != nil {
-		l = m.ModifiedAt.Size()
+	if m.Find != nil {
+		l = m.Find.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *EnvEntry) Size() (n int) {
+func (m *ConnectionState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ModifiedAt != nil {
+		l = m.ModifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EnvEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
@@ -8953,6 +10873,23 @@ func (m *ExecProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *ForbiddenManifestField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JQPathExpression)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GnuPGPublicKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8971,6 +10908,10 @@ func (m *GnuPGPublicKey) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.KeyData)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ExpiresAt != nil {
+		l = m.ExpiresAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9017,6 +10958,19 @@ func (m *HelmFileParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BinaryPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HelmParameter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9031,6 +10985,22 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HookRetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9132,6 +11102,19 @@ func (m *JsonnetVar) Size() (n int) {
 	return n
 }
 
+func (m *KeylessIdentity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *KnownTypeField) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9160,6 +11143,24 @@ func (m *KsonnetParameter) Size() (n int) {
 	return n
 }
 
+func (m *KustomizeBuildOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LoadRestrictor)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.ExtraArgs) > 0 {
+		for _, s := range m.ExtraArgs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *KustomizeOptions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9170,6 +11171,56 @@ func (m *KustomizeOptions) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.BinaryPath)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProjectBuildOptions != nil {
+		l = m.ProjectBuildOptions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ManagedNamespaceMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ManifestPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredAnnotations) > 0 {
+		for _, s := range m.RequiredAnnotations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ForbiddenFields) > 0 {
+		for _, e := range m.ForbiddenFields {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9288,6 +11339,39 @@ func (m *OverrideIgnoreDiff) Size() (n int) {
 	return n
 }
 
+func (m *PhaseRetryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectResourceCustomization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthLua)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Actions)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ProjectRole) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9346,6 +11430,8 @@ func (m *RepoCreds) Size() (n int) {
 	n += 2
 	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AuthProvider)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9405,6 +11491,12 @@ func (m *Repository) Size() (n int) {
 	n += 2 + l + sovGenerated(uint64(l))
 	l = len(m.Project)
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.AuthProvider)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.EnableSubmodules != nil {
+		n += 3
+	}
+	n += 3
 	return n
 }
 
@@ -9502,12 +11594,12 @@ func (m *ResourceActionParam) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Type)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Default)
-	n += 1 + l + sovGenerated(uint64(l))
+	if m.Default != nil {
+		l = len(*m.Default)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9693,6 +11785,8 @@ func (m *ResourceOverride) Size() (n int) {
 		}
 	}
 	n += 2
+	l = m.IgnoreResourceUpdates.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9784,6 +11878,18 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Phases) > 0 {
+		for _, e := range m.Phases {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9804,6 +11910,8 @@ func (m *RevisionHistory) Size() (n int) {
 		l = m.DeployStartedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.RolledBackRevision)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9827,6 +11935,12 @@ func (m *RevisionMetadata) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SignatureInfo)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ChangedFiles) > 0 {
+		for _, s := range m.ChangedFiles {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9877,6 +11991,8 @@ func (m *SyncOperation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.RolledBackRevision)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9936,6 +12052,25 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.Retry.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AutoRollback != nil {
+		l = m.AutoRollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ManagedNamespaceMetadata != nil {
+		l = m.ManagedNamespaceMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncPolicyAutoRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10035,6 +12170,16 @@ func (m *SyncWindow) Size() (n int) {
 		}
 	}
 	n += 2
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ClusterSelector != nil {
+		l = m.ClusterSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NamespaceSelector != nil {
+		l = m.NamespaceSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10062,6 +12207,20 @@ func (m *TLSClientConfig) Size() (n int) {
 	return n
 }
 
+func (m *YttDataValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10151,6 +12310,16 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceBlacklist += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForClusterResourceBlacklist += "}"
+	repeatedStringForResourceCustomizations := "[]ProjectResourceCustomization{"
+	for _, f := range this.ResourceCustomizations {
+		repeatedStringForResourceCustomizations += strings.Replace(strings.Replace(f.String(), "ProjectResourceCustomization", "ProjectResourceCustomization", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourceCustomizations += "}"
+	repeatedStringForDestinationServiceAccounts := "[]ApplicationDestinationServiceAccount{"
+	for _, f := range this.DestinationServiceAccounts {
+		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinationServiceAccounts += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -10163,6 +12332,14 @@ func (this *AppProjectSpec) String() string {
 		`NamespaceResourceWhitelist:` + repeatedStringForNamespaceResourceWhitelist + `,`,
 		`SignatureKeys:` + repeatedStringForSignatureKeys + `,`,
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
+		`ChartSignatureVerification:` + strings.Replace(this.ChartSignatureVerification.String(), "ChartSignatureVerification", "ChartSignatureVerification", 1) + `,`,
+		`HelmVersion:` + fmt.Sprintf("%v", this.HelmVersion) + `,`,
+		`KustomizeBuildOptions:` + strings.Replace(this.KustomizeBuildOptions.String(), "KustomizeBuildOptions", "KustomizeBuildOptions", 1) + `,`,
+		`ResourceCustomizations:` + repeatedStringForResourceCustomizations + `,`,
+		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`ManifestPolicy:` + strings.Replace(this.ManifestPolicy.String(), "ManifestPolicy", "ManifestPolicy", 1) + `,`,
+		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`SopsKeySet:` + fmt.Sprintf("%v", this.SopsKeySet) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10224,6 +12401,18 @@ func (this *ApplicationDestination) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDestinationServiceAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestinationServiceAccount{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`DefaultServiceAccount:` + fmt.Sprintf("%v", this.DefaultServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -10254,6 +12443,10 @@ func (this *ApplicationSource) String() string {
 		`Directory:` + strings.Replace(this.Directory.String(), "ApplicationSourceDirectory", "ApplicationSourceDirectory", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "ApplicationSourcePlugin", "ApplicationSourcePlugin", 1) + `,`,
 		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`Ytt:` + strings.Replace(this.Ytt.String(), "ApplicationSourceYtt", "ApplicationSourceYtt", 1) + `,`,
+		`Helmfile:` + strings.Replace(this.Helmfile.String(), "ApplicationSourceHelmfile", "ApplicationSourceHelmfile", 1) + `,`,
+		`KubeVersion:` + fmt.Sprintf("%v", this.KubeVersion) + `,`,
+		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10267,6 +12460,7 @@ func (this *ApplicationSourceDirectory) String() string {
 		`Jsonnet:` + strings.Replace(strings.Replace(this.Jsonnet.String(), "ApplicationSourceJsonnet", "ApplicationSourceJsonnet", 1), `&`, ``, 1) + `,`,
 		`Exclude:` + fmt.Sprintf("%v", this.Exclude) + `,`,
 		`Include:` + fmt.Sprintf("%v", this.Include) + `,`,
+		`Envsubst:` + fmt.Sprintf("%v", this.Envsubst) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10296,6 +12490,17 @@ func (this *ApplicationSourceHelm) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSourceHelmfile) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSourceHelmfile{`,
+		`Environment:` + fmt.Sprintf("%v", this.Environment) + `,`,
+		`Selectors:` + fmt.Sprintf("%v", this.Selectors) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSourceJsonnet) String() string {
 	if this == nil {
 		return "nil"
@@ -10387,6 +12592,24 @@ func (this *ApplicationSourcePlugin) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSourceYtt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDataValues := "[]YttDataValue{"
+	for _, f := range this.DataValues {
+		repeatedStringForDataValues += strings.Replace(strings.Replace(f.String(), "YttDataValue", "YttDataValue", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDataValues += "}"
+	s := strings.Join([]string{`&ApplicationSourceYtt{`,
+		`Files:` + fmt.Sprintf("%v", this.Files) + `,`,
+		`DataValuesFiles:` + fmt.Sprintf("%v", this.DataValuesFiles) + `,`,
+		`DataValues:` + repeatedStringForDataValues + `,`,
+		`Kbld:` + fmt.Sprintf("%v", this.Kbld) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -10409,6 +12632,7 @@ func (this *ApplicationSpec) String() string {
 		`IgnoreDifferences:` + repeatedStringForIgnoreDifferences + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10492,6 +12716,7 @@ func (this *ApplicationWatchEvent) String() string {
 	s := strings.Join([]string{`&ApplicationWatchEvent{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Application:` + strings.Replace(strings.Replace(this.Application.String(), "Application", "Application", 1), `&`, ``, 1) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10508,10 +12733,36 @@ func (this *Backoff) String() string {
 	}, "")
 	return s
 }
+func (this *ChartSignatureVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKeylessIdentities := "[]KeylessIdentity{"
+	for _, f := range this.KeylessIdentities {
+		repeatedStringForKeylessIdentities += strings.Replace(strings.Replace(f.String(), "KeylessIdentity", "KeylessIdentity", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForKeylessIdentities += "}"
+	s := strings.Join([]string{`&ChartSignatureVerification{`,
+		`PublicKeys:` + fmt.Sprintf("%v", this.PublicKeys) + `,`,
+		`KeylessIdentities:` + repeatedStringForKeylessIdentities + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForResourceExclusions := "[]ClusterResourceFilter{"
+	for _, f := range this.ResourceExclusions {
+		repeatedStringForResourceExclusions += strings.Replace(strings.Replace(f.String(), "ClusterResourceFilter", "ClusterResourceFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourceExclusions += "}"
+	repeatedStringForResourceInclusions := "[]ClusterResourceFilter{"
+	for _, f := range this.ResourceInclusions {
+		repeatedStringForResourceInclusions += strings.Replace(strings.Replace(f.String(), "ClusterResourceFilter", "ClusterResourceFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourceInclusions += "}"
 	keysForLabels := make([]string, 0, len(this.Labels))
 	for k := range this.Labels {
 		keysForLabels = append(keysForLabels, k)
@@ -10546,6 +12797,9 @@ func (this *Cluster) String() string {
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`ResourceExclusions:` + repeatedStringForResourceExclusions + `,`,
+		`ResourceInclusions:` + repeatedStringForResourceInclusions + `,`,
+		`ResyncInterval:` + strings.Replace(fmt.Sprintf("%v", this.ResyncInterval), "Duration", "v1.Duration", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10607,12 +12861,23 @@ func (this *ClusterList) String() string {
 	}, "")
 	return s
 }
-func (this *Command) String() string {
+func (this *ClusterResourceFilter) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Command{`,
-		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
+	s := strings.Join([]string{`&ClusterResourceFilter{`,
+		`APIGroups:` + fmt.Sprintf("%v", this.APIGroups) + `,`,
+		`Kinds:` + fmt.Sprintf("%v", this.Kinds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Command) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Command{`,
+		`Command:` + fmt.Sprintf("%v", this.Command) + `,`,
 		`Args:` + fmt.Sprintf("%v", this.Args) + `,`,
 		`}`,
 	}, "")
@@ -10645,10 +12910,29 @@ func (this *ConfigManagementPlugin) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForEnv := "[]*EnvEntry{"
+	for _, f := range this.Env {
+		repeatedStringForEnv += strings.Replace(f.String(), "EnvEntry", "EnvEntry", 1) + ","
+	}
+	repeatedStringForEnv += "}"
 	s := strings.Join([]string{`&ConfigManagementPlugin{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Init:` + strings.Replace(this.Init.String(), "Command", "Command", 1) + `,`,
 		`Generate:` + strings.Replace(strings.Replace(this.Generate.String(), "Command", "Command", 1), `&`, ``, 1) + `,`,
+		`Discover:` + strings.Replace(this.Discover.String(), "ConfigManagementPluginDiscovery", "ConfigManagementPluginDiscovery", 1) + `,`,
+		`Env:` + repeatedStringForEnv + `,`,
+		`AllowedAppEnv:` + fmt.Sprintf("%v", this.AllowedAppEnv) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigManagementPluginDiscovery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigManagementPluginDiscovery{`,
+		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
+		`Find:` + strings.Replace(this.Find.String(), "Command", "Command", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10700,6 +12984,19 @@ func (this *ExecProviderConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ForbiddenManifestField) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForbiddenManifestField{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`JQPathExpression:` + fmt.Sprintf("%v", this.JQPathExpression) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GnuPGPublicKey) String() string {
 	if this == nil {
 		return "nil"
@@ -10711,6 +13008,7 @@ func (this *GnuPGPublicKey) String() string {
 		`Trust:` + fmt.Sprintf("%v", this.Trust) + `,`,
 		`SubType:` + fmt.Sprintf("%v", this.SubType) + `,`,
 		`KeyData:` + fmt.Sprintf("%v", this.KeyData) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10753,6 +13051,17 @@ func (this *HelmFileParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmOptions{`,
+		`BinaryPath:` + fmt.Sprintf("%v", this.BinaryPath) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HelmParameter) String() string {
 	if this == nil {
 		return "nil"
@@ -10765,6 +13074,18 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HookRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HookRetryStrategy{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -10856,6 +13177,17 @@ func (this *JsonnetVar) String() string {
 	}, "")
 	return s
 }
+func (this *KeylessIdentity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeylessIdentity{`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KnownTypeField) String() string {
 	if this == nil {
 		return "nil"
@@ -10879,6 +13211,18 @@ func (this *KsonnetParameter) String() string {
 	}, "")
 	return s
 }
+func (this *KustomizeBuildOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeBuildOptions{`,
+		`LoadRestrictor:` + fmt.Sprintf("%v", this.LoadRestrictor) + `,`,
+		`EnableAlphaPlugins:` + fmt.Sprintf("%v", this.EnableAlphaPlugins) + `,`,
+		`ExtraArgs:` + fmt.Sprintf("%v", this.ExtraArgs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KustomizeOptions) String() string {
 	if this == nil {
 		return "nil"
@@ -10886,6 +13230,54 @@ func (this *KustomizeOptions) String() string {
 	s := strings.Join([]string{`&KustomizeOptions{`,
 		`BuildOptions:` + fmt.Sprintf("%v", this.BuildOptions) + `,`,
 		`BinaryPath:` + fmt.Sprintf("%v", this.BinaryPath) + `,`,
+		`ProjectBuildOptions:` + strings.Replace(this.ProjectBuildOptions.String(), "KustomizeBuildOptions", "KustomizeBuildOptions", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManagedNamespaceMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ManagedNamespaceMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManifestPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForForbiddenFields := "[]ForbiddenManifestField{"
+	for _, f := range this.ForbiddenFields {
+		repeatedStringForForbiddenFields += strings.Replace(strings.Replace(f.String(), "ForbiddenManifestField", "ForbiddenManifestField", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForForbiddenFields += "}"
+	s := strings.Join([]string{`&ManifestPolicy{`,
+		`RequiredAnnotations:` + fmt.Sprintf("%v", this.RequiredAnnotations) + `,`,
+		`ForbiddenFields:` + repeatedStringForForbiddenFields + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10974,6 +13366,31 @@ func (this *OverrideIgnoreDiff) String() string {
 	}, "")
 	return s
 }
+func (this *PhaseRetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PhaseRetryStrategy{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectResourceCustomization) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectResourceCustomization{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`HealthLua:` + fmt.Sprintf("%v", this.HealthLua) + `,`,
+		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectRole) String() string {
 	if this == nil {
 		return "nil"
@@ -11010,6 +13427,7 @@ func (this *RepoCreds) String() string {
 		`GitHubAppEnterpriseBaseURL:` + fmt.Sprintf("%v", this.GitHubAppEnterpriseBaseURL) + `,`,
 		`EnableOCI:` + fmt.Sprintf("%v", this.EnableOCI) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`AuthProvider:` + fmt.Sprintf("%v", this.AuthProvider) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11055,6 +13473,9 @@ func (this *Repository) String() string {
 		`GitHubAppEnterpriseBaseURL:` + fmt.Sprintf("%v", this.GitHubAppEnterpriseBaseURL) + `,`,
 		`Proxy:` + fmt.Sprintf("%v", this.Proxy) + `,`,
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
+		`AuthProvider:` + fmt.Sprintf("%v", this.AuthProvider) + `,`,
+		`EnableSubmodules:` + valueToStringGenerated(this.EnableSubmodules) + `,`,
+		`SubmodulesUseRepoCreds:` + fmt.Sprintf("%v", this.SubmodulesUseRepoCreds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11139,9 +13560,8 @@ func (this *ResourceActionParam) String() string {
 	}
 	s := strings.Join([]string{`&ResourceActionParam{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`Default:` + fmt.Sprintf("%v", this.Default) + `,`,
+		`Default:` + valueToStringGenerated(this.Default) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11284,6 +13704,7 @@ func (this *ResourceOverride) String() string {
 		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
+		`IgnoreResourceUpdates:` + strings.Replace(strings.Replace(this.IgnoreResourceUpdates.String(), "OverrideIgnoreDiff", "OverrideIgnoreDiff", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11344,9 +13765,21 @@ func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPhases := "[]PhaseRetryStrategy{"
+	for _, f := range this.Phases {
+		repeatedStringForPhases += strings.Replace(strings.Replace(f.String(), "PhaseRetryStrategy", "PhaseRetryStrategy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPhases += "}"
+	repeatedStringForHooks := "[]HookRetryStrategy{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "HookRetryStrategy", "HookRetryStrategy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`Phases:` + repeatedStringForPhases + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11361,6 +13794,7 @@ func (this *RevisionHistory) String() string {
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`DeployStartedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeployStartedAt), "Time", "v1.Time", 1) + `,`,
+		`RolledBackRevision:` + fmt.Sprintf("%v", this.RolledBackRevision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11375,6 +13809,7 @@ func (this *RevisionMetadata) String() string {
 		`Tags:` + fmt.Sprintf("%v", this.Tags) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`SignatureInfo:` + fmt.Sprintf("%v", this.SignatureInfo) + `,`,
+		`ChangedFiles:` + fmt.Sprintf("%v", this.ChangedFiles) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11407,6 +13842,7 @@ func (this *SyncOperation) String() string {
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Manifests:` + fmt.Sprintf("%v", this.Manifests) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`RolledBackRevision:` + fmt.Sprintf("%v", this.RolledBackRevision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11449,6 +13885,18 @@ func (this *SyncPolicy) String() string {
 		`Automated:` + strings.Replace(this.Automated.String(), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`AutoRollback:` + strings.Replace(this.AutoRollback.String(), "SyncPolicyAutoRollback", "SyncPolicyAutoRollback", 1) + `,`,
+		`ManagedNamespaceMetadata:` + strings.Replace(this.ManagedNamespaceMetadata.String(), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyAutoRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyAutoRollback{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11520,6 +13968,9 @@ func (this *SyncWindow) String() string {
 		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
 		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
 		`ManualSync:` + fmt.Sprintf("%v", this.ManualSync) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`ClusterSelector:` + strings.Replace(fmt.Sprintf("%v", this.ClusterSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`NamespaceSelector:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11538,6 +13989,18 @@ func (this *TLSClientConfig) String() string {
 	}, "")
 	return s
 }
+func (this *YttDataValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&YttDataValue{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`YAML:` + fmt.Sprintf("%v", this.YAML) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -12327,59 +14790,77 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartSignatureVerification", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.ChartSignatureVerification == nil {
+				m.ChartSignatureVerification = &ChartSignatureVerification{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.ChartSignatureVerification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersion", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeBuildOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12406,20 +14887,274 @@ func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JWTTokensByRole == nil {
-				m.JWTTokensByRole = make(map[string]JWTTokens)
+			if m.KustomizeBuildOptions == nil {
+				m.KustomizeBuildOptions = &KustomizeBuildOptions{}
 			}
-			var mapkey string
-			mapvalue := &JWTTokens{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+			if err := m.KustomizeBuildOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceCustomizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceCustomizations = append(m.ResourceCustomizations, ProjectResourceCustomization{})
+			if err := m.ResourceCustomizations[len(m.ResourceCustomizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManifestPolicy == nil {
+				m.ManifestPolicy = &ManifestPolicy{}
+			}
+			if err := m.ManifestPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationServiceAccounts = append(m.DestinationServiceAccounts, ApplicationDestinationServiceAccount{})
+			if err := m.DestinationServiceAccounts[len(m.DestinationServiceAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SopsKeySet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SopsKeySet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppProjectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppProjectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokensByRole", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JWTTokensByRole == nil {
+				m.JWTTokensByRole = make(map[string]JWTTokens)
+			}
+			var mapkey string
+			mapvalue := &JWTTokens{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
@@ -13008,7 +15743,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13031,15 +15766,161 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13462,61 +16343,11 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ytt", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13526,15 +16357,31 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ytt == nil {
+				m.Ytt = &ApplicationSourceYtt{}
+			}
+			if err := m.Ytt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helmfile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13561,13 +16408,16 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Helmfile == nil {
+				m.Helmfile = &ApplicationSourceHelmfile{}
+			}
+			if err := m.Helmfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13595,11 +16445,11 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13627,7 +16477,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13650,7 +16500,7 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13673,17 +16523,17 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13693,27 +16543,15 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			m.Recurse = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13740,14 +16578,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13775,11 +16612,11 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			m.Exclude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13807,47 +16644,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Include = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envsubst", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13857,24 +16660,12 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Envsubst = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13896,7 +16687,7 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13919,15 +16710,47 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13954,14 +16777,78 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtVars = append(m.ExtVars, JsonnetVar{})
-			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13988,14 +16875,14 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLAs = append(m.TLAs, JsonnetVar{})
-			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14023,7 +16910,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14046,7 +16933,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceHelmfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14069,10 +16956,10 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceHelmfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceHelmfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14109,9 +16996,9 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14121,25 +17008,23 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, KsonnetParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14162,7 +17047,7 @@ func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14185,17 +17070,17 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14205,29 +17090,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			m.ExtVars = append(m.ExtVars, JsonnetVar{})
+			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14237,27 +17124,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			m.TLAs = append(m.TLAs, JsonnetVar{})
+			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14285,11 +17174,93 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Environment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14316,107 +17287,64 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonLabels == nil {
-				m.CommonLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Parameters = append(m.Parameters, KsonnetParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CommonLabels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14444,13 +17372,13 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14460,15 +17388,238 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonLabels == nil {
+				m.CommonLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
@@ -14750,7 +17901,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceYtt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14773,17 +17924,17 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceYtt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceYtt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14793,30 +17944,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataValuesFiles", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14826,30 +17976,29 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataValuesFiles = append(m.DataValuesFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataValues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14859,7 +18008,177 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataValues = append(m.DataValues, YttDataValue{})
+			if err := m.DataValues[len(m.DataValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kbld", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kbld = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -15001,6 +18320,38 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15773,6 +19124,38 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15928,7 +19311,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ChartSignatureVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15951,15 +19334,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChartSignatureVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChartSignatureVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15987,13 +19370,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.PublicKeys = append(m.PublicKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeylessIdentities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16003,32 +19386,148 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KeylessIdentities = append(m.KeylessIdentities, KeylessIdentity{})
+			if err := m.KeylessIdentities[len(m.KeylessIdentities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -16544,7 +20043,1813 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceExclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceExclusions = append(m.ResourceExclusions, ClusterResourceFilter{})
+			if err := m.ResourceExclusions[len(m.ResourceExclusions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceInclusions = append(m.ResourceInclusions, ClusterResourceFilter{})
+			if err := m.ResourceInclusions[len(m.ResourceInclusions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResyncInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResyncInterval == nil {
+				m.ResyncInterval = &v1.Duration{}
+			}
+			if err := m.ResyncInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			}
+			m.ResourcesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourcesCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			}
+			m.APIsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.APIsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
+			}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecProviderConfig == nil {
+				m.ExecProviderConfig = &ExecProviderConfig{}
+			}
+			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
+			}
+			m.ApplicationsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplicationsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResourceFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResourceFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResourceFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIGroups = append(m.APIGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kinds = append(m.Kinds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discover", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discover == nil {
+				m.Discover = &ConfigManagementPluginDiscovery{}
+			}
+			if err := m.Discover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAppEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAppEnv = append(m.AllowedAppEnv, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigManagementPluginDiscovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigManagementPluginDiscovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigManagementPluginDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Find", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Find == nil {
+				m.Find = &Command{}
+			}
+			if err := m.Find.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16567,7 +21872,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16590,17 +21895,17 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.ResourcesCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16610,35 +21915,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.APIsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.APIsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16648,27 +21947,23 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCacheSyncTime == nil {
-				m.LastCacheSyncTime = &v1.Time{}
-			}
-			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16691,7 +21986,7 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16714,15 +22009,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16750,11 +22045,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16782,13 +22077,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16798,29 +22093,124 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16830,66 +22220,29 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16899,27 +22252,23 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecProviderConfig == nil {
-				m.ExecProviderConfig = &ExecProviderConfig{}
-			}
-			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16942,7 +22291,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *ForbiddenManifestField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16965,17 +22314,17 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForbiddenManifestField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForbiddenManifestField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16985,28 +22334,27 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17034,13 +22382,13 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17050,47 +22398,27 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JQPathExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
-			}
-			m.ApplicationsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplicationsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17118,7 +22446,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17141,7 +22469,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17164,17 +22492,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17184,30 +22512,29 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17217,79 +22544,59 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Command) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17317,11 +22624,11 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Trust = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17349,63 +22656,13 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.SubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17415,28 +22672,27 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17463,7 +22719,10 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = &v1.Time{}
+			}
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17488,7 +22747,7 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17511,17 +22770,17 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17531,29 +22790,30 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17563,55 +22823,25 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, GnuPGPublicKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17634,7 +22864,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17657,15 +22887,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17693,49 +22923,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Init == nil {
-				m.Init = &Command{}
-			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17745,24 +22939,23 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17785,7 +22978,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17808,15 +23001,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17844,11 +23037,11 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17876,43 +23069,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
-			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17935,7 +23092,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17958,15 +23115,15 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17994,11 +23151,11 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18026,7 +23183,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18049,7 +23206,7 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18072,15 +23229,15 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18108,11 +23265,11 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18140,172 +23297,13 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Env[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18315,24 +23313,12 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18354,7 +23340,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *HookRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18377,15 +23363,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18413,13 +23399,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18429,29 +23415,16 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18461,27 +23434,81 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18509,13 +23536,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18525,29 +23552,31 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18557,23 +23586,24 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18596,7 +23626,7 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18619,17 +23649,17 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18639,30 +23669,29 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var msglen int
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18672,26 +23701,49 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18713,7 +23765,7 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18736,15 +23788,15 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18772,11 +23824,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18804,7 +23856,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18827,7 +23879,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18850,10 +23902,10 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18890,7 +23942,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18918,7 +23970,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18941,7 +23993,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18964,17 +24016,17 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18984,29 +24036,16 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19016,29 +24055,16 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19048,12 +24074,24 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19075,7 +24113,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19098,81 +24136,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19199,7 +24171,8 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19224,7 +24197,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19247,15 +24220,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19283,13 +24256,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19299,35 +24272,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Capacity = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19337,11 +24304,12 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19363,7 +24331,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *KeylessIdentity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19386,15 +24354,15 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeylessIdentity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeylessIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19422,11 +24390,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19454,7 +24422,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19477,7 +24445,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19500,15 +24468,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19536,11 +24504,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19568,7 +24536,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19591,7 +24559,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19614,17 +24582,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19634,33 +24602,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ExpiresAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Component = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19688,63 +24650,13 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19754,25 +24666,23 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19795,7 +24705,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *KustomizeBuildOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19818,15 +24728,15 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeBuildOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeBuildOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadRestrictor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19854,13 +24764,13 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LoadRestrictor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableAlphaPlugins", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19870,29 +24780,17 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.EnableAlphaPlugins = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraArgs", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19902,12 +24800,24 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraArgs = append(m.ExtraArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19929,7 +24839,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19952,15 +24862,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19988,11 +24898,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20020,7 +24930,43 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectBuildOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProjectBuildOptions == nil {
+				m.ProjectBuildOptions = &KustomizeBuildOptions{}
+			}
+			if err := m.ProjectBuildOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20043,7 +24989,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20066,17 +25012,17 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20086,61 +25032,124 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20150,23 +25159,118 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20189,7 +25293,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *ManifestPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20212,15 +25316,15 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAnnotations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20248,13 +25352,13 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.RequiredAnnotations = append(m.RequiredAnnotations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForbiddenFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20264,23 +25368,25 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.ForbiddenFields = append(m.ForbiddenFields, ForbiddenManifestField{})
+			if err := m.ForbiddenFields[len(m.ForbiddenFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20790,7 +25896,144 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20800,33 +26043,29 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RetryCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20836,11 +26075,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20862,7 +26114,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20885,17 +26137,38 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Warn = &b
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20905,27 +26178,79 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20953,11 +26278,11 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20985,7 +26310,7 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21008,7 +26333,7 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
+func (m *PhaseRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21031,17 +26356,17 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: PhaseRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PhaseRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21051,16 +26376,46 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21087,8 +26442,10 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21113,7 +26470,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+func (m *ProjectResourceCustomization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21123,28 +26480,92 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectResourceCustomization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectResourceCustomization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21172,11 +26593,11 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JQPathExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21204,7 +26625,7 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JQPathExpressions = append(m.JQPathExpressions, string(dAtA[iNdEx:postIndex]))
+			m.Actions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21814,6 +27235,38 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			}
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22536,6 +27989,79 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.EnableSubmodules = &b
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmodulesUseRepoCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SubmodulesUseRepoCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23314,38 +28840,6 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
@@ -23408,7 +28902,8 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Default = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25106,6 +30601,39 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseOpenLibs = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26090,7 +31618,62 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Limit = 0
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phases", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26100,14 +31683,29 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phases = append(m.Phases, PhaseRetryStrategy{})
+			if err := m.Phases[len(m.Phases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26134,10 +31732,8 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
-			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hooks = append(m.Hooks, HookRetryStrategy{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26344,6 +31940,38 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBackRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolledBackRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26555,6 +32183,38 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFiles = append(m.ChangedFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26929,6 +32589,38 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			}
 			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBackRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolledBackRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27372,13 +33064,171 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRollback == nil {
+				m.AutoRollback = &SyncPolicyAutoRollback{}
+			}
+			if err := m.AutoRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyAutoRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyAutoRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyAutoRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27388,27 +33238,23 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28204,6 +34050,110 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ManualSync = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterSelector == nil {
+				m.ClusterSelector = &v1.LabelSelector{}
+			}
+			if err := m.ClusterSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceSelector == nil {
+				m.NamespaceSelector = &v1.LabelSelector{}
+			}
+			if err := m.NamespaceSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28429,6 +34379,140 @@ func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *YttDataValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YttDataValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YttDataValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YAML", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.YAML = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
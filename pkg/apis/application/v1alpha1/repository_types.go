@@ -6,6 +6,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cert"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,8 +36,10 @@ type RepoCreds struct {
 	GitHubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty" protobuf:"bytes,10,opt,name=githubAppEnterpriseBaseUrl"`
 	// EnableOCI specifies whether helm-oci support should be enabled for this repo
 	EnableOCI bool `json:"enableOCI,omitempty" protobuf:"bytes,11,opt,name=enableOCI"`
-	// Type specifies the type of the repoCreds. Can be either "git" or "helm. "git" is assumed if empty or absent.
+	// Type specifies the type of the repoCreds. Can be either "git", "helm" or "oci". "git" is assumed if empty or absent.
 	Type string `json:"type,omitempty" protobuf:"bytes,12,opt,name=type"`
+	// AuthProvider specifies a cloud provider ("aws" or "gcp") issuing OCI registry tokens for the identity of the repo server, instead of using a static password
+	AuthProvider string `json:"authProvider,omitempty" protobuf:"bytes,13,opt,name=authProvider"`
 }
 
 // Repository is a repository holding application configurations
@@ -62,7 +65,7 @@ type Repository struct {
 	TLSClientCertData string `json:"tlsClientCertData,omitempty" protobuf:"bytes,9,opt,name=tlsClientCertData"`
 	// TLSClientCertKey contains a private key in PEM format for authenticating at the repo server
 	TLSClientCertKey string `json:"tlsClientCertKey,omitempty" protobuf:"bytes,10,opt,name=tlsClientCertKey"`
-	// Type specifies the type of the repo. Can be either "git" or "helm. "git" is assumed if empty or absent.
+	// Type specifies the type of the repo. Can be either "git", "helm" or "oci". "git" is assumed if empty or absent.
 	Type string `json:"type,omitempty" protobuf:"bytes,11,opt,name=type"`
 	// Name specifies a name to be used for this repo. Only used with Helm repos
 	Name string `json:"name,omitempty" protobuf:"bytes,12,opt,name=name"`
@@ -82,6 +85,12 @@ type Repository struct {
 	Proxy string `json:"proxy,omitempty" protobuf:"bytes,19,opt,name=proxy"`
 	// Reference between project and repository that allow you automatically to be added as item inside SourceRepos project entity
 	Project string `json:"project,omitempty" protobuf:"bytes,20,opt,name=project"`
+	// AuthProvider specifies a cloud provider ("aws" or "gcp") issuing OCI registry tokens for the identity of the repo server, instead of using a static password
+	AuthProvider string `json:"authProvider,omitempty" protobuf:"bytes,21,opt,name=authProvider"`
+	// EnableSubmodules specifies whether the submodules of the repository are checked out. The default of the repo server is used if not set. Only valid for Git repositories.
+	EnableSubmodules *bool `json:"enableSubmodules,omitempty" protobuf:"bytes,22,opt,name=enableSubmodules"`
+	// SubmodulesUseRepoCreds specifies whether the URLs of the submodules hosted on the same server as the repository are rewritten to the URL scheme of the repository, so that they are fetched using the credentials of the repository. Only valid for Git repositories.
+	SubmodulesUseRepoCreds bool `json:"submodulesUseRepoCreds,omitempty" protobuf:"bytes,23,opt,name=submodulesUseRepoCreds"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification
@@ -96,7 +105,7 @@ func (repo *Repository) IsLFSEnabled() bool {
 
 // HasCredentials returns true when the repository has been configured with any credentials
 func (m *Repository) HasCredentials() bool {
-	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != ""
+	return m.Username != "" || m.Password != "" || m.SSHPrivateKey != "" || m.TLSClientCertData != "" || m.GithubAppPrivateKey != "" || m.AuthProvider != ""
 }
 
 // CopyCredentialsFromRepo copies all credential information from source repository to receiving repository
@@ -129,6 +138,9 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 		if repo.GitHubAppEnterpriseBaseURL == "" {
 			repo.GitHubAppEnterpriseBaseURL = source.GitHubAppEnterpriseBaseURL
 		}
+		if repo.AuthProvider == "" {
+			repo.AuthProvider = source.AuthProvider
+		}
 	}
 }
 
@@ -162,6 +174,9 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.GitHubAppEnterpriseBaseURL == "" {
 			repo.GitHubAppEnterpriseBaseURL = source.GitHubAppEnterpriseBaseURL
 		}
+		if repo.AuthProvider == "" {
+			repo.AuthProvider = source.AuthProvider
+		}
 	}
 }
 
@@ -174,7 +189,7 @@ func (repo *Repository) GetGitCreds() git.Creds {
 		return git.NewHTTPSCreds(repo.Username, repo.Password, repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure(), repo.Proxy)
 	}
 	if repo.SSHPrivateKey != "" {
-		return git.NewSSHCreds(repo.SSHPrivateKey, getCAPath(repo.Repo), repo.IsInsecure())
+		return git.NewSSHCreds(repo.SSHPrivateKey, getCAPath(repo.Repo), repo.IsInsecure(), repo.Proxy)
 	}
 	if repo.GithubAppPrivateKey != "" && repo.GithubAppId != 0 && repo.GithubAppInstallationId != 0 {
 		return git.NewGitHubAppCreds(repo.GithubAppId, repo.GithubAppInstallationId, repo.GithubAppPrivateKey, repo.GitHubAppEnterpriseBaseURL, repo.Repo, repo.TLSClientCertData, repo.TLSClientCertKey, repo.IsInsecure())
@@ -191,11 +206,24 @@ func (repo *Repository) GetHelmCreds() helm.Creds {
 		CertData:           []byte(repo.TLSClientCertData),
 		KeyData:            []byte(repo.TLSClientCertKey),
 		InsecureSkipVerify: repo.Insecure,
+		AuthProvider:       repo.AuthProvider,
+	}
+}
+
+// GetOCICreds returns the credentials from a repository configuration used to authenticate against an OCI registry
+func (repo *Repository) GetOCICreds() oci.Creds {
+	return oci.Creds{
+		Username:           repo.Username,
+		Password:           repo.Password,
+		CAPath:             getCAPath(repo.Repo),
+		CertData:           []byte(repo.TLSClientCertData),
+		KeyData:            []byte(repo.TLSClientCertKey),
+		InsecureSkipVerify: repo.Insecure,
 	}
 }
 
 func getCAPath(repoURL string) string {
-	if git.IsHTTPSURL(repoURL) {
+	if git.IsHTTPSURL(repoURL) || oci.IsOCIRepo(repoURL) {
 		if parsedURL, err := url.Parse(repoURL); err == nil {
 			if caPath, err := cert.GetCertBundlePathForRepository(parsedURL.Host); err == nil {
 				return caPath
@@ -218,6 +246,8 @@ func (m *Repository) CopySettingsFrom(source *Repository) {
 		m.InsecureIgnoreHostKey = source.InsecureIgnoreHostKey
 		m.Insecure = source.Insecure
 		m.InheritedCreds = source.InheritedCreds
+		m.EnableSubmodules = source.EnableSubmodules
+		m.SubmodulesUseRepoCreds = source.SubmodulesUseRepoCreds
 	}
 }
 
@@ -283,6 +313,8 @@ type GnuPGPublicKey struct {
 	SubType string `json:"subType,omitempty" protobuf:"bytes,5,opt,name=subType"`
 	// KeyData holds the raw key data, in base64 encoded format
 	KeyData string `json:"keyData,omitempty" protobuf:"bytes,6,opt,name=keyData"`
+	// ExpiresAt is the time the key expires at, or nil if the key does not expire
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,7,opt,name=expiresAt"`
 }
 
 // GnuPGPublicKeyList is a collection of GnuPGPublicKey objects
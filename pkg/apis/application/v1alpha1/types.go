@@ -7,12 +7,14 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
@@ -24,6 +26,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/watch"
@@ -32,6 +35,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 )
 
 // Application is a definition of Application resource.
@@ -71,6 +75,9 @@ type ApplicationSpec struct {
 	// Increasing will increase the space used to store the history, so we do not recommend increasing it.
 	// Default is 10.
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
+	// DependsOn is a list of names of applications which must be Synced and Healthy before this application is
+	// automatically synced
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,8,opt,name=dependsOn"`
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -140,13 +147,14 @@ func (e Env) Envsubst(s string) string {
 
 // ApplicationSource contains all required information about the source of an application
 type ApplicationSource struct {
-	// RepoURL is the URL to the repository (Git or Helm) that contains the application manifests
+	// RepoURL is the URL to the repository (Git, Helm or OCI) that contains the application manifests
 	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
-	// Path is a directory path within the Git repository, and is only valid for applications sourced from Git.
+	// Path is a directory path within the Git repository or OCI artifact, and is only valid for applications sourced from Git or OCI.
 	Path string `json:"path,omitempty" protobuf:"bytes,2,opt,name=path"`
 	// TargetRevision defines the revision of the source to sync the application to.
 	// In case of Git, this can be commit, tag, or branch. If omitted, will equal to HEAD.
 	// In case of Helm, this is a semver tag for the Chart's version.
+	// In case of OCI, this is a tag or digest of the artifact. If omitted, will equal to latest.
 	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,4,opt,name=targetRevision"`
 	// Helm holds helm specific options
 	Helm *ApplicationSourceHelm `json:"helm,omitempty" protobuf:"bytes,7,opt,name=helm"`
@@ -160,6 +168,14 @@ type ApplicationSource struct {
 	Plugin *ApplicationSourcePlugin `json:"plugin,omitempty" protobuf:"bytes,11,opt,name=plugin"`
 	// Chart is a Helm chart name, and must be specified for applications sourced from a Helm repo.
 	Chart string `json:"chart,omitempty" protobuf:"bytes,12,opt,name=chart"`
+	// Ytt holds ytt specific options
+	Ytt *ApplicationSourceYtt `json:"ytt,omitempty" protobuf:"bytes,13,opt,name=ytt"`
+	// Helmfile holds helmfile specific options
+	Helmfile *ApplicationSourceHelmfile `json:"helmfile,omitempty" protobuf:"bytes,14,opt,name=helmfile"`
+	// KubeVersion overrides the Kubernetes version passed to Helm and config management plugins, which defaults to the version of the destination cluster, e.g. to render the manifests for the version the cluster is upgraded to.
+	KubeVersion string `json:"kubeVersion,omitempty" protobuf:"bytes,15,opt,name=kubeVersion"`
+	// APIVersions overrides the Kubernetes API versions passed to Helm and config management plugins, which default to the API versions served by the destination cluster. The format is [group/]version, or [group/]version/kind to declare the support of a single kind.
+	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,16,opt,name=apiVersions"`
 }
 
 // AllowsConcurrentProcessing returns true if given application source can be processed concurrently
@@ -188,6 +204,11 @@ func (a *ApplicationSource) IsHelmOci() bool {
 	return helm.IsHelmOciRepo(a.RepoURL)
 }
 
+// IsOCI returns true when the application source is an artifact stored in an OCI registry
+func (a *ApplicationSource) IsOCI() bool {
+	return !a.IsHelm() && oci.IsOCIRepo(a.RepoURL)
+}
+
 // IsZero returns true if the application source is considered empty
 func (a *ApplicationSource) IsZero() bool {
 	return a == nil ||
@@ -198,7 +219,11 @@ func (a *ApplicationSource) IsZero() bool {
 			a.Kustomize.IsZero() &&
 			a.Ksonnet.IsZero() &&
 			a.Directory.IsZero() &&
-			a.Plugin.IsZero()
+			a.Plugin.IsZero() &&
+			a.Ytt.IsZero() &&
+			a.Helmfile.IsZero() &&
+			a.KubeVersion == "" &&
+			len(a.APIVersions) == 0
 }
 
 // ApplicationSourceType specifies the type of the application's source
@@ -210,6 +235,8 @@ const (
 	ApplicationSourceTypeKsonnet   ApplicationSourceType = "Ksonnet"
 	ApplicationSourceTypeDirectory ApplicationSourceType = "Directory"
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
+	ApplicationSourceTypeYtt       ApplicationSourceType = "Ytt"
+	ApplicationSourceTypeHelmfile  ApplicationSourceType = "Helmfile"
 )
 
 // RefreshType specifies how to refresh the sources of a given application
@@ -220,6 +247,19 @@ const (
 	RefreshTypeHard   RefreshType = "hard"
 )
 
+// TrackingMethod specifies how Argo CD records the application which a resource belongs to
+type TrackingMethod string
+
+const (
+	// TrackingMethodLabel records the application name in the application instance label
+	TrackingMethodLabel TrackingMethod = "label"
+	// TrackingMethodAnnotation records the application name and the resource identity in the tracking-id annotation
+	TrackingMethodAnnotation TrackingMethod = "annotation"
+	// TrackingMethodAnnotationAndLabel tracks the resources with the annotation, and also sets the label for tools which
+	// rely on it
+	TrackingMethodAnnotationAndLabel TrackingMethod = "annotation+label"
+)
+
 // ApplicationSourceHelm holds helm specific options
 type ApplicationSourceHelm struct {
 	// ValuesFiles is a list of Helm value files to use when generating a template
@@ -468,11 +508,13 @@ type ApplicationSourceDirectory struct {
 	Exclude string `json:"exclude,omitempty" protobuf:"bytes,3,opt,name=exclude"`
 	// Include contains a glob pattern to match paths against that should be explicitly included during manifest generation
 	Include string `json:"include,omitempty" protobuf:"bytes,4,opt,name=include"`
+	// Envsubst specifies whether to substitute references to build environment variables, e.g. $ARGOCD_APP_NAME, in plain YAML manifests
+	Envsubst bool `json:"envsubst,omitempty" protobuf:"bytes,5,opt,name=envsubst"`
 }
 
 // IsZero returns true if the ApplicationSourceDirectory is considered empty
 func (d *ApplicationSourceDirectory) IsZero() bool {
-	return d == nil || !d.Recurse && d.Jsonnet.IsZero()
+	return d == nil || !d.Recurse && !d.Envsubst && d.Jsonnet.IsZero()
 }
 
 // ApplicationSourcePlugin holds options specific to config management plugins
@@ -514,6 +556,59 @@ func (c *ApplicationSourcePlugin) RemoveEnvEntry(key string) error {
 	return fmt.Errorf("unable to find env variable with key %q for plugin %q", key, c.Name)
 }
 
+// ApplicationSourceYtt holds options specific to applications rendered with ytt
+type ApplicationSourceYtt struct {
+	// Files is a list of additional template and overlay files or directories, relative to the application path
+	Files []string `json:"files,omitempty" protobuf:"bytes,1,rep,name=files"`
+	// DataValuesFiles is a list of data values files, relative to the application path
+	DataValuesFiles []string `json:"dataValuesFiles,omitempty" protobuf:"bytes,2,rep,name=dataValuesFiles"`
+	// DataValues is a list of data values overriding the ones of the templates and data values files
+	DataValues []YttDataValue `json:"dataValues,omitempty" protobuf:"bytes,3,rep,name=dataValues"`
+	// Kbld specifies whether images of the rendered manifests are resolved to digests with kbld
+	Kbld bool `json:"kbld,omitempty" protobuf:"varint,4,opt,name=kbld"`
+}
+
+// YttDataValue is a data value passed to ytt
+type YttDataValue struct {
+	// Name is the path of the data value, e.g. app.replicas
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the data value
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+	// YAML specifies whether the value is parsed as YAML instead of being used as a string
+	YAML bool `json:"yaml,omitempty" protobuf:"varint,3,opt,name=yaml"`
+}
+
+// IsZero returns true if the ApplicationSourceYtt is considered empty
+func (y *ApplicationSourceYtt) IsZero() bool {
+	return y == nil || len(y.Files) == 0 && len(y.DataValuesFiles) == 0 && len(y.DataValues) == 0 && !y.Kbld
+}
+
+// AddDataValue merges a data value into the list of data values. If a data value with the same name already exists,
+// it is overwritten. Otherwise, the data value is appended to the list.
+func (y *ApplicationSourceYtt) AddDataValue(value YttDataValue) {
+	for i, v := range y.DataValues {
+		if v.Name == value.Name {
+			y.DataValues[i] = value
+			return
+		}
+	}
+	y.DataValues = append(y.DataValues, value)
+}
+
+// ApplicationSourceHelmfile holds options specific to applications rendered with helmfile
+type ApplicationSourceHelmfile struct {
+	// Environment is the helmfile environment used to render the releases
+	Environment string `json:"environment,omitempty" protobuf:"bytes,1,opt,name=environment"`
+	// Selectors is a list of label selectors, e.g. tier=frontend, restricting the rendered releases. Releases matching
+	// any of the selectors are rendered.
+	Selectors []string `json:"selectors,omitempty" protobuf:"bytes,2,rep,name=selectors"`
+}
+
+// IsZero returns true if the ApplicationSourceHelmfile is considered empty
+func (h *ApplicationSourceHelmfile) IsZero() bool {
+	return h == nil || h.Environment == "" && len(h.Selectors) == 0
+}
+
 // ApplicationDestination holds information about the application's destination
 type ApplicationDestination struct {
 	// Server specifies the URL of the target cluster and must be set to the Kubernetes control plane API
@@ -611,6 +706,15 @@ func (in RevisionHistories) Trunc(n int) RevisionHistories {
 	return in
 }
 
+// TruncBefore removes the history items deployed before the given time. The most recent item is always kept since it
+// describes the currently deployed revision.
+func (in RevisionHistories) TruncBefore(t time.Time) RevisionHistories {
+	for len(in) > 1 && in[0].DeployedAt.Time.Before(t) {
+		in = in[1:]
+	}
+	return in
+}
+
 // HasIdentity determines whether a sync operation is identified by a manifest
 func (r SyncOperationResource) HasIdentity(name string, namespace string, gvk schema.GroupVersionKind) bool {
 	if name == r.Name && gvk.Kind == r.Kind && gvk.Group == r.Group && (r.Namespace == "" || namespace == r.Namespace) {
@@ -639,6 +743,8 @@ type SyncOperation struct {
 	Manifests []string `json:"manifests,omitempty" protobuf:"bytes,8,opt,name=manifests"`
 	// SyncOptions provide per-sync sync-options, e.g. Validate=false
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,9,opt,name=syncOptions"`
+	// RolledBackRevision is the revision rolled back by this sync. It is set by automatic rollbacks
+	RolledBackRevision string `json:"rolledBackRevision,omitempty" protobuf:"bytes,10,opt,name=rolledBackRevision"`
 }
 
 // IsApplyStrategy returns true if the sync strategy is "apply"
@@ -703,6 +809,25 @@ func (o SyncOptions) HasOption(option string) bool {
 	return false
 }
 
+// WithDefaults returns the list of sync options completed with the given default options whose name, i.e. the part
+// before the "=" sign, is not set in the list
+func (o SyncOptions) WithDefaults(defaults SyncOptions) SyncOptions {
+	optionName := func(option string) string {
+		return strings.SplitN(option, "=", 2)[0]
+	}
+	names := make(map[string]bool, len(o))
+	for _, option := range o {
+		names[optionName(option)] = true
+	}
+	res := append(SyncOptions{}, o...)
+	for _, option := range defaults {
+		if !names[optionName(option)] {
+			res = append(res, option)
+		}
+	}
+	return res
+}
+
 // SyncPolicy controls when a sync will be performed in response to updates in git
 type SyncPolicy struct {
 	// Automated will keep an application synced to the target revision
@@ -711,11 +836,24 @@ type SyncPolicy struct {
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,2,opt,name=syncOptions"`
 	// Retry controls failed sync retry behavior
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
+	// AutoRollback controls the automatic rollback of automated syncs which degrade the application health
+	AutoRollback *SyncPolicyAutoRollback `json:"autoRollback,omitempty" protobuf:"bytes,4,opt,name=autoRollback"`
+	// ManagedNamespaceMetadata controls the labels and annotations of the namespace created by the CreateNamespace=true sync option
+	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,5,opt,name=managedNamespaceMetadata"`
 }
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.AutoRollback == nil && p.ManagedNamespaceMetadata == nil)
+}
+
+// ManagedNamespaceMetadata contains the labels and annotations which are applied to the destination namespace of the
+// application and kept in sync by Argo CD
+type ManagedNamespaceMetadata struct {
+	// Labels are the labels of the namespace
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
+	// Annotations are the annotations of the namespace
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -724,6 +862,81 @@ type RetryStrategy struct {
 	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
 	// Backoff controls how to backoff on subsequent retries of failed syncs
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+	// Phases overrides the retry strategy of syncs which failed in the given sync phases
+	Phases []PhaseRetryStrategy `json:"phases,omitempty" protobuf:"bytes,3,rep,name=phases"`
+	// Hooks overrides the retry strategy of syncs in which the given hooks failed. It takes precedence over Phases
+	Hooks []HookRetryStrategy `json:"hooks,omitempty" protobuf:"bytes,4,rep,name=hooks"`
+}
+
+// PhaseRetryStrategy contains the strategy to apply when a sync failed in a given sync phase
+type PhaseRetryStrategy struct {
+	// Phase is the sync phase the strategy applies to, one of PreSync, Sync, PostSync or SyncFail
+	Phase synccommon.SyncPhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Limit is the maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,2,opt,name=limit"`
+	// Backoff controls how to backoff on subsequent retries of failed syncs
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff,casttype=Backoff"`
+}
+
+// HookRetryStrategy contains the strategy to apply when a given hook failed
+type HookRetryStrategy struct {
+	// Name is the name of the hook resource the strategy applies to
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Limit is the maximum number of attempts for retrying a failed sync. If set to 0, no retries will be performed.
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,2,opt,name=limit"`
+	// Backoff controls how to backoff on subsequent retries of failed syncs
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff,casttype=Backoff"`
+}
+
+// ForSyncResult returns the retry strategy to apply to the failed sync with the given result: the strategy of the first
+// failed hook which has one, else the strategy of the sync phase of the first failed resource which has one, else the
+// strategy itself.
+func (r *RetryStrategy) ForSyncResult(res *SyncOperationResult) RetryStrategy {
+	strategy := RetryStrategy{Limit: r.Limit, Backoff: r.Backoff}
+	if res == nil || (len(r.Phases) == 0 && len(r.Hooks) == 0) {
+		return strategy
+	}
+	var failed []*ResourceResult
+	for _, resource := range res.Resources {
+		if resource.Status == synccommon.ResultCodeSyncFailed || resource.HookPhase == synccommon.OperationFailed || resource.HookPhase == synccommon.OperationError {
+			failed = append(failed, resource)
+		}
+	}
+	for _, resource := range failed {
+		if resource.HookType == "" {
+			continue
+		}
+		for _, hook := range r.Hooks {
+			if hook.Name == resource.Name {
+				return RetryStrategy{Limit: hook.Limit, Backoff: hook.Backoff}
+			}
+		}
+	}
+	for _, resource := range failed {
+		for _, phase := range r.Phases {
+			if phase.Phase == resource.SyncPhase {
+				return RetryStrategy{Limit: phase.Limit, Backoff: phase.Backoff}
+			}
+		}
+	}
+	return strategy
+}
+
+// Validate checks whether the retry strategy has a valid configuration
+func (r *RetryStrategy) Validate() error {
+	for _, phase := range r.Phases {
+		switch phase.Phase {
+		case synccommon.SyncPhasePreSync, synccommon.SyncPhaseSync, synccommon.SyncPhasePostSync, synccommon.SyncPhaseSyncFail:
+		default:
+			return fmt.Errorf("unknown sync phase '%s': can only be PreSync, Sync, PostSync or SyncFail", phase.Phase)
+		}
+	}
+	for _, hook := range r.Hooks {
+		if hook.Name == "" {
+			return fmt.Errorf("hook name is required")
+		}
+	}
+	return nil
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -790,6 +1003,24 @@ type SyncPolicyAutomated struct {
 	AllowEmpty bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 }
 
+// SyncPolicyAutoRollback controls the automatic rollback of automated syncs. If the application becomes Degraded within
+// the given duration after an automated sync, it is synced back to the previous revision of its history
+type SyncPolicyAutoRollback struct {
+	// Duration is the amount of time the health of the application is monitored after an automated sync, e.g. 5m (default: 5m)
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+}
+
+// DefaultAutoRollbackDuration is the default amount of time the health of the application is monitored after an automated sync
+const DefaultAutoRollbackDuration = 5 * time.Minute
+
+// GetDuration returns the amount of time the health of the application is monitored after an automated sync
+func (r *SyncPolicyAutoRollback) GetDuration() (time.Duration, error) {
+	if r.Duration == "" {
+		return DefaultAutoRollbackDuration, nil
+	}
+	return time.ParseDuration(r.Duration)
+}
+
 // SyncStrategy controls the manner in which a sync is performed
 type SyncStrategy struct {
 	// Apply will perform a `kubectl apply` to perform the sync.
@@ -843,6 +1074,8 @@ type RevisionMetadata struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 	// SignatureInfo contains a hint on the signer if the revision was signed with GPG, and signature verification is enabled.
 	SignatureInfo string `json:"signatureInfo,omitempty" protobuf:"bytes,5,opt,name=signatureInfo"`
+	// ChangedFiles contains the paths of the files changed since the previous revision, if it was requested
+	ChangedFiles []string `json:"changedFiles,omitempty" protobuf:"bytes,6,rep,name=changedFiles"`
 }
 
 // SyncOperationResult represent result of sync operation
@@ -924,6 +1157,8 @@ type RevisionHistory struct {
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,6,opt,name=source"`
 	// DeployStartedAt holds the time the sync operation started
 	DeployStartedAt *metav1.Time `json:"deployStartedAt,omitempty" protobuf:"bytes,7,opt,name=deployStartedAt"`
+	// RolledBackRevision holds the revision which was automatically rolled back by the sync operation
+	RolledBackRevision string `json:"rolledBackRevision,omitempty" protobuf:"bytes,8,opt,name=rolledBackRevision"`
 }
 
 // ApplicationWatchEvent contains information about application change.
@@ -936,6 +1171,11 @@ type ApplicationWatchEvent struct {
 	//  * If Type is Error: *api.Status is recommended; other types may make sense
 	//    depending on context.
 	Application Application `json:"application" protobuf:"bytes,2,opt,name=application"`
+
+	// Patch is the JSON merge patch of the application since the previous event of the same application, which
+	// incremental watches send instead of the full application when Type is Modified. Application then only holds the
+	// name and resource version of the application.
+	Patch string `json:"patch,omitempty" protobuf:"bytes,3,opt,name=patch"`
 }
 
 // ApplicationList is list of Application resources
@@ -975,6 +1215,8 @@ type ApplicationConditionType = string
 const (
 	// ApplicationConditionDeletionError indicates that controller failed to delete application
 	ApplicationConditionDeletionError = "DeletionError"
+	// ApplicationConditionDeletionProgress reports the resources remaining for deletion while the controller deletes the resources of an application
+	ApplicationConditionDeletionProgress = "DeletionProgress"
 	// ApplicationConditionInvalidSpecError indicates that application source is invalid
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationConditionComparisonError indicates controller failed to compare application state
@@ -991,6 +1233,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionManifestGenerationWarning indicates that non-fatal issues were found while generating the application manifests
+	ApplicationConditionManifestGenerationWarning = "ManifestGenerationWarning"
+	// ApplicationConditionManifestPolicyError indicates that the manifests violate the manifest policy of the project
+	ApplicationConditionManifestPolicyError = "ManifestPolicyError"
 )
 
 // ApplicationCondition contains details about an application condition, which is usally an error or warning
@@ -1279,6 +1525,20 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// ResourceExclusions holds the API groups and kinds of the cluster to exclude from Argo CD's watch, in addition to the ones excluded in argocd-cm
+	ResourceExclusions []ClusterResourceFilter `json:"resourceExclusions,omitempty" protobuf:"bytes,14,rep,name=resourceExclusions"`
+	// ResourceInclusions holds the only API groups and kinds of the cluster that Argo CD will watch, in addition to the ones included in argocd-cm
+	ResourceInclusions []ClusterResourceFilter `json:"resourceInclusions,omitempty" protobuf:"bytes,15,rep,name=resourceInclusions"`
+	// ResyncInterval overrides the interval of the full resynchronization of the cluster cache, e.g. to resync large clusters less often
+	ResyncInterval *metav1.Duration `json:"resyncInterval,omitempty" protobuf:"bytes,16,opt,name=resyncInterval"`
+}
+
+// ClusterResourceFilter matches the resources of a cluster by API group and kind
+type ClusterResourceFilter struct {
+	// APIGroups is the list of matched API groups, which supports glob patterns. All the groups are matched if empty.
+	APIGroups []string `json:"apiGroups,omitempty" protobuf:"bytes,1,rep,name=apiGroups"`
+	// Kinds is the list of matched kinds, or "*". All the kinds are matched if empty.
+	Kinds []string `json:"kinds,omitempty" protobuf:"bytes,2,rep,name=kinds"`
 }
 
 // Equals returns true if two cluster objects are considered to be equal
@@ -1316,6 +1576,18 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if !reflect.DeepEqual(c.ResourceExclusions, other.ResourceExclusions) {
+		return false
+	}
+
+	if !reflect.DeepEqual(c.ResourceInclusions, other.ResourceInclusions) {
+		return false
+	}
+
+	if !reflect.DeepEqual(c.ResyncInterval, other.ResyncInterval) {
+		return false
+	}
+
 	return reflect.DeepEqual(c.Config, other.Config)
 }
 
@@ -1366,6 +1638,9 @@ type AWSAuthConfig struct {
 	RoleARN string `json:"roleARN,omitempty" protobuf:"bytes,2,opt,name=roleARN"`
 }
 
+// awsAuthCommand is the command run to authenticate against the clusters configured with an AWSAuthConfig
+const awsAuthCommand = "aws"
+
 // ExecProviderConfig is config used to call an external command to perform cluster authentication
 // See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig
 type ExecProviderConfig struct {
@@ -1441,11 +1716,12 @@ type OverrideIgnoreDiff struct {
 }
 
 type rawResourceOverride struct {
-	HealthLua         string           `json:"health.lua,omitempty"`
-	UseOpenLibs       bool             `json:"health.lua.useOpenLibs,omitempty"`
-	Actions           string           `json:"actions,omitempty"`
-	IgnoreDifferences string           `json:"ignoreDifferences,omitempty"`
-	KnownTypeFields   []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthLua             string           `json:"health.lua,omitempty"`
+	UseOpenLibs           bool             `json:"health.lua.useOpenLibs,omitempty"`
+	Actions               string           `json:"actions,omitempty"`
+	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
+	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
+	KnownTypeFields       []KnownTypeField `json:"knownTypeFields,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -1455,7 +1731,9 @@ type ResourceOverride struct {
 	UseOpenLibs       bool               `protobuf:"bytes,5,opt,name=useOpenLibs"`
 	Actions           string             `protobuf:"bytes,3,opt,name=actions"`
 	IgnoreDifferences OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
-	KnownTypeFields   []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	// IgnoreResourceUpdates lists the fields whose updates do not trigger the refresh of the applications
+	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
+	KnownTypeFields       []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
 }
 
 // TODO: describe this method
@@ -1468,6 +1746,9 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
 	s.Actions = raw.Actions
+	if err := yaml.Unmarshal([]byte(raw.IgnoreResourceUpdates), &s.IgnoreResourceUpdates); err != nil {
+		return err
+	}
 	return yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 }
 
@@ -1477,7 +1758,11 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
+	ignoreResourceUpdatesData, err := yaml.Marshal(s.IgnoreResourceUpdates)
+	if err != nil {
+		return nil, err
+	}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), s.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -1513,13 +1798,14 @@ type ResourceAction struct {
 	Disabled bool                  `json:"disabled,omitempty" protobuf:"varint,3,opt,name=disabled"`
 }
 
-// TODO: describe this type
-// TODO: describe members of this type
+// ResourceActionParam is a parameter a resource action declares in its discovery script
 type ResourceActionParam struct {
-	Name    string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
-	Value   string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
-	Type    string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
-	Default string `json:"default,omitempty" protobuf:"bytes,4,opt,name=default"`
+	// Name is the name of the parameter
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the parameter: string (the default), integer, number or boolean
+	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// Default is the value of the parameter when it is not supplied, the parameters without a default are required
+	Default *string `json:"default,omitempty" protobuf:"bytes,4,opt,name=default"`
 }
 
 // TODO: refactor to use rbacpolicy.ActionGet, rbacpolicy.ActionCreate, without import cycle
@@ -1631,6 +1917,40 @@ type SignatureKey struct {
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
 }
 
+// ChartSignatureVerification is the specification of the cosign signatures accepted for OCI Helm charts.
+// A chart is accepted if it is signed with any of the public keys, or has a keyless signature issued to any of the identities.
+type ChartSignatureVerification struct {
+	// PublicKeys is a list of PEM encoded cosign public keys
+	PublicKeys []string `json:"publicKeys,omitempty" protobuf:"bytes,1,rep,name=publicKeys"`
+	// KeylessIdentities is a list of identities of keyless signing certificates issued by Fulcio
+	KeylessIdentities []KeylessIdentity `json:"keylessIdentities,omitempty" protobuf:"bytes,2,rep,name=keylessIdentities"`
+}
+
+// KeylessIdentity is the identity of a keyless signing certificate
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer of the identity, e.g. https://token.actions.githubusercontent.com
+	Issuer string `json:"issuer" protobuf:"bytes,1,name=issuer"`
+	// Subject is the identity, e.g. an email address or a CI workflow URL
+	Subject string `json:"subject" protobuf:"bytes,2,name=subject"`
+}
+
+// IsZero returns true if no signature is configured
+func (v *ChartSignatureVerification) IsZero() bool {
+	return v == nil || len(v.PublicKeys) == 0 && len(v.KeylessIdentities) == 0
+}
+
+// GetHelmVerification returns the signature verification settings used by the Helm client
+func (v *ChartSignatureVerification) GetHelmVerification() *helm.SignatureVerification {
+	if v.IsZero() {
+		return nil
+	}
+	res := &helm.SignatureVerification{PublicKeys: v.PublicKeys}
+	for _, identity := range v.KeylessIdentities {
+		res.KeylessIdentities = append(res.KeylessIdentities, helm.KeylessIdentity{Issuer: identity.Issuer, Subject: identity.Subject})
+	}
+	return res
+}
+
 // AppProjectSpec is the specification of an AppProject
 type AppProjectSpec struct {
 	// SourceRepos contains list of repository URLs which can be used for deployment
@@ -1655,6 +1975,65 @@ type AppProjectSpec struct {
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
+	// ChartSignatureVerification specifies the cosign signatures that OCI Helm charts must be signed with in order to be allowed for sync
+	ChartSignatureVerification *ChartSignatureVerification `json:"chartSignatureVerification,omitempty" protobuf:"bytes,12,opt,name=chartSignatureVerification"`
+	// HelmVersion is the Helm version used by the applications of this project which don't specify one, either v2, v3 or a version registered in argocd-cm
+	HelmVersion string `json:"helmVersion,omitempty" protobuf:"bytes,13,opt,name=helmVersion"`
+	// KustomizeBuildOptions are the options of `kustomize build` used by the applications of this project, which must be allowed by the repo server
+	KustomizeBuildOptions *KustomizeBuildOptions `json:"kustomizeBuildOptions,omitempty" protobuf:"bytes,14,opt,name=kustomizeBuildOptions"`
+	// SopsKeySet is the name of the SOPS key set of the repo server the SOPS encrypted Helm values files and manifests of the applications of this project are decrypted with, they are not decrypted if empty
+	SopsKeySet string `json:"sopsKeySet,omitempty" protobuf:"bytes,20,opt,name=sopsKeySet"`
+	// ResourceCustomizations are the Lua health checks and actions of the resources of the applications of this project, used for the resources whose health check or actions are not customized in argocd-cm
+	ResourceCustomizations []ProjectResourceCustomization `json:"resourceCustomizations,omitempty" protobuf:"bytes,16,rep,name=resourceCustomizations"`
+	// SyncOptions are the default sync options of the applications of this project, used for the options not set by the application or the sync operation
+	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,17,opt,name=syncOptions"`
+	// ManifestPolicy restricts the manifests that the applications of this project can deploy
+	ManifestPolicy *ManifestPolicy `json:"manifestPolicy,omitempty" protobuf:"bytes,18,opt,name=manifestPolicy"`
+	// DestinationServiceAccounts are the service accounts impersonated to sync the applications of this project to their destination, when the impersonation is enabled in argocd-cm
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,19,rep,name=destinationServiceAccounts"`
+}
+
+// ApplicationDestinationServiceAccount is the service account impersonated to sync the applications to a destination
+type ApplicationDestinationServiceAccount struct {
+	// Server is the URL of the destination cluster, which can be a glob pattern
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace is the destination namespace, which can be a glob pattern
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount is the name of the impersonated service account, in the destination namespace unless qualified as <namespace>:<name>
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+}
+
+// ManifestPolicy contains the rules that the manifests of the applications of a project must comply with. The
+// applications whose manifests violate the policy cannot be synced.
+type ManifestPolicy struct {
+	// RequiredAnnotations are the annotations that every resource must have
+	RequiredAnnotations []string `json:"requiredAnnotations,omitempty" protobuf:"bytes,1,rep,name=requiredAnnotations"`
+	// ForbiddenFields are the fields that the resources must not set
+	ForbiddenFields []ForbiddenManifestField `json:"forbiddenFields,omitempty" protobuf:"bytes,2,rep,name=forbiddenFields"`
+}
+
+// ForbiddenManifestField is a field that the resources of a given kind must not set
+type ForbiddenManifestField struct {
+	// Group is the API group of the resources, the field is forbidden in the resources of all groups if empty
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the resources, the field is forbidden in the resources of all kinds if empty
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// JQPathExpression is the JQ path expression of the field, e.g. .spec.template.spec.hostNetwork
+	JQPathExpression string `json:"jqPathExpression" protobuf:"bytes,3,opt,name=jqPathExpression"`
+	// Value is the JSON value that the field is forbidden to have, e.g. true. Any value other than null is forbidden if empty.
+	Value string `json:"value,omitempty" protobuf:"bytes,4,opt,name=value"`
+}
+
+// ProjectResourceCustomization contains the Lua health check and actions of a kind of resources
+type ProjectResourceCustomization struct {
+	// Group is the API group of the resources
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// HealthLua is the Lua script assessing the health of the resources
+	HealthLua string `json:"healthLua,omitempty" protobuf:"bytes,3,opt,name=healthLua"`
+	// Actions are the Lua discovery script and action definitions of the resources, in the same format as in argocd-cm
+	Actions string `json:"actions,omitempty" protobuf:"bytes,4,opt,name=actions"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -1676,6 +2055,12 @@ type SyncWindow struct {
 	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,opt,name=clusters"`
 	// ManualSync enables manual syncs when they would otherwise be blocked
 	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
+	// TimeZone is the IANA time zone of the schedule, e.g. Europe/Paris. Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
+	// ClusterSelector selects the destination clusters, by the labels of their cluster secrets, that the window will apply to
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty" protobuf:"bytes,9,opt,name=clusterSelector"`
+	// NamespaceSelector selects the destination namespaces, by their labels, that the window will apply to
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" protobuf:"bytes,10,opt,name=namespaceSelector"`
 }
 
 // HasWindows returns true if any window is defined
@@ -1689,19 +2074,10 @@ func (s *SyncWindows) Active() *SyncWindows {
 }
 
 func (s *SyncWindows) active(currentTime time.Time) *SyncWindows {
-
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if s.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *s {
-			schedule, _ := specParser.Parse(w.Schedule)
-			duration, _ := time.ParseDuration(w.Duration)
-			nextWindow := schedule.Next(currentTime.Add(-duration))
-			if nextWindow.Before(currentTime) {
+			if w.active(currentTime) {
 				active = append(active, w)
 			}
 		}
@@ -1718,11 +2094,6 @@ func (s *SyncWindows) InactiveAllows() *SyncWindows {
 }
 
 func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
-
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if s.HasWindows() {
 		var inactive SyncWindows
 		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
@@ -1730,8 +2101,9 @@ func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
 			if w.Kind == "allow" {
 				schedule, sErr := specParser.Parse(w.Schedule)
 				duration, dErr := time.ParseDuration(w.Duration)
-				nextWindow := schedule.Next(currentTime.Add(-duration))
-				if !nextWindow.Before(currentTime) && sErr == nil && dErr == nil {
+				localTime := currentTime.In(w.location())
+				nextWindow := schedule.Next(localTime.Add(-duration))
+				if !nextWindow.Before(localTime) && sErr == nil && dErr == nil {
 					inactive = append(inactive, w)
 				}
 			}
@@ -1744,7 +2116,7 @@ func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
-func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool) error {
+func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, tz string, clSelector *metav1.LabelSelector, nsSelector *metav1.LabelSelector) error {
 	if len(knd) == 0 || len(sch) == 0 || len(dur) == 0 {
 		return fmt.Errorf("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
 
@@ -1754,6 +2126,7 @@ func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []str
 		Schedule:   sch,
 		Duration:   dur,
 		ManualSync: ms,
+		TimeZone:   tz,
 	}
 
 	if len(app) > 0 {
@@ -1765,6 +2138,8 @@ func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []str
 	if len(cl) > 0 {
 		window.Clusters = cl
 	}
+	window.ClusterSelector = clSelector
+	window.NamespaceSelector = nsSelector
 
 	err := window.Validate()
 	if err != nil {
@@ -1793,8 +2168,15 @@ func (s *AppProjectSpec) DeleteWindow(id int) error {
 	return nil
 }
 
-// Matches returns a list of sync windows that are defined for a given application
+// Matches returns a list of sync windows that are defined for a given application. Windows which select clusters or
+// namespaces by labels are only matched by MatchesWithLabels.
 func (w *SyncWindows) Matches(app *Application) *SyncWindows {
+	return w.MatchesWithLabels(app, nil, nil)
+}
+
+// MatchesWithLabels returns a list of sync windows that are defined for a given application, matching the cluster and
+// namespace selectors of the windows with the labels of the destination cluster and namespace of the application.
+func (w *SyncWindows) MatchesWithLabels(app *Application, clusterLabels map[string]string, namespaceLabels map[string]string) *SyncWindows {
 	if w.HasWindows() {
 		var matchingWindows SyncWindows
 		for _, w := range *w {
@@ -1822,6 +2204,12 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 					}
 				}
 			}
+			if selectorMatches(w.ClusterSelector, clusterLabels) {
+				matchingWindows = append(matchingWindows, w)
+			}
+			if selectorMatches(w.NamespaceSelector, namespaceLabels) {
+				matchingWindows = append(matchingWindows, w)
+			}
 		}
 		if len(matchingWindows) > 0 {
 			return &matchingWindows
@@ -1858,6 +2246,32 @@ func (w *SyncWindows) CanSync(isManual bool) bool {
 	return false
 }
 
+// HasSelectors returns true if any window selects clusters or namespaces by labels
+func (w *SyncWindows) HasSelectors() bool {
+	if !w.HasWindows() {
+		return false
+	}
+	for _, s := range *w {
+		if s.ClusterSelector != nil || s.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorMatches returns true if the labels are matched by the selector. Nil labels are never matched, as they are
+// not known.
+func selectorMatches(selector *metav1.LabelSelector, labels map[string]string) bool {
+	if selector == nil || labels == nil {
+		return false
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+	return s.Matches(k8slabels.Set(labels))
+}
+
 func (w *SyncWindows) hasDeny() (bool, bool) {
 	if !w.HasWindows() {
 		return false, false
@@ -1911,9 +2325,9 @@ func (w SyncWindow) Active() bool {
 
 func (w SyncWindow) active(currentTime time.Time) bool {
 
-	// If SyncWindow.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before search
-	currentTime = currentTime.UTC()
+	// The schedule is evaluated in the time zone of the window, UTC by default,
+	// whatever the locale SyncWindow.Active() is called in
+	currentTime = currentTime.In(w.location())
 
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 	schedule, _ := specParser.Parse(w.Schedule)
@@ -1923,10 +2337,22 @@ func (w SyncWindow) active(currentTime time.Time) bool {
 	return nextWindow.Before(currentTime)
 }
 
+// location returns the time zone of the sync window schedule, UTC if none or an invalid one is set
+func (w SyncWindow) location() *time.Location {
+	if w.TimeZone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // Update updates a sync window's settings with the given parameter
-func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string) error {
-	if len(s) == 0 && len(d) == 0 && len(a) == 0 && len(n) == 0 && len(c) == 0 {
-		return fmt.Errorf("cannot update: require one or more of schedule, duration, application, namespace, or cluster")
+func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string) error {
+	if len(s) == 0 && len(d) == 0 && len(a) == 0 && len(n) == 0 && len(c) == 0 && len(tz) == 0 {
+		return fmt.Errorf("cannot update: require one or more of schedule, duration, application, namespace, cluster or time zone")
 	}
 
 	if len(s) > 0 {
@@ -1946,6 +2372,9 @@ func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []stri
 	if len(c) > 0 {
 		w.Clusters = c
 	}
+	if len(tz) > 0 {
+		w.TimeZone = tz
+	}
 
 	return nil
 }
@@ -1964,6 +2393,21 @@ func (w *SyncWindow) Validate() error {
 	if err != nil {
 		return fmt.Errorf("cannot parse duration '%s': %s", w.Duration, err)
 	}
+	if w.TimeZone != "" {
+		if _, err = time.LoadLocation(w.TimeZone); err != nil {
+			return fmt.Errorf("cannot parse time zone '%s': %s", w.TimeZone, err)
+		}
+	}
+	if w.ClusterSelector != nil {
+		if _, err = metav1.LabelSelectorAsSelector(w.ClusterSelector); err != nil {
+			return fmt.Errorf("cannot parse cluster selector: %s", err)
+		}
+	}
+	if w.NamespaceSelector != nil {
+		if _, err = metav1.LabelSelectorAsSelector(w.NamespaceSelector); err != nil {
+			return fmt.Errorf("cannot parse namespace selector: %s", err)
+		}
+	}
 	return nil
 }
 
@@ -2010,6 +2454,24 @@ type ConfigManagementPlugin struct {
 	Name     string   `json:"name" protobuf:"bytes,1,name=name"`
 	Init     *Command `json:"init,omitempty" protobuf:"bytes,2,name=init"`
 	Generate Command  `json:"generate" protobuf:"bytes,3,name=generate"`
+	// Discover selects the plugin for applications which don't specify their source type
+	Discover *ConfigManagementPluginDiscovery `json:"discover,omitempty" protobuf:"bytes,4,opt,name=discover"`
+	// Env is a list of environment variables set for the plugin commands. Values starting with '$' reference a key of
+	// argocd-secret, or of another secret labeled as part of Argo CD with the '$<secret-name>:<key>' syntax.
+	Env Env `json:"env,omitempty" protobuf:"bytes,5,rep,name=env"`
+	// AllowedAppEnv is a list of glob patterns matching the names of the environment variables applications can set in
+	// spec.source.plugin.env. Applications can set any variable if empty.
+	AllowedAppEnv []string `json:"allowedAppEnv,omitempty" protobuf:"bytes,6,rep,name=allowedAppEnv"`
+}
+
+// ConfigManagementPluginDiscovery determines whether a config management plugin is used for an application directory.
+// The plugin is selected if any of the rules match.
+type ConfigManagementPluginDiscovery struct {
+	// FileName is a glob pattern which matches the name of at least one file in the application directory
+	FileName string `json:"fileName,omitempty" protobuf:"bytes,1,opt,name=fileName"`
+	// Find is a command run in the application directory, which selects the plugin if it succeeds and prints a
+	// non-empty output
+	Find *Command `json:"find,omitempty" protobuf:"bytes,2,opt,name=find"`
 }
 
 // KustomizeOptions are options for kustomize to use when building manifests
@@ -2018,6 +2480,26 @@ type KustomizeOptions struct {
 	BuildOptions string `protobuf:"bytes,1,opt,name=buildOptions"`
 	// BinaryPath holds optional path to kustomize binary
 	BinaryPath string `protobuf:"bytes,2,opt,name=binaryPath"`
+	// ProjectBuildOptions are the build options of the project of the application
+	ProjectBuildOptions *KustomizeBuildOptions `protobuf:"bytes,3,opt,name=projectBuildOptions"`
+}
+
+// KustomizeBuildOptions are options of `kustomize build` enabled by a project
+type KustomizeBuildOptions struct {
+	// LoadRestrictor is the value of the --load-restrictor flag, e.g. LoadRestrictionsNone
+	LoadRestrictor string `json:"loadRestrictor,omitempty" protobuf:"bytes,1,opt,name=loadRestrictor"`
+	// EnableAlphaPlugins sets the --enable-alpha-plugins flag
+	EnableAlphaPlugins bool `json:"enableAlphaPlugins,omitempty" protobuf:"varint,2,opt,name=enableAlphaPlugins"`
+	// ExtraArgs are additional flags in the format --flag or --flag=value
+	ExtraArgs []string `json:"extraArgs,omitempty" protobuf:"bytes,3,rep,name=extraArgs"`
+}
+
+// HelmOptions are options for helm to use when building manifests
+type HelmOptions struct {
+	// BinaryPath holds optional path to helm binary
+	BinaryPath string `protobuf:"bytes,1,opt,name=binaryPath"`
+	// Version is the major version of the helm binary, either v2 or v3
+	Version string `protobuf:"bytes,2,opt,name=version"`
 }
 
 // CascadedDeletion indicates if the deletion finalizer is set and controller should delete the application and it's cascaded resources
@@ -2050,6 +2532,26 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// GetManifestGenerationTimeout returns the manifest generation timeout of the application, or zero if the default
+// timeout of the repo server applies.
+func (app *Application) GetManifestGenerationTimeout() (time.Duration, error) {
+	val, ok := app.GetAnnotations()[AnnotationKeyManifestGenerationTimeout]
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(val)
+	if err != nil || timeout < time.Second {
+		return 0, fmt.Errorf("invalid value '%s' of annotation %s: must be a duration of at least 1s, e.g. 3m", val, AnnotationKeyManifestGenerationTimeout)
+	}
+	return timeout, nil
+}
+
+// IsReconcileSkipped returns whether the reconciliation of the application is paused by the skip-reconcile annotation
+func (app *Application) IsReconcileSkipped() bool {
+	skip, err := strconv.ParseBool(app.GetAnnotations()[AnnotationKeySkipReconcile])
+	return err == nil && skip
+}
+
 // SetCascadedDeletion will enable cascaded deletion by setting the propagation policy finalizer
 func (app *Application) SetCascadedDeletion(finalizer string) {
 	setFinalizer(&app.ObjectMeta, finalizer, true)
@@ -2185,6 +2687,12 @@ func (source *ApplicationSource) ExplicitType() (*ApplicationSourceType, error)
 	if source.Plugin != nil {
 		appTypes = append(appTypes, ApplicationSourceTypePlugin)
 	}
+	if source.Ytt != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeYtt)
+	}
+	if source.Helmfile != nil {
+		appTypes = append(appTypes, ApplicationSourceTypeHelmfile)
+	}
 	if len(appTypes) == 0 {
 		return nil, nil
 	}
@@ -2343,7 +2851,7 @@ func (c *Cluster) RawRestConfig() *rest.Config {
 				TLSClientConfig: tlsClientConfig,
 				ExecProvider: &api.ExecConfig{
 					APIVersion: "client.authentication.k8s.io/v1alpha1",
-					Command:    "aws",
+					Command:    awsAuthCommand,
 					Args:       args,
 				},
 			}
@@ -2381,9 +2889,124 @@ func (c *Cluster) RawRestConfig() *rest.Config {
 	if err != nil {
 		panic(fmt.Sprintf("Unable to create K8s REST config: %v", err))
 	}
+	if config.ExecProvider != nil {
+		if err := c.Config.ValidateExecProvider(ExecProviderAllowedPaths); err != nil {
+			// fail the requests sent to the cluster rather than running a command which is not allowed
+			config.ExecProvider = nil
+			config.WrapTransport = func(_ http.RoundTripper) http.RoundTripper {
+				return failingRoundTripper{err: err}
+			}
+		}
+	}
 	return config
 }
 
+// failingRoundTripper is a http.RoundTripper failing all the requests with the given error
+type failingRoundTripper struct {
+	err error
+}
+
+func (rt failingRoundTripper) RoundTrip(_ *http.Request) (*http.Response, error) {
+	return nil, rt.err
+}
+
+// ExecCommand returns the command run to authenticate against the cluster, if any
+func (c *ClusterConfig) ExecCommand() string {
+	if c.AWSAuthConfig != nil {
+		return awsAuthCommand
+	}
+	if c.ExecProviderConfig != nil {
+		return c.ExecProviderConfig.Command
+	}
+	return ""
+}
+
+// deniedExecProviderEnvPrefixes are the prefixes of the environment variables which cannot be set for the exec
+// providers, since they allow to run arbitrary code in the Argo CD components, e.g. LD_PRELOAD
+var deniedExecProviderEnvPrefixes = []string{"LD_", "DYLD_"}
+
+// deniedExecProviderEnv are the environment variables which cannot be set for the exec providers, since they change
+// the command which is run or allow to run arbitrary code in the Argo CD components
+var deniedExecProviderEnv = map[string]bool{
+	"PATH":          true,
+	"BASH_ENV":      true,
+	"ENV":           true,
+	"GCONV_PATH":    true,
+	"NODE_OPTIONS":  true,
+	"PERL5LIB":      true,
+	"PERL5OPT":      true,
+	"PYTHONPATH":    true,
+	"PYTHONHOME":    true,
+	"PYTHONSTARTUP": true,
+	"RUBYLIB":       true,
+	"RUBYOPT":       true,
+}
+
+// execCommandPaths caches the paths of the exec provider commands which are looked up in the PATH
+var execCommandPaths sync.Map
+
+// ValidateExecProvider returns an error if the command run to authenticate against the cluster is not located in one
+// of the given directories, or if the exec provider sets an environment variable which is not allowed. Any command is
+// allowed if no directory is given.
+func (c *ClusterConfig) ValidateExecProvider(allowedPaths []string) error {
+	if c.ExecProviderConfig != nil {
+		for name := range c.ExecProviderConfig.Env {
+			if isDeniedExecProviderEnv(name) {
+				return fmt.Errorf("exec provider environment variable %q is not allowed", name)
+			}
+		}
+	}
+	command := c.ExecCommand()
+	if command == "" || len(allowedPaths) == 0 {
+		return nil
+	}
+	path, err := lookExecCommandPath(command)
+	if err != nil {
+		return err
+	}
+	for _, dir := range allowedPaths {
+		rel, err := filepath.Rel(filepath.Clean(dir), path)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("exec provider command %q is not located in one of the allowed paths: %s", command, strings.Join(allowedPaths, ", "))
+}
+
+func isDeniedExecProviderEnv(name string) bool {
+	name = strings.ToUpper(name)
+	if deniedExecProviderEnv[name] {
+		return true
+	}
+	for _, prefix := range deniedExecProviderEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookExecCommandPath returns the absolute path of the given command, looking it up in the PATH if it is not absolute.
+// The paths found in the PATH are cached, since the clusters are validated each time their REST config is built.
+func lookExecCommandPath(command string) (string, error) {
+	if filepath.IsAbs(command) {
+		return filepath.Clean(command), nil
+	}
+	if path, ok := execCommandPaths.Load(command); ok {
+		return path.(string), nil
+	}
+	path, err := exec.LookPath(command)
+	if err != nil {
+		return "", fmt.Errorf("exec provider command %q cannot be found: %w", command, err)
+	}
+	if path, err = filepath.Abs(path); err != nil {
+		return "", fmt.Errorf("exec provider command %q cannot be resolved: %w", command, err)
+	}
+	path = filepath.Clean(path)
+	execCommandPaths.Store(command, path)
+	return path, nil
+}
+
 // RESTConfig returns a go-client REST config from cluster with tuned throttling and HTTP client settings.
 func (c *Cluster) RESTConfig() *rest.Config {
 	config := c.RawRestConfig()
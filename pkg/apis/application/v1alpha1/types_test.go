@@ -1,6 +1,11 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -9,8 +14,10 @@ import (
 
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -239,6 +246,18 @@ func TestAppProject_RemoveGroupFromRole(t *testing.T) {
 	})
 }
 
+func TestAppProject_SetJWTTokenExpiration(t *testing.T) {
+	p := newTestProject()
+	p.Spec.Roles[0].JWTTokens = []JWTToken{{ID: "abc", IssuedAt: 1}}
+	p.Status.JWTTokensByRole = map[string]JWTTokens{"my-role": {Items: []JWTToken{{ID: "abc", IssuedAt: 1}}}}
+
+	assert.NoError(t, p.SetJWTTokenExpiration(0, "abc", 100))
+	assert.Equal(t, int64(100), p.Spec.Roles[0].JWTTokens[0].ExpiresAt)
+	assert.Equal(t, int64(100), p.Status.JWTTokensByRole["my-role"].Items[0].ExpiresAt)
+
+	assert.Error(t, p.SetJWTTokenExpiration(0, "other", 100))
+}
+
 func newTestProject() *AppProject {
 	p := AppProject{
 		ObjectMeta: metav1.ObjectMeta{Name: "my-proj"},
@@ -280,6 +299,114 @@ func TestAppProject_ValidateRoleName(t *testing.T) {
 	}
 }
 
+// TestAppProject_ValidateSopsKeySet tests for an invalid SOPS key set name
+func TestAppProject_ValidateSopsKeySet(t *testing.T) {
+	p := newTestProject()
+	for _, badName := range []string{"../other", "my/keys", ".", "-keys"} {
+		p.Spec.SopsKeySet = badName
+		assert.Error(t, p.ValidateProject())
+	}
+	p.Spec.SopsKeySet = "my-keys"
+	assert.NoError(t, p.ValidateProject())
+}
+
+// TestAppProject_ValidateResourceCustomizations tests for invalid resource customizations
+func TestAppProject_ValidateResourceCustomizations(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ResourceCustomizations = []ProjectResourceCustomization{{Group: "example.com", Kind: "Widget"}, {Kind: "Widget"}}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.ResourceCustomizations = append(p.Spec.ResourceCustomizations, ProjectResourceCustomization{Group: "example.com", Kind: "Widget"})
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.ResourceCustomizations = []ProjectResourceCustomization{{Group: "example.com"}}
+	assert.Error(t, p.ValidateProject())
+}
+
+// TestAppProject_ValidateManifestPolicy tests for invalid manifest policies
+func TestAppProject_ValidateManifestPolicy(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ManifestPolicy = &ManifestPolicy{
+		RequiredAnnotations: []string{"example.com/owner"},
+		ForbiddenFields:     []ForbiddenManifestField{{JQPathExpression: ".spec.template.spec.hostNetwork", Value: "true"}},
+	}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicy.ForbiddenFields = []ForbiddenManifestField{{Kind: "Pod"}}
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicy.ForbiddenFields = []ForbiddenManifestField{{JQPathExpression: ".spec.hostNetwork", Value: "yes"}}
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicy.ForbiddenFields = []ForbiddenManifestField{{JQPathExpression: ".spec.["}}
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicy.ForbiddenFields = []ForbiddenManifestField{{JQPathExpression: "$undefined"}}
+	assert.Error(t, p.ValidateProject())
+}
+
+// TestAppProject_ValidateDestinationServiceAccounts tests for invalid destination service accounts
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"}}
+	assert.NoError(t, p.ValidateProject())
+
+	p.Spec.DestinationServiceAccounts = append(p.Spec.DestinationServiceAccounts, ApplicationDestinationServiceAccount{Server: "*", Namespace: "*", DefaultServiceAccount: "other"})
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Namespace: "*", DefaultServiceAccount: "deployer"}}
+	assert.Error(t, p.ValidateProject())
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*"}}
+	assert.Error(t, p.ValidateProject())
+}
+
+func TestAppProject_ServiceAccountToImpersonate(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "guestbook-deployer"},
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "argocd:team-deployer"},
+		{Server: "https://kubernetes.default.svc", Namespace: "", DefaultServiceAccount: "cluster-deployer"},
+	}
+
+	userName, err := p.ServiceAccountToImpersonate(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"})
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:guestbook:guestbook-deployer", userName)
+
+	userName, err = p.ServiceAccountToImpersonate(ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-a"})
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:team-deployer", userName)
+
+	_, err = p.ServiceAccountToImpersonate(ApplicationDestination{Server: "https://kubernetes.default.svc"})
+	assert.Error(t, err)
+
+	_, err = p.ServiceAccountToImpersonate(ApplicationDestination{Server: "https://other.cluster", Namespace: "guestbook"})
+	assert.Error(t, err)
+}
+
+func TestAppProject_ResourceOverrides(t *testing.T) {
+	overrides := map[string]ResourceOverride{
+		"example.com/Widget": {HealthLua: "global health"},
+		"example.com/Gadget": {UseOpenLibs: true},
+		"ConfigMap":          {IgnoreDifferences: OverrideIgnoreDiff{JSONPointers: []string{"/data"}}},
+	}
+	p := newTestProject()
+	assert.Equal(t, overrides, p.ResourceOverrides(overrides))
+
+	p.Spec.ResourceCustomizations = []ProjectResourceCustomization{
+		{Group: "example.com", Kind: "Widget", HealthLua: "project health", Actions: "project actions"},
+		{Group: "example.com", Kind: "Gadget", HealthLua: "project health"},
+		{Kind: "ConfigMap", HealthLua: "project health"},
+	}
+	assert.Equal(t, map[string]ResourceOverride{
+		"example.com/Widget": {HealthLua: "global health", Actions: "project actions"},
+		"example.com/Gadget": {UseOpenLibs: true},
+		"ConfigMap":          {HealthLua: "project health", IgnoreDifferences: OverrideIgnoreDiff{JSONPointers: []string{"/data"}}},
+	}, p.ResourceOverrides(overrides))
+	assert.Equal(t, "global health", overrides["example.com/Widget"].HealthLua)
+	assert.Empty(t, overrides["example.com/Widget"].Actions)
+}
+
 // TestValidateGroupName tests for an invalid group name
 func TestAppProject_ValidateGroupName(t *testing.T) {
 	p := newTestProject()
@@ -410,6 +537,29 @@ func TestExplicitType(t *testing.T) {
 	assert.Equal(t, *explicitType, ApplicationSourceTypeHelm)
 }
 
+func TestExplicitTypeYtt(t *testing.T) {
+	src := ApplicationSource{Ytt: &ApplicationSourceYtt{}}
+	explicitType, err := src.ExplicitType()
+	assert.NoError(t, err)
+	assert.Equal(t, ApplicationSourceTypeYtt, *explicitType)
+	assert.True(t, src.Ytt.IsZero())
+
+	src.Ytt.AddDataValue(YttDataValue{Name: "app.replicas", Value: "1", YAML: true})
+	src.Ytt.AddDataValue(YttDataValue{Name: "app.replicas", Value: "2", YAML: true})
+	assert.Equal(t, []YttDataValue{{Name: "app.replicas", Value: "2", YAML: true}}, src.Ytt.DataValues)
+}
+
+func TestExplicitTypeHelmfile(t *testing.T) {
+	src := ApplicationSource{Helmfile: &ApplicationSourceHelmfile{}}
+	explicitType, err := src.ExplicitType()
+	assert.NoError(t, err)
+	assert.Equal(t, ApplicationSourceTypeHelmfile, *explicitType)
+	assert.True(t, src.Helmfile.IsZero())
+
+	src.Helmfile.Environment = "production"
+	assert.False(t, src.Helmfile.IsZero())
+}
+
 func TestExplicitTypeWithDirectory(t *testing.T) {
 	src := ApplicationSource{
 		Ksonnet: &ApplicationSourceKsonnet{
@@ -881,6 +1031,24 @@ func TestNewHelmParameter(t *testing.T) {
 	})
 }
 
+func TestApplicationSource_IsOCI(t *testing.T) {
+	tests := []struct {
+		name   string
+		source *ApplicationSource
+		want   bool
+	}{
+		{"Git", &ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps"}, false},
+		{"Helm", &ApplicationSource{RepoURL: "https://charts.bitnami.com/bitnami", Chart: "redis"}, false},
+		{"HelmOCI", &ApplicationSource{RepoURL: "ghcr.io/org", Chart: "redis"}, false},
+		{"OCI", &ApplicationSource{RepoURL: "oci://ghcr.io/org/manifests"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.source.IsOCI())
+		})
+	}
+}
+
 func TestApplicationSourceHelm_IsZero(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -1378,9 +1546,9 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m))
+				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, "", nil, nil))
 			case "noError":
-				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m))
+				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, "", nil, nil))
 				assert.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
@@ -1435,6 +1603,30 @@ func TestSyncWindows_Matches(t *testing.T) {
 		windows := proj.Spec.SyncWindows.Matches(app)
 		assert.Nil(t, windows)
 	})
+	t.Run("MatchClusterSelector", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].ClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+		assert.Nil(t, proj.Spec.SyncWindows.Matches(app))
+		assert.Nil(t, proj.Spec.SyncWindows.MatchesWithLabels(app, map[string]string{"env": "dev"}, nil))
+		windows := proj.Spec.SyncWindows.MatchesWithLabels(app, map[string]string{"env": "prod"}, nil)
+		assert.Equal(t, 1, len(*windows))
+		proj.Spec.SyncWindows[0].ClusterSelector = nil
+	})
+	t.Run("MatchNamespaceSelector", func(t *testing.T) {
+		proj.Spec.SyncWindows[0].NamespaceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"payments", "billing"}},
+		}}
+		windows := proj.Spec.SyncWindows.MatchesWithLabels(app, nil, map[string]string{"team": "billing"})
+		assert.Equal(t, 1, len(*windows))
+		assert.Nil(t, proj.Spec.SyncWindows.MatchesWithLabels(app, map[string]string{"team": "billing"}, map[string]string{}))
+		proj.Spec.SyncWindows[0].NamespaceSelector = nil
+	})
+}
+
+func TestSyncWindows_HasSelectors(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	assert.False(t, proj.Spec.SyncWindows.HasSelectors())
+	proj.Spec.SyncWindows[0].ClusterSelector = &metav1.LabelSelector{}
+	assert.True(t, proj.Spec.SyncWindows.HasSelectors())
 }
 
 func TestSyncWindows_CanSync(t *testing.T) {
@@ -1714,34 +1906,39 @@ func TestSyncWindow_Active(t *testing.T) {
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
-		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{})
+		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{}, "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"app1", "app2"}, e.Applications)
 	})
 	t.Run("AddNamespace", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{})
+		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{}, "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"namespace1"}, e.Namespaces)
 	})
 	t.Run("AddCluster", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"})
+		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"}, "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"cluster1"}, e.Clusters)
 	})
 	t.Run("MissingConfig", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{})
-		assert.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, or cluster")
+		err := e.Update("", "", []string{}, []string{}, []string{}, "")
+		assert.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster or time zone")
 	})
 	t.Run("ChangeDuration", func(t *testing.T) {
-		err := e.Update("", "10h", []string{}, []string{}, []string{})
+		err := e.Update("", "10h", []string{}, []string{}, []string{}, "")
 		assert.NoError(t, err)
 		assert.Equal(t, "10h", e.Duration)
 	})
 	t.Run("ChangeSchedule", func(t *testing.T) {
-		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{})
+		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{}, "")
 		assert.NoError(t, err)
 		assert.Equal(t, "* 1 0 0 *", e.Schedule)
 	})
+	t.Run("ChangeTimeZone", func(t *testing.T) {
+		err := e.Update("", "", []string{}, []string{}, []string{}, "Asia/Tokyo")
+		assert.NoError(t, err)
+		assert.Equal(t, "Asia/Tokyo", e.TimeZone)
+	})
 }
 
 func TestSyncWindow_Validate(t *testing.T) {
@@ -1764,6 +1961,32 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		assert.Error(t, window.Validate())
 	})
+	t.Run("IncorrectTimeZone", func(t *testing.T) {
+		window.Duration = "1h"
+		window.TimeZone = "Mars/Olympus_Mons"
+		assert.Error(t, window.Validate())
+		window.TimeZone = "Europe/Paris"
+		assert.NoError(t, window.Validate())
+	})
+	t.Run("IncorrectSelector", func(t *testing.T) {
+		window.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Unknown"}}}
+		assert.Error(t, window.Validate())
+		window.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}
+		assert.NoError(t, window.Validate())
+	})
+}
+
+func TestSyncWindow_ActiveInTimeZone(t *testing.T) {
+	window := &SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "America/New_York"}
+
+	// 22:30 in New York is 02:30 UTC during daylight saving time and 03:30 UTC otherwise
+	assert.True(t, window.active(time.Date(2022, 7, 2, 2, 30, 0, 0, time.UTC)))
+	assert.True(t, window.active(time.Date(2022, 1, 2, 3, 30, 0, 0, time.UTC)))
+	assert.False(t, window.active(time.Date(2022, 7, 1, 22, 30, 0, 0, time.UTC)))
+
+	windows := SyncWindows{window}
+	assert.NotNil(t, windows.active(time.Date(2022, 7, 2, 2, 30, 0, 0, time.UTC)))
+	assert.Nil(t, windows.active(time.Date(2022, 7, 1, 22, 30, 0, 0, time.UTC)))
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -2024,6 +2247,13 @@ func TestSyncOptions_RemoveOption(t *testing.T) {
 	assert.Len(t, options.RemoveOption("a=1").RemoveOption("a=1"), 0)
 }
 
+func TestSyncOptions_WithDefaults(t *testing.T) {
+	options := SyncOptions{"Prune=false", "Validate=true"}
+	assert.Equal(t, SyncOptions{"Prune=false", "Validate=true", "CreateNamespace=true"}, options.WithDefaults(SyncOptions{"CreateNamespace=true", "Prune=true"}))
+	assert.Equal(t, SyncOptions{"Prune=false", "Validate=true"}, options, "the options must not be modified")
+	assert.Equal(t, SyncOptions{"ServerSideApply=true"}, SyncOptions(nil).WithDefaults(SyncOptions{"ServerSideApply=true"}))
+}
+
 func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Len(t, RevisionHistories{}.Trunc(1), 0)
 	assert.Len(t, RevisionHistories{{}}.Trunc(1), 1)
@@ -2032,6 +2262,18 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Equal(t, RevisionHistories{{Revision: "my-revision"}}, RevisionHistories{{}, {}, {Revision: "my-revision"}}.Trunc(1))
 }
 
+func TestRevisionHistories_TruncBefore(t *testing.T) {
+	now := time.Now()
+	old := RevisionHistory{Revision: "old", DeployedAt: metav1.NewTime(now.Add(-2 * time.Hour))}
+	recent := RevisionHistory{Revision: "recent", DeployedAt: metav1.NewTime(now.Add(-time.Minute))}
+
+	assert.Len(t, RevisionHistories{}.TruncBefore(now), 0)
+	assert.Equal(t, RevisionHistories{recent}, RevisionHistories{old, recent}.TruncBefore(now.Add(-time.Hour)))
+	assert.Equal(t, RevisionHistories{old, recent}, RevisionHistories{old, recent}.TruncBefore(now.Add(-3*time.Hour)))
+	// the most recent item is kept
+	assert.Equal(t, RevisionHistories{recent}, RevisionHistories{old, recent}.TruncBefore(now))
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -2148,6 +2390,49 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestRetryStrategy_ForSyncResult(t *testing.T) {
+	retry := RetryStrategy{
+		Limit: 5,
+		Phases: []PhaseRetryStrategy{
+			{Phase: common.SyncPhasePreSync, Limit: 1},
+			{Phase: common.SyncPhasePostSync, Limit: 2, Backoff: &Backoff{Duration: "1m"}},
+		},
+		Hooks: []HookRetryStrategy{{Name: "db-migration", Limit: 10}},
+	}
+	result := func(resources ...*ResourceResult) *SyncOperationResult {
+		return &SyncOperationResult{Resources: resources}
+	}
+
+	t.Run("NoResult", func(t *testing.T) {
+		assert.Equal(t, RetryStrategy{Limit: 5}, retry.ForSyncResult(nil))
+	})
+	t.Run("NoFailedResource", func(t *testing.T) {
+		res := result(&ResourceResult{Name: "guestbook", SyncPhase: common.SyncPhasePreSync, Status: common.ResultCodeSynced})
+		assert.Equal(t, RetryStrategy{Limit: 5}, retry.ForSyncResult(res))
+	})
+	t.Run("FailedPhase", func(t *testing.T) {
+		res := result(
+			&ResourceResult{Name: "guestbook", SyncPhase: common.SyncPhaseSync, Status: common.ResultCodeSynced},
+			&ResourceResult{Name: "smoke-test", SyncPhase: common.SyncPhasePostSync, HookType: common.HookTypePostSync, HookPhase: common.OperationFailed},
+		)
+		assert.Equal(t, RetryStrategy{Limit: 2, Backoff: &Backoff{Duration: "1m"}}, retry.ForSyncResult(res))
+	})
+	t.Run("FailedPhaseWithoutStrategy", func(t *testing.T) {
+		res := result(&ResourceResult{Name: "guestbook", SyncPhase: common.SyncPhaseSync, Status: common.ResultCodeSyncFailed})
+		assert.Equal(t, RetryStrategy{Limit: 5}, retry.ForSyncResult(res))
+	})
+	t.Run("FailedHook", func(t *testing.T) {
+		res := result(&ResourceResult{Name: "db-migration", SyncPhase: common.SyncPhasePreSync, HookType: common.HookTypePreSync, HookPhase: common.OperationError})
+		assert.Equal(t, RetryStrategy{Limit: 10}, retry.ForSyncResult(res))
+	})
+}
+
+func TestRetryStrategy_Validate(t *testing.T) {
+	assert.NoError(t, (&RetryStrategy{Phases: []PhaseRetryStrategy{{Phase: common.SyncPhaseSyncFail}}}).Validate())
+	assert.Error(t, (&RetryStrategy{Phases: []PhaseRetryStrategy{{Phase: "PreDelete"}}}).Validate())
+	assert.Error(t, (&RetryStrategy{Hooks: []HookRetryStrategy{{Limit: 1}}}).Validate())
+}
+
 func TestSourceAllowsConcurrentProcessing_KsonnetNoParams(t *testing.T) {
 	src := ApplicationSource{Path: "."}
 
@@ -2239,3 +2524,122 @@ func TestOrphanedResourcesMonitorSettings_IsWarn(t *testing.T) {
 	settings.Warn = pointer.BoolPtr(true)
 	assert.True(t, settings.IsWarn())
 }
+
+func TestApplication_GetManifestGenerationTimeout(t *testing.T) {
+	app := &Application{}
+	timeout, err := app.GetManifestGenerationTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	app.Annotations = map[string]string{AnnotationKeyManifestGenerationTimeout: "3m"}
+	timeout, err = app.GetManifestGenerationTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 3*time.Minute, timeout)
+
+	app.Annotations[AnnotationKeyManifestGenerationTimeout] = "500ms"
+	_, err = app.GetManifestGenerationTimeout()
+	assert.EqualError(t, err, "invalid value '500ms' of annotation argocd.argoproj.io/manifest-generation-timeout: must be a duration of at least 1s, e.g. 3m")
+}
+
+func TestApplication_IsReconcileSkipped(t *testing.T) {
+	app := &Application{}
+	assert.False(t, app.IsReconcileSkipped())
+
+	app.Annotations = map[string]string{AnnotationKeySkipReconcile: "true"}
+	assert.True(t, app.IsReconcileSkipped())
+
+	app.Annotations[AnnotationKeySkipReconcile] = "false"
+	assert.False(t, app.IsReconcileSkipped())
+
+	app.Annotations[AnnotationKeySkipReconcile] = "maybe"
+	assert.False(t, app.IsReconcileSkipped())
+}
+
+func TestClusterConfig_ValidateExecProvider(t *testing.T) {
+	execConfig := func(command string) ClusterConfig {
+		return ClusterConfig{ExecProviderConfig: &ExecProviderConfig{Command: command}}
+	}
+	allowedPaths := []string{"/custom-tools", "/usr/local/bin/"}
+
+	assert.NoError(t, (&ClusterConfig{}).ValidateExecProvider(allowedPaths))
+	assert.NoError(t, (&ClusterConfig{BearerToken: "token"}).ValidateExecProvider(allowedPaths))
+	// any command is allowed without an allow list
+	config := execConfig("/usr/bin/my-auth-plugin")
+	assert.NoError(t, config.ValidateExecProvider(nil))
+
+	config = execConfig("/custom-tools/my-auth-plugin")
+	assert.NoError(t, config.ValidateExecProvider(allowedPaths))
+	config = execConfig("/usr/local/bin/gke/gke-gcloud-auth-plugin")
+	assert.NoError(t, config.ValidateExecProvider(allowedPaths))
+
+	config = execConfig("/usr/bin/my-auth-plugin")
+	assert.EqualError(t, config.ValidateExecProvider(allowedPaths), `exec provider command "/usr/bin/my-auth-plugin" is not located in one of the allowed paths: /custom-tools, /usr/local/bin/`)
+	config = execConfig("/custom-tools/../usr/bin/my-auth-plugin")
+	assert.Error(t, config.ValidateExecProvider(allowedPaths))
+	config = execConfig("/custom-tools-2/my-auth-plugin")
+	assert.Error(t, config.ValidateExecProvider(allowedPaths))
+	config = execConfig("/custom-tools")
+	assert.Error(t, config.ValidateExecProvider(allowedPaths))
+	config = execConfig("my-missing-auth-plugin")
+	assert.Error(t, config.ValidateExecProvider(allowedPaths))
+
+	// the variables allowing to run arbitrary code are denied even without an allow list
+	for _, name := range []string{"LD_PRELOAD", "ld_library_path", "DYLD_INSERT_LIBRARIES", "PATH", "BASH_ENV", "NODE_OPTIONS"} {
+		config = execConfig("/custom-tools/my-auth-plugin")
+		config.ExecProviderConfig.Env = map[string]string{name: "/tmp/evil"}
+		assert.EqualError(t, config.ValidateExecProvider(nil), fmt.Sprintf("exec provider environment variable %q is not allowed", name))
+	}
+	config = execConfig("/custom-tools/my-auth-plugin")
+	config.ExecProviderConfig.Env = map[string]string{"AWS_PROFILE": "prod", "CLOUDSDK_CONFIG": "/custom-tools/gcloud"}
+	assert.NoError(t, config.ValidateExecProvider(allowedPaths))
+}
+
+func TestLookExecCommandPath(t *testing.T) {
+	dir := t.TempDir()
+	command := "my-cached-auth-plugin"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, command), []byte("#!/bin/sh\n"), 0755))
+	pathEnv := os.Getenv("PATH")
+	defer func() {
+		_ = os.Setenv("PATH", pathEnv)
+	}()
+	require.NoError(t, os.Setenv("PATH", dir))
+
+	path, err := lookExecCommandPath(command)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, command), path)
+
+	// the path is cached once found
+	require.NoError(t, os.Setenv("PATH", ""))
+	path, err = lookExecCommandPath(command)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, command), path)
+
+	path, err = lookExecCommandPath("/custom-tools/../usr/bin/my-auth-plugin")
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/bin/my-auth-plugin", path)
+}
+
+func TestCluster_RESTConfig_ExecCommandNotAllowed(t *testing.T) {
+	allowedPaths := ExecProviderAllowedPaths
+	ExecProviderAllowedPaths = []string{"/custom-tools"}
+	defer func() {
+		ExecProviderAllowedPaths = allowedPaths
+	}()
+
+	cluster := Cluster{
+		Server: "https://my-cluster",
+		Config: ClusterConfig{ExecProviderConfig: &ExecProviderConfig{Command: "/usr/bin/my-auth-plugin", APIVersion: "client.authentication.k8s.io/v1beta1"}},
+	}
+	config := cluster.RESTConfig()
+	assert.Nil(t, config.ExecProvider)
+	transport, err := rest.TransportFor(config)
+	require.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(cluster.Server)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `exec provider command "/usr/bin/my-auth-plugin" is not located in one of the allowed paths`)
+
+	cluster.Config.ExecProviderConfig.Command = "/custom-tools/my-auth-plugin"
+	config = cluster.RESTConfig()
+	assert.Nil(t, config.ExecProvider)
+	assert.Nil(t, config.WrapTransport)
+}
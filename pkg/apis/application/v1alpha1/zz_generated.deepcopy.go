@@ -148,6 +148,36 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]v1.GroupKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChartSignatureVerification != nil {
+		in, out := &in.ChartSignatureVerification, &out.ChartSignatureVerification
+		*out = new(ChartSignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KustomizeBuildOptions != nil {
+		in, out := &in.KustomizeBuildOptions, &out.KustomizeBuildOptions
+		*out = new(KustomizeBuildOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceCustomizations != nil {
+		in, out := &in.ResourceCustomizations, &out.ResourceCustomizations
+		*out = make([]ProjectResourceCustomization, len(*in))
+		copy(*out, *in)
+	}
+	if in.SyncOptions != nil {
+		in, out := &in.SyncOptions, &out.SyncOptions
+		*out = make(SyncOptions, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManifestPolicy != nil {
+		in, out := &in.ManifestPolicy, &out.ManifestPolicy
+		*out = new(ManifestPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationServiceAccounts != nil {
+		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
+		*out = make([]ApplicationDestinationServiceAccount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -253,6 +283,22 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationServiceAccount.
+func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinationServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -314,6 +360,21 @@ func (in *ApplicationSource) DeepCopyInto(out *ApplicationSource) {
 		*out = new(ApplicationSourcePlugin)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ytt != nil {
+		in, out := &in.Ytt, &out.Ytt
+		*out = new(ApplicationSourceYtt)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Helmfile != nil {
+		in, out := &in.Helmfile, &out.Helmfile
+		*out = new(ApplicationSourceHelmfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -375,6 +436,27 @@ func (in *ApplicationSourceHelm) DeepCopy() *ApplicationSourceHelm {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceHelmfile) DeepCopyInto(out *ApplicationSourceHelmfile) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceHelmfile.
+func (in *ApplicationSourceHelmfile) DeepCopy() *ApplicationSourceHelmfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceHelmfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSourceJsonnet) DeepCopyInto(out *ApplicationSourceJsonnet) {
 	*out = *in
@@ -489,6 +571,37 @@ func (in *ApplicationSourcePlugin) DeepCopy() *ApplicationSourcePlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSourceYtt) DeepCopyInto(out *ApplicationSourceYtt) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataValuesFiles != nil {
+		in, out := &in.DataValuesFiles, &out.DataValuesFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataValues != nil {
+		in, out := &in.DataValues, &out.DataValues
+		*out = make([]YttDataValue, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSourceYtt.
+func (in *ApplicationSourceYtt) DeepCopy() *ApplicationSourceYtt {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSourceYtt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 	*out = *in
@@ -516,6 +629,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -683,6 +801,32 @@ func (in *Backoff) DeepCopy() *Backoff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartSignatureVerification) DeepCopyInto(out *ChartSignatureVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeylessIdentities != nil {
+		in, out := &in.KeylessIdentities, &out.KeylessIdentities
+		*out = make([]KeylessIdentity, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartSignatureVerification.
+func (in *ChartSignatureVerification) DeepCopy() *ChartSignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartSignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -717,6 +861,25 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 			(*out)[key] = val
 		}
 	}
+	if in.ResourceExclusions != nil {
+		in, out := &in.ResourceExclusions, &out.ResourceExclusions
+		*out = make([]ClusterResourceFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceInclusions != nil {
+		in, out := &in.ResourceInclusions, &out.ResourceInclusions
+		*out = make([]ClusterResourceFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResyncInterval != nil {
+		in, out := &in.ResyncInterval, &out.ResyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -824,6 +987,32 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceFilter) DeepCopyInto(out *ClusterResourceFilter) {
+	*out = *in
+	if in.APIGroups != nil {
+		in, out := &in.APIGroups, &out.APIGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceFilter.
+func (in *ClusterResourceFilter) DeepCopy() *ClusterResourceFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Command) DeepCopyInto(out *Command) {
 	*out = *in
@@ -893,6 +1082,27 @@ func (in *ConfigManagementPlugin) DeepCopyInto(out *ConfigManagementPlugin) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Generate.DeepCopyInto(&out.Generate)
+	if in.Discover != nil {
+		in, out := &in.Discover, &out.Discover
+		*out = new(ConfigManagementPluginDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(Env, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EnvEntry)
+				**out = **in
+			}
+		}
+	}
+	if in.AllowedAppEnv != nil {
+		in, out := &in.AllowedAppEnv, &out.AllowedAppEnv
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -906,6 +1116,27 @@ func (in *ConfigManagementPlugin) DeepCopy() *ConfigManagementPlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigManagementPluginDiscovery) DeepCopyInto(out *ConfigManagementPluginDiscovery) {
+	*out = *in
+	if in.Find != nil {
+		in, out := &in.Find, &out.Find
+		*out = new(Command)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigManagementPluginDiscovery.
+func (in *ConfigManagementPluginDiscovery) DeepCopy() *ConfigManagementPluginDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigManagementPluginDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionState) DeepCopyInto(out *ConnectionState) {
 	*out = *in
@@ -996,9 +1227,29 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForbiddenManifestField) DeepCopyInto(out *ForbiddenManifestField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForbiddenManifestField.
+func (in *ForbiddenManifestField) DeepCopy() *ForbiddenManifestField {
+	if in == nil {
+		return nil
+	}
+	out := new(ForbiddenManifestField)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GnuPGPublicKey) DeepCopyInto(out *GnuPGPublicKey) {
 	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1019,7 +1270,9 @@ func (in *GnuPGPublicKeyList) DeepCopyInto(out *GnuPGPublicKeyList) {
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]GnuPGPublicKey, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1066,6 +1319,22 @@ func (in *HelmFileParameter) DeepCopy() *HelmFileParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmOptions) DeepCopyInto(out *HelmOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmOptions.
+func (in *HelmOptions) DeepCopy() *HelmOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmParameter) DeepCopyInto(out *HelmParameter) {
 	*out = *in
@@ -1082,6 +1351,27 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookRetryStrategy) DeepCopyInto(out *HookRetryStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookRetryStrategy.
+func (in *HookRetryStrategy) DeepCopy() *HookRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(HookRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -1205,6 +1495,22 @@ func (in *JsonnetVar) DeepCopy() *JsonnetVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessIdentity) DeepCopyInto(out *KeylessIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessIdentity.
+func (in *KeylessIdentity) DeepCopy() *KeylessIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KnownTypeField) DeepCopyInto(out *KnownTypeField) {
 	*out = *in
@@ -1237,6 +1543,27 @@ func (in *KsonnetParameter) DeepCopy() *KsonnetParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeBuildOptions) DeepCopyInto(out *KustomizeBuildOptions) {
+	*out = *in
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeBuildOptions.
+func (in *KustomizeBuildOptions) DeepCopy() *KustomizeBuildOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeBuildOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in KustomizeImages) DeepCopyInto(out *KustomizeImages) {
 	{
@@ -1260,6 +1587,11 @@ func (in KustomizeImages) DeepCopy() KustomizeImages {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeOptions) DeepCopyInto(out *KustomizeOptions) {
 	*out = *in
+	if in.ProjectBuildOptions != nil {
+		in, out := &in.ProjectBuildOptions, &out.ProjectBuildOptions
+		*out = new(KustomizeBuildOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1273,6 +1605,62 @@ func (in *KustomizeOptions) DeepCopy() *KustomizeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedNamespaceMetadata) DeepCopyInto(out *ManagedNamespaceMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNamespaceMetadata.
+func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedNamespaceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestPolicy) DeepCopyInto(out *ManifestPolicy) {
+	*out = *in
+	if in.RequiredAnnotations != nil {
+		in, out := &in.RequiredAnnotations, &out.RequiredAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForbiddenFields != nil {
+		in, out := &in.ForbiddenFields, &out.ForbiddenFields
+		*out = make([]ForbiddenManifestField, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestPolicy.
+func (in *ManifestPolicy) DeepCopy() *ManifestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -1418,6 +1806,43 @@ func (in *OverrideIgnoreDiff) DeepCopy() *OverrideIgnoreDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseRetryStrategy) DeepCopyInto(out *PhaseRetryStrategy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseRetryStrategy.
+func (in *PhaseRetryStrategy) DeepCopy() *PhaseRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectResourceCustomization) DeepCopyInto(out *ProjectResourceCustomization) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectResourceCustomization.
+func (in *ProjectResourceCustomization) DeepCopy() *ProjectResourceCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectResourceCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -1517,6 +1942,11 @@ func (in Repositories) DeepCopy() Repositories {
 func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
 	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.EnableSubmodules != nil {
+		in, out := &in.EnableSubmodules, &out.EnableSubmodules
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1609,7 +2039,9 @@ func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make([]ResourceActionParam, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1643,6 +2075,11 @@ func (in *ResourceActionDefinition) DeepCopy() *ResourceActionDefinition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActionParam) DeepCopyInto(out *ResourceActionParam) {
 	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1816,6 +2253,7 @@ func (in *ResourceNode) DeepCopy() *ResourceNode {
 func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 	*out = *in
 	in.IgnoreDifferences.DeepCopyInto(&out.IgnoreDifferences)
+	in.IgnoreResourceUpdates.DeepCopyInto(&out.IgnoreResourceUpdates)
 	if in.KnownTypeFields != nil {
 		in, out := &in.KnownTypeFields, &out.KnownTypeFields
 		*out = make([]KnownTypeField, len(*in))
@@ -1921,6 +2359,20 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(Backoff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]PhaseRetryStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]HookRetryStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1987,6 +2439,11 @@ func (in *RevisionMetadata) DeepCopyInto(out *RevisionMetadata) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2139,6 +2596,16 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(RetryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoRollback != nil {
+		in, out := &in.AutoRollback, &out.AutoRollback
+		*out = new(SyncPolicyAutoRollback)
+		**out = **in
+	}
+	if in.ManagedNamespaceMetadata != nil {
+		in, out := &in.ManagedNamespaceMetadata, &out.ManagedNamespaceMetadata
+		*out = new(ManagedNamespaceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2152,6 +2619,22 @@ func (in *SyncPolicy) DeepCopy() *SyncPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyAutoRollback) DeepCopyInto(out *SyncPolicyAutoRollback) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyAutoRollback.
+func (in *SyncPolicyAutoRollback) DeepCopy() *SyncPolicyAutoRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyAutoRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 	*out = *in
@@ -2262,6 +2745,16 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2331,3 +2824,19 @@ func (in *TLSClientConfig) DeepCopy() *TLSClientConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YttDataValue) DeepCopyInto(out *YttDataValue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YttDataValue.
+func (in *YttDataValue) DeepCopy() *YttDataValue {
+	if in == nil {
+		return nil
+	}
+	out := new(YttDataValue)
+	in.DeepCopyInto(out)
+	return out
+}
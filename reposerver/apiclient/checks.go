@@ -0,0 +1,26 @@
+package apiclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Err returns an error describing each failed check if none of the checks succeeded, including the authentication
+// method used and the certificates presented by the server, so that the error can be acted upon
+func (r *TestRepositoryResponse) Err() error {
+	var failures []string
+	for _, check := range r.GetChecks() {
+		if check.Successful {
+			return nil
+		}
+		failure := fmt.Sprintf("%s check failed using %s authentication: %s", check.Name, check.AuthMethod, check.Message)
+		if len(check.TlsCertificates) > 0 {
+			failure += fmt.Sprintf(" (server certificates: %s)", strings.Join(check.TlsCertificates, ", "))
+		}
+		failures = append(failures, failure)
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s", strings.Join(failures, "; "))
+}
@@ -0,0 +1,21 @@
+package apiclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestRepositoryResponse_Err(t *testing.T) {
+	assert.NoError(t, (&TestRepositoryResponse{}).Err())
+	assert.NoError(t, (&TestRepositoryResponse{Checks: []*RepositoryCheck{
+		{Name: "git", Message: "not found", AuthMethod: "anonymous"},
+		{Name: "helm", Successful: true, AuthMethod: "anonymous"},
+	}}).Err())
+
+	err := (&TestRepositoryResponse{Checks: []*RepositoryCheck{
+		{Name: "git", Message: "x509: certificate signed by unknown authority", AuthMethod: "basic", TlsCertificates: []string{"CN=leaf", "CN=root"}},
+		{Name: "helm", Message: "404 Not Found", AuthMethod: "basic"},
+	}}).Err()
+	assert.EqualError(t, err, "git check failed using basic authentication: x509: certificate signed by unknown authority (server certificates: CN=leaf, CN=root); helm check failed using basic authentication: 404 Not Found")
+}
@@ -39,10 +39,23 @@ type clientSet struct {
 	address        string
 	timeoutSeconds int
 	tlsConfig      TLSConfiguration
+	priority       RequestPriority
+	sharding       bool
+}
+
+// ClientsetOpts configures a repo server Clientset
+type ClientsetOpts func(c *clientSet)
+
+// WithSharding sends all the requests for a repository to the same repo server replica, so that the repository is
+// only cloned by that replica. The address must resolve to the addresses of all the replicas, e.g. a headless service.
+func WithSharding(sharding bool) ClientsetOpts {
+	return func(c *clientSet) {
+		c.sharding = sharding
+	}
 }
 
 func (c *clientSet) NewRepoServerClient() (io.Closer, RepoServerServiceClient, error) {
-	conn, err := NewConnection(c.address, c.timeoutSeconds, &c.tlsConfig)
+	conn, err := newConnection(c.address, c.timeoutSeconds, &c.tlsConfig, c.priority, c.sharding)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -50,20 +63,36 @@ func (c *clientSet) NewRepoServerClient() (io.Closer, RepoServerServiceClient, e
 }
 
 func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration) (*grpc.ClientConn, error) {
+	return newConnection(address, timeoutSeconds, tlsConfig, "", false)
+}
+
+func newConnection(address string, timeoutSeconds int, tlsConfig *TLSConfiguration, priority RequestPriority, sharding bool) (*grpc.ClientConn, error) {
 	retryOpts := []grpc_retry.CallOption{
 		grpc_retry.WithMax(3),
 		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(1000 * time.Millisecond)),
 	}
-	unaryInterceptors := []grpc.UnaryClientInterceptor{grpc_retry.UnaryClientInterceptor(retryOpts...)}
+	var unaryInterceptors []grpc.UnaryClientInterceptor
+	if sharding {
+		unaryInterceptors = append(unaryInterceptors, shardingInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, grpc_retry.UnaryClientInterceptor(retryOpts...))
 	if timeoutSeconds > 0 {
 		unaryInterceptors = append(unaryInterceptors, argogrpc.WithTimeout(time.Duration(timeoutSeconds)*time.Second))
 	}
+	if priority != "" {
+		unaryInterceptors = append(unaryInterceptors, priorityInterceptor(priority))
+	}
 	opts := []grpc.DialOption{
 		grpc.WithStreamInterceptor(grpc_retry.StreamClientInterceptor(retryOpts...)),
 		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(unaryInterceptors...)),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize), grpc.MaxCallSendMsgSize(MaxGRPCMessageSize)),
 	}
 
+	if sharding {
+		address = shardingTarget(address)
+		opts = append(opts, grpc.WithBalancerName(ShardingBalancerName))
+	}
+
 	tlsC := &tls.Config{}
 	if !tlsConfig.DisableTLS {
 		if !tlsConfig.StrictValidation {
@@ -85,6 +114,20 @@ func NewConnection(address string, timeoutSeconds int, tlsConfig *TLSConfigurati
 }
 
 // NewRepoServerClientset creates new instance of repo server Clientset
-func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration) Clientset {
-	return &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+func NewRepoServerClientset(address string, timeoutSeconds int, tlsConfig TLSConfiguration, opts ...ClientsetOpts) Clientset {
+	c := &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+// NewRepoServerClientsetWithPriority creates new instance of repo server Clientset, which sets the priority of all
+// the requests it makes
+func NewRepoServerClientsetWithPriority(address string, timeoutSeconds int, tlsConfig TLSConfiguration, priority RequestPriority, opts ...ClientsetOpts) Clientset {
+	c := &clientSet{address: address, timeoutSeconds: timeoutSeconds, tlsConfig: tlsConfig, priority: priority}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
 }
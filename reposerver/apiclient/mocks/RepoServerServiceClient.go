@@ -49,6 +49,36 @@ func (_m *RepoServerServiceClient) GenerateManifest(ctx context.Context, in *api
 	return r0, r1
 }
 
+// GenerateManifests provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GenerateManifests(ctx context.Context, in *apiclient.ManifestsRequest, opts ...grpc.CallOption) (*apiclient.ManifestsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.ManifestsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestsRequest, ...grpc.CallOption) *apiclient.ManifestsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ManifestsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ManifestsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAppDetails provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GetAppDetails(ctx context.Context, in *apiclient.RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*apiclient.RepoAppDetailsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -109,6 +139,36 @@ func (_m *RepoServerServiceClient) GetHelmCharts(ctx context.Context, in *apicli
 	return r0, r1
 }
 
+// GetGnuPGKeyring provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) GetGnuPGKeyring(ctx context.Context, in *apiclient.GnuPGKeyringRequest, opts ...grpc.CallOption) (*apiclient.GnuPGKeyringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.GnuPGKeyringResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.GnuPGKeyringRequest, ...grpc.CallOption) *apiclient.GnuPGKeyringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.GnuPGKeyringResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.GnuPGKeyringRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRevisionMetadata provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *apiclient.RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
 	_va := make([]interface{}, len(opts))
@@ -169,6 +229,36 @@ func (_m *RepoServerServiceClient) ListApps(ctx context.Context, in *apiclient.L
 	return r0, r1
 }
 
+// ResolveRevision provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) ResolveRevision(ctx context.Context, in *apiclient.ResolveRevisionRequest, opts ...grpc.CallOption) (*apiclient.ResolveRevisionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.ResolveRevisionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ResolveRevisionRequest, ...grpc.CallOption) *apiclient.ResolveRevisionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ResolveRevisionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ResolveRevisionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 //
 func (_m *RepoServerServiceClient) TestRepository(ctx context.Context, in *apiclient.TestRepositoryRequest, opts ...grpc.CallOption) (*apiclient.TestRepositoryResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -228,3 +318,33 @@ func (_m *RepoServerServiceClient) ListRefs(ctx context.Context, in *apiclient.L
 
 	return r0, r1
 }
+
+// WarmManifestCache provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) WarmManifestCache(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (*apiclient.WarmManifestCacheResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.WarmManifestCacheResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) *apiclient.WarmManifestCacheResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.WarmManifestCacheResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
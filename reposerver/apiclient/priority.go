@@ -0,0 +1,47 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestPriority is the priority class of a request, which determines the order in which requests waiting for the
+// manifest generation to be allowed by the parallelism limit are processed
+type RequestPriority string
+
+const (
+	// RequestPriorityInteractive is the priority of requests made on behalf of users, e.g. from the UI or the CLI
+	RequestPriorityInteractive RequestPriority = "interactive"
+	// RequestPriorityBackground is the priority of requests made by the application controller, and the default
+	RequestPriorityBackground RequestPriority = "background"
+
+	requestPriorityKey = "argocd-request-priority"
+)
+
+// WithRequestPriority returns a context which sets the priority of the requests made with it
+func WithRequestPriority(ctx context.Context, priority RequestPriority) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, requestPriorityKey, string(priority))
+}
+
+// RequestPriorityFromContext returns the priority of the request being served, set in the gRPC call metadata
+func RequestPriorityFromContext(ctx context.Context) RequestPriority {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestPriorityKey); len(values) > 0 && RequestPriority(values[0]) == RequestPriorityInteractive {
+			return RequestPriorityInteractive
+		}
+	}
+	return RequestPriorityBackground
+}
+
+// priorityInterceptor sets the priority of all the requests made by a client, unless the priority of a request has
+// been set explicitly using WithRequestPriority
+func priorityInterceptor(priority RequestPriority) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, ok := metadata.FromOutgoingContext(ctx); !ok || len(md.Get(requestPriorityKey)) == 0 {
+			ctx = WithRequestPriority(ctx, priority)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,26 @@
+package apiclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPriorityInterceptor(t *testing.T) {
+	priorityOf := func(ctx context.Context) RequestPriority {
+		var priority RequestPriority
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			priority = RequestPriorityFromContext(metadata.NewIncomingContext(ctx, md))
+			return nil
+		}
+		assert.NoError(t, priorityInterceptor(RequestPriorityInteractive)(ctx, "method", nil, nil, nil, invoker))
+		return priority
+	}
+
+	assert.Equal(t, RequestPriorityInteractive, priorityOf(context.Background()))
+	assert.Equal(t, RequestPriorityBackground, priorityOf(WithRequestPriority(context.Background(), RequestPriorityBackground)))
+}
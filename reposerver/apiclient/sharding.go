@@ -0,0 +1,224 @@
+package apiclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+const (
+	// ShardingBalancerName is the name of the gRPC load balancing policy which sends the requests for a repository to
+	// the same repo server replica
+	ShardingBalancerName = "argocd_repo_sharding"
+	// shardingVirtualNodes is the number of points of each replica on the hash ring, which spreads the repositories
+	// evenly between the replicas
+	shardingVirtualNodes = 100
+)
+
+func init() {
+	balancer.Register(&shardingBuilder{})
+}
+
+type shardingKey struct{}
+
+// withShardingKey returns a context which requests are sent to the replica the repository is assigned to
+func withShardingKey(ctx context.Context, repoURL string) context.Context {
+	return context.WithValue(ctx, shardingKey{}, git.NormalizeGitURL(repoURL))
+}
+
+func shardingKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(shardingKey{}).(string)
+	return key
+}
+
+// shardingInterceptor sets the sharding key of the requests which refer to a repository
+func shardingInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if r, ok := req.(interface{ GetRepo() *v1alpha1.Repository }); ok && r.GetRepo() != nil {
+			ctx = withShardingKey(ctx, r.GetRepo().Repo)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// GetRepo returns the repository of the batch, so that it is sent to the replica the repository is assigned to like
+// the single requests
+func (m *ManifestsRequest) GetRepo() *v1alpha1.Repository {
+	if m == nil || len(m.Requests) == 0 {
+		return nil
+	}
+	return m.Requests[0].GetRepo()
+}
+
+// shardingTarget returns the gRPC target of the address, which must resolve to the addresses of all the replicas
+// (e.g. a headless service), so that the sharding balancer can pick one of them
+func shardingTarget(address string) string {
+	if strings.Contains(address, "://") {
+		return address
+	}
+	return "dns:///" + address
+}
+
+type shardingBuilder struct{}
+
+func (*shardingBuilder) Build(cc balancer.ClientConn, _ balancer.BuildOptions) balancer.Balancer {
+	return &shardingBalancer{
+		cc:       cc,
+		subConns: map[resolver.Address]balancer.SubConn{},
+		scStates: map[balancer.SubConn]connectivity.State{},
+	}
+}
+
+func (*shardingBuilder) Name() string {
+	return ShardingBalancerName
+}
+
+// shardingBalancer maintains a connection to each resolved replica. Unlike the round robin balancer, the replicas
+// which are not ready are kept on the hash ring, so that the requests wait for the replica a repository is assigned to
+// while it is connecting, and are only sent to another replica if it fails.
+type shardingBalancer struct {
+	cc       balancer.ClientConn
+	csEvltr  balancer.ConnectivityStateEvaluator
+	state    connectivity.State
+	subConns map[resolver.Address]balancer.SubConn
+	scStates map[balancer.SubConn]connectivity.State
+}
+
+func (b *shardingBalancer) HandleResolvedAddrs(addrs []resolver.Address, err error) {
+	if err != nil {
+		log.Warnf("Failed to resolve the repo server replicas: %v", err)
+		return
+	}
+	addrsSet := map[resolver.Address]bool{}
+	for _, a := range addrs {
+		addrsSet[a] = true
+		if _, ok := b.subConns[a]; ok {
+			continue
+		}
+		sc, err := b.cc.NewSubConn([]resolver.Address{a}, balancer.NewSubConnOptions{})
+		if err != nil {
+			log.Warnf("Failed to connect to repo server replica %s: %v", a.Addr, err)
+			continue
+		}
+		b.subConns[a] = sc
+		b.scStates[sc] = connectivity.Idle
+		sc.Connect()
+	}
+	for a, sc := range b.subConns {
+		if !addrsSet[a] {
+			// the state of the connection is deleted once it is shut down
+			b.cc.RemoveSubConn(sc)
+			delete(b.subConns, a)
+		}
+	}
+	b.cc.UpdateBalancerState(b.state, b.newPicker())
+}
+
+func (b *shardingBalancer) HandleSubConnStateChange(sc balancer.SubConn, s connectivity.State) {
+	oldS, ok := b.scStates[sc]
+	if !ok {
+		return
+	}
+	b.scStates[sc] = s
+	switch s {
+	case connectivity.Idle:
+		sc.Connect()
+	case connectivity.Shutdown:
+		delete(b.scStates, sc)
+	}
+	b.state = b.csEvltr.RecordTransition(oldS, s)
+	b.cc.UpdateBalancerState(b.state, b.newPicker())
+}
+
+func (b *shardingBalancer) Close() {
+}
+
+// newPicker takes a snapshot of the replicas and of their states
+func (b *shardingBalancer) newPicker() balancer.Picker {
+	p := &shardingPicker{failed: b.state == connectivity.TransientFailure, states: map[balancer.SubConn]connectivity.State{}}
+	for addr, sc := range b.subConns {
+		p.states[sc] = b.scStates[sc]
+		if b.scStates[sc] == connectivity.Ready {
+			p.ready = append(p.ready, sc)
+		}
+		for i := 0; i < shardingVirtualNodes; i++ {
+			p.ring = append(p.ring, ringNode{hash: ringHash(fmt.Sprintf("%s-%d", addr.Addr, i)), subConn: sc})
+		}
+	}
+	sort.Slice(p.ring, func(i, j int) bool {
+		return p.ring[i].hash < p.ring[j].hash
+	})
+	return p
+}
+
+// ringHash returns the position of the value on the hash ring. A cryptographic hash spreads similar values, like the
+// URLs of the repositories of an organization, much more evenly than FNV.
+func ringHash(value string) uint32 {
+	sum := sha256.Sum256([]byte(value))
+	return binary.BigEndian.Uint32(sum[:4])
+}
+
+type ringNode struct {
+	hash    uint32
+	subConn balancer.SubConn
+}
+
+// shardingPicker assigns the repositories to the replicas using consistent hashing, so that only the repositories of
+// a replica are reassigned when it is added or removed. Requests without a repository are balanced using round robin.
+type shardingPicker struct {
+	failed bool
+	ring   []ringNode
+	states map[balancer.SubConn]connectivity.State
+	ready  []balancer.SubConn
+
+	mu   sync.Mutex
+	next int
+}
+
+func (p *shardingPicker) Pick(ctx context.Context, opts balancer.PickOptions) (balancer.SubConn, func(balancer.DoneInfo), error) {
+	if p.failed {
+		return nil, nil, balancer.ErrTransientFailure
+	}
+	key := shardingKeyFromContext(ctx)
+	if key == "" || len(p.ring) == 0 {
+		if len(p.ready) == 0 {
+			return nil, nil, balancer.ErrNoSubConnAvailable
+		}
+		p.mu.Lock()
+		sc := p.ready[p.next%len(p.ready)]
+		p.next = (p.next + 1) % len(p.ready)
+		p.mu.Unlock()
+		return sc, nil, nil
+	}
+	h := ringHash(key)
+	start := sort.Search(len(p.ring), func(i int) bool {
+		return p.ring[i].hash >= h
+	})
+	// the repository is served by the first replica of the ring which is not failing
+	for i := 0; i < len(p.ring); i++ {
+		sc := p.ring[(start+i)%len(p.ring)].subConn
+		switch p.states[sc] {
+		case connectivity.Ready:
+			return sc, nil, nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			continue
+		default:
+			// wait for the replica to be connected
+			return nil, nil, balancer.ErrNoSubConnAvailable
+		}
+	}
+	return nil, nil, balancer.ErrNoSubConnAvailable
+}
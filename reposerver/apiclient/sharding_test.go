@@ -0,0 +1,124 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+type fakeSubConn struct {
+	addr string
+}
+
+func (f *fakeSubConn) UpdateAddresses([]resolver.Address) {}
+
+func (f *fakeSubConn) Connect() {}
+
+func newTestShardingBalancer(states ...connectivity.State) (*shardingBalancer, []balancer.SubConn) {
+	b := &shardingBalancer{subConns: map[resolver.Address]balancer.SubConn{}, scStates: map[balancer.SubConn]connectivity.State{}, state: connectivity.Ready}
+	var subConns []balancer.SubConn
+	for i, state := range states {
+		sc := &fakeSubConn{addr: fmt.Sprintf("10.0.0.%d:8081", i)}
+		b.subConns[resolver.Address{Addr: sc.addr}] = sc
+		b.scStates[sc] = state
+		subConns = append(subConns, sc)
+	}
+	return b, subConns
+}
+
+func pick(t *testing.T, p balancer.Picker, repoURL string) (balancer.SubConn, error) {
+	ctx := context.Background()
+	if repoURL != "" {
+		ctx = withShardingKey(ctx, repoURL)
+	}
+	sc, _, err := p.Pick(ctx, balancer.PickOptions{})
+	return sc, err
+}
+
+func TestShardingPicker(t *testing.T) {
+	t.Run("SameReplicaForRepo", func(t *testing.T) {
+		b, _ := newTestShardingBalancer(connectivity.Ready, connectivity.Ready, connectivity.Ready)
+		p := b.newPicker()
+		picked := map[balancer.SubConn]bool{}
+		for i := 0; i < 20; i++ {
+			repoURL := fmt.Sprintf("https://github.com/argoproj/repo-%d", i)
+			sc, err := pick(t, p, repoURL)
+			assert.NoError(t, err)
+			picked[sc] = true
+			for j := 0; j < 3; j++ {
+				other, err := pick(t, p, repoURL)
+				assert.NoError(t, err)
+				assert.Equal(t, sc, other)
+			}
+			// all the forms of the URL are assigned to the same replica
+			other, err := pick(t, p, repoURL+".git")
+			assert.NoError(t, err)
+			assert.Equal(t, sc, other)
+		}
+		assert.Len(t, picked, 3)
+	})
+	t.Run("WaitForConnectingReplica", func(t *testing.T) {
+		b, subConns := newTestShardingBalancer(connectivity.Ready, connectivity.Ready)
+		owner, err := pick(t, b.newPicker(), "https://github.com/argoproj/argo-cd")
+		assert.NoError(t, err)
+		b.scStates[owner] = connectivity.Connecting
+		_, err = pick(t, b.newPicker(), "https://github.com/argoproj/argo-cd")
+		assert.Equal(t, balancer.ErrNoSubConnAvailable, err)
+
+		// the repository is served by another replica while its replica is failing
+		b.scStates[owner] = connectivity.TransientFailure
+		sc, err := pick(t, b.newPicker(), "https://github.com/argoproj/argo-cd")
+		assert.NoError(t, err)
+		assert.NotEqual(t, owner, sc)
+		assert.Contains(t, subConns, sc)
+	})
+	t.Run("RoundRobinWithoutRepo", func(t *testing.T) {
+		b, _ := newTestShardingBalancer(connectivity.Ready, connectivity.Ready, connectivity.Connecting)
+		p := b.newPicker()
+		first, err := pick(t, p, "")
+		assert.NoError(t, err)
+		second, err := pick(t, p, "")
+		assert.NoError(t, err)
+		assert.NotEqual(t, first, second)
+		third, err := pick(t, p, "")
+		assert.NoError(t, err)
+		assert.Equal(t, first, third)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		b, _ := newTestShardingBalancer(connectivity.TransientFailure)
+		b.state = connectivity.TransientFailure
+		_, err := pick(t, b.newPicker(), "https://github.com/argoproj/argo-cd")
+		assert.Equal(t, balancer.ErrTransientFailure, err)
+	})
+}
+
+func TestShardingInterceptor(t *testing.T) {
+	keyOf := func(req interface{}) string {
+		var key string
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			key = shardingKeyFromContext(ctx)
+			return nil
+		}
+		assert.NoError(t, shardingInterceptor()(context.Background(), "method", req, nil, nil, invoker))
+		return key
+	}
+
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", keyOf(&ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd.git"}}))
+	assert.Equal(t, "", keyOf(&ManifestRequest{}))
+	assert.Equal(t, "https://github.com/argoproj/argo-cd", keyOf(&ManifestsRequest{Requests: []*ManifestRequest{{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}}}}))
+	assert.Equal(t, "", keyOf(&ManifestsRequest{}))
+	assert.Equal(t, "", keyOf(&TestRepositoryResponse{}))
+}
+
+func TestShardingTarget(t *testing.T) {
+	assert.Equal(t, "dns:///argocd-repo-server-headless:8081", shardingTarget("argocd-repo-server-headless:8081"))
+	assert.Equal(t, "dns://10.0.0.10/argocd-repo-server-headless:8081", shardingTarget("dns://10.0.0.10/argocd-repo-server-headless:8081"))
+}
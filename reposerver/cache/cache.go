@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-git/go-git/v5/plumbing"
@@ -18,16 +22,25 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/crypto"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/hash"
 )
 
 var ErrCacheMiss = cacheutil.ErrCacheMiss
 
 type Cache struct {
-	cache                   *cacheutil.Cache
-	repoCacheExpiration     time.Duration
-	revisionCacheExpiration time.Duration
+	cache *cacheutil.Cache
+	// sharedCache bypasses the in-memory cache, if any, for the entries which must be consistent across replicas,
+	// like the resolved git references which are invalidated by webhooks. cache is used if nil.
+	sharedCache *cacheutil.Cache
+	// expirationsLock protects expirations, which might be reloaded while the cache is in use
+	expirationsLock sync.RWMutex
+	expirations     CacheExpirations
+	// encryptionKey is used to encrypt manifests and app details, which might contain sensitive values. The entries
+	// are not encrypted if the key is empty.
+	encryptionKey []byte
 }
 
 // ClusterRuntimeInfo holds cluster runtime information
@@ -38,26 +51,284 @@ type ClusterRuntimeInfo interface {
 	GetKubeVersion() string
 }
 
+// CacheExpirations holds the expirations of the different types of cache entries
+type CacheExpirations struct {
+	// Repo is the expiration of app lists and revision metadata, and the default expiration of manifests and
+	// app details
+	Repo time.Duration
+	// Revision is the default expiration of resolved git references and Helm indexes
+	Revision time.Duration
+	// Manifest is the expiration of generated manifests. Repo is used if 0.
+	Manifest time.Duration
+	// AppDetails is the expiration of app details. Repo is used if 0.
+	AppDetails time.Duration
+	// HelmIndex is the expiration of Helm repository indexes. Revision is used if 0.
+	HelmIndex time.Duration
+	// GitRefs is the expiration of resolved git references. Revision is used if 0.
+	GitRefs time.Duration
+}
+
+// cacheExpirationParams maps the argocd-cmd-params-cm keys to the expirations they configure
+var cacheExpirationParams = map[string]func(e *CacheExpirations) *time.Duration{
+	"reposerver.repo.cache.expiration":        func(e *CacheExpirations) *time.Duration { return &e.Repo },
+	"reposerver.revision.cache.expiration":    func(e *CacheExpirations) *time.Duration { return &e.Revision },
+	"reposerver.manifest.cache.expiration":    func(e *CacheExpirations) *time.Duration { return &e.Manifest },
+	"reposerver.app.details.cache.expiration": func(e *CacheExpirations) *time.Duration { return &e.AppDetails },
+	"reposerver.helm.index.cache.expiration":  func(e *CacheExpirations) *time.Duration { return &e.HelmIndex },
+	"reposerver.git.refs.cache.expiration":    func(e *CacheExpirations) *time.Duration { return &e.GitRefs },
+}
+
+// LoadCacheExpirations reads the expirations from a directory with a file per argocd-cmd-params-cm key, e.g. a mounted
+// ConfigMap. The given defaults are used for the keys which are missing from the directory.
+func LoadCacheExpirations(dir string, defaults CacheExpirations) (CacheExpirations, error) {
+	expirations := defaults
+	for key, field := range cacheExpirationParams {
+		data, err := ioutil.ReadFile(filepath.Join(dir, key))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return defaults, err
+		}
+		value := strings.TrimSpace(string(data))
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return defaults, fmt.Errorf("invalid %s: %v", key, err)
+		}
+		if duration < 0 {
+			return defaults, fmt.Errorf("invalid %s: expiration must not be negative", key)
+		}
+		*field(&expirations) = duration
+	}
+	return expirations, nil
+}
+
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration) *Cache {
-	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration}
+	return &Cache{cache: cache, expirations: CacheExpirations{Repo: repoCacheExpiration, Revision: revisionCacheExpiration}}
+}
+
+// Expirations returns the expirations of the cache entries
+func (c *Cache) Expirations() CacheExpirations {
+	c.expirationsLock.RLock()
+	defer c.expirationsLock.RUnlock()
+	return c.expirations
+}
+
+// SetExpirations updates the expirations of the cache entries. Existing entries keep their expiration.
+func (c *Cache) SetExpirations(expirations CacheExpirations) {
+	c.expirationsLock.Lock()
+	defer c.expirationsLock.Unlock()
+	c.expirations = expirations
+}
+
+func (c *Cache) repoCacheExpiration() time.Duration {
+	return c.Expirations().Repo
+}
+
+func (c *Cache) revisionCacheExpiration() time.Duration {
+	return c.Expirations().Revision
+}
+
+func (c *Cache) manifestCacheExpiration() time.Duration {
+	expirations := c.Expirations()
+	if expirations.Manifest > 0 {
+		return expirations.Manifest
+	}
+	return expirations.Repo
+}
+
+func (c *Cache) appDetailsCacheExpiration() time.Duration {
+	expirations := c.Expirations()
+	if expirations.AppDetails > 0 {
+		return expirations.AppDetails
+	}
+	return expirations.Repo
+}
+
+func (c *Cache) helmIndexCacheExpiration() time.Duration {
+	expirations := c.Expirations()
+	if expirations.HelmIndex > 0 {
+		return expirations.HelmIndex
+	}
+	return expirations.Revision
+}
+
+func (c *Cache) gitRefsCacheExpiration() time.Duration {
+	expirations := c.Expirations()
+	if expirations.GitRefs > 0 {
+		return expirations.GitRefs
+	}
+	return expirations.Revision
+}
+
+// getSharedCache returns the cache which is shared by all the replicas, without the in-memory cache in front of it
+func (c *Cache) getSharedCache() *cacheutil.Cache {
+	if c.sharedCache != nil {
+		return c.sharedCache
+	}
+	return c.cache
+}
+
+const (
+	// CacheBackendRedis stores the repository cache in Redis
+	CacheBackendRedis = "redis"
+	// CacheBackendMemcached stores the repository cache in memcached
+	CacheBackendMemcached = "memcached"
+	// envRepoCacheEncryptionKey is a env variable name which stores the key used to encrypt manifests and app details
+	envRepoCacheEncryptionKey = "ARGOCD_REPO_CACHE_ENCRYPTION_KEY"
+)
+
+// backendFlags are the flags which select the backend of the repo cache
+type backendFlags struct {
+	backend          string
+	memcachedServers []string
+	memcachedTimeout time.Duration
+}
+
+func addBackendFlagsToCmd(cmd *cobra.Command) *backendFlags {
+	f := &backendFlags{}
+	cmd.Flags().StringVar(&f.backend, "repo-cache-backend", env.StringFromEnv("ARGOCD_REPO_CACHE_BACKEND", CacheBackendRedis), "Backend of the repo cache, one of: redis, memcached")
+	cmd.Flags().StringArrayVar(&f.memcachedServers, "repo-cache-memcached-server", env.StringsFromEnv("ARGOCD_REPO_CACHE_MEMCACHED_SERVERS", []string{}, ","), "Memcached server hostname and port (e.g. memcached-0:11211), used with --repo-cache-backend=memcached")
+	cmd.Flags().DurationVar(&f.memcachedTimeout, "repo-cache-memcached-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_MEMCACHED_TIMEOUT", time.Second, 0, math.MaxInt64), "Timeout of memcached requests")
+	return f
+}
+
+// newCache returns the cache stored in the selected backend. redisCache returns the cache stored in Redis.
+func (f *backendFlags) newCache(redisCache func() (*cacheutil.Cache, error), expiration time.Duration) (*cacheutil.Cache, error) {
+	switch f.backend {
+	case CacheBackendRedis:
+		return redisCache()
+	case CacheBackendMemcached:
+		client, err := cacheutil.NewMemcachedCache(f.memcachedServers, expiration, f.memcachedTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return cacheutil.NewCache(client), nil
+	default:
+		return nil, fmt.Errorf("unknown repo cache backend '%s', supported backends: %s, %s", f.backend, CacheBackendRedis, CacheBackendMemcached)
+	}
+}
+
+// AddSharedCacheFlagsToCmd adds the flags which select the backend of the repo cache to a command which only accesses
+// the entries shared by the repo servers, like the API server which invalidates the resolved git references on webhook
+// events. The returned function creates the repo cache, which is stored in the given cache with the redis backend.
+func AddSharedCacheFlagsToCmd(cmd *cobra.Command) func(redisCache *cacheutil.Cache) (*Cache, error) {
+	backend := addBackendFlagsToCmd(cmd)
+	return func(redisCache *cacheutil.Cache) (*Cache, error) {
+		cache, err := backend.newCache(func() (*cacheutil.Cache, error) {
+			return redisCache, nil
+		}, 24*time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		return NewCache(cache, 24*time.Hour, 3*time.Minute), nil
+	}
 }
 
-func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client)) func() (*Cache, error) {
+func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client redis.UniversalClient)) func() (*Cache, error) {
 	var repoCacheExpiration time.Duration
 	var revisionCacheExpiration time.Duration
+	var manifestCacheExpiration time.Duration
+	var appDetailsCacheExpiration time.Duration
+	var helmIndexCacheExpiration time.Duration
+	var gitRefsCacheExpiration time.Duration
+	var localCacheSize int
+	var localCacheExpiration time.Duration
 
 	cmd.Flags().DurationVar(&repoCacheExpiration, "repo-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration for repo state, incl. app lists, app details, manifest generation, revision meta-data")
 	cmd.Flags().DurationVar(&revisionCacheExpiration, "revision-cache-expiration", env.ParseDurationFromEnv("ARGOCD_RECONCILIATION_TIMEOUT", 3*time.Minute, 0, math.MaxInt64), "Cache expiration for cached revision")
+	cmd.Flags().DurationVar(&manifestCacheExpiration, "manifest-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_MANIFEST_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for generated manifests. The repo cache expiration is used if 0.")
+	cmd.Flags().DurationVar(&appDetailsCacheExpiration, "app-details-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_APP_DETAILS_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for app details. The repo cache expiration is used if 0.")
+	cmd.Flags().DurationVar(&helmIndexCacheExpiration, "helm-index-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_HELM_INDEX_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for Helm repository indexes. The revision cache expiration is used if 0.")
+	cmd.Flags().DurationVar(&gitRefsCacheExpiration, "git-refs-cache-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_GIT_REFS_CACHE_EXPIRATION", 0, 0, math.MaxInt64), "Cache expiration for resolved git references, which are shared by all replicas and invalidated by webhooks. The revision cache expiration is used if 0.")
+	backend := addBackendFlagsToCmd(cmd)
+	cmd.Flags().IntVar(&localCacheSize, "repo-cache-local-size", env.ParseNumFromEnv("ARGOCD_REPO_CACHE_LOCAL_SIZE", 0, 0, math.MaxInt32), "Maximum number of entries of the in-memory cache in front of the repo cache backend. The in-memory cache is disabled if 0.")
+	cmd.Flags().DurationVar(&localCacheExpiration, "repo-cache-local-expiration", env.ParseDurationFromEnv("ARGOCD_REPO_CACHE_LOCAL_EXPIRATION", time.Minute, 0, math.MaxInt64), "Maximum duration entries are kept in the in-memory cache")
 
 	repoFactory := cacheutil.AddCacheFlagsToCmd(cmd, opts...)
 
 	return func() (*Cache, error) {
-		cache, err := repoFactory()
+		cache, err := backend.newCache(repoFactory, repoCacheExpiration)
 		if err != nil {
 			return nil, err
 		}
-		return NewCache(cache, repoCacheExpiration, revisionCacheExpiration), nil
+		var sharedCache *cacheutil.Cache
+		if localCacheSize > 0 {
+			sharedCache = cacheutil.NewCache(cache.GetClient())
+			client, err := cacheutil.NewTwoLevelLRUClient(cache.GetClient(), localCacheSize, localCacheExpiration)
+			if err != nil {
+				return nil, err
+			}
+			cache.SetClient(client)
+		}
+		repoCache := NewCache(cache, repoCacheExpiration, revisionCacheExpiration)
+		repoCache.sharedCache = sharedCache
+		repoCache.SetExpirations(CacheExpirations{
+			Repo:       repoCacheExpiration,
+			Revision:   revisionCacheExpiration,
+			Manifest:   manifestCacheExpiration,
+			AppDetails: appDetailsCacheExpiration,
+			HelmIndex:  helmIndexCacheExpiration,
+			GitRefs:    gitRefsCacheExpiration,
+		})
+		if secret := os.Getenv(envRepoCacheEncryptionKey); secret != "" {
+			key, err := crypto.NewKey([]byte(secret))
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", envRepoCacheEncryptionKey, err)
+			}
+			repoCache.encryptionKey = key
+		}
+		return repoCache, nil
+	}
+}
+
+// encryptedKey returns the key of the entry depending on whether the entry is encrypted, so that plain and encrypted
+// entries are never mixed up when the encryption is enabled or disabled
+func (c *Cache) encryptedKey(key string) string {
+	if len(c.encryptionKey) == 0 {
+		return key
 	}
+	return key + "|encrypted"
+}
+
+// setEncryptedItem stores the item encrypted with the cache encryption key, if it is configured. The ciphertext is
+// bound to the key of the entry, so that it can't be moved to another entry by anyone with write access to the cache.
+func (c *Cache) setEncryptedItem(key string, item interface{}, expiration time.Duration, delete bool) error {
+	key = c.encryptedKey(key)
+	if len(c.encryptionKey) == 0 || delete {
+		return c.cache.SetItem(key, item, expiration, delete)
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	encrypted, err := crypto.Encrypt(data, c.encryptionKey, []byte(key))
+	if err != nil {
+		return err
+	}
+	return c.cache.SetItem(key, encrypted, expiration, false)
+}
+
+// getEncryptedItem loads the item stored by setEncryptedItem. Entries which can't be decrypted, e.g. after the
+// encryption key has been rotated or if the entry was stored under another key, are treated as a cache miss.
+func (c *Cache) getEncryptedItem(key string, item interface{}) error {
+	key = c.encryptedKey(key)
+	if len(c.encryptionKey) == 0 {
+		return c.cache.GetItem(key, item)
+	}
+	var encrypted []byte
+	if err := c.cache.GetItem(key, &encrypted); err != nil {
+		return err
+	}
+	data, err := crypto.Decrypt(encrypted, c.encryptionKey, []byte(key))
+	if err != nil {
+		log.Warnf("Failed to decrypt cache entry '%s', treating as a cache miss: %v", key, err)
+		return ErrCacheMiss
+	}
+	return json.Unmarshal(data, item)
 }
 
 func appSourceKey(appSrc *appv1.ApplicationSource) uint32 {
@@ -92,7 +363,7 @@ func (c *Cache) ListApps(repoUrl, revision string) (map[string]string, error) {
 }
 
 func (c *Cache) SetApps(repoUrl, revision string, apps map[string]string) error {
-	return c.cache.SetItem(listApps(repoUrl, revision), apps, c.repoCacheExpiration, apps == nil)
+	return c.cache.SetItem(listApps(repoUrl, revision), apps, c.repoCacheExpiration(), apps == nil)
 }
 
 func helmIndexRefsKey(repo string) string {
@@ -101,7 +372,7 @@ func helmIndexRefsKey(repo string) string {
 
 // SetHelmIndex stores helm repository index.yaml content to cache
 func (c *Cache) SetHelmIndex(repo string, indexData []byte) error {
-	return c.cache.SetItem(helmIndexRefsKey(repo), indexData, c.revisionCacheExpiration, false)
+	return c.cache.SetItem(helmIndexRefsKey(repo), indexData, c.helmIndexCacheExpiration(), false)
 }
 
 // GetHelmIndex retrieves helm repository index.yaml content from cache
@@ -109,8 +380,9 @@ func (c *Cache) GetHelmIndex(repo string, indexData *[]byte) error {
 	return c.cache.GetItem(helmIndexRefsKey(repo), indexData)
 }
 
+// gitRefsKey returns the key of the references of the repository, which is the same for all the forms of its URL
 func gitRefsKey(repo string) string {
-	return fmt.Sprintf("git-refs|%s", repo)
+	return fmt.Sprintf("git-refs|%s", git.NormalizeGitURL(repo))
 }
 
 // SetGitReferences saves resolved Git repository references to cache
@@ -119,13 +391,13 @@ func (c *Cache) SetGitReferences(repo string, references []*plumbing.Reference)
 	for i := range references {
 		input = append(input, references[i].Strings())
 	}
-	return c.cache.SetItem(gitRefsKey(repo), input, c.revisionCacheExpiration, false)
+	return c.getSharedCache().SetItem(gitRefsKey(repo), input, c.gitRefsCacheExpiration(), false)
 }
 
 // GetGitReferences retrieves resolved Git repository references from cache
 func (c *Cache) GetGitReferences(repo string, references *[]*plumbing.Reference) error {
 	var input [][2]string
-	if err := c.cache.GetItem(gitRefsKey(repo), &input); err != nil {
+	if err := c.getSharedCache().GetItem(gitRefsKey(repo), &input); err != nil {
 		return err
 	}
 	var res []*plumbing.Reference
@@ -136,12 +408,19 @@ func (c *Cache) GetGitReferences(repo string, references *[]*plumbing.Reference)
 	return nil
 }
 
-func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appName string, info ClusterRuntimeInfo) string {
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d", appLabelKey, appName, revision, namespace, appSourceKey(appSrc)+clusterRuntimeInfoKey(info))
+// DeleteGitReferences deletes the resolved Git repository references from cache, e.g. after a push to the repository
+func (c *Cache) DeleteGitReferences(repo string) error {
+	return c.getSharedCache().SetItem(gitRefsKey(repo), "", c.gitRefsCacheExpiration(), true)
+}
+
+// manifestCacheKey returns the key of the manifests of the given source, which includes the SOPS key set so that the
+// manifests generated from decrypted files are not returned to the applications of projects without the key set
+func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, trackingMethod string, appLabelKey string, appName string, sopsKeySet string, info ClusterRuntimeInfo) string {
+	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%s|%d", trackingMethod, appLabelKey, appName, sopsKeySet, revision, namespace, appSourceKey(appSrc)+clusterRuntimeInfoKey(info))
 }
 
-func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, appLabelKey string, appName string, res *CachedManifestResponse) error {
-	err := c.cache.GetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName, clusterInfo), res)
+func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, sopsKeySet string, res *CachedManifestResponse) error {
+	err := c.getEncryptedItem(manifestCacheKey(revision, appSrc, namespace, trackingMethod, appLabelKey, appName, sopsKeySet, clusterInfo), res)
 
 	if err != nil {
 		return err
@@ -156,7 +435,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, c
 	if hash != res.CacheEntryHash || res.ManifestResponse == nil && res.MostRecentError == "" {
 		log.Warnf("Manifest hash did not match expected value or cached manifests response is empty, treating as a cache miss: %s", appName)
 
-		err = c.DeleteManifests(revision, appSrc, clusterInfo, namespace, appLabelKey, appName)
+		err = c.DeleteManifests(revision, appSrc, clusterInfo, namespace, trackingMethod, appLabelKey, appName, sopsKeySet)
 		if err != nil {
 			return fmt.Errorf("Unable to delete manifest after hash mismatch, %v", err)
 		}
@@ -171,7 +450,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, c
 	return nil
 }
 
-func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, appLabelKey string, appName string, res *CachedManifestResponse) error {
+func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, sopsKeySet string, res *CachedManifestResponse) error {
 
 	// Generate and apply the cache entry hash, before writing
 	if res != nil {
@@ -183,11 +462,11 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, c
 		res.CacheEntryHash = hash
 	}
 
-	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName, clusterInfo), res, c.repoCacheExpiration, res == nil)
+	return c.setEncryptedItem(manifestCacheKey(revision, appSrc, namespace, trackingMethod, appLabelKey, appName, sopsKeySet, clusterInfo), res, c.manifestCacheExpiration(), res == nil)
 }
 
-func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, appLabelKey string, appName string) error {
-	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName, clusterInfo), "", c.repoCacheExpiration, true)
+func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, clusterInfo ClusterRuntimeInfo, namespace string, trackingMethod string, appLabelKey string, appName string, sopsKeySet string) error {
+	return c.setEncryptedItem(manifestCacheKey(revision, appSrc, namespace, trackingMethod, appLabelKey, appName, sopsKeySet, clusterInfo), "", c.manifestCacheExpiration(), true)
 }
 
 func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource) string {
@@ -195,11 +474,11 @@ func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource) string
 }
 
 func (c *Cache) GetAppDetails(revision string, appSrc *appv1.ApplicationSource, res *apiclient.RepoAppDetailsResponse) error {
-	return c.cache.GetItem(appDetailsCacheKey(revision, appSrc), res)
+	return c.getEncryptedItem(appDetailsCacheKey(revision, appSrc), res)
 }
 
 func (c *Cache) SetAppDetails(revision string, appSrc *appv1.ApplicationSource, res *apiclient.RepoAppDetailsResponse) error {
-	return c.cache.SetItem(appDetailsCacheKey(revision, appSrc), res, c.repoCacheExpiration, res == nil)
+	return c.setEncryptedItem(appDetailsCacheKey(revision, appSrc), res, c.appDetailsCacheExpiration(), res == nil)
 }
 
 func revisionMetadataKey(repoURL, revision string) string {
@@ -212,7 +491,7 @@ func (c *Cache) GetRevisionMetadata(repoURL, revision string) (*appv1.RevisionMe
 }
 
 func (c *Cache) SetRevisionMetadata(repoURL, revision string, item *appv1.RevisionMetadata) error {
-	return c.cache.SetItem(revisionMetadataKey(repoURL, revision), item, c.repoCacheExpiration, false)
+	return c.cache.SetItem(revisionMetadataKey(repoURL, revision), item, c.repoCacheExpiration(), false)
 }
 
 func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
@@ -3,10 +3,14 @@ package cache
 import (
 	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 
@@ -14,6 +18,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/crypto"
 )
 
 type fixtures struct {
@@ -68,34 +73,87 @@ func TestCache_ListApps(t *testing.T) {
 	assert.Equal(t, map[string]string{"foo": "bar"}, value)
 }
 
+func TestCache_GitReferences(t *testing.T) {
+	cache := newFixtures().Cache
+	var refs []*plumbing.Reference
+	// cache miss
+	err := cache.GetGitReferences("https://github.com/argoproj/argo-cd", &refs)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetGitReferences("https://github.com/argoproj/argo-cd.git", []*plumbing.Reference{
+		plumbing.NewReferenceFromStrings("refs/heads/master", "a67038ae2e9cb9b9b16423702f98b41e36601001"),
+	})
+	assert.NoError(t, err)
+	// cache hit, regardless of the form of the URL
+	err = cache.GetGitReferences("https://github.com/argoproj/argo-cd", &refs)
+	assert.NoError(t, err)
+	assert.Len(t, refs, 1)
+	assert.Equal(t, "a67038ae2e9cb9b9b16423702f98b41e36601001", refs[0].Hash().String())
+	// invalidate
+	err = cache.DeleteGitReferences("https://github.com/argoproj/argo-cd")
+	assert.NoError(t, err)
+	err = cache.GetGitReferences("https://github.com/argoproj/argo-cd.git", &refs)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
+func TestCache_GitReferencesBypassLocalCache(t *testing.T) {
+	// two replicas with their own in-memory cache in front of the same shared cache
+	shared := cacheutil.NewInMemoryCache(time.Hour)
+	newReplicaCache := func() *Cache {
+		local, err := cacheutil.NewTwoLevelLRUClient(shared, 10, time.Hour)
+		assert.NoError(t, err)
+		c := NewCache(cacheutil.NewCache(local), time.Minute, time.Minute)
+		c.sharedCache = cacheutil.NewCache(shared)
+		return c
+	}
+	replica1 := newReplicaCache()
+	replica2 := newReplicaCache()
+
+	refs := []*plumbing.Reference{plumbing.NewReferenceFromStrings("refs/heads/master", "a67038ae2e9cb9b9b16423702f98b41e36601001")}
+	assert.NoError(t, replica1.SetGitReferences("https://github.com/argoproj/argo-cd", refs))
+	var res []*plumbing.Reference
+	assert.NoError(t, replica2.GetGitReferences("https://github.com/argoproj/argo-cd", &res))
+
+	// the references deleted by a replica are deleted for all of them
+	assert.NoError(t, replica2.DeleteGitReferences("https://github.com/argoproj/argo-cd"))
+	assert.Equal(t, ErrCacheMiss, replica1.GetGitReferences("https://github.com/argoproj/argo-cd", &res))
+	assert.Equal(t, ErrCacheMiss, replica2.GetGitReferences("https://github.com/argoproj/argo-cd", &res))
+}
+
 func TestCache_GetManifests(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
 	q := &apiclient.ManifestRequest{}
 	value := &CachedManifestResponse{}
-	err := cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "my-app-label-key", "my-app-label-value", value)
+	err := cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// populate cache
 	res := &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type"}}
-	err = cache.SetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "my-app-label-key", "my-app-label-value", res)
+	err = cache.SetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "", res)
 	assert.NoError(t, err)
 	// cache miss
-	err = cache.GetManifests("other-revision", &ApplicationSource{}, q, "my-namespace", "my-app-label-key", "my-app-label-value", value)
+	err = cache.GetManifests("other-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache miss
+	err = cache.GetManifests("my-revision", &ApplicationSource{Path: "other-path"}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// cache miss
-	err = cache.GetManifests("my-revision", &ApplicationSource{Path: "other-path"}, q, "my-namespace", "my-app-label-key", "my-app-label-value", value)
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "other-namespace", "", "my-app-label-key", "my-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// cache miss
-	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "other-namespace", "my-app-label-key", "my-app-label-value", value)
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "other-app-label-key", "my-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// cache miss
-	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "other-app-label-key", "my-app-label-value", value)
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "other-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// cache miss
-	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "my-app-label-key", "other-app-label-value", value)
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "my-keys", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache miss
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "annotation", "my-app-label-key", "my-app-label-value", "", value)
 	assert.Equal(t, ErrCacheMiss, err)
 	// cache hit
-	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "my-app-label-key", "my-app-label-value", value)
+	err = cache.GetManifests("my-revision", &ApplicationSource{}, q, "my-namespace", "", "my-app-label-key", "my-app-label-value", "", value)
 	assert.NoError(t, err)
 	assert.Equal(t, &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type"}}, value)
 }
@@ -121,10 +179,153 @@ func TestCache_GetAppDetails(t *testing.T) {
 	assert.Equal(t, &apiclient.RepoAppDetailsResponse{Type: "my-type"}, value)
 }
 
+func TestCache_EncryptedManifests(t *testing.T) {
+	cache := newFixtures().Cache
+	key, err := crypto.NewKey([]byte("0123456789abcdef"))
+	assert.NoError(t, err)
+	cache.encryptionKey = key
+
+	res := &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{Manifests: []string{"password: s3cr3t"}}}
+	assert.NoError(t, cache.SetManifests("my-revision", &ApplicationSource{}, nil, "my-namespace", "", "", "my-app", "", res))
+
+	// the stored entry does not contain the plain manifests
+	var raw []byte
+	assert.NoError(t, cache.cache.GetItem(manifestCacheKey("my-revision", &ApplicationSource{}, "my-namespace", "", "", "my-app", "", nil)+"|encrypted", &raw))
+	assert.NotContains(t, string(raw), "s3cr3t")
+
+	value := &CachedManifestResponse{}
+	assert.NoError(t, cache.GetManifests("my-revision", &ApplicationSource{}, nil, "my-namespace", "", "", "my-app", "", value))
+	assert.Equal(t, []string{"password: s3cr3t"}, value.ManifestResponse.Manifests)
+
+	assert.NoError(t, cache.SetAppDetails("my-revision", &ApplicationSource{}, &apiclient.RepoAppDetailsResponse{Type: "my-type"}))
+	details := &apiclient.RepoAppDetailsResponse{}
+	assert.NoError(t, cache.GetAppDetails("my-revision", &ApplicationSource{}, details))
+	assert.Equal(t, "my-type", details.Type)
+
+	// entries moved to the key of another entry are a cache miss
+	otherKey := manifestCacheKey("my-revision", &ApplicationSource{}, "my-namespace", "", "", "other-app", "", nil) + "|encrypted"
+	assert.NoError(t, cache.cache.SetItem(otherKey, raw, time.Minute, false))
+	assert.Equal(t, ErrCacheMiss, cache.GetManifests("my-revision", &ApplicationSource{}, nil, "my-namespace", "", "", "other-app", "", value))
+
+	// entries encrypted with a rotated key are a cache miss
+	cache.encryptionKey, err = crypto.NewKey([]byte("fedcba9876543210"))
+	assert.NoError(t, err)
+	assert.Equal(t, ErrCacheMiss, cache.GetManifests("my-revision", &ApplicationSource{}, nil, "my-namespace", "", "", "my-app", "", value))
+
+	// plain entries are never read as encrypted and vice versa
+	cache.encryptionKey = nil
+	assert.Equal(t, ErrCacheMiss, cache.GetAppDetails("my-revision", &ApplicationSource{}, details))
+
+	assert.NoError(t, cache.DeleteManifests("my-revision", &ApplicationSource{}, nil, "my-namespace", "", "", "my-app", ""))
+}
+
+func TestAddCacheFlagsToCmd_EncryptionKey(t *testing.T) {
+	_ = os.Setenv("ARGOCD_REPO_CACHE_ENCRYPTION_KEY", "too-short")
+	defer func() { _ = os.Unsetenv("ARGOCD_REPO_CACHE_ENCRYPTION_KEY") }()
+	_, err := AddCacheFlagsToCmd(&cobra.Command{})()
+	assert.EqualError(t, err, "invalid ARGOCD_REPO_CACHE_ENCRYPTION_KEY: encryption key must be at least 16 bytes long")
+
+	_ = os.Setenv("ARGOCD_REPO_CACHE_ENCRYPTION_KEY", "0123456789abcdef")
+	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
+	assert.NoError(t, err)
+	assert.Len(t, cache.encryptionKey, 32)
+}
+
+func TestCache_Expirations(t *testing.T) {
+	cache := newFixtures().Cache
+	assert.Equal(t, time.Minute, cache.manifestCacheExpiration())
+	assert.Equal(t, time.Minute, cache.appDetailsCacheExpiration())
+	assert.Equal(t, time.Minute, cache.helmIndexCacheExpiration())
+
+	cache.SetExpirations(CacheExpirations{Repo: time.Hour, Revision: time.Minute, Manifest: 2 * time.Hour, AppDetails: 3 * time.Hour, HelmIndex: 10 * time.Minute, GitRefs: 30 * time.Second})
+	assert.Equal(t, time.Hour, cache.repoCacheExpiration())
+	assert.Equal(t, time.Minute, cache.revisionCacheExpiration())
+	assert.Equal(t, 2*time.Hour, cache.manifestCacheExpiration())
+	assert.Equal(t, 3*time.Hour, cache.appDetailsCacheExpiration())
+	assert.Equal(t, 10*time.Minute, cache.helmIndexCacheExpiration())
+	assert.Equal(t, 30*time.Second, cache.gitRefsCacheExpiration())
+}
+
+func TestLoadCacheExpirations(t *testing.T) {
+	defaults := CacheExpirations{Repo: 24 * time.Hour, Revision: 3 * time.Minute}
+	dir, err := ioutil.TempDir("", "cache-expirations")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	expirations, err := LoadCacheExpirations(dir, defaults)
+	assert.NoError(t, err)
+	assert.Equal(t, defaults, expirations)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.manifest.cache.expiration"), []byte("1h\n"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.helm.index.cache.expiration"), []byte("10m"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.app.details.cache.expiration"), []byte(""), 0644))
+	expirations, err = LoadCacheExpirations(dir, defaults)
+	assert.NoError(t, err)
+	assert.Equal(t, CacheExpirations{Repo: 24 * time.Hour, Revision: 3 * time.Minute, Manifest: time.Hour, HelmIndex: 10 * time.Minute}, expirations)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.repo.cache.expiration"), []byte("forever"), 0644))
+	expirations, err = LoadCacheExpirations(dir, defaults)
+	assert.Error(t, err)
+	assert.Equal(t, defaults, expirations)
+}
+
+func TestAddCacheFlagsToCmd_Expirations(t *testing.T) {
+	cmd := &cobra.Command{}
+	factory := AddCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--manifest-cache-expiration", "1h", "--helm-index-cache-expiration", "5m", "--git-refs-cache-expiration", "30s"}))
+	cache, err := factory()
+	assert.NoError(t, err)
+	assert.Equal(t, CacheExpirations{Repo: 24 * time.Hour, Revision: 3 * time.Minute, Manifest: time.Hour, HelmIndex: 5 * time.Minute, GitRefs: 30 * time.Second}, cache.Expirations())
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
 	assert.NoError(t, err)
-	assert.Equal(t, 24*time.Hour, cache.repoCacheExpiration)
+	assert.Equal(t, 24*time.Hour, cache.repoCacheExpiration())
+}
+
+func TestAddCacheFlagsToCmd_Backend(t *testing.T) {
+	cmd := &cobra.Command{}
+	factory := AddCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--repo-cache-backend", "memcached", "--repo-cache-memcached-server", "memcached:11211", "--repo-cache-local-size", "100"}))
+	cache, err := factory()
+	assert.NoError(t, err)
+	assert.NotNil(t, cache)
+
+	cmd = &cobra.Command{}
+	factory = AddCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--repo-cache-backend", "memcached"}))
+	_, err = factory()
+	assert.Error(t, err)
+
+	cmd = &cobra.Command{}
+	factory = AddCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--repo-cache-backend", "unknown"}))
+	_, err = factory()
+	assert.EqualError(t, err, "unknown repo cache backend 'unknown', supported backends: redis, memcached")
+}
+
+func TestAddSharedCacheFlagsToCmd(t *testing.T) {
+	redisCache := cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour))
+	cmd := &cobra.Command{}
+	factory := AddSharedCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{}))
+	cache, err := factory(redisCache)
+	assert.NoError(t, err)
+	assert.Same(t, redisCache, cache.cache)
+
+	cmd = &cobra.Command{}
+	factory = AddSharedCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--repo-cache-backend", "memcached", "--repo-cache-memcached-server", "memcached:11211"}))
+	cache, err = factory(redisCache)
+	assert.NoError(t, err)
+	assert.NotSame(t, redisCache, cache.cache)
+
+	cmd = &cobra.Command{}
+	factory = AddSharedCacheFlagsToCmd(cmd)
+	assert.NoError(t, cmd.Flags().Parse([]string{"--repo-cache-backend", "unknown"}))
+	_, err = factory(redisCache)
+	assert.EqualError(t, err, "unknown repo cache backend 'unknown', supported backends: redis, memcached")
 }
 
 func TestCachedManifestResponse_HashBehavior(t *testing.T) {
@@ -154,7 +355,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 		NumberOfCachedResponsesReturned: 0,
 		NumberOfConsecutiveFailures:     0,
 	}
-	err := repoCache.SetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, appKey, appValue, store)
+	err := repoCache.SetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, "", appKey, appValue, "", store)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -185,7 +386,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 
 	// Retrieve the value using 'GetManifests' and confirm it works
 	retrievedVal := &CachedManifestResponse{}
-	err = repoCache.GetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, appKey, appValue, retrievedVal)
+	err = repoCache.GetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, "", appKey, appValue, "", retrievedVal)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -208,7 +409,7 @@ func TestCachedManifestResponse_HashBehavior(t *testing.T) {
 
 	// Retrieve the value using GetManifests and confirm it returns a cache miss
 	retrievedVal = &CachedManifestResponse{}
-	err = repoCache.GetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, appKey, appValue, retrievedVal)
+	err = repoCache.GetManifests(response.Revision, appSrc, &apiclient.ManifestRequest{}, response.Namespace, "", appKey, appValue, "", retrievedVal)
 
 	assert.True(t, err == cacheutil.ErrCacheMiss)
 
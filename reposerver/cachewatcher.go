@@ -0,0 +1,55 @@
+package reposerver
+
+import (
+	"fmt"
+
+	"github.com/fsnotify/fsnotify"
+	log "github.com/sirupsen/logrus"
+
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
+)
+
+// StartCacheExpirationWatcher watches a given directory, e.g. the mounted argocd-cmd-params-cm ConfigMap, and reloads
+// the cache expirations whenever the directory changes. The given defaults are used for the settings missing from the
+// directory.
+func StartCacheExpirationWatcher(cache *reposervercache.Cache, dir string, defaults reposervercache.CacheExpirations) error {
+	log.Infof("Starting cache expiration watcher on directory '%s'", dir)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	reload := func() {
+		expirations, err := reposervercache.LoadCacheExpirations(dir, defaults)
+		if err != nil {
+			log.Errorf("Could not reload cache expirations: %v", err)
+			return
+		}
+		if expirations != cache.Expirations() {
+			log.Infof("Updating cache expirations: %+v", expirations)
+			cache.SetExpirations(expirations)
+		}
+	}
+
+	err = watcher.Add(dir)
+	if err != nil {
+		return err
+	}
+	reload()
+
+	for {
+		select {
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return fmt.Errorf("cache expiration watcher on %s was closed", dir)
+			}
+			reload()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return fmt.Errorf("cache expiration watcher on %s was closed", dir)
+			}
+			log.Errorf("%v", err)
+		}
+	}
+}
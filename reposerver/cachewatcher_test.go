@@ -0,0 +1,43 @@
+package reposerver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
+	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+)
+
+func TestStartCacheExpirationWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache-config")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.manifest.cache.expiration"), []byte("1h"), 0644))
+
+	cache := reposervercache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), 24*time.Hour, 3*time.Minute)
+	defaults := cache.Expirations()
+	go func() {
+		_ = StartCacheExpirationWatcher(cache, dir, defaults)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return cache.Expirations().Manifest == time.Hour
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "reposerver.manifest.cache.expiration"), []byte("2h"), 0644))
+	assert.Eventually(t, func() bool {
+		return cache.Expirations().Manifest == 2*time.Hour
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// removed settings fall back to the defaults
+	require.NoError(t, os.Remove(filepath.Join(dir, "reposerver.manifest.cache.expiration")))
+	assert.Eventually(t, func() bool {
+		return cache.Expirations() == defaults
+	}, 5*time.Second, 10*time.Millisecond)
+}
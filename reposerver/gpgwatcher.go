@@ -13,7 +13,13 @@ import (
 
 const maxRecreateRetries = 5
 
-// StartGPGWatcher watches a given directory for creation and deletion of files and syncs the GPG keyring
+// configMapDataDir is the symbolic link to the data of a mounted ConfigMap. Kubernetes replaces it on each update of the
+// ConfigMap, which is the only event on the update of an existing key.
+const configMapDataDir = "..data"
+
+// StartGPGWatcher watches a given directory for creation and deletion of files and syncs the GPG keyring. If the
+// directory is a mounted ConfigMap, the keyring is also synced on each update of the ConfigMap, so that updated keys
+// (e.g. with an extended expiration date) are reloaded without restarting the repo server.
 func StartGPGWatcher(sourcePath string) error {
 	log.Infof("Starting GPG sync watcher on directory '%s'", sourcePath)
 	forceSync := false
@@ -57,7 +63,7 @@ func StartGPGWatcher(sourcePath string) error {
 						// Force sync because we probably missed an event
 						forceSync = true
 					}
-					if gpg.IsShortKeyID(path.Base(event.Name)) || forceSync {
+					if gpg.IsShortKeyID(path.Base(event.Name)) || path.Base(event.Name) == configMapDataDir || forceSync {
 						log.Infof("Updating GPG keyring on filesystem event")
 						added, removed, err := gpg.SyncKeyRingFromDirectory(sourcePath)
 						if err != nil {
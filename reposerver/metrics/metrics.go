@@ -10,12 +10,15 @@ import (
 )
 
 type MetricsServer struct {
-	handler                  http.Handler
-	gitRequestCounter        *prometheus.CounterVec
-	gitRequestHistogram      *prometheus.HistogramVec
-	repoPendingRequestsGauge *prometheus.GaugeVec
-	redisRequestCounter      *prometheus.CounterVec
-	redisRequestHistogram    *prometheus.HistogramVec
+	handler                   http.Handler
+	gitRequestCounter         *prometheus.CounterVec
+	gitRequestHistogram       *prometheus.HistogramVec
+	repoPendingRequestsGauge  *prometheus.GaugeVec
+	redisRequestCounter       *prometheus.CounterVec
+	redisRequestHistogram     *prometheus.HistogramVec
+	manifestGenTimeoutCounter *prometheus.CounterVec
+	manifestCacheCounter      *prometheus.CounterVec
+	manifestGenPauseCounter   *prometheus.CounterVec
 }
 
 type GitRequestType string
@@ -25,6 +28,22 @@ const (
 	GitRequestTypeFetch    = "fetch"
 )
 
+type ManifestCacheResult string
+
+const (
+	ManifestCacheResultHit  = "hit"
+	ManifestCacheResultMiss = "miss"
+	// ManifestCacheResultCachedError is a cached manifest generation error returned while the generation is paused
+	ManifestCacheResultCachedError = "cached-error"
+)
+
+type ManifestGenerationPauseEvent string
+
+const (
+	ManifestGenerationPauseEventEnter = "enter"
+	ManifestGenerationPauseEventExit  = "exit"
+)
+
 // NewMetricsServer returns a new prometheus server which collects application metrics.
 func NewMetricsServer() *MetricsServer {
 	registry := prometheus.NewRegistry()
@@ -78,13 +97,43 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	manifestGenTimeoutCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_generation_timeout_total",
+			Help: "Number of manifest generations aborted by the repo server because they exceeded the timeout",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(manifestGenTimeoutCounter)
+
+	manifestCacheCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_cache_request_total",
+			Help: "Number of manifest cache lookups performed by repo server",
+		},
+		[]string{"repo", "result"},
+	)
+	registry.MustRegister(manifestCacheCounter)
+
+	manifestGenPauseCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_manifest_generation_pause_total",
+			Help: "Number of times the manifest generation was paused after consecutive failures, or resumed",
+		},
+		[]string{"repo", "event"},
+	)
+	registry.MustRegister(manifestGenPauseCounter)
+
 	return &MetricsServer{
-		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
-		gitRequestCounter:        gitRequestCounter,
-		gitRequestHistogram:      gitRequestHistogram,
-		repoPendingRequestsGauge: repoPendingRequestsGauge,
-		redisRequestCounter:      redisRequestCounter,
-		redisRequestHistogram:    redisRequestHistogram,
+		handler:                   promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		gitRequestCounter:         gitRequestCounter,
+		gitRequestHistogram:       gitRequestHistogram,
+		repoPendingRequestsGauge:  repoPendingRequestsGauge,
+		redisRequestCounter:       redisRequestCounter,
+		redisRequestHistogram:     redisRequestHistogram,
+		manifestGenTimeoutCounter: manifestGenTimeoutCounter,
+		manifestCacheCounter:      manifestCacheCounter,
+		manifestGenPauseCounter:   manifestGenPauseCounter,
 	}
 }
 
@@ -116,3 +165,18 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// IncManifestGenerationTimeout increments the counter of manifest generations which exceeded the timeout
+func (m *MetricsServer) IncManifestGenerationTimeout(repo string) {
+	m.manifestGenTimeoutCounter.WithLabelValues(repo).Inc()
+}
+
+// IncManifestCacheRequest increments the manifest cache lookups counter
+func (m *MetricsServer) IncManifestCacheRequest(repo string, result ManifestCacheResult) {
+	m.manifestCacheCounter.WithLabelValues(repo, string(result)).Inc()
+}
+
+// IncManifestGenerationPause increments the counter of manifest generations which were paused or resumed
+func (m *MetricsServer) IncManifestGenerationPause(repo string, event ManifestGenerationPauseEvent) {
+	m.manifestGenPauseCounter.WithLabelValues(repo, string(event)).Inc()
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+var cloneDirRegexp = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// staleGitLockFiles are the lock files git leaves behind when it is interrupted, e.g. by a restart of the repo server.
+// They would make every following git command fail.
+var staleGitLockFiles = []string{"index.lock", "shallow.lock", "HEAD.lock", "config.lock", "packed-refs.lock"}
+
+// cloneCache stores the clones of the repositories in a persistent directory, keyed by the hash of the repository URL,
+// so that they are reused after a restart of the repo server. The least recently used clones are evicted once the
+// total size of the directory exceeds the configured maximum.
+type cloneCache struct {
+	dir      string
+	maxSize  int64
+	repoLock *repositoryLock
+	now      func() time.Time
+}
+
+func newCloneCache(dir string, maxSize int64, repoLock *repositoryLock) *cloneCache {
+	return &cloneCache{dir: dir, maxSize: maxSize, repoLock: repoLock, now: time.Now}
+}
+
+// path returns the directory of the clone of the given repository
+func (c *cloneCache) path(repoURL string) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(git.NormalizeGitURL(repoURL)))))
+}
+
+// touch records the access to the clone of the given repository, which is used to evict the least recently used
+// clones
+func (c *cloneCache) touch(repoURL string) {
+	now := c.now()
+	if err := os.Chtimes(c.path(repoURL), now, now); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Failed to update the access time of the clone of %s: %v", repoURL, err)
+	}
+}
+
+// validate removes the clones which cannot be reused, like partially initialized repositories or clones of another
+// repository, and the lock files left by interrupted git commands. It must be called before any clone is used.
+func (c *cloneCache) validate() error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		path := filepath.Join(c.dir, entry.Name())
+		if err := c.validateClone(path, entry); err != nil {
+			log.Warnf("Removing invalid clone %s: %v", path, err)
+			if err := os.RemoveAll(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cloneCache) validateClone(path string, info os.FileInfo) error {
+	if !info.IsDir() || !cloneDirRegexp.MatchString(info.Name()) {
+		return fmt.Errorf("not a clone directory")
+	}
+	repo, err := gogit.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+	remote, err := repo.Remote(gogit.DefaultRemoteName)
+	if err != nil {
+		return err
+	}
+	if urls := remote.Config().URLs; len(urls) == 0 || c.path(urls[0]) != path {
+		return fmt.Errorf("remote %s does not match the clone directory", gogit.DefaultRemoteName)
+	}
+	for _, name := range staleGitLockFiles {
+		if err := os.Remove(filepath.Join(path, ".git", name)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// gc evicts the least recently used clones until the total size of the cache is within the limit. Clones which are in
+// use are skipped.
+func (c *cloneCache) gc() error {
+	if c.maxSize <= 0 {
+		return nil
+	}
+	type cloneInfo struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	entries, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+	var clones []cloneInfo
+	var total int64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(c.dir, entry.Name())
+		size, err := dirSize(path)
+		if err != nil {
+			return err
+		}
+		clones = append(clones, cloneInfo{path: path, size: size, modTime: entry.ModTime()})
+		total += size
+	}
+	sort.Slice(clones, func(i, j int) bool {
+		return clones[i].modTime.Before(clones[j].modTime)
+	})
+	for i := 0; total > c.maxSize && i < len(clones); i++ {
+		removed, err := c.repoLock.runIfIdle(clones[i].path, func() error {
+			return os.RemoveAll(clones[i].path)
+		})
+		if err != nil {
+			return err
+		}
+		if !removed {
+			log.Debugf("Skipping the eviction of clone %s which is in use", clones[i].path)
+			continue
+		}
+		log.Infof("Evicted clone %s (%d bytes) from clone cache", clones[i].path, clones[i].size)
+		total -= clones[i].size
+	}
+	return nil
+}
+
+// run evicts the least recently used clones at the given interval
+func (c *cloneCache) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := c.gc(); err != nil {
+			log.Warnf("Failed to evict clones from clone cache: %v", err)
+		}
+	}
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the files of a clone might be removed by a concurrent git command
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
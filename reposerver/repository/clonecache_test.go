@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func initClone(t *testing.T, path string, repoURL string) {
+	repo, err := gogit.PlainInit(path, false)
+	require.NoError(t, err)
+	_, err = repo.CreateRemote(&config.RemoteConfig{Name: gogit.DefaultRemoteName, URLs: []string{repoURL}})
+	require.NoError(t, err)
+}
+
+func TestCloneCache_Path(t *testing.T) {
+	c := newCloneCache("/tmp/clones", 0, NewRepositoryLock())
+	assert.Equal(t, c.path("https://github.com/argoproj/argo-cd"), c.path("https://github.com/argoproj/argo-cd.git"))
+	assert.NotEqual(t, c.path("https://github.com/argoproj/argo-cd"), c.path("https://github.com/argoproj/argocd-example-apps"))
+	assert.Equal(t, "/tmp/clones", filepath.Dir(c.path("https://github.com/argoproj/argo-cd")))
+}
+
+func TestCloneCache_Validate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	c := newCloneCache(dir, 0, NewRepositoryLock())
+
+	valid := c.path("https://github.com/argoproj/argo-cd")
+	initClone(t, valid, "https://github.com/argoproj/argo-cd.git")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(valid, ".git", "index.lock"), nil, 0644))
+	// clone stored in the directory of another repository
+	otherRepo := c.path("https://github.com/argoproj/argocd-example-apps")
+	initClone(t, otherRepo, "https://github.com/argoproj/argo-cd")
+	// repository which was not initialized
+	notInitialized := c.path("https://github.com/argoproj/argo-rollouts")
+	require.NoError(t, os.MkdirAll(notInitialized, 0755))
+	unknown := filepath.Join(dir, "unknown")
+	require.NoError(t, ioutil.WriteFile(unknown, nil, 0644))
+
+	require.NoError(t, c.validate())
+
+	assert.DirExists(t, valid)
+	assert.NoFileExists(t, filepath.Join(valid, ".git", "index.lock"))
+	assert.NoDirExists(t, otherRepo)
+	assert.NoDirExists(t, notInitialized)
+	assert.NoFileExists(t, unknown)
+}
+
+func TestCloneCache_GC(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clone-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	repoLock := NewRepositoryLock()
+	c := newCloneCache(dir, 25, repoLock)
+
+	now := time.Now()
+	addClone := func(repoURL string, lastUsed time.Time) string {
+		path := c.path(repoURL)
+		require.NoError(t, os.MkdirAll(path, 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(path, "file"), make([]byte, 10), 0644))
+		c.now = func() time.Time { return lastUsed }
+		c.touch(repoURL)
+		return path
+	}
+	oldest := addClone("https://github.com/argoproj/repo-1", now.Add(-3*time.Hour))
+	inUse := addClone("https://github.com/argoproj/repo-2", now.Add(-2*time.Hour))
+	older := addClone("https://github.com/argoproj/repo-3", now.Add(-time.Hour))
+	recent := addClone("https://github.com/argoproj/repo-4", now)
+
+	closer, err := repoLock.Lock(inUse, "1", true, func() error { return nil })
+	require.NoError(t, err)
+	require.NoError(t, c.gc())
+	io.Close(closer)
+
+	assert.NoDirExists(t, oldest)
+	assert.DirExists(t, inUse)
+	assert.NoDirExists(t, older)
+	assert.DirExists(t, recent)
+
+	t.Run("NoLimit", func(t *testing.T) {
+		c.maxSize = 0
+		addClone("https://github.com/argoproj/repo-5", now)
+		require.NoError(t, c.gc())
+		assert.DirExists(t, inUse)
+		assert.DirExists(t, recent)
+	})
+}
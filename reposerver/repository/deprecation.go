@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// deprecatedAPIs maps deprecated API versions of well-known resources to the API version which should be used instead
+var deprecatedAPIs = map[schema.GroupVersionKind]string{
+	{Group: "extensions", Version: "v1beta1", Kind: "DaemonSet"}:                                        "apps/v1",
+	{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}:                                       "apps/v1",
+	{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet"}:                                       "apps/v1",
+	{Group: "extensions", Version: "v1beta1", Kind: "NetworkPolicy"}:                                    "networking.k8s.io/v1",
+	{Group: "extensions", Version: "v1beta1", Kind: "PodSecurityPolicy"}:                                "policy/v1beta1",
+	{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}:                                          "networking.k8s.io/v1",
+	{Group: "apps", Version: "v1beta1", Kind: "Deployment"}:                                             "apps/v1",
+	{Group: "apps", Version: "v1beta1", Kind: "StatefulSet"}:                                            "apps/v1",
+	{Group: "apps", Version: "v1beta2", Kind: "DaemonSet"}:                                              "apps/v1",
+	{Group: "apps", Version: "v1beta2", Kind: "Deployment"}:                                             "apps/v1",
+	{Group: "apps", Version: "v1beta2", Kind: "ReplicaSet"}:                                             "apps/v1",
+	{Group: "apps", Version: "v1beta2", Kind: "StatefulSet"}:                                            "apps/v1",
+	{Group: "networking.k8s.io", Version: "v1beta1", Kind: "Ingress"}:                                   "networking.k8s.io/v1",
+	{Group: "networking.k8s.io", Version: "v1beta1", Kind: "IngressClass"}:                              "networking.k8s.io/v1",
+	{Group: "batch", Version: "v1beta1", Kind: "CronJob"}:                                               "batch/v1",
+	{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget"}:                                  "policy/v1",
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRole"}:                       "rbac.authorization.k8s.io/v1",
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "ClusterRoleBinding"}:                "rbac.authorization.k8s.io/v1",
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "Role"}:                              "rbac.authorization.k8s.io/v1",
+	{Group: "rbac.authorization.k8s.io", Version: "v1beta1", Kind: "RoleBinding"}:                       "rbac.authorization.k8s.io/v1",
+	{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"}:               "apiextensions.k8s.io/v1",
+	{Group: "apiregistration.k8s.io", Version: "v1beta1", Kind: "APIService"}:                           "apiregistration.k8s.io/v1",
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "MutatingWebhookConfiguration"}:   "admissionregistration.k8s.io/v1",
+	{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "ValidatingWebhookConfiguration"}: "admissionregistration.k8s.io/v1",
+	{Group: "scheduling.k8s.io", Version: "v1beta1", Kind: "PriorityClass"}:                             "scheduling.k8s.io/v1",
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSIDriver"}:                                    "storage.k8s.io/v1",
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "CSINode"}:                                      "storage.k8s.io/v1",
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "StorageClass"}:                                 "storage.k8s.io/v1",
+	{Group: "storage.k8s.io", Version: "v1beta1", Kind: "VolumeAttachment"}:                             "storage.k8s.io/v1",
+	{Group: "certificates.k8s.io", Version: "v1beta1", Kind: "CertificateSigningRequest"}:               "certificates.k8s.io/v1",
+	{Group: "coordination.k8s.io", Version: "v1beta1", Kind: "Lease"}:                                   "coordination.k8s.io/v1",
+}
+
+// deprecatedAPIWarning returns a warning if the resource uses a deprecated API version, or an empty string otherwise
+func deprecatedAPIWarning(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	replacement, ok := deprecatedAPIs[gvk]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s %s uses deprecated API version %s, use %s instead", gvk.Kind, obj.GetName(), gvk.GroupVersion().String(), replacement)
+}
@@ -68,6 +68,25 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 	}
 }
 
+// runIfIdle runs f while holding the lock of the repository, unless an operation is in progress on it. Returns false
+// if the repository is in use.
+func (r *repositoryLock) runIfIdle(path string, f func() error) (bool, error) {
+	r.lock.Lock()
+	state, ok := r.stateByKey[path]
+	if !ok {
+		state = &repositoryState{cond: &sync.Cond{L: &sync.Mutex{}}}
+		r.stateByKey[path] = state
+	}
+	r.lock.Unlock()
+
+	state.cond.L.Lock()
+	defer state.cond.L.Unlock()
+	if state.revision != "" {
+		return false, nil
+	}
+	return true, f()
+}
+
 type repositoryState struct {
 	cond            *sync.Cond
 	revision        string
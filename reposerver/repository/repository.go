@@ -3,17 +3,23 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/url"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	gosync "sync"
 	"time"
 
 	"github.com/Masterminds/semver"
@@ -23,14 +29,15 @@ import (
 	"github.com/argoproj/pkg/sync"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/ghodss/yaml"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
 	"github.com/google/go-jsonnet"
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -40,17 +47,24 @@ import (
 	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
 	"github.com/argoproj/argo-cd/v2/util/app/discovery"
 	argopath "github.com/argoproj/argo-cd/v2/util/app/path"
+	"github.com/argoproj/argo-cd/v2/util/argo"
 	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/glob"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/helm"
+	"github.com/argoproj/argo-cd/v2/util/helmfile"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	argojsonnet "github.com/argoproj/argo-cd/v2/util/jsonnet"
 	"github.com/argoproj/argo-cd/v2/util/ksonnet"
-	argokube "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
+	"github.com/argoproj/argo-cd/v2/util/manifestpolicy"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+	"github.com/argoproj/argo-cd/v2/util/sandbox"
 	"github.com/argoproj/argo-cd/v2/util/security"
+	"github.com/argoproj/argo-cd/v2/util/sops"
 	"github.com/argoproj/argo-cd/v2/util/text"
+	"github.com/argoproj/argo-cd/v2/util/ytt"
 )
 
 const (
@@ -58,18 +72,26 @@ const (
 	helmDepUpMarkerFile            = ".argocd-helm-dep-up"
 	allowConcurrencyFile           = ".argocd-allow-concurrency"
 	repoSourceFile                 = ".argocd-source.yaml"
+	ignoreFile                     = ".argocdignore"
 	appSourceFile                  = ".argocd-source-%s.yaml"
 	ociPrefix                      = "oci://"
+	helmGitDependencyPrefix        = "git+"
+	helmGitDependencyDir           = "helm-git-dependencies-"
+	jsonnetLibRepositoryDir        = "jsonnet-lib-repositories"
+	jsonnetLibCacheDir             = "jsonnet-libs"
 )
 
 // Service implements ManifestService interface
 type Service struct {
 	repoLock                  *repositoryLock
 	cache                     *reposervercache.Cache
-	parallelismLimitSemaphore *semaphore.Weighted
+	parallelismLimitSemaphore *prioritySemaphore
 	metricsServer             *metrics.MetricsServer
 	newGitClient              func(rawRepoURL string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds oci.Creds, proxy string) oci.Client
+	helmDependencyCache       *helm.DependencyCache
+	jsonnetVendorCache        *argojsonnet.VendorCache
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -80,32 +102,113 @@ type RepoServerInitConstants struct {
 	PauseGenerationAfterFailedGenerationAttempts int
 	PauseGenerationOnFailureForMinutes           int
 	PauseGenerationOnFailureForRequests          int
+	// HelmDependencyCacheDir is the directory of the chart archive cache shared by all applications, the cache is disabled if empty
+	HelmDependencyCacheDir string
+	// HelmDependencyCacheMaxSize is the maximum size in bytes of the chart archive cache
+	HelmDependencyCacheMaxSize int64
+	// JsonnetVendorCacheDir is the directory of the jsonnet-bundler dependency cache shared by all applications, the
+	// cache is disabled if empty
+	JsonnetVendorCacheDir string
+	// JsonnetNativeFunctions is the list of native functions available to Jsonnet files
+	JsonnetNativeFunctions []string
+	// JsonnetImportPaths is the list of directories outside of the repository Jsonnet files are allowed to import
+	// files from
+	JsonnetImportPaths []string
+	// ManifestGenerationTimeout is the default maximum duration of the manifest generation, it is not limited if zero
+	ManifestGenerationTimeout time.Duration
+	// MaxManifestGenerationTimeout is the maximum manifest generation timeout applications can set, it is
+	// ManifestGenerationTimeout if zero
+	MaxManifestGenerationTimeout time.Duration
+	// FailOnDuplicateResources makes the manifest generation fail if a resource is generated more than once, instead of
+	// only reporting a warning
+	FailOnDuplicateResources bool
+	// PluginMaxOutputSize is the maximum size in bytes of the output of config management plugin commands, it is not
+	// limited if less than 1
+	PluginMaxOutputSize int64
+	// CloneCacheDir is the persistent directory of the clones of the repositories, which are reused after a restart.
+	// The clones are stored in the temp directory if empty
+	CloneCacheDir string
+	// CloneCacheMaxSize is the size in bytes of the clone cache above which the least recently used clones are evicted
+	CloneCacheMaxSize int64
+	// CloneCacheGCInterval is the interval at which the clone cache size is checked
+	CloneCacheGCInterval time.Duration
+	// KustomizeAllowedBuildOptions is the list of flags of `kustomize build` projects are allowed to enable
+	KustomizeAllowedBuildOptions []string
+	// AppDiscoveryMaxDepth is the maximum depth of the applications discovered by ListApps, it is not limited if less
+	// than 1
+	AppDiscoveryMaxDepth int
+	// AppDiscoveryExclusions is the list of glob patterns of the directories which are not walked by ListApps
+	AppDiscoveryExclusions []string
+	// Sandbox restricts the commands of the templating tools and plugins, they are not sandboxed if nil
+	Sandbox *sandbox.Sandbox
+	// SopsKeysDir is the directory holding the SOPS key sets used to decrypt SOPS encrypted files, one subdirectory per
+	// key set
+	SopsKeysDir string
 }
 
 // NewService returns a new instance of the Manifest service
 func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cache, initConstants RepoServerInitConstants) *Service {
-	var parallelismLimitSemaphore *semaphore.Weighted
+	var parallelismLimitSemaphore *prioritySemaphore
 	if initConstants.ParallelismLimit > 0 {
-		parallelismLimitSemaphore = semaphore.NewWeighted(initConstants.ParallelismLimit)
+		parallelismLimitSemaphore = newPrioritySemaphore(initConstants.ParallelismLimit)
+	}
+	var helmDependencyCache *helm.DependencyCache
+	if initConstants.HelmDependencyCacheDir != "" {
+		helmDependencyCache = helm.NewDependencyCache(initConstants.HelmDependencyCacheDir, initConstants.HelmDependencyCacheMaxSize)
+	}
+	var jsonnetVendorCache *argojsonnet.VendorCache
+	if initConstants.JsonnetVendorCacheDir != "" {
+		jsonnetVendorCache = argojsonnet.NewVendorCache(initConstants.JsonnetVendorCacheDir)
 	}
 	repoLock := NewRepositoryLock()
+	newGitClient := git.NewClient
+	if initConstants.CloneCacheDir != "" {
+		cloneCache := newCloneCache(initConstants.CloneCacheDir, initConstants.CloneCacheMaxSize, repoLock)
+		if err := cloneCache.validate(); err != nil {
+			log.Warnf("Failed to validate the clone cache %s: %v", initConstants.CloneCacheDir, err)
+		}
+		if initConstants.CloneCacheGCInterval > 0 {
+			go cloneCache.run(initConstants.CloneCacheGCInterval)
+		}
+		newGitClient = func(rawRepoURL string, creds git.Creds, insecure bool, enableLfs bool, proxy string, opts ...git.ClientOpts) (git.Client, error) {
+			cloneCache.touch(rawRepoURL)
+			return git.NewClientExt(rawRepoURL, cloneCache.path(rawRepoURL), creds, insecure, enableLfs, proxy, opts...)
+		}
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
 		cache:                     cache,
 		metricsServer:             metricsServer,
-		newGitClient:              git.NewClient,
+		newGitClient:              newGitClient,
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, opts...)
 		},
-		initConstants: initConstants,
-		now:           time.Now,
+		newOCIClient: func(repoURL string, creds oci.Creds, proxy string) oci.Client {
+			return oci.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), proxy)
+		},
+		helmDependencyCache: helmDependencyCache,
+		jsonnetVendorCache:  jsonnetVendorCache,
+		initConstants:       initConstants,
+		now:                 time.Now,
 	}
 }
 
 // List a subset of the refs (currently, branches and tags) of a git repo
 func (s *Service) ListRefs(ctx context.Context, q *apiclient.ListRefsRequest) (*apiclient.Refs, error) {
-	gitClient, err := s.newClient(q.Repo)
+	if q.Offset < 0 || q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+	var re *regexp.Regexp
+	if q.Regex != "" {
+		var err error
+		re, err = regexp.Compile(q.Regex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid regex '%s': %v", q.Regex, err)
+		}
+	}
+
+	gitClient, err := s.newClient(q.Repo, git.WithCache(s.cache, true))
 	if err != nil {
 		return nil, err
 	}
@@ -118,17 +221,47 @@ func (s *Service) ListRefs(ctx context.Context, q *apiclient.ListRefsRequest) (*
 		return nil, err
 	}
 
+	branches := filterRefs(refs.Branches, q.Prefix, re)
+	tags := filterRefs(refs.Tags, q.Prefix, re)
 	res := apiclient.Refs{
-		Branches: refs.Branches,
-		Tags:     refs.Tags,
+		Branches:      paginateRefs(branches, q.Offset, q.Limit),
+		Tags:          paginateRefs(tags, q.Offset, q.Limit),
+		BranchesCount: int64(len(branches)),
+		TagsCount:     int64(len(tags)),
 	}
 
 	return &res, nil
 }
 
+// filterRefs returns the refs starting with the given prefix and matching the given regular expression, if not nil
+func filterRefs(refs []string, prefix string, re *regexp.Regexp) []string {
+	if prefix == "" && re == nil {
+		return refs
+	}
+	res := []string{}
+	for _, ref := range refs {
+		if strings.HasPrefix(ref, prefix) && (re == nil || re.MatchString(ref)) {
+			res = append(res, ref)
+		}
+	}
+	return res
+}
+
+// paginateRefs returns at most limit refs, starting at the given offset. There is no limit if it is zero
+func paginateRefs(refs []string, offset int64, limit int64) []string {
+	if offset >= int64(len(refs)) {
+		return []string{}
+	}
+	refs = refs[offset:]
+	if limit > 0 && limit < int64(len(refs)) {
+		refs = refs[:limit]
+	}
+	return refs
+}
+
 // ListApps lists the contents of a GitHub repo
 func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*apiclient.AppList, error) {
-	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	gitClient, commitSHA, err := s.newClientResolveRevision(q.Repo, q.Revision, git.WithCache(s.cache, true))
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +282,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	}
 
 	defer io.Close(closer)
-	apps, err := discovery.Discover(gitClient.Root())
+	apps, err := discovery.Discover(gitClient.Root(), discovery.WithMaxDepth(s.initConstants.AppDiscoveryMaxDepth), discovery.WithExclusions(s.initConstants.AppDiscoveryExclusions))
 	if err != nil {
 		return nil, err
 	}
@@ -162,10 +295,11 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 }
 
 type operationSettings struct {
-	sem             *semaphore.Weighted
-	noCache         bool
-	noRevisionCache bool
-	allowConcurrent bool
+	sem                        *prioritySemaphore
+	noCache                    bool
+	noRevisionCache            bool
+	allowConcurrent            bool
+	chartSignatureVerification *helm.SignatureVerification
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -185,7 +319,7 @@ type operationContext struct {
 // the calling function (for example, 'runManifestGen')
 type operationContextSrc = func() (*operationContext, error)
 
-// runRepoOperation downloads either git folder or helm chart and executes specified operation
+// runRepoOperation downloads either git folder, helm chart or OCI artifact and executes specified operation
 // - Returns a value from the cache if present (by calling getCached(...)); if no value is present, the
 // provide operation(...) is called. The specific return type of this function is determined by the
 // calling function, via the provided  getCached(...) and operation(...) function.
@@ -201,13 +335,36 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
+	cacheKey := revision
+	var chartDigest string
 	if source.IsHelm() {
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 		if err != nil {
 			return err
 		}
+		cacheKey = revision
+		// the chart version is resolved to a digest once, the chart is then verified and pulled by digest, so that
+		// the verified chart is the one rendered even if the tag is pushed again in between
+		if settings.chartSignatureVerification != nil {
+			chartDigest, err = helmClient.ResolveChartDigest(source.Chart, revision)
+			if err != nil {
+				return err
+			}
+			verificationHash, err := settings.chartSignatureVerification.Hash()
+			if err != nil {
+				return err
+			}
+			// the manifests are cached by digest and accepted signatures, so that the chart is verified again if either changes
+			cacheKey = fmt.Sprintf("%s@%s|%s", revision, chartDigest, verificationHash)
+		}
+	} else if source.IsOCI() {
+		ociClient, revision, err = s.newOCIClientResolveRevision(repo, revision)
+		if err != nil {
+			return err
+		}
 	} else {
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache))
 		if err != nil {
@@ -216,7 +373,7 @@ func (s *Service) runRepoOperation(
 	}
 
 	if !settings.noCache {
-		if ok, err := cacheFn(revision, true); ok {
+		if ok, err := cacheFn(cacheKey, true); ok {
 			return err
 		}
 	}
@@ -225,28 +382,56 @@ func (s *Service) runRepoOperation(
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
 	if settings.sem != nil {
-		err = settings.sem.Acquire(ctx, 1)
+		err = settings.sem.Acquire(ctx, apiclient.RequestPriorityFromContext(ctx))
 		if err != nil {
 			return err
 		}
-		defer settings.sem.Release(1)
+		defer settings.sem.Release()
 	}
 
 	if source.IsHelm() {
+		chartVersion := revision
+		if chartDigest != "" {
+			err = helmClient.VerifyChartSignature(source.Chart, chartDigest, settings.chartSignatureVerification)
+			if err != nil {
+				return err
+			}
+			chartVersion = chartDigest
+		}
 		if settings.noCache {
-			err = helmClient.CleanChartCache(source.Chart, revision)
+			err = helmClient.CleanChartCache(source.Chart, chartVersion)
 			if err != nil {
 				return err
 			}
 		}
-		chartPath, closer, err := helmClient.ExtractChart(source.Chart, revision)
+		chartPath, closer, err := helmClient.ExtractChart(source.Chart, chartVersion)
 		if err != nil {
 			return err
 		}
 		defer io.Close(closer)
-		return operation(chartPath, revision, revision, func() (*operationContext, error) {
+		return operation(chartPath, revision, cacheKey, func() (*operationContext, error) {
 			return &operationContext{chartPath, ""}, nil
 		})
+	} else if source.IsOCI() {
+		if settings.noCache {
+			err = ociClient.CleanCache(revision)
+			if err != nil {
+				return err
+			}
+		}
+		artifactPath, closer, err := ociClient.Extract(revision)
+		if err != nil {
+			return err
+		}
+		defer io.Close(closer)
+		// Here revision is the digest of the artifact, so that manifests are regenerated if a tag is moved
+		return operation(artifactPath, revision, revision, func() (*operationContext, error) {
+			appPath, err := argopath.Path(artifactPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, ""}, nil
+		})
 	} else {
 		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() error {
 			return checkoutRevision(gitClient, revision)
@@ -289,25 +474,218 @@ func (s *Service) runRepoOperation(
 }
 
 func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
-	var res *apiclient.ManifestResponse
+	results, err := s.generateManifests(ctx, []*apiclient.ManifestRequest{q})
+	if err != nil {
+		return nil, err
+	}
+	return results[0].response, results[0].err
+}
+
+// manifestResult is the outcome of the manifest generation of a source
+type manifestResult struct {
+	response *apiclient.ManifestResponse
+	err      error
+}
+
+// generateManifests generates the manifests of sources of the same repository and revision from a single checkout, and
+// returns the results in the same order as the requests. Only the manifests which are not cached are generated.
+// Batches of several requests are only supported for git repositories.
+func (s *Service) generateManifests(ctx context.Context, requests []*apiclient.ManifestRequest) ([]manifestResult, error) {
+	results := make([]manifestResult, len(requests))
+	// pending are the indexes of the requests whose manifests are not cached
+	pending := make([]int, len(requests))
+	for i := range requests {
+		pending[i] = i
+	}
+
+	cacheFn := func(cacheKey string, firstInvocation bool) (bool, error) {
+		var notCached []int
+		for _, i := range pending {
+			if !requests[i].NoCache {
+				if ok, resp, err := s.getManifestCacheEntry(cacheKey, requests[i], firstInvocation); ok {
+					results[i] = manifestResult{resp, err}
+					continue
+				}
+			}
+			notCached = append(notCached, i)
+		}
+		pending = notCached
+		return len(pending) == 0, nil
+	}
+
+	operation := func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) error {
+		if len(requests) > 1 {
+			ctxSrc = batchOperationContextSrc(ctxSrc)
+		}
+		for _, i := range pending {
+			req := requests[i]
+			reqCtxSrc := ctxSrc
+			if len(requests) > 1 {
+				reqCtxSrc = func() (*operationContext, error) {
+					ctx, err := ctxSrc()
+					if err != nil {
+						return nil, err
+					}
+					appPath, err := argopath.Path(repoRoot, req.ApplicationSource.Path)
+					if err != nil {
+						return nil, err
+					}
+					if !req.VerifySignature {
+						return &operationContext{appPath, ""}, nil
+					}
+					return &operationContext{appPath, ctx.verificationResult}, nil
+				}
+			}
+			resp, err := s.runManifestGen(repoRoot, commitSHA, cacheKey, reqCtxSrc, req)
+			results[i] = manifestResult{resp, err}
+		}
+		return nil
+	}
+
+	first := requests[0]
+	settings := operationSettings{
+		sem:                        s.parallelismLimitSemaphore,
+		noCache:                    true,
+		allowConcurrent:            true,
+		chartSignatureVerification: first.ChartSignatureVerification.GetHelmVerification(),
+	}
+	verifyCommit := false
+	for _, req := range requests {
+		settings.noCache = settings.noCache && req.NoCache
+		settings.noRevisionCache = settings.noRevisionCache || req.NoCache || req.NoRevisionCache
+		settings.allowConcurrent = settings.allowConcurrent && req.ApplicationSource.AllowsConcurrentProcessing()
+		verifyCommit = verifyCommit || req.VerifySignature
+	}
+	source := first.ApplicationSource
+	if len(requests) > 1 {
+		// the path of each source is resolved by the operation
+		source = source.DeepCopy()
+		source.Path = ""
+	}
+
+	err := s.runRepoOperation(ctx, first.Revision, first.Repo, source, verifyCommit, cacheFn, operation, settings)
+	if err != nil {
+		return nil, err
+	}
+	// the policy is checked after the cache lookup, so that the cached manifests comply with the current policy too
+	for i, req := range requests {
+		if results[i].err == nil && req.ManifestPolicy != nil {
+			if err := checkManifestPolicy(req.ManifestPolicy, results[i].response); err != nil {
+				results[i] = manifestResult{err: err}
+			}
+		}
+	}
+	return results, nil
+}
+
+// checkManifestPolicy returns an error if the generated manifests violate the given manifest policy
+func checkManifestPolicy(policy *v1alpha1.ManifestPolicy, res *apiclient.ManifestResponse) error {
+	objs := make([]*unstructured.Unstructured, 0, len(res.Manifests))
+	for _, manifest := range res.Manifests {
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(manifest), &obj); err != nil {
+			return status.Errorf(codes.Internal, "failed to parse the generated manifests: %v", err)
+		}
+		objs = append(objs, &obj)
+	}
+	if err := manifestpolicy.Check(policy, objs); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+// batchOperationContextSrc returns an operation context source which calls ctxSrc at most once, so that the signature
+// of the commit is verified once for all the sources of a batch
+func batchOperationContextSrc(ctxSrc operationContextSrc) operationContextSrc {
+	var ctx *operationContext
 	var err error
+	called := false
+	return func() (*operationContext, error) {
+		if !called {
+			ctx, err = ctxSrc()
+			called = true
+		}
+		return ctx, err
+	}
+}
 
+// WarmManifestCache generates the manifests of the application into the manifest cache, e.g. when a webhook reports a
+// push, so that the next refresh of the application controller hits the cache. The revision is resolved bypassing the
+// revision cache, which refreshes the cached git references too. The manifests are not returned.
+func (s *Service) WarmManifestCache(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.WarmManifestCacheResponse, error) {
+	warmReq := *q
+	warmReq.NoCache = false
+	warmReq.NoRevisionCache = true
+
+	res := &apiclient.WarmManifestCacheResponse{}
 	cacheFn := func(cacheKey string, firstInvocation bool) (bool, error) {
-		ok, resp, err := s.getManifestCacheEntry(cacheKey, q, firstInvocation)
-		res = resp
+		ok, _, err := s.getManifestCacheEntry(cacheKey, &warmReq, firstInvocation)
+		if ok {
+			res.Revision = cacheKey
+			res.Cached = true
+		}
 		return ok, err
 	}
 
 	operation := func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) error {
-		res, err = s.runManifestGen(repoRoot, commitSHA, cacheKey, ctxSrc, q)
+		_, err := s.runManifestGen(repoRoot, commitSHA, cacheKey, ctxSrc, &warmReq)
+		res.Revision = cacheKey
 		return err
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{
+		sem:                        s.parallelismLimitSemaphore,
+		noRevisionCache:            true,
+		allowConcurrent:            warmReq.ApplicationSource.AllowsConcurrentProcessing(),
+		chartSignatureVerification: warmReq.ChartSignatureVerification.GetHelmVerification(),
+	}
 
-	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings)
+	if err := s.runRepoOperation(ctx, warmReq.Revision, warmReq.Repo, warmReq.ApplicationSource, warmReq.VerifySignature, cacheFn, operation, settings); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
 
-	return res, err
+// GenerateManifests generates the manifests of several sources of the same git repository and revision, e.g. the
+// applications of a monorepo which are refreshed after a push, from a single checkout. The revision is resolved and
+// checked out once, and the manifests of the sources which are not cached are generated one after the other while the
+// checkout is locked. The failure of a source is reported in its result and does not fail the other sources.
+func (s *Service) GenerateManifests(ctx context.Context, q *apiclient.ManifestsRequest) (*apiclient.ManifestsResponse, error) {
+	if len(q.Requests) == 0 {
+		return &apiclient.ManifestsResponse{}, nil
+	}
+	first := q.Requests[0]
+	if first.Repo == nil || first.ApplicationSource == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "repository and source are required")
+	}
+	revision := textutils.FirstNonEmpty(first.Revision, first.ApplicationSource.TargetRevision)
+	for _, req := range q.Requests {
+		if req.Repo == nil || req.ApplicationSource == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "repository and source are required")
+		}
+		if git.NormalizeGitURL(req.Repo.Repo) != git.NormalizeGitURL(first.Repo.Repo) {
+			return nil, status.Errorf(codes.InvalidArgument, "all the sources must belong to repository %s", first.Repo.Repo)
+		}
+		if textutils.FirstNonEmpty(req.Revision, req.ApplicationSource.TargetRevision) != revision {
+			return nil, status.Errorf(codes.InvalidArgument, "all the sources must target revision %s", revision)
+		}
+		if len(q.Requests) > 1 && (req.ApplicationSource.IsHelm() || req.ApplicationSource.IsOCI()) {
+			return nil, status.Errorf(codes.InvalidArgument, "only the sources of git repositories can be generated in a batch")
+		}
+	}
+
+	results, err := s.generateManifests(ctx, q.Requests)
+	if err != nil {
+		return nil, err
+	}
+	res := &apiclient.ManifestsResponse{Results: make([]*apiclient.ManifestResult, len(results))}
+	for i, result := range results {
+		res.Results[i] = &apiclient.ManifestResult{Response: result.response}
+		if result.err != nil {
+			res.Results[i] = &apiclient.ManifestResult{Error: result.err.Error(), Code: uint32(status.Code(result.err))}
+		}
+	}
+	return res, nil
 }
 
 // runManifestGen will be called by runRepoOperation if:
@@ -319,7 +697,7 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 	var manifestGenResult *apiclient.ManifestResponse
 	ctx, err := ctxSrc()
 	if err == nil {
-		manifestGenResult, err = GenerateManifests(ctx.appPath, repoRoot, commitSHA, q, false)
+		manifestGenResult, err = s.generateManifestsWithTimeout(ctx.appPath, repoRoot, commitSHA, q)
 	}
 	if err != nil {
 
@@ -329,7 +707,7 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName, innerRes)
+			cacheErr := s.cache.GetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, innerRes)
 			if cacheErr != nil && cacheErr != reposervercache.ErrCacheMiss {
 				log.Warnf("manifest cache set error %s: %v", q.ApplicationSource.String(), cacheErr)
 				return nil, cacheErr
@@ -343,8 +721,11 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
+			if innerRes.NumberOfConsecutiveFailures == s.initConstants.PauseGenerationAfterFailedGenerationAttempts {
+				s.metricsServer.IncManifestGenerationPause(q.Repo.Repo, metrics.ManifestGenerationPauseEventEnter)
+			}
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName, innerRes)
+			cacheErr = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, innerRes)
 			if cacheErr != nil {
 				log.Warnf("manifest cache set error %s: %v", q.ApplicationSource.String(), cacheErr)
 				return nil, cacheErr
@@ -363,13 +744,71 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = ctx.verificationResult
-	err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName, &manifestGenCacheEntry)
+	err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, &manifestGenCacheEntry)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 	}
 	return manifestGenCacheEntry.ManifestResponse, nil
 }
 
+// generateManifestOpts returns the options of GenerateManifests which are configured on the repo server
+func (s *Service) generateManifestOpts() []GenerateManifestOpt {
+	importPaths := s.initConstants.JsonnetImportPaths
+	if s.initConstants.JsonnetVendorCacheDir != "" {
+		// cached dependencies are linked into the vendor directory of the application
+		importPaths = append(append([]string{}, importPaths...), s.initConstants.JsonnetVendorCacheDir)
+	}
+	return []GenerateManifestOpt{
+		WithHelmDependencyCache(s.helmDependencyCache),
+		WithJsonnetVendorCache(s.jsonnetVendorCache),
+		WithJsonnetNativeFunctions(s.initConstants.JsonnetNativeFunctions),
+		WithJsonnetImportPaths(importPaths),
+		WithFailOnDuplicateResources(s.initConstants.FailOnDuplicateResources),
+		WithPluginMaxOutputSize(s.initConstants.PluginMaxOutputSize),
+		WithKustomizeAllowedBuildOptions(s.initConstants.KustomizeAllowedBuildOptions),
+		WithSandbox(s.initConstants.Sandbox),
+		WithSopsKeysDir(s.initConstants.SopsKeysDir),
+	}
+}
+
+// manifestGenerationTimeout returns the maximum duration of the manifest generation of the given request, the
+// manifest generation is not limited if zero. The timeout set by the application is lowered to the maximum configured
+// by the administrator, so that applications cannot hold the workers of the repo server for longer.
+func (s *Service) manifestGenerationTimeout(q *apiclient.ManifestRequest) time.Duration {
+	timeout := s.initConstants.ManifestGenerationTimeout
+	if q.ManifestGenerationTimeoutSeconds > 0 {
+		timeout = time.Duration(q.ManifestGenerationTimeoutSeconds) * time.Second
+	}
+	maxTimeout := s.initConstants.MaxManifestGenerationTimeout
+	if maxTimeout <= 0 {
+		maxTimeout = s.initConstants.ManifestGenerationTimeout
+	}
+	if maxTimeout > 0 && (timeout <= 0 || timeout > maxTimeout) {
+		timeout = maxTimeout
+	}
+	return timeout
+}
+
+// generateManifestsWithTimeout generates the manifests, and gives up once the manifest generation timeout is exceeded.
+// The commands still running are killed then, and the manifest generation returns before the repository is unlocked,
+// so that the checkout is not modified under a running command.
+func (s *Service) generateManifestsWithTimeout(appPath, repoRoot, commitSHA string, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	timeout := s.manifestGenerationTimeout(q)
+	if timeout <= 0 {
+		return GenerateManifests(appPath, repoRoot, commitSHA, q, false, s.generateManifestOpts()...)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := GenerateManifests(appPath, repoRoot, commitSHA, q, false, append(s.generateManifestOpts(), WithContext(ctx))...)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		s.metricsServer.IncManifestGenerationTimeout(q.Repo.Repo)
+		log.Warnf("manifest generation of application %s timed out after %v", q.AppName, timeout)
+		return nil, status.Errorf(codes.DeadlineExceeded, "manifest generation timed out after %v", timeout)
+	}
+	return res, err
+}
+
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, e.g.:
 // - If the cache result is empty for the requested key
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (e.g. res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
@@ -378,7 +817,7 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 // If true is returned, either the second or third parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
 func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRequest, firstInvocation bool) (bool, *apiclient.ManifestResponse, error) {
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName, &res)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, &res)
 	if err == nil {
 
 		// The cache contains an existing value
@@ -397,11 +836,13 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 					// After X minutes, reset the cache and retry the operation (e.g. perhaps the error is ephemeral and has passed)
 					if elapsedTimeInMinutes >= s.initConstants.PauseGenerationOnFailureForMinutes {
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
 						log.Infof("manifest error cache hit and reset: %s/%s", q.ApplicationSource.String(), cacheKey)
+						s.metricsServer.IncManifestGenerationPause(q.Repo.Repo, metrics.ManifestGenerationPauseEventExit)
+						s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultMiss)
 						return false, nil, nil
 					}
 				}
@@ -411,11 +852,13 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 
 					if res.NumberOfCachedResponsesReturned >= s.initConstants.PauseGenerationOnFailureForRequests {
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
 						log.Infof("manifest error cache hit and reset: %s/%s", q.ApplicationSource.String(), cacheKey)
+						s.metricsServer.IncManifestGenerationPause(q.Repo.Repo, metrics.ManifestGenerationPauseEventExit)
+						s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultMiss)
 						return false, nil, nil
 					}
 				}
@@ -425,11 +868,12 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 
 				cachedErrorResponse := fmt.Errorf(cachedManifestGenerationPrefix+": %s", res.MostRecentError)
 
+				s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultCachedError)
 				if firstInvocation {
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.AppLabelKey, q.AppName, &res)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, &res)
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -442,10 +886,12 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 			// Otherwise we are not yet in the manifest generation error state, and not enough consecutive errors have
 			// yet occurred to put us in that state.
 			log.Infof("manifest error cache miss: %s/%s", q.ApplicationSource.String(), cacheKey)
+			s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultMiss)
 			return false, res.ManifestResponse, nil
 		}
 
 		log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
+		s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultHit)
 		return true, res.ManifestResponse, nil
 	}
 
@@ -454,10 +900,31 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 	} else {
 		log.Infof("manifest cache miss: %s/%s", q.ApplicationSource.String(), cacheKey)
 	}
+	s.incManifestCacheRequest(q, firstInvocation, metrics.ManifestCacheResultMiss)
 
 	return false, nil, nil
 }
 
+// incManifestCacheRequest counts the first manifest cache lookup of a request. The lookup done again once the
+// repository is locked is not counted, so that each request is counted once.
+func (s *Service) incManifestCacheRequest(q *apiclient.ManifestRequest, firstInvocation bool, result metrics.ManifestCacheResult) {
+	if firstInvocation {
+		s.metricsServer.IncManifestCacheRequest(q.Repo.Repo, result)
+	}
+}
+
+// getHelmBinary returns the helm version and the path of the helm binary used to render the source. The options resolved
+// by the settings take precedence over the version of the source, the binary of the version is used if the path is empty.
+func getHelmBinary(source *v1alpha1.ApplicationSource, helmOptions *v1alpha1.HelmOptions) (string, string) {
+	if helmOptions != nil {
+		return helmOptions.Version, helmOptions.BinaryPath
+	}
+	if source.Helm != nil {
+		return source.Helm.Version, ""
+	}
+	return "", ""
+}
+
 func getHelmRepos(repositories []*v1alpha1.Repository) []helm.HelmRepository {
 	repos := make([]helm.HelmRepository, 0)
 	for _, repo := range repositories {
@@ -471,11 +938,81 @@ type dependencies struct {
 }
 
 type repositories struct {
+	Name       string `yaml:"name"`
+	Version    string `yaml:"version"`
 	Repository string `yaml:"repository"`
+	Alias      string `yaml:"alias"`
 }
 
-func getHelmDependencyRepos(appPath string) ([]*v1alpha1.Repository, error) {
-	repos := make([]*v1alpha1.Repository, 0)
+// helmChartMetadata is the metadata of a chart declared in Chart.yaml
+type helmChartMetadata struct {
+	Name         string         `yaml:"name"`
+	Version      string         `yaml:"version"`
+	AppVersion   string         `yaml:"appVersion"`
+	Description  string         `yaml:"description"`
+	Dependencies []repositories `yaml:"dependencies"`
+}
+
+// populateHelmChartMetadata sets the chart metadata of the app details from Chart.yaml, and the dependencies with
+// their locked versions from Chart.lock (or requirements.yaml and requirements.lock for charts of Helm 2)
+func populateHelmChartMetadata(res *apiclient.HelmAppSpec, appPath string) error {
+	data, err := ioutil.ReadFile(filepath.Join(appPath, "Chart.yaml"))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	chart := &helmChartMetadata{}
+	if err := yaml.Unmarshal(data, chart); err != nil {
+		return err
+	}
+	deps := chart.Dependencies
+	if len(deps) == 0 {
+		data, err := ioutil.ReadFile(filepath.Join(appPath, "requirements.yaml"))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		} else if err == nil {
+			d := &dependencies{}
+			if err := yaml.Unmarshal(data, d); err != nil {
+				return err
+			}
+			deps = d.Dependencies
+		}
+	}
+	var locked []repositories
+	for _, lockFile := range []string{"Chart.lock", "requirements.lock"} {
+		data, err := ioutil.ReadFile(filepath.Join(appPath, lockFile))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		d := &dependencies{}
+		if err := yaml.Unmarshal(data, d); err != nil {
+			return err
+		}
+		locked = d.Dependencies
+		break
+	}
+
+	res.Name = chart.Name
+	res.ChartVersion = chart.Version
+	res.AppVersion = chart.AppVersion
+	res.Description = chart.Description
+	for _, d := range deps {
+		dep := &apiclient.HelmChartDependency{Name: d.Name, Version: d.Version, Repository: d.Repository, Alias: d.Alias}
+		for _, l := range locked {
+			if l.Name == d.Name && l.Repository == d.Repository {
+				dep.LockedVersion = l.Version
+				break
+			}
+		}
+		res.Dependencies = append(res.Dependencies, dep)
+	}
+	return nil
+}
+
+func getHelmDependencies(appPath string) ([]repositories, error) {
 	f, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", appPath, "Chart.yaml"))
 	if err != nil {
 		return nil, err
@@ -485,8 +1022,17 @@ func getHelmDependencyRepos(appPath string) ([]*v1alpha1.Repository, error) {
 	if err = yaml.Unmarshal(f, d); err != nil {
 		return nil, err
 	}
+	return d.Dependencies, nil
+}
+
+func getHelmDependencyRepos(appPath string) ([]*v1alpha1.Repository, error) {
+	repos := make([]*v1alpha1.Repository, 0)
+	deps, err := getHelmDependencies(appPath)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, r := range d.Dependencies {
+	for _, r := range deps {
 		if u, err := url.Parse(r.Repository); err == nil && (u.Scheme == "https" || u.Scheme == "oci") {
 			repo := &v1alpha1.Repository{
 				Repo: r.Repository,
@@ -508,6 +1054,263 @@ func repoExists(repo string, repos []*v1alpha1.Repository) bool {
 	return false
 }
 
+// helmGitDependency is a chart dependency hosted in a Git repository. It is declared in Chart.yaml using the
+// helm-git plugin notation: git+<repo URL>@<chart path>?ref=<revision>
+type helmGitDependency struct {
+	name     string
+	repoURL  string
+	path     string
+	revision string
+}
+
+// parseHelmGitDependency parses the repository field of a chart dependency. Returns nil if the dependency
+// is not hosted in a Git repository.
+func parseHelmGitDependency(name string, repository string) (*helmGitDependency, error) {
+	if !strings.HasPrefix(repository, helmGitDependencyPrefix) {
+		return nil, nil
+	}
+	dep := &helmGitDependency{name: name}
+	rawURL := strings.TrimPrefix(repository, helmGitDependencyPrefix)
+	if i := strings.Index(rawURL, "?"); i >= 0 {
+		query, err := url.ParseQuery(rawURL[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid git dependency '%s': %v", repository, err)
+		}
+		dep.revision = query.Get("ref")
+		rawURL = rawURL[:i]
+	}
+	// the chart path is separated by the last '@' which is not part of the user info (e.g. git@github.com)
+	hostStart := 0
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		hostStart = i + len("://")
+	}
+	pathStart := strings.Index(rawURL[hostStart:], "/")
+	sep := strings.LastIndex(rawURL, "@")
+	if pathStart < 0 || sep < hostStart+pathStart {
+		return nil, fmt.Errorf("invalid git dependency '%s': chart path is missing", repository)
+	}
+	dep.repoURL = rawURL[:sep]
+	dep.path = strings.Trim(rawURL[sep+1:], "/")
+	if dep.path == "" {
+		return nil, fmt.Errorf("invalid git dependency '%s': chart path is missing", repository)
+	}
+	// local repositories, e.g. file:// URLs, would give access to the files of the repo server
+	if isSSH, _ := git.IsSSHURL(dep.repoURL); !isSSH && !git.IsHTTPSURL(dep.repoURL) && !git.IsHTTPURL(dep.repoURL) {
+		return nil, fmt.Errorf("invalid git dependency '%s': repository URL must be an HTTP(S) or SSH URL", repository)
+	}
+	return dep, nil
+}
+
+// getHelmGitDependencyRepo returns the repository a Git chart dependency is cloned from, with its credentials. The
+// repository must be the one of the application, or be configured in Argo CD and permitted by the project.
+func getHelmGitDependencyRepo(repoURL string, q *apiclient.ManifestRequest) (*v1alpha1.Repository, error) {
+	for _, r := range append([]*v1alpha1.Repository{q.Repo}, q.HelmGitDependencyRepos...) {
+		if r != nil && git.SameURL(r.Repo, repoURL) {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("repository %s is not configured or not permitted by the project", repoURL)
+}
+
+// getGitDependencyRepo returns the repository used to clone a Jsonnet library, using the credentials of a matching
+// configured repository or credential template.
+func getGitDependencyRepo(repoURL string, q *apiclient.ManifestRequest) *v1alpha1.Repository {
+	repo := &v1alpha1.Repository{Repo: repoURL}
+	for _, r := range append(append([]*v1alpha1.Repository{q.Repo}, q.Repos...), q.JsonnetLibRepos...) {
+		if r != nil && git.SameURL(r.Repo, repoURL) {
+			repo.CopyCredentialsFromRepo(r)
+			repo.CopySettingsFrom(r)
+			repo.Proxy = r.Proxy
+			return repo
+		}
+	}
+	repo.CopyCredentialsFrom(getRepoCredential(q.HelmRepoCreds, repoURL))
+	return repo
+}
+
+// vendorHelmGitDependencies copies chart dependencies hosted in Git repositories into the charts/ directory of
+// the chart, so that they are available to `helm template` and `helm dependency build`. Dependencies referenced
+// using file:// must not point outside of the repository.
+func vendorHelmGitDependencies(appPath string, repoRoot string, q *apiclient.ManifestRequest) error {
+	deps, err := getHelmDependencies(appPath)
+	if err != nil {
+		return err
+	}
+
+	chartsDir := path.Join(appPath, "charts")
+	manifestGenerateLock.Lock(chartsDir)
+	defer manifestGenerateLock.Unlock(chartsDir)
+
+	for _, d := range deps {
+		if strings.HasPrefix(d.Repository, "file://") {
+			absRepoPath, err := filepath.Abs(repoRoot)
+			if err != nil {
+				return err
+			}
+			absAppPath, err := filepath.Abs(appPath)
+			if err != nil {
+				return err
+			}
+			if _, err := security.EnforceToCurrentRoot(absRepoPath, filepath.Join(absAppPath, strings.TrimPrefix(d.Repository, "file://"))); err != nil {
+				return fmt.Errorf("dependency '%s' points outside of the repository: %v", d.Name, err)
+			}
+			continue
+		}
+		dep, err := parseHelmGitDependency(d.Name, d.Repository)
+		if err != nil {
+			return err
+		}
+		if dep == nil {
+			continue
+		}
+		dest := path.Join(chartsDir, dep.name)
+		if _, err := os.Stat(dest); err == nil {
+			continue
+		}
+		if err := vendorHelmGitDependency(dep, dest, q); err != nil {
+			return fmt.Errorf("failed to vendor dependency '%s' from %s: %v", dep.name, dep.repoURL, err)
+		}
+	}
+	return nil
+}
+
+func vendorHelmGitDependency(dep *helmGitDependency, dest string, q *apiclient.ManifestRequest) error {
+	repo, err := getHelmGitDependencyRepo(dep.repoURL, q)
+	if err != nil {
+		return err
+	}
+	dir, err := getHelmGitDependencyDir()
+	if err != nil {
+		return err
+	}
+	root := filepath.Join(dir, regexp.MustCompile("(/|:)").ReplaceAllString(git.NormalizeGitURL(repo.Repo), "_"))
+	gitClient, err := git.NewClientExt(repo.Repo, root, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, submoduleOpts(repo)...)
+	if err != nil {
+		return err
+	}
+
+	manifestGenerateLock.Lock(root)
+	defer manifestGenerateLock.Unlock(root)
+
+	if err := checkoutRevision(gitClient, dep.revision); err != nil {
+		return err
+	}
+	chartPath, err := argopath.Path(gitClient.Root(), dep.path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(dest), 0755); err != nil {
+		return err
+	}
+	_, err = executil.Run(exec.Command("cp", "-r", chartPath, dest))
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(path.Join(dest, ".git"))
+}
+
+var (
+	helmGitDependencyDirLock gosync.Mutex
+	// helmGitDependencyDirPath is the directory of the clones of the repositories of the Git chart dependencies
+	helmGitDependencyDirPath string
+)
+
+// getHelmGitDependencyDir returns the directory of the clones of the repositories of the Git chart dependencies. The
+// directory is created with an unpredictable name on first use, like the one of the Jsonnet libraries.
+func getHelmGitDependencyDir() (string, error) {
+	helmGitDependencyDirLock.Lock()
+	defer helmGitDependencyDirLock.Unlock()
+	if helmGitDependencyDirPath == "" {
+		dir, err := os.MkdirTemp("", helmGitDependencyDir)
+		if err != nil {
+			return "", err
+		}
+		helmGitDependencyDirPath = dir
+	}
+	return helmGitDependencyDirPath, nil
+}
+
+var (
+	jsonnetLibDirLock gosync.Mutex
+	// jsonnetLibDir is the directory of the clones of the repositories of the Jsonnet libraries and of jsonnetLibCache
+	jsonnetLibDir string
+	// jsonnetLibCache holds the Jsonnet library directories of other repositories, by commit SHA
+	jsonnetLibCache *argojsonnet.LibCache
+)
+
+// getJsonnetLibDir returns the directory of the clones of the repositories of the Jsonnet libraries and the library
+// cache. The directory is created with an unpredictable name on first use, so that it cannot be prepared in advance by
+// other processes of the host.
+func getJsonnetLibDir() (string, *argojsonnet.LibCache, error) {
+	jsonnetLibDirLock.Lock()
+	defer jsonnetLibDirLock.Unlock()
+	if jsonnetLibDir == "" {
+		dir, err := os.MkdirTemp("", "jsonnet-libs-")
+		if err != nil {
+			return "", nil, err
+		}
+		jsonnetLibDir = dir
+		jsonnetLibCache = argojsonnet.NewLibCache(filepath.Join(dir, jsonnetLibCacheDir))
+	}
+	return jsonnetLibDir, jsonnetLibCache, nil
+}
+
+// fetchJsonnetLibs copies the Jsonnet library directories of other repositories into the library cache, and returns
+// their paths by library
+func fetchJsonnetLibs(libs []string, q *apiclient.ManifestRequest) (map[string]string, error) {
+	paths := map[string]string{}
+	for _, l := range libs {
+		if !argojsonnet.IsRemoteLib(l) {
+			continue
+		}
+		lib, err := argojsonnet.ParseRemoteLib(l)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		libPath, err := fetchJsonnetLib(lib, q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch jsonnet library '%s': %v", l, err)
+		}
+		paths[l] = libPath
+	}
+	return paths, nil
+}
+
+func fetchJsonnetLib(lib *argojsonnet.RemoteLib, q *apiclient.ManifestRequest) (string, error) {
+	dir, libCache, err := getJsonnetLibDir()
+	if err != nil {
+		return "", err
+	}
+	repo := getGitDependencyRepo(lib.RepoURL, q)
+	root := filepath.Join(dir, jsonnetLibRepositoryDir, regexp.MustCompile("(/|:)").ReplaceAllString(git.NormalizeGitURL(lib.RepoURL), "_"))
+	gitClient, err := git.NewClientExt(repo.Repo, root, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, submoduleOpts(repo)...)
+	if err != nil {
+		return "", err
+	}
+	commitSHA, err := gitClient.LsRemote(lib.Revision)
+	if err != nil {
+		return "", err
+	}
+	resolved := *lib
+	resolved.Revision = commitSHA
+	return libCache.Install(&resolved, func(dest string) error {
+		manifestGenerateLock.Lock(root)
+		defer manifestGenerateLock.Unlock(root)
+
+		if err := checkoutRevision(gitClient, commitSHA); err != nil {
+			return err
+		}
+		libPath, err := argopath.Path(gitClient.Root(), lib.Path)
+		if err != nil {
+			return err
+		}
+		if _, err := executil.Run(exec.Command("cp", "-r", libPath+"/.", dest)); err != nil {
+			return err
+		}
+		return os.RemoveAll(filepath.Join(dest, ".git"))
+	})
+}
+
 func isConcurrencyAllowed(appPath string) bool {
 	if _, err := os.Stat(path.Join(appPath, allowConcurrencyFile)); err == nil {
 		return true
@@ -521,7 +1324,7 @@ var manifestGenerateLock = sync.NewKeyLock()
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+func runHelmBuild(appPath string, h helm.Helm, depCache *helm.DependencyCache, repos []*v1alpha1.Repository) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -536,38 +1339,184 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 		return err
 	}
 
+	err = buildHelmDependencies(appPath, h, depCache, repos)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(markerFile, []byte("marker"), 0644)
+}
+
+// installJsonnetDependencies runs jsonnet-bundler in a given path and ensures that it is executed only once if multiple
+// threads are trying to run it. The vendor directory is removed when the repository is re-initialized.
+func installJsonnetDependencies(appPath, repoRoot string, vendorCache *argojsonnet.VendorCache, runner *executil.Runner) error {
+	manifestGenerateLock.Lock(appPath)
+	defer manifestGenerateLock.Unlock(appPath)
+	return argojsonnet.InstallDependencies(appPath, repoRoot, vendorCache, runner)
+}
+
+// getLockedHelmDependencies returns the chart dependencies downloaded from remote repositories, with their exact
+// versions taken from Chart.lock (or requirements.lock) if present. The second return value is false if the exact
+// version of at least one remote dependency could not be determined.
+func getLockedHelmDependencies(appPath string) ([]repositories, bool, error) {
+	var deps []repositories
+	lockFound := false
+	for _, lockFile := range []string{"Chart.lock", "requirements.lock"} {
+		data, err := ioutil.ReadFile(path.Join(appPath, lockFile))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, false, err
+		}
+		d := &dependencies{}
+		if err = yaml.Unmarshal(data, d); err != nil {
+			return nil, false, err
+		}
+		deps = d.Dependencies
+		lockFound = true
+		break
+	}
+	if !lockFound {
+		var err error
+		if deps, err = getHelmDependencies(appPath); err != nil {
+			return nil, false, err
+		}
+	}
+
+	complete := true
+	var res []repositories
+	for _, d := range deps {
+		if d.Repository == "" || strings.HasPrefix(d.Repository, "file://") || strings.HasPrefix(d.Repository, helmGitDependencyPrefix) {
+			continue
+		}
+		u, err := url.Parse(d.Repository)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "oci") || !helm.IsVersion(d.Version) {
+			complete = false
+			continue
+		}
+		res = append(res, d)
+	}
+	return res, complete, nil
+}
+
+// getHelmDependencyCreds returns the credentials of the repository a chart dependency is downloaded from, which is
+// referenced either by URL or by name
+func getHelmDependencyCreds(repository string, repos []*v1alpha1.Repository) helm.Creds {
+	name := strings.TrimPrefix(strings.TrimPrefix(repository, "@"), "alias:")
+	for _, r := range repos {
+		if r.Name == name || strings.TrimPrefix(repository, ociPrefix) == strings.TrimPrefix(r.Repo, ociPrefix) {
+			return r.GetHelmCreds()
+		}
+	}
+	return helm.Creds{}
+}
+
+// buildHelmDependencies restores chart dependencies from the shared dependency cache and runs
+// `helm dependency build` only if some of them are not cached. Downloaded chart archives are added to the cache,
+// keyed by the credentials of their repository so that they are only shared with the applications having them.
+func buildHelmDependencies(appPath string, h helm.Helm, depCache *helm.DependencyCache, repos []*v1alpha1.Repository) error {
+	if depCache == nil {
+		return h.DependencyBuild()
+	}
+	deps, complete, err := getLockedHelmDependencies(appPath)
+	if err != nil {
+		return err
+	}
+	chartsDir := path.Join(appPath, "charts")
+	for _, d := range deps {
+		ok, err := depCache.Get(d.Repository, getHelmDependencyCreds(d.Repository, repos), d.Name, d.Version, chartsDir)
+		if err != nil {
+			log.Warnf("helm dependency cache error %s/%s: %v", d.Repository, d.Name, err)
+		}
+		complete = complete && ok
+	}
+	if complete {
+		log.Infof("helm dependency cache hit: %s", appPath)
+		return nil
+	}
+
 	err = h.DependencyBuild()
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(markerFile, []byte("marker"), 0644)
+	for _, d := range deps {
+		archive := path.Join(chartsDir, fmt.Sprintf("%s-%s.tgz", d.Name, d.Version))
+		if _, err := os.Stat(archive); err != nil {
+			continue
+		}
+		if err := depCache.Put(d.Repository, getHelmDependencyCreds(d.Repository, repos), d.Name, d.Version, archive); err != nil {
+			log.Warnf("helm dependency cache set error %s/%s: %v", d.Repository, d.Name, err)
+		}
+	}
+	return nil
+}
+
+// getKubeVersionAndAPIVersions returns the Kubernetes version and API versions the manifests are generated for, which
+// are the ones of the destination cluster unless the application source overrides them
+func getKubeVersionAndAPIVersions(q *apiclient.ManifestRequest) (string, []string) {
+	kubeVersion, apiVersions := q.KubeVersion, q.ApiVersions
+	if q.ApplicationSource.KubeVersion != "" {
+		kubeVersion = q.ApplicationSource.KubeVersion
+	}
+	if len(q.ApplicationSource.APIVersions) > 0 {
+		apiVersions = q.ApplicationSource.APIVersions
+	}
+	return kubeVersion, apiVersions
+}
+
+// decryptValuesFile writes the decrypted content of the given values file to a temporary file if it is encrypted by
+// SOPS, and returns the path of the temporary file. An empty path is returned if the file is not encrypted.
+func decryptValuesFile(decrypter *sops.Decrypter, runner *executil.Runner, path string) (string, error) {
+	encrypted, err := sops.IsFileEncrypted(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !encrypted {
+		return "", nil
+	}
+	data, err := decrypter.Decrypt(runner, path)
+	if err != nil {
+		return "", err
+	}
+	file, err := ioutil.TempFile("", "values-*"+filepath.Ext(path))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+	if _, err := file.Write(data); err != nil {
+		_ = os.Remove(file.Name())
+		return "", err
+	}
+	return file.Name(), nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool) ([]*unstructured.Unstructured, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, opt *generateManifestOpt) ([]*unstructured.Unstructured, error) {
 	concurrencyAllowed := isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
 		defer manifestGenerateLock.Unlock(appPath)
 	}
 
+	kubeVersion, apiVersions := getKubeVersionAndAPIVersions(q)
 	templateOpts := &helm.TemplateOpts{
 		Name:        q.AppName,
 		Namespace:   q.Namespace,
-		KubeVersion: text.SemVer(q.KubeVersion),
-		APIVersions: q.ApiVersions,
+		KubeVersion: text.SemVer(kubeVersion),
+		APIVersions: apiVersions,
 		Set:         map[string]string{},
 		SetString:   map[string]string{},
 		SetFile:     map[string]string{},
 	}
+	// the temporary values files, which helm needs to access in addition to the repository
+	var valuesFiles []string
 
 	appHelm := q.ApplicationSource.Helm
-	var version string
+	version, binaryPath := getHelmBinary(q.ApplicationSource, q.HelmOptions)
 	if appHelm != nil {
-		if appHelm.Version != "" {
-			version = appHelm.Version
-		}
 		if appHelm.ReleaseName != "" {
-			templateOpts.Name = appHelm.ReleaseName
+			templateOpts.Name = env.Envsubst(appHelm.ReleaseName)
 		}
 
 		for _, val := range appHelm.ValueFiles {
@@ -594,6 +1543,18 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 				if err != nil {
 					return nil, err
 				}
+
+				if opt.sopsDecrypter != nil {
+					decrypted, err := decryptValuesFile(opt.sopsDecrypter, opt.runner("sops", repoRoot, appPath), path)
+					if err != nil {
+						return nil, err
+					}
+					if decrypted != "" {
+						defer func() { _ = os.Remove(decrypted) }()
+						valuesFiles = append(valuesFiles, decrypted)
+						val = decrypted
+					}
+				}
 			}
 			templateOpts.Values = append(templateOpts.Values, val)
 		}
@@ -610,6 +1571,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 				return nil, err
 			}
 			defer file.Close()
+			valuesFiles = append(valuesFiles, p)
 			templateOpts.Values = append(templateOpts.Values, p)
 		}
 
@@ -627,6 +1589,9 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	if templateOpts.Name == "" {
 		templateOpts.Name = q.AppName
 	}
+	if len(templateOpts.Name) > helmMaxReleaseNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Helm release name %q is %d characters long, which exceeds the maximum of %d", templateOpts.Name, len(templateOpts.Name), helmMaxReleaseNameLength)
+	}
 	for i, j := range templateOpts.Set {
 		templateOpts.Set[i] = env.Envsubst(j)
 	}
@@ -642,6 +1607,11 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		return nil, err
 	}
 
+	err = vendorHelmGitDependencies(appPath, repoRoot, q)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, r := range repos {
 		if !repoExists(r.Repo, q.Repos) {
 			repositoryCredential := getRepoCredential(q.HelmRepoCreds, r.Repo)
@@ -652,6 +1622,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 				r.SSHPrivateKey = repositoryCredential.SSHPrivateKey
 				r.TLSClientCertData = repositoryCredential.TLSClientCertData
 				r.TLSClientCertKey = repositoryCredential.TLSClientCertKey
+				r.AuthProvider = repositoryCredential.AuthProvider
 			}
 			q.Repos = append(q.Repos, r)
 		}
@@ -662,7 +1633,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		proxy = q.Repo.Proxy
 	}
 
-	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), isLocal, version, proxy)
+	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), isLocal, version, proxy, binaryPath, opt.runner("helm", append([]string{repoRoot, appPath}, valuesFiles...)...))
 	if err != nil {
 		return nil, err
 	}
@@ -680,9 +1651,9 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		}
 
 		if concurrencyAllowed {
-			err = runHelmBuild(appPath, h)
+			err = runHelmBuild(appPath, h, opt.helmDependencyCache, q.Repos)
 		} else {
-			err = h.DependencyBuild()
+			err = buildHelmDependencies(appPath, h, opt.helmDependencyCache, q.Repos)
 		}
 
 		if err != nil {
@@ -694,7 +1665,49 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, err
 		}
 	}
-	return kube.SplitYAML([]byte(out))
+	objs, err := kube.SplitYAML([]byte(out))
+	if err != nil {
+		return nil, err
+	}
+	if err := validateResourceNameLengths(objs); err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// helmMaxReleaseNameLength is the maximum length of Helm release names, so that the names derived from it fit in DNS
+// labels
+const helmMaxReleaseNameLength = 53
+
+// dnsLabelNameKinds are the kinds of the core group whose names must be DNS labels
+var dnsLabelNameKinds = map[string]bool{
+	"Namespace":             true,
+	"Service":               true,
+	"ReplicationController": true,
+}
+
+// validateResourceNameLengths returns an error if the name or a label value of a resource exceeds the maximum length
+// accepted by Kubernetes, so that the error is reported when generating the manifests rather than when syncing them
+func validateResourceNameLengths(objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		gvk := obj.GroupVersionKind()
+		maxLength := validation.DNS1123SubdomainMaxLength
+		if gvk.Group == "" && dnsLabelNameKinds[gvk.Kind] {
+			maxLength = validation.DNS1123LabelMaxLength
+		}
+		if name := obj.GetName(); len(name) > maxLength {
+			return status.Errorf(codes.InvalidArgument, "name of %s %q is %d characters long, which exceeds the maximum of %d", gvk.Kind, name, len(name), maxLength)
+		}
+		for key, value := range obj.GetLabels() {
+			if len(value) > validation.LabelValueMaxLength {
+				return status.Errorf(codes.InvalidArgument, "value of label %q of %s %q is %d characters long, which exceeds the maximum of %d", key, gvk.Kind, obj.GetName(), len(value), validation.LabelValueMaxLength)
+			}
+		}
+	}
+	return nil
 }
 
 func getRepoCredential(repoCredentials []*v1alpha1.RepoCreds, repoURL string) *v1alpha1.RepoCreds {
@@ -707,12 +1720,139 @@ func getRepoCredential(repoCredentials []*v1alpha1.RepoCreds, repoURL string) *v
 	return nil
 }
 
+type generateManifestOpt struct {
+	helmDependencyCache *helm.DependencyCache
+	jsonnetVendorCache  *argojsonnet.VendorCache
+	// jsonnetNativeFunctions are the names of the native functions available to Jsonnet files
+	jsonnetNativeFunctions []string
+	// jsonnetImportPaths are the directories outside of the repository Jsonnet files can import from
+	jsonnetImportPaths []string
+	// jsonnetLibPaths are the directories of the Jsonnet libraries of other repositories, by library
+	jsonnetLibPaths map[string]string
+	// failOnDuplicateResources makes the manifest generation fail if a resource is generated more than once
+	failOnDuplicateResources bool
+	// pluginMaxOutputSize is the maximum size in bytes of the output of config management plugin commands
+	pluginMaxOutputSize int64
+	// kustomizeAllowedBuildOptions are the flags of `kustomize build` projects are allowed to enable
+	kustomizeAllowedBuildOptions []string
+	// sandbox restricts the commands of the templating tools and plugins, they are not sandboxed if nil
+	sandbox *sandbox.Sandbox
+	// sopsKeysDir is the directory holding the SOPS key sets
+	sopsKeysDir string
+	// sopsDecrypter decrypts the SOPS encrypted values files and manifests with the key set of the request, they are not
+	// decrypted if nil
+	sopsDecrypter *sops.Decrypter
+	// ctx is the context of the manifest generation, the commands still running once it is done are killed
+	ctx context.Context
+}
+
+// runner returns the runner of the commands of the given templating tool or plugin, sandboxed if the sandbox is
+// enabled. The commands can access the given files and directories, e.g. the checkout of the repository.
+func (o *generateManifestOpt) runner(tool string, paths ...string) *executil.Runner {
+	return o.sandbox.Runner(executil.NewRunner(o.ctx), tool, paths...)
+}
+
+// GenerateManifestOpt is an option of GenerateManifests
+type GenerateManifestOpt func(*generateManifestOpt)
+
+// WithHelmDependencyCache sets the chart archive cache used to build Helm chart dependencies
+func WithHelmDependencyCache(depCache *helm.DependencyCache) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependencyCache = depCache
+	}
+}
+
+// WithJsonnetVendorCache sets the cache of dependencies installed by jsonnet-bundler
+func WithJsonnetVendorCache(vendorCache *argojsonnet.VendorCache) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetVendorCache = vendorCache
+	}
+}
+
+// WithJsonnetNativeFunctions sets the native functions available to Jsonnet files
+func WithJsonnetNativeFunctions(names []string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetNativeFunctions = names
+	}
+}
+
+// WithJsonnetImportPaths sets the directories outside of the repository Jsonnet files can import from. They are also
+// added to the library paths.
+func WithJsonnetImportPaths(paths []string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.jsonnetImportPaths = paths
+	}
+}
+
+// WithFailOnDuplicateResources sets whether the manifest generation fails if a resource is generated more than once.
+// Otherwise the duplicates are reported as warnings.
+func WithFailOnDuplicateResources(fail bool) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.failOnDuplicateResources = fail
+	}
+}
+
+// WithPluginMaxOutputSize sets the maximum size in bytes of the output of config management plugin commands. The output
+// is not limited if less than 1.
+func WithPluginMaxOutputSize(size int64) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.pluginMaxOutputSize = size
+	}
+}
+
+// WithKustomizeAllowedBuildOptions sets the flags of `kustomize build` projects are allowed to enable
+func WithKustomizeAllowedBuildOptions(flags []string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.kustomizeAllowedBuildOptions = flags
+	}
+}
+
+// WithSandbox sets the sandbox of the commands of the templating tools and plugins
+func WithSandbox(s *sandbox.Sandbox) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.sandbox = s
+	}
+}
+
+// WithSopsKeysDir sets the directory holding the SOPS key sets the SOPS encrypted values files and manifests are
+// decrypted with
+func WithSopsKeysDir(dir string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.sopsKeysDir = dir
+	}
+}
+
+// WithContext sets the context of the manifest generation, the commands still running once it is done are killed
+func WithContext(ctx context.Context) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.ctx = ctx
+	}
+}
+
 // GenerateManifests generates manifests from a path
-func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool) (*apiclient.ManifestResponse, error) {
+func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, opts ...GenerateManifestOpt) (*apiclient.ManifestResponse, error) {
 	var targetObjs []*unstructured.Unstructured
 	var dest *v1alpha1.ApplicationDestination
+	var warnings []string
+
+	opt := &generateManifestOpt{}
+	for i := range opts {
+		opts[i](opt)
+	}
+	if q.SopsKeySet != "" {
+		// the files are only decrypted with the key set of the project of the application
+		decrypter, err := sops.NewDecrypter(opt.sopsKeysDir, q.SopsKeySet)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		opt.sopsDecrypter = decrypter
+	}
 
-	appSourceType, err := GetAppSourceType(q.ApplicationSource, appPath, q.AppName)
+	// the commands generating the manifests only have access to the files of the application repository
+	runner := func(tool string) *executil.Runner {
+		return opt.runner(tool, repoRoot, appPath)
+	}
+	appSourceType, err := GetAppSourceType(q.ApplicationSource, appPath, q.AppName, q.Plugins, runner(""))
 	if err != nil {
 		return nil, err
 	}
@@ -724,30 +1864,47 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeKsonnet:
-		targetObjs, dest, err = ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet)
+		targetObjs, dest, err = ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet, runner("ksonnet"))
 	case v1alpha1.ApplicationSourceTypeHelm:
-		targetObjs, err = helmTemplate(appPath, repoRoot, env, q, isLocal)
+		targetObjs, err = helmTemplate(appPath, repoRoot, env, q, isLocal, opt)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		kustomizeBinary := ""
 		if q.KustomizeOptions != nil {
 			kustomizeBinary = q.KustomizeOptions.BinaryPath
+			if err := kustomize.ValidateBuildOptions(q.KustomizeOptions.ProjectBuildOptions, opt.kustomizeAllowedBuildOptions); err != nil {
+				return nil, err
+			}
 		}
-		k := kustomize.NewKustomizeApp(appPath, q.Repo.GetGitCreds(), repoURL, kustomizeBinary)
+		k := kustomize.NewKustomizeApp(appPath, q.Repo.GetGitCreds(), repoURL, kustomizeBinary, runner("kustomize"))
 		targetObjs, _, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions)
 	case v1alpha1.ApplicationSourceTypePlugin:
-		targetObjs, err = runConfigManagementPlugin(appPath, env, q, q.Repo.GetGitCreds())
+		targetObjs, err = runConfigManagementPlugin(appPath, env, q, q.Repo.GetGitCreds(), opt.pluginMaxOutputSize, runner)
 	case v1alpha1.ApplicationSourceTypeDirectory:
 		var directory *v1alpha1.ApplicationSourceDirectory
 		if directory = q.ApplicationSource.Directory; directory == nil {
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
-		targetObjs, err = findManifests(appPath, repoRoot, env, *directory)
+		if argojsonnet.IsBundlerProject(appPath) {
+			err = installJsonnetDependencies(appPath, repoRoot, opt.jsonnetVendorCache, runner("jb"))
+			if err != nil {
+				return nil, err
+			}
+		}
+		opt.jsonnetLibPaths, err = fetchJsonnetLibs(directory.Jsonnet.Libs, q)
+		if err != nil {
+			return nil, err
+		}
+		targetObjs, warnings, err = findManifests(appPath, repoRoot, env, *directory, opt)
+	case v1alpha1.ApplicationSourceTypeYtt:
+		targetObjs, err = yttTemplate(appPath, repoRoot, env, q.ApplicationSource.Ytt, runner("ytt"))
+	case v1alpha1.ApplicationSourceTypeHelmfile:
+		targetObjs, err = helmfileTemplate(appPath, env, q.ApplicationSource.Helmfile, runner("helmfile"))
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	manifests := make([]string, 0)
+	var generated []generatedManifest
 	for _, obj := range targetObjs {
 		var targets []*unstructured.Unstructured
 		if obj.IsList() {
@@ -770,7 +1927,7 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 
 		for _, target := range targets {
 			if q.AppLabelKey != "" && q.AppName != "" && !kube.IsCRD(target) {
-				err = argokube.SetAppInstanceLabel(target, q.AppLabelKey, q.AppName)
+				err = argo.SetAppInstance(target, q.AppLabelKey, q.AppName, q.Namespace, argo.ParseTrackingMethod(q.TrackingMethod))
 				if err != nil {
 					return nil, err
 				}
@@ -779,13 +1936,24 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 			if err != nil {
 				return nil, err
 			}
-			manifests = append(manifests, string(manifestStr))
+			if warning := deprecatedAPIWarning(target); warning != "" {
+				warnings = append(warnings, warning)
+			}
+			generated = append(generated, generatedManifest{key: kube.GetResourceKey(target), manifest: string(manifestStr)})
 		}
 	}
+	duplicates := duplicateManifestWarnings(generated)
+	if opt.failOnDuplicateResources && len(duplicates) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", strings.Join(duplicates, "; "))
+	}
+	warnings = append(warnings, duplicates...)
+	manifests := sortManifests(generated)
 
 	res := apiclient.ManifestResponse{
-		Manifests:  manifests,
-		SourceType: string(appSourceType),
+		Manifests:   manifests,
+		SourceType:  string(appSourceType),
+		ContentHash: manifestsContentHash(manifests),
+		Warnings:    warnings,
 	}
 	if dest != nil {
 		res.Namespace = dest.Namespace
@@ -794,6 +1962,69 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 	return &res, nil
 }
 
+type generatedManifest struct {
+	key      kube.ResourceKey
+	manifest string
+}
+
+// duplicateManifestWarnings returns a warning for each resource which is generated more than once
+func duplicateManifestWarnings(generated []generatedManifest) []string {
+	counts := map[kube.ResourceKey]int{}
+	var keys []kube.ResourceKey
+	for _, m := range generated {
+		if m.key.Name == "" {
+			continue
+		}
+		if counts[m.key] == 0 {
+			keys = append(keys, m.key)
+		}
+		counts[m.key]++
+	}
+	var warnings []string
+	for _, key := range keys {
+		if counts[key] > 1 {
+			warnings = append(warnings, fmt.Sprintf("Resource %s appeared %d times among generated manifests", key.String(), counts[key]))
+		}
+	}
+	return warnings
+}
+
+// sortManifests orders the manifests by group, kind, namespace and name, and then by content, so that the generated
+// manifests don't depend on the order in which the config management tool emitted them
+func sortManifests(generated []generatedManifest) []string {
+	sort.Slice(generated, func(i, j int) bool {
+		a, b := generated[i].key, generated[j].key
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return generated[i].manifest < generated[j].manifest
+	})
+	manifests := make([]string, len(generated))
+	for i := range generated {
+		manifests[i] = generated[i].manifest
+	}
+	return manifests
+}
+
+// manifestsContentHash returns the SHA-256 hash of the sorted manifests
+func manifestsContentHash(manifests []string) string {
+	h := sha256.New()
+	for _, manifest := range manifests {
+		_, _ = h.Write([]byte(manifest))
+		_, _ = h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func newEnv(q *apiclient.ManifestRequest, revision string) *v1alpha1.Env {
 	return &v1alpha1.Env{
 		&v1alpha1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: q.AppName},
@@ -863,8 +2094,10 @@ func mergeSourceParameters(source *v1alpha1.ApplicationSource, path, appName str
 	return nil
 }
 
-// GetAppSourceType returns explicit application source type or examines a directory and determines its application source type
-func GetAppSourceType(source *v1alpha1.ApplicationSource, path, appName string) (v1alpha1.ApplicationSourceType, error) {
+// GetAppSourceType returns explicit application source type or examines a directory and determines its application source type.
+// If the directory matches the discovery rules of a config management plugin, the plugin is set in the source.
+// The plugin discovery commands are run by the given runner.
+func GetAppSourceType(source *v1alpha1.ApplicationSource, path, appName string, plugins []*v1alpha1.ConfigManagementPlugin, runner *executil.Runner) (v1alpha1.ApplicationSourceType, error) {
 	err := mergeSourceParameters(source, path, appName)
 	if err != nil {
 		return "", fmt.Errorf("error while parsing source parameters: %v", err)
@@ -877,6 +2110,14 @@ func GetAppSourceType(source *v1alpha1.ApplicationSource, path, appName string)
 	if appSourceType != nil {
 		return *appSourceType, nil
 	}
+	pluginName, err := discovery.DiscoverPlugin(path, plugins, append(os.Environ(), "ARGOCD_APP_NAME="+appName), runner)
+	if err != nil {
+		return "", err
+	}
+	if pluginName != "" {
+		source.Plugin = &v1alpha1.ApplicationSourcePlugin{Name: pluginName}
+		return v1alpha1.ApplicationSourceTypePlugin, nil
+	}
 	appType, err := discovery.AppType(path)
 	if err != nil {
 		return "", err
@@ -905,8 +2146,8 @@ func isNullList(obj *unstructured.Unstructured) bool {
 }
 
 // ksShow runs `ks show` in an app directory after setting any component parameter overrides
-func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSourceKsonnet) ([]*unstructured.Unstructured, *v1alpha1.ApplicationDestination, error) {
-	ksApp, err := ksonnet.NewKsonnetApp(appPath)
+func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSourceKsonnet, runner *executil.Runner) ([]*unstructured.Unstructured, *v1alpha1.ApplicationDestination, error) {
+	ksApp, err := ksonnet.NewKsonnetApp(appPath, runner)
 	if err != nil {
 		return nil, nil, status.Errorf(codes.FailedPrecondition, "unable to load application from %s: %v", appPath, err)
 	}
@@ -938,28 +2179,63 @@ func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSource
 
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
+// readIgnoreFile parses the .argocdignore file of the given directory, which uses the gitignore syntax. Returns nil
+// if the directory has no such file.
+func readIgnoreFile(appPath string) (gitignore.Matcher, error) {
+	data, err := ioutil.ReadFile(filepath.Join(appPath, ignoreFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var patterns []gitignore.Pattern
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, nil))
+	}
+	return gitignore.NewMatcher(patterns), nil
+}
+
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
+// findManifests returns the resources found in the files of the given directory, and warnings about the files which
+// were ignored because they don't look like Kubernetes manifests
+func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, opt *generateManifestOpt) ([]*unstructured.Unstructured, []string, error) {
 	var objs []*unstructured.Unstructured
-	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
+	var warnings []string
+	ignored, err := readIgnoreFile(appPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(appPath, path)
 		if err != nil {
 			return err
 		}
 		if f.IsDir() {
 			if path != appPath && !directory.Recurse {
 				return filepath.SkipDir
+			} else if path == filepath.Join(appPath, argojsonnet.VendorDir) && argojsonnet.IsBundlerProject(appPath) {
+				// dependencies of jsonnet-bundler projects are only imported
+				return filepath.SkipDir
+			} else if path != appPath && ignored != nil && ignored.Match(strings.Split(relPath, string(filepath.Separator)), true) {
+				return filepath.SkipDir
 			} else {
 				return nil
 			}
 		}
 
-		if !manifestFile.MatchString(f.Name()) {
+		if !manifestFile.MatchString(f.Name()) || f.Name() == argojsonnet.JsonnetfileName || f.Name() == argojsonnet.LockfileName {
 			return nil
 		}
 
-		relPath, err := filepath.Rel(appPath, path)
-		if err != nil {
-			return err
+		if ignored != nil && ignored.Match(strings.Split(relPath, string(filepath.Separator)), false) {
+			return nil
 		}
 		if directory.Exclude != "" && glob.Match(directory.Exclude, relPath) {
 			return nil
@@ -970,7 +2246,7 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 		}
 
 		if strings.HasSuffix(f.Name(), ".jsonnet") {
-			vm, err := makeJsonnetVm(appPath, repoRoot, directory.Jsonnet, env)
+			vm, err := makeJsonnetVm(appPath, repoRoot, directory.Jsonnet, env, opt)
 			if err != nil {
 				return err
 			}
@@ -997,6 +2273,12 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 			if err != nil {
 				return err
 			}
+			if opt.sopsDecrypter != nil && sops.IsEncrypted(out) {
+				out, err = opt.sopsDecrypter.Decrypt(opt.runner("sops", repoRoot, appPath), path)
+				if err != nil {
+					return status.Errorf(codes.FailedPrecondition, "Failed to decrypt %q: %v", f.Name(), err)
+				}
+			}
 			if strings.HasSuffix(f.Name(), ".json") {
 				var obj unstructured.Unstructured
 				err = json.Unmarshal(out, &obj)
@@ -1005,6 +2287,9 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 				}
 				objs = append(objs, &obj)
 			} else {
+				if directory.Envsubst && env != nil {
+					out = []byte(env.Envsubst(string(out)))
+				}
 				yamlObjs, err := kube.SplitYAML(out)
 				if err != nil {
 					if len(yamlObjs) > 0 {
@@ -1020,6 +2305,7 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 						return status.Errorf(codes.FailedPrecondition, "Failed to unmarshal %q: %v", f.Name(), err)
 					}
 					// Otherwise, it might be a unrelated YAML file which we will ignore
+					warnings = append(warnings, fmt.Sprintf("Ignored %q since it could not be parsed as a Kubernetes manifest: %v", relPath, err))
 					return nil
 				}
 				objs = append(objs, yamlObjs...)
@@ -1028,14 +2314,40 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return objs, nil
+	return objs, warnings, nil
+}
+
+func yttTemplate(appPath string, repoRoot string, env *v1alpha1.Env, sourceYtt *v1alpha1.ApplicationSourceYtt, runner *executil.Runner) ([]*unstructured.Unstructured, error) {
+	var opts v1alpha1.ApplicationSourceYtt
+	if sourceYtt != nil {
+		opts = *sourceYtt.DeepCopy()
+	}
+	for i, v := range opts.DataValues {
+		opts.DataValues[i].Value = env.Envsubst(v.Value)
+	}
+	return ytt.NewYttApp(appPath, repoRoot, runner).Build(&opts)
+}
+
+func helmfileTemplate(appPath string, env *v1alpha1.Env, sourceHelmfile *v1alpha1.ApplicationSourceHelmfile, runner *executil.Runner) ([]*unstructured.Unstructured, error) {
+	var opts v1alpha1.ApplicationSourceHelmfile
+	if sourceHelmfile != nil {
+		opts = *sourceHelmfile.DeepCopy()
+	}
+	opts.Environment = env.Envsubst(opts.Environment)
+	for i, selector := range opts.Selectors {
+		opts.Selectors[i] = env.Envsubst(selector)
+	}
+	return helmfile.NewHelmfileApp(appPath, runner).Template(&opts, env.Environ())
 }
 
-func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
+func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env, opt *generateManifestOpt) (*jsonnet.VM, error) {
 
 	vm := jsonnet.MakeVM()
+	if err := argojsonnet.RegisterNativeFunctions(vm, opt.jsonnetNativeFunctions); err != nil {
+		return nil, err
+	}
 	for i, j := range sourceJsonnet.TLAs {
 		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
 	}
@@ -1059,29 +2371,42 @@ func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 
 	// Jsonnet Imports relative to the repository path
 	jpaths := []string{appPath}
+	if argojsonnet.IsBundlerProject(appPath) {
+		jpaths = append(jpaths, filepath.Join(appPath, argojsonnet.VendorDir))
+	}
+	allowedPaths := append([]string{repoRoot}, opt.jsonnetImportPaths...)
 	for _, p := range sourceJsonnet.Libs {
+		if argojsonnet.IsRemoteLib(p) {
+			libPath, ok := opt.jsonnetLibPaths[p]
+			if !ok {
+				return nil, status.Errorf(codes.FailedPrecondition, "%s: library of another repository is not available", p)
+			}
+			jpaths = append(jpaths, libPath)
+			allowedPaths = append(allowedPaths, libPath)
+			continue
+		}
 		jpath := path.Join(repoRoot, p)
 		if !strings.HasPrefix(jpath, repoRoot) {
 			return nil, status.Errorf(codes.FailedPrecondition, "%s: referenced library points outside the repository", p)
 		}
 		jpaths = append(jpaths, jpath)
 	}
+	jpaths = append(jpaths, opt.jsonnetImportPaths...)
 
-	vm.Importer(&jsonnet.FileImporter{
-		JPaths: jpaths,
-	})
+	// imports are restricted to the repository and the directories allowed by the administrator
+	vm.Importer(argojsonnet.NewRestrictedImporter(jpaths, allowedPaths))
 
 	return vm, nil
 }
 
-func runCommand(command v1alpha1.Command, path string, env []string) (string, error) {
+func runCommand(command v1alpha1.Command, path string, env []string, maxOutputSize int64, runner *executil.Runner) (string, error) {
 	if len(command.Command) == 0 {
 		return "", fmt.Errorf("Command is empty")
 	}
 	cmd := exec.Command(command.Command[0], append(command.Command[1:], command.Args...)...)
 	cmd.Env = env
 	cmd.Dir = path
-	return executil.Run(cmd)
+	return runner.RunWithOutputLimit(cmd, maxOutputSize)
 }
 
 func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alpha1.ConfigManagementPlugin {
@@ -1093,7 +2418,22 @@ func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alph
 	return nil
 }
 
-func runConfigManagementPlugin(appPath string, envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds git.Creds) ([]*unstructured.Unstructured, error) {
+// isPluginEnvAllowed returns whether applications can set the given environment variable for the plugin
+func isPluginEnvAllowed(plugin *v1alpha1.ConfigManagementPlugin, name string) bool {
+	if len(plugin.AllowedAppEnv) == 0 {
+		return true
+	}
+	for _, pattern := range plugin.AllowedAppEnv {
+		if glob.Match(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// runConfigManagementPlugin runs the commands of the plugin of the application source with the runner of the plugin
+// returned by the given function
+func runConfigManagementPlugin(appPath string, envVars *v1alpha1.Env, q *apiclient.ManifestRequest, creds git.Creds, maxOutputSize int64, pluginRunner func(name string) *executil.Runner) ([]*unstructured.Unstructured, error) {
 	concurrencyAllowed := isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
@@ -1105,6 +2445,7 @@ func runConfigManagementPlugin(appPath string, envVars *v1alpha1.Env, q *apiclie
 		return nil, fmt.Errorf("config management plugin with name '%s' is not supported", q.ApplicationSource.Plugin.Name)
 	}
 	env := append(os.Environ(), envVars.Environ()...)
+	runner := pluginRunner(plugin.Name)
 	if creds != nil {
 		closer, environ, err := creds.Environ()
 		if err != nil {
@@ -1112,9 +2453,11 @@ func runConfigManagementPlugin(appPath string, envVars *v1alpha1.Env, q *apiclie
 		}
 		defer func() { _ = closer.Close() }()
 		env = append(env, environ...)
+		runner = runner.WithPaths(git.CredsFiles(closer)...)
 	}
-	env = append(env, "KUBE_VERSION="+q.KubeVersion)
-	env = append(env, "KUBE_API_VERSIONS="+strings.Join(q.ApiVersions, ","))
+	kubeVersion, apiVersions := getKubeVersionAndAPIVersions(q)
+	env = append(env, "KUBE_VERSION="+kubeVersion)
+	env = append(env, "KUBE_API_VERSIONS="+strings.Join(apiVersions, ","))
 
 	parsedEnv := make(v1alpha1.Env, len(env))
 	for i, v := range env {
@@ -1127,17 +2470,22 @@ func runConfigManagementPlugin(appPath string, envVars *v1alpha1.Env, q *apiclie
 
 	pluginEnv := q.ApplicationSource.Plugin.Env
 	for i, j := range pluginEnv {
+		if !isPluginEnvAllowed(plugin, j.Name) {
+			return nil, status.Errorf(codes.FailedPrecondition, "environment variable '%s' is not allowed by config management plugin '%s'", j.Name, plugin.Name)
+		}
 		pluginEnv[i].Value = parsedEnv.Envsubst(j.Value)
 	}
 	env = append(env, pluginEnv.Environ()...)
+	// variables of the plugin definition, which might be resolved from secrets, have precedence over the application ones
+	env = append(env, plugin.Env.Environ()...)
 
 	if plugin.Init != nil {
-		_, err := runCommand(*plugin.Init, appPath, env)
+		_, err := runCommand(*plugin.Init, appPath, env, maxOutputSize, runner)
 		if err != nil {
 			return nil, err
 		}
 	}
-	out, err := runCommand(plugin.Generate, appPath, env)
+	out, err := runCommand(plugin.Generate, appPath, env, maxOutputSize, runner)
 	if err != nil {
 		return nil, err
 	}
@@ -1154,7 +2502,11 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 			return err
 		}
 
-		appSourceType, err := GetAppSourceType(q.Source, ctx.appPath, q.AppName)
+		// the commands only have access to the files of the application repository
+		runner := func(tool string) *executil.Runner {
+			return s.initConstants.Sandbox.Runner(nil, tool, repoRoot, ctx.appPath)
+		}
+		appSourceType, err := GetAppSourceType(q.Source, ctx.appPath, q.AppName, q.Plugins, runner(""))
 		if err != nil {
 			return err
 		}
@@ -1163,15 +2515,15 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 
 		switch appSourceType {
 		case v1alpha1.ApplicationSourceTypeKsonnet:
-			if err := populateKsonnetAppDetails(res, ctx.appPath, q); err != nil {
+			if err := populateKsonnetAppDetails(res, ctx.appPath, q, runner("ksonnet")); err != nil {
 				return err
 			}
 		case v1alpha1.ApplicationSourceTypeHelm:
-			if err := populateHelmAppDetails(res, ctx.appPath, q); err != nil {
+			if err := populateHelmAppDetails(res, ctx.appPath, q, runner("helm")); err != nil {
 				return err
 			}
 		case v1alpha1.ApplicationSourceTypeKustomize:
-			if err := populateKustomizeAppDetails(res, q, ctx.appPath); err != nil {
+			if err := populateKustomizeAppDetails(res, q, ctx.appPath, s.initConstants.KustomizeAllowedBuildOptions, runner("kustomize")); err != nil {
 				return err
 			}
 		}
@@ -1202,7 +2554,7 @@ func (s *Service) createGetAppDetailsCacheHandler(res *apiclient.RepoAppDetailsR
 	}
 }
 
-func populateKsonnetAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, q *apiclient.RepoServerAppDetailsQuery) error {
+func populateKsonnetAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, q *apiclient.RepoServerAppDetailsQuery, runner *executil.Runner) error {
 	var ksonnetAppSpec apiclient.KsonnetAppSpec
 	data, err := ioutil.ReadFile(filepath.Join(appPath, "app.yaml"))
 	if err != nil {
@@ -1212,7 +2564,7 @@ func populateKsonnetAppDetails(res *apiclient.RepoAppDetailsResponse, appPath st
 	if err != nil {
 		return err
 	}
-	ksApp, err := ksonnet.NewKsonnetApp(appPath)
+	ksApp, err := ksonnet.NewKsonnetApp(appPath, runner)
 	if err != nil {
 		return status.Errorf(codes.FailedPrecondition, "unable to load application from %s: %v", appPath, err)
 	}
@@ -1229,7 +2581,7 @@ func populateKsonnetAppDetails(res *apiclient.RepoAppDetailsResponse, appPath st
 	return nil
 }
 
-func populateHelmAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, q *apiclient.RepoServerAppDetailsQuery) error {
+func populateHelmAppDetails(res *apiclient.RepoAppDetailsResponse, appPath string, q *apiclient.RepoServerAppDetailsQuery, runner *executil.Runner) error {
 	var selectedValueFiles []string
 
 	if q.Source.Helm != nil {
@@ -1242,13 +2594,11 @@ func populateHelmAppDetails(res *apiclient.RepoAppDetailsResponse, appPath strin
 	}
 
 	res.Helm = &apiclient.HelmAppSpec{ValueFiles: availableValueFiles}
-	var version string
-	if q.Source.Helm != nil {
-		if q.Source.Helm.Version != "" {
-			version = q.Source.Helm.Version
-		}
+	if err := populateHelmChartMetadata(res.Helm, appPath); err != nil {
+		return err
 	}
-	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), false, version, q.Repo.Proxy)
+	version, binaryPath := getHelmBinary(q.Source, q.HelmOptions)
+	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), false, version, q.Repo.Proxy, binaryPath, runner)
 	if err != nil {
 		return err
 	}
@@ -1316,13 +2666,16 @@ func findHelmValueFilesInPath(path string) ([]string, error) {
 	return result, nil
 }
 
-func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apiclient.RepoServerAppDetailsQuery, appPath string) error {
+func populateKustomizeAppDetails(res *apiclient.RepoAppDetailsResponse, q *apiclient.RepoServerAppDetailsQuery, appPath string, allowedBuildOptions []string, runner *executil.Runner) error {
 	res.Kustomize = &apiclient.KustomizeAppSpec{}
 	kustomizeBinary := ""
 	if q.KustomizeOptions != nil {
 		kustomizeBinary = q.KustomizeOptions.BinaryPath
+		if err := kustomize.ValidateBuildOptions(q.KustomizeOptions.ProjectBuildOptions, allowedBuildOptions); err != nil {
+			return err
+		}
 	}
-	k := kustomize.NewKustomizeApp(appPath, q.Repo.GetGitCreds(), q.Repo.Repo, kustomizeBinary)
+	k := kustomize.NewKustomizeApp(appPath, q.Repo.GetGitCreds(), q.Repo.Repo, kustomizeBinary, runner)
 	_, images, err := k.Build(q.Source.Kustomize, q.KustomizeOptions)
 	if err != nil {
 		return err
@@ -1335,6 +2688,9 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	if !(git.IsCommitSHA(q.Revision) || git.IsTruncatedCommitSHA(q.Revision)) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
+	if q.PreviousRevision != "" && !(git.IsCommitSHA(q.PreviousRevision) || git.IsTruncatedCommitSHA(q.PreviousRevision)) {
+		return nil, fmt.Errorf("previous revision %s must be resolved", q.PreviousRevision)
+	}
 	metadata, err := s.cache.GetRevisionMetadata(q.Repo.Repo, q.Revision)
 	if err == nil {
 		// The logic here is that if a signature check on metadata is requested,
@@ -1344,6 +2700,9 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 		// that we return.
 		if q.CheckSignature && metadata.SignatureInfo == "" {
 			log.Infof("revision metadata cache hit, but need to regenerate due to missing signature info: %s/%s", q.Repo.Repo, q.Revision)
+		} else if q.PreviousRevision != "" {
+			// changed files depend on the previous revision, so they are not cached
+			log.Infof("revision metadata cache hit, but need to list changed files: %s/%s", q.Repo.Repo, q.Revision)
 		} else {
 			log.Infof("revision metadata cache hit: %s/%s", q.Repo.Repo, q.Revision)
 			if !q.CheckSignature {
@@ -1359,7 +2718,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 		}
 	}
 
-	gitClient, _, err := s.newClientResolveRevision(q.Repo, q.Revision)
+	gitClient, _, err := s.newClientResolveRevision(q.Repo, q.Revision, git.WithCache(s.cache, true))
 	if err != nil {
 		return nil, err
 	}
@@ -1405,9 +2764,38 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 
 	metadata = &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: m.Message, SignatureInfo: signatureInfo}
 	_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.Revision, metadata)
+
+	if q.PreviousRevision != "" {
+		metadata.ChangedFiles, err = gitClient.ChangedFiles(q.PreviousRevision, q.Revision)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return metadata, nil
 }
 
+// ResolveRevision resolves the revision of an application source to a commit SHA, chart version or OCI artifact digest
+func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
+	source := q.Source
+	if source == nil {
+		source = &v1alpha1.ApplicationSource{RepoURL: q.Repo.Repo}
+	}
+	ambiguousRevision := textutils.FirstNonEmpty(q.AmbiguousRevision, source.TargetRevision)
+	var revision string
+	var err error
+	if source.IsHelm() {
+		_, revision, err = s.newHelmClientResolveRevision(q.Repo, ambiguousRevision, source.Chart, q.NoRevisionCache)
+	} else if source.IsOCI() {
+		_, revision, err = s.newOCIClientResolveRevision(q.Repo, ambiguousRevision)
+	} else {
+		_, revision, err = s.newClientResolveRevision(q.Repo, ambiguousRevision, git.WithCache(s.cache, !q.NoRevisionCache))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &apiclient.ResolveRevisionResponse{Revision: revision, AmbiguousRevision: ambiguousRevision}, nil
+}
+
 func fileParameters(q *apiclient.RepoServerAppDetailsQuery) []v1alpha1.HelmFileParameter {
 	if q.Source.Helm == nil {
 		return nil
@@ -1417,9 +2805,22 @@ func fileParameters(q *apiclient.RepoServerAppDetailsQuery) []v1alpha1.HelmFileP
 
 func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (git.Client, error) {
 	opts = append(opts, git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)))
+	opts = append(opts, submoduleOpts(repo)...)
 	return s.newGitClient(repo.Repo, repo.GetGitCreds(), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, opts...)
 }
 
+// submoduleOpts returns the git client options which apply the submodule settings of the repository
+func submoduleOpts(repo *v1alpha1.Repository) []git.ClientOpts {
+	var opts []git.ClientOpts
+	if repo.EnableSubmodules != nil {
+		opts = append(opts, git.WithSubmodules(*repo.EnableSubmodules))
+	}
+	if repo.SubmodulesUseRepoCreds {
+		opts = append(opts, git.WithSubmodulesUseRepoCreds())
+	}
+	return opts
+}
+
 // newClientResolveRevision is a helper to perform the common task of instantiating a git client
 // and resolving a revision to a commit SHA
 func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision string, opts ...git.ClientOpts) (git.Client, string, error) {
@@ -1437,27 +2838,45 @@ func (s *Service) newClientResolveRevision(repo *v1alpha1.Repository, revision s
 func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
 	helmClient := s.newHelmClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy, helm.WithIndexCache(s.cache))
-	// OCI helm registers don't support semver ranges. Assuming that given revision is exact version
-	if helm.IsVersion(revision) || enableOCI {
+	if helm.IsVersion(revision) {
 		return helmClient, revision, nil
 	}
 	constraints, err := semver.NewConstraint(revision)
 	if err != nil {
 		return nil, "", fmt.Errorf("invalid revision '%s': %v", revision, err)
 	}
-	index, err := helmClient.GetIndex(noRevisionCache)
-	if err != nil {
-		return nil, "", err
+	var entries helm.Entries
+	if enableOCI {
+		// OCI registries have no index, so versions are taken from the tags of the chart repository
+		entries, err = helmClient.GetTags(chart)
+		if err != nil {
+			return nil, "", err
+		}
+	} else {
+		index, err := helmClient.GetIndex(noRevisionCache)
+		if err != nil {
+			return nil, "", err
+		}
+		entries, err = index.GetEntries(chart)
+		if err != nil {
+			return nil, "", err
+		}
 	}
-	entries, err := index.GetEntries(chart)
+	version, err := entries.MaxVersion(constraints)
 	if err != nil {
 		return nil, "", err
 	}
-	version, err := entries.MaxVersion(constraints)
+	return helmClient, version.String(), nil
+}
+
+// newOCIClientResolveRevision is a helper to instantiate an OCI client and resolve a tag to the artifact digest
+func (s *Service) newOCIClientResolveRevision(repo *v1alpha1.Repository, revision string) (oci.Client, string, error) {
+	ociClient := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy)
+	digest, err := ociClient.ResolveRevision(revision)
 	if err != nil {
 		return nil, "", err
 	}
-	return helmClient, version.String(), nil
+	return ociClient, digest, nil
 }
 
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
@@ -1494,21 +2913,47 @@ func checkoutRevision(gitClient git.Client, revision string) error {
 }
 
 func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
-	index, err := s.newHelmClient(q.Repo.Repo, q.Repo.GetHelmCreds(), q.Repo.EnableOCI, q.Repo.Proxy).GetIndex(true)
-	if err != nil {
-		return nil, err
+	if q.Offset < 0 || q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+	helmClient := s.newHelmClient(q.Repo.Repo, q.Repo.GetHelmCreds(), q.Repo.EnableOCI, q.Repo.Proxy)
+	// the name filter is applied while parsing the index, so that the entries of the other charts are never decoded
+	filter := func(chart string) bool {
+		return strings.Contains(chart, q.Name)
 	}
-	res := apiclient.HelmChartsResponse{}
-	for chartName, entries := range index.Entries {
+	var charts []*apiclient.HelmChart
+	err := helmClient.ListCharts(true, filter, func(chartName string, entries helm.Entries) error {
 		chart := apiclient.HelmChart{
 			Name: chartName,
 		}
 		for _, entry := range entries {
 			chart.Versions = append(chart.Versions, entry.Version)
 		}
-		res.Items = append(res.Items, &chart)
+		charts = append(charts, &chart)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &res, nil
+	sort.Slice(charts, func(i, j int) bool {
+		return charts[i].Name < charts[j].Name
+	})
+	return &apiclient.HelmChartsResponse{
+		Items: paginateHelmCharts(charts, q.Offset, q.Limit),
+		Count: int64(len(charts)),
+	}, nil
+}
+
+// paginateHelmCharts returns at most limit charts, starting at the given offset. There is no limit if it is zero
+func paginateHelmCharts(charts []*apiclient.HelmChart, offset int64, limit int64) []*apiclient.HelmChart {
+	if offset >= int64(len(charts)) {
+		return nil
+	}
+	charts = charts[offset:]
+	if limit > 0 && limit < int64(len(charts)) {
+		charts = charts[:limit]
+	}
+	return charts
 }
 
 func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
@@ -1529,16 +2974,112 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 				return err
 			}
 		},
+		"oci": func() error {
+			return oci.NewClient(repo.Repo, repo.GetOCICreds(), repo.Proxy).TestRepository()
+		},
 	}
-	if check, ok := checks[repo.Type]; ok {
-		return &apiclient.TestRepositoryResponse{VerifiedRepository: false}, check()
-	}
+	res := &apiclient.TestRepositoryResponse{}
 	var err error
-	for _, check := range checks {
+	if check, ok := checks[repo.Type]; ok {
 		err = check()
-		if err == nil {
-			return &apiclient.TestRepositoryResponse{VerifiedRepository: true}, nil
+		res.Checks = append(res.Checks, newRepositoryCheck(repo, repo.Type, err))
+	} else {
+		for _, name := range []string{"git", "helm", "oci"} {
+			err = checks[name]()
+			res.Checks = append(res.Checks, newRepositoryCheck(repo, name, err))
+			if err == nil {
+				res.VerifiedRepository = true
+				break
+			}
+		}
+	}
+	if q.Diagnose {
+		return res, nil
+	}
+	return res, err
+}
+
+// newRepositoryCheck returns the outcome of a check. The certificates presented by the server are only retrieved if
+// the check failed, to help diagnose the error.
+func newRepositoryCheck(repo *v1alpha1.Repository, name string, err error) *apiclient.RepositoryCheck {
+	check := &apiclient.RepositoryCheck{Name: name, Successful: err == nil, AuthMethod: repositoryAuthMethod(repo)}
+	if err != nil {
+		check.Message = err.Error()
+		if repo.Proxy == "" {
+			check.TlsCertificates, err = tlsCertificateChain(repo.Repo)
+			if err != nil {
+				log.Debugf("Failed to retrieve the TLS certificates of repository %s: %v", repo.Repo, err)
+			}
+		}
+	}
+	return check
+}
+
+func repositoryAuthMethod(repo *v1alpha1.Repository) string {
+	switch {
+	case repo.GithubAppPrivateKey != "":
+		return "github-app"
+	case repo.SSHPrivateKey != "":
+		return "ssh-private-key"
+	case repo.TLSClientCertData != "":
+		return "tls-client-cert"
+	case repo.Username != "" || repo.Password != "":
+		return "basic"
+	default:
+		return "anonymous"
+	}
+}
+
+// GetGnuPGKeyring returns the public keys of the keyring the signatures of the commits are verified with. The keyring
+// is synchronized with the GnuPG keys ConfigMap whenever it changes, so the keys reflect the configuration which is
+// currently enforced.
+func (s *Service) GetGnuPGKeyring(ctx context.Context, q *apiclient.GnuPGKeyringRequest) (*apiclient.GnuPGKeyringResponse, error) {
+	res := &apiclient.GnuPGKeyringResponse{Enabled: gpg.IsGPGEnabled()}
+	if !res.Enabled {
+		return res, nil
+	}
+	keys, err := gpg.GetInstalledPGPKeys(nil)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list the keys of the keyring: %v", err)
+	}
+	for _, key := range keys {
+		// the key pair generated to initialize the trust database is not part of the configuration
+		secret, err := gpg.IsSecretKey(key.KeyID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list the keys of the keyring: %v", err)
+		}
+		if secret {
+			continue
 		}
+		key.KeyData = ""
+		res.Items = append(res.Items, key)
+	}
+	return res, nil
+}
+
+// tlsCertificateChain returns a description of the certificates presented by the server of an HTTPS repository
+func tlsCertificateChain(repoURL string) ([]string, error) {
+	if !strings.Contains(repoURL, "://") {
+		// OCI Helm repositories are only made of a host name and port
+		repoURL = "https://" + repoURL
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil || u.Scheme != "https" {
+		return nil, nil
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+	// the certificates are only inspected, the check itself verifies them
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 10 * time.Second}, "tcp", host, &tls.Config{InsecureSkipVerify: true}) // nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(conn)
+	var chain []string
+	for _, cert := range conn.ConnectionState().PeerCertificates {
+		chain = append(chain, fmt.Sprintf("%s (issuer: %s, expires: %s)", cert.Subject, cert.Issuer, cert.NotAfter.UTC().Format(time.RFC3339)))
 	}
-	return &apiclient.TestRepositoryResponse{VerifiedRepository: false}, err
+	return chain, nil
 }
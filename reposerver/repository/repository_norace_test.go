@@ -35,7 +35,7 @@ func TestHelmDependencyWithConcurrency(t *testing.T) {
 			res, err := helmTemplate("../../util/helm/testdata/helm2-dependency", "../..", nil, &apiclient.ManifestRequest{
 				ApplicationSource: &argoappv1.ApplicationSource{},
 				Repos:             []*argoappv1.Repository{&helmRepo},
-			}, false)
+			}, false, &generateManifestOpt{})
 
 			assert.NoError(t, err)
 			assert.NotNil(t, res)
@@ -6,18 +6,25 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +40,10 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	helmmocks "github.com/argoproj/argo-cd/v2/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	argojsonnet "github.com/argoproj/argo-cd/v2/util/jsonnet"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v2/util/oci/mocks"
+	"github.com/argoproj/argo-cd/v2/util/sops"
 )
 
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
@@ -74,9 +85,21 @@ func newServiceWithOpt(cf clientFunc) (*Service, *gitmocks.Client) {
 
 	chart := "my-chart"
 	version := "1.1.0"
-	helmClient.On("GetIndex", true).Return(&helm.Index{Entries: map[string]helm.Entries{
-		chart: {{Version: "1.0.0"}, {Version: version}},
-	}}, nil)
+	index := &helm.Index{Entries: map[string]helm.Entries{
+		chart:           {{Version: "1.0.0"}, {Version: version}},
+		"another-chart": {{Version: "0.1.0"}},
+	}}
+	helmClient.On("GetIndex", true).Return(index, nil)
+	helmClient.On("ListCharts", true, mock.Anything, mock.Anything).Return(func(_ bool, filter func(string) bool, visit func(string, helm.Entries) error) error {
+		for name, entries := range index.Entries {
+			if filter(name) {
+				if err := visit(name, entries); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 	helmClient.On("ExtractChart", chart, version).Return("./testdata/my-chart", io.NopCloser, nil)
 	helmClient.On("CleanChartCache", chart, version).Return(nil)
 
@@ -143,6 +166,115 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Equal(t, 3, len(res2.Manifests))
 }
 
+func TestGenerateManifests_Sorted(t *testing.T) {
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{}}
+	res, err := GenerateManifests("./testdata/concatenated", "/", "", &q, false)
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 3)
+
+	var keys []string
+	for _, manifest := range res.Manifests {
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, json.Unmarshal([]byte(manifest), obj))
+		keys = append(keys, obj.GetKind()+"/"+obj.GetName())
+	}
+	assert.True(t, sort.StringsAreSorted(keys), "manifests are not sorted: %v", keys)
+	assert.Equal(t, manifestsContentHash(res.Manifests), res.ContentHash)
+	assert.Len(t, res.ContentHash, 64)
+}
+
+func TestGenerateManifests_Warnings(t *testing.T) {
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{}}
+	res, err := GenerateManifests("./testdata/manifest-warnings", "/", "", &q, false)
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	require.Len(t, res.Warnings, 3)
+	assert.Contains(t, res.Warnings[0], `Ignored "values.yaml"`)
+	assert.Equal(t, "Ingress my-ingress uses deprecated API version extensions/v1beta1, use networking.k8s.io/v1 instead", res.Warnings[1])
+	assert.Equal(t, "Resource /ConfigMap//my-config appeared 2 times among generated manifests", res.Warnings[2])
+
+	res, err = GenerateManifests("./testdata/concatenated", "/", "", &q, false)
+	require.NoError(t, err)
+	assert.Empty(t, res.Warnings)
+}
+
+func TestGenerateManifests_FailOnDuplicateResources(t *testing.T) {
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{}}
+	_, err := GenerateManifests("./testdata/manifest-warnings", "/", "", &q, false, WithFailOnDuplicateResources(true))
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "Resource /ConfigMap//my-config appeared 2 times among generated manifests")
+
+	res, err := GenerateManifests("./testdata/concatenated", "/", "", &q, false, WithFailOnDuplicateResources(true))
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+}
+
+func TestGenerateManifests_SopsKeySet(t *testing.T) {
+	keysDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(keysDir, "my-keys"), 0700))
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{}, SopsKeySet: "other-keys"}
+	_, err := GenerateManifests("./testdata/concatenated", "/", "", &q, false, WithSopsKeysDir(keysDir))
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "SOPS key set 'other-keys' does not exist")
+
+	q.SopsKeySet = "../" + filepath.Base(keysDir)
+	_, err = GenerateManifests("./testdata/concatenated", "/", "", &q, false, WithSopsKeysDir(keysDir))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	q.SopsKeySet = "my-keys"
+	res, err := GenerateManifests("./testdata/concatenated", "/", "", &q, false, WithSopsKeysDir(keysDir))
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+}
+
+func TestGenerateManifest_ManifestPolicy(t *testing.T) {
+	service := newService(".")
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "testdata/concatenated"},
+		ManifestPolicy:    &argoappv1.ManifestPolicy{RequiredAnnotations: []string{"example.com/owner"}},
+	}
+	_, err := service.GenerateManifest(context.Background(), &q)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "ServiceAccount/sa1 is missing the required annotation example.com/owner")
+
+	// the policy applies to the cached manifests too
+	q.ManifestPolicy = nil
+	res, err := service.GenerateManifest(context.Background(), &q)
+	require.NoError(t, err)
+	assert.Len(t, res.Manifests, 3)
+	q.ManifestPolicy = &argoappv1.ManifestPolicy{ForbiddenFields: []argoappv1.ForbiddenManifestField{{Kind: "ServiceAccount", JQPathExpression: ".metadata.name", Value: `"sa2"`}}}
+	_, err = service.GenerateManifest(context.Background(), &q)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func Test_sortManifests(t *testing.T) {
+	generated := func() []generatedManifest {
+		return []generatedManifest{
+			{key: kube.ResourceKey{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "b"}, manifest: "deploy-b"},
+			{key: kube.ResourceKey{Kind: "Service", Namespace: "default", Name: "a"}, manifest: "svc-a"},
+			{key: kube.ResourceKey{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "a"}, manifest: "deploy-a-2"},
+			{key: kube.ResourceKey{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "a"}, manifest: "deploy-a-1"},
+			{key: kube.ResourceKey{Kind: "ConfigMap", Namespace: "other", Name: "a"}, manifest: "cm-other"},
+			{key: kube.ResourceKey{Kind: "ConfigMap", Namespace: "default", Name: "a"}, manifest: "cm-default"},
+		}
+	}
+	manifests := sortManifests(generated())
+	assert.Equal(t, []string{"cm-default", "cm-other", "svc-a", "deploy-a-1", "deploy-a-2", "deploy-b"}, manifests)
+
+	// the order in which the manifests were generated does not matter
+	reversed := generated()
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	assert.Equal(t, manifests, sortManifests(reversed))
+	assert.Equal(t, manifestsContentHash(manifests), manifestsContentHash(sortManifests(reversed)))
+	assert.NotEqual(t, manifestsContentHash(manifests), manifestsContentHash(manifests[1:]))
+}
+
 func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 	service := newService("../..")
 
@@ -154,7 +286,7 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 
 	cachedFakeResponse := &apiclient.ManifestResponse{Manifests: []string{"Fake"}}
 
-	err := service.cache.SetManifests(mock.Anything, &src, &q, "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse})
+	err := service.cache.SetManifests(mock.Anything, &src, &q, "", "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cachedFakeResponse})
 	assert.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -168,6 +300,23 @@ func TestGenerateManifests_K8SAPIResetCache(t *testing.T) {
 	assert.True(t, len(res.Manifests) > 1)
 }
 
+func TestGetKubeVersionAndAPIVersions(t *testing.T) {
+	q := &apiclient.ManifestRequest{
+		KubeVersion:       "v1.21.5",
+		ApiVersions:       []string{"v1", "apps/v1"},
+		ApplicationSource: &argoappv1.ApplicationSource{},
+	}
+	kubeVersion, apiVersions := getKubeVersionAndAPIVersions(q)
+	assert.Equal(t, "v1.21.5", kubeVersion)
+	assert.Equal(t, []string{"v1", "apps/v1"}, apiVersions)
+
+	q.ApplicationSource.KubeVersion = "v1.22.0"
+	q.ApplicationSource.APIVersions = []string{"v1", "apps/v1", "networking.k8s.io/v1/Ingress"}
+	kubeVersion, apiVersions = getKubeVersionAndAPIVersions(q)
+	assert.Equal(t, "v1.22.0", kubeVersion)
+	assert.Equal(t, []string{"v1", "apps/v1", "networking.k8s.io/v1/Ingress"}, apiVersions)
+}
+
 func TestGenerateManifests_EmptyCache(t *testing.T) {
 	service := newService("../..")
 
@@ -176,7 +325,7 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 		Repo: &argoappv1.Repository{}, ApplicationSource: &src,
 	}
 
-	err := service.cache.SetManifests(mock.Anything, &src, &q, "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil})
+	err := service.cache.SetManifests(mock.Anything, &src, &q, "", "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: nil})
 	assert.NoError(t, err)
 
 	res, err := service.GenerateManifest(context.Background(), &q)
@@ -201,6 +350,79 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	}, response)
 }
 
+func TestHelmManifestFromChartRepoWithChartSignatureVerification(t *testing.T) {
+	verification := &argoappv1.ChartSignatureVerification{PublicKeys: []string{"-----BEGIN PUBLIC KEY-----"}}
+	digest := "sha256:" + strings.Repeat("a", 64)
+	source := &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: "1.1.0"}
+	request := &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: source, ChartSignatureVerification: verification}
+
+	newServiceWithHelmClient := func(helmClient helm.Client) *Service {
+		service := newService(".")
+		service.newHelmClient = func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
+			return helmClient
+		}
+		return service
+	}
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		helmClient := &helmmocks.Client{}
+		helmClient.On("ResolveChartDigest", "my-chart", "1.1.0").Return(digest, nil)
+		helmClient.On("VerifyChartSignature", "my-chart", digest, verification.GetHelmVerification()).Return(errors.New("no matching signatures"))
+		service := newServiceWithHelmClient(helmClient)
+
+		_, err := service.GenerateManifest(context.Background(), request)
+		assert.EqualError(t, err, "no matching signatures")
+		helmClient.AssertNotCalled(t, "ExtractChart", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		// the manifests cached for the digest and the accepted signatures are returned without verifying the chart again
+		helmClient := &helmmocks.Client{}
+		helmClient.On("ResolveChartDigest", "my-chart", "1.1.0").Return(digest, nil)
+		service := newServiceWithHelmClient(helmClient)
+		hash, err := verification.GetHelmVerification().Hash()
+		require.NoError(t, err)
+		cached := &apiclient.ManifestResponse{Manifests: []string{"{}"}, Revision: "1.1.0", SourceType: "Helm"}
+		require.NoError(t, service.cache.SetManifests(fmt.Sprintf("1.1.0@%s|%s", digest, hash), source, request, "", "", "", "", "", &cache.CachedManifestResponse{ManifestResponse: cached}))
+
+		res, err := service.GenerateManifest(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, cached.Manifests, res.Manifests)
+		helmClient.AssertNotCalled(t, "VerifyChartSignature", mock.Anything, mock.Anything, mock.Anything)
+
+		// the chart is verified again if the tag is moved
+		helmClient = &helmmocks.Client{}
+		helmClient.On("ResolveChartDigest", "my-chart", "1.1.0").Return("sha256:"+strings.Repeat("b", 64), nil)
+		helmClient.On("VerifyChartSignature", "my-chart", "sha256:"+strings.Repeat("b", 64), verification.GetHelmVerification()).Return(errors.New("no matching signatures"))
+		service.newHelmClient = func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
+			return helmClient
+		}
+		_, err = service.GenerateManifest(context.Background(), request)
+		assert.EqualError(t, err, "no matching signatures")
+	})
+}
+
+func TestGenerateManifestFromOCIRepo(t *testing.T) {
+	service := newService(".")
+	digest := "sha256:" + strings.Repeat("a", 64)
+	ociClient := &ocimocks.Client{}
+	ociClient.On("ResolveRevision", "v1").Return(digest, nil)
+	ociClient.On("Extract", digest).Return("./testdata", io.NopCloser, nil)
+	ociClient.On("CleanCache", digest).Return(nil)
+	service.newOCIClient = func(repoURL string, creds oci.Creds, proxy string) oci.Client {
+		return ociClient
+	}
+
+	source := &argoappv1.ApplicationSource{RepoURL: "oci://ghcr.io/org/manifests", Path: "recurse", TargetRevision: "v1", Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}}
+	request := &apiclient.ManifestRequest{Repo: &argoappv1.Repository{Repo: "oci://ghcr.io/org/manifests"}, ApplicationSource: source, NoCache: true}
+	response, err := service.GenerateManifest(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Len(t, response.Manifests, 2)
+	assert.Equal(t, digest, response.Revision)
+	assert.Equal(t, "Directory", response.SourceType)
+	ociClient.AssertExpectations(t)
+}
+
 func TestGenerateManifestsUseExactRevision(t *testing.T) {
 	service, gitClient := newServiceWithMocks(".", false)
 
@@ -308,7 +530,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest, manifestRequest.Namespace, manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse)
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest, manifestRequest.Namespace, manifestRequest.TrackingMethod, manifestRequest.AppLabelKey, manifestRequest.AppName, manifestRequest.SopsKeySet, cachedManifestResponse)
 		assert.Nil(t, err)
 		return cachedManifestResponse
 	}
@@ -425,6 +647,33 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 	}
 }
 
+func TestManifestGenErrorCacheMetrics(t *testing.T) {
+	service := newService(".")
+	service.initConstants = RepoServerInitConstants{
+		ParallelismLimit: 1,
+		PauseGenerationAfterFailedGenerationAttempts: 2,
+		PauseGenerationOnFailureForRequests:          4,
+	}
+
+	// 2 failed generations pause the generation, 4 cached errors are returned, then the generation is resumed
+	for i := 0; i < 7; i++ {
+		_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:              &argoappv1.Repository{Repo: "https://github.com/argoproj/argo-cd"},
+			AppName:           "test",
+			ApplicationSource: &argoappv1.ApplicationSource{Path: "./testdata/invalid-helm"},
+		})
+		assert.Error(t, err)
+	}
+
+	rr := httptest.NewRecorder()
+	service.metricsServer.GetHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rr.Body.String()
+	assert.Contains(t, body, `argocd_repo_manifest_cache_request_total{repo="https://github.com/argoproj/argo-cd",result="miss"} 3`)
+	assert.Contains(t, body, `argocd_repo_manifest_cache_request_total{repo="https://github.com/argoproj/argo-cd",result="cached-error"} 4`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_pause_total{event="enter",repo="https://github.com/argoproj/argo-cd"} 1`)
+	assert.Contains(t, body, `argocd_repo_manifest_generation_pause_total{event="exit",repo="https://github.com/argoproj/argo-cd"} 1`)
+}
+
 func TestManifestGenErrorCacheFileContentsChange(t *testing.T) {
 
 	tmpDir, err := ioutil.TempDir("", "repository-test-")
@@ -869,17 +1118,42 @@ func TestGenerateNullList(t *testing.T) {
 }
 
 func TestIdentifyAppSourceTypeByAppDirWithKustomizations(t *testing.T) {
-	sourceType, err := GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/kustomization_yaml", "testapp")
+	sourceType, err := GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/kustomization_yaml", "testapp", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, argoappv1.ApplicationSourceTypeKustomize, sourceType)
+
+	sourceType, err = GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/kustomization_yml", "testapp", nil, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, argoappv1.ApplicationSourceTypeKustomize, sourceType)
 
-	sourceType, err = GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/kustomization_yml", "testapp")
+	sourceType, err = GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/Kustomization", "testapp", nil, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, argoappv1.ApplicationSourceTypeKustomize, sourceType)
+}
+
+func TestIdentifyAppSourceTypeByPluginDiscovery(t *testing.T) {
+	plugins := []*argoappv1.ConfigManagementPlugin{{
+		Name:     "kustomized-helm",
+		Discover: &argoappv1.ConfigManagementPluginDiscovery{FileName: "kustomization.yaml"},
+	}}
+	source := &argoappv1.ApplicationSource{}
+	sourceType, err := GetAppSourceType(source, "./testdata/kustomization_yaml", "testapp", plugins, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, argoappv1.ApplicationSourceTypePlugin, sourceType)
+	assert.Equal(t, &argoappv1.ApplicationSourcePlugin{Name: "kustomized-helm"}, source.Plugin)
 
-	sourceType, err = GetAppSourceType(&argoappv1.ApplicationSource{}, "./testdata/Kustomization", "testapp")
+	// an explicit source type has precedence over the discovery
+	source = &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{}}
+	sourceType, err = GetAppSourceType(source, "./testdata/kustomization_yaml", "testapp", plugins, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, argoappv1.ApplicationSourceTypeDirectory, sourceType)
+	assert.Nil(t, source.Plugin)
+
+	source = &argoappv1.ApplicationSource{}
+	sourceType, err = GetAppSourceType(source, "./testdata/kustomization_yml", "testapp", plugins, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, argoappv1.ApplicationSourceTypeKustomize, sourceType)
+	assert.Nil(t, source.Plugin)
 }
 
 func TestRunCustomTool(t *testing.T) {
@@ -926,6 +1200,145 @@ func TestRunCustomTool(t *testing.T) {
 	assert.Equal(t, map[string]string{"revision": "prefix-mock.Anything"}, obj.GetLabels())
 }
 
+func TestRunCustomTool_PluginEnv(t *testing.T) {
+	service := newService(".")
+
+	request := func(env argoappv1.Env) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			AppName: "test-app",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Plugin: &argoappv1.ApplicationSourcePlugin{Name: "test", Env: env},
+			},
+			Plugins: []*argoappv1.ConfigManagementPlugin{{
+				Name: "test",
+				Generate: argoappv1.Command{
+					Command: []string{"sh", "-c"},
+					Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\", \"annotations\": {\"TOKEN\": \"$TOKEN\", \"APP_FOO\": \"$APP_FOO\"}}}"`},
+				},
+				Env:           argoappv1.Env{{Name: "TOKEN", Value: "secret-token"}},
+				AllowedAppEnv: []string{"APP_*"},
+			}},
+			Repo: &argoappv1.Repository{},
+		}
+	}
+
+	res, err := service.GenerateManifest(context.Background(), request(argoappv1.Env{{Name: "APP_FOO", Value: "bar"}}))
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, json.Unmarshal([]byte(res.Manifests[0]), obj))
+	assert.Equal(t, map[string]string{"TOKEN": "secret-token", "APP_FOO": "bar"}, obj.GetAnnotations())
+
+	_, err = service.GenerateManifest(context.Background(), request(argoappv1.Env{{Name: "TOKEN", Value: "overridden"}}))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "environment variable 'TOKEN' is not allowed by config management plugin 'test'")
+}
+
+func TestRunCustomTool_MaxOutputSize(t *testing.T) {
+	service := newService(".")
+	service.initConstants.PluginMaxOutputSize = 10
+
+	_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		AppName: "test-app",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Plugin: &argoappv1.ApplicationSourcePlugin{Name: "test"},
+		},
+		Plugins: []*argoappv1.ConfigManagementPlugin{{
+			Name: "test",
+			Generate: argoappv1.Command{
+				Command: []string{"sh", "-c"},
+				Args:    []string{`echo "{\"kind\": \"FakeObject\", \"metadata\": { \"name\": \"$ARGOCD_APP_NAME\"}}"`},
+			},
+		}},
+		Repo: &argoappv1.Repository{},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "output size limit exceeded: more than 10 bytes written to stdout")
+}
+
+func TestManifestGenerationTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		defaultTimeout     time.Duration
+		maxTimeout         time.Duration
+		applicationTimeout int64
+		expectedTimeout    time.Duration
+	}{
+		{name: "NoLimit", expectedTimeout: 0},
+		{name: "NoLimitApplication", applicationTimeout: 600, expectedTimeout: 10 * time.Minute},
+		{name: "Default", defaultTimeout: time.Minute, expectedTimeout: time.Minute},
+		{name: "ApplicationBelowDefault", defaultTimeout: time.Minute, applicationTimeout: 30, expectedTimeout: 30 * time.Second},
+		{name: "ApplicationAboveDefault", defaultTimeout: time.Minute, applicationTimeout: 600, expectedTimeout: time.Minute},
+		{name: "ApplicationBelowMaximum", defaultTimeout: time.Minute, maxTimeout: 5 * time.Minute, applicationTimeout: 120, expectedTimeout: 2 * time.Minute},
+		{name: "ApplicationAboveMaximum", defaultTimeout: time.Minute, maxTimeout: 5 * time.Minute, applicationTimeout: 3600, expectedTimeout: 5 * time.Minute},
+		{name: "MaximumWithoutDefault", maxTimeout: 5 * time.Minute, expectedTimeout: 5 * time.Minute},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			service := newService(".")
+			service.initConstants.ManifestGenerationTimeout = tc.defaultTimeout
+			service.initConstants.MaxManifestGenerationTimeout = tc.maxTimeout
+			timeout := service.manifestGenerationTimeout(&apiclient.ManifestRequest{ManifestGenerationTimeoutSeconds: tc.applicationTimeout})
+			assert.Equal(t, tc.expectedTimeout, timeout)
+		})
+	}
+}
+
+func TestGenerateManifestTimeout(t *testing.T) {
+	service := newService(".")
+	service.initConstants.ManifestGenerationTimeout = 100 * time.Millisecond
+	service.initConstants.MaxManifestGenerationTimeout = time.Minute
+	newRequest := func() *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			AppName:           "test-app",
+			NoCache:           true,
+			ApplicationSource: &argoappv1.ApplicationSource{Plugin: &argoappv1.ApplicationSourcePlugin{Name: "slow"}},
+			Plugins: []*argoappv1.ConfigManagementPlugin{{
+				Name: "slow",
+				Generate: argoappv1.Command{
+					Command: []string{"sh", "-c"},
+					Args:    []string{`sleep 1 && echo '{"kind": "FakeObject", "metadata": {"name": "slow"}}'`},
+				},
+			}},
+			Repo: &argoappv1.Repository{},
+		}
+	}
+
+	t.Run("DefaultTimeout", func(t *testing.T) {
+		_, err := service.GenerateManifest(context.Background(), newRequest())
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.EqualError(t, err, "rpc error: code = DeadlineExceeded desc = manifest generation timed out after 100ms")
+	})
+
+	t.Run("ApplicationTimeout", func(t *testing.T) {
+		q := newRequest()
+		q.ManifestGenerationTimeoutSeconds = 10
+		res, err := service.GenerateManifest(context.Background(), q)
+		require.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+	})
+
+	t.Run("ApplicationTimeoutAboveMaximum", func(t *testing.T) {
+		service := newService(".")
+		service.initConstants.ManifestGenerationTimeout = 100 * time.Millisecond
+		q := newRequest()
+		q.ManifestGenerationTimeoutSeconds = 10
+		_, err := service.GenerateManifest(context.Background(), q)
+		assert.EqualError(t, err, "rpc error: code = DeadlineExceeded desc = manifest generation timed out after 100ms")
+	})
+
+	t.Run("CommandsKilled", func(t *testing.T) {
+		marker := filepath.Join(t.TempDir(), "marker")
+		q := newRequest()
+		q.Plugins[0].Generate.Args = []string{"(sleep 1 && touch " + marker + ") & wait"}
+		start := time.Now()
+		_, err := service.GenerateManifest(context.Background(), q)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+		time.Sleep(1500 * time.Millisecond)
+		assert.NoFileExists(t, marker)
+	})
+}
+
 func TestGenerateFromUTF16(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		Repo:              &argoappv1.Repository{},
@@ -936,6 +1349,19 @@ func TestGenerateFromUTF16(t *testing.T) {
 	assert.Equal(t, 2, len(res1.Manifests))
 }
 
+func TestGenerateKustomizeWithProjectBuildOptions(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{},
+		KustomizeOptions: &argoappv1.KustomizeOptions{
+			ProjectBuildOptions: &argoappv1.KustomizeBuildOptions{EnableAlphaPlugins: true},
+		},
+	}
+	_, err := GenerateManifests("../../util/kustomize/testdata/kustomization_yaml", "../..", "", &q, false,
+		WithKustomizeAllowedBuildOptions([]string{"--load-restrictor"}))
+	assert.EqualError(t, err, "kustomize build option --enable-alpha-plugins is not allowed")
+}
+
 func TestListApps(t *testing.T) {
 	service := newService("./testdata")
 
@@ -1031,11 +1457,241 @@ func TestGetHelmCharts(t *testing.T) {
 	service := newService("../..")
 	res, err := service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}})
 	assert.NoError(t, err)
-	assert.Len(t, res.Items, 1)
+	assert.Len(t, res.Items, 2)
+	assert.EqualValues(t, 2, res.Count)
 
-	item := res.Items[0]
+	item := res.Items[1]
 	assert.Equal(t, "my-chart", item.Name)
 	assert.EqualValues(t, []string{"1.0.0", "1.1.0"}, item.Versions)
+
+	t.Run("Name", func(t *testing.T) {
+		res, err := service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}, Name: "my-"})
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, 1)
+		assert.Equal(t, "my-chart", res.Items[0].Name)
+		assert.EqualValues(t, 1, res.Count)
+	})
+	t.Run("Pagination", func(t *testing.T) {
+		res, err := service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}, Offset: 1, Limit: 1})
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, 1)
+		assert.Equal(t, "my-chart", res.Items[0].Name)
+		assert.EqualValues(t, 2, res.Count)
+
+		res, err = service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}, Offset: 2})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Items)
+		assert.EqualValues(t, 2, res.Count)
+	})
+	t.Run("InvalidPagination", func(t *testing.T) {
+		_, err := service.GetHelmCharts(context.Background(), &apiclient.HelmChartsRequest{Repo: &argoappv1.Repository{}, Limit: -1})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestGetRevisionMetadata_ChangedFiles(t *testing.T) {
+	service, gitClient := newServiceWithMocks("../..", false)
+	gitClient.On("RevisionMetadata", mock.Anything).Return(&git.RevisionMetadata{Message: "test"}, nil)
+	gitClient.On("ChangedFiles", "da52afd3b2df1ec49470603d8bbb46954dab1091", "c0b400fc458875d925171398f9ba9eabd5529923").
+		Return([]string{"guestbook/deployment.yaml"}, nil)
+
+	res, err := service.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:             &argoappv1.Repository{},
+		Revision:         "c0b400fc458875d925171398f9ba9eabd5529923",
+		PreviousRevision: "da52afd3b2df1ec49470603d8bbb46954dab1091",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guestbook/deployment.yaml"}, res.ChangedFiles)
+
+	// changed files are not cached
+	res, err = service.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &argoappv1.Repository{},
+		Revision: "c0b400fc458875d925171398f9ba9eabd5529923",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.ChangedFiles)
+
+	_, err = service.GetRevisionMetadata(context.Background(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:             &argoappv1.Repository{},
+		Revision:         "c0b400fc458875d925171398f9ba9eabd5529923",
+		PreviousRevision: "master",
+	})
+	assert.EqualError(t, err, "previous revision master must be resolved")
+}
+
+func TestResolveRevision(t *testing.T) {
+	service, _ := newServiceWithOpt(func(gitClient *gitmocks.Client) {
+		gitClient.On("LsRemote", "master").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+	})
+
+	t.Run("Git", func(t *testing.T) {
+		res, err := service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+			Repo:   &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"},
+			Source: &argoappv1.ApplicationSource{TargetRevision: "master"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "632039659e542ed7de0c170a4fcc1c571b288fc0", res.Revision)
+		assert.Equal(t, "master", res.AmbiguousRevision)
+	})
+
+	t.Run("Helm", func(t *testing.T) {
+		res, err := service.ResolveRevision(context.Background(), &apiclient.ResolveRevisionRequest{
+			Repo:              &argoappv1.Repository{Repo: "https://helm.example.com"},
+			Source:            &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: "1.0.0"},
+			AmbiguousRevision: "1.*",
+			NoRevisionCache:   true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1.1.0", res.Revision)
+		assert.Equal(t, "1.*", res.AmbiguousRevision)
+	})
+}
+
+func TestWarmManifestCache(t *testing.T) {
+	service := newService("../..")
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		Revision:          "HEAD",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "manifests/base"},
+		NoCache:           true,
+	}
+
+	res, err := service.WarmManifestCache(context.Background(), &q)
+	require.NoError(t, err)
+	assert.False(t, res.Cached)
+	assert.Equal(t, mock.Anything, res.Revision)
+	// the request of the caller is left unchanged
+	assert.True(t, q.NoCache)
+	assert.False(t, q.NoRevisionCache)
+
+	res, err = service.WarmManifestCache(context.Background(), &q)
+	require.NoError(t, err)
+	assert.True(t, res.Cached)
+
+	cachedRes := cache.CachedManifestResponse{}
+	err = service.cache.GetManifests(mock.Anything, q.ApplicationSource, &q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, q.SopsKeySet, &cachedRes)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cachedRes.ManifestResponse.Manifests)
+}
+
+func TestGenerateManifests_Batch(t *testing.T) {
+	service, gitClient := newServiceWithMocks(".", false)
+	newRequest := func(path string) *apiclient.ManifestRequest {
+		return &apiclient.ManifestRequest{
+			Repo:              &argoappv1.Repository{Repo: "https://github.com/argoproj/argo-cd"},
+			Revision:          "HEAD",
+			ApplicationSource: &argoappv1.ApplicationSource{Path: path},
+		}
+	}
+
+	res, err := service.GenerateManifests(context.Background(), &apiclient.ManifestsRequest{Requests: []*apiclient.ManifestRequest{
+		newRequest("testdata/concatenated"),
+		newRequest("testdata/invalid-manifests"),
+		newRequest("testdata/recurse"),
+	}})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	assert.Empty(t, res.Results[0].Error)
+	assert.Len(t, res.Results[0].Response.Manifests, 3)
+	// the failure of a source does not fail the other ones
+	assert.NotEmpty(t, res.Results[1].Error)
+	assert.Equal(t, uint32(codes.FailedPrecondition), res.Results[1].Code)
+	assert.Nil(t, res.Results[1].Response)
+	assert.Empty(t, res.Results[2].Error)
+	assert.Equal(t, mock.Anything, res.Results[2].Response.Revision)
+	// all the sources are generated from a single checkout
+	gitClient.AssertNumberOfCalls(t, "Checkout", 1)
+
+	t.Run("Cached", func(t *testing.T) {
+		res, err := service.GenerateManifests(context.Background(), &apiclient.ManifestsRequest{Requests: []*apiclient.ManifestRequest{
+			newRequest("testdata/concatenated"),
+		}})
+		require.NoError(t, err)
+		assert.Len(t, res.Results[0].Response.Manifests, 3)
+		gitClient.AssertNumberOfCalls(t, "Checkout", 1)
+	})
+
+	t.Run("DifferentRevisions", func(t *testing.T) {
+		other := newRequest("testdata/recurse")
+		other.Revision = "v1.0.0"
+		_, err := service.GenerateManifests(context.Background(), &apiclient.ManifestsRequest{Requests: []*apiclient.ManifestRequest{
+			newRequest("testdata/concatenated"), other,
+		}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DifferentRepositories", func(t *testing.T) {
+		other := newRequest("testdata/recurse")
+		other.Repo = &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}
+		_, err := service.GenerateManifests(context.Background(), &apiclient.ManifestsRequest{Requests: []*apiclient.ManifestRequest{
+			newRequest("testdata/concatenated"), other,
+		}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListRefs(t *testing.T) {
+	service, _ := newServiceWithOpt(func(gitClient *gitmocks.Client) {
+		gitClient.On("LsRefs").Return(&git.Refs{
+			Branches: []string{"master", "release-1.0", "release-1.1", "release-2.0"},
+			Tags:     []string{"v1.0.0", "v1.1.0", "v2.0.0", "v2.0.0-rc1"},
+		}, nil)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		res, err := service.ListRefs(context.Background(), &apiclient.ListRefsRequest{Repo: &argoappv1.Repository{}})
+		require.NoError(t, err)
+		assert.Len(t, res.Branches, 4)
+		assert.Len(t, res.Tags, 4)
+		assert.Equal(t, int64(4), res.BranchesCount)
+		assert.Equal(t, int64(4), res.TagsCount)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		res, err := service.ListRefs(context.Background(), &apiclient.ListRefsRequest{
+			Repo:   &argoappv1.Repository{},
+			Prefix: "release-1.",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"release-1.0", "release-1.1"}, res.Branches)
+		assert.Empty(t, res.Tags)
+
+		res, err = service.ListRefs(context.Background(), &apiclient.ListRefsRequest{
+			Repo:  &argoappv1.Repository{},
+			Regex: `^v\d+\.\d+\.\d+$`,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Branches)
+		assert.Equal(t, []string{"v1.0.0", "v1.1.0", "v2.0.0"}, res.Tags)
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		res, err := service.ListRefs(context.Background(), &apiclient.ListRefsRequest{
+			Repo:   &argoappv1.Repository{},
+			Prefix: "v",
+			Offset: 1,
+			Limit:  2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"v1.1.0", "v2.0.0"}, res.Tags)
+		assert.Equal(t, int64(4), res.TagsCount)
+
+		res, err = service.ListRefs(context.Background(), &apiclient.ListRefsRequest{
+			Repo:   &argoappv1.Repository{},
+			Offset: 10,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Branches)
+		assert.Equal(t, int64(4), res.BranchesCount)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := service.ListRefs(context.Background(), &apiclient.ListRefsRequest{Repo: &argoappv1.Repository{}, Regex: "("})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = service.ListRefs(context.Background(), &apiclient.ListRefsRequest{Repo: &argoappv1.Repository{}, Limit: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func TestGetRevisionMetadata(t *testing.T) {
@@ -1181,6 +1837,17 @@ func TestService_newHelmClientResolveRevision(t *testing.T) {
 		_, _, err := service.newHelmClientResolveRevision(&argoappv1.Repository{}, "???", "", true)
 		assert.EqualError(t, err, "invalid revision '???': improper constraint: ???", true)
 	})
+	t.Run("OCIRange", func(t *testing.T) {
+		helmClient := &helmmocks.Client{}
+		helmClient.On("GetTags", "my-chart").Return(helm.Entries{{Version: "1.0.0"}, {Version: "1.2.3+build"}, {Version: "2.0.0"}, {Version: "latest"}}, nil)
+		service.newHelmClient = func(repoURL string, creds helm.Creds, enableOci bool, proxy string, opts ...helm.ClientOpts) helm.Client {
+			assert.True(t, enableOci)
+			return helmClient
+		}
+		_, revision, err := service.newHelmClientResolveRevision(&argoappv1.Repository{Repo: "ghcr.io/org"}, "1.x", "my-chart", true)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2.3+build", revision)
+	})
 }
 
 func TestGetAppDetailsWithAppParameterFile(t *testing.T) {
@@ -1492,11 +2159,11 @@ func TestFindResources(t *testing.T) {
 	for i := range testCases {
 		tc := testCases[i]
 		t.Run(tc.name, func(t *testing.T) {
-			objs, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
+			objs, _, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
 				Recurse: true,
 				Include: tc.include,
 				Exclude: tc.exclude,
-			})
+			}, &generateManifestOpt{})
 			if !assert.NoError(t, err) {
 				return
 			}
@@ -1510,10 +2177,10 @@ func TestFindResources(t *testing.T) {
 }
 
 func TestFindManifests_Exclude(t *testing.T) {
-	objs, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
+	objs, _, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "subdir/deploymentSub.yaml",
-	})
+	}, &generateManifestOpt{})
 
 	if !assert.NoError(t, err) || !assert.Len(t, objs, 1) {
 		return
@@ -1523,10 +2190,10 @@ func TestFindManifests_Exclude(t *testing.T) {
 }
 
 func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
-	objs, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
+	objs, _, err := findManifests("testdata/app-include-exclude", ".", nil, argoappv1.ApplicationSourceDirectory{
 		Recurse: true,
 		Exclude: "nothing.yaml",
-	})
+	}, &generateManifestOpt{})
 
 	if !assert.NoError(t, err) || !assert.Len(t, objs, 2) {
 		return
@@ -1536,6 +2203,152 @@ func TestFindManifests_Exclude_NothingMatches(t *testing.T) {
 		[]string{"nginx-deployment", "nginx-deployment-sub"}, []string{objs[0].GetName(), objs[1].GetName()})
 }
 
+func TestFindManifests_ArgoCDIgnore(t *testing.T) {
+	objs, _, err := findManifests("testdata/app-argocdignore", ".", nil, argoappv1.ApplicationSourceDirectory{
+		Recurse: true,
+	}, &generateManifestOpt{})
+
+	if !assert.NoError(t, err) || !assert.Len(t, objs, 1) {
+		return
+	}
+
+	assert.Equal(t, "main", objs[0].GetName())
+}
+
+func TestFindManifests_Envsubst(t *testing.T) {
+	env := &argoappv1.Env{
+		&argoappv1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: "guestbook"},
+		&argoappv1.EnvEntry{Name: "ARGOCD_APP_REVISION", Value: "abc123"},
+	}
+
+	objs, _, err := findManifests("testdata/envsubst", ".", env, argoappv1.ApplicationSourceDirectory{Envsubst: true}, &generateManifestOpt{})
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "guestbook-config", objs[0].GetName())
+	revision, _, _ := unstructured.NestedString(objs[0].Object, "data", "revision")
+	assert.Equal(t, "abc123", revision)
+
+	// references are kept as is unless enabled
+	objs, _, err = findManifests("testdata/envsubst", ".", env, argoappv1.ApplicationSourceDirectory{}, &generateManifestOpt{})
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "$ARGOCD_APP_NAME-config", objs[0].GetName())
+}
+
+func TestGenerateManifests_JsonnetBundler(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}},
+	}
+	// dependencies are already vendored, so jsonnet-bundler does not run
+	res, err := GenerateManifests("./testdata/jsonnet-bundler", "/", "", &q, false)
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"jsonnet-bundler"`)
+}
+
+func TestGenerateManifests_JsonnetNativeFunctions(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{Include: "*.jsonnet"}},
+	}
+	_, err := GenerateManifests("./testdata/jsonnet-native", "./testdata/jsonnet-native", "", &q, false)
+	assert.Error(t, err)
+
+	res, err := GenerateManifests("./testdata/jsonnet-native", "./testdata/jsonnet-native", "", &q, false, WithJsonnetNativeFunctions([]string{"parseYaml"}))
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"data":{"key":"value"}`)
+}
+
+func TestGenerateManifests_JsonnetImportOutsideRepository(t *testing.T) {
+	q := apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{}},
+	}
+	// the application path is the repository root, so the library is outside of the repository
+	_, err := GenerateManifests("./testdata/jsonnet-shared/app", "./testdata/jsonnet-shared/app", "", &q, false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "import of '../libs/shared.libsonnet' is not allowed")
+
+	res, err := GenerateManifests("./testdata/jsonnet-shared/app", "./testdata/jsonnet-shared/app", "", &q, false, WithJsonnetImportPaths([]string{"./testdata/jsonnet-shared/libs"}))
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"jsonnet-shared"`)
+}
+
+func TestGenerateManifests_JsonnetRemoteLib(t *testing.T) {
+	libRepo, err := ioutil.TempDir("", "lib-repo")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(libRepo) }()
+	require.NoError(t, os.MkdirAll(filepath.Join(libRepo, "jsonnet"), 0755))
+	lib, err := ioutil.ReadFile("./testdata/jsonnet-shared/libs/shared.libsonnet")
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(libRepo, "jsonnet", "shared.libsonnet"), lib, 0644))
+	for _, args := range [][]string{
+		{"init"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "init"},
+		{"tag", "v0.1.0"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = libRepo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	// serve the library repository over HTTP, local repositories are rejected
+	gitPath, err := exec.LookPath("git")
+	require.NoError(t, err)
+	server := httptest.NewServer(&cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Env:  []string{"GIT_PROJECT_ROOT=" + filepath.Dir(libRepo), "GIT_HTTP_EXPORT_ALL=1"},
+	})
+	defer server.Close()
+	libRepoURL := server.URL + "/" + filepath.Base(libRepo)
+
+	libDir, err := ioutil.TempDir("", "jsonnet-libs")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(libDir) }()
+	prevDir, prevCache := jsonnetLibDir, jsonnetLibCache
+	jsonnetLibDir, jsonnetLibCache = libDir, argojsonnet.NewLibCache(filepath.Join(libDir, jsonnetLibCacheDir))
+	defer func() { jsonnetLibDir, jsonnetLibCache = prevDir, prevCache }()
+
+	appPath, err := ioutil.TempDir("", "app")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, "main.jsonnet"), []byte(`(import 'shared.libsonnet').configMap('remote-lib')`), 0644))
+
+	q := apiclient.ManifestRequest{
+		Repo: &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{Directory: &argoappv1.ApplicationSourceDirectory{
+			Jsonnet: argoappv1.ApplicationSourceJsonnet{Libs: []string{fmt.Sprintf("git::%s//jsonnet?ref=v0.1.0", libRepoURL)}},
+		}},
+	}
+	res, err := GenerateManifests(appPath, appPath, "", &q, false)
+	require.NoError(t, err)
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], `"name":"remote-lib"`)
+
+	t.Run("PathOutsideRepository", func(t *testing.T) {
+		q := q
+		q.ApplicationSource = q.ApplicationSource.DeepCopy()
+		q.ApplicationSource.Directory.Jsonnet.Libs = []string{fmt.Sprintf("git::%s//../jsonnet?ref=v0.1.0", libRepoURL)}
+		_, err := GenerateManifests(appPath, appPath, "", &q, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("LocalRepository", func(t *testing.T) {
+		q := q
+		q.ApplicationSource = q.ApplicationSource.DeepCopy()
+		q.ApplicationSource.Directory.Jsonnet.Libs = []string{fmt.Sprintf("git::file://%s//jsonnet?ref=v0.1.0", libRepo)}
+		_, err := GenerateManifests(appPath, appPath, "", &q, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an HTTP(S) or SSH URL")
+	})
+}
+
 func TestTestRepoOCI(t *testing.T) {
 	service := newService(".")
 	_, err := service.TestRepository(context.Background(), &apiclient.TestRepositoryRequest{
@@ -1549,6 +2362,43 @@ func TestTestRepoOCI(t *testing.T) {
 	assert.Equal(t, "OCI Helm repository URL should include hostname and port only", err.Error())
 }
 
+func TestTestRepository_Diagnose(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	service := newService(".")
+	res, err := service.TestRepository(context.Background(), &apiclient.TestRepositoryRequest{
+		Repo:     &argoappv1.Repository{Repo: server.URL + "/repo.git", Type: "git", Username: "admin", Password: "secret"},
+		Diagnose: true,
+	})
+	require.NoError(t, err)
+	assert.False(t, res.VerifiedRepository)
+	require.Len(t, res.Checks, 1)
+	check := res.Checks[0]
+	assert.Equal(t, "git", check.Name)
+	assert.False(t, check.Successful)
+	assert.Contains(t, check.Message, "certificate")
+	assert.Equal(t, "basic", check.AuthMethod)
+	require.Len(t, check.TlsCertificates, 1)
+	assert.Contains(t, check.TlsCertificates[0], "Acme Co")
+
+	// without diagnostics, the error of the check is returned
+	_, err = service.TestRepository(context.Background(), &apiclient.TestRepositoryRequest{
+		Repo: &argoappv1.Repository{Repo: server.URL + "/repo.git", Type: "git"},
+	})
+	assert.EqualError(t, err, check.Message)
+}
+
+func Test_repositoryAuthMethod(t *testing.T) {
+	assert.Equal(t, "anonymous", repositoryAuthMethod(&argoappv1.Repository{}))
+	assert.Equal(t, "basic", repositoryAuthMethod(&argoappv1.Repository{Username: "admin", Password: "secret"}))
+	assert.Equal(t, "ssh-private-key", repositoryAuthMethod(&argoappv1.Repository{SSHPrivateKey: "key"}))
+	assert.Equal(t, "tls-client-cert", repositoryAuthMethod(&argoappv1.Repository{TLSClientCertData: "cert", TLSClientCertKey: "key"}))
+	assert.Equal(t, "github-app", repositoryAuthMethod(&argoappv1.Repository{GithubAppPrivateKey: "key", GithubAppId: 1}))
+}
+
 func Test_getHelmDependencyRepos(t *testing.T) {
 	repo1 := "https://charts.bitnami.com/bitnami"
 	repo2 := "https://eventstore.github.io/EventStore.Charts"
@@ -1559,3 +2409,281 @@ func Test_getHelmDependencyRepos(t *testing.T) {
 	assert.Equal(t, repos[0].Repo, repo1)
 	assert.Equal(t, repos[1].Repo, repo2)
 }
+
+func Test_getHelmBinary(t *testing.T) {
+	source := &argoappv1.ApplicationSource{Helm: &argoappv1.ApplicationSourceHelm{Version: "v2"}}
+
+	version, binaryPath := getHelmBinary(source, nil)
+	assert.Equal(t, "v2", version)
+	assert.Equal(t, "", binaryPath)
+
+	version, binaryPath = getHelmBinary(source, &argoappv1.HelmOptions{Version: "v3", BinaryPath: "/custom-tools/helm-3.2.4"})
+	assert.Equal(t, "v3", version)
+	assert.Equal(t, "/custom-tools/helm-3.2.4", binaryPath)
+
+	version, binaryPath = getHelmBinary(&argoappv1.ApplicationSource{}, nil)
+	assert.Equal(t, "", version)
+	assert.Equal(t, "", binaryPath)
+}
+
+func Test_parseHelmGitDependency(t *testing.T) {
+	dep, err := parseHelmGitDependency("redis", "https://charts.bitnami.com/bitnami")
+	assert.NoError(t, err)
+	assert.Nil(t, dep)
+
+	dep, err = parseHelmGitDependency("cert-manager", "git+https://github.com/jetstack/cert-manager@deploy/charts/cert-manager?ref=v0.6.2")
+	assert.NoError(t, err)
+	assert.Equal(t, &helmGitDependency{name: "cert-manager", repoURL: "https://github.com/jetstack/cert-manager", path: "deploy/charts/cert-manager", revision: "v0.6.2"}, dep)
+
+	dep, err = parseHelmGitDependency("chart", "git+ssh://git@github.com/argoproj/argo-cd.git@test/chart")
+	assert.NoError(t, err)
+	assert.Equal(t, &helmGitDependency{name: "chart", repoURL: "ssh://git@github.com/argoproj/argo-cd.git", path: "test/chart"}, dep)
+
+	_, err = parseHelmGitDependency("chart", "git+ssh://git@github.com/argoproj/argo-cd.git")
+	assert.EqualError(t, err, "invalid git dependency 'git+ssh://git@github.com/argoproj/argo-cd.git': chart path is missing")
+
+	_, err = parseHelmGitDependency("chart", "git+file:///tmp/repo@charts/sub")
+	assert.EqualError(t, err, "invalid git dependency 'git+file:///tmp/repo@charts/sub': repository URL must be an HTTP(S) or SSH URL")
+
+	_, err = parseHelmGitDependency("chart", "git+/tmp/repo@charts/sub")
+	assert.EqualError(t, err, "invalid git dependency 'git+/tmp/repo@charts/sub': repository URL must be an HTTP(S) or SSH URL")
+}
+
+func Test_vendorHelmGitDependencies(t *testing.T) {
+	depRepo, err := ioutil.TempDir("", "dep-repo")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(depRepo) }()
+	require.NoError(t, os.MkdirAll(filepath.Join(depRepo, "charts", "sub"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(depRepo, "charts", "sub", "Chart.yaml"), []byte("apiVersion: v2\nname: sub\nversion: 0.1.0\n"), 0644))
+	for _, args := range [][]string{
+		{"init"},
+		{"add", "."},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-m", "init"},
+		{"tag", "v0.1.0"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = depRepo
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	// serve the dependency repository over HTTP, local repositories are rejected
+	gitPath, err := exec.LookPath("git")
+	require.NoError(t, err)
+	server := httptest.NewServer(&cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Env:  []string{"GIT_PROJECT_ROOT=" + filepath.Dir(depRepo), "GIT_HTTP_EXPORT_ALL=1"},
+	})
+	defer server.Close()
+	depRepoURL := server.URL + "/" + filepath.Base(depRepo)
+
+	cloneDir, err := ioutil.TempDir("", "helm-git-dependencies")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cloneDir) }()
+	prevDir := helmGitDependencyDirPath
+	helmGitDependencyDirPath = cloneDir
+	defer func() { helmGitDependencyDirPath = prevDir }()
+
+	newAppPath := func() string {
+		appPath, err := ioutil.TempDir("", "app")
+		require.NoError(t, err)
+		chart := fmt.Sprintf("apiVersion: v2\nname: app\nversion: 0.1.0\ndependencies:\n- name: sub\n  version: 0.1.0\n  repository: git+%s@charts/sub?ref=v0.1.0\n", depRepoURL)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(chart), 0644))
+		return appPath
+	}
+
+	appPath := newAppPath()
+	defer func() { _ = os.RemoveAll(appPath) }()
+	err = vendorHelmGitDependencies(appPath, appPath, &apiclient.ManifestRequest{
+		Repo:                   &argoappv1.Repository{},
+		HelmGitDependencyRepos: []*argoappv1.Repository{{Repo: depRepoURL}},
+	})
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(appPath, "charts", "sub", "Chart.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "name: sub")
+
+	t.Run("RepositoryNotPermitted", func(t *testing.T) {
+		appPath := newAppPath()
+		defer func() { _ = os.RemoveAll(appPath) }()
+		err := vendorHelmGitDependencies(appPath, appPath, &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("repository %s is not configured or not permitted by the project", depRepoURL))
+	})
+}
+
+func Test_vendorHelmGitDependencies_FileOutsideRepo(t *testing.T) {
+	appPath, err := ioutil.TempDir("", "app")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+	chart := "apiVersion: v2\nname: app\nversion: 0.1.0\ndependencies:\n- name: sub\n  version: 0.1.0\n  repository: file://../../sub\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(chart), 0644))
+
+	err = vendorHelmGitDependencies(appPath, appPath, &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency 'sub' points outside of the repository")
+}
+
+func Test_getHelmDependencyCreds(t *testing.T) {
+	repos := []*argoappv1.Repository{
+		{Name: "private", Repo: "https://example.com/private", Username: "user", Password: "pass"},
+		{Name: "https://example.com/public", Repo: "https://example.com/public"},
+	}
+	assert.Equal(t, "user", getHelmDependencyCreds("https://example.com/private", repos).Username)
+	assert.Equal(t, "user", getHelmDependencyCreds("@private", repos).Username)
+	assert.Equal(t, "user", getHelmDependencyCreds("alias:private", repos).Username)
+	assert.Equal(t, helm.Creds{}, getHelmDependencyCreds("https://example.com/other", repos))
+}
+
+func Test_getLockedHelmDependencies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "helm-locked-deps")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Chart.yaml"), []byte(`apiVersion: v2
+name: app
+version: 1.0.0
+dependencies:
+- name: redis
+  version: ">=1.0.0"
+  repository: https://charts.bitnami.com/bitnami
+- name: local
+  version: 1.0.0
+  repository: file://../local
+`), 0644))
+
+	t.Run("RangeWithoutLock", func(t *testing.T) {
+		deps, complete, err := getLockedHelmDependencies(dir)
+		require.NoError(t, err)
+		assert.False(t, complete)
+		assert.Empty(t, deps)
+	})
+
+	t.Run("Lock", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "Chart.lock"), []byte(`dependencies:
+- name: redis
+  version: 1.2.3
+  repository: https://charts.bitnami.com/bitnami
+- name: local
+  version: 1.0.0
+  repository: file://../local
+`), 0644))
+		deps, complete, err := getLockedHelmDependencies(dir)
+		require.NoError(t, err)
+		assert.True(t, complete)
+		assert.Equal(t, []repositories{{Name: "redis", Version: "1.2.3", Repository: "https://charts.bitnami.com/bitnami"}}, deps)
+	})
+}
+
+func TestGetGnuPGKeyring_Disabled(t *testing.T) {
+	oldval := os.Getenv("ARGOCD_GPG_ENABLED")
+	os.Setenv("ARGOCD_GPG_ENABLED", "false")
+	defer os.Setenv("ARGOCD_GPG_ENABLED", oldval)
+
+	service := newService(".")
+	res, err := service.GetGnuPGKeyring(context.Background(), &apiclient.GnuPGKeyringRequest{})
+	require.NoError(t, err)
+	assert.False(t, res.Enabled)
+	assert.Empty(t, res.Items)
+}
+
+func Test_decryptValuesFile(t *testing.T) {
+	decrypter := &sops.Decrypter{}
+
+	path, err := decryptValuesFile(decrypter, nil, "./testdata/my-chart/my-chart-values.yaml")
+	assert.NoError(t, err)
+	assert.Empty(t, path)
+
+	path, err = decryptValuesFile(decrypter, nil, "./testdata/my-chart/non-existent-values.yaml")
+	assert.NoError(t, err)
+	assert.Empty(t, path)
+}
+
+func TestGenerateHelmWithTooLongReleaseName(t *testing.T) {
+	service := newService("../..")
+
+	_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+		Repo:    &argoappv1.Repository{},
+		AppName: "test",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Path: "./util/helm/testdata/redis",
+			Helm: &argoappv1.ApplicationSourceHelm{
+				ReleaseName: "$ARGOCD_APP_NAME-with-a-release-name-suffix-which-is-much-too-long-for-helm",
+			},
+		},
+		NoCache: true,
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `Helm release name "test-with-a-release-name-suffix-which-is-much-too-long-for-helm" is 63 characters long`)
+}
+
+func Test_validateResourceNameLengths(t *testing.T) {
+	newObj := func(apiVersion, kind, name string, labels map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetLabels(labels)
+		return obj
+	}
+	longName := strings.Repeat("a", 64)
+
+	assert.NoError(t, validateResourceNameLengths([]*unstructured.Unstructured{
+		newObj("apps/v1", "Deployment", longName, map[string]string{"app": "my-app"}),
+		newObj("v1", "Service", "my-app", nil),
+		nil,
+	}))
+
+	err := validateResourceNameLengths([]*unstructured.Unstructured{newObj("v1", "Service", longName, nil)})
+	assert.EqualError(t, err, fmt.Sprintf(`rpc error: code = InvalidArgument desc = name of Service %q is 64 characters long, which exceeds the maximum of 63`, longName))
+
+	err = validateResourceNameLengths([]*unstructured.Unstructured{newObj("apps/v1", "Deployment", strings.Repeat("a", 254), nil)})
+	assert.Error(t, err)
+
+	err = validateResourceNameLengths([]*unstructured.Unstructured{newObj("apps/v1", "Deployment", "my-app", map[string]string{"app.kubernetes.io/instance": longName})})
+	assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = value of label "app.kubernetes.io/instance" of Deployment "my-app" is 64 characters long, which exceeds the maximum of 63`)
+}
+
+func Test_populateHelmChartMetadata(t *testing.T) {
+	appPath, err := ioutil.TempDir("", "chart")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+
+	res := &apiclient.HelmAppSpec{}
+	require.NoError(t, populateHelmChartMetadata(res, appPath))
+	assert.Equal(t, &apiclient.HelmAppSpec{}, res)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(`apiVersion: v2
+name: my-chart
+version: 1.2.3
+appVersion: "4.5"
+description: My chart
+dependencies:
+- name: redis
+  version: ^14.0.0
+  repository: https://charts.bitnami.com/bitnami
+  alias: cache
+- name: common
+  version: 1.0.0
+  repository: file://../common
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, "Chart.lock"), []byte(`dependencies:
+- name: redis
+  version: 14.8.8
+  repository: https://charts.bitnami.com/bitnami
+- name: common
+  version: 1.0.0
+  repository: file://../common
+`), 0644))
+
+	require.NoError(t, populateHelmChartMetadata(res, appPath))
+	assert.Equal(t, "my-chart", res.Name)
+	assert.Equal(t, "1.2.3", res.ChartVersion)
+	assert.Equal(t, "4.5", res.AppVersion)
+	assert.Equal(t, "My chart", res.Description)
+	assert.Equal(t, []*apiclient.HelmChartDependency{
+		{Name: "redis", Version: "^14.0.0", Repository: "https://charts.bitnami.com/bitnami", Alias: "cache", LockedVersion: "14.8.8"},
+		{Name: "common", Version: "1.0.0", Repository: "file://../common", LockedVersion: "1.0.0"},
+	}, res.Dependencies)
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+// prioritySemaphore limits the number of concurrent operations like semaphore.Weighted, but lets operations of
+// interactive requests go before the waiting operations of background requests. Operations of the same priority
+// are allowed in FIFO order.
+type prioritySemaphore struct {
+	lock        sync.Mutex
+	size        int64
+	current     int64
+	interactive list.List
+	background  list.List
+}
+
+func newPrioritySemaphore(size int64) *prioritySemaphore {
+	return &prioritySemaphore{size: size}
+}
+
+// Acquire blocks until the operation is allowed or the context is done. It returns the error of the context in the
+// latter case.
+func (s *prioritySemaphore) Acquire(ctx context.Context, priority apiclient.RequestPriority) error {
+	s.lock.Lock()
+	if s.current < s.size && s.interactive.Len() == 0 && s.background.Len() == 0 {
+		s.current++
+		s.lock.Unlock()
+		return nil
+	}
+	waiters := &s.background
+	if priority == apiclient.RequestPriorityInteractive {
+		waiters = &s.interactive
+	}
+	ready := make(chan struct{})
+	elem := waiters.PushBack(ready)
+	s.lock.Unlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		select {
+		case <-ready:
+			// the operation was allowed concurrently, so let another one go instead
+			s.current--
+			s.notifyWaiters()
+		default:
+			waiters.Remove(elem)
+		}
+		return ctx.Err()
+	}
+}
+
+// Release allows another operation to go
+func (s *prioritySemaphore) Release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.current--
+	s.notifyWaiters()
+}
+
+func (s *prioritySemaphore) notifyWaiters() {
+	for s.current < s.size {
+		waiters := &s.interactive
+		if waiters.Len() == 0 {
+			waiters = &s.background
+		}
+		front := waiters.Front()
+		if front == nil {
+			return
+		}
+		waiters.Remove(front)
+		s.current++
+		close(front.Value.(chan struct{}))
+	}
+}
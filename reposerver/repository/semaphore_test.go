@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+)
+
+func TestPrioritySemaphore(t *testing.T) {
+	sem := newPrioritySemaphore(1)
+	require.NoError(t, sem.Acquire(context.Background(), apiclient.RequestPriorityBackground))
+
+	order := make(chan apiclient.RequestPriority, 2)
+	acquire := func(priority apiclient.RequestPriority) {
+		go func() {
+			if assert.NoError(t, sem.Acquire(context.Background(), priority)) {
+				order <- priority
+				sem.Release()
+			}
+		}()
+	}
+	acquire(apiclient.RequestPriorityBackground)
+	// make sure the background operation waits first
+	assert.Eventually(t, func() bool {
+		sem.lock.Lock()
+		defer sem.lock.Unlock()
+		return sem.background.Len() == 1
+	}, time.Second, time.Millisecond)
+	acquire(apiclient.RequestPriorityInteractive)
+	assert.Eventually(t, func() bool {
+		sem.lock.Lock()
+		defer sem.lock.Unlock()
+		return sem.interactive.Len() == 1
+	}, time.Second, time.Millisecond)
+
+	sem.Release()
+	assert.Equal(t, apiclient.RequestPriorityInteractive, <-order)
+	assert.Equal(t, apiclient.RequestPriorityBackground, <-order)
+}
+
+func TestPrioritySemaphore_Cancel(t *testing.T) {
+	sem := newPrioritySemaphore(1)
+	require.NoError(t, sem.Acquire(context.Background(), apiclient.RequestPriorityBackground))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, sem.Acquire(ctx, apiclient.RequestPriorityInteractive))
+	assert.Equal(t, 0, sem.interactive.Len())
+
+	sem.Release()
+	require.NoError(t, sem.Acquire(context.Background(), apiclient.RequestPriorityBackground))
+}
+
+func TestRequestPriorityFromContext(t *testing.T) {
+	assert.Equal(t, apiclient.RequestPriorityBackground, apiclient.RequestPriorityFromContext(context.Background()))
+
+	ctx := apiclient.WithRequestPriority(context.Background(), apiclient.RequestPriorityInteractive)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	assert.Equal(t, apiclient.RequestPriorityInteractive, apiclient.RequestPriorityFromContext(metadata.NewIncomingContext(context.Background(), md)))
+}
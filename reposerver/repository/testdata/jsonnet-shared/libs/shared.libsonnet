@@ -0,0 +1,9 @@
+{
+  configMap(name):: {
+    apiVersion: 'v1',
+    kind: 'ConfigMap',
+    metadata: {
+      name: name,
+    },
+  },
+}
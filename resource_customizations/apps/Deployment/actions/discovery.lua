@@ -1,3 +1,8 @@
 actions = {}
 actions["restart"] = {}
+local replicas = {["name"] = "replicas", ["type"] = "integer"}
+if obj.spec.replicas ~= nil then
+    replicas["default"] = tostring(obj.spec.replicas)
+end
+actions["scale"] = {["params"] = {replicas}}
 return actions
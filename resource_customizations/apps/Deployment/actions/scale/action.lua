@@ -0,0 +1,5 @@
+if actionParams["replicas"] < 0 then
+    error("replicas must not be negative")
+end
+obj.spec.replicas = actionParams["replicas"]
+return obj
@@ -120,7 +120,7 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 
 // CanI checks if the current account has permission to perform an action
 func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
-	if !slice.ContainsString(rbacpolicy.Actions, r.Action, nil) {
+	if !rbacpolicy.IsValidAction(r.Action) {
 		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbacpolicy.Actions, r.Action)
 	}
 	if !slice.ContainsString(rbacpolicy.Resources, r.Resource, nil) {
@@ -141,7 +141,7 @@ func toApiAccount(name string, a settings.Account) *account.Account {
 	}
 	var tokens []*account.Token
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, LastUsedAt: t.LastUsedAt})
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
@@ -195,12 +195,112 @@ func (s *Server) GetAccount(ctx context.Context, r *account.GetAccountRequest) (
 	return toApiAccount(r.Name, *a), nil
 }
 
+// accountNameRegexp matches valid local account names. Dots and colons are reserved since they are used as separators
+// in the argocd-cm keys and in API token subjects.
+var accountNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func parseCapabilities(values []string) ([]settings.AccountCapability, error) {
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one capability is required")
+	}
+	var capabilities []settings.AccountCapability
+	for _, v := range values {
+		c := settings.AccountCapability(v)
+		switch c {
+		case settings.AccountCapabilityLogin, settings.AccountCapabilityApiKey:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid capability '%s', must be one of %s, %s", v, settings.AccountCapabilityLogin, settings.AccountCapabilityApiKey)
+		}
+		found := false
+		for _, existing := range capabilities {
+			if existing == c {
+				found = true
+			}
+		}
+		if !found {
+			capabilities = append(capabilities, c)
+		}
+	}
+	return capabilities, nil
+}
+
+// CreateAccount creates a local account
+func (s *Server) CreateAccount(ctx context.Context, r *account.CreateAccountRequest) (*account.Account, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionCreate, r.Name); err != nil {
+		return nil, err
+	}
+	if !accountNameRegexp.MatchString(r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account name '%s', must match %s", r.Name, accountNameRegexp.String())
+	}
+	capabilities, err := parseCapabilities(r.Capabilities)
+	if err != nil {
+		return nil, err
+	}
+	a := settings.Account{Enabled: !r.Disabled, Capabilities: capabilities}
+	if err := s.settingsMgr.AddAccount(r.Name, a); err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' created account '%s'", session.Username(ctx), r.Name)
+	return toApiAccount(r.Name, a), nil
+}
+
+// UpdateAccount updates whether a local account is enabled and its capabilities
+func (s *Server) UpdateAccount(ctx context.Context, r *account.UpdateAccountRequest) (*account.Account, error) {
+	// unlike the other account operations, accounts are not allowed to update themselves so that they cannot grant
+	// themselves capabilities
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	var capabilities []settings.AccountCapability
+	if len(r.Capabilities) > 0 {
+		if r.Name == common.ArgoCDAdminUsername {
+			return nil, status.Errorf(codes.InvalidArgument, "capabilities of the '%s' account cannot be changed", common.ArgoCDAdminUsername)
+		}
+		var err error
+		if capabilities, err = parseCapabilities(r.Capabilities); err != nil {
+			return nil, err
+		}
+	}
+	var updated settings.Account
+	err := s.settingsMgr.UpdateAccount(r.Name, func(a *settings.Account) error {
+		if r.Enabled != nil {
+			a.Enabled = r.Enabled.Value
+		}
+		if capabilities != nil {
+			a.Capabilities = capabilities
+		}
+		updated = *a
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("user '%s' updated account '%s' (enabled: %t, capabilities: %s)", session.Username(ctx), r.Name, updated.Enabled, updated.FormatCapabilities())
+	return toApiAccount(r.Name, updated), nil
+}
+
 // CreateToken creates a token
 func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest) (*account.CreateTokenResponse, error) {
 	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
 		return nil, err
 	}
 
+	argoCDSettings, err := s.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := argoCDSettings.MaxAPITokenDurationError(); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "API tokens cannot be created: %v", err)
+	}
+	if maxDuration := argoCDSettings.MaxAPITokenDuration; maxDuration > 0 {
+		if r.ExpiresIn <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "tokens must expire, maximum allowed duration is %s", maxDuration)
+		}
+		if time.Duration(r.ExpiresIn)*time.Second > maxDuration {
+			return nil, status.Errorf(codes.InvalidArgument, "token duration %s exceeds the maximum allowed duration %s", time.Duration(r.ExpiresIn)*time.Second, maxDuration)
+		}
+	}
+
 	id := r.Id
 	if id == "" {
 		uniqueId, err := uuid.NewRandom()
@@ -211,7 +311,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	}
 
 	var tokenString string
-	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
 		if account.TokenIndex(id) > -1 {
 			return fmt.Errorf("account already has token with id '%s'", id)
 		}
@@ -261,3 +361,26 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+// DeleteTokens deletes all the tokens of an account and returns them
+func (s *Server) DeleteTokens(ctx context.Context, r *account.DeleteTokensRequest) (*account.TokensList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+
+	var deleted []settings.Token
+	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+		deleted = account.Tokens
+		account.Tokens = nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &account.TokensList{}
+	for _, t := range deleted {
+		resp.Items = append(resp.Items, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, LastUsedAt: t.LastUsedAt})
+	}
+	log.Infof("user '%s' deleted %d token(s) of account '%s'", session.Username(ctx), len(resp.Items), r.Name)
+	return resp, nil
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -36,6 +38,13 @@ func newTestAccountServer(ctx context.Context, opts ...func(cm *v1.ConfigMap, se
 	}, opts...)
 }
 
+// newTestAccountServerWithBuiltinPolicy returns an AccountServer enforcing the built-in RBAC policy
+func newTestAccountServerWithBuiltinPolicy(ctx context.Context, opts ...func(cm *v1.ConfigMap, secret *v1.Secret)) (*Server, *session.Server) {
+	return newTestAccountServerExt(ctx, nil, opts...)
+}
+
+// newTestAccountServerExt returns an AccountServer enforcing the built-in RBAC policy and the given claims enforcer
+// function, or the claims of the users according to the policy if nil
 func newTestAccountServerExt(ctx context.Context, enforceFn rbac.ClaimsEnforcerFunc, opts ...func(cm *v1.ConfigMap, secret *v1.Secret)) (*Server, *session.Server) {
 	bcrypt, err := password.HashPassword("oldpassword")
 	errors.CheckError(err)
@@ -66,6 +75,10 @@ func newTestAccountServerExt(ctx context.Context, enforceFn rbac.ClaimsEnforcerF
 	settingsMgr := settings.NewSettingsManager(ctx, kubeclientset, testNamespace)
 	sessionMgr := sessionutil.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", sessionutil.NewUserStateStorage(nil))
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	errors.CheckError(enforcer.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	if enforceFn == nil {
+		enforceFn = rbacpolicy.NewRBACPolicyEnforcer(enforcer, test.NewFakeProjLister()).EnforceClaims
+	}
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
 	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
@@ -94,6 +107,11 @@ func ssoAdminContext(ctx context.Context, iat time.Time) context.Context {
 	})
 }
 
+func localUserContext(ctx context.Context, name string) context.Context {
+	// nolint:staticcheck
+	return context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: name, Issuer: sessionutil.SessionManagerClaimsIssuer})
+}
+
 func projTokenContext(ctx context.Context) context.Context {
 	// nolint:staticcheck
 	return context.WithValue(ctx, "claims", &jwt.StandardClaims{
@@ -311,3 +329,159 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 
 	assert.Len(t, acc.Tokens, 0)
 }
+
+func TestCreateToken_MaxDuration(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["users.token.maxDuration"] = "24h"
+	})
+
+	t.Run("NoExpiration", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ExceedsMaxDuration", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: int64((25 * time.Hour).Seconds())})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("WithinMaxDuration", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: int64(time.Hour.Seconds())})
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateToken_InvalidMaxDuration(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["users.token.maxDuration"] = "forever"
+	})
+
+	// an invalid maximum duration does not allow tokens without expiration
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: int64(time.Hour.Seconds())})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestDeleteTokens(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		secret.Data["accounts.account1.tokens"] = []byte(`[{"id":"123","iat":1583789194},{"id":"456","iat":1583789195,"lastUsedAt":1583789196}]`)
+	})
+
+	deleted, err := accountServer.DeleteTokens(ctx, &account.DeleteTokensRequest{Name: "account1"})
+	assert.NoError(t, err)
+	if assert.Len(t, deleted.Items, 2) {
+		assert.Equal(t, "123", deleted.Items[0].Id)
+		assert.Equal(t, int64(1583789196), deleted.Items[1].LastUsedAt)
+	}
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	assert.NoError(t, err)
+	assert.Len(t, acc.Tokens, 0)
+}
+
+func TestCreateAccount(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx)
+
+	t.Run("Created", func(t *testing.T) {
+		acc, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account1", Capabilities: []string{"apiKey", "login"}})
+		assert.NoError(t, err)
+		assert.True(t, acc.Enabled)
+		assert.Equal(t, []string{"apiKey", "login"}, acc.Capabilities)
+
+		acc, err = accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+		assert.NoError(t, err)
+		assert.True(t, acc.Enabled)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account2", Capabilities: []string{"login"}, Disabled: true})
+		assert.NoError(t, err)
+
+		acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account2"})
+		assert.NoError(t, err)
+		assert.False(t, acc.Enabled)
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account1", Capabilities: []string{"login"}})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account.3", Capabilities: []string{"login"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InvalidCapabilities", func(t *testing.T) {
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account3"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "account3", Capabilities: []string{"admin"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCreateAccount_BuiltinPolicy(t *testing.T) {
+	ctx := context.Background()
+	accountServer, _ := newTestAccountServerWithBuiltinPolicy(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+	})
+
+	_, err := accountServer.CreateAccount(adminContext(ctx), &account.CreateAccountRequest{Name: "account1", Capabilities: []string{"apiKey"}})
+	assert.NoError(t, err)
+
+	_, err = accountServer.CreateAccount(localUserContext(ctx, "alice"), &account.CreateAccountRequest{Name: "account2", Capabilities: []string{"apiKey"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestUpdateAccount(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	acc, err := accountServer.UpdateAccount(ctx, &account.UpdateAccountRequest{Name: "account1", Enabled: &account.BoolValue{Value: false}})
+	assert.NoError(t, err)
+	assert.False(t, acc.Enabled)
+	assert.Equal(t, []string{"apiKey"}, acc.Capabilities)
+
+	acc, err = accountServer.UpdateAccount(ctx, &account.UpdateAccountRequest{Name: "account1", Enabled: &account.BoolValue{Value: true}, Capabilities: []string{"login"}})
+	assert.NoError(t, err)
+	assert.True(t, acc.Enabled)
+	assert.Equal(t, []string{"login"}, acc.Capabilities)
+
+	// the account stays enabled if only the capabilities are updated
+	acc, err = accountServer.UpdateAccount(ctx, &account.UpdateAccountRequest{Name: "account1", Capabilities: []string{"apiKey"}})
+	assert.NoError(t, err)
+	assert.True(t, acc.Enabled)
+	assert.Equal(t, []string{"apiKey"}, acc.Capabilities)
+
+	_, err = accountServer.UpdateAccount(ctx, &account.UpdateAccountRequest{Name: "admin", Capabilities: []string{"apiKey"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateAccount_CannotUpdateItself(t *testing.T) {
+	ctx := localUserContext(context.Background(), "alice")
+	accountServer, _ := newTestAccountServerWithBuiltinPolicy(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "apiKey"
+	})
+
+	// alice has access to her own account, e.g. to create tokens
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "alice", ExpiresIn: int64(time.Hour.Seconds())})
+	assert.NoError(t, err)
+
+	// but cannot update it without the RBAC permission, e.g. to grant herself capabilities
+	_, err = accountServer.UpdateAccount(ctx, &account.UpdateAccountRequest{Name: "alice", Capabilities: []string{"apiKey", "login"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// which the built-in admin role has
+	_, err = accountServer.UpdateAccount(adminContext(context.Background()), &account.UpdateAccountRequest{Name: "alice", Capabilities: []string{"apiKey", "login"}})
+	assert.NoError(t, err)
+}
@@ -1,9 +1,11 @@
 package application
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"sort"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/Masterminds/semver"
 	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
@@ -42,16 +45,19 @@ import (
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
 	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	reposervercache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/deeplinks"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/lua"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -59,12 +65,15 @@ import (
 
 const (
 	maxPodLogsToRender                 = 10
+	maxLogStreamsToRender              = 30
 	backgroundPropagationPolicy string = "background"
 	foregroundPropagationPolicy string = "foreground"
 )
 
 var (
 	watchAPIBufferSize = env.ParseNumFromEnv(argocommon.EnvWatchAPIBufferSize, 1000, 0, math.MaxInt32)
+	// watchBookmarkInterval is how often the watches allowing bookmarks receive the latest resource version
+	watchBookmarkInterval = time.Minute
 )
 
 // Server provides a Application service
@@ -83,6 +92,7 @@ type Server struct {
 	auditLogger    *argo.AuditLogger
 	settingsMgr    *settings.SettingsManager
 	cache          *servercache.Cache
+	repoCache      *reposervercache.Cache
 	projInformer   cache.SharedIndexInformer
 }
 
@@ -95,6 +105,7 @@ func NewServer(
 	appInformer cache.SharedIndexInformer,
 	repoClientset apiclient.Clientset,
 	cache *servercache.Cache,
+	repoCache *reposervercache.Cache,
 	kubectl kube.Kubectl,
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
@@ -102,7 +113,7 @@ func NewServer(
 	settingsMgr *settings.SettingsManager,
 	projInformer cache.SharedIndexInformer,
 ) application.ApplicationServiceServer {
-	appBroadcaster := &broadcasterHandler{}
+	appBroadcaster := &broadcasterHandler{lastSyncResourceVersion: appInformer.LastSyncResourceVersion}
 	appInformer.AddEventHandler(appBroadcaster)
 	return &Server{
 		ns:             namespace,
@@ -112,6 +123,7 @@ func NewServer(
 		appBroadcaster: appBroadcaster,
 		kubeclientset:  kubeclientset,
 		cache:          cache,
+		repoCache:      repoCache,
 		db:             db,
 		repoClientset:  repoClientset,
 		kubectl:        kubectl,
@@ -130,11 +142,11 @@ func appRBACName(app appv1.Application) string {
 
 // List returns list of applications
 func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*appv1.ApplicationList, error) {
-	labelsMap, err := labels.ConvertSelectorToLabelsMap(q.Selector)
+	selector, err := labels.Parse(q.Selector)
 	if err != nil {
 		return nil, err
 	}
-	apps, err := s.appLister.List(labelsMap.AsSelector())
+	apps, err := s.appLister.List(selector)
 	if err != nil {
 		return nil, err
 	}
@@ -157,9 +169,18 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*ap
 	// Filter applications by source repo URL
 	newItems = argoutil.FilterByRepo(newItems, q.Repo)
 
-	// Sort found applications by name
+	// Filter applications by fields
+	newItems, err = argoutil.FilterByFieldSelector(newItems, q.FieldSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	sortKey, err := newAppSortKey(q.SortBy)
+	if err != nil {
+		return nil, err
+	}
 	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
+		return sortKey.less(newItems[i], newItems[j])
 	})
 
 	appList := appv1.ApplicationList{
@@ -168,9 +189,136 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*ap
 		},
 		Items: newItems,
 	}
+	if err := paginateApps(&appList, sortKey, q.Limit, q.Continue); err != nil {
+		return nil, err
+	}
 	return &appList, nil
 }
 
+// appSortKey is the key the applications of a list are sorted by
+type appSortKey struct {
+	// field is the sorted field: name, sync or health
+	field      string
+	descending bool
+}
+
+func newAppSortKey(sortBy string) (appSortKey, error) {
+	key := appSortKey{field: strings.TrimPrefix(sortBy, "-"), descending: strings.HasPrefix(sortBy, "-")}
+	switch key.field {
+	case "":
+		key.field = "name"
+	case "name", "sync", "health":
+	default:
+		return key, status.Errorf(codes.InvalidArgument, "invalid sort key '%s': must be one of name, sync or health", sortBy)
+	}
+	return key, nil
+}
+
+func (k appSortKey) String() string {
+	if k.descending {
+		return "-" + k.field
+	}
+	return k.field
+}
+
+// value returns the value of the sorted field of the application, which is empty when sorting by name
+func (k appSortKey) value(a appv1.Application) string {
+	switch k.field {
+	case "sync":
+		return string(a.Status.Sync.Status)
+	case "health":
+		return string(a.Status.Health.Status)
+	}
+	return ""
+}
+
+// healthSeverity orders the health statuses from the most to the least healthy. Unknown statuses are the least healthy.
+var healthSeverity = map[string]int{
+	string(health.HealthStatusHealthy):     0,
+	string(health.HealthStatusSuspended):   1,
+	string(health.HealthStatusProgressing): 2,
+	string(health.HealthStatusMissing):     3,
+	string(health.HealthStatusDegraded):    4,
+}
+
+// compare compares the applications with the given sorted field values and names. Applications with the same value
+// are sorted by name. Health values are compared by severity.
+func (k appSortKey) compare(value1, name1, value2, name2 string) bool {
+	if value1 == value2 {
+		return k.ordered(name1, name2)
+	}
+	if k.field == "health" {
+		return k.ordered(healthRank(value1), healthRank(value2))
+	}
+	return k.ordered(value1, value2)
+}
+
+// ordered returns whether the first value comes before the second one in the sort direction
+func (k appSortKey) ordered(value1, value2 string) bool {
+	if k.descending {
+		return value1 > value2
+	}
+	return value1 < value2
+}
+
+// healthRank returns a string that sorts the health statuses by severity and the unknown ones alphabetically
+func healthRank(status string) string {
+	if severity, ok := healthSeverity[status]; ok {
+		return fmt.Sprintf("%d", severity)
+	}
+	return fmt.Sprintf("%d%s", len(healthSeverity), status)
+}
+
+func (k appSortKey) less(a1, a2 appv1.Application) bool {
+	return k.compare(k.value(a1), a1.Name, k.value(a2), a2.Name)
+}
+
+// appContinueToken identifies the last application of a page of a sorted list. The next page starts after it so
+// that the applications created or deleted in between don't shift the pages.
+type appContinueToken struct {
+	SortBy string `json:"sortBy"`
+	Value  string `json:"value,omitempty"`
+	Name   string `json:"name"`
+}
+
+// paginateApps restricts the sorted applications of the list to the page starting after the given continue token
+func paginateApps(appList *appv1.ApplicationList, sortKey appSortKey, limit int64, continueToken string) error {
+	if limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	items := appList.Items
+	if continueToken != "" {
+		data, err := base64.RawURLEncoding.DecodeString(continueToken)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid continue token")
+		}
+		var token appContinueToken
+		if err := json.Unmarshal(data, &token); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid continue token")
+		}
+		if token.SortBy != sortKey.String() {
+			return status.Errorf(codes.InvalidArgument, "continue token was returned for a list sorted by '%s'", token.SortBy)
+		}
+		start := sort.Search(len(items), func(i int) bool {
+			return sortKey.compare(token.Value, token.Name, sortKey.value(items[i]), items[i].Name)
+		})
+		items = items[start:]
+	}
+	if limit > 0 && int64(len(items)) > limit {
+		last := items[limit-1]
+		data, err := json.Marshal(appContinueToken{SortBy: sortKey.String(), Value: sortKey.value(last), Name: last.Name})
+		if err != nil {
+			return err
+		}
+		remaining := int64(len(items)) - limit
+		appList.Continue = base64.RawURLEncoding.EncodeToString(data)
+		appList.RemainingItemCount = &remaining
+		items = items[:limit]
+	}
+	appList.Items = items
+	return nil
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *application.ApplicationCreateRequest) (*appv1.Application, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionCreate, appRBACName(q.Application)); err != nil {
@@ -230,6 +378,7 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	helmRepos []*appv1.Repository,
 	helmCreds []*v1alpha1.RepoCreds,
 	kustomizeOptions *v1alpha1.KustomizeOptions,
+	helmOptions *v1alpha1.HelmOptions,
 ) error) error {
 
 	closer, client, err := s.repoClientset.NewRepoServerClient()
@@ -241,19 +390,27 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	if err != nil {
 		return err
 	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
+		}
+		return err
+	}
 	kustomizeSettings, err := s.settingsMgr.GetKustomizeSettings()
 	if err != nil {
 		return err
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(a.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(a.Spec.Source, proj)
 	if err != nil {
 		return err
 	}
-	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	helmSettings, err := s.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return err
+	}
+	helmOptions, err := helmSettings.GetOptions(a.Spec.Source, proj)
 	if err != nil {
-		if apierr.IsNotFound(err) {
-			return status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
-		}
 		return err
 	}
 
@@ -274,7 +431,7 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	if err != nil {
 		return err
 	}
-	return action(client, repo, permittedHelmRepos, permittedHelmCredentials, kustomizeOptions)
+	return action(client, repo, permittedHelmRepos, permittedHelmCredentials, kustomizeOptions, helmOptions)
 }
 
 // GetManifests returns application manifests
@@ -287,13 +444,42 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, err
 	}
 
-	var manifestInfo *apiclient.ManifestResponse
-	err = s.queryRepoServer(ctx, a, func(
-		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, kustomizeOptions *appv1.KustomizeOptions) error {
-		revision := a.Spec.Source.TargetRevision
-		if q.Revision != "" {
-			revision = q.Revision
+	revision := a.Spec.Source.TargetRevision
+	if q.Revision != "" {
+		revision = q.Revision
+	}
+	manifestInfo, err := s.generateManifests(ctx, a, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, manifest := range manifestInfo.Manifests {
+		obj := &unstructured.Unstructured{}
+		err = json.Unmarshal([]byte(manifest), obj)
+		if err != nil {
+			return nil, err
+		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			obj, _, err = diff.HideSecretData(obj, nil)
+			if err != nil {
+				return nil, err
+			}
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, err
+			}
+			manifestInfo.Manifests[i] = string(data)
 		}
+	}
+
+	return manifestInfo, nil
+}
+
+// generateManifests generates the manifests of the application at the given revision with the repo server
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, revision string) (*apiclient.ManifestResponse, error) {
+	var manifestInfo *apiclient.ManifestResponse
+	err := s.queryRepoServer(ctx, a, func(
+		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, kustomizeOptions *appv1.KustomizeOptions, helmOptions *appv1.HelmOptions) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
 			return err
@@ -318,47 +504,47 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return err
 		}
 
-		manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
-			Repo:              repo,
-			Revision:          revision,
-			AppLabelKey:       appInstanceLabelKey,
-			AppName:           a.Name,
-			Namespace:         a.Spec.Destination.Namespace,
-			ApplicationSource: &a.Spec.Source,
-			Repos:             helmRepos,
-			Plugins:           plugins,
-			KustomizeOptions:  kustomizeOptions,
-			KubeVersion:       serverVersion,
-			ApiVersions:       argo.APIGroupsToVersions(apiGroups),
-			HelmRepoCreds:     helmCreds,
-		})
-		return err
-	})
-
-	if err != nil {
-		return nil, err
-	}
+		manifestGenerationTimeout, err := a.GetManifestGenerationTimeout()
+		if err != nil {
+			return err
+		}
 
-	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
+		proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil)
-			if err != nil {
-				return nil, err
-			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return nil, err
-			}
-			manifestInfo.Manifests[i] = string(data)
+		jsonnetLibRepos, err := argo.GetJsonnetLibRepos(ctx, s.db, proj, a.Spec.Source)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		helmGitDependencyRepos, err := argo.GetHelmGitDependencyRepos(ctx, s.db, proj)
+		if err != nil {
+			return err
 		}
-	}
 
-	return manifestInfo, nil
+		manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+			Repo:                             repo,
+			Revision:                         revision,
+			AppLabelKey:                      appInstanceLabelKey,
+			AppName:                          a.Name,
+			Namespace:                        a.Spec.Destination.Namespace,
+			TrackingMethod:                   string(argo.GetTrackingMethod(s.settingsMgr)),
+			ApplicationSource:                &a.Spec.Source,
+			Repos:                            helmRepos,
+			Plugins:                          plugins,
+			KustomizeOptions:                 kustomizeOptions,
+			KubeVersion:                      serverVersion,
+			ApiVersions:                      argo.APIGroupsToVersions(apiGroups),
+			HelmRepoCreds:                    helmCreds,
+			ManifestGenerationTimeoutSeconds: int64(manifestGenerationTimeout.Seconds()),
+			JsonnetLibRepos:                  jsonnetLibRepos,
+			HelmGitDependencyRepos:           helmGitDependencyRepos,
+			HelmOptions:                      helmOptions,
+			SopsKeySet:                       proj.Spec.SopsKeySet,
+		})
+		return err
+	})
+	return manifestInfo, err
 }
 
 // Get returns an application by name
@@ -379,6 +565,11 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	if q.Refresh == nil {
 		return a, nil
 	}
+	if a.IsReconcileSkipped() {
+		// the controller does not refresh the application until its reconciliation is resumed
+		log.WithField("application", a.Name).Infof("Skipping refresh: reconciliation is paused by the %s annotation", appv1.AnnotationKeySkipReconcile)
+		return a, nil
+	}
 
 	refreshType := appv1.RefreshTypeNormal
 	if *q.Refresh == string(appv1.RefreshTypeHard) {
@@ -399,6 +590,10 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 	}
 
 	if refreshType == appv1.RefreshTypeHard {
+		plugins, err := s.plugins()
+		if err != nil {
+			log.Warnf("Failed to get config management plugins: %v", err)
+		}
 		// force refresh cached application details
 		if err := s.queryRepoServer(ctx, a, func(
 			client apiclient.RepoServerServiceClient,
@@ -406,6 +601,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 			helmRepos []*appv1.Repository,
 			_ []*appv1.RepoCreds,
 			kustomizeOptions *appv1.KustomizeOptions,
+			helmOptions *appv1.HelmOptions,
 		) error {
 			_, err := client.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
 				Repo:             repo,
@@ -414,6 +610,8 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*app
 				KustomizeOptions: kustomizeOptions,
 				Repos:            helmRepos,
 				NoCache:          true,
+				Plugins:          plugins,
+				HelmOptions:      helmOptions,
 			})
 			return err
 		}); err != nil {
@@ -744,6 +942,8 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			minVersion = 0
 		}
 	}
+	// sent holds the JSON of the applications last sent to incremental watches, which the next events are patches of
+	sent := map[string][]byte{}
 
 	// sendIfPermitted is a helper to send the application to the client's streaming channel if the
 	// caller has RBAC privileges permissions to view it
@@ -760,10 +960,22 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			// do not emit apps user does not have accessing
 			return
 		}
-		err := ws.Send(&appv1.ApplicationWatchEvent{
+		event := &appv1.ApplicationWatchEvent{
 			Type:        eventType,
 			Application: a,
-		})
+		}
+		if q.Incremental {
+			incrementalEvent, err := incrementalWatchEvent(sent, a, eventType)
+			if err != nil {
+				logCtx.Warnf("Unable to compute the application patch: %v", err)
+				return
+			}
+			if incrementalEvent == nil {
+				return
+			}
+			event = incrementalEvent
+		}
+		err := ws.Send(event)
 		if err != nil {
 			logCtx.Warnf("Unable to send stream message: %v", err)
 			return
@@ -771,6 +983,14 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 	}
 
 	events := make(chan *appv1.ApplicationWatchEvent, watchAPIBufferSize)
+	// subscribe before listing the applications or replaying the history so that no event is missed in between
+	unsubscribe := s.appBroadcaster.Subscribe(events)
+	defer unsubscribe()
+	// streamVersion is the resource version of the latest application or event the stream was sent or filtered out,
+	// which the bookmarks hold
+	streamVersion := minVersion
+	// replayedVersion is the resource version of the latest replayed event, received again by the subscription
+	replayedVersion := 0
 	// Mimic watch API behavior: send ADDED events if no resource version provided
 	// If watch API is executed for one application when emit event even if resource version is provided
 	// This is required since single app watch API is used for during operations like app syncing and it is
@@ -784,21 +1004,85 @@ func (s *Server) Watch(q *application.ApplicationQuery, ws application.Applicati
 			return apps[i].Name < apps[j].Name
 		})
 		for i := range apps {
+			if appVersion, err := strconv.Atoi(apps[i].ResourceVersion); err == nil && appVersion > streamVersion {
+				streamVersion = appVersion
+			}
 			sendIfPermitted(*apps[i], watch.Added)
 		}
+	} else {
+		// resume the watch: replay the events the client missed
+		history, latest, ok := s.appBroadcaster.since(minVersion)
+		if !ok {
+			return status.Errorf(codes.OutOfRange, "resource version %s is too old to resume the watch, applications must be listed again", q.ResourceVersion)
+		}
+		for _, event := range history {
+			sendIfPermitted(event.Application, event.Type)
+		}
+		streamVersion, replayedVersion = latest, latest
+	}
+
+	var bookmarks <-chan time.Time
+	if q.AllowWatchBookmarks {
+		ticker := time.NewTicker(watchBookmarkInterval)
+		defer ticker.Stop()
+		bookmarks = ticker.C
 	}
-	unsubscribe := s.appBroadcaster.Subscribe(events)
-	defer unsubscribe()
 	for {
 		select {
 		case event := <-events:
+			eventVersion := resourceVersion(event)
+			if eventVersion > 0 && eventVersion <= replayedVersion {
+				continue
+			}
+			if eventVersion > streamVersion {
+				streamVersion = eventVersion
+			}
 			sendIfPermitted(event.Application, event.Type)
+		case <-bookmarks:
+			if streamVersion == 0 {
+				continue
+			}
+			err := ws.Send(&appv1.ApplicationWatchEvent{
+				Type:        watch.Bookmark,
+				Application: appv1.Application{ObjectMeta: metav1.ObjectMeta{ResourceVersion: strconv.Itoa(streamVersion)}},
+			})
+			if err != nil {
+				logCtx.Warnf("Unable to send stream message: %v", err)
+			}
 		case <-ws.Context().Done():
 			return nil
 		}
 	}
 }
 
+// incrementalWatchEvent returns the event of an incremental watch, given the JSON of the applications it was last
+// sent. The modifications of these applications are sent as JSON merge patches, and the deleted applications only hold
+// their name and resource version. Returns nil if the application did not change.
+func incrementalWatchEvent(sent map[string][]byte, a appv1.Application, eventType watch.EventType) (*appv1.ApplicationWatchEvent, error) {
+	compact := appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: a.Name, Namespace: a.Namespace, ResourceVersion: a.ResourceVersion}}
+	if eventType == watch.Deleted {
+		delete(sent, a.Name)
+		return &appv1.ApplicationWatchEvent{Type: eventType, Application: compact}, nil
+	}
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	prevData, ok := sent[a.Name]
+	sent[a.Name] = data
+	if eventType != watch.Modified || !ok {
+		return &appv1.ApplicationWatchEvent{Type: eventType, Application: a}, nil
+	}
+	patch, err := jsonpatch.CreateMergePatch(prevData, data)
+	if err != nil {
+		return nil, err
+	}
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+	return &appv1.ApplicationWatchEvent{Type: eventType, Application: compact, Patch: string(patch)}, nil
+}
+
 func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Application, validate bool) error {
 	proj, err := argo.GetAppProject(&app.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
 	if err != nil {
@@ -833,7 +1117,15 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	if err != nil {
 		return err
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source, proj)
+	if err != nil {
+		return err
+	}
+	helmSettings, err := s.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return err
+	}
+	helmOptions, err := helmSettings.GetOptions(app.Spec.Source, proj)
 	if err != nil {
 		return err
 	}
@@ -848,7 +1140,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 
 	var conditions []appv1.ApplicationCondition
 	if validate {
-		conditions, err = argo.ValidateRepo(ctx, app, s.repoClientset, s.db, kustomizeOptions, plugins, s.kubectl, proj)
+		conditions, err = argo.ValidateRepo(ctx, app, s.repoClientset, s.db, kustomizeOptions, helmOptions, plugins, s.kubectl, proj)
 		if err != nil {
 			return err
 		}
@@ -869,6 +1161,8 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 	return nil
 }
 
+// getApplicationClusterConfig returns the configuration of the destination cluster of the application, impersonating
+// the service account of the destination if impersonation is enabled, like the application controller
 func (s *Server) getApplicationClusterConfig(ctx context.Context, a *appv1.Application) (*rest.Config, error) {
 	if err := argo.ValidateDestination(ctx, &a.Spec.Destination, s.db); err != nil {
 		return nil, err
@@ -877,8 +1171,15 @@ func (s *Server) getApplicationClusterConfig(ctx context.Context, a *appv1.Appli
 	if err != nil {
 		return nil, err
 	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, err
+	}
 	config := clst.RESTConfig()
-	return config, err
+	if err := argo.ImpersonateServiceAccount(s.settingsMgr, proj, a.Spec.Destination, config); err != nil {
+		return nil, err
+	}
+	return config, nil
 }
 
 // getCachedAppState loads the cached state and trigger app refresh if cache is missing
@@ -912,12 +1213,28 @@ func (s *Server) getAppResources(ctx context.Context, a *appv1.Application) (*ap
 	return &tree, err
 }
 
+// enforceResourceAction checks that the given action is allowed on the whole application, or on the requested resource only.
+// An explicit deny of the action on the whole application also denies it on the resource, as the denies of an action
+// cover its sub-actions.
+func (s *Server) enforceResourceAction(ctx context.Context, action string, a *appv1.Application, q *application.ApplicationResourceRequest) error {
+	if s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a)) {
+		return nil
+	}
+	return s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ResourceAction(action, q.Group, q.Kind, q.Namespace, q.ResourceName), appRBACName(*a))
+}
+
 func (s *Server) getAppResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.ResourceNode, *rest.Config, *appv1.Application, error) {
 	a, err := s.appLister.Get(*q.Name)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a)); err != nil {
+	switch action {
+	case rbacpolicy.ActionUpdate, rbacpolicy.ActionDelete:
+		err = s.enforceResourceAction(ctx, action, a, q)
+	default:
+		err = s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a))
+	}
+	if err != nil {
 		return nil, nil, nil, err
 	}
 
@@ -987,9 +1304,6 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 	if err != nil {
 		return nil, err
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, appRBACName(*a)); err != nil {
-		return nil, err
-	}
 
 	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.PatchType), []byte(q.Patch))
 	if err != nil {
@@ -1027,9 +1341,6 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	if err != nil {
 		return nil, err
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, appRBACName(*a)); err != nil {
-		return nil, err
-	}
 	var deleteOption metav1.DeleteOptions
 	if q.GetOrphan() {
 		propagationPolicy := metav1.DeletePropagationOrphan
@@ -1168,14 +1479,13 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		}
 	}
 
-	literal := ""
-	inverse := false
-	if q.GetFilter() != "" {
-		literal = *q.Filter
-		if literal[0] == '!' {
-			literal = literal[1:]
-			inverse = true
-		}
+	if untilTime != nil && q.SinceTime != nil && q.SinceTime.After(untilTime.Time) {
+		return status.Errorf(codes.InvalidArgument, "sinceTime must be before untilTime")
+	}
+
+	matchFilter, err := newLogFilter(q.GetFilter(), q.FilterRegex)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter parameter value: %v", err)
 	}
 
 	a, err := s.appLister.Get(q.GetName())
@@ -1186,6 +1496,16 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return err
 	}
+	// the logs may contain sensitive information, so reading them can require a separate permission
+	logsEnforced, err := s.settingsMgr.GetServerRBACLogEnforceEnable()
+	if err != nil {
+		return err
+	}
+	if logsEnforced {
+		if err := s.enf.EnforceErr(ws.Context().Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionLogs, appRBACName(*a)); err != nil {
+			return err
+		}
+	}
 
 	tree, err := s.getAppResources(ws.Context(), a)
 	if err != nil {
@@ -1212,50 +1532,87 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		return errors.New("Max pods to view logs are reached. Please provide more granular query.")
 	}
 
-	var streams []chan logEntry
+	// stop reading the logs of every container once the request completes
+	ctx, cancel := context.WithCancel(ws.Context())
+	defer cancel()
 
+	type podContainer struct {
+		pod       appv1.ResourceNode
+		container string
+		err       error
+	}
+	var targets []podContainer
 	for _, pod := range pods {
-		stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &v1.PodLogOptions{
-			Container:    q.Container,
-			Follow:       q.Follow,
-			Timestamps:   true,
-			SinceSeconds: sinceSeconds,
-			SinceTime:    q.SinceTime,
-			TailLines:    tailLines,
-		}).Stream(ws.Context())
-		podName := pod.Name
-		logStream := make(chan logEntry)
+		if !q.AllContainers {
+			targets = append(targets, podContainer{pod: pod, container: q.Container})
+			continue
+		}
+		containers, err := getPodContainers(ctx, kubeClientset, pod.Namespace, pod.Name)
+		if err != nil {
+			targets = append(targets, podContainer{pod: pod, err: err})
+			continue
+		}
+		for _, container := range containers {
+			targets = append(targets, podContainer{pod: pod, container: container})
+		}
+	}
+	if len(targets) > maxLogStreamsToRender {
+		return errors.New("Max containers to view logs are reached. Please provide more granular query.")
+	}
+
+	var streams []chan logEntry
+	for _, target := range targets {
+		podName := target.pod.Name
+		containerName := target.container
+		err := target.err
+		var stream io.ReadCloser
+		if err == nil {
+			stream, err = kubeClientset.CoreV1().Pods(target.pod.Namespace).GetLogs(podName, &v1.PodLogOptions{
+				Container:    containerName,
+				Follow:       q.Follow,
+				Previous:     q.Previous,
+				Timestamps:   true,
+				SinceSeconds: sinceSeconds,
+				SinceTime:    q.SinceTime,
+				TailLines:    tailLines,
+			}).Stream(ctx)
+		}
 		if err == nil {
 			defer ioutil.Close(stream)
 		}
 
+		logStream := make(chan logEntry)
 		streams = append(streams, logStream)
 		go func() {
 			// if k8s failed to start steaming logs (typically because Pod is not ready yet)
 			// then the error should be shown in the UI so that user know the reason
 			if err != nil {
-				logStream <- logEntry{line: err.Error()}
+				logStream <- logEntry{line: err.Error(), podName: podName, containerName: containerName}
 			} else {
-				parseLogsStream(podName, stream, logStream)
+				parseLogsStream(podName, containerName, stream, logStream)
 			}
 			close(logStream)
 		}()
 	}
 
 	logStream := mergeLogStreams(streams, time.Millisecond*100)
+	// once the request completes, the canceled streams are drained so that none of the goroutines stays blocked
+	defer func() {
+		go func() {
+			for range logStream {
+			}
+		}()
+	}()
 	sentCount := int64(0)
-	done := make(chan error)
+	done := make(chan error, 1)
 	go func() {
 		for entry := range logStream {
 			if entry.err != nil {
 				done <- entry.err
 				return
 			} else {
-				if q.Filter != nil {
-					lineContainsFilter := strings.Contains(entry.line, literal)
-					if (inverse && lineContainsFilter) || (!inverse && !lineContainsFilter) {
-						continue
-					}
+				if !matchFilter(entry.line) {
+					continue
 				}
 				if untilTime != nil && entry.timeStamp.After(untilTime.Time) {
 					done <- ws.Send(&application.LogEntry{
@@ -1265,13 +1622,14 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 				} else {
 					sentCount++
 					if err := ws.Send(&application.LogEntry{
-						PodName:      entry.podName,
-						Content:      entry.line,
-						TimeStampStr: entry.timeStamp.Format(time.RFC3339Nano),
-						TimeStamp:    metav1.NewTime(entry.timeStamp),
+						PodName:       entry.podName,
+						ContainerName: entry.containerName,
+						Content:       entry.line,
+						TimeStampStr:  entry.timeStamp.Format(time.RFC3339Nano),
+						TimeStamp:     metav1.NewTime(entry.timeStamp),
 					}); err != nil {
 						done <- err
-						break
+						return
 					}
 				}
 			}
@@ -1358,7 +1716,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		return a, err
 	}
 
-	if !proj.Spec.SyncWindows.Matches(a).CanSync(true) {
+	clusterLabels, namespaceLabels, err := argo.GetSyncWindowsLabels(ctx, &proj.Spec.SyncWindows, a, s.db, argo.GetLiveNamespaceLabels)
+	if err != nil {
+		return a, fmt.Errorf("error getting the labels selected by sync windows: %w", err)
+	}
+	if !proj.Spec.SyncWindows.MatchesWithLabels(a, clusterLabels, namespaceLabels).CanSync(true) {
 		return a, status.Errorf(codes.PermissionDenied, "Cannot sync: Blocked by sync window")
 	}
 
@@ -1376,6 +1738,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.IsReconcileSkipped() {
+		return nil, status.Errorf(codes.FailedPrecondition, "application reconciliation is paused by the %s annotation", appv1.AnnotationKeySkipReconcile)
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != text.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
@@ -1399,6 +1764,12 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		syncOptions = syncReq.SyncOptions.Items
 	}
 
+	for _, r := range syncReq.Resources {
+		if r.Kind == "" || r.Name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "The kind and name of the resources to sync are required")
+		}
+	}
+
 	// We cannot use local manifests if we're only allowed to sync to signed commits
 	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
@@ -1448,6 +1819,9 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if a.IsReconcileSkipped() {
+		return nil, status.Errorf(codes.FailedPrecondition, "application reconciliation is paused by the %s annotation", appv1.AnnotationKeySkipReconcile)
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
@@ -1510,24 +1884,46 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 		if helm.IsVersion(ambiguousRevision) {
 			return ambiguousRevision, ambiguousRevision, nil
 		}
-		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || app.Spec.Source.IsHelmOci(), repo.Proxy)
-		index, err := client.GetIndex(false)
+		enableOCI := repo.EnableOCI || app.Spec.Source.IsHelmOci()
+		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), enableOCI, repo.Proxy)
+		var entries helm.Entries
+		if enableOCI {
+			entries, err = client.GetTags(app.Spec.Source.Chart)
+			if err != nil {
+				return "", "", err
+			}
+		} else {
+			index, err := client.GetIndex(false)
+			if err != nil {
+				return "", "", err
+			}
+			entries, err = index.GetEntries(app.Spec.Source.Chart)
+			if err != nil {
+				return "", "", err
+			}
+		}
+		constraints, err := semver.NewConstraint(ambiguousRevision)
 		if err != nil {
 			return "", "", err
 		}
-		entries, err := index.GetEntries(app.Spec.Source.Chart)
+		version, err := entries.MaxVersion(constraints)
 		if err != nil {
 			return "", "", err
 		}
-		constraints, err := semver.NewConstraint(ambiguousRevision)
+		return version.String(), fmt.Sprintf("%v (%v)", ambiguousRevision, version.String()), nil
+	} else if app.Spec.Source.IsOCI() {
+		if oci.IsDigest(ambiguousRevision) {
+			return ambiguousRevision, ambiguousRevision, nil
+		}
+		repo, err := s.db.GetRepository(ctx, app.Spec.Source.RepoURL)
 		if err != nil {
 			return "", "", err
 		}
-		version, err := entries.MaxVersion(constraints)
+		revision, err = oci.NewClient(repo.Repo, repo.GetOCICreds(), repo.Proxy).ResolveRevision(ambiguousRevision)
 		if err != nil {
 			return "", "", err
 		}
-		return version.String(), fmt.Sprintf("%v (%v)", ambiguousRevision, version.String()), nil
+		return revision, fmt.Sprintf("%s (%s)", ambiguousRevision, revision), nil
 	} else {
 		if git.IsCommitSHA(ambiguousRevision) {
 			// If it's already a commit SHA, then no need to look it up
@@ -1537,7 +1933,13 @@ func (s *Server) resolveRevision(ctx context.Context, app *appv1.Application, sy
 		if err != nil {
 			return "", "", err
 		}
-		gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy)
+		var opts []git.ClientOpts
+		if s.repoCache != nil {
+			// the revision to sync is always resolved from the remote, the resolved references are stored in the
+			// shared cache for the repo servers
+			opts = append(opts, git.WithCache(s.repoCache, false))
+		}
+		gitClient, err := git.NewClient(repo.Repo, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, opts...)
 		if err != nil {
 			return "", "", err
 		}
@@ -1603,7 +2005,7 @@ func (s *Server) logResourceEvent(res *appv1.ResourceNode, ctx context.Context,
 }
 
 func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
-	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+	res, config, a, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
 	if err != nil {
 		return nil, err
 	}
@@ -1611,7 +2013,7 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 	if err != nil {
 		return nil, err
 	}
-	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	resourceOverrides, err := s.getResourceOverrides(ctx, a)
 	if err != nil {
 		return nil, err
 	}
@@ -1624,6 +2026,93 @@ func (s *Server) ListResourceActions(ctx context.Context, q *application.Applica
 	return &application.ResourceActionsListResponse{Actions: availableActions}, nil
 }
 
+// ListLinks returns the deep links of the application configured in argocd-cm
+func (s *Server) ListLinks(ctx context.Context, q *application.ListAppLinksRequest) (*application.LinksResponse, error) {
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	links, err := s.settingsMgr.GetApplicationDeepLinks()
+	if err != nil {
+		return nil, err
+	}
+	items, err := deeplinks.EvaluateDeepLinks(links, a, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &application.LinksResponse{Items: items}, nil
+}
+
+// ListResourceLinks returns the deep links of the application resource configured in argocd-cm
+func (s *Server) ListResourceLinks(ctx context.Context, q *application.ApplicationResourceRequest) (*application.LinksResponse, error) {
+	res, _, a, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.getCachedAppResource(ctx, a, res)
+	if err != nil {
+		return nil, err
+	}
+	// the values of the secrets must not end up in the links
+	obj, err = replaceSecretValues(obj)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.settingsMgr.GetResourceDeepLinks()
+	if err != nil {
+		return nil, err
+	}
+	items, err := deeplinks.EvaluateDeepLinks(links, a, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &application.LinksResponse{Items: items}, nil
+}
+
+// getCachedAppResource returns the live state of the given resource of the application as last cached by the
+// controller. The live state of the resources the application does not manage directly is not cached: these get an
+// object with the identity of their resource tree node only.
+func (s *Server) getCachedAppResource(ctx context.Context, a *appv1.Application, res *appv1.ResourceNode) (*unstructured.Unstructured, error) {
+	managedResources := make([]*appv1.ResourceDiff, 0)
+	if err := s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.Name, &managedResources)
+	}); err != nil {
+		return nil, err
+	}
+	for _, managed := range managedResources {
+		if managed.Group != res.Group || managed.Kind != res.Kind || managed.Namespace != res.Namespace || managed.Name != res.Name {
+			continue
+		}
+		if managed.LiveState == "" || managed.LiveState == "null" {
+			break
+		}
+		return appv1.UnmarshalToUnstructured(managed.LiveState)
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(res.GroupKindVersion())
+	obj.SetNamespace(res.Namespace)
+	obj.SetName(res.Name)
+	obj.SetUID(types.UID(res.UID))
+	return obj, nil
+}
+
+// getResourceOverrides returns the resource overrides of argocd-cm completed with the resource customizations of the
+// project of the application
+func (s *Server) getResourceOverrides(ctx context.Context, a *appv1.Application) (map[string]appv1.ResourceOverride, error) {
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, err
+	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return proj.ResourceOverrides(resourceOverrides), nil
+}
+
 func (s *Server) getAvailableActions(resourceOverrides map[string]appv1.ResourceOverride, obj *unstructured.Unstructured) ([]appv1.ResourceAction, error) {
 	luaVM := lua.VM{
 		ResourceOverrides: resourceOverrides,
@@ -1663,7 +2152,7 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, err
 	}
 
-	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	resourceOverrides, err := s.getResourceOverrides(ctx, a)
 	if err != nil {
 		return nil, err
 	}
@@ -1676,7 +2165,21 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		return nil, err
 	}
 
-	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	availableActions, err := s.getAvailableActions(resourceOverrides, liveObj)
+	if err != nil {
+		return nil, err
+	}
+	declaredParams := lua.FindResourceActionParams(availableActions, q.Action)
+	paramValues, err := lua.SplitResourceActionParams(q.Parameters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	params, err := lua.ParseResourceActionParams(declaredParams, paramValues)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameters of action %s: %v", q.Action, err)
+	}
+
+	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1770,7 +2273,7 @@ func splitStatusPatch(patch []byte) ([]byte, []byte, error) {
 }
 
 func (s *Server) plugins() ([]*v1alpha1.ConfigManagementPlugin, error) {
-	plugins, err := s.settingsMgr.GetConfigManagementPlugins()
+	plugins, err := s.settingsMgr.GetConfigManagementPluginsWithSecrets()
 	if err != nil {
 		return nil, err
 	}
@@ -1798,7 +2301,11 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 		return nil, err
 	}
 
-	windows := proj.Spec.SyncWindows.Matches(a)
+	clusterLabels, namespaceLabels, err := argo.GetSyncWindowsLabels(ctx, &proj.Spec.SyncWindows, a, s.db, argo.GetLiveNamespaceLabels)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the labels selected by sync windows: %w", err)
+	}
+	windows := proj.Spec.SyncWindows.MatchesWithLabels(a, clusterLabels, namespaceLabels)
 	sync := windows.CanSync(true)
 
 	res := &application.ApplicationSyncWindowsResponse{
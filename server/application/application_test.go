@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/argoproj/pkg/sync"
@@ -35,10 +36,12 @@ import (
 	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
@@ -180,6 +183,7 @@ func newTestAppServerWithEnforcerConfigure(f func(*rbac.Enforcer), objects ...ru
 		appInformer,
 		mockRepoClient,
 		nil,
+		nil,
 		&kubetest.MockKubectlCmd{},
 		db,
 		enforcer,
@@ -288,6 +292,92 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsPaginated(t *testing.T) {
+	newApp := func(name string, syncStatus appsv1.SyncStatusCode) runtime.Object {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Name = name
+			app.Status.Sync.Status = syncStatus
+		})
+	}
+	appServer := newTestAppServer(
+		newApp("a", appsv1.SyncStatusCodeSynced),
+		newApp("b", appsv1.SyncStatusCodeOutOfSync),
+		newApp("c", appsv1.SyncStatusCodeSynced),
+		newApp("d", appsv1.SyncStatusCodeOutOfSync),
+		newApp("e", appsv1.SyncStatusCodeSynced),
+	)
+	list := func(q *application.ApplicationQuery) ([]string, *appsv1.ApplicationList) {
+		res, err := appServer.List(context.Background(), q)
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names, res
+	}
+
+	names, res := list(&application.ApplicationQuery{SortBy: "sync", Limit: 3})
+	assert.Equal(t, []string{"b", "d", "a"}, names)
+	assert.NotEmpty(t, res.Continue)
+	assert.Equal(t, int64(2), *res.RemainingItemCount)
+
+	names, res = list(&application.ApplicationQuery{SortBy: "sync", Limit: 3, Continue: res.Continue})
+	assert.Equal(t, []string{"c", "e"}, names)
+	assert.Empty(t, res.Continue)
+	assert.Nil(t, res.RemainingItemCount)
+
+	names, res = list(&application.ApplicationQuery{SortBy: "-name", Limit: 2})
+	assert.Equal(t, []string{"e", "d"}, names)
+	names, _ = list(&application.ApplicationQuery{SortBy: "-name", Limit: 2, Continue: res.Continue})
+	assert.Equal(t, []string{"c", "b"}, names)
+
+	names, _ = list(&application.ApplicationQuery{FieldSelector: "status.sync.status=OutOfSync"})
+	assert.Equal(t, []string{"b", "d"}, names)
+
+	_, err := appServer.List(context.Background(), &application.ApplicationQuery{SortBy: "name", Continue: res.Continue})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.List(context.Background(), &application.ApplicationQuery{SortBy: "size"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = appServer.List(context.Background(), &application.ApplicationQuery{Continue: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAppsSortedByHealth(t *testing.T) {
+	newApp := func(name string, healthStatus health.HealthStatusCode) runtime.Object {
+		return newTestApp(func(app *appsv1.Application) {
+			app.Name = name
+			app.Status.Health.Status = healthStatus
+		})
+	}
+	appServer := newTestAppServer(
+		newApp("a", health.HealthStatusDegraded),
+		newApp("b", health.HealthStatusHealthy),
+		newApp("c", health.HealthStatusUnknown),
+		newApp("d", health.HealthStatusProgressing),
+		newApp("e", health.HealthStatusMissing),
+		newApp("f", health.HealthStatusSuspended),
+	)
+	list := func(q *application.ApplicationQuery) ([]string, *appsv1.ApplicationList) {
+		res, err := appServer.List(context.Background(), q)
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names, res
+	}
+
+	names, res := list(&application.ApplicationQuery{SortBy: "health", Limit: 3})
+	assert.Equal(t, []string{"b", "f", "d"}, names)
+	names, _ = list(&application.ApplicationQuery{SortBy: "health", Continue: res.Continue})
+	assert.Equal(t, []string{"e", "a", "c"}, names)
+
+	names, res = list(&application.ApplicationQuery{SortBy: "-health", Limit: 2})
+	assert.Equal(t, []string{"c", "a"}, names)
+	names, _ = list(&application.ApplicationQuery{SortBy: "-health", Continue: res.Continue})
+	assert.Equal(t, []string{"e", "d", "f", "b"}, names)
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := context.Background()
@@ -481,6 +571,52 @@ func TestDeleteApp_InvalidName(t *testing.T) {
 	assert.True(t, apierrors.IsNotFound(err))
 }
 
+func TestEnforceResourceAction(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(`p, alice, applications, update, default/*, allow
+p, bob, applications, update/apps/Deployment/*/guestbook-ui, default/*, allow
+p, bob, applications, delete//Pod/*, default/*, allow`)
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(f)
+	testApp := newTestApp()
+	deployment := &application.ApplicationResourceRequest{Group: "apps", Kind: "Deployment", Namespace: "guestbook", ResourceName: "guestbook-ui"}
+	pod := &application.ApplicationResourceRequest{Kind: "Pod", Namespace: "guestbook", ResourceName: "guestbook-ui-1234"}
+	userCtx := func(user string) context.Context {
+		return context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: user})
+	}
+
+	// alice may update any resource of the application
+	assert.NoError(t, appServer.enforceResourceAction(userCtx("alice"), rbacpolicy.ActionUpdate, testApp, deployment))
+	assert.NoError(t, appServer.enforceResourceAction(userCtx("alice"), rbacpolicy.ActionUpdate, testApp, pod))
+	assert.Error(t, appServer.enforceResourceAction(userCtx("alice"), rbacpolicy.ActionDelete, testApp, pod))
+
+	// bob may only update the guestbook-ui deployment and delete pods
+	assert.NoError(t, appServer.enforceResourceAction(userCtx("bob"), rbacpolicy.ActionUpdate, testApp, deployment))
+	assert.Error(t, appServer.enforceResourceAction(userCtx("bob"), rbacpolicy.ActionUpdate, testApp, pod))
+	assert.NoError(t, appServer.enforceResourceAction(userCtx("bob"), rbacpolicy.ActionDelete, testApp, pod))
+	assert.Error(t, appServer.enforceResourceAction(userCtx("bob"), rbacpolicy.ActionDelete, testApp, deployment))
+}
+
+func TestEnforceResourceAction_CoarseDeny(t *testing.T) {
+	f := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(`p, alice, applications, *, default/*, allow
+p, alice, applications, delete, default/*, deny
+p, bob, applications, delete//Pod/*, default/*, allow
+p, bob, applications, delete, default/*, deny`)
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(f)
+	testApp := newTestApp()
+	pod := &application.ApplicationResourceRequest{Kind: "Pod", Namespace: "guestbook", ResourceName: "guestbook-ui-1234"}
+	userCtx := func(user string) context.Context {
+		return context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: user})
+	}
+
+	// the explicit deny of the action on the whole application wins over the allowed actions on the resources
+	assert.NoError(t, appServer.enforceResourceAction(userCtx("alice"), rbacpolicy.ActionUpdate, testApp, pod))
+	assert.Error(t, appServer.enforceResourceAction(userCtx("alice"), rbacpolicy.ActionDelete, testApp, pod))
+	assert.Error(t, appServer.enforceResourceAction(userCtx("bob"), rbacpolicy.ActionDelete, testApp, pod))
+}
+
 func TestSyncAndTerminate(t *testing.T) {
 	ctx := context.Background()
 	appServer := newTestAppServer()
@@ -572,6 +708,34 @@ func TestSyncGit(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync locally", events.Items[1].Message)
 }
 
+func TestSyncResources(t *testing.T) {
+	ctx := context.Background()
+	appServer := newTestAppServer()
+	testApp := newTestApp()
+	app, err := appServer.Create(ctx, &application.ApplicationCreateRequest{Application: *testApp})
+	assert.NoError(t, err)
+	manifests := []string{`{"apiVersion": "v1", "kind": "ServiceAccount", "metadata": {"name": "test", "namespace": "test"}}`}
+
+	_, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:      &app.Name,
+		Manifests: manifests,
+		Resources: []appsv1.SyncOperationResource{{Kind: "ServiceAccount"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resources := []appsv1.SyncOperationResource{{Kind: "ServiceAccount", Namespace: "test", Name: "test"}}
+	app, err = appServer.Sync(ctx, &application.ApplicationSyncRequest{
+		Name:      &app.Name,
+		Manifests: manifests,
+		Resources: resources,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, resources, app.Operation.Sync.Resources)
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Unknown user initiated partial sync locally", events.Items[1].Message)
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []appsv1.RevisionHistory{{
@@ -594,6 +758,23 @@ func TestRollbackApp(t *testing.T) {
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
 }
 
+func TestSyncAndRollbackSkippedApp(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Annotations = map[string]string{appsv1.AnnotationKeySkipReconcile: "true"}
+	testApp.Status.History = []appsv1.RevisionHistory{{
+		ID:       1,
+		Revision: "abc",
+		Source:   *testApp.Spec.Source.DeepCopy(),
+	}}
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{Name: &testApp.Name, ID: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestUpdateAppProject(t *testing.T) {
 	testApp := newTestApp()
 	ctx := context.Background()
@@ -716,6 +897,43 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	})
 }
 
+func TestGetApplicationClusterConfig_Impersonation(t *testing.T) {
+	impersonatingProj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "impersonating", Namespace: "default"},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			DestinationServiceAccounts: []appsv1.ApplicationDestinationServiceAccount{
+				{Server: "*", Namespace: test.FakeDestNamespace, DefaultServiceAccount: "deployer"},
+			},
+		},
+	}
+	appServer := newTestAppServer(impersonatingProj)
+	ctx := context.Background()
+	app := newTestApp(func(app *appsv1.Application) {
+		app.Spec.Project = "impersonating"
+	})
+
+	config, err := appServer.getApplicationClusterConfig(ctx, app)
+	require.NoError(t, err)
+	assert.Empty(t, config.Impersonate.UserName)
+
+	cm, err := appServer.kubeclientset.CoreV1().ConfigMaps(testNamespace).Get(ctx, "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data = map[string]string{"application.sync.impersonation.enabled": "true"}
+	_, err = appServer.kubeclientset.CoreV1().ConfigMaps(testNamespace).Update(ctx, cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	appServer.settingsMgr = settings.NewSettingsManager(ctx, appServer.kubeclientset, testNamespace)
+
+	config, err = appServer.getApplicationClusterConfig(ctx, app)
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:"+test.FakeDestNamespace+":deployer", config.Impersonate.UserName)
+
+	// the applications whose destination has no service account cannot be managed
+	_, err = appServer.getApplicationClusterConfig(ctx, newTestApp())
+	assert.Error(t, err)
+}
+
 func TestGetCachedAppState(t *testing.T) {
 	testApp := newTestApp()
 	testApp.ObjectMeta.ResourceVersion = "1"
@@ -939,3 +1157,187 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	assert.True(t, getAppDetailsQuery.NoCache)
 	assert.Equal(t, &testApp.Spec.Source, getAppDetailsQuery.Source)
 }
+
+func TestGetAppRefresh_SkippedReconcile(t *testing.T) {
+	testApp := newTestApp()
+	testApp.ObjectMeta.ResourceVersion = "1"
+	testApp.Annotations = map[string]string{appsv1.AnnotationKeySkipReconcile: "true"}
+	appServer := newTestAppServer(testApp)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the refresh is not waited for, since the controller does not refresh paused applications
+	app, err := appServer.Get(ctx, &application.ApplicationQuery{
+		Name:    &testApp.Name,
+		Refresh: pointer.StringPtr(string(appsv1.RefreshTypeHard)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, testApp.Name, app.Name)
+	app, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(ctx, testApp.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, app.Annotations, appsv1.AnnotationKeyRefresh)
+}
+
+type fakeWatchServer struct {
+	application.ApplicationService_WatchServer
+	ctx    context.Context
+	events chan *appsv1.ApplicationWatchEvent
+}
+
+func (s *fakeWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchServer) Send(event *appsv1.ApplicationWatchEvent) error {
+	s.events <- event
+	return nil
+}
+
+func TestWatchResumeIncremental(t *testing.T) {
+	appServer := newTestAppServer()
+	appServer.appBroadcaster = &broadcasterHandler{lastSyncResourceVersion: func() string {
+		return "10"
+	}}
+	newApp := func(version string, syncStatus appsv1.SyncStatusCode) appsv1.Application {
+		return *newTestApp(func(app *appsv1.Application) {
+			app.ResourceVersion = version
+			app.Status.Sync.Status = syncStatus
+		})
+	}
+	appServer.appBroadcaster.notify(&appsv1.ApplicationWatchEvent{Type: watch.Modified, Application: newApp("11", appsv1.SyncStatusCodeOutOfSync)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchServer{ctx: ctx, events: make(chan *appsv1.ApplicationWatchEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- appServer.Watch(&application.ApplicationQuery{ResourceVersion: "10", Incremental: true}, stream)
+	}()
+
+	// the missed event is replayed with the full application
+	event := <-stream.events
+	assert.Equal(t, "11", event.Application.ResourceVersion)
+	assert.Equal(t, appsv1.SyncStatusCodeOutOfSync, event.Application.Status.Sync.Status)
+	assert.Empty(t, event.Patch)
+
+	// the next modification is sent as a patch
+	appServer.appBroadcaster.notify(&appsv1.ApplicationWatchEvent{Type: watch.Modified, Application: newApp("12", appsv1.SyncStatusCodeSynced)})
+	event = <-stream.events
+	assert.Equal(t, watch.Modified, event.Type)
+	assert.Equal(t, "test-app", event.Application.Name)
+	assert.Equal(t, "12", event.Application.ResourceVersion)
+	assert.Empty(t, event.Application.Spec.Source.RepoURL)
+	assert.JSONEq(t, `{"metadata":{"resourceVersion":"12"},"status":{"sync":{"status":"Synced"}}}`, event.Patch)
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	err := appServer.Watch(&application.ApplicationQuery{ResourceVersion: "9"}, &fakeWatchServer{ctx: context.Background()})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestWatchBookmarks(t *testing.T) {
+	prevInterval := watchBookmarkInterval
+	watchBookmarkInterval = 10 * time.Millisecond
+	defer func() {
+		watchBookmarkInterval = prevInterval
+	}()
+	appServer := newTestAppServer()
+	appServer.appBroadcaster = &broadcasterHandler{lastSyncResourceVersion: func() string {
+		return "10"
+	}}
+	// events the client is not allowed to receive still advance the resource version of the bookmarks
+	appServer.appBroadcaster.notify(&appsv1.ApplicationWatchEvent{Type: watch.Added, Application: *newTestApp(func(app *appsv1.Application) {
+		app.ResourceVersion = "11"
+		app.Labels = map[string]string{"team": "other"}
+	})})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeWatchServer{ctx: ctx, events: make(chan *appsv1.ApplicationWatchEvent, 10)}
+	go func() {
+		_ = appServer.Watch(&application.ApplicationQuery{ResourceVersion: "10", Selector: "team=mine", AllowWatchBookmarks: true}, stream)
+	}()
+	event := <-stream.events
+	assert.Equal(t, watch.Bookmark, event.Type)
+	assert.Equal(t, "11", event.Application.ResourceVersion)
+}
+
+func TestListLinks(t *testing.T) {
+	appServer := newTestAppServer(newTestApp(func(app *appsv1.Application) {
+		app.Labels = map[string]string{"team": "payments"}
+	}))
+	appServer.settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"application.links": `
+- title: Grafana
+  url: https://grafana.example.com/d/apps?var-app={{.app.metadata.name}}
+  icon.class: fa-chart-area
+- title: Billing
+  url: https://billing.example.com/{{.app.metadata.name}}
+  selector: team=billing
+`},
+	}), testNamespace)
+
+	links, err := appServer.ListLinks(context.Background(), &application.ListAppLinksRequest{Name: pointer.StringPtr("test-app")})
+	assert.NoError(t, err)
+	if assert.Len(t, links.Items, 1) {
+		assert.Equal(t, "Grafana", links.Items[0].GetTitle())
+		assert.Equal(t, "https://grafana.example.com/d/apps?var-app=test-app", links.Items[0].GetUrl())
+		assert.Equal(t, "fa-chart-area", links.Items[0].GetIconClass())
+	}
+
+	_, err = appServer.ListLinks(context.Background(), &application.ListAppLinksRequest{Name: pointer.StringPtr("other-app")})
+	assert.Error(t, err)
+}
+
+func TestListResourceLinks(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	appServer.settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"resource.links": `
+- title: Workload
+  url: https://grafana.example.com/d/workloads?workload={{.resource.metadata.name}}&replicas={{.resource.spec.replicas}}
+  kinds: [apps/Deployment]
+- title: Pod
+  url: https://kibana.example.com/logs?pod={{.resource.metadata.name}}
+  kinds: [Pod]
+`},
+	}), testNamespace)
+	stateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Minute)
+	require.NoError(t, stateCache.SetAppResourcesTree("test-app", &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{{
+		ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+	}, {
+		ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook-1"},
+	}}}))
+	require.NoError(t, stateCache.SetAppManagedResources("test-app", []*appsv1.ResourceDiff{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+		LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"},"spec":{"replicas":3}}`,
+	}}))
+	appServer.cache = servercache.NewCache(stateCache, time.Minute, time.Minute, time.Minute)
+
+	// the links are rendered from the cached live state, the kubectl mock has no live object
+	links, err := appServer.ListResourceLinks(context.Background(), &application.ApplicationResourceRequest{
+		Name: pointer.StringPtr("test-app"), Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "guestbook",
+	})
+	require.NoError(t, err)
+	if assert.Len(t, links.Items, 1) {
+		assert.Equal(t, "https://grafana.example.com/d/workloads?workload=guestbook&replicas=3", links.Items[0].GetUrl())
+	}
+
+	links, err = appServer.ListResourceLinks(context.Background(), &application.ApplicationResourceRequest{
+		Name: pointer.StringPtr("test-app"), Kind: "Pod", Namespace: "default", ResourceName: "guestbook-1",
+	})
+	require.NoError(t, err)
+	if assert.Len(t, links.Items, 1) {
+		assert.Equal(t, "https://kibana.example.com/logs?pod=guestbook-1", links.Items[0].GetUrl())
+	}
+}
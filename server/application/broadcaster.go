@@ -1,10 +1,12 @@
 package application
 
 import (
+	"strconv"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
@@ -23,9 +25,66 @@ func (s *subscriber) matches(event *appv1.ApplicationWatchEvent) bool {
 	return true
 }
 
+// watchHistorySize is the number of latest events the resumed watches can replay
+const watchHistorySize = 1000
+
 type broadcasterHandler struct {
 	lock        sync.Mutex
 	subscribers []*subscriber
+	// lastSyncResourceVersion returns the resource version of the latest list or event of the informer
+	lastSyncResourceVersion func() string
+	// history holds the latest events in the order they were received
+	history []*appv1.ApplicationWatchEvent
+	// historyVersion is the resource version the history starts after, zero until the informer has listed the
+	// applications. Watches resumed from an older version could miss events.
+	historyVersion int
+}
+
+// resourceVersion returns the resource version of the application of the event, zero if it is invalid
+func resourceVersion(event *appv1.ApplicationWatchEvent) int {
+	version, _ := strconv.Atoi(event.Application.ResourceVersion)
+	return version
+}
+
+// initHistory starts the history after the latest list of the informer: the events of the applications it returned
+// which are still to be received are not recorded. Must be called with the lock held.
+func (b *broadcasterHandler) initHistory() {
+	if b.historyVersion == 0 && b.lastSyncResourceVersion != nil {
+		b.historyVersion, _ = strconv.Atoi(b.lastSyncResourceVersion())
+	}
+}
+
+// record adds the event to the history. Must be called with the lock held.
+func (b *broadcasterHandler) record(event *appv1.ApplicationWatchEvent) {
+	b.initHistory()
+	if b.historyVersion == 0 || resourceVersion(event) <= b.historyVersion {
+		return
+	}
+	b.history = append(b.history, event)
+	if len(b.history) > watchHistorySize {
+		b.historyVersion = resourceVersion(b.history[0])
+		b.history = b.history[1:]
+	}
+}
+
+// since returns the recorded events after the given resource version and the resource version of the latest one, or
+// false if some of the events after the version are not recorded
+func (b *broadcasterHandler) since(version int) ([]*appv1.ApplicationWatchEvent, int, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.initHistory()
+	if b.historyVersion == 0 || version < b.historyVersion {
+		return nil, 0, false
+	}
+	var events []*appv1.ApplicationWatchEvent
+	latest := version
+	for _, event := range b.history {
+		if eventVersion := resourceVersion(event); eventVersion > version {
+			events = append(events, event)
+			latest = eventVersion
+		}
+	}
+	return events, latest, true
 }
 
 func (b *broadcasterHandler) notify(event *appv1.ApplicationWatchEvent) {
@@ -33,6 +92,7 @@ func (b *broadcasterHandler) notify(event *appv1.ApplicationWatchEvent) {
 	// to avoid data race on b.subscribers changes
 	subscribers := []*subscriber{}
 	b.lock.Lock()
+	b.record(event)
 	subscribers = append(subscribers, b.subscribers...)
 	b.lock.Unlock()
 
@@ -81,6 +141,19 @@ func (b *broadcasterHandler) OnUpdate(_, newObj interface{}) {
 }
 
 func (b *broadcasterHandler) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		// the deletion was detected by a relist: its resource version is unknown, so the watches can't be resumed
+		// from before the relist anymore
+		b.lock.Lock()
+		if b.lastSyncResourceVersion != nil {
+			if version, err := strconv.Atoi(b.lastSyncResourceVersion()); err == nil && version > b.historyVersion {
+				b.historyVersion = version
+				b.history = nil
+			}
+		}
+		b.lock.Unlock()
+		obj = tombstone.Obj
+	}
 	if app, ok := obj.(*appv1.Application); ok {
 		b.notify(&appv1.ApplicationWatchEvent{Application: *app, Type: watch.Deleted})
 	}
@@ -1,10 +1,14 @@
 package application
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
@@ -51,3 +55,59 @@ func TestBroadcasterHandler_ReceiveEvents(t *testing.T) {
 	}
 
 }
+
+func newWatchEvent(version string, eventType watch.EventType) *appv1.ApplicationWatchEvent {
+	return &appv1.ApplicationWatchEvent{
+		Type:        eventType,
+		Application: appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app-" + version, ResourceVersion: version}},
+	}
+}
+
+func TestBroadcasterHandler_History(t *testing.T) {
+	lastSyncResourceVersion := ""
+	broadcaster := broadcasterHandler{lastSyncResourceVersion: func() string {
+		return lastSyncResourceVersion
+	}}
+
+	// the applications are not listed yet
+	_, _, ok := broadcaster.since(0)
+	assert.False(t, ok)
+
+	lastSyncResourceVersion = "10"
+	broadcaster.notify(newWatchEvent("5", watch.Added))
+	broadcaster.notify(newWatchEvent("11", watch.Modified))
+	broadcaster.notify(newWatchEvent("12", watch.Deleted))
+
+	events, latest, ok := broadcaster.since(10)
+	assert.True(t, ok)
+	assert.Equal(t, 12, latest)
+	assert.Len(t, events, 2)
+	events, latest, ok = broadcaster.since(11)
+	assert.True(t, ok)
+	assert.Equal(t, 12, latest)
+	assert.Equal(t, []*appv1.ApplicationWatchEvent{newWatchEvent("12", watch.Deleted)}, events)
+	events, latest, ok = broadcaster.since(15)
+	assert.True(t, ok)
+	assert.Equal(t, 15, latest)
+	assert.Empty(t, events)
+	_, _, ok = broadcaster.since(9)
+	assert.False(t, ok)
+
+	// the oldest events are evicted
+	for i := 0; i < watchHistorySize; i++ {
+		broadcaster.notify(newWatchEvent(strconv.Itoa(13+i), watch.Modified))
+	}
+	_, _, ok = broadcaster.since(11)
+	assert.False(t, ok)
+	events, _, ok = broadcaster.since(12)
+	assert.True(t, ok)
+	assert.Len(t, events, watchHistorySize)
+
+	// deletions detected by a relist can't be replayed
+	lastSyncResourceVersion = "2000"
+	broadcaster.OnDelete(cache.DeletedFinalStateUnknown{Obj: &newWatchEvent("14", watch.Deleted).Application})
+	_, _, ok = broadcaster.since(1999)
+	assert.False(t, ok)
+	_, _, ok = broadcaster.since(2000)
+	assert.True(t, ok)
+}
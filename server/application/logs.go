@@ -2,22 +2,72 @@ package application
 
 import (
 	"bufio"
+	"context"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 type logEntry struct {
-	line      string
-	timeStamp time.Time
-	podName   string
-	err       error
+	line          string
+	timeStamp     time.Time
+	podName       string
+	containerName string
+	err           error
+}
+
+// newLogFilter returns a function matching the log lines against the given filter: a literal string, or a regular
+// expression if regex is true, which lines must contain. A '!' prefix inverts the filter.
+func newLogFilter(filter string, regex bool) (func(line string) bool, error) {
+	if filter == "" {
+		return func(string) bool {
+			return true
+		}, nil
+	}
+	inverse := false
+	if filter[0] == '!' {
+		filter = filter[1:]
+		inverse = true
+	}
+	contains := func(line string) bool {
+		return strings.Contains(line, filter)
+	}
+	if regex {
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, err
+		}
+		contains = re.MatchString
+	}
+	return func(line string) bool {
+		return contains(line) != inverse
+	}, nil
+}
+
+// getPodContainers returns the names of the init containers and containers of the given pod
+func getPodContainers(ctx context.Context, kubeClientset kubernetes.Interface, namespace string, podName string) ([]string, error) {
+	pod, err := kubeClientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var containers []string
+	for _, c := range pod.Spec.InitContainers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+	return containers, nil
 }
 
 // parseLogsStream converts given ReadCloser into channel that emits log entries
-func parseLogsStream(podName string, stream io.ReadCloser, ch chan logEntry) {
+func parseLogsStream(podName string, containerName string, stream io.ReadCloser, ch chan logEntry) {
 	bufReader := bufio.NewReader(stream)
 	eof := false
 	for !eof {
@@ -44,7 +94,7 @@ func parseLogsStream(podName string, stream io.ReadCloser, ch chan logEntry) {
 
 		lines := strings.Join(parts[1:], " ")
 		for _, line := range strings.Split(lines, "\r") {
-			ch <- logEntry{line: line, timeStamp: logTime, podName: podName}
+			ch <- logEntry{line: line, timeStamp: logTime, podName: podName, containerName: containerName}
 		}
 
 	}
@@ -118,11 +168,22 @@ func mergeLogStreams(streams []chan logEntry, bufferingDuration time.Duration) c
 
 	var sentAtLock sync.Mutex
 	var sentAt time.Time
+	stopped := false
 
 	ticker := time.NewTicker(bufferingDuration)
+	stop := make(chan struct{})
 	go func() {
-		for range ticker.C {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
 			sentAtLock.Lock()
+			if stopped {
+				sentAtLock.Unlock()
+				return
+			}
 			// waited long enough for logs from each streams, send everything accumulated
 			if sentAt.Add(bufferingDuration).Before(time.Now()) {
 				_ = send(true)
@@ -142,10 +203,15 @@ func mergeLogStreams(streams []chan logEntry, bufferingDuration time.Duration) c
 			}
 		}
 
+		// the ticker goroutine may be sending the remaining entries: wait for it to stop before closing the merged stream
+		ticker.Stop()
+		sentAtLock.Lock()
+		stopped = true
+		close(stop)
+		sentAtLock.Unlock()
 		_ = send(true)
 
 		close(merged)
-		ticker.Stop()
 	}()
 	return merged
 }
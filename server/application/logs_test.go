@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"io/ioutil"
 	"strings"
 	"testing"
@@ -8,6 +9,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestParseLogsStream_Successful(t *testing.T) {
@@ -16,7 +20,7 @@ func TestParseLogsStream_Successful(t *testing.T) {
 
 	res := make(chan logEntry)
 	go func() {
-		parseLogsStream("test", r, res)
+		parseLogsStream("test", "main", r, res)
 		close(res)
 	}()
 
@@ -29,8 +33,8 @@ func TestParseLogsStream_Successful(t *testing.T) {
 	}
 
 	assert.Equal(t, []logEntry{
-		{timeStamp: expectedTimestamp, podName: "test", line: "hello"},
-		{timeStamp: expectedTimestamp, podName: "test", line: "world"},
+		{timeStamp: expectedTimestamp, podName: "test", containerName: "main", line: "hello"},
+		{timeStamp: expectedTimestamp, podName: "test", containerName: "main", line: "world"},
 	}, entries)
 }
 
@@ -39,7 +43,7 @@ func TestParseLogsStream_ParsingError(t *testing.T) {
 
 	res := make(chan logEntry)
 	go func() {
-		parseLogsStream("test", r, res)
+		parseLogsStream("test", "", r, res)
 		close(res)
 	}()
 
@@ -56,14 +60,14 @@ func TestMergeLogStreams(t *testing.T) {
 
 	first := make(chan logEntry)
 	go func() {
-		parseLogsStream("first", ioutil.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1
+		parseLogsStream("first", "", ioutil.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1
 2021-02-09T00:00:03Z 3`)), first)
 		close(first)
 	}()
 
 	second := make(chan logEntry)
 	go func() {
-		parseLogsStream("second", ioutil.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2
+		parseLogsStream("second", "", ioutil.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2
 2021-02-09T00:00:04Z 4`)), second)
 		close(second)
 	}()
@@ -76,3 +80,72 @@ func TestMergeLogStreams(t *testing.T) {
 
 	assert.Equal(t, []string{"1", "2", "3", "4"}, lines)
 }
+
+func TestMergeLogStreams_Flush(t *testing.T) {
+	first := make(chan logEntry)
+	second := make(chan logEntry)
+	merged := mergeLogStreams([]chan logEntry{first, second}, 10*time.Millisecond)
+
+	// the second stream has no entry yet: the first entry is sent once the buffering duration elapsed
+	first <- logEntry{line: "1", timeStamp: time.Now()}
+	select {
+	case entry := <-merged:
+		assert.Equal(t, "1", entry.line)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the buffered entry was not flushed")
+	}
+
+	close(first)
+	close(second)
+	select {
+	case _, ok := <-merged:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the merged stream was not closed")
+	}
+}
+
+func TestNewLogFilter(t *testing.T) {
+	match, err := newLogFilter("", false)
+	require.NoError(t, err)
+	assert.True(t, match("anything"))
+
+	match, err = newLogFilter("error", false)
+	require.NoError(t, err)
+	assert.True(t, match("an error occurred"))
+	assert.False(t, match("all good"))
+
+	match, err = newLogFilter("!error", false)
+	require.NoError(t, err)
+	assert.False(t, match("an error occurred"))
+	assert.True(t, match("all good"))
+
+	match, err = newLogFilter(`status=5\d\d`, true)
+	require.NoError(t, err)
+	assert.True(t, match("GET / status=503"))
+	assert.False(t, match("GET / status=200"))
+
+	match, err = newLogFilter(`!^level=(debug|info)`, true)
+	require.NoError(t, err)
+	assert.False(t, match("level=info msg=started"))
+	assert.True(t, match("level=error msg=failed"))
+
+	_, err = newLogFilter("[", true)
+	assert.Error(t, err)
+}
+
+func TestGetPodContainers(t *testing.T) {
+	kubeClientset := fake.NewSimpleClientset(&v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "guestbook"},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "init"}},
+			Containers:     []v1.Container{{Name: "main"}, {Name: "sidecar"}},
+		},
+	})
+	containers, err := getPodContainers(context.Background(), kubeClientset, "default", "guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"init", "main", "sidecar"}, containers)
+
+	_, err = getPodContainers(context.Background(), kubeClientset, "default", "other")
+	assert.Error(t, err)
+}
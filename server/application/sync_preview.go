@@ -0,0 +1,454 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/syncwaves"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/openapi"
+
+	argocommon "github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	logutils "github.com/argoproj/argo-cd/v2/util/log"
+)
+
+const (
+	syncPreviewActionCreate    = "Create"
+	syncPreviewActionUpdate    = "Update"
+	syncPreviewActionUnchanged = "Unchanged"
+	syncPreviewActionPrune     = "Prune"
+
+	// maxSyncPreviewIterations bounds the number of phases and waves run by the dry-run of a sync
+	maxSyncPreviewIterations = 1000
+)
+
+// apiResourcesInfoProvider tells whether the kinds served by a cluster are namespaced, the kinds which are not served
+// yet, e.g. the ones of the CRDs created by the sync, are unknown
+type apiResourcesInfoProvider map[schema.GroupKind]bool
+
+func (p apiResourcesInfoProvider) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	namespaced, ok := p[gk]
+	if !ok {
+		return false, fmt.Errorf("unknown kind %s", gk.String())
+	}
+	return namespaced, nil
+}
+
+// serverSideDryRunKubectl runs the dry-runs of the resource operations of a sync on the API server, so that the resources
+// are validated and admitted by the cluster as they would be by the sync
+type serverSideDryRunKubectl struct {
+	kube.Kubectl
+}
+
+func (k serverSideDryRunKubectl) ManageResources(config *rest.Config, openAPISchema openapi.Resources) (kube.ResourceOperations, func(), error) {
+	resourceOps, cleanup, err := k.Kubectl.ManageResources(config, openAPISchema)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serverSideDryRunResourceOperations{resourceOps}, cleanup, nil
+}
+
+// serverSideDryRunResourceOperations turns the client-side dry-runs of the resource operations into server-side ones
+type serverSideDryRunResourceOperations struct {
+	kube.ResourceOperations
+}
+
+func serverSideDryRunStrategy(dryRunStrategy cmdutil.DryRunStrategy) cmdutil.DryRunStrategy {
+	if dryRunStrategy == cmdutil.DryRunClient {
+		return cmdutil.DryRunServer
+	}
+	return dryRunStrategy
+}
+
+func (o serverSideDryRunResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate bool) (string, error) {
+	return o.ResourceOperations.ApplyResource(ctx, obj, serverSideDryRunStrategy(dryRunStrategy), force, validate)
+}
+
+func (o serverSideDryRunResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	return o.ResourceOperations.ReplaceResource(ctx, obj, serverSideDryRunStrategy(dryRunStrategy), force)
+}
+
+func (o serverSideDryRunResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	return o.ResourceOperations.CreateResource(ctx, obj, serverSideDryRunStrategy(dryRunStrategy), validate)
+}
+
+func (o serverSideDryRunResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	return o.ResourceOperations.UpdateResource(ctx, obj, serverSideDryRunStrategy(dryRunStrategy))
+}
+
+// SyncPreview performs a server-side dry-run of the sync of the application, including the hooks and the sync waves, and
+// returns the actions it would perform on each resource in the order they would be synced. The sync windows blocking the
+// sync are reported rather than failing the preview.
+func (s *Server) SyncPreview(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*application.SyncPreviewResponse, error) {
+	a, err := s.appLister.Get(*syncReq.Name)
+	if err != nil {
+		return nil, err
+	}
+	a = a.DeepCopy()
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	if syncReq.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, appRBACName(*a)); err != nil {
+			return nil, err
+		}
+	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
+		}
+		return nil, err
+	}
+	for _, r := range syncReq.Resources {
+		if r.Kind == "" || r.Name == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "The kind and name of the resources to sync are required")
+		}
+	}
+	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot use local sync when signature keys are required.")
+	}
+	blocked, activeWindows, err := s.getSyncPreviewWindows(ctx, a, proj)
+	if err != nil {
+		return nil, err
+	}
+	revision, _, err := s.resolveRevision(ctx, a, syncReq)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+
+	var syncOptions appv1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+	if syncReq.SyncOptions != nil {
+		syncOptions = syncReq.SyncOptions.Items
+	}
+	syncOp := appv1.SyncOperation{
+		Revision:     revision,
+		Prune:        syncReq.Prune,
+		DryRun:       true,
+		SyncOptions:  syncOptions.WithDefaults(proj.Spec.SyncOptions),
+		SyncStrategy: syncReq.Strategy,
+		Resources:    syncReq.Resources,
+		Manifests:    syncReq.Manifests,
+	}
+
+	manifests := syncReq.Manifests
+	if manifests == nil {
+		manifestInfo, err := s.generateManifests(ctx, a, revision)
+		if err != nil {
+			return nil, err
+		}
+		manifests = manifestInfo.Manifests
+		revision = manifestInfo.Revision
+	}
+	targetObjs := make([]*unstructured.Unstructured, 0)
+	for _, manifest := range manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal the manifests: %v", err)
+		}
+		if obj != nil {
+			targetObjs = append(targetObjs, obj)
+		}
+	}
+
+	phase, message, resources, err := s.previewSync(ctx, a, proj, &syncOp, targetObjs)
+	if err != nil {
+		return nil, err
+	}
+	return &application.SyncPreviewResponse{
+		Revision:             revision,
+		Phase:                string(phase),
+		Message:              message,
+		Resources:            resources,
+		BlockedBySyncWindows: blocked,
+		ActiveSyncWindows:    activeWindows,
+	}, nil
+}
+
+// getSyncPreviewWindows returns whether the sync windows of the project currently block a manual sync of the
+// application, and the active sync windows of the application
+func (s *Server) getSyncPreviewWindows(ctx context.Context, a *appv1.Application, proj *appv1.AppProject) (bool, []*application.ApplicationSyncWindow, error) {
+	clusterLabels, namespaceLabels, err := argo.GetSyncWindowsLabels(ctx, &proj.Spec.SyncWindows, a, s.db, argo.GetLiveNamespaceLabels)
+	if err != nil {
+		return false, nil, fmt.Errorf("error getting the labels selected by sync windows: %w", err)
+	}
+	windows := proj.Spec.SyncWindows.MatchesWithLabels(a, clusterLabels, namespaceLabels)
+	return !windows.CanSync(true), convertSyncWindows(windows.Active()), nil
+}
+
+// getSyncClusterConfigs returns the raw and the regular configurations of the destination cluster of the application,
+// impersonating the service account of the destination if impersonation is enabled, like the application controller
+func (s *Server) getSyncClusterConfigs(ctx context.Context, a *appv1.Application, proj *appv1.AppProject) (*rest.Config, *rest.Config, error) {
+	if err := argo.ValidateDestination(ctx, &a.Spec.Destination, s.db); err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "application destination is invalid: %v", err)
+	}
+	clst, err := s.db.GetCluster(ctx, a.Spec.Destination.Server)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawConfig := clst.RawRestConfig()
+	restConfig := clst.RESTConfig()
+	if err := argo.ImpersonateServiceAccount(s.settingsMgr, proj, a.Spec.Destination, rawConfig, restConfig); err != nil {
+		return nil, nil, err
+	}
+	return rawConfig, restConfig, nil
+}
+
+// previewSync reconciles the target objects with the live state of the cluster and runs a dry-run of the sync of the
+// application through every phase and wave
+func (s *Server) previewSync(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, syncOp *appv1.SyncOperation, targetObjs []*unstructured.Unstructured) (common.OperationPhase, string, []*application.SyncPreviewResource, error) {
+	rawConfig, restConfig, err := s.getSyncClusterConfigs(ctx, a, proj)
+	if err != nil {
+		return "", "", nil, err
+	}
+	resFilter, err := s.settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return "", "", nil, err
+	}
+	apiResources, err := s.kubectl.GetAPIResources(restConfig, resFilter)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to get the API resources of the cluster: %w", err)
+	}
+	infoProvider := apiResourcesInfoProvider{}
+	for _, res := range apiResources {
+		infoProvider[res.GroupKind] = res.Meta.Namespaced
+	}
+
+	targets := make([]*unstructured.Unstructured, 0, len(targetObjs))
+	for _, obj := range targetObjs {
+		gvk := obj.GroupVersionKind()
+		if resFilter.IsExcludedResource(gvk.Group, gvk.Kind, a.Spec.Destination.Server) {
+			continue
+		}
+		if !kube.IsNamespacedOrUnknown(infoProvider, gvk.GroupKind()) {
+			obj.SetNamespace("")
+		} else if obj.GetNamespace() == "" {
+			obj.SetNamespace(a.Spec.Destination.Namespace)
+		}
+		targets = append(targets, obj)
+	}
+
+	liveObjByKey, err := s.getSyncPreviewLiveObjs(ctx, a, proj, restConfig, targets)
+	if err != nil {
+		return "", "", nil, err
+	}
+	reconciliationResult := sync.Reconcile(targets, liveObjByKey, a.Spec.Destination.Namespace, infoProvider)
+
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return "", "", nil, err
+	}
+	resourceOverrides = proj.ResourceOverrides(resourceOverrides)
+	diffNormalizer, err := argo.NewDiffNormalizer(a.Spec.IgnoreDifferences, resourceOverrides)
+	if err != nil {
+		return "", "", nil, err
+	}
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return "", "", nil, err
+	}
+	diffResults, err := diff.DiffArray(reconciliationResult.Target, reconciliationResult.Live,
+		diff.WithNormalizer(diffNormalizer), diff.IgnoreAggregatedRoles(compareOptions.IgnoreAggregatedRoles))
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to diff the resources: %w", err)
+	}
+
+	openAPISchema, err := s.kubectl.LoadOpenAPISchema(restConfig)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to load openAPISchema: %w", err)
+	}
+	prunePropagationPolicy := metav1.DeletePropagationForeground
+	switch {
+	case syncOp.SyncOptions.HasOption("PrunePropagationPolicy=background"):
+		prunePropagationPolicy = metav1.DeletePropagationBackground
+	case syncOp.SyncOptions.HasOption("PrunePropagationPolicy=orphan"):
+		prunePropagationPolicy = metav1.DeletePropagationOrphan
+	}
+
+	syncCtx, cleanup, err := sync.NewSyncContext(
+		syncOp.Revision,
+		reconciliationResult,
+		restConfig,
+		rawConfig,
+		serverSideDryRunKubectl{s.kubectl},
+		a.Spec.Destination.Namespace,
+		openAPISchema,
+		sync.WithLogr(logutils.NewLogrusLogger(log.WithFields(log.Fields{"application": a.Name, "operation": "sync-preview"}))),
+		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
+			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
+				return fmt.Errorf("Resource %s:%s is not permitted in project %s.", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
+			}
+			if res.Namespaced && !proj.IsDestinationPermitted(appv1.ApplicationDestination{Namespace: un.GetNamespace(), Server: a.Spec.Destination.Server}) {
+				return fmt.Errorf("namespace %v is not permitted in project '%s'", un.GetNamespace(), proj.Name)
+			}
+			return nil
+		}),
+		sync.WithOperationSettings(true, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
+		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+			return len(syncOp.Resources) == 0 || argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)
+		}),
+		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption(common.SyncOptionsDisableValidation)),
+		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), func(un *unstructured.Unstructured) bool {
+			if un != nil && kube.GetAppInstanceLabel(un, argocommon.LabelKeyAppInstance) != "" {
+				kube.UnsetLabel(un, argocommon.LabelKeyAppInstance)
+				return true
+			}
+			return false
+		}),
+		sync.WithPruneLast(syncOp.SyncOptions.HasOption(common.SyncOptionPruneLast)),
+		sync.WithResourceModificationChecker(syncOp.SyncOptions.HasOption("ApplyOutOfSyncOnly=true"), diffResults),
+		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
+		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
+	)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to initialize sync context: %w", err)
+	}
+	defer cleanup()
+
+	// a dry-run completes the tasks of a wave at once, each call moves on to the next wave until the sync completes
+	for i := 0; i < maxSyncPreviewIterations; i++ {
+		syncCtx.Sync()
+		if phase, _, _ := syncCtx.GetState(); phase.Completed() {
+			break
+		}
+	}
+	phase, message, results := syncCtx.GetState()
+	return phase, message, newSyncPreviewResources(results, reconciliationResult, diffResults), nil
+}
+
+// getSyncPreviewLiveObjs returns the live objects of the resources managed by the application from the live state
+// cached by the application controller. The data of the cached secrets is hidden, so the live state of the targeted
+// secrets is read from the cluster to tell whether they are modified.
+func (s *Server) getSyncPreviewLiveObjs(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, config *rest.Config, targets []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	managedResources := make([]*appv1.ResourceDiff, 0)
+	if err := s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.Name, &managedResources)
+	}); err != nil {
+		return nil, err
+	}
+	liveObjByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, res := range managedResources {
+		if res.Hook || res.LiveState == "" || res.LiveState == "null" {
+			continue
+		}
+		liveObj, err := appv1.UnmarshalToUnstructured(res.LiveState)
+		if err != nil || liveObj == nil {
+			continue
+		}
+		if proj.IsLiveResourcePermitted(liveObj, a.Spec.Destination.Server) {
+			liveObjByKey[kube.GetResourceKey(liveObj)] = liveObj
+		}
+	}
+
+	for _, obj := range targets {
+		key := kube.GetResourceKey(obj)
+		if _, ok := liveObjByKey[key]; !ok || key.Group != "" || key.Kind != kube.SecretKind {
+			continue
+		}
+		liveObj, err := s.kubectl.GetResource(ctx, config, obj.GroupVersionKind(), key.Name, key.Namespace)
+		if err != nil {
+			if apierr.IsNotFound(err) {
+				delete(liveObjByKey, key)
+				continue
+			}
+			return nil, fmt.Errorf("failed to get the live state of %s: %w", key.String(), err)
+		}
+		liveObjByKey[key] = liveObj
+	}
+	return liveObjByKey, nil
+}
+
+// newSyncPreviewResources returns the planned actions of the results of the dry-run of a sync, the results being sorted
+// in the order of the sync
+func newSyncPreviewResources(results []common.ResourceSyncResult, reconciliationResult sync.ReconciliationResult, diffResults *diff.DiffResultList) []*application.SyncPreviewResource {
+	type state struct {
+		target   *unstructured.Unstructured
+		live     *unstructured.Unstructured
+		modified bool
+	}
+	stateByKey := make(map[kube.ResourceKey]state)
+	for i := range reconciliationResult.Target {
+		st := state{target: reconciliationResult.Target[i], live: reconciliationResult.Live[i]}
+		if diffResults != nil && i < len(diffResults.Diffs) {
+			st.modified = diffResults.Diffs[i].Modified
+		}
+		if st.target != nil {
+			stateByKey[kube.GetResourceKey(st.target)] = st
+		} else if st.live != nil {
+			stateByKey[kube.GetResourceKey(st.live)] = st
+		}
+	}
+
+	resources := make([]*application.SyncPreviewResource, 0, len(results))
+	for _, res := range results {
+		resource := &application.SyncPreviewResource{
+			Group:     res.ResourceKey.Group,
+			Version:   res.Version,
+			Kind:      res.ResourceKey.Kind,
+			Namespace: res.ResourceKey.Namespace,
+			Name:      res.ResourceKey.Name,
+			Action:    syncPreviewActionCreate,
+			SyncPhase: string(res.SyncPhase),
+			HookType:  string(res.HookType),
+			Status:    string(res.Status),
+			Message:   res.Message,
+		}
+		if res.HookType != "" {
+			// hooks are created by each sync
+			if hook := findHook(reconciliationResult.Hooks, res.ResourceKey); hook != nil {
+				resource.SyncWave = int64(syncwaves.Wave(hook))
+			}
+		} else if st, ok := stateByKey[res.ResourceKey]; ok {
+			switch {
+			case st.target == nil:
+				resource.Action = syncPreviewActionPrune
+				resource.SyncWave = int64(syncwaves.Wave(st.live))
+			case st.live == nil:
+				resource.SyncWave = int64(syncwaves.Wave(st.target))
+			case st.modified:
+				resource.Action = syncPreviewActionUpdate
+				resource.SyncWave = int64(syncwaves.Wave(st.target))
+			default:
+				resource.Action = syncPreviewActionUnchanged
+				resource.SyncWave = int64(syncwaves.Wave(st.target))
+			}
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// findHook returns the hook of the given key, the hooks with a generated name being matched on their name prefix
+func findHook(hooks []*unstructured.Unstructured, key kube.ResourceKey) *unstructured.Unstructured {
+	for _, hook := range hooks {
+		gvk := hook.GroupVersionKind()
+		if gvk.Group != key.Group || gvk.Kind != key.Kind {
+			continue
+		}
+		if hook.GetName() == key.Name || (hook.GetName() == "" && hook.GetGenerateName() != "" && strings.HasPrefix(key.Name, hook.GetGenerateName())) {
+			return hook
+		}
+	}
+	return nil
+}
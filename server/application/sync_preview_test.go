@@ -0,0 +1,210 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/sync"
+	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
+	testingutils "github.com/argoproj/gitops-engine/pkg/utils/testing"
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+)
+
+// dryRunRecorder records the dry-run strategies of the resource operations
+type dryRunRecorder struct {
+	kube.ResourceOperations
+	strategies []cmdutil.DryRunStrategy
+}
+
+func (r *dryRunRecorder) ApplyResource(_ context.Context, _ *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, _, _ bool) (string, error) {
+	r.strategies = append(r.strategies, dryRunStrategy)
+	return "", nil
+}
+
+func (r *dryRunRecorder) CreateResource(_ context.Context, _ *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, _ bool) (string, error) {
+	r.strategies = append(r.strategies, dryRunStrategy)
+	return "", nil
+}
+
+func TestServerSideDryRunKubectl(t *testing.T) {
+	resourceOps, cleanup, err := serverSideDryRunKubectl{&kubetest.MockKubectlCmd{}}.ManageResources(&rest.Config{}, nil)
+	require.NoError(t, err)
+	defer cleanup()
+	assert.IsType(t, serverSideDryRunResourceOperations{}, resourceOps)
+
+	recorder := &dryRunRecorder{}
+	resourceOps = serverSideDryRunResourceOperations{recorder}
+	_, err = resourceOps.ApplyResource(context.Background(), testingutils.NewPod(), cmdutil.DryRunClient, false, true)
+	require.NoError(t, err)
+	_, err = resourceOps.CreateResource(context.Background(), testingutils.NewPod(), cmdutil.DryRunNone, true)
+	require.NoError(t, err)
+	assert.Equal(t, []cmdutil.DryRunStrategy{cmdutil.DryRunServer, cmdutil.DryRunNone}, recorder.strategies)
+}
+
+// getResourceKubectl returns the live objects of its map and records the keys of the objects it gets
+type getResourceKubectl struct {
+	*kubetest.MockKubectlCmd
+	liveObjs map[kube.ResourceKey]*unstructured.Unstructured
+	gets     []kube.ResourceKey
+}
+
+func (k *getResourceKubectl) GetResource(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+	key := kube.NewResourceKey(gvk.Group, gvk.Kind, namespace, name)
+	k.gets = append(k.gets, key)
+	return k.liveObjs[key], nil
+}
+
+func TestGetSyncPreviewLiveObjs(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	stateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Minute)
+	require.NoError(t, stateCache.SetAppManagedResources("test-app", []*appsv1.ResourceDiff{{
+		Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook",
+		LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"},"spec":{"replicas":3}}`,
+	}, {
+		Kind: "Secret", Namespace: "default", Name: "guestbook",
+		LiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"guestbook","namespace":"default"},"data":{"password":"++++++++"}}`,
+	}, {
+		Kind: "Pod", Namespace: "default", Name: "migrate", Hook: true,
+		LiveState: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"migrate","namespace":"default"}}`,
+	}}))
+	appServer.cache = servercache.NewCache(stateCache, time.Minute, time.Minute, time.Minute)
+	secretKey := kube.NewResourceKey("", "Secret", "default", "guestbook")
+	liveSecret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1", "kind": "Secret",
+		"metadata": map[string]interface{}{"name": "guestbook", "namespace": "default"},
+		"data":     map[string]interface{}{"password": "c2VjcmV0"},
+	}}
+	kubectl := &getResourceKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{}, liveObjs: map[kube.ResourceKey]*unstructured.Unstructured{secretKey: liveSecret}}
+	appServer.kubectl = kubectl
+	targetSecret := liveSecret.DeepCopy()
+	targetSecret.Object["data"] = map[string]interface{}{"password": "bmV3LXNlY3JldA=="}
+
+	proj := &appsv1.AppProject{Spec: appsv1.AppProjectSpec{Destinations: []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}}}}
+	liveObjs, err := appServer.getSyncPreviewLiveObjs(context.Background(), newTestApp(), proj, &rest.Config{}, []*unstructured.Unstructured{targetSecret})
+	require.NoError(t, err)
+	require.Len(t, liveObjs, 2)
+	// the live state is read from the cache, except the one of the targeted secrets whose cached data is hidden
+	assert.Equal(t, int64(3), liveObjs[kube.NewResourceKey("apps", "Deployment", "default", "guestbook")].Object["spec"].(map[string]interface{})["replicas"])
+	assert.Equal(t, liveSecret, liveObjs[secretKey])
+	assert.Equal(t, []kube.ResourceKey{secretKey}, kubectl.gets)
+}
+
+func TestGetSyncPreviewWindows(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	proj := &appsv1.AppProject{Spec: appsv1.AppProjectSpec{SyncWindows: appsv1.SyncWindows{
+		{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"test-app"}},
+	}}}
+	blocked, activeWindows, err := appServer.getSyncPreviewWindows(context.Background(), newTestApp(), proj)
+	require.NoError(t, err)
+	assert.False(t, blocked)
+	assert.Len(t, activeWindows, 1)
+
+	proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, &appsv1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}})
+	blocked, activeWindows, err = appServer.getSyncPreviewWindows(context.Background(), newTestApp(), proj)
+	require.NoError(t, err)
+	assert.True(t, blocked)
+	if assert.Len(t, activeWindows, 2) {
+		assert.Equal(t, "deny", activeWindows[1].GetKind())
+	}
+}
+
+func TestSyncPreview_PermissionDenied(t *testing.T) {
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+	appServer := newTestAppServer(newTestApp())
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`p, admin, applications, get, default/test-app, allow`)
+
+	_, err := appServer.SyncPreview(ctx, &application.ApplicationSyncRequest{Name: pointer.StringPtr("test-app")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_ = appServer.enf.SetBuiltinPolicy(`p, admin, applications, sync, default/test-app, allow`)
+	_, err = appServer.SyncPreview(ctx, &application.ApplicationSyncRequest{Name: pointer.StringPtr("test-app"), Manifests: []string{}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSyncPreview_InvalidResources(t *testing.T) {
+	appServer := newTestAppServer(newTestApp())
+	_, err := appServer.SyncPreview(context.Background(), &application.ApplicationSyncRequest{
+		Name:      pointer.StringPtr("test-app"),
+		Resources: []appsv1.SyncOperationResource{{Kind: "Deployment"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNewSyncPreviewResources(t *testing.T) {
+	withWave := func(obj *unstructured.Unstructured, wave string) *unstructured.Unstructured {
+		obj.SetAnnotations(map[string]string{"argocd.argoproj.io/sync-wave": wave})
+		return obj
+	}
+	created := withWave(testingutils.NewPod(), "1")
+	created.SetName("created")
+	updated := testingutils.NewPod()
+	updated.SetName("updated")
+	unchanged := testingutils.NewPod()
+	unchanged.SetName("unchanged")
+	pruned := withWave(testingutils.NewPod(), "2")
+	pruned.SetName("pruned")
+	hook := withWave(testingutils.NewPod(), "-1")
+	hook.SetName("")
+	hook.SetGenerateName("migrate-")
+	hook.SetAnnotations(map[string]string{"argocd.argoproj.io/hook": "PreSync", "argocd.argoproj.io/sync-wave": "-1"})
+
+	reconciliationResult := sync.ReconciliationResult{
+		Target: []*unstructured.Unstructured{created, updated, unchanged, nil},
+		Live:   []*unstructured.Unstructured{nil, updated, unchanged, pruned},
+		Hooks:  []*unstructured.Unstructured{hook},
+	}
+	diffResults := &diff.DiffResultList{Diffs: []diff.DiffResult{{Modified: true}, {Modified: true}, {}, {Modified: true}}}
+	result := func(obj *unstructured.Unstructured, name string, phase common.SyncPhase, hookType common.HookType) common.ResourceSyncResult {
+		key := kube.GetResourceKey(obj)
+		key.Name = name
+		return common.ResourceSyncResult{ResourceKey: key, Version: "v1", Status: common.ResultCodeSynced, SyncPhase: phase, HookType: hookType}
+	}
+	results := []common.ResourceSyncResult{
+		result(hook, "migrate-abc1234-presync-1", common.SyncPhasePreSync, common.HookTypePreSync),
+		result(updated, "updated", common.SyncPhaseSync, ""),
+		result(unchanged, "unchanged", common.SyncPhaseSync, ""),
+		result(created, "created", common.SyncPhaseSync, ""),
+		result(pruned, "pruned", common.SyncPhaseSync, ""),
+	}
+
+	resources := newSyncPreviewResources(results, reconciliationResult, diffResults)
+	require.Len(t, resources, 5)
+	actions := make(map[string]string)
+	waves := make(map[string]int64)
+	for _, res := range resources {
+		actions[res.Name] = res.Action
+		waves[res.Name] = res.SyncWave
+	}
+	assert.Equal(t, map[string]string{
+		"migrate-abc1234-presync-1": syncPreviewActionCreate,
+		"updated":                   syncPreviewActionUpdate,
+		"unchanged":                 syncPreviewActionUnchanged,
+		"created":                   syncPreviewActionCreate,
+		"pruned":                    syncPreviewActionPrune,
+	}, actions)
+	assert.Equal(t, map[string]int64{"migrate-abc1234-presync-1": -1, "updated": 0, "unchanged": 0, "created": 1, "pruned": 2}, waves)
+	assert.Equal(t, "PreSync", resources[0].HookType)
+	assert.Equal(t, "PreSync", resources[0].SyncPhase)
+	assert.Equal(t, "migrate-abc1234-presync-1", resources[0].Name)
+}
@@ -0,0 +1,433 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/exec"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// TerminalPath is the path of the websocket endpoint of the web terminal
+	TerminalPath = "/terminal"
+
+	terminalOperationStdin  = "stdin"
+	terminalOperationStdout = "stdout"
+	terminalOperationResize = "resize"
+
+	// endOfTransmission is sent to the shell when the websocket is closed so that it exits
+	endOfTransmission = "\u0004"
+
+	// terminalMaxMessageSize is the maximum size of the messages of the web terminal, which closes the session if exceeded
+	terminalMaxMessageSize = 64 * 1024
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// terminalMessage is a message exchanged with the web terminal over the websocket: the input of the user, the output
+// of the shell or the new size of the terminal
+type terminalMessage struct {
+	Operation string `json:"operation"`
+	Data      string `json:"data,omitempty"`
+	Rows      uint16 `json:"rows,omitempty"`
+	Cols      uint16 `json:"cols,omitempty"`
+}
+
+// terminalHandler serves the web terminal, which runs a shell in a container of a pod of an application and streams
+// its input and output over a websocket
+type terminalHandler struct {
+	appLister     applisters.ApplicationNamespaceLister
+	projInformer  cache.SharedIndexInformer
+	namespace     string
+	db            db.ArgoDB
+	enf           *rbac.Enforcer
+	settingsMgr   *settings.SettingsManager
+	cache         *servercache.Cache
+	auditLogger   *argo.AuditLogger
+	newKubeClient func(config *rest.Config) (kubernetes.Interface, error)
+}
+
+// NewTerminalHandler returns the handler of the web terminal. The requests must be authenticated beforehand, the
+// handler checks that the user is allowed to exec into the pods of the application.
+func NewTerminalHandler(
+	namespace string,
+	kubeclientset kubernetes.Interface,
+	appLister applisters.ApplicationNamespaceLister,
+	projInformer cache.SharedIndexInformer,
+	db db.ArgoDB,
+	enf *rbac.Enforcer,
+	settingsMgr *settings.SettingsManager,
+	cache *servercache.Cache,
+) http.Handler {
+	return &terminalHandler{
+		appLister:    appLister,
+		projInformer: projInformer,
+		namespace:    namespace,
+		db:           db,
+		enf:          enf,
+		settingsMgr:  settingsMgr,
+		cache:        cache,
+		auditLogger:  argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		newKubeClient: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+	}
+}
+
+func (h *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	enabled, err := h.settingsMgr.IsExecEnabled()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to load the settings: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if !enabled {
+		http.Error(w, "The terminal is not enabled", http.StatusNotFound)
+		return
+	}
+
+	q := r.URL.Query()
+	appName := q.Get("appName")
+	namespace := q.Get("namespace")
+	podName := q.Get("podName")
+	container := q.Get("container")
+	shell := q.Get("shell")
+	for param, value := range map[string]string{"appName": appName, "namespace": namespace, "podName": podName} {
+		if value == "" {
+			http.Error(w, fmt.Sprintf("The %s parameter is required", param), http.StatusBadRequest)
+			return
+		}
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		http.Error(w, fmt.Sprintf("Invalid namespace: %s", strings.Join(errs, ", ")), http.StatusBadRequest)
+		return
+	}
+	if errs := validation.IsDNS1123Subdomain(podName); len(errs) > 0 {
+		http.Error(w, fmt.Sprintf("Invalid pod name: %s", strings.Join(errs, ", ")), http.StatusBadRequest)
+		return
+	}
+
+	shells, err := h.settingsMgr.GetExecShells()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to load the settings: %v", err), http.StatusInternalServerError)
+		return
+	}
+	idleTimeout, sessionTimeout, err := h.settingsMgr.GetExecTimeouts()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to load the settings: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if shell != "" {
+		if !isAllowedShell(shell, shells) {
+			http.Error(w, fmt.Sprintf("The shell %s is not allowed", shell), http.StatusBadRequest)
+			return
+		}
+		shells = []string{shell}
+	}
+
+	ctx := r.Context()
+	a, err := h.appLister.Get(appName)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("Application %s not found", appName), http.StatusNotFound)
+		} else {
+			http.Error(w, fmt.Sprintf("Failed to get the application: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	// opening a shell requires the exec permission in addition to the get permission
+	for _, action := range []string{rbacpolicy.ActionGet, rbacpolicy.ActionExec} {
+		if !h.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a)) {
+			http.Error(w, "Permission denied", http.StatusForbidden)
+			return
+		}
+	}
+
+	var tree appv1.ApplicationTree
+	if err := h.cache.GetAppResourcesTree(a.Name, &tree); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get the resources of the application: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if tree.FindNode("", kube.PodKind, namespace, podName) == nil {
+		http.Error(w, fmt.Sprintf("Pod %s/%s is not part of application %s", namespace, podName, appName), http.StatusBadRequest)
+		return
+	}
+
+	config, err := h.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get the cluster of the application: %v", err), http.StatusInternalServerError)
+		return
+	}
+	kubeClientset, err := h.newKubeClient(config)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to create the client of the cluster: %v", err), http.StatusInternalServerError)
+		return
+	}
+	pod, err := kubeClientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get the pod: %v", err), http.StatusBadRequest)
+		return
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		http.Error(w, fmt.Sprintf("Pod %s/%s is not running", namespace, podName), http.StatusBadRequest)
+		return
+	}
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+	if !hasContainer(pod, container) {
+		http.Error(w, fmt.Sprintf("Pod %s/%s has no container %s", namespace, podName, container), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied to the client
+		log.Warnf("Failed to upgrade the terminal connection: %v", err)
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	h.auditLogger.LogAppEvent(a, argo.EventInfo{Type: v1.EventTypeNormal, Reason: argo.EventReasonExecStarted},
+		fmt.Sprintf("%s started a shell in container %s of pod %s/%s", user, container, namespace, podName))
+
+	ts := newTerminalSession(conn, idleTimeout, time.Now().Add(sessionTimeout))
+	defer ts.close()
+	err = startShell(kubeClientset, config, ts, namespace, podName, container, shells)
+	logCtx := log.WithFields(log.Fields{"application": appName, "namespace": namespace, "pod": podName, "container": container})
+	if err != nil {
+		logCtx.Warnf("Terminal session failed: %v", err)
+		_ = ts.writeMessage(terminalMessage{Operation: terminalOperationStdout, Data: fmt.Sprintf("\r\n%v\r\n", err)})
+		return
+	}
+	logCtx.Infof("Terminal session of %s completed", user)
+}
+
+func (h *terminalHandler) getApplicationClusterConfig(ctx context.Context, a *appv1.Application) (*rest.Config, error) {
+	if err := argo.ValidateDestination(ctx, &a.Spec.Destination, h.db); err != nil {
+		return nil, err
+	}
+	clst, err := h.db.GetCluster(ctx, a.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(h.projInformer.GetIndexer()), h.namespace, h.settingsMgr, h.db, ctx)
+	if err != nil {
+		return nil, err
+	}
+	// exec with the permissions of the service account of the destination, like the other operations on the resources
+	config := clst.RESTConfig()
+	if err := argo.ImpersonateServiceAccount(h.settingsMgr, proj, a.Spec.Destination, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func isAllowedShell(shell string, shells []string) bool {
+	for _, s := range shells {
+		if s == shell {
+			return true
+		}
+	}
+	return false
+}
+
+func hasContainer(pod *v1.Pod, container string) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return true
+		}
+	}
+	return false
+}
+
+// startShell runs the first of the given shells available in the container, streaming its input and output through
+// the terminal session until it exits. The next shell is only tried if the previous one failed to start, i.e. before
+// the session received any input.
+func startShell(kubeClientset kubernetes.Interface, config *rest.Config, ts *terminalSession, namespace string, podName string, container string, shells []string) error {
+	var err error
+	for _, shell := range shells {
+		req := kubeClientset.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(namespace).
+			Name(podName).
+			SubResource("exec").
+			VersionedParams(&v1.PodExecOptions{
+				Container: container,
+				Command:   []string{shell},
+				Stdin:     true,
+				Stdout:    true,
+				Stderr:    true,
+				TTY:       true,
+			}, scheme.ParameterCodec)
+		var executor remotecommand.Executor
+		executor, err = remotecommand.NewSPDYExecutor(config, http.MethodPost, req.URL())
+		if err != nil {
+			return err
+		}
+		err = executor.Stream(remotecommand.StreamOptions{
+			Stdin:             ts,
+			Stdout:            ts,
+			Stderr:            ts,
+			Tty:               true,
+			TerminalSizeQueue: ts,
+		})
+		if !isShellNotFoundError(err) || ts.receivedInput() {
+			return err
+		}
+	}
+	return err
+}
+
+// isShellNotFoundError returns whether the shell failed to start because it is not available in the container, in
+// which case the next shell is tried
+func isShellNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if exitErr, ok := err.(exec.ExitError); ok && (exitErr.ExitStatus() == 126 || exitErr.ExitStatus() == 127) {
+		return true
+	}
+	return strings.Contains(err.Error(), "executable file not found")
+}
+
+// terminalSession streams the input and output of a shell over a websocket
+type terminalSession struct {
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+	sizeChan  chan remotecommand.TerminalSize
+	doneChan  chan struct{}
+	closeOnce sync.Once
+	pending   []byte
+	closed    bool
+	// input is set once the session received input from the user, which is then sent to the shell
+	input int32
+	// idleTimeout is how long the session stays open without input, and expiresAt the time it is closed at the latest
+	idleTimeout time.Duration
+	expiresAt   time.Time
+}
+
+func newTerminalSession(conn *websocket.Conn, idleTimeout time.Duration, expiresAt time.Time) *terminalSession {
+	conn.SetReadLimit(terminalMaxMessageSize)
+	return &terminalSession{
+		conn:        conn,
+		sizeChan:    make(chan remotecommand.TerminalSize, 1),
+		doneChan:    make(chan struct{}),
+		idleTimeout: idleTimeout,
+		expiresAt:   expiresAt,
+	}
+}
+
+// receivedInput returns whether the session received input from the user
+func (t *terminalSession) receivedInput() bool {
+	return atomic.LoadInt32(&t.input) == 1
+}
+
+// Read returns the input of the user. It handles the resize messages received in between.
+func (t *terminalSession) Read(p []byte) (int, error) {
+	for len(t.pending) == 0 {
+		if t.closed {
+			return 0, io.EOF
+		}
+		deadline := time.Now().Add(t.idleTimeout)
+		if deadline.After(t.expiresAt) {
+			deadline = t.expiresAt
+		}
+		_ = t.conn.SetReadDeadline(deadline)
+		var msg terminalMessage
+		if err := t.conn.ReadJSON(&msg); err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				_ = t.writeMessage(terminalMessage{Operation: terminalOperationStdout, Data: "\r\nThe terminal session timed out\r\n"})
+			}
+			// make the shell exit once the user closed the terminal or the session timed out or exceeded the message size
+			t.closed = true
+			t.close()
+			_ = t.conn.Close()
+			t.pending = []byte(endOfTransmission)
+			break
+		}
+		switch msg.Operation {
+		case terminalOperationStdin:
+			atomic.StoreInt32(&t.input, 1)
+			t.pending = []byte(msg.Data)
+		case terminalOperationResize:
+			select {
+			case <-t.sizeChan:
+			default:
+			}
+			t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+		default:
+			log.Warnf("Unknown terminal message operation %q", msg.Operation)
+		}
+	}
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+// Write sends the output of the shell to the user
+func (t *terminalSession) Write(p []byte) (int, error) {
+	if err := t.writeMessage(terminalMessage{Operation: terminalOperationStdout, Data: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Next returns the new size of the terminal, or nil once the session is closed
+func (t *terminalSession) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-t.sizeChan:
+		return &size
+	case <-t.doneChan:
+		return nil
+	}
+}
+
+func (t *terminalSession) writeMessage(msg terminalMessage) error {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+	return t.conn.WriteJSON(msg)
+}
+
+func (t *terminalSession) close() {
+	t.closeOnce.Do(func() {
+		close(t.doneChan)
+	})
+}
@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/exec"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func newTestTerminalHandler(t *testing.T, cmData map[string]string, policy string, pods ...*v1.Pod) *terminalHandler {
+	appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:test")
+		_ = enf.SetUserPolicy(policy)
+	}, newTestApp())
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      common.ArgoCDConfigMapName,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: cmData,
+	})
+	stateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Minute)
+	require.NoError(t, stateCache.SetAppResourcesTree("test-app", &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{{
+		ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook"},
+	}}}))
+	serverCache := servercache.NewCache(stateCache, time.Minute, time.Minute, time.Minute)
+	handler := NewTerminalHandler(testNamespace, kubeclientset, appServer.appLister, appServer.projInformer, appServer.db, appServer.enf,
+		settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace), serverCache).(*terminalHandler)
+	var objects []runtime.Object
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+	handler.newKubeClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		return fake.NewSimpleClientset(objects...), nil
+	}
+	return handler
+}
+
+func TestTerminalHandler(t *testing.T) {
+	execPolicy := `
+p, role:test, applications, get, default/test-app, allow
+p, role:test, applications, exec, default/test-app, allow
+`
+	pendingPod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "guestbook"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "guestbook"}}},
+		Status:     v1.PodStatus{Phase: v1.PodPending},
+	}
+	for name, tc := range map[string]struct {
+		cmData         map[string]string
+		policy         string
+		query          string
+		expectedStatus int
+	}{
+		"Disabled": {
+			query:          "appName=test-app&namespace=default&podName=guestbook",
+			policy:         execPolicy,
+			expectedStatus: http.StatusNotFound,
+		},
+		"MissingPodName": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         execPolicy,
+			query:          "appName=test-app&namespace=default",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"InvalidPodName": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         execPolicy,
+			query:          "appName=test-app&namespace=default&podName=../guestbook",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ShellNotAllowed": {
+			cmData:         map[string]string{"exec.enabled": "true", "exec.shells": "sh"},
+			policy:         execPolicy,
+			query:          "appName=test-app&namespace=default&podName=guestbook&shell=bash",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ApplicationNotFound": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         execPolicy,
+			query:          "appName=other-app&namespace=default&podName=guestbook",
+			expectedStatus: http.StatusNotFound,
+		},
+		"NoExecPermission": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         "p, role:test, applications, get, default/test-app, allow",
+			query:          "appName=test-app&namespace=default&podName=guestbook",
+			expectedStatus: http.StatusForbidden,
+		},
+		"PodNotPartOfApplication": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         execPolicy,
+			query:          "appName=test-app&namespace=kube-system&podName=guestbook",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"PodNotRunning": {
+			cmData:         map[string]string{"exec.enabled": "true"},
+			policy:         execPolicy,
+			query:          "appName=test-app&namespace=default&podName=guestbook",
+			expectedStatus: http.StatusBadRequest,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			handler := newTestTerminalHandler(t, tc.cmData, tc.policy, pendingPod)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, TerminalPath+"?"+tc.query, nil))
+			assert.Equal(t, tc.expectedStatus, w.Code, w.Body.String())
+		})
+	}
+}
+
+func TestIsShellNotFoundError(t *testing.T) {
+	assert.False(t, isShellNotFoundError(nil))
+	assert.True(t, isShellNotFoundError(exec.CodeExitError{Err: fmt.Errorf("command terminated with exit code 127"), Code: 127}))
+	assert.True(t, isShellNotFoundError(fmt.Errorf(`exec: "bash": executable file not found in $PATH`)))
+	assert.False(t, isShellNotFoundError(exec.CodeExitError{Err: fmt.Errorf("command terminated with exit code 1"), Code: 1}))
+}
+
+func newTestTerminalSession(t *testing.T, idleTimeout time.Duration, expiresAt time.Time) (*terminalSession, *websocket.Conn) {
+	sessions := make(chan *terminalSession, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		sessions <- newTerminalSession(conn, idleTimeout, expiresAt)
+	}))
+	t.Cleanup(server.Close)
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	return <-sessions, client
+}
+
+func readAll(t *testing.T, ts *terminalSession) string {
+	var input []byte
+	buf := make([]byte, 1024)
+	for {
+		n, err := ts.Read(buf)
+		input = append(input, buf[:n]...)
+		if err != nil {
+			return string(input)
+		}
+	}
+}
+
+func TestTerminalSession_Input(t *testing.T) {
+	ts, client := newTestTerminalSession(t, time.Minute, time.Now().Add(time.Minute))
+	assert.False(t, ts.receivedInput())
+	require.NoError(t, client.WriteJSON(terminalMessage{Operation: terminalOperationResize, Rows: 24, Cols: 80}))
+	require.NoError(t, client.WriteJSON(terminalMessage{Operation: terminalOperationStdin, Data: "ls\r"}))
+
+	buf := make([]byte, 1024)
+	n, err := ts.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ls\r", string(buf[:n]))
+	assert.True(t, ts.receivedInput())
+	assert.Equal(t, &remotecommand.TerminalSize{Width: 80, Height: 24}, ts.Next())
+
+	// the shell exits once the user closed the terminal
+	require.NoError(t, client.Close())
+	assert.Equal(t, endOfTransmission, readAll(t, ts))
+}
+
+func TestTerminalSession_Timeouts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		idleTimeout time.Duration
+		expiresAt   time.Time
+	}{
+		"Idle":    {idleTimeout: 100 * time.Millisecond, expiresAt: time.Now().Add(time.Minute)},
+		"Expired": {idleTimeout: time.Minute, expiresAt: time.Now().Add(100 * time.Millisecond)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ts, client := newTestTerminalSession(t, tc.idleTimeout, tc.expiresAt)
+			assert.Equal(t, endOfTransmission, readAll(t, ts))
+
+			var msg terminalMessage
+			require.NoError(t, client.ReadJSON(&msg))
+			assert.Contains(t, msg.Data, "timed out")
+			assert.Nil(t, ts.Next())
+		})
+	}
+}
+
+func TestTerminalSession_ReadLimit(t *testing.T) {
+	ts, client := newTestTerminalSession(t, time.Minute, time.Now().Add(time.Minute))
+	require.NoError(t, client.WriteJSON(terminalMessage{Operation: terminalOperationStdin, Data: strings.Repeat("a", terminalMaxMessageSize)}))
+
+	assert.Equal(t, endOfTransmission, readAll(t, ts))
+	assert.False(t, ts.receivedInput())
+}
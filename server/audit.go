@@ -0,0 +1,318 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+// auditedMethods are the actions recorded in the audit log, by gRPC method
+var auditedMethods = map[string]string{
+	"/application.ApplicationService/Create":             "applications.create",
+	"/application.ApplicationService/Update":             "applications.update",
+	"/application.ApplicationService/UpdateSpec":         "applications.update",
+	"/application.ApplicationService/Patch":              "applications.update",
+	"/application.ApplicationService/Delete":             "applications.delete",
+	"/application.ApplicationService/Sync":               "applications.sync",
+	"/application.ApplicationService/Rollback":           "applications.rollback",
+	"/application.ApplicationService/TerminateOperation": "applications.terminate-operation",
+	"/application.ApplicationService/PatchResource":      "applications.patch-resource",
+	"/application.ApplicationService/RunResourceAction":  "applications.run-resource-action",
+	"/application.ApplicationService/DeleteResource":     "applications.delete-resource",
+
+	"/project.ProjectService/Create":      "projects.create",
+	"/project.ProjectService/Update":      "projects.update",
+	"/project.ProjectService/Delete":      "projects.delete",
+	"/project.ProjectService/CreateToken": "projects.create-token",
+	"/project.ProjectService/DeleteToken": "projects.delete-token",
+
+	"/repository.RepositoryService/Create":                    "repositories.create",
+	"/repository.RepositoryService/CreateRepository":          "repositories.create",
+	"/repository.RepositoryService/Update":                    "repositories.update",
+	"/repository.RepositoryService/UpdateRepository":          "repositories.update",
+	"/repository.RepositoryService/Delete":                    "repositories.delete",
+	"/repository.RepositoryService/DeleteRepository":          "repositories.delete",
+	"/repocreds.RepoCredsService/CreateRepositoryCredentials": "repocreds.create",
+	"/repocreds.RepoCredsService/UpdateRepositoryCredentials": "repocreds.update",
+	"/repocreds.RepoCredsService/DeleteRepositoryCredentials": "repocreds.delete",
+
+	"/cluster.ClusterService/Create":     "clusters.create",
+	"/cluster.ClusterService/Update":     "clusters.update",
+	"/cluster.ClusterService/Delete":     "clusters.delete",
+	"/cluster.ClusterService/RotateAuth": "clusters.rotate-auth",
+
+	"/account.AccountService/CreateAccount":  "accounts.create",
+	"/account.AccountService/UpdateAccount":  "accounts.update",
+	"/account.AccountService/UpdatePassword": "accounts.update-password",
+	"/account.AccountService/CreateToken":    "accounts.create-token",
+	"/account.AccountService/DeleteToken":    "accounts.delete-token",
+	"/account.AccountService/DeleteTokens":   "accounts.delete-tokens",
+
+	"/gpgkey.GPGKeyService/Create":                      "gpgkeys.create",
+	"/gpgkey.GPGKeyService/Delete":                      "gpgkeys.delete",
+	"/certificate.CertificateService/CreateCertificate": "certificates.create",
+	"/certificate.CertificateService/DeleteCertificate": "certificates.delete",
+
+	"/session.SessionService/Create": "sessions.create",
+	"/session.SessionService/Delete": "sessions.delete",
+}
+
+// auditedStreamMethods are the actions recorded in the audit log, by streaming gRPC method
+var auditedStreamMethods = map[string]string{
+	"/application.ApplicationService/PodLogs": "applications.logs",
+}
+
+const (
+	// auditActionAuthentication is the action of the events recording the failed authentications
+	auditActionAuthentication = "authentication"
+	// auditActionExec is the action of the events recording the terminal sessions
+	auditActionExec = "applications.exec"
+	// auditActionWebhook is the action of the events recording the received git webhooks
+	auditActionWebhook = "webhooks.receive"
+	// auditActionExtension is the action of the events recording the requests proxied to the extensions
+	auditActionExtension = "extensions.invoke"
+	// auditActionLogin is the action of the events recording the logins through SSO
+	auditActionLogin = "sessions.create"
+	// auditActionLogout is the action of the events recording the logouts
+	auditActionLogout = "sessions.delete"
+	// auditActionSCIM is the action of the events recording the SCIM provisioning requests
+	auditActionSCIM = "scim.provision"
+)
+
+// auditedObject returns the name and the namespace of the object a request performs an action on
+func auditedObject(req interface{}) (string, string) {
+	switch r := req.(type) {
+	case interface{ GetApplication() *v1alpha1.Application }:
+		if app := r.GetApplication(); app != nil {
+			return app.Name, app.Namespace
+		}
+	case interface{ GetApplication() v1alpha1.Application }:
+		app := r.GetApplication()
+		return app.Name, app.Namespace
+	case interface{ GetProject() *v1alpha1.AppProject }:
+		if proj := r.GetProject(); proj != nil {
+			return proj.Name, proj.Namespace
+		}
+	case interface{ GetProject() string }:
+		return r.GetProject(), ""
+	case interface{ GetRepo() *v1alpha1.Repository }:
+		if repo := r.GetRepo(); repo != nil {
+			return repo.Repo, ""
+		}
+	case interface{ GetRepo() string }:
+		return r.GetRepo(), ""
+	case interface{ GetCreds() *v1alpha1.RepoCreds }:
+		if creds := r.GetCreds(); creds != nil {
+			return creds.URL, ""
+		}
+	case interface{ GetCluster() *v1alpha1.Cluster }:
+		if cluster := r.GetCluster(); cluster != nil {
+			return cluster.Server, ""
+		}
+	case interface {
+		GetServer() string
+		GetName() string
+	}:
+		if r.GetServer() != "" {
+			return r.GetServer(), ""
+		}
+		return r.GetName(), ""
+	case interface {
+		GetPublickey() *v1alpha1.GnuPGPublicKey
+	}:
+		if key := r.GetPublickey(); key != nil {
+			return key.KeyID, ""
+		}
+	case interface{ GetKeyID() string }:
+		return r.GetKeyID(), ""
+	case interface{ GetHostNamePattern() string }:
+		return r.GetHostNamePattern(), ""
+	case interface{ GetUsername() string }:
+		return r.GetUsername(), ""
+	case interface{ GetName() string }:
+		return r.GetName(), ""
+	case interface{ GetUrl() string }:
+		return r.GetUrl(), ""
+	}
+	return "", ""
+}
+
+// newAuditEvent returns the event recording the action performed by the gRPC method handling the given context
+func newAuditEvent(ctx context.Context, action string, method string) audit.Event {
+	user := session.Username(ctx)
+	if user == "" {
+		user = session.Sub(ctx)
+	}
+	return audit.Event{
+		Time:      time.Now().UTC(),
+		RequestID: grpc_util.RequestID(ctx),
+		User:      user,
+		Action:    action,
+		Method:    method,
+	}
+}
+
+func setAuditEventResult(event *audit.Event, err error) {
+	event.Success = err == nil
+	if err != nil {
+		event.Error = err.Error()
+	}
+}
+
+// auditUnaryServerInterceptor returns a UnaryServerInterceptor recording the audited actions in the given audit log
+func auditUnaryServerInterceptor(logger *audit.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		action, ok := auditedMethods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		event := newAuditEvent(ctx, action, info.FullMethod)
+		event.Name, event.Namespace = auditedObject(req)
+		resp, err := handler(ctx, req)
+		setAuditEventResult(&event, err)
+		logger.Log(event)
+		return resp, err
+	}
+}
+
+// auditedServerStream records the request of a server stream
+type auditedServerStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+func (s *auditedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+// auditStreamServerInterceptor returns a StreamServerInterceptor recording the audited actions in the given audit log
+// once the streams end
+func auditStreamServerInterceptor(logger *audit.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		action, ok := auditedStreamMethods[info.FullMethod]
+		if !ok {
+			return handler(srv, stream)
+		}
+		event := newAuditEvent(stream.Context(), action, info.FullMethod)
+		auditedStream := &auditedServerStream{ServerStream: stream}
+		err := handler(srv, auditedStream)
+		event.Name, event.Namespace = auditedObject(auditedStream.req)
+		setAuditEventResult(&event, err)
+		logger.Log(event)
+		return err
+	}
+}
+
+// auditAuthenticationFailure records the failed authentication of the gRPC request with the given context in the
+// given audit log, if any. The requests without credentials are not recorded.
+func auditAuthenticationFailure(logger *audit.Logger, ctx context.Context, err error) {
+	if logger == nil || err == ErrNoSession {
+		return
+	}
+	method, _ := grpc.Method(ctx)
+	event := newAuditEvent(ctx, auditActionAuthentication, method)
+	setAuditEventResult(&event, err)
+	logger.Log(event)
+}
+
+// auditedResponseWriter records the status code of the responses of the audited HTTP handlers
+type auditedResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditedResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditedResponseWriter) Write(data []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditedResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack hijacks the connection of the websocket handlers, e.g. the terminal
+func (w *auditedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("the response writer does not support hijacking")
+	}
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+// auditHTTPHandler returns a handler recording the requests served by the given handler as events of the given action
+// in the given audit log, if any. The requests are authenticated with the given middleware, if not nil, so that the
+// events record the users, and the requests failing to authenticate are recorded as failed authentications.
+func auditHTTPHandler(logger *audit.Logger, action string, authenticate func(http.Handler) http.Handler, handler http.Handler) http.Handler {
+	if logger == nil {
+		if authenticate != nil {
+			return authenticate(handler)
+		}
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(grpc_util.RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(grpc_util.RequestIDHeader, requestID)
+		event := audit.Event{
+			Time:      time.Now().UTC(),
+			RequestID: requestID,
+			Action:    auditActionAuthentication,
+			Method:    r.Method + " " + r.URL.Path,
+		}
+		var served http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			event.Action = action
+			event.User = session.Username(r.Context())
+			if event.User == "" {
+				event.User = session.Sub(r.Context())
+			}
+			handler.ServeHTTP(w, r)
+		})
+		if authenticate != nil {
+			served = authenticate(served)
+		} else {
+			event.Action = action
+		}
+		recorder := &auditedResponseWriter{ResponseWriter: w}
+		served.ServeHTTP(recorder, r)
+		status := recorder.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		event.Success = status < http.StatusBadRequest
+		if !event.Success {
+			event.Error = fmt.Sprintf("%d %s", status, http.StatusText(status))
+		}
+		logger.Log(event)
+	})
+}
@@ -0,0 +1,263 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/repository"
+	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+func TestAuditedObject(t *testing.T) {
+	name := "guestbook"
+	for _, tc := range []struct {
+		req       interface{}
+		name      string
+		namespace string
+	}{
+		{&application.ApplicationSyncRequest{Name: &name}, "guestbook", ""},
+		{&application.ApplicationCreateRequest{Application: v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}}}, "guestbook", "argocd"},
+		{&project.ProjectUpdateRequest{Project: &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}}}, "default", "argocd"},
+		{&project.ProjectTokenCreateRequest{Project: "default"}, "default", ""},
+		{&repository.RepoCreateRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}}, "https://github.com/argoproj/argocd-example-apps", ""},
+		{&repository.RepoQuery{Repo: "https://github.com/argoproj/argocd-example-apps"}, "https://github.com/argoproj/argocd-example-apps", ""},
+		{&cluster.ClusterUpdateRequest{Cluster: &v1alpha1.Cluster{Server: "https://kubernetes.default.svc"}}, "https://kubernetes.default.svc", ""},
+		{&cluster.ClusterQuery{Name: "in-cluster"}, "in-cluster", ""},
+		{&sessionpkg.SessionCreateRequest{Username: "alice"}, "alice", ""},
+	} {
+		name, namespace := auditedObject(tc.req)
+		assert.Equal(t, tc.name, name)
+		assert.Equal(t, tc.namespace, namespace)
+	}
+}
+
+type fakeAuditSink struct {
+	lock   sync.Mutex
+	events []audit.Event
+}
+
+func (s *fakeAuditSink) Write(_ context.Context, events []audit.Event) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+// newFakeAuditLogger returns a logger writing to the returned sink, whose events are written once the logger is
+// closed
+func newFakeAuditLogger() (*audit.Logger, *fakeAuditSink) {
+	sink := &fakeAuditSink{}
+	return audit.NewLogger(sink), sink
+}
+
+func TestAuditUnaryServerInterceptor(t *testing.T) {
+	logger, sink := newFakeAuditLogger()
+	interceptor := auditUnaryServerInterceptor(logger)
+
+	reqCtx := context.WithValue(grpc_util.ContextWithRequestID(context.Background(), "my-request"), "claims", &jwt.StandardClaims{Subject: "admin", Issuer: session.SessionManagerClaimsIssuer})
+	name := "guestbook"
+	_, err := interceptor(reqCtx, &application.ApplicationSyncRequest{Name: &name}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("sync failed")
+	})
+	assert.Error(t, err)
+
+	_, err = interceptor(reqCtx, &application.ApplicationQuery{}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/List"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), &sessionpkg.SessionCreateRequest{Username: "alice"}, &grpc.UnaryServerInfo{FullMethod: "/session.SessionService/Create"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &sessionpkg.SessionResponse{}, nil
+	})
+	assert.NoError(t, err)
+
+	logger.Close()
+	if assert.Len(t, sink.events, 2) {
+		event := sink.events[0]
+		assert.Equal(t, "my-request", event.RequestID)
+		assert.Equal(t, "admin", event.User)
+		assert.Equal(t, "applications.sync", event.Action)
+		assert.Equal(t, "/application.ApplicationService/Sync", event.Method)
+		assert.Equal(t, "guestbook", event.Name)
+		assert.False(t, event.Success)
+		assert.Equal(t, "sync failed", event.Error)
+
+		event = sink.events[1]
+		assert.Equal(t, "sessions.create", event.Action)
+		assert.Equal(t, "alice", event.Name)
+		assert.True(t, event.Success)
+	}
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	*m.(*application.ApplicationPodLogsQuery) = *s.req.(*application.ApplicationPodLogsQuery)
+	return nil
+}
+
+func TestAuditStreamServerInterceptor(t *testing.T) {
+	logger, sink := newFakeAuditLogger()
+	interceptor := auditStreamServerInterceptor(logger)
+
+	reqCtx := context.WithValue(grpc_util.ContextWithRequestID(context.Background(), "my-request"), "claims", &jwt.StandardClaims{Subject: "admin", Issuer: session.SessionManagerClaimsIssuer})
+	name := "guestbook"
+	stream := &fakeServerStream{ctx: reqCtx, req: &application.ApplicationPodLogsQuery{Name: &name}}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/PodLogs"}, func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&application.ApplicationPodLogsQuery{})
+	})
+	assert.NoError(t, err)
+
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Watch"}, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	assert.NoError(t, err)
+
+	logger.Close()
+	if assert.Len(t, sink.events, 1) {
+		event := sink.events[0]
+		assert.Equal(t, "my-request", event.RequestID)
+		assert.Equal(t, "admin", event.User)
+		assert.Equal(t, "applications.logs", event.Action)
+		assert.Equal(t, "guestbook", event.Name)
+		assert.True(t, event.Success)
+	}
+}
+
+func TestAuditAuthenticationFailure(t *testing.T) {
+	logger, sink := newFakeAuditLogger()
+	ctx := grpc_util.ContextWithRequestID(context.Background(), "my-request")
+	auditAuthenticationFailure(logger, ctx, ErrNoSession)
+	auditAuthenticationFailure(logger, ctx, status.Error(codes.Unauthenticated, "invalid session: token is expired"))
+	auditAuthenticationFailure(nil, ctx, status.Error(codes.Unauthenticated, "invalid session: token is expired"))
+
+	logger.Close()
+	if assert.Len(t, sink.events, 1) {
+		event := sink.events[0]
+		assert.Equal(t, "my-request", event.RequestID)
+		assert.Equal(t, "authentication", event.Action)
+		assert.False(t, event.Success)
+		assert.Contains(t, event.Error, "token is expired")
+	}
+}
+
+func TestAuditHTTPHandler(t *testing.T) {
+	authenticate := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer valid" {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			// nolint:staticcheck
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "claims", &jwt.StandardClaims{Subject: "admin", Issuer: session.SessionManagerClaimsIssuer})))
+		})
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	t.Run("Authenticated", func(t *testing.T) {
+		logger, sink := newFakeAuditLogger()
+		audited := auditHTTPHandler(logger, "applications.exec", authenticate, handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/terminal", nil)
+		req.Header.Set("Authorization", "Bearer valid")
+		req.Header.Set("X-Request-Id", "my-request")
+		w := httptest.NewRecorder()
+		audited.ServeHTTP(w, req)
+		assert.Equal(t, "my-request", w.Header().Get("X-Request-Id"))
+
+		req = httptest.NewRequest(http.MethodGet, "/missing", nil)
+		req.Header.Set("Authorization", "Bearer valid")
+		w = httptest.NewRecorder()
+		audited.ServeHTTP(w, req)
+		assert.NotEmpty(t, w.Header().Get("X-Request-Id"))
+
+		logger.Close()
+		if assert.Len(t, sink.events, 2) {
+			event := sink.events[0]
+			assert.Equal(t, "my-request", event.RequestID)
+			assert.Equal(t, "admin", event.User)
+			assert.Equal(t, "applications.exec", event.Action)
+			assert.Equal(t, "GET /terminal", event.Method)
+			assert.True(t, event.Success)
+
+			event = sink.events[1]
+			assert.Equal(t, w.Header().Get("X-Request-Id"), event.RequestID)
+			assert.False(t, event.Success)
+			assert.Equal(t, "404 Not Found", event.Error)
+		}
+	})
+
+	t.Run("FailedAuthentication", func(t *testing.T) {
+		logger, sink := newFakeAuditLogger()
+		audited := auditHTTPHandler(logger, "applications.exec", authenticate, handler)
+
+		req := httptest.NewRequest(http.MethodGet, "/terminal", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		w := httptest.NewRecorder()
+		audited.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		logger.Close()
+		if assert.Len(t, sink.events, 1) {
+			event := sink.events[0]
+			assert.Equal(t, "authentication", event.Action)
+			assert.Empty(t, event.User)
+			assert.False(t, event.Success)
+		}
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		logger, sink := newFakeAuditLogger()
+		audited := auditHTTPHandler(logger, "webhooks.receive", nil, handler)
+
+		w := httptest.NewRecorder()
+		audited.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/webhook", nil))
+
+		logger.Close()
+		if assert.Len(t, sink.events, 1) {
+			event := sink.events[0]
+			assert.Equal(t, "webhooks.receive", event.Action)
+			assert.Equal(t, "POST /api/webhook", event.Method)
+			assert.True(t, event.Success)
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		audited := auditHTTPHandler(nil, "applications.exec", authenticate, handler)
+		w := httptest.NewRecorder()
+		audited.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/terminal", nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
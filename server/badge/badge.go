@@ -1,32 +1,31 @@
 package badge
 
 import (
-	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"regexp"
 
 	healthutil "github.com/argoproj/gitops-engine/pkg/health"
 	"k8s.io/apimachinery/pkg/api/errors"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
-	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 //NewHandler creates handler serving to do api/badge endpoint
-func NewHandler(appClientset versioned.Interface, settingsMrg *settings.SettingsManager, namespace string) http.Handler {
-	return &Handler{appClientset: appClientset, namespace: namespace, settingsMgr: settingsMrg}
+func NewHandler(appLister applisters.ApplicationNamespaceLister, settingsMrg *settings.SettingsManager) http.Handler {
+	return &Handler{appLister: appLister, settingsMgr: settingsMrg}
 }
 
 //Handler used to get application in order to access health/sync
 type Handler struct {
-	namespace    string
-	appClientset versioned.Interface
-	settingsMgr  *settings.SettingsManager
+	appLister   applisters.ApplicationNamespaceLister
+	settingsMgr *settings.SettingsManager
 }
 
 var (
@@ -38,12 +37,24 @@ var (
 	leftTextPattern          = regexp.MustCompile(`id="leftText" [^>]*>([^<]*)`)
 	rightTextPattern         = regexp.MustCompile(`id="rightText" [^>]*>([^<]*)`)
 	revisionTextPattern      = regexp.MustCompile(`id="revisionText" [^>]*>([^<]*)`)
+	cornerRadiusPattern      = regexp.MustCompile(`(id="cornerRect" [^>]*rx=)"[^"]*"`)
 )
 
 const (
 	svgWidthWithRevision = 192
+
+	// styleFlat is the default style of the badges, with rounded corners
+	styleFlat = "flat"
+	// styleFlatSquare is the style of the badges with square corners
+	styleFlatSquare = "flat-square"
 )
 
+// isKeyValid returns whether the key grants access to the badges of the applications of the project
+func isKeyValid(keys map[string]string, project string, key string) bool {
+	projectKey, ok := keys[project]
+	return ok && key != "" && subtle.ConstantTimeCompare([]byte(projectKey), []byte(key)) == 1
+}
+
 func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) string {
 	result := ""
 	lastIndex := 0
@@ -70,46 +81,77 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	revisionEnabled := false
 	enabled := false
 	notFound := false
+	var keys map[string]string
 	if sets, err := h.settingsMgr.GetSettings(); err == nil {
 		enabled = sets.StatusBadgeEnabled
+		keys = sets.StatusBadgeKeys
+	}
+	// when the badges are not enabled for everyone, the key of the project grants access to the badges of its applications
+	//Sample url: http://localhost:8080/api/badge?name=123&key=abc
+	key := r.URL.Query().Get("key")
+	allowed := func(project string) bool {
+		return enabled || isKeyValid(keys, project, key)
+	}
+	// the applications are only looked up if the badges are enabled or the key is the one of a project
+	lookup := enabled
+	for project := range keys {
+		lookup = lookup || isKeyValid(keys, project, key)
 	}
 
 	//Sample url: http://localhost:8080/api/badge?name=123
-	if name, ok := r.URL.Query()["name"]; ok && enabled {
-		if app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(context.Background(), name[0], v1.GetOptions{}); err == nil {
-			health = app.Status.Health.Status
-			status = app.Status.Sync.Status
-			if app.Status.OperationState != nil && app.Status.OperationState.SyncResult != nil {
-				revision = app.Status.OperationState.SyncResult.Revision
+	if name, ok := r.URL.Query()["name"]; ok && lookup {
+		if app, err := h.appLister.Get(name[0]); err == nil {
+			if allowed(app.Spec.GetProject()) {
+				health = app.Status.Health.Status
+				status = app.Status.Sync.Status
+				if app.Status.OperationState != nil && app.Status.OperationState.SyncResult != nil {
+					revision = app.Status.OperationState.SyncResult.Revision
+				}
 			}
-		} else if errors.IsNotFound(err) {
+		} else if errors.IsNotFound(err) && enabled {
+			// the existence of the applications is not disclosed to the holders of a key
 			notFound = true
 		}
 	}
 	//Sample url: http://localhost:8080/api/badge?project=default
-	if projects, ok := r.URL.Query()["project"]; ok && enabled {
-		if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).List(context.Background(), v1.ListOptions{}); err == nil {
-			applicationSet := argo.FilterByProjects(apps.Items, projects)
-			for _, a := range applicationSet {
-				if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
-					status = appv1.SyncStatusCodeOutOfSync
+	if projects, ok := r.URL.Query()["project"]; ok && lookup {
+		projectsAllowed := true
+		for _, project := range projects {
+			projectsAllowed = projectsAllowed && allowed(project)
+		}
+		if projectsAllowed {
+			if apps, err := h.appLister.List(labels.Everything()); err == nil {
+				applicationSet := argo.FilterByProjectsP(apps, projects)
+				for _, a := range applicationSet {
+					if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
+						status = appv1.SyncStatusCodeOutOfSync
+					}
+					if a.Status.Health.Status != healthutil.HealthStatusHealthy {
+						health = healthutil.HealthStatusDegraded
+					}
 				}
-				if a.Status.Health.Status != healthutil.HealthStatusHealthy {
-					health = healthutil.HealthStatusDegraded
+				if health != healthutil.HealthStatusDegraded && len(applicationSet) > 0 {
+					health = healthutil.HealthStatusHealthy
+				}
+				if status != appv1.SyncStatusCodeOutOfSync && len(applicationSet) > 0 {
+					status = appv1.SyncStatusCodeSynced
 				}
-			}
-			if health != healthutil.HealthStatusDegraded && len(applicationSet) > 0 {
-				health = healthutil.HealthStatusHealthy
-			}
-			if status != appv1.SyncStatusCodeOutOfSync && len(applicationSet) > 0 {
-				status = appv1.SyncStatusCodeSynced
 			}
 		}
 	}
 	//Sample url: http://localhost:8080/api/badge?name=123&revision=true
-	if _, ok := r.URL.Query()["revision"]; ok && enabled {
+	if _, ok := r.URL.Query()["revision"]; ok {
 		revisionEnabled = true
 	}
+	//Sample url: http://localhost:8080/api/badge?name=123&style=flat-square
+	style := r.URL.Query().Get("style")
+	if style == "" {
+		style = styleFlat
+	}
+	if style != styleFlat && style != styleFlatSquare {
+		http.Error(w, fmt.Sprintf("Unknown badge style %s, supported styles are %s and %s", style, styleFlat, styleFlatSquare), http.StatusBadRequest)
+		return
+	}
 
 	leftColorString := ""
 	if leftColor, ok := HealthStatusColors[health]; ok {
@@ -134,6 +176,9 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	badge := assets.BadgeSVG
+	if style == styleFlatSquare {
+		badge = cornerRadiusPattern.ReplaceAllString(badge, `$1"0"`)
+	}
 	badge = leftRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="leftRect" fill="%s" $2`, leftColorString))
 	badge = rightRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="rightRect" fill="%s" $2`, rightColorString))
 	badge = replaceFirstGroupSubMatch(leftTextPattern, badge, leftText)
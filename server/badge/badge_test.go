@@ -10,14 +10,16 @@ import (
 	"testing"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
-	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
@@ -52,15 +54,20 @@ var (
 			},
 		},
 	}
-	testProject = v1alpha1.AppProject{
-		ObjectMeta: v1.ObjectMeta{Name: "testProject", Namespace: "default"},
-		Spec:       v1alpha1.AppProjectSpec{},
-	}
 )
 
+// newTestHandler returns a handler serving the badges of the given applications of the namespace
+func newTestHandler(settingsMgr *settings.SettingsManager, namespace string, apps ...*v1alpha1.Application) http.Handler {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		_ = indexer.Add(app)
+	}
+	return NewHandler(applisters.NewApplicationLister(indexer).Applications(namespace), settingsMgr)
+}
+
 func TestHandlerFeatureIsEnabled(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	handler := newTestHandler(settingsMgr, "default", &testApp)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
 	assert.NoError(t, err)
 
@@ -111,7 +118,7 @@ func TestHandlerFeatureProjectIsEnabled(t *testing.T) {
 		argoCDCm.ObjectMeta.Namespace = tt.namespace
 		argoCDSecret.ObjectMeta.Namespace = tt.namespace
 		settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), tt.namespace)
-		handler := NewHandler(appclientset.NewSimpleClientset(&testProject, tt.testApp[0], tt.testApp[1]), settingsMgr, tt.namespace)
+		handler := newTestHandler(settingsMgr, tt.namespace, tt.testApp[0], tt.testApp[1])
 		rr := httptest.NewRecorder()
 		req, err := http.NewRequest("GET", tt.apiEndPoint, nil)
 		assert.NoError(t, err)
@@ -176,7 +183,7 @@ func createApplications(appCombo, projectName []string, namespace string) []*v1a
 }
 func TestHandlerFeatureIsEnabledRevisionIsEnabled(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	handler := newTestHandler(settingsMgr, "default", &testApp)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp&revision=true", nil)
 	assert.NoError(t, err)
 
@@ -199,7 +206,7 @@ func TestHandlerRevisionIsEnabledNoOperationState(t *testing.T) {
 	app.Status.OperationState = nil
 
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+	handler := newTestHandler(settingsMgr, "default", app)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp&revision=true", nil)
 	assert.NoError(t, err)
 
@@ -222,7 +229,7 @@ func TestHandlerRevisionIsEnabledShortCommitSHA(t *testing.T) {
 	app.Status.OperationState.SyncResult.Revision = "abc"
 
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+	handler := newTestHandler(settingsMgr, "default", app)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp&revision=true", nil)
 	assert.NoError(t, err)
 
@@ -239,7 +246,7 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
 
 	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, &argoCDSecret), "default")
-	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+	handler := newTestHandler(settingsMgr, "default", &testApp)
 	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
 	assert.NoError(t, err)
 
@@ -255,3 +262,109 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1])
 	assert.Equal(t, "Unknown", rightTextPattern.FindStringSubmatch(response)[1])
 }
+
+func TestHandlerFeatureIsDisabledProjectKey(t *testing.T) {
+	argoCDCmDisabled := argoCDCm.DeepCopy()
+	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
+	argoCDSecretWithKey := argoCDSecret.DeepCopy()
+	argoCDSecretWithKey.Data["statusbadge.key.default"] = []byte("abc")
+	app := testApp.DeepCopy()
+	app.Spec.Project = "default"
+	otherApp := createApplicationFeatureProjectIsEnabled(health.HealthStatusDegraded, v1alpha1.SyncStatusCodeOutOfSync, "otherApp", "other", "default")
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, argoCDSecretWithKey), "default")
+	handler := newTestHandler(settingsMgr, "default", app, otherApp)
+	badge := func(query string) string {
+		req, err := http.NewRequest("GET", "/api/badge?"+query, nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		return rr.Body.String()
+	}
+
+	response := badge("name=testApp&key=abc&revision=true")
+	assert.Equal(t, "Healthy", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Synced", rightTextPattern.FindStringSubmatch(response)[1])
+	assert.Contains(t, response, "(aa29b85)")
+
+	response = badge("project=default&key=abc")
+	assert.Equal(t, "Healthy", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Synced", rightTextPattern.FindStringSubmatch(response)[1])
+
+	for _, query := range []string{"name=testApp", "name=testApp&key=wrong", "name=otherApp&key=abc", "name=missing&key=abc", "project=default&project=other&key=abc"} {
+		response = badge(query)
+		assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1], query)
+		assert.Equal(t, "Unknown", rightTextPattern.FindStringSubmatch(response)[1], query)
+		assert.NotContains(t, response, "(aa29b85)", query)
+	}
+}
+
+// countingLister counts the calls to an application lister
+type countingLister struct {
+	applisters.ApplicationNamespaceLister
+	calls int
+}
+
+func (l *countingLister) List(selector labels.Selector) ([]*v1alpha1.Application, error) {
+	l.calls++
+	return l.ApplicationNamespaceLister.List(selector)
+}
+
+func (l *countingLister) Get(name string) (*v1alpha1.Application, error) {
+	l.calls++
+	return l.ApplicationNamespaceLister.Get(name)
+}
+
+func TestHandlerFeatureIsDisabledInvalidKey(t *testing.T) {
+	argoCDCmDisabled := argoCDCm.DeepCopy()
+	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
+	argoCDSecretWithKey := argoCDSecret.DeepCopy()
+	argoCDSecretWithKey.Data["statusbadge.key.default"] = []byte("abc")
+	app := testApp.DeepCopy()
+	app.Spec.Project = "default"
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	assert.NoError(t, indexer.Add(app))
+	lister := &countingLister{ApplicationNamespaceLister: applisters.NewApplicationLister(indexer).Applications("default")}
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, argoCDSecretWithKey), "default")
+	handler := NewHandler(lister, settingsMgr)
+	badge := func(query string) string {
+		req, err := http.NewRequest("GET", "/api/badge?"+query, nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		return rr.Body.String()
+	}
+
+	// the applications are not looked up without the key of a project
+	for _, query := range []string{"name=testApp", "name=testApp&key=wrong", "project=default&key=wrong", "project=default&project=other&key=abc"} {
+		response := badge(query)
+		assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1], query)
+	}
+	assert.Equal(t, 0, lister.calls)
+
+	response := badge("project=default&key=abc")
+	assert.Equal(t, "Healthy", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, 1, lister.calls)
+}
+
+func TestHandlerStyle(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := newTestHandler(settingsMgr, "default", &testApp)
+	badge := func(query string) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/api/badge?name=testApp"+query, nil)
+		assert.NoError(t, err)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	assert.Contains(t, badge("").Body.String(), `id="cornerRect" width="100%" height="100%" rx="3"`)
+	assert.Contains(t, badge("&style=flat").Body.String(), `id="cornerRect" width="100%" height="100%" rx="3"`)
+	rr := badge("&style=flat-square")
+	assert.Contains(t, rr.Body.String(), `id="cornerRect" width="100%" height="100%" rx="0"`)
+	assert.Equal(t, "Healthy", leftTextPattern.FindStringSubmatch(rr.Body.String())[1])
+	assert.Equal(t, http.StatusBadRequest, badge("&style=plastic").Code)
+}
@@ -174,6 +174,15 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"clusterResources": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.ClusterResources = existing.ClusterResources
 	},
+	"resourceExclusions": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.ResourceExclusions = existing.ResourceExclusions
+	},
+	"resourceInclusions": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.ResourceInclusions = existing.ResourceInclusions
+	},
+	"resyncInterval": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.ResyncInterval = existing.ResyncInterval
+	},
 }
 
 // Update updates a cluster
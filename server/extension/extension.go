@@ -0,0 +1,334 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// URLPrefix is the prefix of the paths of the requests of the extensions, e.g. /extensions/<name>/<path>
+	URLPrefix = "/extensions/"
+
+	// argoCDHeaderPrefix is the prefix of the Argo CD headers, which the clients can't supply except the application
+	// and project names
+	argoCDHeaderPrefix = "Argocd-"
+	// HeaderArgoCDApplicationName is the header of the requests naming the application they are made in the context of
+	HeaderArgoCDApplicationName = "Argocd-Application-Name"
+	// HeaderArgoCDProjectName is the header of the requests naming the project of the application
+	HeaderArgoCDProjectName = "Argocd-Project-Name"
+	// HeaderArgoCDTargetClusterName is the header of the proxied requests holding the name of the cluster of the application
+	HeaderArgoCDTargetClusterName = "Argocd-Target-Cluster-Name"
+	// HeaderArgoCDTargetClusterURL is the header of the proxied requests holding the server URL of the cluster of the application
+	HeaderArgoCDTargetClusterURL = "Argocd-Target-Cluster-URL"
+	// HeaderArgoCDUsername is the header of the proxied requests holding the name of the user
+	HeaderArgoCDUsername = "Argocd-Username"
+
+	defaultConnectionTimeout     = 2 * time.Second
+	defaultKeepAlive             = 15 * time.Second
+	defaultIdleConnectionTimeout = 60 * time.Second
+	defaultMaxIdleConnections    = 30
+)
+
+// serviceProxy proxies the requests to a backend service of an extension
+type serviceProxy struct {
+	service settings.ExtensionService
+	proxy   *httputil.ReverseProxy
+}
+
+// Manager proxies the requests of the UI extensions to the backend services configured in argocd-cm, in the context
+// of an application the user is allowed to get
+type Manager struct {
+	settingsMgr *settings.SettingsManager
+	appLister   applisters.ApplicationNamespaceLister
+	db          db.ArgoDB
+	enf         *rbac.Enforcer
+
+	lock       sync.RWMutex
+	configs    []settings.ExtensionConfig
+	proxies    map[string][]serviceProxy
+	transports []*http.Transport
+}
+
+// proxiesKey is the key of the context of the requests holding the proxies of the services of the requested extension
+type proxiesKey struct{}
+
+// NewManager returns a new manager of the extensions configured at the time. Run keeps them up to date.
+func NewManager(settingsMgr *settings.SettingsManager, appLister applisters.ApplicationNamespaceLister, db db.ArgoDB, enf *rbac.Enforcer) *Manager {
+	m := &Manager{
+		settingsMgr: settingsMgr,
+		appLister:   appLister,
+		db:          db,
+		enf:         enf,
+	}
+	m.reload()
+	return m
+}
+
+// Run reloads the extensions when the settings change until the context is done
+func (m *Manager) Run(ctx context.Context) {
+	updateCh := make(chan *settings.ArgoCDSettings, 1)
+	m.settingsMgr.Subscribe(updateCh)
+	defer m.settingsMgr.Unsubscribe(updateCh)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-updateCh:
+			m.reload()
+		}
+	}
+}
+
+// Handler returns the handler of the URLPrefix path. The requests of the configured extensions are authenticated by the
+// given middleware and proxied to their backend services, the other requests are served by the given handler, e.g. the
+// handler of the UI extensions files.
+func (m *Manager) Handler(authenticate func(http.Handler) http.Handler, next http.Handler) http.Handler {
+	proxyHandler := authenticate(http.HandlerFunc(m.serveProxy))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, _ := splitPath(r.URL.Path)
+		proxies, ok := m.getProxies()[name]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		proxyHandler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), proxiesKey{}, proxies)))
+	})
+}
+
+// splitPath returns the name of the extension and the path of the request to its backend service
+func splitPath(path string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(path, URLPrefix), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], "/"
+	}
+	return parts[0], "/" + parts[1]
+}
+
+// getProxies returns the proxies of the services of the extensions by extension name
+func (m *Manager) getProxies() map[string][]serviceProxy {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.proxies
+}
+
+// reload recreates the proxies of the services of the extensions if their configuration changed, keeping the previous
+// ones if it is invalid. The idle connections of the replaced proxies are closed.
+func (m *Manager) reload() {
+	configs, err := m.settingsMgr.GetExtensionConfigs()
+	if err != nil {
+		log.Warnf("Failed to load the extensions: %v", err)
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.proxies != nil && reflect.DeepEqual(configs, m.configs) {
+		return
+	}
+	proxies := make(map[string][]serviceProxy)
+	var transports []*http.Transport
+	for _, config := range configs {
+		transport := newTransport(config.Backend)
+		transports = append(transports, transport)
+		for _, service := range config.Backend.Services {
+			// the URLs are validated by the settings manager
+			target, _ := url.Parse(service.URL)
+			proxies[config.Name] = append(proxies[config.Name], serviceProxy{service: service, proxy: newReverseProxy(config.Name, target, transport)})
+		}
+	}
+	for _, transport := range m.transports {
+		transport.CloseIdleConnections()
+	}
+	m.configs = configs
+	m.proxies = proxies
+	m.transports = transports
+	log.Infof("Loaded %d extensions", len(configs))
+}
+
+func newTransport(backend settings.ExtensionBackend) *http.Transport {
+	durationOrDefault := func(d time.Duration, defaultDuration time.Duration) time.Duration {
+		if d == 0 {
+			return defaultDuration
+		}
+		return d
+	}
+	maxIdleConnections := backend.MaxIdleConnections
+	if maxIdleConnections == 0 {
+		maxIdleConnections = defaultMaxIdleConnections
+	}
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   durationOrDefault(backend.ConnectionTimeout.Duration, defaultConnectionTimeout),
+			KeepAlive: durationOrDefault(backend.KeepAlive.Duration, defaultKeepAlive),
+		}).DialContext,
+		MaxIdleConns:          maxIdleConnections,
+		IdleConnTimeout:       durationOrDefault(backend.IdleConnectionTimeout.Duration, defaultIdleConnectionTimeout),
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+func newReverseProxy(name string, target *url.URL, transport http.RoundTripper) *httputil.ReverseProxy {
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = target.Host
+	}
+	proxy.Transport = &identityTransport{transport: transport}
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		log.Warnf("Failed to proxy the request of extension %s to %s: %v", name, target.Host, err)
+		http.Error(w, fmt.Sprintf("Failed to reach the backend of extension %s", name), http.StatusBadGateway)
+	}
+	return proxy
+}
+
+func (m *Manager) serveProxy(w http.ResponseWriter, r *http.Request) {
+	name, path := splitPath(r.URL.Path)
+	appName := r.Header.Get(HeaderArgoCDApplicationName)
+	projName := r.Header.Get(HeaderArgoCDProjectName)
+	if appName == "" || projName == "" {
+		http.Error(w, fmt.Sprintf("The %s and %s headers are required", HeaderArgoCDApplicationName, HeaderArgoCDProjectName), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	a, err := m.appLister.Get(appName)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("Application %s not found", appName), http.StatusNotFound)
+		} else {
+			http.Error(w, fmt.Sprintf("Failed to get the application: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+	if a.Spec.GetProject() != projName {
+		http.Error(w, fmt.Sprintf("Application %s is not part of project %s", appName, projName), http.StatusBadRequest)
+		return
+	}
+	// invoking an extension requires the permission to get the application and to invoke the extension
+	claims := ctx.Value("claims")
+	if !m.enf.Enforce(claims, rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, fmt.Sprintf("%s/%s", projName, a.Name)) ||
+		!m.enf.Enforce(claims, rbacpolicy.ResourceExtensions, rbacpolicy.ActionInvoke, name) {
+		http.Error(w, "Permission denied", http.StatusForbidden)
+		return
+	}
+
+	dest := a.Spec.Destination
+	if err := argo.ValidateDestination(ctx, &dest, m.db); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get the cluster of the application: %v", err), http.StatusInternalServerError)
+		return
+	}
+	cluster, err := m.db.GetCluster(ctx, dest.Server)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to get the cluster of the application: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	proxies, _ := ctx.Value(proxiesKey{}).([]serviceProxy)
+	proxy := selectProxy(proxies, cluster)
+	if proxy == nil {
+		http.Error(w, fmt.Sprintf("Extension %s has no backend for cluster %s", name, cluster.Server), http.StatusBadRequest)
+		return
+	}
+
+	req := r.Clone(context.WithValue(ctx, identityKey{}, identity{
+		username:    session.Username(ctx),
+		clusterName: cluster.Name,
+		clusterURL:  cluster.Server,
+	}))
+	req.URL.Path = path
+	req.URL.RawPath = ""
+	// the credentials of the user are not forwarded to the backend services
+	req.Header.Del("Authorization")
+	req.Header.Del("Cookie")
+	removeArgoCDHeaders(req.Header)
+	proxy.ServeHTTP(w, req)
+}
+
+// removeArgoCDHeaders removes the Argo CD headers the client supplied, except the names of the application and of its
+// project, and the tokens of the Connection header naming Argo CD headers
+func removeArgoCDHeaders(header http.Header) {
+	for name := range header {
+		if strings.HasPrefix(name, argoCDHeaderPrefix) && name != HeaderArgoCDApplicationName && name != HeaderArgoCDProjectName {
+			header.Del(name)
+		}
+	}
+	var tokens []string
+	for _, value := range header.Values("Connection") {
+		for _, token := range strings.Split(value, ",") {
+			token = strings.TrimSpace(token)
+			if token != "" && !strings.HasPrefix(http.CanonicalHeaderKey(token), argoCDHeaderPrefix) {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	header.Del("Connection")
+	if len(tokens) > 0 {
+		header.Set("Connection", strings.Join(tokens, ", "))
+	}
+}
+
+// identityKey is the key of the context of the proxied requests holding the identity of the user and of the cluster
+type identityKey struct{}
+
+type identity struct {
+	username    string
+	clusterName string
+	clusterURL  string
+}
+
+// identityTransport sets the identity headers of the requests to the backend services. The reverse proxy removes the
+// hop-by-hop headers before the transport sends the requests, so the client can't remove the identity headers by naming
+// them in the Connection header.
+type identityTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *identityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id, ok := req.Context().Value(identityKey{}).(identity)
+	if !ok {
+		return nil, fmt.Errorf("the identity of the request is missing")
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(HeaderArgoCDTargetClusterName, id.clusterName)
+	req.Header.Set(HeaderArgoCDTargetClusterURL, id.clusterURL)
+	req.Header.Set(HeaderArgoCDUsername, id.username)
+	return t.transport.RoundTrip(req)
+}
+
+// selectProxy returns the proxy of the service of the given cluster, or of the service without cluster
+func selectProxy(proxies []serviceProxy, cluster *appv1.Cluster) *httputil.ReverseProxy {
+	var defaultProxy *httputil.ReverseProxy
+	for _, p := range proxies {
+		c := p.service.Cluster
+		if c == nil {
+			defaultProxy = p.proxy
+			continue
+		}
+		if (c.Name != "" && c.Name == cluster.Name) || (c.Server != "" && c.Server == cluster.Server) {
+			return p.proxy
+		}
+	}
+	return defaultProxy
+}
@@ -0,0 +1,260 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+	"github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "argocd"
+
+const invokePolicy = `
+p, role:test, applications, get, default/*, allow
+p, role:test, extensions, invoke, metrics, allow
+`
+
+func newTestManager(t *testing.T, policy string, extensionConfig string) *Manager {
+	m, _ := newTestManagerWithClientset(t, policy, extensionConfig)
+	return m
+}
+
+func newTestManagerWithClientset(t *testing.T, policy string, extensionConfig string) (*Manager, kubernetes.Interface) {
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      common.ArgoCDConfigMapName,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"extension.config": extensionConfig},
+	}, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      common.ArgoCDSecretName,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"server.secretkey": []byte("test")},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	argoDB := db.NewDB(testNamespace, settingsMgr, kubeclientset)
+	_, err := argoDB.CreateCluster(context.Background(), &appv1.Cluster{Name: "production", Server: "https://production.example.com"})
+	require.NoError(t, err)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, app := range []*appv1.Application{{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: appv1.ApplicationSpec{
+			Project:     "default",
+			Destination: appv1.ApplicationDestination{Server: appv1.KubernetesInternalAPIServerAddr, Namespace: "default"},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: testNamespace},
+		Spec: appv1.ApplicationSpec{
+			Project:     "default",
+			Destination: appv1.ApplicationDestination{Name: "production", Namespace: "payments"},
+		},
+	}} {
+		require.NoError(t, indexer.Add(app))
+	}
+
+	enf := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enf.SetDefaultRole("role:test")
+	require.NoError(t, enf.SetUserPolicy(policy))
+	return NewManager(settingsMgr, applisters.NewApplicationLister(indexer).Applications(testNamespace), argoDB, enf), kubeclientset
+}
+
+// newBackend returns a backend service replying with its name, the path and the headers of the requests
+func newBackend(name string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s %s cluster=%s,%s user=%s auth=%s cookie=%s", name, r.URL.RequestURI(),
+			r.Header.Get(HeaderArgoCDTargetClusterName), r.Header.Get(HeaderArgoCDTargetClusterURL), r.Header.Get(HeaderArgoCDUsername),
+			r.Header.Get("Authorization"), r.Header.Get("Cookie"))
+	}))
+}
+
+func TestManager_Handler(t *testing.T) {
+	defaultBackend := newBackend("default")
+	defer defaultBackend.Close()
+	productionBackend := newBackend("production")
+	defer productionBackend.Close()
+	extensionConfig := fmt.Sprintf(`
+extensions:
+- name: metrics
+  backend:
+    services:
+    - url: %s
+    - url: %s
+      cluster:
+        name: production
+- name: logs
+  backend:
+    services:
+    - url: %s
+      cluster:
+        server: https://production.example.com
+`, defaultBackend.URL, productionBackend.URL, defaultBackend.URL)
+
+	authenticated := false
+	authenticate := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authenticated = true
+			claims := jwt.MapClaims{"iss": session.SessionManagerClaimsIssuer, "sub": "alice"}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), "claims", claims)))
+		})
+	}
+	static := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "static")
+	})
+
+	request := func(handler http.Handler, path string, app string, project string, headers ...string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		for i := 0; i+1 < len(headers); i += 2 {
+			r.Header.Add(headers[i], headers[i+1])
+		}
+		if app != "" {
+			r.Header.Set(HeaderArgoCDApplicationName, app)
+		}
+		if project != "" {
+			r.Header.Set(HeaderArgoCDProjectName, project)
+		}
+		r.Header.Set("Authorization", "Bearer token")
+		r.Header.Set("Cookie", "argocd.token=token")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	handler := newTestManager(t, invokePolicy, extensionConfig).Handler(authenticate, static)
+
+	t.Run("UIExtensionFiles", func(t *testing.T) {
+		authenticated = false
+		w := request(handler, "/extensions/argoproj.io/Rollout/ui/extensions.js", "", "")
+		assert.Equal(t, "static", w.Body.String())
+		assert.False(t, authenticated)
+	})
+
+	t.Run("DefaultService", func(t *testing.T) {
+		authenticated = false
+		w := request(handler, "/extensions/metrics/api/v1/query?q=up", "guestbook", "default")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "default /api/v1/query?q=up cluster=in-cluster,https://kubernetes.default.svc user=alice auth= cookie=", w.Body.String())
+		assert.True(t, authenticated)
+	})
+
+	t.Run("ClusterService", func(t *testing.T) {
+		w := request(handler, "/extensions/metrics/api/v1/query", "payments", "default")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "production /api/v1/query cluster=production,https://production.example.com user=alice auth= cookie=", w.Body.String())
+	})
+
+	t.Run("ClientIdentityHeaders", func(t *testing.T) {
+		// the identity headers can't be supplied by the client, nor removed by naming them in the Connection header
+		w := request(handler, "/extensions/metrics/", "guestbook", "default",
+			HeaderArgoCDUsername, "admin",
+			HeaderArgoCDTargetClusterName, "production",
+			"Connection", "Argocd-Username, argocd-target-cluster-url",
+			"Connection", HeaderArgoCDTargetClusterName)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "default / cluster=in-cluster,https://kubernetes.default.svc user=alice auth= cookie=", w.Body.String())
+	})
+
+	t.Run("NoServiceForCluster", func(t *testing.T) {
+		w := request(newTestManager(t, invokePolicy+"p, role:test, extensions, invoke, logs, allow", extensionConfig).Handler(authenticate, static),
+			"/extensions/logs/", "guestbook", "default")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MissingHeaders", func(t *testing.T) {
+		w := request(handler, "/extensions/metrics/", "guestbook", "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ApplicationNotFound", func(t *testing.T) {
+		w := request(handler, "/extensions/metrics/", "other", "default")
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("WrongProject", func(t *testing.T) {
+		w := request(handler, "/extensions/metrics/", "guestbook", "other")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("NoInvokePermission", func(t *testing.T) {
+		w := request(handler, "/extensions/logs/", "payments", "default")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("NoApplicationPermission", func(t *testing.T) {
+		handler := newTestManager(t, "p, role:test, extensions, invoke, metrics, allow", extensionConfig).Handler(authenticate, static)
+		w := request(handler, "/extensions/metrics/", "guestbook", "default")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestSplitPath(t *testing.T) {
+	name, path := splitPath("/extensions/metrics/api/v1/query")
+	assert.Equal(t, "metrics", name)
+	assert.Equal(t, "/api/v1/query", path)
+	name, path = splitPath("/extensions/metrics")
+	assert.Equal(t, "metrics", name)
+	assert.Equal(t, "/", path)
+}
+
+func TestManager_Reload(t *testing.T) {
+	backend := newBackend("default")
+	defer backend.Close()
+	m, kubeclientset := newTestManagerWithClientset(t, invokePolicy, fmt.Sprintf(`
+extensions:
+- name: metrics
+  backend:
+    services:
+    - url: %s
+`, backend.URL))
+	proxies := m.getProxies()
+	transports := m.transports
+	require.Len(t, proxies["metrics"], 1)
+	require.Len(t, transports, 1)
+
+	// the proxies are kept while the configuration is unchanged
+	m.reload()
+	assert.Equal(t, proxies["metrics"][0].proxy, m.getProxies()["metrics"][0].proxy)
+
+	cm, err := m.settingsMgr.GetConfigMapByName(common.ArgoCDConfigMapName)
+	require.NoError(t, err)
+	cm = cm.DeepCopy()
+	cm.Data["extension.config"] = fmt.Sprintf(`
+extensions:
+- name: logs
+  backend:
+    services:
+    - url: %s
+`, backend.URL)
+	_, err = kubeclientset.CoreV1().ConfigMaps(testNamespace).Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		m.reload()
+		_, ok := m.getProxies()["logs"]
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.NotContains(t, m.getProxies(), "metrics")
+	assert.NotSame(t, transports[0], m.transports[0])
+}
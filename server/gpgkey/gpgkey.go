@@ -3,8 +3,11 @@ package gpgkey
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	gpgkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/gpgkey"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -12,6 +15,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/gpg"
+	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
 
@@ -53,6 +57,45 @@ func (s *Server) List(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*a
 	return keyList, nil
 }
 
+// ListKeyring returns the GnuPG public keys of the keyring the repository server verifies the signatures of the commits
+// with, which allows to find the keys which expire soon
+func (s *Server) ListKeyring(ctx context.Context, q *gpgkeypkg.GnuPGKeyringQuery) (*appsv1.GnuPGPublicKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	var expiresBefore *time.Time
+	if q.ExpiresWithin != "" {
+		expiresWithin, err := time.ParseDuration(q.ExpiresWithin)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid duration %s: %v", q.ExpiresWithin, err)
+		}
+		t := time.Now().Add(expiresWithin)
+		expiresBefore = &t
+	}
+
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(conn)
+
+	keyring, err := repoClient.GetGnuPGKeyring(ctx, &apiclient.GnuPGKeyringRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if !keyring.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "GnuPG signature verification is disabled on the repository server")
+	}
+	keyList := &appsv1.GnuPGPublicKeyList{}
+	for _, key := range keyring.Items {
+		if expiresBefore != nil && (key.ExpiresAt == nil || key.ExpiresAt.After(*expiresBefore)) {
+			continue
+		}
+		keyList.Items = append(keyList.Items, *key)
+	}
+	return keyList, nil
+}
+
 // GetGnuPGPublicKey retrieves a single GPG public key from the configuration
 func (s *Server) Get(ctx context.Context, q *gpgkeypkg.GnuPGPublicKeyQuery) (*appsv1.GnuPGPublicKey, error) {
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceGPGKeys, rbacpolicy.ActionGet, ""); err != nil {
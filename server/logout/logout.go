@@ -117,10 +117,13 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if argoCDSettings.OIDCConfig() == nil || argoCDSettings.OIDCConfig().LogoutURL == "" || issuer == session.SessionManagerClaimsIssuer {
+	oidcConfig = argoCDSettings.OIDCProviderByIssuer(issuer)
+	if oidcConfig == nil {
+		oidcConfig = argoCDSettings.OIDCConfig()
+	}
+	if oidcConfig == nil || oidcConfig.LogoutURL == "" || issuer == session.SessionManagerClaimsIssuer {
 		http.Redirect(w, r, logoutRedirectURL, http.StatusSeeOther)
 	} else {
-		oidcConfig = argoCDSettings.OIDCConfig()
 		logoutURL := constructLogoutURL(oidcConfig.LogoutURL, tokenString, logoutRedirectURL)
 		http.Redirect(w, r, logoutURL, http.StatusSeeOther)
 	}
@@ -8,12 +8,15 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/argoproj/argo-cd/v2/util/audit"
 )
 
 type MetricsServer struct {
 	*http.Server
 	redisRequestCounter   *prometheus.CounterVec
 	redisRequestHistogram *prometheus.HistogramVec
+	apiThrottledCounter   *prometheus.CounterVec
 }
 
 var (
@@ -32,6 +35,13 @@ var (
 		},
 		[]string{"initiator"},
 	)
+	apiThrottledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_api_requests_throttled_total",
+			Help: "Number of API requests rejected by the rate limits.",
+		},
+		[]string{"scope", "methods"},
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects api server metrics
@@ -45,6 +55,8 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(apiThrottledCounter)
+	registry.MustRegister(audit.DroppedEventsCounter)
 
 	return &MetricsServer{
 		Server: &http.Server{
@@ -53,6 +65,7 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 		},
 		redisRequestCounter:   redisRequestCounter,
 		redisRequestHistogram: redisRequestHistogram,
+		apiThrottledCounter:   apiThrottledCounter,
 	}
 }
 
@@ -64,3 +77,8 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-server").Observe(duration.Seconds())
 }
+
+// IncAPIRequestThrottled increments the number of API requests rejected by the rate limits of the given scope and methods
+func (m *MetricsServer) IncAPIRequestThrottled(scope string, methods string) {
+	m.apiThrottledCounter.WithLabelValues(scope, methods).Inc()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
@@ -92,22 +93,40 @@ func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateR
 		return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Project); err != nil {
-		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups, s.policyEnf.GetScopes()) {
+		if !s.policyEnf.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups) {
 			return nil, err
 		}
 	}
-	id := q.Id
 	if err := prj.ValidateJWTTokenID(q.Role, q.Id); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	jwtToken, err := s.issueToken(prj, q.Role, q.ExpiresIn, q.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	prj.NormalizeJWTTokens()
+
+	_, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(prj, ctx, argo.EventReasonResourceCreated, "created token")
+	return &project.ProjectTokenResponse{Token: jwtToken}, nil
+
+}
+
+// issueToken creates a new token of a project role and adds it to the tokens of the role in the project status. The
+// project is not updated.
+func (s *Server) issueToken(prj *v1alpha1.AppProject, roleName string, expiresIn int64, id string) (string, error) {
 	if id == "" {
 		uniqueId, _ := uuid.NewRandom()
 		id = uniqueId.String()
 	}
-	subject := fmt.Sprintf(JWTTokenSubFormat, q.Project, q.Role)
-	jwtToken, err := s.sessionMgr.Create(subject, q.ExpiresIn, id)
+	subject := fmt.Sprintf(JWTTokenSubFormat, prj.Name, roleName)
+	jwtToken, err := s.sessionMgr.Create(subject, expiresIn, id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return "", status.Error(codes.InvalidArgument, err.Error())
 	}
 	parser := &jwt.Parser{
 		ValidationHelper: jwt.NewValidationHelper(jwt.WithoutClaimsValidation(), jwt.WithoutAudienceValidation()),
@@ -115,7 +134,7 @@ func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateR
 	claims := jwt.StandardClaims{}
 	_, _, err = parser.ParseUnverified(jwtToken, &claims)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return "", status.Error(codes.InvalidArgument, err.Error())
 	}
 	var issuedAt, expiresAt int64
 	if claims.IssuedAt != nil {
@@ -126,14 +145,75 @@ func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateR
 	}
 	id = claims.ID
 
-	items := append(prj.Status.JWTTokensByRole[q.Role].Items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: id})
-	if _, found := prj.Status.JWTTokensByRole[q.Role]; found {
-		prj.Status.JWTTokensByRole[q.Role] = v1alpha1.JWTTokens{Items: items}
+	items := append(prj.Status.JWTTokensByRole[roleName].Items, v1alpha1.JWTToken{IssuedAt: issuedAt, ExpiresAt: expiresAt, ID: id})
+	if _, found := prj.Status.JWTTokensByRole[roleName]; found {
+		prj.Status.JWTTokensByRole[roleName] = v1alpha1.JWTTokens{Items: items}
 	} else {
 		tokensMap := make(map[string]v1alpha1.JWTTokens)
-		tokensMap[q.Role] = v1alpha1.JWTTokens{Items: items}
+		tokensMap[roleName] = v1alpha1.JWTTokens{Items: items}
 		prj.Status.JWTTokensByRole = tokensMap
 	}
+	return jwtToken, nil
+}
+
+// RotateToken creates a new token of a project role to replace an existing one. The existing token remains valid during
+// the grace period, and is revoked immediately if there is none. The tokens of the role which have already expired are
+// removed.
+func (s *Server) RotateToken(ctx context.Context, q *project.ProjectTokenRotateRequest) (*project.ProjectTokenResponse, error) {
+	if q.GracePeriod < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "grace period must not be negative")
+	}
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	err = validateProject(prj)
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	role, roleIndex, err := prj.GetRoleByName(q.Role)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Project); err != nil {
+		if !s.policyEnf.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups) {
+			return nil, err
+		}
+	}
+	prj.NormalizeJWTTokens()
+	token, _, err := prj.GetJWTToken(q.Role, -1, q.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "project '%s' does not have token '%s' for role '%s'", q.Project, q.Id, q.Role)
+	}
+
+	// the new token expires after the same duration as the previous one by default
+	expiresIn := q.ExpiresIn
+	if expiresIn <= 0 {
+		if token.ExpiresAt <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "the expiration of the new token is required since token '%s' does not expire", token.ID)
+		}
+		expiresIn = token.ExpiresAt - token.IssuedAt
+	}
+
+	now := time.Now().Unix()
+	removeExpiredTokens(prj, roleIndex, now, token.ID)
+	if q.GracePeriod == 0 {
+		if err := prj.RemoveJWTToken(roleIndex, -1, token.ID); err != nil {
+			return nil, err
+		}
+	} else if expiresAt := now + q.GracePeriod; token.ExpiresAt == 0 || expiresAt < token.ExpiresAt {
+		if err := prj.SetJWTTokenExpiration(roleIndex, token.ID, expiresAt); err != nil {
+			return nil, err
+		}
+	}
+	jwtToken, err := s.issueToken(prj, q.Role, expiresIn, "")
+	if err != nil {
+		return nil, err
+	}
 
 	prj.NormalizeJWTTokens()
 
@@ -141,9 +221,71 @@ func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateR
 	if err != nil {
 		return nil, err
 	}
-	s.logEvent(prj, ctx, argo.EventReasonResourceCreated, "created token")
+	s.logEvent(prj, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("rotated token '%s'", token.ID))
 	return &project.ProjectTokenResponse{Token: jwtToken}, nil
+}
+
+// ListTokens returns the metadata of the tokens of a project, optionally restricted to a role. The tokens which have
+// expired are removed from the project.
+func (s *Server) ListTokens(ctx context.Context, q *project.ProjectTokenListRequest) (*project.ProjectTokenListResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionGet, q.Project); err != nil {
+		return nil, err
+	}
 
+	s.projectLock.Lock(q.Project)
+	defer s.projectLock.Unlock(q.Project)
+
+	prj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if q.Role != "" {
+		if _, _, err := prj.GetRoleByName(q.Role); err != nil {
+			return nil, status.Errorf(codes.NotFound, "project '%s' does not have role '%s'", q.Project, q.Role)
+		}
+	}
+	prj.NormalizeJWTTokens()
+	now := time.Now().Unix()
+	var expiredIDs []string
+	for i, role := range prj.Spec.Roles {
+		if q.Role == "" || role.Name == q.Role {
+			expiredIDs = append(expiredIDs, removeExpiredTokens(prj, i, now, "")...)
+		}
+	}
+	if len(expiredIDs) > 0 {
+		prj.NormalizeJWTTokens()
+		prj, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, prj, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		s.logEvent(prj, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("removed expired tokens '%s'", strings.Join(expiredIDs, "', '")))
+	}
+
+	res := &project.ProjectTokenListResponse{}
+	for _, role := range prj.Spec.Roles {
+		if q.Role != "" && role.Name != q.Role {
+			continue
+		}
+		for _, t := range role.JWTTokens {
+			res.Items = append(res.Items, &project.ProjectToken{Role: role.Name, Id: t.ID, IssuedAt: t.IssuedAt, ExpiresAt: t.ExpiresAt})
+		}
+	}
+	return res, nil
+}
+
+// removeExpiredTokens removes the tokens of a role which have expired, except the token with the given id, and returns
+// the ids of the removed tokens
+func removeExpiredTokens(prj *v1alpha1.AppProject, roleIndex int, now int64, keepID string) []string {
+	var expiredIDs []string
+	for _, t := range prj.Status.JWTTokensByRole[prj.Spec.Roles[roleIndex].Name].Items {
+		if t.ID != keepID && t.ExpiresAt > 0 && t.ExpiresAt < now {
+			expiredIDs = append(expiredIDs, t.ID)
+		}
+	}
+	for _, id := range expiredIDs {
+		_ = prj.RemoveJWTToken(roleIndex, -1, id)
+	}
+	return expiredIDs
 }
 
 // DeleteToken deletes a token in a project
@@ -165,7 +307,7 @@ func (s *Server) DeleteToken(ctx context.Context, q *project.ProjectTokenDeleteR
 		return &project.EmptyResponse{}, nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceProjects, rbacpolicy.ActionUpdate, q.Project); err != nil {
-		if !jwtutil.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups, s.policyEnf.GetScopes()) {
+		if !s.policyEnf.IsMember(jwtutil.Claims(ctx.Value("claims")), role.Groups) {
 			return nil, err
 		}
 	}
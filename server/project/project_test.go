@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -497,6 +499,87 @@ p, role:admin, projects, update, *, allow`)
 		assert.Equal(t, projWithoutToken.Spec.Roles[0].JWTTokens[0].IssuedAt, secondIssuedAt)
 	})
 
+	t.Run("TestRotateTokenSuccessfully", func(t *testing.T) {
+		projWithToken := existingProj.DeepCopy()
+		projWithToken.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{
+			{IssuedAt: 1, ID: "old"},
+			{IssuedAt: 2, ExpiresAt: 3, ID: "expired"},
+		}}}
+		clientset := apps.NewSimpleClientset(projWithToken)
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+
+		tokenResponse, err := projectServer.RotateToken(ctx, &project.ProjectTokenRotateRequest{Project: projWithToken.Name, Role: tokenName, Id: "old", ExpiresIn: 100, GracePeriod: 3600})
+		require.NoError(t, err)
+		_, _, err = sessionMgr.Parse(tokenResponse.Token)
+		assert.NoError(t, err)
+
+		tokens, err := projectServer.ListTokens(ctx, &project.ProjectTokenListRequest{Project: projWithToken.Name, Role: tokenName})
+		require.NoError(t, err)
+		require.Len(t, tokens.Items, 2)
+		old := tokens.Items[1]
+		assert.Equal(t, "old", old.Id)
+		assert.InDelta(t, time.Now().Add(time.Hour).Unix(), old.ExpiresAt, 5)
+		assert.InDelta(t, time.Now().Add(100*time.Second).Unix(), tokens.Items[0].ExpiresAt, 5)
+
+		// the new token expires after the same duration as the rotated one by default
+		_, err = projectServer.RotateToken(ctx, &project.ProjectTokenRotateRequest{Project: projWithToken.Name, Role: tokenName, Id: tokens.Items[0].Id})
+		require.NoError(t, err)
+		tokens, err = projectServer.ListTokens(ctx, &project.ProjectTokenListRequest{Project: projWithToken.Name})
+		require.NoError(t, err)
+		require.Len(t, tokens.Items, 2)
+		assert.Equal(t, "old", tokens.Items[1].Id)
+		assert.InDelta(t, time.Now().Add(100*time.Second).Unix(), tokens.Items[0].ExpiresAt, 5)
+	})
+
+	t.Run("TestRotateTokenWithoutExpiration", func(t *testing.T) {
+		projWithToken := existingProj.DeepCopy()
+		projWithToken.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{{IssuedAt: 1, ID: "old"}}}}
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+
+		_, err := projectServer.RotateToken(ctx, &project.ProjectTokenRotateRequest{Project: projWithToken.Name, Role: tokenName, Id: "old", GracePeriod: 60})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TestListTokensRemovesExpiredTokens", func(t *testing.T) {
+		projWithToken := existingProj.DeepCopy()
+		projWithToken.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, JWTTokens: []v1alpha1.JWTToken{
+			{IssuedAt: 1, ID: "valid"},
+			{IssuedAt: 2, ExpiresAt: 3, ID: "expired"},
+		}}}
+		clientset := apps.NewSimpleClientset(projWithToken)
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+
+		tokens, err := projectServer.ListTokens(ctx, &project.ProjectTokenListRequest{Project: projWithToken.Name})
+		require.NoError(t, err)
+		require.Len(t, tokens.Items, 1)
+		assert.Equal(t, "valid", tokens.Items[0].Id)
+
+		prj, err := clientset.ArgoprojV1alpha1().AppProjects("default").Get(ctx, projWithToken.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, prj.Spec.Roles[0].JWTTokens, 1)
+		assert.Equal(t, "valid", prj.Spec.Roles[0].JWTTokens[0].ID)
+		assert.Len(t, prj.Status.JWTTokensByRole[tokenName].Items, 1)
+	})
+
+	t.Run("TestRotateTokenNotFound", func(t *testing.T) {
+		projWithToken := existingProj.DeepCopy()
+		projWithToken.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projWithToken), enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+
+		_, err := projectServer.RotateToken(ctx, &project.ProjectTokenRotateRequest{Project: projWithToken.Name, Role: tokenName, Id: "other", GracePeriod: 60})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = projectServer.RotateToken(ctx, &project.ProjectTokenRotateRequest{Project: projWithToken.Name, Role: tokenName, Id: "other", GracePeriod: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
 	enforcer = newEnforcer(kubeclientset)
 
 	t.Run("TestCreateTwoTokensInRoleSuccess", func(t *testing.T) {
@@ -0,0 +1,437 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// idleTimeout is how long the bucket of a client is kept after its last request
+	idleTimeout = 10 * time.Minute
+	// cleanupInterval is how often the idle buckets are removed
+	cleanupInterval = time.Minute
+)
+
+var (
+	// syncMethods are the methods of the sync class
+	syncMethods = map[string]bool{
+		"/application.ApplicationService/Sync":               true,
+		"/application.ApplicationService/SyncPreview":        true,
+		"/application.ApplicationService/Rollback":           true,
+		"/application.ApplicationService/TerminateOperation": true,
+	}
+	// readMethodPrefixes are the prefixes of the names of the methods of the read class
+	readMethodPrefixes = []string{"Get", "List", "Watch"}
+	// readMethods are the names of the methods of the read class which don't start with one of the read prefixes
+	readMethods = map[string]bool{
+		"ManagedResources": true,
+		"ResourceTree":     true,
+		"PodLogs":          true,
+		"RevisionMetadata": true,
+		"CanI":             true,
+		"Version":          true,
+		"ValidateAccess":   true,
+	}
+)
+
+// loginMethod is the method authenticating with a username and a password, whose requests are counted by client IP
+// address before authentication in every scope so that the password guesses are limited
+const loginMethod = "/session.SessionService/Create"
+
+// numShards is the number of shards of the buckets, each guarded by its own lock
+const numShards = 32
+
+// Metrics records the requests rejected by the rate limits
+type Metrics interface {
+	IncAPIRequestThrottled(scope string, methods string)
+}
+
+// bucketKey identifies the bucket of a client for a rate limit
+type bucketKey struct {
+	// limit is the index of the rate limit in the configured limits
+	limit  int
+	client string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+type shard struct {
+	lock    sync.Mutex
+	buckets map[bucketKey]*bucket
+}
+
+// reservationsKey is the context key of the tokens reserved before the authentication
+type reservationsKey struct{}
+
+// Limiter enforces the rate limits of the API requests configured in the argocd-cm config map, using a token bucket per
+// client and rate limit. Requests exceeding a limit are rejected with the ResourceExhausted code, which the gateway
+// returns as 429 Too Many Requests, without consuming the tokens of the other limits.
+//
+// The limits by client IP address are enforced before the authentication, so that the requests failing to
+// authenticate are limited too, and the limits by token or account once the requests are authenticated.
+type Limiter struct {
+	settingsMgr *settings.SettingsManager
+	metrics     Metrics
+	now         func() time.Time
+
+	// lock guards the configuration, which is only locked for writing when it is reloaded
+	lock           sync.RWMutex
+	limits         []settings.APIRateLimit
+	trustedProxies []*net.IPNet
+	shards         [numShards]shard
+}
+
+// NewLimiter returns a new limiter enforcing the rate limits configured at the time. Run keeps them up to date.
+func NewLimiter(settingsMgr *settings.SettingsManager, metrics Metrics) *Limiter {
+	l := &Limiter{
+		settingsMgr: settingsMgr,
+		metrics:     metrics,
+		now:         time.Now,
+	}
+	for i := range l.shards {
+		l.shards[i].buckets = map[bucketKey]*bucket{}
+	}
+	l.reload()
+	return l
+}
+
+// Run reloads the rate limits when the settings change and removes the buckets of the idle clients until the context
+// is done
+func (l *Limiter) Run(ctx context.Context) {
+	updateCh := make(chan *settings.ArgoCDSettings, 1)
+	l.settingsMgr.Subscribe(updateCh)
+	defer l.settingsMgr.Unsubscribe(updateCh)
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-updateCh:
+			l.reload()
+		case <-ticker.C:
+			l.cleanup()
+		}
+	}
+}
+
+// reload loads the configured rate limits and trusted proxies, keeping the previous ones if they are invalid
+func (l *Limiter) reload() {
+	limits, err := l.settingsMgr.GetAPIRateLimits()
+	if err != nil {
+		log.Warnf("Failed to load the API rate limits: %v", err)
+		return
+	}
+	trustedProxies, err := l.settingsMgr.GetTrustedProxies()
+	if err != nil {
+		log.Warnf("Failed to load the trusted proxies: %v", err)
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.trustedProxies = trustedProxies
+	if reflect.DeepEqual(limits, l.limits) {
+		return
+	}
+	l.limits = limits
+	for i := range l.shards {
+		l.shards[i].buckets = map[bucketKey]*bucket{}
+	}
+	log.Infof("Loaded %d API rate limits", len(limits))
+}
+
+func (l *Limiter) cleanup() {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	now := l.now()
+	for i := range l.shards {
+		s := &l.shards[i]
+		s.lock.Lock()
+		for key, b := range s.buckets {
+			if now.Sub(b.lastUsed) > idleTimeout {
+				delete(s.buckets, key)
+			}
+		}
+		s.lock.Unlock()
+	}
+}
+
+// bucket returns the bucket of the given key, which must be called with the configuration locked
+func (l *Limiter) bucket(key bucketKey, limit settings.APIRateLimit, now time.Time) *rate.Limiter {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key.client))
+	s := &l.shards[(h.Sum32()+uint32(key.limit))%numShards]
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)}
+		s.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b.limiter
+}
+
+// reserve reserves a token of the bucket of the client for each rate limit of the method class the client function
+// returns a client for. If one of the buckets is empty, the new and the given reservations are cancelled and a
+// ResourceExhausted error is returned.
+func (l *Limiter) reserve(reserved []*rate.Reservation, class string, client func(limit settings.APIRateLimit) (string, bool)) ([]*rate.Reservation, error) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	now := l.now()
+	for i, limit := range l.limits {
+		if limit.Methods != settings.APIRateLimitMethodsAll && limit.Methods != class {
+			continue
+		}
+		key, ok := client(limit)
+		if !ok {
+			continue
+		}
+		r := l.bucket(bucketKey{limit: i, client: key}, limit, now).ReserveN(now, 1)
+		if !r.OK() || r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, previous := range reserved {
+				previous.CancelAt(now)
+			}
+			if l.metrics != nil {
+				l.metrics.IncAPIRequestThrottled(limit.Scope, limit.Methods)
+			}
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit of %v %s requests per second per %s exceeded", limit.RequestsPerSecond, limit.Methods, limit.Scope)
+		}
+		reserved = append(reserved, r)
+	}
+	return reserved, nil
+}
+
+// reserveBeforeAuth reserves the tokens of the limits by client IP address, and of all the limits for the login
+func (l *Limiter) reserveBeforeAuth(ctx context.Context, fullMethod string) ([]*rate.Reservation, error) {
+	clientIP := l.ClientIP(ctx)
+	return l.reserve(nil, MethodClass(fullMethod), func(limit settings.APIRateLimit) (string, bool) {
+		if limit.Scope != settings.APIRateLimitScopeIP && fullMethod != loginMethod {
+			return "", false
+		}
+		return "ip:" + clientIP, true
+	})
+}
+
+// reserveAfterAuth reserves the tokens of the limits by token or account, in addition to the given reservations
+func (l *Limiter) reserveAfterAuth(ctx context.Context, fullMethod string, reserved []*rate.Reservation) error {
+	if fullMethod == loginMethod {
+		return nil
+	}
+	clientIP := l.ClientIP(ctx)
+	_, err := l.reserve(reserved, MethodClass(fullMethod), func(limit settings.APIRateLimit) (string, bool) {
+		if limit.Scope == settings.APIRateLimitScopeIP {
+			return "", false
+		}
+		return clientKey(ctx, limit.Scope, clientIP), true
+	})
+	return err
+}
+
+// Allow consumes a token of the buckets of the client of the authenticated request for each rate limit of the method,
+// and returns a ResourceExhausted error without consuming any token if one of them is empty
+func (l *Limiter) Allow(ctx context.Context, fullMethod string) error {
+	reserved, err := l.reserveBeforeAuth(ctx, fullMethod)
+	if err != nil {
+		return err
+	}
+	return l.reserveAfterAuth(ctx, fullMethod, reserved)
+}
+
+// UnauthenticatedUnaryServerInterceptor returns a new unary server interceptor enforcing the rate limits by client IP
+// address. It must run before the authentication, and the UnaryServerInterceptor after it.
+func (l *Limiter) UnauthenticatedUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		reserved, err := l.reserveBeforeAuth(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, reservationsKey{}, reserved), req)
+	}
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor enforcing the rate limits by token or account. It
+// must run after the authentication.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		reserved, _ := ctx.Value(reservationsKey{}).([]*rate.Reservation)
+		if err := l.reserveAfterAuth(ctx, info.FullMethod, reserved); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// UnauthenticatedStreamServerInterceptor returns a new stream server interceptor enforcing the rate limits by client
+// IP address when the streams are opened. It must run before the authentication, and the StreamServerInterceptor
+// after it.
+func (l *Limiter) UnauthenticatedStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		reserved, err := l.reserveBeforeAuth(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = context.WithValue(stream.Context(), reservationsKey{}, reserved)
+		return handler(srv, wrapped)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor enforcing the rate limits by token or account when
+// the streams are opened. It must run after the authentication.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		reserved, _ := stream.Context().Value(reservationsKey{}).([]*rate.Reservation)
+		if err := l.reserveAfterAuth(stream.Context(), info.FullMethod, reserved); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// Handler returns a handler enforcing the rate limits on the HTTP requests which are not served by the gRPC server,
+// e.g. the SSO login and the webhooks. The requests are counted by client IP address in every scope, since they are
+// authenticated by the handler, and the GET, HEAD and OPTIONS requests are of the read class.
+func (l *Limiter) Handler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		class := settings.APIRateLimitMethodsWrite
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			class = settings.APIRateLimitMethodsRead
+		}
+		clientIP := l.clientIP(remoteHost(r.RemoteAddr), r.Header.Values("X-Forwarded-For"), false)
+		_, err := l.reserve(nil, class, func(limit settings.APIRateLimit) (string, bool) {
+			return "ip:" + clientIP, true
+		})
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusTooManyRequests)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// MethodClass returns the class of the method with the given full name: read, write or sync
+func MethodClass(fullMethod string) string {
+	if syncMethods[fullMethod] {
+		return settings.APIRateLimitMethodsSync
+	}
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if readMethods[name] {
+		return settings.APIRateLimitMethodsRead
+	}
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return settings.APIRateLimitMethodsRead
+		}
+	}
+	return settings.APIRateLimitMethodsWrite
+}
+
+// clientKey returns the key the requests of the client with the given IP address are counted by in the given scope.
+// Unauthenticated requests are counted by client IP address.
+func clientKey(ctx context.Context, scope string, clientIP string) string {
+	if !session.LoggedIn(ctx) {
+		return "ip:" + clientIP
+	}
+	account := session.Iss(ctx) + "/" + session.Sub(ctx)
+	switch scope {
+	case settings.APIRateLimitScopeToken:
+		if id := session.ID(ctx); id != "" {
+			return "token:" + account + "/" + id
+		}
+		// tokens without identifier are told apart by their issue time
+		iat, _ := session.Iat(ctx)
+		return fmt.Sprintf("token:%s/%d", account, iat.Unix())
+	case settings.APIRateLimitScopeAccount:
+		return "account:" + account
+	default:
+		return "ip:" + clientIP
+	}
+}
+
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+// ClientIP returns the IP address of the client of the gRPC request. The x-forwarded-for metadata of the requests of
+// the gateway, which are sent from the loopback interface, and of the trusted proxies is used to find the address of
+// the client.
+func (l *Limiter) ClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	var forwardedFor []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		forwardedFor = md.Get("x-forwarded-for")
+	}
+	return l.clientIP(remoteHost(p.Addr.String()), forwardedFor, true)
+}
+
+// clientIP returns the IP address of the client of a request received from the given remote address with the given
+// X-Forwarded-For values: the first address which is neither a trusted proxy nor, if trusted, the loopback interface,
+// going back from the remote address. The addresses before it may have been set by the client.
+func (l *Limiter) clientIP(remote string, forwardedFor []string, trustLoopback bool) string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	var addresses []string
+	for _, value := range forwardedFor {
+		for _, address := range strings.Split(value, ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	client := remote
+	for i := len(addresses) - 1; i >= 0; i-- {
+		if !l.isTrustedProxy(client, trustLoopback) {
+			break
+		}
+		client = addresses[i]
+	}
+	return client
+}
+
+func (l *Limiter) isTrustedProxy(address string, trustLoopback bool) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	if trustLoopback && ip.IsLoopback() {
+		return true
+	}
+	for _, network := range l.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,268 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testNamespace = "default"
+
+type fakeMetrics struct {
+	throttled map[string]int
+}
+
+func (m *fakeMetrics) IncAPIRequestThrottled(scope string, methods string) {
+	m.throttled[scope+"/"+methods]++
+}
+
+func newTestLimiter(rateLimits string, opts ...func(data map[string]string)) (*Limiter, *fakeMetrics) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"server.rateLimits": rateLimits},
+	}
+	for _, opt := range opts {
+		opt(cm.Data)
+	}
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(cm), testNamespace)
+	metrics := &fakeMetrics{throttled: map[string]int{}}
+	l := NewLimiter(settingsMgr, metrics)
+	now := time.Now()
+	l.now = func() time.Time {
+		return now
+	}
+	return l, metrics
+}
+
+func clientContext(ip string, claims jwt.Claims) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+	if claims != nil {
+		ctx = context.WithValue(ctx, "claims", claims)
+	}
+	return ctx
+}
+
+func TestMethodClass(t *testing.T) {
+	assert.Equal(t, "sync", MethodClass("/application.ApplicationService/Sync"))
+	assert.Equal(t, "sync", MethodClass("/application.ApplicationService/TerminateOperation"))
+	assert.Equal(t, "read", MethodClass("/application.ApplicationService/Get"))
+	assert.Equal(t, "read", MethodClass("/application.ApplicationService/ResourceTree"))
+	assert.Equal(t, "read", MethodClass("/cluster.ClusterService/List"))
+	assert.Equal(t, "write", MethodClass("/application.ApplicationService/Delete"))
+	assert.Equal(t, "write", MethodClass("/cluster.ClusterService/InvalidateCache"))
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, metrics := newTestLimiter(`
+- scope: account
+  methods: sync
+  requestsPerSecond: 1
+  burst: 2
+`)
+	alice := clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "alice", "jti": "token-1"})
+	aliceOtherToken := clientContext("10.0.0.2", jwt.MapClaims{"iss": "argocd", "sub": "alice", "jti": "token-2"})
+	bob := clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "bob"})
+
+	assert.NoError(t, l.Allow(alice, "/application.ApplicationService/Sync"))
+	assert.NoError(t, l.Allow(aliceOtherToken, "/application.ApplicationService/Sync"))
+	err := l.Allow(alice, "/application.ApplicationService/Sync")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, metrics.throttled["account/sync"])
+
+	// other methods and accounts are not limited
+	assert.NoError(t, l.Allow(alice, "/application.ApplicationService/Get"))
+	assert.NoError(t, l.Allow(bob, "/application.ApplicationService/Sync"))
+
+	// the bucket refills at the configured rate
+	now := l.now().Add(time.Second)
+	l.now = func() time.Time {
+		return now
+	}
+	assert.NoError(t, l.Allow(alice, "/application.ApplicationService/Sync"))
+	assert.Error(t, l.Allow(alice, "/application.ApplicationService/Sync"))
+}
+
+func TestLimiter_AllowScopes(t *testing.T) {
+	l, _ := newTestLimiter(`
+- scope: token
+  requestsPerSecond: 1
+- scope: ip
+  methods: read
+  requestsPerSecond: 1
+  burst: 3
+`)
+	token1 := clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "alice", "jti": "token-1"})
+	token2 := clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "alice", "jti": "token-2"})
+	anonymous := clientContext("10.0.0.1", nil)
+
+	assert.NoError(t, l.Allow(token1, "/application.ApplicationService/List"))
+	assert.Error(t, l.Allow(token1, "/application.ApplicationService/Delete"))
+	assert.NoError(t, l.Allow(token2, "/application.ApplicationService/List"))
+	// unauthenticated requests are counted by IP address in the token scope
+	assert.NoError(t, l.Allow(anonymous, "/application.ApplicationService/List"))
+	// the IP address used all of its read requests
+	err := l.Allow(clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "bob"}), "/application.ApplicationService/List")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, l.Allow(clientContext("10.0.0.2", nil), "/application.ApplicationService/List"))
+}
+
+func TestLimiter_Cleanup(t *testing.T) {
+	l, _ := newTestLimiter(`
+- scope: ip
+  requestsPerSecond: 1
+`)
+	assert.NoError(t, l.Allow(clientContext("10.0.0.1", nil), "/version.VersionService/Version"))
+	l.cleanup()
+	assert.Equal(t, 1, numBuckets(l))
+
+	now := l.now().Add(idleTimeout + time.Second)
+	l.now = func() time.Time {
+		return now
+	}
+	l.cleanup()
+	assert.Equal(t, 0, numBuckets(l))
+}
+
+func numBuckets(l *Limiter) int {
+	n := 0
+	for i := range l.shards {
+		n += len(l.shards[i].buckets)
+	}
+	return n
+}
+
+func TestLimiter_RejectedRequestsConsumeNoToken(t *testing.T) {
+	l, metrics := newTestLimiter(`
+- scope: ip
+  requestsPerSecond: 1
+  burst: 2
+- scope: account
+  methods: sync
+  requestsPerSecond: 1
+  burst: 1
+`)
+	alice := clientContext("10.0.0.1", jwt.MapClaims{"iss": "argocd", "sub": "alice"})
+
+	assert.NoError(t, l.Allow(alice, "/application.ApplicationService/Sync"))
+	// rejected by the account limit, without consuming the token of the IP address
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.Allow(alice, "/application.ApplicationService/Sync")))
+	assert.Equal(t, 1, metrics.throttled["account/sync"])
+	assert.NoError(t, l.Allow(alice, "/application.ApplicationService/Get"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.Allow(alice, "/application.ApplicationService/Get")))
+	assert.Equal(t, 1, metrics.throttled["ip/all"])
+}
+
+func TestLimiter_Interceptors(t *testing.T) {
+	l, _ := newTestLimiter(`
+- scope: ip
+  requestsPerSecond: 1
+  burst: 2
+- scope: token
+  requestsPerSecond: 1
+  burst: 1
+`)
+	authErr := status.Error(codes.Unauthenticated, "invalid session")
+	chain := grpc_middleware.ChainUnaryServer(
+		l.UnauthenticatedUnaryServerInterceptor(),
+		func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if req == "invalid" {
+				return nil, authErr
+			}
+			return handler(context.WithValue(ctx, "claims", jwt.MapClaims{"iss": "argocd", "sub": "alice", "jti": "token-1"}), req)
+		},
+		l.UnaryServerInterceptor(),
+	)
+	call := func(ip string, method string, req string) error {
+		_, err := chain(clientContext(ip, nil), req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return err
+	}
+
+	// the requests failing to authenticate are limited by IP address
+	assert.Equal(t, authErr, call("10.0.0.1", "/application.ApplicationService/Get", "invalid"))
+	assert.Equal(t, authErr, call("10.0.0.1", "/application.ApplicationService/Get", "invalid"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("10.0.0.1", "/application.ApplicationService/Get", "invalid")))
+
+	// the authenticated ones by token too, and a request rejected by the token limit consumes no token of the IP address
+	assert.NoError(t, call("10.0.0.2", "/application.ApplicationService/Get", ""))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("10.0.0.3", "/application.ApplicationService/Get", "")))
+	assert.NoError(t, call("10.0.0.3", loginMethod, ""))
+
+	// the logins are limited by IP address in every scope
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("10.0.0.3", loginMethod, "")))
+}
+
+func TestLimiter_Handler(t *testing.T) {
+	l, _ := newTestLimiter(`
+- scope: token
+  methods: write
+  requestsPerSecond: 1
+  burst: 1
+`, func(data map[string]string) {
+		data["server.trustedProxies"] = "10.0.0.0/8"
+	})
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(method string, remoteAddr string, forwardedFor string) int {
+		r := httptest.NewRequest(method, "/api/webhook", nil)
+		r.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// the requests forwarded by the trusted proxies are counted by client IP address
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "10.0.0.1:1234", "192.168.0.1"))
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "10.0.0.2:1234", "192.168.0.1"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "10.0.0.1:1234", "192.168.0.2"))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "10.0.0.1:1234", "192.168.0.1"))
+}
+
+func TestLimiter_ClientIP(t *testing.T) {
+	l, _ := newTestLimiter("", func(data map[string]string) {
+		data["server.trustedProxies"] = "10.1.0.0/16"
+	})
+	assert.Equal(t, "10.0.0.1", l.ClientIP(clientContext("10.0.0.1", nil)))
+	assert.Equal(t, "", l.ClientIP(context.Background()))
+
+	// requests forwarded by the gateway
+	ctx := metadata.NewIncomingContext(clientContext("127.0.0.1", nil), metadata.Pairs("x-forwarded-for", "192.168.0.1, 10.0.0.3"))
+	assert.Equal(t, "10.0.0.3", l.ClientIP(ctx))
+
+	// requests forwarded by the gateway and the trusted proxies, whose addresses are skipped
+	ctx = metadata.NewIncomingContext(clientContext("127.0.0.1", nil), metadata.Pairs("x-forwarded-for", "192.168.0.2, 192.168.0.1, 10.1.0.1"))
+	assert.Equal(t, "192.168.0.1", l.ClientIP(ctx))
+	ctx = metadata.NewIncomingContext(clientContext("10.1.0.1", nil), metadata.Pairs("x-forwarded-for", "192.168.0.1"))
+	assert.Equal(t, "192.168.0.1", l.ClientIP(ctx))
+
+	// the metadata of the other clients is not trusted
+	ctx = metadata.NewIncomingContext(clientContext("10.0.0.1", nil), metadata.Pairs("x-forwarded-for", "10.0.0.3"))
+	assert.Equal(t, "10.0.0.1", l.ClientIP(ctx))
+}
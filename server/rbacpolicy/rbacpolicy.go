@@ -1,6 +1,7 @@
 package rbacpolicy
 
 import (
+	"fmt"
 	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go/v4"
@@ -21,6 +22,7 @@ const (
 	ResourceCertificates = "certificates"
 	ResourceAccounts     = "accounts"
 	ResourceGPGKeys      = "gpgkeys"
+	ResourceExtensions   = "extensions"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -30,6 +32,9 @@ const (
 	ActionSync     = "sync"
 	ActionOverride = "override"
 	ActionAction   = "action"
+	ActionLogs     = "logs"
+	ActionExec     = "exec"
+	ActionInvoke   = "invoke"
 )
 
 var (
@@ -40,6 +45,7 @@ var (
 		ResourceApplications,
 		ResourceRepositories,
 		ResourceCertificates,
+		ResourceExtensions,
 	}
 	Actions = []string{
 		ActionGet,
@@ -48,18 +54,48 @@ var (
 		ActionDelete,
 		ActionSync,
 		ActionOverride,
+		ActionLogs,
+		ActionExec,
+		ActionInvoke,
 	}
 )
 
+// IsValidAction returns whether the given action can be granted by the policies, i.e. one of Actions, a resource action
+// such as action/apps/Deployment/restart, or an update or a deletion of a single resource
+func IsValidAction(action string) bool {
+	for _, a := range Actions {
+		if a == action {
+			return true
+		}
+	}
+	for _, prefix := range []string{ActionAction, ActionUpdate, ActionDelete} {
+		if strings.HasPrefix(action, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceAction returns the action on a single resource of an application, e.g. update/apps/Deployment/default/guestbook-ui,
+// which policies can grant instead of the action on the whole application
+func ResourceAction(action string, group string, kind string, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", action, group, kind, namespace, name)
+}
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
 type RBACPolicyEnforcer struct {
-	enf        *rbac.Enforcer
-	projLister applister.AppProjectNamespaceLister
-	scopes     []string
+	enf            *rbac.Enforcer
+	projLister     applister.AppProjectNamespaceLister
+	scopes         []string
+	userGroupsFunc UserGroupsFunc
 }
 
+// UserGroupsFunc returns the groups of the user with the given claims and whether they replace the ones of the token
+// claims, e.g. because the groups of the user are managed elsewhere
+type UserGroupsFunc func(claims jwt.MapClaims) ([]string, bool)
+
 // NewRBACPolicyEnforcer returns a new RBAC Enforcer for the Argo CD API Server
 func NewRBACPolicyEnforcer(enf *rbac.Enforcer, projLister applister.AppProjectNamespaceLister) *RBACPolicyEnforcer {
 	return &RBACPolicyEnforcer{
@@ -81,6 +117,38 @@ func (p *RBACPolicyEnforcer) GetScopes() []string {
 	return scopes
 }
 
+// SetUserGroupsFunc sets the function providing the groups of the users instead of their token claims, e.g. the SCIM
+// group memberships
+func (p *RBACPolicyEnforcer) SetUserGroupsFunc(userGroupsFunc UserGroupsFunc) {
+	p.userGroupsFunc = userGroupsFunc
+}
+
+// GetGroups returns the groups of the user with the given claims
+func (p *RBACPolicyEnforcer) GetGroups(mapClaims jwt.MapClaims) []string {
+	if p.userGroupsFunc != nil {
+		if groups, ok := p.userGroupsFunc(mapClaims); ok {
+			return groups
+		}
+	}
+	return jwtutil.GetScopeValues(mapClaims, p.GetScopes())
+}
+
+// IsMember returns whether the user with the given claims is a member of any of the groups
+func (p *RBACPolicyEnforcer) IsMember(claims jwt.Claims, groups []string) bool {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return false
+	}
+	for _, userGroup := range p.GetGroups(mapClaims) {
+		for _, group := range groups {
+			if userGroup == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func IsProjectSubject(subject string) bool {
 	_, _, ok := GetProjectRoleFromSubject(subject)
 	return ok
@@ -123,12 +191,8 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 		return true
 	}
 
-	scopes := p.scopes
-	if scopes == nil {
-		scopes = defaultScopes
-	}
 	// Finally check if any of the user's groups grant them permissions
-	groups := jwtutil.GetScopeValues(mapClaims, scopes)
+	groups := p.GetGroups(mapClaims)
 
 	// Get groups to reduce the amount to checking groups
 	groupingPolicies := enforcer.GetGroupingPolicy()
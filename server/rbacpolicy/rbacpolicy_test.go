@@ -74,6 +74,36 @@ func TestEnforceAllPolicies(t *testing.T) {
 	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
 }
 
+func TestEnforceUserGroupsFunc(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	rbacEnf.SetUserGroupsFunc(func(claims jwt.MapClaims) ([]string, bool) {
+		switch claims["email"] {
+		case "alice@example.com":
+			return []string{"my-org:my-team"}, true
+		case "bob@example.com":
+			return nil, true
+		}
+		return nil, false
+	})
+
+	// the groups of the known users replace the groups of their token
+	claims := jwt.MapClaims{"email": "alice@example.com"}
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.True(t, rbacEnf.IsMember(claims, []string{"my-org:my-team"}))
+	claims = jwt.MapClaims{"email": "bob@example.com", "groups": []string{"my-org:my-team"}}
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.False(t, rbacEnf.IsMember(claims, []string{"my-org:my-team"}))
+
+	// unknown users keep the groups of their token
+	claims = jwt.MapClaims{"email": "cathy@example.com", "groups": []string{"my-org:my-team"}}
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.Equal(t, []string{"my-org:my-team"}, rbacEnf.GetGroups(claims))
+}
+
 func TestEnforceActionActions(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
@@ -122,3 +152,35 @@ func TestGetScopes_CustomScopes(t *testing.T) {
 	scopes := rbacEnforcer.GetScopes()
 	assert.Equal(t, scopes, customScopes)
 }
+
+func TestIsValidAction(t *testing.T) {
+	for _, action := range Actions {
+		assert.True(t, IsValidAction(action), action)
+	}
+	assert.True(t, IsValidAction(ResourceAction(ActionUpdate, "apps", "Deployment", "default", "guestbook-ui")))
+	assert.True(t, IsValidAction(ResourceAction(ActionDelete, "", "Pod", "*", "*")))
+	assert.False(t, IsValidAction("invalid"))
+	assert.False(t, IsValidAction("invalid/apps/Deployment"))
+}
+
+func TestEnforceResourceActions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, update/*, my-proj/*, allow
+p, bob, applications, delete//Pod/*, my-proj/*, allow
+`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// Alice may update any resource but not the application itself
+	claims := jwt.MapClaims{"sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "guestbook-ui"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ActionUpdate, "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "apps", "Deployment", "default", "guestbook-ui"), "my-proj/my-app"))
+
+	// Bob may only delete pods
+	claims = jwt.MapClaims{"sub": "bob"}
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "", "Pod", "default", "guestbook-ui-1234"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "apps", "Deployment", "default", "guestbook-ui"), "my-proj/my-app"))
+}
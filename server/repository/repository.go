@@ -131,6 +131,8 @@ func (s *Server) Get(ctx context.Context, q *repositorypkg.RepoQuery) (*appsv1.R
 		Username:                   repo.Username,
 		Insecure:                   repo.IsInsecure(),
 		EnableLFS:                  repo.EnableLFS,
+		EnableSubmodules:           repo.EnableSubmodules,
+		SubmodulesUseRepoCreds:     repo.SubmodulesUseRepoCreds,
 		GithubAppId:                repo.GithubAppId,
 		GithubAppInstallationId:    repo.GithubAppInstallationId,
 		GitHubAppEnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
@@ -159,15 +161,18 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 			}
 			// remove secrets
 			items = append(items, &appsv1.Repository{
-				Repo:      repo.Repo,
-				Type:      rType,
-				Name:      repo.Name,
-				Username:  repo.Username,
-				Insecure:  repo.IsInsecure(),
-				EnableLFS: repo.EnableLFS,
-				EnableOCI: repo.EnableOCI,
-				Proxy:     repo.Proxy,
-				Project:   repo.Project,
+				Repo:                   repo.Repo,
+				Type:                   rType,
+				Name:                   repo.Name,
+				Username:               repo.Username,
+				Insecure:               repo.IsInsecure(),
+				EnableLFS:              repo.EnableLFS,
+				EnableOCI:              repo.EnableOCI,
+				EnableSubmodules:       repo.EnableSubmodules,
+				SubmodulesUseRepoCreds: repo.SubmodulesUseRepoCreds,
+				Proxy:                  repo.Proxy,
+				Project:                repo.Project,
+				AuthProvider:           repo.AuthProvider,
 			})
 		}
 	}
@@ -181,7 +186,7 @@ func (s *Server) ListRepositories(ctx context.Context, q *repositorypkg.RepoQuer
 	return &appsv1.RepositoryList{Items: items}, nil
 }
 
-func (s *Server) ListRefs(ctx context.Context, q *repositorypkg.RepoQuery) (*apiclient.Refs, error) {
+func (s *Server) ListRefs(ctx context.Context, q *repositorypkg.RepoRefsQuery) (*apiclient.Refs, error) {
 	repo, err := s.getRepo(ctx, q.Repo)
 	if err != nil {
 		return nil, err
@@ -198,7 +203,11 @@ func (s *Server) ListRefs(ctx context.Context, q *repositorypkg.RepoQuery) (*api
 	defer io.Close(conn)
 
 	return repoClient.ListRefs(ctx, &apiclient.ListRefsRequest{
-		Repo: repo,
+		Repo:   repo,
+		Prefix: q.Prefix,
+		Regex:  q.Regex,
+		Offset: q.Offset,
+		Limit:  q.Limit,
 	})
 }
 
@@ -258,21 +267,39 @@ func (s *Server) GetAppDetails(ctx context.Context, q *repositorypkg.RepoAppDeta
 	if err != nil {
 		return nil, err
 	}
-	kustomizeOptions, err := kustomizeSettings.GetOptions(*q.Source)
+	kustomizeOptions, err := kustomizeSettings.GetOptions(*q.Source, nil)
 	if err != nil {
 		return nil, err
 	}
+	helmSettings, err := s.settings.GetHelmSettings()
+	if err != nil {
+		return nil, err
+	}
+	helmOptions, err := helmSettings.GetOptions(*q.Source, nil)
+	if err != nil {
+		return nil, err
+	}
+	plugins, err := s.settings.GetConfigManagementPlugins()
+	if err != nil {
+		return nil, err
+	}
+	tools := make([]*appsv1.ConfigManagementPlugin, len(plugins))
+	for i := range plugins {
+		tools[i] = &plugins[i]
+	}
 	return repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
 		Repo:             repo,
 		Source:           q.Source,
 		Repos:            helmRepos,
 		KustomizeOptions: kustomizeOptions,
 		AppName:          q.AppName,
+		Plugins:          tools,
+		HelmOptions:      helmOptions,
 	})
 }
 
 // GetHelmCharts returns list of helm charts in the specified repository
-func (s *Server) GetHelmCharts(ctx context.Context, q *repositorypkg.RepoQuery) (*apiclient.HelmChartsResponse, error) {
+func (s *Server) GetHelmCharts(ctx context.Context, q *repositorypkg.RepoHelmChartsQuery) (*apiclient.HelmChartsResponse, error) {
 	repo, err := s.getRepo(ctx, q.Repo)
 	if err != nil {
 		return nil, err
@@ -285,7 +312,12 @@ func (s *Server) GetHelmCharts(ctx context.Context, q *repositorypkg.RepoQuery)
 		return nil, err
 	}
 	defer io.Close(conn)
-	return repoClient.GetHelmCharts(ctx, &apiclient.HelmChartsRequest{Repo: repo})
+	return repoClient.GetHelmCharts(ctx, &apiclient.HelmChartsRequest{
+		Repo:   repo,
+		Name:   q.Name,
+		Offset: q.Offset,
+		Limit:  q.Limit,
+	})
 }
 
 // Create creates a repository or repository credential set
@@ -430,6 +462,7 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		GithubAppInstallationId:    q.GithubAppInstallationID,
 		GitHubAppEnterpriseBaseURL: q.GithubAppEnterpriseBaseUrl,
 		Proxy:                      q.Proxy,
+		AuthProvider:               q.AuthProvider,
 	}
 
 	// If repo does not have credentials, check if there are credentials stored
@@ -457,8 +490,12 @@ func (s *Server) testRepo(ctx context.Context, repo *appsv1.Repository) error {
 	}
 	defer io.Close(conn)
 
-	_, err = repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{
-		Repo: repo,
+	res, err := repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{
+		Repo:     repo,
+		Diagnose: true,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	return res.Err()
 }
@@ -97,6 +97,25 @@ func TestRepositoryServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test_validateAccessReportsFailedChecks", func(t *testing.T) {
+		repoServerClient := mocks.RepoServerServiceClient{}
+		repoServerClient.On("TestRepository", mock.Anything, mock.MatchedBy(func(q *apiclient.TestRepositoryRequest) bool {
+			return q.Diagnose
+		})).Return(&apiclient.TestRepositoryResponse{Checks: []*apiclient.RepositoryCheck{{
+			Name:            "git",
+			Message:         "x509: certificate signed by unknown authority",
+			AuthMethod:      "anonymous",
+			TlsCertificates: []string{"CN=test"},
+		}}}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
+
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, settingsMgr)
+		_, err := s.ValidateAccess(context.TODO(), &repository.RepoAccessQuery{
+			Repo: "https://test",
+		})
+		assert.EqualError(t, err, "git check failed using anonymous authentication: x509: certificate signed by unknown authority (server certificates: CN=test)")
+	})
+
 	t.Run("Test_Get", func(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
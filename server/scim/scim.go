@@ -0,0 +1,963 @@
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// Path is the path the SCIM endpoint is served at
+const Path = "/api/scim/v2"
+
+const (
+	contentType = "application/scim+json"
+	// maxBodySize is the maximum size of the request bodies
+	maxBodySize = 1 << 20
+	// maxResults is the maximum number of resources returned by a query
+	maxResults = 200
+)
+
+var (
+	filterRegexp        = regexp.MustCompile(`^\s*([a-zA-Z.]+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	memberFilterRegexp  = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+"([^"]*)"\s*\]$`)
+	userFilterableAttrs = map[string]bool{"id": true, "externalid": true, "username": true, "displayname": true}
+	groupFilterableAttr = map[string]bool{"id": true, "externalid": true, "displayname": true}
+)
+
+// scimError is an error returned to the identity providers as a SCIM error response
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType string, format string, args ...interface{}) *scimError {
+	return &scimError{status: status, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+// Handler serves the SCIM 2.0 endpoint through which identity providers provision the users and the groups, so that
+// group membership changes apply to the RBAC enforcement without waiting for the users to log in again.
+type Handler struct {
+	store       *Store
+	settingsMgr *settings.SettingsManager
+	now         func() time.Time
+}
+
+// NewHandler returns a new SCIM handler
+func NewHandler(store *Store, settingsMgr *settings.SettingsManager) *Handler {
+	return &Handler{store: store, settingsMgr: settingsMgr, now: time.Now}
+}
+
+// NewUserGroupsFunc returns the function providing the groups of the users provisioned through SCIM to the RBAC
+// enforcer. The tokens of the configured issuer are matched on the configured subject claim against the externalId of
+// the SCIM users, and have no groups if they do not match any provisioned user or if the groups cannot be determined.
+// The tokens of the other issuers keep their groups.
+func NewUserGroupsFunc(store *Store, settingsMgr *settings.SettingsManager) rbacpolicy.UserGroupsFunc {
+	return func(claims jwt.MapClaims) ([]string, bool) {
+		issuer := jwtutil.StringField(claims, "iss")
+		// local accounts are never provisioned through SCIM
+		if issuer == session.SessionManagerClaimsIssuer {
+			return nil, false
+		}
+		scimSettings, err := settingsMgr.GetSCIMSettings()
+		if err != nil {
+			log.Warnf("Failed to get the SCIM settings, denying the groups of the token: %v", err)
+			return nil, true
+		}
+		if !scimSettings.Enabled || issuer != scimSettings.Issuer {
+			return nil, false
+		}
+		subject := jwtutil.StringField(claims, scimSettings.SubjectClaim)
+		if subject == "" || (scimSettings.SubjectClaim == "email" && !emailVerified(claims)) {
+			return nil, true
+		}
+		groups, err := store.UserGroups(subject)
+		if err != nil {
+			log.Warnf("Failed to get the SCIM groups of '%s', denying the groups of the token: %v", subject, err)
+			return nil, true
+		}
+		return groups, true
+	}
+}
+
+// emailVerified returns whether the identity provider verified the email claim of the token, without which some
+// identity providers let the users choose any email
+func emailVerified(claims jwt.MapClaims) bool {
+	switch verified := claims["email_verified"].(type) {
+	case bool:
+		return verified
+	case string:
+		return verified == "true"
+	}
+	return false
+}
+
+// ServeHTTP serves the SCIM requests
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	scimSettings, err := h.settingsMgr.GetSCIMSettings()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if !scimSettings.Enabled {
+		http.NotFound(w, r)
+		return
+	}
+	if !authorized(r, scimSettings.BearerToken) {
+		writeError(w, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+
+	status, resp, err := h.route(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, status, resp)
+}
+
+func (h *Handler) route(r *http.Request) (int, interface{}, error) {
+	ctx := r.Context()
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, Path), "/"), "/")
+	if len(parts) > 2 {
+		return 0, nil, newError(http.StatusNotFound, "", "%s not found", r.URL.Path)
+	}
+	id := ""
+	if len(parts) == 2 {
+		id = parts[1]
+	}
+	switch {
+	case parts[0] == "ServiceProviderConfig" && id == "" && r.Method == http.MethodGet:
+		return http.StatusOK, serviceProviderConfig(), nil
+	case parts[0] == "ResourceTypes" && id == "" && r.Method == http.MethodGet:
+		return http.StatusOK, resourceTypes(), nil
+	case parts[0] == "Users" && id == "":
+		switch r.Method {
+		case http.MethodGet:
+			return h.listUsers(ctx, r)
+		case http.MethodPost:
+			return h.createUser(ctx, r)
+		}
+	case parts[0] == "Users":
+		switch r.Method {
+		case http.MethodGet:
+			return h.getUser(ctx, id)
+		case http.MethodPut:
+			return h.replaceUser(ctx, r, id)
+		case http.MethodPatch:
+			return h.patchUser(ctx, r, id)
+		case http.MethodDelete:
+			return h.deleteUser(ctx, id)
+		}
+	case parts[0] == "Groups" && id == "":
+		switch r.Method {
+		case http.MethodGet:
+			return h.listGroups(ctx, r)
+		case http.MethodPost:
+			return h.createGroup(ctx, r)
+		}
+	case parts[0] == "Groups":
+		switch r.Method {
+		case http.MethodGet:
+			return h.getGroup(ctx, id)
+		case http.MethodPut:
+			return h.replaceGroup(ctx, r, id)
+		case http.MethodPatch:
+			return h.patchGroup(ctx, r, id)
+		case http.MethodDelete:
+			return h.deleteGroup(ctx, id)
+		}
+	default:
+		return 0, nil, newError(http.StatusNotFound, "", "%s not found", r.URL.Path)
+	}
+	return 0, nil, newError(http.StatusMethodNotAllowed, "", "method %s is not allowed", r.Method)
+}
+
+func (h *Handler) listUsers(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"), userFilterableAttrs)
+	if err != nil {
+		return 0, nil, err
+	}
+	users, err := h.store.listUsers(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	var resources []*User
+	for _, u := range sortedUsers(users) {
+		if attr == "" || matches(attr, userAttribute(u, attr), value) {
+			resources = append(resources, renderUser(u, groups))
+		}
+	}
+	return paginate(r, len(resources), func(start, end int) interface{} {
+		return resources[start:end]
+	})
+}
+
+func (h *Handler) getUser(ctx context.Context, id string) (int, interface{}, error) {
+	u, err := h.store.getUser(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, renderUser(u, groups), nil
+}
+
+func (h *Handler) createUser(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req User
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	users, err := h.store.listUsers(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	u := h.storedUser(req, nil)
+	u.ID = uuid.New().String()
+	if err := validateUser(u, users); err != nil {
+		return 0, nil, err
+	}
+	if err := h.store.createUser(ctx, u); err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM user '%s' created", u.UserName)
+	return http.StatusCreated, renderUser(u, nil), nil
+}
+
+// updateUser replaces the user with the given id by the one the callback returns from the existing user, and
+// returns it rendered
+func (h *Handler) updateUser(ctx context.Context, id string, callback func(existing *User) (*User, error)) (*User, error) {
+	updated, err := h.store.updateUser(ctx, id, func(existing *User) (*User, error) {
+		u, err := callback(existing)
+		if err != nil {
+			return nil, err
+		}
+		users, err := h.store.listUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateUser(u, users); err != nil {
+			return nil, err
+		}
+		return u, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return renderUser(updated, groups), nil
+}
+
+func (h *Handler) replaceUser(ctx context.Context, r *http.Request, id string) (int, interface{}, error) {
+	var req User
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	replaced, err := h.updateUser(ctx, id, func(existing *User) (*User, error) {
+		return h.storedUser(req, existing), nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM user '%s' replaced", replaced.UserName)
+	return http.StatusOK, replaced, nil
+}
+
+func (h *Handler) patchUser(ctx context.Context, r *http.Request, id string) (int, interface{}, error) {
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	patched, err := h.updateUser(ctx, id, func(existing *User) (*User, error) {
+		u := *existing
+		for _, op := range req.Operations {
+			if err := applyUserOperation(&u, op); err != nil {
+				return nil, err
+			}
+		}
+		return h.storedUser(u, existing), nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM user '%s' updated (active: %t)", patched.UserName, patched.IsActive())
+	return http.StatusOK, patched, nil
+}
+
+// deleteUser deletes the user once it is removed from its groups, so that a failed deletion can be retried
+func (h *Handler) deleteUser(ctx context.Context, id string) (int, interface{}, error) {
+	u, err := h.store.getUser(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, g := range sortedGroups(groups) {
+		if !g.HasMember(id) {
+			continue
+		}
+		_, err := h.store.updateGroup(ctx, g.ID, func(existing *Group) (*Group, error) {
+			updated := *existing
+			updated.Members = removeMembers(existing.Members, []Reference{{Value: id}})
+			return h.storedGroup(updated, existing), nil
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	if err := h.store.deleteUser(ctx, id); err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM user '%s' deleted", u.UserName)
+	return http.StatusNoContent, nil, nil
+}
+
+func (h *Handler) listGroups(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"), groupFilterableAttr)
+	if err != nil {
+		return 0, nil, err
+	}
+	users, err := h.store.listUsers(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	var resources []*Group
+	for _, g := range sortedGroups(groups) {
+		if attr == "" || matches(attr, groupAttribute(g, attr), value) {
+			resources = append(resources, renderGroup(g, users))
+		}
+	}
+	return paginate(r, len(resources), func(start, end int) interface{} {
+		return resources[start:end]
+	})
+}
+
+func (h *Handler) getGroup(ctx context.Context, id string) (int, interface{}, error) {
+	g, err := h.store.getGroup(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	users, err := h.store.listUsers(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, renderGroup(g, users), nil
+}
+
+func (h *Handler) createGroup(ctx context.Context, r *http.Request) (int, interface{}, error) {
+	var req Group
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	users, err := h.store.listUsers(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := h.store.listGroups(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	g := h.storedGroup(req, nil)
+	g.ID = uuid.New().String()
+	if err := validateGroup(g, users, groups); err != nil {
+		return 0, nil, err
+	}
+	if err := h.store.createGroup(ctx, g); err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM group '%s' created with %d member(s)", g.DisplayName, len(g.Members))
+	return http.StatusCreated, renderGroup(g, users), nil
+}
+
+// updateGroup replaces the group with the given id by the one the callback returns from the existing group, and
+// returns it rendered
+func (h *Handler) updateGroup(ctx context.Context, id string, callback func(existing *Group) (*Group, error)) (*Group, error) {
+	var users map[string]*User
+	updated, err := h.store.updateGroup(ctx, id, func(existing *Group) (*Group, error) {
+		g, err := callback(existing)
+		if err != nil {
+			return nil, err
+		}
+		if users, err = h.store.listUsers(ctx); err != nil {
+			return nil, err
+		}
+		groups, err := h.store.listGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateGroup(g, users, groups); err != nil {
+			return nil, err
+		}
+		return g, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return renderGroup(updated, users), nil
+}
+
+func (h *Handler) replaceGroup(ctx context.Context, r *http.Request, id string) (int, interface{}, error) {
+	var req Group
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	replaced, err := h.updateGroup(ctx, id, func(existing *Group) (*Group, error) {
+		return h.storedGroup(req, existing), nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM group '%s' replaced with %d member(s)", replaced.DisplayName, len(replaced.Members))
+	return http.StatusOK, replaced, nil
+}
+
+func (h *Handler) patchGroup(ctx context.Context, r *http.Request, id string) (int, interface{}, error) {
+	var req patchRequest
+	if err := decode(r, &req); err != nil {
+		return 0, nil, err
+	}
+	patched, err := h.updateGroup(ctx, id, func(existing *Group) (*Group, error) {
+		g := *existing
+		g.Members = append([]Reference(nil), existing.Members...)
+		for _, op := range req.Operations {
+			if err := applyGroupOperation(&g, op); err != nil {
+				return nil, err
+			}
+		}
+		return h.storedGroup(g, existing), nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM group '%s' updated, %d member(s)", patched.DisplayName, len(patched.Members))
+	return http.StatusOK, patched, nil
+}
+
+func (h *Handler) deleteGroup(ctx context.Context, id string) (int, interface{}, error) {
+	g, err := h.store.getGroup(ctx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := h.store.deleteGroup(ctx, id); err != nil {
+		return 0, nil, err
+	}
+	log.Infof("SCIM group '%s' deleted", g.DisplayName)
+	return http.StatusNoContent, nil, nil
+}
+
+// storedUser returns the persisted attributes of the user, keeping the id and the creation time of the existing user
+func (h *Handler) storedUser(u User, existing *User) *User {
+	now := h.now().UTC()
+	stored := &User{
+		ExternalID:  u.ExternalID,
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Active:      u.Active,
+		Emails:      u.Emails,
+		Meta:        &Meta{Created: &now, LastModified: &now},
+	}
+	if existing != nil {
+		stored.ID = existing.ID
+		if existing.Meta != nil && existing.Meta.Created != nil {
+			stored.Meta.Created = existing.Meta.Created
+		}
+	}
+	return stored
+}
+
+// storedGroup returns the persisted attributes of the group, keeping the id and the creation time of the existing group
+func (h *Handler) storedGroup(g Group, existing *Group) *Group {
+	now := h.now().UTC()
+	stored := &Group{
+		ExternalID:  g.ExternalID,
+		DisplayName: g.DisplayName,
+		Members:     addMembers(nil, g.Members),
+		Meta:        &Meta{Created: &now, LastModified: &now},
+	}
+	for i := range stored.Members {
+		stored.Members[i].Display = ""
+	}
+	if existing != nil {
+		stored.ID = existing.ID
+		if existing.Meta != nil && existing.Meta.Created != nil {
+			stored.Meta.Created = existing.Meta.Created
+		}
+	}
+	return stored
+}
+
+func validateUser(u *User, users map[string]*User) error {
+	if u.UserName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+	for id, existing := range users {
+		if id == u.ID {
+			continue
+		}
+		if strings.EqualFold(existing.UserName, u.UserName) {
+			return newError(http.StatusConflict, "uniqueness", "user with userName '%s' already exists", u.UserName)
+		}
+		// the externalId identifies the user in the tokens
+		if u.ExternalID != "" && existing.ExternalID == u.ExternalID {
+			return newError(http.StatusConflict, "uniqueness", "user with externalId '%s' already exists", u.ExternalID)
+		}
+	}
+	return nil
+}
+
+func validateGroup(g *Group, users map[string]*User, groups map[string]*Group) error {
+	if g.DisplayName == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	for id, existing := range groups {
+		if id != g.ID && strings.EqualFold(existing.DisplayName, g.DisplayName) {
+			return newError(http.StatusConflict, "uniqueness", "group with displayName '%s' already exists", g.DisplayName)
+		}
+	}
+	for _, m := range g.Members {
+		if _, ok := users[m.Value]; !ok {
+			return newError(http.StatusBadRequest, "invalidValue", "member %s is not a provisioned user", m.Value)
+		}
+	}
+	return nil
+}
+
+func renderUser(u *User, groups map[string]*Group) *User {
+	rendered := *u
+	rendered.Schemas = []string{userSchema}
+	rendered.Groups = nil
+	for _, g := range sortedGroups(groups) {
+		if g.HasMember(u.ID) {
+			rendered.Groups = append(rendered.Groups, Reference{Value: g.ID, Display: g.DisplayName})
+		}
+	}
+	rendered.Meta = &Meta{ResourceType: "User"}
+	if u.Meta != nil {
+		rendered.Meta.Created = u.Meta.Created
+		rendered.Meta.LastModified = u.Meta.LastModified
+	}
+	return &rendered
+}
+
+func renderGroup(g *Group, users map[string]*User) *Group {
+	rendered := *g
+	rendered.Schemas = []string{groupSchema}
+	rendered.Members = nil
+	for _, m := range g.Members {
+		ref := Reference{Value: m.Value}
+		if u, ok := users[m.Value]; ok {
+			ref.Display = u.UserName
+		}
+		rendered.Members = append(rendered.Members, ref)
+	}
+	rendered.Meta = &Meta{ResourceType: "Group"}
+	if g.Meta != nil {
+		rendered.Meta.Created = g.Meta.Created
+		rendered.Meta.LastModified = g.Meta.LastModified
+	}
+	return &rendered
+}
+
+func validOperation(op patchOperation) (string, error) {
+	name := strings.ToLower(op.Op)
+	switch name {
+	case "add", "replace":
+		return name, nil
+	case "remove":
+		if op.Path == "" {
+			return "", newError(http.StatusBadRequest, "noTarget", "remove operations require a path")
+		}
+		return name, nil
+	}
+	return "", newError(http.StatusBadRequest, "invalidSyntax", "unsupported operation '%s'", op.Op)
+}
+
+// applyUserOperation applies a PATCH operation to the user. Operations on attributes which are not persisted are
+// ignored.
+func applyUserOperation(u *User, op patchOperation) error {
+	name, err := validOperation(op)
+	if err != nil {
+		return err
+	}
+	if op.Path != "" {
+		return setUserAttribute(u, op.Path, op.Value, name == "remove")
+	}
+	values, ok := op.Value.(map[string]interface{})
+	if !ok {
+		return newError(http.StatusBadRequest, "invalidValue", "operations without path require an object value")
+	}
+	for attr, value := range values {
+		if err := setUserAttribute(u, attr, value, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setUserAttribute(u *User, attr string, value interface{}, remove bool) error {
+	var err error
+	switch strings.ToLower(attr) {
+	case "active":
+		if remove {
+			u.Active = nil
+			return nil
+		}
+		active, err := boolValue(attr, value)
+		if err != nil {
+			return err
+		}
+		u.Active = &active
+	case "username":
+		if remove {
+			return newError(http.StatusBadRequest, "mutability", "userName cannot be removed")
+		}
+		u.UserName, err = stringValue(attr, value)
+	case "displayname":
+		u.DisplayName = ""
+		if !remove {
+			u.DisplayName, err = stringValue(attr, value)
+		}
+	case "externalid":
+		u.ExternalID = ""
+		if !remove {
+			u.ExternalID, err = stringValue(attr, value)
+		}
+	case "emails":
+		u.Emails = nil
+		if !remove {
+			err = convert(attr, value, &u.Emails)
+		}
+	default:
+		log.Debugf("Ignoring SCIM operation on unsupported user attribute '%s'", attr)
+	}
+	return err
+}
+
+// applyGroupOperation applies a PATCH operation to the group. Operations on attributes which are not persisted are
+// ignored.
+func applyGroupOperation(g *Group, op patchOperation) error {
+	name, err := validOperation(op)
+	if err != nil {
+		return err
+	}
+	if op.Path != "" {
+		if match := memberFilterRegexp.FindStringSubmatch(op.Path); match != nil {
+			if name != "remove" {
+				return newError(http.StatusBadRequest, "invalidPath", "unsupported path '%s' for %s operations", op.Path, op.Op)
+			}
+			g.Members = removeMembers(g.Members, []Reference{{Value: match[1]}})
+			return nil
+		}
+		return setGroupAttribute(g, name, op.Path, op.Value)
+	}
+	values, ok := op.Value.(map[string]interface{})
+	if !ok {
+		return newError(http.StatusBadRequest, "invalidValue", "operations without path require an object value")
+	}
+	for attr, value := range values {
+		if err := setGroupAttribute(g, name, attr, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setGroupAttribute(g *Group, op string, attr string, value interface{}) error {
+	var err error
+	switch strings.ToLower(attr) {
+	case "displayname":
+		if op == "remove" {
+			return newError(http.StatusBadRequest, "mutability", "displayName cannot be removed")
+		}
+		g.DisplayName, err = stringValue(attr, value)
+	case "externalid":
+		g.ExternalID = ""
+		if op != "remove" {
+			g.ExternalID, err = stringValue(attr, value)
+		}
+	case "members":
+		var members []Reference
+		if value != nil {
+			if _, ok := value.(map[string]interface{}); ok {
+				value = []interface{}{value}
+			}
+			if err := convert(attr, value, &members); err != nil {
+				return err
+			}
+		}
+		switch op {
+		case "add":
+			g.Members = addMembers(g.Members, members)
+		case "replace":
+			g.Members = addMembers(nil, members)
+		case "remove":
+			if len(members) == 0 {
+				g.Members = nil
+			} else {
+				g.Members = removeMembers(g.Members, members)
+			}
+		}
+	default:
+		log.Debugf("Ignoring SCIM operation on unsupported group attribute '%s'", attr)
+	}
+	return err
+}
+
+func addMembers(members []Reference, added []Reference) []Reference {
+	res := append([]Reference(nil), members...)
+	for _, m := range added {
+		found := false
+		for _, existing := range res {
+			if existing.Value == m.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, Reference{Value: m.Value})
+		}
+	}
+	return res
+}
+
+func removeMembers(members []Reference, removed []Reference) []Reference {
+	var res []Reference
+	for _, m := range members {
+		keep := true
+		for _, r := range removed {
+			if m.Value == r.Value {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+func boolValue(attr string, value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		// some identity providers send booleans as strings, e.g. "False"
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+	}
+	return false, newError(http.StatusBadRequest, "invalidValue", "%s must be a boolean", attr)
+}
+
+func stringValue(attr string, value interface{}) (string, error) {
+	if v, ok := value.(string); ok {
+		return v, nil
+	}
+	return "", newError(http.StatusBadRequest, "invalidValue", "%s must be a string", attr)
+}
+
+func convert(attr string, value interface{}, out interface{}) error {
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(data, out)
+	}
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid value of %s: %v", attr, err)
+	}
+	return nil
+}
+
+// parseFilter parses the supported filters, which compare a single attribute to a value with the eq operator
+func parseFilter(filter string, attrs map[string]bool) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+	match := filterRegexp.FindStringSubmatch(filter)
+	if match == nil || !attrs[strings.ToLower(match[1])] {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", "unsupported filter '%s'", filter)
+	}
+	value, err := strconv.Unquote(`"` + match[2] + `"`)
+	if err != nil {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", "unsupported filter '%s'", filter)
+	}
+	return strings.ToLower(match[1]), value, nil
+}
+
+// matches compares the attribute values, case insensitively except for identifiers
+func matches(attr string, actual string, expected string) bool {
+	if attr == "id" || attr == "externalid" {
+		return actual == expected
+	}
+	return strings.EqualFold(actual, expected)
+}
+
+func userAttribute(u *User, attr string) string {
+	switch attr {
+	case "id":
+		return u.ID
+	case "externalid":
+		return u.ExternalID
+	case "username":
+		return u.UserName
+	case "displayname":
+		return u.DisplayName
+	}
+	return ""
+}
+
+func groupAttribute(g *Group, attr string) string {
+	switch attr {
+	case "id":
+		return g.ID
+	case "externalid":
+		return g.ExternalID
+	case "displayname":
+		return g.DisplayName
+	}
+	return ""
+}
+
+// paginate returns the page of the resources requested by the startIndex and count query parameters
+func paginate(r *http.Request, total int, page func(start, end int) interface{}) (int, interface{}, error) {
+	startIndex := 1
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		if i, err := strconv.Atoi(v); err == nil && i > 1 {
+			startIndex = i
+		}
+	}
+	count := maxResults
+	if v := r.URL.Query().Get("count"); v != "" {
+		if i, err := strconv.Atoi(v); err == nil && i >= 0 && i < maxResults {
+			count = i
+		}
+	}
+	start := startIndex - 1
+	if start > total {
+		start = total
+	}
+	end := start + count
+	if end > total {
+		end = total
+	}
+	resources := page(start, end)
+	return http.StatusOK, &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: end - start,
+		Resources:    resources,
+	}, nil
+}
+
+func serviceProviderConfig() interface{} {
+	return map[string]interface{}{
+		"schemas":        []string{serviceProviderConfigSchema},
+		"patch":          map[string]interface{}{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxResults},
+		"changePassword": map[string]interface{}{"supported": false},
+		"sort":           map[string]interface{}{"supported": false},
+		"etag":           map[string]interface{}{"supported": false},
+		"authenticationSchemes": []interface{}{map[string]interface{}{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using the bearer token configured in argocd-secret",
+		}},
+	}
+}
+
+func resourceTypes() interface{} {
+	types := []interface{}{
+		map[string]interface{}{"schemas": []string{resourceTypeSchema}, "id": "User", "name": "User", "endpoint": "/Users", "schema": userSchema},
+		map[string]interface{}{"schemas": []string{resourceTypeSchema}, "id": "Group", "name": "Group", "endpoint": "/Groups", "schema": groupSchema},
+	}
+	return &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(types),
+		StartIndex:   1,
+		ItemsPerPage: len(types),
+		Resources:    types,
+	}
+}
+
+func authorized(r *http.Request, bearerToken string) bool {
+	if bearerToken == "" {
+		return false
+	}
+	header := r.Header.Get("Authorization")
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(header[len(prefix):]), []byte(bearerToken)) == 1
+}
+
+func decode(r *http.Request, out interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(out); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp interface{}) {
+	if resp == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Warnf("Failed to write SCIM response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var e *scimError
+	if !errors.As(err, &e) {
+		log.Errorf("SCIM request failed: %v", err)
+		e = newError(http.StatusInternalServerError, "", "internal error")
+	}
+	writeJSON(w, e.status, &errorResponse{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(e.status),
+		ScimType: e.scimType,
+		Detail:   e.detail,
+	})
+}
@@ -0,0 +1,276 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	testNamespace = "default"
+	testToken     = "scim-token"
+	testIssuer    = "https://idp.example.com"
+)
+
+func newTestHandler(t *testing.T, enabled string, opts ...func(data map[string]string)) (*Handler, *Store) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string]string{"scim.enabled": enabled, "scim.issuer": testIssuer},
+	}
+	for _, opt := range opts {
+		opt(cm.Data)
+	}
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSecretName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"scim.bearerToken": []byte(testToken)},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	kubeClient := fake.NewSimpleClientset(cm, secret)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, testNamespace)
+	store := NewStore(kubeClient, testNamespace)
+	go store.Run(ctx)
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), store.HasSynced))
+	return NewHandler(store, settingsMgr), store
+}
+
+func request(t *testing.T, h http.Handler, method string, path string, body string) (int, map[string]interface{}) {
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, Path+path, nil)
+	} else {
+		req = httptest.NewRequest(method, Path+path, strings.NewReader(body))
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	var resp map[string]interface{}
+	if w.Header().Get("Content-Type") == contentType {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	}
+	return w.Code, resp
+}
+
+func TestHandler_Authentication(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		h, _ := newTestHandler(t, "false")
+		status, _ := request(t, h, http.MethodGet, "/Users", "")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		h, _ := newTestHandler(t, "true")
+		req := httptest.NewRequest(http.MethodGet, Path+"/Users", nil)
+		req.Header.Set("Authorization", "Bearer wrong")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("ServiceProviderConfig", func(t *testing.T) {
+		h, _ := newTestHandler(t, "true")
+		status, resp := request(t, h, http.MethodGet, "/ServiceProviderConfig", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, true, resp["patch"].(map[string]interface{})["supported"])
+	})
+}
+
+func TestHandler_Users(t *testing.T) {
+	h, _ := newTestHandler(t, "true")
+
+	status, user := request(t, h, http.MethodPost, "/Users", `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","externalId":"00u1","name":{"givenName":"Alice"}}`)
+	require.Equal(t, http.StatusCreated, status)
+	id := user["id"].(string)
+	assert.NotEmpty(t, id)
+	assert.Equal(t, "alice@example.com", user["userName"])
+
+	status, resp := request(t, h, http.MethodPost, "/Users", `{"userName":"Alice@example.com"}`)
+	assert.Equal(t, http.StatusConflict, status)
+	assert.Equal(t, "uniqueness", resp["scimType"])
+	status, resp = request(t, h, http.MethodPost, "/Users", `{"userName":"bob@example.com","externalId":"00u1"}`)
+	assert.Equal(t, http.StatusConflict, status)
+	assert.Equal(t, "uniqueness", resp["scimType"])
+
+	status, resp = request(t, h, http.MethodGet, `/Users?filter=userName+eq+%22ALICE@example.com%22`, "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(1), resp["totalResults"])
+
+	status, resp = request(t, h, http.MethodGet, `/Users?filter=userName+eq+%22bob@example.com%22`, "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(0), resp["totalResults"])
+
+	status, resp = request(t, h, http.MethodGet, `/Users?filter=name.givenName+sw+%22A%22`, "")
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "invalidFilter", resp["scimType"])
+
+	// some identity providers send booleans as strings
+	status, user = request(t, h, http.MethodPatch, "/Users/"+id, `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","path":"active","value":"False"},{"op":"replace","path":"name.givenName","value":"Al"}]}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, false, user["active"])
+
+	status, user = request(t, h, http.MethodPut, "/Users/"+id, `{"userName":"alice@example.com","active":true}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, true, user["active"])
+	assert.Nil(t, user["externalId"])
+
+	status, _ = request(t, h, http.MethodDelete, "/Users/"+id, "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = request(t, h, http.MethodGet, "/Users/"+id, "")
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = request(t, h, http.MethodGet, "/Users/not-an-id", "")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestHandler_Groups(t *testing.T) {
+	h, store := newTestHandler(t, "true")
+
+	_, alice := request(t, h, http.MethodPost, "/Users", `{"userName":"alice@example.com","externalId":"00u1"}`)
+	aliceID := alice["id"].(string)
+	_, bob := request(t, h, http.MethodPost, "/Users", `{"userName":"bob@example.com","externalId":"00u2"}`)
+	bobID := bob["id"].(string)
+
+	status, group := request(t, h, http.MethodPost, "/Groups", `{"displayName":"my-team","members":[{"value":"`+aliceID+`"}]}`)
+	require.Equal(t, http.StatusCreated, status)
+	groupID := group["id"].(string)
+
+	status, resp := request(t, h, http.MethodPost, "/Groups", `{"displayName":"other-team","members":[{"value":"unknown"}]}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "invalidValue", resp["scimType"])
+
+	// each resource is stored in its own config map
+	configMaps, err := store.kubeClient.CoreV1().ConfigMaps(testNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: common.LabelKeySCIMResourceType})
+	require.NoError(t, err)
+	assert.Len(t, configMaps.Items, 3)
+
+	userGroups := func(externalID string) func() []string {
+		return func() []string {
+			groups, err := store.UserGroups(externalID)
+			assert.NoError(t, err)
+			return groups
+		}
+	}
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"my-team"}, userGroups("00u1")())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status, group = request(t, h, http.MethodPatch, "/Groups/"+groupID, `{"Operations":[{"op":"add","path":"members","value":[{"value":"`+bobID+`"}]},{"op":"remove","path":"members[value eq \"`+aliceID+`\"]"}]}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Len(t, group["members"], 1)
+	assert.Eventually(t, func() bool {
+		return len(userGroups("00u1")()) == 0 && assert.ObjectsAreEqual([]string{"my-team"}, userGroups("00u2")())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status, user := request(t, h, http.MethodGet, "/Users/"+bobID, "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Len(t, user["groups"], 1)
+
+	status, group = request(t, h, http.MethodPatch, "/Groups/"+groupID, `{"Operations":[{"op":"replace","value":{"displayName":"renamed-team"}}]}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "renamed-team", group["displayName"])
+
+	// deleting a user removes it from its groups
+	status, _ = request(t, h, http.MethodDelete, "/Users/"+bobID, "")
+	assert.Equal(t, http.StatusNoContent, status)
+	_, group = request(t, h, http.MethodGet, "/Groups/"+groupID, "")
+	assert.Nil(t, group["members"])
+
+	status, _ = request(t, h, http.MethodDelete, "/Groups/"+groupID, "")
+	assert.Equal(t, http.StatusNoContent, status)
+	_, resp = request(t, h, http.MethodGet, "/Groups", "")
+	assert.Equal(t, float64(0), resp["totalResults"])
+}
+
+func TestNewUserGroupsFunc(t *testing.T) {
+	h, store := newTestHandler(t, "true")
+	_, alice := request(t, h, http.MethodPost, "/Users", `{"userName":"alice@example.com","externalId":"00u1"}`)
+	request(t, h, http.MethodPost, "/Groups", `{"displayName":"my-team","members":[{"value":"`+alice["id"].(string)+`"}]}`)
+	userGroups := NewUserGroupsFunc(store, h.settingsMgr)
+
+	assert.Eventually(t, func() bool {
+		groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "sub": "00u1"})
+		return ok && assert.ObjectsAreEqual([]string{"my-team"}, groups)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the tokens of other issuers and local accounts keep their groups, even with the subject of a provisioned user
+	_, ok := userGroups(jwt.MapClaims{"iss": "https://other-idp.example.com", "sub": "00u1"})
+	assert.False(t, ok)
+	_, ok = userGroups(jwt.MapClaims{"iss": "argocd", "sub": "00u1"})
+	assert.False(t, ok)
+
+	// the users of the issuer which are not provisioned have no groups
+	groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "sub": "00u2", "groups": []string{"my-team"}})
+	assert.True(t, ok)
+	assert.Empty(t, groups)
+
+	// nor have the deleted users
+	status, _ := request(t, h, http.MethodDelete, "/Users/"+alice["id"].(string), "")
+	require.Equal(t, http.StatusNoContent, status)
+	assert.Eventually(t, func() bool {
+		groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "sub": "00u1", "groups": []string{"my-team"}})
+		return ok && len(groups) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewUserGroupsFunc_EmailVerified(t *testing.T) {
+	h, store := newTestHandler(t, "true", func(data map[string]string) {
+		data["scim.subjectClaim"] = "email"
+	})
+	_, alice := request(t, h, http.MethodPost, "/Users", `{"userName":"alice","externalId":"alice@example.com"}`)
+	request(t, h, http.MethodPost, "/Groups", `{"displayName":"my-team","members":[{"value":"`+alice["id"].(string)+`"}]}`)
+	userGroups := NewUserGroupsFunc(store, h.settingsMgr)
+
+	assert.Eventually(t, func() bool {
+		groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "email": "alice@example.com", "email_verified": true})
+		return ok && assert.ObjectsAreEqual([]string{"my-team"}, groups)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an email which is not verified may have been chosen by anyone
+	groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "email": "alice@example.com"})
+	assert.True(t, ok)
+	assert.Empty(t, groups)
+	groups, ok = userGroups(jwt.MapClaims{"iss": testIssuer, "email": "alice@example.com", "email_verified": false})
+	assert.True(t, ok)
+	assert.Empty(t, groups)
+}
+
+func TestNewUserGroupsFunc_FailClosed(t *testing.T) {
+	h, store := newTestHandler(t, "maybe")
+	userGroups := NewUserGroupsFunc(store, h.settingsMgr)
+
+	// the groups of the tokens are denied if the SCIM settings are invalid
+	groups, ok := userGroups(jwt.MapClaims{"iss": testIssuer, "sub": "00u1", "groups": []string{"my-team"}})
+	assert.True(t, ok)
+	assert.Empty(t, groups)
+	// but not the ones of the local accounts
+	_, ok = userGroups(jwt.MapClaims{"iss": "argocd", "sub": "admin"})
+	assert.False(t, ok)
+}
+
+func TestStore_UserGroups_NotSynced(t *testing.T) {
+	store := NewStore(fake.NewSimpleClientset(), testNamespace)
+	_, err := store.UserGroups("00u1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,444 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	v1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+const (
+	// userConfigMapPrefix is the prefix of the names of the config maps holding the users, followed by their id
+	userConfigMapPrefix = "argocd-scim-user-"
+	// groupConfigMapPrefix is the prefix of the names of the config maps holding the groups, followed by their id
+	groupConfigMapPrefix = "argocd-scim-group-"
+
+	// externalIDIndex indexes the users by externalId
+	externalIDIndex = "externalId"
+	// memberIndex indexes the groups by the ids of their members
+	memberIndex = "member"
+
+	idKey           = "id"
+	externalIDKey   = "externalId"
+	userNameKey     = "userName"
+	displayNameKey  = "displayName"
+	activeKey       = "active"
+	emailsKey       = "emails"
+	membersKey      = "members"
+	createdKey      = "created"
+	lastModifiedKey = "lastModified"
+)
+
+// Store persists each user and group provisioned through SCIM in its own config map, labeled with the type of the
+// resource, so that their number is not limited by the size of a config map. The identity providers read and write the
+// resources through the Kubernetes API while the group memberships used by the RBAC enforcement are read from an
+// informer cache.
+type Store struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	informer   cache.SharedIndexInformer
+}
+
+// NewStore returns a new SCIM store in the given namespace
+func NewStore(kubeClient kubernetes.Interface, namespace string) *Store {
+	informer := coreinformers.NewFilteredConfigMapInformer(kubeClient, namespace, 3*time.Minute, cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		externalIDIndex:      indexByExternalID,
+		memberIndex:          indexByMember,
+	}, func(options *metav1.ListOptions) {
+		options.LabelSelector = common.LabelKeySCIMResourceType
+	})
+	return &Store{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		informer:   informer,
+	}
+}
+
+func isResource(obj interface{}, resourceType string) (*v1.ConfigMap, bool) {
+	cm, ok := obj.(*v1.ConfigMap)
+	if !ok || cm.Labels[common.LabelKeySCIMResourceType] != resourceType {
+		return nil, false
+	}
+	return cm, true
+}
+
+func indexByExternalID(obj interface{}) ([]string, error) {
+	if cm, ok := isResource(obj, common.LabelValueSCIMResourceTypeUser); ok && cm.Data[externalIDKey] != "" {
+		return []string{cm.Data[externalIDKey]}, nil
+	}
+	return nil, nil
+}
+
+func indexByMember(obj interface{}) ([]string, error) {
+	if cm, ok := isResource(obj, common.LabelValueSCIMResourceTypeGroup); ok {
+		return splitMembers(cm.Data[membersKey]), nil
+	}
+	return nil, nil
+}
+
+// Run runs the informer of the store until the context is done
+func (s *Store) Run(ctx context.Context) {
+	s.informer.Run(ctx.Done())
+}
+
+// HasSynced returns whether the informer of the store has synced
+func (s *Store) HasSynced() bool {
+	return s.informer.HasSynced()
+}
+
+// UserGroups returns the sorted names of the groups of the user with the given externalId. Users which are not
+// provisioned, e.g. deleted ones, and inactive users have no groups.
+func (s *Store) UserGroups(externalID string) ([]string, error) {
+	if !s.informer.HasSynced() {
+		return nil, errors.New("the SCIM users and groups are not synced yet")
+	}
+	users, err := s.informer.GetIndexer().ByIndex(externalIDIndex, externalID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(users) == 0:
+		return nil, nil
+	case len(users) > 1:
+		return nil, fmt.Errorf("%d SCIM users have the externalId '%s'", len(users), externalID)
+	}
+	user, err := userFromConfigMap(users[0].(*v1.ConfigMap))
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, nil
+	}
+	groups, err := s.informer.GetIndexer().ByIndex(memberIndex, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, obj := range groups {
+		names = append(names, obj.(*v1.ConfigMap).Data[displayNameKey])
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// configMapName returns the name of the config map of the resource with the given id, or false if the id is not one
+// generated by the store
+func configMapName(prefix string, id string) (string, bool) {
+	parsed, err := uuid.Parse(id)
+	if err != nil || parsed.String() != id {
+		return "", false
+	}
+	return prefix + id, true
+}
+
+func (s *Store) list(ctx context.Context, resourceType string) ([]v1.ConfigMap, error) {
+	list, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", common.LabelKeySCIMResourceType, resourceType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (s *Store) get(ctx context.Context, resourceType string, prefix string, id string) (*v1.ConfigMap, error) {
+	name, ok := configMapName(prefix, id)
+	if !ok {
+		return nil, newError(http.StatusNotFound, "", "%s %s not found", resourceType, id)
+	}
+	cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil, newError(http.StatusNotFound, "", "%s %s not found", resourceType, id)
+	}
+	return cm, err
+}
+
+// update runs the callback against the config map of the resource and persists the changes it applied. The callback
+// is run again if the config map was updated concurrently.
+func (s *Store) update(ctx context.Context, resourceType string, prefix string, id string, callback func(cm *v1.ConfigMap) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.get(ctx, resourceType, prefix, id)
+		if err != nil {
+			return err
+		}
+		if err := callback(cm); err != nil {
+			return err
+		}
+		_, err = s.kubeClient.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (s *Store) delete(ctx context.Context, resourceType string, prefix string, id string) error {
+	name, ok := configMapName(prefix, id)
+	if !ok {
+		return newError(http.StatusNotFound, "", "%s %s not found", resourceType, id)
+	}
+	err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if apierr.IsNotFound(err) {
+		return newError(http.StatusNotFound, "", "%s %s not found", resourceType, id)
+	}
+	return err
+}
+
+func (s *Store) create(ctx context.Context, cm *v1.ConfigMap) error {
+	_, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+	return err
+}
+
+// listUsers returns the users by id
+func (s *Store) listUsers(ctx context.Context) (map[string]*User, error) {
+	items, err := s.list(ctx, common.LabelValueSCIMResourceTypeUser)
+	if err != nil {
+		return nil, err
+	}
+	users := map[string]*User{}
+	for i := range items {
+		u, err := userFromConfigMap(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		users[u.ID] = u
+	}
+	return users, nil
+}
+
+func (s *Store) getUser(ctx context.Context, id string) (*User, error) {
+	cm, err := s.get(ctx, "user", userConfigMapPrefix, id)
+	if err != nil {
+		return nil, err
+	}
+	return userFromConfigMap(cm)
+}
+
+func (s *Store) createUser(ctx context.Context, u *User) error {
+	cm, err := s.userConfigMap(u)
+	if err != nil {
+		return err
+	}
+	return s.create(ctx, cm)
+}
+
+// updateUser replaces the user with the given id by the one the callback returns from the existing user
+func (s *Store) updateUser(ctx context.Context, id string, callback func(existing *User) (*User, error)) (*User, error) {
+	var updated *User
+	err := s.update(ctx, "user", userConfigMapPrefix, id, func(cm *v1.ConfigMap) error {
+		existing, err := userFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		if updated, err = callback(existing); err != nil {
+			return err
+		}
+		cm.Data, err = userData(updated)
+		return err
+	})
+	return updated, err
+}
+
+func (s *Store) deleteUser(ctx context.Context, id string) error {
+	return s.delete(ctx, "user", userConfigMapPrefix, id)
+}
+
+// listGroups returns the groups by id
+func (s *Store) listGroups(ctx context.Context) (map[string]*Group, error) {
+	items, err := s.list(ctx, common.LabelValueSCIMResourceTypeGroup)
+	if err != nil {
+		return nil, err
+	}
+	groups := map[string]*Group{}
+	for i := range items {
+		g, err := groupFromConfigMap(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		groups[g.ID] = g
+	}
+	return groups, nil
+}
+
+func (s *Store) getGroup(ctx context.Context, id string) (*Group, error) {
+	cm, err := s.get(ctx, "group", groupConfigMapPrefix, id)
+	if err != nil {
+		return nil, err
+	}
+	return groupFromConfigMap(cm)
+}
+
+func (s *Store) createGroup(ctx context.Context, g *Group) error {
+	return s.create(ctx, s.groupConfigMap(g))
+}
+
+// updateGroup replaces the group with the given id by the one the callback returns from the existing group
+func (s *Store) updateGroup(ctx context.Context, id string, callback func(existing *Group) (*Group, error)) (*Group, error) {
+	var updated *Group
+	err := s.update(ctx, "group", groupConfigMapPrefix, id, func(cm *v1.ConfigMap) error {
+		existing, err := groupFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		if updated, err = callback(existing); err != nil {
+			return err
+		}
+		cm.Data = groupData(updated)
+		return nil
+	})
+	return updated, err
+}
+
+func (s *Store) deleteGroup(ctx context.Context, id string) error {
+	return s.delete(ctx, "group", groupConfigMapPrefix, id)
+}
+
+func (s *Store) newConfigMap(name string, resourceType string) *v1.ConfigMap {
+	return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: s.namespace,
+		Labels: map[string]string{
+			"app.kubernetes.io/part-of":     "argocd",
+			common.LabelKeySCIMResourceType: resourceType,
+		},
+	}}
+}
+
+func (s *Store) userConfigMap(u *User) (*v1.ConfigMap, error) {
+	name, ok := configMapName(userConfigMapPrefix, u.ID)
+	if !ok {
+		return nil, fmt.Errorf("invalid user id '%s'", u.ID)
+	}
+	cm := s.newConfigMap(name, common.LabelValueSCIMResourceTypeUser)
+	var err error
+	cm.Data, err = userData(u)
+	return cm, err
+}
+
+func (s *Store) groupConfigMap(g *Group) *v1.ConfigMap {
+	cm := s.newConfigMap(groupConfigMapPrefix+g.ID, common.LabelValueSCIMResourceTypeGroup)
+	cm.Data = groupData(g)
+	return cm
+}
+
+func setData(data map[string]string, key string, value string) {
+	if value != "" {
+		data[key] = value
+	}
+}
+
+func setMeta(data map[string]string, meta *Meta) {
+	if meta == nil {
+		return
+	}
+	if meta.Created != nil {
+		data[createdKey] = meta.Created.Format(time.RFC3339Nano)
+	}
+	if meta.LastModified != nil {
+		data[lastModifiedKey] = meta.LastModified.Format(time.RFC3339Nano)
+	}
+}
+
+func parseMeta(cm *v1.ConfigMap) (*Meta, error) {
+	meta := &Meta{}
+	for key, t := range map[string]**time.Time{createdKey: &meta.Created, lastModifiedKey: &meta.LastModified} {
+		if value, ok := cm.Data[key]; ok {
+			parsed, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse key '%s' of config map %s: %w", key, cm.Name, err)
+			}
+			*t = &parsed
+		}
+	}
+	return meta, nil
+}
+
+func userData(u *User) (map[string]string, error) {
+	data := map[string]string{idKey: u.ID, userNameKey: u.UserName}
+	setData(data, externalIDKey, u.ExternalID)
+	setData(data, displayNameKey, u.DisplayName)
+	if u.Active != nil {
+		data[activeKey] = strconv.FormatBool(*u.Active)
+	}
+	if len(u.Emails) > 0 {
+		emails, err := json.Marshal(u.Emails)
+		if err != nil {
+			return nil, err
+		}
+		data[emailsKey] = string(emails)
+	}
+	setMeta(data, u.Meta)
+	return data, nil
+}
+
+func userFromConfigMap(cm *v1.ConfigMap) (*User, error) {
+	u := &User{
+		ID:          cm.Data[idKey],
+		ExternalID:  cm.Data[externalIDKey],
+		UserName:    cm.Data[userNameKey],
+		DisplayName: cm.Data[displayNameKey],
+	}
+	if value, ok := cm.Data[activeKey]; ok {
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key '%s' of config map %s: %w", activeKey, cm.Name, err)
+		}
+		u.Active = &active
+	}
+	if value, ok := cm.Data[emailsKey]; ok {
+		if err := json.Unmarshal([]byte(value), &u.Emails); err != nil {
+			return nil, fmt.Errorf("failed to parse key '%s' of config map %s: %w", emailsKey, cm.Name, err)
+		}
+	}
+	var err error
+	u.Meta, err = parseMeta(cm)
+	return u, err
+}
+
+func groupData(g *Group) map[string]string {
+	data := map[string]string{idKey: g.ID, displayNameKey: g.DisplayName}
+	setData(data, externalIDKey, g.ExternalID)
+	var members []string
+	for _, m := range g.Members {
+		members = append(members, m.Value)
+	}
+	setData(data, membersKey, strings.Join(members, "\n"))
+	setMeta(data, g.Meta)
+	return data
+}
+
+func groupFromConfigMap(cm *v1.ConfigMap) (*Group, error) {
+	g := &Group{
+		ID:          cm.Data[idKey],
+		ExternalID:  cm.Data[externalIDKey],
+		DisplayName: cm.Data[displayNameKey],
+	}
+	for _, id := range splitMembers(cm.Data[membersKey]) {
+		g.Members = append(g.Members, Reference{Value: id})
+	}
+	var err error
+	g.Meta, err = parseMeta(cm)
+	return g, err
+}
+
+// splitMembers returns the ids of the members of a group, stored one per line
+func splitMembers(members string) []string {
+	if members == "" {
+		return nil
+	}
+	return strings.Split(members, "\n")
+}
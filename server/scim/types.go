@@ -0,0 +1,126 @@
+package scim
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	resourceTypeSchema          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// Meta holds the metadata of a SCIM resource
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+}
+
+// Email is an email address of a SCIM user
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Reference references a SCIM user from a group or a SCIM group from a user
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// User is a SCIM user. Only the attributes Argo CD uses are persisted.
+type User struct {
+	Schemas     []string `json:"schemas,omitempty"`
+	ID          string   `json:"id"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	// Active defaults to true
+	Active *bool   `json:"active,omitempty"`
+	Emails []Email `json:"emails,omitempty"`
+	// Groups is computed from the members of the groups and never persisted
+	Groups []Reference `json:"groups,omitempty"`
+	Meta   *Meta       `json:"meta,omitempty"`
+}
+
+// IsActive returns whether the user is active
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// Group is a SCIM group, whose display name is the group name RBAC policies refer to
+type Group struct {
+	Schemas     []string    `json:"schemas,omitempty"`
+	ID          string      `json:"id"`
+	ExternalID  string      `json:"externalId,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members,omitempty"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// HasMember returns whether the user with the given id is a member of the group
+func (g *Group) HasMember(userID string) bool {
+	for _, m := range g.Members {
+		if m.Value == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// listResponse is the response to the queries of SCIM resources
+type listResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+// patchRequest is a SCIM PATCH request
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// errorResponse is a SCIM error
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func sortedUsers(users map[string]*User) []*User {
+	var res []*User
+	for _, u := range users {
+		res = append(res, u)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+func sortedGroups(groups map[string]*Group) []*Group {
+	var res []*Group
+	for _, g := range groups {
+		res = append(res, g)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"regexp"
 	"strings"
 	gosync "sync"
@@ -71,18 +72,22 @@ import (
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/certificate"
 	"github.com/argoproj/argo-cd/v2/server/cluster"
+	"github.com/argoproj/argo-cd/v2/server/extension"
 	"github.com/argoproj/argo-cd/v2/server/gpgkey"
 	"github.com/argoproj/argo-cd/v2/server/logout"
 	"github.com/argoproj/argo-cd/v2/server/metrics"
 	"github.com/argoproj/argo-cd/v2/server/project"
+	"github.com/argoproj/argo-cd/v2/server/ratelimit"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/server/repocreds"
 	"github.com/argoproj/argo-cd/v2/server/repository"
+	"github.com/argoproj/argo-cd/v2/server/scim"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/server/settings"
 	"github.com/argoproj/argo-cd/v2/server/version"
 	"github.com/argoproj/argo-cd/v2/ui"
 	"github.com/argoproj/argo-cd/v2/util/assets"
+	"github.com/argoproj/argo-cd/v2/util/audit"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/dex"
@@ -157,6 +162,7 @@ type ArgoCDServer struct {
 	policyEnforcer *rbacpolicy.RBACPolicyEnforcer
 	appInformer    cache.SharedIndexInformer
 	appLister      applisters.ApplicationNamespaceLister
+	scimStore      *scim.Store
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh           chan struct{}
@@ -165,6 +171,7 @@ type ArgoCDServer struct {
 	indexData        []byte
 	indexDataErr     error
 	staticAssets     http.FileSystem
+	auditLogger      *audit.Logger
 }
 
 type ArgoCDServerOpts struct {
@@ -182,10 +189,19 @@ type ArgoCDServerOpts struct {
 	AppClientset        appclientset.Interface
 	RepoClientset       repoapiclient.Clientset
 	Cache               *servercache.Cache
-	RedisClient         *redis.Client
+	RedisClient         redis.UniversalClient
 	TLSConfigCustomizer tlsutil.ConfigCustomizer
 	XFrameOptions       string
 	ListenHost          string
+	// WebhookWarmManifestCache enables the generation of the manifests of the applications affected by a webhook
+	// push event before they are refreshed
+	WebhookWarmManifestCache bool
+	// AuditLogger records the actions performed through the API, if not nil. It is run and closed by the caller, so
+	// that the queued events are flushed on shutdown.
+	AuditLogger *audit.Logger
+	// RepoCache is the repo server cache in which the API server invalidates the resolved git references on webhook
+	// events. The repo server entries of Cache are used if nil.
+	RepoCache *repocache.Cache
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -214,6 +230,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	settingsMgr := settings_util.NewSettingsManager(ctx, opts.KubeClientset, opts.Namespace)
 	settings, err := settingsMgr.InitializeSettings(opts.Insecure)
 	errors.CheckError(err)
+	if opts.RepoCache == nil && opts.Cache != nil {
+		opts.RepoCache = repocache.NewCache(opts.Cache.GetCache(), 24*time.Hour, 3*time.Minute)
+	}
 	err = initializeDefaultProject(opts)
 	errors.CheckError(err)
 
@@ -232,7 +251,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	errors.CheckError(err)
 	enf.EnableLog(os.Getenv(common.EnvVarRBACDebug) == "1")
 
+	scimStore := scim.NewStore(opts.KubeClientset, opts.Namespace)
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
+	policyEnf.SetUserGroupsFunc(scim.NewUserGroupsFunc(scimStore, settingsMgr))
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
 
 	var staticFS fs.FS = io.NewSubDirFS("dist/app", ui.Embedded)
@@ -250,9 +271,11 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 		projInformer:     projInformer,
 		appInformer:      appInformer,
 		appLister:        appLister,
+		scimStore:        scimStore,
 		policyEnforcer:   policyEnf,
 		userStateStorage: userStateStorage,
 		staticAssets:     http.FS(staticFS),
+		auditLogger:      opts.AuditLogger,
 	}
 }
 
@@ -279,15 +302,21 @@ func (a *ArgoCDServer) healthCheck(r *http.Request) error {
 func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	a.userStateStorage.Init(ctx)
 
-	grpcS := a.newGRPCServer()
+	metricsServ := metrics.NewMetricsServer(a.ListenHost, metricsPort)
+	if a.RedisClient != nil {
+		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
+	}
+
+	rateLimiter := ratelimit.NewLimiter(a.settingsMgr, metricsServ)
+	grpcS := a.newGRPCServer(rateLimiter)
 	grpcWebS := grpcweb.WrapServer(grpcS)
 	var httpS *http.Server
 	var httpsS *http.Server
 	if a.useTLS() {
 		httpS = newRedirectServer(port, a.RootPath)
-		httpsS = a.newHTTPServer(ctx, port, grpcWebS)
+		httpsS = a.newHTTPServer(ctx, port, grpcWebS, rateLimiter)
 	} else {
-		httpS = a.newHTTPServer(ctx, port, grpcWebS)
+		httpS = a.newHTTPServer(ctx, port, grpcWebS, rateLimiter)
 	}
 	if a.RootPath != "" {
 		httpS.Handler = withRootPath(httpS.Handler, a)
@@ -301,11 +330,6 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 		httpsS.Handler = &bug21955Workaround{handler: httpsS.Handler}
 	}
 
-	metricsServ := metrics.NewMetricsServer(a.ListenHost, metricsPort)
-	if a.RedisClient != nil {
-		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
-	}
-
 	// Start listener
 	var conn net.Listener
 	var realErr error
@@ -354,6 +378,8 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 
 	go a.projInformer.Run(ctx.Done())
 	go a.appInformer.Run(ctx.Done())
+	go a.scimStore.Run(ctx)
+	go rateLimiter.Run(ctx)
 
 	go func() { a.checkServeErr("grpcS", grpcS.Serve(grpcL)) }()
 	go func() { a.checkServeErr("httpS", httpS.Serve(httpL)) }()
@@ -363,9 +389,10 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	}
 	go a.watchSettings()
 	go a.rbacPolicyLoader(ctx)
+	go db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset).SyncGPGPublicKeys(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { a.checkServeErr("metrics", metricsServ.ListenAndServe()) }()
-	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced, a.appInformer.HasSynced) {
+	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced, a.appInformer.HasSynced, a.scimStore.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
 	}
 
@@ -413,6 +440,7 @@ func (a *ArgoCDServer) watchSettings() {
 
 	prevURL := a.settings.URL
 	prevOIDCConfig := a.settings.OIDCConfigRAW
+	prevOIDCProviders := a.settings.OIDCProvidersRAW
 	prevDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings)
 	errors.CheckError(err)
 	prevGitHubSecret := a.settings.WebhookGitHubSecret
@@ -420,6 +448,9 @@ func (a *ArgoCDServer) watchSettings() {
 	prevBitbucketUUID := a.settings.WebhookBitbucketUUID
 	prevBitbucketServerSecret := a.settings.WebhookBitbucketServerSecret
 	prevGogsSecret := a.settings.WebhookGogsSecret
+	prevGiteaSecret := a.settings.WebhookGiteaSecret
+	prevAzureDevOpsUsername := a.settings.WebhookAzureDevOpsUsername
+	prevAzureDevOpsPassword := a.settings.WebhookAzureDevOpsPassword
 	var prevCert, prevCertKey string
 	if a.settings.Certificate != nil && !a.ArgoCDServerOpts.Insecure {
 		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
@@ -438,6 +469,10 @@ func (a *ArgoCDServer) watchSettings() {
 			log.Infof("oidc config modified. restarting")
 			break
 		}
+		if prevOIDCProviders != a.settings.OIDCProvidersRAW {
+			log.Infof("oidc providers modified. restarting")
+			break
+		}
 		if prevURL != a.settings.URL {
 			log.Infof("url modified. restarting")
 			break
@@ -462,6 +497,14 @@ func (a *ArgoCDServer) watchSettings() {
 			log.Infof("gogs secret modified. restarting")
 			break
 		}
+		if prevGiteaSecret != a.settings.WebhookGiteaSecret {
+			log.Infof("gitea secret modified. restarting")
+			break
+		}
+		if prevAzureDevOpsUsername != a.settings.WebhookAzureDevOpsUsername || prevAzureDevOpsPassword != a.settings.WebhookAzureDevOpsPassword {
+			log.Infof("azure devops credentials modified. restarting")
+			break
+		}
 		if !a.ArgoCDServerOpts.Insecure {
 			var newCert, newCertKey string
 			if a.settings.Certificate != nil {
@@ -503,7 +546,7 @@ func (a *ArgoCDServer) useTLS() bool {
 	return true
 }
 
-func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
+func (a *ArgoCDServer) newGRPCServer(rateLimiter *ratelimit.Limiter) *grpc.Server {
 	if enableGRPCTimeHistogram {
 		grpc_prometheus.EnableHandlingTimeHistogram()
 	}
@@ -533,31 +576,50 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
-	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_logrus.StreamServerInterceptor(a.log),
+		grpc_util.RequestIDStreamServerInterceptor(),
 		grpc_prometheus.StreamServerInterceptor,
-		grpc_auth.StreamServerInterceptor(a.Authenticate),
+		rateLimiter.UnauthenticatedStreamServerInterceptor(),
+		grpc_auth.StreamServerInterceptor(a.authenticateAndAudit),
+		rateLimiter.StreamServerInterceptor(),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadStreamServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
+	}
+	if a.auditLogger != nil {
+		streamInterceptors = append(streamInterceptors, auditStreamServerInterceptor(a.auditLogger))
+	}
+	streamInterceptors = append(streamInterceptors,
 		grpc_util.ErrorCodeK8sStreamServerInterceptor(),
 		grpc_util.ErrorCodeGitStreamServerInterceptor(),
 		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
-	)))
-	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+	)
+	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)))
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		bug21955WorkaroundInterceptor,
 		grpc_logrus.UnaryServerInterceptor(a.log),
+		grpc_util.RequestIDUnaryServerInterceptor(),
 		grpc_prometheus.UnaryServerInterceptor,
-		grpc_auth.UnaryServerInterceptor(a.Authenticate),
+		rateLimiter.UnauthenticatedUnaryServerInterceptor(),
+		grpc_auth.UnaryServerInterceptor(a.authenticateAndAudit),
+		rateLimiter.UnaryServerInterceptor(),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
+	}
+	if a.auditLogger != nil {
+		// audit the actions once authenticated, with the errors returned to the clients
+		unaryInterceptors = append(unaryInterceptors, auditUnaryServerInterceptor(a.auditLogger))
+	}
+	unaryInterceptors = append(unaryInterceptors,
 		grpc_util.ErrorCodeK8sUnaryServerInterceptor(),
 		grpc_util.ErrorCodeGitUnaryServerInterceptor(),
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
-	)))
+	)
+	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)))
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
 	kubectl := kubeutil.NewKubectl()
@@ -578,6 +640,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 		a.appInformer,
 		a.RepoClientset,
 		a.Cache,
+		a.RepoCache,
 		kubectl,
 		db,
 		a.enf,
@@ -675,16 +738,19 @@ func compressHandler(handler http.Handler) http.Handler {
 
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
 // using grpc-gateway as a proxy to the gRPC server.
-func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler) *http.Server {
+func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandler http.Handler, rateLimiter *ratelimit.Limiter) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
+	// the requests of the gateway and gRPC-web clients are rate limited by the gRPC server, the ones of the other
+	// handlers by the handlers themselves
+	limit := rateLimiter.Handler
 	httpS := http.Server{
 		Addr: endpoint,
 		Handler: &handlerSwitcher{
 			handler: mux,
 			urlToHandler: map[string]http.Handler{
-				"/api/badge":          badge.NewHandler(a.AppClientset, a.settingsMgr, a.Namespace),
-				common.LogoutEndpoint: logout.NewHandler(a.AppClientset, a.settingsMgr, a.sessionMgr, a.ArgoCDServerOpts.RootPath, a.ArgoCDServerOpts.BaseHRef, a.Namespace),
+				"/api/badge":          limit(badge.NewHandler(a.appLister, a.settingsMgr)),
+				common.LogoutEndpoint: limit(auditHTTPHandler(a.auditLogger, auditActionLogout, nil, logout.NewHandler(a.AppClientset, a.settingsMgr, a.sessionMgr, a.ArgoCDServerOpts.RootPath, a.ArgoCDServerOpts.BaseHRef, a.Namespace))),
 			},
 			contentTypeToHandler: map[string]http.Handler{
 				"application/grpc-web+proto": grpcWebHandler,
@@ -718,7 +784,21 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	// the request IDs are forwarded to the gRPC server and returned to the clients, so that they can be correlated with
+	// the audit events
+	gwRequestIDInOpts := runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		if strings.EqualFold(key, grpc_util.RequestIDHeader) {
+			return grpc_util.RequestIDHeader, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+	gwRequestIDOutOpts := runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+		if key == grpc_util.RequestIDHeader {
+			return http.CanonicalHeaderKey(grpc_util.RequestIDHeader), true
+		}
+		return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+	})
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwRequestIDInOpts, gwRequestIDOutOpts)
 
 	var handler http.Handler = gwmux
 	if a.EnableGZip {
@@ -743,22 +823,36 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	healthz.ServeHealthCheck(mux, a.healthCheck)
 
 	// Dex reverse proxy and client app and OAuth2 login/callback
-	a.registerDexHandlers(mux)
+	a.registerDexHandlers(mux, limit)
 
-	// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
+	// Webhook handler for git events
 	argoDB := db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset)
-	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings, a.settingsMgr, repocache.NewCache(a.Cache.GetCache(), 24*time.Hour, 3*time.Minute), a.Cache, argoDB)
-	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+	var webhookRepoClientset repoapiclient.Clientset
+	if a.WebhookWarmManifestCache {
+		webhookRepoClientset = a.RepoClientset
+	}
+	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings, a.settingsMgr, a.RepoCache, a.Cache, argoDB, webhookRepoClientset)
+	mux.Handle("/api/webhook", limit(auditHTTPHandler(a.auditLogger, auditActionWebhook, nil, http.HandlerFunc(acdWebhookHandler.Handler))))
+
+	// Web terminal running a shell in the pods of the applications
+	terminalHandler := application.NewTerminalHandler(a.Namespace, a.KubeClientset, a.appLister, a.projInformer, argoDB, a.enf, a.settingsMgr, a.Cache)
+	mux.Handle(application.TerminalPath, limit(auditHTTPHandler(a.auditLogger, auditActionExec, a.authMiddleware, terminalHandler)))
+
+	// SCIM endpoint through which identity providers provision users and groups
+	mux.Handle(scim.Path+"/", limit(auditHTTPHandler(a.auditLogger, auditActionSCIM, nil, scim.NewHandler(a.scimStore, a.settingsMgr))))
 
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
 
-	// Serve extensions
-	var extensionsApiPath = "/extensions/"
+	// Serve extensions, proxying the requests of the configured extensions to their backend services
 	var extensionsSharedPath = "/tmp/extensions/"
 
-	extHandler := http.StripPrefix(extensionsApiPath, http.FileServer(http.Dir(extensionsSharedPath)))
-	mux.HandleFunc(extensionsApiPath, extHandler.ServeHTTP)
+	extHandler := http.StripPrefix(extension.URLPrefix, http.FileServer(http.Dir(extensionsSharedPath)))
+	extensionManager := extension.NewManager(a.settingsMgr, a.appLister, argoDB, a.enf)
+	go extensionManager.Run(ctx)
+	mux.Handle(extension.URLPrefix, extensionManager.Handler(func(next http.Handler) http.Handler {
+		return limit(auditHTTPHandler(a.auditLogger, auditActionExtension, a.authMiddleware, next))
+	}, extHandler))
 
 	// Serve UI static assets
 	var assetsHandler http.Handler = http.HandlerFunc(a.newStaticAssetsHandler())
@@ -770,21 +864,30 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 }
 
 // registerDexHandlers will register dex HTTP handlers, creating the the OAuth client app
-func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
+func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux, limit func(http.Handler) http.Handler) {
 	if !a.settings.IsSSOConfigured() {
 		return
 	}
 	// Run dex OpenID Connect Identity Provider behind a reverse proxy (served at /api/dex)
 	var err error
-	mux.HandleFunc(common.DexAPIEndpoint+"/", dexutil.NewDexHTTPReverseProxy(a.DexServerAddr, a.BaseHRef))
+	mux.Handle(common.DexAPIEndpoint+"/", limit(http.HandlerFunc(dexutil.NewDexHTTPReverseProxy(a.DexServerAddr, a.BaseHRef))))
 	if a.useTLS() {
 		tlsConfig := a.settings.TLSConfig()
 		tlsConfig.InsecureSkipVerify = true
 	}
-	a.ssoClientApp, err = oidc.NewClientApp(a.settings, a.Cache, a.DexServerAddr, a.BaseHRef)
-	errors.CheckError(err)
-	mux.HandleFunc(common.LoginEndpoint, a.ssoClientApp.HandleLogin)
-	mux.HandleFunc(common.CallbackEndpoint, a.ssoClientApp.HandleCallback)
+	if a.settings.IsDexConfigured() || a.settings.OIDCConfig() != nil {
+		a.ssoClientApp, err = oidc.NewClientApp(a.settings, a.Cache, a.DexServerAddr, a.BaseHRef)
+		errors.CheckError(err)
+		mux.Handle(common.LoginEndpoint, limit(http.HandlerFunc(a.ssoClientApp.HandleLogin)))
+		mux.Handle(common.CallbackEndpoint, limit(auditHTTPHandler(a.auditLogger, auditActionLogin, nil, http.HandlerFunc(a.ssoClientApp.HandleCallback))))
+	}
+	// Each additional OIDC provider has its own login and callback endpoints, e.g. /auth/login/okta
+	for _, provider := range a.settings.OIDCProviders() {
+		clientApp, err := oidc.NewProviderClientApp(a.settings, provider, a.Cache, a.BaseHRef)
+		errors.CheckError(err)
+		mux.Handle(path.Join(common.LoginEndpoint, provider.Name), limit(http.HandlerFunc(clientApp.HandleLogin)))
+		mux.Handle(path.Join(common.CallbackEndpoint, provider.Name), limit(auditHTTPHandler(a.auditLogger, auditActionLogin, nil, http.HandlerFunc(clientApp.HandleCallback))))
+	}
 }
 
 // newRedirectServer returns an HTTP server which does a 307 redirect to the HTTPS server
@@ -916,6 +1019,30 @@ func mustRegisterGWHandler(register registerFunc, ctx context.Context, mux *runt
 	}
 }
 
+// authMiddleware authenticates the HTTP requests served outside of the gRPC gateway like the gateway requests, whose
+// metadata holds the Authorization and Cookie headers
+func (a *ArgoCDServer) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := metadata.MD{"authorization": r.Header.Values("Authorization"), "grpcgateway-cookie": r.Header.Values("Cookie")}
+		ctx, err := a.Authenticate(metadata.NewIncomingContext(r.Context(), md))
+		if err != nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authenticateAndAudit authenticates the gRPC requests, recording the failed authentications in the audit log. The
+// services overriding the authentication ignore the errors, so their requests are not recorded.
+func (a *ArgoCDServer) authenticateAndAudit(ctx context.Context) (context.Context, error) {
+	ctx, err := a.Authenticate(ctx)
+	if err != nil {
+		auditAuthenticationFailure(a.auditLogger, ctx, err)
+	}
+	return ctx, err
+}
+
 // Authenticate checks for the presence of a valid token when accessing server-side resources.
 func (a *ArgoCDServer) Authenticate(ctx context.Context) (context.Context, error) {
 	if a.DisableAuth {
@@ -926,7 +1053,7 @@ func (a *ArgoCDServer) Authenticate(ctx context.Context) (context.Context, error
 		// Add claims to the context to inspect for RBAC
 		// nolint:staticcheck
 		ctx = context.WithValue(ctx, "claims", claims)
-		if newToken != "" {
+		if newToken != "" && grpc.ServerTransportStreamFromContext(ctx) != nil {
 			// Session tokens that are expiring soon should be regenerated if user stays active.
 			// The renewed token is stored in outgoing ServerMetadata. Metadata is available to grpc-gateway
 			// response forwarder that will translate it into Set-Cookie header.
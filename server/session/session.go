@@ -13,6 +13,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	util "github.com/argoproj/argo-cd/v2/util/io"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
 )
 
@@ -90,10 +91,16 @@ func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (c
 }
 
 func (s *Server) GetUserInfo(ctx context.Context, q *session.GetUserInfoRequest) (*session.GetUserInfoResponse, error) {
+	var groups []string
+	if claims := jwtutil.Claims(ctx.Value("claims")); claims != nil {
+		if mapClaims, err := jwtutil.MapClaims(claims); err == nil {
+			groups = s.policyEnf.GetGroups(mapClaims)
+		}
+	}
 	return &session.GetUserInfoResponse{
 		LoggedIn: sessionmgr.LoggedIn(ctx),
 		Username: sessionmgr.Username(ctx),
 		Iss:      sessionmgr.Iss(ctx),
-		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes()),
+		Groups:   groups,
 	}, nil
 }
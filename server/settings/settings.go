@@ -42,6 +42,10 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := s.mgr.GetTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
 	argoCDSettings, err := s.mgr.GetSettings()
 	if err != nil {
 		return nil, err
@@ -100,6 +104,7 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 		KustomizeVersions:  kustomizeVersions,
 		UiCssURL:           argoCDSettings.UiCssURL,
 		PasswordPattern:    argoCDSettings.PasswordPattern,
+		TrackingMethod:     trackingMethod,
 	}
 
 	if sessionmgr.LoggedIn(ctx) || s.disableAuth {
@@ -114,6 +119,10 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 		set.ConfigManagementPlugins = tools
 		set.UiBannerContent = argoCDSettings.UiBannerContent
 		set.UiBannerURL = argoCDSettings.UiBannerURL
+		set.ExecEnabled, err = s.mgr.IsExecEnabled()
+		if err != nil {
+			return nil, err
+		}
 	}
 	if argoCDSettings.DexConfig != "" {
 		var cfg settingspkg.DexConfig
@@ -123,20 +132,30 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 		}
 	}
 	if oidcConfig := argoCDSettings.OIDCConfig(); oidcConfig != nil {
-		set.OIDCConfig = &settingspkg.OIDCConfig{
-			Name:        oidcConfig.Name,
-			Issuer:      oidcConfig.Issuer,
-			ClientID:    oidcConfig.ClientID,
-			CLIClientID: oidcConfig.CLIClientID,
-			Scopes:      oidcConfig.RequestedScopes,
-		}
-		if len(argoCDSettings.OIDCConfig().RequestedIDTokenClaims) > 0 {
-			set.OIDCConfig.IDTokenClaims = argoCDSettings.OIDCConfig().RequestedIDTokenClaims
-		}
+		set.OIDCConfig = toOIDCConfig(oidcConfig)
+	}
+	providers := argoCDSettings.OIDCProviders()
+	for i := range providers {
+		set.OIDCProviders = append(set.OIDCProviders, toOIDCConfig(&providers[i]))
 	}
 	return &set, nil
 }
 
+// toOIDCConfig returns the public part of the given OIDC provider config
+func toOIDCConfig(oidcConfig *settings.OIDCConfig) *settingspkg.OIDCConfig {
+	res := &settingspkg.OIDCConfig{
+		Name:        oidcConfig.Name,
+		Issuer:      oidcConfig.Issuer,
+		ClientID:    oidcConfig.ClientID,
+		CLIClientID: oidcConfig.CLIClientID,
+		Scopes:      oidcConfig.RequestedScopes,
+	}
+	if len(oidcConfig.RequestedIDTokenClaims) > 0 {
+		res.IDTokenClaims = oidcConfig.RequestedIDTokenClaims
+	}
+	return res
+}
+
 func (s *Server) plugins() ([]*settingspkg.Plugin, error) {
 	in, err := s.mgr.GetConfigManagementPlugins()
 	if err != nil {
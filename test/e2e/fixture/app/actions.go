@@ -58,6 +58,12 @@ func (a *Actions) AddFile(fileName, fileContents string) *Actions {
 	return a
 }
 
+func (a *Actions) AddSopsEncryptedFile(fileName, fileContents string) *Actions {
+	a.context.t.Helper()
+	fixture.AddSopsEncryptedFile(a.context.path+"/"+fileName, fileContents)
+	return a
+}
+
 func (a *Actions) AddSignedFile(fileName, fileContents string) *Actions {
 	a.context.t.Helper()
 	fixture.AddSignedFile(a.context.path+"/"+fileName, fileContents)
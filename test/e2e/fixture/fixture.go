@@ -100,6 +100,8 @@ const (
 	GitUsername                     = "admin"
 	GitPassword                     = "password"
 	GpgGoodKeyID                    = "D56C4FCA57A46444"
+	GpgGoodKeyFingerprint           = "EA459B49595CBE3FD1FBA303D56C4FCA57A46444"
+	SopsKeySet                      = "e2e"
 	HelmOCIRegistryURL              = "localhost:5000/myrepo"
 )
 
@@ -559,6 +561,17 @@ func EnsureCleanState(t *testing.T) {
 		FailOnErr(Run("", "chmod", "0700", TmpDir+"/app/config/gpg/keys"))
 	}
 
+	// recreate the SOPS key set holding the GnuPG key of the tests
+	if IsLocal() {
+		sopsGnuPGHome := TmpDir + "/app/config/sops/" + SopsKeySet + "/.gnupg"
+		FailOnErr(Run("", "rm", "-rf", TmpDir+"/app/config/sops"))
+		FailOnErr(Run("", "mkdir", "-p", sopsGnuPGHome))
+		FailOnErr(Run("", "chmod", "0700", sopsGnuPGHome))
+		os.Setenv("GNUPGHOME", sopsGnuPGHome)
+		FailOnErr(Run("", "gpg", "--import", "../fixture/gpg/signingkey.asc"))
+		os.Setenv("GNUPGHOME", prevGnuPGHome)
+	}
+
 	// set-up tmp repo, must have unique name
 	FailOnErr(Run("", "cp", "-Rf", "testdata", repoDirectory()))
 	FailOnErr(Run(repoDirectory(), "chmod", "777", "."))
@@ -661,6 +674,23 @@ func AddFile(path, contents string) {
 	}
 }
 
+// AddSopsEncryptedFile adds the given YAML file to the repository, with its data encrypted by SOPS with the GnuPG key of
+// the tests
+func AddSopsEncryptedFile(path, contents string) {
+	WriteFile(path, contents)
+
+	prevGnuPGHome := os.Getenv("GNUPGHOME")
+	os.Setenv("GNUPGHOME", TmpDir+"/gpg")
+	FailOnErr(Run(repoDirectory(), "sops", "--encrypt", "--in-place", "--encrypted-regex", "^(data|stringData)$", "--pgp", GpgGoodKeyFingerprint, path))
+	os.Setenv("GNUPGHOME", prevGnuPGHome)
+	FailOnErr(Run(repoDirectory(), "git", "add", "."))
+	FailOnErr(Run(repoDirectory(), "git", "commit", "-am", "add encrypted file"))
+
+	if IsRemote() {
+		FailOnErr(Run(repoDirectory(), "git", "push", "-f", "origin", "master"))
+	}
+}
+
 func AddSignedFile(path, contents string) {
 	WriteFile(path, contents)
 
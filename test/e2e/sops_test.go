@@ -0,0 +1,82 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	. "github.com/argoproj/argo-cd/v2/test/e2e/fixture"
+	. "github.com/argoproj/argo-cd/v2/test/e2e/fixture/app"
+	. "github.com/argoproj/argo-cd/v2/util/errors"
+)
+
+// TestSopsDecryption tests that the SOPS encrypted manifests are decrypted with the key set of the project of the
+// application, and that the manifests decrypted for a project are not served from the cache once the project no longer
+// has the key set
+func TestSopsDecryption(t *testing.T) {
+	if IsRemote() {
+		// the key set of the tests is only created for the local repo server
+		t.SkipNow()
+	}
+	Given(t).
+		ProjectSpec(AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []ApplicationDestination{{Namespace: "*", Server: "*"}},
+			SopsKeySet:   SopsKeySet,
+		}).
+		Path("sops").
+		When().
+		AddSopsEncryptedFile("secret.yaml", `apiVersion: v1
+kind: Secret
+metadata:
+  name: my-secret
+stringData:
+  password: my-password
+data:
+  token: bXktdG9rZW4=
+`).
+		Create().
+		Sync().
+		Then().
+		Expect(OperationPhaseIs(OperationSucceeded)).
+		Expect(SyncStatusIs(SyncStatusCodeSynced)).
+		And(func(app *Application) {
+			secret, err := KubeClientset.CoreV1().Secrets(DeploymentNamespace()).Get(context.Background(), "my-secret", metav1.GetOptions{})
+			CheckError(err)
+			assert.Equal(t, "my-token", string(secret.Data["token"]))
+			assert.Equal(t, "my-password", string(secret.Data["password"]))
+
+			manifests, err := RunCli("app", "manifests", app.Name, "--source", "git")
+			CheckError(err)
+			assert.NotContains(t, manifests, "ENC[")
+		}).
+		Given().
+		ProjectSpec(AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []ApplicationDestination{{Namespace: "*", Server: "*"}},
+		}).
+		When().
+		Then().
+		And(func(app *Application) {
+			manifests, err := RunCli("app", "manifests", app.Name, "--source", "git")
+			CheckError(err)
+			assert.Contains(t, manifests, "ENC[")
+			assert.NotContains(t, manifests, "my-password")
+			assert.NotContains(t, manifests, "bXktdG9rZW4=")
+		}).
+		Given().
+		ProjectSpec(AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []ApplicationDestination{{Namespace: "*", Server: "*"}},
+			SopsKeySet:   "other",
+		}).
+		When().
+		IgnoreErrors().
+		Refresh(RefreshTypeNormal).
+		Then().
+		Expect(Condition(ApplicationConditionComparisonError, "SOPS key set 'other' does not exist"))
+}
@@ -6,6 +6,7 @@ import {YamlEditor} from '../../../shared/components';
 import * as models from '../../../shared/models';
 import {services} from '../../../shared/services';
 import {ApplicationResourcesDiff} from '../application-resources-diff/application-resources-diff';
+import {DeepLinks, useDeepLinks} from '../deep-links';
 import {ComparisonStatusIcon, getPodStateReason, HealthStatusIcon} from '../utils';
 
 require('./application-node-info.scss');
@@ -16,6 +17,13 @@ export const ApplicationNodeInfo = (props: {
     live: models.State;
     controlled: {summary: models.ResourceStatus; state: models.ResourceDiff};
 }) => {
+    const links = useDeepLinks(() => services.applications.getResourceLinks(props.application.metadata.name, props.node), [
+        props.application.metadata.name,
+        props.node.group,
+        props.node.kind,
+        props.node.namespace,
+        props.node.name
+    ]);
     const attributes: {title: string; value: any}[] = [
         {title: 'KIND', value: props.node.kind},
         {title: 'NAME', value: props.node.name},
@@ -45,6 +53,9 @@ export const ApplicationNodeInfo = (props: {
         });
     }
     if (props.live) {
+        if (links.length > 0) {
+            attributes.push({title: 'LINKS', value: <DeepLinks links={links} />});
+        }
         if (props.node.kind === 'Pod') {
             const {reason, message} = getPodStateReason(props.live);
             attributes.push({title: 'STATE', value: reason});
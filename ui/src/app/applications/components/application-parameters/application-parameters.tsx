@@ -216,6 +216,32 @@ export const ApplicationParameters = (props: {
             );
         }
     } else if (props.details.type === 'Helm' && props.details.helm) {
+        const helmDetails = props.details.helm;
+        if (helmDetails.name) {
+            attributes.push({
+                title: 'CHART',
+                view: (
+                    <span title={helmDetails.description}>
+                        {helmDetails.name} {helmDetails.chartVersion}
+                        {helmDetails.appVersion && ` (app version ${helmDetails.appVersion})`}
+                    </span>
+                )
+            });
+        }
+        if ((helmDetails.dependencies || []).length > 0) {
+            attributes.push({
+                title: 'DEPENDENCIES',
+                view: (
+                    <div>
+                        {helmDetails.dependencies.map(dep => (
+                            <div key={dep.alias || dep.name}>
+                                {dep.alias ? `${dep.alias} (${dep.name})` : dep.name} {dep.lockedVersion || dep.version} {dep.repository && `from ${dep.repository}`}
+                            </div>
+                        ))}
+                    </div>
+                )
+            });
+        }
         attributes.push({
             title: 'VALUES FILES',
             view: (app.spec.source.helm && (app.spec.source.helm.valueFiles || []).join(', ')) || 'No values files selected',
@@ -324,6 +350,11 @@ export const ApplicationParameters = (props: {
             view: (!!directory.recurse).toString(),
             edit: (formApi: FormApi) => <FormField formApi={formApi} field='spec.source.directory.recurse' component={CheckboxField} />
         });
+        attributes.push({
+            title: 'SUBSTITUTE ENVIRONMENT VARIABLES',
+            view: (!!directory.envsubst).toString(),
+            edit: (formApi: FormApi) => <FormField formApi={formApi} field='spec.source.directory.envsubst' component={CheckboxField} />
+        });
         attributes.push({
             title: 'TOP-LEVEL ARGUMENTS',
             view: ((directory.jsonnet && directory.jsonnet.tlas) || []).map((i, j) => (
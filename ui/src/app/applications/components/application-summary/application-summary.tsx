@@ -9,6 +9,7 @@ import {services} from '../../../shared/services';
 
 import * as moment from 'moment';
 import {ApplicationSyncOptionsField} from '../application-sync-options/application-sync-options';
+import {DeepLinks, useDeepLinks} from '../deep-links';
 import {RevisionFormField} from '../revision-form-field/revision-form-field';
 import {ComparisonStatusIcon, HealthStatusIcon, syncStatusMessage} from '../utils';
 
@@ -34,6 +35,7 @@ export const ApplicationSummary = (props: {app: models.Application; updateApp: (
     const initialState = app.spec.destination.server === undefined ? 'NAME' : 'URL';
     const [destFormat, setDestFormat] = React.useState(initialState);
     const [changeSync, setChangeSync] = React.useState(false);
+    const links = useDeepLinks(() => services.applications.getLinks(app.metadata.name), [app.metadata.name]);
     const attributes = [
         {
             title: 'PROJECT',
@@ -282,6 +284,10 @@ export const ApplicationSummary = (props: {app: models.Application; updateApp: (
         });
     }
 
+    if (links.length > 0) {
+        attributes.push({title: 'LINKS', view: <DeepLinks links={links} />});
+    }
+
     if ((app.status.summary.images || []).length) {
         attributes.push({
             title: 'IMAGES',
@@ -3,8 +3,8 @@ import * as classNames from 'classnames';
 import * as React from 'react';
 import {Key, KeybindingContext, KeybindingProvider} from 'argo-ui/v2';
 import {RouteComponentProps} from 'react-router';
-import {combineLatest, from, merge, Observable} from 'rxjs';
-import {bufferTime, delay, filter, map, mergeMap, repeat, retryWhen} from 'rxjs/operators';
+import {combineLatest, defer, EMPTY, from, merge, Observable, Subject} from 'rxjs';
+import {bufferTime, delay, exhaustMap, filter, map, mergeMap, retryWhen} from 'rxjs/operators';
 import {AddAuthToToolbar, ClusterCtx, DataLoader, EmptyState, ObservableQuery, Page, Paginate, Query, Spinner} from '../../../shared/components';
 import {Consumer, Context, ContextApis} from '../../../shared/context';
 import * as models from '../../../shared/models';
@@ -38,48 +38,84 @@ const APP_FIELDS = [
     'status.operationState.operation.sync',
     'status.summary'
 ];
-const APP_LIST_FIELDS = ['metadata.resourceVersion', ...APP_FIELDS.map(field => `items.${field}`)];
-const APP_WATCH_FIELDS = ['result.type', ...APP_FIELDS.map(field => `result.application.${field}`)];
+const APP_LIST_FIELDS = ['metadata.resourceVersion', 'metadata.continue', ...APP_FIELDS.map(field => `items.${field}`)];
+// the applications are loaded in pages sorted by name, the next one when the user asks for more applications
+const APP_LIST_PAGE_SIZE = 500;
+// the watch needs the patches of the modified applications and the resource versions to resume
+const APP_WATCH_FIELDS = ['result.type', 'result.patch', 'result.application.metadata.resourceVersion', ...APP_FIELDS.map(field => `result.application.${field}`)];
 
-function loadApplications(): Observable<models.Application[]> {
-    return from(services.applications.list([], {fields: APP_LIST_FIELDS})).pipe(
-        mergeMap(applicationsList => {
-            const applications = applicationsList.items;
-            return merge(
-                from([applications]),
-                services.applications
-                    .watch({resourceVersion: applicationsList.metadata.resourceVersion}, {fields: APP_WATCH_FIELDS})
-                    .pipe(repeat())
-                    .pipe(retryWhen(errors => errors.pipe(delay(WATCH_RETRY_TIMEOUT))))
-                    // batch events to avoid constant re-rendering and improve UI performance
-                    .pipe(bufferTime(EVENTS_BUFFER_TIMEOUT))
-                    .pipe(
-                        map(appChanges => {
-                            appChanges.forEach(appChange => {
-                                const index = applications.findIndex(item => item.metadata.name === appChange.application.metadata.name);
-                                switch (appChange.type) {
-                                    case 'DELETED':
-                                        if (index > -1) {
-                                            applications.splice(index, 1);
-                                        }
-                                        break;
-                                    default:
-                                        if (index > -1) {
-                                            applications[index] = appChange.application;
-                                        } else {
-                                            applications.unshift(appChange.application);
-                                        }
-                                        break;
-                                }
-                            });
-                            return {applications, updated: appChanges.length > 0};
-                        })
-                    )
-                    .pipe(filter(item => item.updated))
-                    .pipe(map(item => item.applications))
-            );
-        })
-    );
+interface ApplicationsPages {
+    applications: models.Application[];
+    // continue is the token of the next page, empty once all the applications are loaded
+    continue: string;
+}
+
+function loadApplications(nextPage: Observable<void>): Observable<ApplicationsPages> {
+    // the pages loaded so far are listed again if the watch can't be resumed
+    let limit = APP_LIST_PAGE_SIZE;
+    return defer(() => from(services.applications.list([], {fields: APP_LIST_FIELDS, limit})))
+        .pipe(
+            mergeMap(applicationsList => {
+                const pages: ApplicationsPages = {applications: applicationsList.items, continue: applicationsList.metadata.continue};
+                const lastName = (items: models.Application[]) => (items.length > 0 ? items[items.length - 1].metadata.name : '');
+                let loadedUntil = lastName(applicationsList.items);
+                // the changes of the applications of the pages that are not loaded yet come with their page
+                const isLoaded = (name: string) => !pages.continue || name <= loadedUntil;
+                return merge(
+                    from([pages]),
+                    services.applications
+                        .watch({resourceVersion: applicationsList.metadata.resourceVersion}, {fields: APP_WATCH_FIELDS})
+                        // batch events to avoid constant re-rendering and improve UI performance
+                        .pipe(bufferTime(EVENTS_BUFFER_TIMEOUT))
+                        .pipe(
+                            map(appChanges => {
+                                appChanges = appChanges.filter(appChange => isLoaded(appChange.application.metadata.name));
+                                appChanges.forEach(appChange => {
+                                    const index = pages.applications.findIndex(item => item.metadata.name === appChange.application.metadata.name);
+                                    switch (appChange.type) {
+                                        case 'DELETED':
+                                            if (index > -1) {
+                                                pages.applications.splice(index, 1);
+                                            }
+                                            break;
+                                        default:
+                                            if (index > -1) {
+                                                pages.applications[index] = appChange.application;
+                                            } else {
+                                                pages.applications.unshift(appChange.application);
+                                            }
+                                            break;
+                                    }
+                                });
+                                return {pages, updated: appChanges.length > 0};
+                            })
+                        )
+                        .pipe(filter(item => item.updated))
+                        .pipe(map(item => item.pages)),
+                    nextPage
+                        .pipe(
+                            exhaustMap(() =>
+                                pages.continue
+                                    ? from(services.applications.list([], {fields: APP_LIST_FIELDS, limit: APP_LIST_PAGE_SIZE, continue: pages.continue}))
+                                    : EMPTY
+                            )
+                        )
+                        .pipe(
+                            map(page => {
+                                // the watch already added the applications created since the first page was listed
+                                const names = new Set(pages.applications.map(item => item.metadata.name));
+                                pages.applications.push(...page.items.filter(item => !names.has(item.metadata.name)));
+                                pages.continue = page.metadata.continue;
+                                loadedUntil = lastName(page.items) || loadedUntil;
+                                limit = Math.max(limit, pages.applications.length);
+                                return pages;
+                            })
+                        )
+                );
+            })
+        )
+        // list the applications again if the watch can't be resumed
+        .pipe(retryWhen(errors => errors.pipe(delay(WATCH_RETRY_TIMEOUT))));
 }
 
 const ViewPref = ({children}: {children: (pref: AppsListPreferences & {page: number; search: string}) => React.ReactNode}) => (
@@ -285,16 +321,17 @@ export const ApplicationsList = (props: RouteComponentProps<{}>) => {
     const clusters = React.useMemo(() => services.clusters.list(), []);
     const [isAppCreatePending, setAppCreatePending] = React.useState(false);
     const loaderRef = React.useRef<DataLoader>();
+    const nextPage = React.useMemo(() => new Subject<void>(), []);
 
     function refreshApp(appName: string) {
         // app refreshing might be done too quickly so that UI might miss it due to event batching
         // add refreshing annotation in the UI to improve user experience
         if (loaderRef.current) {
-            const applications = loaderRef.current.getData() as models.Application[];
-            const app = applications.find(item => item.metadata.name === appName);
+            const pages = loaderRef.current.getData() as ApplicationsPages;
+            const app = pages.applications.find(item => item.metadata.name === appName);
             if (app) {
                 AppUtils.setAppRefreshing(app);
-                loaderRef.current.setData(applications);
+                loaderRef.current.setData(pages);
             }
         }
         services.applications.get(appName, 'normal');
@@ -320,13 +357,13 @@ export const ApplicationsList = (props: RouteComponentProps<{}>) => {
                         <Page title='Applications' toolbar={{breadcrumbs: [{title: 'Applications', path: '/applications'}]}} hideAuth={true}>
                             <DataLoader
                                 ref={loaderRef}
-                                load={() => AppUtils.handlePageVisibility(() => loadApplications())}
+                                load={() => AppUtils.handlePageVisibility(() => loadApplications(nextPage))}
                                 loadingRenderer={() => (
                                     <div className='argo-container'>
                                         <MockupList height={100} marginTop={30} />
                                     </div>
                                 )}>
-                                {(applications: models.Application[]) => (
+                                {({applications, continue: continueToken}: ApplicationsPages) => (
                                     <React.Fragment>
                                         <FlexTopBar
                                             toolbar={services.viewPreferences.getPreferences().pipe(
@@ -439,6 +476,14 @@ export const ApplicationsList = (props: RouteComponentProps<{}>) => {
                                                                         }
                                                                     </Paginate>
                                                                 )}
+                                                                {continueToken && (
+                                                                    <div className='applications-list__more'>
+                                                                        <button className='argo-button argo-button--base-o' onClick={() => nextPage.next()}>
+                                                                            Load more applications
+                                                                        </button>
+                                                                        <div>The filters and the summary only cover the {applications.length} loaded applications</div>
+                                                                    </div>
+                                                                )}
                                                             </ApplicationsFilter>
                                                         );
                                                     return (
@@ -0,0 +1,29 @@
+import * as React from 'react';
+
+import {LinkInfo} from '../../shared/models';
+
+export const DeepLinks = (props: {links: LinkInfo[]}) => (
+    <React.Fragment>
+        {props.links.map(link => (
+            <a key={link.title} href={link.url} target='_blank' rel='noopener noreferrer' title={link.description}>
+                <i className={`fa ${link.iconClass || 'fa-external-link-alt'}`} /> {link.title} &nbsp;
+            </a>
+        ))}
+    </React.Fragment>
+);
+
+// useDeepLinks returns the loaded deep links, which are empty while they load or if they fail to load
+export const useDeepLinks = (load: () => Promise<LinkInfo[]>, deps: React.DependencyList): LinkInfo[] => {
+    const [links, setLinks] = React.useState<LinkInfo[]>([]);
+    React.useEffect(() => {
+        let cancelled = false;
+        setLinks([]);
+        load()
+            .then(loaded => !cancelled && setLinks(loaded || []))
+            .catch(() => !cancelled && setLinks([]));
+        return () => {
+            cancelled = true;
+        };
+    }, deps);
+    return links;
+};
@@ -0,0 +1,140 @@
+import * as React from 'react';
+import requests from '../../../shared/services/requests';
+import {TerminalScreen} from './terminal-screen';
+
+import './pod-terminal-viewer.scss';
+
+export interface PodTerminalProps {
+    applicationName: string;
+    namespace: string;
+    podName: string;
+    containerName: string;
+}
+
+interface TerminalMessage {
+    operation: 'stdin' | 'stdout' | 'resize';
+    data?: string;
+    rows?: number;
+    cols?: number;
+}
+
+// the input sent for the keys which are not characters
+const KEY_SEQUENCES: {[key: string]: string} = {
+    Enter: '\r',
+    Backspace: '\x7f',
+    Tab: '\t',
+    Escape: '\x1b',
+    ArrowUp: '\x1b[A',
+    ArrowDown: '\x1b[B',
+    ArrowRight: '\x1b[C',
+    ArrowLeft: '\x1b[D',
+    Home: '\x1b[H',
+    End: '\x1b[F',
+    Delete: '\x1b[3~'
+};
+
+function keyInput(e: React.KeyboardEvent): string {
+    if (e.metaKey) {
+        return null;
+    }
+    if (e.ctrlKey && e.key.length === 1) {
+        // e.g. Ctrl+C is the end of text character
+        const code = e.key.toUpperCase().charCodeAt(0);
+        return code >= 64 && code < 96 ? String.fromCharCode(code - 64) : null;
+    }
+    return KEY_SEQUENCES[e.key] || (e.key.length === 1 ? e.key : null);
+}
+
+// PodTerminalViewer opens a shell in a container of a pod through the web terminal of the API server
+export const PodTerminalViewer = (props: PodTerminalProps) => {
+    const [screen, setScreen] = React.useState({lines: [''], cursor: 0});
+    const [status, setStatus] = React.useState<'connecting' | 'connected' | 'closed'>('connecting');
+    const socket = React.useRef<WebSocket>(null);
+    const container = React.useRef<HTMLPreElement>(null);
+    const charSize = React.useRef<HTMLSpanElement>(null);
+
+    const send = (msg: TerminalMessage) => {
+        if (socket.current && socket.current.readyState === WebSocket.OPEN) {
+            socket.current.send(JSON.stringify(msg));
+        }
+    };
+    const resize = () => {
+        if (container.current && charSize.current) {
+            const size = charSize.current.getBoundingClientRect();
+            const cols = Math.max(Math.floor(container.current.clientWidth / size.width) - 1, 1);
+            const rows = Math.max(Math.floor(container.current.clientHeight / size.height), 1);
+            send({operation: 'resize', cols, rows});
+        }
+    };
+
+    React.useEffect(() => {
+        const terminalScreen = new TerminalScreen();
+        const search = new URLSearchParams({appName: props.applicationName, namespace: props.namespace, podName: props.podName, container: props.containerName});
+        const protocol = window.location.protocol === 'https:' ? 'wss:' : 'ws:';
+        const ws = new WebSocket(`${protocol}//${window.location.host}${requests.toAbsURL('/terminal')}?${search.toString()}`);
+        socket.current = ws;
+        setStatus('connecting');
+        setScreen({lines: [''], cursor: 0});
+        ws.onopen = () => {
+            setStatus('connected');
+            resize();
+            if (container.current) {
+                container.current.focus();
+            }
+        };
+        ws.onmessage = e => {
+            const msg = JSON.parse(e.data) as TerminalMessage;
+            if (msg.operation === 'stdout') {
+                terminalScreen.write(msg.data);
+                setScreen({lines: terminalScreen.lines.slice(), cursor: terminalScreen.cursor});
+            }
+        };
+        ws.onclose = () => setStatus('closed');
+        window.addEventListener('resize', resize);
+        return () => {
+            window.removeEventListener('resize', resize);
+            ws.close();
+        };
+    }, [props.applicationName, props.namespace, props.podName, props.containerName]);
+
+    React.useEffect(() => {
+        if (container.current) {
+            container.current.scrollTop = container.current.scrollHeight;
+        }
+    }, [screen]);
+
+    const lastLine = screen.lines[screen.lines.length - 1].padEnd(screen.cursor + 1);
+    return (
+        <div className='pod-terminal-viewer'>
+            <pre
+                ref={container}
+                className='pod-terminal-viewer__screen'
+                tabIndex={0}
+                onKeyDown={e => {
+                    const input = keyInput(e);
+                    if (input !== null) {
+                        e.preventDefault();
+                        send({operation: 'stdin', data: input});
+                    }
+                }}
+                onPaste={e => {
+                    e.preventDefault();
+                    send({operation: 'stdin', data: e.clipboardData.getData('text')});
+                }}>
+                {screen.lines.slice(0, -1).map(line => line + '\n')}
+                {lastLine.slice(0, screen.cursor)}
+                {status === 'connected' ? <span className='pod-terminal-viewer__cursor'>{lastLine[screen.cursor]}</span> : lastLine[screen.cursor]}
+                {lastLine.slice(screen.cursor + 1)}
+                <span ref={charSize} className='pod-terminal-viewer__char-size'>
+                    X
+                </span>
+            </pre>
+            {status === 'connecting' && <div className='pod-terminal-viewer__status'>Connecting...</div>}
+            {status === 'closed' && (
+                <div className='pod-terminal-viewer__status'>
+                    The terminal session ended. The shell may have exited, timed out or failed to start, e.g. because the exec permission is missing.
+                </div>
+            )}
+        </div>
+    );
+};
@@ -0,0 +1,38 @@
+import {TerminalScreen} from './terminal-screen';
+
+test('line editing', () => {
+    const screen = new TerminalScreen();
+    screen.write('$ ls\r\nfoo  bar\r\n$ ');
+    expect(screen.lines).toEqual(['$ ls', 'foo  bar', '$ ']);
+    expect(screen.cursor).toBe(2);
+
+    // the shell echoes the backspaces as a cursor move and an erasure
+    screen.write('echoo');
+    screen.write('\b\x1b[K');
+    expect(screen.lines[2]).toBe('$ echo');
+
+    screen.write('\r$ cat');
+    expect(screen.lines[2]).toBe('$ cato');
+    screen.write('\x1b[K');
+    expect(screen.lines[2]).toBe('$ cat');
+});
+
+test('escape sequences', () => {
+    const screen = new TerminalScreen();
+    screen.write('\x1b]0;root@guestbook: /\x07\x1b[01;32mroot\x1b[00m:/# ');
+    expect(screen.lines).toEqual(['root:/# ']);
+
+    // the sequences split across the outputs are ignored once complete
+    screen.write('\x1b[01');
+    screen.write(';34mls\x1b[0m');
+    expect(screen.lines).toEqual(['root:/# ls']);
+
+    screen.write('\x1b[H\x1b[2J$ ');
+    expect(screen.lines).toEqual(['$ ']);
+});
+
+test('max lines', () => {
+    const screen = new TerminalScreen(2);
+    screen.write('1\r\n2\r\n3');
+    expect(screen.lines).toEqual(['2', '3']);
+});
@@ -0,0 +1,105 @@
+// a complete escape sequence: a control sequence, an operating system command or a character set selection
+const ESCAPE_SEQUENCE = /^\x1b(\[([0-9;?]*)[ -\/]*([@-~])|\][^\x07\x1b]*(\x07|\x1b\\)|[()][0-9A-Za-z]|[=>78DEM])/;
+// the beginning of an escape sequence whose end is in the next output
+const PARTIAL_ESCAPE_SEQUENCE = /^\x1b(\[[0-9;?]*[ -\/]*|\][^\x07\x1b]*\x1b?|[()])?$/;
+
+// TerminalScreen renders the output of a shell as lines of plain text. It handles the line editing of the shells, i.e.
+// the carriage returns, backspaces, cursor moves and erasures of the current line, and ignores the other escape sequences.
+export class TerminalScreen {
+    public lines: string[] = [''];
+    // cursor is the column of the cursor in the last line
+    public cursor = 0;
+    private pending = '';
+
+    constructor(private maxLines = 5000) {}
+
+    public write(output: string) {
+        const data = this.pending + output;
+        this.pending = '';
+        let i = 0;
+        while (i < data.length) {
+            const ch = data[i];
+            if (ch === '\x1b') {
+                const rest = data.slice(i);
+                const match = ESCAPE_SEQUENCE.exec(rest);
+                if (match) {
+                    if (match[3]) {
+                        this.control(match[3], match[2]);
+                    }
+                    i += match[0].length;
+                } else if (PARTIAL_ESCAPE_SEQUENCE.test(rest)) {
+                    this.pending = rest;
+                    return;
+                } else {
+                    i++;
+                }
+                continue;
+            }
+            switch (ch) {
+                case '\r':
+                    this.cursor = 0;
+                    break;
+                case '\n':
+                    this.newLine();
+                    break;
+                case '\b':
+                    this.cursor = Math.max(0, this.cursor - 1);
+                    break;
+                case '\x07':
+                    break;
+                default:
+                    this.put(ch);
+            }
+            i++;
+        }
+    }
+
+    private setLine(line: string) {
+        this.lines[this.lines.length - 1] = line;
+    }
+
+    private put(ch: string) {
+        const line = this.lines[this.lines.length - 1];
+        this.setLine(line.padEnd(this.cursor).slice(0, this.cursor) + ch + line.slice(this.cursor + 1));
+        this.cursor++;
+    }
+
+    private newLine() {
+        this.lines.push('');
+        this.cursor = 0;
+        if (this.lines.length > this.maxLines) {
+            this.lines.shift();
+        }
+    }
+
+    private control(command: string, params: string) {
+        const n = parseInt(params, 10) || 0;
+        const line = this.lines[this.lines.length - 1];
+        switch (command) {
+            case 'C':
+                this.cursor += Math.max(n, 1);
+                break;
+            case 'D':
+                this.cursor = Math.max(0, this.cursor - Math.max(n, 1));
+                break;
+            case 'G':
+                this.cursor = Math.max(0, n - 1);
+                break;
+            case 'K':
+                if (n === 0) {
+                    this.setLine(line.slice(0, this.cursor));
+                } else if (n === 1) {
+                    this.setLine(' '.repeat(this.cursor) + line.slice(this.cursor));
+                } else {
+                    this.setLine('');
+                }
+                break;
+            case 'J':
+                if (n === 2 || n === 3) {
+                    this.lines = [''];
+                    this.cursor = 0;
+                }
+                break;
+        }
+    }
+}
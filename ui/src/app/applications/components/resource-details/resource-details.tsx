@@ -14,6 +14,7 @@ import {ResourceTreeNode} from '../application-resource-tree/application-resourc
 import {ApplicationResourcesDiff} from '../application-resources-diff/application-resources-diff';
 import {ApplicationSummary} from '../application-summary/application-summary';
 import {PodsLogsViewer} from '../pod-logs-viewer/pod-logs-viewer';
+import {PodTerminalViewer} from '../pod-terminal-viewer/pod-terminal-viewer';
 import {ResourceIcon} from '../resource-icon';
 import {ResourceLabel} from '../resource-label';
 import * as AppUtils from '../utils';
@@ -40,7 +41,15 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
     const page = parseInt(new URLSearchParams(appContext.history.location.search).get('page'), 10) || 0;
     const untilTimes = (new URLSearchParams(appContext.history.location.search).get('untilTimes') || '').split(',') || [];
 
-    const getResourceTabs = (node: ResourceNode, state: State, podState: State, events: Event[], ExtensionComponent: React.ComponentType<ExtensionComponentProps>, tabs: Tab[]) => {
+    const getResourceTabs = (
+        node: ResourceNode,
+        state: State,
+        podState: State,
+        events: Event[],
+        execEnabled: boolean,
+        ExtensionComponent: React.ComponentType<ExtensionComponentProps>,
+        tabs: Tab[]
+    ) => {
         if (!node || node === undefined) {
             return [];
         }
@@ -114,6 +123,40 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
                 }
             ]);
         }
+        if (execEnabled && state && state.kind === 'Pod' && state.status && state.status.phase === 'Running') {
+            const containers: any[] = (state.spec && state.spec.containers) || [];
+            const containerIndex = selectedNodeInfo.container < containers.length ? selectedNodeInfo.container : 0;
+            tabs.push({
+                key: 'exec',
+                icon: 'fa fa-terminal',
+                title: 'TERMINAL',
+                content: (
+                    <div className='application-details__tab-content-full-height'>
+                        <div className='row' style={{height: '100%'}}>
+                            <div className='columns small-3 medium-2'>
+                                <p>CONTAINERS</p>
+                                {containers.map((container, i) => (
+                                    <div className='application-details__container' key={container.name} onClick={() => SelectNode(selectedNodeKey, i, 'exec', appContext)}>
+                                        {i === containerIndex && <i className='fa fa-angle-right' />}
+                                        <span title={container.name}>{container.name}</span>
+                                    </div>
+                                ))}
+                            </div>
+                            <div className='columns small-9 medium-10' style={{height: '100%'}}>
+                                {containers.length > 0 && (
+                                    <PodTerminalViewer
+                                        applicationName={application.metadata.name}
+                                        namespace={state.metadata.namespace}
+                                        podName={state.metadata.name}
+                                        containerName={containers[containerIndex].name}
+                                    />
+                                )}
+                            </div>
+                        </div>
+                    </div>
+                )
+            });
+        }
         if (ExtensionComponent && state) {
             tabs.push({
                 title: 'More',
@@ -242,7 +285,9 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
                             }
                         }
 
-                        return {controlledState, liveState, events, podState};
+                        const settings = await services.authService.settings();
+
+                        return {controlledState, liveState, events, podState, execEnabled: !!settings.execEnabled};
                     }}>
                     {data => (
                         <React.Fragment>
@@ -272,7 +317,7 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
                             </div>
                             <Tabs
                                 navTransparent={true}
-                                tabs={getResourceTabs(selectedNode, data.liveState, data.podState, data.events, extension?.component, [
+                                tabs={getResourceTabs(selectedNode, data.liveState, data.podState, data.events, data.execEnabled, extension?.component, [
                                     {
                                         title: 'SUMMARY',
                                         icon: 'fa fa-file-alt',
@@ -309,6 +309,55 @@ export const deletePopup = async (ctx: ContextApis, resource: ResourceTreeNode,
     );
 };
 
+const runResourceActionWithParamsPopup = (ctx: ContextApis, application: appModels.Application, resource: ResourceTreeNode, action: appModels.ResourceAction) => {
+    const params = action.params || [];
+    return ctx.popup.prompt(
+        `Execute '${action.name}' action`,
+        api => (
+            <div>
+                <p>
+                    Execute '{action.name}' action on {resource.kind} '{resource.name}' with the following parameters:
+                </p>
+                {params.map(param => (
+                    <div className='argo-form-row' key={param.name}>
+                        <FormField label={`${param.name} (${param.type || 'string'})`} formApi={api} field={`params.${param.name}`} component={Text} />
+                    </div>
+                ))}
+            </div>
+        ),
+        {
+            validate: vals => ({
+                params: params.reduce(
+                    (errors, param) => ({
+                        ...errors,
+                        [param.name]: param.default === undefined && !(vals.params || {})[param.name] && `${param.name} is required`
+                    }),
+                    {} as {[name: string]: string | boolean}
+                )
+            }),
+            submit: async (vals, _, close) => {
+                const values: {[name: string]: string} = {};
+                // the empty values of the typed parameters fall back to their default
+                params
+                    .filter(param => !!(vals.params || {})[param.name] || !param.type || param.type === 'string')
+                    .forEach(param => (values[param.name] = (vals.params || {})[param.name] || ''));
+                try {
+                    await services.applications.runResourceAction(application.metadata.name, resource, action.name, values);
+                    close();
+                } catch (e) {
+                    ctx.notifications.show({
+                        content: <ErrorNotification title='Unable to execute resource action' e={e} />,
+                        type: NotificationType.Error
+                    });
+                }
+            }
+        },
+        null,
+        null,
+        {params: params.reduce((values, param) => ({...values, [param.name]: param.default || ''}), {} as {[name: string]: string})}
+    );
+};
+
 export function renderResourceMenu(
     resource: ResourceTreeNode,
     application: appModels.Application,
@@ -356,6 +405,9 @@ export function renderResourceMenu(
                         title: action.name,
                         disabled: !!action.disabled,
                         action: async () => {
+                            if ((action.params || []).length > 0) {
+                                return runResourceActionWithParamsPopup(appContext.apis, application, resource, action);
+                            }
                             try {
                                 const confirmed = await appContext.apis.popup.confirm(
                                     `Execute '${action.name}' action?`,
@@ -101,19 +101,23 @@ export const AccountDetails = (props: RouteComponentProps<{name: string}>) => {
                                         <div className='argo-table-list'>
                                             <div className='argo-table-list__head'>
                                                 <div className='row'>
-                                                    <div className='columns small-4'>ID</div>
-                                                    <div className='columns small-4'>ISSUED AT</div>
-                                                    <div className='columns small-4'>EXPIRES AT</div>
+                                                    <div className='columns small-3'>ID</div>
+                                                    <div className='columns small-3'>ISSUED AT</div>
+                                                    <div className='columns small-3'>LAST USED</div>
+                                                    <div className='columns small-3'>EXPIRES AT</div>
                                                 </div>
                                             </div>
                                             {tokens.map(token => (
                                                 <div className='argo-table-list__row' key={token.id}>
                                                     <div className='row'>
-                                                        <div className='columns small-4'>{token.id}</div>
-                                                        <div className='columns small-4'>
+                                                        <div className='columns small-3'>{token.id}</div>
+                                                        <div className='columns small-3'>
                                                             <Timestamp date={token.issuedAt * 1000} />
                                                         </div>
-                                                        <div className='columns small-4'>
+                                                        <div className='columns small-3'>
+                                                            {(token.lastUsedAt && <Timestamp date={token.lastUsedAt * 1000} />) || <span>Never</span>}
+                                                        </div>
+                                                        <div className='columns small-3'>
                                                             {(token.expiresAt && <Timestamp date={token.expiresAt * 1000} />) || <span>Never</span>}
                                                             <i
                                                                 className='fa fa-times account-details__remove-token'
@@ -131,11 +131,12 @@ export interface Application {
     operation?: Operation;
 }
 
-export type WatchType = 'ADDED' | 'MODIFIED' | 'DELETED' | 'ERROR';
+export type WatchType = 'ADDED' | 'MODIFIED' | 'DELETED' | 'ERROR' | 'BOOKMARK';
 
 export interface ApplicationWatchEvent {
     type: WatchType;
     application: Application;
+    patch?: string;
 }
 
 export interface ComponentParameter {
@@ -232,6 +233,7 @@ interface ApplicationSourceJsonnet {
 
 export interface ApplicationSourceDirectory {
     recurse: boolean;
+    envsubst?: boolean;
     jsonnet?: ApplicationSourceJsonnet;
 }
 
@@ -409,6 +411,7 @@ export interface LogEntry {
     last: boolean;
     timeStampStr: string;
     podName: string;
+    containerName?: string;
 }
 
 // describes plugin settings
@@ -432,6 +435,9 @@ export interface AuthSettings {
     oidcConfig: {
         name: string;
     };
+    oidcProviders: {
+        name: string;
+    }[];
     help: {
         chatUrl: string;
         chatText: string;
@@ -442,6 +448,7 @@ export interface AuthSettings {
     uiCssURL: string;
     uiBannerContent: string;
     uiBannerURL: string;
+    execEnabled?: boolean;
 }
 
 export interface UserInfo {
@@ -583,6 +590,18 @@ export interface HelmAppSpec {
     values?: string;
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
+    chartVersion?: string;
+    appVersion?: string;
+    description?: string;
+    dependencies?: HelmChartDependency[];
+}
+
+export interface HelmChartDependency {
+    name: string;
+    version: string;
+    repository: string;
+    alias?: string;
+    lockedVersion?: string;
 }
 
 export interface KustomizeAppSpec {
@@ -714,9 +733,8 @@ export interface ManifestResponse {
 
 export interface ResourceActionParam {
     name: string;
-    value: string;
     type: string;
-    default: string;
+    default?: string;
 }
 
 export interface ResourceAction {
@@ -725,6 +743,13 @@ export interface ResourceAction {
     disabled: boolean;
 }
 
+export interface LinkInfo {
+    title: string;
+    url: string;
+    description?: string;
+    iconClass?: string;
+}
+
 export interface SyncWindowsState {
     windows: SyncWindow[];
 }
@@ -752,6 +777,7 @@ export interface Token {
     id: string;
     issuedAt: number;
     expiresAt: number;
+    lastUsedAt?: number;
 }
 
 export interface Account {
@@ -767,6 +793,7 @@ export interface GnuPGPublicKey {
     subType?: string;
     owner?: string;
     keyData?: string;
+    expiresAt?: models.Time;
 }
 
 export interface GnuPGPublicKeyList extends ItemsList<GnuPGPublicKey> {}
@@ -1,16 +1,25 @@
 import * as deepMerge from 'deepmerge';
-import {Observable} from 'rxjs';
-import {map, repeat, retry} from 'rxjs/operators';
+import {defer, EMPTY, Observable, throwError} from 'rxjs';
+import {catchError, filter, map, repeat} from 'rxjs/operators';
 
 import * as models from '../models';
 import requests from './requests';
 
+const jsonMergePatch = require('json-merge-patch');
+
 interface QueryOptions {
     fields: string[];
     exclude?: boolean;
     selector?: string;
 }
 
+interface ListOptions extends QueryOptions {
+    fieldSelector?: string;
+    sortBy?: string;
+    limit?: number;
+    continue?: string;
+}
+
 function optionsToSearch(options?: QueryOptions) {
     if (options) {
         return {fields: (options.exclude ? '-' : '') + options.fields.join(','), selector: options.selector || ''};
@@ -19,10 +28,18 @@ function optionsToSearch(options?: QueryOptions) {
 }
 
 export class ApplicationsService {
-    public list(projects: string[], options?: QueryOptions): Promise<models.ApplicationList> {
+    public list(projects: string[], options?: ListOptions): Promise<models.ApplicationList> {
+        const query: {[key: string]: any} = {project: projects, ...optionsToSearch(options)};
+        if (options) {
+            for (const key of ['fieldSelector', 'sortBy', 'limit', 'continue'] as (keyof ListOptions)[]) {
+                if (options[key]) {
+                    query[key] = options[key];
+                }
+            }
+        }
         return requests
             .get('/applications')
-            .query({project: projects, ...optionsToSearch(options)})
+            .query(query)
             .then(res => res.body as models.ApplicationList)
             .then(list => {
                 list.items = (list.items || []).map(app => this.parseAppFields(app));
@@ -128,34 +145,58 @@ export class ApplicationsService {
             .then(() => true);
     }
 
+    // watch receives the changes of the applications it already received as patches. It resumes from the latest resource version it received when
+    // the connection is lost, and fails if it can't connect or resume, e.g. because the resource version is too old.
+    // The fields of the options must include result.patch and result.application.metadata.resourceVersion.
     public watch(query?: {name?: string; resourceVersion?: string}, options?: QueryOptions): Observable<models.ApplicationWatchEvent> {
-        const search = new URLSearchParams();
-        if (query) {
-            if (query.name) {
+        let resourceVersion = query && query.resourceVersion;
+        return defer(() => {
+            const search = new URLSearchParams();
+            if (query && query.name) {
                 search.set('name', query.name);
             }
-            if (query.resourceVersion) {
-                search.set('resourceVersion', query.resourceVersion);
+            if (resourceVersion) {
+                search.set('resourceVersion', resourceVersion);
             }
-        }
-        if (options) {
-            const searchOptions = optionsToSearch(options);
-            search.set('fields', searchOptions.fields);
-            search.set('selector', searchOptions.selector);
-        }
-        const searchStr = search.toString();
-        const url = `/stream/applications${(searchStr && '?' + searchStr) || ''}`;
-        return requests
-            .loadEventSource(url)
-            .pipe(repeat())
-            .pipe(retry())
-            .pipe(map(data => JSON.parse(data).result as models.ApplicationWatchEvent))
-            .pipe(
-                map(watchEvent => {
-                    watchEvent.application = this.parseAppFields(watchEvent.application);
-                    return watchEvent;
-                })
-            );
+            if (options) {
+                const searchOptions = optionsToSearch(options);
+                search.set('fields', searchOptions.fields);
+                search.set('selector', searchOptions.selector);
+            }
+            search.set('incremental', 'true');
+            search.set('allowWatchBookmarks', 'true');
+            // the patches of a stream apply to the applications it sent
+            const applications = new Map<string, any>();
+            let received = false;
+            return requests
+                .loadEventSource(`/stream/applications?${search.toString()}`)
+                .pipe(
+                    map(data => {
+                        received = true;
+                        const watchEvent = JSON.parse(data).result as models.ApplicationWatchEvent;
+                        resourceVersion = watchEvent.application.metadata.resourceVersion || resourceVersion;
+                        return watchEvent;
+                    })
+                )
+                .pipe(filter(watchEvent => watchEvent.type !== 'BOOKMARK'))
+                .pipe(
+                    map(watchEvent => {
+                        const name = watchEvent.application.metadata.name;
+                        let app: any = watchEvent.application;
+                        if (watchEvent.type === 'DELETED') {
+                            applications.delete(name);
+                        } else {
+                            if (watchEvent.patch && applications.has(name)) {
+                                app = jsonMergePatch.apply(JSON.parse(JSON.stringify(applications.get(name))), JSON.parse(watchEvent.patch));
+                            }
+                            applications.set(name, app);
+                        }
+                        watchEvent.application = this.parseAppFields(JSON.parse(JSON.stringify(app)));
+                        return watchEvent;
+                    })
+                )
+                .pipe(catchError(err => (received ? EMPTY : throwError(err))));
+        }).pipe(repeat());
     }
 
     public sync(
@@ -254,9 +295,13 @@ export class ApplicationsService {
             .then(res => (res.body.actions as models.ResourceAction[]) || []);
     }
 
-    public runResourceAction(name: string, resource: models.ResourceNode, action: string): Promise<models.ResourceAction[]> {
+    public getLinks(name: string): Promise<models.LinkInfo[]> {
+        return requests.get(`/applications/${name}/links`).then(res => (res.body.items as models.LinkInfo[]) || []);
+    }
+
+    public getResourceLinks(name: string, resource: models.ResourceNode): Promise<models.LinkInfo[]> {
         return requests
-            .post(`/applications/${name}/resource/actions`)
+            .get(`/applications/${name}/resource/links`)
             .query({
                 namespace: resource.namespace,
                 resourceName: resource.name,
@@ -264,6 +309,20 @@ export class ApplicationsService {
                 kind: resource.kind,
                 group: resource.group
             })
+            .then(res => (res.body.items as models.LinkInfo[]) || []);
+    }
+
+    public runResourceAction(name: string, resource: models.ResourceNode, action: string, params: {[name: string]: string} = {}): Promise<models.ResourceAction[]> {
+        return requests
+            .post(`/applications/${name}/resource/actions`)
+            .query({
+                namespace: resource.namespace,
+                resourceName: resource.name,
+                version: resource.version,
+                kind: resource.kind,
+                group: resource.group,
+                parameters: Object.keys(params).map(param => `${param}=${params[param]}`)
+            })
             .send(JSON.stringify(action))
             .then(res => (res.body.actions as models.ResourceAction[]) || []);
     }
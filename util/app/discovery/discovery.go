@@ -1,20 +1,113 @@
 package discovery
 
 import (
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+	"github.com/argoproj/argo-cd/v2/util/helmfile"
 	"github.com/argoproj/argo-cd/v2/util/kustomize"
 )
 
-func Discover(root string) (map[string]string, error) {
+type discoverOpt struct {
+	// maxDepth is the maximum depth of the application directories below the root, not limited if less than 1
+	maxDepth int
+	// exclude are the glob patterns of the directories which are not walked
+	exclude []string
+}
+
+// DiscoverOpt is an option of Discover
+type DiscoverOpt func(*discoverOpt)
+
+// WithMaxDepth sets the maximum depth of the application directories below the root, e.g. 1 only discovers the
+// applications of the direct subdirectories of the root. The depth is not limited if less than 1.
+func WithMaxDepth(depth int) DiscoverOpt {
+	return func(o *discoverOpt) {
+		o.maxDepth = depth
+	}
+}
+
+// WithExclusions sets the glob patterns of the directories which are not walked. A pattern matches the path of a
+// directory relative to the root, e.g. docs/*, or its name, e.g. node_modules.
+func WithExclusions(patterns []string) DiscoverOpt {
+	return func(o *discoverOpt) {
+		o.exclude = patterns
+	}
+}
+
+// ValidateExclusions returns an error if one of the exclusion patterns is malformed
+func ValidateExclusions(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid exclusion pattern %s: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// depth returns the number of directories between the root and the directory, whose path is relative to the root
+func depth(dir string) int {
+	if dir == "." {
+		return 0
+	}
+	return strings.Count(dir, string(filepath.Separator)) + 1
+}
+
+// skipDir returns true if the directory, whose path is relative to the root, must not be walked
+func (o *discoverOpt) skipDir(dir string) (bool, error) {
+	if dir == "." {
+		return false, nil
+	}
+	base := filepath.Base(dir)
+	if base == ".git" {
+		return true, nil
+	}
+	// the components directory of a Ksonnet application is one level below the application directory
+	if d := depth(dir); o.maxDepth > 0 && d > o.maxDepth {
+		if d > o.maxDepth+1 || !strings.HasSuffix(base, "components") {
+			return true, nil
+		}
+	}
+	for _, pattern := range o.exclude {
+		for _, name := range []string{dir, base} {
+			matched, err := filepath.Match(pattern, name)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func Discover(root string, opts ...DiscoverOpt) (map[string]string, error) {
+	opt := &discoverOpt{}
+	for i := range opts {
+		opts[i](opt)
+	}
 	apps := make(map[string]string)
 	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 		if info.IsDir() {
+			dir, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			if skip, err := opt.skipDir(dir); err != nil {
+				return err
+			} else if skip {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 		dir, err := filepath.Rel(root, filepath.Dir(path))
@@ -25,9 +118,16 @@ func Discover(root string) (map[string]string, error) {
 		if base == "params.libsonnet" && strings.HasSuffix(dir, "components") {
 			apps[filepath.Dir(dir)] = "Ksonnet"
 		}
+		// only the files of the Ksonnet components directories are walked below the maximum depth
+		if opt.maxDepth > 0 && depth(dir) > opt.maxDepth {
+			return nil
+		}
 		if strings.HasSuffix(base, "Chart.yaml") {
 			apps[dir] = "Helm"
 		}
+		if base == helmfile.HelmfileName {
+			apps[dir] = "Helmfile"
+		}
 		if kustomize.IsKustomization(base) {
 			apps[dir] = "Kustomize"
 		}
@@ -47,3 +147,48 @@ func AppType(path string) (string, error) {
 	}
 	return "Directory", nil
 }
+
+// DiscoverPlugin returns the name of the first config management plugin whose discovery rules match the application
+// directory, or an empty string if no plugin matches. The discovery commands are run by the given runner.
+func DiscoverPlugin(path string, plugins []*v1alpha1.ConfigManagementPlugin, env []string, runner *executil.Runner) (string, error) {
+	for _, plugin := range plugins {
+		if plugin == nil || plugin.Discover == nil {
+			continue
+		}
+		matched, err := matchPlugin(path, plugin.Discover, env, runner)
+		if err != nil {
+			return "", err
+		}
+		if matched {
+			return plugin.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func matchPlugin(path string, discover *v1alpha1.ConfigManagementPluginDiscovery, env []string, runner *executil.Runner) (bool, error) {
+	if discover.FileName != "" {
+		matches, err := filepath.Glob(filepath.Join(path, discover.FileName))
+		if err != nil {
+			return false, err
+		}
+		if len(matches) > 0 {
+			return true, nil
+		}
+	}
+	if discover.Find != nil && len(discover.Find.Command) > 0 {
+		cmd := exec.Command(discover.Find.Command[0], append(discover.Find.Command[1:], discover.Find.Args...)...)
+		cmd.Env = env
+		cmd.Dir = path
+		out, err := runner.Run(cmd)
+		if err != nil {
+			// a failing command means the plugin does not apply to the directory
+			log.Debugf("plugin discovery command %v failed in %s: %v", discover.Find.Command, path, err)
+			return false, nil
+		}
+		if strings.TrimSpace(out) != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
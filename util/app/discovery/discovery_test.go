@@ -1,9 +1,15 @@
 package discovery
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func TestDiscover(t *testing.T) {
@@ -13,7 +19,50 @@ func TestDiscover(t *testing.T) {
 		"foo": "Kustomize",
 		"bar": "Ksonnet",
 		"baz": "Helm",
+		"qux": "Helmfile",
+	}, apps)
+}
+
+func TestDiscover_Options(t *testing.T) {
+	root, err := ioutil.TempDir("", "discovery")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	for _, file := range []string{
+		"apps/foo/kustomization.yaml",
+		"apps/nested/bar/Chart.yaml",
+		"apps/ks/components/params.libsonnet",
+		"docs/examples/kustomization.yaml",
+		"apps/node_modules/lib/Chart.yaml",
+		".git/Chart.yaml",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, filepath.Dir(file)), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(root, file), nil, 0644))
+	}
+
+	apps, err := Discover(root, WithMaxDepth(2), WithExclusions([]string{"docs/*", "node_modules"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"apps/foo": "Kustomize",
+		"apps/ks":  "Ksonnet",
 	}, apps)
+
+	apps, err = Discover(root)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"apps/foo":              "Kustomize",
+		"apps/nested/bar":       "Helm",
+		"apps/ks":               "Ksonnet",
+		"docs/examples":         "Kustomize",
+		"apps/node_modules/lib": "Helm",
+	}, apps)
+
+	_, err = Discover(root, WithExclusions([]string{"["}))
+	assert.Error(t, err)
+}
+
+func TestValidateExclusions(t *testing.T) {
+	assert.NoError(t, ValidateExclusions([]string{"docs/*", "node_modules"}))
+	assert.Error(t, ValidateExclusions([]string{"["}))
 }
 
 func TestAppType(t *testing.T) {
@@ -29,7 +78,42 @@ func TestAppType(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Helm", appType)
 
+	appType, err = AppType("./testdata/qux")
+	assert.NoError(t, err)
+	assert.Equal(t, "Helmfile", appType)
+
 	appType, err = AppType("./testdata")
 	assert.NoError(t, err)
 	assert.Equal(t, "Directory", appType)
 }
+
+func TestDiscoverPlugin(t *testing.T) {
+	plugins := []*v1alpha1.ConfigManagementPlugin{
+		{Name: "no-discovery"},
+		{Name: "helmfile", Discover: &v1alpha1.ConfigManagementPluginDiscovery{FileName: "helmfile.y*ml"}},
+		{Name: "helm", Discover: &v1alpha1.ConfigManagementPluginDiscovery{Find: &v1alpha1.Command{
+			Command: []string{"sh", "-c"},
+			Args:    []string{"test -f Chart.yaml && echo Chart.yaml"},
+		}}},
+		{Name: "empty-output", Discover: &v1alpha1.ConfigManagementPluginDiscovery{Find: &v1alpha1.Command{
+			Command: []string{"true"},
+		}}},
+	}
+
+	name, err := DiscoverPlugin("./testdata/qux", plugins, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "helmfile", name)
+
+	name, err = DiscoverPlugin("./testdata/baz", plugins, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "helm", name)
+
+	name, err = DiscoverPlugin("./testdata/foo", plugins, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", name)
+
+	_, err = DiscoverPlugin("./testdata/foo", []*v1alpha1.ConfigManagementPlugin{
+		{Name: "invalid", Discover: &v1alpha1.ConfigManagementPluginDiscovery{FileName: "["}},
+	}, nil, nil)
+	assert.Error(t, err)
+}
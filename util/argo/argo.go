@@ -17,13 +17,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/typed/application/v1alpha1"
 	applicationsv1 "github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/jsonnet"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -60,6 +65,25 @@ func FilterByProjects(apps []argoappv1.Application, projects []string) []argoapp
 
 }
 
+// FilterByProjectsP returns application pointers which belongs to the specified project
+func FilterByProjectsP(apps []*argoappv1.Application, projects []string) []*argoappv1.Application {
+	if len(projects) == 0 {
+		return apps
+	}
+	projectsMap := make(map[string]bool)
+	for i := range projects {
+		projectsMap[projects[i]] = true
+	}
+	items := make([]*argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		a := apps[i]
+		if _, ok := projectsMap[a.Spec.GetProject()]; ok {
+			items = append(items, a)
+		}
+	}
+	return items
+}
+
 // FilterByRepo returns an application
 func FilterByRepo(apps []argoappv1.Application, repo string) []argoappv1.Application {
 	if repo == "" {
@@ -74,6 +98,52 @@ func FilterByRepo(apps []argoappv1.Application, repo string) []argoappv1.Applica
 	return items
 }
 
+// applicationFields returns the fields of the application which can be used in field selectors
+func applicationFields(app argoappv1.Application) fields.Set {
+	set := fields.Set{
+		"metadata.name":               app.Name,
+		"spec.project":                app.Spec.GetProject(),
+		"spec.source.repoURL":         app.Spec.Source.RepoURL,
+		"spec.source.path":            app.Spec.Source.Path,
+		"spec.source.chart":           app.Spec.Source.Chart,
+		"spec.source.targetRevision":  app.Spec.Source.TargetRevision,
+		"spec.destination.server":     app.Spec.Destination.Server,
+		"spec.destination.name":       app.Spec.Destination.Name,
+		"spec.destination.namespace":  app.Spec.Destination.Namespace,
+		"status.sync.status":          string(app.Status.Sync.Status),
+		"status.health.status":        string(app.Status.Health.Status),
+		"status.operationState.phase": "",
+	}
+	if app.Status.OperationState != nil {
+		set["status.operationState.phase"] = string(app.Status.OperationState.Phase)
+	}
+	return set
+}
+
+// FilterByFieldSelector returns the applications matching the given field selector
+func FilterByFieldSelector(apps []argoappv1.Application, selector string) ([]argoappv1.Application, error) {
+	if selector == "" {
+		return apps, nil
+	}
+	fieldSelector, err := fields.ParseSelector(selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid field selector '%s': %v", selector, err)
+	}
+	supported := applicationFields(argoappv1.Application{})
+	for _, requirement := range fieldSelector.Requirements() {
+		if !supported.Has(requirement.Field) {
+			return nil, status.Errorf(codes.InvalidArgument, "field '%s' is not supported in application field selectors", requirement.Field)
+		}
+	}
+	items := make([]argoappv1.Application, 0)
+	for i := 0; i < len(apps); i++ {
+		if fieldSelector.Matches(applicationFields(apps[i])) {
+			items = append(items, apps[i])
+		}
+	}
+	return items, nil
+}
+
 // FilterByName returns an application
 func FilterByName(apps []argoappv1.Application, name string) ([]argoappv1.Application, error) {
 	if name == "" {
@@ -154,16 +224,21 @@ func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerS
 	repo = repo.DeepCopy()
 	if isHelm {
 		repo.Type = "helm"
+	} else if oci.IsOCIRepo(repo.Repo) {
+		repo.Type = "oci"
 	} else {
 		repo.Type = "git"
 	}
 	repo.EnableOCI = repo.EnableOCI || isHelmOci
 
-	_, err := repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{
-		Repo: repo,
+	res, err := repoClient.TestRepository(ctx, &apiclient.TestRepositoryRequest{
+		Repo:     repo,
+		Diagnose: true,
 	})
-
-	return err
+	if err != nil {
+		return err
+	}
+	return res.Err()
 }
 
 // ValidateRepo validates the repository specified in application spec. Following is checked:
@@ -177,6 +252,7 @@ func ValidateRepo(
 	repoClientset apiclient.Clientset,
 	db db.ArgoDB,
 	kustomizeOptions *argoappv1.KustomizeOptions,
+	helmOptions *argoappv1.HelmOptions,
 	plugins []*argoappv1.ConfigManagementPlugin,
 	kubectl kube.Kubectl,
 	proj *argoappv1.AppProject,
@@ -234,12 +310,26 @@ func ValidateRepo(
 	if err != nil {
 		return nil, err
 	}
+	jsonnetLibRepos, err := GetJsonnetLibRepos(ctx, db, proj, spec.Source)
+	if err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: err.Error(),
+		})
+		return conditions, nil
+	}
+	helmGitDependencyRepos, err := GetHelmGitDependencyRepos(ctx, db, proj)
+	if err != nil {
+		return nil, err
+	}
 	// get the app details, and populate the Ksonnet stuff from it
 	appDetails, err := repoClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
 		Repo:             repo,
 		Source:           &spec.Source,
 		Repos:            permittedHelmRepos,
 		KustomizeOptions: kustomizeOptions,
+		Plugins:          plugins,
+		HelmOptions:      helmOptions,
 	})
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -269,7 +359,7 @@ func ValidateRepo(
 		return nil, err
 	}
 	conditions = append(conditions, verifyGenerateManifests(
-		ctx, repo, permittedHelmRepos, app, repoClient, kustomizeOptions, plugins, cluster.ServerVersion, APIGroupsToVersions(apiGroups), permittedHelmCredentials)...)
+		ctx, repo, permittedHelmRepos, app, repoClient, kustomizeOptions, helmOptions, plugins, cluster.ServerVersion, APIGroupsToVersions(apiGroups), permittedHelmCredentials, jsonnetLibRepos, helmGitDependencyRepos, proj.Spec.SopsKeySet, proj.Spec.ManifestPolicy)...)
 
 	return conditions, nil
 }
@@ -365,6 +455,32 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	} else if spec.Destination.Server == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionInvalidSpecError, Message: errDestinationMissing})
 	}
+
+	// Ensure the ignored differences, e.g. the JQ path expressions, can be evaluated during diffing
+	if _, err := normalizers.NewIgnoreNormalizer(spec.IgnoreDifferences, nil); err != nil {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("spec.ignoreDifferences is invalid: %v", err),
+		})
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Retry != nil {
+		if err := spec.SyncPolicy.Retry.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.retry is invalid: %v", err),
+			})
+		}
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.AutoRollback != nil {
+		if _, err := spec.SyncPolicy.AutoRollback.GetDuration(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.syncPolicy.autoRollback.duration is invalid: %v", err),
+			})
+		}
+	}
 	return conditions, nil
 }
 
@@ -407,7 +523,7 @@ func retrieveScopedClusters(name string, db db.ArgoDB, ctx context.Context) []*a
 	return clusters
 }
 
-//GetAppProject returns a project from an application
+// GetAppProject returns a project from an application
 func GetAppProjectWithScopedResources(name string, projLister applicationsv1.AppProjectLister, ns string, settingsManager *settings.SettingsManager, db db.ArgoDB, ctx context.Context) (*argoappv1.AppProject, argoappv1.Repositories, []*argoappv1.Cluster, error) {
 	projOrig, err := projLister.AppProjects(ns).Get(name)
 	if err != nil {
@@ -453,6 +569,80 @@ func GetAppProject(spec *argoappv1.ApplicationSpec, projLister applicationsv1.Ap
 	return GetAppProjectByName(spec.GetProject(), projLister, ns, settingsManager, db, ctx)
 }
 
+// NamespaceLabelsGetter returns the labels of a namespace of the given cluster, which are empty if the namespace does not
+// exist
+type NamespaceLabelsGetter func(ctx context.Context, cluster *argoappv1.Cluster, namespace string) (map[string]string, error)
+
+// GetLiveNamespaceLabels gets the labels of the namespace from the API server of the cluster. It is meant for the API
+// server, the controller gets them from its live state cache.
+func GetLiveNamespaceLabels(ctx context.Context, cluster *argoappv1.Cluster, namespace string) (map[string]string, error) {
+	clientset, err := kubernetes.NewForConfig(cluster.RESTConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error creating client of the destination cluster: %w", err)
+	}
+	ns, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return ns.Labels, nil
+}
+
+// GetSyncWindowsLabels returns the labels of the destination cluster and namespace of the application, which are
+// needed to match the cluster and namespace selectors of the given sync windows. Nothing is looked up if none of the
+// windows have a selector. The namespace labels are empty if the namespace does not exist yet.
+func GetSyncWindowsLabels(ctx context.Context, windows *argoappv1.SyncWindows, app *argoappv1.Application, db db.ArgoDB, getNamespaceLabels NamespaceLabelsGetter) (map[string]string, map[string]string, error) {
+	if !windows.HasSelectors() {
+		return nil, nil, nil
+	}
+	dest := app.Spec.Destination
+	if err := ValidateDestination(ctx, &dest, db); err != nil {
+		return nil, nil, err
+	}
+	cluster, err := db.GetCluster(ctx, dest.Server)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	clusterLabels := cluster.Labels
+	if clusterLabels == nil {
+		clusterLabels = map[string]string{}
+	}
+	namespaceLabels := map[string]string{}
+	if dest.Namespace == "" {
+		return clusterLabels, namespaceLabels, nil
+	}
+	labels, err := getNamespaceLabels(ctx, cluster, dest.Namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting destination namespace '%s': %w", dest.Namespace, err)
+	}
+	for k, v := range labels {
+		namespaceLabels[k] = v
+	}
+	return clusterLabels, namespaceLabels, nil
+}
+
+// ImpersonateServiceAccount sets the given configurations of the destination cluster of an application to impersonate
+// the service account of the destination if impersonation is enabled, so that the application is managed with the
+// permissions of the service account rather than the ones of the cluster credentials
+func ImpersonateServiceAccount(settingsMgr *settings.SettingsManager, proj *argoappv1.AppProject, dest argoappv1.ApplicationDestination, configs ...*rest.Config) error {
+	impersonationEnabled, err := settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return fmt.Errorf("failed to load the impersonation settings: %w", err)
+	}
+	if !impersonationEnabled {
+		return nil
+	}
+	userName, err := proj.ServiceAccountToImpersonate(dest)
+	if err != nil {
+		return fmt.Errorf("failed to find the service account to impersonate: %w", err)
+	}
+	for _, config := range configs {
+		config.Impersonate = rest.ImpersonationConfig{UserName: userName}
+	}
+	return nil
+}
+
 // verifyGenerateManifests verifies a repo path can generate manifests
 func verifyGenerateManifests(
 	ctx context.Context,
@@ -461,10 +651,15 @@ func verifyGenerateManifests(
 	app *argoappv1.Application,
 	repoClient apiclient.RepoServerServiceClient,
 	kustomizeOptions *argoappv1.KustomizeOptions,
+	helmOptions *argoappv1.HelmOptions,
 	plugins []*argoappv1.ConfigManagementPlugin,
 	kubeVersion string,
 	apiVersions []string,
 	repositoryCredentials []*argoappv1.RepoCreds,
+	jsonnetLibRepos []*argoappv1.Repository,
+	helmGitDependencyRepos []*argoappv1.Repository,
+	sopsKeySet string,
+	manifestPolicy *argoappv1.ManifestPolicy,
 ) []argoappv1.ApplicationCondition {
 	spec := &app.Spec
 	var conditions []argoappv1.ApplicationCondition
@@ -482,22 +677,27 @@ func verifyGenerateManifests(
 			Name:  repoRes.Name,
 			Proxy: repoRes.Proxy,
 		},
-		Repos:             helmRepos,
-		Revision:          spec.Source.TargetRevision,
-		AppName:           app.Name,
-		Namespace:         spec.Destination.Namespace,
-		ApplicationSource: &spec.Source,
-		Plugins:           plugins,
-		KustomizeOptions:  kustomizeOptions,
-		KubeVersion:       kubeVersion,
-		ApiVersions:       apiVersions,
-		HelmRepoCreds:     repositoryCredentials,
+		Repos:                  helmRepos,
+		Revision:               spec.Source.TargetRevision,
+		AppName:                app.Name,
+		Namespace:              spec.Destination.Namespace,
+		ApplicationSource:      &spec.Source,
+		Plugins:                plugins,
+		KustomizeOptions:       kustomizeOptions,
+		KubeVersion:            kubeVersion,
+		ApiVersions:            apiVersions,
+		HelmRepoCreds:          repositoryCredentials,
+		JsonnetLibRepos:        jsonnetLibRepos,
+		HelmGitDependencyRepos: helmGitDependencyRepos,
+		HelmOptions:            helmOptions,
+		SopsKeySet:             sopsKeySet,
+		ManifestPolicy:         manifestPolicy,
 	}
 	req.Repo.CopyCredentialsFromRepo(repoRes)
 	req.Repo.CopySettingsFrom(repoRes)
 
-	// Only check whether we can access the application's path,
-	// and not whether it actually contains any manifests.
+	// Only check whether we can access the application's path and whether its manifests comply with the manifest
+	// policy of the project, and not whether it actually contains any manifests.
 	_, err := repoClient.GenerateManifest(ctx, &req)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -590,6 +790,57 @@ func GetPermittedReposCredentials(proj *argoappv1.AppProject, repoCreds []*argoa
 	return permittedRepoCreds, nil
 }
 
+// GetJsonnetLibRepos returns the repositories of the Jsonnet libraries of other repositories referenced by the source,
+// with their credentials. The repositories must be permitted by the project.
+func GetJsonnetLibRepos(ctx context.Context, db db.ArgoDB, proj *argoappv1.AppProject, source argoappv1.ApplicationSource) ([]*argoappv1.Repository, error) {
+	if source.Directory == nil {
+		return nil, nil
+	}
+	var repos []*argoappv1.Repository
+	for _, l := range source.Directory.Jsonnet.Libs {
+		if !jsonnet.IsRemoteLib(l) {
+			continue
+		}
+		lib, err := jsonnet.ParseRemoteLib(l)
+		if err != nil {
+			return nil, err
+		}
+		if !proj.IsSourcePermitted(argoappv1.ApplicationSource{RepoURL: lib.RepoURL}) {
+			return nil, fmt.Errorf("jsonnet library repository %s is not permitted in project '%s'", lib.RepoURL, proj.Name)
+		}
+		repo, err := db.GetRepository(ctx, lib.RepoURL)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+// GetHelmGitDependencyRepos returns the Git repositories configured in Argo CD and permitted by the project, with
+// their credentials. The Helm chart dependencies hosted in Git repositories can only be fetched from them.
+func GetHelmGitDependencyRepos(ctx context.Context, db db.ArgoDB, proj *argoappv1.AppProject) ([]*argoappv1.Repository, error) {
+	repos, err := db.ListRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var gitRepos []*argoappv1.Repository
+	for _, r := range repos {
+		if r.Type != "" && r.Type != "git" {
+			continue
+		}
+		if !proj.IsSourcePermitted(argoappv1.ApplicationSource{RepoURL: r.Repo}) {
+			continue
+		}
+		repo, err := db.GetRepository(ctx, r.Repo)
+		if err != nil {
+			return nil, err
+		}
+		gitRepos = append(gitRepos, repo)
+	}
+	return gitRepos, nil
+}
+
 func GetPermittedRepos(proj *argoappv1.AppProject, repos []*argoappv1.Repository) ([]*argoappv1.Repository, error) {
 	var permittedRepos []*argoappv1.Repository
 	for _, v := range repos {
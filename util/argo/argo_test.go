@@ -8,6 +8,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/util/db"
 
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube/kubetest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -81,6 +83,37 @@ func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	assert.Equal(t, proj.Name, projName)
 }
 
+func TestImpersonateServiceAccount(t *testing.T) {
+	newSettingsMgr := func(data map[string]string) *settings.SettingsManager {
+		kubeClient := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "argocd-cm",
+				Namespace: test.FakeArgoCDNamespace,
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Data: data,
+		})
+		return settings.NewSettingsManager(context.Background(), kubeClient, test.FakeArgoCDNamespace)
+	}
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{DestinationServiceAccounts: []argoappv1.ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "guestbook", DefaultServiceAccount: "deployer"},
+	}}}
+	dest := argoappv1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}
+
+	config := &rest.Config{}
+	assert.NoError(t, ImpersonateServiceAccount(newSettingsMgr(nil), proj, dest, config))
+	assert.Empty(t, config.Impersonate.UserName)
+
+	settingsMgr := newSettingsMgr(map[string]string{"application.sync.impersonation.enabled": "true"})
+	rawConfig := &rest.Config{}
+	assert.NoError(t, ImpersonateServiceAccount(settingsMgr, proj, dest, config, rawConfig))
+	assert.Equal(t, "system:serviceaccount:guestbook:deployer", config.Impersonate.UserName)
+	assert.Equal(t, "system:serviceaccount:guestbook:deployer", rawConfig.Impersonate.UserName)
+
+	dest.Namespace = "other"
+	assert.Error(t, ImpersonateServiceAccount(settingsMgr, proj, dest, &rest.Config{}))
+}
+
 func TestContainsSyncResource(t *testing.T) {
 	var (
 		blankUnstructured unstructured.Unstructured
@@ -257,7 +290,8 @@ func TestValidateRepo(t *testing.T) {
 
 	repo.Type = "git"
 	repoClient.On("TestRepository", context.Background(), &apiclient.TestRepositoryRequest{
-		Repo: repo,
+		Repo:     repo,
+		Diagnose: true,
 	}).Return(&apiclient.TestRepositoryResponse{
 		VerifiedRepository: true,
 	}, nil)
@@ -270,6 +304,7 @@ func TestValidateRepo(t *testing.T) {
 	db.On("ListHelmRepositories", context.Background()).Return(helmRepos, nil)
 	db.On("GetCluster", context.Background(), app.Spec.Destination.Server).Return(cluster, nil)
 	db.On("GetAllHelmRepositoryCredentials", context.Background()).Return(nil, nil)
+	db.On("ListRepositories", context.Background()).Return([]*argoappv1.Repository{repo, {Repo: "https://charts.example.com", Type: "helm"}}, nil)
 
 	var receivedRequest *apiclient.ManifestRequest
 
@@ -278,7 +313,7 @@ func TestValidateRepo(t *testing.T) {
 		return true
 	})).Return(nil, nil)
 
-	conditions, err := ValidateRepo(context.Background(), app, repoClientSet, db, kustomizeOptions, nil, &kubetest.MockKubectlCmd{Version: kubeVersion, APIGroups: apiGroups}, proj)
+	conditions, err := ValidateRepo(context.Background(), app, repoClientSet, db, kustomizeOptions, nil, nil, &kubetest.MockKubectlCmd{Version: kubeVersion, APIGroups: apiGroups}, proj)
 
 	assert.NoError(t, err)
 	assert.Empty(t, conditions)
@@ -287,6 +322,68 @@ func TestValidateRepo(t *testing.T) {
 	assert.Equal(t, app.Spec.Destination.Namespace, receivedRequest.Namespace)
 	assert.Equal(t, &app.Spec.Source, receivedRequest.ApplicationSource)
 	assert.Equal(t, kustomizeOptions, receivedRequest.KustomizeOptions)
+	assert.Equal(t, []*argoappv1.Repository{repo}, receivedRequest.HelmGitDependencyRepos)
+}
+
+func TestGetHelmGitDependencyRepos(t *testing.T) {
+	permitted := &argoappv1.Repository{Repo: "https://github.com/argoproj/permitted", Username: "user", Password: "pass"}
+	db := &dbmocks.ArgoDB{}
+	db.On("ListRepositories", context.Background()).Return([]*argoappv1.Repository{
+		{Repo: permitted.Repo},
+		{Repo: "https://github.com/argoproj/other"},
+		{Repo: "https://github.com/argoproj/charts", Type: "helm"},
+	}, nil)
+	db.On("GetRepository", context.Background(), permitted.Repo).Return(permitted, nil)
+	proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SourceRepos: []string{"https://github.com/argoproj/permitted", "https://github.com/argoproj/charts"}}}
+
+	repos, err := GetHelmGitDependencyRepos(context.Background(), db, proj)
+	assert.NoError(t, err)
+	assert.Equal(t, []*argoappv1.Repository{permitted}, repos)
+}
+
+func TestGetSyncWindowsLabels(t *testing.T) {
+	app := &argoappv1.Application{Spec: argoappv1.ApplicationSpec{Destination: argoappv1.ApplicationDestination{Server: "https://cluster-api.com"}}}
+	db := &dbmocks.ArgoDB{}
+	db.On("GetCluster", context.Background(), "https://cluster-api.com").Return(&argoappv1.Cluster{
+		Server: "https://cluster-api.com",
+		Labels: map[string]string{"env": "prod"},
+	}, nil)
+
+	getNamespaceLabels := func(ctx context.Context, cluster *argoappv1.Cluster, namespace string) (map[string]string, error) {
+		assert.Equal(t, "https://cluster-api.com", cluster.Server)
+		if namespace == "payments" {
+			return map[string]string{"team": "payments"}, nil
+		}
+		return nil, fmt.Errorf("unexpected namespace %s", namespace)
+	}
+
+	t.Run("NoSelectors", func(t *testing.T) {
+		windows := argoappv1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Clusters: []string{"*"}}}
+		clusterLabels, namespaceLabels, err := GetSyncWindowsLabels(context.Background(), &windows, app, db, getNamespaceLabels)
+		assert.NoError(t, err)
+		assert.Nil(t, clusterLabels)
+		assert.Nil(t, namespaceLabels)
+	})
+	t.Run("ClusterSelector", func(t *testing.T) {
+		windows := argoappv1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", ClusterSelector: &metav1.LabelSelector{}}}
+		clusterLabels, namespaceLabels, err := GetSyncWindowsLabels(context.Background(), &windows, app, db, getNamespaceLabels)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"env": "prod"}, clusterLabels)
+		assert.Empty(t, namespaceLabels)
+	})
+	t.Run("NamespaceSelector", func(t *testing.T) {
+		windows := argoappv1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h", NamespaceSelector: &metav1.LabelSelector{}}}
+		nsApp := app.DeepCopy()
+		nsApp.Spec.Destination.Namespace = "payments"
+		clusterLabels, namespaceLabels, err := GetSyncWindowsLabels(context.Background(), &windows, nsApp, db, getNamespaceLabels)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"env": "prod"}, clusterLabels)
+		assert.Equal(t, map[string]string{"team": "payments"}, namespaceLabels)
+
+		nsApp.Spec.Destination.Namespace = "other"
+		_, _, err = GetSyncWindowsLabels(context.Background(), &windows, nsApp, db, getNamespaceLabels)
+		assert.Error(t, err)
+	})
 }
 
 func TestFormatAppConditions(t *testing.T) {
@@ -388,6 +485,59 @@ func TestFilterByRepo(t *testing.T) {
 	})
 }
 
+func TestFilterByFieldSelector(t *testing.T) {
+	apps := []argoappv1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app1"},
+			Spec: argoappv1.ApplicationSpec{
+				Destination: argoappv1.ApplicationDestination{Namespace: "default"},
+			},
+			Status: argoappv1.ApplicationStatus{
+				Sync:           argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeOutOfSync},
+				OperationState: &argoappv1.OperationState{Phase: synccommon.OperationFailed},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app2"},
+			Spec: argoappv1.ApplicationSpec{
+				Project:     "my-project",
+				Destination: argoappv1.ApplicationDestination{Namespace: "default"},
+			},
+			Status: argoappv1.ApplicationStatus{
+				Sync: argoappv1.SyncStatus{Status: argoappv1.SyncStatusCodeSynced},
+			},
+		},
+	}
+
+	t.Run("Empty filter", func(t *testing.T) {
+		res, err := FilterByFieldSelector(apps, "")
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		res, err := FilterByFieldSelector(apps, "status.sync.status=OutOfSync,spec.destination.namespace=default")
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "app1", res[0].Name)
+
+		res, err = FilterByFieldSelector(apps, "spec.project!=default")
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "app2", res[0].Name)
+
+		res, err = FilterByFieldSelector(apps, "status.operationState.phase=Failed")
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "app1", res[0].Name)
+	})
+
+	t.Run("Unsupported field", func(t *testing.T) {
+		_, err := FilterByFieldSelector(apps, "spec.source.helm.releaseName=foo")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestValidatePermissions(t *testing.T) {
 	t.Run("Empty Repo URL result in condition", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
@@ -565,6 +715,77 @@ func TestValidatePermissions(t *testing.T) {
 		_, err := ValidatePermissions(context.Background(), &spec, &proj, db)
 		assert.Error(t, err)
 	})
+
+	t.Run("Invalid JQ path expression result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    "guestbook",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "default",
+			},
+			IgnoreDifferences: []argoappv1.ResourceIgnoreDifferences{{
+				Group:             "apps",
+				Kind:              "Deployment",
+				JQPathExpressions: []string{".spec.template.spec.containers[] | select(.name == \"missing-quote)"},
+			}},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "default",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(&argoappv1.Cluster{Server: spec.Destination.Server}, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "failed to parse JQ path expression")
+	})
+
+	t.Run("Invalid auto rollback duration result in condition", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    "guestbook",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "default",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{
+				Automated:    &argoappv1.SyncPolicyAutomated{},
+				AutoRollback: &argoappv1.SyncPolicyAutoRollback{Duration: "5 minutes"},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "default",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(&argoappv1.Cluster{Server: spec.Destination.Server}, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "spec.syncPolicy.autoRollback.duration is invalid")
+	})
 }
 
 func TestSetAppOperations(t *testing.T) {
@@ -0,0 +1,187 @@
+package managedfields
+
+import (
+	"bytes"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
+)
+
+// DefaultArgoCDManagers are the field managers used when Argo CD applies or patches resources: client side apply run by
+// the application controller, server-side apply and the resource patches and actions run by the API server.
+var DefaultArgoCDManagers = []string{
+	"argocd-application-controller",
+	"argocd-controller",
+	"argocd-server",
+	"kubectl-client-side-apply",
+}
+
+// Normalize returns copies of the config and live objects without the fields which are owned in the live object by
+// field managers other than the given Argo CD managers (DefaultArgoCDManagers if none are given), e.g. the replicas managed by a HorizontalPodAutoscaler or the
+// sidecar containers injected by an admission webhook. Fields shared with an Argo CD manager are kept.
+func Normalize(config, live *unstructured.Unstructured, argoCDManagers []string) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	if live == nil || len(live.GetManagedFields()) == 0 {
+		return config, live, nil
+	}
+	ignored, err := otherManagersFields(live, argoCDManagers)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ignored.Empty() {
+		return config, live, nil
+	}
+
+	// the fields are removed in reverse order so that children are removed before their parents and list elements
+	// selected by index are removed from the last one
+	var paths []fieldpath.Path
+	ignored.Iterate(func(p fieldpath.Path) {
+		paths = append(paths, p.Copy())
+	})
+	normalize := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		if obj == nil {
+			return nil
+		}
+		obj = obj.DeepCopy()
+		for i := len(paths) - 1; i >= 0; i-- {
+			removePath(obj.Object, paths[i])
+		}
+		return obj
+	}
+	return normalize(config), normalize(live), nil
+}
+
+// NormalizeAll normalizes every pair of config and live objects of the given lists.
+func NormalizeAll(configs, lives []*unstructured.Unstructured, argoCDManagers []string) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+	if len(configs) != len(lives) {
+		return nil, nil, fmt.Errorf("config and live lists must have the same length")
+	}
+	normalizedConfigs := make([]*unstructured.Unstructured, len(configs))
+	normalizedLives := make([]*unstructured.Unstructured, len(lives))
+	for i := range configs {
+		config, live, err := Normalize(configs[i], lives[i], argoCDManagers)
+		if err != nil {
+			return nil, nil, err
+		}
+		normalizedConfigs[i] = config
+		normalizedLives[i] = live
+	}
+	return normalizedConfigs, normalizedLives, nil
+}
+
+// otherManagersFields returns the fields of the live object owned by other managers and not by any Argo CD manager.
+// Fields owned by other managers which contain fields owned by Argo CD, e.g. a labels map, are not returned.
+func otherManagersFields(live *unstructured.Unstructured, argoCDManagers []string) (*fieldpath.Set, error) {
+	if len(argoCDManagers) == 0 {
+		argoCDManagers = DefaultArgoCDManagers
+	}
+	isArgoCDManager := make(map[string]bool)
+	for _, manager := range argoCDManagers {
+		isArgoCDManager[manager] = true
+	}
+	own := &fieldpath.Set{}
+	others := &fieldpath.Set{}
+	for _, entry := range live.GetManagedFields() {
+		if entry.FieldsV1 == nil {
+			continue
+		}
+		fields := &fieldpath.Set{}
+		if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("failed to parse fields of manager '%s': %w", entry.Manager, err)
+		}
+		if isArgoCDManager[entry.Manager] {
+			own = own.Union(fields)
+		} else {
+			others = others.Union(fields)
+		}
+	}
+	res := &fieldpath.Set{}
+	others.Difference(own).Iterate(func(p fieldpath.Path) {
+		// the children of an ignored field are ignored with it, e.g. the name of an ignored container
+		for i := 1; i < len(p); i++ {
+			if res.Has(p[:i]) {
+				return
+			}
+		}
+		if children(own, p).Empty() {
+			res.Insert(p.Copy())
+		}
+	})
+	return res, nil
+}
+
+// children returns the fields of the set under the given path.
+func children(set *fieldpath.Set, path fieldpath.Path) *fieldpath.Set {
+	for _, pe := range path {
+		set = set.WithPrefix(pe)
+	}
+	return set
+}
+
+// removePath removes the field identified by the given path from the unstructured object and returns the updated
+// object. Missing fields are ignored.
+func removePath(obj interface{}, path fieldpath.Path) interface{} {
+	if len(path) == 0 {
+		return obj
+	}
+	switch v := obj.(type) {
+	case map[string]interface{}:
+		if path[0].FieldName == nil {
+			return obj
+		}
+		name := *path[0].FieldName
+		child, ok := v[name]
+		if !ok {
+			return obj
+		}
+		if len(path) == 1 {
+			delete(v, name)
+		} else {
+			v[name] = removePath(child, path[1:])
+		}
+	case []interface{}:
+		i := listElementIndex(v, path[0])
+		if i < 0 {
+			return obj
+		}
+		if len(path) == 1 {
+			return append(v[:i:i], v[i+1:]...)
+		}
+		v[i] = removePath(v[i], path[1:])
+	}
+	return obj
+}
+
+// listElementIndex returns the index of the list element selected by the given path element, or -1 if there is none.
+func listElementIndex(list []interface{}, pe fieldpath.PathElement) int {
+	for i, item := range list {
+		switch {
+		case pe.Key != nil:
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			matches := true
+			for _, key := range *pe.Key {
+				field, ok := fields[key.Name]
+				if !ok || !value.Equals(value.NewValueInterface(field), key.Value) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return i
+			}
+		case pe.Value != nil:
+			if value.Equals(value.NewValueInterface(item), *pe.Value) {
+				return i
+			}
+		case pe.Index != nil:
+			if i == *pe.Index {
+				return i
+			}
+		}
+	}
+	return -1
+}
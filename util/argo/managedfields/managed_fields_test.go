@@ -0,0 +1,164 @@
+package managedfields
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const liveDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    app: guestbook
+    injected: "true"
+  managedFields:
+  - manager: argocd-application-controller
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          .: {}
+          f:app: {}
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"guestbook"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+  - manager: kube-controller-manager
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:spec:
+        f:replicas: {}
+  - manager: sidecar-injector
+    operation: Update
+    apiVersion: apps/v1
+    fieldsType: FieldsV1
+    fieldsV1:
+      f:metadata:
+        f:labels:
+          .: {}
+          f:injected: {}
+      f:spec:
+        f:template:
+          f:spec:
+            f:containers:
+              k:{"name":"proxy"}:
+                .: {}
+                f:image: {}
+                f:name: {}
+spec:
+  replicas: 5
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v1
+      - name: proxy
+        image: proxy:v1
+`
+
+const configDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    app: guestbook
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v1
+`
+
+func unmarshal(t *testing.T, data string) *unstructured.Unstructured {
+	jsonData, err := yaml.YAMLToJSON([]byte(data))
+	require.NoError(t, err)
+	var obj unstructured.Unstructured
+	require.NoError(t, obj.UnmarshalJSON(jsonData))
+	return &obj
+}
+
+func TestNormalize(t *testing.T) {
+	config := unmarshal(t, configDeployment)
+	live := unmarshal(t, liveDeployment)
+
+	normalizedConfig, normalizedLive, err := Normalize(config, live, nil)
+	require.NoError(t, err)
+
+	for _, obj := range []*unstructured.Unstructured{normalizedConfig, normalizedLive} {
+		_, has, err := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.False(t, has)
+		containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+		require.NoError(t, err)
+		assert.Len(t, containers, 1)
+		assert.Equal(t, map[string]string{"app": "guestbook"}, obj.GetLabels())
+	}
+
+	// the original objects are not modified
+	assert.Equal(t, unmarshal(t, configDeployment), config)
+	assert.Equal(t, unmarshal(t, liveDeployment), live)
+}
+
+func TestNormalize_SharedFieldsAreKept(t *testing.T) {
+	config := unmarshal(t, configDeployment)
+	live := unmarshal(t, liveDeployment)
+
+	_, normalizedLive, err := Normalize(config, live, []string{"argocd-application-controller", "kube-controller-manager"})
+	require.NoError(t, err)
+
+	replicas, has, err := unstructured.NestedInt64(normalizedLive.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.True(t, has)
+	assert.Equal(t, int64(5), replicas)
+	containers, _, err := unstructured.NestedSlice(normalizedLive.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Len(t, containers, 1)
+}
+
+func TestNormalize_NoManagedFields(t *testing.T) {
+	config := unmarshal(t, configDeployment)
+	live := unmarshal(t, configDeployment)
+
+	normalizedConfig, normalizedLive, err := Normalize(config, live, nil)
+	require.NoError(t, err)
+	assert.Same(t, config, normalizedConfig)
+	assert.Same(t, live, normalizedLive)
+
+	normalizedConfig, normalizedLive, err = Normalize(config, nil, nil)
+	require.NoError(t, err)
+	assert.Same(t, config, normalizedConfig)
+	assert.Nil(t, normalizedLive)
+}
+
+func TestNormalizeAll(t *testing.T) {
+	configs := []*unstructured.Unstructured{unmarshal(t, configDeployment), nil}
+	lives := []*unstructured.Unstructured{unmarshal(t, liveDeployment), unmarshal(t, liveDeployment)}
+
+	normalizedConfigs, normalizedLives, err := NormalizeAll(configs, lives, nil)
+	require.NoError(t, err)
+	assert.Len(t, normalizedConfigs, 2)
+	assert.Nil(t, normalizedConfigs[1])
+	_, has, err := unstructured.NestedInt64(normalizedLives[1].Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	_, _, err = NormalizeAll(configs, lives[:1], nil)
+	assert.Error(t, err)
+}
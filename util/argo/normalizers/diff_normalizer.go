@@ -131,11 +131,11 @@ func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides
 		for _, pathExpression := range ignore[i].JQPathExpressions {
 			jqDeletionQuery, err := gojq.Parse(fmt.Sprintf("del(%s)", pathExpression))
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("failed to parse JQ path expression '%s': %w", pathExpression, err)
 			}
 			jqDeletionCode, err := gojq.Compile(jqDeletionQuery)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("failed to compile JQ path expression '%s': %w", pathExpression, err)
 			}
 			patches = append(patches, &jqNormalizerPatch{
 				baseNormalizerPatch: baseNormalizerPatch{
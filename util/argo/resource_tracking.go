@@ -0,0 +1,126 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	argokube "github.com/argoproj/argo-cd/v2/util/kube"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+// AppInstanceValue is the value of the tracking annotation: the application which a resource belongs to and the
+// identity of the resource, so that the copies of the resource, e.g. made by other tools, are not tracked
+type AppInstanceValue struct {
+	ApplicationName string
+	Group           string
+	Kind            string
+	Namespace       string
+	Name            string
+}
+
+// GetTrackingMethod returns the resource tracking method of the settings, the label method if it is not set or invalid
+func GetTrackingMethod(settingsMgr *settings.SettingsManager) argoappv1.TrackingMethod {
+	method, err := settingsMgr.GetTrackingMethod()
+	if err != nil {
+		log.Warnf("Failed to get the resource tracking method, using the label method: %v", err)
+		return argoappv1.TrackingMethodLabel
+	}
+	return ParseTrackingMethod(method)
+}
+
+// ParseTrackingMethod returns the given resource tracking method, the label method if it is empty or invalid
+func ParseTrackingMethod(method string) argoappv1.TrackingMethod {
+	switch argoappv1.TrackingMethod(method) {
+	case argoappv1.TrackingMethodAnnotation, argoappv1.TrackingMethodAnnotationAndLabel:
+		return argoappv1.TrackingMethod(method)
+	case "", argoappv1.TrackingMethodLabel:
+	default:
+		log.Warnf("Unknown resource tracking method '%s', using the label method", method)
+	}
+	return argoappv1.TrackingMethodLabel
+}
+
+// GetAppName returns the name of the application which the resource belongs to, or an empty string if it does not
+// belong to any application. With the annotation tracking methods, the annotation must identify the resource itself.
+func GetAppName(un *unstructured.Unstructured, key string, trackingMethod argoappv1.TrackingMethod) string {
+	switch trackingMethod {
+	case argoappv1.TrackingMethodAnnotation, argoappv1.TrackingMethodAnnotationAndLabel:
+		value, err := ParseAppInstanceValue(un.GetAnnotations()[common.AnnotationKeyAppInstance])
+		if err != nil || !value.identifies(un) {
+			return ""
+		}
+		return value.ApplicationName
+	default:
+		return un.GetLabels()[key]
+	}
+}
+
+// SetAppInstance records that the resource belongs to the given application. The namespace is the one of the resource
+// if it does not have any, i.e. the destination namespace of the application.
+func SetAppInstance(un *unstructured.Unstructured, key, appName, namespace string, trackingMethod argoappv1.TrackingMethod) error {
+	switch trackingMethod {
+	case argoappv1.TrackingMethodAnnotation, argoappv1.TrackingMethodAnnotationAndLabel:
+		if trackingMethod == argoappv1.TrackingMethodAnnotationAndLabel {
+			if err := argokube.SetAppInstanceLabel(un, key, appName); err != nil {
+				return err
+			}
+		}
+		if un.GetNamespace() != "" {
+			namespace = un.GetNamespace()
+		}
+		gvk := un.GroupVersionKind()
+		annotations := un.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[common.AnnotationKeyAppInstance] = BuildAppInstanceValue(AppInstanceValue{
+			ApplicationName: appName,
+			Group:           gvk.Group,
+			Kind:            gvk.Kind,
+			Namespace:       namespace,
+			Name:            un.GetName(),
+		})
+		un.SetAnnotations(annotations)
+		return nil
+	default:
+		return argokube.SetAppInstanceLabel(un, key, appName)
+	}
+}
+
+// BuildAppInstanceValue returns the value of the tracking annotation, <application name>:<group>/<kind>:<namespace>/<name>
+func BuildAppInstanceValue(value AppInstanceValue) string {
+	return fmt.Sprintf("%s:%s/%s:%s/%s", value.ApplicationName, value.Group, value.Kind, value.Namespace, value.Name)
+}
+
+// ParseAppInstanceValue parses the value of the tracking annotation
+func ParseAppInstanceValue(value string) (*AppInstanceValue, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return nil, fmt.Errorf("invalid tracking id '%s'", value)
+	}
+	groupKind := strings.Split(parts[1], "/")
+	namespaceName := strings.Split(parts[2], "/")
+	if len(groupKind) != 2 || len(namespaceName) != 2 {
+		return nil, fmt.Errorf("invalid tracking id '%s'", value)
+	}
+	return &AppInstanceValue{
+		ApplicationName: parts[0],
+		Group:           groupKind[0],
+		Kind:            groupKind[1],
+		Namespace:       namespaceName[0],
+		Name:            namespaceName[1],
+	}, nil
+}
+
+// identifies returns whether the tracking annotation identifies the given resource. The namespace of cluster scoped
+// resources is ignored since it is the destination namespace of the application.
+func (v *AppInstanceValue) identifies(un *unstructured.Unstructured) bool {
+	gvk := un.GroupVersionKind()
+	return v.Group == gvk.Group && v.Kind == gvk.Kind && v.Name == un.GetName() &&
+		(un.GetNamespace() == "" || v.Namespace == un.GetNamespace())
+}
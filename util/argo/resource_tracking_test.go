@@ -0,0 +1,85 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func newTrackedDeployment(namespace string) *unstructured.Unstructured {
+	un := &unstructured.Unstructured{}
+	un.SetAPIVersion("apps/v1")
+	un.SetKind("Deployment")
+	un.SetName("guestbook-ui")
+	un.SetNamespace(namespace)
+	return un
+}
+
+func TestParseTrackingMethod(t *testing.T) {
+	assert.Equal(t, argoappv1.TrackingMethodLabel, ParseTrackingMethod(""))
+	assert.Equal(t, argoappv1.TrackingMethodLabel, ParseTrackingMethod("label"))
+	assert.Equal(t, argoappv1.TrackingMethodAnnotation, ParseTrackingMethod("annotation"))
+	assert.Equal(t, argoappv1.TrackingMethodAnnotationAndLabel, ParseTrackingMethod("annotation+label"))
+	assert.Equal(t, argoappv1.TrackingMethodLabel, ParseTrackingMethod("unknown"))
+}
+
+func TestSetAppInstance(t *testing.T) {
+	t.Run("Label", func(t *testing.T) {
+		un := newTrackedDeployment("default")
+		require.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "guestbook", "default", argoappv1.TrackingMethodLabel))
+		assert.Equal(t, "guestbook", un.GetLabels()[common.LabelKeyAppInstance])
+		assert.NotContains(t, un.GetAnnotations(), common.AnnotationKeyAppInstance)
+		assert.Equal(t, "guestbook", GetAppName(un, common.LabelKeyAppInstance, argoappv1.TrackingMethodLabel))
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		un := newTrackedDeployment("default")
+		require.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "guestbook", "argocd", argoappv1.TrackingMethodAnnotation))
+		assert.NotContains(t, un.GetLabels(), common.LabelKeyAppInstance)
+		assert.Equal(t, "guestbook:apps/Deployment:default/guestbook-ui", un.GetAnnotations()[common.AnnotationKeyAppInstance])
+		assert.Equal(t, "guestbook", GetAppName(un, common.LabelKeyAppInstance, argoappv1.TrackingMethodAnnotation))
+		assert.Equal(t, "", GetAppName(un, common.LabelKeyAppInstance, argoappv1.TrackingMethodLabel))
+	})
+	t.Run("AnnotationWithoutNamespace", func(t *testing.T) {
+		un := newTrackedDeployment("")
+		require.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "guestbook", "default", argoappv1.TrackingMethodAnnotation))
+		assert.Equal(t, "guestbook:apps/Deployment:default/guestbook-ui", un.GetAnnotations()[common.AnnotationKeyAppInstance])
+	})
+	t.Run("AnnotationAndLabel", func(t *testing.T) {
+		un := newTrackedDeployment("default")
+		require.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "guestbook", "default", argoappv1.TrackingMethodAnnotationAndLabel))
+		assert.Equal(t, "guestbook", un.GetLabels()[common.LabelKeyAppInstance])
+		assert.Equal(t, "guestbook:apps/Deployment:default/guestbook-ui", un.GetAnnotations()[common.AnnotationKeyAppInstance])
+		assert.Equal(t, "guestbook", GetAppName(un, common.LabelKeyAppInstance, argoappv1.TrackingMethodAnnotationAndLabel))
+	})
+}
+
+func TestGetAppName_CopiedAnnotation(t *testing.T) {
+	un := newTrackedDeployment("default")
+	require.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "guestbook", "default", argoappv1.TrackingMethodAnnotation))
+
+	copied := newTrackedDeployment("default")
+	copied.SetName("guestbook-ui-copy")
+	copied.SetAnnotations(un.GetAnnotations())
+	assert.Equal(t, "", GetAppName(copied, common.LabelKeyAppInstance, argoappv1.TrackingMethodAnnotation))
+
+	copied = newTrackedDeployment("other")
+	copied.SetAnnotations(un.GetAnnotations())
+	assert.Equal(t, "", GetAppName(copied, common.LabelKeyAppInstance, argoappv1.TrackingMethodAnnotation))
+}
+
+func TestParseAppInstanceValue(t *testing.T) {
+	value, err := ParseAppInstanceValue("guestbook:/ConfigMap:default/guestbook-cm")
+	require.NoError(t, err)
+	assert.Equal(t, AppInstanceValue{ApplicationName: "guestbook", Kind: "ConfigMap", Namespace: "default", Name: "guestbook-cm"}, *value)
+	assert.Equal(t, "guestbook:/ConfigMap:default/guestbook-cm", BuildAppInstanceValue(*value))
+
+	for _, invalid := range []string{"", "guestbook", "guestbook:/ConfigMap", ":/ConfigMap:default/guestbook-cm", "guestbook:ConfigMap:default/guestbook-cm"} {
+		_, err := ParseAppInstanceValue(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,333 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SinkStdout writes the audit events to the standard output
+	SinkStdout = "stdout"
+	// SinkWebhook posts the audit events to a webhook
+	SinkWebhook = "webhook"
+	// SinkKafka produces the audit events to a Kafka topic through a Kafka REST proxy
+	SinkKafka = "kafka"
+
+	// eventBufferSize is the number of events buffered while waiting to be written to the sink
+	eventBufferSize = 1000
+	// maxBatchSize is the maximum number of events written to the sink at once
+	maxBatchSize = 100
+	// flushTimeout is how long the buffered events are written for once the logger is closed
+	flushTimeout = 10 * time.Second
+)
+
+var (
+	// enqueueTimeout is how long an event waits for room in the buffer before being dropped
+	enqueueTimeout = 5 * time.Second
+
+	// DroppedEventsCounter counts the audit events which could not be written to the sink
+	DroppedEventsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "argocd_audit_events_dropped_total",
+		Help: "Number of audit events dropped since they could not be written to the audit log sink.",
+	})
+)
+
+// Event records an action performed through the API
+type Event struct {
+	// Time is the time at which the action was performed
+	Time time.Time `json:"time"`
+	// RequestID is the ID of the request performing the action
+	RequestID string `json:"requestId,omitempty"`
+	// User is the user who performed the action
+	User string `json:"user,omitempty"`
+	// Action is the performed action, e.g. applications.sync
+	Action string `json:"action"`
+	// Method is the full name of the gRPC method or the HTTP method and path of the request performing the action
+	Method string `json:"method"`
+	// Name is the name of the object the action was performed on, e.g. the application name or the repository URL
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the object the action was performed on, if any
+	Namespace string `json:"namespace,omitempty"`
+	// Success is whether the action succeeded
+	Success bool `json:"success"`
+	// Error is the error returned if the action failed
+	Error string `json:"error,omitempty"`
+}
+
+// Sink stores the audit events
+type Sink interface {
+	// Write writes the given events, in order
+	Write(ctx context.Context, events []Event) error
+}
+
+// SinkOptions are the settings of the sinks
+type SinkOptions struct {
+	// WebhookURL is the URL the webhook sink posts the events to
+	WebhookURL string
+	// KafkaURL is the URL of the Kafka REST proxy the Kafka sink produces the events through
+	KafkaURL string
+	// KafkaTopic is the topic the Kafka sink produces the events to
+	KafkaTopic string
+}
+
+// NewSink returns the sink of the given kind, or nil if the kind is empty
+func NewSink(kind string, opts SinkOptions) (Sink, error) {
+	switch kind {
+	case "":
+		return nil, nil
+	case SinkStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkWebhook:
+		if opts.WebhookURL == "" {
+			return nil, fmt.Errorf("the %s audit log sink requires a webhook URL", SinkWebhook)
+		}
+		return NewWebhookSink(opts.WebhookURL), nil
+	case SinkKafka:
+		if opts.KafkaURL == "" || opts.KafkaTopic == "" {
+			return nil, fmt.Errorf("the %s audit log sink requires a Kafka REST proxy URL and a topic", SinkKafka)
+		}
+		return NewKafkaSink(opts.KafkaURL, opts.KafkaTopic), nil
+	default:
+		return nil, fmt.Errorf("unknown audit log sink %s, must be one of %s, %s or %s", kind, SinkStdout, SinkWebhook, SinkKafka)
+	}
+}
+
+type writerSink struct {
+	lock    sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterSink returns a sink writing the events to the given writer, one JSON object per line
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *writerSink) Write(_ context.Context, events []Event) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, event := range events {
+		if err := s.encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postJSON posts the given value as JSON with the given content type
+func postJSON(ctx context.Context, client *http.Client, url string, contentType string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a sink posting the events to the given URL, as a JSON array of events per batch
+func NewWebhookSink(url string) Sink {
+	return &webhookSink{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *webhookSink) Write(ctx context.Context, events []Event) error {
+	return postJSON(ctx, s.client, s.url, "application/json", events)
+}
+
+type kafkaSink struct {
+	url    string
+	client *http.Client
+}
+
+type kafkaRecord struct {
+	Value Event `json:"value"`
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// NewKafkaSink returns a sink producing the events to the given Kafka topic through the Kafka REST proxy at the given
+// URL, one JSON record per event
+func NewKafkaSink(proxyURL string, topic string) Sink {
+	return &kafkaSink{
+		url:    strings.TrimSuffix(proxyURL, "/") + "/topics/" + url.PathEscape(topic),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *kafkaSink) Write(ctx context.Context, events []Event) error {
+	records := kafkaRecords{Records: make([]kafkaRecord, len(events))}
+	for i := range events {
+		records.Records[i] = kafkaRecord{Value: events[i]}
+	}
+	return postJSON(ctx, s.client, s.url, "application/vnd.kafka.json.v2+json", records)
+}
+
+// Logger writes the audit events to a sink in the background, in batches, so that a slow sink does not delay the API
+// calls. The events wait for the sink to catch up if the buffer is full, and are dropped, with an error message and
+// the DroppedEventsCounter incremented, if it does not in time.
+type Logger struct {
+	// dropped is first to be 64-bit aligned for the atomic operations
+	dropped int64
+	sink    Sink
+	events  chan Event
+	// closing is closed when the logger starts closing, to wake up the events waiting for room in the buffer
+	closing     chan struct{}
+	closingOnce sync.Once
+	// lock guards closed, so that no event is queued once the queued events are flushed
+	lock    sync.RWMutex
+	closed  bool
+	done    chan struct{}
+	runOnce sync.Once
+}
+
+// NewLogger returns a logger writing the audit events to the given sink once it runs
+func NewLogger(sink Sink) *Logger {
+	return &Logger{
+		sink:    sink,
+		events:  make(chan Event, eventBufferSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+func (l *Logger) drop(event Event, reason string) {
+	dropped := atomic.AddInt64(&l.dropped, 1)
+	DroppedEventsCounter.Inc()
+	log.WithFields(log.Fields{"action": event.Action, "name": event.Name, "user": event.User}).Errorf("Dropped audit event since %s (%d dropped events)", reason, dropped)
+}
+
+// Dropped returns the number of events dropped so far
+func (l *Logger) Dropped() int64 {
+	return atomic.LoadInt64(&l.dropped)
+}
+
+// Log queues the given event
+func (l *Logger) Log(event Event) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+	if l.closed {
+		l.drop(event, "the audit logger is closed")
+		return
+	}
+	select {
+	case l.events <- event:
+		return
+	default:
+	}
+	timer := time.NewTimer(enqueueTimeout)
+	defer timer.Stop()
+	select {
+	case l.events <- event:
+	case <-l.closing:
+		l.drop(event, "the audit logger is closed")
+	case <-timer.C:
+		l.drop(event, "the audit log sink is too slow")
+	}
+}
+
+func (l *Logger) write(ctx context.Context, batch []Event) {
+	if err := l.sink.Write(ctx, batch); err != nil {
+		for _, event := range batch {
+			l.drop(event, fmt.Sprintf("it could not be written: %v", err))
+		}
+	}
+}
+
+// nextBatch returns the given event followed by the events already queued, up to the maximum batch size
+func (l *Logger) nextBatch(first Event) []Event {
+	batch := []Event{first}
+	for len(batch) < maxBatchSize {
+		select {
+		case event := <-l.events:
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// Run writes the queued events to the sink until the given context is done or the logger is closed, and then writes
+// the events still queued
+func (l *Logger) Run(ctx context.Context) {
+	l.runOnce.Do(func() {
+		defer close(l.done)
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-l.closing:
+				break loop
+			case event := <-l.events:
+				l.write(ctx, l.nextBatch(event))
+			}
+		}
+		l.shutdown()
+		l.flush()
+	})
+}
+
+// shutdown stops queuing the events
+func (l *Logger) shutdown() {
+	l.closingOnce.Do(func() {
+		close(l.closing)
+	})
+	l.lock.Lock()
+	l.closed = true
+	l.lock.Unlock()
+}
+
+func (l *Logger) flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	for {
+		select {
+		case event := <-l.events:
+			l.write(ctx, l.nextBatch(event))
+		default:
+			return
+		}
+	}
+}
+
+// Close stops queuing the events and waits for the queued events to be written
+func (l *Logger) Close() {
+	l.shutdown()
+	l.runOnce.Do(func() {
+		// the logger never ran
+		defer close(l.done)
+		l.flush()
+	})
+	<-l.done
+}
@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink("", SinkOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, sink)
+
+	sink, err = NewSink(SinkStdout, SinkOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, sink)
+
+	_, err = NewSink(SinkWebhook, SinkOptions{})
+	assert.Error(t, err)
+
+	_, err = NewSink(SinkKafka, SinkOptions{KafkaURL: "http://kafka-rest:8082"})
+	assert.Error(t, err)
+
+	sink, err = NewSink(SinkKafka, SinkOptions{KafkaURL: "http://kafka-rest:8082", KafkaTopic: "argocd-audit"})
+	assert.NoError(t, err)
+	assert.NotNil(t, sink)
+
+	_, err = NewSink("syslog", SinkOptions{})
+	assert.Error(t, err)
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	event := Event{Time: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), RequestID: "123", User: "admin", Action: "applications.sync", Method: "/application.ApplicationService/Sync", Name: "guestbook", Success: true}
+	assert.NoError(t, sink.Write(context.Background(), []Event{event, event}))
+	line := `{"time":"2022-03-01T00:00:00Z","requestId":"123","user":"admin","action":"applications.sync","method":"/application.ApplicationService/Sync","name":"guestbook","success":true}` + "\n"
+	assert.Equal(t, line+line, buf.String())
+}
+
+func TestWebhookSink(t *testing.T) {
+	batches := make(chan []Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var events []Event
+		if err := json.NewDecoder(r.Body).Decode(&events); err != nil || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if events[0].Name == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		batches <- events
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL)
+	events := []Event{{Action: "projects.update", Name: "default"}, {Action: "projects.delete", Name: "default"}}
+	assert.NoError(t, sink.Write(context.Background(), events))
+	assert.Equal(t, events, <-batches)
+
+	assert.Error(t, sink.Write(context.Background(), []Event{{Action: "projects.update", Name: "fail"}}))
+}
+
+func TestKafkaSink(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan kafkaRecords, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var records kafkaRecords
+		if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests <- r
+		bodies <- records
+	}))
+	defer server.Close()
+
+	sink := NewKafkaSink(server.URL+"/", "argocd-audit")
+	assert.NoError(t, sink.Write(context.Background(), []Event{{Action: "clusters.delete"}, {Action: "clusters.create"}}))
+	req := <-requests
+	assert.Equal(t, "/topics/argocd-audit", req.URL.Path)
+	assert.Equal(t, "application/vnd.kafka.json.v2+json", req.Header.Get("Content-Type"))
+	assert.Equal(t, kafkaRecords{Records: []kafkaRecord{{Value: Event{Action: "clusters.delete"}}, {Value: Event{Action: "clusters.create"}}}}, <-bodies)
+}
+
+type fakeSink struct {
+	events chan Event
+	// block blocks the writes until it is closed, if not nil
+	block chan struct{}
+}
+
+func (s *fakeSink) Write(_ context.Context, events []Event) error {
+	if s.block != nil {
+		<-s.block
+	}
+	for _, event := range events {
+		s.events <- event
+	}
+	return nil
+}
+
+func TestLogger(t *testing.T) {
+	sink := &fakeSink{events: make(chan Event, 1)}
+	logger := NewLogger(sink)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go logger.Run(ctx)
+
+	logger.Log(Event{Action: "clusters.delete", Name: "https://kubernetes.default.svc"})
+	select {
+	case event := <-sink.events:
+		assert.Equal(t, "clusters.delete", event.Action)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not written")
+	}
+}
+
+func TestLogger_DropsEventsWhenFull(t *testing.T) {
+	defer func(timeout time.Duration) {
+		enqueueTimeout = timeout
+	}(enqueueTimeout)
+	enqueueTimeout = 10 * time.Millisecond
+
+	logger := NewLogger(&fakeSink{})
+	for i := 0; i < eventBufferSize+10; i++ {
+		logger.Log(Event{Action: "applications.sync"})
+	}
+	assert.Len(t, logger.events, eventBufferSize)
+	assert.Equal(t, int64(10), logger.Dropped())
+}
+
+func TestLogger_WaitsForRoom(t *testing.T) {
+	sink := &fakeSink{events: make(chan Event, eventBufferSize+1)}
+	logger := NewLogger(sink)
+	for i := 0; i < eventBufferSize; i++ {
+		logger.Log(Event{Action: "applications.sync"})
+	}
+	go logger.Run(context.Background())
+	// the event waits for the logger to write the queued events rather than being dropped
+	logger.Log(Event{Action: "applications.delete"})
+	logger.Close()
+	assert.Equal(t, int64(0), logger.Dropped())
+	assert.Len(t, sink.events, eventBufferSize+1)
+}
+
+func TestLogger_Close(t *testing.T) {
+	sink := &fakeSink{events: make(chan Event, 10), block: make(chan struct{})}
+	logger := NewLogger(sink)
+	go logger.Run(context.Background())
+	for i := 0; i < 5; i++ {
+		logger.Log(Event{Action: "applications.sync"})
+	}
+	close(sink.block)
+	// the queued events are written before Close returns
+	logger.Close()
+	assert.Len(t, sink.events, 5)
+
+	logger.Log(Event{Action: "applications.sync"})
+	assert.Equal(t, int64(1), logger.Dropped())
+
+	// the events queued to a logger which never ran are written too
+	logger = NewLogger(&fakeSink{events: sink.events})
+	logger.Log(Event{Action: "applications.delete"})
+	logger.Close()
+	assert.Len(t, sink.events, 6)
+}
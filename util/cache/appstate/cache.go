@@ -29,7 +29,7 @@ func NewCache(cache *cacheutil.Cache, appStateCacheExpiration time.Duration) *Ca
 	return &Cache{cache, appStateCacheExpiration}
 }
 
-func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client)) func() (*Cache, error) {
+func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client redis.UniversalClient)) func() (*Cache, error) {
 	var appStateCacheExpiration time.Duration
 
 	cmd.Flags().DurationVar(&appStateCacheExpiration, "app-state-cache-expiration", env.ParseDurationFromEnv("ARGOCD_APP_STATE_CACHE_EXPIRATION", 1*time.Hour, 0, 10*time.Hour), "Cache expiration for app state")
@@ -69,6 +69,22 @@ func (c *Cache) SetAppManagedResources(appName string, managedResources []*appv1
 	return c.SetItem(appManagedResourcesKey(appName), managedResources, c.appStateCacheExpiration, managedResources == nil)
 }
 
+func appDiffsKey(appName, revision, hash string) string {
+	return fmt.Sprintf("app|diffs|%s|%s|%s", appName, revision, hash)
+}
+
+// GetAppDiffs returns the diffs of the managed resources of the application computed for the given revision and hash of
+// the target states and comparison settings
+func (c *Cache) GetAppDiffs(appName, revision, hash string, res *[]*appv1.ResourceDiff) error {
+	return c.GetItem(appDiffsKey(appName, revision, hash), &res)
+}
+
+// SetAppDiffs stores the diffs of the managed resources of the application computed for the given revision and hash of
+// the target states and comparison settings
+func (c *Cache) SetAppDiffs(appName, revision, hash string, diffs []*appv1.ResourceDiff) error {
+	return c.SetItem(appDiffsKey(appName, revision, hash), diffs, c.appStateCacheExpiration, diffs == nil)
+}
+
 func appResourcesTreeKey(appName string) string {
 	return fmt.Sprintf("app|resources-tree|%s", appName)
 }
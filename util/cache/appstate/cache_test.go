@@ -40,6 +40,26 @@ func TestCache_GetAppManagedResources(t *testing.T) {
 	assert.Equal(t, &[]*ResourceDiff{{Name: "my-name"}}, value)
 }
 
+func TestCache_GetAppDiffs(t *testing.T) {
+	cache := newFixtures().Cache
+	// cache miss
+	value := &[]*ResourceDiff{}
+	err := cache.GetAppDiffs("my-appname", "my-revision", "my-hash", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetAppDiffs("my-appname", "my-revision", "my-hash", []*ResourceDiff{{Modified: true}, {NormalizedLiveState: "{}"}})
+	assert.NoError(t, err)
+	// cache miss
+	err = cache.GetAppDiffs("my-appname", "other-revision", "my-hash", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	err = cache.GetAppDiffs("my-appname", "my-revision", "other-hash", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetAppDiffs("my-appname", "my-revision", "my-hash", value)
+	assert.NoError(t, err)
+	assert.Equal(t, &[]*ResourceDiff{{Modified: true}, {NormalizedLiveState: "{}"}}, value)
+}
+
 func TestCache_GetAppResourcesTree(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
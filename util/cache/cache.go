@@ -21,6 +21,10 @@ import (
 const (
 	// envRedisPassword is a env variable name which stores redis password
 	envRedisPassword = "REDIS_PASSWORD"
+	// envRedisUsername is a env variable name which stores redis username (for Redis ACL authentication)
+	envRedisUsername = "REDIS_USERNAME"
+	// envRedisSentinelPassword is a env variable name which stores redis sentinel password
+	envRedisSentinelPassword = "REDIS_SENTINEL_PASSWORD"
 	// envRedisRetryCount is a env variable name which stores redis retry count
 	envRedisRetryCount = "REDIS_RETRY_COUNT"
 	// defaultRedisRetryCount holds default number of retries
@@ -32,10 +36,11 @@ func NewCache(client CacheClient) *Cache {
 }
 
 // AddCacheFlagsToCmd adds flags which control caching to the specified command
-func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client)) func() (*Cache, error) {
+func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client redis.UniversalClient)) func() (*Cache, error) {
 	redisAddress := ""
 	sentinelAddresses := make([]string, 0)
 	sentinelMaster := ""
+	clusterAddresses := make([]string, 0)
 	redisDB := 0
 	redisCACerticate := ""
 	redisClientCertificate := ""
@@ -48,6 +53,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client))
 	cmd.Flags().IntVar(&redisDB, "redisdb", env.ParseNumFromEnv("REDISDB", 0, 0, math.MaxInt32), "Redis database.")
 	cmd.Flags().StringArrayVar(&sentinelAddresses, "sentinel", []string{}, "Redis sentinel hostname and port (e.g. argocd-redis-ha-announce-0:6379). ")
 	cmd.Flags().StringVar(&sentinelMaster, "sentinelmaster", "master", "Redis sentinel master group name.")
+	cmd.Flags().StringArrayVar(&clusterAddresses, "redis-cluster", []string{}, "Redis cluster node hostname and port (e.g. argocd-redis-cluster-0:6379). ")
 	cmd.Flags().DurationVar(&defaultCacheExpiration, "default-cache-expiration", env.ParseDurationFromEnv("ARGOCD_DEFAULT_CACHE_EXPIRATION", 24*time.Hour, 0, math.MaxInt64), "Cache expiration default")
 	cmd.Flags().BoolVar(&redisUseTLS, "redis-use-tls", false, "Use TLS when connecting to Redis. ")
 	cmd.Flags().StringVar(&redisClientCertificate, "redis-client-certificate", "", "Path to Redis client certificate (e.g. /etc/certs/redis/client.crt).")
@@ -82,15 +88,37 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client))
 			}
 		}
 		password := os.Getenv(envRedisPassword)
+		username := os.Getenv(envRedisUsername)
 		maxRetries := env.ParseNumFromEnv(envRedisRetryCount, defaultRedisRetryCount, 0, math.MaxInt32)
+		if len(clusterAddresses) > 0 {
+			if len(sentinelAddresses) > 0 {
+				return nil, fmt.Errorf("--redis-cluster and --sentinel cannot be used together")
+			}
+			if redisDB != 0 {
+				return nil, fmt.Errorf("--redisdb is not supported with --redis-cluster, Redis Cluster only has database 0")
+			}
+			client := redis.NewClusterClient(&redis.ClusterOptions{
+				Addrs:      clusterAddresses,
+				Username:   username,
+				Password:   password,
+				MaxRetries: maxRetries,
+				TLSConfig:  tlsConfig,
+			})
+			for i := range opts {
+				opts[i](client)
+			}
+			return NewCache(NewRedisCache(client, defaultCacheExpiration)), nil
+		}
 		if len(sentinelAddresses) > 0 {
 			client := redis.NewFailoverClient(&redis.FailoverOptions{
-				MasterName:    sentinelMaster,
-				SentinelAddrs: sentinelAddresses,
-				DB:            redisDB,
-				Password:      password,
-				MaxRetries:    maxRetries,
-				TLSConfig:     tlsConfig,
+				MasterName:       sentinelMaster,
+				SentinelAddrs:    sentinelAddresses,
+				SentinelPassword: os.Getenv(envRedisSentinelPassword),
+				DB:               redisDB,
+				Username:         username,
+				Password:         password,
+				MaxRetries:       maxRetries,
+				TLSConfig:        tlsConfig,
 			})
 			for i := range opts {
 				opts[i](client)
@@ -103,6 +131,7 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...func(client *redis.Client))
 
 		client := redis.NewClient(&redis.Options{
 			Addr:       redisAddress,
+			Username:   username,
 			Password:   password,
 			DB:         redisDB,
 			MaxRetries: maxRetries,
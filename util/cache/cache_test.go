@@ -4,8 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddCacheFlagsToCmd(t *testing.T) {
@@ -14,6 +16,34 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	assert.Equal(t, 24*time.Hour, cache.client.(*redisCache).expiration)
 }
 
+func TestAddCacheFlagsToCmd_RedisCluster(t *testing.T) {
+	var client redis.UniversalClient
+	cmd := &cobra.Command{}
+	factory := AddCacheFlagsToCmd(cmd, func(c redis.UniversalClient) {
+		client = c
+	})
+	require.NoError(t, cmd.Flags().Parse([]string{"--redis-cluster", "redis-0:6379", "--redis-cluster", "redis-1:6379"}))
+	_, err := factory()
+	require.NoError(t, err)
+	clusterClient, ok := client.(*redis.ClusterClient)
+	require.True(t, ok)
+	assert.Equal(t, []string{"redis-0:6379", "redis-1:6379"}, clusterClient.Options().Addrs)
+}
+
+func TestAddCacheFlagsToCmd_RedisClusterInvalid(t *testing.T) {
+	cmd := &cobra.Command{}
+	factory := AddCacheFlagsToCmd(cmd)
+	require.NoError(t, cmd.Flags().Parse([]string{"--redis-cluster", "redis-0:6379", "--sentinel", "sentinel-0:26379"}))
+	_, err := factory()
+	assert.EqualError(t, err, "--redis-cluster and --sentinel cannot be used together")
+
+	cmd = &cobra.Command{}
+	factory = AddCacheFlagsToCmd(cmd)
+	require.NoError(t, cmd.Flags().Parse([]string{"--redis-cluster", "redis-0:6379", "--redisdb", "1"}))
+	_, err = factory()
+	assert.EqualError(t, err, "--redisdb is not supported with --redis-cluster, Redis Cluster only has database 0")
+}
+
 func TestCacheClient(t *testing.T) {
 	client := NewInMemoryCache(60 * time.Second)
 	cache := NewCache(client)
@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// NewLRUCache creates an in-memory cache that holds at most size entries and evicts the least recently used entries
+// first. Entries expire after the given expiration unless the item specifies its own expiration.
+func NewLRUCache(size int, expiration time.Duration) (*LRUCache, error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &LRUCache{entries: entries, expiration: expiration}, nil
+}
+
+// compile-time validation of adherance of the CacheClient contract
+var _ CacheClient = &LRUCache{}
+
+type lruEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// LRUCache is a size bounded in-memory cache. Values are stored JSON encoded, same as in Redis, so the values read from
+// the LRU cache are identical to the values read from the external cache.
+type LRUCache struct {
+	entries    *lru.Cache
+	expiration time.Duration
+}
+
+func (c *LRUCache) Set(item *Item) error {
+	data, err := json.Marshal(item.Object)
+	if err != nil {
+		return err
+	}
+	expiration := item.Expiration
+	if expiration == 0 || expiration > c.expiration {
+		expiration = c.expiration
+	}
+	c.entries.Add(item.Key, &lruEntry{data: data, expiresAt: time.Now().Add(expiration)})
+	return nil
+}
+
+func (c *LRUCache) get(key string) ([]byte, bool) {
+	val, ok := c.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := val.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.entries.Remove(key)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func (c *LRUCache) Get(key string, obj interface{}) error {
+	data, ok := c.get(key)
+	if !ok {
+		return ErrCacheMiss
+	}
+	return json.Unmarshal(data, obj)
+}
+
+func (c *LRUCache) Delete(key string) error {
+	c.entries.Remove(key)
+	return nil
+}
+
+// Len returns the number of entries in the cache, including expired entries which have not been evicted yet.
+func (c *LRUCache) Len() int {
+	return c.entries.Len()
+}
+
+func (c *LRUCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
+	return nil
+}
+
+func (c *LRUCache) NotifyUpdated(key string) error {
+	return nil
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUCache(t *testing.T) {
+	c, err := NewLRUCache(2, time.Hour)
+	require.NoError(t, err)
+
+	var obj testStruct
+	assert.Equal(t, ErrCacheMiss, c.Get("a", &obj))
+
+	require.NoError(t, c.Set(&Item{Key: "a", Object: testStruct{Foo: "a"}}))
+	require.NoError(t, c.Set(&Item{Key: "b", Object: testStruct{Foo: "b"}}))
+	require.NoError(t, c.Get("a", &obj))
+	assert.Equal(t, "a", obj.Foo)
+
+	// "b" is the least recently used entry and is evicted
+	require.NoError(t, c.Set(&Item{Key: "c", Object: testStruct{Foo: "c"}}))
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, ErrCacheMiss, c.Get("b", &obj))
+
+	require.NoError(t, c.Delete("a"))
+	assert.Equal(t, ErrCacheMiss, c.Get("a", &obj))
+}
+
+func TestLRUCache_Expiration(t *testing.T) {
+	c, err := NewLRUCache(10, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Set(&Item{Key: "key", Object: "value", Expiration: time.Millisecond}))
+	time.Sleep(10 * time.Millisecond)
+	var value string
+	assert.Equal(t, ErrCacheMiss, c.Get("key", &value))
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestTwoLevelLRUClient(t *testing.T) {
+	external := NewInMemoryCache(time.Hour)
+	client, err := NewTwoLevelLRUClient(external, 10, time.Hour)
+	require.NoError(t, err)
+
+	require.NoError(t, client.Set(&Item{Key: "key", Object: "value"}))
+	// the value is served from memory even if it is removed from the external cache
+	require.NoError(t, external.Delete("key"))
+	var value string
+	require.NoError(t, client.Get("key", &value))
+	assert.Equal(t, "value", value)
+
+	require.NoError(t, external.Set(&Item{Key: "other", Object: "external"}))
+	require.NoError(t, client.Get("other", &value))
+	assert.Equal(t, "external", value)
+
+	// setting the same value again restores the entry evicted from the external cache
+	require.NoError(t, client.Set(&Item{Key: "key", Object: "value"}))
+	require.NoError(t, external.Get("key", &value))
+	assert.Equal(t, "value", value)
+
+	require.NoError(t, client.Delete("key"))
+	assert.Equal(t, ErrCacheMiss, client.Get("key", &value))
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+const (
+	// memcachedMaxKeyLength is the maximum length of a memcached key
+	memcachedMaxKeyLength = 250
+	// memcachedMaxRelativeExpiration is the maximum expiration which memcached treats as relative to the current time.
+	// Larger values are interpreted as an absolute unix timestamp.
+	memcachedMaxRelativeExpiration = 30 * 24 * time.Hour
+	// memcachedMaxIdleConnsPerServer is the number of idle connections kept open per memcached server
+	memcachedMaxIdleConnsPerServer = 10
+)
+
+var errMemcachedNoServers = errors.New("at least one memcached server is required")
+
+// NewMemcachedCache creates a cache client which stores the values in the given memcached servers. Keys are distributed
+// between the servers by hash, so the servers don't need to be aware of each other.
+func NewMemcachedCache(servers []string, expiration time.Duration, timeout time.Duration) (CacheClient, error) {
+	if len(servers) == 0 {
+		return nil, errMemcachedNoServers
+	}
+	client := memcache.New(servers...)
+	client.Timeout = timeout
+	client.MaxIdleConns = memcachedMaxIdleConnsPerServer
+	return &memcachedCache{client: client, expiration: expiration}, nil
+}
+
+// compile-time validation of adherance of the CacheClient contract
+var _ CacheClient = &memcachedCache{}
+
+type memcachedCache struct {
+	client     *memcache.Client
+	expiration time.Duration
+}
+
+// memcachedKey returns a key which satisfies the memcached key restrictions: keys are limited to 250 characters and must
+// not include whitespace or control characters. Other keys are replaced by their hash.
+func memcachedKey(key string) string {
+	valid := len(key) <= memcachedMaxKeyLength
+	for i := 0; valid && i < len(key); i++ {
+		valid = key[i] > ' ' && key[i] != 0x7f
+	}
+	if valid {
+		return key
+	}
+	sum := sha256.Sum256([]byte(key))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// memcachedExpiration converts the expiration into the memcached expiration time
+func memcachedExpiration(expiration time.Duration) int32 {
+	if expiration <= 0 {
+		return 0
+	}
+	if expiration > memcachedMaxRelativeExpiration {
+		return int32(time.Now().Add(expiration).Unix())
+	}
+	seconds := int32(expiration / time.Second)
+	if seconds == 0 {
+		seconds = 1
+	}
+	return seconds
+}
+
+func (c *memcachedCache) Set(item *Item) error {
+	expiration := item.Expiration
+	if expiration == 0 {
+		expiration = c.expiration
+	}
+	val, err := json.Marshal(item.Object)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(&memcache.Item{Key: memcachedKey(item.Key), Value: val, Expiration: memcachedExpiration(expiration)})
+}
+
+func (c *memcachedCache) Get(key string, obj interface{}) error {
+	item, err := c.client.Get(memcachedKey(key))
+	if err == memcache.ErrCacheMiss {
+		return ErrCacheMiss
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(item.Value, obj)
+}
+
+func (c *memcachedCache) Delete(key string) error {
+	err := c.client.Delete(memcachedKey(key))
+	if err == memcache.ErrCacheMiss {
+		return nil
+	}
+	return err
+}
+
+// OnUpdated is a no-op since memcached does not support notifications
+func (c *memcachedCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
+	return nil
+}
+
+// NotifyUpdated is a no-op since memcached does not support notifications
+func (c *memcachedCache) NotifyUpdated(key string) error {
+	return nil
+}
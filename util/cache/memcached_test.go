@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMemcached implements the get, set and delete commands of the memcached text protocol
+type fakeMemcached struct {
+	listener net.Listener
+	lock     sync.Mutex
+	items    map[string][]byte
+	commands []string
+}
+
+func newFakeMemcached(t *testing.T) *fakeMemcached {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	m := &fakeMemcached{listener: listener, items: map[string][]byte{}}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go m.serve(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	return m
+}
+
+func (m *fakeMemcached) serve(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		m.lock.Lock()
+		m.commands = append(m.commands, strings.TrimSpace(line))
+		switch fields[0] {
+		case "get", "gets":
+			if data, ok := m.items[fields[1]]; ok {
+				_, _ = fmt.Fprintf(rw, "VALUE %s 0 %d\r\n%s\r\n", fields[1], len(data), data)
+			}
+			_, _ = rw.WriteString("END\r\n")
+		case "set":
+			size, _ := strconv.Atoi(fields[4])
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(rw, data); err != nil {
+				m.lock.Unlock()
+				return
+			}
+			m.items[fields[1]] = data[:size]
+			_, _ = rw.WriteString("STORED\r\n")
+		case "delete":
+			if _, ok := m.items[fields[1]]; ok {
+				delete(m.items, fields[1])
+				_, _ = rw.WriteString("DELETED\r\n")
+			} else {
+				_, _ = rw.WriteString("NOT_FOUND\r\n")
+			}
+		default:
+			_, _ = rw.WriteString("ERROR\r\n")
+		}
+		m.lock.Unlock()
+		if err := rw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func TestMemcachedCache(t *testing.T) {
+	server := newFakeMemcached(t)
+	c, err := NewMemcachedCache([]string{server.listener.Addr().String()}, time.Hour, time.Second)
+	require.NoError(t, err)
+
+	var obj testStruct
+	assert.Equal(t, ErrCacheMiss, c.Get("key", &obj))
+
+	require.NoError(t, c.Set(&Item{Key: "key", Object: testStruct{Foo: "foo", Bar: []byte("bar")}}))
+	require.NoError(t, c.Get("key", &obj))
+	assert.Equal(t, testStruct{Foo: "foo", Bar: []byte("bar")}, obj)
+
+	require.NoError(t, c.Delete("key"))
+	require.NoError(t, c.Delete("key"))
+	assert.Equal(t, ErrCacheMiss, c.Get("key", &obj))
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	assert.Contains(t, server.commands, "set key 0 3600 26")
+}
+
+func TestMemcachedCache_NoServers(t *testing.T) {
+	_, err := NewMemcachedCache(nil, time.Hour, time.Second)
+	assert.Error(t, err)
+}
+
+func Test_memcachedKey(t *testing.T) {
+	assert.Equal(t, "mfst|abc|1.8.3", memcachedKey("mfst|abc|1.8.3"))
+	hashed := memcachedKey("key with spaces")
+	assert.True(t, strings.HasPrefix(hashed, "sha256:"))
+	assert.NotEqual(t, hashed, memcachedKey("key with other spaces"))
+	assert.True(t, strings.HasPrefix(memcachedKey(strings.Repeat("a", 251)), "sha256:"))
+}
+
+func Test_memcachedExpiration(t *testing.T) {
+	assert.Equal(t, int32(0), memcachedExpiration(0))
+	assert.Equal(t, int32(1), memcachedExpiration(time.Millisecond))
+	assert.Equal(t, int32(86400), memcachedExpiration(24*time.Hour))
+	assert.Greater(t, int64(memcachedExpiration(60*24*time.Hour)), time.Now().Unix())
+}
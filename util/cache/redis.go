@@ -11,7 +11,7 @@ import (
 	"github.com/go-redis/redis/v8"
 )
 
-func NewRedisCache(client *redis.Client, expiration time.Duration) CacheClient {
+func NewRedisCache(client redis.UniversalClient, expiration time.Duration) CacheClient {
 	return &redisCache{
 		client:     client,
 		expiration: expiration,
@@ -24,7 +24,7 @@ var _ CacheClient = &redisCache{}
 
 type redisCache struct {
 	expiration time.Duration
-	client     *redis.Client
+	client     redis.UniversalClient
 	cache      *rediscache.Cache
 }
 
@@ -118,6 +118,6 @@ func (redisHook) AfterProcessPipeline(_ context.Context, _ []redis.Cmder) error
 }
 
 // CollectMetrics add transport wrapper that pushes metrics into the specified metrics registry
-func CollectMetrics(client *redis.Client, registry MetricsRegistry) {
+func CollectMetrics(client redis.UniversalClient, registry MetricsRegistry) {
 	client.AddHook(&redisHook{registry: registry})
 }
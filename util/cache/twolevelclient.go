@@ -13,22 +13,26 @@ func NewTwoLevelClient(client CacheClient, inMemoryExpiration time.Duration) *tw
 	return &twoLevelClient{inMemoryCache: NewInMemoryCache(inMemoryExpiration), externalCache: client}
 }
 
+// NewTwoLevelLRUClient creates cache client that proxies requests to given external cache and keeps up to size most
+// recently used entries in memory for at most inMemoryExpiration.
+func NewTwoLevelLRUClient(client CacheClient, size int, inMemoryExpiration time.Duration) (*twoLevelClient, error) {
+	lruCache, err := NewLRUCache(size, inMemoryExpiration)
+	if err != nil {
+		return nil, err
+	}
+	return &twoLevelClient{inMemoryCache: lruCache, externalCache: client}, nil
+}
+
 type twoLevelClient struct {
-	inMemoryCache *InMemoryCache
+	inMemoryCache CacheClient
 	externalCache CacheClient
 }
 
 // Set stores the given value in both in-memory and external cache.
-// Skip storing the value in external cache if the same value already exists in memory to avoid requesting external cache.
+// The value is always written to the external cache, even if the same value already exists in memory, so that the
+// expiration of the external entry is extended and entries evicted from the external cache are restored.
 func (c *twoLevelClient) Set(item *Item) error {
-	has, err := c.inMemoryCache.HasSame(item.Key, item.Object)
-	if has {
-		return nil
-	}
-	if err != nil {
-		log.Warnf("Failed to check key '%s' in in-memory cache: %v", item.Key, err)
-	}
-	err = c.inMemoryCache.Set(item)
+	err := c.inMemoryCache.Set(item)
 	if err != nil {
 		log.Warnf("Failed to save key '%s' in in-memory cache: %v", item.Key, err)
 	}
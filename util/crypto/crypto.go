@@ -0,0 +1,60 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MinKeyLength is the minimum length of a key which is accepted by NewKey
+const MinKeyLength = 16
+
+// NewKey derives a 256 bit AES key from the given secret. The secret is expected to be randomly generated,
+// e.g. using `openssl rand -base64 32`.
+func NewKey(secret []byte) ([]byte, error) {
+	if len(secret) < MinKeyLength {
+		return nil, fmt.Errorf("encryption key must be at least %d bytes long", MinKeyLength)
+	}
+	key := sha256.Sum256(secret)
+	return key[:], nil
+}
+
+// Encrypt encrypts the data using AES-GCM. The random nonce is prepended to the returned ciphertext. The additional
+// data is authenticated but not encrypted, and must be passed unchanged to Decrypt, e.g. to bind the ciphertext to the
+// key it is stored under.
+func Encrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, data, additionalData), nil
+}
+
+// Decrypt decrypts the data which was encrypted using Encrypt with the same additional data
+func Decrypt(data []byte, key []byte, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := gcm.NonceSize()
+	if len(data) < nonceSize {
+		return nil, errors.New("encrypted data is too short")
+	}
+	return gcm.Open(nil, data[:nonceSize], data[nonceSize:], additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKey(t *testing.T) {
+	_, err := NewKey([]byte("short"))
+	assert.EqualError(t, err, "encryption key must be at least 16 bytes long")
+
+	key, err := NewKey([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	assert.Len(t, key, 32)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := NewKey([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+
+	encrypted, err := Encrypt([]byte("secret manifest"), key, []byte("my-key"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(encrypted), "secret manifest")
+
+	// the nonce is random, so the same data is never encrypted into the same ciphertext
+	other, err := Encrypt([]byte("secret manifest"), key, []byte("my-key"))
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, other)
+
+	decrypted, err := Decrypt(encrypted, key, []byte("my-key"))
+	require.NoError(t, err)
+	assert.Equal(t, "secret manifest", string(decrypted))
+
+	otherKey, err := NewKey([]byte("fedcba9876543210"))
+	require.NoError(t, err)
+	_, err = Decrypt(encrypted, otherKey, []byte("my-key"))
+	assert.Error(t, err)
+
+	// the ciphertext is bound to the additional data
+	_, err = Decrypt(encrypted, key, []byte("other-key"))
+	assert.Error(t, err)
+	_, err = Decrypt(encrypted, key, nil)
+	assert.Error(t, err)
+
+	_, err = Decrypt([]byte("short"), key, nil)
+	assert.Error(t, err)
+}
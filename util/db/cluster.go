@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 var (
@@ -31,6 +33,15 @@ var (
 	initLocalCluster sync.Once
 )
 
+const (
+	// clusterResourceExclusionsKey is the key of the cluster secret holding the resources of the cluster excluded from the watch
+	clusterResourceExclusionsKey = "resource.exclusions"
+	// clusterResourceInclusionsKey is the key of the cluster secret holding the only resources of the cluster to watch
+	clusterResourceInclusionsKey = "resource.inclusions"
+	// clusterResyncIntervalKey is the key of the cluster secret holding the interval of the full resync of the cluster cache
+	clusterResyncIntervalKey = "cache.resync.interval"
+)
+
 func (db *db) getLocalCluster() *appv1.Cluster {
 	initLocalCluster.Do(func() {
 		info, err := db.kubeclientset.Discovery().ServerVersion()
@@ -79,6 +90,9 @@ func (db *db) ListClusters(ctx context.Context) (*appv1.ClusterList, error) {
 
 // CreateCluster creates a cluster
 func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Cluster, error) {
+	if err := c.Config.ValidateExecProvider(appv1.ExecProviderAllowedPaths); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	secName, err := URIToSecretName("cluster", c.Server)
 	if err != nil {
 		return nil, err
@@ -125,63 +139,126 @@ func (db *db) WatchClusters(ctx context.Context,
 	}
 	handleAddEvent(localCls)
 
+	resolveCredentials := func(secret *apiv1.Secret, cluster *appv1.Cluster) {
+		if err := db.resolveClusterCredentials(ctx, secret, cluster); err != nil {
+			log.Errorf("could not resolve the credentials of cluster %s: %v", cluster.Server, err)
+		}
+	}
+
+	// the events are handled in order by a single goroutine rather than by the informer callbacks, since resolving the
+	// references to the external credentials may take a while
+	events := &clusterEventQueue{notify: make(chan struct{}, 1)}
+	go events.run(ctx)
+
 	db.watchSecrets(
 		ctx,
 		common.LabelValueSecretTypeCluster,
 
 		func(secret *apiv1.Secret) {
-			cluster, err := secretToCluster(secret)
-			if err != nil {
-				log.Errorf("could not unmarshal cluster secret %s", secret.Name)
-				return
-			}
-			if cluster.Server == appv1.KubernetesInternalAPIServerAddr {
-				// change local cluster event to modified or deleted, since it cannot be re-added or deleted
-				handleModEvent(localCls, cluster)
-				localCls = cluster
-				return
-			}
-			handleAddEvent(cluster)
+			events.add(func() {
+				cluster, err := secretToCluster(secret)
+				if err != nil {
+					log.Errorf("could not unmarshal cluster secret %s", secret.Name)
+					return
+				}
+				resolveCredentials(secret, cluster)
+				if cluster.Server == appv1.KubernetesInternalAPIServerAddr {
+					// change local cluster event to modified or deleted, since it cannot be re-added or deleted
+					handleModEvent(localCls, cluster)
+					localCls = cluster
+					return
+				}
+				handleAddEvent(cluster)
+			})
 		},
 
 		func(oldSecret *apiv1.Secret, newSecret *apiv1.Secret) {
-			oldCluster, err := secretToCluster(oldSecret)
-			if err != nil {
-				log.Errorf("could not unmarshal cluster secret %s", oldSecret.Name)
-				return
-			}
-			newCluster, err := secretToCluster(newSecret)
-			if err != nil {
-				log.Errorf("could not unmarshal cluster secret %s", newSecret.Name)
-				return
-			}
-			if newCluster.Server == appv1.KubernetesInternalAPIServerAddr {
-				localCls = newCluster
-			}
-			handleModEvent(oldCluster, newCluster)
+			events.add(func() {
+				oldCluster, err := secretToCluster(oldSecret)
+				if err != nil {
+					log.Errorf("could not unmarshal cluster secret %s", oldSecret.Name)
+					return
+				}
+				newCluster, err := secretToCluster(newSecret)
+				if err != nil {
+					log.Errorf("could not unmarshal cluster secret %s", newSecret.Name)
+					return
+				}
+				resolveCredentials(oldSecret, oldCluster)
+				resolveCredentials(newSecret, newCluster)
+				if newCluster.Server == appv1.KubernetesInternalAPIServerAddr {
+					localCls = newCluster
+				}
+				handleModEvent(oldCluster, newCluster)
+			})
 		},
 
 		func(secret *apiv1.Secret) {
-			if string(secret.Data["server"]) == appv1.KubernetesInternalAPIServerAddr {
-				// change local cluster event to modified or deleted, since it cannot be re-added or deleted
-				handleModEvent(localCls, db.getLocalCluster())
-				localCls = db.getLocalCluster()
-			} else {
-				handleDeleteEvent(string(secret.Data["server"]))
-			}
+			events.add(func() {
+				if string(secret.Data["server"]) == appv1.KubernetesInternalAPIServerAddr {
+					// change local cluster event to modified or deleted, since it cannot be re-added or deleted
+					handleModEvent(localCls, db.getLocalCluster())
+					localCls = db.getLocalCluster()
+				} else {
+					handleDeleteEvent(string(secret.Data["server"]))
+				}
+			})
 		},
 	)
 
 	return err
 }
 
+// clusterEventQueue is an unbounded queue of cluster events, so that queuing an event never blocks the informer
+type clusterEventQueue struct {
+	lock   sync.Mutex
+	events []func()
+	notify chan struct{}
+}
+
+func (q *clusterEventQueue) add(event func()) {
+	q.lock.Lock()
+	q.events = append(q.events, event)
+	q.lock.Unlock()
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *clusterEventQueue) next() (func(), bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if len(q.events) == 0 {
+		return nil, false
+	}
+	event := q.events[0]
+	q.events[0] = nil
+	q.events = q.events[1:]
+	return event, true
+}
+
+// run handles the queued events until the context is done
+func (q *clusterEventQueue) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.notify:
+		}
+		for event, ok := q.next(); ok && ctx.Err() == nil; event, ok = q.next() {
+			event()
+		}
+	}
+}
+
 func (db *db) getClusterSecret(server string) (*apiv1.Secret, error) {
-	clusterSecrets, err := db.listSecretsByType(common.LabelValueSecretTypeCluster)
+	clusterSecrets, err := db.settingsMgr.GetSecretsByIndex(settings.ByClusterURLIndexer, strings.TrimRight(server, "/"))
 	if err != nil {
 		return nil, err
 	}
 	for _, clusterSecret := range clusterSecrets {
-		if cluster, err := secretToCluster(clusterSecret); err == nil && cluster.Server == strings.TrimRight(server, "/") {
+		if _, err := secretToCluster(clusterSecret); err == nil {
 			return clusterSecret, nil
 		}
 	}
@@ -198,7 +275,14 @@ func (db *db) GetCluster(ctx context.Context, server string) (*appv1.Cluster, er
 			return nil, err
 		}
 	}
-	return secretToCluster(clusterSecret)
+	cluster, err := secretToCluster(clusterSecret)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.resolveClusterCredentials(ctx, clusterSecret, cluster); err != nil {
+		return nil, err
+	}
+	return cluster, nil
 }
 
 // UpdateCluster updates a cluster
@@ -210,6 +294,19 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
+	if err := c.Config.ValidateExecProvider(appv1.ExecProviderAllowedPaths); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if existing, err := secretToCluster(clusterSecret); err == nil {
+		// keep the references to the external credentials if the cluster was retrieved using GetCluster
+		c = c.DeepCopy()
+		if c.Config.Password, err = db.updatedCredential(ctx, clusterSecret, c.Project, existing.Config.Password, c.Config.Password); err != nil {
+			return nil, err
+		}
+		if c.Config.BearerToken, err = db.updatedCredential(ctx, clusterSecret, c.Project, existing.Config.BearerToken, c.Config.BearerToken); err != nil {
+			return nil, err
+		}
+	}
 	if err := clusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
@@ -267,13 +364,32 @@ func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
+	if len(c.ResourceExclusions) > 0 {
+		if data[clusterResourceExclusionsKey], err = yaml.Marshal(c.ResourceExclusions); err != nil {
+			return err
+		}
+	}
+	if len(c.ResourceInclusions) > 0 {
+		if data[clusterResourceInclusionsKey], err = yaml.Marshal(c.ResourceInclusions); err != nil {
+			return err
+		}
+	}
+	if c.ResyncInterval != nil {
+		data[clusterResyncIntervalKey] = []byte(c.ResyncInterval.Duration.String())
+	}
 	secret.Data = data
 
 	secret.Labels = c.Labels
-	secret.Annotations = c.Annotations
-
-	if secret.Annotations == nil {
-		secret.Annotations = make(map[string]string)
+	// the opt-in to the references to external credentials can only be set on the secret itself
+	credentialsProvider := secret.Annotations[common.AnnotationKeyCredentialsProvider]
+	secret.Annotations = make(map[string]string, len(c.Annotations))
+	for key, value := range c.Annotations {
+		if key != common.AnnotationKeyCredentialsProvider {
+			secret.Annotations[key] = value
+		}
+	}
+	if credentialsProvider != "" {
+		secret.Annotations[common.AnnotationKeyCredentialsProvider] = credentialsProvider
 	}
 
 	if c.RefreshRequestedAt != nil {
@@ -317,6 +433,22 @@ func secretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
 			shard = pointer.Int64Ptr(int64(val))
 		}
 	}
+	resourceExclusions, err := secretToClusterResourceFilters(s, clusterResourceExclusionsKey)
+	if err != nil {
+		log.Warnf("Error while parsing resource exclusions in cluster secret '%s': %v", s.Name, err)
+	}
+	resourceInclusions, err := secretToClusterResourceFilters(s, clusterResourceInclusionsKey)
+	if err != nil {
+		log.Warnf("Error while parsing resource inclusions in cluster secret '%s': %v", s.Name, err)
+	}
+	var resyncInterval *metav1.Duration
+	if resyncIntervalStr := s.Data[clusterResyncIntervalKey]; resyncIntervalStr != nil {
+		if val, err := time.ParseDuration(string(resyncIntervalStr)); err != nil || val <= 0 {
+			log.Warnf("Error while parsing resync interval in cluster secret '%s': invalid duration %q", s.Name, string(resyncIntervalStr))
+		} else {
+			resyncInterval = &metav1.Duration{Duration: val}
+		}
+	}
 	cluster := appv1.Cluster{
 		ID:                 string(s.UID),
 		Server:             strings.TrimRight(string(s.Data["server"]), "/"),
@@ -329,6 +461,22 @@ func secretToCluster(s *apiv1.Secret) (*appv1.Cluster, error) {
 		Project:            string(s.Data["project"]),
 		Labels:             s.GetLabels(),
 		Annotations:        s.GetAnnotations(),
+		ResourceExclusions: resourceExclusions,
+		ResourceInclusions: resourceInclusions,
+		ResyncInterval:     resyncInterval,
 	}
 	return &cluster, nil
 }
+
+// secretToClusterResourceFilters parses the resource filters stored under the given key of a cluster secret
+func secretToClusterResourceFilters(s *apiv1.Secret, key string) ([]appv1.ClusterResourceFilter, error) {
+	value := s.Data[key]
+	if len(value) == 0 {
+		return nil, nil
+	}
+	var filters []appv1.ClusterResourceFilter
+	if err := yaml.Unmarshal(value, &filters); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
@@ -2,13 +2,17 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -56,6 +60,70 @@ func Test_secretToCluster(t *testing.T) {
 	})
 }
 
+func Test_secretToCluster_ResyncInterval(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mycluster",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"server":         []byte("http://mycluster"),
+			"cache.resync.interval": []byte("24h"),
+		},
+	}
+	cluster, err := secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Equal(t, &metav1.Duration{Duration: 24 * time.Hour}, cluster.ResyncInterval)
+
+	// invalid intervals are ignored
+	secret.Data["cache.resync.interval"] = []byte("-1h")
+	cluster, err = secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.ResyncInterval)
+
+	secret.Data["cache.resync.interval"] = []byte("daily")
+	cluster, err = secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Nil(t, cluster.ResyncInterval)
+
+	secret.Data = map[string][]byte{}
+	err = clusterToSecret(&v1alpha1.Cluster{Server: "http://mycluster", ResyncInterval: &metav1.Duration{Duration: 90 * time.Minute}}, secret)
+	require.NoError(t, err)
+	assert.Equal(t, "1h30m0s", string(secret.Data["cache.resync.interval"]))
+}
+
+func Test_secretToCluster_ResourceFilters(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mycluster",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"name":   []byte("test"),
+			"server": []byte("http://mycluster"),
+			"resource.exclusions": []byte(`- apiGroups:
+  - "*.noisy.io"
+  kinds:
+  - Report
+`),
+			"resource.inclusions": []byte("invalid"),
+		},
+	}
+	cluster, err := secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ClusterResourceFilter{{APIGroups: []string{"*.noisy.io"}, Kinds: []string{"Report"}}}, cluster.ResourceExclusions)
+	// invalid filters are ignored
+	assert.Empty(t, cluster.ResourceInclusions)
+
+	cluster.ResourceInclusions = []v1alpha1.ClusterResourceFilter{{APIGroups: []string{"apps"}}}
+	require.NoError(t, clusterToSecret(cluster, secret))
+	assert.Equal(t, "- apiGroups:\n  - apps\n", string(secret.Data["resource.inclusions"]))
+	roundTripped, err := secretToCluster(secret)
+	require.NoError(t, err)
+	assert.Equal(t, cluster.ResourceExclusions, roundTripped.ResourceExclusions)
+	assert.Equal(t, cluster.ResourceInclusions, roundTripped.ResourceInclusions)
+}
+
 func Test_secretToCluster_NoConfig(t *testing.T) {
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -126,6 +194,44 @@ func TestUpdateCluster(t *testing.T) {
 	assert.Equal(t, secret.Annotations[v1alpha1.AnnotationKeyRefresh], requestedAt.Format(time.RFC3339))
 }
 
+func TestCreateCluster_ExecProviderNotAllowed(t *testing.T) {
+	allowedPaths := appv1.ExecProviderAllowedPaths
+	appv1.ExecProviderAllowedPaths = []string{"/custom-tools"}
+	defer func() {
+		appv1.ExecProviderAllowedPaths = allowedPaths
+	}()
+	kubeclientset := fake.NewSimpleClientset()
+	settingsManager := settings.NewSettingsManager(context.Background(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+
+	_, err := db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: "http://mycluster",
+		Config: v1alpha1.ClusterConfig{ExecProviderConfig: &v1alpha1.ExecProviderConfig{Command: "/usr/bin/my-auth-plugin"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = db.UpdateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: "http://mycluster",
+		Config: v1alpha1.ClusterConfig{ExecProviderConfig: &v1alpha1.ExecProviderConfig{Command: "/usr/bin/my-auth-plugin"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: "http://mycluster",
+		Config: v1alpha1.ClusterConfig{ExecProviderConfig: &v1alpha1.ExecProviderConfig{
+			Command: "/custom-tools/my-auth-plugin",
+			Env:     map[string]string{"LD_PRELOAD": "/tmp/evil.so"},
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = db.CreateCluster(context.Background(), &v1alpha1.Cluster{
+		Server: "http://mycluster",
+		Config: v1alpha1.ClusterConfig{ExecProviderConfig: &v1alpha1.ExecProviderConfig{Command: "/custom-tools/my-auth-plugin"}},
+	})
+	assert.NoError(t, err)
+}
+
 func TestDeleteUnknownCluster(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(&v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -259,3 +365,59 @@ func TestListClusters(t *testing.T) {
 		assert.Len(t, clusters.Items, 2)
 	})
 }
+
+func newBenchmarkDB(b *testing.B, secretType string, count int, data func(i int) map[string][]byte) ArgoDB {
+	objects := make([]runtime.Object, 0, count+1)
+	objects = append(objects, &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDConfigMapName,
+			Namespace: fakeNamespace,
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+	})
+	for i := 0; i < count; i++ {
+		objects = append(objects, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", secretType, i),
+				Namespace: fakeNamespace,
+				Labels:    map[string]string{common.LabelKeySecretType: secretType},
+			},
+			Data: data(i),
+		})
+	}
+	kubeclientset := fake.NewSimpleClientset(objects...)
+	settingsManager := settings.NewSettingsManager(context.Background(), kubeclientset, fakeNamespace)
+	db := NewDB(fakeNamespace, settingsManager, kubeclientset)
+	// warm up the informers
+	_, err := settingsManager.GetSecretsLister()
+	require.NoError(b, err)
+	return db
+}
+
+func benchmarkClusterData(i int) map[string][]byte {
+	return map[string][]byte{
+		"server": []byte(fmt.Sprintf("https://cluster-%d", i)),
+		"name":   []byte(fmt.Sprintf("cluster-%d", i)),
+		"config": []byte(`{"bearerToken":"token"}`),
+	}
+}
+
+func BenchmarkListClusters(b *testing.B) {
+	db := newBenchmarkDB(b, common.LabelValueSecretTypeCluster, 5000, benchmarkClusterData)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		clusters, err := db.ListClusters(context.Background())
+		require.NoError(b, err)
+		require.Len(b, clusters.Items, 5001)
+	}
+}
+
+func BenchmarkGetCluster(b *testing.B) {
+	db := newBenchmarkDB(b, common.LabelValueSecretTypeCluster, 5000, benchmarkClusterData)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cluster, err := db.GetCluster(context.Background(), fmt.Sprintf("https://cluster-%d", i%5000))
+		require.NoError(b, err)
+		require.Equal(b, fmt.Sprintf("cluster-%d", i%5000), cluster.Name)
+	}
+}
@@ -0,0 +1,217 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// CredentialsProvider fetches credentials from an external secret store. The repository, credential template and
+// cluster secrets annotated with "argocd.argoproj.io/credentials-provider: <scheme>" can reference such credentials as
+// "<scheme>:<reference>" instead of holding their value, e.g. "vault:secret/data/argocd/github#password". The
+// references are resolved whenever the credentials are used, so the secret values are never stored in Kubernetes
+// secrets.
+type CredentialsProvider interface {
+	// GetCredential returns the value of the credential with the given reference, which does not include the scheme
+	GetCredential(ctx context.Context, ref string) (string, error)
+}
+
+var (
+	credentialsProvidersLock sync.RWMutex
+	credentialsProviders     = map[string]CredentialsProvider{}
+
+	// credentialsCacheExpiration is how long the resolved credentials are cached for
+	credentialsCacheExpiration = time.Minute
+)
+
+// The keys of the repository and credential template secrets which can reference external credentials
+var repositoryCredentialKeys = []string{"password", "sshPrivateKey", "tlsClientCertKey", "githubAppPrivateKey"}
+
+// RegisterCredentialsProvider registers the provider resolving the credentials referenced with the given scheme
+func RegisterCredentialsProvider(scheme string, provider CredentialsProvider) {
+	credentialsProvidersLock.Lock()
+	defer credentialsProvidersLock.Unlock()
+	credentialsProviders[scheme] = provider
+}
+
+// UnregisterCredentialsProvider removes the provider resolving the credentials referenced with the given scheme
+func UnregisterCredentialsProvider(scheme string) {
+	credentialsProvidersLock.Lock()
+	defer credentialsProvidersLock.Unlock()
+	delete(credentialsProviders, scheme)
+}
+
+func getCredentialsProvider(scheme string) (CredentialsProvider, bool) {
+	credentialsProvidersLock.RLock()
+	defer credentialsProvidersLock.RUnlock()
+	provider, ok := credentialsProviders[scheme]
+	return provider, ok
+}
+
+// credentialsProviderScheme returns the scheme of the given credential of the given secret if it references an
+// external secret store, which is only the case if the secret opted in to it with the credentials provider annotation
+func credentialsProviderScheme(secret *corev1.Secret, value string) (string, bool) {
+	scheme := secret.Annotations[common.AnnotationKeyCredentialsProvider]
+	if scheme == "" || !strings.HasPrefix(value, scheme+":") {
+		return "", false
+	}
+	return scheme, true
+}
+
+type resolvedCredential struct {
+	value     string
+	expiresAt time.Time
+}
+
+// credentialsCache caches the resolved credentials by reference
+type credentialsCache struct {
+	lock        sync.Mutex
+	credentials map[string]resolvedCredential
+}
+
+func (c *credentialsCache) get(ref string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cached, ok := c.credentials[ref]
+	if !ok || time.Now().After(cached.expiresAt) {
+		return "", false
+	}
+	return cached.value, true
+}
+
+func (c *credentialsCache) set(ref string, value string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now()
+	if c.credentials == nil {
+		c.credentials = make(map[string]resolvedCredential)
+	}
+	// evict the expired credentials, so that the credentials no longer referenced do not stay in memory
+	for cachedRef, cached := range c.credentials {
+		if now.After(cached.expiresAt) {
+			delete(c.credentials, cachedRef)
+		}
+	}
+	c.credentials[ref] = resolvedCredential{value: value, expiresAt: now.Add(credentialsCacheExpiration)}
+}
+
+// checkCredentialReference returns an error if the administrators did not allow the repositories and clusters of the
+// given project to use the given reference, so that the users allowed to create them cannot make Argo CD read any
+// credential of the external secret store.
+func (db *db) checkCredentialReference(project string, ref string) error {
+	references, err := db.settingsMgr.GetAllowedCredentialsReferences()
+	if err != nil {
+		return err
+	}
+	for _, reference := range references {
+		if !strings.HasPrefix(ref, reference.Prefix) {
+			continue
+		}
+		if len(reference.Projects) == 0 && project == "" {
+			return nil
+		}
+		for _, allowedProject := range reference.Projects {
+			if allowedProject == project {
+				return nil
+			}
+		}
+	}
+	if project == "" {
+		return fmt.Errorf("the credential %q is not allowed to be referenced", ref)
+	}
+	return fmt.Errorf("the credential %q is not allowed to be referenced in project %q", ref, project)
+}
+
+// resolveCredential returns the value of the given credential of the given secret if it references an external
+// secret store, or the credential itself otherwise
+func (db *db) resolveCredential(ctx context.Context, secret *corev1.Secret, project string, value string) (string, error) {
+	scheme, ok := credentialsProviderScheme(secret, value)
+	if !ok {
+		return value, nil
+	}
+	provider, ok := getCredentialsProvider(scheme)
+	if !ok {
+		return "", fmt.Errorf("failed to get the credential %q: no %s credentials provider is configured", value, scheme)
+	}
+	if err := db.checkCredentialReference(project, value); err != nil {
+		return "", err
+	}
+	if resolved, ok := db.credentialsCache.get(value); ok {
+		return resolved, nil
+	}
+	resolved, err := provider.GetCredential(ctx, strings.TrimPrefix(value, scheme+":"))
+	if err != nil {
+		return "", fmt.Errorf("failed to get the credential %q: %w", value, err)
+	}
+	db.credentialsCache.set(value, resolved)
+	return resolved, nil
+}
+
+// resolveCredentials resolves in place the given credentials of the given secret
+func (db *db) resolveCredentials(ctx context.Context, secret *corev1.Secret, project string, values ...*string) error {
+	for _, value := range values {
+		resolved, err := db.resolveCredential(ctx, secret, project, *value)
+		if err != nil {
+			return err
+		}
+		*value = resolved
+	}
+	return nil
+}
+
+func (db *db) resolveRepositoryCredentials(ctx context.Context, secret *corev1.Secret, repo *appsv1.Repository) error {
+	return db.resolveCredentials(ctx, secret, repo.Project, &repo.Password, &repo.SSHPrivateKey, &repo.TLSClientCertKey, &repo.GithubAppPrivateKey)
+}
+
+func (db *db) resolveRepoCredsCredentials(ctx context.Context, secret *corev1.Secret, creds *appsv1.RepoCreds) error {
+	return db.resolveCredentials(ctx, secret, "", &creds.Password, &creds.SSHPrivateKey, &creds.TLSClientCertKey, &creds.GithubAppPrivateKey)
+}
+
+func (db *db) resolveClusterCredentials(ctx context.Context, secret *corev1.Secret, cluster *appsv1.Cluster) error {
+	return db.resolveCredentials(ctx, secret, cluster.Project, &cluster.Config.Password, &cluster.Config.BearerToken)
+}
+
+// updatedCredential returns the credential to store in the given secret being updated with the given value. The
+// reference held by the existing credential is kept if the value is its resolved value, so that updating an object
+// retrieved from the database does not store the resolved value. The references themselves can only be changed by
+// editing the secret, so that the users allowed to update the object cannot make Argo CD read other credentials.
+func (db *db) updatedCredential(ctx context.Context, secret *corev1.Secret, project string, existing string, value string) (string, error) {
+	if value == existing || secret.Annotations[common.AnnotationKeyCredentialsProvider] == "" {
+		return value, nil
+	}
+	if _, ok := credentialsProviderScheme(secret, existing); ok {
+		resolved, err := db.resolveCredential(ctx, secret, project, existing)
+		if err != nil {
+			log.Warnf("Failed to resolve the existing credential: %v", err)
+		} else if resolved == value {
+			return existing, nil
+		}
+	}
+	if _, ok := credentialsProviderScheme(secret, value); ok {
+		return "", status.Errorf(codes.InvalidArgument, "the references to external credentials can only be changed in the secret %s", secret.Name)
+	}
+	return value, nil
+}
+
+// updateRepositorySecretCredentials updates in place the credentials of the given repository or credential template
+// secret, which previously held the given data, as returned by updatedCredential
+func (db *db) updateRepositorySecretCredentials(ctx context.Context, secret *corev1.Secret, project string, existingData map[string][]byte) error {
+	for _, key := range repositoryCredentialKeys {
+		value, err := db.updatedCredential(ctx, secret, project, string(existingData[key]), string(secret.Data[key]))
+		if err != nil {
+			return err
+		}
+		updateSecretString(secret, key, value)
+	}
+	return nil
+}
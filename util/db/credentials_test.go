@@ -0,0 +1,265 @@
+package db
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const testAllowedCredentialsReferences = `
+- prefix: fake:github#
+- prefix: fake:cluster#
+- prefix: fake:team-a/
+  projects:
+  - team-a
+`
+
+type fakeCredentialsProvider struct {
+	credentials map[string]string
+	calls       int
+}
+
+func (p *fakeCredentialsProvider) GetCredential(_ context.Context, ref string) (string, error) {
+	p.calls++
+	value, ok := p.credentials[ref]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return value, nil
+}
+
+func registerFakeCredentialsProvider(t *testing.T, credentials map[string]string) *fakeCredentialsProvider {
+	provider := &fakeCredentialsProvider{credentials: credentials}
+	RegisterCredentialsProvider("fake", provider)
+	t.Cleanup(func() {
+		UnregisterCredentialsProvider("fake")
+	})
+	return provider
+}
+
+func newCredentialsTestDB(objects ...*corev1.Secret) *db {
+	var runtimeObjects []runtime.Object
+	for _, object := range objects {
+		runtimeObjects = append(runtimeObjects, object)
+	}
+	clientset := getClientset(map[string]string{"credentials.allowedReferences": testAllowedCredentialsReferences}, runtimeObjects...)
+	return NewDB(testNamespace, settings.NewSettingsManager(context.Background(), clientset, testNamespace), clientset).(*db)
+}
+
+func optedInSecret(scheme string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:        "my-secret",
+		Annotations: map[string]string{common.AnnotationKeyCredentialsProvider: scheme},
+	}}
+}
+
+func TestResolveCredential(t *testing.T) {
+	provider := registerFakeCredentialsProvider(t, map[string]string{
+		"github#password":      "my-password",
+		"team-a/repo#password": "team-password",
+	})
+	testee := newCredentialsTestDB()
+	ctx := context.Background()
+
+	value, err := testee.resolveCredential(ctx, optedInSecret("fake"), "", "fake:github#password")
+	require.NoError(t, err)
+	assert.Equal(t, "my-password", value)
+	// the resolved credentials are cached
+	_, err = testee.resolveCredential(ctx, optedInSecret("fake"), "", "fake:github#password")
+	require.NoError(t, err)
+	assert.Equal(t, 1, provider.calls)
+
+	value, err = testee.resolveCredential(ctx, optedInSecret("fake"), "team-a", "fake:team-a/repo#password")
+	require.NoError(t, err)
+	assert.Equal(t, "team-password", value)
+
+	t.Run("NotOptedIn", func(t *testing.T) {
+		value, err := testee.resolveCredential(ctx, &corev1.Secret{}, "", "fake:github#password")
+		require.NoError(t, err)
+		assert.Equal(t, "fake:github#password", value)
+
+		value, err = testee.resolveCredential(ctx, optedInSecret("other"), "", "fake:github#password")
+		require.NoError(t, err)
+		assert.Equal(t, "fake:github#password", value)
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		_, err := testee.resolveCredential(ctx, optedInSecret("fake"), "", "fake:team-a/repo#password")
+		assert.EqualError(t, err, `the credential "fake:team-a/repo#password" is not allowed to be referenced`)
+
+		_, err = testee.resolveCredential(ctx, optedInSecret("fake"), "team-b", "fake:team-a/repo#password")
+		assert.EqualError(t, err, `the credential "fake:team-a/repo#password" is not allowed to be referenced in project "team-b"`)
+
+		_, err = testee.resolveCredential(ctx, optedInSecret("fake"), "team-a", "fake:github#password")
+		assert.Error(t, err)
+	})
+
+	t.Run("NoProvider", func(t *testing.T) {
+		_, err := testee.resolveCredential(ctx, optedInSecret("vault"), "", "vault:secret/data/argocd#password")
+		assert.EqualError(t, err, `failed to get the credential "vault:secret/data/argocd#password": no vault credentials provider is configured`)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := testee.resolveCredential(ctx, optedInSecret("fake"), "", "fake:github#username")
+		assert.EqualError(t, err, `failed to get the credential "fake:github#username": not found`)
+	})
+}
+
+func TestCredentialsCache_EvictsExpiredCredentials(t *testing.T) {
+	defer func(expiration time.Duration) {
+		credentialsCacheExpiration = expiration
+	}(credentialsCacheExpiration)
+	credentialsCacheExpiration = -time.Second
+
+	cache := &credentialsCache{}
+	cache.set("fake:github#password", "my-password")
+	_, ok := cache.get("fake:github#password")
+	assert.False(t, ok)
+
+	cache.set("fake:gitlab#password", "my-password")
+	assert.Len(t, cache.credentials, 1)
+}
+
+func TestGetRepository_ExternalCredentials(t *testing.T) {
+	registerFakeCredentialsProvider(t, map[string]string{"github#password": "my-password"})
+	newRepoSecret := func(name string, url string, annotations map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      name,
+				Labels: map[string]string{
+					common.LabelKeySecretType: common.LabelValueSecretTypeRepository,
+				},
+				Annotations: annotations,
+			},
+			Data: map[string][]byte{
+				"url":      []byte(url),
+				"username": []byte("my-username"),
+				"password": []byte("fake:github#password"),
+			},
+		}
+	}
+	testee := newCredentialsTestDB(
+		newRepoSecret("repo-secret", "https://github.com/argoproj/argo-cd", map[string]string{common.AnnotationKeyCredentialsProvider: "fake"}),
+		newRepoSecret("literal-secret", "https://github.com/argoproj/literal", nil),
+	)
+
+	repo, err := testee.GetRepository(context.Background(), "https://github.com/argoproj/argo-cd")
+	require.NoError(t, err)
+	assert.Equal(t, "my-username", repo.Username)
+	assert.Equal(t, "my-password", repo.Password)
+
+	// the secrets which did not opt in hold literal credentials
+	repo, err = testee.GetRepository(context.Background(), "https://github.com/argoproj/literal")
+	require.NoError(t, err)
+	assert.Equal(t, "fake:github#password", repo.Password)
+
+	// the references cannot be changed through the API
+	repo.Repo = "https://github.com/argoproj/argo-cd"
+	repo.Password = "fake:gitlab#password"
+	_, err = testee.UpdateRepository(context.Background(), repo)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetAndUpdateCluster_ExternalCredentials(t *testing.T) {
+	registerFakeCredentialsProvider(t, map[string]string{"cluster#token": "my-token"})
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "mycluster",
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeCluster,
+			},
+			Annotations: map[string]string{
+				common.AnnotationKeyCredentialsProvider: "fake",
+			},
+		},
+		Data: map[string][]byte{
+			"server": []byte("https://mycluster"),
+			"config": []byte(`{"bearerToken":"fake:cluster#token"}`),
+		},
+	}
+	testee := newCredentialsTestDB(clusterSecret)
+	clientset := testee.kubeclientset
+
+	cluster, err := testee.GetCluster(context.Background(), "https://mycluster")
+	require.NoError(t, err)
+	assert.Equal(t, "my-token", cluster.Config.BearerToken)
+
+	// the reference is kept when updating the retrieved cluster
+	cluster.Name = "my-cluster"
+	_, err = testee.UpdateCluster(context.Background(), cluster)
+	require.NoError(t, err)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.Background(), "mycluster", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "my-cluster", string(secret.Data["name"]))
+	assert.Contains(t, string(secret.Data["config"]), `"bearerToken":"fake:cluster#token"`)
+	assert.Equal(t, "fake", secret.Annotations[common.AnnotationKeyCredentialsProvider])
+
+	// but not when the credential is changed
+	cluster.Config.BearerToken = "new-token"
+	_, err = testee.UpdateCluster(context.Background(), cluster)
+	require.NoError(t, err)
+	secret, err = clientset.CoreV1().Secrets(testNamespace).Get(context.Background(), "mycluster", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, string(secret.Data["config"]), `"bearerToken":"new-token"`)
+
+	// the references cannot be changed through the API
+	cluster.Config.BearerToken = "fake:other#token"
+	_, err = testee.UpdateCluster(context.Background(), cluster)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateCluster_CannotOptInToExternalCredentials(t *testing.T) {
+	registerFakeCredentialsProvider(t, map[string]string{"cluster#token": "my-token"})
+	testee := newCredentialsTestDB()
+
+	cluster := &appv1.Cluster{
+		Server:      "https://mycluster",
+		Config:      appv1.ClusterConfig{BearerToken: "fake:cluster#token"},
+		Annotations: map[string]string{common.AnnotationKeyCredentialsProvider: "fake"},
+	}
+	_, err := testee.CreateCluster(context.Background(), cluster)
+	require.NoError(t, err)
+
+	cluster, err = testee.GetCluster(context.Background(), "https://mycluster")
+	require.NoError(t, err)
+	assert.Equal(t, "fake:cluster#token", cluster.Config.BearerToken)
+	assert.NotContains(t, cluster.Annotations, common.AnnotationKeyCredentialsProvider)
+}
+
+func TestClusterEventQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	queue := &clusterEventQueue{notify: make(chan struct{}, 1)}
+	go queue.run(ctx)
+
+	handled := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		queue.add(func() {
+			handled <- i
+		})
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case event := <-handled:
+			assert.Equal(t, i, event)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the event was not handled")
+		}
+	}
+}
@@ -75,12 +75,16 @@ type ArgoDB interface {
 	AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*appv1.GnuPGPublicKey, []string, error)
 	// DeleteGPGPublicKey removes a GPG public key from the configuration
 	DeleteGPGPublicKey(ctx context.Context, keyID string) error
+	// SyncGPGPublicKeys makes the GPG public keys stored in secrets available to the repo server until the context is done
+	SyncGPGPublicKeys(ctx context.Context)
 }
 
 type db struct {
 	ns            string
 	kubeclientset kubernetes.Interface
 	settingsMgr   *settings.SettingsManager
+
+	credentialsCache credentialsCache
 }
 
 // NewDB returns a new instance of the argo database
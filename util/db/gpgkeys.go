@@ -5,8 +5,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -51,7 +60,39 @@ func validatePGPKey(keyData string) (*appsv1.GnuPGPublicKey, error) {
 	}
 }
 
-// ListConfiguredGPGPublicKeys returns a list of all configured GPG public keys from the ConfigMap
+// gpgKeysSyncLeaseName is the name of the lease electing the API server replica syncing the GPG public keys
+const gpgKeysSyncLeaseName = "argocd-gpg-keys-sync"
+
+// gpgKeySecretDataKey is the key of the GPG public key secrets holding the ASCII armored public key
+const gpgKeySecretDataKey = "keyData"
+
+// gpgKeySecretName returns the name of the secret created for the GPG public key with the given ID
+func gpgKeySecretName(keyID string) string {
+	return "gpg-key-" + strings.ToLower(keyID)
+}
+
+// listGPGPublicKeySecrets returns the GPG public keys stored in secrets, along with the secret holding each of them
+func (db *db) listGPGPublicKeySecrets() (map[string]*appsv1.GnuPGPublicKey, map[string]*apiv1.Secret, error) {
+	secrets, err := db.listSecretsByType(common.LabelValueSecretTypeGPGKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make(map[string]*appsv1.GnuPGPublicKey)
+	keySecrets := make(map[string]*apiv1.Secret)
+	for _, secret := range secrets {
+		parsedKey, err := validatePGPKey(string(secret.Data[gpgKeySecretDataKey]))
+		if err != nil {
+			// skipped like by the sync to the ConfigMap, so that a single invalid secret does not break the others
+			log.Warnf("Ignoring invalid GPG key in secret '%s': %v", secret.Name, err)
+			continue
+		}
+		keys[parsedKey.KeyID] = parsedKey
+		keySecrets[parsedKey.KeyID] = secret
+	}
+	return keys, keySecrets, nil
+}
+
+// ListConfiguredGPGPublicKeys returns a list of all configured GPG public keys from the secrets and the ConfigMap
 func (db *db) ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*appsv1.GnuPGPublicKey, error) {
 	log.Debugf("Loading PGP public keys from config map")
 	result := make(map[string]*appsv1.GnuPGPublicKey)
@@ -79,10 +120,19 @@ func (db *db) ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*apps
 		}
 	}
 
+	log.Debugf("Loading PGP public keys from secrets")
+	secretKeys, _, err := db.listGPGPublicKeySecrets()
+	if err != nil {
+		return nil, err
+	}
+	for kid, key := range secretKeys {
+		result[kid] = key
+	}
+
 	return result, nil
 }
 
-// AddGPGPublicKey adds one or more public keys to the configuration
+// AddGPGPublicKey adds one or more public keys to the configuration, storing each of them in its own secret
 func (db *db) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*appsv1.GnuPGPublicKey, []string, error) {
 	result := make(map[string]*appsv1.GnuPGPublicKey)
 	skipped := make([]string, 0)
@@ -92,29 +142,36 @@ func (db *db) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*
 		return nil, nil, err
 	}
 
-	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	configured, err := db.ListConfiguredGPGPublicKeys(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if keysCM.Data == nil {
-		keysCM.Data = make(map[string]string)
-	}
-
 	for kid, key := range keys {
-		if _, ok := keysCM.Data[kid]; ok {
+		if _, ok := configured[kid]; ok {
 			skipped = append(skipped, kid)
 			log.Debugf("Not adding incoming key with kid=%s because it is configured already", kid)
-		} else {
-			result[kid] = key
-			keysCM.Data[kid] = key.KeyData
-			log.Debugf("Adding incoming key with kid=%s to database", kid)
+			continue
+		}
+		keySecret := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: gpgKeySecretName(kid),
+			},
+			Data: map[string][]byte{
+				gpgKeySecretDataKey: []byte(key.KeyData),
+			},
 		}
+		if _, err := db.createSecret(ctx, common.LabelValueSecretTypeGPGKey, keySecret); err != nil {
+			return nil, nil, err
+		}
+		result[kid] = key
+		log.Debugf("Adding incoming key with kid=%s to database", kid)
 	}
 
-	err = db.settingsMgr.SaveGPGPublicKeyData(ctx, keysCM.Data)
-	if err != nil {
-		return nil, nil, err
+	if len(result) > 0 {
+		if err := db.settingsMgr.ResyncInformers(); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	return result, skipped, nil
@@ -122,6 +179,17 @@ func (db *db) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*
 
 // DeleteGPGPublicKey deletes a GPG public key from the configuration
 func (db *db) DeleteGPGPublicKey(ctx context.Context, keyID string) error {
+	_, keySecrets, err := db.listGPGPublicKeySecrets()
+	if err != nil {
+		return err
+	}
+	if keySecret, ok := keySecrets[keyID]; ok {
+		if err := db.deleteSecret(ctx, keySecret); err != nil {
+			return err
+		}
+		return db.settingsMgr.ResyncInformers()
+	}
+
 	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
 	if err != nil {
 		return err
@@ -140,3 +208,143 @@ func (db *db) DeleteGPGPublicKey(ctx context.Context, keyID string) error {
 	err = db.settingsMgr.SaveGPGPublicKeyData(ctx, keysCM.Data)
 	return err
 }
+
+// SyncGPGPublicKeys migrates the GPG public keys of the argocd-gpg-keys-cm ConfigMap to secrets, then copies the GPG
+// public keys stored in secrets to the argocd-gpg-keys-secrets-cm ConfigMap, which is mounted by the repo server along
+// with argocd-gpg-keys-cm, whenever the secrets change and until the context is done. Only the replica holding the
+// argocd-gpg-keys-sync lease syncs the keys.
+func (db *db) SyncGPGPublicKeys(ctx context.Context) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "argocd-server"
+	}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{Name: gpgKeysSyncLeaseName, Namespace: db.ns},
+		Client:    db.kubeclientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: hostname + "_" + uuid.New().String(),
+		},
+	}
+	// campaign again when the leadership is lost, until the context is done
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			ReleaseOnCancel: true,
+			LeaseDuration:   15 * time.Second,
+			RenewDeadline:   10 * time.Second,
+			RetryPeriod:     2 * time.Second,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: db.syncGPGPublicKeys,
+				OnStoppedLeading: func() {
+					log.Infof("Stopped syncing the GPG public keys stored in secrets")
+				},
+			},
+		})
+	}
+}
+
+func (db *db) syncGPGPublicKeys(ctx context.Context) {
+	log.Infof("Syncing the GPG public keys stored in secrets")
+	if err := db.migrateGPGPublicKeysConfigMap(ctx); err != nil {
+		log.Errorf("Failed to migrate the GPG public keys of the ConfigMap to secrets: %v", err)
+	}
+	syncKeys := func() {
+		if err := db.syncGPGPublicKeysConfigMap(ctx); err != nil {
+			log.Errorf("Failed to sync the GPG public keys stored in secrets: %v", err)
+		}
+	}
+	syncKeys()
+	db.watchSecrets(ctx, common.LabelValueSecretTypeGPGKey,
+		func(_ *apiv1.Secret) { syncKeys() },
+		func(_ *apiv1.Secret, _ *apiv1.Secret) { syncKeys() },
+		func(_ *apiv1.Secret) { syncKeys() })
+}
+
+// migrateGPGPublicKeysConfigMap moves the valid GPG public keys of the argocd-gpg-keys-cm ConfigMap to secrets, so that
+// all the keys are managed the same way. The invalid keys are left in the ConfigMap.
+func (db *db) migrateGPGPublicKeysConfigMap(ctx context.Context) error {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+	if len(keysCM.Data) == 0 {
+		return nil
+	}
+	remaining := make(map[string]string)
+	for k, keyData := range keysCM.Data {
+		parsedKey, err := validatePGPKey(keyData)
+		if err != nil || parsedKey.KeyID != gpg.KeyID(k) {
+			log.Warnf("Not migrating the invalid GPG key of entry '%s' of the ConfigMap to a secret", k)
+			remaining[k] = keyData
+			continue
+		}
+		keySecret := &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: gpgKeySecretName(parsedKey.KeyID),
+			},
+			Data: map[string][]byte{
+				gpgKeySecretDataKey: []byte(keyData),
+			},
+		}
+		if _, err := db.createSecret(ctx, common.LabelValueSecretTypeGPGKey, keySecret); err != nil && !apierr.IsAlreadyExists(err) {
+			return err
+		}
+		log.Infof("Migrated the GPG key with kid=%s of the ConfigMap to the secret '%s'", parsedKey.KeyID, keySecret.Name)
+	}
+	if len(remaining) == len(keysCM.Data) {
+		return nil
+	}
+	return db.settingsMgr.SaveGPGPublicKeyData(ctx, remaining)
+}
+
+func (db *db) syncGPGPublicKeysConfigMap(ctx context.Context) error {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+	// the secrets informer of the settings manager might lag behind the events of our own watch
+	secrets, err := db.kubeclientset.CoreV1().Secrets(db.ns).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeySecretType + "=" + common.LabelValueSecretTypeGPGKey,
+	})
+	if err != nil {
+		return err
+	}
+	data := make(map[string]string)
+	for _, secret := range secrets.Items {
+		keyData := string(secret.Data[gpgKeySecretDataKey])
+		parsedKey, err := validatePGPKey(keyData)
+		if err != nil {
+			log.Warnf("Ignoring invalid GPG key in secret '%s': %v", secret.Name, err)
+			continue
+		}
+		// the keys of both ConfigMaps are projected in the same directory, so they must not overlap
+		if _, ok := keysCM.Data[parsedKey.KeyID]; ok {
+			continue
+		}
+		data[parsedKey.KeyID] = keyData
+	}
+
+	configMaps := db.kubeclientset.CoreV1().ConfigMaps(db.ns)
+	secretsCM, err := configMaps.Get(ctx, common.ArgoCDGPGKeysSecretsConfigMapName, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		_, err = configMaps.Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: common.ArgoCDGPGKeysSecretsConfigMapName,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":    common.ArgoCDGPGKeysSecretsConfigMapName,
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: data,
+		}, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(secretsCM.Data, data) || len(secretsCM.Data) == 0 && len(data) == 0 {
+		return nil
+	}
+	secretsCM.Data = data
+	_, err = configMaps.Update(ctx, secretsCM, metav1.UpdateOptions{})
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -99,7 +100,7 @@ var gpgCMGarbageCMKey = v1.ConfigMap{
 }
 
 // Returns a fake client set for use in tests
-func getGPGKeysClientset(gpgCM v1.ConfigMap) *fake.Clientset {
+func getGPGKeysClientset(gpgCM v1.ConfigMap, objects ...runtime.Object) *fake.Clientset {
 	cm := v1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "argocd-cm",
@@ -111,7 +112,7 @@ func getGPGKeysClientset(gpgCM v1.ConfigMap) *fake.Clientset {
 		Data: nil,
 	}
 
-	return fake.NewSimpleClientset([]runtime.Object{&cm, &gpgCM}...)
+	return fake.NewSimpleClientset(append([]runtime.Object{&cm, &gpgCM}, objects...)...)
 }
 
 func Test_ValidatePGPKey(t *testing.T) {
@@ -202,6 +203,14 @@ func Test_ListConfiguredGPGPublicKeys(t *testing.T) {
 	}
 }
 
+func getGPGKeySecrets(t *testing.T, clientset *fake.Clientset) []v1.Secret {
+	secrets, err := clientset.CoreV1().Secrets(testNamespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: common.LabelKeySecretType + "=" + common.LabelValueSecretTypeGPGKey,
+	})
+	assert.NoError(t, err)
+	return secrets.Items
+}
+
 func Test_AddGPGPublicKey(t *testing.T) {
 	// Good case
 	{
@@ -214,45 +223,57 @@ func Test_AddGPGPublicKey(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, new, 1)
 		assert.Len(t, skipped, 0)
+		secrets := getGPGKeySecrets(t, clientset)
+		assert.Len(t, secrets, 1)
+		assert.Equal(t, "gpg-key-4aee18f83afdeb23", secrets[0].Name)
+		assert.Contains(t, string(secrets[0].Data["keyData"]), "-----BEGIN PGP PUBLIC KEY BLOCK-----")
 		cm, err := settings.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
 		assert.NoError(t, err)
-		assert.Len(t, cm.Data, 1)
+		assert.Len(t, cm.Data, 0)
 
 		// Same key should not be added, but skipped
 		new, skipped, err = db.AddGPGPublicKey(context.Background(), test.MustLoadFileToString("../gpg/testdata/github.asc"))
 		assert.NoError(t, err)
 		assert.Len(t, new, 0)
 		assert.Len(t, skipped, 1)
-		cm, err = settings.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
-		assert.NoError(t, err)
-		assert.Len(t, cm.Data, 1)
+		assert.Len(t, getGPGKeySecrets(t, clientset), 1)
 
 		// New keys should be added
 		new, skipped, err = db.AddGPGPublicKey(context.Background(), test.MustLoadFileToString("../gpg/testdata/multi.asc"))
 		assert.NoError(t, err)
 		assert.Len(t, new, 2)
 		assert.Len(t, skipped, 0)
-		cm, err = settings.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
-		assert.NoError(t, err)
-		assert.Len(t, cm.Data, 3)
+		assert.Len(t, getGPGKeySecrets(t, clientset), 3)
 
 		// Same new keys should be skipped
 		new, skipped, err = db.AddGPGPublicKey(context.Background(), test.MustLoadFileToString("../gpg/testdata/multi.asc"))
 		assert.NoError(t, err)
 		assert.Len(t, new, 0)
 		assert.Len(t, skipped, 2)
-		cm, err = settings.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
-		assert.NoError(t, err)
-		assert.Len(t, cm.Data, 3)
+		assert.Len(t, getGPGKeySecrets(t, clientset), 3)
 
 		// Garbage input should result in error
 		new, skipped, err = db.AddGPGPublicKey(context.Background(), test.MustLoadFileToString("../gpg/testdata/garbage.asc"))
 		assert.Error(t, err)
 		assert.Nil(t, new)
 		assert.Nil(t, skipped)
-		cm, err = settings.GetConfigMapByName(common.ArgoCDGPGKeysConfigMapName)
+		assert.Len(t, getGPGKeySecrets(t, clientset), 3)
+
+		n, err := db.ListConfiguredGPGPublicKeys(context.Background())
 		assert.NoError(t, err)
-		assert.Len(t, cm.Data, 3)
+		assert.Len(t, n, 3)
+	}
+	// Keys configured in the ConfigMap should be skipped
+	{
+		clientset := getGPGKeysClientset(gpgCMSingleGoodPubkey)
+		settings := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+		db := NewDB(testNamespace, settings, clientset)
+
+		new, skipped, err := db.AddGPGPublicKey(context.Background(), test.MustLoadFileToString("../gpg/testdata/github.asc"))
+		assert.NoError(t, err)
+		assert.Len(t, new, 0)
+		assert.Len(t, skipped, 1)
+		assert.Len(t, getGPGKeySecrets(t, clientset), 0)
 	}
 }
 
@@ -302,3 +323,132 @@ func Test_DeleteGPGPublicKey(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func newGPGKeySecret(name string, keyData string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeGPGKey,
+			},
+		},
+		Data: map[string][]byte{
+			"keyData": []byte(keyData),
+		},
+	}
+}
+
+func Test_GPGPublicKeySecrets(t *testing.T) {
+	defer os.Setenv("GNUPGHOME", "")
+	clientset := getGPGKeysClientset(gpgCMSingleGoodPubkey,
+		newGPGKeySecret("johndoe", test.MustLoadFileToString("../gpg/testdata/johndoe.asc")),
+		newGPGKeySecret("github", test.MustLoadFileToString("../gpg/testdata/github.asc")))
+	settings := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	argoDB := NewDB(testNamespace, settings, clientset)
+
+	keys, err := argoDB.ListConfiguredGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Contains(t, keys, "4AEE18F83AFDEB23")
+	assert.Contains(t, keys, "FDC79815400D88A9")
+
+	// The keys stored in secrets are copied to the ConfigMap mounted by the repo server, except the ones of argocd-gpg-keys-cm
+	err = argoDB.(*db).syncGPGPublicKeysConfigMap(context.Background())
+	assert.NoError(t, err)
+	secretsCM, err := clientset.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDGPGKeysSecretsConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"FDC79815400D88A9": test.MustLoadFileToString("../gpg/testdata/johndoe.asc")}, secretsCM.Data)
+
+	// Deleting a key stored in a secret not managed by Argo CD only removes its label
+	err = argoDB.DeleteGPGPublicKey(context.Background(), "FDC79815400D88A9")
+	assert.NoError(t, err)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.Background(), "johndoe", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, secret.Labels, common.LabelKeySecretType)
+	keys, err = argoDB.ListConfiguredGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+
+	err = argoDB.(*db).syncGPGPublicKeysConfigMap(context.Background())
+	assert.NoError(t, err)
+	secretsCM, err = clientset.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDGPGKeysSecretsConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, secretsCM.Data)
+}
+
+func Test_GPGPublicKeySecrets_InvalidSecret(t *testing.T) {
+	defer os.Setenv("GNUPGHOME", "")
+	clientset := getGPGKeysClientset(gpgCMEmpty,
+		newGPGKeySecret("johndoe", test.MustLoadFileToString("../gpg/testdata/johndoe.asc")),
+		newGPGKeySecret("garbage", test.MustLoadFileToString("../gpg/testdata/garbage.asc")))
+	settings := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	argoDB := NewDB(testNamespace, settings, clientset)
+
+	// the invalid secret is skipped like by the sync to the ConfigMap
+	keys, err := argoDB.ListConfiguredGPGPublicKeys(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Contains(t, keys, "FDC79815400D88A9")
+}
+
+func Test_MigrateGPGPublicKeysConfigMap(t *testing.T) {
+	defer os.Setenv("GNUPGHOME", "")
+	gpgCM := gpgCMMultiGoodPubkey.DeepCopy()
+	gpgCM.Data["5AEE18F83AFDEB23"] = test.MustLoadFileToString("../gpg/testdata/github.asc")
+	clientset := getGPGKeysClientset(*gpgCM)
+	settings := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	argoDB := NewDB(testNamespace, settings, clientset)
+
+	err := argoDB.(*db).migrateGPGPublicKeysConfigMap(context.Background())
+	assert.NoError(t, err)
+
+	for _, name := range []string{"gpg-key-fdc79815400d88a9", "gpg-key-f7842a5ceaa9c0b1"} {
+		secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, common.LabelValueSecretTypeGPGKey, secret.Labels[common.LabelKeySecretType])
+	}
+	// the invalid entry is left in the ConfigMap
+	keysCM, err := clientset.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDGPGKeysConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5AEE18F83AFDEB23"}, configMapKeys(keysCM.Data))
+
+	// migrating again is a no-op
+	err = argoDB.(*db).migrateGPGPublicKeysConfigMap(context.Background())
+	assert.NoError(t, err)
+}
+
+func Test_SyncGPGPublicKeys_LeaderElected(t *testing.T) {
+	defer os.Setenv("GNUPGHOME", "")
+	clientset := getGPGKeysClientset(gpgCMSingleGoodPubkey,
+		newGPGKeySecret("johndoe", test.MustLoadFileToString("../gpg/testdata/johndoe.asc")))
+	settings := settings.NewSettingsManager(context.Background(), clientset, testNamespace)
+	argoDB := NewDB(testNamespace, settings, clientset)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		argoDB.SyncGPGPublicKeys(ctx)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	assert.Eventually(t, func() bool {
+		secretsCM, err := clientset.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), common.ArgoCDGPGKeysSecretsConfigMapName, metav1.GetOptions{})
+		return err == nil && len(secretsCM.Data) == 2
+	}, 10*time.Second, 100*time.Millisecond)
+	lease, err := clientset.CoordinationV1().Leases(testNamespace).Get(context.Background(), gpgKeysSyncLeaseName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotNil(t, lease.Spec.HolderIdentity)
+}
+
+func configMapKeys(data map[string]string) []string {
+	var result []string
+	for k := range data {
+		result = append(result, k)
+	}
+	return result
+}
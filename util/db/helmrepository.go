@@ -62,8 +62,15 @@ func (db *db) ListHelmRepositories(ctx context.Context) ([]*v1alpha1.Repository,
 	if err != nil {
 		return nil, err
 	}
-	result = append(result, v1alpha1.Repositories(repos).Filter(func(r *v1alpha1.Repository) bool {
+	for _, repo := range v1alpha1.Repositories(repos).Filter(func(r *v1alpha1.Repository) bool {
 		return r.Type == "helm" && r.Name != ""
-	})...)
+	}) {
+		// get the repository again to resolve the references to the external credentials of its secret
+		resolved, err := db.GetRepository(ctx, repo.Repo)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, resolved)
+	}
 	return result, nil
 }
@@ -449,6 +449,11 @@ func (_m *ArgoDB) RemoveRepoCertificates(ctx context.Context, selector *db.Certi
 	return r0, r1
 }
 
+// SyncGPGPublicKeys provides a mock function with given fields: ctx
+func (_m *ArgoDB) SyncGPGPublicKeys(ctx context.Context) {
+	_m.Called(ctx)
+}
+
 // UpdateCluster provides a mock function with given fields: ctx, c
 func (_m *ArgoDB) UpdateCluster(ctx context.Context, c *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 	ret := _m.Called(ctx, c)
@@ -3,7 +3,11 @@ package db
 import (
 	"fmt"
 	"hash/fnv"
+	"regexp"
+	"strings"
+	"sync"
 
+	"github.com/gobwas/glob"
 	log "github.com/sirupsen/logrus"
 
 	"golang.org/x/net/context"
@@ -11,9 +15,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/git"
 )
 
 const (
+	// Prefix of the credential template URLs holding a regular expression matching the repository URLs
+	credURLRegexPrefix = "regex:"
 	// Prefix to use for naming repository secrets
 	repoSecretPrefix = "repo"
 	// Prefix to use for naming credential template secrets
@@ -336,3 +343,128 @@ func RepoURLToSecretName(prefix string, repo string) string {
 	_, _ = h.Write([]byte(repo))
 	return fmt.Sprintf("%s-%v", prefix, h.Sum32())
 }
+
+// credURLMatcher is the compiled form of a credential template URL being either a regular expression or a glob pattern
+type credURLMatcher struct {
+	regexp *regexp.Regexp
+	scheme string
+	user   string
+	host   glob.Glob
+	path   glob.Glob
+}
+
+// credURLMatchers caches the compiled credential template URLs, a nil matcher denoting an invalid template
+var credURLMatchers sync.Map
+
+// getCredURLMatcher returns the compiled matcher of the given credential template URL, or nil if it is invalid
+func getCredURLMatcher(credURL string) *credURLMatcher {
+	if cached, ok := credURLMatchers.Load(credURL); ok {
+		return cached.(*credURLMatcher)
+	}
+	matcher, err := compileCredURLMatcher(credURL)
+	if err != nil {
+		log.Warnf("Invalid credential template URL %q: %v", credURL, err)
+		matcher = nil
+	}
+	credURLMatchers.Store(credURL, matcher)
+	return matcher
+}
+
+func compileCredURLMatcher(credURL string) (*credURLMatcher, error) {
+	if strings.HasPrefix(credURL, credURLRegexPrefix) {
+		expr := strings.TrimPrefix(credURL, credURLRegexPrefix)
+		if expr == "" {
+			return nil, fmt.Errorf("empty regular expression")
+		}
+		credRegexp, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return nil, err
+		}
+		return &credURLMatcher{regexp: credRegexp}, nil
+	}
+	scheme, user, host, path, ok := splitCredURL(credURL)
+	if !ok {
+		return nil, fmt.Errorf("cannot parse the URL")
+	}
+	hostGlob, err := glob.Compile(host, '.')
+	if err != nil {
+		return nil, err
+	}
+	pathGlob, err := glob.Compile(path, '/')
+	if err != nil {
+		return nil, err
+	}
+	return &credURLMatcher{scheme: scheme, user: user, host: hostGlob, path: pathGlob}, nil
+}
+
+// splitCredURL splits the given repository or credential template URL into its lowercase scheme, user, host and path,
+// the path being stripped of its leading and trailing slashes and of its .git suffix. URLs holding a query, a fragment
+// or a backslash are rejected, so that a glob pattern can never match a host hidden behind them.
+func splitCredURL(rawURL string) (scheme string, user string, host string, path string, ok bool) {
+	rawURL = strings.ToLower(strings.TrimSpace(rawURL))
+	if strings.ContainsAny(rawURL, "?#\\") {
+		return "", "", "", "", false
+	}
+	rest := rawURL
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		scheme = rawURL[:i]
+		rest = rawURL[i+len("://"):]
+	} else if isSSH, _ := git.IsSSHURL(rawURL); isSSH {
+		// scp-like syntax, e.g. git@github.com:argoproj/argo-cd.git
+		scheme = "ssh"
+		rest = strings.Replace(rawURL, ":", "/", 1)
+	} else {
+		return "", "", "", "", false
+	}
+	authority := rest
+	if i := strings.Index(rest, "/"); i >= 0 {
+		authority = rest[:i]
+		path = rest[i+1:]
+	}
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		user = authority[:i]
+		authority = authority[i+1:]
+	}
+	if scheme == "" || authority == "" {
+		return "", "", "", "", false
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	return scheme, user, authority, path, true
+}
+
+// credentialMatchLength returns the length of the credential template URL if it matches the given repository URL, or
+// -1 otherwise, so that the longest (best) matching template can be selected. The template URL matches if it is a
+// prefix of the repository URL, if it is a glob pattern containing a '*' whose scheme and user equal, and whose host
+// and path match the ones of the repository URL, or if it is a regular expression prefixed with "regex:" matching the
+// whole repository URL.
+func credentialMatchLength(credURL string, repoURL string) int {
+	if strings.HasPrefix(credURL, credURLRegexPrefix) {
+		matcher := getCredURLMatcher(credURL)
+		if matcher == nil || !matcher.regexp.MatchString(repoURL) {
+			return -1
+		}
+		return len(credURL)
+	}
+	normalizedCredURL := git.NormalizeGitURL(credURL)
+	if normalizedCredURL == "" {
+		return -1
+	}
+	if strings.HasPrefix(git.NormalizeGitURL(repoURL), normalizedCredURL) {
+		return len(normalizedCredURL)
+	}
+	if !strings.Contains(credURL, "*") {
+		return -1
+	}
+	matcher := getCredURLMatcher(credURL)
+	if matcher == nil {
+		return -1
+	}
+	scheme, user, host, path, ok := splitCredURL(repoURL)
+	if !ok || scheme != matcher.scheme || (matcher.user != "" && user != matcher.user) {
+		return -1
+	}
+	if !matcher.host.Match(host) || !matcher.path.Match(path) {
+		return -1
+	}
+	return len(normalizedCredURL)
+}
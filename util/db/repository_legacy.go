@@ -455,14 +455,10 @@ func (l *legacyRepositoryBackend) getRepositoryIndex(repos []settings.Repository
 // configuration, i.e. the one with the longest match
 func getRepositoryCredentialIndex(repoCredentials []settings.RepositoryCredentials, repoURL string) int {
 	var max, idx int = 0, -1
-	repoURL = git.NormalizeGitURL(repoURL)
 	for i, cred := range repoCredentials {
-		credUrl := git.NormalizeGitURL(cred.URL)
-		if strings.HasPrefix(repoURL, credUrl) {
-			if len(credUrl) > max {
-				max = len(credUrl)
-				idx = i
-			}
+		if matchLength := credentialMatchLength(cred.URL, repoURL); matchLength > max {
+			max = matchLength
+			idx = i
 		}
 	}
 	return idx
@@ -12,6 +12,9 @@ func Test_getRepositoryCredentialIndex(t *testing.T) {
 		{URL: "http://known/repos"},
 		{URL: "http://known/other"},
 		{URL: "http://known/other/other"},
+		{URL: "http://known/*/deploy-*.git"},
+		{URL: "regex:^http://regex/[a-z]+/app-[0-9]+$"},
+		{URL: "regex:["},
 	}
 	tests := []struct {
 		name    string
@@ -24,6 +27,12 @@ func Test_getRepositoryCredentialIndex(t *testing.T) {
 		{"TestFoundFound", "http://known/repos/repo", 1},
 		{"TestFoundFound", "http://known/other/repo/foo", 2},
 		{"TestFoundFound", "http://known/other/other/repo", 3},
+		{"TestFoundGlob", "http://known/team/deploy-app.git", 4},
+		{"TestFoundGlob", "http://known/other/deploy-app", 4},
+		{"TestFoundPrefixNotGlob", "http://known/team/sub/deploy-app.git", 0},
+		{"TestFoundRegex", "http://regex/team/app-42", 5},
+		{"TestNotFoundRegex", "http://regex/team/app-x", -1},
+		{"TestNotFoundRegexUnanchored", "http://evil/?http://regex/team/app-42", -1},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
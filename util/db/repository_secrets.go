@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 var _ repositoryBackend = &secretsRepositoryBackend{}
@@ -59,6 +60,10 @@ func (s *secretsRepositoryBackend) GetRepository(ctx context.Context, repoURL st
 		return nil, err
 	}
 
+	if err := s.db.resolveRepositoryCredentials(ctx, secret, repository); err != nil {
+		return nil, err
+	}
+
 	return repository, err
 }
 
@@ -104,7 +109,11 @@ func (s *secretsRepositoryBackend) UpdateRepository(ctx context.Context, reposit
 		return nil, err
 	}
 
+	existingData := copySecretData(repositorySecret)
 	s.repositoryToSecret(repository, repositorySecret)
+	if err := s.db.updateRepositorySecretCredentials(ctx, repositorySecret, repository.Project, existingData); err != nil {
+		return nil, err
+	}
 
 	_, err = s.db.kubeclientset.CoreV1().Secrets(s.db.ns).Update(ctx, repositorySecret, metav1.UpdateOptions{})
 	if err != nil {
@@ -149,7 +158,11 @@ func (s *secretsRepositoryBackend) CreateRepoCreds(ctx context.Context, repoCred
 		},
 	}
 
+	existingData := copySecretData(repoCredsSecret)
 	s.repoCredsToSecret(repoCreds, repoCredsSecret)
+	if err := s.db.updateRepositorySecretCredentials(ctx, repoCredsSecret, "", existingData); err != nil {
+		return nil, err
+	}
 
 	_, err := s.db.createSecret(ctx, common.LabelValueSecretTypeRepoCreds, repoCredsSecret)
 	if err != nil {
@@ -172,7 +185,16 @@ func (s *secretsRepositoryBackend) GetRepoCreds(ctx context.Context, repoURL str
 		return nil, err
 	}
 
-	return s.secretToRepoCred(secret)
+	repoCreds, err := s.secretToRepoCred(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.db.resolveRepoCredsCredentials(ctx, secret, repoCreds); err != nil {
+		return nil, err
+	}
+
+	return repoCreds, nil
 }
 
 func (s *secretsRepositoryBackend) ListRepoCreds(ctx context.Context) ([]string, error) {
@@ -199,7 +221,11 @@ func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCred
 		return nil, err
 	}
 
+	existingData := copySecretData(repoCredsSecret)
 	s.repoCredsToSecret(repoCreds, repoCredsSecret)
+	if err := s.db.updateRepositorySecretCredentials(ctx, repoCredsSecret, "", existingData); err != nil {
+		return nil, err
+	}
 
 	repoCredsSecret, err = s.db.kubeclientset.CoreV1().Secrets(s.db.ns).Update(ctx, repoCredsSecret, metav1.UpdateOptions{})
 	if err != nil {
@@ -255,6 +281,10 @@ func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(ctx context.Context) ([]*
 				return nil, err
 			}
 
+			if err := s.db.resolveRepoCredsCredentials(ctx, secret, repoCreds); err != nil {
+				return nil, err
+			}
+
 			helmRepoCreds = append(helmRepoCreds, repoCreds)
 		}
 	}
@@ -276,6 +306,7 @@ func (s *secretsRepositoryBackend) secretToRepository(secret *corev1.Secret) (*a
 		GitHubAppEnterpriseBaseURL: string(secret.Data["githubAppEnterpriseBaseUrl"]),
 		Proxy:                      string(secret.Data["proxy"]),
 		Project:                    string(secret.Data["project"]),
+		AuthProvider:               string(secret.Data["authProvider"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secret, "insecureIgnoreHostKey")
@@ -302,6 +333,18 @@ func (s *secretsRepositoryBackend) secretToRepository(secret *corev1.Secret) (*a
 	}
 	repository.EnableOCI = enableOCI
 
+	enableSubmodules, err := boolOrNil(secret, "enableSubmodules")
+	if err != nil {
+		return repository, err
+	}
+	repository.EnableSubmodules = enableSubmodules
+
+	submodulesUseRepoCreds, err := boolOrFalse(secret, "submodulesUseRepoCreds")
+	if err != nil {
+		return repository, err
+	}
+	repository.SubmodulesUseRepoCreds = submodulesUseRepoCreds
+
 	githubAppID, err := intOrZero(secret, "githubAppID")
 	if err != nil {
 		return repository, err
@@ -339,7 +382,10 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretBool(secret, "insecureIgnoreHostKey", repository.InsecureIgnoreHostKey)
 	updateSecretBool(secret, "insecure", repository.Insecure)
 	updateSecretBool(secret, "enableLfs", repository.EnableLFS)
+	updateSecretBoolPtr(secret, "enableSubmodules", repository.EnableSubmodules)
+	updateSecretBool(secret, "submodulesUseRepoCreds", repository.SubmodulesUseRepoCreds)
 	updateSecretString(secret, "proxy", repository.Proxy)
+	updateSecretString(secret, "authProvider", repository.AuthProvider)
 }
 
 func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*appsv1.RepoCreds, error) {
@@ -353,6 +399,7 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		Type:                       string(secret.Data["type"]),
 		GithubAppPrivateKey:        string(secret.Data["githubAppPrivateKey"]),
 		GitHubAppEnterpriseBaseURL: string(secret.Data["githubAppEnterpriseBaseUrl"]),
+		AuthProvider:               string(secret.Data["authProvider"]),
 	}
 
 	enableOCI, err := boolOrFalse(secret, "enableOCI")
@@ -393,17 +440,17 @@ func (s *secretsRepositoryBackend) repoCredsToSecret(repoCreds *appsv1.RepoCreds
 	updateSecretInt(secret, "githubAppID", repoCreds.GithubAppId)
 	updateSecretInt(secret, "githubAppInstallationID", repoCreds.GithubAppInstallationId)
 	updateSecretString(secret, "githubAppEnterpriseBaseUrl", repoCreds.GitHubAppEnterpriseBaseURL)
+	updateSecretString(secret, "authProvider", repoCreds.AuthProvider)
 }
 
 func (s *secretsRepositoryBackend) getRepositorySecret(repoURL string) (*corev1.Secret, error) {
-	secrets, err := s.db.listSecretsByType(common.LabelValueSecretTypeRepository)
-	if err != nil {
-		return nil, err
-	}
-
-	for _, secret := range secrets {
-		if git.SameURL(string(secret.Data["url"]), repoURL) {
-			return secret, nil
+	if normalizedURL := git.NormalizeGitURL(repoURL); normalizedURL != "" {
+		secrets, err := s.db.settingsMgr.GetSecretsByIndex(settings.ByRepoURLIndexer, normalizedURL)
+		if err != nil {
+			return nil, err
+		}
+		if len(secrets) > 0 {
+			return secrets[0], nil
 		}
 	}
 
@@ -426,14 +473,10 @@ func (s *secretsRepositoryBackend) getRepoCredsSecret(repoURL string) (*corev1.S
 
 func (s *secretsRepositoryBackend) getRepositoryCredentialIndex(repoCredentials []*corev1.Secret, repoURL string) int {
 	var max, idx = 0, -1
-	repoURL = git.NormalizeGitURL(repoURL)
 	for i, cred := range repoCredentials {
-		credUrl := git.NormalizeGitURL(string(cred.Data["url"]))
-		if strings.HasPrefix(repoURL, credUrl) {
-			if len(credUrl) > max {
-				max = len(credUrl)
-				idx = i
-			}
+		if matchLength := credentialMatchLength(string(cred.Data["url"]), repoURL); matchLength > max {
+			max = matchLength
+			idx = i
 		}
 	}
 	return idx
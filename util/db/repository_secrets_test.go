@@ -1,10 +1,12 @@
 package db
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -258,6 +260,47 @@ func TestSecretsRepositoryBackend_UpdateRepository(t *testing.T) {
 	assert.Equal(t, "foo", string(secret.Data["username"]))
 }
 
+func TestSecretsRepositoryBackend_SubmoduleSettings(t *testing.T) {
+	clientset := getClientset(map[string]string{})
+	testee := &secretsRepositoryBackend{db: &db{
+		ns:            testNamespace,
+		kubeclientset: clientset,
+		settingsMgr:   settings.NewSettingsManager(context.TODO(), clientset, testNamespace),
+	}}
+
+	enableSubmodules := false
+	input := &appsv1.Repository{
+		Repo:                   "https://github.com/argoproj/argo-cd.git",
+		EnableSubmodules:       &enableSubmodules,
+		SubmodulesUseRepoCreds: true,
+	}
+	_, err := testee.CreateRepository(context.TODO(), input)
+	require.NoError(t, err)
+
+	secretName := RepoURLToSecretName(repoSecretPrefix, input.Repo)
+	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "false", string(secret.Data["enableSubmodules"]))
+	assert.Equal(t, "true", string(secret.Data["submodulesUseRepoCreds"]))
+
+	repository, err := testee.GetRepository(context.TODO(), input.Repo)
+	require.NoError(t, err)
+	require.NotNil(t, repository.EnableSubmodules)
+	assert.False(t, *repository.EnableSubmodules)
+	assert.True(t, repository.SubmodulesUseRepoCreds)
+
+	// the default of the repo server is used once the setting is removed
+	repository.EnableSubmodules = nil
+	_, err = testee.UpdateRepository(context.TODO(), repository)
+	require.NoError(t, err)
+	secret, err = clientset.CoreV1().Secrets(testNamespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, secret.Data, "enableSubmodules")
+	repository, err = testee.GetRepository(context.TODO(), input.Repo)
+	require.NoError(t, err)
+	assert.Nil(t, repository.EnableSubmodules)
+}
+
 func TestSecretsRepositoryBackend_DeleteRepository(t *testing.T) {
 	managedSecretName := RepoURLToSecretName(repoSecretPrefix, "git@github.com:argoproj/argo-cd.git")
 	repoSecrets := []runtime.Object{
@@ -321,10 +364,11 @@ func TestSecretsRepositoryBackend_CreateRepoCreds(t *testing.T) {
 	}}
 
 	input := &appsv1.RepoCreds{
-		URL:       "git@github.com:argoproj",
-		Username:  "someUsername",
-		Password:  "somePassword",
-		EnableOCI: true,
+		URL:          "git@github.com:argoproj",
+		Username:     "someUsername",
+		Password:     "somePassword",
+		EnableOCI:    true,
+		AuthProvider: "aws",
 	}
 
 	output, err := testee.CreateRepoCreds(context.TODO(), input)
@@ -346,6 +390,11 @@ func TestSecretsRepositoryBackend_CreateRepoCreds(t *testing.T) {
 	assert.Equal(t, input.Username, string(secret.Data["username"]))
 	assert.Equal(t, input.Password, string(secret.Data["password"]))
 	assert.Equal(t, strconv.FormatBool(input.EnableOCI), string(secret.Data["enableOCI"]))
+	assert.Equal(t, input.AuthProvider, string(secret.Data["authProvider"]))
+
+	repoCreds, err := testee.secretToRepoCred(secret)
+	assert.NoError(t, err)
+	assert.Equal(t, input.AuthProvider, repoCreds.AuthProvider)
 }
 
 func TestSecretsRepositoryBackend_GetRepoCreds(t *testing.T) {
@@ -623,3 +672,19 @@ func TestSecretsRepositoryBackend_GetAllHelmRepoCreds(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, repoCreds, 1)
 }
+
+func BenchmarkGetRepository(b *testing.B) {
+	db := newBenchmarkDB(b, common.LabelValueSecretTypeRepository, 5000, func(i int) map[string][]byte {
+		return map[string][]byte{
+			"url":      []byte(fmt.Sprintf("https://github.com/argoproj/repo-%d", i)),
+			"username": []byte("username"),
+			"password": []byte("password"),
+		}
+	})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		repo, err := db.GetRepository(context.Background(), fmt.Sprintf("https://github.com/argoproj/repo-%d", i%5000))
+		require.NoError(b, err)
+		require.Equal(b, "username", repo.Username)
+	}
+}
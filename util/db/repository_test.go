@@ -230,7 +230,23 @@ func TestDb_GetRepositoryCredentials(t *testing.T) {
 		},
 	}
 
-	clientset := getClientset(map[string]string{"repository.credentials": repositoryCredentialsSettings}, newManagedSecret(), repoCredsSecret)
+	globRepoCredsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "glob-repocreds-secret",
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeRepoCreds,
+			},
+		},
+		Data: map[string][]byte{
+			"type":     []byte("git"),
+			"url":      []byte("https://github.example.com/*/deploy-*.git"),
+			"username": []byte("someUsername"),
+			"password": []byte("somePassword"),
+		},
+	}
+
+	clientset := getClientset(map[string]string{"repository.credentials": repositoryCredentialsSettings}, newManagedSecret(), repoCredsSecret, globRepoCredsSecret)
 	testee := NewDB(testNamespace, settings.NewSettingsManager(context.TODO(), clientset, testNamespace), clientset)
 
 	repoCreds, err := testee.GetRepositoryCredentials(context.TODO(), "git@github.com:argoproj/argoproj.git")
@@ -246,6 +262,50 @@ func TestDb_GetRepositoryCredentials(t *testing.T) {
 	repoCreds, err = testee.GetRepositoryCredentials(context.TODO(), "git@github.com:example/not-existing.git")
 	assert.NoError(t, err)
 	assert.Nil(t, repoCreds)
+
+	repoCreds, err = testee.GetRepositoryCredentials(context.TODO(), "https://github.example.com/team/deploy-app.git")
+	assert.NoError(t, err)
+	assert.NotNil(t, repoCreds)
+	assert.Equal(t, "https://github.example.com/*/deploy-*.git", repoCreds.URL)
+
+	repoCreds, err = testee.GetRepositoryCredentials(context.TODO(), "https://github.example.com/team/app.git")
+	assert.NoError(t, err)
+	assert.Nil(t, repoCreds)
+
+	repo, err := testee.GetRepository(context.TODO(), "https://github.example.com/team/deploy-app.git")
+	assert.NoError(t, err)
+	assert.Equal(t, "someUsername", repo.Username)
+	assert.True(t, repo.InheritedCreds)
+}
+
+func Test_credentialMatchLength(t *testing.T) {
+	tests := []struct {
+		credURL string
+		repoURL string
+		matches bool
+	}{
+		{"https://*.example.com/**", "https://git.example.com/team/app.git", true},
+		{"https://*.example.com/**", "HTTPS://Git.Example.com/team/app", true},
+		{"https://*.example.com/**", "https://attacker.com?.example.com/x.git", false},
+		{"https://*.example.com/**", "https://attacker.com#.example.com/x.git", false},
+		{"https://*.example.com/**", "https://foo.example.com@attacker.com/x.git", false},
+		{"https://*.example.com/**", "https://attacker.com\\.example.com/x.git", false},
+		{"https://*.example.com/**", "https://git.example.com.attacker.com/x.git", false},
+		{"https://*.example.com/**", "http://git.example.com/team/app.git", false},
+		{"https://**.example.com/*", "https://a.b.example.com/app", true},
+		{"https://git@*.example.com/*", "https://other@git.example.com/app", false},
+		{"https://git@*.example.com/*", "https://git@git.example.com/app", true},
+		{"ssh://git@*.example.com/**", "git@git.example.com:team/app.git", true},
+		{"regex:https://[a-z]+\\.example\\.com/.*", "https://git.example.com/team/app", true},
+		{"regex:https://[a-z]+\\.example\\.com/.*", "https://attacker.com/https://git.example.com/x", false},
+		{"regex:https://git\\.example\\.com", "https://git.example.com.attacker.com", false},
+		{"regex:", "https://git.example.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.credURL+" "+tt.repoURL, func(t *testing.T) {
+			assert.Equal(t, tt.matches, credentialMatchLength(tt.credURL, tt.repoURL) > 0)
+		})
+	}
 }
 
 func TestRepoURLToSecretName(t *testing.T) {
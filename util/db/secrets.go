@@ -13,29 +13,21 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/selection"
 	informerv1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 func (db *db) listSecretsByType(types ...string) ([]*apiv1.Secret, error) {
-	labelSelector := labels.NewSelector()
-	req, err := labels.NewRequirement(common.LabelKeySecretType, selection.Equals, types)
-	if err != nil {
-		return nil, err
-	}
-	labelSelector = labelSelector.Add(*req)
-
-	secretsLister, err := db.settingsMgr.GetSecretsLister()
-	if err != nil {
-		return nil, err
-	}
-	secrets, err := secretsLister.Secrets(db.ns).List(labelSelector)
-	if err != nil {
-		return nil, err
+	var secrets []*apiv1.Secret
+	for _, secretType := range types {
+		typeSecrets, err := db.settingsMgr.GetSecretsByIndex(settings.BySecretTypeIndexer, secretType)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, typeSecrets...)
 	}
 	return secrets, nil
 }
@@ -49,6 +41,19 @@ func boolOrFalse(secret *apiv1.Secret, key string) (bool, error) {
 	return strconv.ParseBool(string(val))
 }
 
+func boolOrNil(secret *apiv1.Secret, key string) (*bool, error) {
+	val, present := secret.Data[key]
+	if !present {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(string(val))
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
 func intOrZero(secret *apiv1.Secret, key string) (int64, error) {
 	val, present := secret.Data[key]
 	if !present {
@@ -64,6 +69,14 @@ func updateSecretBool(secret *apiv1.Secret, key string, value bool) {
 	}
 }
 
+func updateSecretBoolPtr(secret *apiv1.Secret, key string, value *bool) {
+	if value != nil {
+		secret.Data[key] = []byte(strconv.FormatBool(*value))
+	} else {
+		delete(secret.Data, key)
+	}
+}
+
 func updateSecretInt(secret *apiv1.Secret, key string, value int64) {
 	if _, present := secret.Data[key]; present || value != 0 {
 		secret.Data[key] = []byte(strconv.FormatInt(value, 10))
@@ -76,6 +89,15 @@ func updateSecretString(secret *apiv1.Secret, key, value string) {
 	}
 }
 
+// copySecretData returns a copy of the data of the given secret, e.g. to compare it with the updated data
+func copySecretData(secret *apiv1.Secret) map[string][]byte {
+	data := make(map[string][]byte, len(secret.Data))
+	for key, value := range secret.Data {
+		data[key] = value
+	}
+	return data
+}
+
 func (db *db) createSecret(ctx context.Context, secretType string, secret *apiv1.Secret) (*apiv1.Secret, error) {
 	if secret.Annotations == nil {
 		secret.Annotations = map[string]string{}
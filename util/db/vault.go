@@ -0,0 +1,149 @@
+package db
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// VaultCredentialsScheme is the scheme of the credentials stored in HashiCorp Vault, referenced as
+	// "vault:<path>#<key>", e.g. "vault:secret/data/argocd/github#password"
+	VaultCredentialsScheme = "vault"
+
+	// The environment variables configuring the Vault client, named like the ones of the Vault CLI
+	envVaultAddr      = "VAULT_ADDR"
+	envVaultToken     = "VAULT_TOKEN"
+	envVaultNamespace = "VAULT_NAMESPACE"
+	envVaultCACert    = "VAULT_CACERT"
+)
+
+// RegisterVaultCredentialsProviderFromEnv registers the provider of the Vault credentials configured by the VAULT_*
+// environment variables, if VAULT_ADDR is set
+func RegisterVaultCredentialsProviderFromEnv() error {
+	address := os.Getenv(envVaultAddr)
+	if address == "" {
+		return nil
+	}
+	provider, err := NewVaultCredentialsProvider(address, os.Getenv(envVaultNamespace), os.Getenv(envVaultCACert))
+	if err != nil {
+		return fmt.Errorf("failed to configure the Vault credentials provider: %w", err)
+	}
+	RegisterCredentialsProvider(VaultCredentialsScheme, provider)
+	return nil
+}
+
+// vaultCredentialsProvider reads the credentials from the KV secrets engines of HashiCorp Vault
+type vaultCredentialsProvider struct {
+	address   string
+	namespace string
+	client    *http.Client
+	// getToken returns the token authenticating the requests
+	getToken func() (string, error)
+}
+
+// NewVaultCredentialsProvider returns a provider reading the credentials from the Vault server with the given address.
+// The requests are authenticated with the VAULT_TOKEN environment variable if set, or with the token of the
+// ~/.vault-token file otherwise, which is read on every request so that it can be renewed, e.g. by a Vault agent.
+func NewVaultCredentialsProvider(address string, namespace string, caCertPath string) (CredentialsProvider, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCertPath != "" {
+		caCert, err := os.ReadFile(caCertPath)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificate found in %s", caCertPath)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool}
+	}
+	return &vaultCredentialsProvider{
+		address:   strings.TrimRight(address, "/"),
+		namespace: namespace,
+		client:    &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		getToken:  getVaultToken,
+	}, nil
+}
+
+func getVaultToken() (string, error) {
+	if token := os.Getenv(envVaultToken); token != "" {
+		return token, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	token, err := os.ReadFile(filepath.Join(home, ".vault-token"))
+	if err != nil {
+		return "", fmt.Errorf("no Vault token: %w", err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// GetCredential returns the value of the key of a Vault secret, referenced as "<path>#<key>". The path is the API
+// path of the secret, i.e. "<mount>/data/<name>" for the version 2 of the KV secrets engine and "<mount>/<name>" for
+// the version 1.
+func (p *vaultCredentialsProvider) GetCredential(ctx context.Context, ref string) (string, error) {
+	i := strings.LastIndex(ref, "#")
+	if i <= 0 || i == len(ref)-1 {
+		return "", fmt.Errorf("the Vault credentials must be referenced as <path>#<key>")
+	}
+	path, key := strings.Trim(ref[:i], "/"), ref[i+1:]
+	// the allowed references are checked by prefix, so the path must not escape it
+	if strings.ContainsAny(path, "%?#\\") {
+		return "", fmt.Errorf("invalid Vault secret path %s", path)
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("invalid Vault secret path %s", path)
+		}
+	}
+
+	token, err := p.getToken()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/%s", p.address, path), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var secret struct {
+		Data   map[string]interface{} `json:"data"`
+		Errors []string               `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("failed to decode the Vault secret %s: %w", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to read the Vault secret %s: %s %s", path, resp.Status, strings.Join(secret.Errors, ", "))
+	}
+	data := secret.Data
+	// the version 2 of the KV secrets engine nests the data along with its metadata
+	if nested, ok := data["data"].(map[string]interface{}); ok && data["metadata"] != nil {
+		data = nested
+	}
+	value, ok := data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("key %s not found in the Vault secret %s", key, path)
+	}
+	return value, nil
+}
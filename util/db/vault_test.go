@@ -0,0 +1,60 @@
+package db
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestVaultCredentialsProvider_GetCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "my-token" || r.Header.Get("X-Vault-Namespace") != "my-namespace" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/argocd/github":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"kv2-password"},"metadata":{"version":3}}}`))
+		case "/v1/kv/argocd/github":
+			_, _ = w.Write([]byte(`{"data":{"password":"kv1-password"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewVaultCredentialsProvider(server.URL+"/", "my-namespace", "")
+	require.NoError(t, err)
+	provider.(*vaultCredentialsProvider).getToken = func() (string, error) {
+		return "my-token", nil
+	}
+
+	value, err := provider.GetCredential(context.Background(), "secret/data/argocd/github#password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv2-password", value)
+
+	value, err = provider.GetCredential(context.Background(), "/kv/argocd/github#password")
+	require.NoError(t, err)
+	assert.Equal(t, "kv1-password", value)
+
+	_, err = provider.GetCredential(context.Background(), "secret/data/argocd/github#username")
+	assert.EqualError(t, err, "key username not found in the Vault secret secret/data/argocd/github")
+
+	_, err = provider.GetCredential(context.Background(), "secret/data/argocd/gitlab#password")
+	assert.Error(t, err)
+
+	_, err = provider.GetCredential(context.Background(), "secret/data/argocd/github")
+	assert.Error(t, err)
+
+	provider.(*vaultCredentialsProvider).getToken = func() (string, error) {
+		return "other-token", nil
+	}
+	_, err = provider.GetCredential(context.Background(), "secret/data/argocd/github#password")
+	assert.EqualError(t, err, "failed to read the Vault secret secret/data/argocd/github: 403 Forbidden permission denied")
+}
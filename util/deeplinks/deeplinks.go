@@ -0,0 +1,153 @@
+package deeplinks
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// AppKey is the name the application is available as in the templates of the links
+	AppKey = "app"
+	// ResourceKey is the name the resource is available as in the templates of the resource links
+	ResourceKey = "resource"
+
+	urlQueryFunc = "urlquery"
+)
+
+// EvaluateDeepLinks returns the links which apply to the given application and, if not nil, resource, with their URLs
+// rendered. The links which can't be rendered to an http or https URL are skipped.
+func EvaluateDeepLinks(links []settings.DeepLink, app *v1alpha1.Application, obj *unstructured.Unstructured) ([]*application.LinkInfo, error) {
+	appObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{AppKey: appObj}
+	if obj != nil {
+		data[ResourceKey] = obj.Object
+	}
+	result := make([]*application.LinkInfo, 0)
+	for _, link := range links {
+		if !matchesApp(link, app) || (obj != nil && !matchesKind(link, obj)) {
+			continue
+		}
+		linkURL, err := renderURL(link, data)
+		if err != nil {
+			log.Warnf("Failed to render the url of link '%s' of application '%s': %v", link.Title, app.Name, err)
+			continue
+		}
+		title := link.Title
+		info := &application.LinkInfo{Title: &title, Url: &linkURL}
+		if description := link.Description; description != "" {
+			info.Description = &description
+		}
+		if iconClass := link.IconClass; iconClass != "" {
+			info.IconClass = &iconClass
+		}
+		result = append(result, info)
+	}
+	return result, nil
+}
+
+func matchesApp(link settings.DeepLink, app *v1alpha1.Application) bool {
+	if link.Selector == "" {
+		return true
+	}
+	selector, err := labels.Parse(link.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(app.Labels))
+}
+
+// matchesKind returns whether one of the kinds of the link matches the resource, as Kind or group/Kind
+func matchesKind(link settings.DeepLink, obj *unstructured.Unstructured) bool {
+	if len(link.Kinds) == 0 {
+		return true
+	}
+	gvk := obj.GroupVersionKind()
+	for _, pattern := range link.Kinds {
+		text := gvk.Kind
+		if strings.Contains(pattern, "/") {
+			text = gvk.Group + "/" + gvk.Kind
+		}
+		if glob.Match(pattern, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderURL renders the URL template of the link. The values the template outputs are escaped so that they can't
+// change the scheme, the host or the query parameters of the URL.
+func renderURL(link settings.DeepLink, data map[string]interface{}) (string, error) {
+	tmpl, err := template.New(link.Title).Option("missingkey=error").Parse(link.URL)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range tmpl.Templates() {
+		escapeActions(t.Tree, t.Root)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	u, err := url.Parse(buf.String())
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("unsupported url scheme '%s'", u.Scheme)
+	}
+	return buf.String(), nil
+}
+
+// escapeActions pipes the output of the actions of the given template node to the urlquery function
+func escapeActions(tree *parse.Tree, node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			escapeActions(tree, child)
+		}
+	case *parse.ActionNode:
+		// the variable declarations output nothing, and the escaped values must not be escaped twice
+		if len(n.Pipe.Decl) > 0 || isURLQuery(n.Pipe.Cmds[len(n.Pipe.Cmds)-1]) {
+			return
+		}
+		escape := parse.NewIdentifier(urlQueryFunc).SetTree(tree).SetPos(n.Pos)
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{NodeType: parse.NodeCommand, Pos: n.Pos, Args: []parse.Node{escape}})
+	case *parse.IfNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	case *parse.WithNode:
+		escapeActions(tree, n.List)
+		escapeActions(tree, n.ElseList)
+	}
+}
+
+func isURLQuery(cmd *parse.CommandNode) bool {
+	if len(cmd.Args) == 0 {
+		return false
+	}
+	identifier, ok := cmd.Args[0].(*parse.IdentifierNode)
+	return ok && identifier.Ident == urlQueryFunc
+}
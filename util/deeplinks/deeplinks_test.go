@@ -0,0 +1,123 @@
+package deeplinks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+func titles(links []*application.LinkInfo) []string {
+	var result []string
+	for _, link := range links {
+		result = append(result, link.GetTitle())
+	}
+	return result
+}
+
+func TestEvaluateDeepLinks(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", Labels: map[string]string{"team": "payments"}},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		},
+	}
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "guestbook-ui", "namespace": "default"},
+	}}
+	links := []settings.DeepLink{{
+		Title:       "Grafana",
+		URL:         "https://grafana.example.com/d/apps?var-app={{.app.metadata.name}}&var-ns={{.app.spec.destination.namespace}}",
+		Description: "Dashboard of the application",
+		IconClass:   "fa-chart-area",
+	}, {
+		Title:    "Payments",
+		URL:      "https://payments.example.com/{{.app.metadata.name}}",
+		Selector: "team=payments",
+	}, {
+		Title:    "Billing",
+		URL:      "https://billing.example.com/{{.app.metadata.name}}",
+		Selector: "team=billing",
+	}}
+
+	result, err := EvaluateDeepLinks(links, app, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Grafana", "Payments"}, titles(result))
+	assert.Equal(t, "https://grafana.example.com/d/apps?var-app=guestbook&var-ns=default", result[0].GetUrl())
+	assert.Equal(t, "Dashboard of the application", result[0].GetDescription())
+	assert.Equal(t, "fa-chart-area", result[0].GetIconClass())
+	assert.Nil(t, result[1].Description)
+
+	t.Run("ResourceLinks", func(t *testing.T) {
+		links := []settings.DeepLink{{
+			Title: "Kibana",
+			URL:   "https://kibana.example.com/logs?app={{.app.metadata.name}}&name={{.resource.metadata.name}}",
+			Kinds: []string{"apps/Deployment", "apps/StatefulSet"},
+		}, {
+			Title: "Pods",
+			URL:   "https://pods.example.com/{{.resource.metadata.name}}",
+			Kinds: []string{"Pod"},
+		}, {
+			Title: "All",
+			URL:   "https://example.com/{{.resource.kind}}",
+		}, {
+			Title: "Wildcard",
+			URL:   "https://example.com/{{.resource.kind}}",
+			Kinds: []string{"*/Deploy*"},
+		}}
+		result, err := EvaluateDeepLinks(links, app, deployment)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Kibana", "All", "Wildcard"}, titles(result))
+		assert.Equal(t, "https://kibana.example.com/logs?app=guestbook&name=guestbook-ui", result[0].GetUrl())
+		assert.Equal(t, "https://example.com/Deployment", result[1].GetUrl())
+	})
+
+	t.Run("InvalidLinks", func(t *testing.T) {
+		links := []settings.DeepLink{{
+			Title: "MissingKey",
+			URL:   "https://example.com/{{.resource.metadata.name}}",
+		}, {
+			Title: "Javascript",
+			URL:   "javascript:alert('{{.app.metadata.name}}')",
+		}, {
+			Title: "Relative",
+			URL:   "/applications/{{.app.metadata.name}}",
+		}}
+		result, err := EvaluateDeepLinks(links, app, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("EscapedValues", func(t *testing.T) {
+		app := app.DeepCopy()
+		app.Name = "guestbook&admin=true"
+		app.Annotations = map[string]string{"url": "javascript:alert(1)//"}
+		links := []settings.DeepLink{{
+			Title: "Query",
+			URL:   "https://example.com/apps?name={{.app.metadata.name}}",
+		}, {
+			Title: "Conditional",
+			URL:   "https://example.com/{{if .app.metadata.name}}{{.app.metadata.name}}{{end}}",
+		}, {
+			Title: "AlreadyEscaped",
+			URL:   "https://example.com/apps?name={{.app.metadata.name | urlquery}}",
+		}, {
+			Title: "Scheme",
+			URL:   "{{.app.metadata.annotations.url}}",
+		}}
+		result, err := EvaluateDeepLinks(links, app, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Query", "Conditional", "AlreadyEscaped"}, titles(result))
+		assert.Equal(t, "https://example.com/apps?name=guestbook%26admin%3Dtrue", result[0].GetUrl())
+		assert.Equal(t, "https://example.com/guestbook%26admin%3Dtrue", result[1].GetUrl())
+		assert.Equal(t, "https://example.com/apps?name=guestbook%26admin%3Dtrue", result[2].GetUrl())
+	})
+}
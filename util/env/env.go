@@ -63,6 +63,29 @@ func ParseDurationFromEnv(env string, defaultValue, min, max time.Duration) time
 	return dur
 }
 
+// Helper function to parse a floating point number from an environment variable. Returns a
+// default if env is not set, is not parseable to a number, exceeds max or is less than min.
+func ParseFloatFromEnv(env string, defaultValue, min, max float64) float64 {
+	str := os.Getenv(env)
+	if str == "" {
+		return defaultValue
+	}
+	num, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		log.Warnf("Could not parse '%s' as a number from environment %s", str, env)
+		return defaultValue
+	}
+	if num < min {
+		log.Warnf("Value in %s is %v, which is less than minimum %v allowed", env, num, min)
+		return defaultValue
+	}
+	if num > max {
+		log.Warnf("Value in %s is %v, which is greater than maximum %v allowed", env, num, max)
+		return defaultValue
+	}
+	return num
+}
+
 func StringFromEnv(env string, defaultValue string) string {
 	if str := os.Getenv(env); str != "" {
 		return str
@@ -70,6 +93,20 @@ func StringFromEnv(env string, defaultValue string) string {
 	return defaultValue
 }
 
+// StringsFromEnv parses given value from the environment as a list of strings,
+// using separator as the delimiter, and returns them as a slice. The strings
+// in the returned slice will have leading and trailing white space removed.
+func StringsFromEnv(env string, defaultValue []string, separator string) []string {
+	if str := os.Getenv(env); str != "" {
+		ss := strings.Split(str, separator)
+		for i, s := range ss {
+			ss[i] = strings.TrimSpace(s)
+		}
+		return ss
+	}
+	return defaultValue
+}
+
 // ParseBoolFromEnv retrieves a boolean value from given environment envVar.
 // Returns default value if envVar is not set.
 func ParseBoolFromEnv(envVar string, defaultValue bool) bool {
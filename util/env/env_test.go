@@ -104,6 +104,48 @@ func TestParseDurationFromEnv(t *testing.T) {
 	}
 }
 
+func TestParseFloatFromEnv(t *testing.T) {
+	testKey := "key"
+	defaultVal := 2.0
+	min := 0.5
+	max := 3.0
+
+	testCases := []struct {
+		name     string
+		env      string
+		expected float64
+	}{{
+		name:     "EnvNotSet",
+		expected: defaultVal,
+	}, {
+		name:     "ValidValueSet",
+		env:      "0.5",
+		expected: 0.5,
+	}, {
+		name:     "MoreThanMaxSet",
+		env:      "5",
+		expected: defaultVal,
+	}, {
+		name:     "LessThanMinSet",
+		env:      "0.1",
+		expected: defaultVal,
+	}, {
+		name:     "InvalidSet",
+		env:      "hello",
+		expected: defaultVal,
+	}}
+
+	for i, tc := range testCases {
+		t.Run(testCases[i].name, func(t *testing.T) {
+			tc = testCases[i]
+			setEnv(t, testKey, tc.env)
+
+			val := ParseFloatFromEnv(testKey, defaultVal, min, max)
+			assert.Equal(t, tc.expected, val)
+		})
+	}
+}
+
 func Test_ParseBoolFromEnv(t *testing.T) {
 	t.Run("Get 'true' value from existing env var", func(t *testing.T) {
 		_ = os.Setenv("TEST_BOOL_VAL", "true")
@@ -120,3 +162,10 @@ func Test_ParseBoolFromEnv(t *testing.T) {
 		assert.True(t, ParseBoolFromEnv("TEST_BOOL_VAL", true))
 	})
 }
+
+func TestStringsFromEnv(t *testing.T) {
+	closer := setEnv(t, "test", "parseYaml, sha256")
+	defer util.Close(closer)
+	assert.Equal(t, []string{"parseYaml", "sha256"}, StringsFromEnv("test", nil, ","))
+	assert.Equal(t, []string{"default"}, StringsFromEnv("unset", []string{"default"}, ","))
+}
@@ -1,9 +1,15 @@
 package exec
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/tracing"
@@ -26,6 +32,10 @@ func initTimeout() {
 	}
 }
 
+// waitDelay is the time the output of a killed command is still read for, in case it was inherited by a process which
+// was not killed
+const waitDelay = 5 * time.Second
+
 func Run(cmd *exec.Cmd) (string, error) {
 	return RunWithRedactor(cmd, nil)
 }
@@ -43,3 +53,190 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 	defer span.Finish()
 	return argoexec.RunCommandExt(cmd, opts)
 }
+
+// maxStderrSize is the maximum number of bytes of the standard error kept by RunWithOutputLimit
+const maxStderrSize = 64 * 1024
+
+// truncatedBuffer keeps the first bytes written to it, and discards the others
+type truncatedBuffer struct {
+	bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *truncatedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.max - b.Len(); remaining < len(p) {
+		b.truncated = true
+		_, _ = b.Buffer.Write(p[:remaining])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+func (b *truncatedBuffer) String() string {
+	if b.truncated {
+		return b.Buffer.String() + "... (truncated)"
+	}
+	return b.Buffer.String()
+}
+
+// RunWithOutputLimit runs the command like Run, but streams its standard output and kills the command once it exceeds
+// maxOutputSize bytes. The output is not limited if maxOutputSize is less than 1. The standard error is included in
+// the returned error if the command fails.
+func RunWithOutputLimit(cmd *exec.Cmd, maxOutputSize int64) (string, error) {
+	if maxOutputSize < 1 {
+		return Run(cmd)
+	}
+	span := tracing.NewLoggingTracer(log.NewLogrusLogger(log.NewWithCurrentConfig())).StartSpan(fmt.Sprintf("exec %v", cmd.Args[0]))
+	span.SetBaggageItem("dir", fmt.Sprintf("%v", cmd.Dir))
+	span.SetBaggageItem("args", fmt.Sprintf("%v", cmd.Args))
+	defer span.Finish()
+
+	args := strings.Join(cmd.Args, " ")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+	stderrPipe, err := cmd.StderrPipe()
+	if err != nil {
+		return "", err
+	}
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	stderr := &truncatedBuffer{max: maxStderrSize}
+	stderrDone := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(stderr, stderrPipe)
+		close(stderrDone)
+	}()
+
+	// the pipes are closed as well, since they might be kept open by child processes of the killed command
+	kill := func() {
+		_ = cmd.Process.Kill()
+		_ = stdout.Close()
+		_ = stderrPipe.Close()
+	}
+	var timedOut int32
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			atomic.StoreInt32(&timedOut, 1)
+			kill()
+		})
+		defer timer.Stop()
+	}
+
+	// one more byte than the limit is read to detect whether it is exceeded
+	out, readErr := ioutil.ReadAll(io.LimitReader(stdout, maxOutputSize+1))
+	if int64(len(out)) > maxOutputSize {
+		kill()
+		_ = cmd.Wait()
+		return "", &argoexec.CmdError{Args: args, Cause: fmt.Errorf("output size limit exceeded: more than %d bytes written to stdout", maxOutputSize)}
+	}
+	<-stderrDone
+	err = cmd.Wait()
+	if atomic.LoadInt32(&timedOut) == 1 {
+		return "", &argoexec.CmdError{Args: args, Cause: fmt.Errorf("timeout after %v", timeout)}
+	}
+	if err == nil {
+		err = readErr
+	}
+	if err != nil {
+		return "", &argoexec.CmdError{Args: args, Cause: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// PrepareFunc prepares a command before it is started, e.g. to sandbox it. The command needs to access the given
+// files and directories.
+type PrepareFunc func(cmd *exec.Cmd, paths []string) error
+
+// Runner runs the commands of an operation, e.g. the generation of the manifests of an application. The commands still
+// running once the context of the runner is done are killed, along with the processes they started. A nil runner runs
+// the commands like the functions of this package.
+type Runner struct {
+	ctx context.Context
+	// prepare prepares the commands before they are started, if not nil
+	prepare PrepareFunc
+	// paths are the files and directories the commands need to access
+	paths []string
+}
+
+// NewRunner returns a runner of commands bound to the given context
+func NewRunner(ctx context.Context) *Runner {
+	return &Runner{ctx: ctx}
+}
+
+// WithPrepare returns a copy of the runner preparing the commands with the given function, which is passed the given
+// files and directories
+func (r *Runner) WithPrepare(prepare PrepareFunc, paths ...string) *Runner {
+	res := &Runner{prepare: prepare}
+	if r != nil {
+		res.ctx = r.ctx
+	}
+	return res.WithPaths(paths...)
+}
+
+// WithPaths returns a copy of the runner whose commands also need to access the given files and directories
+func (r *Runner) WithPaths(paths ...string) *Runner {
+	if r == nil {
+		return nil
+	}
+	res := *r
+	res.paths = append(append([]string{}, r.paths...), paths...)
+	return &res
+}
+
+// Run runs the command like Run
+func (r *Runner) Run(cmd *exec.Cmd) (string, error) {
+	return r.RunWithRedactor(cmd, nil)
+}
+
+// RunWithRedactor runs the command like RunWithRedactor
+func (r *Runner) RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string, error) {
+	cmd, err := r.command(cmd)
+	if err != nil {
+		return "", err
+	}
+	return RunWithRedactor(cmd, redactor)
+}
+
+// RunWithOutputLimit runs the command like RunWithOutputLimit
+func (r *Runner) RunWithOutputLimit(cmd *exec.Cmd, maxOutputSize int64) (string, error) {
+	cmd, err := r.command(cmd)
+	if err != nil {
+		return "", err
+	}
+	return RunWithOutputLimit(cmd, maxOutputSize)
+}
+
+// command returns the given command prepared by the runner and bound to its context
+func (r *Runner) command(cmd *exec.Cmd) (*exec.Cmd, error) {
+	if r == nil {
+		return cmd, nil
+	}
+	if r.prepare != nil {
+		if err := r.prepare(cmd, r.paths); err != nil {
+			return nil, err
+		}
+	}
+	if r.ctx == nil || r.ctx.Done() == nil {
+		return cmd, nil
+	}
+	if err := r.ctx.Err(); err != nil {
+		return nil, err
+	}
+	res := exec.CommandContext(r.ctx, cmd.Path)
+	res.Args = cmd.Args
+	res.Env = cmd.Env
+	res.Dir = cmd.Dir
+	res.Stdin = cmd.Stdin
+	res.Stdout = cmd.Stdout
+	res.Stderr = cmd.Stderr
+	res.ExtraFiles = cmd.ExtraFiles
+	res.SysProcAttr = cmd.SysProcAttr
+	res.Err = cmd.Err
+	res.WaitDelay = waitDelay
+	killProcessGroupOnCancel(res)
+	return res, nil
+}
@@ -1,9 +1,13 @@
 package exec
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"testing"
 	"time"
 
@@ -39,3 +43,67 @@ func TestHideUsernamePassword(t *testing.T) {
 	_, err = RunWithRedactor(exec.Command("helm registry login https://charts.bitnami.com/bitnami", "--username", "foo", "--password", "bar"), redactor)
 	assert.NotEmpty(t, err)
 }
+
+func TestRunWithOutputLimit(t *testing.T) {
+	t.Run("WithinLimit", func(t *testing.T) {
+		out, err := RunWithOutputLimit(exec.Command("sh", "-c", "echo hello"), 6)
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", out)
+	})
+	t.Run("LimitExceeded", func(t *testing.T) {
+		_, err := RunWithOutputLimit(exec.Command("sh", "-c", "yes"), 1024)
+		assert.EqualError(t, err, "`sh -c yes` failed output size limit exceeded: more than 1024 bytes written to stdout")
+	})
+	t.Run("Stderr", func(t *testing.T) {
+		_, err := RunWithOutputLimit(exec.Command("sh", "-c", "echo out; echo failure details >&2; exit 1"), 1024)
+		assert.EqualError(t, err, "`sh -c echo out; echo failure details >&2; exit 1` failed exit status 1: failure details")
+	})
+}
+
+func TestRunner(t *testing.T) {
+	t.Run("NilRunner", func(t *testing.T) {
+		var r *Runner
+		out, err := r.Run(exec.Command("sh", "-c", "echo hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", out)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewRunner(ctx).Run(exec.Command("sh", "-c", "echo hello"))
+		assert.Equal(t, context.Canceled, err)
+	})
+	t.Run("Prepare", func(t *testing.T) {
+		var prepared []string
+		prepare := func(cmd *exec.Cmd, paths []string) error {
+			prepared = paths
+			cmd.Args = append(cmd.Args, "prepared")
+			return nil
+		}
+		var r *Runner
+		r = r.WithPrepare(prepare, "/repo").WithPaths("/values.yaml")
+		out, err := r.Run(exec.Command("echo", "hello"))
+		assert.NoError(t, err)
+		assert.Equal(t, "hello prepared", out)
+		assert.Equal(t, []string{"/repo", "/values.yaml"}, prepared)
+
+		_, err = NewRunner(context.Background()).WithPrepare(func(*exec.Cmd, []string) error {
+			return fmt.Errorf("sandbox unavailable")
+		}).Run(exec.Command("echo", "hello"))
+		assert.EqualError(t, err, "sandbox unavailable")
+	})
+	t.Run("ChildProcessesKilled", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip()
+		}
+		marker := filepath.Join(t.TempDir(), "marker")
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := NewRunner(ctx).RunWithOutputLimit(exec.Command("sh", "-c", "(sleep 1 && touch "+marker+") & wait"), 1024)
+		assert.Error(t, err)
+		assert.Less(t, int64(time.Since(start)), int64(time.Second))
+		time.Sleep(1500 * time.Millisecond)
+		assert.NoFileExists(t, marker)
+	})
+}
@@ -0,0 +1,9 @@
+package exec
+
+import (
+	"os/exec"
+)
+
+// killProcessGroupOnCancel keeps the default cancellation of the command, which kills the command only
+func killProcessGroupOnCancel(_ *exec.Cmd) {
+}
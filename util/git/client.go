@@ -62,6 +62,7 @@ type Client interface {
 	LsLargeFiles() ([]string, error)
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
+	ChangedFiles(revision string, targetRevision string) ([]string, error)
 	VerifyCommitSignature(string) (string, error)
 }
 
@@ -90,6 +91,10 @@ type nativeGitClient struct {
 	loadRefFromCache bool
 	// HTTP/HTTPS proxy used to access repository
 	proxy string
+	// Whether the submodules are checked out, the ARGOCD_GIT_MODULES_ENABLED env variable is used if nil
+	enableSubmodules *bool
+	// Whether the URLs of the submodules are rewritten so that the credentials of the repository are used
+	submodulesUseRepoCreds bool
 }
 
 var (
@@ -123,6 +128,21 @@ func WithEventHandlers(handlers EventHandlers) ClientOpts {
 	}
 }
 
+// WithSubmodules sets whether the submodules are checked out, instead of the ARGOCD_GIT_MODULES_ENABLED env variable
+func WithSubmodules(enabled bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.enableSubmodules = &enabled
+	}
+}
+
+// WithSubmodulesUseRepoCreds rewrites the URLs of the submodules hosted on the same server as the repository to the
+// URL scheme of the repository, so that they are fetched using the credentials of the repository
+func WithSubmodulesUseRepoCreds() ClientOpts {
+	return func(c *nativeGitClient) {
+		c.submodulesUseRepoCreds = true
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile("(/|:)")
 	root := filepath.Join(os.TempDir(), r.ReplaceAllString(NormalizeGitURL(rawRepoURL), "_"))
@@ -307,6 +327,50 @@ func (m *nativeGitClient) Init() error {
 	return err
 }
 
+// submodulesEnabled returns whether the submodules of the repository are checked out
+func (m *nativeGitClient) submodulesEnabled() bool {
+	if m.enableSubmodules != nil {
+		return *m.enableSubmodules
+	}
+	return os.Getenv(common.EnvGitSubmoduleEnabled) != "false"
+}
+
+// submoduleURLConfig returns the git configuration which rewrites the HTTPS and SSH URLs of the submodules hosted on
+// the same server as the repository to the URL scheme of the repository
+func submoduleURLConfig(repoURL string) []string {
+	var base, host string
+	if IsHTTPSURL(repoURL) {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return nil
+		}
+		base = fmt.Sprintf("https://%s/", u.Host)
+		host = u.Hostname()
+	} else if ok, user := IsSSHURL(repoURL); ok {
+		if strings.HasPrefix(repoURL, "ssh://") {
+			u, err := url.Parse(repoURL)
+			if err != nil {
+				return nil
+			}
+			base = fmt.Sprintf("ssh://%s@%s/", user, u.Host)
+			host = u.Hostname()
+		} else {
+			// scp-like syntax, e.g. git@github.com:argoproj/argo-cd.git
+			base = repoURL[:strings.Index(repoURL, ":")+1]
+			host = strings.TrimPrefix(base[:len(base)-1], user+"@")
+		}
+	} else {
+		return nil
+	}
+	var config []string
+	for _, prefix := range []string{fmt.Sprintf("https://%s/", host), fmt.Sprintf("git@%s:", host), fmt.Sprintf("ssh://git@%s/", host)} {
+		if prefix != base {
+			config = append(config, "-c", fmt.Sprintf("url.%s.insteadOf=%s", base, prefix))
+		}
+	}
+	return config
+}
+
 // Returns true if the repository is LFS enabled
 func (m *nativeGitClient) IsLFSEnabled() bool {
 	return m.enableLfs
@@ -381,8 +445,13 @@ func (m *nativeGitClient) Checkout(revision string) error {
 		}
 	}
 	if _, err := os.Stat(m.root + "/.gitmodules"); !os.IsNotExist(err) {
-		if submoduleEnabled := os.Getenv(common.EnvGitSubmoduleEnabled); submoduleEnabled != "false" {
-			if err := m.runCredentialedCmd("git", "submodule", "update", "--init", "--recursive"); err != nil {
+		if m.submodulesEnabled() {
+			var args []string
+			if m.submodulesUseRepoCreds {
+				args = submoduleURLConfig(m.repoURL)
+			}
+			args = append(args, "submodule", "update", "--init", "--recursive")
+			if err := m.runCredentialedCmd("git", args...); err != nil {
 				return err
 			}
 		}
@@ -560,6 +629,30 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
+// ChangedFiles returns the paths, relative to the root of the repository, of the files changed between the two
+// given commits
+func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) ([]string, error) {
+	for _, r := range []string{revision, targetRevision} {
+		if !IsCommitSHA(r) && !IsTruncatedCommitSHA(r) {
+			return nil, fmt.Errorf("invalid revision '%s', must be a commit SHA", r)
+		}
+	}
+	if revision == targetRevision {
+		return []string{}, nil
+	}
+	out, err := m.runCmd("diff", "--name-only", revision, targetRevision)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, file := range strings.Split(out, "\n") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
 // VerifyCommitSignature Runs verify-commit on a given revision and returns the output
 func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error) {
 	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision)
@@ -6,8 +6,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"os"
-	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +20,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 
 	certutil "github.com/argoproj/argo-cd/v2/util/cert"
+	"github.com/argoproj/argo-cd/v2/util/env"
 )
 
 // In memory cache for storing github APP api token credentials
@@ -28,14 +29,13 @@ var (
 )
 
 func init() {
-	githubAppCredsExp := common.GithubAppCredsExpirationDuration
-	if exp := os.Getenv(common.EnvGithubAppCredsExpirationDuration); exp != "" {
-		if qps, err := strconv.Atoi(exp); err != nil {
-			githubAppCredsExp = time.Duration(qps) * time.Minute
-		}
-	}
+	githubAppTokenCache = gocache.New(getGithubAppCredsExpiration(), 1*time.Minute)
+}
 
-	githubAppTokenCache = gocache.New(githubAppCredsExp, 1*time.Minute)
+// getGithubAppCredsExpiration returns how long GitHub app credentials are cached, configured in minutes by env variable
+func getGithubAppCredsExpiration() time.Duration {
+	defaultMinutes := int(common.GithubAppCredsExpirationDuration / time.Minute)
+	return time.Duration(env.ParseNumFromEnv(common.EnvGithubAppCredsExpirationDuration, defaultMinutes, 1, math.MaxInt32)) * time.Minute
 }
 
 type Creds interface {
@@ -174,10 +174,12 @@ type SSHCreds struct {
 	sshPrivateKey string
 	caPath        string
 	insecure      bool
+	// SOCKS5 or HTTP CONNECT proxy used to access repository
+	proxy string
 }
 
-func NewSSHCreds(sshPrivateKey string, caPath string, insecureIgnoreHostKey bool) SSHCreds {
-	return SSHCreds{sshPrivateKey, caPath, insecureIgnoreHostKey}
+func NewSSHCreds(sshPrivateKey string, caPath string, insecureIgnoreHostKey bool, proxy string) SSHCreds {
+	return SSHCreds{sshPrivateKey, caPath, insecureIgnoreHostKey, proxy}
 }
 
 type sshPrivateKeyFile string
@@ -229,6 +231,11 @@ func (c SSHCreds) Environ() (io.Closer, []string, error) {
 		knownHostsFile := certutil.GetSSHKnownHostsDataPath()
 		args = append(args, "-o", "StrictHostKeyChecking=yes", "-o", fmt.Sprintf("UserKnownHostsFile=%s", knownHostsFile))
 	}
+	if c.proxy != "" {
+		// the proxy URL may contain credentials, so it is passed in the environment rather than on the command line
+		args = append(args, "-o", "'ProxyCommand=argocd-git-ssh-proxy %h %p'")
+		env = append(env, fmt.Sprintf("%s=%s", common.EnvGitSSHProxy, c.proxy))
+	}
 	env = append(env, []string{fmt.Sprintf("GIT_SSH_COMMAND=%s", strings.Join(args, " "))}...)
 	return sshPrivateKeyFile(file.Name()), env, nil
 }
@@ -357,7 +364,7 @@ func (g GitHubAppCreds) getAccessToken() (string, error) {
 	itr.BaseURL = baseUrl
 
 	// Add transport to cache
-	githubAppTokenCache.Set(key, itr, time.Minute*60)
+	githubAppTokenCache.Set(key, itr, gocache.DefaultExpiration)
 
 	return itr.Token(ctx)
 }
@@ -373,3 +380,15 @@ func (g GitHubAppCreds) GetClientCertData() string {
 func (g GitHubAppCreds) GetClientCertKey() string {
 	return g.clientCertKey
 }
+
+// CredsFiles returns the temporary files holding the credentials of the environment returned by Creds.Environ, given
+// its closer, e.g. so that a sandboxed command can access them
+func CredsFiles(closer io.Closer) []string {
+	switch c := closer.(type) {
+	case authFilePaths:
+		return c
+	case sshPrivateKeyFile:
+		return []string{string(c)}
+	}
+	return nil
+}
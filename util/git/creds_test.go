@@ -0,0 +1,23 @@
+package git
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/common"
+)
+
+func TestGetGithubAppCredsExpiration(t *testing.T) {
+	defer func() { _ = os.Unsetenv(common.EnvGithubAppCredsExpirationDuration) }()
+
+	assert.Equal(t, common.GithubAppCredsExpirationDuration, getGithubAppCredsExpiration())
+
+	_ = os.Setenv(common.EnvGithubAppCredsExpirationDuration, "15")
+	assert.Equal(t, 15*time.Minute, getGithubAppCredsExpiration())
+
+	_ = os.Setenv(common.EnvGithubAppCredsExpirationDuration, "abc")
+	assert.Equal(t, common.GithubAppCredsExpirationDuration, getGithubAppCredsExpiration())
+}
@@ -5,11 +5,15 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/test/fixture/log"
 	"github.com/argoproj/argo-cd/v2/test/fixture/path"
 	"github.com/argoproj/argo-cd/v2/test/fixture/test"
@@ -322,6 +326,45 @@ func TestVerifyCommitSignature(t *testing.T) {
 	}
 }
 
+func TestChangedFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-changed-files")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	runGit := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	runGit("init")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte("a"), 0644))
+	runGit("add", ".")
+	runGit("commit", "-m", "first")
+	first := runGit("rev-parse", "HEAD")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "app"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app", "b.yaml"), []byte("b"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.yaml"), []byte("aa"), 0644))
+	runGit("add", ".")
+	runGit("commit", "-m", "second")
+	second := runGit("rev-parse", "HEAD")
+
+	client, err := NewClientExt("https://github.com/argoproj/argo-cd.git", dir, NopCreds{}, false, false, "")
+	require.NoError(t, err)
+
+	files, err := client.ChangedFiles(first, second)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a.yaml", "app/b.yaml"}, files)
+
+	files, err = client.ChangedFiles(second, second)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	_, err = client.ChangedFiles("--output=/tmp/x", second)
+	assert.EqualError(t, err, "invalid revision '--output=/tmp/x', must be a commit SHA")
+}
+
 func TestNewFactory(t *testing.T) {
 	addBinDirToPath := path.NewBinDirToPath()
 	defer addBinDirToPath.Close()
@@ -404,3 +447,75 @@ func TestListRevisions(t *testing.T) {
 	assert.NotContains(t, lsResult.Branches, testTag)
 	assert.NotContains(t, lsResult.Tags, testBranch)
 }
+
+func TestSubmoduleURLConfig(t *testing.T) {
+	assert.Equal(t, []string{
+		"-c", "url.https://github.com/.insteadOf=git@github.com:",
+		"-c", "url.https://github.com/.insteadOf=ssh://git@github.com/",
+	}, submoduleURLConfig("https://github.com/argoproj/argo-cd.git"))
+	assert.Equal(t, []string{
+		"-c", "url.https://gitlab.example.com:8443/.insteadOf=https://gitlab.example.com/",
+		"-c", "url.https://gitlab.example.com:8443/.insteadOf=git@gitlab.example.com:",
+		"-c", "url.https://gitlab.example.com:8443/.insteadOf=ssh://git@gitlab.example.com/",
+	}, submoduleURLConfig("https://user@gitlab.example.com:8443/argoproj/argo-cd.git"))
+	assert.Equal(t, []string{
+		"-c", "url.git@github.com:.insteadOf=https://github.com/",
+		"-c", "url.git@github.com:.insteadOf=ssh://git@github.com/",
+	}, submoduleURLConfig("git@github.com:argoproj/argo-cd.git"))
+	assert.Equal(t, []string{
+		"-c", "url.ssh://git@github.com/.insteadOf=https://github.com/",
+		"-c", "url.ssh://git@github.com/.insteadOf=git@github.com:",
+	}, submoduleURLConfig("ssh://git@github.com/argoproj/argo-cd.git"))
+	assert.Empty(t, submoduleURLConfig("file:///tmp/argo-cd"))
+}
+
+func TestCheckoutSubmodules(t *testing.T) {
+	// local submodules are not allowed by default since git 2.38.1
+	for k, v := range map[string]string{"GIT_CONFIG_COUNT": "1", "GIT_CONFIG_KEY_0": "protocol.file.allow", "GIT_CONFIG_VALUE_0": "always"} {
+		_ = os.Setenv(k, v)
+		defer func(k string) { _ = os.Unsetenv(k) }(k)
+	}
+
+	runGit := func(dir string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	tempDir := func() string {
+		dir, err := ioutil.TempDir("", "test-submodules")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.RemoveAll(dir) })
+		return dir
+	}
+	newRepo := func() string {
+		dir := tempDir()
+		runGit(dir, "init")
+		return dir
+	}
+	sub := newRepo()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(sub, "a.yaml"), []byte("a"), 0644))
+	runGit(sub, "add", ".")
+	runGit(sub, "commit", "-m", "first")
+	parent := newRepo()
+	runGit(parent, "submodule", "add", sub, "sub")
+	runGit(parent, "commit", "-m", "first")
+
+	checkout := func(opts ...ClientOpts) string {
+		client, err := NewClientExt("file://"+parent, tempDir(), NopCreds{}, false, false, "", opts...)
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		require.NoError(t, client.Fetch(""))
+		require.NoError(t, client.Checkout("FETCH_HEAD"))
+		return client.Root()
+	}
+
+	assert.FileExists(t, filepath.Join(checkout(), "sub", "a.yaml"))
+	assert.FileExists(t, filepath.Join(checkout(WithSubmodules(true)), "sub", "a.yaml"))
+	assert.NoFileExists(t, filepath.Join(checkout(WithSubmodules(false)), "sub", "a.yaml"))
+
+	_ = os.Setenv(common.EnvGitSubmoduleEnabled, "false")
+	defer func() { _ = os.Unsetenv(common.EnvGitSubmoduleEnabled) }()
+	assert.NoFileExists(t, filepath.Join(checkout(), "sub", "a.yaml"))
+	assert.FileExists(t, filepath.Join(checkout(WithSubmodules(true)), "sub", "a.yaml"))
+}
@@ -0,0 +1,185 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
+)
+
+// sshProxyDialTimeout bounds the time spent connecting to the git server through the proxy
+const sshProxyDialTimeout = 30 * time.Second
+
+// DialProxy opens a connection to addr through the given SOCKS5 (socks5://, socks5h://) or HTTP CONNECT
+// (http://, https://) proxy. Proxy credentials can be specified as user info of the proxy URL.
+func DialProxy(proxyURL string, addr string) (net.Conn, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL '%s': %v", proxyURL, err)
+	}
+	dialer := &net.Dialer{Timeout: sshProxyDialTimeout}
+	switch u.Scheme {
+	case "socks5", "socks5h":
+		d, err := proxy.FromURL(u, dialer)
+		if err != nil {
+			return nil, err
+		}
+		return d.Dial("tcp", addr)
+	case "http", "https":
+		return dialHTTPConnect(dialer, u, addr)
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme '%s', must be one of socks5, socks5h, http or https", u.Scheme)
+	}
+}
+
+func dialHTTPConnect(dialer *net.Dialer, u *url.URL, addr string) (net.Conn, error) {
+	proxyAddr := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "https" {
+			proxyAddr = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			proxyAddr = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+	var conn net.Conn
+	var err error
+	if u.Scheme == "https" {
+		conn, err = tls.DialWithDialer(dialer, "tcp", proxyAddr, &tls.Config{ServerName: u.Hostname()})
+	} else {
+		conn, err = dialer.Dial("tcp", proxyAddr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(u.User.Username()+":"+password)))
+	}
+	_ = conn.SetDeadline(time.Now().Add(sshProxyDialTimeout))
+	if err = req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("proxy refused connection to %s: %s", addr, resp.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	// SSH servers send their banner right away, which may already be buffered
+	return &bufferedConn{Conn: conn, reader: br}, nil
+}
+
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// sshProxyUploadPackSession lists the references of an SSH git repository through a proxy. go-git only honors the
+// ALL_PROXY environment variable of the process for SSH, so the connection is established here instead.
+type sshProxyUploadPackSession struct {
+	endpoint *transport.Endpoint
+	client   *ssh.Client
+	session  *ssh.Session
+	refs     *packp.AdvRefs
+}
+
+func newSSHProxyUploadPackSession(ep *transport.Endpoint, auth transport.AuthMethod, proxyURL string) (transport.UploadPackSession, error) {
+	sshAuth, ok := auth.(gitssh.AuthMethod)
+	if !ok {
+		return nil, transport.ErrInvalidAuthMethod
+	}
+	config, err := sshAuth.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	port := ep.Port
+	if port <= 0 {
+		port = gitssh.DefaultPort
+	}
+	addr := net.JoinHostPort(ep.Host, strconv.Itoa(port))
+	conn, err := DialProxy(proxyURL, addr)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	s := &sshProxyUploadPackSession{endpoint: ep, client: ssh.NewClient(c, chans, reqs)}
+	s.session, err = s.client.NewSession()
+	if err != nil {
+		_ = s.client.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sshProxyUploadPackSession) AdvertisedReferences() (*packp.AdvRefs, error) {
+	if s.refs != nil {
+		return s.refs, nil
+	}
+	stdout, err := s.session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr := &bytes.Buffer{}
+	s.session.Stderr = stderr
+	// the repository path is quoted the same way go-git does
+	if err = s.session.Start(fmt.Sprintf("%s '%s'", transport.UploadPackServiceName, s.endpoint.Path)); err != nil {
+		return nil, err
+	}
+	refs := packp.NewAdvRefs()
+	if err = refs.Decode(stdout); err != nil {
+		// wait for the remote command to exit, so that its error message is fully copied
+		_ = s.session.Wait()
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%v: %s", err, msg)
+		}
+		return nil, err
+	}
+	s.refs = refs
+	return refs, nil
+}
+
+func (s *sshProxyUploadPackSession) UploadPack(context.Context, *packp.UploadPackRequest) (*packp.UploadPackResponse, error) {
+	return nil, errors.New("fetching through an SSH proxy is only supported by the git CLI")
+}
+
+func (s *sshProxyUploadPackSession) Close() error {
+	_ = s.session.Close()
+	return s.client.Close()
+}
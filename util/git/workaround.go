@@ -19,6 +19,10 @@ func newUploadPackSession(url string, auth transport.AuthMethod, insecure bool,
 		return nil, err
 	}
 
+	if ok, _ := IsSSHURL(url); ok && proxy != "" {
+		return newSSHProxyUploadPackSession(ep, auth, proxy)
+	}
+
 	return c.NewUploadPackSession(ep, auth)
 }
 
@@ -11,6 +11,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -20,6 +23,9 @@ import (
 // Regular expression to match public key beginning
 var subTypeMatch = regexp.MustCompile(`^pub\s+([a-z0-9]+)\s\d+-\d+-\d+\s\[[A-Z]+\].*$`)
 
+// Regular expression to match the expiration date of a public key
+var expiresMatch = regexp.MustCompile(`\[expire[sd]:\s(\d+-\d+-\d+)\]`)
+
 // Regular expression to match key ID output from gpg
 var keyIdMatch = regexp.MustCompile(`^\s+([0-9A-Za-z]+)\s*$`)
 
@@ -491,6 +497,15 @@ func GetInstalledPGPKeys(kids []string) ([]*appsv1.GnuPGPublicKey, error) {
 			}
 			key.SubType = token[1]
 
+			// Keys which expire have the expiration date at the end of the line
+			if token := expiresMatch.FindStringSubmatch(scanner.Text()); len(token) == 2 {
+				expiresAt, err := time.Parse("2006-01-02", token[1])
+				if err != nil {
+					return nil, fmt.Errorf("Invalid expiration date in line: %s", scanner.Text())
+				}
+				key.ExpiresAt = &metav1.Time{Time: expiresAt}
+			}
+
 			// Next line should be the key ID, no prefix
 			if !scanner.Scan() {
 				return nil, fmt.Errorf("Invalid output from gpg, end of text after primary key")
@@ -702,9 +717,14 @@ func SyncKeyRingFromDirectory(basePath string) ([]string, []string, error) {
 		installed[v.KeyID] = v
 	}
 
-	// First, add all keys that are found in the configuration but are not yet in the keyring
+	// First, add all keys that are found in the configuration but are not yet in the keyring. Keys which are already in
+	// the keyring are imported again, so that their updates (e.g. an extended expiration date) are merged.
 	for key := range configured {
-		if _, ok := installed[key]; !ok {
+		if _, ok := installed[key]; ok {
+			if _, err := ImportPGPKeys(path.Join(basePath, key)); err != nil {
+				return nil, nil, err
+			}
+		} else {
 			addedKey, err := ImportPGPKeys(path.Join(basePath, key))
 			if err != nil {
 				return nil, nil, err
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -271,6 +272,18 @@ func Test_ValidateGPGKeys(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, keys, 1)
 		assert.Contains(t, keys, "4AEE18F83AFDEB23")
+		// The key does not expire
+		assert.Nil(t, keys["4AEE18F83AFDEB23"].ExpiresAt)
+	}
+
+	// Expiration date of a key
+	{
+		keys, err := ValidatePGPKeys("testdata/janedoe.asc")
+		assert.NoError(t, err)
+		assert.Len(t, keys, 1)
+		if assert.NotNil(t, keys["F7842A5CEAA9C0B1"].ExpiresAt) {
+			assert.Equal(t, time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC), keys["F7842A5CEAA9C0B1"].ExpiresAt.Time)
+		}
 	}
 
 	// Validation bad case
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"github.com/google/uuid"
+	ctx_logrus "github.com/grpc-ecosystem/go-grpc-middleware/tags/logrus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the header holding the ID of a request, which is generated unless the client provides it
+const RequestIDHeader = "x-request-id"
+
+type requestIDKey struct{}
+
+// RequestID returns the ID of the request handled with the given context, or an empty string if it has none
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ContextWithRequestID returns a copy of the given context holding the given request ID
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(RequestIDHeader)) > 0 {
+		id = md.Get(RequestIDHeader)[0]
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	ctx_logrus.AddFields(ctx, logrus.Fields{"grpc.request.id": id})
+	return ContextWithRequestID(ctx, id)
+}
+
+// RequestIDUnaryServerInterceptor returns a UnaryServerInterceptor which assigns an ID to the requests, returns it in
+// the response headers and adds it to the log fields
+func RequestIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withRequestID(ctx), req)
+	}
+}
+
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RequestIDStreamServerInterceptor returns a StreamServerInterceptor which assigns an ID to the requests, returns it in
+// the response headers and adds it to the log fields
+func RequestIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &requestIDServerStream{ServerStream: stream, ctx: withRequestID(stream.Context())})
+	}
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDUnaryServerInterceptor(t *testing.T) {
+	interceptor := RequestIDUnaryServerInterceptor()
+	var requestID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		requestID = RequestID(ctx)
+		return nil, nil
+	}
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Len(t, requestID, 36)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "my-request"))
+	_, err = interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-request", requestID)
+}
+
+func TestRequestID(t *testing.T) {
+	assert.Equal(t, "", RequestID(context.Background()))
+	assert.Equal(t, "my-request", RequestID(ContextWithRequestID(context.Background(), "my-request")))
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	goio "io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -23,6 +24,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/cache"
 	executil "github.com/argoproj/argo-cd/v2/util/exec"
 	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/oci"
 	"github.com/argoproj/argo-cd/v2/util/proxy"
 )
 
@@ -38,6 +40,8 @@ type Creds struct {
 	CertData           []byte
 	KeyData            []byte
 	InsecureSkipVerify bool
+	// AuthProvider obtains OCI registry tokens for the identity of the repo server, instead of using Username/Password
+	AuthProvider string
 }
 
 type indexCache interface {
@@ -49,7 +53,11 @@ type Client interface {
 	CleanChartCache(chart string, version string) error
 	ExtractChart(chart string, version string) (string, io.Closer, error)
 	GetIndex(noCache bool) (*Index, error)
+	ListCharts(noCache bool, filter func(chart string) bool, visit func(chart string, entries Entries) error) error
+	GetTags(chart string) (Entries, error)
 	TestHelmOCI() (bool, error)
+	ResolveChartDigest(chart string, version string) (string, error)
+	VerifyChartSignature(chart string, digest string, verification *SignatureVerification) error
 }
 
 type ClientOpts func(c *nativeHelmChart)
@@ -114,10 +122,31 @@ func (c *nativeHelmChart) ensureHelmChartRepoPath() error {
 }
 
 func (c *nativeHelmChart) CleanChartCache(chart string, version string) error {
+	if c.enableOci && oci.IsDigest(version) {
+		ociClient, err := c.newOCIClient(chart)
+		if err != nil {
+			return err
+		}
+		return ociClient.CleanCache(version)
+	}
 	return os.RemoveAll(c.getCachedChartPath(chart, version))
 }
 
+// ExtractChart downloads the given chart version and extracts it into a temporary directory. The version of an OCI
+// chart can be the digest of its manifest, which is pulled by digest so that it cannot be replaced by pushing the tag.
 func (c *nativeHelmChart) ExtractChart(chart string, version string) (string, io.Closer, error) {
+	if c.enableOci && oci.IsDigest(version) {
+		ociClient, err := c.newOCIClient(chart)
+		if err != nil {
+			return "", nil, err
+		}
+		tempDir, closer, err := ociClient.Extract(version)
+		if err != nil {
+			return "", nil, err
+		}
+		return path.Join(tempDir, normalizeChartName(chart)), closer, nil
+	}
+
 	err := c.ensureHelmChartRepoPath()
 	if err != nil {
 		return "", nil, err
@@ -162,14 +191,18 @@ func (c *nativeHelmChart) ExtractChart(chart string, version string) (string, io
 		defer func() { _ = os.RemoveAll(tempDest) }()
 
 		if c.enableOci {
-			if c.creds.Password != "" && c.creds.Username != "" {
-				_, err = helmCmd.Login(c.repoURL, c.creds)
+			creds, err := getRegistryCreds(c.repoURL, c.creds)
+			if err != nil {
+				return "", nil, err
+			}
+			if creds.Password != "" && creds.Username != "" {
+				_, err = helmCmd.Login(c.repoURL, creds)
 				if err != nil {
 					return "", nil, err
 				}
 
 				defer func() {
-					_, _ = helmCmd.Logout(c.repoURL, c.creds)
+					_, _ = helmCmd.Logout(c.repoURL, creds)
 				}()
 			}
 
@@ -262,6 +295,85 @@ func (c *nativeHelmChart) GetIndex(noCache bool) (*Index, error) {
 	return index, nil
 }
 
+// ListCharts visits the entries of the charts of the index accepted by filter. Unlike GetIndex, the index is parsed
+// while it is downloaded, only the accepted charts are decoded, and the index is not stored in the index cache.
+func (c *nativeHelmChart) ListCharts(noCache bool, filter func(chart string) bool, visit func(chart string, entries Entries) error) error {
+	if !noCache && c.indexCache != nil {
+		var data []byte
+		if err := c.indexCache.GetHelmIndex(c.repoURL, &data); err != nil && err != cache.ErrCacheMiss {
+			log.Warnf("Failed to load index cache for repo: %s: %v", c.repoURL, err)
+		}
+		if len(data) > 0 {
+			return DecodeIndexEntries(bytes.NewReader(data), filter, visit)
+		}
+	}
+
+	start := time.Now()
+	body, err := c.openRepoIndex()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = body.Close() }()
+	if err := DecodeIndexEntries(body, filter, visit); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"seconds": time.Since(start).Seconds()}).Info("took to list charts")
+	return nil
+}
+
+// GetTags returns the versions of the given chart, listed from the tags of the OCI registry
+func (c *nativeHelmChart) GetTags(chart string) (Entries, error) {
+	if !c.enableOci {
+		return nil, errors.New("listing tags is only supported by OCI Helm repositories")
+	}
+	start := time.Now()
+	ociClient, err := c.newOCIClient(chart)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := ociClient.ListTags()
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"seconds": time.Since(start).Seconds()}).Info("took to get tags")
+
+	entries := Entries{}
+	for _, tag := range tags {
+		// OCI tags cannot contain '+', so Helm replaces it with '_' when pushing charts
+		entries = append(entries, Entry{Version: strings.ReplaceAll(tag, "_", "+")})
+	}
+	return entries, nil
+}
+
+// ResolveChartDigest returns the digest of the manifest of the given OCI chart version
+func (c *nativeHelmChart) ResolveChartDigest(chart string, version string) (string, error) {
+	if !c.enableOci {
+		return "", errors.New("resolving chart digests is only supported by OCI Helm repositories")
+	}
+	ociClient, err := c.newOCIClient(chart)
+	if err != nil {
+		return "", err
+	}
+	// OCI tags cannot contain '+', so Helm replaces it with '_' when pushing charts
+	return ociClient.ResolveRevision(strings.ReplaceAll(version, "+", "_"))
+}
+
+// newOCIClient returns a client of the OCI repository of the given chart
+func (c *nativeHelmChart) newOCIClient(chart string) (oci.Client, error) {
+	creds, err := getRegistryCreds(c.repoURL, c.creds)
+	if err != nil {
+		return nil, err
+	}
+	return oci.NewClientWithLock(oci.URLPrefix+strings.TrimSuffix(c.repoURL, "/")+"/"+chart, oci.Creds{
+		Username:           creds.Username,
+		Password:           creds.Password,
+		CAPath:             creds.CAPath,
+		CertData:           creds.CertData,
+		KeyData:            creds.KeyData,
+		InsecureSkipVerify: creds.InsecureSkipVerify,
+	}, c.repoLock, c.proxy), nil
+}
+
 func (c *nativeHelmChart) TestHelmOCI() (bool, error) {
 	start := time.Now()
 
@@ -277,15 +389,20 @@ func (c *nativeHelmChart) TestHelmOCI() (bool, error) {
 	}
 	defer helmCmd.Close()
 
+	creds, err := getRegistryCreds(c.repoURL, c.creds)
+	if err != nil {
+		return false, err
+	}
+
 	// Looks like there is no good way to test access to OCI repo if credentials are not provided
 	// just assume it is accessible
-	if c.creds.Username != "" && c.creds.Password != "" {
-		_, err = helmCmd.Login(c.repoURL, c.creds)
+	if creds.Username != "" && creds.Password != "" {
+		_, err = helmCmd.Login(c.repoURL, creds)
 		if err != nil {
 			return false, err
 		}
 		defer func() {
-			_, _ = helmCmd.Logout(c.repoURL, c.creds)
+			_, _ = helmCmd.Logout(c.repoURL, creds)
 		}()
 
 		log.WithFields(log.Fields{"seconds": time.Since(start).Seconds()}).Info("took to test helm oci repository")
@@ -294,6 +411,16 @@ func (c *nativeHelmChart) TestHelmOCI() (bool, error) {
 }
 
 func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
+	body, err := c.openRepoIndex()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+	return ioutil.ReadAll(body)
+}
+
+// openRepoIndex requests the index of the repository and returns the response body, which must be closed by the caller
+func (c *nativeHelmChart) openRepoIndex() (goio.ReadCloser, error) {
 	repoURL, err := url.Parse(c.repoURL)
 	if err != nil {
 		return nil, err
@@ -323,12 +450,12 @@ func (c *nativeHelmChart) loadRepoIndex() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != 200 {
+		_ = resp.Body.Close()
 		return nil, errors.New("failed to get index: " + resp.Status)
 	}
-	return ioutil.ReadAll(resp.Body)
+	return resp.Body, nil
 }
 
 func newTLSConfig(creds Creds) (*tls.Config, error) {
@@ -1,8 +1,17 @@
 package helm
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,6 +70,25 @@ func TestIndex(t *testing.T) {
 		assert.Equal(t, fakeIndex, *index)
 	})
 
+	t.Run("ListChartsCached", func(t *testing.T) {
+		fakeIndex := Index{Entries: map[string]Entries{"fake": {{Version: "1.0.0"}}, "other": {{Version: "2.0.0"}}}}
+		data := bytes.Buffer{}
+		err := yaml.NewEncoder(&data).Encode(fakeIndex)
+		require.NoError(t, err)
+
+		client := NewClient("https://argoproj.github.io/argo-helm", Creds{}, false, "", WithIndexCache(&fakeIndexCache{data: data.Bytes()}))
+		charts := map[string]Entries{}
+		err = client.ListCharts(false, func(chart string) bool {
+			return chart == "fake"
+		}, func(chart string, entries Entries) error {
+			charts[chart] = entries
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]Entries{"fake": {{Version: "1.0.0"}}}, charts)
+	})
+
 }
 
 func Test_nativeHelmChart_ExtractChart(t *testing.T) {
@@ -83,6 +111,49 @@ func Test_nativeHelmChart_ExtractChart_insecure(t *testing.T) {
 	assert.True(t, info.IsDir())
 }
 
+func Test_nativeHelmChart_ExtractChartByDigest(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	chartYAML := "apiVersion: v2\nname: my-chart\nversion: 1.0.0+build\n"
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "my-chart/Chart.yaml", Mode: 0644, Size: int64(len(chartYAML)), Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte(chartYAML))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	layer := buf.Bytes()
+	layerDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(layer))
+	manifest := []byte(fmt.Sprintf(`{"mediaType":"application/vnd.oci.image.manifest.v1+json","layers":[{"mediaType":"application/vnd.cncf.helm.chart.content.v1.tar+gzip","digest":"%s","size":%d}]}`, layerDigest, len(layer)))
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/org/my-chart/manifests/1.0.0_build", "/v2/org/my-chart/manifests/" + digest:
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			w.Header().Set("Docker-Content-Digest", digest)
+			_, _ = w.Write(manifest)
+		case "/v2/org/my-chart/blobs/" + layerDigest:
+			_, _ = w.Write(layer)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(strings.TrimPrefix(server.URL, "https://")+"/org", Creds{InsecureSkipVerify: true}, true, "")
+	resolved, err := client.ResolveChartDigest("my-chart", "1.0.0+build")
+	require.NoError(t, err)
+	assert.Equal(t, digest, resolved)
+
+	chartPath, closer, err := client.ExtractChart("my-chart", digest)
+	require.NoError(t, err)
+	defer io.Close(closer)
+	data, err := ioutil.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, chartYAML, string(data))
+	assert.NoError(t, client.CleanChartCache("my-chart", digest))
+}
+
 func Test_normalizeChartName(t *testing.T) {
 	t.Run("Test non-slashed name", func(t *testing.T) {
 		n := normalizeChartName("mychart")
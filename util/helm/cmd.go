@@ -22,6 +22,8 @@ type Cmd struct {
 	IsLocal   bool
 	IsHelmOci bool
 	proxy     string
+	// runner runs the helm commands
+	runner *executil.Runner
 }
 
 func NewCmd(workDir string, version string, proxy string) (*Cmd, error) {
@@ -66,7 +68,11 @@ func (c Cmd) run(args ...string) (string, error) {
 
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy)
 
-	return executil.RunWithRedactor(cmd, redactor)
+	runner := c.runner
+	if !c.IsLocal {
+		runner = runner.WithPaths(c.helmHome)
+	}
+	return runner.RunWithRedactor(cmd, redactor)
 }
 
 func (c *Cmd) Init() (string, error) {
@@ -0,0 +1,128 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+	"github.com/argoproj/argo-cd/v2/util/oci"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+)
+
+// cosignBinary is the name of the cosign executable, replaced by unit tests
+var cosignBinary = "cosign"
+
+// SignatureVerification specifies the cosign signatures accepted for OCI charts. A chart is accepted if it is
+// signed with any of the public keys, or has a keyless signature issued to any of the identities.
+type SignatureVerification struct {
+	// PublicKeys is a list of PEM encoded cosign public keys
+	PublicKeys []string
+	// KeylessIdentities is a list of identities of keyless signing certificates
+	KeylessIdentities []KeylessIdentity
+}
+
+// KeylessIdentity is the identity of a signing certificate issued by Fulcio
+type KeylessIdentity struct {
+	// Issuer is the OIDC issuer of the identity
+	Issuer string
+	// Subject is the identity, e.g. an email address or a CI workflow URL
+	Subject string
+}
+
+func (v *SignatureVerification) IsZero() bool {
+	return v == nil || len(v.PublicKeys) == 0 && len(v.KeylessIdentities) == 0
+}
+
+// Hash returns a hash of the accepted signatures
+func (v *SignatureVerification) Hash() (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// VerifyChartSignature checks the cosign signature of the OCI chart with the given manifest digest. The chart is
+// verified by digest, which must be the one the chart is then pulled with, since its tag could be moved in between.
+func (c *nativeHelmChart) VerifyChartSignature(chart string, digest string, verification *SignatureVerification) error {
+	if verification.IsZero() {
+		return nil
+	}
+	if !c.enableOci {
+		return errors.New("chart signature verification is only supported by OCI Helm repositories")
+	}
+	if !oci.IsDigest(digest) {
+		return fmt.Errorf("invalid chart digest '%s'", digest)
+	}
+
+	tempDir, err := ioutil.TempDir("", "cosign")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+
+	// registry credentials are passed to cosign using a docker config file, so that they do not appear in process arguments
+	creds, err := getRegistryCreds(c.repoURL, c.creds)
+	if err != nil {
+		return err
+	}
+	if creds.Username != "" && creds.Password != "" {
+		if err = writeDockerConfig(tempDir, c.repoURL, creds); err != nil {
+			return err
+		}
+	}
+
+	ref := fmt.Sprintf("%s/%s@%s", strings.TrimSuffix(c.repoURL, "/"), chart, digest)
+	var failures []string
+	for i, key := range verification.PublicKeys {
+		keyPath := filepath.Join(tempDir, fmt.Sprintf("key-%d.pub", i))
+		if err = ioutil.WriteFile(keyPath, []byte(key), 0600); err != nil {
+			return err
+		}
+		if err = c.runCosign(tempDir, "verify", "--key", keyPath, ref); err == nil {
+			return nil
+		}
+		failures = append(failures, fmt.Sprintf("public key #%d: %v", i+1, err))
+	}
+	for _, identity := range verification.KeylessIdentities {
+		if err = c.runCosign(tempDir, "verify", "--certificate-identity", identity.Subject, "--certificate-oidc-issuer", identity.Issuer, ref); err == nil {
+			return nil
+		}
+		failures = append(failures, fmt.Sprintf("identity %s (%s): %v", identity.Subject, identity.Issuer, err))
+	}
+	return fmt.Errorf("failed to verify signature of chart %s: %s", ref, strings.Join(failures, "; "))
+}
+
+func (c *nativeHelmChart) runCosign(dockerConfigDir string, args ...string) error {
+	if c.creds.InsecureSkipVerify {
+		args = append([]string{args[0], "--allow-insecure-registry"}, args[1:]...)
+	}
+	cmd := exec.Command(cosignBinary, args...)
+	cmd.Env = append(os.Environ(), "DOCKER_CONFIG="+dockerConfigDir)
+	cmd.Env = proxy.UpsertEnv(cmd, c.proxy)
+	_, err := executil.Run(cmd)
+	return err
+}
+
+func writeDockerConfig(dir string, repoURL string, creds Creds) error {
+	config := map[string]interface{}{
+		"auths": map[string]interface{}{
+			registryHost(repoURL): map[string]string{
+				"auth": base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password)),
+			},
+		},
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "config.json"), data, 0600)
+}
@@ -0,0 +1,87 @@
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCosign installs a cosign script which records its arguments and succeeds only if they contain validArg
+func fakeCosign(t *testing.T, validArg string) (string, func()) {
+	dir, err := ioutil.TempDir("", "fake-cosign")
+	require.NoError(t, err)
+	argsFile := filepath.Join(dir, "args")
+	script := `#!/bin/sh
+echo "$@" >> ` + argsFile + `
+test -f "$DOCKER_CONFIG/config.json" && cat "$DOCKER_CONFIG/config.json" >> ` + argsFile + `
+for arg in "$@"; do
+  if [ "$arg" = "` + validArg + `" ]; then exit 0; fi
+done
+echo "no matching signatures" >&2
+exit 1
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "cosign"), []byte(script), 0755))
+	prev := cosignBinary
+	cosignBinary = filepath.Join(dir, "cosign")
+	return argsFile, func() {
+		cosignBinary = prev
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestVerifyChartSignature(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	verification := &SignatureVerification{
+		PublicKeys:        []string{"-----BEGIN PUBLIC KEY-----"},
+		KeylessIdentities: []KeylessIdentity{{Issuer: "https://token.actions.githubusercontent.com", Subject: "https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main"}},
+	}
+
+	t.Run("Keyless", func(t *testing.T) {
+		argsFile, cleanup := fakeCosign(t, "https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main")
+		defer cleanup()
+
+		client := NewClient("ghcr.io/org", Creds{Username: "user", Password: "pass"}, true, "")
+		err := client.VerifyChartSignature("my-chart", digest, verification)
+		assert.NoError(t, err)
+
+		data, err := ioutil.ReadFile(argsFile)
+		require.NoError(t, err)
+		args := string(data)
+		assert.Contains(t, args, "ghcr.io/org/my-chart@"+digest)
+		assert.Contains(t, args, "--certificate-oidc-issuer https://token.actions.githubusercontent.com")
+		assert.Contains(t, args, `"ghcr.io":{"auth":"dXNlcjpwYXNz"}`)
+		assert.NotContains(t, args, "pass ")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, cleanup := fakeCosign(t, "unknown")
+		defer cleanup()
+
+		client := NewClient("ghcr.io/org", Creds{}, true, "")
+		err := client.VerifyChartSignature("my-chart", digest, verification)
+		assert.Error(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "failed to verify signature of chart ghcr.io/org/my-chart@"+digest+": public key #1: "), err.Error())
+	})
+
+	t.Run("NotDigest", func(t *testing.T) {
+		client := NewClient("ghcr.io/org", Creds{}, true, "")
+		err := client.VerifyChartSignature("my-chart", "1.0.0", verification)
+		assert.EqualError(t, err, "invalid chart digest '1.0.0'")
+	})
+
+	t.Run("NotOCI", func(t *testing.T) {
+		client := NewClient("https://charts.bitnami.com/bitnami", Creds{}, false, "")
+		err := client.VerifyChartSignature("redis", digest, verification)
+		assert.EqualError(t, err, "chart signature verification is only supported by OCI Helm repositories")
+	})
+
+	t.Run("NoVerification", func(t *testing.T) {
+		client := NewClient("https://charts.bitnami.com/bitnami", Creds{}, false, "")
+		assert.NoError(t, client.VerifyChartSignature("redis", digest, nil))
+	})
+}
@@ -0,0 +1,159 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DependencyCache is an on-disk cache of chart archives downloaded by `helm dependency build`. Archives are keyed
+// by repository, credentials, chart name and version, so that they can be shared between applications. The least
+// recently used archives are evicted once the total size of the cache exceeds the configured maximum.
+type DependencyCache struct {
+	dir     string
+	maxSize int64
+	lock    sync.Mutex
+	now     func() time.Time
+}
+
+// NewDependencyCache returns a chart archive cache stored in the given directory
+func NewDependencyCache(dir string, maxSize int64) *DependencyCache {
+	return &DependencyCache{dir: dir, maxSize: maxSize, now: time.Now}
+}
+
+// credentialsID identifies the credentials an archive is downloaded with, it is empty for the repositories without
+// credentials. The archives of private repositories are only shared with the applications using the same credentials.
+func credentialsID(creds Creds) string {
+	if creds.Username == "" && creds.Password == "" && len(creds.CertData) == 0 && len(creds.KeyData) == 0 && creds.AuthProvider == "" {
+		return ""
+	}
+	h := sha256.New()
+	for _, v := range [][]byte{[]byte(creds.Username), []byte(creds.Password), creds.CertData, creds.KeyData, []byte(creds.AuthProvider)} {
+		_, _ = fmt.Fprintf(h, "%d:%s", len(v), v)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (c *DependencyCache) archivePath(repo string, creds Creds, chart string, version string) string {
+	key := repo
+	if id := credentialsID(creds); id != "" {
+		key = fmt.Sprintf("%s@%s", repo, id)
+	}
+	return filepath.Join(c.dir, fmt.Sprintf("%x", sha256.Sum256([]byte(key))), fmt.Sprintf("%s-%s.tgz", normalizeChartName(chart), version))
+}
+
+// Get copies the cached archive of the given chart version, downloaded with the given credentials, into destDir.
+// Returns false if the archive is not cached.
+func (c *DependencyCache) Get(repo string, creds Creds, chart string, version string, destDir string) (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	archive := c.archivePath(repo, creds, chart, version)
+	exists, err := fileExist(archive)
+	if err != nil || !exists {
+		return false, err
+	}
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return false, err
+	}
+	if err := copyFile(archive, filepath.Join(destDir, filepath.Base(archive))); err != nil {
+		return false, err
+	}
+	// modification time is used to track the last access for eviction
+	now := c.now()
+	return true, os.Chtimes(archive, now, now)
+}
+
+// Put stores the given chart archive, downloaded with the given credentials, in the cache and evicts least recently
+// used archives if needed
+func (c *DependencyCache) Put(repo string, creds Creds, chart string, version string, archive string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	dest := c.archivePath(repo, creds, chart, version)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(dest), "archive-")
+	if err != nil {
+		return err
+	}
+	_ = tmp.Close()
+	if err := copyFile(archive, tmp.Name()); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	now := c.now()
+	if err := os.Chtimes(dest, now, now); err != nil {
+		return err
+	}
+	return c.evict()
+}
+
+// evict removes the least recently used archives until the cache size is within the limit
+func (c *DependencyCache) evict() error {
+	if c.maxSize <= 0 {
+		return nil
+	}
+	type archiveInfo struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var archives []archiveInfo
+	var total int64
+	err := filepath.Walk(c.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		archives = append(archives, archiveInfo{path: path, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].modTime.Before(archives[j].modTime)
+	})
+	for i := 0; total > c.maxSize && i < len(archives); i++ {
+		log.Debugf("Evicting chart archive %s from dependency cache", archives[i].path)
+		if err := os.Remove(archives[i].path); err != nil {
+			return err
+		}
+		total -= archives[i].size
+	}
+	return nil
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,96 @@
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeArchive(t *testing.T, dir string, name string, size int) string {
+	p := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(p, make([]byte, size), 0644))
+	return p
+}
+
+func TestDependencyCache(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "dependency-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+	srcDir, err := ioutil.TempDir("", "archives")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(srcDir) }()
+
+	c := NewDependencyCache(cacheDir, 0)
+
+	t.Run("Miss", func(t *testing.T) {
+		ok, err := c.Get("https://charts.bitnami.com/bitnami", Creds{}, "redis", "1.0.0", srcDir)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Hit", func(t *testing.T) {
+		require.NoError(t, c.Put("https://charts.bitnami.com/bitnami", Creds{}, "redis", "1.0.0", writeArchive(t, srcDir, "archive.tgz", 10)))
+
+		destDir := filepath.Join(srcDir, "charts")
+		ok, err := c.Get("https://charts.bitnami.com/bitnami", Creds{}, "redis", "1.0.0", destDir)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		_, err = os.Stat(filepath.Join(destDir, "redis-1.0.0.tgz"))
+		assert.NoError(t, err)
+
+		ok, err = c.Get("https://example.com/charts", Creds{}, "redis", "1.0.0", destDir)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Credentials", func(t *testing.T) {
+		creds := Creds{Username: "user", Password: "pass"}
+		require.NoError(t, c.Put("https://example.com/private", creds, "app", "1.0.0", writeArchive(t, srcDir, "private.tgz", 10)))
+
+		destDir := filepath.Join(srcDir, "private")
+		ok, err := c.Get("https://example.com/private", creds, "app", "1.0.0", destDir)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		// the archive is not served to the applications with other or without credentials
+		for _, other := range []Creds{{}, {Username: "user", Password: "other"}, {Username: "other", Password: "pass"}} {
+			ok, err := c.Get("https://example.com/private", other, "app", "1.0.0", destDir)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		}
+	})
+}
+
+func TestDependencyCache_Evict(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "dependency-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+	srcDir, err := ioutil.TempDir("", "archives")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(srcDir) }()
+
+	now := time.Now()
+	c := NewDependencyCache(cacheDir, 25)
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.Put("repo", Creds{}, "a", "1.0.0", writeArchive(t, srcDir, "a.tgz", 10)))
+	now = now.Add(time.Minute)
+	require.NoError(t, c.Put("repo", Creds{}, "b", "1.0.0", writeArchive(t, srcDir, "b.tgz", 10)))
+	now = now.Add(time.Minute)
+	// accessing 'a' makes 'b' the least recently used archive
+	ok, err := c.Get("repo", Creds{}, "a", "1.0.0", srcDir)
+	require.NoError(t, err)
+	require.True(t, ok)
+	now = now.Add(time.Minute)
+	require.NoError(t, c.Put("repo", Creds{}, "c", "1.0.0", writeArchive(t, srcDir, "c.tgz", 10)))
+
+	for chart, cached := range map[string]bool{"a": true, "b": false, "c": true} {
+		_, err := os.Stat(c.archivePath("repo", Creds{}, chart, "1.0.0"))
+		assert.Equal(t, cached, err == nil, chart)
+	}
+}
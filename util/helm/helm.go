@@ -36,13 +36,18 @@ type Helm interface {
 	Dispose()
 }
 
-// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool.
-func NewHelmApp(workDir string, repos []HelmRepository, isLocal bool, version string, proxy string) (Helm, error) {
+// NewHelmApp create a new wrapper to run commands on the `helm` command-line tool. The binary of the version is used
+// unless a binary path is given. The commands are run by the given runner.
+func NewHelmApp(workDir string, repos []HelmRepository, isLocal bool, version string, proxy string, binaryPath string, runner *executil.Runner) (Helm, error) {
 	cmd, err := NewCmd(workDir, version, proxy)
 	if err != nil {
 		return nil, err
 	}
 	cmd.IsLocal = isLocal
+	cmd.runner = runner
+	if binaryPath != "" {
+		cmd.binaryName = binaryPath
+	}
 
 	return &helm{repos: repos, cmd: *cmd}, nil
 }
@@ -78,11 +83,15 @@ func (h *helm) DependencyBuild() error {
 		repo := h.repos[i]
 		if repo.EnableOci {
 			h.cmd.IsHelmOci = true
-			if repo.Creds.Username != "" && repo.Creds.Password != "" {
-				_, err := h.cmd.Login(repo.Repo, repo.Creds)
+			creds, err := getRegistryCreds(repo.Repo, repo.Creds)
+			if err != nil {
+				return err
+			}
+			if creds.Username != "" && creds.Password != "" {
+				_, err := h.cmd.Login(repo.Repo, creds)
 
 				defer func() {
-					_, _ = h.cmd.Logout(repo.Repo, repo.Creds)
+					_, _ = h.cmd.Logout(repo.Repo, creds)
 				}()
 
 				if err != nil {
@@ -23,7 +23,7 @@ func template(h Helm, opts *TemplateOpts) ([]*unstructured.Unstructured, error)
 }
 
 func TestHelmTemplateParams(t *testing.T) {
-	h, err := NewHelmApp("./testdata/minio", []HelmRepository{}, false, "", "")
+	h, err := NewHelmApp("./testdata/minio", []HelmRepository{}, false, "", "", "", nil)
 	assert.NoError(t, err)
 	opts := TemplateOpts{
 		Name: "test",
@@ -52,7 +52,7 @@ func TestHelmTemplateParams(t *testing.T) {
 }
 
 func TestHelmTemplateValues(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", []HelmRepository{}, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", []HelmRepository{}, false, "", "", "", nil)
 	assert.NoError(t, err)
 	opts := TemplateOpts{
 		Name:   "test",
@@ -73,7 +73,7 @@ func TestHelmTemplateValues(t *testing.T) {
 }
 
 func TestHelmGetParams(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", nil, false, "", "", "", nil)
 	assert.NoError(t, err)
 	params, err := h.GetParameters([]string{})
 	assert.Nil(t, err)
@@ -83,7 +83,7 @@ func TestHelmGetParams(t *testing.T) {
 }
 
 func TestHelmGetParamsValueFiles(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", nil, false, "", "", "", nil)
 	assert.NoError(t, err)
 	params, err := h.GetParameters([]string{"values-production.yaml"})
 	assert.Nil(t, err)
@@ -93,7 +93,7 @@ func TestHelmGetParamsValueFiles(t *testing.T) {
 }
 
 func TestHelmGetParamsValueFilesThatExist(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", nil, false, "", "", "", nil)
 	assert.NoError(t, err)
 	params, err := h.GetParameters([]string{"values-missing.yaml", "values-production.yaml"})
 	assert.Nil(t, err)
@@ -114,7 +114,7 @@ func TestHelmDependencyBuild(t *testing.T) {
 			}
 			clean()
 			defer clean()
-			h, err := NewHelmApp(fmt.Sprintf("./testdata/%s", chart), helmRepos, false, "", "")
+			h, err := NewHelmApp(fmt.Sprintf("./testdata/%s", chart), helmRepos, false, "", "", "", nil)
 			assert.NoError(t, err)
 			err = h.Init()
 			assert.NoError(t, err)
@@ -129,7 +129,7 @@ func TestHelmDependencyBuild(t *testing.T) {
 }
 
 func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", nil, false, "", "", "", nil)
 	assert.NoError(t, err)
 
 	objs, err := template(h, &TemplateOpts{Name: "my-release"})
@@ -147,7 +147,7 @@ func TestHelmTemplateReleaseNameOverwrite(t *testing.T) {
 }
 
 func TestHelmTemplateReleaseName(t *testing.T) {
-	h, err := NewHelmApp("./testdata/redis", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/redis", nil, false, "", "", "", nil)
 	assert.NoError(t, err)
 	objs, err := template(h, &TemplateOpts{Name: "test"})
 	assert.Nil(t, err)
@@ -206,7 +206,7 @@ func Test_flatVals(t *testing.T) {
 }
 
 func TestAPIVersions(t *testing.T) {
-	h, err := NewHelmApp("./testdata/api-versions", nil, false, "", "")
+	h, err := NewHelmApp("./testdata/api-versions", nil, false, "", "", "", nil)
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -223,3 +223,12 @@ func TestAPIVersions(t *testing.T) {
 	}
 	assert.Equal(t, objs[0].GetAPIVersion(), "sample/v2")
 }
+
+func TestNewHelmApp_BinaryPath(t *testing.T) {
+	h, err := NewHelmApp("./testdata/redis", nil, false, "v2", "", "/custom-tools/helm-2.17.0", nil)
+	assert.NoError(t, err)
+	defer h.Dispose()
+	cmd := h.(*helm).cmd
+	assert.Equal(t, "/custom-tools/helm-2.17.0", cmd.binaryName)
+	assert.Equal(t, HelmV2.templateNameArg, cmd.templateNameArg)
+}
@@ -2,9 +2,11 @@ package helm
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
 
 	"github.com/Masterminds/semver"
 )
@@ -56,3 +58,27 @@ func (e Entries) MaxVersion(constraints *semver.Constraints) (*semver.Version, e
 	}
 	return maxVersion, nil
 }
+
+// DecodeIndexEntries decodes the entries of the index.yaml read from r. The index is parsed while it is read, and only
+// the charts accepted by filter (all charts if nil) are decoded and passed to visit.
+func DecodeIndexEntries(r io.Reader, filter func(chart string) bool, visit func(chart string, entries Entries) error) error {
+	var index struct {
+		Entries map[string]yaml.Node `yaml:"entries"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&index); err != nil && err != io.EOF {
+		return err
+	}
+	for name, node := range index.Entries {
+		if filter != nil && !filter(name) {
+			continue
+		}
+		var entries Entries
+		if err := node.Decode(&entries); err != nil {
+			return fmt.Errorf("failed to decode the entries of chart '%s': %v", name, err)
+		}
+		if err := visit(name, entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package helm
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var index = Index{
@@ -58,3 +62,90 @@ func TestEntries_MaxVersion(t *testing.T) {
 		assert.Equal(t, semver.MustParse("0.7.2"), version)
 	})
 }
+
+const indexYaml = `apiVersion: v1
+entries:
+  argo-cd:
+  - apiVersion: v2
+    created: "2021-09-01T10:00:00Z"
+    description: |
+      A Helm chart for Argo CD
+
+      # not a comment
+    name: argo-cd
+    version: 3.17.5
+  - created: "2021-08-01T10:00:00Z"
+    name: argo-cd
+    version: 3.17.4
+  # a comment
+  argo-events:
+    - name: argo-events
+      version: 1.7.0
+  "argo-rollouts": []
+generated: "2021-09-01T10:00:00Z"
+`
+
+func TestDecodeIndexEntries(t *testing.T) {
+	decode := func(data string, filter func(chart string) bool) (map[string]Entries, error) {
+		res := map[string]Entries{}
+		err := DecodeIndexEntries(strings.NewReader(data), filter, func(chart string, entries Entries) error {
+			res[chart] = entries
+			return nil
+		})
+		return res, err
+	}
+
+	t.Run("All", func(t *testing.T) {
+		res, err := decode(indexYaml, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Entries{
+			"argo-cd": {
+				{Version: "3.17.5", Created: time.Date(2021, 9, 1, 10, 0, 0, 0, time.UTC)},
+				{Version: "3.17.4", Created: time.Date(2021, 8, 1, 10, 0, 0, 0, time.UTC)},
+			},
+			"argo-events":   {{Version: "1.7.0"}},
+			"argo-rollouts": {},
+		}, res)
+	})
+	t.Run("Filter", func(t *testing.T) {
+		res, err := decode(indexYaml, func(chart string) bool {
+			return chart == "argo-events"
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Entries{"argo-events": {{Version: "1.7.0"}}}, res)
+	})
+	t.Run("JSON", func(t *testing.T) {
+		res, err := decode(`{"entries": {"argo-cd": [{"version": "3.17.5"}], "argo-events": []}}`, func(chart string) bool {
+			return chart == "argo-cd"
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Entries{"argo-cd": {{Version: "3.17.5"}}}, res)
+	})
+	t.Run("FlowStyleAndIndentation", func(t *testing.T) {
+		res, err := decode(`entries:
+    # charts indented with four spaces
+    argo-cd: [{version: 3.17.5}, {"version": "3.17.4"}]
+    argo-events:
+    -   version: 1.7.0 # a comment
+    argo-workflows: []
+apiVersion: v1
+`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Entries{
+			"argo-cd":        {{Version: "3.17.5"}, {Version: "3.17.4"}},
+			"argo-events":    {{Version: "1.7.0"}},
+			"argo-workflows": {},
+		}, res)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		res, err := decode("apiVersion: v1\nentries: {}\n", nil)
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+	t.Run("VisitError", func(t *testing.T) {
+		err := DecodeIndexEntries(strings.NewReader(indexYaml), nil, func(chart string, entries Entries) error {
+			return errors.New("stop")
+		})
+		assert.EqualError(t, err, "stop")
+	})
+}
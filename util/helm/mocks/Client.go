@@ -81,6 +81,64 @@ func (_m *Client) GetIndex(noCache bool) (*helm.Index, error) {
 	return r0, r1
 }
 
+// GetTags provides a mock function with given fields: chart
+func (_m *Client) GetTags(chart string) (helm.Entries, error) {
+	ret := _m.Called(chart)
+
+	var r0 helm.Entries
+	if rf, ok := ret.Get(0).(func(string) helm.Entries); ok {
+		r0 = rf(chart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(helm.Entries)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(chart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCharts provides a mock function with given fields: noCache, filter, visit
+func (_m *Client) ListCharts(noCache bool, filter func(string) bool, visit func(string, helm.Entries) error) error {
+	ret := _m.Called(noCache, filter, visit)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(bool, func(string) bool, func(string, helm.Entries) error) error); ok {
+		r0 = rf(noCache, filter, visit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolveChartDigest provides a mock function with given fields: chart, version
+func (_m *Client) ResolveChartDigest(chart string, version string) (string, error) {
+	ret := _m.Called(chart, version)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(chart, version)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(chart, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TestHelmOCI provides a mock function with given fields:
 func (_m *Client) TestHelmOCI() (bool, error) {
 	ret := _m.Called()
@@ -101,3 +159,17 @@ func (_m *Client) TestHelmOCI() (bool, error) {
 
 	return r0, r1
 }
+
+// VerifyChartSignature provides a mock function with given fields: chart, digest, verification
+func (_m *Client) VerifyChartSignature(chart string, digest string, verification *helm.SignatureVerification) error {
+	ret := _m.Called(chart, digest, verification)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, *helm.SignatureVerification) error); ok {
+		r0 = rf(chart, digest, verification)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,139 @@
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+const (
+	// AuthProviderAWS exchanges the AWS identity of the repo server (e.g. IRSA) for an Amazon ECR token
+	AuthProviderAWS = "aws"
+	// AuthProviderGCP exchanges the Google service account of the repo server (e.g. Workload Identity) for an
+	// Artifact Registry token
+	AuthProviderGCP = "gcp"
+)
+
+var (
+	// awsBinary is the name of the AWS CLI executable, replaced by unit tests
+	awsBinary = "aws"
+	// gcpTokenURL is the metadata server endpoint returning the access token of the default service account,
+	// replaced by unit tests
+	gcpTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+
+	ecrHostRegexp = regexp.MustCompile(`^\d+\.dkr\.ecr(-fips)?\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+
+	// registryTokenCache holds registry tokens until shortly before they expire
+	registryTokenCache = gocache.New(gocache.NoExpiration, 10*time.Minute)
+)
+
+// tokenExpiryMargin is subtracted from token lifetimes so that tokens are never used right before they expire
+const tokenExpiryMargin = 5 * time.Minute
+
+// IsValidAuthProvider returns whether the given auth provider is supported
+func IsValidAuthProvider(provider string) bool {
+	return provider == "" || provider == AuthProviderAWS || provider == AuthProviderGCP
+}
+
+type registryToken struct {
+	username string
+	password string
+}
+
+// getRegistryCreds returns the credentials used to access an OCI registry. If an auth provider is configured, the
+// username and password are replaced by a short-lived token issued for the identity of the repo server.
+func getRegistryCreds(repoURL string, creds Creds) (Creds, error) {
+	if creds.AuthProvider == "" {
+		return creds, nil
+	}
+	host := registryHost(repoURL)
+	key := creds.AuthProvider + "|" + host
+	if token, ok := registryTokenCache.Get(key); ok {
+		creds.Username = token.(registryToken).username
+		creds.Password = token.(registryToken).password
+		return creds, nil
+	}
+
+	var token registryToken
+	var expiresIn time.Duration
+	var err error
+	switch creds.AuthProvider {
+	case AuthProviderAWS:
+		token, expiresIn, err = getECRToken(host)
+	case AuthProviderGCP:
+		token, expiresIn, err = getGCPToken()
+	default:
+		err = fmt.Errorf("unknown auth provider '%s'", creds.AuthProvider)
+	}
+	if err != nil {
+		return creds, fmt.Errorf("failed to get registry token for %s: %v", host, err)
+	}
+	if expiresIn > tokenExpiryMargin {
+		registryTokenCache.Set(key, token, expiresIn-tokenExpiryMargin)
+	}
+	creds.Username = token.username
+	creds.Password = token.password
+	return creds, nil
+}
+
+func registryHost(repoURL string) string {
+	return strings.SplitN(strings.TrimSuffix(repoURL, "/"), "/", 2)[0]
+}
+
+// getECRToken runs 'aws ecr get-login-password', which picks up IRSA credentials from the environment
+func getECRToken(host string) (registryToken, time.Duration, error) {
+	match := ecrHostRegexp.FindStringSubmatch(host)
+	if match == nil {
+		return registryToken{}, 0, fmt.Errorf("'%s' is not an Amazon ECR registry", host)
+	}
+	cmd := exec.Command(awsBinary, "ecr", "get-login-password", "--region", match[2])
+	// the output is the token itself, which must not be logged
+	out, err := executil.RunWithRedactor(cmd, func(text string) string {
+		if text == fmt.Sprintf("%v", cmd.Args) {
+			return text
+		}
+		return "******"
+	})
+	if err != nil {
+		return registryToken{}, 0, err
+	}
+	// ECR tokens are valid for 12 hours
+	return registryToken{username: "AWS", password: strings.TrimSpace(out)}, 12 * time.Hour, nil
+}
+
+// getGCPToken requests an access token of the default service account from the GCE metadata server
+func getGCPToken() (registryToken, time.Duration, error) {
+	req, err := http.NewRequest(http.MethodGet, gcpTokenURL, nil)
+	if err != nil {
+		return registryToken{}, 0, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return registryToken{}, 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return registryToken{}, 0, fmt.Errorf("metadata server returned %s", resp.Status)
+	}
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return registryToken{}, 0, err
+	}
+	if body.AccessToken == "" {
+		return registryToken{}, 0, fmt.Errorf("metadata server returned an empty access token")
+	}
+	return registryToken{username: "oauth2accesstoken", password: body.AccessToken}, time.Duration(body.ExpiresIn) * time.Second, nil
+}
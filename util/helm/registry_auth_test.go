@@ -0,0 +1,76 @@
+package helm
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegistryCreds(t *testing.T) {
+	t.Run("NoProvider", func(t *testing.T) {
+		creds, err := getRegistryCreds("ghcr.io/org", Creds{Username: "user", Password: "pass"})
+		assert.NoError(t, err)
+		assert.Equal(t, Creds{Username: "user", Password: "pass"}, creds)
+	})
+
+	t.Run("AWS", func(t *testing.T) {
+		registryTokenCache.Flush()
+		dir, err := ioutil.TempDir("", "fake-aws")
+		require.NoError(t, err)
+		defer func() { _ = os.RemoveAll(dir) }()
+		script := "#!/bin/sh\n[ \"$*\" = \"ecr get-login-password --region eu-west-1\" ] && echo ecr-token\n"
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "aws"), []byte(script), 0755))
+		prev := awsBinary
+		awsBinary = filepath.Join(dir, "aws")
+		defer func() { awsBinary = prev }()
+
+		creds, err := getRegistryCreds("123456789012.dkr.ecr.eu-west-1.amazonaws.com/charts", Creds{AuthProvider: AuthProviderAWS, InsecureSkipVerify: true})
+		assert.NoError(t, err)
+		assert.Equal(t, Creds{Username: "AWS", Password: "ecr-token", AuthProvider: AuthProviderAWS, InsecureSkipVerify: true}, creds)
+
+		// token is served from the cache
+		require.NoError(t, os.Remove(filepath.Join(dir, "aws")))
+		creds, err = getRegistryCreds("123456789012.dkr.ecr.eu-west-1.amazonaws.com/other", Creds{AuthProvider: AuthProviderAWS})
+		assert.NoError(t, err)
+		assert.Equal(t, "ecr-token", creds.Password)
+
+		_, err = getRegistryCreds("ghcr.io/org", Creds{AuthProvider: AuthProviderAWS})
+		assert.EqualError(t, err, "failed to get registry token for ghcr.io: 'ghcr.io' is not an Amazon ECR registry")
+	})
+
+	t.Run("GCP", func(t *testing.T) {
+		registryTokenCache.Flush()
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.Header.Get("Metadata-Flavor") != "Google" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "gcp-token", "expires_in": 3599, "token_type": "Bearer"}`))
+		}))
+		defer server.Close()
+		prev := gcpTokenURL
+		gcpTokenURL = server.URL
+		defer func() { gcpTokenURL = prev }()
+
+		for i := 0; i < 2; i++ {
+			creds, err := getRegistryCreds("europe-docker.pkg.dev/project/charts", Creds{AuthProvider: AuthProviderGCP})
+			assert.NoError(t, err)
+			assert.Equal(t, "oauth2accesstoken", creds.Username)
+			assert.Equal(t, "gcp-token", creds.Password)
+		}
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := getRegistryCreds("ghcr.io/org", Creds{AuthProvider: "azure"})
+		assert.EqualError(t, err, "failed to get registry token for ghcr.io: unknown auth provider 'azure'")
+	})
+}
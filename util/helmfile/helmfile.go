@@ -0,0 +1,85 @@
+package helmfile
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+const (
+	// HelmfileName is the name of the file which identifies helmfile applications
+	HelmfileName = "helmfile.yaml"
+)
+
+// helmfileBinary is the name of the helmfile executable, replaced by unit tests
+var helmfileBinary = "helmfile"
+
+// allowedEnvPrefixes are the variables of the repo server environment which are passed to helmfile. Templates come
+// from the repository and can read the whole environment, which must not expose the secrets of the repo server.
+var allowedEnvPrefixes = []string{"PATH=", "HOME=", "HELM_", "HELMFILE_"}
+
+// Helmfile provides wrapper functionality around the `helmfile` command.
+type Helmfile interface {
+	// Template returns a list of unstructured objects from a `helmfile template` command
+	Template(opts *v1alpha1.ApplicationSourceHelmfile, env []string) ([]*unstructured.Unstructured, error)
+}
+
+// NewHelmfileApp create a new wrapper to run commands on the `helmfile` command-line tool. The commands are run by the
+// given runner.
+func NewHelmfileApp(path string, runner *executil.Runner) Helmfile {
+	return &helmfile{path: path, runner: runner}
+}
+
+type helmfile struct {
+	// path inside the checked out tree
+	path string
+	// runner of the commands
+	runner *executil.Runner
+}
+
+var _ Helmfile = &helmfile{}
+
+func (h *helmfile) Template(opts *v1alpha1.ApplicationSourceHelmfile, env []string) ([]*unstructured.Unstructured, error) {
+	if opts == nil {
+		opts = &v1alpha1.ApplicationSourceHelmfile{}
+	}
+	// values are passed as part of the flags, so that they cannot be interpreted as flags themselves
+	args := []string{"--file=" + HelmfileName}
+	if opts.Environment != "" {
+		args = append(args, "--environment="+opts.Environment)
+	}
+	for _, selector := range opts.Selectors {
+		args = append(args, "--selector="+selector)
+	}
+	args = append(args, "template", "--include-crds")
+
+	cmd := exec.Command(helmfileBinary, args...)
+	cmd.Dir = h.path
+	// helmfile templates commonly read the environment, e.g. with requiredEnv
+	cmd.Env = append(allowedEnviron(), env...)
+	out, err := h.runner.Run(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return kube.SplitYAML([]byte(out))
+}
+
+// allowedEnviron returns the variables of the repo server environment which helmfile is allowed to read
+func allowedEnviron() []string {
+	var res []string
+	for _, v := range os.Environ() {
+		for _, prefix := range allowedEnvPrefixes {
+			if strings.HasPrefix(v, prefix) {
+				res = append(res, v)
+				break
+			}
+		}
+	}
+	return res
+}
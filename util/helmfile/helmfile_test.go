@@ -0,0 +1,88 @@
+package helmfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// fakeHelmfile installs a helmfile binary which renders a ConfigMap holding its arguments and the application name
+func fakeHelmfile(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "fake-helmfile")
+	require.NoError(t, err)
+	script := `#!/bin/sh
+[ -f helmfile.yaml ] || exit 1
+cat <<EOF
+# Source: guestbook/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $ARGOCD_APP_NAME
+data:
+  args: "$*"
+  env: "$REDIS_PASSWORD$HELM_CACHE_HOME"
+EOF
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "helmfile"), []byte(script), 0755))
+	prev := helmfileBinary
+	helmfileBinary = filepath.Join(dir, "helmfile")
+	return func() {
+		helmfileBinary = prev
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	defer fakeHelmfile(t)()
+	appPath, err := ioutil.TempDir("", "helmfile")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(appPath) }()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, HelmfileName), []byte("releases: []"), 0644))
+
+	t.Run("Defaults", func(t *testing.T) {
+		objs, err := NewHelmfileApp(appPath, nil).Template(nil, []string{"ARGOCD_APP_NAME=guestbook"})
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "guestbook", objs[0].GetName())
+		args, _, _ := unstructured.NestedString(objs[0].Object, "data", "args")
+		assert.Equal(t, "--file=helmfile.yaml template --include-crds", args)
+	})
+
+	t.Run("EnvironmentAndSelectors", func(t *testing.T) {
+		objs, err := NewHelmfileApp(appPath, nil).Template(&v1alpha1.ApplicationSourceHelmfile{
+			Environment: "production",
+			Selectors:   []string{"tier=frontend", "name=redis"},
+		}, []string{"ARGOCD_APP_NAME=guestbook"})
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		args, _, _ := unstructured.NestedString(objs[0].Object, "data", "args")
+		assert.Equal(t, "--file=helmfile.yaml --environment=production --selector=tier=frontend --selector=name=redis template --include-crds", args)
+	})
+
+	t.Run("Environment", func(t *testing.T) {
+		// the secrets of the repo server are not exposed to the templates
+		require.NoError(t, os.Setenv("REDIS_PASSWORD", "secret"))
+		require.NoError(t, os.Setenv("HELM_CACHE_HOME", "/helm/cache"))
+		defer func() {
+			_ = os.Unsetenv("REDIS_PASSWORD")
+			_ = os.Unsetenv("HELM_CACHE_HOME")
+		}()
+		objs, err := NewHelmfileApp(appPath, nil).Template(nil, []string{"ARGOCD_APP_NAME=guestbook"})
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		env, _, _ := unstructured.NestedString(objs[0].Object, "data", "env")
+		assert.Equal(t, "/helm/cache", env)
+	})
+
+	t.Run("MissingHelmfile", func(t *testing.T) {
+		_, err := NewHelmfileApp(filepath.Dir(appPath), nil).Template(nil, nil)
+		assert.Error(t, err)
+	})
+}
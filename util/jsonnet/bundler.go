@@ -0,0 +1,188 @@
+package jsonnet
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/argoproj/pkg/sync"
+	log "github.com/sirupsen/logrus"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+const (
+	// JsonnetfileName is the name of the jsonnet-bundler project file
+	JsonnetfileName = "jsonnetfile.json"
+	// LockfileName is the name of the jsonnet-bundler lock file, which pins the versions of the dependencies
+	LockfileName = "jsonnetfile.lock.json"
+	// VendorDir is the directory, relative to the project, the dependencies are installed into
+	VendorDir = "vendor"
+)
+
+// jbBinary is the name of the jsonnet-bundler executable, replaced by unit tests
+var jbBinary = "jb"
+
+// VendorCache is an on-disk cache of the dependencies installed by jsonnet-bundler. Installed dependencies are keyed
+// by the hash of the project and lock files, so that they can be shared between applications and revisions.
+type VendorCache struct {
+	dir  string
+	lock sync.KeyLock
+}
+
+// NewVendorCache returns a dependency cache stored in the given directory
+func NewVendorCache(dir string) *VendorCache {
+	return &VendorCache{dir: dir, lock: sync.NewKeyLock()}
+}
+
+// IsBundlerProject returns true if the given directory contains a jsonnet-bundler project file
+func IsBundlerProject(appPath string) bool {
+	_, err := os.Stat(filepath.Join(appPath, JsonnetfileName))
+	return err == nil
+}
+
+// bundlerFile is the part of the jsonnet-bundler project and lock files which describes where dependencies come from
+type bundlerFile struct {
+	Dependencies []struct {
+		Source struct {
+			Git *struct {
+				Remote string `json:"remote"`
+			} `json:"git"`
+			Local *struct {
+				Directory string `json:"directory"`
+			} `json:"local"`
+		} `json:"source"`
+	} `json:"dependencies"`
+}
+
+// validateDependencies returns an error if the given project or lock file has dependencies which would give
+// jsonnet-bundler access to the files of the repo server: local directories outside of the repository, or local git
+// repositories, e.g. file:// URLs.
+func validateDependencies(name string, data []byte, appPath, repoRoot string) error {
+	var file bundlerFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", name, err)
+	}
+	for _, dep := range file.Dependencies {
+		if dep.Source.Git != nil {
+			remote := dep.Source.Git.Remote
+			if isSSH, _ := git.IsSSHURL(remote); !isSSH && !git.IsHTTPSURL(remote) && !git.IsHTTPURL(remote) {
+				return fmt.Errorf("%s: dependency repository URL %s must be an HTTP(S) or SSH URL", name, remote)
+			}
+		}
+		if dep.Source.Local != nil {
+			if err := enforceToRepository(repoRoot, filepath.Join(appPath, dep.Source.Local.Directory)); err != nil {
+				return fmt.Errorf("%s: dependency directory %s points outside of the repository: %v", name, dep.Source.Local.Directory, err)
+			}
+		}
+	}
+	return nil
+}
+
+// enforceToRepository returns an error if the given path, once its symbolic links are resolved, is not inside the
+// repository.
+func enforceToRepository(repoRoot, path string) error {
+	absRepoRoot, err := filepath.Abs(repoRoot)
+	if err != nil {
+		return err
+	}
+	if resolved, err := filepath.EvalSymlinks(absRepoRoot); err == nil {
+		absRepoRoot = resolved
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if resolved, err := filepath.EvalSymlinks(absPath); err == nil {
+		absPath = resolved
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	_, err = security.EnforceToCurrentRoot(absRepoRoot, absPath)
+	return err
+}
+
+// InstallDependencies installs the dependencies of the jsonnet-bundler project of the given directory into its vendor
+// directory, unless the vendor directory already exists. Dependencies pinned by a lock file are taken from the cache
+// if it is not nil. jsonnet-bundler is run by the given runner, once the project and lock files are validated against
+// the given repository root.
+func InstallDependencies(appPath, repoRoot string, cache *VendorCache, runner *executil.Runner) error {
+	vendorPath := filepath.Join(appPath, VendorDir)
+	if _, err := os.Lstat(vendorPath); err == nil {
+		// dependencies are committed to the repository or already installed
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	jsonnetfile, err := ioutil.ReadFile(filepath.Join(appPath, JsonnetfileName))
+	if err != nil {
+		return err
+	}
+	if err := validateDependencies(JsonnetfileName, jsonnetfile, appPath, repoRoot); err != nil {
+		return err
+	}
+	lockfile, err := ioutil.ReadFile(filepath.Join(appPath, LockfileName))
+	if err == nil {
+		// jsonnet-bundler installs the dependencies of the lock file rather than those of the project file
+		if err := validateDependencies(LockfileName, lockfile, appPath, repoRoot); err != nil {
+			return err
+		}
+	}
+	if os.IsNotExist(err) || cache == nil {
+		// dependencies are not pinned, so they cannot be cached
+		return runInstall(appPath, runner)
+	} else if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%x", sha256.Sum256(append(append(jsonnetfile, 0), lockfile...)))
+	return cache.install(key, appPath, runner)
+}
+
+func (c *VendorCache) install(key string, appPath string, runner *executil.Runner) error {
+	c.lock.Lock(key)
+	defer c.lock.Unlock(key)
+
+	vendorPath := filepath.Join(appPath, VendorDir)
+	entry := filepath.Join(c.dir, key)
+	if _, err := os.Stat(entry); err == nil {
+		log.Debugf("Using jsonnet dependencies %s from cache", key)
+		return os.Symlink(entry, vendorPath)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := runInstall(appPath, runner); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempDir(c.dir, "vendor-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	// the content of the vendor directory is copied, so that the entry is only visible once complete
+	if _, err := executil.Run(exec.Command("cp", "-r", vendorPath+"/.", tmp)); err != nil {
+		return err
+	}
+	return os.Rename(tmp, entry)
+}
+
+func runInstall(appPath string, runner *executil.Runner) error {
+	cmd := exec.Command(jbBinary, "install")
+	cmd.Dir = appPath
+	if _, err := runner.Run(cmd); err != nil {
+		// a partially installed vendor directory would be mistaken for installed dependencies
+		_ = os.RemoveAll(filepath.Join(appPath, VendorDir))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package jsonnet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJb installs a jb binary which vendors a single library and records its invocations in the returned file
+func fakeJb(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "fake-jb")
+	require.NoError(t, err)
+	invocations := filepath.Join(dir, "invocations")
+	script := `#!/bin/sh
+[ "$*" = "install" ] || exit 1
+echo "$PWD" >> ` + invocations + `
+mkdir -p vendor/github.com/org/lib
+echo '{ name: "lib" }' > vendor/github.com/org/lib/lib.libsonnet
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "jb"), []byte(script), 0755))
+	prev := jbBinary
+	jbBinary = filepath.Join(dir, "jb")
+	return invocations, func() {
+		jbBinary = prev
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func newProject(t *testing.T, lockfile string) string {
+	appPath, err := ioutil.TempDir("", "jsonnet-project")
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, JsonnetfileName), []byte(`{"version": 1}`), 0644))
+	if lockfile != "" {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, LockfileName), []byte(lockfile), 0644))
+	}
+	return appPath
+}
+
+func countInvocations(t *testing.T, invocations string) int {
+	data, err := ioutil.ReadFile(invocations)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	require.NoError(t, err)
+	return len(strings.Split(strings.TrimSpace(string(data)), "\n"))
+}
+
+func TestIsBundlerProject(t *testing.T) {
+	appPath := newProject(t, "")
+	defer func() { _ = os.RemoveAll(appPath) }()
+	assert.True(t, IsBundlerProject(appPath))
+	assert.False(t, IsBundlerProject(filepath.Dir(appPath)))
+}
+
+func TestInstallDependencies(t *testing.T) {
+	invocations, cleanup := fakeJb(t)
+	defer cleanup()
+	cacheDir, err := ioutil.TempDir("", "jsonnet-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+	cache := NewVendorCache(cacheDir)
+
+	t.Run("Unpinned", func(t *testing.T) {
+		appPath := newProject(t, "")
+		defer func() { _ = os.RemoveAll(appPath) }()
+		require.NoError(t, InstallDependencies(appPath, appPath, cache, nil))
+		assert.FileExists(t, filepath.Join(appPath, "vendor/github.com/org/lib/lib.libsonnet"))
+		assert.Equal(t, 1, countInvocations(t, invocations))
+		// nothing is cached without lock file
+		entries, err := ioutil.ReadDir(cacheDir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("Cached", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			appPath := newProject(t, `{"version": 1, "dependencies": []}`)
+			require.NoError(t, InstallDependencies(appPath, appPath, cache, nil))
+			assert.FileExists(t, filepath.Join(appPath, "vendor/github.com/org/lib/lib.libsonnet"))
+			_ = os.RemoveAll(appPath)
+		}
+		// the second project uses the dependencies installed for the first one
+		assert.Equal(t, 2, countInvocations(t, invocations))
+	})
+
+	t.Run("AlreadyVendored", func(t *testing.T) {
+		appPath := newProject(t, "")
+		defer func() { _ = os.RemoveAll(appPath) }()
+		require.NoError(t, os.Mkdir(filepath.Join(appPath, VendorDir), 0755))
+		require.NoError(t, InstallDependencies(appPath, appPath, cache, nil))
+		assert.Equal(t, 2, countInvocations(t, invocations))
+	})
+}
+
+func TestInstallDependencies_InvalidDependencies(t *testing.T) {
+	invocations, cleanup := fakeJb(t)
+	defer cleanup()
+	repoRoot, err := ioutil.TempDir("", "jsonnet-repo")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(repoRoot) }()
+	appPath := filepath.Join(repoRoot, "app")
+	require.NoError(t, os.MkdirAll(filepath.Join(appPath, "lib"), 0755))
+	require.NoError(t, os.Symlink("/etc", filepath.Join(appPath, "etc")))
+
+	install := func(file, content string) error {
+		_ = os.Remove(filepath.Join(appPath, JsonnetfileName))
+		_ = os.Remove(filepath.Join(appPath, LockfileName))
+		if file != JsonnetfileName {
+			require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, JsonnetfileName), []byte(`{"version": 1}`), 0644))
+		}
+		require.NoError(t, ioutil.WriteFile(filepath.Join(appPath, file), []byte(content), 0644))
+		defer func() { _ = os.RemoveAll(filepath.Join(appPath, VendorDir)) }()
+		return InstallDependencies(appPath, repoRoot, nil, nil)
+	}
+	local := func(dir string) string {
+		return `{"version": 1, "dependencies": [{"source": {"local": {"directory": "` + dir + `"}}}]}`
+	}
+	remote := func(url string) string {
+		return `{"version": 1, "dependencies": [{"source": {"git": {"remote": "` + url + `"}}, "version": "main"}]}`
+	}
+
+	for _, file := range []string{JsonnetfileName, LockfileName} {
+		t.Run(file, func(t *testing.T) {
+			assert.NoError(t, install(file, local("lib")))
+			assert.NoError(t, install(file, remote("https://github.com/org/lib.git")))
+			assert.NoError(t, install(file, remote("git@github.com:org/lib.git")))
+
+			err := install(file, local("../.."))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "points outside of the repository")
+			err = install(file, local("etc"))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "points outside of the repository")
+			err = install(file, remote("file:///etc"))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "must be an HTTP(S) or SSH URL")
+			err = install(file, remote("/etc"))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "must be an HTTP(S) or SSH URL")
+		})
+	}
+	// jb is not run for invalid dependencies
+	assert.Equal(t, 6, countInvocations(t, invocations))
+}
@@ -0,0 +1,52 @@
+package jsonnet
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/google/go-jsonnet"
+
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+// restrictedImporter imports files from the library paths like jsonnet.FileImporter, but only if they are located
+// within one of the allowed directories once symbolic links are resolved
+type restrictedImporter struct {
+	importer    *jsonnet.FileImporter
+	allowedDirs []string
+}
+
+// NewRestrictedImporter returns an importer which searches the given library paths and refuses to import files
+// located outside of the allowed directories
+func NewRestrictedImporter(jpaths []string, allowedDirs []string) jsonnet.Importer {
+	return &restrictedImporter{importer: &jsonnet.FileImporter{JPaths: jpaths}, allowedDirs: allowedDirs}
+}
+
+func (i *restrictedImporter) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	contents, foundAt, err := i.importer.Import(importedFrom, importedPath)
+	if err != nil {
+		return contents, foundAt, err
+	}
+	path, err := resolvePath(foundAt)
+	if err != nil {
+		return jsonnet.Contents{}, "", err
+	}
+	for _, dir := range i.allowedDirs {
+		dir, err := resolvePath(dir)
+		if err != nil {
+			continue
+		}
+		if _, err := security.EnforceToCurrentRoot(dir, path); err == nil {
+			return contents, foundAt, nil
+		}
+	}
+	return jsonnet.Contents{}, "", fmt.Errorf("import of '%s' is not allowed: the file is outside of the repository and of the allowed import paths", importedPath)
+}
+
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(path)
+}
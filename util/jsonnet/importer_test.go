@@ -0,0 +1,48 @@
+package jsonnet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-jsonnet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestrictedImporter(t *testing.T) {
+	root, err := ioutil.TempDir("", "jsonnet-import")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(root) }()
+	repoRoot := filepath.Join(root, "repo")
+	sharedLibs := filepath.Join(root, "shared")
+	secrets := filepath.Join(root, "secrets")
+	for _, dir := range []string{filepath.Join(repoRoot, "app"), sharedLibs, secrets} {
+		require.NoError(t, os.MkdirAll(dir, 0755))
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repoRoot, "common.libsonnet"), []byte(`{ name: "common" }`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(sharedLibs, "shared.libsonnet"), []byte(`{ name: "shared" }`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(secrets, "secret.txt"), []byte("secret"), 0644))
+	require.NoError(t, os.Symlink(filepath.Join(secrets, "secret.txt"), filepath.Join(repoRoot, "app", "link.txt")))
+
+	appPath := filepath.Join(repoRoot, "app")
+	vm := jsonnet.MakeVM()
+	vm.Importer(NewRestrictedImporter([]string{appPath, sharedLibs}, []string{repoRoot, sharedLibs}))
+	evaluate := func(snippet string) (string, error) {
+		return vm.EvaluateAnonymousSnippet(filepath.Join(appPath, "main.jsonnet"), snippet)
+	}
+
+	out, err := evaluate(`[(import '../common.libsonnet').name, (import 'shared.libsonnet').name]`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["common", "shared"]`, out)
+
+	_, err = evaluate(`importstr '../../secrets/secret.txt'`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "import of '../../secrets/secret.txt' is not allowed")
+
+	// symbolic links are resolved before the location is checked
+	_, err = evaluate(`importstr 'link.txt'`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "import of 'link.txt' is not allowed")
+}
@@ -0,0 +1,166 @@
+package jsonnet
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/sync"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/git"
+)
+
+const (
+	// RemoteLibPrefix is the prefix of the library paths which reference a directory of another git repository, e.g.
+	// git::https://github.com/argoproj/libs.git//jsonnet?ref=v1.0.0
+	RemoteLibPrefix = "git::"
+	// remoteLibMaxAge is the time after which the unused revisions of a library are removed from the cache
+	remoteLibMaxAge = 24 * time.Hour
+)
+
+// RemoteLib is a library directory of another git repository
+type RemoteLib struct {
+	RepoURL string
+	// Path is the path of the library directory, relative to the root of the repository
+	Path string
+	// Revision is the branch, tag or commit SHA of the library, HEAD if not set
+	Revision string
+}
+
+// IsRemoteLib returns true if the library path references a directory of another git repository
+func IsRemoteLib(lib string) bool {
+	return strings.HasPrefix(lib, RemoteLibPrefix)
+}
+
+// ParseRemoteLib parses a library path in the format git::<repoURL>//<path>?ref=<revision>. The path and the revision
+// are optional.
+func ParseRemoteLib(lib string) (*RemoteLib, error) {
+	if !IsRemoteLib(lib) {
+		return nil, fmt.Errorf("%s: library does not reference another repository", lib)
+	}
+	ref := strings.TrimPrefix(lib, RemoteLibPrefix)
+	remote := &RemoteLib{Revision: "HEAD"}
+	if i := strings.LastIndex(ref, "?"); i >= 0 {
+		query, err := url.ParseQuery(ref[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid library query: %v", lib, err)
+		}
+		if revision := query.Get("ref"); revision != "" {
+			remote.Revision = revision
+		}
+		ref = ref[:i]
+	}
+	// the path is separated by a double slash, which must not be mistaken for the one of the scheme
+	start := 0
+	if i := strings.Index(ref, "://"); i >= 0 {
+		start = i + len("://")
+	}
+	if i := strings.Index(ref[start:], "//"); i >= 0 {
+		remote.RepoURL = ref[:start+i]
+		remote.Path = ref[start+i+len("//"):]
+	} else {
+		remote.RepoURL = ref
+	}
+	if remote.RepoURL == "" {
+		return nil, fmt.Errorf("%s: library repository URL is empty", lib)
+	}
+	// local repositories, e.g. file:// URLs, would give access to the files of the repo server
+	if isSSH, _ := git.IsSSHURL(remote.RepoURL); !isSSH && !git.IsHTTPSURL(remote.RepoURL) && !git.IsHTTPURL(remote.RepoURL) {
+		return nil, fmt.Errorf("%s: library repository URL must be an HTTP(S) or SSH URL", lib)
+	}
+	if filepath.IsAbs(remote.Path) {
+		return nil, fmt.Errorf("%s: library path is absolute", lib)
+	}
+	return remote, nil
+}
+
+// String returns the library path referencing the library
+func (l *RemoteLib) String() string {
+	lib := RemoteLibPrefix + l.RepoURL
+	if l.Path != "" {
+		lib += "//" + l.Path
+	}
+	return lib + "?ref=" + url.QueryEscape(l.Revision)
+}
+
+// LibCache is an on-disk cache of the library directories of other git repositories. The libraries are keyed by their
+// repository, path and commit SHA, so that the files of a revision are only copied once and can be used without
+// locking the clone of the repository.
+type LibCache struct {
+	dir  string
+	lock sync.KeyLock
+	now  func() time.Time
+}
+
+// NewLibCache returns a library cache stored in the given directory
+func NewLibCache(dir string) *LibCache {
+	return &LibCache{dir: dir, lock: sync.NewKeyLock(), now: time.Now}
+}
+
+// Path returns the directory of the library, whose revision must be a commit SHA
+func (c *LibCache) Path(lib *RemoteLib) string {
+	key := fmt.Sprintf("%x", sha256.Sum256([]byte(git.NormalizeGitURL(lib.RepoURL)+"\n"+filepath.Clean(lib.Path))))
+	return filepath.Join(c.dir, key, lib.Revision)
+}
+
+// Install copies the library into the cache unless it is already cached, and returns its directory. The copy function
+// copies the files of the library into the given directory. The other revisions of the library which were not used
+// recently are removed.
+func (c *LibCache) Install(lib *RemoteLib, copy func(dest string) error) (string, error) {
+	entry := c.Path(lib)
+	c.lock.Lock(entry)
+	defer c.lock.Unlock(entry)
+
+	now := c.now()
+	if _, err := os.Stat(entry); err == nil {
+		log.Debugf("Using jsonnet library %s from cache", lib)
+		// the modification time records the last use of the revision
+		return entry, os.Chtimes(entry, now, now)
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(entry), 0755); err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(filepath.Dir(entry), "lib-")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	// the files are copied into a temporary directory, so that the entry is only visible once complete
+	if err := copy(tmp); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, entry); err != nil {
+		return "", err
+	}
+	if err := os.Chtimes(entry, now, now); err != nil {
+		return "", err
+	}
+	c.prune(filepath.Dir(entry), now)
+	return entry, nil
+}
+
+// prune removes the revisions of a library which were not used recently, e.g. the previous commits of a branch
+func (c *LibCache) prune(dir string, now time.Time) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		log.Warnf("Failed to list the revisions of jsonnet library %s: %v", dir, err)
+		return
+	}
+	for _, entry := range entries {
+		if now.Sub(entry.ModTime()) <= remoteLibMaxAge {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			log.Warnf("Failed to remove revision %s of jsonnet library %s: %v", entry.Name(), dir, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package jsonnet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRemoteLib(t *testing.T) {
+	for _, tc := range []struct {
+		lib      string
+		expected RemoteLib
+	}{
+		{"git::https://github.com/argoproj/libs.git//jsonnet/lib?ref=v1.0.0", RemoteLib{RepoURL: "https://github.com/argoproj/libs.git", Path: "jsonnet/lib", Revision: "v1.0.0"}},
+		{"git::https://github.com/argoproj/libs.git", RemoteLib{RepoURL: "https://github.com/argoproj/libs.git", Revision: "HEAD"}},
+		{"git::git@github.com:argoproj/libs.git//jsonnet", RemoteLib{RepoURL: "git@github.com:argoproj/libs.git", Path: "jsonnet", Revision: "HEAD"}},
+		{"git::ssh://git@github.com/argoproj/libs.git?ref=release-1.0", RemoteLib{RepoURL: "ssh://git@github.com/argoproj/libs.git", Revision: "release-1.0"}},
+	} {
+		t.Run(tc.lib, func(t *testing.T) {
+			lib, err := ParseRemoteLib(tc.lib)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, *lib)
+		})
+	}
+
+	for _, lib := range []string{"vendor", "git::", "git::?ref=v1.0.0", "git::https://github.com/argoproj/libs.git///jsonnet", "git::https://github.com/argoproj/libs.git?%zz", "git::file:///srv/libs//jsonnet", "git::/srv/libs//jsonnet"} {
+		t.Run(lib, func(t *testing.T) {
+			_, err := ParseRemoteLib(lib)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRemoteLib_String(t *testing.T) {
+	lib := &RemoteLib{RepoURL: "https://github.com/argoproj/libs.git", Path: "jsonnet", Revision: "v1.0.0"}
+	assert.Equal(t, "git::https://github.com/argoproj/libs.git//jsonnet?ref=v1.0.0", lib.String())
+	parsed, err := ParseRemoteLib(lib.String())
+	require.NoError(t, err)
+	assert.Equal(t, lib, parsed)
+}
+
+func TestLibCache_Install(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lib-cache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	cache := NewLibCache(dir)
+
+	copies := 0
+	copyLib := func(dest string) error {
+		copies++
+		return ioutil.WriteFile(filepath.Join(dest, "lib.libsonnet"), []byte(`{ name: "lib" }`), 0644)
+	}
+	lib := &RemoteLib{RepoURL: "https://github.com/argoproj/libs.git", Path: "jsonnet", Revision: "1111111111111111111111111111111111111111"}
+
+	now := time.Now()
+	cache.now = func() time.Time { return now.Add(-2 * remoteLibMaxAge) }
+	path, err := cache.Install(lib, copyLib)
+	require.NoError(t, err)
+	assert.Equal(t, cache.Path(lib), path)
+	assert.FileExists(t, filepath.Join(path, "lib.libsonnet"))
+
+	// the library is only copied once
+	_, err = cache.Install(lib, copyLib)
+	require.NoError(t, err)
+	assert.Equal(t, 1, copies)
+
+	// the revisions which were not used recently are removed once another revision is installed
+	cache.now = func() time.Time { return now }
+	newLib := *lib
+	newLib.Revision = "2222222222222222222222222222222222222222"
+	newPath, err := cache.Install(&newLib, copyLib)
+	require.NoError(t, err)
+	assert.DirExists(t, newPath)
+	assert.NoDirExists(t, path)
+}
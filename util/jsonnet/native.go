@@ -0,0 +1,110 @@
+package jsonnet
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/go-jsonnet"
+	"github.com/google/go-jsonnet/ast"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// nativeFunctions are the native functions which can be enabled by administrators, called with std.native('name')
+var nativeFunctions = map[string]*jsonnet.NativeFunction{}
+
+func init() {
+	registerStringFunction("parseJson", ast.Identifiers{"json"}, func(args []string) (interface{}, error) {
+		var res interface{}
+		err := json.Unmarshal([]byte(args[0]), &res)
+		return res, err
+	})
+	// parseYaml returns the array of documents of the YAML stream
+	registerStringFunction("parseYaml", ast.Identifiers{"yaml"}, func(args []string) (interface{}, error) {
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(args[0])))
+		res := make([]interface{}, 0)
+		for {
+			doc, err := reader.Read()
+			if err == io.EOF {
+				return res, nil
+			} else if err != nil {
+				return nil, err
+			}
+			var obj interface{}
+			if err := yaml.Unmarshal(doc, &obj); err != nil {
+				return nil, err
+			}
+			if obj != nil {
+				res = append(res, obj)
+			}
+		}
+	})
+	registerStringFunction("manifestYamlFromJson", ast.Identifiers{"json"}, func(args []string) (interface{}, error) {
+		data, err := yaml.JSONToYAML([]byte(args[0]))
+		return string(data), err
+	})
+	registerStringFunction("sha256", ast.Identifiers{"str"}, func(args []string) (interface{}, error) {
+		return fmt.Sprintf("%x", sha256.Sum256([]byte(args[0]))), nil
+	})
+	registerStringFunction("regexMatch", ast.Identifiers{"regex", "string"}, func(args []string) (interface{}, error) {
+		return regexp.MatchString(args[0], args[1])
+	})
+	registerStringFunction("regexSubst", ast.Identifiers{"regex", "src", "repl"}, func(args []string) (interface{}, error) {
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return re.ReplaceAllString(args[1], args[2]), nil
+	})
+}
+
+// registerStringFunction adds a native function whose parameters are all strings
+func registerStringFunction(name string, params ast.Identifiers, fn func(args []string) (interface{}, error)) {
+	nativeFunctions[name] = &jsonnet.NativeFunction{
+		Name:   name,
+		Params: params,
+		Func: func(args []interface{}) (interface{}, error) {
+			strArgs := make([]string, len(args))
+			for i, arg := range args {
+				str, ok := arg.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: parameter '%s' must be a string", name, params[i])
+				}
+				strArgs[i] = str
+			}
+			return fn(strArgs)
+		},
+	}
+}
+
+// ValidateNativeFunctions returns an error if one of the given native functions does not exist
+func ValidateNativeFunctions(names []string) error {
+	for _, name := range names {
+		if _, ok := nativeFunctions[name]; !ok {
+			var supported []string
+			for n := range nativeFunctions {
+				supported = append(supported, n)
+			}
+			sort.Strings(supported)
+			return fmt.Errorf("unknown jsonnet native function '%s', must be one of %s", name, strings.Join(supported, ", "))
+		}
+	}
+	return nil
+}
+
+// RegisterNativeFunctions makes the given native functions available to the Jsonnet files evaluated by the VM
+func RegisterNativeFunctions(vm *jsonnet.VM, names []string) error {
+	if err := ValidateNativeFunctions(names); err != nil {
+		return err
+	}
+	for _, name := range names {
+		vm.NativeFunction(nativeFunctions[name])
+	}
+	return nil
+}
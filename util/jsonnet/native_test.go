@@ -0,0 +1,39 @@
+package jsonnet
+
+import (
+	"testing"
+
+	"github.com/google/go-jsonnet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterNativeFunctions(t *testing.T) {
+	vm := jsonnet.MakeVM()
+	require.NoError(t, RegisterNativeFunctions(vm, []string{"parseYaml", "sha256", "regexSubst"}))
+	out, err := vm.EvaluateAnonymousSnippet("test.jsonnet", `{
+  docs: std.native('parseYaml')('a: 1\n---\nb: [x]\n'),
+  hash: std.native('sha256')('argo'),
+  subst: std.native('regexSubst')('-+', 'a--b', '.'),
+}`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "docs": [{"a": 1}, {"b": ["x"]}],
+  "hash": "774113f725e8622bcdb91dde0a36221bedf7cb2623a39f1218f17cf6ed246d19",
+  "subst": "a.b"
+}`, out)
+
+	_, err = vm.EvaluateAnonymousSnippet("test.jsonnet", `std.native('sha256')(1)`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "sha256: parameter 'str' must be a string")
+
+	// functions which are not enabled cannot be called
+	_, err = vm.EvaluateAnonymousSnippet("test.jsonnet", `std.native('parseJson')('{}')`)
+	assert.Error(t, err)
+}
+
+func TestValidateNativeFunctions(t *testing.T) {
+	assert.NoError(t, ValidateNativeFunctions([]string{"parseJson", "manifestYamlFromJson", "regexMatch"}))
+	assert.EqualError(t, ValidateNativeFunctions([]string{"exec"}),
+		"unknown jsonnet native function 'exec', must be one of manifestYamlFromJson, parseJson, parseYaml, regexMatch, regexSubst, sha256")
+}
@@ -77,13 +77,16 @@ func Version() (string, error) {
 
 type ksonnetApp struct {
 	rootDir string
+	// runner of the commands
+	runner *executil.Runner
 }
 
 var _ KsonnetApp = &ksonnetApp{}
 
-// NewKsonnetApp tries to create a new wrapper to run commands on the `ks` command-line tool.
-func NewKsonnetApp(path string) (KsonnetApp, error) {
-	ksApp := ksonnetApp{rootDir: path}
+// NewKsonnetApp tries to create a new wrapper to run commands on the `ks` command-line tool. The commands are run by the
+// given runner.
+func NewKsonnetApp(path string, runner *executil.Runner) (KsonnetApp, error) {
+	ksApp := ksonnetApp{rootDir: path, runner: runner}
 	// ensure that the file exists
 	if _, err := ksApp.appYamlPath(); err != nil {
 		return nil, err
@@ -104,7 +107,7 @@ func (k *ksonnetApp) ksCmd(args ...string) (string, error) {
 	cmd := exec.Command("ks", args...)
 	cmd.Dir = k.Root()
 
-	return executil.Run(cmd)
+	return k.runner.Run(cmd)
 }
 
 func (k *ksonnetApp) Root() string {
@@ -27,7 +27,7 @@ func init() {
 }
 
 func TestKsonnet(t *testing.T) {
-	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
+	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName), nil)
 	assert.Nil(t, err)
 	defaultDest, err := ksApp.Destination(testEnvName)
 	assert.True(t, err == nil)
@@ -35,7 +35,7 @@ func TestKsonnet(t *testing.T) {
 }
 
 func TestShow(t *testing.T) {
-	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName))
+	ksApp, err := NewKsonnetApp(filepath.Join(testDataDir, testAppName), nil)
 	assert.Nil(t, err)
 	objs, err := ksApp.Show(testEnvName)
 	assert.Nil(t, err)
@@ -34,12 +34,14 @@ type Kustomize interface {
 }
 
 // NewKustomizeApp create a new wrapper to run commands on the `kustomize` command-line tool.
-func NewKustomizeApp(path string, creds git.Creds, fromRepo string, binaryPath string) Kustomize {
+// The commands are run by the given runner.
+func NewKustomizeApp(path string, creds git.Creds, fromRepo string, binaryPath string, runner *executil.Runner) Kustomize {
 	return &kustomize{
 		path:       path,
 		creds:      creds,
 		repo:       fromRepo,
 		binaryPath: binaryPath,
+		runner:     runner,
 	}
 }
 
@@ -52,6 +54,8 @@ type kustomize struct {
 	repo string
 	// optional kustomize binary path
 	binaryPath string
+	// runner of the commands
+	runner *executil.Runner
 }
 
 var _ Kustomize = &kustomize{}
@@ -90,7 +94,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 		if opts.NamePrefix != "" {
 			cmd := exec.Command(k.getBinaryPath(), "edit", "set", "nameprefix", "--", opts.NamePrefix)
 			cmd.Dir = k.path
-			_, err := executil.Run(cmd)
+			_, err := k.runner.Run(cmd)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -98,7 +102,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 		if opts.NameSuffix != "" {
 			cmd := exec.Command(k.getBinaryPath(), "edit", "set", "namesuffix", "--", opts.NameSuffix)
 			cmd.Dir = k.path
-			_, err := executil.Run(cmd)
+			_, err := k.runner.Run(cmd)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -112,7 +116,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 			}
 			cmd := exec.Command(k.getBinaryPath(), args...)
 			cmd.Dir = k.path
-			_, err := executil.Run(cmd)
+			_, err := k.runner.Run(cmd)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -126,7 +130,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 			}
 			cmd := exec.Command(k.getBinaryPath(), append(args, mapToEditAddArgs(opts.CommonLabels)...)...)
 			cmd.Dir = k.path
-			_, err := executil.Run(cmd)
+			_, err := k.runner.Run(cmd)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -140,20 +144,21 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 			}
 			cmd := exec.Command(k.getBinaryPath(), append(args, mapToEditAddArgs(opts.CommonAnnotations)...)...)
 			cmd.Dir = k.path
-			_, err := executil.Run(cmd)
+			_, err := k.runner.Run(cmd)
 			if err != nil {
 				return nil, nil, err
 			}
 		}
 	}
 
-	var cmd *exec.Cmd
-	if kustomizeOptions != nil && kustomizeOptions.BuildOptions != "" {
-		params := parseKustomizeBuildOptions(k.path, kustomizeOptions.BuildOptions)
-		cmd = exec.Command(k.getBinaryPath(), params...)
-	} else {
-		cmd = exec.Command(k.getBinaryPath(), "build", k.path)
+	params := []string{"build", k.path}
+	if kustomizeOptions != nil {
+		if kustomizeOptions.BuildOptions != "" {
+			params = parseKustomizeBuildOptions(k.path, kustomizeOptions.BuildOptions)
+		}
+		params = append(params, BuildOptionsArgs(kustomizeOptions.ProjectBuildOptions)...)
 	}
+	cmd := exec.Command(k.getBinaryPath(), params...)
 
 	cmd.Env = os.Environ()
 	closer, environ, err := k.creds.Environ()
@@ -184,7 +189,8 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	}
 
 	cmd.Env = append(cmd.Env, environ...)
-	out, err := executil.Run(cmd)
+	// the credentials of remote bases are written to temporary files, which a sandboxed command needs to access
+	out, err := k.runner.WithPaths(git.CredsFiles(closer)...).Run(cmd)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -201,6 +207,40 @@ func parseKustomizeBuildOptions(path, buildOptions string) []string {
 	return append([]string{"build", path}, strings.Split(buildOptions, " ")...)
 }
 
+// BuildOptionsArgs returns the flags of `kustomize build` enabled by the build options of a project
+func BuildOptionsArgs(opts *v1alpha1.KustomizeBuildOptions) []string {
+	if opts == nil {
+		return nil
+	}
+	var args []string
+	if opts.LoadRestrictor != "" {
+		args = append(args, "--load-restrictor="+opts.LoadRestrictor)
+	}
+	if opts.EnableAlphaPlugins {
+		args = append(args, "--enable-alpha-plugins")
+	}
+	return append(args, opts.ExtraArgs...)
+}
+
+// ValidateBuildOptions returns an error if the build options of a project enable a flag of `kustomize build` which is
+// not one of the allowed flags, or pass an argument which is not in the format --flag or --flag=value
+func ValidateBuildOptions(opts *v1alpha1.KustomizeBuildOptions, allowedFlags []string) error {
+	allowed := map[string]bool{}
+	for _, flag := range allowedFlags {
+		allowed[strings.TrimLeft(flag, "-")] = true
+	}
+	for _, arg := range BuildOptionsArgs(opts) {
+		if !strings.HasPrefix(arg, "--") || len(arg) == len("--") {
+			return fmt.Errorf("kustomize build option %s must be in the format --flag or --flag=value", arg)
+		}
+		flag := strings.SplitN(strings.TrimPrefix(arg, "--"), "=", 2)[0]
+		if !allowed[flag] {
+			return fmt.Errorf("kustomize build option --%s is not allowed", flag)
+		}
+	}
+	return nil
+}
+
 var KustomizationNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
 
 // kustomization is a file that describes a configuration consumable by kustomize.
@@ -36,7 +36,7 @@ func TestKustomizeBuild(t *testing.T) {
 	assert.Nil(t, err)
 	namePrefix := "namePrefix-"
 	nameSuffix := "-nameSuffix"
-	kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "")
+	kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "", nil)
 	kustomizeSource := v1alpha1.ApplicationSourceKustomize{
 		NamePrefix: namePrefix,
 		NameSuffix: nameSuffix,
@@ -115,6 +115,31 @@ func TestParseKustomizeBuildOptions(t *testing.T) {
 	assert.Equal(t, []string{"build", "guestbook", "-v", "6", "--logtostderr"}, built)
 }
 
+func TestBuildOptionsArgs(t *testing.T) {
+	assert.Empty(t, BuildOptionsArgs(nil))
+	args := BuildOptionsArgs(&v1alpha1.KustomizeBuildOptions{
+		LoadRestrictor:     "LoadRestrictionsNone",
+		EnableAlphaPlugins: true,
+		ExtraArgs:          []string{"--enable-helm"},
+	})
+	assert.Equal(t, []string{"--load-restrictor=LoadRestrictionsNone", "--enable-alpha-plugins", "--enable-helm"}, args)
+}
+
+func TestValidateBuildOptions(t *testing.T) {
+	allowed := []string{"--load-restrictor", "enable-helm"}
+	assert.NoError(t, ValidateBuildOptions(nil, nil))
+	assert.NoError(t, ValidateBuildOptions(&v1alpha1.KustomizeBuildOptions{
+		LoadRestrictor: "LoadRestrictionsNone",
+		ExtraArgs:      []string{"--enable-helm"},
+	}, allowed))
+	assert.EqualError(t, ValidateBuildOptions(&v1alpha1.KustomizeBuildOptions{EnableAlphaPlugins: true}, allowed),
+		"kustomize build option --enable-alpha-plugins is not allowed")
+	assert.EqualError(t, ValidateBuildOptions(&v1alpha1.KustomizeBuildOptions{ExtraArgs: []string{"--enable-helm", "/etc"}}, allowed),
+		"kustomize build option /etc must be in the format --flag or --flag=value")
+	assert.EqualError(t, ValidateBuildOptions(&v1alpha1.KustomizeBuildOptions{ExtraArgs: []string{"--output=/tmp/out"}}, allowed),
+		"kustomize build option --output is not allowed")
+}
+
 func TestVersion(t *testing.T) {
 	ver, err := Version(false)
 	assert.NoError(t, err)
@@ -162,7 +187,7 @@ func TestKustomizeBuildForceCommonLabels(t *testing.T) {
 	for _, tc := range testCases {
 		appPath, err := testDataDir(tc.TestData)
 		assert.Nil(t, err)
-		kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "")
+		kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "", nil)
 		objs, _, err := kustomize.Build(&tc.KustomizeSource, nil)
 		switch tc.ExpectErr {
 		case true:
@@ -211,7 +236,7 @@ func TestKustomizeBuildForceCommonAnnotations(t *testing.T) {
 	for _, tc := range testCases {
 		appPath, err := testDataDir(tc.TestData)
 		assert.Nil(t, err)
-		kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "")
+		kustomize := NewKustomizeApp(appPath, git.NopCreds{}, "", "", nil)
 		objs, _, err := kustomize.Build(&tc.KustomizeSource, nil)
 		switch tc.ExpectErr {
 		case true:
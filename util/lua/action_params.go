@@ -0,0 +1,101 @@
+package lua
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// actionParamsGlobal is the name of the global table holding the values of the parameters of the resource actions
+	actionParamsGlobal = "actionParams"
+
+	ResourceActionParamTypeString  = "string"
+	ResourceActionParamTypeInteger = "integer"
+	ResourceActionParamTypeNumber  = "number"
+	ResourceActionParamTypeBoolean = "boolean"
+)
+
+// FindResourceActionParams returns the parameters the given action declares in the result of the discovery script
+func FindResourceActionParams(actions []appv1.ResourceAction, actionName string) []appv1.ResourceActionParam {
+	for _, action := range actions {
+		if action.Name == actionName {
+			return action.Params
+		}
+	}
+	return nil
+}
+
+// SplitResourceActionParams splits the values of parameters formatted as name=value
+func SplitResourceActionParams(params []string) (map[string]string, error) {
+	values := make(map[string]string)
+	for _, param := range params {
+		parts := strings.SplitN(param, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid parameter '%s', expected name=value", param)
+		}
+		if _, ok := values[parts[0]]; ok {
+			return nil, fmt.Errorf("parameter '%s' is set more than once", parts[0])
+		}
+		values[parts[0]] = parts[1]
+	}
+	return values, nil
+}
+
+// ParseResourceActionParams validates the values supplied for the parameters declared by a resource action and
+// converts them to the declared types: string (the default), integer, number or boolean. The parameters which are not
+// supplied get their default value, the parameters without a default value are required.
+func ParseResourceActionParams(declared []appv1.ResourceActionParam, values map[string]string) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	declaredNames := make(map[string]bool)
+	for _, param := range declared {
+		declaredNames[param.Name] = true
+		value, ok := values[param.Name]
+		if !ok {
+			if param.Default == nil {
+				return nil, fmt.Errorf("parameter '%s' is required", param.Name)
+			}
+			value = *param.Default
+		}
+		converted, err := convertResourceActionParam(param, value)
+		if err != nil {
+			return nil, err
+		}
+		params[param.Name] = converted
+	}
+	for name := range values {
+		if !declaredNames[name] {
+			return nil, fmt.Errorf("unknown parameter '%s'", name)
+		}
+	}
+	return params, nil
+}
+
+func convertResourceActionParam(param appv1.ResourceActionParam, value string) (interface{}, error) {
+	switch param.Type {
+	case "", ResourceActionParamTypeString:
+		return value, nil
+	case ResourceActionParamTypeInteger:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be an integer: %s", param.Name, value)
+		}
+		return i, nil
+	case ResourceActionParamTypeNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be a number: %s", param.Name, value)
+		}
+		return f, nil
+	case ResourceActionParamTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%s' must be a boolean: %s", param.Name, value)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("parameter '%s' has unknown type '%s'", param.Name, param.Type)
+	}
+}
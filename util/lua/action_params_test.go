@@ -0,0 +1,94 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/grpc"
+)
+
+func TestSplitResourceActionParams(t *testing.T) {
+	values, err := SplitResourceActionParams([]string{"replicas=3", "image=nginx:1.21", "message=a=b", "empty="})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"replicas": "3", "image": "nginx:1.21", "message": "a=b", "empty": ""}, values)
+
+	_, err = SplitResourceActionParams([]string{"replicas"})
+	assert.EqualError(t, err, "invalid parameter 'replicas', expected name=value")
+	_, err = SplitResourceActionParams([]string{"=3"})
+	assert.Error(t, err)
+	_, err = SplitResourceActionParams([]string{"replicas=3", "replicas=4"})
+	assert.EqualError(t, err, "parameter 'replicas' is set more than once")
+}
+
+func TestParseResourceActionParams(t *testing.T) {
+	declared := []appv1.ResourceActionParam{
+		{Name: "image"},
+		{Name: "replicas", Type: ResourceActionParamTypeInteger, Default: pointer.StringPtr("1")},
+		{Name: "ratio", Type: ResourceActionParamTypeNumber, Default: pointer.StringPtr("0.5")},
+		{Name: "force", Type: ResourceActionParamTypeBoolean, Default: pointer.StringPtr("false")},
+		{Name: "suffix", Default: pointer.StringPtr("")},
+	}
+
+	params, err := ParseResourceActionParams(declared, map[string]string{"image": "nginx", "replicas": "3", "force": "true"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"image": "nginx", "replicas": int64(3), "ratio": 0.5, "force": true, "suffix": ""}, params)
+
+	_, err = ParseResourceActionParams(declared, map[string]string{"replicas": "3"})
+	assert.EqualError(t, err, "parameter 'image' is required")
+	_, err = ParseResourceActionParams(declared, map[string]string{"image": "nginx", "replicas": "three"})
+	assert.EqualError(t, err, "parameter 'replicas' must be an integer: three")
+	_, err = ParseResourceActionParams(declared, map[string]string{"image": "nginx", "ratio": "half"})
+	assert.EqualError(t, err, "parameter 'ratio' must be a number: half")
+	_, err = ParseResourceActionParams(declared, map[string]string{"image": "nginx", "force": "maybe"})
+	assert.EqualError(t, err, "parameter 'force' must be a boolean: maybe")
+	_, err = ParseResourceActionParams(declared, map[string]string{"image": "nginx", "tag": "latest"})
+	assert.EqualError(t, err, "unknown parameter 'tag'")
+	_, err = ParseResourceActionParams([]appv1.ResourceActionParam{{Name: "size", Type: "bytes", Default: pointer.StringPtr("1")}}, nil)
+	assert.EqualError(t, err, "parameter 'size' has unknown type 'bytes'")
+
+	params, err = ParseResourceActionParams(nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, params)
+}
+
+const paramsDiscoveryLua = `
+actions = {}
+actions["scale"] = {["params"] = {{["name"] = "replicas", ["type"] = "integer", ["default"] = "1"}}}
+actions["restart"] = {}
+return actions
+`
+
+const paramsActionLua = `
+obj.spec = {replicas = actionParams["replicas"]}
+return obj
+`
+
+func TestExecuteResourceActionWithParams(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"argoproj.io/Rollout": {Actions: string(grpc.MustMarshal(appv1.ResourceActions{ActionDiscoveryLua: paramsDiscoveryLua}))},
+		},
+	}
+
+	discoveryLua, err := vm.GetResourceActionDiscovery(testObj)
+	assert.NoError(t, err)
+	availableActions, err := vm.ExecuteResourceActionDiscovery(testObj, discoveryLua)
+	assert.NoError(t, err)
+	declared := FindResourceActionParams(availableActions, "scale")
+	assert.Equal(t, []appv1.ResourceActionParam{{Name: "replicas", Type: "integer", Default: pointer.StringPtr("1")}}, declared)
+	assert.Empty(t, FindResourceActionParams(availableActions, "restart"))
+	assert.Empty(t, FindResourceActionParams(availableActions, "pause"))
+
+	params, err := ParseResourceActionParams(declared, map[string]string{"replicas": "5"})
+	assert.NoError(t, err)
+	result, err := vm.ExecuteResourceAction(testObj, paramsActionLua, params)
+	assert.NoError(t, err)
+	replicas, _, err := unstructured.NestedInt64(result.Object, "spec", "replicas")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), replicas)
+}
@@ -37,10 +37,11 @@ type IndividualDiscoveryTest struct {
 }
 
 type IndividualActionTest struct {
-	Action             string `yaml:"action"`
-	InputPath          string `yaml:"inputPath"`
-	ExpectedOutputPath string `yaml:"expectedOutputPath"`
-	InputStr           string `yaml:"input"`
+	Action             string            `yaml:"action"`
+	InputPath          string            `yaml:"inputPath"`
+	ExpectedOutputPath string            `yaml:"expectedOutputPath"`
+	InputStr           string            `yaml:"input"`
+	Parameters         map[string]string `yaml:"parameters"`
 }
 
 func TestLuaResourceActionsScript(t *testing.T) {
@@ -86,11 +87,17 @@ func TestLuaResourceActionsScript(t *testing.T) {
 				obj := getObj(filepath.Join(dir, test.InputPath))
 				action, err := vm.GetResourceAction(obj, test.Action)
 				assert.NoError(t, err)
+				discoveryLua, err := vm.GetResourceActionDiscovery(obj)
+				assert.NoError(t, err)
+				availableActions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryLua)
+				assert.NoError(t, err)
+				params, err := ParseResourceActionParams(FindResourceActionParams(availableActions, test.Action), test.Parameters)
+				assert.NoError(t, err)
 
 				// freeze time so that lua test has predictable time output (will return 0001-01-01T00:00:00Z)
 				patch, err := mpatch.PatchMethod(time.Now, func() time.Time { return time.Time{} })
 				assert.NoError(t, err)
-				result, err := vm.ExecuteResourceAction(obj, action.ActionLua)
+				result, err := vm.ExecuteResourceAction(obj, action.ActionLua, params)
 				assert.NoError(t, err)
 				err = patch.Unpatch()
 				assert.NoError(t, err)
@@ -56,6 +56,11 @@ type VM struct {
 }
 
 func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
+	return vm.runLuaWithGlobals(obj, script, nil)
+}
+
+// runLuaWithGlobals runs the script with the given object as the obj global, and the given additional globals
+func (vm VM) runLuaWithGlobals(obj *unstructured.Unstructured, script string, globals map[string]interface{}) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
@@ -87,6 +92,9 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 	l.SetContext(ctx)
 	objectValue := decodeValue(l, obj.Object)
 	l.SetGlobal("obj", objectValue)
+	for name, value := range globals {
+		l.SetGlobal(name, decodeValue(l, value))
+	}
 	err := l.DoString(script)
 	return l, err
 }
@@ -131,8 +139,14 @@ func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, erro
 	return builtInScript, true, err
 }
 
-func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
-	l, err := vm.runLua(obj, script)
+// ExecuteResourceAction runs the lua script of a resource action and returns the updated resource. The values of the
+// parameters of the action, as returned by ParseResourceActionParams, are available to the script in the actionParams
+// global table.
+func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string, params map[string]interface{}) (*unstructured.Unstructured, error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	l, err := vm.runLuaWithGlobals(obj, script, map[string]interface{}{actionParamsGlobal: params})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,160 @@
+package manifestpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// maxFormattedViolations is the maximum number of violations included in the formatted violations
+const maxFormattedViolations = 10
+
+type forbiddenField struct {
+	v1alpha1.ForbiddenManifestField
+	code *gojq.Code
+	// hasValue is whether only the value is forbidden, rather than any value other than null
+	hasValue bool
+	value    interface{}
+}
+
+// Policy is a compiled manifest policy
+type Policy struct {
+	requiredAnnotations []string
+	forbiddenFields     []forbiddenField
+}
+
+// compileJQPathExpression compiles the JQ path expression of a forbidden field
+func compileJQPathExpression(expression string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JQ path expression '%s': %w", expression, err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile JQ path expression '%s': %w", expression, err)
+	}
+	return code, nil
+}
+
+// Compile compiles the given manifest policy, which is nil if the policy is nil
+func Compile(policy *v1alpha1.ManifestPolicy) (*Policy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	res := &Policy{requiredAnnotations: policy.RequiredAnnotations}
+	for _, field := range policy.ForbiddenFields {
+		code, err := compileJQPathExpression(field.JQPathExpression)
+		if err != nil {
+			return nil, err
+		}
+		compiled := forbiddenField{ForbiddenManifestField: field, code: code}
+		if field.Value != "" {
+			if err := json.Unmarshal([]byte(field.Value), &compiled.value); err != nil {
+				return nil, fmt.Errorf("failed to parse value '%s' of forbidden field '%s': %w", field.Value, field.JQPathExpression, err)
+			}
+			compiled.hasValue = true
+		}
+		res.forbiddenFields = append(res.forbiddenFields, compiled)
+	}
+	return res, nil
+}
+
+// isSetIn returns whether the given JSON document of a resource sets the forbidden field. A field set to null is
+// not distinguished from a missing one, so a field forbidden to be null must be set to another value.
+func (f forbiddenField) isSetIn(doc map[string]interface{}) (bool, error) {
+	iter := f.code.Run(doc)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return false, nil
+		}
+		if err, ok := v.(error); ok {
+			return false, fmt.Errorf("failed to evaluate JQ path expression '%s': %w", f.JQPathExpression, err)
+		}
+		if (f.hasValue && reflect.DeepEqual(v, f.value)) || (!f.hasValue && v != nil) {
+			return true, nil
+		}
+	}
+}
+
+// toJSONDocument converts the given resource to the types produced by encoding/json, which are the only ones
+// supported by gojq, unlike the int64 values of unstructured objects
+func toJSONDocument(obj *unstructured.Unstructured) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Violations returns the violations of the policy by the given resources
+func (p *Policy) Violations(objs []*unstructured.Unstructured) ([]string, error) {
+	if p == nil {
+		return nil, nil
+	}
+	var violations []string
+	for _, obj := range objs {
+		annotations := obj.GetAnnotations()
+		for _, annotation := range p.requiredAnnotations {
+			if _, ok := annotations[annotation]; !ok {
+				violations = append(violations, fmt.Sprintf("%s/%s is missing the required annotation %s", obj.GetKind(), obj.GetName(), annotation))
+			}
+		}
+		gvk := obj.GroupVersionKind()
+		// the resource is only converted if a forbidden field applies to it, and at most once
+		var doc map[string]interface{}
+		for _, field := range p.forbiddenFields {
+			if !field.Matches(gvk.Group, gvk.Kind) {
+				continue
+			}
+			if doc == nil {
+				var err error
+				if doc, err = toJSONDocument(obj); err != nil {
+					return nil, fmt.Errorf("%s/%s: %w", obj.GetKind(), obj.GetName(), err)
+				}
+			}
+			set, err := field.isSetIn(doc)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", obj.GetKind(), obj.GetName(), err)
+			}
+			if set {
+				violations = append(violations, fmt.Sprintf("%s/%s sets the forbidden field %s", obj.GetKind(), obj.GetName(), field.JQPathExpression))
+			}
+		}
+	}
+	return violations, nil
+}
+
+// FormatViolations formats the given violations as a single message, which only includes the first violations
+func FormatViolations(violations []string) string {
+	if len(violations) <= maxFormattedViolations {
+		return strings.Join(violations, "; ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(violations[:maxFormattedViolations], "; "), len(violations)-maxFormattedViolations)
+}
+
+// Check returns an error if the given resources violate the given manifest policy or if it cannot be evaluated
+func Check(policy *v1alpha1.ManifestPolicy, objs []*unstructured.Unstructured) error {
+	compiled, err := Compile(policy)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the manifest policy: %w", err)
+	}
+	violations, err := compiled.Violations(objs)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the manifest policy: %w", err)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("the manifests violate the manifest policy: %s", FormatViolations(violations))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package manifestpolicy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/test"
+)
+
+func violations(policy *v1alpha1.ManifestPolicy, objs []*unstructured.Unstructured) ([]string, error) {
+	compiled, err := Compile(policy)
+	if err != nil {
+		return nil, err
+	}
+	return compiled.Violations(objs)
+}
+
+func TestPolicy_Violations(t *testing.T) {
+	deployment := test.NewDeployment()
+	assert.NoError(t, unstructured.SetNestedField(deployment.Object, true, "spec", "template", "spec", "hostNetwork"))
+	assert.NoError(t, unstructured.SetNestedField(deployment.Object, int64(3), "spec", "replicas"))
+	deployment.SetAnnotations(map[string]string{"example.com/owner": "team-a"})
+	targetObjs := []*unstructured.Unstructured{deployment}
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		violations, err := violations(nil, targetObjs)
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("RequiredAnnotations", func(t *testing.T) {
+		violations, err := violations(&v1alpha1.ManifestPolicy{RequiredAnnotations: []string{"example.com/owner", "example.com/team"}}, targetObjs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Deployment/nginx-deployment is missing the required annotation example.com/team"}, violations)
+	})
+
+	t.Run("AnyValue", func(t *testing.T) {
+		violations, err := violations(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{
+			{JQPathExpression: ".spec.template.spec.hostNetwork"},
+			{JQPathExpression: ".spec.template.spec.hostPID"},
+		}}, targetObjs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Deployment/nginx-deployment sets the forbidden field .spec.template.spec.hostNetwork"}, violations)
+	})
+
+	t.Run("Value", func(t *testing.T) {
+		violations, err := violations(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{
+			{JQPathExpression: ".spec.template.spec.hostNetwork", Value: "false"},
+			{JQPathExpression: ".spec.replicas", Value: "3"},
+		}}, targetObjs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Deployment/nginx-deployment sets the forbidden field .spec.replicas"}, violations)
+	})
+
+	t.Run("GroupKind", func(t *testing.T) {
+		violations, err := violations(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{
+			{Kind: "Pod", JQPathExpression: ".spec.template.spec.hostNetwork"},
+			{Group: "extensions", Kind: "Deployment", JQPathExpression: ".spec.template.spec.hostNetwork"},
+		}}, targetObjs)
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+
+	t.Run("NullValue", func(t *testing.T) {
+		violations, err := violations(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{
+			{JQPathExpression: ".spec.template.spec.hostNetwork", Value: "null"},
+			{JQPathExpression: ".spec.template.spec.hostPID", Value: "null"},
+		}}, targetObjs)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Deployment/nginx-deployment sets the forbidden field .spec.template.spec.hostPID"}, violations)
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := violations(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{
+			{JQPathExpression: ".spec.["},
+		}}, targetObjs)
+		assert.Error(t, err)
+	})
+}
+
+func TestFormatViolations(t *testing.T) {
+	assert.Equal(t, "a; b", FormatViolations([]string{"a", "b"}))
+
+	var many []string
+	for i := 0; i < 12; i++ {
+		many = append(many, fmt.Sprintf("violation %d", i))
+	}
+	message := FormatViolations(many)
+	assert.Contains(t, message, "violation 9 and 2 more")
+	assert.NotContains(t, message, "violation 10")
+}
+
+func TestCheck(t *testing.T) {
+	objs := []*unstructured.Unstructured{test.NewDeployment()}
+	assert.NoError(t, Check(nil, objs))
+	assert.NoError(t, Check(&v1alpha1.ManifestPolicy{ForbiddenFields: []v1alpha1.ForbiddenManifestField{{JQPathExpression: ".spec.template.spec.hostNetwork"}}}, objs))
+
+	err := Check(&v1alpha1.ManifestPolicy{RequiredAnnotations: []string{"example.com/owner"}}, objs)
+	assert.EqualError(t, err, "the manifests violate the manifest policy: Deployment/nginx-deployment is missing the required annotation example.com/owner")
+}
@@ -0,0 +1,613 @@
+package oci
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	goio "io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/sync"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/proxy"
+)
+
+const (
+	// URLPrefix is the prefix of the OCI artifact repository URLs
+	URLPrefix = "oci://"
+
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// annotationTitle holds the file name of a layer pushed by `oras push`
+	annotationTitle = "org.opencontainers.image.title"
+	// annotationUnpack is set by `oras push` on layers holding a gzipped tarball of a directory
+	annotationUnpack = "io.deis.oras.content.unpack"
+)
+
+var (
+	globalLock = sync.NewKeyLock()
+
+	challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	linkNextRegex       = regexp.MustCompile(`<([^>]+)>;\s*rel="?next"?`)
+	digestRegex         = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+type Creds struct {
+	Username           string
+	Password           string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+// Client fetches artifacts stored in an OCI registry
+type Client interface {
+	// ResolveRevision resolves the given tag (or digest) to the digest of the artifact manifest
+	ResolveRevision(revision string) (string, error)
+	// ListTags returns all tags of the repository
+	ListTags() ([]string, error)
+	// Extract downloads the artifact with the given digest and unpacks its layers into a temporary directory
+	Extract(digest string) (string, io.Closer, error)
+	// CleanCache removes the downloaded layers of the artifact with the given digest
+	CleanCache(digest string) error
+	// TestRepository checks that the repository is accessible
+	TestRepository() error
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Layers    []descriptor `json:"layers"`
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+func NewClient(repoURL string, creds Creds, proxy string) Client {
+	return NewClientWithLock(repoURL, creds, globalLock, proxy)
+}
+
+func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy string) Client {
+	return &nativeOCIClient{
+		repoURL:  repoURL,
+		creds:    creds,
+		repoPath: filepath.Join(os.TempDir(), strings.Replace(strings.TrimPrefix(repoURL, URLPrefix), "/", "_", -1)),
+		repoLock: repoLock,
+		proxy:    proxy,
+	}
+}
+
+var _ Client = &nativeOCIClient{}
+
+type nativeOCIClient struct {
+	repoPath string
+	repoURL  string
+	creds    Creds
+	repoLock sync.KeyLock
+	proxy    string
+	token    string
+}
+
+// IsOCIRepo returns true if the given URL points to an OCI artifact repository, i.e. oci://<registry>/<repository>
+func IsOCIRepo(repoURL string) bool {
+	return strings.HasPrefix(repoURL, URLPrefix)
+}
+
+// IsDigest returns true if the given revision is a manifest digest
+func IsDigest(revision string) bool {
+	return digestRegex.MatchString(revision)
+}
+
+// parseRepoURL splits oci://<registry>/<repository> into the registry host and the repository name
+func parseRepoURL(repoURL string) (string, string, error) {
+	if !IsOCIRepo(repoURL) {
+		return "", "", fmt.Errorf("invalid OCI repository URL '%s': must start with %s", repoURL, URLPrefix)
+	}
+	parts := strings.SplitN(strings.Trim(strings.TrimPrefix(repoURL, URLPrefix), "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid OCI repository URL '%s': must be %s<registry>/<repository>", repoURL, URLPrefix)
+	}
+	return parts[0], parts[1], nil
+}
+
+func (c *nativeOCIClient) ResolveRevision(revision string) (string, error) {
+	if IsDigest(revision) {
+		return revision, nil
+	}
+	if revision == "" || revision == "HEAD" {
+		revision = "latest"
+	}
+	resp, err := c.getManifest(revision, http.MethodHead)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		// some registries do not return digest in HEAD response
+		data, err := c.readManifest(revision)
+		if err != nil {
+			return "", err
+		}
+		digest = fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	}
+	if !IsDigest(digest) {
+		return "", fmt.Errorf("unsupported manifest digest '%s'", digest)
+	}
+	return digest, nil
+}
+
+func (c *nativeOCIClient) ListTags() ([]string, error) {
+	_, repository, err := parseRepoURL(c.repoURL)
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	apiPath := "tags/list"
+	for apiPath != "" {
+		resp, err := c.do(http.MethodGet, apiPath, "")
+		if err != nil {
+			return nil, err
+		}
+		var tagList struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&tagList)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tag list: %v", err)
+		}
+		tags = append(tags, tagList.Tags...)
+
+		// registries paginate the tag list using the Link header
+		apiPath = ""
+		if match := linkNextRegex.FindStringSubmatch(resp.Header.Get("Link")); match != nil {
+			next, err := url.Parse(match[1])
+			if err != nil {
+				return nil, err
+			}
+			prefix := fmt.Sprintf("/v2/%s/", repository)
+			if !strings.HasPrefix(next.Path, prefix) {
+				return nil, fmt.Errorf("unexpected tag list link '%s'", match[1])
+			}
+			apiPath = strings.TrimPrefix(next.Path, prefix)
+			if next.RawQuery != "" {
+				apiPath += "?" + next.RawQuery
+			}
+		}
+	}
+	return tags, nil
+}
+
+func (c *nativeOCIClient) TestRepository() error {
+	_, _, err := parseRepoURL(c.repoURL)
+	if err != nil {
+		return err
+	}
+	resp, err := c.do(http.MethodGet, "tags/list", "")
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+func (c *nativeOCIClient) CleanCache(digest string) error {
+	return os.RemoveAll(c.getCachedArtifactPath(digest))
+}
+
+func (c *nativeOCIClient) Extract(digest string) (string, io.Closer, error) {
+	if !IsDigest(digest) {
+		return "", nil, fmt.Errorf("invalid digest '%s'", digest)
+	}
+	cachedPath := c.getCachedArtifactPath(digest)
+
+	c.repoLock.Lock(cachedPath)
+	defer c.repoLock.Unlock(cachedPath)
+
+	layers, err := c.downloadArtifact(digest, cachedPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// throw away temp directory that stores extracted artifact and should be deleted as soon as no longer needed by returned closer
+	tempDir, err := ioutil.TempDir("", "oci")
+	if err != nil {
+		return "", nil, err
+	}
+	for _, layer := range layers {
+		if err = extractLayer(filepath.Join(cachedPath, digestHex(layer.Digest)), layer, tempDir); err != nil {
+			_ = os.RemoveAll(tempDir)
+			return "", nil, fmt.Errorf("failed to extract layer %s: %v", layer.Digest, err)
+		}
+	}
+	return tempDir, io.NewCloser(func() error {
+		return os.RemoveAll(tempDir)
+	}), nil
+}
+
+// downloadArtifact ensures that the manifest and layers of the given artifact are downloaded into the cache directory
+func (c *nativeOCIClient) downloadArtifact(digest string, cachedPath string) ([]descriptor, error) {
+	manifestPath := filepath.Join(cachedPath, "manifest.json")
+	data, err := ioutil.ReadFile(manifestPath)
+	if os.IsNotExist(err) {
+		start := time.Now()
+		data, err = c.readManifest(digest)
+		if err != nil {
+			return nil, err
+		}
+		if err = verifyDigest(digest, data); err != nil {
+			return nil, err
+		}
+		if err = os.MkdirAll(cachedPath, 0700); err != nil {
+			return nil, err
+		}
+		m, err := parseManifest(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, layer := range m.Layers {
+			if err = c.downloadBlob(layer.Digest, filepath.Join(cachedPath, digestHex(layer.Digest))); err != nil {
+				_ = os.RemoveAll(cachedPath)
+				return nil, err
+			}
+		}
+		// the manifest is written last and marks the artifact as completely downloaded
+		if err = ioutil.WriteFile(manifestPath, data, 0600); err != nil {
+			return nil, err
+		}
+		log.WithFields(log.Fields{"seconds": time.Since(start).Seconds()}).Infof("took to download artifact %s@%s", c.repoURL, digest)
+	} else if err != nil {
+		return nil, err
+	}
+	m, err := parseManifest(data)
+	if err != nil {
+		return nil, err
+	}
+	return m.Layers, nil
+}
+
+func parseManifest(data []byte) (*manifest, error) {
+	m := &manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %v", err)
+	}
+	for _, layer := range m.Layers {
+		if !IsDigest(layer.Digest) {
+			return nil, fmt.Errorf("unsupported layer digest '%s'", layer.Digest)
+		}
+	}
+	return m, nil
+}
+
+func (c *nativeOCIClient) readManifest(reference string) ([]byte, error) {
+	resp, err := c.getManifest(reference, http.MethodGet)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (c *nativeOCIClient) getManifest(reference string, method string) (*http.Response, error) {
+	return c.do(method, "manifests/"+reference, strings.Join([]string{mediaTypeOCIManifest, mediaTypeDockerManifest}, ","))
+}
+
+func (c *nativeOCIClient) downloadBlob(digest string, dest string) error {
+	resp, err := c.do(http.MethodGet, "blobs/"+digest, "")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	hash := sha256.New()
+	_, err = goio.Copy(f, goio.TeeReader(resp.Body, hash))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if actual := "sha256:" + hex.EncodeToString(hash.Sum(nil)); actual != digest {
+		return fmt.Errorf("digest mismatch of blob %s: got %s", digest, actual)
+	}
+	return nil
+}
+
+// do sends a request to the registry API of the repository and handles basic and bearer token authentication
+func (c *nativeOCIClient) do(method string, apiPath string, accept string) (*http.Response, error) {
+	host, repository, err := parseRepoURL(c.repoURL)
+	if err != nil {
+		return nil, err
+	}
+	client, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	reqURL := fmt.Sprintf("https://%s/v2/%s/%s", host, repository, apiPath)
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequest(method, reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		return req, nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+		if err = c.authorize(client, req, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = client.Do(req); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: %s", reqURL, resp.Status)
+	}
+	return resp, nil
+}
+
+// authorize sets the credentials requested by the given WWW-Authenticate challenge on the request
+func (c *nativeOCIClient) authorize(client *http.Client, req *http.Request, challenge string) error {
+	scheme := strings.ToLower(strings.SplitN(challenge, " ", 2)[0])
+	switch scheme {
+	case "basic":
+		if c.creds.Username == "" && c.creds.Password == "" {
+			return errors.New("registry requires basic authentication but no credentials are configured")
+		}
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	case "bearer":
+		params := map[string]string{}
+		for _, match := range challengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+			params[match[1]] = match[2]
+		}
+		token, err := c.fetchToken(client, params["realm"], params["service"], params["scope"])
+		if err != nil {
+			return err
+		}
+		c.token = token
+		req.Header.Set("Authorization", "Bearer "+token)
+	default:
+		return fmt.Errorf("unsupported authentication challenge '%s'", challenge)
+	}
+	return nil
+}
+
+func (c *nativeOCIClient) fetchToken(client *http.Client, realm string, service string, scope string) (string, error) {
+	if realm == "" {
+		return "", errors.New("authentication challenge does not specify realm")
+	}
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return "", err
+	}
+	query := tokenURL.Query()
+	if service != "" {
+		query.Set("service", service)
+	}
+	if scope != "" {
+		query.Set("scope", scope)
+	}
+	tokenURL.RawQuery = query.Encode()
+	req, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.creds.Username != "" || c.creds.Password != "" {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get registry token: %s", resp.Status)
+	}
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", err
+	}
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+	return tokenResp.AccessToken, nil
+}
+
+func (c *nativeOCIClient) httpClient() (*http.Client, error) {
+	tlsConf, err := newTLSConfig(c.creds)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: &http.Transport{
+		Proxy:           proxy.GetCallback(c.proxy),
+		TLSClientConfig: tlsConf,
+	}}, nil
+}
+
+func newTLSConfig(creds Creds) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if creds.CAPath != "" {
+		caData, err := ioutil.ReadFile(creds.CAPath)
+		if err != nil {
+			return nil, err
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func (c *nativeOCIClient) getCachedArtifactPath(digest string) string {
+	return filepath.Join(c.repoPath, digestHex(digest))
+}
+
+func digestHex(digest string) string {
+	return strings.TrimPrefix(digest, "sha256:")
+}
+
+func verifyDigest(digest string, data []byte) error {
+	if actual := fmt.Sprintf("sha256:%x", sha256.Sum256(data)); actual != digest {
+		return fmt.Errorf("digest mismatch of manifest %s: got %s", digest, actual)
+	}
+	return nil
+}
+
+// extractLayer unpacks the given layer into destDir. Layers with a file name annotation are copied as is,
+// other layers are expected to be (optionally gzipped) tarballs.
+func extractLayer(layerPath string, layer descriptor, destDir string) error {
+	title := layer.Annotations[annotationTitle]
+	if title != "" && layer.Annotations[annotationUnpack] != "true" {
+		dest, err := securePath(destDir, title)
+		if err != nil {
+			return err
+		}
+		if err = os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		return copyFile(layerPath, dest)
+	}
+
+	f, err := os.Open(layerPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	var r goio.Reader = bufio.NewReader(f)
+	if magic, err := r.(*bufio.Reader).Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzr, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = gzr.Close() }()
+		r = gzr
+	}
+	return untar(r, destDir)
+}
+
+func untar(r goio.Reader, destDir string) error {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == goio.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dest, err := securePath(destDir, header.Name)
+		if err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(dest, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(dest, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode)&0755|0600)
+			if err != nil {
+				return err
+			}
+			_, err = goio.Copy(f, tr)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			// symbolic links and other special files are ignored since they might point outside of the artifact
+			log.Debugf("Ignoring %s of type %c", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// securePath joins the given file name with the destination directory and ensures that the result is inside of it
+func securePath(destDir string, name string) (string, error) {
+	dest := filepath.Join(destDir, name)
+	if dest != destDir && !strings.HasPrefix(dest, filepath.Clean(destDir)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("illegal file path '%s'", name)
+	}
+	return dest, nil
+}
+
+func copyFile(src string, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := goio.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
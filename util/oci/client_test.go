@@ -0,0 +1,202 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func digestOf(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
+
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+}
+
+func newFakeRegistry(t *testing.T, tag string, layers map[*descriptor][]byte) *fakeRegistry {
+	r := &fakeRegistry{manifests: map[string][]byte{}, blobs: map[string][]byte{}}
+	m := manifest{MediaType: mediaTypeOCIManifest}
+	for layer, data := range layers {
+		layer.Digest = digestOf(data)
+		layer.Size = int64(len(data))
+		r.blobs[layer.Digest] = data
+		m.Layers = append(m.Layers, *layer)
+	}
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	r.manifests[tag] = data
+	r.manifests[digestOf(data)] = data
+	return r
+}
+
+func (r *fakeRegistry) handler(serverURL func() string) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/token" {
+			if user, pass, ok := req.BasicAuth(); !ok || user != "user" || pass != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"token": "secret"}`))
+			return
+		}
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:org/app:pull"`, serverURL()))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case strings.HasPrefix(req.URL.Path, "/v2/org/app/manifests/"):
+			data, ok := r.manifests[strings.TrimPrefix(req.URL.Path, "/v2/org/app/manifests/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", mediaTypeOCIManifest)
+			w.Header().Set("Docker-Content-Digest", digestOf(data))
+			_, _ = w.Write(data)
+		case strings.HasPrefix(req.URL.Path, "/v2/org/app/blobs/"):
+			data, ok := r.blobs[strings.TrimPrefix(req.URL.Path, "/v2/org/app/blobs/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case req.URL.Path == "/v2/org/app/tags/list" && req.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/org/app/tags/list?last=v1&n=1>; rel="next"`)
+			_, _ = w.Write([]byte(`{"name": "org/app", "tags": ["v1"]}`))
+		case req.URL.Path == "/v2/org/app/tags/list" && req.URL.Query().Get("last") == "v1":
+			_, _ = w.Write([]byte(`{"name": "org/app", "tags": ["v2"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func newTestClient(t *testing.T, registry *fakeRegistry, creds Creds) (Client, func()) {
+	var server *httptest.Server
+	server = httptest.NewTLSServer(registry.handler(func() string { return server.URL }))
+	tmpDir, err := ioutil.TempDir("", "oci-cache")
+	require.NoError(t, err)
+	creds.InsecureSkipVerify = true
+	client := NewClient(URLPrefix+strings.TrimPrefix(server.URL, "https://")+"/org/app", creds, "")
+	client.(*nativeOCIClient).repoPath = tmpDir
+	return client, func() {
+		server.Close()
+		_ = os.RemoveAll(tmpDir)
+	}
+}
+
+func TestClient_Extract(t *testing.T) {
+	registry := newFakeRegistry(t, "v1", map[*descriptor][]byte{
+		{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip"}: tarball(t, map[string]string{
+			"kustomize/kustomization.yaml": "resources:\n- cm.yaml\n",
+			"kustomize/cm.yaml":            "kind: ConfigMap\n",
+		}),
+		{MediaType: "application/vnd.acme.manifest", Annotations: map[string]string{annotationTitle: "plain/deployment.yaml"}}: []byte("kind: Deployment\n"),
+	})
+	client, cleanup := newTestClient(t, registry, Creds{Username: "user", Password: "pass"})
+	defer cleanup()
+
+	digest, err := client.ResolveRevision("v1")
+	require.NoError(t, err)
+	assert.Equal(t, digestOf(registry.manifests["v1"]), digest)
+
+	for i := 0; i < 2; i++ {
+		appRoot, closer, err := client.Extract(digest)
+		require.NoError(t, err)
+
+		data, err := ioutil.ReadFile(filepath.Join(appRoot, "kustomize", "cm.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: ConfigMap\n", string(data))
+		data, err = ioutil.ReadFile(filepath.Join(appRoot, "plain", "deployment.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: Deployment\n", string(data))
+
+		require.NoError(t, closer.Close())
+		_, err = os.Stat(appRoot)
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	assert.NoError(t, client.TestRepository())
+	assert.NoError(t, client.CleanCache(digest))
+}
+
+func TestClient_ListTags(t *testing.T) {
+	registry := newFakeRegistry(t, "v1", nil)
+	client, cleanup := newTestClient(t, registry, Creds{Username: "user", Password: "pass"})
+	defer cleanup()
+
+	tags, err := client.ListTags()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, tags)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	registry := newFakeRegistry(t, "v1", nil)
+	client, cleanup := newTestClient(t, registry, Creds{Username: "user", Password: "wrong"})
+	defer cleanup()
+
+	_, err := client.ResolveRevision("v1")
+	assert.EqualError(t, err, "failed to get registry token: 401 Unauthorized")
+}
+
+func TestClient_ExtractIllegalPath(t *testing.T) {
+	registry := newFakeRegistry(t, "v1", map[*descriptor][]byte{
+		{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip"}: tarball(t, map[string]string{"../escape.yaml": "kind: ConfigMap\n"}),
+	})
+	client, cleanup := newTestClient(t, registry, Creds{Username: "user", Password: "pass"})
+	defer cleanup()
+
+	digest, err := client.ResolveRevision("v1")
+	require.NoError(t, err)
+	_, _, err = client.Extract(digest)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "illegal file path '../escape.yaml'")
+}
+
+func TestParseRepoURL(t *testing.T) {
+	host, repository, err := parseRepoURL("oci://ghcr.io/org/manifests")
+	assert.NoError(t, err)
+	assert.Equal(t, "ghcr.io", host)
+	assert.Equal(t, "org/manifests", repository)
+
+	_, _, err = parseRepoURL("oci://ghcr.io")
+	assert.Error(t, err)
+	_, _, err = parseRepoURL("https://ghcr.io/org/manifests")
+	assert.Error(t, err)
+}
+
+func TestIsDigest(t *testing.T) {
+	assert.True(t, IsDigest(digestOf([]byte("foo"))))
+	assert.False(t, IsDigest("v1.0.0"))
+	assert.False(t, IsDigest("sha256:abc"))
+}
@@ -1,6 +1,8 @@
 package oidc
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"html"
@@ -48,6 +50,8 @@ type ClaimsRequest struct {
 type OIDCState struct {
 	// ReturnURL is the URL in which to redirect a user back to after completing an OAuth2 login
 	ReturnURL string `json:"returnURL"`
+	// CodeVerifier is the PKCE code verifier of the login, if PKCE is enabled
+	CodeVerifier string `json:"codeVerifier,omitempty"`
 }
 
 type OIDCStateStorage interface {
@@ -73,6 +77,8 @@ type ClientApp struct {
 	secureCookie bool
 	// settings holds Argo CD settings
 	settings *settings.ArgoCDSettings
+	// oidcConfig is the config of the OIDC provider, nil if the provider is Dex
+	oidcConfig *settings.OIDCConfig
 	// provider is the OIDC provider
 	provider Provider
 	// cache holds temporary nonce tokens to which hold application state values
@@ -101,40 +107,68 @@ func NewClientApp(settings *settings.ArgoCDSettings, cache OIDCStateStorage, dex
 		issuerURL:    settings.IssuerURL(),
 		baseHRef:     baseHRef,
 		cache:        cache,
+		settings:     settings,
+		oidcConfig:   settings.OIDCConfig(),
 	}
-	log.Infof("Creating client app (%s)", a.clientID)
-	u, err := url.Parse(settings.URL)
+	var transport http.RoundTripper = newTransport(settings)
+	if settings.DexConfig != "" && settings.OIDCConfigRAW == "" {
+		transport = dex.NewDexRewriteURLRoundTripper(dexServerAddr, transport)
+	}
+	return initClientApp(&a, transport)
+}
+
+// NewProviderClientApp returns the client app of the given additional OIDC provider, which has its own login and
+// callback URLs
+func NewProviderClientApp(settings *settings.ArgoCDSettings, provider settings.OIDCConfig, cache OIDCStateStorage, baseHRef string) (*ClientApp, error) {
+	redirectURL, err := settings.OIDCProviderRedirectURL(provider.Name)
 	if err != nil {
-		return nil, fmt.Errorf("parse redirect-uri: %v", err)
+		return nil, err
 	}
+	a := ClientApp{
+		clientID:     provider.ClientID,
+		clientSecret: provider.ClientSecret,
+		redirectURI:  redirectURL,
+		issuerURL:    provider.Issuer,
+		baseHRef:     baseHRef,
+		cache:        cache,
+		settings:     settings,
+		oidcConfig:   &provider,
+	}
+	return initClientApp(&a, newTransport(settings))
+}
+
+func newTransport(settings *settings.ArgoCDSettings) *http.Transport {
 	tlsConfig := settings.TLSConfig()
 	if tlsConfig != nil {
 		tlsConfig.InsecureSkipVerify = true
 	}
-	a.client = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: tlsConfig,
-			Proxy:           http.ProxyFromEnvironment,
-			Dial: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).Dial,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		},
+	return &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
+		Dial: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).Dial,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
 	}
-	if settings.DexConfig != "" && settings.OIDCConfigRAW == "" {
-		a.client.Transport = dex.NewDexRewriteURLRoundTripper(dexServerAddr, a.client.Transport)
+}
+
+func initClientApp(a *ClientApp, transport http.RoundTripper) (*ClientApp, error) {
+	log.Infof("Creating client app (%s)", a.clientID)
+	u, err := url.Parse(a.settings.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redirect-uri: %v", err)
 	}
 	if os.Getenv(common.EnvVarSSODebug) == "1" {
-		a.client.Transport = httputil.DebugTransport{T: a.client.Transport}
+		transport = httputil.DebugTransport{T: transport}
 	}
+	a.client = &http.Client{Transport: transport}
 
 	a.provider = NewOIDCProvider(a.issuerURL, a.client)
 	// NOTE: if we ever have replicas of Argo CD, this needs to switch to Redis cache
 	a.secureCookie = bool(u.Scheme == "https")
-	a.settings = settings
-	return &a, nil
+	return a, nil
 }
 
 func (a *ClientApp) oauth2Config(scopes []string) (*oauth2.Config, error) {
@@ -152,12 +186,12 @@ func (a *ClientApp) oauth2Config(scopes []string) (*oauth2.Config, error) {
 }
 
 // generateAppState creates an app state nonce
-func (a *ClientApp) generateAppState(returnURL string) string {
+func (a *ClientApp) generateAppState(returnURL string, codeVerifier string) string {
 	randStr := rand.RandString(10)
 	if returnURL == "" {
 		returnURL = a.baseHRef
 	}
-	err := a.cache.SetOIDCState(randStr, &OIDCState{ReturnURL: returnURL})
+	err := a.cache.SetOIDCState(randStr, &OIDCState{ReturnURL: returnURL, CodeVerifier: codeVerifier})
 	if err != nil {
 		// This should never happen with the in-memory cache
 		log.Errorf("Failed to set app state: %v", err)
@@ -236,7 +270,7 @@ func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
 	}
 	scopes := make([]string, 0)
 	var opts []oauth2.AuthCodeOption
-	if config := a.settings.OIDCConfig(); config != nil {
+	if config := a.oidcConfig; config != nil {
 		scopes = config.RequestedScopes
 		opts = AppendClaimsAuthenticationRequestParameter(opts, config.RequestedIDTokenClaims)
 	}
@@ -251,8 +285,13 @@ func (a *ClientApp) HandleLogin(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid return_url", http.StatusBadRequest)
 		return
 	}
-	stateNonce := a.generateAppState(returnURL)
 	grantType := InferGrantType(oidcConf)
+	var codeVerifier string
+	if grantType == GrantTypeAuthorizationCode && a.pkceEnabled() {
+		codeVerifier = PKCECodeVerifier()
+		opts = append(opts, PKCEAuthCodeOptions(codeVerifier)...)
+	}
+	stateNonce := a.generateAppState(returnURL, codeVerifier)
 	var url string
 	switch grantType {
 	case GrantTypeAuthorizationCode:
@@ -293,7 +332,11 @@ func (a *ClientApp) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	ctx := gooidc.ClientContext(r.Context(), a.client)
-	token, err := oauth2Config.Exchange(ctx, code)
+	var opts []oauth2.AuthCodeOption
+	if appState.CodeVerifier != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("code_verifier", appState.CodeVerifier))
+	}
+	token, err := oauth2Config.Exchange(ctx, code, opts...)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to get token: %v", err), http.StatusInternalServerError)
 		return
@@ -388,6 +431,27 @@ func (a *ClientApp) handleImplicitFlow(w http.ResponseWriter, state string) {
 	renderTemplate(w, implicitFlowTmpl, vals)
 }
 
+// pkceEnabled returns whether the logins use the PKCE extension of the authorization code flow
+func (a *ClientApp) pkceEnabled() bool {
+	return a.oidcConfig != nil && a.oidcConfig.EnablePKCEAuthentication
+}
+
+// PKCECodeVerifier returns a random code verifier of the PKCE extension of the authorization code flow, see
+// https://tools.ietf.org/html/rfc7636
+func PKCECodeVerifier() string {
+	return rand.RandStringCharset(43, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~")
+}
+
+// PKCEAuthCodeOptions returns the options adding the S256 challenge of the given PKCE code verifier to an
+// authorization request
+func PKCEAuthCodeOptions(codeVerifier string) []oauth2.AuthCodeOption {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(hash[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+}
+
 // ImplicitFlowURL is an adaptation of oauth2.Config::AuthCodeURL() which returns a URL
 // appropriate for an OAuth2 implicit login flow (as opposed to authorization code flow).
 func ImplicitFlowURL(c *oauth2.Config, state string, opts ...oauth2.AuthCodeOption) string {
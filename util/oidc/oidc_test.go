@@ -3,6 +3,7 @@ package oidc
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
@@ -12,6 +13,8 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/argoproj/argo-cd/v2/server/settings/oidc"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 func TestInferGrantType(t *testing.T) {
@@ -68,14 +71,31 @@ func TestIDTokenClaims(t *testing.T) {
 }
 
 type fakeProvider struct {
+	endpoint oauth2.Endpoint
+	config   *OIDCConfiguration
 }
 
 func (p *fakeProvider) Endpoint() (*oauth2.Endpoint, error) {
-	return &oauth2.Endpoint{}, nil
+	return &p.endpoint, nil
 }
 
 func (p *fakeProvider) ParseConfig() (*OIDCConfiguration, error) {
-	return nil, nil
+	return p.config, nil
+}
+
+type fakeStateStorage map[string]*OIDCState
+
+func (s fakeStateStorage) GetOIDCState(key string) (*OIDCState, error) {
+	state, ok := s[key]
+	if !ok || state == nil {
+		return nil, appstatecache.ErrCacheMiss
+	}
+	return state, nil
+}
+
+func (s fakeStateStorage) SetOIDCState(key string, state *OIDCState) error {
+	s[key] = state
+	return nil
 }
 
 func (p *fakeProvider) Verify(_, _ string) (*gooidc.IDToken, error) {
@@ -97,6 +117,102 @@ func TestHandleCallback(t *testing.T) {
 	assert.Equal(t, "login-failed: &lt;script&gt;alert(&#39;hello&#39;)&lt;/script&gt;\n", w.Body.String())
 }
 
+func TestNewProviderClientApp(t *testing.T) {
+	set := &settings.ArgoCDSettings{URL: "https://argocd.example.com"}
+	provider := settings.OIDCConfig{
+		Name:            "okta",
+		Issuer:          "https://dev-123456.oktapreview.com",
+		ClientID:        "okta-client",
+		ClientSecret:    "okta-secret",
+		RequestedScopes: []string{"openid", "roles"},
+	}
+	app, err := NewProviderClientApp(set, provider, nil, "/")
+	assert.NoError(t, err)
+	assert.Equal(t, "okta-client", app.clientID)
+	assert.Equal(t, "okta-secret", app.clientSecret)
+	assert.Equal(t, "https://dev-123456.oktapreview.com", app.issuerURL)
+	assert.Equal(t, "https://argocd.example.com/auth/callback/okta", app.redirectURI)
+	assert.Equal(t, []string{"openid", "roles"}, app.oidcConfig.RequestedScopes)
+	assert.True(t, app.secureCookie)
+}
+
+func TestPKCEAuthCodeOptions(t *testing.T) {
+	// example of https://tools.ietf.org/html/rfc7636#appendix-B
+	opts := PKCEAuthCodeOptions("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	config := oauth2.Config{Endpoint: oauth2.Endpoint{AuthURL: "https://idp.example.com/auth"}}
+	authURL, err := url.Parse(config.AuthCodeURL("state", opts...))
+	assert.NoError(t, err)
+	assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", authURL.Query().Get("code_challenge"))
+	assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	assert.Len(t, PKCECodeVerifier(), 43)
+}
+
+func TestHandleLogin_PKCE(t *testing.T) {
+	var tokenRequest url.Values
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		tokenRequest = r.PostForm
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "token", "token_type": "bearer"}`))
+	}))
+	defer tokenServer.Close()
+
+	cache := fakeStateStorage{}
+	app := ClientApp{
+		clientID:    "argo-cd",
+		redirectURI: "https://argocd.example.com/auth/callback",
+		client:      tokenServer.Client(),
+		settings:    &settings.ArgoCDSettings{URL: "https://argocd.example.com"},
+		oidcConfig:  &settings.OIDCConfig{EnablePKCEAuthentication: true},
+		cache:       cache,
+		provider: &fakeProvider{
+			endpoint: oauth2.Endpoint{AuthURL: "https://idp.example.com/auth", TokenURL: tokenServer.URL},
+			config:   &OIDCConfiguration{ResponseTypesSupported: []string{ResponseTypeCode}},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	app.HandleLogin(w, httptest.NewRequest("GET", "https://argocd.example.com/auth/login", nil))
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	authURL, err := url.Parse(w.Header().Get("Location"))
+	assert.NoError(t, err)
+	assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	state := cache[authURL.Query().Get("state")]
+	if !assert.NotNil(t, state) {
+		return
+	}
+	assert.NotEmpty(t, state.CodeVerifier)
+	codeVerifier := state.CodeVerifier
+
+	w = httptest.NewRecorder()
+	app.HandleCallback(w, httptest.NewRequest("GET", "https://argocd.example.com/auth/callback?code=abc&state="+authURL.Query().Get("state"), nil))
+	// the fake token endpoint does not return an ID token
+	assert.Contains(t, w.Body.String(), "no id_token in token response")
+	assert.Equal(t, codeVerifier, tokenRequest.Get("code_verifier"))
+	assert.Equal(t, "abc", tokenRequest.Get("code"))
+}
+
+func TestHandleLogin_NoPKCE(t *testing.T) {
+	cache := fakeStateStorage{}
+	app := ClientApp{
+		clientID: "argo-cd",
+		settings: &settings.ArgoCDSettings{URL: "https://argocd.example.com"},
+		cache:    cache,
+		provider: &fakeProvider{
+			endpoint: oauth2.Endpoint{AuthURL: "https://idp.example.com/auth"},
+			config:   &OIDCConfiguration{ResponseTypesSupported: []string{ResponseTypeCode}},
+		},
+	}
+
+	w := httptest.NewRecorder()
+	app.HandleLogin(w, httptest.NewRequest("GET", "https://argocd.example.com/auth/login", nil))
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	authURL, err := url.Parse(w.Header().Get("Location"))
+	assert.NoError(t, err)
+	assert.Empty(t, authURL.Query().Get("code_challenge"))
+	assert.Empty(t, cache[authURL.Query().Get("state")].CodeVerifier)
+}
+
 func TestIsValidRedirect(t *testing.T) {
 	var tests = []struct {
 		name        string
@@ -129,6 +129,25 @@ func TestDefaultRole(t *testing.T) {
 	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
 }
 
+// TestDenyCoversSubActions tests that an explicit deny of an action also denies its sub-actions, e.g. the actions on
+// the resources of an application
+func TestDenyCoversSubActions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	err := enf.syncUpdate(fakeConfigMap(), noOpUpdate)
+	assert.Nil(t, err)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, *, default/*, allow
+p, alice, applications, delete, default/*, deny
+p, bob, applications, delete/*, default/*, allow`)
+
+	assert.True(t, enf.Enforce("alice", "applications", "update/apps/Deployment/default/guestbook-ui", "default/guestbook"))
+	assert.False(t, enf.Enforce("alice", "applications", "delete", "default/guestbook"))
+	assert.False(t, enf.Enforce("alice", "applications", "delete/apps/Deployment/default/guestbook-ui", "default/guestbook"))
+	// an allowed action does not allow its sub-actions
+	assert.False(t, enf.Enforce("bob", "applications", "delete", "default/guestbook"))
+	assert.True(t, enf.Enforce("bob", "applications", "delete/apps/Deployment/default/guestbook-ui", "default/guestbook"))
+}
+
 // TestURLAsObjectName tests the ability to have a URL as an object name
 func TestURLAsObjectName(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset()
@@ -0,0 +1,146 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+// HelperName is the binary name the repo server runs itself with to start a sandboxed command
+const HelperName = "argocd-sandbox"
+
+const (
+	// binaryNameEnv is the variable of the environment selecting the command of the Argo CD binary
+	binaryNameEnv = "ARGOCD_BINARY_NAME"
+	// configEnv is the variable of the environment holding the configuration of the sandbox of a command
+	configEnv = "ARGOCD_SANDBOX_CONFIG"
+	// helperFailureExitCode is the exit code of the helper if the sandbox of the command cannot be set up
+	helperFailureExitCode = 126
+)
+
+// secretEnvPrefixes are the prefixes of the variables of the repo server environment which are not passed to the
+// sandboxed commands
+var secretEnvPrefixes = []string{"ARGOCD_REPO_SERVER_", "ARGOCD_REPO_CACHE_", "REDIS_"}
+
+// Sandbox runs the commands generating the manifests of an application in their own user, mount and network
+// namespaces. The directories holding the checkouts and credentials of the other repositories are hidden from the
+// commands, which run without capabilities and with a seccomp filter denying the system calls which could be used to
+// leave the sandbox. The commands have no network access unless their tool is allowed to.
+type Sandbox struct {
+	hiddenDirs   []string
+	networkTools map[string]bool
+}
+
+// NewSandbox returns a sandbox hiding the given directories from the commands, except the files and directories the
+// commands need to access. The commands of the given tools (helm, kustomize, helmfile, ytt, jb, sops, ksonnet or the
+// name of a config management plugin) keep network access. No sandbox is returned if it is not enabled.
+func NewSandbox(enabled bool, hiddenDirs []string, networkTools []string) (*Sandbox, error) {
+	if !enabled {
+		return nil, nil
+	}
+	s := &Sandbox{networkTools: map[string]bool{}}
+	for _, dir := range hiddenDirs {
+		if !filepath.IsAbs(dir) || filepath.Clean(dir) == "/" {
+			return nil, fmt.Errorf("sandbox hidden directory %s must be an absolute path other than /", dir)
+		}
+		s.hiddenDirs = append(s.hiddenDirs, filepath.Clean(dir))
+	}
+	for _, tool := range networkTools {
+		s.networkTools[tool] = true
+	}
+	return s, nil
+}
+
+// Runner returns a copy of the given runner sandboxing the commands of the given tool. The commands can access the
+// given files and directories, e.g. the checkout of the repository of the application, in addition to the ones which
+// are not hidden. The runner is returned as is if there is no sandbox.
+func (s *Sandbox) Runner(runner *executil.Runner, tool string, paths ...string) *executil.Runner {
+	if s == nil {
+		return runner
+	}
+	network := s.networkTools[tool]
+	return runner.WithPrepare(func(cmd *exec.Cmd, paths []string) error {
+		return s.prepare(cmd, paths, network)
+	}, paths...)
+}
+
+// config is the configuration of the sandbox of a command, passed to the helper in its environment
+type config struct {
+	// Path is the path of the executable of the command
+	Path string `json:"path"`
+	// Hidden are the directories hidden from the command
+	Hidden []string `json:"hidden,omitempty"`
+	// Exposed are the files and directories of the hidden directories the command can access
+	Exposed []string `json:"exposed,omitempty"`
+}
+
+// prepare makes the command start the helper, which sets up the sandbox and then runs the command
+func (s *Sandbox) prepare(cmd *exec.Cmd, paths []string, network bool) error {
+	if cmd.Err != nil {
+		return cmd.Err
+	}
+	cfg := config{Path: cmd.Path, Hidden: s.hiddenDirs}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		cfg.Exposed = append(cfg.Exposed, abs)
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	cmd.Env = append(FilterEnv(env), binaryNameEnv+"="+HelperName, configEnv+"="+string(data))
+	cmd.Path = "/proc/self/exe"
+	return isolate(cmd, network)
+}
+
+// FilterEnv removes the variables holding secrets of the repo server from the given environment
+func FilterEnv(env []string) []string {
+	var res []string
+	for _, v := range env {
+		secret := false
+		for _, prefix := range secretEnvPrefixes {
+			if strings.HasPrefix(v, prefix) {
+				secret = true
+				break
+			}
+		}
+		if !secret {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Main sets up the sandbox configured by the environment, once the process has been started in its namespaces by the
+// repo server, and then runs the sandboxed command. It does not return.
+func Main() {
+	var cfg config
+	err := json.Unmarshal([]byte(os.Getenv(configEnv)), &cfg)
+	if err == nil {
+		var env []string
+		for _, v := range os.Environ() {
+			if !strings.HasPrefix(v, configEnv+"=") && !strings.HasPrefix(v, binaryNameEnv+"=") {
+				env = append(env, v)
+			}
+		}
+		// only returns if the command could not be run
+		err = run(cfg, os.Args, env)
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "%s: %v\n", HelperName, err)
+	os.Exit(helperFailureExitCode)
+}
@@ -0,0 +1,263 @@
+//go:build linux
+// +build linux
+
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	secbitNoroot                  = 1 << 0
+	secbitNorootLocked            = 1 << 1
+	secbitNoSetuidFixup           = 1 << 2
+	secbitNoSetuidFixupLocked     = 1 << 3
+	secbitKeepCapsLocked          = 1 << 5
+	secbitNoCapAmbientRaise       = 1 << 6
+	secbitNoCapAmbientRaiseLocked = 1 << 7
+
+	openTreeClone       = 1
+	moveMountFEmptyPath = 4
+)
+
+// atFdcwd is AT_FDCWD, as a variable so that it can be converted to the unsigned argument of a system call
+var atFdcwd = unix.AT_FDCWD
+
+// isolate starts the command in its own user and mount namespaces, in which the helper is root and can set up the
+// mounts hiding the directories of the sandbox, and in its own network namespace without interfaces unless network
+// access is allowed. Neither privileges nor capabilities are required in the namespace of the repo server.
+func isolate(cmd *exec.Cmd, network bool) error {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	attr := cmd.SysProcAttr
+	attr.Cloneflags |= syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS
+	if !network {
+		attr.Cloneflags |= syscall.CLONE_NEWNET
+	}
+	attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}}
+	attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}}
+	attr.GidMappingsEnableSetgroups = false
+	attr.Pdeathsig = syscall.SIGKILL
+	return nil
+}
+
+// run sets up the sandbox and executes the command, it only returns if the command could not be run
+func run(cfg config, args []string, env []string) error {
+	// capabilities, securebits, no_new_privs and seccomp filters are attributes of threads, so they are set on the
+	// thread executing the command
+	runtime.LockOSThread()
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := mount(cfg); err != nil {
+		return err
+	}
+	// the working directory is entered again, so that it is resolved through the mounts of the sandbox rather than
+	// giving access to the hidden directories
+	if err := os.Chdir(wd); err != nil {
+		return err
+	}
+	if err := dropPrivileges(); err != nil {
+		return err
+	}
+	return syscall.Exec(cfg.Path, args, env)
+}
+
+// exposedPath is a file or directory of a hidden directory which is mounted back into the sandbox
+type exposedPath struct {
+	path  string
+	fd    int
+	isDir bool
+}
+
+// mount hides the directories of the sandbox with empty file systems, and mounts the exposed paths back. /proc is
+// hidden as well, except the directory of the sandboxed process, since it gives access to the files of the other
+// processes of the container through /proc/<pid>/root.
+func mount(cfg config) error {
+	// the mounts of the sandbox are not propagated to the namespace of the repo server
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to set up the mounts of the sandbox, user namespaces might not be allowed: %v", err)
+	}
+	hidden := topLevelDirs(cfg.Hidden)
+
+	// the exposed paths are opened before they are hidden, and mounted back from their file descriptors
+	var exposed []exposedPath
+	for _, path := range cfg.Exposed {
+		if !isWithin(path, hidden) {
+			continue
+		}
+		fd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to open %s: %v", path, err)
+		}
+		defer func() { _ = unix.Close(fd) }()
+		var stat unix.Stat_t
+		if err := unix.Fstat(fd, &stat); err != nil {
+			return err
+		}
+		exposed = append(exposed, exposedPath{path: path, fd: fd, isDir: stat.Mode&unix.S_IFMT == unix.S_IFDIR})
+	}
+	proc, err := openTree("/proc/self")
+	if err != nil {
+		return fmt.Errorf("failed to open /proc/self: %v", err)
+	}
+	if proc >= 0 {
+		defer func() { _ = unix.Close(proc) }()
+	}
+
+	for _, dir := range hidden {
+		if err := unix.Mount("tmpfs", dir, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+			return fmt.Errorf("failed to hide %s: %v", dir, err)
+		}
+	}
+	// parent directories are mounted first, so that they do not hide their exposed children
+	sort.Slice(exposed, func(i, j int) bool {
+		return len(exposed[i].path) < len(exposed[j].path)
+	})
+	for _, p := range exposed {
+		if err := createMountPoint(p); err != nil {
+			return err
+		}
+		if err := unix.Mount(fmt.Sprintf("/proc/self/fd/%d", p.fd), p.path, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return fmt.Errorf("failed to expose %s: %v", p.path, err)
+		}
+	}
+	return hideProc(proc)
+}
+
+// hideProc replaces /proc by a file system only holding the directory of the current process, which becomes the one of
+// the command, given the detached mount of this directory. /proc is left empty if the detached mount is not available.
+func hideProc(proc int) error {
+	if err := unix.Mount("tmpfs", "/proc", "tmpfs", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "mode=555"); err != nil {
+		return fmt.Errorf("failed to hide /proc: %v", err)
+	}
+	if proc < 0 {
+		return nil
+	}
+	pid := strconv.Itoa(os.Getpid())
+	dir := filepath.Join("/proc", pid)
+	if err := os.Mkdir(dir, 0555); err != nil {
+		return err
+	}
+	if err := moveMount(proc, dir); err != nil {
+		return fmt.Errorf("failed to mount %s: %v", dir, err)
+	}
+	return os.Symlink(pid, "/proc/self")
+}
+
+// openTree returns a detached bind mount of the given path, or -1 if detached mounts are not supported by the kernel
+func openTree(path string) (int, error) {
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return -1, err
+	}
+	fd, _, errno := unix.Syscall(unix.SYS_OPEN_TREE, uintptr(atFdcwd), uintptr(unsafe.Pointer(p)), uintptr(openTreeClone|unix.O_CLOEXEC))
+	if errno == unix.ENOSYS {
+		return -1, nil
+	} else if errno != 0 {
+		return -1, errno
+	}
+	return int(fd), nil
+}
+
+// moveMount attaches the given detached mount to the given path
+func moveMount(fd int, path string) error {
+	empty, err := unix.BytePtrFromString("")
+	if err != nil {
+		return err
+	}
+	p, err := unix.BytePtrFromString(path)
+	if err != nil {
+		return err
+	}
+	_, _, errno := unix.Syscall6(unix.SYS_MOVE_MOUNT, uintptr(fd), uintptr(unsafe.Pointer(empty)), uintptr(atFdcwd), uintptr(unsafe.Pointer(p)), moveMountFEmptyPath, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// createMountPoint creates the file or directory the exposed path is mounted on in its hidden directory
+func createMountPoint(p exposedPath) error {
+	if p.isDir {
+		return os.MkdirAll(p.path, 0755)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.path), 0755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(p.path, os.O_CREATE|os.O_RDONLY, 0600)
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// dropPrivileges drops the capabilities of the current thread, so that they cannot be regained by executing a
+// program, even as the root user of the user namespace, and restricts its system calls
+func dropPrivileges() error {
+	securebits := secbitNoroot | secbitNorootLocked | secbitNoSetuidFixup | secbitNoSetuidFixupLocked | secbitKeepCapsLocked | secbitNoCapAmbientRaise | secbitNoCapAmbientRaiseLocked
+	if err := unix.Prctl(unix.PR_SET_SECUREBITS, uintptr(securebits), 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set the securebits: %v", err)
+	}
+	for capability := 0; ; capability++ {
+		// the capabilities are dropped from the bounding set until the last one supported by the kernel
+		if err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(capability), 0, 0, 0); err == unix.EINVAL {
+			break
+		} else if err != nil {
+			return fmt.Errorf("failed to drop capability %d from the bounding set: %v", capability, err)
+		}
+	}
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to clear the ambient capabilities: %v", err)
+	}
+	var data [2]unix.CapUserData
+	if err := unix.Capset(&unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}, &data[0]); err != nil {
+		return fmt.Errorf("failed to drop the capabilities: %v", err)
+	}
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set no_new_privs: %v", err)
+	}
+	return installSeccompFilter()
+}
+
+// topLevelDirs returns the existing directories of the given ones which are not within another one
+func topLevelDirs(dirs []string) []string {
+	sorted := append([]string{}, dirs...)
+	sort.Strings(sorted)
+	var res []string
+	for _, dir := range sorted {
+		if isWithin(dir, res) {
+			continue
+		}
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		res = append(res, dir)
+	}
+	return res
+}
+
+// isWithin returns whether the given path is one of the given directories or is within one of them
+func isWithin(path string, dirs []string) bool {
+	for _, dir := range dirs {
+		if path == dir || strings.HasPrefix(path, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,171 @@
+package sandbox
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+// podSecurityContextEnv is set when the tests run with the security context of the repo server container
+const podSecurityContextEnv = "ARGOCD_SANDBOX_TEST_POD_SECURITY_CONTEXT"
+
+// userNamespacesAllowed returns whether the current process is allowed to create user namespaces
+func userNamespacesAllowed() bool {
+	cmd := exec.Command("true")
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags:                 syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS,
+		UidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}},
+		GidMappings:                []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}},
+		GidMappingsEnableSetgroups: false,
+	}
+	return cmd.Run() == nil
+}
+
+func TestSandbox_Runner(t *testing.T) {
+	if !userNamespacesAllowed() {
+		if os.Getenv(podSecurityContextEnv) != "" {
+			t.Fatal("user namespaces are not allowed with the security context of the repo server container")
+		}
+		t.Skip("user namespaces are not allowed")
+	}
+	if os.Getenv(podSecurityContextEnv) != "" {
+		require.NotZero(t, os.Getuid())
+	}
+	hidden := t.TempDir()
+	repo := filepath.Join(hidden, "repo")
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, "app"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repo, "app", "values.yaml"), []byte("app values\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(repo, "common.yaml"), []byte("common values\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(hidden, "other"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(hidden, "other", "values.yaml"), []byte("other values\n"), 0644))
+	creds := filepath.Join(hidden, "creds")
+	require.NoError(t, ioutil.WriteFile(creds, []byte("creds\n"), 0600))
+
+	s, err := NewSandbox(true, []string{hidden}, []string{"kustomize"})
+	require.NoError(t, err)
+	runner := s.Runner(executil.NewRunner(context.Background()), "helm", repo).WithPaths(creds)
+	run := func(runner *executil.Runner, script string) (string, error) {
+		cmd := exec.Command("sh", "-c", script)
+		cmd.Dir = filepath.Join(repo, "app")
+		cmd.Env = append(os.Environ(), "REDIS_PASSWORD=secret")
+		return runner.Run(cmd)
+	}
+
+	t.Run("ExposedPaths", func(t *testing.T) {
+		out, err := run(runner, "cat values.yaml ../common.yaml "+creds+" && echo written > ../generated.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "app values\ncommon values\ncreds", out)
+		assert.FileExists(t, filepath.Join(repo, "generated.yaml"))
+	})
+	t.Run("HiddenPaths", func(t *testing.T) {
+		out, err := run(runner, "ls "+hidden)
+		require.NoError(t, err)
+		assert.Equal(t, "creds\nrepo", out)
+		_, err = run(runner, "cat ../../other/values.yaml")
+		assert.Error(t, err)
+	})
+	t.Run("Environment", func(t *testing.T) {
+		out, err := run(runner, `echo "${REDIS_PASSWORD:-none} ${ARGOCD_SANDBOX_CONFIG:-none}"`)
+		require.NoError(t, err)
+		assert.Equal(t, "none none", out)
+	})
+	t.Run("Proc", func(t *testing.T) {
+		out, err := run(runner, "ls /proc && test -r /proc/self/status")
+		require.NoError(t, err)
+		// only the directory of the command and the self link
+		assert.Len(t, strings.Fields(out), 2)
+	})
+	t.Run("Network", func(t *testing.T) {
+		ns, err := os.Readlink("/proc/self/ns/net")
+		require.NoError(t, err)
+		out, err := run(runner, "readlink /proc/self/ns/net")
+		require.NoError(t, err)
+		assert.NotEqual(t, ns, out)
+		out, err = run(s.Runner(nil, "kustomize", repo), "readlink /proc/self/ns/net")
+		require.NoError(t, err)
+		assert.Equal(t, ns, out)
+	})
+	t.Run("Privileges", func(t *testing.T) {
+		out, err := run(runner, "grep -E '^(CapInh|CapPrm|CapEff|CapBnd|CapAmb|NoNewPrivs|Seccomp):' /proc/self/status")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"CapInh: 0000000000000000", "CapPrm: 0000000000000000", "CapEff: 0000000000000000", "CapBnd: 0000000000000000", "CapAmb: 0000000000000000", "NoNewPrivs: 1", "Seccomp: 2"}, strings.Split(strings.ReplaceAll(out, "\t", " "), "\n"))
+		if _, err := exec.LookPath("unshare"); err == nil {
+			_, err = run(runner, "unshare --user --map-root-user true")
+			assert.Error(t, err)
+		}
+	})
+	t.Run("CommandFailure", func(t *testing.T) {
+		_, err := run(runner, "exit 3")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exit status 3")
+	})
+}
+
+// TestSandbox_PodSecurityContext runs TestSandbox_Runner with the security context of the repo server container of the
+// manifests: as the argocd user, without capabilities and without privilege escalation
+func TestSandbox_PodSecurityContext(t *testing.T) {
+	if os.Getenv(podSecurityContextEnv) != "" {
+		t.Skip("already running with the security context of the repo server container")
+	}
+	if os.Getuid() != 0 {
+		t.Skip("running as the argocd user requires root")
+	}
+	if !userNamespacesAllowed() {
+		t.Skip("user namespaces are not allowed")
+	}
+	const argocdUID = 999
+	dir, err := ioutil.TempDir("", "sandbox")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	require.NoError(t, os.Chmod(dir, 0755))
+	tmp := filepath.Join(dir, "tmp")
+	require.NoError(t, os.Mkdir(tmp, 0755))
+	require.NoError(t, os.Chown(tmp, argocdUID, argocdUID))
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(exe)
+	require.NoError(t, err)
+	testBinary := filepath.Join(dir, "sandbox.test")
+	require.NoError(t, ioutil.WriteFile(testBinary, data, 0755))
+
+	cmd := exec.Command(testBinary, "-test.run", "^TestSandbox_Runner$", "-test.v")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "TMPDIR="+tmp, "HOME="+tmp, podSecurityContextEnv+"=true")
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: argocdUID, Gid: argocdUID}}
+	type result struct {
+		out []byte
+		err error
+	}
+	res := make(chan result)
+	go func() {
+		// capabilities and no_new_privs are attributes of the thread starting the test binary, which is not unlocked
+		// so that it exits with the goroutine
+		runtime.LockOSThread()
+		for capability := 0; ; capability++ {
+			if err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(capability), 0, 0, 0); err != nil {
+				break
+			}
+		}
+		if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+			res <- result{err: err}
+			return
+		}
+		out, err := cmd.CombinedOutput()
+		res <- result{out: out, err: err}
+	}()
+	r := <-res
+	require.NoError(t, r.err, string(r.out))
+	assert.Contains(t, string(r.out), "--- PASS: TestSandbox_Runner")
+}
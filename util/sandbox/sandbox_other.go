@@ -0,0 +1,17 @@
+//go:build !linux
+// +build !linux
+
+package sandbox
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+func isolate(_ *exec.Cmd, _ bool) error {
+	return fmt.Errorf("sandboxing commands is only supported on Linux")
+}
+
+func run(_ config, _ []string, _ []string) error {
+	return fmt.Errorf("sandboxing commands is only supported on Linux")
+}
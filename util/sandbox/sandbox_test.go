@@ -0,0 +1,65 @@
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+func TestMain(m *testing.M) {
+	// the sandboxed commands of the tests are started by the test binary acting as the helper
+	if os.Getenv(binaryNameEnv) == HelperName {
+		Main()
+	}
+	os.Exit(m.Run())
+}
+
+func TestNewSandbox(t *testing.T) {
+	s, err := NewSandbox(false, []string{"/tmp"}, []string{"helm"})
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+	runner := executil.NewRunner(context.Background())
+	assert.Same(t, runner, s.Runner(runner, "helm", "/tmp/repo"))
+
+	for _, dir := range []string{"tmp", "/", "/tmp/.."} {
+		_, err := NewSandbox(true, []string{dir}, nil)
+		assert.Error(t, err, dir)
+	}
+
+	s, err = NewSandbox(true, []string{"/tmp/", "/dev/shm"}, []string{"helm"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/tmp", "/dev/shm"}, s.hiddenDirs)
+}
+
+func TestFilterEnv(t *testing.T) {
+	env := []string{"HOME=/home/argocd", "REDIS_PASSWORD=secret", "ARGOCD_REPO_CACHE_ENCRYPTION_KEY=secret", "ARGOCD_REPO_SERVER_SOPS_AGE_KEY_FILE=/keys", "ARGOCD_APP_NAME=guestbook"}
+	assert.Equal(t, []string{"HOME=/home/argocd", "ARGOCD_APP_NAME=guestbook"}, FilterEnv(env))
+}
+
+func TestSandbox_prepare(t *testing.T) {
+	s, err := NewSandbox(true, []string{"/tmp"}, nil)
+	require.NoError(t, err)
+	cmd := exec.Command("sh", "-c", "true")
+	cmd.Env = []string{"HOME=/home/argocd", "REDIS_PASSWORD=secret"}
+	path := cmd.Path
+	err = s.prepare(cmd, []string{"/tmp/repo", "", "/tmp/values.yaml"}, false)
+	if err != nil {
+		// the sandbox is only supported on Linux
+		return
+	}
+	assert.Equal(t, "/proc/self/exe", cmd.Path)
+	assert.Equal(t, []string{"sh", "-c", "true"}, cmd.Args)
+	require.Len(t, cmd.Env, 3)
+	assert.Equal(t, []string{"HOME=/home/argocd", "ARGOCD_BINARY_NAME=argocd-sandbox"}, cmd.Env[:2])
+	var cfg config
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(cmd.Env[2], configEnv+"=")), &cfg))
+	assert.Equal(t, config{Path: path, Hidden: []string{"/tmp"}, Exposed: []string{"/tmp/repo", "/tmp/values.yaml"}}, cfg)
+}
@@ -0,0 +1,114 @@
+//go:build linux && (amd64 || arm64 || ppc64le || s390x)
+// +build linux
+// +build amd64 arm64 ppc64le s390x
+
+package sandbox
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	seccompRetKillProcess = 0x80000000
+	seccompRetErrno       = 0x00050000
+	seccompRetAllow       = 0x7fff0000
+
+	// offsets of the fields of struct seccomp_data
+	seccompDataNrOffset   = 0
+	seccompDataArchOffset = 4
+
+	namespaceFlags = unix.CLONE_NEWNS | unix.CLONE_NEWUTS | unix.CLONE_NEWIPC | unix.CLONE_NEWUSER | unix.CLONE_NEWPID | unix.CLONE_NEWNET | unix.CLONE_NEWCGROUP
+)
+
+// deniedSyscalls are the system calls the sandboxed commands cannot make, since they could be used to leave the
+// sandbox, e.g. by creating namespaces or changing mounts, to inspect other processes or to attack the kernel
+var deniedSyscalls = []uint32{
+	unix.SYS_ACCT,
+	unix.SYS_ADD_KEY,
+	unix.SYS_BPF,
+	unix.SYS_CHROOT,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_FSCONFIG,
+	unix.SYS_FSMOUNT,
+	unix.SYS_FSOPEN,
+	unix.SYS_FSPICK,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_KCMP,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEYCTL,
+	unix.SYS_MOUNT,
+	unix.SYS_MOVE_MOUNT,
+	unix.SYS_NAME_TO_HANDLE_AT,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_OPEN_TREE,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_PTRACE,
+	unix.SYS_QUOTACTL,
+	unix.SYS_REBOOT,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_SETNS,
+	unix.SYS_SWAPOFF,
+	unix.SYS_SWAPON,
+	unix.SYS_UMOUNT2,
+	unix.SYS_UNSHARE,
+	unix.SYS_USERFAULTFD,
+}
+
+func bpfStmt(code uint16, k uint32) unix.SockFilter {
+	return unix.SockFilter{Code: code, K: k}
+}
+
+func bpfJump(code uint16, k uint32, jt uint8, jf uint8) unix.SockFilter {
+	return unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k}
+}
+
+// seccompFilter returns the seccomp filter of the sandboxed commands. System calls of other architectures are not
+// allowed, since their numbers differ. clone is denied if it creates namespaces, and clone3, whose arguments cannot be
+// checked, is reported as not implemented so that the C libraries fall back to clone.
+func seccompFilter() []unix.SockFilter {
+	const (
+		load = unix.BPF_LD | unix.BPF_W | unix.BPF_ABS
+		jeq  = unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K
+		jge  = unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K
+		jset = unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K
+		ret  = unix.BPF_RET | unix.BPF_K
+	)
+	filter := []unix.SockFilter{
+		bpfStmt(load, seccompDataArchOffset),
+		bpfJump(jeq, auditArch, 1, 0),
+		bpfStmt(ret, seccompRetKillProcess),
+		bpfStmt(load, seccompDataNrOffset),
+	}
+	if x32SyscallBit != 0 {
+		filter = append(filter, bpfJump(jge, x32SyscallBit, 0, 1), bpfStmt(ret, seccompRetErrno|uint32(unix.EPERM)))
+	}
+	for _, nr := range deniedSyscalls {
+		filter = append(filter, bpfJump(jeq, nr, 0, 1), bpfStmt(ret, seccompRetErrno|uint32(unix.EPERM)))
+	}
+	return append(filter,
+		bpfJump(jeq, unix.SYS_CLONE3, 0, 1),
+		bpfStmt(ret, seccompRetErrno|uint32(unix.ENOSYS)),
+		bpfJump(jeq, unix.SYS_CLONE, 0, 3),
+		bpfStmt(load, cloneFlagsOffset),
+		bpfJump(jset, namespaceFlags, 0, 1),
+		bpfStmt(ret, seccompRetErrno|uint32(unix.EPERM)),
+		bpfStmt(ret, seccompRetAllow),
+	)
+}
+
+// installSeccompFilter restricts the system calls of the current thread and of the programs it executes
+func installSeccompFilter() error {
+	filter := seccompFilter()
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	if err := unix.Prctl(unix.PR_SET_SECCOMP, unix.SECCOMP_MODE_FILTER, uintptr(unsafe.Pointer(&prog)), 0, 0); err != nil {
+		return fmt.Errorf("failed to install the seccomp filter: %v", err)
+	}
+	return nil
+}
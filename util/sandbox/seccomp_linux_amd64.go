@@ -0,0 +1,10 @@
+package sandbox
+
+const (
+	// auditArch is the AUDIT_ARCH_X86_64 architecture of the system calls allowed by the seccomp filter
+	auditArch = 0xc000003e
+	// x32SyscallBit is set in the numbers of the system calls of the x32 ABI, which are denied
+	x32SyscallBit = 0x40000000
+	// cloneFlagsOffset is the offset in struct seccomp_data of the lower 32 bits of the flags argument of clone
+	cloneFlagsOffset = 16
+)
@@ -0,0 +1,10 @@
+package sandbox
+
+const (
+	// auditArch is the AUDIT_ARCH_PPC64LE architecture of the system calls allowed by the seccomp filter
+	auditArch = 0xc0000015
+	// x32SyscallBit is not used on ppc64le
+	x32SyscallBit = 0
+	// cloneFlagsOffset is the offset in struct seccomp_data of the lower 32 bits of the flags argument of clone
+	cloneFlagsOffset = 16
+)
@@ -0,0 +1,11 @@
+package sandbox
+
+const (
+	// auditArch is the AUDIT_ARCH_S390X architecture of the system calls allowed by the seccomp filter
+	auditArch = 0x80000016
+	// x32SyscallBit is not used on s390x
+	x32SyscallBit = 0
+	// cloneFlagsOffset is the offset in struct seccomp_data of the lower 32 bits of the flags argument of clone, which
+	// is the second argument on s390x, in big endian
+	cloneFlagsOffset = 28
+)
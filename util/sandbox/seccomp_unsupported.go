@@ -0,0 +1,13 @@
+//go:build linux && !amd64 && !arm64 && !ppc64le && !s390x
+// +build linux,!amd64,!arm64,!ppc64le,!s390x
+
+package sandbox
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func installSeccompFilter() error {
+	return fmt.Errorf("the seccomp filter of the sandbox is not supported on %s", runtime.GOARCH)
+}
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	oidc "github.com/coreos/go-oidc"
@@ -39,7 +40,11 @@ type SessionManager struct {
 	projectsLister                v1alpha1.AppProjectNamespaceLister
 	client                        *http.Client
 	prov                          oidcutil.Provider
+	providersLock                 sync.Mutex
+	providers                     map[string]oidcutil.Provider
 	storage                       UserStateStorage
+	tokenUsesLock                 sync.Mutex
+	tokenUses                     map[string]time.Time
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
 }
@@ -64,6 +69,8 @@ const (
 	usernameTooLongError        = "Username is too long (%d bytes max)"
 	userDoesNotHaveCapability   = "Account %s does not have %s capability"
 	autoRegenerateTokenDuration = time.Minute * 5
+	// tokenLastUsedUpdateInterval throttles how often the last usage time of an API token is persisted
+	tokenLastUsedUpdateInterval = time.Minute * 10
 )
 
 const (
@@ -119,6 +126,8 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 		sleep:                         time.Sleep,
 		projectsLister:                projectsLister,
 		verificationDelayNoiseEnabled: true,
+		providers:                     map[string]oidcutil.Provider{},
+		tokenUses:                     map[string]time.Time{},
 	}
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
@@ -232,6 +241,36 @@ func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCap
 	return subject, capability
 }
 
+// recordTokenUse asynchronously persists the time at which the API token was last used. Updates are throttled to
+// avoid rewriting the argocd-secret on every request.
+func (mgr *SessionManager) recordTokenUse(account string, token settings.Token) {
+	now := time.Now()
+	if now.Sub(time.Unix(token.LastUsedAt, 0)) < tokenLastUsedUpdateInterval {
+		return
+	}
+	key := account + ":" + token.ID
+	mgr.tokenUsesLock.Lock()
+	if lastUpdate, ok := mgr.tokenUses[key]; ok && now.Sub(lastUpdate) < tokenLastUsedUpdateInterval {
+		mgr.tokenUsesLock.Unlock()
+		return
+	}
+	// the uses recorded before the interval do not throttle the updates anymore, so they are pruned to bound the map
+	// to the tokens used during the interval
+	for k, lastUpdate := range mgr.tokenUses {
+		if now.Sub(lastUpdate) >= tokenLastUsedUpdateInterval {
+			delete(mgr.tokenUses, k)
+		}
+	}
+	mgr.tokenUses[key] = now
+	mgr.tokenUsesLock.Unlock()
+
+	go func() {
+		if err := mgr.settingsMgr.UpdateTokenLastUsedAt(account, token.ID, now.Unix()); err != nil {
+			log.Warnf("Failed to record the usage of token '%s' of account '%s': %v", token.ID, account, err)
+		}
+	}()
+}
+
 // Parse tries to parse the provided string and returns the token claims for local login.
 func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error) {
 	// Parse takes the token string and a function for looking up the key. The latter is especially
@@ -267,10 +306,14 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		if err != nil {
 			return nil, "", err
 		}
-		_, _, err = proj.GetJWTToken(role, issuedAt.Unix(), id)
+		projToken, _, err := proj.GetJWTToken(role, issuedAt.Unix(), id)
 		if err != nil {
 			return nil, "", err
 		}
+		// the expiration of the rotated tokens is shortened to their grace period
+		if projToken.ExpiresAt > 0 && time.Now().Unix() > projToken.ExpiresAt {
+			return nil, "", fmt.Errorf("JWT token '%s' of role '%s' has expired in project '%s'", projToken.ID, role, projName)
+		}
 
 		return token.Claims, "", nil
 	}
@@ -293,8 +336,12 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 
 	if id == "" || mgr.storage.IsTokenRevoked(id) {
 		return nil, "", errors.New("token is revoked, please re-login")
-	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
-		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
+	} else if capability == settings.AccountCapabilityApiKey {
+		index := account.TokenIndex(id)
+		if index == -1 {
+			return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
+		}
+		mgr.recordTokenUse(subject, account.Tokens[index])
 	}
 
 	if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
@@ -513,15 +560,19 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		return mgr.Parse(tokenString)
 	default:
 		// IDP signed token
-		prov, err := mgr.provider()
+		prov, oidcConfig, audiences, err := mgr.provider(claims.Issuer)
 		if err != nil {
 			return claims, "", err
 		}
 
-		// Token must be verified for at least one audience
-		// TODO(jannfis): Is this the right way? Shouldn't we know our audience and only validate for the correct one?
+		// The token must be issued to one of the clients of the provider, rather than to another client of the same
+		// identity provider
 		var idToken *oidc.IDToken
-		for _, aud := range claims.Audience {
+		err = fmt.Errorf("the token audience %v is not one of the clients of the issuer %s", []string(claims.Audience), claims.Issuer)
+		for _, aud := range audiences {
+			if !containsString(claims.Audience, aud) {
+				continue
+			}
 			idToken, err = prov.Verify(aud, tokenString)
 			if err == nil {
 				break
@@ -530,29 +581,80 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, string,
 		if err != nil {
 			return claims, "", err
 		}
-		if idToken == nil {
-			return claims, "", fmt.Errorf("No audience found in the token")
-		}
 
 		var claims jwt.MapClaims
 		err = idToken.Claims(&claims)
+		if err == nil && oidcConfig != nil {
+			mapGroupsClaim(claims, oidcConfig.GroupsClaim)
+		}
 		return claims, "", err
 	}
 }
 
-func (mgr *SessionManager) provider() (oidcutil.Provider, error) {
-	if mgr.prov != nil {
-		return mgr.prov, nil
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
 	}
+	return false
+}
+
+// provider returns the OIDC provider of the tokens issued by the given issuer, either one of the additional OIDC
+// providers or the Dex or OIDC provider of oidc.config, along with its config which is nil for Dex and the audiences
+// of its tokens, i.e. the IDs of its clients
+func (mgr *SessionManager) provider(issuer string) (oidcutil.Provider, *settings.OIDCConfig, []string, error) {
 	settings, err := mgr.settingsMgr.GetSettings()
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
 	if !settings.IsSSOConfigured() {
-		return nil, fmt.Errorf("SSO is not configured")
+		return nil, nil, nil, fmt.Errorf("SSO is not configured")
+	}
+	if config := settings.OIDCProviderByIssuer(issuer); config != nil {
+		mgr.providersLock.Lock()
+		defer mgr.providersLock.Unlock()
+		prov, ok := mgr.providers[config.Issuer]
+		if !ok {
+			prov = oidcutil.NewOIDCProvider(config.Issuer, mgr.client)
+			mgr.providers[config.Issuer] = prov
+		}
+		return prov, config, clientIDs(config.ClientID, config.CLIClientID), nil
+	}
+	if issuer != settings.IssuerURL() {
+		if err := settings.OIDCProvidersError(); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid OIDC providers config: %v", err)
+		}
+	}
+	if mgr.prov == nil {
+		mgr.prov = oidcutil.NewOIDCProvider(settings.IssuerURL(), mgr.client)
+	}
+	oidcConfig := settings.OIDCConfig()
+	if oidcConfig == nil {
+		return mgr.prov, nil, clientIDs(common.ArgoCDClientAppID, common.ArgoCDCLIClientAppID), nil
+	}
+	return mgr.prov, oidcConfig, clientIDs(oidcConfig.ClientID, oidcConfig.CLIClientID), nil
+}
+
+// clientIDs returns the given client IDs which are set
+func clientIDs(ids ...string) []string {
+	var res []string
+	for _, id := range ids {
+		if id != "" {
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// mapGroupsClaim copies the groups of the user from the given claim to the groups claim, used by RBAC by default
+func mapGroupsClaim(claims jwt.MapClaims, groupsClaim string) {
+	if groupsClaim == "" || groupsClaim == "groups" {
+		return
+	}
+	if groups, ok := claims[groupsClaim]; ok {
+		claims["groups"] = groups
 	}
-	mgr.prov = oidcutil.NewOIDCProvider(settings.IssuerURL(), mgr.client)
-	return mgr.prov, nil
 }
 
 func (mgr *SessionManager) RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error {
@@ -601,6 +703,15 @@ func Sub(ctx context.Context) string {
 	return jwtutil.StringField(mapClaims, "sub")
 }
 
+// ID returns the unique identifier (jti claim) of the token of the request, if any
+func ID(ctx context.Context) string {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return ""
+	}
+	return jwtutil.StringField(mapClaims, "jti")
+}
+
 func Groups(ctx context.Context, scopes []string) []string {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/go-oidc"
 	"github.com/dgrijalva/jwt-go/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	oidcutil "github.com/argoproj/argo-cd/v2/util/oidc"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
@@ -173,6 +175,50 @@ func TestSessionManager_AdminToken_LoginCapabilityDisabled(t *testing.T) {
 	assert.Contains(t, err.Error(), "account admin does not have 'apiKey' capability")
 }
 
+func TestSessionManager_APIToken_RecordsLastUse(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	err := settingsMgr.AddAccount("user1", settings.Account{
+		Enabled:      true,
+		Capabilities: []settings.AccountCapability{settings.AccountCapabilityApiKey},
+		Tokens:       []settings.Token{{ID: "abc", IssuedAt: time.Now().Unix()}},
+	})
+	require.NoError(t, err)
+	token, err := mgr.Create("user1:apiKey", 0, "abc")
+	require.NoError(t, err)
+
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		account, err := settingsMgr.GetAccount("user1")
+		return err == nil && account.Tokens[0].LastUsedAt > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// subsequent uses are throttled
+	mgr.tokenUsesLock.Lock()
+	lastUpdate := mgr.tokenUses["user1:abc"]
+	mgr.tokenUsesLock.Unlock()
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	mgr.tokenUsesLock.Lock()
+	assert.Equal(t, lastUpdate, mgr.tokenUses["user1:abc"])
+	mgr.tokenUsesLock.Unlock()
+}
+
+func TestSessionManager_RecordTokenUse_PrunesOldUses(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+	mgr.tokenUses["user1:old"] = time.Now().Add(-2 * tokenLastUsedUpdateInterval)
+
+	mgr.recordTokenUse("user1", settings.Token{ID: "abc"})
+
+	mgr.tokenUsesLock.Lock()
+	defer mgr.tokenUsesLock.Unlock()
+	assert.Len(t, mgr.tokenUses, 1)
+	assert.Contains(t, mgr.tokenUses, "user1:abc")
+}
+
 func TestSessionManager_ProjectToken(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
 
@@ -198,6 +244,29 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Token Expired In Project", func(t *testing.T) {
+		proj := appv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "argocd",
+			},
+			Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "test"}}},
+			Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+				"test": {
+					Items: []appv1.JWTToken{{ID: "abc", IssuedAt: time.Now().Unix(), ExpiresAt: time.Now().Add(-time.Minute).Unix()}},
+				},
+			}},
+		}
+		mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+
+		jwtToken, err := mgr.Create("proj:default:test", 100, "abc")
+		require.NoError(t, err)
+
+		_, _, err = mgr.Parse(jwtToken)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has expired in project 'default'")
+	})
+
 	t.Run("Token Revoked", func(t *testing.T) {
 		proj := appv1.AppProject{
 			ObjectMeta: metav1.ObjectMeta{
@@ -224,10 +293,138 @@ var loggedOutContext = context.Background()
 // nolint:staticcheck
 var loggedInContext = context.WithValue(context.Background(), "claims", &jwt.MapClaims{"iss": "qux", "sub": "foo", "email": "bar", "groups": []string{"baz"}})
 
+func TestSessionManager_Provider(t *testing.T) {
+	kubeClient := getKubeClient("pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["url"] = "https://argocd.example.com"
+	cm.Data["oidc.config"] = `
+name: Corporate
+issuer: https://sso.example.com
+clientID: argocd`
+	cm.Data["oidc.providers"] = `
+- name: customer
+  issuer: https://idp.customer.com
+  clientID: argocd
+  groupsClaim: roles`
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	mgr := newSessionManager(settings.NewSettingsManager(context.Background(), kubeClient, "argocd"), getProjLister(), NewUserStateStorage(nil))
+
+	prov, config, audiences, err := mgr.provider("https://sso.example.com")
+	require.NoError(t, err)
+	assert.NotNil(t, prov)
+	assert.Equal(t, "Corporate", config.Name)
+	assert.Equal(t, []string{"argocd"}, audiences)
+
+	customerProv, config, _, err := mgr.provider("https://idp.customer.com")
+	require.NoError(t, err)
+	assert.NotSame(t, prov, customerProv)
+	assert.Equal(t, "customer", config.Name)
+	assert.Equal(t, "roles", config.GroupsClaim)
+
+	sameProv, _, _, err := mgr.provider("https://idp.customer.com")
+	require.NoError(t, err)
+	assert.Same(t, customerProv, sameProv)
+}
+
+func TestSessionManager_ProviderInvalidConfig(t *testing.T) {
+	kubeClient := getKubeClient("pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["url"] = "https://argocd.example.com"
+	cm.Data["oidc.config"] = `
+name: Corporate
+issuer: https://sso.example.com
+clientID: argocd`
+	cm.Data["oidc.providers"] = `
+- name: customer
+  clientID: argocd`
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	mgr := newSessionManager(settings.NewSettingsManager(context.Background(), kubeClient, "argocd"), getProjLister(), NewUserStateStorage(nil))
+
+	_, _, _, err = mgr.provider("https://idp.customer.com")
+	assert.EqualError(t, err, "invalid OIDC providers config: OIDC provider 'customer' has no issuer")
+
+	_, _, _, err = mgr.provider("https://sso.example.com")
+	assert.NoError(t, err)
+}
+
+// fakeOIDCProvider records the client IDs the tokens are verified for, and fails to verify them
+type fakeOIDCProvider struct {
+	oidcutil.Provider
+	clientIDs []string
+}
+
+func (p *fakeOIDCProvider) Verify(clientID, _ string) (*oidc.IDToken, error) {
+	p.clientIDs = append(p.clientIDs, clientID)
+	return nil, fmt.Errorf("invalid signature")
+}
+
+func TestSessionManager_VerifyTokenAudience(t *testing.T) {
+	kubeClient := getKubeClient("pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), "argocd-cm", metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["url"] = "https://argocd.example.com"
+	cm.Data["oidc.config"] = `
+name: Corporate
+issuer: https://sso.example.com
+clientID: argocd
+cliClientID: argocd-cli`
+	cm.Data["oidc.providers"] = `
+- name: customer
+  issuer: https://idp.customer.com
+  clientID: argocd-customer`
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	mgr := newSessionManager(settings.NewSettingsManager(context.Background(), kubeClient, "argocd"), getProjLister(), NewUserStateStorage(nil))
+	prov := &fakeOIDCProvider{}
+	mgr.prov = prov
+	customerProv := &fakeOIDCProvider{}
+	mgr.providers["https://idp.customer.com"] = customerProv
+
+	token := func(issuer string, audience ...string) string {
+		tokenString, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{Issuer: issuer, Subject: "alice", Audience: audience}).SignedString([]byte("key"))
+		require.NoError(t, err)
+		return tokenString
+	}
+
+	// tokens issued to another client of the identity provider are rejected without being verified
+	_, _, err = mgr.VerifyToken(token("https://sso.example.com", "other-client"))
+	assert.EqualError(t, err, "the token audience [other-client] is not one of the clients of the issuer https://sso.example.com")
+	_, _, err = mgr.VerifyToken(token("https://idp.customer.com", "argocd"))
+	assert.Error(t, err)
+	assert.Empty(t, prov.clientIDs)
+	assert.Empty(t, customerProv.clientIDs)
+
+	// tokens are only verified for the clients of their issuer
+	_, _, err = mgr.VerifyToken(token("https://sso.example.com", "other-client", "argocd-cli"))
+	assert.EqualError(t, err, "invalid signature")
+	assert.Equal(t, []string{"argocd-cli"}, prov.clientIDs)
+	_, _, err = mgr.VerifyToken(token("https://idp.customer.com", "argocd-customer", "argocd"))
+	assert.EqualError(t, err, "invalid signature")
+	assert.Equal(t, []string{"argocd-customer"}, customerProv.clientIDs)
+}
+
+func TestMapGroupsClaim(t *testing.T) {
+	claims := jwt.MapClaims{"groups": []interface{}{"a"}, "roles": []interface{}{"b"}}
+	mapGroupsClaim(claims, "")
+	assert.Equal(t, []interface{}{"a"}, claims["groups"])
+
+	mapGroupsClaim(claims, "roles")
+	assert.Equal(t, []interface{}{"b"}, claims["groups"])
+
+	claims = jwt.MapClaims{"groups": []interface{}{"a"}}
+	mapGroupsClaim(claims, "roles")
+	assert.Equal(t, []interface{}{"a"}, claims["groups"])
+}
+
 func TestIss(t *testing.T) {
 	assert.Empty(t, Iss(loggedOutContext))
 	assert.Equal(t, "qux", Iss(loggedInContext))
 }
+
 func TestLoggedIn(t *testing.T) {
 	assert.False(t, LoggedIn(loggedOutContext))
 	assert.True(t, LoggedIn(loggedInContext))
@@ -1,6 +1,7 @@
 package settings
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/v2/common"
 )
@@ -45,6 +48,8 @@ type Token struct {
 	ID        string `json:"id"`
 	IssuedAt  int64  `json:"iat"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	// LastUsedAt is the approximate time at which the token was last used, see SessionManager
+	LastUsedAt int64 `json:"lastUsedAt,omitempty"`
 }
 
 // Account holds local account information
@@ -140,6 +145,46 @@ func (mgr *SettingsManager) UpdateAccount(name string, callback func(account *Ac
 	return mgr.saveAccount(name, *account)
 }
 
+// accountTokensKey returns the key of the tokens of the account with the given name in the argocd-secret
+func accountTokensKey(name string) string {
+	if name == common.ArgoCDAdminUsername {
+		return settingAdminTokensKey
+	}
+	return fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix)
+}
+
+// UpdateTokenLastUsedAt records the time at which the token with the given ID of the account with the given name was
+// last used. Unlike UpdateAccount, only the tokens of the account are updated, from the latest argocd-secret rather
+// than the informer cache, and the update is retried if the secret changed in the meantime, so that a deleted token
+// is never restored. Nothing is updated if the token does not exist anymore.
+func (mgr *SettingsManager) UpdateTokenLastUsedAt(name string, id string, lastUsedAt int64) error {
+	key := accountTokensKey(name)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		var tokens []Token
+		if data := secret.Data[key]; len(data) > 0 {
+			if err := json.Unmarshal(data, &tokens); err != nil {
+				return err
+			}
+		}
+		index := (&Account{Tokens: tokens}).TokenIndex(id)
+		if index == -1 || tokens[index].LastUsedAt >= lastUsedAt {
+			return nil
+		}
+		tokens[index].LastUsedAt = lastUsedAt
+		data, err := json.Marshal(tokens)
+		if err != nil {
+			return err
+		}
+		secret.Data[key] = data
+		_, err = mgr.clientset.CoreV1().Secrets(mgr.namespace).Update(context.Background(), secret, metav1.UpdateOptions{})
+		return err
+	})
+}
+
 // GetAccounts returns list of configured accounts
 func (mgr *SettingsManager) GetAccounts() (map[string]Account, error) {
 	err := mgr.ensureSynced(false)
@@ -192,7 +237,7 @@ func saveAccount(secret *v1.Secret, cm *v1.ConfigMap, name string, account Accou
 	} else {
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordSuffix), account.PasswordHash, "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordMtimeSuffix), account.FormatPasswordMtime(), "")
-		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix), string(tokens), "[]")
+		updateAccountSecret(secret, accountTokensKey(name), string(tokens), "[]")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountEnabledSuffix), strconv.FormatBool(account.Enabled), "true")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s", accountsKeyPrefix, name), account.FormatCapabilities(), "")
 	}
@@ -224,6 +224,28 @@ func TestUpdateAccount_UpdateAdminPassword(t *testing.T) {
 	assert.Equal(t, mTime.Format(time.RFC3339), string(secret.Data["admin.passwordMtime"]))
 }
 
+func TestUpdateTokenLastUsedAt(t *testing.T) {
+	kubeClient, settingsManager := fixtures(map[string]string{"accounts.test": "apiKey"}, func(secret *v1.Secret) {
+		secret.Data["accounts.test.tokens"] = []byte(`[{"id":"abc","iat":1583789194},{"id":"def","iat":1583789194}]`)
+	})
+	_, err := settingsManager.GetAccounts()
+	assert.NoError(t, err)
+
+	// the token is deleted while the informer cache still has it
+	secret, err := kubeClient.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	secret.Data["accounts.test.tokens"] = []byte(`[{"id":"def","iat":1583789194}]`)
+	_, err = kubeClient.CoreV1().Secrets("default").Update(context.Background(), secret, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, settingsManager.UpdateTokenLastUsedAt("test", "abc", 1583789200))
+	assert.NoError(t, settingsManager.UpdateTokenLastUsedAt("test", "def", 1583789200))
+
+	secret, err = kubeClient.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"id":"def","iat":1583789194,"lastUsedAt":1583789200}]`, string(secret.Data["accounts.test.tokens"]))
+}
+
 func TestUpdateAccount_AccountDoesNotExist(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
 
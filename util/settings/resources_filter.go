@@ -1,5 +1,7 @@
 package settings
 
+import "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+
 // The core exclusion list are K8s resources that we assume will never be managed by operators,
 // and are never child objects of managed resources that need to be presented in the resource tree.
 // This list contains high volume and  high churn metadata objects which we exclude for performance
@@ -84,3 +86,24 @@ func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bo
 	// if no inclusion rules defined for cluster, default is allow
 	return false
 }
+
+// ForCluster returns a filter combining the resource exclusions and inclusions of the settings with the ones of the
+// given cluster. The returned filter must only be used for resources of that cluster.
+func (rf *ResourcesFilter) ForCluster(cluster *v1alpha1.Cluster) *ResourcesFilter {
+	if len(cluster.ResourceExclusions) == 0 && len(cluster.ResourceInclusions) == 0 {
+		return rf
+	}
+	return &ResourcesFilter{
+		ResourceExclusions: appendClusterResourceFilters(rf.ResourceExclusions, cluster.ResourceExclusions),
+		ResourceInclusions: appendClusterResourceFilters(rf.ResourceInclusions, cluster.ResourceInclusions),
+	}
+}
+
+func appendClusterResourceFilters(resources []FilteredResource, filters []v1alpha1.ClusterResourceFilter) []FilteredResource {
+	res := make([]FilteredResource, 0, len(resources)+len(filters))
+	res = append(res, resources...)
+	for _, filter := range filters {
+		res = append(res, FilteredResource{APIGroups: filter.APIGroups, Kinds: filter.Kinds})
+	}
+	return res
+}
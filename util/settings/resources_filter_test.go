@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func TestIsExcludedResource(t *testing.T) {
@@ -60,3 +62,25 @@ func TestResourceInclusionsExclusionMultiCluster(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-two"))
 	assert.False(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-three"))
 }
+
+func TestResourcesFilter_ForCluster(t *testing.T) {
+	filter := &ResourcesFilter{
+		ResourceExclusions: []FilteredResource{{APIGroups: []string{"excluded.io"}}},
+	}
+	assert.Same(t, filter, filter.ForCluster(&v1alpha1.Cluster{Server: "https://my-cluster"}))
+
+	clusterFilter := filter.ForCluster(&v1alpha1.Cluster{
+		Server:             "https://my-cluster",
+		ResourceExclusions: []v1alpha1.ClusterResourceFilter{{APIGroups: []string{"noisy.io"}, Kinds: []string{"Report"}}},
+		ResourceInclusions: []v1alpha1.ClusterResourceFilter{{APIGroups: []string{"*.io", "apps"}}},
+	})
+	assert.True(t, clusterFilter.IsExcludedResource("excluded.io", "Foo", "https://my-cluster"))
+	assert.True(t, clusterFilter.IsExcludedResource("noisy.io", "Report", "https://my-cluster"))
+	assert.False(t, clusterFilter.IsExcludedResource("noisy.io", "Other", "https://my-cluster"))
+	assert.False(t, clusterFilter.IsExcludedResource("apps", "Deployment", "https://my-cluster"))
+	assert.True(t, clusterFilter.IsExcludedResource("", "ConfigMap", "https://my-cluster"))
+	// the global filter is not modified
+	assert.Len(t, filter.ResourceExclusions, 1)
+	assert.Empty(t, filter.ResourceInclusions)
+	assert.False(t, filter.IsExcludedResource("noisy.io", "Report", "https://my-cluster"))
+}
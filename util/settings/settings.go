@@ -8,14 +8,20 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"math/big"
+	"net"
 	"net/url"
 	"path"
 	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
+	"unicode"
 
 	timeutil "github.com/argoproj/pkg/time"
 	"github.com/ghodss/yaml"
@@ -35,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/server/settings/oidc"
 	"github.com/argoproj/argo-cd/v2/util"
+	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	tlsutil "github.com/argoproj/argo-cd/v2/util/tls"
@@ -47,10 +54,15 @@ type ArgoCDSettings struct {
 	URL string `json:"url,omitempty"`
 	// Indicates if status badge is enabled or not.
 	StatusBadgeEnabled bool `json:"statusBadgeEnable"`
+	// StatusBadgeKeys holds the keys granting access to the status badges of the applications of a project, by project
+	StatusBadgeKeys map[string]string `json:"statusBadgeKeys,omitempty"`
 	// DexConfig contains portions of a dex config yaml
 	DexConfig string `json:"dexConfig,omitempty"`
 	// OIDCConfigRAW holds OIDC configuration as a raw string
 	OIDCConfigRAW string `json:"oidcConfig,omitempty"`
+	// OIDCProvidersRAW holds the configuration of the OIDC providers available in addition to the one of OIDCConfigRAW
+	// as a raw string
+	OIDCProvidersRAW string `json:"oidcProviders,omitempty"`
 	// ServerSignature holds the key used to generate JWT tokens.
 	ServerSignature []byte `json:"serverSignature,omitempty"`
 	// Certificate holds the certificate/private key for the Argo CD API server.
@@ -68,6 +80,15 @@ type ArgoCDSettings struct {
 	WebhookBitbucketServerSecret string `json:"webhookBitbucketServerSecret,omitempty"`
 	// WebhookGogsSecret holds the shared secret for authenticating Gogs webhook events
 	WebhookGogsSecret string `json:"webhookGogsSecret,omitempty"`
+	// WebhookGiteaSecret holds the shared secret for authenticating Gitea webhook events
+	WebhookGiteaSecret string `json:"webhookGiteaSecret,omitempty"`
+	// WebhookAzureDevOpsUsername holds the username for authenticating Azure DevOps webhook events
+	WebhookAzureDevOpsUsername string `json:"webhookAzureDevOpsUsername,omitempty"`
+	// WebhookAzureDevOpsPassword holds the password for authenticating Azure DevOps webhook events
+	WebhookAzureDevOpsPassword string `json:"webhookAzureDevOpsPassword,omitempty"`
+	// WebhookAdditionalSecrets holds the secrets accepted in addition to the webhook secret of a provider while the
+	// secret is rotated, by key of the webhook secret, e.g. webhook.github.secret
+	WebhookAdditionalSecrets map[string][]string `json:"webhookAdditionalSecrets,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// KustomizeBuildOptions is a string of kustomize build parameters
@@ -76,6 +97,10 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// Specifies token expiration duration
 	UserSessionDuration time.Duration `json:"userSessionDuration,omitempty"`
+	// MaxAPITokenDuration is the maximum lifetime of the API tokens of the local accounts, which must expire if set
+	MaxAPITokenDuration time.Duration `json:"maxAPITokenDuration,omitempty"`
+	// maxAPITokenDurationErr is the error of the configured maximum lifetime of the API tokens, if it is invalid
+	maxAPITokenDurationErr error
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"`
 	// Content of UI Banner
@@ -84,6 +109,15 @@ type ArgoCDSettings struct {
 	UiBannerURL string `json:"uiBannerURL,omitempty"`
 	// PasswordPattern for password regular expression
 	PasswordPattern string `json:"passwordPattern,omitempty"`
+	// oidcProviders holds the parsed OIDCProvidersRAW, shared by the settings returned until the settings change
+	oidcProviders *oidcProviders
+}
+
+// oidcProviders holds the additional OIDC providers parsed from their raw config, or the error of the config
+type oidcProviders struct {
+	raw       string
+	providers []OIDCConfig
+	err       error
 }
 
 type GoogleAnalytics struct {
@@ -113,6 +147,11 @@ type OIDCConfig struct {
 	RequestedScopes        []string               `json:"requestedScopes,omitempty"`
 	RequestedIDTokenClaims map[string]*oidc.Claim `json:"requestedIDTokenClaims,omitempty"`
 	LogoutURL              string                 `json:"logoutURL,omitempty"`
+	// GroupsClaim is the claim of the ID tokens holding the groups of the user, used instead of the groups claim
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// EnablePKCEAuthentication enables the PKCE extension of the authorization code flow for the logins through the
+	// UI, which allows the provider to treat Argo CD as a public client without client secret
+	EnablePKCEAuthentication bool `json:"enablePKCEAuthentication,omitempty"`
 }
 
 // DEPRECATED. Helm repository credentials are now managed using RepoCredentials
@@ -141,7 +180,8 @@ type KustomizeSettings struct {
 	Versions     []KustomizeVersion
 }
 
-func (ks *KustomizeSettings) GetOptions(source v1alpha1.ApplicationSource) (*v1alpha1.KustomizeOptions, error) {
+// GetOptions returns the kustomize options used to build the source, including the build options of the project if set
+func (ks *KustomizeSettings) GetOptions(source v1alpha1.ApplicationSource, proj *v1alpha1.AppProject) (*v1alpha1.KustomizeOptions, error) {
 	binaryPath := ""
 	buildOptions := ""
 	if source.Kustomize != nil && source.Kustomize.Version != "" {
@@ -160,17 +200,67 @@ func (ks *KustomizeSettings) GetOptions(source v1alpha1.ApplicationSource) (*v1a
 		// add build options for the default version
 		buildOptions = ks.BuildOptions
 	}
-	return &v1alpha1.KustomizeOptions{
+	opts := &v1alpha1.KustomizeOptions{
 		BuildOptions: buildOptions,
 		BinaryPath:   binaryPath,
-	}, nil
+	}
+	if proj != nil {
+		opts.ProjectBuildOptions = proj.Spec.KustomizeBuildOptions
+	}
+	return opts, nil
+}
+
+// HelmVersion holds information about an additional Helm binary
+type HelmVersion struct {
+	// Name holds the Helm version name, e.g. v3.2.4. Versions whose major version is 2 are run as Helm 2 binaries.
+	Name string
+	// Path holds corresponding binary path
+	Path string
+}
+
+// HelmSettings holds helm settings
+type HelmSettings struct {
+	Versions []HelmVersion
+}
+
+// GetOptions returns the helm binary used to render the source. The version of the source takes precedence over the
+// default version of the project. The built-in binaries are used for the versions v2 and v3 unless they are registered.
+func (hs *HelmSettings) GetOptions(source v1alpha1.ApplicationSource, proj *v1alpha1.AppProject) (*v1alpha1.HelmOptions, error) {
+	version := ""
+	if source.Helm != nil {
+		version = source.Helm.Version
+	}
+	if version == "" && proj != nil {
+		version = proj.Spec.HelmVersion
+	}
+	if version == "" {
+		return nil, nil
+	}
+	for _, ver := range hs.Versions {
+		if ver.Name == version {
+			return &v1alpha1.HelmOptions{BinaryPath: ver.Path, Version: helmMajorVersion(ver.Name)}, nil
+		}
+	}
+	switch version {
+	case "v2", "v3":
+		return &v1alpha1.HelmOptions{Version: version}, nil
+	}
+	return nil, fmt.Errorf("helm version %s is not registered", version)
+}
+
+// helmMajorVersion returns the major version of a helm version name, either v2 or v3
+func helmMajorVersion(name string) string {
+	if strings.HasPrefix(strings.TrimPrefix(name, "v"), "2") {
+		return "v2"
+	}
+	return "v3"
 }
 
 // Credentials for accessing a Git repository
 type Repository struct {
 	// The URL to the repository
 	URL string `json:"url,omitempty"`
-	// the type of the repo, "git" or "helm", assumed to be "git" if empty or absent
+	// the type of the repo, "git", "helm" or "oci", assumed to be "git" if empty or absent
 	Type string `json:"type,omitempty"`
 	// helm only
 	Name string `json:"name,omitempty"`
@@ -259,6 +349,8 @@ const (
 	settingDexConfigKey = "dex.config"
 	// settingsOIDCConfigKey designates the key for OIDC config
 	settingsOIDCConfigKey = "oidc.config"
+	// settingsOIDCProvidersKey designates the key for the config of the additional OIDC providers
+	settingsOIDCProvidersKey = "oidc.providers"
 	// statusBadgeEnabledKey holds the key which enables of disables status badge feature
 	statusBadgeEnabledKey = "statusbadge.enabled"
 	// settingsWebhookGitHubSecret is the key for the GitHub shared webhook secret
@@ -271,10 +363,33 @@ const (
 	settingsWebhookBitbucketServerSecretKey = "webhook.bitbucketserver.secret"
 	// settingsWebhookGogsSecret is the key for Gogs webhook secret
 	settingsWebhookGogsSecretKey = "webhook.gogs.secret"
+	// settingsWebhookGiteaSecretKey is the key for Gitea webhook secret
+	settingsWebhookGiteaSecretKey = "webhook.gitea.secret"
+	// settingsWebhookAzureDevOpsUsernameKey is the key for Azure DevOps webhook username
+	settingsWebhookAzureDevOpsUsernameKey = "webhook.azuredevops.username"
+	// settingsWebhookAzureDevOpsPasswordKey is the key for Azure DevOps webhook password
+	settingsWebhookAzureDevOpsPasswordKey = "webhook.azuredevops.password"
+	// settingsStatusBadgeKeyPrefix is the prefix of the keys holding the access key of the status badges of a project,
+	// e.g. statusbadge.key.my-project
+	settingsStatusBadgeKeyPrefix = "statusbadge.key."
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsApplicationInstanceLabelKey = "application.instanceLabelKey"
+	// settingsResourceTrackingMethodKey is the key to configure how the resources of applications are tracked
+	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
+	// settingsApplicationHistoryMaxEntriesKey is the key to configure the default number of revision history entries kept per application
+	settingsApplicationHistoryMaxEntriesKey = "application.history.maxEntries"
+	// settingsApplicationHistoryMaxAgeKey is the key to configure the age after which revision history entries are pruned
+	settingsApplicationHistoryMaxAgeKey = "application.history.maxAge"
+	// settingsApplicationOperationStateMaxAgeKey is the key to configure the age after which the results of completed operations are pruned
+	settingsApplicationOperationStateMaxAgeKey = "application.operationState.maxAge"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
+	// resourceIgnoreResourceUpdatesEnabledKey is the key to enable ignoring the resource updates configured with ignoreResourceUpdates customizations
+	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+	// serverRBACLogEnforceEnableKey is the key to require the logs permission on the applications to read the logs of their pods
+	serverRBACLogEnforceEnableKey = "server.rbac.log.enforce.enable"
+	// applicationSyncImpersonationEnabledKey is the key to enable the impersonation of the destination service accounts of the projects during the syncs
+	applicationSyncImpersonationEnabledKey = "application.sync.impersonation.enabled"
 	// resourceExclusions is the key to the list of excluded resources
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
@@ -287,10 +402,14 @@ const (
 	kustomizeVersionKeyPrefix = "kustomize.version"
 	// kustomizePathPrefixKey is a kustomize path for a specific version
 	kustomizePathPrefixKey = "kustomize.path"
+	// helmPathPrefixKey is a helm path for a specific version
+	helmPathPrefixKey = "helm.path"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// anonymousUserEnabledKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// maxAPITokenDurationKey is the key which specifies the maximum lifetime of the API tokens of the local accounts
+	maxAPITokenDurationKey = "users.token.maxDuration"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUiCssURLKey designates the key for user-defined CSS URL for UI customization
@@ -313,15 +432,170 @@ const (
 	partOfArgoCDSelector = "app.kubernetes.io/part-of=argocd"
 	// settingsPasswordPatternKey is the key to configure user password regular expression
 	settingsPasswordPatternKey = "passwordPattern"
+	// scimEnabledKey is the key to enable the SCIM endpoint through which identity providers provision users and groups
+	scimEnabledKey = "scim.enabled"
+	// scimIssuerKey is the key of the issuer of the tokens of the users provisioned through SCIM
+	scimIssuerKey = "scim.issuer"
+	// scimSubjectClaimKey is the key of the token claim which is matched against the externalId of the SCIM users
+	scimSubjectClaimKey = "scim.subjectClaim"
+	// scimBearerTokenKey designates the key of the token identity providers authenticate to the SCIM endpoint with inside a Kubernetes secret
+	scimBearerTokenKey = "scim.bearerToken"
+	// serverRateLimitsKey is the key to configure the rate limits of the API requests
+	serverRateLimitsKey = "server.rateLimits"
+	// serverTrustedProxiesKey is the key to configure the addresses of the proxies whose X-Forwarded-For header is trusted
+	serverTrustedProxiesKey = "server.trustedProxies"
+	// execEnabledKey is the key to enable the web terminal opening a shell in the pods of the applications
+	execEnabledKey = "exec.enabled"
+	// execShellsKey is the key to configure the shells the web terminal tries to run, in order
+	execShellsKey = "exec.shells"
+	// execIdleTimeoutKey is the key to configure how long the web terminal sessions stay open without input
+	execIdleTimeoutKey = "exec.idleTimeout"
+	// execSessionTimeoutKey is the key to configure how long the web terminal sessions stay open at most
+	execSessionTimeoutKey = "exec.sessionTimeout"
+	// applicationLinksKey is the key to configure the deep links of the applications
+	applicationLinksKey = "application.links"
+	// resourceLinksKey is the key to configure the deep links of the resources of the applications
+	resourceLinksKey = "resource.links"
+	// extensionConfigKey is the key to configure the backend services the API server proxies the requests of the UI extensions to
+	extensionConfigKey = "extension.config"
+	// credentialsAllowedReferencesKey is the key to configure the references to the external credentials the repositories, the credential templates and the clusters are allowed to use
+	credentialsAllowedReferencesKey = "credentials.allowedReferences"
 )
 
+// extensionNameRegex is the format of the names of the extensions, which are part of the path of their requests
+var extensionNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// defaultExecShells are the shells the web terminal tries to run by default
+var defaultExecShells = []string{"bash", "sh", "powershell", "cmd"}
+
+const (
+	// defaultExecIdleTimeout is how long the web terminal sessions stay open without input by default
+	defaultExecIdleTimeout = 30 * time.Minute
+	// defaultExecSessionTimeout is how long the web terminal sessions stay open at most by default
+	defaultExecSessionTimeout = 8 * time.Hour
+)
+
+// defaultSCIMSubjectClaim is the token claim matched against the externalId of the SCIM users by default
+const defaultSCIMSubjectClaim = "sub"
+
+// SCIMSettings holds the settings of the SCIM endpoint through which identity providers provision users and groups
+type SCIMSettings struct {
+	// Enabled indicates whether the SCIM endpoint is served
+	Enabled bool
+	// BearerToken is the token identity providers authenticate to the SCIM endpoint with
+	BearerToken string
+	// Issuer is the issuer of the tokens of the provisioned users. The tokens of other issuers are never matched.
+	Issuer string
+	// SubjectClaim is the token claim which is matched against the externalId of the SCIM users
+	SubjectClaim string
+}
+
+const (
+	// APIRateLimitScopeToken counts the requests per API token or session token
+	APIRateLimitScopeToken = "token"
+	// APIRateLimitScopeAccount counts the requests per account, across all its tokens
+	APIRateLimitScopeAccount = "account"
+	// APIRateLimitScopeIP counts the requests per client IP address
+	APIRateLimitScopeIP = "ip"
+
+	// APIRateLimitMethodsRead limits the methods reading resources, e.g. get, list and watch
+	APIRateLimitMethodsRead = "read"
+	// APIRateLimitMethodsWrite limits the methods changing resources, other than the sync methods
+	APIRateLimitMethodsWrite = "write"
+	// APIRateLimitMethodsSync limits the methods starting or stopping application operations, i.e. sync, rollback and terminate
+	APIRateLimitMethodsSync = "sync"
+	// APIRateLimitMethodsAll limits all methods
+	APIRateLimitMethodsAll = "all"
+)
+
+// APIRateLimit limits the rate of the requests a class of API methods receive from each client
+type APIRateLimit struct {
+	// Scope is what the requests are counted by: token, account or ip. Unauthenticated requests are counted by client
+	// IP address in every scope.
+	Scope string `json:"scope"`
+	// Methods is the class of the limited methods: read, write, sync or all. Defaults to all.
+	Methods string `json:"methods,omitempty"`
+	// RequestsPerSecond is the sustained rate of requests each client is allowed
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	// Burst is the number of requests each client is allowed above the sustained rate. Defaults to the rate rounded up.
+	Burst int `json:"burst,omitempty"`
+}
+
+// AllowedCredentialsReference allows the repositories, the credential templates and the clusters to reference the
+// external credentials starting with a prefix, e.g. "vault:secret/data/team-a/"
+type AllowedCredentialsReference struct {
+	// Prefix is the prefix of the allowed references, including their scheme
+	Prefix string `json:"prefix"`
+	// Projects are the projects whose repositories and clusters are allowed to use the references. If empty, only the
+	// credential templates and the repositories and clusters which are not scoped to a project are.
+	Projects []string `json:"projects,omitempty"`
+}
+
+// DeepLink is a link to an external system, e.g. a dashboard or a log viewer, shown next to the applications or their
+// resources. The URL is a Go template rendered with the application as `.app` and, for resource links, the live
+// resource as `.resource`.
+type DeepLink struct {
+	// Title is the text of the link
+	Title string `json:"title"`
+	// URL is the template of the URL of the link
+	URL string `json:"url"`
+	// Description is an optional description of the link
+	Description string `json:"description,omitempty"`
+	// IconClass is an optional icon class of the link, e.g. fa-chart-area
+	IconClass string `json:"icon.class,omitempty"`
+	// Kinds are the patterns of the kinds of the resources the link applies to, formatted as Kind or group/Kind.
+	// Resource links apply to all resources when empty.
+	Kinds []string `json:"kinds,omitempty"`
+	// Selector is a label selector restricting the link to the applications with matching labels
+	Selector string `json:"selector,omitempty"`
+}
+
+// ExtensionConfig configures the backend services the API server proxies the requests of a UI extension to
+type ExtensionConfig struct {
+	// Name is the name of the extension, its requests are sent to /extensions/<name>/
+	Name string `json:"name"`
+	// Backend holds the backend services of the extension
+	Backend ExtensionBackend `json:"backend"`
+}
+
+// ExtensionBackend holds the backend services of an extension and the settings of the connections to them
+type ExtensionBackend struct {
+	// ConnectionTimeout is the maximum time to wait for a connection to a service. Defaults to 2s.
+	ConnectionTimeout metav1.Duration `json:"connectionTimeout,omitempty"`
+	// KeepAlive is the interval between the keep-alive probes of the connections. Defaults to 15s.
+	KeepAlive metav1.Duration `json:"keepAlive,omitempty"`
+	// IdleConnectionTimeout is how long an idle connection is kept open. Defaults to 60s.
+	IdleConnectionTimeout metav1.Duration `json:"idleConnectionTimeout,omitempty"`
+	// MaxIdleConnections is the maximum number of idle connections to the services. Defaults to 30.
+	MaxIdleConnections int `json:"maxIdleConnections,omitempty"`
+	// Services are the backend services of the extension. The requests are sent to the service of the cluster of the
+	// application, or to the service without cluster.
+	Services []ExtensionService `json:"services"`
+}
+
+// ExtensionService is a backend service of an extension
+type ExtensionService struct {
+	// URL is the base URL of the service
+	URL string `json:"url"`
+	// Cluster restricts the service to the applications deployed to the given cluster
+	Cluster *ExtensionCluster `json:"cluster,omitempty"`
+}
+
+// ExtensionCluster identifies a cluster by name or server URL
+type ExtensionCluster struct {
+	Name   string `json:"name,omitempty"`
+	Server string `json:"server,omitempty"`
+}
+
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
 type SettingsManager struct {
-	ctx        context.Context
-	clientset  kubernetes.Interface
-	secrets    v1listers.SecretLister
-	configmaps v1listers.ConfigMapLister
-	namespace  string
+	ctx       context.Context
+	clientset kubernetes.Interface
+	secrets   v1listers.SecretLister
+	// secretsIndexer indexes the secrets with the secretIndexers
+	secretsIndexer cache.Indexer
+	configmaps     v1listers.ConfigMapLister
+	namespace      string
 	// subscribers is a list of subscribers to settings updates
 	subscribers []chan<- *ArgoCDSettings
 	// mutex protects concurrency sensitive parts of settings manager: access to subscribers list and initialization flag
@@ -329,6 +603,8 @@ type SettingsManager struct {
 	initContextCancel func()
 	reposCache        []Repository
 	repoCredsCache    []RepositoryCredentials
+	// oidcProvidersCache holds the additional OIDC providers, parsed once per settings change
+	oidcProvidersCache *oidcProviders
 }
 
 type incompleteSettingsError struct {
@@ -351,12 +627,82 @@ type ArgoCDDiffOptions struct {
 
 	// If set to true then differences caused by status are ignored.
 	IgnoreResourceStatusField IgnoreStatus `json:"ignoreResourceStatusField,omitempty"`
+
+	// If set to true then the fields owned in the live resources by field managers other than Argo CD are ignored.
+	IgnoreFieldsOwnedByOtherManagers bool `json:"ignoreFieldsOwnedByOtherManagers,omitempty"`
+
+	// ArgoCDFieldManagers overrides the field managers considered as Argo CD when ignoring the fields owned by other managers.
+	ArgoCDFieldManagers []string `json:"argoCDFieldManagers,omitempty"`
 }
 
 func (e *incompleteSettingsError) Error() string {
 	return e.message
 }
 
+const (
+	// BySecretTypeIndexer indexes the secrets by their argocd.argoproj.io/secret-type label
+	BySecretTypeIndexer = "bySecretType"
+	// ByClusterURLIndexer indexes the cluster secrets by the URL of their cluster
+	ByClusterURLIndexer = "byClusterURL"
+	// ByRepoURLIndexer indexes the repository secrets by the normalized URL of their repository
+	ByRepoURLIndexer = "byRepoURL"
+)
+
+// secretIndexers allow looking up the Argo CD secrets without going through all the secrets of the namespace, which
+// matters for installations managing thousands of clusters or repositories
+var secretIndexers = cache.Indexers{
+	BySecretTypeIndexer: func(obj interface{}) ([]string, error) {
+		s, ok := obj.(*apiv1.Secret)
+		if !ok {
+			return nil, nil
+		}
+		if secretType, ok := s.Labels[common.LabelKeySecretType]; ok {
+			return []string{secretType}, nil
+		}
+		return nil, nil
+	},
+	ByClusterURLIndexer: func(obj interface{}) ([]string, error) {
+		s, ok := obj.(*apiv1.Secret)
+		if !ok || s.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeCluster {
+			return nil, nil
+		}
+		if server, ok := s.Data["server"]; ok {
+			return []string{strings.TrimRight(string(server), "/")}, nil
+		}
+		return nil, nil
+	},
+	ByRepoURLIndexer: func(obj interface{}) ([]string, error) {
+		s, ok := obj.(*apiv1.Secret)
+		if !ok || s.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeRepository {
+			return nil, nil
+		}
+		if repoURL := git.NormalizeGitURL(string(s.Data["url"])); repoURL != "" {
+			return []string{repoURL}, nil
+		}
+		return nil, nil
+	},
+}
+
+// GetSecretsByIndex returns the secrets indexed with the given value by the given indexer, one of BySecretTypeIndexer,
+// ByClusterURLIndexer or ByRepoURLIndexer
+func (mgr *SettingsManager) GetSecretsByIndex(indexName string, value string) ([]*apiv1.Secret, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return nil, err
+	}
+	objs, err := mgr.secretsIndexer.ByIndex(indexName, value)
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]*apiv1.Secret, 0, len(objs))
+	for _, obj := range objs {
+		if s, ok := obj.(*apiv1.Secret); ok && s.Namespace == mgr.namespace {
+			secrets = append(secrets, s)
+		}
+	}
+	return secrets, nil
+}
+
 func (mgr *SettingsManager) GetSecretsLister() (v1listers.SecretLister, error) {
 	err := mgr.ensureSynced(false)
 	if err != nil {
@@ -519,6 +865,60 @@ func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
 	return label, nil
 }
 
+// GetTrackingMethod returns the resource tracking method, either label, annotation or annotation+label. An empty
+// method means the default label method.
+func (mgr *SettingsManager) GetTrackingMethod() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", err
+	}
+	return argoCDCM.Data[settingsResourceTrackingMethodKey], nil
+}
+
+// AppHistoryRetention holds the retention of the revision history and of the operation state of the applications
+type AppHistoryRetention struct {
+	// MaxEntries is the number of revision history entries kept for the applications which don't set spec.revisionHistoryLimit
+	MaxEntries int
+	// MaxAge is the age after which revision history entries are pruned, the most recent entry is always kept. Zero means no limit
+	MaxAge time.Duration
+	// OperationStateMaxAge is the age after which the resource results of a completed operation are pruned. Zero means no limit
+	OperationStateMaxAge time.Duration
+}
+
+// DefaultAppHistoryRetention is the retention of the revision history and of the operation state of the applications
+// when it is not configured
+var DefaultAppHistoryRetention = AppHistoryRetention{MaxEntries: v1alpha1.RevisionHistoryLimit}
+
+// GetAppHistoryRetention loads the retention of the revision history and of the operation state of the applications.
+// The invalid settings are ignored in favor of their default value.
+func (mgr *SettingsManager) GetAppHistoryRetention() (*AppHistoryRetention, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	retention := DefaultAppHistoryRetention
+	if value := argoCDCM.Data[settingsApplicationHistoryMaxEntriesKey]; value != "" {
+		if maxEntries, err := strconv.Atoi(value); err != nil || maxEntries < 1 {
+			log.Warnf("Invalid '%s' key '%s': must be a positive number, using the default", settingsApplicationHistoryMaxEntriesKey, value)
+		} else {
+			retention.MaxEntries = maxEntries
+		}
+	}
+	for key, duration := range map[string]*time.Duration{
+		settingsApplicationHistoryMaxAgeKey:        &retention.MaxAge,
+		settingsApplicationOperationStateMaxAgeKey: &retention.OperationStateMaxAge,
+	} {
+		if value := argoCDCM.Data[key]; value != "" {
+			if parsed, err := time.ParseDuration(value); err != nil || parsed < 0 {
+				log.Warnf("Invalid '%s' key '%s': must be a positive duration, using the default", key, value)
+			} else {
+				*duration = parsed
+			}
+		}
+	}
+	return &retention, nil
+}
+
 func (mgr *SettingsManager) GetPasswordPattern() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -546,6 +946,344 @@ func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManag
 	return plugins, nil
 }
 
+// GetConfigManagementPluginsWithSecrets loads the config management plugins like GetConfigManagementPlugins, and
+// replaces the secret references in the environment variables of the plugins with the secret values. The result must
+// only be passed to the repo server.
+func (mgr *SettingsManager) GetConfigManagementPluginsWithSecrets() ([]v1alpha1.ConfigManagementPlugin, error) {
+	plugins, err := mgr.GetConfigManagementPlugins()
+	if err != nil {
+		return nil, err
+	}
+	var secretValues map[string]string
+	for i := range plugins {
+		for _, entry := range plugins[i].Env {
+			if entry == nil || !strings.HasPrefix(entry.Value, "$") {
+				continue
+			}
+			if secretValues == nil {
+				if secretValues, err = mgr.getReferenceableSecretValues(); err != nil {
+					return nil, err
+				}
+			}
+			entry.Value = ReplaceStringSecret(entry.Value, secretValues)
+		}
+	}
+	return plugins, nil
+}
+
+// getReferenceableSecretValues returns the values of argocd-secret and of the secrets labeled as part of Argo CD
+func (mgr *SettingsManager) getReferenceableSecretValues() (map[string]string, error) {
+	err := mgr.ensureSynced(false)
+	if err != nil {
+		return nil, err
+	}
+	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := labels.Parse(partOfArgoCDSelector)
+	if err != nil {
+		return nil, err
+	}
+	secrets, err := mgr.secrets.Secrets(mgr.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return getSecretValues(argoCDSecret, secrets), nil
+}
+
+// GetIsIgnoreResourceUpdatesEnabled returns true if the updates of the fields configured with ignoreResourceUpdates
+// customizations should not trigger the refresh of the applications
+func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
+}
+
+// GetServerRBACLogEnforceEnable returns whether reading the logs of the pods of an application requires the logs
+// permission on the application, in addition to the get permission
+func (mgr *SettingsManager) GetServerRBACLogEnforceEnable() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[serverRBACLogEnforceEnableKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[serverRBACLogEnforceEnableKey])
+}
+
+// IsImpersonationEnabled returns whether the applications are synced by impersonating the destination service accounts
+// of their project
+func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[applicationSyncImpersonationEnabledKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[applicationSyncImpersonationEnabledKey])
+}
+
+// IsExecEnabled returns whether the web terminal can open a shell in the pods of the applications
+func (mgr *SettingsManager) IsExecEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[execEnabledKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[execEnabledKey])
+}
+
+// GetExecShells returns the shells the web terminal tries to run in the pods, in order
+func (mgr *SettingsManager) GetExecShells() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var shells []string
+	for _, shell := range strings.Split(argoCDCM.Data[execShellsKey], ",") {
+		if shell = strings.TrimSpace(shell); shell != "" {
+			shells = append(shells, shell)
+		}
+	}
+	if len(shells) == 0 {
+		return append([]string{}, defaultExecShells...), nil
+	}
+	return shells, nil
+}
+
+// GetExecTimeouts returns how long the web terminal sessions stay open without input and at most
+func (mgr *SettingsManager) GetExecTimeouts() (time.Duration, time.Duration, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return 0, 0, err
+	}
+	timeouts := []time.Duration{defaultExecIdleTimeout, defaultExecSessionTimeout}
+	for i, key := range []string{execIdleTimeoutKey, execSessionTimeoutKey} {
+		value := argoCDCM.Data[key]
+		if value == "" {
+			continue
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return 0, 0, fmt.Errorf("invalid %s '%s': must be a positive duration", key, value)
+		}
+		timeouts[i] = timeout
+	}
+	return timeouts[0], timeouts[1], nil
+}
+
+// GetApplicationDeepLinks returns the deep links of the applications
+func (mgr *SettingsManager) GetApplicationDeepLinks() ([]DeepLink, error) {
+	return mgr.getDeepLinks(applicationLinksKey)
+}
+
+// GetResourceDeepLinks returns the deep links of the resources of the applications
+func (mgr *SettingsManager) GetResourceDeepLinks() ([]DeepLink, error) {
+	return mgr.getDeepLinks(resourceLinksKey)
+}
+
+func (mgr *SettingsManager) getDeepLinks(key string) ([]DeepLink, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var links []DeepLink
+	if value, ok := argoCDCM.Data[key]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &links); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' key: %v", key, err)
+		}
+	}
+	for _, link := range links {
+		if link.Title == "" || link.URL == "" {
+			return nil, fmt.Errorf("invalid '%s' key: links must have a title and a url", key)
+		}
+		if _, err := template.New(link.Title).Parse(link.URL); err != nil {
+			return nil, fmt.Errorf("invalid '%s' key: invalid url of link '%s': %v", key, link.Title, err)
+		}
+		if _, err := labels.Parse(link.Selector); err != nil {
+			return nil, fmt.Errorf("invalid '%s' key: invalid selector of link '%s': %v", key, link.Title, err)
+		}
+	}
+	return links, nil
+}
+
+// GetExtensionConfigs returns the backend services of the UI extensions proxied by the API server
+func (mgr *SettingsManager) GetExtensionConfigs() ([]ExtensionConfig, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var config struct {
+		Extensions []ExtensionConfig `json:"extensions"`
+	}
+	if value, ok := argoCDCM.Data[extensionConfigKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &config); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' key: %v", extensionConfigKey, err)
+		}
+	}
+	names := make(map[string]bool)
+	for _, ext := range config.Extensions {
+		if !extensionNameRegex.MatchString(ext.Name) {
+			return nil, fmt.Errorf("invalid '%s' key: invalid extension name '%s'", extensionConfigKey, ext.Name)
+		}
+		if names[ext.Name] {
+			return nil, fmt.Errorf("invalid '%s' key: extension '%s' is configured more than once", extensionConfigKey, ext.Name)
+		}
+		names[ext.Name] = true
+		if len(ext.Backend.Services) == 0 {
+			return nil, fmt.Errorf("invalid '%s' key: extension '%s' has no services", extensionConfigKey, ext.Name)
+		}
+		defaultServices := 0
+		for _, service := range ext.Backend.Services {
+			u, err := url.Parse(service.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, fmt.Errorf("invalid '%s' key: invalid url '%s' of extension '%s'", extensionConfigKey, service.URL, ext.Name)
+			}
+			if service.Cluster == nil {
+				defaultServices++
+			} else if service.Cluster.Name == "" && service.Cluster.Server == "" {
+				return nil, fmt.Errorf("invalid '%s' key: the cluster of a service of extension '%s' has no name or server", extensionConfigKey, ext.Name)
+			}
+		}
+		if defaultServices > 1 {
+			return nil, fmt.Errorf("invalid '%s' key: extension '%s' has more than one service without cluster", extensionConfigKey, ext.Name)
+		}
+	}
+	return config.Extensions, nil
+}
+
+// GetSCIMSettings returns the settings of the SCIM endpoint
+func (mgr *SettingsManager) GetSCIMSettings() (*SCIMSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	scimSettings := &SCIMSettings{Issuer: argoCDCM.Data[scimIssuerKey], SubjectClaim: defaultSCIMSubjectClaim}
+	if argoCDCM.Data[scimEnabledKey] != "" {
+		if scimSettings.Enabled, err = strconv.ParseBool(argoCDCM.Data[scimEnabledKey]); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' key: %v", scimEnabledKey, err)
+		}
+	}
+	if claim := argoCDCM.Data[scimSubjectClaimKey]; claim != "" {
+		scimSettings.SubjectClaim = claim
+	}
+	if scimSettings.Enabled && scimSettings.Issuer == "" {
+		// defaults to the issuer of the SSO tokens
+		argoCDSettings, err := mgr.GetSettings()
+		if err != nil {
+			return nil, err
+		}
+		scimSettings.Issuer = argoCDSettings.IssuerURL()
+		if scimSettings.Issuer == "" {
+			return nil, fmt.Errorf("the SCIM endpoint requires the '%s' key or SSO to be configured", scimIssuerKey)
+		}
+	}
+	argoCDSecret, err := mgr.secrets.Secrets(mgr.namespace).Get(common.ArgoCDSecretName)
+	if err != nil {
+		return nil, err
+	}
+	scimSettings.BearerToken = string(argoCDSecret.Data[scimBearerTokenKey])
+	return scimSettings, nil
+}
+
+// GetAPIRateLimits returns the rate limits of the API requests
+func (mgr *SettingsManager) GetAPIRateLimits() ([]APIRateLimit, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var rateLimits []APIRateLimit
+	if value, ok := argoCDCM.Data[serverRateLimitsKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &rateLimits); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' key: %v", serverRateLimitsKey, err)
+		}
+	}
+	for i := range rateLimits {
+		limit := &rateLimits[i]
+		switch limit.Scope {
+		case APIRateLimitScopeToken, APIRateLimitScopeAccount, APIRateLimitScopeIP:
+		default:
+			return nil, fmt.Errorf("invalid '%s' key: unknown scope '%s'", serverRateLimitsKey, limit.Scope)
+		}
+		switch limit.Methods {
+		case "":
+			limit.Methods = APIRateLimitMethodsAll
+		case APIRateLimitMethodsRead, APIRateLimitMethodsWrite, APIRateLimitMethodsSync, APIRateLimitMethodsAll:
+		default:
+			return nil, fmt.Errorf("invalid '%s' key: unknown methods '%s'", serverRateLimitsKey, limit.Methods)
+		}
+		if limit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("invalid '%s' key: requestsPerSecond must be positive", serverRateLimitsKey)
+		}
+		if limit.Burst < 0 {
+			return nil, fmt.Errorf("invalid '%s' key: burst must not be negative", serverRateLimitsKey)
+		}
+		if limit.Burst == 0 {
+			limit.Burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+	}
+	return rateLimits, nil
+}
+
+// GetAllowedCredentialsReferences returns the references to the external credentials the repositories, the credential
+// templates and the clusters are allowed to use
+func (mgr *SettingsManager) GetAllowedCredentialsReferences() ([]AllowedCredentialsReference, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var references []AllowedCredentialsReference
+	if value, ok := argoCDCM.Data[credentialsAllowedReferencesKey]; ok && value != "" {
+		if err := yaml.Unmarshal([]byte(value), &references); err != nil {
+			return nil, fmt.Errorf("failed to parse '%s' key: %v", credentialsAllowedReferencesKey, err)
+		}
+	}
+	for _, reference := range references {
+		if !strings.Contains(reference.Prefix, ":") {
+			return nil, fmt.Errorf("invalid '%s' key: prefix '%s' has no scheme", credentialsAllowedReferencesKey, reference.Prefix)
+		}
+	}
+	return references, nil
+}
+
+// GetTrustedProxies returns the networks of the proxies forwarding the API requests, e.g. the ingress controllers,
+// whose X-Forwarded-For header is trusted to determine the client IP address
+func (mgr *SettingsManager) GetTrustedProxies() ([]*net.IPNet, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	var proxies []*net.IPNet
+	for _, value := range strings.FieldsFunc(argoCDCM.Data[serverTrustedProxiesKey], func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}) {
+		if !strings.Contains(value, "/") {
+			// a single address
+			if ip := net.ParseIP(value); ip != nil && ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid '%s' key: %v", serverTrustedProxiesKey, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -660,6 +1398,13 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.IgnoreDifferences = overrideIgnoreDiff
+		case "ignoreResourceUpdates":
+			overrideIgnoreUpdates := v1alpha1.OverrideIgnoreDiff{}
+			err := yaml.Unmarshal([]byte(v), &overrideIgnoreUpdates)
+			if err != nil {
+				return err
+			}
+			overrideVal.IgnoreResourceUpdates = overrideIgnoreUpdates
 		case "knownTypeFields":
 			var knownTypeFields []v1alpha1.KnownTypeField
 			err := yaml.Unmarshal([]byte(v), &knownTypeFields)
@@ -772,6 +1517,25 @@ func addKustomizeVersion(prefix, name, path string, kvMap map[string]KustomizeVe
 	return nil
 }
 
+// GetHelmSettings loads the helm settings from argocd-cm ConfigMap
+func (mgr *SettingsManager) GetHelmSettings() (*HelmSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	settings := &HelmSettings{}
+	// extract version and path from helm.path.<version>
+	for k, v := range argoCDCM.Data {
+		if strings.HasPrefix(k, helmPathPrefixKey+".") {
+			settings.Versions = append(settings.Versions, HelmVersion{Name: k[len(helmPathPrefixKey)+1:], Path: v})
+		}
+	}
+	sort.Slice(settings.Versions, func(i, j int) bool {
+		return settings.Versions[i].Name < settings.Versions[j].Name
+	})
+	return settings, nil
+}
+
 // DEPRECATED. Helm repository credentials are now managed using RepoCredentials
 func (mgr *SettingsManager) GetHelmRepositories() ([]HelmRepoCredentials, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -933,6 +1697,7 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 	if err := mgr.updateSettingsFromSecret(&settings, argoCDSecret, secrets); err != nil {
 		errs = append(errs, err)
 	}
+	settings.oidcProviders = mgr.getOIDCProviders(&settings)
 	if len(errs) > 0 {
 		return &settings, errs[0]
 	}
@@ -947,6 +1712,23 @@ func (mgr *SettingsManager) invalidateCache() {
 
 	mgr.reposCache = nil
 	mgr.repoCredsCache = nil
+	mgr.oidcProvidersCache = nil
+}
+
+// getOIDCProviders returns the additional OIDC providers of the given settings, which are only parsed if the settings
+// changed since they were last parsed. An invalid config is logged once per change.
+func (mgr *SettingsManager) getOIDCProviders(settings *ArgoCDSettings) *oidcProviders {
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	if mgr.oidcProvidersCache != nil && mgr.oidcProvidersCache.raw == settings.OIDCProvidersRAW {
+		return mgr.oidcProvidersCache
+	}
+	providers, err := settings.parseOIDCProviders()
+	if err != nil {
+		log.Errorf("Invalid %s, the additional OIDC providers are disabled: %v", settingsOIDCProvidersKey, err)
+	}
+	mgr.oidcProvidersCache = &oidcProviders{raw: settings.OIDCProvidersRAW, providers: providers, err: err}
+	return mgr.oidcProvidersCache
 }
 
 func (mgr *SettingsManager) initialize(ctx context.Context) error {
@@ -963,6 +1745,9 @@ func (mgr *SettingsManager) initialize(ctx context.Context) error {
 	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
 	cmInformer := v1.NewFilteredConfigMapInformer(mgr.clientset, mgr.namespace, 3*time.Minute, indexers, tweakConfigMap)
 	secretsInformer := v1.NewSecretInformer(mgr.clientset, mgr.namespace, 3*time.Minute, indexers)
+	if err := secretsInformer.AddIndexers(secretIndexers); err != nil {
+		return err
+	}
 	cmInformer.AddEventHandler(eventHandler)
 	secretsInformer.AddEventHandler(eventHandler)
 
@@ -1010,6 +1795,7 @@ func (mgr *SettingsManager) initialize(ctx context.Context) error {
 	secretsInformer.AddEventHandler(handler)
 	cmInformer.AddEventHandler(handler)
 	mgr.secrets = v1listers.NewSecretLister(secretsInformer.GetIndexer())
+	mgr.secretsIndexer = secretsInformer.GetIndexer()
 	mgr.configmaps = v1listers.NewConfigMapLister(cmInformer.GetIndexer())
 	return nil
 }
@@ -1033,6 +1819,7 @@ func (mgr *SettingsManager) ensureSynced(forceResync bool) error {
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.OIDCConfigRAW = argoCDCM.Data[settingsOIDCConfigKey]
+	settings.OIDCProvidersRAW = argoCDCM.Data[settingsOIDCProvidersKey]
 	settings.KustomizeBuildOptions = argoCDCM.Data[kustomizeBuildOptionsKey]
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.AnonymousUserEnabled = argoCDCM.Data[anonymousUserEnabledKey] == "true"
@@ -1055,6 +1842,14 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 	} else {
 		settings.UserSessionDuration = time.Hour * 24
 	}
+	if maxAPITokenDurationStr, ok := argoCDCM.Data[maxAPITokenDurationKey]; ok {
+		if val, err := timeutil.ParseDuration(maxAPITokenDurationStr); err != nil {
+			log.Warnf("Failed to parse '%s' key, no API token can be created: %v", maxAPITokenDurationKey, err)
+			settings.maxAPITokenDurationErr = fmt.Errorf("invalid %s '%s': %s", maxAPITokenDurationKey, maxAPITokenDurationStr, strings.TrimSpace(err.Error()))
+		} else {
+			settings.MaxAPITokenDuration = *val
+		}
+	}
 	settings.PasswordPattern = argoCDCM.Data[settingsPasswordPatternKey]
 	if settings.PasswordPattern == "" {
 		settings.PasswordPattern = common.PasswordPatten
@@ -1100,6 +1895,26 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	if gogsWebhookSecret := argoCDSecret.Data[settingsWebhookGogsSecretKey]; len(gogsWebhookSecret) > 0 {
 		settings.WebhookGogsSecret = string(gogsWebhookSecret)
 	}
+	if giteaWebhookSecret := argoCDSecret.Data[settingsWebhookGiteaSecretKey]; len(giteaWebhookSecret) > 0 {
+		settings.WebhookGiteaSecret = string(giteaWebhookSecret)
+	}
+	if azureDevOpsWebhookUsername := argoCDSecret.Data[settingsWebhookAzureDevOpsUsernameKey]; len(azureDevOpsWebhookUsername) > 0 {
+		settings.WebhookAzureDevOpsUsername = string(azureDevOpsWebhookUsername)
+	}
+	if azureDevOpsWebhookPassword := argoCDSecret.Data[settingsWebhookAzureDevOpsPasswordKey]; len(azureDevOpsWebhookPassword) > 0 {
+		settings.WebhookAzureDevOpsPassword = string(azureDevOpsWebhookPassword)
+	}
+	settings.WebhookAdditionalSecrets = webhookAdditionalSecrets(argoCDSecret.Data)
+	for k, v := range argoCDSecret.Data {
+		if project := strings.TrimPrefix(k, settingsStatusBadgeKeyPrefix); project != k && project != "" {
+			if key := strings.TrimSpace(string(v)); key != "" {
+				if settings.StatusBadgeKeys == nil {
+					settings.StatusBadgeKeys = make(map[string]string)
+				}
+				settings.StatusBadgeKeys[project] = key
+			}
+		}
+	}
 
 	// The TLS certificate may be externally managed. We try to load it from an
 	// external secret first. If the external secret doesn't exist, we either
@@ -1126,6 +1941,16 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 			}
 		}
 	}
+	settings.Secrets = getSecretValues(argoCDSecret, secrets)
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// getSecretValues returns the values which can be referenced in the settings: the keys of argocd-secret, and the keys
+// of the other secrets as <secret-name>:<key>
+func getSecretValues(argoCDSecret *apiv1.Secret, secrets []*apiv1.Secret) map[string]string {
 	secretValues := make(map[string]string, len(argoCDSecret.Data))
 	for _, s := range secrets {
 		for k, v := range s.Data {
@@ -1135,11 +1960,7 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	for k, v := range argoCDSecret.Data {
 		secretValues[k] = string(v)
 	}
-	settings.Secrets = secretValues
-	if len(errs) > 0 {
-		return errs[0]
-	}
-	return nil
+	return secretValues
 }
 
 // externalServerTLSCertificate will try and load a TLS certificate from an
@@ -1182,6 +2003,11 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		} else {
 			delete(argoCDCM.Data, settingsOIDCConfigKey)
 		}
+		if settings.OIDCProvidersRAW != "" {
+			argoCDCM.Data[settingsOIDCProvidersKey] = settings.OIDCProvidersRAW
+		} else {
+			delete(argoCDCM.Data, settingsOIDCProvidersKey)
+		}
 		if settings.UiCssURL != "" {
 			argoCDCM.Data[settingUiCssURLKey] = settings.UiCssURL
 		}
@@ -1219,6 +2045,15 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		if settings.WebhookGogsSecret != "" {
 			argoCDSecret.Data[settingsWebhookGogsSecretKey] = []byte(settings.WebhookGogsSecret)
 		}
+		if settings.WebhookGiteaSecret != "" {
+			argoCDSecret.Data[settingsWebhookGiteaSecretKey] = []byte(settings.WebhookGiteaSecret)
+		}
+		if settings.WebhookAzureDevOpsUsername != "" {
+			argoCDSecret.Data[settingsWebhookAzureDevOpsUsernameKey] = []byte(settings.WebhookAzureDevOpsUsername)
+		}
+		if settings.WebhookAzureDevOpsPassword != "" {
+			argoCDSecret.Data[settingsWebhookAzureDevOpsPasswordKey] = []byte(settings.WebhookAzureDevOpsPassword)
+		}
 		// we only write the certificate to the secret if it's not externally
 		// managed.
 		if settings.Certificate != nil && !settings.CertificateIsExternal {
@@ -1325,6 +2160,9 @@ func (a *ArgoCDSettings) IsSSOConfigured() bool {
 	if a.OIDCConfig() != nil {
 		return true
 	}
+	if len(a.OIDCProviders()) > 0 {
+		return true
+	}
 	return false
 }
 
@@ -1366,6 +2204,97 @@ func UnmarshalOIDCConfig(config string) (OIDCConfig, error) {
 	return oidcConfig, err
 }
 
+// parseOIDCProviders parses the config of the additional OIDC providers, replacing the references to the secrets
+func (a *ArgoCDSettings) parseOIDCProviders() ([]OIDCConfig, error) {
+	if a.OIDCProvidersRAW == "" {
+		return nil, nil
+	}
+	providers, err := UnmarshalOIDCProviders(a.OIDCProvidersRAW)
+	if err != nil {
+		return nil, err
+	}
+	for i := range providers {
+		providers[i].ClientSecret = ReplaceStringSecret(providers[i].ClientSecret, a.Secrets)
+		providers[i].ClientID = ReplaceStringSecret(providers[i].ClientID, a.Secrets)
+	}
+	return providers, nil
+}
+
+func (a *ArgoCDSettings) getOIDCProviders() ([]OIDCConfig, error) {
+	if a.oidcProviders != nil && a.oidcProviders.raw == a.OIDCProvidersRAW {
+		return a.oidcProviders.providers, a.oidcProviders.err
+	}
+	return a.parseOIDCProviders()
+}
+
+// OIDCProviders returns the OIDC providers available in addition to the one of oidc.config, which are all disabled
+// if their config is invalid
+func (a *ArgoCDSettings) OIDCProviders() []OIDCConfig {
+	providers, _ := a.getOIDCProviders()
+	return providers
+}
+
+// MaxAPITokenDurationError returns the error of the configured maximum lifetime of the API tokens, if it is invalid,
+// in which case no API token may be created
+func (a *ArgoCDSettings) MaxAPITokenDurationError() error {
+	return a.maxAPITokenDurationErr
+}
+
+// OIDCProvidersError returns the error of the config of the additional OIDC providers, if it is invalid
+func (a *ArgoCDSettings) OIDCProvidersError() error {
+	_, err := a.getOIDCProviders()
+	return err
+}
+
+// OIDCProvider returns the additional OIDC provider with the given name, or nil if there is none
+func (a *ArgoCDSettings) OIDCProvider(name string) *OIDCConfig {
+	for _, provider := range a.OIDCProviders() {
+		if provider.Name == name {
+			return &provider
+		}
+	}
+	return nil
+}
+
+// OIDCProviderByIssuer returns the additional OIDC provider with the given issuer, or nil if there is none
+func (a *ArgoCDSettings) OIDCProviderByIssuer(issuer string) *OIDCConfig {
+	for _, provider := range a.OIDCProviders() {
+		if provider.Issuer == issuer {
+			return &provider
+		}
+	}
+	return nil
+}
+
+var oidcProviderNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+// UnmarshalOIDCProviders parses and validates the config of the additional OIDC providers
+func UnmarshalOIDCProviders(config string) ([]OIDCConfig, error) {
+	var providers []OIDCConfig
+	if err := yaml.Unmarshal([]byte(config), &providers); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	issuers := map[string]bool{}
+	for _, provider := range providers {
+		if !oidcProviderNameRegex.MatchString(provider.Name) {
+			return nil, fmt.Errorf("invalid OIDC provider name '%s': the name is part of the login URL and must only consist of alphanumeric characters, '.', '_' or '-'", provider.Name)
+		}
+		if provider.Issuer == "" {
+			return nil, fmt.Errorf("OIDC provider '%s' has no issuer", provider.Name)
+		}
+		if names[provider.Name] {
+			return nil, fmt.Errorf("OIDC provider '%s' is defined more than once", provider.Name)
+		}
+		if issuers[provider.Issuer] {
+			return nil, fmt.Errorf("OIDC issuer '%s' is used by more than one provider", provider.Issuer)
+		}
+		names[provider.Name] = true
+		issuers[provider.Issuer] = true
+	}
+	return providers, nil
+}
+
 // TLSConfig returns a tls.Config with the configured certificates
 func (a *ArgoCDSettings) TLSConfig() *tls.Config {
 	if a.Certificate == nil {
@@ -1425,6 +2354,11 @@ func (a *ArgoCDSettings) RedirectURL() (string, error) {
 	return appendURLPath(a.URL, common.CallbackEndpoint)
 }
 
+// OIDCProviderRedirectURL returns the callback URL of the additional OIDC provider with the given name
+func (a *ArgoCDSettings) OIDCProviderRedirectURL(name string) (string, error) {
+	return appendURLPath(a.URL, path.Join(common.CallbackEndpoint, name))
+}
+
 func (a *ArgoCDSettings) DexRedirectURL() (string, error) {
 	return appendURLPath(a.URL, common.DexCallbackEndpoint)
 }
@@ -1443,6 +2377,86 @@ func (a *ArgoCDSettings) DexOAuth2ClientSecret() string {
 	return base64.URLEncoding.EncodeToString(sha)[:40]
 }
 
+// webhookSecretKeys are the keys of the webhook secrets which can be rotated
+var webhookSecretKeys = []string{
+	settingsWebhookGitHubSecretKey,
+	settingsWebhookGitLabSecretKey,
+	settingsWebhookBitbucketUUIDKey,
+	settingsWebhookBitbucketServerSecretKey,
+	settingsWebhookGogsSecretKey,
+	settingsWebhookGiteaSecretKey,
+	settingsWebhookAzureDevOpsPasswordKey,
+}
+
+// webhookAdditionalSecrets returns the additional secrets of the webhook secrets, by key of the webhook secret. The
+// additional secrets of e.g. webhook.github.secret are held by the keys webhook.github.secret.<name>, sorted by name.
+// Their values are used as is.
+func webhookAdditionalSecrets(data map[string][]byte) map[string][]string {
+	var keys []string
+	for k, v := range data {
+		if len(v) > 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var secrets map[string][]string
+	for _, k := range keys {
+		for _, key := range webhookSecretKeys {
+			if name := strings.TrimPrefix(k, key+"."); name != k && name != "" {
+				if secrets == nil {
+					secrets = make(map[string][]string)
+				}
+				secrets[key] = append(secrets[key], string(data[k]))
+			}
+		}
+	}
+	return secrets
+}
+
+// webhookSecrets returns the webhook secret of a key followed by its additional secrets
+func (a *ArgoCDSettings) webhookSecrets(key string, secret string) []string {
+	var secrets []string
+	if secret != "" {
+		secrets = append(secrets, secret)
+	}
+	return append(secrets, a.WebhookAdditionalSecrets[key]...)
+}
+
+// WebhookGitHubSecrets returns the secrets accepted for the GitHub webhook events
+func (a *ArgoCDSettings) WebhookGitHubSecrets() []string {
+	return a.webhookSecrets(settingsWebhookGitHubSecretKey, a.WebhookGitHubSecret)
+}
+
+// WebhookGitLabSecrets returns the secrets accepted for the GitLab webhook events
+func (a *ArgoCDSettings) WebhookGitLabSecrets() []string {
+	return a.webhookSecrets(settingsWebhookGitLabSecretKey, a.WebhookGitLabSecret)
+}
+
+// WebhookBitbucketUUIDs returns the UUIDs accepted for the Bitbucket webhook events
+func (a *ArgoCDSettings) WebhookBitbucketUUIDs() []string {
+	return a.webhookSecrets(settingsWebhookBitbucketUUIDKey, a.WebhookBitbucketUUID)
+}
+
+// WebhookBitbucketServerSecrets returns the secrets accepted for the BitbucketServer webhook events
+func (a *ArgoCDSettings) WebhookBitbucketServerSecrets() []string {
+	return a.webhookSecrets(settingsWebhookBitbucketServerSecretKey, a.WebhookBitbucketServerSecret)
+}
+
+// WebhookGogsSecrets returns the secrets accepted for the Gogs webhook events
+func (a *ArgoCDSettings) WebhookGogsSecrets() []string {
+	return a.webhookSecrets(settingsWebhookGogsSecretKey, a.WebhookGogsSecret)
+}
+
+// WebhookGiteaSecrets returns the secrets accepted for the Gitea webhook events
+func (a *ArgoCDSettings) WebhookGiteaSecrets() []string {
+	return a.webhookSecrets(settingsWebhookGiteaSecretKey, a.WebhookGiteaSecret)
+}
+
+// WebhookAzureDevOpsPasswords returns the passwords accepted for the Azure DevOps webhook events
+func (a *ArgoCDSettings) WebhookAzureDevOpsPasswords() []string {
+	return a.webhookSecrets(settingsWebhookAzureDevOpsPasswordKey, a.WebhookAzureDevOpsPassword)
+}
+
 // Subscribe registers a channel in which to subscribe to settings updates
 func (mgr *SettingsManager) Subscribe(subCh chan<- *ArgoCDSettings) {
 	mgr.mutex.Lock()
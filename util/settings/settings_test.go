@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -135,6 +136,47 @@ func TestGetConfigManagementPlugins(t *testing.T) {
 	}}, plugins)
 }
 
+func TestGetConfigManagementPluginsWithSecrets(t *testing.T) {
+	data := map[string]string{
+		"configManagementPlugins": `
+      - name: kasane
+        generate:
+          command: [kasane, show]
+        env:
+        - name: TOKEN
+          value: $plugin.token
+        - name: OTHER_TOKEN
+          value: $plugin-secret:token
+        - name: FOO
+          value: bar`,
+	}
+	kubeClient, settingsManager := fixtures(data, func(secret *v1.Secret) {
+		secret.Data["plugin.token"] = []byte("argocd-secret-token")
+	})
+	_, err := kubeClient.CoreV1().Secrets("default").Create(context.Background(), &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "plugin-secret",
+			Namespace: "default",
+			Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+		},
+		Data: map[string][]byte{"token": []byte("other-secret-token")},
+	}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	plugins, err := settingsManager.GetConfigManagementPluginsWithSecrets()
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.Env{
+		{Name: "TOKEN", Value: "argocd-secret-token"},
+		{Name: "OTHER_TOKEN", Value: "other-secret-token"},
+		{Name: "FOO", Value: "bar"},
+	}, plugins[0].Env)
+
+	// the secret references are not resolved otherwise
+	plugins, err = settingsManager.GetConfigManagementPlugins()
+	assert.NoError(t, err)
+	assert.Equal(t, "$plugin.token", plugins[0].Env[0].Value)
+}
+
 func TestGetAppInstanceLabelKey(t *testing.T) {
 	_, settingsManager := fixtures(map[string]string{
 		"application.instanceLabelKey": "testLabel",
@@ -305,6 +347,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
         - bar`,
+			"resource.customizations.ignoreResourceUpdates.apps_Deployment": `jsonPointers:
+        - /status`,
 		}
 		crdGK := "apiextensions.k8s.io/CustomResourceDefinition"
 
@@ -332,6 +376,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, 1, len(overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers))
 		assert.Equal(t, 1, len(overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions))
 		assert.Equal(t, "bar", overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions[0])
+		assert.Equal(t, []string{"/status"}, overrides["apps/Deployment"].IgnoreResourceUpdates.JSONPointers)
 	})
 
 	t.Run("SplitKeysCompareOptionsAll", func(t *testing.T) {
@@ -414,6 +459,17 @@ func TestGetResourceCompareOptions(t *testing.T) {
 		assert.False(t, compareOptions.IgnoreAggregatedRoles)
 	}
 
+	// ignoreFieldsOwnedByOtherManagers is true with custom Argo CD managers
+	{
+		_, settingsManager := fixtures(map[string]string{
+			"resource.compareoptions": "ignoreFieldsOwnedByOtherManagers: true\nargoCDFieldManagers: [argocd-controller]",
+		})
+		compareOptions, err := settingsManager.GetResourceCompareOptions()
+		assert.NoError(t, err)
+		assert.True(t, compareOptions.IgnoreFieldsOwnedByOtherManagers)
+		assert.Equal(t, []string{"argocd-controller"}, compareOptions.ArgoCDFieldManagers)
+	}
+
 	// The empty resource.compareoptions should result in default being returned
 	{
 		_, settingsManager := fixtures(map[string]string{
@@ -435,6 +491,407 @@ func TestGetResourceCompareOptions(t *testing.T) {
 	}
 }
 
+func TestGetIsIgnoreResourceUpdatesEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, err := settingsManager.GetIsIgnoreResourceUpdatesEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"resource.ignoreResourceUpdatesEnabled": "true"})
+	enabled, err = settingsManager.GetIsIgnoreResourceUpdatesEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"resource.ignoreResourceUpdatesEnabled": "maybe"})
+	_, err = settingsManager.GetIsIgnoreResourceUpdatesEnabled()
+	assert.Error(t, err)
+}
+
+func TestIsImpersonationEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, err := settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"application.sync.impersonation.enabled": "true"})
+	enabled, err = settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestGetServerRBACLogEnforceEnable(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, err := settingsManager.GetServerRBACLogEnforceEnable()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"server.rbac.log.enforce.enable": "true"})
+	enabled, err = settingsManager.GetServerRBACLogEnforceEnable()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestIsExecEnabled(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	enabled, err := settingsManager.IsExecEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{"exec.enabled": "true"})
+	enabled, err = settingsManager.IsExecEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+}
+
+func TestGetExecShells(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	shells, err := settingsManager.GetExecShells()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bash", "sh", "powershell", "cmd"}, shells)
+
+	_, settingsManager = fixtures(map[string]string{"exec.shells": "zsh, sh,"})
+	shells, err = settingsManager.GetExecShells()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"zsh", "sh"}, shells)
+}
+
+func TestGetExecTimeouts(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	idleTimeout, sessionTimeout, err := settingsManager.GetExecTimeouts()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, idleTimeout)
+	assert.Equal(t, 8*time.Hour, sessionTimeout)
+
+	_, settingsManager = fixtures(map[string]string{"exec.idleTimeout": "5m", "exec.sessionTimeout": "1h"})
+	idleTimeout, sessionTimeout, err = settingsManager.GetExecTimeouts()
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, idleTimeout)
+	assert.Equal(t, time.Hour, sessionTimeout)
+
+	for _, value := range []string{"forever", "0s"} {
+		_, settingsManager = fixtures(map[string]string{"exec.sessionTimeout": value})
+		_, _, err = settingsManager.GetExecTimeouts()
+		assert.Error(t, err)
+	}
+}
+
+func TestMaxAPITokenDuration(t *testing.T) {
+	withSecretKey := func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("test")
+	}
+	for value, expected := range map[string]time.Duration{
+		"":        0,
+		"90d":     90 * 24 * time.Hour,
+		"forever": 0,
+	} {
+		data := map[string]string{}
+		if value != "" {
+			data["users.token.maxDuration"] = value
+		}
+		_, settingsManager := fixtures(data, withSecretKey)
+		settings, err := settingsManager.GetSettings()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, settings.MaxAPITokenDuration, value)
+		if value == "forever" {
+			assert.Error(t, settings.MaxAPITokenDurationError())
+		} else {
+			assert.NoError(t, settings.MaxAPITokenDurationError())
+		}
+	}
+}
+
+func TestGetSCIMSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	scimSettings, err := settingsManager.GetSCIMSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, &SCIMSettings{SubjectClaim: "sub"}, scimSettings)
+
+	_, settingsManager = fixtures(map[string]string{
+		"scim.enabled":      "true",
+		"scim.issuer":       "https://idp.example.com",
+		"scim.subjectClaim": "oid",
+	}, func(secret *v1.Secret) {
+		secret.Data["scim.bearerToken"] = []byte("token")
+	})
+	scimSettings, err = settingsManager.GetSCIMSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, &SCIMSettings{Enabled: true, BearerToken: "token", Issuer: "https://idp.example.com", SubjectClaim: "oid"}, scimSettings)
+
+	// the issuer defaults to the one of the SSO tokens
+	_, settingsManager = fixtures(map[string]string{
+		"scim.enabled": "true",
+		"url":          "https://argocd.example.com",
+		"oidc.config":  "name: Okta\nissuer: https://dev-123456.oktapreview.com\nclientID: aaaabbbbccccddddeee\n",
+	}, func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("secret")
+	})
+	scimSettings, err = settingsManager.GetSCIMSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://dev-123456.oktapreview.com", scimSettings.Issuer)
+
+	// and is required without SSO
+	_, settingsManager = fixtures(map[string]string{"scim.enabled": "true"}, func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("secret")
+	})
+	_, err = settingsManager.GetSCIMSettings()
+	assert.Error(t, err)
+
+	_, settingsManager = fixtures(map[string]string{"scim.enabled": "yes please"})
+	_, err = settingsManager.GetSCIMSettings()
+	assert.Error(t, err)
+}
+
+func TestGetTrustedProxies(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	proxies, err := settingsManager.GetTrustedProxies()
+	assert.NoError(t, err)
+	assert.Empty(t, proxies)
+
+	_, settingsManager = fixtures(map[string]string{"server.trustedProxies": "10.0.0.0/8, 192.168.1.1\nfd00::1"})
+	proxies, err = settingsManager.GetTrustedProxies()
+	assert.NoError(t, err)
+	if assert.Len(t, proxies, 3) {
+		assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+		assert.Equal(t, "192.168.1.1/32", proxies[1].String())
+		assert.Equal(t, "fd00::1/128", proxies[2].String())
+	}
+
+	_, settingsManager = fixtures(map[string]string{"server.trustedProxies": "ingress"})
+	_, err = settingsManager.GetTrustedProxies()
+	assert.Error(t, err)
+}
+
+func TestGetAPIRateLimits(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	rateLimits, err := settingsManager.GetAPIRateLimits()
+	assert.NoError(t, err)
+	assert.Empty(t, rateLimits)
+
+	_, settingsManager = fixtures(map[string]string{
+		"server.rateLimits": `
+- scope: account
+  methods: sync
+  requestsPerSecond: 0.2
+  burst: 5
+- scope: ip
+  requestsPerSecond: 20.5
+`,
+	})
+	rateLimits, err = settingsManager.GetAPIRateLimits()
+	assert.NoError(t, err)
+	assert.Equal(t, []APIRateLimit{
+		{Scope: "account", Methods: "sync", RequestsPerSecond: 0.2, Burst: 5},
+		{Scope: "ip", Methods: "all", RequestsPerSecond: 20.5, Burst: 21},
+	}, rateLimits)
+
+	for _, value := range []string{
+		"- scope: cluster\n  requestsPerSecond: 1",
+		"- scope: token\n  methods: delete\n  requestsPerSecond: 1",
+		"- scope: token",
+		"- scope: token\n  requestsPerSecond: 1\n  burst: -1",
+		"scope: token",
+	} {
+		_, settingsManager = fixtures(map[string]string{"server.rateLimits": value})
+		_, err = settingsManager.GetAPIRateLimits()
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGetAllowedCredentialsReferences(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	references, err := settingsManager.GetAllowedCredentialsReferences()
+	assert.NoError(t, err)
+	assert.Empty(t, references)
+
+	_, settingsManager = fixtures(map[string]string{
+		"credentials.allowedReferences": `
+- prefix: vault:secret/data/argocd/
+- prefix: vault:secret/data/team-a/
+  projects:
+  - team-a
+`,
+	})
+	references, err = settingsManager.GetAllowedCredentialsReferences()
+	assert.NoError(t, err)
+	assert.Equal(t, []AllowedCredentialsReference{
+		{Prefix: "vault:secret/data/argocd/"},
+		{Prefix: "vault:secret/data/team-a/", Projects: []string{"team-a"}},
+	}, references)
+
+	for _, value := range []string{
+		"- prefix: secret/data/argocd/",
+		"prefix: vault:secret/data/argocd/",
+	} {
+		_, settingsManager = fixtures(map[string]string{"credentials.allowedReferences": value})
+		_, err = settingsManager.GetAllowedCredentialsReferences()
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGetDeepLinks(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	links, err := settingsManager.GetApplicationDeepLinks()
+	assert.NoError(t, err)
+	assert.Empty(t, links)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.links": `
+- title: Grafana
+  url: https://grafana.example.com/d/apps?var-app={{.app.metadata.name}}
+  icon.class: fa-chart-area
+  selector: team=payments
+`,
+		"resource.links": `
+- title: Kibana
+  url: https://kibana.example.com/app/logs?pod={{.resource.metadata.name}}
+  description: Logs of the pod
+  kinds: [Pod]
+`,
+	})
+	links, err = settingsManager.GetApplicationDeepLinks()
+	assert.NoError(t, err)
+	assert.Equal(t, []DeepLink{{
+		Title:     "Grafana",
+		URL:       "https://grafana.example.com/d/apps?var-app={{.app.metadata.name}}",
+		IconClass: "fa-chart-area",
+		Selector:  "team=payments",
+	}}, links)
+	links, err = settingsManager.GetResourceDeepLinks()
+	assert.NoError(t, err)
+	assert.Equal(t, []DeepLink{{
+		Title:       "Kibana",
+		URL:         "https://kibana.example.com/app/logs?pod={{.resource.metadata.name}}",
+		Description: "Logs of the pod",
+		Kinds:       []string{"Pod"},
+	}}, links)
+
+	for _, value := range []string{
+		"- url: https://grafana.example.com",
+		"- title: Grafana",
+		"- title: Grafana\n  url: https://grafana.example.com/{{.app.metadata.name",
+		"- title: Grafana\n  url: https://grafana.example.com\n  selector: 'team in payments'",
+		"title: Grafana",
+	} {
+		_, settingsManager = fixtures(map[string]string{"application.links": value})
+		_, err = settingsManager.GetApplicationDeepLinks()
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGetExtensionConfigs(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	extensions, err := settingsManager.GetExtensionConfigs()
+	assert.NoError(t, err)
+	assert.Empty(t, extensions)
+
+	_, settingsManager = fixtures(map[string]string{
+		"extension.config": `
+extensions:
+- name: metrics
+  backend:
+    connectionTimeout: 5s
+    services:
+    - url: http://metrics.monitoring.svc
+    - url: https://metrics.example.com
+      cluster:
+        name: production
+`,
+	})
+	extensions, err = settingsManager.GetExtensionConfigs()
+	assert.NoError(t, err)
+	assert.Equal(t, []ExtensionConfig{{
+		Name: "metrics",
+		Backend: ExtensionBackend{
+			ConnectionTimeout: metav1.Duration{Duration: 5 * time.Second},
+			Services: []ExtensionService{
+				{URL: "http://metrics.monitoring.svc"},
+				{URL: "https://metrics.example.com", Cluster: &ExtensionCluster{Name: "production"}},
+			},
+		},
+	}}, extensions)
+
+	for _, value := range []string{
+		"extensions:\n- name: metrics/v1\n  backend:\n    services:\n    - url: http://metrics",
+		"extensions:\n- name: metrics\n  backend:\n    services:\n    - url: http://metrics\n- name: metrics\n  backend:\n    services:\n    - url: http://metrics",
+		"extensions:\n- name: metrics\n  backend: {}",
+		"extensions:\n- name: metrics\n  backend:\n    services:\n    - url: metrics.monitoring.svc",
+		"extensions:\n- name: metrics\n  backend:\n    services:\n    - url: http://metrics\n      cluster: {}",
+		"extensions:\n- name: metrics\n  backend:\n    services:\n    - url: http://metrics\n    - url: http://other",
+		"extensions: metrics",
+	} {
+		_, settingsManager = fixtures(map[string]string{"extension.config": value})
+		_, err = settingsManager.GetExtensionConfigs()
+		assert.Error(t, err, value)
+	}
+}
+
+func TestGetSettings_WebhookSecrets(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{}, func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("test")
+		secret.Data["webhook.github.secret"] = []byte(" current, secret\n")
+		secret.Data["webhook.github.secret.next"] = []byte("next-secret")
+		secret.Data["webhook.github.secret.empty"] = []byte("")
+		secret.Data["webhook.github.secret."] = []byte("no-name")
+		secret.Data["webhook.gitlab.secret.next"] = []byte("next-gitlab-secret")
+		secret.Data["webhook.azuredevops.password"] = []byte("password")
+		secret.Data["webhook.azuredevops.password.a"] = []byte("password-a")
+		secret.Data["webhook.azuredevops.password.b"] = []byte("password-b")
+	})
+	settings, err := settingsManager.GetSettings()
+	assert.NoError(t, err)
+	// the values of the webhook secrets are used as is
+	assert.Equal(t, []string{" current, secret\n", "next-secret"}, settings.WebhookGitHubSecrets())
+	assert.Equal(t, []string{"next-gitlab-secret"}, settings.WebhookGitLabSecrets())
+	assert.Equal(t, []string{"password", "password-a", "password-b"}, settings.WebhookAzureDevOpsPasswords())
+	assert.Empty(t, settings.WebhookGiteaSecrets())
+}
+
+func TestGetSettings_StatusBadgeKeys(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{}, func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("test")
+		secret.Data["statusbadge.key.default"] = []byte("default-key\n")
+		secret.Data["statusbadge.key.empty"] = []byte(" ")
+		secret.Data["statusbadge.key."] = []byte("no-project")
+	})
+	settings, err := settingsManager.GetSettings()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"default": "default-key"}, settings.StatusBadgeKeys)
+}
+
+func TestGetAppHistoryRetention(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{})
+	retention, err := settingsManager.GetAppHistoryRetention()
+	assert.NoError(t, err)
+	assert.Equal(t, &AppHistoryRetention{MaxEntries: 10}, retention)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.history.maxEntries":    "3",
+		"application.history.maxAge":        "720h",
+		"application.operationState.maxAge": "24h",
+	})
+	retention, err = settingsManager.GetAppHistoryRetention()
+	assert.NoError(t, err)
+	assert.Equal(t, &AppHistoryRetention{MaxEntries: 3, MaxAge: 720 * time.Hour, OperationStateMaxAge: 24 * time.Hour}, retention)
+
+	// the invalid settings fall back to their default
+	_, settingsManager = fixtures(map[string]string{
+		"application.history.maxEntries":    "0",
+		"application.history.maxAge":        "a month",
+		"application.operationState.maxAge": "-1h",
+	})
+	retention, err = settingsManager.GetAppHistoryRetention()
+	assert.NoError(t, err)
+	assert.Equal(t, &DefaultAppHistoryRetention, retention)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.history.maxEntries": "not a number",
+		"application.history.maxAge":     "1h",
+	})
+	retention, err = settingsManager.GetAppHistoryRetention()
+	assert.NoError(t, err)
+	assert.Equal(t, &AppHistoryRetention{MaxEntries: 10, MaxAge: time.Hour}, retention)
+}
+
 func TestSettingsManager_GetKustomizeBuildOptions(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
 		_, settingsManager := fixtures(map[string]string{})
@@ -528,12 +985,12 @@ func TestKustomizeSettings_GetOptions(t *testing.T) {
 
 	t.Run("VersionDoesNotExist", func(t *testing.T) {
 		_, err := settings.GetOptions(v1alpha1.ApplicationSource{
-			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v4"}})
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v4"}}, nil)
 		assert.Error(t, err)
 	})
 
 	t.Run("DefaultBuildOptions", func(t *testing.T) {
-		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{})
+		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{}, nil)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -543,7 +1000,7 @@ func TestKustomizeSettings_GetOptions(t *testing.T) {
 
 	t.Run("VersionExists", func(t *testing.T) {
 		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{
-			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v2"}})
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v2"}}, nil)
 		if !assert.NoError(t, err) {
 			return
 		}
@@ -553,13 +1010,82 @@ func TestKustomizeSettings_GetOptions(t *testing.T) {
 
 	t.Run("VersionExistsWithBuildOption", func(t *testing.T) {
 		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{
-			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v3"}})
+			Kustomize: &v1alpha1.ApplicationSourceKustomize{Version: "v3"}}, nil)
 		if !assert.NoError(t, err) {
 			return
 		}
 		assert.Equal(t, "path_v3", ver.BinaryPath)
 		assert.Equal(t, "--opt2 val2", ver.BuildOptions)
 	})
+
+	t.Run("ProjectBuildOptions", func(t *testing.T) {
+		buildOptions := &v1alpha1.KustomizeBuildOptions{EnableAlphaPlugins: true}
+		ver, err := settings.GetOptions(v1alpha1.ApplicationSource{}, &v1alpha1.AppProject{
+			Spec: v1alpha1.AppProjectSpec{KustomizeBuildOptions: buildOptions}})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "--opt1 val1", ver.BuildOptions)
+		assert.Equal(t, buildOptions, ver.ProjectBuildOptions)
+	})
+}
+
+func TestSettingsManager_GetHelmSettings(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"helm.path.v3.2.4":  "/custom-tools/helm-3.2.4",
+		"helm.path.v2.17.0": "/custom-tools/helm-2.17.0",
+		"other.options":     "--global true",
+	})
+
+	got, err := settingsManager.GetHelmSettings()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []HelmVersion{
+		{Name: "v2.17.0", Path: "/custom-tools/helm-2.17.0"},
+		{Name: "v3.2.4", Path: "/custom-tools/helm-3.2.4"},
+	}, got.Versions)
+}
+
+func TestHelmSettings_GetOptions(t *testing.T) {
+	settings := HelmSettings{
+		Versions: []HelmVersion{
+			{Name: "v2.17.0", Path: "path_v2.17.0"},
+			{Name: "3.2.4", Path: "path_3.2.4"},
+		},
+	}
+	source := func(version string) v1alpha1.ApplicationSource {
+		return v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{Version: version}}
+	}
+	proj := &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{HelmVersion: "3.2.4"}}
+
+	t.Run("NoVersion", func(t *testing.T) {
+		opts, err := settings.GetOptions(v1alpha1.ApplicationSource{}, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, opts)
+	})
+
+	t.Run("VersionDoesNotExist", func(t *testing.T) {
+		_, err := settings.GetOptions(source("v3.5.0"), nil)
+		assert.EqualError(t, err, "helm version v3.5.0 is not registered")
+	})
+
+	t.Run("BuiltInVersion", func(t *testing.T) {
+		opts, err := settings.GetOptions(source("v2"), proj)
+		assert.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HelmOptions{Version: "v2"}, opts)
+	})
+
+	t.Run("VersionExists", func(t *testing.T) {
+		opts, err := settings.GetOptions(source("v2.17.0"), proj)
+		assert.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HelmOptions{BinaryPath: "path_v2.17.0", Version: "v2"}, opts)
+	})
+
+	t.Run("ProjectDefault", func(t *testing.T) {
+		opts, err := settings.GetOptions(v1alpha1.ApplicationSource{}, proj)
+		assert.NoError(t, err)
+		assert.Equal(t, &v1alpha1.HelmOptions{BinaryPath: "path_3.2.4", Version: "v3"}, opts)
+	})
 }
 
 func TestGetGoogleAnalytics(t *testing.T) {
@@ -633,6 +1159,108 @@ func TestGetOIDCConfig(t *testing.T) {
 	assert.Equal(t, true, claim.Essential)
 }
 
+func TestOIDCProviders(t *testing.T) {
+	settings := &ArgoCDSettings{
+		URL: "https://argocd.example.com",
+		OIDCProvidersRAW: `
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+  clientID: $oidc.okta.clientID
+  clientSecret: $oidc.okta.clientSecret
+  groupsClaim: roles
+- name: azure
+  issuer: https://login.microsoftonline.com/tenant/v2.0
+  clientID: aaaabbbbccccddddeee`,
+		Secrets: map[string]string{
+			"oidc.okta.clientID":     "okta-client",
+			"oidc.okta.clientSecret": "okta-secret",
+		},
+	}
+	assert.True(t, settings.IsSSOConfigured())
+	providers := settings.OIDCProviders()
+	assert.Len(t, providers, 2)
+	assert.Equal(t, "okta-client", providers[0].ClientID)
+	assert.Equal(t, "okta-secret", providers[0].ClientSecret)
+	assert.Equal(t, "roles", providers[0].GroupsClaim)
+
+	provider := settings.OIDCProvider("azure")
+	if assert.NotNil(t, provider) {
+		assert.Equal(t, "https://login.microsoftonline.com/tenant/v2.0", provider.Issuer)
+	}
+	assert.Nil(t, settings.OIDCProvider("google"))
+
+	provider = settings.OIDCProviderByIssuer("https://dev-123456.oktapreview.com")
+	if assert.NotNil(t, provider) {
+		assert.Equal(t, "okta", provider.Name)
+	}
+	assert.Nil(t, settings.OIDCProviderByIssuer("https://accounts.google.com"))
+
+	redirectURL, err := settings.OIDCProviderRedirectURL("okta")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://argocd.example.com/auth/callback/okta", redirectURL)
+}
+
+func TestSettingsManager_OIDCProviders(t *testing.T) {
+	_, settingsManager := fixtures(map[string]string{
+		"url": "https://argocd.example.com",
+		"oidc.providers": `
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+  clientID: okta-client`,
+	}, func(secret *v1.Secret) {
+		secret.Data["server.secretkey"] = []byte("test")
+	})
+	settings, err := settingsManager.GetSettings()
+	assert.NoError(t, err)
+	providers := settings.OIDCProviders()
+	assert.Len(t, providers, 1)
+	assert.NoError(t, settings.OIDCProvidersError())
+
+	// the providers are only parsed once until the settings change
+	settings, err = settingsManager.GetSettings()
+	assert.NoError(t, err)
+	assert.Same(t, &providers[0], &settings.OIDCProviders()[0])
+
+	settings.OIDCProvidersRAW = `
+- name: okta
+  clientID: okta-client`
+	assert.Empty(t, settings.OIDCProviders())
+	assert.EqualError(t, settings.OIDCProvidersError(), "OIDC provider 'okta' has no issuer")
+}
+
+func TestUnmarshalOIDCProviders(t *testing.T) {
+	_, err := UnmarshalOIDCProviders(`
+- name: okta corp
+  issuer: https://dev-123456.oktapreview.com`)
+	assert.Error(t, err)
+
+	_, err = UnmarshalOIDCProviders(`
+- name: okta`)
+	assert.Error(t, err)
+
+	_, err = UnmarshalOIDCProviders(`
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+- name: okta
+  issuer: https://login.microsoftonline.com/tenant/v2.0`)
+	assert.Error(t, err)
+
+	_, err = UnmarshalOIDCProviders(`
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+- name: azure
+  issuer: https://dev-123456.oktapreview.com`)
+	assert.Error(t, err)
+
+	providers, err := UnmarshalOIDCProviders(`
+- name: okta
+  issuer: https://dev-123456.oktapreview.com
+- name: azure
+  issuer: https://login.microsoftonline.com/tenant/v2.0`)
+	assert.NoError(t, err)
+	assert.Len(t, providers, 2)
+}
+
 func TestRedirectURL(t *testing.T) {
 	cases := map[string][]string{
 		"https://localhost:4000":         {"https://localhost:4000/auth/callback", "https://localhost:4000/api/dex/callback"},
@@ -947,3 +1575,49 @@ requestedIDTokenClaims: {"groups": {"essential": true}}`,
 	oidcConfig := settings.OIDCConfig()
 	assert.Equal(t, oidcConfig.ClientSecret, "deadbeef")
 }
+
+func TestGetSecretsByIndex(t *testing.T) {
+	kubeClient, settingsManager := fixtures(nil)
+	newSecret := func(name string, secretType string, data map[string]string) *v1.Secret {
+		secret := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{common.LabelKeySecretType: secretType}},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+	for _, secret := range []*v1.Secret{
+		newSecret("cluster", common.LabelValueSecretTypeCluster, map[string]string{"server": "https://my-cluster/"}),
+		newSecret("repo", common.LabelValueSecretTypeRepository, map[string]string{"url": "https://github.com/argoproj/Argo-CD.git"}),
+		newSecret("repo-creds", common.LabelValueSecretTypeRepoCreds, map[string]string{"url": "https://github.com/argoproj"}),
+	} {
+		_, err := kubeClient.CoreV1().Secrets("default").Create(context.Background(), secret, metav1.CreateOptions{})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, settingsManager.ResyncInformers())
+
+	secrets, err := settingsManager.GetSecretsByIndex(BySecretTypeIndexer, common.LabelValueSecretTypeRepoCreds)
+	assert.NoError(t, err)
+	if assert.Len(t, secrets, 1) {
+		assert.Equal(t, "repo-creds", secrets[0].Name)
+	}
+
+	secrets, err = settingsManager.GetSecretsByIndex(ByClusterURLIndexer, "https://my-cluster")
+	assert.NoError(t, err)
+	if assert.Len(t, secrets, 1) {
+		assert.Equal(t, "cluster", secrets[0].Name)
+	}
+
+	secrets, err = settingsManager.GetSecretsByIndex(ByRepoURLIndexer, "https://github.com/argoproj/argo-cd")
+	assert.NoError(t, err)
+	if assert.Len(t, secrets, 1) {
+		assert.Equal(t, "repo", secrets[0].Name)
+	}
+
+	// repository credential templates are not indexed by URL
+	secrets, err = settingsManager.GetSecretsByIndex(ByRepoURLIndexer, "https://github.com/argoproj")
+	assert.NoError(t, err)
+	assert.Empty(t, secrets)
+}
@@ -0,0 +1,92 @@
+package sops
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+)
+
+// environment are the variables of the repo server environment passed to sops, the other variables, e.g. the
+// credentials of cloud KMS, are not passed so that sops only has access to the keys of the key set
+var environment = []string{"PATH", "TMPDIR", "LANG", "LC_ALL", "HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"}
+
+// IsEncrypted returns whether the given YAML or JSON document has been encrypted by SOPS, that is whether it holds the
+// SOPS metadata with the message authentication code of the encrypted values. Only the first document of a multi
+// document YAML file is checked.
+func IsEncrypted(data []byte) bool {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false
+	}
+	metadata, ok := doc["sops"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = metadata["mac"]
+	return ok
+}
+
+// Decrypter decrypts the files encrypted by SOPS using the `sops` binary and the keys of a key set. A key set is a
+// directory of the keys directory of the repo server, which is the home directory of `sops`: age keys are read from
+// .config/sops/age/keys.txt, PGP keys from the .gnupg directory and the credentials of cloud KMS from their default
+// location, e.g. .aws/credentials. The keys of the other key sets are not available to `sops`.
+type Decrypter struct {
+	// keySetDir is the directory of the key set
+	keySetDir string
+}
+
+// NewDecrypter returns the decrypter using the keys of the given key set of the keys directory
+func NewDecrypter(keysDir, keySet string) (*Decrypter, error) {
+	if keysDir == "" {
+		return nil, fmt.Errorf("SOPS key set '%s' is not available: the repo server has no SOPS keys directory", keySet)
+	}
+	if keySet == "" || keySet == "." || keySet == ".." || strings.ContainsAny(keySet, `/\`) {
+		return nil, fmt.Errorf("invalid SOPS key set name '%s'", keySet)
+	}
+	dir := filepath.Join(keysDir, keySet)
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("SOPS key set '%s' does not exist", keySet)
+	}
+	return &Decrypter{keySetDir: dir}, nil
+}
+
+// IsFileEncrypted returns whether the given YAML or JSON file has been encrypted by SOPS
+func IsFileEncrypted(path string) (bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	return IsEncrypted(data), nil
+}
+
+// Decrypt returns the decrypted content of the given file, in the format of the file. sops is run by the given runner,
+// with access to the keys of the key set of the decrypter.
+func (d *Decrypter) Decrypt(runner *executil.Runner, path string) ([]byte, error) {
+	cmd := exec.Command("sops", "--decrypt", path)
+	cmd.Env = []string{"HOME=" + d.keySetDir}
+	for _, name := range environment {
+		if value, ok := os.LookupEnv(name); ok {
+			cmd.Env = append(cmd.Env, name+"="+value)
+		}
+	}
+	args := strings.Join(cmd.Args, " ")
+	// the decrypted output must not be logged
+	out, err := runner.WithPaths(d.keySetDir).RunWithRedactor(cmd, func(text string) string {
+		if text == args || text == fmt.Sprintf("%v", cmd.Args) {
+			return text
+		}
+		return "******"
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s with sops: %v", path, err)
+	}
+	return []byte(out), nil
+}
@@ -0,0 +1,80 @@
+package sops
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeyFingerprint is the fingerprint of the GnuPG key of the end-to-end tests
+const testKeyFingerprint = "EA459B49595CBE3FD1FBA303D56C4FCA57A46444"
+
+func TestIsEncrypted(t *testing.T) {
+	assert.True(t, IsEncrypted([]byte(`password: ENC[AES256_GCM,data:2Q==,iv:AA==,tag:AA==,type:str]
+sops:
+    mac: ENC[AES256_GCM,data:AA==,iv:AA==,tag:AA==,type:str]
+    version: 3.7.1
+`)))
+	assert.True(t, IsEncrypted([]byte(`{"password": "ENC[AES256_GCM,data:2Q==,type:str]", "sops": {"mac": "ENC[AES256_GCM,data:AA==,type:str]"}}`)))
+	assert.False(t, IsEncrypted([]byte(`password: secret`)))
+	assert.False(t, IsEncrypted([]byte(`sops: enabled`)))
+	assert.False(t, IsEncrypted([]byte(`sops: {version: 3.7.1}`)))
+	assert.False(t, IsEncrypted([]byte(`{`)))
+}
+
+func TestNewDecrypter(t *testing.T) {
+	keysDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(keysDir, "my-keys"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(keysDir, "keys.txt"), nil, 0600))
+
+	_, err := NewDecrypter(keysDir, "my-keys")
+	assert.NoError(t, err)
+	_, err = NewDecrypter("", "my-keys")
+	assert.EqualError(t, err, "SOPS key set 'my-keys' is not available: the repo server has no SOPS keys directory")
+	_, err = NewDecrypter(keysDir, "other-keys")
+	assert.EqualError(t, err, "SOPS key set 'other-keys' does not exist")
+	_, err = NewDecrypter(keysDir, "keys.txt")
+	assert.EqualError(t, err, "SOPS key set 'keys.txt' does not exist")
+	for _, keySet := range []string{"", ".", "..", "../my-keys", "my-keys/.gnupg"} {
+		_, err = NewDecrypter(keysDir, keySet)
+		assert.EqualError(t, err, "invalid SOPS key set name '"+keySet+"'")
+	}
+}
+
+func TestDecrypter_Decrypt(t *testing.T) {
+	keysDir := t.TempDir()
+	gnupgHome := filepath.Join(keysDir, "my-keys", ".gnupg")
+	require.NoError(t, os.MkdirAll(gnupgHome, 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(keysDir, "other-keys"), 0700))
+	cmd := exec.Command("gpg", "--batch", "--import", "../../test/fixture/gpg/signingkey.asc")
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+gnupgHome)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	path := filepath.Join(t.TempDir(), "secret.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("password: secret\n"), 0600))
+	cmd = exec.Command("sops", "--encrypt", "--in-place", "--pgp", testKeyFingerprint, path)
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+gnupgHome)
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	encrypted, err := IsFileEncrypted(path)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+
+	decrypter, err := NewDecrypter(keysDir, "my-keys")
+	require.NoError(t, err)
+	decrypted, err := decrypter.Decrypt(nil, path)
+	require.NoError(t, err)
+	assert.Equal(t, "password: secret", string(decrypted))
+
+	// the keys of the other key sets are not available
+	decrypter, err = NewDecrypter(keysDir, "other-keys")
+	require.NoError(t, err)
+	_, err = decrypter.Decrypt(nil, path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,273 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// The webhooks library does not support Gitea and Azure DevOps, so their push and pull request events are parsed here.
+// The errors match the ones of the webhooks library.
+var (
+	errInvalidHTTPMethod      = errors.New("invalid HTTP Method")
+	errParsingPayload         = errors.New("error parsing payload")
+	errHMACVerificationFailed = errors.New("HMAC verification failed")
+	errMissingSignatureHeader = errors.New("missing X-Gitea-Signature Header")
+	errBasicAuthFailed        = errors.New("basic auth verification failed")
+	errEventNotFound          = errors.New("event not defined to be parsed")
+)
+
+// giteaPushPayload is the payload of the push events of Gitea.
+// See: https://docs.gitea.io/en-us/webhooks/
+type giteaPushPayload struct {
+	Ref     string `json:"ref"`
+	Before  string `json:"before"`
+	After   string `json:"after"`
+	Commits []struct {
+		Added    []string `json:"added"`
+		Removed  []string `json:"removed"`
+		Modified []string `json:"modified"`
+	} `json:"commits"`
+	Repository struct {
+		HTMLURL       string `json:"html_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+// giteaPullRequestPayload is the payload of the pull request events of Gitea
+type giteaPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Merged         bool   `json:"merged"`
+		MergeCommitSHA string `json:"merge_commit_sha"`
+		Head           struct {
+			Ref string `json:"ref"`
+			SHA string `json:"sha"`
+			// Repo is the repository of the source branch, which is a fork of the repository of the pull requests
+			// opened from forks
+			Repo *struct {
+				CloneURL      string `json:"clone_url"`
+				DefaultBranch string `json:"default_branch"`
+			} `json:"repo"`
+		} `json:"head"`
+		Base struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+	} `json:"pull_request"`
+	Repository struct {
+		HTMLURL       string `json:"html_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+// giteaWebhook parses the push and pull request events of Gitea, which are signed with one of the shared secrets if
+// they are set
+type giteaWebhook struct {
+	secrets []string
+}
+
+func (hook *giteaWebhook) Parse(r *http.Request) (interface{}, error) {
+	payload, err := readPayload(r)
+	if err != nil {
+		return nil, err
+	}
+	event := r.Header.Get("X-Gitea-Event")
+	if event != "push" && event != "pull_request" {
+		return nil, errEventNotFound
+	}
+	if len(hook.secrets) > 0 {
+		signature := r.Header.Get("X-Gitea-Signature")
+		if signature == "" {
+			return nil, errMissingSignatureHeader
+		}
+		if !verifyGiteaSignature(payload, signature, hook.secrets) {
+			return nil, errHMACVerificationFailed
+		}
+	}
+	if event == "pull_request" {
+		var pl giteaPullRequestPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, errParsingPayload
+		}
+		return pl, nil
+	}
+	var pl giteaPushPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return nil, errParsingPayload
+	}
+	return pl, nil
+}
+
+// verifyGiteaSignature returns whether the payload is signed with one of the secrets
+func verifyGiteaSignature(payload []byte, signature string, secrets []string) bool {
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write(payload)
+		if hmac.Equal([]byte(signature), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+			return true
+		}
+	}
+	return false
+}
+
+// azureDevOpsPushPayload is the payload of the "Code pushed" service hook events of Azure DevOps Services and Azure
+// DevOps Server.
+// See: https://docs.microsoft.com/en-us/azure/devops/service-hooks/events#code-pushed
+type azureDevOpsPushPayload struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		RefUpdates []azureDevOpsRefUpdate `json:"refUpdates"`
+		Repository struct {
+			RemoteURL     string `json:"remoteUrl"`
+			DefaultBranch string `json:"defaultBranch"`
+		} `json:"repository"`
+	} `json:"resource"`
+}
+
+// azureDevOpsRefUpdate is a ref updated by a push to an Azure DevOps repository
+type azureDevOpsRefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
+}
+
+// azureDevOpsPullRequestPayload is the payload of the "Pull request created" and "Pull request updated" service hook
+// events of Azure DevOps.
+// See: https://docs.microsoft.com/en-us/azure/devops/service-hooks/events#pull-request-updated
+type azureDevOpsPullRequestPayload struct {
+	EventType string `json:"eventType"`
+	Resource  struct {
+		PullRequestID         int    `json:"pullRequestId"`
+		Status                string `json:"status"`
+		SourceRefName         string `json:"sourceRefName"`
+		TargetRefName         string `json:"targetRefName"`
+		LastMergeSourceCommit struct {
+			CommitID string `json:"commitId"`
+		} `json:"lastMergeSourceCommit"`
+		LastMergeCommit struct {
+			CommitID string `json:"commitId"`
+		} `json:"lastMergeCommit"`
+		Repository struct {
+			ID            string `json:"id"`
+			RemoteURL     string `json:"remoteUrl"`
+			DefaultBranch string `json:"defaultBranch"`
+		} `json:"repository"`
+	} `json:"resource"`
+	// unchanged is set if the pull request was updated without changing its source branch or status, e.g. by a vote or
+	// a title edit
+	unchanged bool
+}
+
+// maxAzureDevOpsPullRequests is the maximum number of pull requests whose state azureDevOpsWebhook keeps
+const maxAzureDevOpsPullRequests = 10000
+
+// azureDevOpsPullRequestState is the state of a pull request as of its last event
+type azureDevOpsPullRequestState struct {
+	status       string
+	sourceCommit string
+}
+
+// azureDevOpsWebhook parses the push and pull request events of Azure DevOps. Service hooks can't sign their requests,
+// so they are authenticated with basic authentication if a username or passwords are set. Any of the passwords is
+// accepted.
+type azureDevOpsWebhook struct {
+	username  string
+	passwords []string
+
+	// The "Pull request updated" events are sent for any update of the pull requests, such as the votes, without telling
+	// what changed. The last state of the pull requests, by repository and pull request id, tells the updates of their
+	// source branch and status apart.
+	lock         sync.Mutex
+	pullRequests map[string]azureDevOpsPullRequestState
+}
+
+func (hook *azureDevOpsWebhook) Parse(r *http.Request) (interface{}, error) {
+	payload, err := readPayload(r)
+	if err != nil {
+		return nil, err
+	}
+	if hook.username != "" || len(hook.passwords) > 0 {
+		username, password, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(hook.username)) != 1 || !matchPassword(password, hook.passwords) {
+			return nil, errBasicAuthFailed
+		}
+	}
+	var event struct {
+		EventType string `json:"eventType"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, errParsingPayload
+	}
+	switch event.EventType {
+	case "git.push":
+		var pl azureDevOpsPushPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, errParsingPayload
+		}
+		return pl, nil
+	case "git.pullrequest.created", "git.pullrequest.updated":
+		var pl azureDevOpsPullRequestPayload
+		if err := json.Unmarshal(payload, &pl); err != nil {
+			return nil, errParsingPayload
+		}
+		pl.unchanged = !hook.updatePullRequestState(pl)
+		return pl, nil
+	default:
+		return nil, errEventNotFound
+	}
+}
+
+// updatePullRequestState records the state of the pull request of the event, and returns whether its source branch or
+// status changed since the previous event. The pull requests seen for the first time are considered changed.
+func (hook *azureDevOpsWebhook) updatePullRequestState(pl azureDevOpsPullRequestPayload) bool {
+	key := fmt.Sprintf("%s/%d", pl.Resource.Repository.ID, pl.Resource.PullRequestID)
+	state := azureDevOpsPullRequestState{status: pl.Resource.Status, sourceCommit: pl.Resource.LastMergeSourceCommit.CommitID}
+	hook.lock.Lock()
+	defer hook.lock.Unlock()
+	previous, ok := hook.pullRequests[key]
+	if ok && previous == state && pl.EventType == "git.pullrequest.updated" {
+		return false
+	}
+	if hook.pullRequests == nil || len(hook.pullRequests) >= maxAzureDevOpsPullRequests {
+		hook.pullRequests = make(map[string]azureDevOpsPullRequestState)
+	}
+	hook.pullRequests[key] = state
+	return true
+}
+
+// matchPassword returns whether the password is one of the passwords. An empty list of passwords only matches an empty
+// password.
+func matchPassword(password string, passwords []string) bool {
+	if len(passwords) == 0 {
+		return password == ""
+	}
+	for _, p := range passwords {
+		if subtle.ConstantTimeCompare([]byte(password), []byte(p)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func readPayload(r *http.Request) ([]byte, error) {
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+	if r.Method != http.MethodPost {
+		return nil, errInvalidHTTPMethod
+	}
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(payload) == 0 {
+		return nil, errParsingPayload
+	}
+	return payload, nil
+}
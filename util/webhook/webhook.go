@@ -1,14 +1,17 @@
 package webhook
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"html"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	gogsclient "github.com/gogits/go-gogs-client"
 	log "github.com/sirupsen/logrus"
@@ -21,73 +24,152 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/reposerver/cache"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
+	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
 type settingsSource interface {
 	GetAppInstanceLabelKey() (string, error)
+	GetTrackingMethod() (string, error)
+	GetConfigManagementPluginsWithSecrets() ([]v1alpha1.ConfigManagementPlugin, error)
+	GetKustomizeSettings() (*settings.KustomizeSettings, error)
+	GetHelmSettings() (*settings.HelmSettings, error)
+}
+
+const (
+	// warmManifestCacheParallelism is the maximum number of applications which manifests are generated concurrently
+	// after a push event
+	warmManifestCacheParallelism = 10
+	// warmManifestCacheQueueSize is the maximum number of applications waiting for their manifests to be generated.
+	// Applications pushed while the queue is full are refreshed right away.
+	warmManifestCacheQueueSize = 1000
+	// warmManifestCacheTimeout is the maximum duration between a push event and the refresh of an application, including
+	// the time spent waiting in the queue. The application is refreshed once the manifests are generated or the timeout
+	// expires.
+	warmManifestCacheTimeout = 2 * time.Minute
+)
+
+// warmManifestCacheItem is an application which manifests are generated before it is refreshed
+type warmManifestCacheItem struct {
+	app                 *v1alpha1.Application
+	trackingMethod      string
+	appInstanceLabelKey string
+	// deadline is the time the application is refreshed at the latest
+	deadline time.Time
 }
 
 var _ settingsSource = &settings.SettingsManager{}
 
 type ArgoCDWebhookHandler struct {
-	repoCache       *cache.Cache
-	serverCache     *servercache.Cache
-	db              db.ArgoDB
-	ns              string
-	appClientset    appclientset.Interface
-	github          *github.Webhook
-	gitlab          *gitlab.Webhook
-	bitbucket       *bitbucket.Webhook
-	bitbucketserver *bitbucketserver.Webhook
-	gogs            *gogs.Webhook
+	repoCache    *cache.Cache
+	serverCache  *servercache.Cache
+	db           db.ArgoDB
+	ns           string
+	appClientset appclientset.Interface
+	// the webhooks of the providers are created for each of their secrets, so that the events signed with any of them
+	// are accepted while a secret is rotated
+	github          []*github.Webhook
+	gitlab          []*gitlab.Webhook
+	bitbucket       []*bitbucket.Webhook
+	bitbucketserver []*bitbucketserver.Webhook
+	gogs            []*gogs.Webhook
+	gitea           *giteaWebhook
+	azuredevops     *azureDevOpsWebhook
 	settingsSrc     settingsSource
+	// repoClientset is used to warm the manifest cache of the applications affected by a push event before they are
+	// refreshed. The cache is not warmed if nil.
+	repoClientset apiclient.Clientset
+	// warmManifestCacheQueue holds the applications which manifest cache is warmed by a fixed number of workers
+	warmManifestCacheQueue chan warmManifestCacheItem
 }
 
-func NewHandler(namespace string, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB) *ArgoCDWebhookHandler {
-	githubWebhook, err := github.New(github.Options.Secret(set.WebhookGitHubSecret))
-	if err != nil {
-		log.Warnf("Unable to init the GitHub webhook")
+// NewHandler creates a webhook handler. If repoClientset is not nil, the handler generates the manifests of the
+// applications affected by a push event before refreshing them, so that the refreshes hit the manifest cache.
+func NewHandler(namespace string, appClientset appclientset.Interface, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB, repoClientset apiclient.Clientset) *ArgoCDWebhookHandler {
+	var githubWebhooks []*github.Webhook
+	for _, secret := range webhookSecrets(set.WebhookGitHubSecrets()) {
+		githubWebhook, err := github.New(github.Options.Secret(secret))
+		if err != nil {
+			log.Warnf("Unable to init the GitHub webhook")
+		}
+		githubWebhooks = append(githubWebhooks, githubWebhook)
 	}
-	gitlabWebhook, err := gitlab.New(gitlab.Options.Secret(set.WebhookGitLabSecret))
-	if err != nil {
-		log.Warnf("Unable to init the GitLab webhook")
+	var gitlabWebhooks []*gitlab.Webhook
+	for _, secret := range webhookSecrets(set.WebhookGitLabSecrets()) {
+		gitlabWebhook, err := gitlab.New(gitlab.Options.Secret(secret))
+		if err != nil {
+			log.Warnf("Unable to init the GitLab webhook")
+		}
+		gitlabWebhooks = append(gitlabWebhooks, gitlabWebhook)
 	}
-	bitbucketWebhook, err := bitbucket.New(bitbucket.Options.UUID(set.WebhookBitbucketUUID))
-	if err != nil {
-		log.Warnf("Unable to init the Bitbucket webhook")
+	var bitbucketWebhooks []*bitbucket.Webhook
+	for _, uuid := range webhookSecrets(set.WebhookBitbucketUUIDs()) {
+		bitbucketWebhook, err := bitbucket.New(bitbucket.Options.UUID(uuid))
+		if err != nil {
+			log.Warnf("Unable to init the Bitbucket webhook")
+		}
+		bitbucketWebhooks = append(bitbucketWebhooks, bitbucketWebhook)
 	}
-	bitbucketserverWebhook, err := bitbucketserver.New(bitbucketserver.Options.Secret(set.WebhookBitbucketServerSecret))
-	if err != nil {
-		log.Warnf("Unable to init the Bitbucket Server webhook")
+	var bitbucketserverWebhooks []*bitbucketserver.Webhook
+	for _, secret := range webhookSecrets(set.WebhookBitbucketServerSecrets()) {
+		bitbucketserverWebhook, err := bitbucketserver.New(bitbucketserver.Options.Secret(secret))
+		if err != nil {
+			log.Warnf("Unable to init the Bitbucket Server webhook")
+		}
+		bitbucketserverWebhooks = append(bitbucketserverWebhooks, bitbucketserverWebhook)
 	}
-	gogsWebhook, err := gogs.New(gogs.Options.Secret(set.WebhookGogsSecret))
-	if err != nil {
-		log.Warnf("Unable to init the Gogs webhook")
+	var gogsWebhooks []*gogs.Webhook
+	for _, secret := range webhookSecrets(set.WebhookGogsSecrets()) {
+		gogsWebhook, err := gogs.New(gogs.Options.Secret(secret))
+		if err != nil {
+			log.Warnf("Unable to init the Gogs webhook")
+		}
+		gogsWebhooks = append(gogsWebhooks, gogsWebhook)
 	}
 
 	acdWebhook := ArgoCDWebhookHandler{
 		ns:              namespace,
 		appClientset:    appClientset,
-		github:          githubWebhook,
-		gitlab:          gitlabWebhook,
-		bitbucket:       bitbucketWebhook,
-		bitbucketserver: bitbucketserverWebhook,
-		gogs:            gogsWebhook,
+		github:          githubWebhooks,
+		gitlab:          gitlabWebhooks,
+		bitbucket:       bitbucketWebhooks,
+		bitbucketserver: bitbucketserverWebhooks,
+		gogs:            gogsWebhooks,
+		gitea:           &giteaWebhook{secrets: set.WebhookGiteaSecrets()},
+		azuredevops:     &azureDevOpsWebhook{username: set.WebhookAzureDevOpsUsername, passwords: set.WebhookAzureDevOpsPasswords()},
 		settingsSrc:     settingsSrc,
 		repoCache:       repoCache,
 		serverCache:     serverCache,
 		db:              argoDB,
+		repoClientset:   repoClientset,
+	}
+	if repoClientset != nil {
+		acdWebhook.warmManifestCacheQueue = make(chan warmManifestCacheItem, warmManifestCacheQueueSize)
+		for i := 0; i < warmManifestCacheParallelism; i++ {
+			go acdWebhook.runWarmManifestCacheWorker()
+		}
 	}
 
 	return &acdWebhook
 }
 
+// webhookSecrets returns the secrets of a provider, or a single empty secret if none is set so that the events are not
+// authenticated
+func webhookSecrets(secrets []string) []string {
+	if len(secrets) == 0 {
+		return []string{""}
+	}
+	return secrets
+}
+
 func parseRevision(ref string) string {
 	refParts := strings.SplitN(ref, "/", 3)
 	return refParts[len(refParts)-1]
@@ -187,6 +269,66 @@ func affectedRevisionInfo(payloadIf interface{}) (webURLs []string, revision str
 			changedFiles = append(changedFiles, commit.Modified...)
 			changedFiles = append(changedFiles, commit.Removed...)
 		}
+	case giteaPushPayload:
+		webURLs = append(webURLs, payload.Repository.HTMLURL)
+		revision = parseRevision(payload.Ref)
+		change.shaAfter = parseRevision(payload.After)
+		change.shaBefore = parseRevision(payload.Before)
+		touchedHead = bool(payload.Repository.DefaultBranch == revision)
+		for _, commit := range payload.Commits {
+			changedFiles = append(changedFiles, commit.Added...)
+			changedFiles = append(changedFiles, commit.Modified...)
+			changedFiles = append(changedFiles, commit.Removed...)
+		}
+	case azureDevOpsPushPayload:
+		webURLs = append(webURLs, payload.Resource.Repository.RemoteURL)
+		// the events with multiple ref updates are split into one event per ref update by HandleEvent
+		for _, refUpdate := range payload.Resource.RefUpdates {
+			revision = parseRevision(refUpdate.Name)
+			change.shaAfter = refUpdate.NewObjectID
+			change.shaBefore = refUpdate.OldObjectID
+			break
+		}
+		touchedHead = bool(parseRevision(payload.Resource.Repository.DefaultBranch) == revision)
+
+		// Azure DevOps does not include a list of changed files in its payload
+		// so we cannot update changedFiles for this type of payload
+	case giteaPullRequestPayload:
+		// the pull requests which are opened or updated change their source branch, the merged ones their target branch.
+		// The refs of the pull requests are branch names.
+		switch {
+		case payload.Action == "opened" || payload.Action == "reopened" || payload.Action == "synchronized":
+			revision = payload.PullRequest.Head.Ref
+			change.shaAfter = payload.PullRequest.Head.SHA
+			// the source branch of the pull requests opened from a fork is in the forked repository
+			if head := payload.PullRequest.Head.Repo; head != nil && head.CloneURL != "" {
+				webURLs = append(webURLs, strings.TrimSuffix(head.CloneURL, ".git"))
+				touchedHead = bool(head.DefaultBranch == revision)
+			}
+		case payload.Action == "closed" && payload.PullRequest.Merged:
+			revision = payload.PullRequest.Base.Ref
+			change.shaAfter = payload.PullRequest.MergeCommitSHA
+		}
+		if revision != "" && len(webURLs) == 0 {
+			webURLs = append(webURLs, payload.Repository.HTMLURL)
+			touchedHead = bool(payload.Repository.DefaultBranch == revision)
+		}
+	case azureDevOpsPullRequestPayload:
+		if payload.unchanged {
+			break
+		}
+		switch payload.Resource.Status {
+		case "active":
+			revision = parseRevision(payload.Resource.SourceRefName)
+			change.shaAfter = payload.Resource.LastMergeSourceCommit.CommitID
+		case "completed":
+			revision = parseRevision(payload.Resource.TargetRefName)
+			change.shaAfter = payload.Resource.LastMergeCommit.CommitID
+		}
+		if revision != "" {
+			webURLs = append(webURLs, payload.Resource.Repository.RemoteURL)
+			touchedHead = bool(parseRevision(payload.Resource.Repository.DefaultBranch) == revision)
+		}
 	}
 	return webURLs, revision, change, touchedHead, changedFiles
 }
@@ -198,6 +340,15 @@ type changeInfo struct {
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
+	// Azure DevOps includes all the refs updated by a push in a single event, which are handled separately
+	if azurePayload, ok := payload.(azureDevOpsPushPayload); ok && len(azurePayload.Resource.RefUpdates) > 1 {
+		refUpdates := azurePayload.Resource.RefUpdates
+		for i := range refUpdates {
+			azurePayload.Resource.RefUpdates = refUpdates[i : i+1]
+			a.HandleEvent(azurePayload)
+		}
+		return
+	}
 	webURLs, revision, change, touchedHead, changedFiles := affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if len(webURLs) == 0 {
@@ -219,6 +370,12 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		log.Warnf("Failed to get appInstanceLabelKey: %v", err)
 		return
 	}
+	trackingMethod, err := a.settingsSrc.GetTrackingMethod()
+	if err != nil {
+		log.Warnf("Failed to get trackingMethod: %v", err)
+		return
+	}
+	trackingMethod = string(argo.ParseTrackingMethod(trackingMethod))
 
 	for _, webURL := range webURLs {
 		urlObj, err := url.Parse(webURL)
@@ -234,16 +391,21 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 			continue
 		}
 
+		a.deleteGitReferences(webURL, repoRegexp, apps.Items)
+
 		for _, app := range apps.Items {
 			if appRevisionHasChanged(&app, revision, touchedHead) && appUsesURL(&app, webURL, repoRegexp) {
 				if appFilesHaveChanged(&app, changedFiles) {
+					if a.repoClientset != nil && a.enqueueWarmManifestCache(&app, trackingMethod, appInstanceLabelKey) {
+						continue
+					}
 					_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
 					if err != nil {
 						log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
 						continue
 					}
 				} else if change.shaBefore != "" && change.shaAfter != "" {
-					if err := a.storePreviouslyCachedManifests(&app, change, appInstanceLabelKey); err != nil {
+					if err := a.storePreviouslyCachedManifests(&app, change, trackingMethod, appInstanceLabelKey); err != nil {
 						log.Warnf("Failed to store cached manifests of previous revision for app '%s': %v", app.Name, err)
 					}
 				}
@@ -252,7 +414,172 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
-func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Application, change changeInfo, appInstanceLabelKey string) error {
+// deleteGitReferences deletes the cached references of the pushed repository, for all the URLs the applications use
+// to refer to it, so that all the repo server replicas resolve the revisions of the repository again
+func (a *ArgoCDWebhookHandler) deleteGitReferences(webURL string, repoRegexp *regexp.Regexp, apps []v1alpha1.Application) {
+	repoURLs := map[string]bool{git.NormalizeGitURL(webURL): true}
+	for _, app := range apps {
+		if repoRegexp.MatchString(app.Spec.Source.RepoURL) {
+			repoURLs[git.NormalizeGitURL(app.Spec.Source.RepoURL)] = true
+		}
+	}
+	for repoURL := range repoURLs {
+		if err := a.repoCache.DeleteGitReferences(repoURL); err != nil {
+			log.Warnf("Failed to delete cached git references of repo '%s': %v", repoURL, err)
+		}
+	}
+}
+
+// enqueueWarmManifestCache queues the generation of the manifests of the application before it is refreshed. It returns
+// false if the queue is full, in which case the application must be refreshed right away.
+func (a *ArgoCDWebhookHandler) enqueueWarmManifestCache(app *v1alpha1.Application, trackingMethod string, appInstanceLabelKey string) bool {
+	item := warmManifestCacheItem{
+		app:                 app.DeepCopy(),
+		trackingMethod:      trackingMethod,
+		appInstanceLabelKey: appInstanceLabelKey,
+		deadline:            time.Now().Add(warmManifestCacheTimeout),
+	}
+	select {
+	case a.warmManifestCacheQueue <- item:
+		return true
+	default:
+		log.Warnf("Manifest cache warming queue is full, refreshing app '%s' without warming its manifest cache", app.Name)
+		return false
+	}
+}
+
+// runWarmManifestCacheWorker warms the manifest cache of the queued applications and refreshes them
+func (a *ArgoCDWebhookHandler) runWarmManifestCacheWorker() {
+	for item := range a.warmManifestCacheQueue {
+		a.warmManifestCacheAndRefresh(item)
+	}
+}
+
+// warmManifestCacheAndRefresh generates the manifests of the pushed revision of the application and then refreshes the
+// application. The application is refreshed even if the manifest generation fails, and without generating the manifests
+// if its deadline has expired while it was queued.
+func (a *ArgoCDWebhookHandler) warmManifestCacheAndRefresh(item warmManifestCacheItem) {
+	app := item.app
+	if time.Now().Before(item.deadline) {
+		ctx, cancel := context.WithDeadline(apiclient.WithRequestPriority(context.Background(), apiclient.RequestPriorityBackground), item.deadline)
+		res, err := a.warmManifestCache(ctx, app, item.trackingMethod, item.appInstanceLabelKey)
+		cancel()
+		if err != nil {
+			log.Warnf("Failed to warm manifest cache of app '%s': %v", app.Name, err)
+		} else {
+			log.Infof("Warmed manifest cache of app '%s' for revision %s (already cached: %v)", app.Name, res.Revision, res.Cached)
+		}
+	} else {
+		log.Warnf("Timed out waiting to warm manifest cache of app '%s', refreshing it without warming its manifest cache", app.Name)
+	}
+	_, err := argo.RefreshApp(a.appClientset.ArgoprojV1alpha1().Applications(a.ns), app.Name, v1alpha1.RefreshTypeNormal)
+	if err != nil {
+		log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.Name, err)
+	}
+}
+
+// warmManifestCache asks the repo server to generate the manifests of the application, using the same request as the
+// application controller so that the manifests are stored with the same cache key
+func (a *ArgoCDWebhookHandler) warmManifestCache(ctx context.Context, app *v1alpha1.Application, trackingMethod string, appInstanceLabelKey string) (*apiclient.WarmManifestCacheResponse, error) {
+	if err := argo.ValidateDestination(ctx, &app.Spec.Destination, a.db); err != nil {
+		return nil, err
+	}
+	var clusterInfo v1alpha1.ClusterInfo
+	if err := a.serverCache.GetClusterInfo(app.Spec.Destination.Server, &clusterInfo); err != nil {
+		return nil, err
+	}
+	proj, err := a.appClientset.ArgoprojV1alpha1().AppProjects(a.ns).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	repo, err := a.db.GetRepository(ctx, app.Spec.Source.RepoURL)
+	if err != nil {
+		return nil, err
+	}
+	helmRepos, err := a.db.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	permittedHelmRepos, err := argo.GetPermittedRepos(proj, helmRepos)
+	if err != nil {
+		return nil, err
+	}
+	helmRepositoryCredentials, err := a.db.GetAllHelmRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	permittedHelmCredentials, err := argo.GetPermittedReposCredentials(proj, helmRepositoryCredentials)
+	if err != nil {
+		return nil, err
+	}
+	jsonnetLibRepos, err := argo.GetJsonnetLibRepos(ctx, a.db, proj, app.Spec.Source)
+	if err != nil {
+		return nil, err
+	}
+	helmGitDependencyRepos, err := argo.GetHelmGitDependencyRepos(ctx, a.db, proj)
+	if err != nil {
+		return nil, err
+	}
+	plugins, err := a.settingsSrc.GetConfigManagementPluginsWithSecrets()
+	if err != nil {
+		return nil, err
+	}
+	tools := make([]*v1alpha1.ConfigManagementPlugin, len(plugins))
+	for i := range plugins {
+		tools[i] = &plugins[i]
+	}
+	kustomizeSettings, err := a.settingsSrc.GetKustomizeSettings()
+	if err != nil {
+		return nil, err
+	}
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source, proj)
+	if err != nil {
+		return nil, err
+	}
+	helmSettings, err := a.settingsSrc.GetHelmSettings()
+	if err != nil {
+		return nil, err
+	}
+	helmOptions, err := helmSettings.GetOptions(app.Spec.Source, proj)
+	if err != nil {
+		return nil, err
+	}
+	manifestGenerationTimeout, err := app.GetManifestGenerationTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, repoClient, err := a.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(conn)
+
+	return repoClient.WarmManifestCache(ctx, &apiclient.ManifestRequest{
+		Repo:                             repo,
+		Repos:                            permittedHelmRepos,
+		Revision:                         app.Spec.Source.TargetRevision,
+		AppLabelKey:                      appInstanceLabelKey,
+		TrackingMethod:                   trackingMethod,
+		AppName:                          app.Name,
+		Namespace:                        app.Spec.Destination.Namespace,
+		ApplicationSource:                &app.Spec.Source,
+		Plugins:                          tools,
+		KustomizeOptions:                 kustomizeOptions,
+		KubeVersion:                      clusterInfo.GetKubeVersion(),
+		ApiVersions:                      clusterInfo.GetApiVersions(),
+		VerifySignature:                  len(proj.Spec.SignatureKeys) > 0 && gpg.IsGPGEnabled(),
+		HelmRepoCreds:                    permittedHelmCredentials,
+		ChartSignatureVerification:       proj.Spec.ChartSignatureVerification,
+		ManifestGenerationTimeoutSeconds: int64(manifestGenerationTimeout.Seconds()),
+		JsonnetLibRepos:                  jsonnetLibRepos,
+		HelmGitDependencyRepos:           helmGitDependencyRepos,
+		HelmOptions:                      helmOptions,
+		SopsKeySet:                       proj.Spec.SopsKeySet,
+	})
+}
+
+func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Application, change changeInfo, trackingMethod string, appInstanceLabelKey string) error {
 	err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, a.db)
 	if err != nil {
 		return err
@@ -263,11 +590,15 @@ func (a *ArgoCDWebhookHandler) storePreviouslyCachedManifests(app *v1alpha1.Appl
 	if err != nil {
 		return err
 	}
+	proj, err := a.appClientset.ArgoprojV1alpha1().AppProjects(a.ns).Get(context.Background(), app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
 	var cachedManifests cache.CachedManifestResponse
-	if err := a.repoCache.GetManifests(change.shaBefore, &app.Spec.Source, &clusterInfo, app.Spec.Destination.Namespace, appInstanceLabelKey, app.Name, &cachedManifests); err == nil {
+	if err := a.repoCache.GetManifests(change.shaBefore, &app.Spec.Source, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, proj.Spec.SopsKeySet, &cachedManifests); err == nil {
 		return err
 	}
-	if err = a.repoCache.SetManifests(change.shaAfter, &app.Spec.Source, &clusterInfo, app.Spec.Destination.Namespace, appInstanceLabelKey, app.Name, &cachedManifests); err != nil {
+	if err = a.repoCache.SetManifests(change.shaAfter, &app.Spec.Source, &clusterInfo, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, proj.Spec.SopsKeySet, &cachedManifests); err != nil {
 		return err
 	}
 	return nil
@@ -356,17 +687,32 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 	var err error
 
 	switch {
+	case r.Header.Get("X-Vss-Activityid") != "":
+		payload, err = a.azuredevops.Parse(r)
+	//Gitea needs to be checked before Gogs and GitHub since it carries their headers as well
+	case r.Header.Get("X-Gitea-Event") != "":
+		payload, err = a.gitea.Parse(r)
 	//Gogs needs to be checked before GitHub since it carries both Gogs and (incompatible) GitHub headers
 	case r.Header.Get("X-Gogs-Event") != "":
-		payload, err = a.gogs.Parse(r, gogs.PushEvent)
+		payload, err = parseWithAnyWebhook(r, len(a.gogs), func(i int, r *http.Request) (interface{}, error) {
+			return a.gogs[i].Parse(r, gogs.PushEvent)
+		})
 	case r.Header.Get("X-GitHub-Event") != "":
-		payload, err = a.github.Parse(r, github.PushEvent)
+		payload, err = parseWithAnyWebhook(r, len(a.github), func(i int, r *http.Request) (interface{}, error) {
+			return a.github[i].Parse(r, github.PushEvent)
+		})
 	case r.Header.Get("X-Gitlab-Event") != "":
-		payload, err = a.gitlab.Parse(r, gitlab.PushEvents, gitlab.TagEvents)
+		payload, err = parseWithAnyWebhook(r, len(a.gitlab), func(i int, r *http.Request) (interface{}, error) {
+			return a.gitlab[i].Parse(r, gitlab.PushEvents, gitlab.TagEvents)
+		})
 	case r.Header.Get("X-Hook-UUID") != "":
-		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
+		payload, err = parseWithAnyWebhook(r, len(a.bitbucket), func(i int, r *http.Request) (interface{}, error) {
+			return a.bitbucket[i].Parse(r, bitbucket.RepoPushEvent)
+		})
 	case r.Header.Get("X-Event-Key") != "":
-		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+		payload, err = parseWithAnyWebhook(r, len(a.bitbucketserver), func(i int, r *http.Request) (interface{}, error) {
+			return a.bitbucketserver[i].Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+		})
 	default:
 		log.Debug("Ignoring unknown webhook event")
 		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
@@ -385,3 +731,29 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 
 	a.HandleEvent(payload)
 }
+
+// parseWithAnyWebhook parses the request with each of the given number of webhooks of a provider until one of them
+// succeeds, e.g. the webhook of the secret the event is signed with. The error of the first webhook is returned if none
+// of them succeeds.
+func parseWithAnyWebhook(r *http.Request, count int, parse func(i int, r *http.Request) (interface{}, error)) (interface{}, error) {
+	if count == 1 || r.Method != http.MethodPost {
+		return parse(0, r)
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	_ = r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var firstErr error
+	for i := 0; i < count; i++ {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		payload, err := parse(i, r)
+		if err == nil {
+			return payload, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
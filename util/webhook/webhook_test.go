@@ -2,6 +2,11 @@ package webhook
 
 import (
 	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -14,12 +19,17 @@ import (
 
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	repomocks "github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v2/reposerver/cache"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/settings"
@@ -32,14 +42,34 @@ func (f fakeSettingsSrc) GetAppInstanceLabelKey() (string, error) {
 	return "mycompany.com/appname", nil
 }
 
+func (f fakeSettingsSrc) GetTrackingMethod() (string, error) {
+	return "", nil
+}
+
+func (f fakeSettingsSrc) GetConfigManagementPluginsWithSecrets() ([]v1alpha1.ConfigManagementPlugin, error) {
+	return nil, nil
+}
+
+func (f fakeSettingsSrc) GetKustomizeSettings() (*settings.KustomizeSettings, error) {
+	return &settings.KustomizeSettings{}, nil
+}
+
+func (f fakeSettingsSrc) GetHelmSettings() (*settings.HelmSettings, error) {
+	return &settings.HelmSettings{}, nil
+}
+
 func NewMockHandler() *ArgoCDWebhookHandler {
+	return newMockHandlerWithSettings(&settings.ArgoCDSettings{})
+}
+
+func newMockHandlerWithSettings(set *settings.ArgoCDSettings) *ArgoCDWebhookHandler {
 	appClientset := appclientset.NewSimpleClientset()
 	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
-	return NewHandler("", appClientset, &settings.ArgoCDSettings{}, &fakeSettingsSrc{}, cache.NewCache(
+	return NewHandler("", appClientset, set, &fakeSettingsSrc{}, cache.NewCache(
 		cacheClient,
 		1*time.Minute,
 		1*time.Minute,
-	), servercache.NewCache(appstate.NewCache(cacheClient, time.Minute), time.Minute, time.Minute, time.Minute), &mocks.ArgoDB{})
+	), servercache.NewCache(appstate.NewCache(cacheClient, time.Minute), time.Minute, time.Minute, time.Minute), &mocks.ArgoDB{}, nil)
 }
 
 func TestGitHubCommitEvent(t *testing.T) {
@@ -58,6 +88,52 @@ func TestGitHubCommitEvent(t *testing.T) {
 	hook.Reset()
 }
 
+func TestGitHubCommitEvent_DeleteGitReferences(t *testing.T) {
+	h := NewMockHandler()
+	refs := []*plumbing.Reference{plumbing.NewReferenceFromStrings("refs/heads/master", "a67038ae2e9cb9b9b16423702f98b41e36601001")}
+	assert.NoError(t, h.repoCache.SetGitReferences("https://github.com/jessesuen/test-repo.git", refs))
+
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := ioutil.ReadFile("github-commit-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var res []*plumbing.Reference
+	assert.Equal(t, cache.ErrCacheMiss, h.repoCache.GetGitReferences("https://github.com/jessesuen/test-repo.git", &res))
+}
+
+func TestGitHubCommitEvent_SecretRotation(t *testing.T) {
+	eventJSON, err := ioutil.ReadFile("github-commit-event.json")
+	assert.NoError(t, err)
+	newRequest := func(secret string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+		req.Header.Set("X-GitHub-Event", "push")
+		mac := hmac.New(sha1.New, []byte(secret))
+		_, _ = mac.Write(eventJSON)
+		req.Header.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+		return req
+	}
+	h := newMockHandlerWithSettings(&settings.ArgoCDSettings{
+		WebhookGitHubSecret:      "old-secret",
+		WebhookAdditionalSecrets: map[string][]string{"webhook.github.secret": {"new-secret"}},
+	})
+
+	for _, secret := range []string{"new-secret", "old-secret"} {
+		w := httptest.NewRecorder()
+		h.Handler(w, newRequest(secret))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+
+	w := httptest.NewRecorder()
+	h.Handler(w, newRequest("invalid"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Webhook processing failed: HMAC verification failed\n", w.Body.String())
+}
+
 func TestGitHubTagEvent(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler()
@@ -138,6 +214,267 @@ func TestGitLabPushEvent(t *testing.T) {
 	hook.Reset()
 }
 
+func TestGiteaPushEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Gitea-Event", "push")
+	req.Header.Set("X-Gogs-Event", "push")
+	eventJSON, err := ioutil.ReadFile("gitea-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event repo: http://gitea-server/john/repo-test, revision: main, touchedHead: true"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestGiteaPushEvent_Signature(t *testing.T) {
+	eventJSON, err := ioutil.ReadFile("gitea-event.json")
+	assert.NoError(t, err)
+	newRequest := func(signature string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+		req.Header.Set("X-Gitea-Event", "push")
+		req.Header.Set("X-Gitea-Signature", signature)
+		return req
+	}
+	h := newMockHandlerWithSettings(&settings.ArgoCDSettings{
+		WebhookGiteaSecret:       "secret",
+		WebhookAdditionalSecrets: map[string][]string{"webhook.gitea.secret": {"new-secret"}},
+	})
+
+	w := httptest.NewRecorder()
+	h.Handler(w, newRequest("invalid"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Webhook processing failed: HMAC verification failed\n", w.Body.String())
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write(eventJSON)
+	w = httptest.NewRecorder()
+	h.Handler(w, newRequest(hex.EncodeToString(mac.Sum(nil))))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGiteaPullRequestEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Gitea-Event", "pull_request")
+	req.Header.Set("X-Gogs-Event", "pull_request")
+	eventJSON, err := ioutil.ReadFile("gitea-pull-request-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event repo: http://gitea-server/john/repo-test, revision: main, touchedHead: true"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestGiteaPullRequestEvent_Actions(t *testing.T) {
+	var payload giteaPullRequestPayload
+	payload.Repository.HTMLURL = "http://gitea-server/john/repo-test"
+	payload.Repository.DefaultBranch = "main"
+	payload.PullRequest.Head.Ref = "feature/scale"
+	payload.PullRequest.Head.SHA = "5c1b2e9d0b7f4b3c8a2e6f1d9c0b4a7e3f2d1c0b"
+	payload.PullRequest.Base.Ref = "main"
+	payload.PullRequest.MergeCommitSHA = "6b7cf3b1b2a1cbf2a0a6f2f0a0b5d7c3e0a9f1d4"
+
+	payload.Action = "synchronized"
+	webURLs, revision, change, touchedHead, _ := affectedRevisionInfo(payload)
+	assert.Equal(t, []string{"http://gitea-server/john/repo-test"}, webURLs)
+	assert.Equal(t, "feature/scale", revision)
+	assert.Equal(t, "5c1b2e9d0b7f4b3c8a2e6f1d9c0b4a7e3f2d1c0b", change.shaAfter)
+	assert.False(t, touchedHead)
+
+	payload.Action = "closed"
+	webURLs, _, _, _, _ = affectedRevisionInfo(payload)
+	assert.Empty(t, webURLs)
+
+	payload.PullRequest.Merged = true
+	_, revision, change, touchedHead, _ = affectedRevisionInfo(payload)
+	assert.Equal(t, "main", revision)
+	assert.Equal(t, "6b7cf3b1b2a1cbf2a0a6f2f0a0b5d7c3e0a9f1d4", change.shaAfter)
+	assert.True(t, touchedHead)
+
+	payload.Action = "labeled"
+	webURLs, _, _, _, _ = affectedRevisionInfo(payload)
+	assert.Empty(t, webURLs)
+}
+
+func TestGiteaPullRequestEvent_Fork(t *testing.T) {
+	var payload giteaPullRequestPayload
+	payload.Action = "opened"
+	payload.Repository.HTMLURL = "http://gitea-server/john/repo-test"
+	payload.Repository.DefaultBranch = "main"
+	payload.PullRequest.Head.Ref = "main"
+	payload.PullRequest.Head.SHA = "5c1b2e9d0b7f4b3c8a2e6f1d9c0b4a7e3f2d1c0b"
+	payload.PullRequest.Head.Repo = &struct {
+		CloneURL      string `json:"clone_url"`
+		DefaultBranch string `json:"default_branch"`
+	}{CloneURL: "http://gitea-server/jane/repo-test.git", DefaultBranch: "main"}
+
+	webURLs, revision, _, touchedHead, _ := affectedRevisionInfo(payload)
+	assert.Equal(t, []string{"http://gitea-server/jane/repo-test"}, webURLs)
+	assert.Equal(t, "main", revision)
+	assert.True(t, touchedHead)
+}
+
+func TestGiteaUnsupportedEvent(t *testing.T) {
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("X-Gitea-Event", "issues")
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Webhook processing failed: event not defined to be parsed\n", w.Body.String())
+}
+
+func TestAzureDevOpsPushEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Vss-ActivityId", "03c164c2-8912-4d5e-8009-3707d5f83734")
+	eventJSON, err := ioutil.ReadFile("azuredevops-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event repo: https://tfs.example.com/DefaultCollection/myproject/_git/test-repo, revision: master, touchedHead: true"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestAzureDevOpsPushEvent_MultipleRefUpdates(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	var payload azureDevOpsPushPayload
+	payload.Resource.Repository.RemoteURL = "https://tfs.example.com/DefaultCollection/myproject/_git/test-repo"
+	payload.Resource.Repository.DefaultBranch = "refs/heads/master"
+	payload.Resource.RefUpdates = []azureDevOpsRefUpdate{{Name: "refs/heads/feature"}, {Name: "refs/heads/master"}}
+	h.HandleEvent(payload)
+
+	var messages []string
+	for _, entry := range hook.AllEntries() {
+		messages = append(messages, entry.Message)
+	}
+	assert.Equal(t, []string{
+		"Received push event repo: https://tfs.example.com/DefaultCollection/myproject/_git/test-repo, revision: feature, touchedHead: false",
+		"Received push event repo: https://tfs.example.com/DefaultCollection/myproject/_git/test-repo, revision: master, touchedHead: true",
+	}, messages)
+	hook.Reset()
+}
+
+func TestAzureDevOpsPushEvent_BasicAuth(t *testing.T) {
+	eventJSON, err := ioutil.ReadFile("azuredevops-event.json")
+	assert.NoError(t, err)
+	newRequest := func(username, password string) *http.Request {
+		req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+		req.Header.Set("X-Vss-ActivityId", "03c164c2-8912-4d5e-8009-3707d5f83734")
+		req.SetBasicAuth(username, password)
+		return req
+	}
+	h := newMockHandlerWithSettings(&settings.ArgoCDSettings{
+		WebhookAzureDevOpsUsername: "argocd",
+		WebhookAzureDevOpsPassword: "secret",
+		WebhookAdditionalSecrets:   map[string][]string{"webhook.azuredevops.password": {"new-secret"}},
+	})
+
+	w := httptest.NewRecorder()
+	h.Handler(w, newRequest("argocd", "invalid"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Webhook processing failed: basic auth verification failed\n", w.Body.String())
+
+	for _, password := range []string{"new-secret", "secret"} {
+		w = httptest.NewRecorder()
+		h.Handler(w, newRequest("argocd", password))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
+
+func TestAzureDevOpsPullRequestEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Vss-ActivityId", "2ab4e3d3-b7a6-425e-92b1-5a9982c1269e")
+	eventJSON, err := ioutil.ReadFile("azuredevops-pull-request-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event repo: https://tfs.example.com/DefaultCollection/myproject/_git/test-repo, revision: feature/readme, touchedHead: false"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestAzureDevOpsPullRequestEvent_Unchanged(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	eventJSON, err := ioutil.ReadFile("azuredevops-pull-request-event.json")
+	require.NoError(t, err)
+	send := func(eventJSON []byte) string {
+		req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+		req.Header.Set("X-Vss-ActivityId", "2ab4e3d3-b7a6-425e-92b1-5a9982c1269e")
+		w := httptest.NewRecorder()
+		h.Handler(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		return hook.LastEntry().Message
+	}
+	refreshed := "Received push event repo: https://tfs.example.com/DefaultCollection/myproject/_git/test-repo, revision: feature/readme, touchedHead: false"
+
+	assert.Equal(t, refreshed, send(eventJSON))
+	// e.g. a vote
+	assert.Equal(t, "Ignoring webhook event", send(eventJSON))
+	// a new commit pushed to the source branch
+	assert.Equal(t, refreshed, send(bytes.Replace(eventJSON, []byte("53d54ac915144006c2c9e90d2c7d3880920db49c"), []byte("6b7cf3b1b2a1cbf2a0a6f2f0a0b5d7c3e0a9f1d4"), 1)))
+	assert.Equal(t, "Ignoring webhook event", send(bytes.Replace(eventJSON, []byte("53d54ac915144006c2c9e90d2c7d3880920db49c"), []byte("6b7cf3b1b2a1cbf2a0a6f2f0a0b5d7c3e0a9f1d4"), 1)))
+	hook.Reset()
+}
+
+func TestAzureDevOpsPullRequestEvent_Completed(t *testing.T) {
+	var payload azureDevOpsPullRequestPayload
+	payload.Resource.Repository.RemoteURL = "https://tfs.example.com/DefaultCollection/myproject/_git/test-repo"
+	payload.Resource.Repository.DefaultBranch = "refs/heads/master"
+	payload.Resource.SourceRefName = "refs/heads/feature/readme"
+	payload.Resource.TargetRefName = "refs/heads/master"
+	payload.Resource.LastMergeCommit.CommitID = "eef717f69257a6333f221566c1c987dc94cc0d72"
+
+	payload.Resource.Status = "completed"
+	webURLs, revision, change, touchedHead, _ := affectedRevisionInfo(payload)
+	assert.Equal(t, []string{"https://tfs.example.com/DefaultCollection/myproject/_git/test-repo"}, webURLs)
+	assert.Equal(t, "master", revision)
+	assert.Equal(t, "eef717f69257a6333f221566c1c987dc94cc0d72", change.shaAfter)
+	assert.True(t, touchedHead)
+
+	payload.Resource.Status = "abandoned"
+	webURLs, _, _, _, _ = affectedRevisionInfo(payload)
+	assert.Empty(t, webURLs)
+}
+
+func TestAzureDevOpsPushEvent_DeleteGitReferences(t *testing.T) {
+	h := NewMockHandler()
+	repoURL := "https://tfs.example.com/DefaultCollection/myproject/_git/test-repo"
+	refs := []*plumbing.Reference{plumbing.NewReferenceFromStrings("refs/heads/master", "aad331d8d3b131fa9ae03cf5e53965b51942618a")}
+	assert.NoError(t, h.repoCache.SetGitReferences(repoURL, refs))
+
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Vss-ActivityId", "03c164c2-8912-4d5e-8009-3707d5f83734")
+	eventJSON, err := ioutil.ReadFile("azuredevops-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var res []*plumbing.Reference
+	assert.Equal(t, cache.ErrCacheMiss, h.repoCache.GetGitReferences(repoURL, &res))
+}
+
 func TestInvalidMethod(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler()
@@ -250,3 +587,97 @@ func TestAppRevisionHasChanged(t *testing.T) {
 		},
 	}}, "dev", false))
 }
+
+// newWarmManifestCacheHandler returns a handler which warms the manifest cache of the application "my-app" using the
+// given repo server client
+func newWarmManifestCacheHandler(t *testing.T, repoClient *repomocks.RepoServerServiceClient) (*ArgoCDWebhookHandler, *appclientset.Clientset, *v1alpha1.Repository) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      v1alpha1.ApplicationSource{RepoURL: "https://github.com/jessesuen/test-repo", Path: "guestbook", TargetRevision: "HEAD"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "default"},
+		},
+	}
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	appClientset := appclientset.NewSimpleClientset(app, proj)
+
+	repo := &v1alpha1.Repository{Repo: "https://github.com/jessesuen/test-repo"}
+	argoDB := &mocks.ArgoDB{}
+	argoDB.On("GetRepository", mock.Anything, repo.Repo).Return(repo, nil)
+	argoDB.On("ListHelmRepositories", mock.Anything).Return(nil, nil)
+	argoDB.On("GetAllHelmRepositoryCredentials", mock.Anything).Return(nil, nil)
+	argoDB.On("ListRepositories", mock.Anything).Return(nil, nil)
+
+	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
+	serverCache := servercache.NewCache(appstate.NewCache(cacheClient, time.Minute), time.Minute, time.Minute, time.Minute)
+	assert.NoError(t, serverCache.SetClusterInfo("https://kubernetes.default.svc", &v1alpha1.ClusterInfo{ServerVersion: "1.21", APIVersions: []string{"apps/v1"}}))
+	h := NewHandler("", appClientset, &settings.ArgoCDSettings{}, &fakeSettingsSrc{}, cache.NewCache(cacheClient, time.Minute, time.Minute), serverCache, argoDB, &repomocks.Clientset{RepoServerServiceClient: repoClient})
+	return h, appClientset, repo
+}
+
+func sendGitHubCommitEvent(t *testing.T, h *ArgoCDWebhookHandler) {
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-GitHub-Event", "push")
+	eventJSON, err := ioutil.ReadFile("github-commit-event.json")
+	assert.NoError(t, err)
+	req.Body = ioutil.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func assertRefreshed(t *testing.T, appClientset *appclientset.Clientset) {
+	assert.Eventually(t, func() bool {
+		updated, err := appClientset.ArgoprojV1alpha1().Applications("").Get(context.Background(), "my-app", metav1.GetOptions{})
+		return err == nil && updated.Annotations[v1alpha1.AnnotationKeyRefresh] == string(v1alpha1.RefreshTypeNormal)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestGitHubCommitEvent_WarmManifestCache(t *testing.T) {
+	var warmReq *apiclient.ManifestRequest
+	repoClient := &repomocks.RepoServerServiceClient{}
+	repoClient.On("WarmManifestCache", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		warmReq = args.Get(1).(*apiclient.ManifestRequest)
+	}).Return(&apiclient.WarmManifestCacheResponse{Revision: "63738bb582c8b540af7bcfc18f87c575c3ed66e0"}, nil)
+	h, appClientset, repo := newWarmManifestCacheHandler(t, repoClient)
+
+	sendGitHubCommitEvent(t, h)
+
+	// the app is refreshed once the manifests are generated
+	assertRefreshed(t, appClientset)
+
+	repoClient.AssertExpectations(t)
+	assert.Equal(t, "HEAD", warmReq.Revision)
+	assert.Equal(t, "my-app", warmReq.AppName)
+	assert.Equal(t, "mycompany.com/appname", warmReq.AppLabelKey)
+	assert.Equal(t, "1.21", warmReq.KubeVersion)
+	assert.Equal(t, []string{"apps/v1"}, warmReq.ApiVersions)
+	assert.Equal(t, repo, warmReq.Repo)
+}
+
+func TestGitHubCommitEvent_WarmManifestCacheQueueFull(t *testing.T) {
+	repoClient := &repomocks.RepoServerServiceClient{}
+	h, appClientset, _ := newWarmManifestCacheHandler(t, repoClient)
+	// a queue without workers is always full
+	h.warmManifestCacheQueue = make(chan warmManifestCacheItem)
+
+	sendGitHubCommitEvent(t, h)
+
+	// the app is refreshed right away, without generating its manifests
+	assertRefreshed(t, appClientset)
+	repoClient.AssertNotCalled(t, "WarmManifestCache", mock.Anything, mock.Anything)
+}
+
+func TestWarmManifestCacheAndRefresh_DeadlineExpired(t *testing.T) {
+	repoClient := &repomocks.RepoServerServiceClient{}
+	h, appClientset, _ := newWarmManifestCacheHandler(t, repoClient)
+	app, err := appClientset.ArgoprojV1alpha1().Applications("").Get(context.Background(), "my-app", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	h.warmManifestCacheAndRefresh(warmManifestCacheItem{app: app, deadline: time.Now().Add(-time.Second)})
+
+	// the app is refreshed without generating its manifests once its deadline expired in the queue
+	assertRefreshed(t, appClientset)
+	repoClient.AssertNotCalled(t, "WarmManifestCache", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,113 @@
+package ytt
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v2/util/exec"
+	"github.com/argoproj/argo-cd/v2/util/security"
+)
+
+var (
+	// yttBinary and kbldBinary are the names of the Carvel executables, replaced by unit tests
+	yttBinary  = "ytt"
+	kbldBinary = "kbld"
+)
+
+// Ytt provides wrapper functionality around the `ytt` and `kbld` commands.
+type Ytt interface {
+	// Build returns a list of unstructured objects rendered by `ytt`, with images optionally resolved by `kbld`
+	Build(opts *v1alpha1.ApplicationSourceYtt) ([]*unstructured.Unstructured, error)
+}
+
+// NewYttApp create a new wrapper to run commands on the `ytt` command-line tool. The commands are run by the given
+// runner.
+func NewYttApp(path string, repoRoot string, runner *executil.Runner) Ytt {
+	return &ytt{path: path, repoRoot: repoRoot, runner: runner}
+}
+
+type ytt struct {
+	// path inside the checked out tree
+	path string
+	// root of the checked out tree, files outside of it cannot be referenced
+	repoRoot string
+	// runner of the commands
+	runner *executil.Runner
+}
+
+var _ Ytt = &ytt{}
+
+// resolvePath returns the absolute path of a file referenced by the application, which must be within the repository
+func (y *ytt) resolvePath(path string) (string, error) {
+	absRepoRoot, err := filepath.Abs(y.repoRoot)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(path) {
+		absAppPath, err := filepath.Abs(y.path)
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(absAppPath, path)
+	}
+	if _, err = security.EnforceToCurrentRoot(absRepoRoot, path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func (y *ytt) Build(opts *v1alpha1.ApplicationSourceYtt) ([]*unstructured.Unstructured, error) {
+	if opts == nil {
+		opts = &v1alpha1.ApplicationSourceYtt{}
+	}
+	args := []string{"-f", "."}
+	for _, f := range opts.Files {
+		path, err := y.resolvePath(f)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "-f", path)
+	}
+	for _, f := range opts.DataValuesFiles {
+		path, err := y.resolvePath(f)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--data-values-file", path)
+	}
+	for _, v := range opts.DataValues {
+		if strings.Contains(v.Name, "=") {
+			return nil, fmt.Errorf("invalid data value name '%s'", v.Name)
+		}
+		flag := "--data-value"
+		if v.YAML {
+			flag = "--data-value-yaml"
+		}
+		args = append(args, flag, fmt.Sprintf("%s=%s", v.Name, v.Value))
+	}
+
+	cmd := exec.Command(yttBinary, args...)
+	cmd.Dir = y.path
+	out, err := y.runner.Run(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Kbld {
+		cmd = exec.Command(kbldBinary, "-f", "-")
+		cmd.Dir = y.path
+		cmd.Stdin = strings.NewReader(out)
+		out, err = y.runner.Run(cmd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return kube.SplitYAML([]byte(out))
+}
@@ -0,0 +1,83 @@
+package ytt
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// fakeCarvel installs ytt, which renders a ConfigMap holding its arguments, and kbld, which resolves the image tag
+func fakeCarvel(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "fake-carvel")
+	require.NoError(t, err)
+	ytt := `#!/bin/sh
+cat <<EOF
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: rendered
+  annotations:
+    image: nginx:1.21
+data:
+  args: "$*"
+EOF
+`
+	kbld := `#!/bin/sh
+[ "$*" = "-f -" ] || exit 1
+sed 's/nginx:1.21/nginx@sha256:0123/'
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ytt"), []byte(ytt), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "kbld"), []byte(kbld), 0755))
+	prevYtt, prevKbld := yttBinary, kbldBinary
+	yttBinary, kbldBinary = filepath.Join(dir, "ytt"), filepath.Join(dir, "kbld")
+	return func() {
+		yttBinary, kbldBinary = prevYtt, prevKbld
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	defer fakeCarvel(t)()
+	repoRoot, err := ioutil.TempDir("", "ytt")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(repoRoot) }()
+	appPath := filepath.Join(repoRoot, "app")
+	require.NoError(t, os.Mkdir(appPath, 0755))
+
+	t.Run("DataValues", func(t *testing.T) {
+		objs, err := NewYttApp(appPath, repoRoot, nil).Build(&v1alpha1.ApplicationSourceYtt{
+			Files:           []string{"../overlays"},
+			DataValuesFiles: []string{"values/prod.yaml"},
+			DataValues: []v1alpha1.YttDataValue{
+				{Name: "app.name", Value: "guestbook"},
+				{Name: "app.replicas", Value: "2", YAML: true},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		args, _, _ := unstructured.NestedString(objs[0].Object, "data", "args")
+		assert.Equal(t, "-f . -f "+filepath.Join(repoRoot, "overlays")+" --data-values-file "+filepath.Join(appPath, "values/prod.yaml")+
+			" --data-value app.name=guestbook --data-value-yaml app.replicas=2", args)
+		assert.Equal(t, "nginx:1.21", objs[0].GetAnnotations()["image"])
+	})
+
+	t.Run("Kbld", func(t *testing.T) {
+		objs, err := NewYttApp(appPath, repoRoot, nil).Build(&v1alpha1.ApplicationSourceYtt{Kbld: true})
+		require.NoError(t, err)
+		require.Len(t, objs, 1)
+		assert.Equal(t, "nginx@sha256:0123", objs[0].GetAnnotations()["image"])
+	})
+
+	t.Run("OutsideRepository", func(t *testing.T) {
+		_, err := NewYttApp(appPath, repoRoot, nil).Build(&v1alpha1.ApplicationSourceYtt{DataValuesFiles: []string{"../../secrets.yaml"}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "should be on or under current directory")
+	})
+}